@@ -389,12 +389,17 @@ func (*ReplaceCandidateResponse) Descriptor() ([]byte, []int) {
 }
 
 type CommitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SessionId             string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	User                  string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Message               string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Confirmed             bool                   `protobuf:"varint,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`                                                        // roll back automatically unless confirmed before the timeout
+	ConfirmTimeoutSeconds uint32                 `protobuf:"varint,5,opt,name=confirm_timeout_seconds,json=confirmTimeoutSeconds,proto3" json:"confirm_timeout_seconds,omitempty"` // confirmed commit timeout (0 = 600 seconds)
+	Persist               string                 `protobuf:"bytes,6,opt,name=persist,proto3" json:"persist,omitempty"`                                                             // optional persist token for a confirmed commit (empty = generated)
+	PersistId             string                 `protobuf:"bytes,7,opt,name=persist_id,json=persistId,proto3" json:"persist_id,omitempty"`                                        // persist token of the pending confirmed commit being confirmed or extended
+	CommitAt              string                 `protobuf:"bytes,8,opt,name=commit_at,json=commitAt,proto3" json:"commit_at,omitempty"`                                           // optional RFC 3339 time to schedule the commit for instead of committing now
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CommitRequest) Reset() {
@@ -448,12 +453,50 @@ func (x *CommitRequest) GetMessage() string {
 	return ""
 }
 
+func (x *CommitRequest) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *CommitRequest) GetConfirmTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.ConfirmTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CommitRequest) GetPersist() string {
+	if x != nil {
+		return x.Persist
+	}
+	return ""
+}
+
+func (x *CommitRequest) GetPersistId() string {
+	if x != nil {
+		return x.PersistId
+	}
+	return ""
+}
+
+func (x *CommitRequest) GetCommitAt() string {
+	if x != nil {
+		return x.CommitAt
+	}
+	return ""
+}
+
 type CommitResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommitId      string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
-	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CommitId         string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	Version          uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	PersistId        string                 `protobuf:"bytes,3,opt,name=persist_id,json=persistId,proto3" json:"persist_id,omitempty"`                        // persist token when a confirmed commit is pending
+	ConfirmExpiresAt string                 `protobuf:"bytes,4,opt,name=confirm_expires_at,json=confirmExpiresAt,proto3" json:"confirm_expires_at,omitempty"` // RFC 3339 rollback time when a confirmed commit is pending
+	ScheduledAt      string                 `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`                  // RFC 3339 commit time when the commit was scheduled
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CommitResponse) Reset() {
@@ -500,27 +543,51 @@ func (x *CommitResponse) GetVersion() uint64 {
 	return 0
 }
 
-type ValidateCandidateRequest struct {
+func (x *CommitResponse) GetPersistId() string {
+	if x != nil {
+		return x.PersistId
+	}
+	return ""
+}
+
+func (x *CommitResponse) GetConfirmExpiresAt() string {
+	if x != nil {
+		return x.ConfirmExpiresAt
+	}
+	return ""
+}
+
+func (x *CommitResponse) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+type ScheduledCommit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ScheduledAt   string                 `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // RFC 3339 time the commit is performed
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // RFC 3339 time the commit was scheduled
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateCandidateRequest) Reset() {
-	*x = ValidateCandidateRequest{}
+func (x *ScheduledCommit) Reset() {
+	*x = ScheduledCommit{}
 	mi := &file_api_v1_router_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateCandidateRequest) String() string {
+func (x *ScheduledCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateCandidateRequest) ProtoMessage() {}
+func (*ScheduledCommit) ProtoMessage() {}
 
-func (x *ValidateCandidateRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduledCommit) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,38 +599,59 @@ func (x *ValidateCandidateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateCandidateRequest.ProtoReflect.Descriptor instead.
-func (*ValidateCandidateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduledCommit.ProtoReflect.Descriptor instead.
+func (*ScheduledCommit) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ValidateCandidateRequest) GetSessionId() string {
+func (x *ScheduledCommit) GetScheduledAt() string {
 	if x != nil {
-		return x.SessionId
+		return x.ScheduledAt
 	}
 	return ""
 }
 
-type ValidateCandidateResponse struct {
+func (x *ScheduledCommit) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ScheduledCommit) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ScheduledCommit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetScheduledCommitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateCandidateResponse) Reset() {
-	*x = ValidateCandidateResponse{}
+func (x *GetScheduledCommitRequest) Reset() {
+	*x = GetScheduledCommitRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateCandidateResponse) String() string {
+func (x *GetScheduledCommitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateCandidateResponse) ProtoMessage() {}
+func (*GetScheduledCommitRequest) ProtoMessage() {}
 
-func (x *ValidateCandidateResponse) ProtoReflect() protoreflect.Message {
+func (x *GetScheduledCommitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,32 +663,32 @@ func (x *ValidateCandidateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateCandidateResponse.ProtoReflect.Descriptor instead.
-func (*ValidateCandidateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetScheduledCommitRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledCommitRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{11}
 }
 
-type DiscardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetScheduledCommitResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledCommit *ScheduledCommit       `protobuf:"bytes,1,opt,name=scheduled_commit,json=scheduledCommit,proto3" json:"scheduled_commit,omitempty"` // unset when no commit is scheduled
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DiscardRequest) Reset() {
-	*x = DiscardRequest{}
+func (x *GetScheduledCommitResponse) Reset() {
+	*x = GetScheduledCommitResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiscardRequest) String() string {
+func (x *GetScheduledCommitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscardRequest) ProtoMessage() {}
+func (*GetScheduledCommitResponse) ProtoMessage() {}
 
-func (x *DiscardRequest) ProtoReflect() protoreflect.Message {
+func (x *GetScheduledCommitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,38 +700,39 @@ func (x *DiscardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscardRequest.ProtoReflect.Descriptor instead.
-func (*DiscardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetScheduledCommitResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledCommitResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DiscardRequest) GetSessionId() string {
+func (x *GetScheduledCommitResponse) GetScheduledCommit() *ScheduledCommit {
 	if x != nil {
-		return x.SessionId
+		return x.ScheduledCommit
 	}
-	return ""
+	return nil
 }
 
-type DiscardResponse struct {
+type ClearScheduledCommitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiscardResponse) Reset() {
-	*x = DiscardResponse{}
+func (x *ClearScheduledCommitRequest) Reset() {
+	*x = ClearScheduledCommitRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiscardResponse) String() string {
+func (x *ClearScheduledCommitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscardResponse) ProtoMessage() {}
+func (*ClearScheduledCommitRequest) ProtoMessage() {}
 
-func (x *DiscardResponse) ProtoReflect() protoreflect.Message {
+func (x *ClearScheduledCommitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,35 +744,39 @@ func (x *DiscardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscardResponse.ProtoReflect.Descriptor instead.
-func (*DiscardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClearScheduledCommitRequest.ProtoReflect.Descriptor instead.
+func (*ClearScheduledCommitRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{13}
 }
 
-type RollbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommitId      string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
-	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ClearScheduledCommitRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
 }
 
-func (x *RollbackRequest) Reset() {
-	*x = RollbackRequest{}
+type ClearScheduledCommitResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledCommit *ScheduledCommit       `protobuf:"bytes,1,opt,name=scheduled_commit,json=scheduledCommit,proto3" json:"scheduled_commit,omitempty"` // the cancelled commit
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClearScheduledCommitResponse) Reset() {
+	*x = ClearScheduledCommitResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackRequest) String() string {
+func (x *ClearScheduledCommitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackRequest) ProtoMessage() {}
+func (*ClearScheduledCommitResponse) ProtoMessage() {}
 
-func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
+func (x *ClearScheduledCommitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -695,61 +788,40 @@ func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
-func (*RollbackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClearScheduledCommitResponse.ProtoReflect.Descriptor instead.
+func (*ClearScheduledCommitResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RollbackRequest) GetCommitId() string {
-	if x != nil {
-		return x.CommitId
-	}
-	return ""
-}
-
-func (x *RollbackRequest) GetUser() string {
-	if x != nil {
-		return x.User
-	}
-	return ""
-}
-
-func (x *RollbackRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *RollbackRequest) GetSessionId() string {
+func (x *ClearScheduledCommitResponse) GetScheduledCommit() *ScheduledCommit {
 	if x != nil {
-		return x.SessionId
+		return x.ScheduledCommit
 	}
-	return ""
+	return nil
 }
 
-type RollbackResponse struct {
+type CheckDatastoreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NewCommitId   string                 `protobuf:"bytes,1,opt,name=new_commit_id,json=newCommitId,proto3" json:"new_commit_id,omitempty"`
-	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Repair        bool                   `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"` // remove orphaned candidates and expired locks
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollbackResponse) Reset() {
-	*x = RollbackResponse{}
+func (x *CheckDatastoreRequest) Reset() {
+	*x = CheckDatastoreRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackResponse) String() string {
+func (x *CheckDatastoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackResponse) ProtoMessage() {}
+func (*CheckDatastoreRequest) ProtoMessage() {}
 
-func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckDatastoreRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -761,46 +833,49 @@ func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
-func (*RollbackResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckDatastoreRequest.ProtoReflect.Descriptor instead.
+func (*CheckDatastoreRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RollbackResponse) GetNewCommitId() string {
+func (x *CheckDatastoreRequest) GetUser() string {
 	if x != nil {
-		return x.NewCommitId
+		return x.User
 	}
 	return ""
 }
 
-func (x *RollbackResponse) GetVersion() uint64 {
+func (x *CheckDatastoreRequest) GetRepair() bool {
 	if x != nil {
-		return x.Version
+		return x.Repair
 	}
-	return 0
+	return false
 }
 
-type DiffRequest struct {
+type DatastoreIssue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Check         string                 `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`   // storage, running, candidate, lock, or confirm
+	Object        string                 `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"` // affected record, such as a session or commit ID
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Repaired      bool                   `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiffRequest) Reset() {
-	*x = DiffRequest{}
+func (x *DatastoreIssue) Reset() {
+	*x = DatastoreIssue{}
 	mi := &file_api_v1_router_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiffRequest) String() string {
+func (x *DatastoreIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffRequest) ProtoMessage() {}
+func (*DatastoreIssue) ProtoMessage() {}
 
-func (x *DiffRequest) ProtoReflect() protoreflect.Message {
+func (x *DatastoreIssue) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -812,40 +887,60 @@ func (x *DiffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffRequest.ProtoReflect.Descriptor instead.
-func (*DiffRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DatastoreIssue.ProtoReflect.Descriptor instead.
+func (*DatastoreIssue) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DiffRequest) GetSessionId() string {
+func (x *DatastoreIssue) GetCheck() string {
 	if x != nil {
-		return x.SessionId
+		return x.Check
 	}
 	return ""
 }
 
-type DiffResponse struct {
+func (x *DatastoreIssue) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *DatastoreIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DatastoreIssue) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type CheckDatastoreResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DiffText      string                 `protobuf:"bytes,1,opt,name=diff_text,json=diffText,proto3" json:"diff_text,omitempty"`
-	HasChanges    bool                   `protobuf:"varint,2,opt,name=has_changes,json=hasChanges,proto3" json:"has_changes,omitempty"`
+	Issues        []*DatastoreIssue      `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiffResponse) Reset() {
-	*x = DiffResponse{}
+func (x *CheckDatastoreResponse) Reset() {
+	*x = CheckDatastoreResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiffResponse) String() string {
+func (x *CheckDatastoreResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffResponse) ProtoMessage() {}
+func (*CheckDatastoreResponse) ProtoMessage() {}
 
-func (x *DiffResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckDatastoreResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -857,47 +952,39 @@ func (x *DiffResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffResponse.ProtoReflect.Descriptor instead.
-func (*DiffResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckDatastoreResponse.ProtoReflect.Descriptor instead.
+func (*CheckDatastoreResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DiffResponse) GetDiffText() string {
-	if x != nil {
-		return x.DiffText
-	}
-	return ""
-}
-
-func (x *DiffResponse) GetHasChanges() bool {
+func (x *CheckDatastoreResponse) GetIssues() []*DatastoreIssue {
 	if x != nil {
-		return x.HasChanges
+		return x.Issues
 	}
-	return false
+	return nil
 }
 
-type ListHistoryRequest struct {
+type ValidateCandidateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryRequest) Reset() {
-	*x = ListHistoryRequest{}
+func (x *ValidateCandidateRequest) Reset() {
+	*x = ValidateCandidateRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryRequest) String() string {
+func (x *ValidateCandidateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryRequest) ProtoMessage() {}
+func (*ValidateCandidateRequest) ProtoMessage() {}
 
-func (x *ListHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateCandidateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,46 +996,38 @@ func (x *ListHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ListHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateCandidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCandidateRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListHistoryRequest) GetLimit() int32 {
+func (x *ValidateCandidateRequest) GetSessionId() string {
 	if x != nil {
-		return x.Limit
+		return x.SessionId
 	}
-	return 0
-}
-
-func (x *ListHistoryRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+	return ""
 }
 
-type ListHistoryResponse struct {
+type ValidateCandidateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*CommitEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryResponse) Reset() {
-	*x = ListHistoryResponse{}
+func (x *ValidateCandidateResponse) Reset() {
+	*x = ValidateCandidateResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryResponse) String() string {
+func (x *ValidateCandidateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryResponse) ProtoMessage() {}
+func (*ValidateCandidateResponse) ProtoMessage() {}
 
-func (x *ListHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidateCandidateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -960,45 +1039,32 @@ func (x *ListHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryResponse.ProtoReflect.Descriptor instead.
-func (*ListHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateCandidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCandidateResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListHistoryResponse) GetEntries() []*CommitEntry {
-	if x != nil {
-		return x.Entries
-	}
-	return nil
-}
-
-type CommitEntry struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	CommitId   string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
-	User       string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Timestamp  string                 `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Message    string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	IsRollback bool                   `protobuf:"varint,5,opt,name=is_rollback,json=isRollback,proto3" json:"is_rollback,omitempty"`
-	// Deprecated: Marked as deprecated in api/v1/router.proto.
-	ConfigText    string `protobuf:"bytes,6,opt,name=config_text,json=configText,proto3" json:"config_text,omitempty"` // metadata list responses do not include config text
+type DiscardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitEntry) Reset() {
-	*x = CommitEntry{}
+func (x *DiscardRequest) Reset() {
+	*x = DiscardRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitEntry) String() string {
+func (x *DiscardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitEntry) ProtoMessage() {}
+func (*DiscardRequest) ProtoMessage() {}
 
-func (x *CommitEntry) ProtoReflect() protoreflect.Message {
+func (x *DiscardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,75 +1076,38 @@ func (x *CommitEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitEntry.ProtoReflect.Descriptor instead.
-func (*CommitEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscardRequest.ProtoReflect.Descriptor instead.
+func (*DiscardRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CommitEntry) GetCommitId() string {
-	if x != nil {
-		return x.CommitId
-	}
-	return ""
-}
-
-func (x *CommitEntry) GetUser() string {
-	if x != nil {
-		return x.User
-	}
-	return ""
-}
-
-func (x *CommitEntry) GetTimestamp() string {
-	if x != nil {
-		return x.Timestamp
-	}
-	return ""
-}
-
-func (x *CommitEntry) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *CommitEntry) GetIsRollback() bool {
-	if x != nil {
-		return x.IsRollback
-	}
-	return false
-}
-
-// Deprecated: Marked as deprecated in api/v1/router.proto.
-func (x *CommitEntry) GetConfigText() string {
+func (x *DiscardRequest) GetSessionId() string {
 	if x != nil {
-		return x.ConfigText
+		return x.SessionId
 	}
 	return ""
 }
 
-type CreateSessionRequest struct {
+type DiscardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSessionRequest) Reset() {
-	*x = CreateSessionRequest{}
+func (x *DiscardResponse) Reset() {
+	*x = DiscardResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSessionRequest) String() string {
+func (x *DiscardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionRequest) ProtoMessage() {}
+func (*DiscardResponse) ProtoMessage() {}
 
-func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *DiscardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1090,39 +1119,35 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscardResponse.ProtoReflect.Descriptor instead.
+func (*DiscardResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateSessionRequest) GetUser() string {
-	if x != nil {
-		return x.User
-	}
-	return ""
-}
-
-type CreateSessionResponse struct {
+type RollbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CommitId      string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSessionResponse) Reset() {
-	*x = CreateSessionResponse{}
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSessionResponse) String() string {
+func (x *RollbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionResponse) ProtoMessage() {}
+func (*RollbackRequest) ProtoMessage() {}
 
-func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,39 +1159,61 @@ func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
-func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateSessionResponse) GetSessionId() string {
+func (x *RollbackRequest) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-type CloseSessionRequest struct {
+type RollbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	NewCommitId   string                 `protobuf:"bytes,1,opt,name=new_commit_id,json=newCommitId,proto3" json:"new_commit_id,omitempty"`
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseSessionRequest) Reset() {
-	*x = CloseSessionRequest{}
+func (x *RollbackResponse) Reset() {
+	*x = RollbackResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseSessionRequest) String() string {
+func (x *RollbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionRequest) ProtoMessage() {}
+func (*RollbackResponse) ProtoMessage() {}
 
-func (x *CloseSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1178,38 +1225,46 @@ func (x *CloseSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionRequest.ProtoReflect.Descriptor instead.
-func (*CloseSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CloseSessionRequest) GetSessionId() string {
+func (x *RollbackResponse) GetNewCommitId() string {
 	if x != nil {
-		return x.SessionId
+		return x.NewCommitId
 	}
 	return ""
 }
 
-type CloseSessionResponse struct {
+func (x *RollbackResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DiffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseSessionResponse) Reset() {
-	*x = CloseSessionResponse{}
+func (x *DiffRequest) Reset() {
+	*x = DiffRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseSessionResponse) String() string {
+func (x *DiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionResponse) ProtoMessage() {}
+func (*DiffRequest) ProtoMessage() {}
 
-func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *DiffRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1221,33 +1276,40 @@ func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionResponse.ProtoReflect.Descriptor instead.
-func (*CloseSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffRequest.ProtoReflect.Descriptor instead.
+func (*DiffRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{24}
 }
 
-type AcquireLockRequest struct {
+func (x *DiffRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type DiffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	DiffText      string                 `protobuf:"bytes,1,opt,name=diff_text,json=diffText,proto3" json:"diff_text,omitempty"`
+	HasChanges    bool                   `protobuf:"varint,2,opt,name=has_changes,json=hasChanges,proto3" json:"has_changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcquireLockRequest) Reset() {
-	*x = AcquireLockRequest{}
+func (x *DiffResponse) Reset() {
+	*x = DiffResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcquireLockRequest) String() string {
+func (x *DiffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcquireLockRequest) ProtoMessage() {}
+func (*DiffResponse) ProtoMessage() {}
 
-func (x *AcquireLockRequest) ProtoReflect() protoreflect.Message {
+func (x *DiffResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1259,45 +1321,47 @@ func (x *AcquireLockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcquireLockRequest.ProtoReflect.Descriptor instead.
-func (*AcquireLockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffResponse.ProtoReflect.Descriptor instead.
+func (*DiffResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AcquireLockRequest) GetSessionId() string {
+func (x *DiffResponse) GetDiffText() string {
 	if x != nil {
-		return x.SessionId
+		return x.DiffText
 	}
 	return ""
 }
 
-func (x *AcquireLockRequest) GetUser() string {
+func (x *DiffResponse) GetHasChanges() bool {
 	if x != nil {
-		return x.User
+		return x.HasChanges
 	}
-	return ""
+	return false
 }
 
-type AcquireLockResponse struct {
+type ListHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcquireLockResponse) Reset() {
-	*x = AcquireLockResponse{}
+func (x *ListHistoryRequest) Reset() {
+	*x = ListHistoryRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcquireLockResponse) String() string {
+func (x *ListHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcquireLockResponse) ProtoMessage() {}
+func (*ListHistoryRequest) ProtoMessage() {}
 
-func (x *AcquireLockResponse) ProtoReflect() protoreflect.Message {
+func (x *ListHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,32 +1373,46 @@ func (x *AcquireLockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcquireLockResponse.ProtoReflect.Descriptor instead.
-func (*AcquireLockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{26}
 }
 
-type ReleaseLockRequest struct {
+func (x *ListHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Entries       []*CommitEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseLockRequest) Reset() {
-	*x = ReleaseLockRequest{}
+func (x *ListHistoryResponse) Reset() {
+	*x = ListHistoryResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseLockRequest) String() string {
+func (x *ListHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseLockRequest) ProtoMessage() {}
+func (*ListHistoryResponse) ProtoMessage() {}
 
-func (x *ReleaseLockRequest) ProtoReflect() protoreflect.Message {
+func (x *ListHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1346,38 +1424,46 @@ func (x *ReleaseLockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseLockRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseLockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ReleaseLockRequest) GetSessionId() string {
+func (x *ListHistoryResponse) GetEntries() []*CommitEntry {
 	if x != nil {
-		return x.SessionId
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type ReleaseLockResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type CommitEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CommitId   string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	User       string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Timestamp  string                 `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Message    string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	IsRollback bool                   `protobuf:"varint,5,opt,name=is_rollback,json=isRollback,proto3" json:"is_rollback,omitempty"`
+	// Deprecated: Marked as deprecated in api/v1/router.proto.
+	ConfigText    string `protobuf:"bytes,6,opt,name=config_text,json=configText,proto3" json:"config_text,omitempty"` // metadata list responses do not include config text
+	Source        string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`                           // northbound interface that issued the commit (cli, netconf; empty for older entries)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseLockResponse) Reset() {
-	*x = ReleaseLockResponse{}
+func (x *CommitEntry) Reset() {
+	*x = CommitEntry{}
 	mi := &file_api_v1_router_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseLockResponse) String() string {
+func (x *CommitEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseLockResponse) ProtoMessage() {}
+func (*CommitEntry) ProtoMessage() {}
 
-func (x *ReleaseLockResponse) ProtoReflect() protoreflect.Message {
+func (x *CommitEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1389,32 +1475,82 @@ func (x *ReleaseLockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseLockResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseLockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitEntry.ProtoReflect.Descriptor instead.
+func (*CommitEntry) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{28}
 }
 
-type GetInterfacesRequest struct {
+func (x *CommitEntry) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *CommitEntry) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CommitEntry) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *CommitEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommitEntry) GetIsRollback() bool {
+	if x != nil {
+		return x.IsRollback
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in api/v1/router.proto.
+func (x *CommitEntry) GetConfigText() string {
+	if x != nil {
+		return x.ConfigText
+	}
+	return ""
+}
+
+func (x *CommitEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type CreateSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NameFilter    string                 `protobuf:"bytes,1,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"` // optional: filter by interface name
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInterfacesRequest) Reset() {
-	*x = GetInterfacesRequest{}
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInterfacesRequest) String() string {
+func (x *CreateSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInterfacesRequest) ProtoMessage() {}
+func (*CreateSessionRequest) ProtoMessage() {}
 
-func (x *GetInterfacesRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1426,39 +1562,39 @@ func (x *GetInterfacesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInterfacesRequest.ProtoReflect.Descriptor instead.
-func (*GetInterfacesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetInterfacesRequest) GetNameFilter() string {
+func (x *CreateSessionRequest) GetUser() string {
 	if x != nil {
-		return x.NameFilter
+		return x.User
 	}
 	return ""
 }
 
-type GetInterfacesResponse struct {
+type CreateSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Interfaces    []*InterfaceState      `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInterfacesResponse) Reset() {
-	*x = GetInterfacesResponse{}
+func (x *CreateSessionResponse) Reset() {
+	*x = CreateSessionResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInterfacesResponse) String() string {
+func (x *CreateSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInterfacesResponse) ProtoMessage() {}
+func (*CreateSessionResponse) ProtoMessage() {}
 
-func (x *GetInterfacesResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1470,55 +1606,39 @@ func (x *GetInterfacesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInterfacesResponse.ProtoReflect.Descriptor instead.
-func (*GetInterfacesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetInterfacesResponse) GetInterfaces() []*InterfaceState {
+func (x *CreateSessionResponse) GetSessionId() string {
 	if x != nil {
-		return x.Interfaces
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type InterfaceState struct {
+type CloseSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AdminStatus   string                 `protobuf:"bytes,2,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
-	OperStatus    string                 `protobuf:"bytes,3,opt,name=oper_status,json=operStatus,proto3" json:"oper_status,omitempty"`
-	Speed         uint64                 `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
-	Mtu           uint32                 `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	Mac           string                 `protobuf:"bytes,6,opt,name=mac,proto3" json:"mac,omitempty"`
-	RxPackets     uint64                 `protobuf:"varint,7,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
-	TxPackets     uint64                 `protobuf:"varint,8,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
-	RxBytes       uint64                 `protobuf:"varint,9,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
-	TxBytes       uint64                 `protobuf:"varint,10,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
-	RxErrors      uint64                 `protobuf:"varint,11,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
-	TxErrors      uint64                 `protobuf:"varint,12,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
-	RxQueues      []*InterfaceRxQueue    `protobuf:"bytes,13,rep,name=rx_queues,json=rxQueues,proto3" json:"rx_queues,omitempty"`
-	TxQueues      []*InterfaceTxQueue    `protobuf:"bytes,14,rep,name=tx_queues,json=txQueues,proto3" json:"tx_queues,omitempty"`
-	QosProfile    string                 `protobuf:"bytes,15,opt,name=qos_profile,json=qosProfile,proto3" json:"qos_profile,omitempty"`
-	Ipv4TableId   uint32                 `protobuf:"varint,16,opt,name=ipv4_table_id,json=ipv4TableId,proto3" json:"ipv4_table_id,omitempty"`
-	Ipv6TableId   uint32                 `protobuf:"varint,17,opt,name=ipv6_table_id,json=ipv6TableId,proto3" json:"ipv6_table_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceState) Reset() {
-	*x = InterfaceState{}
+func (x *CloseSessionRequest) Reset() {
+	*x = CloseSessionRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceState) String() string {
+func (x *CloseSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceState) ProtoMessage() {}
+func (*CloseSessionRequest) ProtoMessage() {}
 
-func (x *InterfaceState) ProtoReflect() protoreflect.Message {
+func (x *CloseSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1530,154 +1650,135 @@ func (x *InterfaceState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceState.ProtoReflect.Descriptor instead.
-func (*InterfaceState) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseSessionRequest.ProtoReflect.Descriptor instead.
+func (*CloseSessionRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *InterfaceState) GetName() string {
+func (x *CloseSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.Name
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *InterfaceState) GetAdminStatus() string {
-	if x != nil {
-		return x.AdminStatus
-	}
-	return ""
+type CloseSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceState) GetOperStatus() string {
-	if x != nil {
-		return x.OperStatus
-	}
-	return ""
+func (x *CloseSessionResponse) Reset() {
+	*x = CloseSessionResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceState) GetSpeed() uint64 {
-	if x != nil {
-		return x.Speed
-	}
-	return 0
+func (x *CloseSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InterfaceState) GetMtu() uint32 {
-	if x != nil {
-		return x.Mtu
-	}
-	return 0
-}
+func (*CloseSessionResponse) ProtoMessage() {}
 
-func (x *InterfaceState) GetMac() string {
+func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[32]
 	if x != nil {
-		return x.Mac
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InterfaceState) GetRxPackets() uint64 {
-	if x != nil {
-		return x.RxPackets
-	}
-	return 0
+// Deprecated: Use CloseSessionResponse.ProtoReflect.Descriptor instead.
+func (*CloseSessionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *InterfaceState) GetTxPackets() uint64 {
-	if x != nil {
-		return x.TxPackets
-	}
-	return 0
+type AcquireLockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"` // exclusive (default), private, or shared
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceState) GetRxBytes() uint64 {
-	if x != nil {
-		return x.RxBytes
-	}
-	return 0
-}
-
-func (x *InterfaceState) GetTxBytes() uint64 {
-	if x != nil {
-		return x.TxBytes
-	}
-	return 0
+func (x *AcquireLockRequest) Reset() {
+	*x = AcquireLockRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceState) GetRxErrors() uint64 {
-	if x != nil {
-		return x.RxErrors
-	}
-	return 0
+func (x *AcquireLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InterfaceState) GetTxErrors() uint64 {
-	if x != nil {
-		return x.TxErrors
-	}
-	return 0
-}
+func (*AcquireLockRequest) ProtoMessage() {}
 
-func (x *InterfaceState) GetRxQueues() []*InterfaceRxQueue {
+func (x *AcquireLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[33]
 	if x != nil {
-		return x.RxQueues
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InterfaceState) GetTxQueues() []*InterfaceTxQueue {
-	if x != nil {
-		return x.TxQueues
-	}
-	return nil
+// Deprecated: Use AcquireLockRequest.ProtoReflect.Descriptor instead.
+func (*AcquireLockRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *InterfaceState) GetQosProfile() string {
+func (x *AcquireLockRequest) GetSessionId() string {
 	if x != nil {
-		return x.QosProfile
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *InterfaceState) GetIpv4TableId() uint32 {
+func (x *AcquireLockRequest) GetUser() string {
 	if x != nil {
-		return x.Ipv4TableId
+		return x.User
 	}
-	return 0
+	return ""
 }
 
-func (x *InterfaceState) GetIpv6TableId() uint32 {
+func (x *AcquireLockRequest) GetMode() string {
 	if x != nil {
-		return x.Ipv6TableId
+		return x.Mode
 	}
-	return 0
+	return ""
 }
 
-type InterfaceRxQueue struct {
+type AcquireLockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueId       uint32                 `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
-	WorkerId      uint32                 `protobuf:"varint,2,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
-	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceRxQueue) Reset() {
-	*x = InterfaceRxQueue{}
-	mi := &file_api_v1_router_proto_msgTypes[32]
+func (x *AcquireLockResponse) Reset() {
+	*x = AcquireLockResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceRxQueue) String() string {
+func (x *AcquireLockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceRxQueue) ProtoMessage() {}
+func (*AcquireLockResponse) ProtoMessage() {}
 
-func (x *InterfaceRxQueue) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[32]
+func (x *AcquireLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1688,56 +1789,33 @@ func (x *InterfaceRxQueue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceRxQueue.ProtoReflect.Descriptor instead.
-func (*InterfaceRxQueue) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *InterfaceRxQueue) GetQueueId() uint32 {
-	if x != nil {
-		return x.QueueId
-	}
-	return 0
-}
-
-func (x *InterfaceRxQueue) GetWorkerId() uint32 {
-	if x != nil {
-		return x.WorkerId
-	}
-	return 0
-}
-
-func (x *InterfaceRxQueue) GetMode() string {
-	if x != nil {
-		return x.Mode
-	}
-	return ""
+// Deprecated: Use AcquireLockResponse.ProtoReflect.Descriptor instead.
+func (*AcquireLockResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{34}
 }
 
-type InterfaceTxQueue struct {
+type ReleaseLockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueId       uint32                 `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
-	Shared        bool                   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"`
-	Threads       []uint32               `protobuf:"varint,3,rep,packed,name=threads,proto3" json:"threads,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceTxQueue) Reset() {
-	*x = InterfaceTxQueue{}
-	mi := &file_api_v1_router_proto_msgTypes[33]
+func (x *ReleaseLockRequest) Reset() {
+	*x = ReleaseLockRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceTxQueue) String() string {
+func (x *ReleaseLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceTxQueue) ProtoMessage() {}
+func (*ReleaseLockRequest) ProtoMessage() {}
 
-func (x *InterfaceTxQueue) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[33]
+func (x *ReleaseLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1748,55 +1826,39 @@ func (x *InterfaceTxQueue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceTxQueue.ProtoReflect.Descriptor instead.
-func (*InterfaceTxQueue) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *InterfaceTxQueue) GetQueueId() uint32 {
-	if x != nil {
-		return x.QueueId
-	}
-	return 0
-}
-
-func (x *InterfaceTxQueue) GetShared() bool {
-	if x != nil {
-		return x.Shared
-	}
-	return false
+// Deprecated: Use ReleaseLockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLockRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *InterfaceTxQueue) GetThreads() []uint32 {
+func (x *ReleaseLockRequest) GetSessionId() string {
 	if x != nil {
-		return x.Threads
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type GetRoutesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PrefixFilter   string                 `protobuf:"bytes,1,opt,name=prefix_filter,json=prefixFilter,proto3" json:"prefix_filter,omitempty"`
-	ProtocolFilter string                 `protobuf:"bytes,2,opt,name=protocol_filter,json=protocolFilter,proto3" json:"protocol_filter,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ReleaseLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoutesRequest) Reset() {
-	*x = GetRoutesRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[34]
+func (x *ReleaseLockResponse) Reset() {
+	*x = ReleaseLockResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutesRequest) String() string {
+func (x *ReleaseLockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutesRequest) ProtoMessage() {}
+func (*ReleaseLockResponse) ProtoMessage() {}
 
-func (x *GetRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[34]
+func (x *ReleaseLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1807,47 +1869,33 @@ func (x *GetRoutesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutesRequest.ProtoReflect.Descriptor instead.
-func (*GetRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *GetRoutesRequest) GetPrefixFilter() string {
-	if x != nil {
-		return x.PrefixFilter
-	}
-	return ""
-}
-
-func (x *GetRoutesRequest) GetProtocolFilter() string {
-	if x != nil {
-		return x.ProtocolFilter
-	}
-	return ""
+// Deprecated: Use ReleaseLockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLockResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{36}
 }
 
-type GetRoutesResponse struct {
+type GetInterfacesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Routes        []*RouteEntry          `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	NameFilter    string                 `protobuf:"bytes,1,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"` // optional: filter by interface name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoutesResponse) Reset() {
-	*x = GetRoutesResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[35]
+func (x *GetInterfacesRequest) Reset() {
+	*x = GetInterfacesRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutesResponse) String() string {
+func (x *GetInterfacesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutesResponse) ProtoMessage() {}
+func (*GetInterfacesRequest) ProtoMessage() {}
 
-func (x *GetRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[35]
+func (x *GetInterfacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,45 +1906,40 @@ func (x *GetRoutesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutesResponse.ProtoReflect.Descriptor instead.
-func (*GetRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetInterfacesRequest.ProtoReflect.Descriptor instead.
+func (*GetInterfacesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetRoutesResponse) GetRoutes() []*RouteEntry {
+func (x *GetInterfacesRequest) GetNameFilter() string {
 	if x != nil {
-		return x.Routes
+		return x.NameFilter
 	}
-	return nil
+	return ""
 }
 
-type RouteEntry struct {
+type GetInterfacesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	NextHop       string                 `protobuf:"bytes,2,opt,name=next_hop,json=nextHop,proto3" json:"next_hop,omitempty"`
-	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Metric        uint32                 `protobuf:"varint,4,opt,name=metric,proto3" json:"metric,omitempty"`
-	Interface     string                 `protobuf:"bytes,5,opt,name=interface,proto3" json:"interface,omitempty"`
-	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	Interfaces    []*InterfaceState      `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteEntry) Reset() {
-	*x = RouteEntry{}
-	mi := &file_api_v1_router_proto_msgTypes[36]
+func (x *GetInterfacesResponse) Reset() {
+	*x = GetInterfacesResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteEntry) String() string {
+func (x *GetInterfacesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteEntry) ProtoMessage() {}
+func (*GetInterfacesResponse) ProtoMessage() {}
 
-func (x *RouteEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[36]
+func (x *GetInterfacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,74 +1950,58 @@ func (x *RouteEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteEntry.ProtoReflect.Descriptor instead.
-func (*RouteEntry) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *RouteEntry) GetPrefix() string {
-	if x != nil {
-		return x.Prefix
-	}
-	return ""
-}
-
-func (x *RouteEntry) GetNextHop() string {
-	if x != nil {
-		return x.NextHop
-	}
-	return ""
-}
-
-func (x *RouteEntry) GetProtocol() string {
-	if x != nil {
-		return x.Protocol
-	}
-	return ""
-}
-
-func (x *RouteEntry) GetMetric() uint32 {
-	if x != nil {
-		return x.Metric
-	}
-	return 0
-}
-
-func (x *RouteEntry) GetInterface() string {
-	if x != nil {
-		return x.Interface
-	}
-	return ""
+// Deprecated: Use GetInterfacesResponse.ProtoReflect.Descriptor instead.
+func (*GetInterfacesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RouteEntry) GetActive() bool {
+func (x *GetInterfacesResponse) GetInterfaces() []*InterfaceState {
 	if x != nil {
-		return x.Active
+		return x.Interfaces
 	}
-	return false
+	return nil
 }
 
-type GetBGPNeighborsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type InterfaceState struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AdminStatus string                 `protobuf:"bytes,2,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
+	OperStatus  string                 `protobuf:"bytes,3,opt,name=oper_status,json=operStatus,proto3" json:"oper_status,omitempty"`
+	Speed       uint64                 `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	Mtu         uint32                 `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Mac         string                 `protobuf:"bytes,6,opt,name=mac,proto3" json:"mac,omitempty"`
+	RxPackets   uint64                 `protobuf:"varint,7,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	TxPackets   uint64                 `protobuf:"varint,8,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	RxBytes     uint64                 `protobuf:"varint,9,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes     uint64                 `protobuf:"varint,10,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	RxErrors    uint64                 `protobuf:"varint,11,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	TxErrors    uint64                 `protobuf:"varint,12,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	RxQueues    []*InterfaceRxQueue    `protobuf:"bytes,13,rep,name=rx_queues,json=rxQueues,proto3" json:"rx_queues,omitempty"`
+	TxQueues    []*InterfaceTxQueue    `protobuf:"bytes,14,rep,name=tx_queues,json=txQueues,proto3" json:"tx_queues,omitempty"`
+	QosProfile  string                 `protobuf:"bytes,15,opt,name=qos_profile,json=qosProfile,proto3" json:"qos_profile,omitempty"`
+	Ipv4TableId uint32                 `protobuf:"varint,16,opt,name=ipv4_table_id,json=ipv4TableId,proto3" json:"ipv4_table_id,omitempty"`
+	Ipv6TableId uint32                 `protobuf:"varint,17,opt,name=ipv6_table_id,json=ipv6TableId,proto3" json:"ipv6_table_id,omitempty"`
+	// Stable logical interface index, persisted across VPP and daemon restarts.
+	IfIndex       uint32 `protobuf:"varint,18,opt,name=if_index,json=ifIndex,proto3" json:"if_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBGPNeighborsRequest) Reset() {
-	*x = GetBGPNeighborsRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[37]
+func (x *InterfaceState) Reset() {
+	*x = InterfaceState{}
+	mi := &file_api_v1_router_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBGPNeighborsRequest) String() string {
+func (x *InterfaceState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBGPNeighborsRequest) ProtoMessage() {}
+func (*InterfaceState) ProtoMessage() {}
 
-func (x *GetBGPNeighborsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[37]
+func (x *InterfaceState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1985,160 +2012,160 @@ func (x *GetBGPNeighborsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBGPNeighborsRequest.ProtoReflect.Descriptor instead.
-func (*GetBGPNeighborsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use InterfaceState.ProtoReflect.Descriptor instead.
+func (*InterfaceState) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{39}
 }
 
-type GetBGPNeighborsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Neighbors     []*BGPNeighborState    `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InterfaceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetBGPNeighborsResponse) Reset() {
-	*x = GetBGPNeighborsResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[38]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InterfaceState) GetAdminStatus() string {
+	if x != nil {
+		return x.AdminStatus
+	}
+	return ""
 }
 
-func (x *GetBGPNeighborsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InterfaceState) GetOperStatus() string {
+	if x != nil {
+		return x.OperStatus
+	}
+	return ""
 }
 
-func (*GetBGPNeighborsResponse) ProtoMessage() {}
-
-func (x *GetBGPNeighborsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[38]
+func (x *InterfaceState) GetSpeed() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Speed
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetBGPNeighborsResponse.ProtoReflect.Descriptor instead.
-func (*GetBGPNeighborsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{38}
+func (x *InterfaceState) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
 }
 
-func (x *GetBGPNeighborsResponse) GetNeighbors() []*BGPNeighborState {
+func (x *InterfaceState) GetMac() string {
 	if x != nil {
-		return x.Neighbors
+		return x.Mac
 	}
-	return nil
+	return ""
 }
 
-type BGPNeighborState struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PeerAddress    string                 `protobuf:"bytes,1,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
-	PeerAs         uint32                 `protobuf:"varint,2,opt,name=peer_as,json=peerAs,proto3" json:"peer_as,omitempty"`
-	State          string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	UptimeSecs     uint64                 `protobuf:"varint,4,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
-	PrefixReceived uint32                 `protobuf:"varint,5,opt,name=prefix_received,json=prefixReceived,proto3" json:"prefix_received,omitempty"`
-	PrefixSent     uint32                 `protobuf:"varint,6,opt,name=prefix_sent,json=prefixSent,proto3" json:"prefix_sent,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *InterfaceState) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
 }
 
-func (x *BGPNeighborState) Reset() {
-	*x = BGPNeighborState{}
-	mi := &file_api_v1_router_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InterfaceState) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
 }
 
-func (x *BGPNeighborState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InterfaceState) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
 }
 
-func (*BGPNeighborState) ProtoMessage() {}
+func (x *InterfaceState) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
 
-func (x *BGPNeighborState) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[39]
+func (x *InterfaceState) GetRxErrors() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RxErrors
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use BGPNeighborState.ProtoReflect.Descriptor instead.
-func (*BGPNeighborState) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{39}
+func (x *InterfaceState) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
 }
 
-func (x *BGPNeighborState) GetPeerAddress() string {
+func (x *InterfaceState) GetRxQueues() []*InterfaceRxQueue {
 	if x != nil {
-		return x.PeerAddress
+		return x.RxQueues
 	}
-	return ""
+	return nil
 }
 
-func (x *BGPNeighborState) GetPeerAs() uint32 {
+func (x *InterfaceState) GetTxQueues() []*InterfaceTxQueue {
 	if x != nil {
-		return x.PeerAs
+		return x.TxQueues
 	}
-	return 0
+	return nil
 }
 
-func (x *BGPNeighborState) GetState() string {
+func (x *InterfaceState) GetQosProfile() string {
 	if x != nil {
-		return x.State
+		return x.QosProfile
 	}
 	return ""
 }
 
-func (x *BGPNeighborState) GetUptimeSecs() uint64 {
+func (x *InterfaceState) GetIpv4TableId() uint32 {
 	if x != nil {
-		return x.UptimeSecs
+		return x.Ipv4TableId
 	}
 	return 0
 }
 
-func (x *BGPNeighborState) GetPrefixReceived() uint32 {
+func (x *InterfaceState) GetIpv6TableId() uint32 {
 	if x != nil {
-		return x.PrefixReceived
+		return x.Ipv6TableId
 	}
 	return 0
 }
 
-func (x *BGPNeighborState) GetPrefixSent() uint32 {
+func (x *InterfaceState) GetIfIndex() uint32 {
 	if x != nil {
-		return x.PrefixSent
+		return x.IfIndex
 	}
 	return 0
 }
 
-type GetOSPFNeighborsRequest struct {
+type InterfaceRxQueue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressFamily string                 `protobuf:"bytes,1,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"`
+	QueueId       uint32                 `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	WorkerId      uint32                 `protobuf:"varint,2,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOSPFNeighborsRequest) Reset() {
-	*x = GetOSPFNeighborsRequest{}
+func (x *InterfaceRxQueue) Reset() {
+	*x = InterfaceRxQueue{}
 	mi := &file_api_v1_router_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOSPFNeighborsRequest) String() string {
+func (x *InterfaceRxQueue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOSPFNeighborsRequest) ProtoMessage() {}
+func (*InterfaceRxQueue) ProtoMessage() {}
 
-func (x *GetOSPFNeighborsRequest) ProtoReflect() protoreflect.Message {
+func (x *InterfaceRxQueue) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2150,39 +2177,55 @@ func (x *GetOSPFNeighborsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOSPFNeighborsRequest.ProtoReflect.Descriptor instead.
-func (*GetOSPFNeighborsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InterfaceRxQueue.ProtoReflect.Descriptor instead.
+func (*InterfaceRxQueue) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetOSPFNeighborsRequest) GetAddressFamily() string {
+func (x *InterfaceRxQueue) GetQueueId() uint32 {
 	if x != nil {
-		return x.AddressFamily
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *InterfaceRxQueue) GetWorkerId() uint32 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+func (x *InterfaceRxQueue) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
 	return ""
 }
 
-type GetOSPFNeighborsResponse struct {
+type InterfaceTxQueue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Neighbors     []*OSPFNeighborState   `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+	QueueId       uint32                 `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Shared        bool                   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"`
+	Threads       []uint32               `protobuf:"varint,3,rep,packed,name=threads,proto3" json:"threads,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOSPFNeighborsResponse) Reset() {
-	*x = GetOSPFNeighborsResponse{}
+func (x *InterfaceTxQueue) Reset() {
+	*x = InterfaceTxQueue{}
 	mi := &file_api_v1_router_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOSPFNeighborsResponse) String() string {
+func (x *InterfaceTxQueue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOSPFNeighborsResponse) ProtoMessage() {}
+func (*InterfaceTxQueue) ProtoMessage() {}
 
-func (x *GetOSPFNeighborsResponse) ProtoReflect() protoreflect.Message {
+func (x *InterfaceTxQueue) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2194,46 +2237,54 @@ func (x *GetOSPFNeighborsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOSPFNeighborsResponse.ProtoReflect.Descriptor instead.
-func (*GetOSPFNeighborsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InterfaceTxQueue.ProtoReflect.Descriptor instead.
+func (*InterfaceTxQueue) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetOSPFNeighborsResponse) GetNeighbors() []*OSPFNeighborState {
+func (x *InterfaceTxQueue) GetQueueId() uint32 {
 	if x != nil {
-		return x.Neighbors
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *InterfaceTxQueue) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *InterfaceTxQueue) GetThreads() []uint32 {
+	if x != nil {
+		return x.Threads
 	}
 	return nil
 }
 
-type OSPFNeighborState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RouterId      string                 `protobuf:"bytes,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Interface     string                 `protobuf:"bytes,3,opt,name=interface,proto3" json:"interface,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	Priority      uint32                 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
-	DeadTimeSecs  uint64                 `protobuf:"varint,7,opt,name=dead_time_secs,json=deadTimeSecs,proto3" json:"dead_time_secs,omitempty"`
-	UptimeSecs    uint64                 `protobuf:"varint,8,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetRoutesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PrefixFilter   string                 `protobuf:"bytes,1,opt,name=prefix_filter,json=prefixFilter,proto3" json:"prefix_filter,omitempty"`
+	ProtocolFilter string                 `protobuf:"bytes,2,opt,name=protocol_filter,json=protocolFilter,proto3" json:"protocol_filter,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *OSPFNeighborState) Reset() {
-	*x = OSPFNeighborState{}
+func (x *GetRoutesRequest) Reset() {
+	*x = GetRoutesRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OSPFNeighborState) String() string {
+func (x *GetRoutesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OSPFNeighborState) ProtoMessage() {}
+func (*GetRoutesRequest) ProtoMessage() {}
 
-func (x *OSPFNeighborState) ProtoReflect() protoreflect.Message {
+func (x *GetRoutesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2245,89 +2296,46 @@ func (x *OSPFNeighborState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OSPFNeighborState.ProtoReflect.Descriptor instead.
-func (*OSPFNeighborState) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRoutesRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *OSPFNeighborState) GetRouterId() string {
-	if x != nil {
-		return x.RouterId
-	}
-	return ""
-}
-
-func (x *OSPFNeighborState) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *OSPFNeighborState) GetInterface() string {
-	if x != nil {
-		return x.Interface
-	}
-	return ""
-}
-
-func (x *OSPFNeighborState) GetState() string {
+func (x *GetRoutesRequest) GetPrefixFilter() string {
 	if x != nil {
-		return x.State
+		return x.PrefixFilter
 	}
 	return ""
 }
 
-func (x *OSPFNeighborState) GetRole() string {
+func (x *GetRoutesRequest) GetProtocolFilter() string {
 	if x != nil {
-		return x.Role
+		return x.ProtocolFilter
 	}
 	return ""
 }
 
-func (x *OSPFNeighborState) GetPriority() uint32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-func (x *OSPFNeighborState) GetDeadTimeSecs() uint64 {
-	if x != nil {
-		return x.DeadTimeSecs
-	}
-	return 0
-}
-
-func (x *OSPFNeighborState) GetUptimeSecs() uint64 {
-	if x != nil {
-		return x.UptimeSecs
-	}
-	return 0
-}
-
-type GetRouteTextRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ProtocolFilter string                 `protobuf:"bytes,1,opt,name=protocol_filter,json=protocolFilter,proto3" json:"protocol_filter,omitempty"`
-	AddressFamily  string                 `protobuf:"bytes,2,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetRoutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routes        []*RouteEntry          `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRouteTextRequest) Reset() {
-	*x = GetRouteTextRequest{}
+func (x *GetRoutesResponse) Reset() {
+	*x = GetRoutesResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRouteTextRequest) String() string {
+func (x *GetRoutesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRouteTextRequest) ProtoMessage() {}
+func (*GetRoutesResponse) ProtoMessage() {}
 
-func (x *GetRouteTextRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRoutesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2339,46 +2347,44 @@ func (x *GetRouteTextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRouteTextRequest.ProtoReflect.Descriptor instead.
-func (*GetRouteTextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRoutesResponse.ProtoReflect.Descriptor instead.
+func (*GetRoutesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetRouteTextRequest) GetProtocolFilter() string {
-	if x != nil {
-		return x.ProtocolFilter
-	}
-	return ""
-}
-
-func (x *GetRouteTextRequest) GetAddressFamily() string {
+func (x *GetRoutesResponse) GetRoutes() []*RouteEntry {
 	if x != nil {
-		return x.AddressFamily
+		return x.Routes
 	}
-	return ""
+	return nil
 }
 
-type GetRouteTextResponse struct {
+type RouteEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	NextHop       string                 `protobuf:"bytes,2,opt,name=next_hop,json=nextHop,proto3" json:"next_hop,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Metric        uint32                 `protobuf:"varint,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Interface     string                 `protobuf:"bytes,5,opt,name=interface,proto3" json:"interface,omitempty"`
+	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRouteTextResponse) Reset() {
-	*x = GetRouteTextResponse{}
+func (x *RouteEntry) Reset() {
+	*x = RouteEntry{}
 	mi := &file_api_v1_router_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRouteTextResponse) String() string {
+func (x *RouteEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRouteTextResponse) ProtoMessage() {}
+func (*RouteEntry) ProtoMessage() {}
 
-func (x *GetRouteTextResponse) ProtoReflect() protoreflect.Message {
+func (x *RouteEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2390,38 +2396,73 @@ func (x *GetRouteTextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRouteTextResponse.ProtoReflect.Descriptor instead.
-func (*GetRouteTextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteEntry.ProtoReflect.Descriptor instead.
+func (*RouteEntry) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetRouteTextResponse) GetOutput() string {
+func (x *RouteEntry) GetPrefix() string {
 	if x != nil {
-		return x.Output
+		return x.Prefix
 	}
 	return ""
 }
 
-type GetBGPSummaryTextRequest struct {
+func (x *RouteEntry) GetNextHop() string {
+	if x != nil {
+		return x.NextHop
+	}
+	return ""
+}
+
+func (x *RouteEntry) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *RouteEntry) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+func (x *RouteEntry) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *RouteEntry) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type GetRouteSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBGPSummaryTextRequest) Reset() {
-	*x = GetBGPSummaryTextRequest{}
+func (x *GetRouteSummaryRequest) Reset() {
+	*x = GetRouteSummaryRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBGPSummaryTextRequest) String() string {
+func (x *GetRouteSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBGPSummaryTextRequest) ProtoMessage() {}
+func (*GetRouteSummaryRequest) ProtoMessage() {}
 
-func (x *GetBGPSummaryTextRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRouteSummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2433,32 +2474,32 @@ func (x *GetBGPSummaryTextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBGPSummaryTextRequest.ProtoReflect.Descriptor instead.
-func (*GetBGPSummaryTextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRouteSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetRouteSummaryRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{45}
 }
 
-type GetBGPSummaryTextResponse struct {
+type GetRouteSummaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Families      []*RouteFamilySummary  `protobuf:"bytes,1,rep,name=families,proto3" json:"families,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBGPSummaryTextResponse) Reset() {
-	*x = GetBGPSummaryTextResponse{}
+func (x *GetRouteSummaryResponse) Reset() {
+	*x = GetRouteSummaryResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBGPSummaryTextResponse) String() string {
+func (x *GetRouteSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBGPSummaryTextResponse) ProtoMessage() {}
+func (*GetRouteSummaryResponse) ProtoMessage() {}
 
-func (x *GetBGPSummaryTextResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRouteSummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2470,39 +2511,41 @@ func (x *GetBGPSummaryTextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBGPSummaryTextResponse.ProtoReflect.Descriptor instead.
-func (*GetBGPSummaryTextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRouteSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetRouteSummaryResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetBGPSummaryTextResponse) GetOutput() string {
+func (x *GetRouteSummaryResponse) GetFamilies() []*RouteFamilySummary {
 	if x != nil {
-		return x.Output
+		return x.Families
 	}
-	return ""
+	return nil
 }
 
-type GetBGPNeighborTextRequest struct {
+type RouteFamilySummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PeerAddress   string                 `protobuf:"bytes,1,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	Family        string                 `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"` // "inet" or "inet6"
+	Protocols     []*RouteProtocolCount  `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	Total         uint32                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBGPNeighborTextRequest) Reset() {
-	*x = GetBGPNeighborTextRequest{}
+func (x *RouteFamilySummary) Reset() {
+	*x = RouteFamilySummary{}
 	mi := &file_api_v1_router_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBGPNeighborTextRequest) String() string {
+func (x *RouteFamilySummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBGPNeighborTextRequest) ProtoMessage() {}
+func (*RouteFamilySummary) ProtoMessage() {}
 
-func (x *GetBGPNeighborTextRequest) ProtoReflect() protoreflect.Message {
+func (x *RouteFamilySummary) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2514,39 +2557,55 @@ func (x *GetBGPNeighborTextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBGPNeighborTextRequest.ProtoReflect.Descriptor instead.
-func (*GetBGPNeighborTextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteFamilySummary.ProtoReflect.Descriptor instead.
+func (*RouteFamilySummary) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetBGPNeighborTextRequest) GetPeerAddress() string {
+func (x *RouteFamilySummary) GetFamily() string {
 	if x != nil {
-		return x.PeerAddress
+		return x.Family
 	}
 	return ""
 }
 
-type GetBGPNeighborTextResponse struct {
+func (x *RouteFamilySummary) GetProtocols() []*RouteProtocolCount {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *RouteFamilySummary) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RouteProtocolCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Protocol      string                 `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Routes        uint32                 `protobuf:"varint,2,opt,name=routes,proto3" json:"routes,omitempty"`
+	FibRoutes     uint32                 `protobuf:"varint,3,opt,name=fib_routes,json=fibRoutes,proto3" json:"fib_routes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBGPNeighborTextResponse) Reset() {
-	*x = GetBGPNeighborTextResponse{}
+func (x *RouteProtocolCount) Reset() {
+	*x = RouteProtocolCount{}
 	mi := &file_api_v1_router_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBGPNeighborTextResponse) String() string {
+func (x *RouteProtocolCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBGPNeighborTextResponse) ProtoMessage() {}
+func (*RouteProtocolCount) ProtoMessage() {}
 
-func (x *GetBGPNeighborTextResponse) ProtoReflect() protoreflect.Message {
+func (x *RouteProtocolCount) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2558,39 +2617,52 @@ func (x *GetBGPNeighborTextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBGPNeighborTextResponse.ProtoReflect.Descriptor instead.
-func (*GetBGPNeighborTextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteProtocolCount.ProtoReflect.Descriptor instead.
+func (*RouteProtocolCount) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetBGPNeighborTextResponse) GetOutput() string {
+func (x *RouteProtocolCount) GetProtocol() string {
 	if x != nil {
-		return x.Output
+		return x.Protocol
 	}
 	return ""
 }
 
-type GetOSPFNeighborsTextRequest struct {
+func (x *RouteProtocolCount) GetRoutes() uint32 {
+	if x != nil {
+		return x.Routes
+	}
+	return 0
+}
+
+func (x *RouteProtocolCount) GetFibRoutes() uint32 {
+	if x != nil {
+		return x.FibRoutes
+	}
+	return 0
+}
+
+type GetBGPNeighborsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressFamily string                 `protobuf:"bytes,1,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOSPFNeighborsTextRequest) Reset() {
-	*x = GetOSPFNeighborsTextRequest{}
+func (x *GetBGPNeighborsRequest) Reset() {
+	*x = GetBGPNeighborsRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOSPFNeighborsTextRequest) String() string {
+func (x *GetBGPNeighborsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOSPFNeighborsTextRequest) ProtoMessage() {}
+func (*GetBGPNeighborsRequest) ProtoMessage() {}
 
-func (x *GetOSPFNeighborsTextRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBGPNeighborsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2602,39 +2674,32 @@ func (x *GetOSPFNeighborsTextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOSPFNeighborsTextRequest.ProtoReflect.Descriptor instead.
-func (*GetOSPFNeighborsTextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBGPNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*GetBGPNeighborsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetOSPFNeighborsTextRequest) GetAddressFamily() string {
-	if x != nil {
-		return x.AddressFamily
-	}
-	return ""
-}
-
-type GetOSPFNeighborsTextResponse struct {
+type GetBGPNeighborsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Neighbors     []*BGPNeighborState    `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOSPFNeighborsTextResponse) Reset() {
-	*x = GetOSPFNeighborsTextResponse{}
+func (x *GetBGPNeighborsResponse) Reset() {
+	*x = GetBGPNeighborsResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOSPFNeighborsTextResponse) String() string {
+func (x *GetBGPNeighborsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOSPFNeighborsTextResponse) ProtoMessage() {}
+func (*GetBGPNeighborsResponse) ProtoMessage() {}
 
-func (x *GetOSPFNeighborsTextResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBGPNeighborsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2646,38 +2711,44 @@ func (x *GetOSPFNeighborsTextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOSPFNeighborsTextResponse.ProtoReflect.Descriptor instead.
-func (*GetOSPFNeighborsTextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBGPNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*GetBGPNeighborsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetOSPFNeighborsTextResponse) GetOutput() string {
+func (x *GetBGPNeighborsResponse) GetNeighbors() []*BGPNeighborState {
 	if x != nil {
-		return x.Output
+		return x.Neighbors
 	}
-	return ""
+	return nil
 }
 
-type GetVRRPTextRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BGPNeighborState struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PeerAddress    string                 `protobuf:"bytes,1,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	PeerAs         uint32                 `protobuf:"varint,2,opt,name=peer_as,json=peerAs,proto3" json:"peer_as,omitempty"`
+	State          string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	UptimeSecs     uint64                 `protobuf:"varint,4,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
+	PrefixReceived uint32                 `protobuf:"varint,5,opt,name=prefix_received,json=prefixReceived,proto3" json:"prefix_received,omitempty"`
+	PrefixSent     uint32                 `protobuf:"varint,6,opt,name=prefix_sent,json=prefixSent,proto3" json:"prefix_sent,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetVRRPTextRequest) Reset() {
-	*x = GetVRRPTextRequest{}
+func (x *BGPNeighborState) Reset() {
+	*x = BGPNeighborState{}
 	mi := &file_api_v1_router_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVRRPTextRequest) String() string {
+func (x *BGPNeighborState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVRRPTextRequest) ProtoMessage() {}
+func (*BGPNeighborState) ProtoMessage() {}
 
-func (x *GetVRRPTextRequest) ProtoReflect() protoreflect.Message {
+func (x *BGPNeighborState) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2689,32 +2760,74 @@ func (x *GetVRRPTextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVRRPTextRequest.ProtoReflect.Descriptor instead.
-func (*GetVRRPTextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BGPNeighborState.ProtoReflect.Descriptor instead.
+func (*BGPNeighborState) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{51}
 }
 
-type GetVRRPTextResponse struct {
+func (x *BGPNeighborState) GetPeerAddress() string {
+	if x != nil {
+		return x.PeerAddress
+	}
+	return ""
+}
+
+func (x *BGPNeighborState) GetPeerAs() uint32 {
+	if x != nil {
+		return x.PeerAs
+	}
+	return 0
+}
+
+func (x *BGPNeighborState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BGPNeighborState) GetUptimeSecs() uint64 {
+	if x != nil {
+		return x.UptimeSecs
+	}
+	return 0
+}
+
+func (x *BGPNeighborState) GetPrefixReceived() uint32 {
+	if x != nil {
+		return x.PrefixReceived
+	}
+	return 0
+}
+
+func (x *BGPNeighborState) GetPrefixSent() uint32 {
+	if x != nil {
+		return x.PrefixSent
+	}
+	return 0
+}
+
+type GetOSPFNeighborsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	AddressFamily string                 `protobuf:"bytes,1,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVRRPTextResponse) Reset() {
-	*x = GetVRRPTextResponse{}
+func (x *GetOSPFNeighborsRequest) Reset() {
+	*x = GetOSPFNeighborsRequest{}
 	mi := &file_api_v1_router_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVRRPTextResponse) String() string {
+func (x *GetOSPFNeighborsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVRRPTextResponse) ProtoMessage() {}
+func (*GetOSPFNeighborsRequest) ProtoMessage() {}
 
-func (x *GetVRRPTextResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOSPFNeighborsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2726,41 +2839,39 @@ func (x *GetVRRPTextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVRRPTextResponse.ProtoReflect.Descriptor instead.
-func (*GetVRRPTextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOSPFNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*GetOSPFNeighborsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetVRRPTextResponse) GetOutput() string {
+func (x *GetOSPFNeighborsRequest) GetAddressFamily() string {
 	if x != nil {
-		return x.Output
+		return x.AddressFamily
 	}
 	return ""
 }
 
-type GetBFDTextRequest struct {
+type GetOSPFNeighborsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PeerAddress   string                 `protobuf:"bytes,1,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
-	Brief         bool                   `protobuf:"varint,2,opt,name=brief,proto3" json:"brief,omitempty"`
-	Counters      bool                   `protobuf:"varint,3,opt,name=counters,proto3" json:"counters,omitempty"`
+	Neighbors     []*OSPFNeighborState   `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBFDTextRequest) Reset() {
-	*x = GetBFDTextRequest{}
+func (x *GetOSPFNeighborsResponse) Reset() {
+	*x = GetOSPFNeighborsResponse{}
 	mi := &file_api_v1_router_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBFDTextRequest) String() string {
+func (x *GetOSPFNeighborsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBFDTextRequest) ProtoMessage() {}
+func (*GetOSPFNeighborsResponse) ProtoMessage() {}
 
-func (x *GetBFDTextRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOSPFNeighborsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2772,53 +2883,46 @@ func (x *GetBFDTextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBFDTextRequest.ProtoReflect.Descriptor instead.
-func (*GetBFDTextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOSPFNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*GetOSPFNeighborsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetBFDTextRequest) GetPeerAddress() string {
-	if x != nil {
-		return x.PeerAddress
-	}
-	return ""
-}
-
-func (x *GetBFDTextRequest) GetBrief() bool {
-	if x != nil {
-		return x.Brief
-	}
-	return false
-}
-
-func (x *GetBFDTextRequest) GetCounters() bool {
+func (x *GetOSPFNeighborsResponse) GetNeighbors() []*OSPFNeighborState {
 	if x != nil {
-		return x.Counters
+		return x.Neighbors
 	}
-	return false
+	return nil
 }
 
-type GetBFDTextResponse struct {
+type OSPFNeighborState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	RouterId      string                 `protobuf:"bytes,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Interface     string                 `protobuf:"bytes,3,opt,name=interface,proto3" json:"interface,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Priority      uint32                 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	DeadTimeSecs  uint64                 `protobuf:"varint,7,opt,name=dead_time_secs,json=deadTimeSecs,proto3" json:"dead_time_secs,omitempty"`
+	UptimeSecs    uint64                 `protobuf:"varint,8,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBFDTextResponse) Reset() {
-	*x = GetBFDTextResponse{}
+func (x *OSPFNeighborState) Reset() {
+	*x = OSPFNeighborState{}
 	mi := &file_api_v1_router_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBFDTextResponse) String() string {
+func (x *OSPFNeighborState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBFDTextResponse) ProtoMessage() {}
+func (*OSPFNeighborState) ProtoMessage() {}
 
-func (x *GetBFDTextResponse) ProtoReflect() protoreflect.Message {
+func (x *OSPFNeighborState) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,85 +2934,90 @@ func (x *GetBFDTextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBFDTextResponse.ProtoReflect.Descriptor instead.
-func (*GetBFDTextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OSPFNeighborState.ProtoReflect.Descriptor instead.
+func (*OSPFNeighborState) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetBFDTextResponse) GetOutput() string {
+func (x *OSPFNeighborState) GetRouterId() string {
 	if x != nil {
-		return x.Output
+		return x.RouterId
 	}
 	return ""
 }
 
-type GetBFDStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OSPFNeighborState) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x *GetBFDStatusRequest) Reset() {
-	*x = GetBFDStatusRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[55]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OSPFNeighborState) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
 }
 
-func (x *GetBFDStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OSPFNeighborState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (*GetBFDStatusRequest) ProtoMessage() {}
-
-func (x *GetBFDStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[55]
+func (x *OSPFNeighborState) GetRole() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Role
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetBFDStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetBFDStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{55}
+func (x *OSPFNeighborState) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-type GetBFDStatusResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	LastRun           string                 `protobuf:"bytes,1,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
-	ConfiguredPeers   uint32                 `protobuf:"varint,2,opt,name=configured_peers,json=configuredPeers,proto3" json:"configured_peers,omitempty"`
-	ObservedPeers     uint32                 `protobuf:"varint,3,opt,name=observed_peers,json=observedPeers,proto3" json:"observed_peers,omitempty"`
-	UpPeers           uint32                 `protobuf:"varint,4,opt,name=up_peers,json=upPeers,proto3" json:"up_peers,omitempty"`
-	DownPeers         uint32                 `protobuf:"varint,5,opt,name=down_peers,json=downPeers,proto3" json:"down_peers,omitempty"`
-	SessionDownEvents uint64                 `protobuf:"varint,6,opt,name=session_down_events,json=sessionDownEvents,proto3" json:"session_down_events,omitempty"`
-	RxFailPackets     uint64                 `protobuf:"varint,7,opt,name=rx_fail_packets,json=rxFailPackets,proto3" json:"rx_fail_packets,omitempty"`
-	Peers             []*BFDPeerState        `protobuf:"bytes,8,rep,name=peers,proto3" json:"peers,omitempty"`
-	Issues            []string               `protobuf:"bytes,9,rep,name=issues,proto3" json:"issues,omitempty"`
-	LastError         string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *OSPFNeighborState) GetDeadTimeSecs() uint64 {
+	if x != nil {
+		return x.DeadTimeSecs
+	}
+	return 0
 }
 
-func (x *GetBFDStatusResponse) Reset() {
-	*x = GetBFDStatusResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[56]
+func (x *OSPFNeighborState) GetUptimeSecs() uint64 {
+	if x != nil {
+		return x.UptimeSecs
+	}
+	return 0
+}
+
+type GetNeighborsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressFamily string                 `protobuf:"bytes,1,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"` // "inet" (default) or "inet6"
+	NameFilter    string                 `protobuf:"bytes,2,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"`          // optional: filter by interface name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNeighborsRequest) Reset() {
+	*x = GetNeighborsRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBFDStatusResponse) String() string {
+func (x *GetNeighborsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBFDStatusResponse) ProtoMessage() {}
+func (*GetNeighborsRequest) ProtoMessage() {}
 
-func (x *GetBFDStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[56]
+func (x *GetNeighborsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2919,112 +3028,94 @@ func (x *GetBFDStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBFDStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetBFDStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*GetNeighborsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetBFDStatusResponse) GetLastRun() string {
+func (x *GetNeighborsRequest) GetAddressFamily() string {
 	if x != nil {
-		return x.LastRun
+		return x.AddressFamily
 	}
 	return ""
 }
 
-func (x *GetBFDStatusResponse) GetConfiguredPeers() uint32 {
+func (x *GetNeighborsRequest) GetNameFilter() string {
 	if x != nil {
-		return x.ConfiguredPeers
+		return x.NameFilter
 	}
-	return 0
+	return ""
 }
 
-func (x *GetBFDStatusResponse) GetObservedPeers() uint32 {
-	if x != nil {
-		return x.ObservedPeers
-	}
-	return 0
+type GetNeighborsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Neighbors     []*NeighborState       `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBFDStatusResponse) GetUpPeers() uint32 {
-	if x != nil {
-		return x.UpPeers
-	}
-	return 0
+func (x *GetNeighborsResponse) Reset() {
+	*x = GetNeighborsResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBFDStatusResponse) GetDownPeers() uint32 {
-	if x != nil {
-		return x.DownPeers
-	}
-	return 0
+func (x *GetNeighborsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetBFDStatusResponse) GetSessionDownEvents() uint64 {
-	if x != nil {
-		return x.SessionDownEvents
-	}
-	return 0
-}
+func (*GetNeighborsResponse) ProtoMessage() {}
 
-func (x *GetBFDStatusResponse) GetRxFailPackets() uint64 {
+func (x *GetNeighborsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[56]
 	if x != nil {
-		return x.RxFailPackets
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetBFDStatusResponse) GetPeers() []*BFDPeerState {
-	if x != nil {
-		return x.Peers
-	}
-	return nil
+// Deprecated: Use GetNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*GetNeighborsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetBFDStatusResponse) GetIssues() []string {
+func (x *GetNeighborsResponse) GetNeighbors() []*NeighborState {
 	if x != nil {
-		return x.Issues
+		return x.Neighbors
 	}
 	return nil
 }
 
-func (x *GetBFDStatusResponse) GetLastError() string {
-	if x != nil {
-		return x.LastError
-	}
-	return ""
-}
-
-type BFDPeerState struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Peer              string                 `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	LocalAddress      string                 `protobuf:"bytes,2,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
-	Interface         string                 `protobuf:"bytes,3,opt,name=interface,proto3" json:"interface,omitempty"`
-	Vrf               string                 `protobuf:"bytes,4,opt,name=vrf,proto3" json:"vrf,omitempty"`
-	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Diagnostic        string                 `protobuf:"bytes,6,opt,name=diagnostic,proto3" json:"diagnostic,omitempty"`
-	RemoteDiagnostic  string                 `protobuf:"bytes,7,opt,name=remote_diagnostic,json=remoteDiagnostic,proto3" json:"remote_diagnostic,omitempty"`
-	Observed          bool                   `protobuf:"varint,8,opt,name=observed,proto3" json:"observed,omitempty"`
-	Up                bool                   `protobuf:"varint,9,opt,name=up,proto3" json:"up,omitempty"`
-	SessionDownEvents uint64                 `protobuf:"varint,10,opt,name=session_down_events,json=sessionDownEvents,proto3" json:"session_down_events,omitempty"`
-	RxFailPackets     uint64                 `protobuf:"varint,11,opt,name=rx_fail_packets,json=rxFailPackets,proto3" json:"rx_fail_packets,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type NeighborState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Mac           string                 `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
+	Static        bool                   `protobuf:"varint,4,opt,name=static,proto3" json:"static,omitempty"`
+	AgeSecs       uint64                 `protobuf:"varint,5,opt,name=age_secs,json=ageSecs,proto3" json:"age_secs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BFDPeerState) Reset() {
-	*x = BFDPeerState{}
+func (x *NeighborState) Reset() {
+	*x = NeighborState{}
 	mi := &file_api_v1_router_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BFDPeerState) String() string {
+func (x *NeighborState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BFDPeerState) ProtoMessage() {}
+func (*NeighborState) ProtoMessage() {}
 
-func (x *BFDPeerState) ProtoReflect() protoreflect.Message {
+func (x *NeighborState) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_router_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3036,109 +3127,111 @@ func (x *BFDPeerState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BFDPeerState.ProtoReflect.Descriptor instead.
-func (*BFDPeerState) Descriptor() ([]byte, []int) {
+// Deprecated: Use NeighborState.ProtoReflect.Descriptor instead.
+func (*NeighborState) Descriptor() ([]byte, []int) {
 	return file_api_v1_router_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *BFDPeerState) GetPeer() string {
+func (x *NeighborState) GetInterface() string {
 	if x != nil {
-		return x.Peer
+		return x.Interface
 	}
 	return ""
 }
 
-func (x *BFDPeerState) GetLocalAddress() string {
+func (x *NeighborState) GetAddress() string {
 	if x != nil {
-		return x.LocalAddress
+		return x.Address
 	}
 	return ""
 }
 
-func (x *BFDPeerState) GetInterface() string {
+func (x *NeighborState) GetMac() string {
 	if x != nil {
-		return x.Interface
+		return x.Mac
 	}
 	return ""
 }
 
-func (x *BFDPeerState) GetVrf() string {
+func (x *NeighborState) GetStatic() bool {
 	if x != nil {
-		return x.Vrf
+		return x.Static
 	}
-	return ""
+	return false
 }
 
-func (x *BFDPeerState) GetStatus() string {
+func (x *NeighborState) GetAgeSecs() uint64 {
 	if x != nil {
-		return x.Status
+		return x.AgeSecs
 	}
-	return ""
+	return 0
 }
 
-func (x *BFDPeerState) GetDiagnostic() string {
-	if x != nil {
-		return x.Diagnostic
-	}
-	return ""
+type ClearInterfaceStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // optional: interface to clear; empty clears all interfaces
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BFDPeerState) GetRemoteDiagnostic() string {
-	if x != nil {
-		return x.RemoteDiagnostic
-	}
-	return ""
+func (x *ClearInterfaceStatisticsRequest) Reset() {
+	*x = ClearInterfaceStatisticsRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BFDPeerState) GetObserved() bool {
-	if x != nil {
-		return x.Observed
-	}
-	return false
+func (x *ClearInterfaceStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BFDPeerState) GetUp() bool {
+func (*ClearInterfaceStatisticsRequest) ProtoMessage() {}
+
+func (x *ClearInterfaceStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[58]
 	if x != nil {
-		return x.Up
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *BFDPeerState) GetSessionDownEvents() uint64 {
-	if x != nil {
-		return x.SessionDownEvents
-	}
-	return 0
+// Deprecated: Use ClearInterfaceStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*ClearInterfaceStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *BFDPeerState) GetRxFailPackets() uint64 {
+func (x *ClearInterfaceStatisticsRequest) GetName() string {
 	if x != nil {
-		return x.RxFailPackets
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type GetLCPReconciliationRequest struct {
+type ClearInterfaceStatisticsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLCPReconciliationRequest) Reset() {
-	*x = GetLCPReconciliationRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[58]
+func (x *ClearInterfaceStatisticsResponse) Reset() {
+	*x = ClearInterfaceStatisticsResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLCPReconciliationRequest) String() string {
+func (x *ClearInterfaceStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLCPReconciliationRequest) ProtoMessage() {}
+func (*ClearInterfaceStatisticsResponse) ProtoMessage() {}
 
-func (x *GetLCPReconciliationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[58]
+func (x *ClearInterfaceStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3149,36 +3242,34 @@ func (x *GetLCPReconciliationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLCPReconciliationRequest.ProtoReflect.Descriptor instead.
-func (*GetLCPReconciliationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ClearInterfaceStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*ClearInterfaceStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{59}
 }
 
-type GetLCPReconciliationResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	LastRun         string                 `protobuf:"bytes,1,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
-	PairCount       uint32                 `protobuf:"varint,2,opt,name=pair_count,json=pairCount,proto3" json:"pair_count,omitempty"`
-	Inconsistencies []string               `protobuf:"bytes,3,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
-	LastError       string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetRouteTextRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProtocolFilter string                 `protobuf:"bytes,1,opt,name=protocol_filter,json=protocolFilter,proto3" json:"protocol_filter,omitempty"`
+	AddressFamily  string                 `protobuf:"bytes,2,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetLCPReconciliationResponse) Reset() {
-	*x = GetLCPReconciliationResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[59]
+func (x *GetRouteTextRequest) Reset() {
+	*x = GetRouteTextRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLCPReconciliationResponse) String() string {
+func (x *GetRouteTextRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLCPReconciliationResponse) ProtoMessage() {}
+func (*GetRouteTextRequest) ProtoMessage() {}
 
-func (x *GetLCPReconciliationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[59]
+func (x *GetRouteTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3189,60 +3280,1670 @@ func (x *GetLCPReconciliationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLCPReconciliationResponse.ProtoReflect.Descriptor instead.
-func (*GetLCPReconciliationResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use GetRouteTextRequest.ProtoReflect.Descriptor instead.
+func (*GetRouteTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetRouteTextRequest) GetProtocolFilter() string {
+	if x != nil {
+		return x.ProtocolFilter
+	}
+	return ""
+}
+
+func (x *GetRouteTextRequest) GetAddressFamily() string {
+	if x != nil {
+		return x.AddressFamily
+	}
+	return ""
+}
+
+type GetRouteTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRouteTextResponse) Reset() {
+	*x = GetRouteTextResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRouteTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteTextResponse) ProtoMessage() {}
+
+func (x *GetRouteTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteTextResponse.ProtoReflect.Descriptor instead.
+func (*GetRouteTextResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetRouteTextResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetBGPSummaryTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBGPSummaryTextRequest) Reset() {
+	*x = GetBGPSummaryTextRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBGPSummaryTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBGPSummaryTextRequest) ProtoMessage() {}
+
+func (x *GetBGPSummaryTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBGPSummaryTextRequest.ProtoReflect.Descriptor instead.
+func (*GetBGPSummaryTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{62}
+}
+
+type GetBGPSummaryTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBGPSummaryTextResponse) Reset() {
+	*x = GetBGPSummaryTextResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBGPSummaryTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBGPSummaryTextResponse) ProtoMessage() {}
+
+func (x *GetBGPSummaryTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBGPSummaryTextResponse.ProtoReflect.Descriptor instead.
+func (*GetBGPSummaryTextResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetBGPSummaryTextResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetBGPNeighborTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeerAddress   string                 `protobuf:"bytes,1,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBGPNeighborTextRequest) Reset() {
+	*x = GetBGPNeighborTextRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBGPNeighborTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBGPNeighborTextRequest) ProtoMessage() {}
+
+func (x *GetBGPNeighborTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBGPNeighborTextRequest.ProtoReflect.Descriptor instead.
+func (*GetBGPNeighborTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetBGPNeighborTextRequest) GetPeerAddress() string {
+	if x != nil {
+		return x.PeerAddress
+	}
+	return ""
+}
+
+type GetBGPNeighborTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBGPNeighborTextResponse) Reset() {
+	*x = GetBGPNeighborTextResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBGPNeighborTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBGPNeighborTextResponse) ProtoMessage() {}
+
+func (x *GetBGPNeighborTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBGPNeighborTextResponse.ProtoReflect.Descriptor instead.
+func (*GetBGPNeighborTextResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBGPNeighborTextResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetOSPFNeighborsTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressFamily string                 `protobuf:"bytes,1,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOSPFNeighborsTextRequest) Reset() {
+	*x = GetOSPFNeighborsTextRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOSPFNeighborsTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOSPFNeighborsTextRequest) ProtoMessage() {}
+
+func (x *GetOSPFNeighborsTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOSPFNeighborsTextRequest.ProtoReflect.Descriptor instead.
+func (*GetOSPFNeighborsTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetOSPFNeighborsTextRequest) GetAddressFamily() string {
+	if x != nil {
+		return x.AddressFamily
+	}
+	return ""
+}
+
+type GetOSPFNeighborsTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOSPFNeighborsTextResponse) Reset() {
+	*x = GetOSPFNeighborsTextResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOSPFNeighborsTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOSPFNeighborsTextResponse) ProtoMessage() {}
+
+func (x *GetOSPFNeighborsTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOSPFNeighborsTextResponse.ProtoReflect.Descriptor instead.
+func (*GetOSPFNeighborsTextResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetOSPFNeighborsTextResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetVRRPTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVRRPTextRequest) Reset() {
+	*x = GetVRRPTextRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVRRPTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVRRPTextRequest) ProtoMessage() {}
+
+func (x *GetVRRPTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVRRPTextRequest.ProtoReflect.Descriptor instead.
+func (*GetVRRPTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{68}
+}
+
+type GetVRRPTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVRRPTextResponse) Reset() {
+	*x = GetVRRPTextResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVRRPTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVRRPTextResponse) ProtoMessage() {}
+
+func (x *GetVRRPTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVRRPTextResponse.ProtoReflect.Descriptor instead.
+func (*GetVRRPTextResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetVRRPTextResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetBFDTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeerAddress   string                 `protobuf:"bytes,1,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	Brief         bool                   `protobuf:"varint,2,opt,name=brief,proto3" json:"brief,omitempty"`
+	Counters      bool                   `protobuf:"varint,3,opt,name=counters,proto3" json:"counters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBFDTextRequest) Reset() {
+	*x = GetBFDTextRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBFDTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBFDTextRequest) ProtoMessage() {}
+
+func (x *GetBFDTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBFDTextRequest.ProtoReflect.Descriptor instead.
+func (*GetBFDTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetBFDTextRequest) GetPeerAddress() string {
+	if x != nil {
+		return x.PeerAddress
+	}
+	return ""
+}
+
+func (x *GetBFDTextRequest) GetBrief() bool {
+	if x != nil {
+		return x.Brief
+	}
+	return false
+}
+
+func (x *GetBFDTextRequest) GetCounters() bool {
+	if x != nil {
+		return x.Counters
+	}
+	return false
+}
+
+type GetBFDTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBFDTextResponse) Reset() {
+	*x = GetBFDTextResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBFDTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBFDTextResponse) ProtoMessage() {}
+
+func (x *GetBFDTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBFDTextResponse.ProtoReflect.Descriptor instead.
+func (*GetBFDTextResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetBFDTextResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetBFDStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBFDStatusRequest) Reset() {
+	*x = GetBFDStatusRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBFDStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBFDStatusRequest) ProtoMessage() {}
+
+func (x *GetBFDStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBFDStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBFDStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{72}
+}
+
+type GetBFDStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LastRun           string                 `protobuf:"bytes,1,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	ConfiguredPeers   uint32                 `protobuf:"varint,2,opt,name=configured_peers,json=configuredPeers,proto3" json:"configured_peers,omitempty"`
+	ObservedPeers     uint32                 `protobuf:"varint,3,opt,name=observed_peers,json=observedPeers,proto3" json:"observed_peers,omitempty"`
+	UpPeers           uint32                 `protobuf:"varint,4,opt,name=up_peers,json=upPeers,proto3" json:"up_peers,omitempty"`
+	DownPeers         uint32                 `protobuf:"varint,5,opt,name=down_peers,json=downPeers,proto3" json:"down_peers,omitempty"`
+	SessionDownEvents uint64                 `protobuf:"varint,6,opt,name=session_down_events,json=sessionDownEvents,proto3" json:"session_down_events,omitempty"`
+	RxFailPackets     uint64                 `protobuf:"varint,7,opt,name=rx_fail_packets,json=rxFailPackets,proto3" json:"rx_fail_packets,omitempty"`
+	Peers             []*BFDPeerState        `protobuf:"bytes,8,rep,name=peers,proto3" json:"peers,omitempty"`
+	Issues            []string               `protobuf:"bytes,9,rep,name=issues,proto3" json:"issues,omitempty"`
+	LastError         string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetBFDStatusResponse) Reset() {
+	*x = GetBFDStatusResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBFDStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBFDStatusResponse) ProtoMessage() {}
+
+func (x *GetBFDStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBFDStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBFDStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetBFDStatusResponse) GetLastRun() string {
+	if x != nil {
+		return x.LastRun
+	}
+	return ""
+}
+
+func (x *GetBFDStatusResponse) GetConfiguredPeers() uint32 {
+	if x != nil {
+		return x.ConfiguredPeers
+	}
+	return 0
+}
+
+func (x *GetBFDStatusResponse) GetObservedPeers() uint32 {
+	if x != nil {
+		return x.ObservedPeers
+	}
+	return 0
+}
+
+func (x *GetBFDStatusResponse) GetUpPeers() uint32 {
+	if x != nil {
+		return x.UpPeers
+	}
+	return 0
+}
+
+func (x *GetBFDStatusResponse) GetDownPeers() uint32 {
+	if x != nil {
+		return x.DownPeers
+	}
+	return 0
+}
+
+func (x *GetBFDStatusResponse) GetSessionDownEvents() uint64 {
+	if x != nil {
+		return x.SessionDownEvents
+	}
+	return 0
+}
+
+func (x *GetBFDStatusResponse) GetRxFailPackets() uint64 {
+	if x != nil {
+		return x.RxFailPackets
+	}
+	return 0
+}
+
+func (x *GetBFDStatusResponse) GetPeers() []*BFDPeerState {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *GetBFDStatusResponse) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *GetBFDStatusResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type BFDPeerState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Peer              string                 `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	LocalAddress      string                 `protobuf:"bytes,2,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	Interface         string                 `protobuf:"bytes,3,opt,name=interface,proto3" json:"interface,omitempty"`
+	Vrf               string                 `protobuf:"bytes,4,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Diagnostic        string                 `protobuf:"bytes,6,opt,name=diagnostic,proto3" json:"diagnostic,omitempty"`
+	RemoteDiagnostic  string                 `protobuf:"bytes,7,opt,name=remote_diagnostic,json=remoteDiagnostic,proto3" json:"remote_diagnostic,omitempty"`
+	Observed          bool                   `protobuf:"varint,8,opt,name=observed,proto3" json:"observed,omitempty"`
+	Up                bool                   `protobuf:"varint,9,opt,name=up,proto3" json:"up,omitempty"`
+	SessionDownEvents uint64                 `protobuf:"varint,10,opt,name=session_down_events,json=sessionDownEvents,proto3" json:"session_down_events,omitempty"`
+	RxFailPackets     uint64                 `protobuf:"varint,11,opt,name=rx_fail_packets,json=rxFailPackets,proto3" json:"rx_fail_packets,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BFDPeerState) Reset() {
+	*x = BFDPeerState{}
+	mi := &file_api_v1_router_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFDPeerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFDPeerState) ProtoMessage() {}
+
+func (x *BFDPeerState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFDPeerState.ProtoReflect.Descriptor instead.
+func (*BFDPeerState) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BFDPeerState) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *BFDPeerState) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *BFDPeerState) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *BFDPeerState) GetVrf() string {
+	if x != nil {
+		return x.Vrf
+	}
+	return ""
+}
+
+func (x *BFDPeerState) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BFDPeerState) GetDiagnostic() string {
+	if x != nil {
+		return x.Diagnostic
+	}
+	return ""
+}
+
+func (x *BFDPeerState) GetRemoteDiagnostic() string {
+	if x != nil {
+		return x.RemoteDiagnostic
+	}
+	return ""
+}
+
+func (x *BFDPeerState) GetObserved() bool {
+	if x != nil {
+		return x.Observed
+	}
+	return false
+}
+
+func (x *BFDPeerState) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+func (x *BFDPeerState) GetSessionDownEvents() uint64 {
+	if x != nil {
+		return x.SessionDownEvents
+	}
+	return 0
+}
+
+func (x *BFDPeerState) GetRxFailPackets() uint64 {
+	if x != nil {
+		return x.RxFailPackets
+	}
+	return 0
+}
+
+type GetLCPReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLCPReconciliationRequest) Reset() {
+	*x = GetLCPReconciliationRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLCPReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLCPReconciliationRequest) ProtoMessage() {}
+
+func (x *GetLCPReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLCPReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*GetLCPReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{75}
+}
+
+type GetLCPReconciliationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LastRun         string                 `protobuf:"bytes,1,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	PairCount       uint32                 `protobuf:"varint,2,opt,name=pair_count,json=pairCount,proto3" json:"pair_count,omitempty"`
+	Inconsistencies []string               `protobuf:"bytes,3,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
+	LastError       string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetLCPReconciliationResponse) Reset() {
+	*x = GetLCPReconciliationResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLCPReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLCPReconciliationResponse) ProtoMessage() {}
+
+func (x *GetLCPReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLCPReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*GetLCPReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetLCPReconciliationResponse) GetLastRun() string {
 	if x != nil {
-		return x.LastRun
+		return x.LastRun
+	}
+	return ""
+}
+
+func (x *GetLCPReconciliationResponse) GetPairCount() uint32 {
+	if x != nil {
+		return x.PairCount
+	}
+	return 0
+}
+
+func (x *GetLCPReconciliationResponse) GetInconsistencies() []string {
+	if x != nil {
+		return x.Inconsistencies
+	}
+	return nil
+}
+
+func (x *GetLCPReconciliationResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type GetHAStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHAStatusRequest) Reset() {
+	*x = GetHAStatusRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHAStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHAStatusRequest) ProtoMessage() {}
+
+func (x *GetHAStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHAStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetHAStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{77}
+}
+
+type GetHAStatusResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Configured              bool                   `protobuf:"varint,1,opt,name=configured,proto3" json:"configured,omitempty"`
+	Converged               bool                   `protobuf:"varint,2,opt,name=converged,proto3" json:"converged,omitempty"`
+	VrrpGroups              uint32                 `protobuf:"varint,3,opt,name=vrrp_groups,json=vrrpGroups,proto3" json:"vrrp_groups,omitempty"`
+	Issues                  []string               `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	ClusterEnabled          bool                   `protobuf:"varint,5,opt,name=cluster_enabled,json=clusterEnabled,proto3" json:"cluster_enabled,omitempty"`
+	ClusterNodes            uint32                 `protobuf:"varint,6,opt,name=cluster_nodes,json=clusterNodes,proto3" json:"cluster_nodes,omitempty"`
+	ClusterEtcdSync         bool                   `protobuf:"varint,7,opt,name=cluster_etcd_sync,json=clusterEtcdSync,proto3" json:"cluster_etcd_sync,omitempty"`
+	ClusterSyncAligned      bool                   `protobuf:"varint,8,opt,name=cluster_sync_aligned,json=clusterSyncAligned,proto3" json:"cluster_sync_aligned,omitempty"`
+	FrrVrrpConfiguredGroups uint32                 `protobuf:"varint,9,opt,name=frr_vrrp_configured_groups,json=frrVrrpConfiguredGroups,proto3" json:"frr_vrrp_configured_groups,omitempty"`
+	FrrVrrpObservedGroups   uint32                 `protobuf:"varint,10,opt,name=frr_vrrp_observed_groups,json=frrVrrpObservedGroups,proto3" json:"frr_vrrp_observed_groups,omitempty"`
+	FrrVrrpActiveGroups     uint32                 `protobuf:"varint,11,opt,name=frr_vrrp_active_groups,json=frrVrrpActiveGroups,proto3" json:"frr_vrrp_active_groups,omitempty"`
+	FrrVrrpLastCheck        string                 `protobuf:"bytes,12,opt,name=frr_vrrp_last_check,json=frrVrrpLastCheck,proto3" json:"frr_vrrp_last_check,omitempty"`
+	FrrVrrpIssues           []string               `protobuf:"bytes,13,rep,name=frr_vrrp_issues,json=frrVrrpIssues,proto3" json:"frr_vrrp_issues,omitempty"`
+	FrrVrrpLastError        string                 `protobuf:"bytes,14,opt,name=frr_vrrp_last_error,json=frrVrrpLastError,proto3" json:"frr_vrrp_last_error,omitempty"`
+	VppLcpLastCheck         string                 `protobuf:"bytes,15,opt,name=vpp_lcp_last_check,json=vppLcpLastCheck,proto3" json:"vpp_lcp_last_check,omitempty"`
+	VppLcpPairs             uint32                 `protobuf:"varint,16,opt,name=vpp_lcp_pairs,json=vppLcpPairs,proto3" json:"vpp_lcp_pairs,omitempty"`
+	VppLcpInconsistencies   []string               `protobuf:"bytes,17,rep,name=vpp_lcp_inconsistencies,json=vppLcpInconsistencies,proto3" json:"vpp_lcp_inconsistencies,omitempty"`
+	VppLcpLastError         string                 `protobuf:"bytes,18,opt,name=vpp_lcp_last_error,json=vppLcpLastError,proto3" json:"vpp_lcp_last_error,omitempty"`
+	FrrBfdLastCheck         string                 `protobuf:"bytes,19,opt,name=frr_bfd_last_check,json=frrBfdLastCheck,proto3" json:"frr_bfd_last_check,omitempty"`
+	FrrBfdConfiguredPeers   uint32                 `protobuf:"varint,20,opt,name=frr_bfd_configured_peers,json=frrBfdConfiguredPeers,proto3" json:"frr_bfd_configured_peers,omitempty"`
+	FrrBfdObservedPeers     uint32                 `protobuf:"varint,21,opt,name=frr_bfd_observed_peers,json=frrBfdObservedPeers,proto3" json:"frr_bfd_observed_peers,omitempty"`
+	FrrBfdUpPeers           uint32                 `protobuf:"varint,22,opt,name=frr_bfd_up_peers,json=frrBfdUpPeers,proto3" json:"frr_bfd_up_peers,omitempty"`
+	FrrBfdDownPeers         uint32                 `protobuf:"varint,23,opt,name=frr_bfd_down_peers,json=frrBfdDownPeers,proto3" json:"frr_bfd_down_peers,omitempty"`
+	FrrBfdIssues            []string               `protobuf:"bytes,24,rep,name=frr_bfd_issues,json=frrBfdIssues,proto3" json:"frr_bfd_issues,omitempty"`
+	FrrBfdLastError         string                 `protobuf:"bytes,25,opt,name=frr_bfd_last_error,json=frrBfdLastError,proto3" json:"frr_bfd_last_error,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetHAStatusResponse) Reset() {
+	*x = GetHAStatusResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHAStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHAStatusResponse) ProtoMessage() {}
+
+func (x *GetHAStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHAStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetHAStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetHAStatusResponse) GetConfigured() bool {
+	if x != nil {
+		return x.Configured
+	}
+	return false
+}
+
+func (x *GetHAStatusResponse) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
+func (x *GetHAStatusResponse) GetVrrpGroups() uint32 {
+	if x != nil {
+		return x.VrrpGroups
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *GetHAStatusResponse) GetClusterEnabled() bool {
+	if x != nil {
+		return x.ClusterEnabled
+	}
+	return false
+}
+
+func (x *GetHAStatusResponse) GetClusterNodes() uint32 {
+	if x != nil {
+		return x.ClusterNodes
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetClusterEtcdSync() bool {
+	if x != nil {
+		return x.ClusterEtcdSync
+	}
+	return false
+}
+
+func (x *GetHAStatusResponse) GetClusterSyncAligned() bool {
+	if x != nil {
+		return x.ClusterSyncAligned
+	}
+	return false
+}
+
+func (x *GetHAStatusResponse) GetFrrVrrpConfiguredGroups() uint32 {
+	if x != nil {
+		return x.FrrVrrpConfiguredGroups
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetFrrVrrpObservedGroups() uint32 {
+	if x != nil {
+		return x.FrrVrrpObservedGroups
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetFrrVrrpActiveGroups() uint32 {
+	if x != nil {
+		return x.FrrVrrpActiveGroups
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetFrrVrrpLastCheck() string {
+	if x != nil {
+		return x.FrrVrrpLastCheck
+	}
+	return ""
+}
+
+func (x *GetHAStatusResponse) GetFrrVrrpIssues() []string {
+	if x != nil {
+		return x.FrrVrrpIssues
+	}
+	return nil
+}
+
+func (x *GetHAStatusResponse) GetFrrVrrpLastError() string {
+	if x != nil {
+		return x.FrrVrrpLastError
+	}
+	return ""
+}
+
+func (x *GetHAStatusResponse) GetVppLcpLastCheck() string {
+	if x != nil {
+		return x.VppLcpLastCheck
+	}
+	return ""
+}
+
+func (x *GetHAStatusResponse) GetVppLcpPairs() uint32 {
+	if x != nil {
+		return x.VppLcpPairs
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetVppLcpInconsistencies() []string {
+	if x != nil {
+		return x.VppLcpInconsistencies
+	}
+	return nil
+}
+
+func (x *GetHAStatusResponse) GetVppLcpLastError() string {
+	if x != nil {
+		return x.VppLcpLastError
+	}
+	return ""
+}
+
+func (x *GetHAStatusResponse) GetFrrBfdLastCheck() string {
+	if x != nil {
+		return x.FrrBfdLastCheck
+	}
+	return ""
+}
+
+func (x *GetHAStatusResponse) GetFrrBfdConfiguredPeers() uint32 {
+	if x != nil {
+		return x.FrrBfdConfiguredPeers
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetFrrBfdObservedPeers() uint32 {
+	if x != nil {
+		return x.FrrBfdObservedPeers
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetFrrBfdUpPeers() uint32 {
+	if x != nil {
+		return x.FrrBfdUpPeers
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetFrrBfdDownPeers() uint32 {
+	if x != nil {
+		return x.FrrBfdDownPeers
+	}
+	return 0
+}
+
+func (x *GetHAStatusResponse) GetFrrBfdIssues() []string {
+	if x != nil {
+		return x.FrrBfdIssues
+	}
+	return nil
+}
+
+func (x *GetHAStatusResponse) GetFrrBfdLastError() string {
+	if x != nil {
+		return x.FrrBfdLastError
+	}
+	return ""
+}
+
+type GetRoutingInstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutingInstancesRequest) Reset() {
+	*x = GetRoutingInstancesRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutingInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutingInstancesRequest) ProtoMessage() {}
+
+func (x *GetRoutingInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutingInstancesRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutingInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{79}
+}
+
+type GetRoutingInstancesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Instances     []*RoutingInstanceState `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutingInstancesResponse) Reset() {
+	*x = GetRoutingInstancesResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutingInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutingInstancesResponse) ProtoMessage() {}
+
+func (x *GetRoutingInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutingInstancesResponse.ProtoReflect.Descriptor instead.
+func (*GetRoutingInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetRoutingInstancesResponse) GetInstances() []*RoutingInstanceState {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type RoutingInstanceState struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InstanceType       string                 `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	RouteDistinguisher string                 `protobuf:"bytes,3,opt,name=route_distinguisher,json=routeDistinguisher,proto3" json:"route_distinguisher,omitempty"`
+	Ipv4TableId        uint32                 `protobuf:"varint,4,opt,name=ipv4_table_id,json=ipv4TableId,proto3" json:"ipv4_table_id,omitempty"`
+	Ipv6TableId        uint32                 `protobuf:"varint,5,opt,name=ipv6_table_id,json=ipv6TableId,proto3" json:"ipv6_table_id,omitempty"`
+	ImportTargets      []string               `protobuf:"bytes,6,rep,name=import_targets,json=importTargets,proto3" json:"import_targets,omitempty"`
+	ExportTargets      []string               `protobuf:"bytes,7,rep,name=export_targets,json=exportTargets,proto3" json:"export_targets,omitempty"`
+	ImportPolicies     []string               `protobuf:"bytes,8,rep,name=import_policies,json=importPolicies,proto3" json:"import_policies,omitempty"`
+	ExportPolicies     []string               `protobuf:"bytes,9,rep,name=export_policies,json=exportPolicies,proto3" json:"export_policies,omitempty"`
+	Interfaces         []string               `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RoutingInstanceState) Reset() {
+	*x = RoutingInstanceState{}
+	mi := &file_api_v1_router_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutingInstanceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingInstanceState) ProtoMessage() {}
+
+func (x *RoutingInstanceState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutingInstanceState.ProtoReflect.Descriptor instead.
+func (*RoutingInstanceState) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RoutingInstanceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoutingInstanceState) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *RoutingInstanceState) GetRouteDistinguisher() string {
+	if x != nil {
+		return x.RouteDistinguisher
+	}
+	return ""
+}
+
+func (x *RoutingInstanceState) GetIpv4TableId() uint32 {
+	if x != nil {
+		return x.Ipv4TableId
+	}
+	return 0
+}
+
+func (x *RoutingInstanceState) GetIpv6TableId() uint32 {
+	if x != nil {
+		return x.Ipv6TableId
+	}
+	return 0
+}
+
+func (x *RoutingInstanceState) GetImportTargets() []string {
+	if x != nil {
+		return x.ImportTargets
+	}
+	return nil
+}
+
+func (x *RoutingInstanceState) GetExportTargets() []string {
+	if x != nil {
+		return x.ExportTargets
+	}
+	return nil
+}
+
+func (x *RoutingInstanceState) GetImportPolicies() []string {
+	if x != nil {
+		return x.ImportPolicies
+	}
+	return nil
+}
+
+func (x *RoutingInstanceState) GetExportPolicies() []string {
+	if x != nil {
+		return x.ExportPolicies
+	}
+	return nil
+}
+
+func (x *RoutingInstanceState) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type GetClassOfServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassOfServiceRequest) Reset() {
+	*x = GetClassOfServiceRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassOfServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassOfServiceRequest) ProtoMessage() {}
+
+func (x *GetClassOfServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassOfServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetClassOfServiceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{82}
+}
+
+type GetClassOfServiceResponse struct {
+	state                  protoimpl.MessageState                 `protogen:"open.v1"`
+	ForwardingClasses      []*ClassOfServiceForwardingClass       `protobuf:"bytes,1,rep,name=forwarding_classes,json=forwardingClasses,proto3" json:"forwarding_classes,omitempty"`
+	TrafficControlProfiles []*ClassOfServiceTrafficControlProfile `protobuf:"bytes,2,rep,name=traffic_control_profiles,json=trafficControlProfiles,proto3" json:"traffic_control_profiles,omitempty"`
+	Interfaces             []*ClassOfServiceInterface             `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	EnforcementStatus      string                                 `protobuf:"bytes,4,opt,name=enforcement_status,json=enforcementStatus,proto3" json:"enforcement_status,omitempty"`
+	Capabilities           *ClassOfServiceCapabilities            `protobuf:"bytes,5,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetClassOfServiceResponse) Reset() {
+	*x = GetClassOfServiceResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassOfServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassOfServiceResponse) ProtoMessage() {}
+
+func (x *GetClassOfServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassOfServiceResponse.ProtoReflect.Descriptor instead.
+func (*GetClassOfServiceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetClassOfServiceResponse) GetForwardingClasses() []*ClassOfServiceForwardingClass {
+	if x != nil {
+		return x.ForwardingClasses
+	}
+	return nil
+}
+
+func (x *GetClassOfServiceResponse) GetTrafficControlProfiles() []*ClassOfServiceTrafficControlProfile {
+	if x != nil {
+		return x.TrafficControlProfiles
+	}
+	return nil
+}
+
+func (x *GetClassOfServiceResponse) GetInterfaces() []*ClassOfServiceInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *GetClassOfServiceResponse) GetEnforcementStatus() string {
+	if x != nil {
+		return x.EnforcementStatus
+	}
+	return ""
+}
+
+func (x *GetClassOfServiceResponse) GetCapabilities() *ClassOfServiceCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type ClassOfServiceForwardingClass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Queue         uint32                 `protobuf:"varint,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassOfServiceForwardingClass) Reset() {
+	*x = ClassOfServiceForwardingClass{}
+	mi := &file_api_v1_router_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassOfServiceForwardingClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassOfServiceForwardingClass) ProtoMessage() {}
+
+func (x *ClassOfServiceForwardingClass) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassOfServiceForwardingClass.ProtoReflect.Descriptor instead.
+func (*ClassOfServiceForwardingClass) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ClassOfServiceForwardingClass) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetLCPReconciliationResponse) GetPairCount() uint32 {
+func (x *ClassOfServiceForwardingClass) GetQueue() uint32 {
 	if x != nil {
-		return x.PairCount
+		return x.Queue
 	}
 	return 0
 }
 
-func (x *GetLCPReconciliationResponse) GetInconsistencies() []string {
+type ClassOfServiceTrafficControlProfile struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ShapingRate       uint64                 `protobuf:"varint,2,opt,name=shaping_rate,json=shapingRate,proto3" json:"shaping_rate,omitempty"`
+	SchedulerMap      string                 `protobuf:"bytes,3,opt,name=scheduler_map,json=schedulerMap,proto3" json:"scheduler_map,omitempty"`
+	EnforcementStatus string                 `protobuf:"bytes,4,opt,name=enforcement_status,json=enforcementStatus,proto3" json:"enforcement_status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ClassOfServiceTrafficControlProfile) Reset() {
+	*x = ClassOfServiceTrafficControlProfile{}
+	mi := &file_api_v1_router_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassOfServiceTrafficControlProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassOfServiceTrafficControlProfile) ProtoMessage() {}
+
+func (x *ClassOfServiceTrafficControlProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[85]
 	if x != nil {
-		return x.Inconsistencies
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetLCPReconciliationResponse) GetLastError() string {
+// Deprecated: Use ClassOfServiceTrafficControlProfile.ProtoReflect.Descriptor instead.
+func (*ClassOfServiceTrafficControlProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ClassOfServiceTrafficControlProfile) GetName() string {
 	if x != nil {
-		return x.LastError
+		return x.Name
 	}
 	return ""
 }
 
-type GetHAStatusRequest struct {
+func (x *ClassOfServiceTrafficControlProfile) GetShapingRate() uint64 {
+	if x != nil {
+		return x.ShapingRate
+	}
+	return 0
+}
+
+func (x *ClassOfServiceTrafficControlProfile) GetSchedulerMap() string {
+	if x != nil {
+		return x.SchedulerMap
+	}
+	return ""
+}
+
+func (x *ClassOfServiceTrafficControlProfile) GetEnforcementStatus() string {
+	if x != nil {
+		return x.EnforcementStatus
+	}
+	return ""
+}
+
+type ClassOfServiceInterface struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Name                        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OutputTrafficControlProfile string                 `protobuf:"bytes,2,opt,name=output_traffic_control_profile,json=outputTrafficControlProfile,proto3" json:"output_traffic_control_profile,omitempty"`
+	EnforcementStatus           string                 `protobuf:"bytes,3,opt,name=enforcement_status,json=enforcementStatus,proto3" json:"enforcement_status,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ClassOfServiceInterface) Reset() {
+	*x = ClassOfServiceInterface{}
+	mi := &file_api_v1_router_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassOfServiceInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassOfServiceInterface) ProtoMessage() {}
+
+func (x *ClassOfServiceInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassOfServiceInterface.ProtoReflect.Descriptor instead.
+func (*ClassOfServiceInterface) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ClassOfServiceInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClassOfServiceInterface) GetOutputTrafficControlProfile() string {
+	if x != nil {
+		return x.OutputTrafficControlProfile
+	}
+	return ""
+}
+
+func (x *ClassOfServiceInterface) GetEnforcementStatus() string {
+	if x != nil {
+		return x.EnforcementStatus
+	}
+	return ""
+}
+
+type GetSystemInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHAStatusRequest) Reset() {
-	*x = GetHAStatusRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[60]
+func (x *GetSystemInfoRequest) Reset() {
+	*x = GetSystemInfoRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHAStatusRequest) String() string {
+func (x *GetSystemInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHAStatusRequest) ProtoMessage() {}
+func (*GetSystemInfoRequest) ProtoMessage() {}
 
-func (x *GetHAStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[60]
+func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3253,57 +4954,112 @@ func (x *GetHAStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHAStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetHAStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{87}
 }
 
-type GetHAStatusResponse struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Configured              bool                   `protobuf:"varint,1,opt,name=configured,proto3" json:"configured,omitempty"`
-	Converged               bool                   `protobuf:"varint,2,opt,name=converged,proto3" json:"converged,omitempty"`
-	VrrpGroups              uint32                 `protobuf:"varint,3,opt,name=vrrp_groups,json=vrrpGroups,proto3" json:"vrrp_groups,omitempty"`
-	Issues                  []string               `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
-	ClusterEnabled          bool                   `protobuf:"varint,5,opt,name=cluster_enabled,json=clusterEnabled,proto3" json:"cluster_enabled,omitempty"`
-	ClusterNodes            uint32                 `protobuf:"varint,6,opt,name=cluster_nodes,json=clusterNodes,proto3" json:"cluster_nodes,omitempty"`
-	ClusterEtcdSync         bool                   `protobuf:"varint,7,opt,name=cluster_etcd_sync,json=clusterEtcdSync,proto3" json:"cluster_etcd_sync,omitempty"`
-	ClusterSyncAligned      bool                   `protobuf:"varint,8,opt,name=cluster_sync_aligned,json=clusterSyncAligned,proto3" json:"cluster_sync_aligned,omitempty"`
-	FrrVrrpConfiguredGroups uint32                 `protobuf:"varint,9,opt,name=frr_vrrp_configured_groups,json=frrVrrpConfiguredGroups,proto3" json:"frr_vrrp_configured_groups,omitempty"`
-	FrrVrrpObservedGroups   uint32                 `protobuf:"varint,10,opt,name=frr_vrrp_observed_groups,json=frrVrrpObservedGroups,proto3" json:"frr_vrrp_observed_groups,omitempty"`
-	FrrVrrpActiveGroups     uint32                 `protobuf:"varint,11,opt,name=frr_vrrp_active_groups,json=frrVrrpActiveGroups,proto3" json:"frr_vrrp_active_groups,omitempty"`
-	FrrVrrpLastCheck        string                 `protobuf:"bytes,12,opt,name=frr_vrrp_last_check,json=frrVrrpLastCheck,proto3" json:"frr_vrrp_last_check,omitempty"`
-	FrrVrrpIssues           []string               `protobuf:"bytes,13,rep,name=frr_vrrp_issues,json=frrVrrpIssues,proto3" json:"frr_vrrp_issues,omitempty"`
-	FrrVrrpLastError        string                 `protobuf:"bytes,14,opt,name=frr_vrrp_last_error,json=frrVrrpLastError,proto3" json:"frr_vrrp_last_error,omitempty"`
-	VppLcpLastCheck         string                 `protobuf:"bytes,15,opt,name=vpp_lcp_last_check,json=vppLcpLastCheck,proto3" json:"vpp_lcp_last_check,omitempty"`
-	VppLcpPairs             uint32                 `protobuf:"varint,16,opt,name=vpp_lcp_pairs,json=vppLcpPairs,proto3" json:"vpp_lcp_pairs,omitempty"`
-	VppLcpInconsistencies   []string               `protobuf:"bytes,17,rep,name=vpp_lcp_inconsistencies,json=vppLcpInconsistencies,proto3" json:"vpp_lcp_inconsistencies,omitempty"`
-	VppLcpLastError         string                 `protobuf:"bytes,18,opt,name=vpp_lcp_last_error,json=vppLcpLastError,proto3" json:"vpp_lcp_last_error,omitempty"`
-	FrrBfdLastCheck         string                 `protobuf:"bytes,19,opt,name=frr_bfd_last_check,json=frrBfdLastCheck,proto3" json:"frr_bfd_last_check,omitempty"`
-	FrrBfdConfiguredPeers   uint32                 `protobuf:"varint,20,opt,name=frr_bfd_configured_peers,json=frrBfdConfiguredPeers,proto3" json:"frr_bfd_configured_peers,omitempty"`
-	FrrBfdObservedPeers     uint32                 `protobuf:"varint,21,opt,name=frr_bfd_observed_peers,json=frrBfdObservedPeers,proto3" json:"frr_bfd_observed_peers,omitempty"`
-	FrrBfdUpPeers           uint32                 `protobuf:"varint,22,opt,name=frr_bfd_up_peers,json=frrBfdUpPeers,proto3" json:"frr_bfd_up_peers,omitempty"`
-	FrrBfdDownPeers         uint32                 `protobuf:"varint,23,opt,name=frr_bfd_down_peers,json=frrBfdDownPeers,proto3" json:"frr_bfd_down_peers,omitempty"`
-	FrrBfdIssues            []string               `protobuf:"bytes,24,rep,name=frr_bfd_issues,json=frrBfdIssues,proto3" json:"frr_bfd_issues,omitempty"`
-	FrrBfdLastError         string                 `protobuf:"bytes,25,opt,name=frr_bfd_last_error,json=frrBfdLastError,proto3" json:"frr_bfd_last_error,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type GetSystemInfoResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Hostname   string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Version    string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	UptimeSecs uint64                 `protobuf:"varint,3,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
+	// Role of the calling client: the mapped certificate role for TLS clients,
+	// or "admin" for the local Unix socket.
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// Authenticated user of the calling client: the certificate identity for
+	// TLS clients, or the peer credential user for the local Unix socket.
+	User          string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoResponse) Reset() {
+	*x = GetSystemInfoResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoResponse) ProtoMessage() {}
+
+func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetSystemInfoResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse) GetUptimeSecs() uint64 {
+	if x != nil {
+		return x.UptimeSecs
+	}
+	return 0
+}
+
+func (x *GetSystemInfoResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
 }
 
-func (x *GetHAStatusResponse) Reset() {
-	*x = GetHAStatusResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[61]
+func (x *GetSystemInfoResponse) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type GetSystemHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemHealthRequest) Reset() {
+	*x = GetSystemHealthRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHAStatusResponse) String() string {
+func (x *GetSystemHealthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHAStatusResponse) ProtoMessage() {}
+func (*GetSystemHealthRequest) ProtoMessage() {}
 
-func (x *GetHAStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[61]
+func (x *GetSystemHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3314,207 +5070,277 @@ func (x *GetHAStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHAStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetHAStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetSystemHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemHealthRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetHAStatusResponse) GetConfigured() bool {
-	if x != nil {
-		return x.Configured
-	}
-	return false
+type GetSystemHealthResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Hostname   string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Version    string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Commit     string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	BuildDate  string                 `protobuf:"bytes,4,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	UptimeSecs uint64                 `protobuf:"varint,5,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
+	Subsystems []*SubsystemHealth     `protobuf:"bytes,6,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
+	// NETCONF server metrics, unset when the NETCONF server is not running.
+	Netconf       *NETCONFHealth `protobuf:"bytes,7,opt,name=netconf,proto3" json:"netconf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHAStatusResponse) GetConverged() bool {
-	if x != nil {
-		return x.Converged
-	}
-	return false
+func (x *GetSystemHealthResponse) Reset() {
+	*x = GetSystemHealthResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHAStatusResponse) GetVrrpGroups() uint32 {
-	if x != nil {
-		return x.VrrpGroups
-	}
-	return 0
+func (x *GetSystemHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetHAStatusResponse) GetIssues() []string {
+func (*GetSystemHealthResponse) ProtoMessage() {}
+
+func (x *GetSystemHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[90]
 	if x != nil {
-		return x.Issues
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetHAStatusResponse) GetClusterEnabled() bool {
-	if x != nil {
-		return x.ClusterEnabled
-	}
-	return false
+// Deprecated: Use GetSystemHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemHealthResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetHAStatusResponse) GetClusterNodes() uint32 {
+func (x *GetSystemHealthResponse) GetHostname() string {
 	if x != nil {
-		return x.ClusterNodes
+		return x.Hostname
 	}
-	return 0
+	return ""
 }
 
-func (x *GetHAStatusResponse) GetClusterEtcdSync() bool {
+func (x *GetSystemHealthResponse) GetVersion() string {
 	if x != nil {
-		return x.ClusterEtcdSync
+		return x.Version
 	}
-	return false
+	return ""
 }
 
-func (x *GetHAStatusResponse) GetClusterSyncAligned() bool {
+func (x *GetSystemHealthResponse) GetCommit() string {
 	if x != nil {
-		return x.ClusterSyncAligned
+		return x.Commit
 	}
-	return false
+	return ""
 }
 
-func (x *GetHAStatusResponse) GetFrrVrrpConfiguredGroups() uint32 {
+func (x *GetSystemHealthResponse) GetBuildDate() string {
 	if x != nil {
-		return x.FrrVrrpConfiguredGroups
+		return x.BuildDate
 	}
-	return 0
+	return ""
 }
 
-func (x *GetHAStatusResponse) GetFrrVrrpObservedGroups() uint32 {
+func (x *GetSystemHealthResponse) GetUptimeSecs() uint64 {
 	if x != nil {
-		return x.FrrVrrpObservedGroups
+		return x.UptimeSecs
 	}
 	return 0
 }
 
-func (x *GetHAStatusResponse) GetFrrVrrpActiveGroups() uint32 {
+func (x *GetSystemHealthResponse) GetSubsystems() []*SubsystemHealth {
 	if x != nil {
-		return x.FrrVrrpActiveGroups
+		return x.Subsystems
 	}
-	return 0
+	return nil
 }
 
-func (x *GetHAStatusResponse) GetFrrVrrpLastCheck() string {
+func (x *GetSystemHealthResponse) GetNetconf() *NETCONFHealth {
 	if x != nil {
-		return x.FrrVrrpLastCheck
+		return x.Netconf
 	}
-	return ""
+	return nil
 }
 
-func (x *GetHAStatusResponse) GetFrrVrrpIssues() []string {
+type SubsystemHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "up", "down", or "not running"
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubsystemHealth) Reset() {
+	*x = SubsystemHealth{}
+	mi := &file_api_v1_router_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubsystemHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubsystemHealth) ProtoMessage() {}
+
+func (x *SubsystemHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[91]
 	if x != nil {
-		return x.FrrVrrpIssues
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetHAStatusResponse) GetFrrVrrpLastError() string {
+// Deprecated: Use SubsystemHealth.ProtoReflect.Descriptor instead.
+func (*SubsystemHealth) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SubsystemHealth) GetName() string {
 	if x != nil {
-		return x.FrrVrrpLastError
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetHAStatusResponse) GetVppLcpLastCheck() string {
+func (x *SubsystemHealth) GetStatus() string {
 	if x != nil {
-		return x.VppLcpLastCheck
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetHAStatusResponse) GetVppLcpPairs() uint32 {
+func (x *SubsystemHealth) GetDetail() string {
 	if x != nil {
-		return x.VppLcpPairs
+		return x.Detail
 	}
-	return 0
+	return ""
 }
 
-func (x *GetHAStatusResponse) GetVppLcpInconsistencies() []string {
-	if x != nil {
-		return x.VppLcpInconsistencies
-	}
-	return nil
+type NETCONFHealth struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Listening            bool                   `protobuf:"varint,1,opt,name=listening,proto3" json:"listening,omitempty"`
+	ListenAddrs          []string               `protobuf:"bytes,2,rep,name=listen_addrs,json=listenAddrs,proto3" json:"listen_addrs,omitempty"`
+	ActiveSessions       uint32                 `protobuf:"varint,3,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"`
+	ActiveConnections    uint32                 `protobuf:"varint,4,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	TotalConnections     uint64                 `protobuf:"varint,5,opt,name=total_connections,json=totalConnections,proto3" json:"total_connections,omitempty"`
+	SuccessfulHandshakes uint64                 `protobuf:"varint,6,opt,name=successful_handshakes,json=successfulHandshakes,proto3" json:"successful_handshakes,omitempty"`
+	FailedHandshakes     uint64                 `protobuf:"varint,7,opt,name=failed_handshakes,json=failedHandshakes,proto3" json:"failed_handshakes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetHAStatusResponse) GetVppLcpLastError() string {
+func (x *NETCONFHealth) Reset() {
+	*x = NETCONFHealth{}
+	mi := &file_api_v1_router_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NETCONFHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NETCONFHealth) ProtoMessage() {}
+
+func (x *NETCONFHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[92]
 	if x != nil {
-		return x.VppLcpLastError
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetHAStatusResponse) GetFrrBfdLastCheck() string {
+// Deprecated: Use NETCONFHealth.ProtoReflect.Descriptor instead.
+func (*NETCONFHealth) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *NETCONFHealth) GetListening() bool {
 	if x != nil {
-		return x.FrrBfdLastCheck
+		return x.Listening
 	}
-	return ""
+	return false
 }
 
-func (x *GetHAStatusResponse) GetFrrBfdConfiguredPeers() uint32 {
+func (x *NETCONFHealth) GetListenAddrs() []string {
 	if x != nil {
-		return x.FrrBfdConfiguredPeers
+		return x.ListenAddrs
 	}
-	return 0
+	return nil
 }
 
-func (x *GetHAStatusResponse) GetFrrBfdObservedPeers() uint32 {
+func (x *NETCONFHealth) GetActiveSessions() uint32 {
 	if x != nil {
-		return x.FrrBfdObservedPeers
+		return x.ActiveSessions
 	}
 	return 0
 }
 
-func (x *GetHAStatusResponse) GetFrrBfdUpPeers() uint32 {
+func (x *NETCONFHealth) GetActiveConnections() uint32 {
 	if x != nil {
-		return x.FrrBfdUpPeers
+		return x.ActiveConnections
 	}
 	return 0
 }
 
-func (x *GetHAStatusResponse) GetFrrBfdDownPeers() uint32 {
+func (x *NETCONFHealth) GetTotalConnections() uint64 {
 	if x != nil {
-		return x.FrrBfdDownPeers
+		return x.TotalConnections
 	}
 	return 0
 }
 
-func (x *GetHAStatusResponse) GetFrrBfdIssues() []string {
+func (x *NETCONFHealth) GetSuccessfulHandshakes() uint64 {
 	if x != nil {
-		return x.FrrBfdIssues
+		return x.SuccessfulHandshakes
 	}
-	return nil
+	return 0
 }
 
-func (x *GetHAStatusResponse) GetFrrBfdLastError() string {
+func (x *NETCONFHealth) GetFailedHandshakes() uint64 {
 	if x != nil {
-		return x.FrrBfdLastError
+		return x.FailedHandshakes
 	}
-	return ""
+	return 0
 }
 
-type GetRoutingInstancesRequest struct {
+type GetLastApplyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoutingInstancesRequest) Reset() {
-	*x = GetRoutingInstancesRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[62]
+func (x *GetLastApplyRequest) Reset() {
+	*x = GetLastApplyRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutingInstancesRequest) String() string {
+func (x *GetLastApplyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutingInstancesRequest) ProtoMessage() {}
+func (*GetLastApplyRequest) ProtoMessage() {}
 
-func (x *GetRoutingInstancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[62]
+func (x *GetLastApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3525,33 +5351,39 @@ func (x *GetRoutingInstancesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutingInstancesRequest.ProtoReflect.Descriptor instead.
-func (*GetRoutingInstancesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetLastApplyRequest.ProtoReflect.Descriptor instead.
+func (*GetLastApplyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{93}
 }
 
-type GetRoutingInstancesResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Instances     []*RoutingInstanceState `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+type GetLastApplyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False when no apply has been recorded yet.
+	Recorded      bool           `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	Time          string         `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"` // RFC 3339 time the apply finished
+	Version       uint64         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Status        string         `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "ok" or "failed"
+	Error         string         `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Plugins       []*PluginApply `protobuf:"bytes,6,rep,name=plugins,proto3" json:"plugins,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoutingInstancesResponse) Reset() {
-	*x = GetRoutingInstancesResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[63]
+func (x *GetLastApplyResponse) Reset() {
+	*x = GetLastApplyResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutingInstancesResponse) String() string {
+func (x *GetLastApplyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutingInstancesResponse) ProtoMessage() {}
+func (*GetLastApplyResponse) ProtoMessage() {}
 
-func (x *GetRoutingInstancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[63]
+func (x *GetLastApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3562,155 +5394,144 @@ func (x *GetRoutingInstancesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutingInstancesResponse.ProtoReflect.Descriptor instead.
-func (*GetRoutingInstancesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use GetLastApplyResponse.ProtoReflect.Descriptor instead.
+func (*GetLastApplyResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetRoutingInstancesResponse) GetInstances() []*RoutingInstanceState {
+func (x *GetLastApplyResponse) GetRecorded() bool {
 	if x != nil {
-		return x.Instances
+		return x.Recorded
 	}
-	return nil
-}
-
-type RoutingInstanceState struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	InstanceType       string                 `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
-	RouteDistinguisher string                 `protobuf:"bytes,3,opt,name=route_distinguisher,json=routeDistinguisher,proto3" json:"route_distinguisher,omitempty"`
-	Ipv4TableId        uint32                 `protobuf:"varint,4,opt,name=ipv4_table_id,json=ipv4TableId,proto3" json:"ipv4_table_id,omitempty"`
-	Ipv6TableId        uint32                 `protobuf:"varint,5,opt,name=ipv6_table_id,json=ipv6TableId,proto3" json:"ipv6_table_id,omitempty"`
-	ImportTargets      []string               `protobuf:"bytes,6,rep,name=import_targets,json=importTargets,proto3" json:"import_targets,omitempty"`
-	ExportTargets      []string               `protobuf:"bytes,7,rep,name=export_targets,json=exportTargets,proto3" json:"export_targets,omitempty"`
-	ImportPolicies     []string               `protobuf:"bytes,8,rep,name=import_policies,json=importPolicies,proto3" json:"import_policies,omitempty"`
-	ExportPolicies     []string               `protobuf:"bytes,9,rep,name=export_policies,json=exportPolicies,proto3" json:"export_policies,omitempty"`
-	Interfaces         []string               `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *RoutingInstanceState) Reset() {
-	*x = RoutingInstanceState{}
-	mi := &file_api_v1_router_proto_msgTypes[64]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return false
 }
 
-func (x *RoutingInstanceState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetLastApplyResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
 }
 
-func (*RoutingInstanceState) ProtoMessage() {}
-
-func (x *RoutingInstanceState) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[64]
+func (x *GetLastApplyResponse) GetVersion() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Version
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RoutingInstanceState.ProtoReflect.Descriptor instead.
-func (*RoutingInstanceState) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{64}
+func (x *GetLastApplyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *RoutingInstanceState) GetName() string {
+func (x *GetLastApplyResponse) GetError() string {
 	if x != nil {
-		return x.Name
+		return x.Error
 	}
 	return ""
 }
 
-func (x *RoutingInstanceState) GetInstanceType() string {
+func (x *GetLastApplyResponse) GetPlugins() []*PluginApply {
 	if x != nil {
-		return x.InstanceType
+		return x.Plugins
 	}
-	return ""
+	return nil
+}
+
+type PluginApply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Operations    []*ApplyOperation      `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoutingInstanceState) GetRouteDistinguisher() string {
-	if x != nil {
-		return x.RouteDistinguisher
-	}
-	return ""
+func (x *PluginApply) Reset() {
+	*x = PluginApply{}
+	mi := &file_api_v1_router_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoutingInstanceState) GetIpv4TableId() uint32 {
-	if x != nil {
-		return x.Ipv4TableId
-	}
-	return 0
+func (x *PluginApply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RoutingInstanceState) GetIpv6TableId() uint32 {
+func (*PluginApply) ProtoMessage() {}
+
+func (x *PluginApply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[95]
 	if x != nil {
-		return x.Ipv6TableId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RoutingInstanceState) GetImportTargets() []string {
-	if x != nil {
-		return x.ImportTargets
-	}
-	return nil
+// Deprecated: Use PluginApply.ProtoReflect.Descriptor instead.
+func (*PluginApply) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *RoutingInstanceState) GetExportTargets() []string {
+func (x *PluginApply) GetPlugin() string {
 	if x != nil {
-		return x.ExportTargets
+		return x.Plugin
 	}
-	return nil
+	return ""
 }
 
-func (x *RoutingInstanceState) GetImportPolicies() []string {
+func (x *PluginApply) GetStatus() string {
 	if x != nil {
-		return x.ImportPolicies
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *RoutingInstanceState) GetExportPolicies() []string {
+func (x *PluginApply) GetError() string {
 	if x != nil {
-		return x.ExportPolicies
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *RoutingInstanceState) GetInterfaces() []string {
+func (x *PluginApply) GetOperations() []*ApplyOperation {
 	if x != nil {
-		return x.Interfaces
+		return x.Operations
 	}
 	return nil
 }
 
-type GetClassOfServiceRequest struct {
+type ApplyOperation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // empty when the step succeeded
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClassOfServiceRequest) Reset() {
-	*x = GetClassOfServiceRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[65]
+func (x *ApplyOperation) Reset() {
+	*x = ApplyOperation{}
+	mi := &file_api_v1_router_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassOfServiceRequest) String() string {
+func (x *ApplyOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassOfServiceRequest) ProtoMessage() {}
+func (*ApplyOperation) ProtoMessage() {}
 
-func (x *GetClassOfServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[65]
+func (x *ApplyOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3721,37 +5542,56 @@ func (x *GetClassOfServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassOfServiceRequest.ProtoReflect.Descriptor instead.
-func (*GetClassOfServiceRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use ApplyOperation.ProtoReflect.Descriptor instead.
+func (*ApplyOperation) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{96}
 }
 
-type GetClassOfServiceResponse struct {
-	state                  protoimpl.MessageState                 `protogen:"open.v1"`
-	ForwardingClasses      []*ClassOfServiceForwardingClass       `protobuf:"bytes,1,rep,name=forwarding_classes,json=forwardingClasses,proto3" json:"forwarding_classes,omitempty"`
-	TrafficControlProfiles []*ClassOfServiceTrafficControlProfile `protobuf:"bytes,2,rep,name=traffic_control_profiles,json=trafficControlProfiles,proto3" json:"traffic_control_profiles,omitempty"`
-	Interfaces             []*ClassOfServiceInterface             `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
-	EnforcementStatus      string                                 `protobuf:"bytes,4,opt,name=enforcement_status,json=enforcementStatus,proto3" json:"enforcement_status,omitempty"`
-	Capabilities           *ClassOfServiceCapabilities            `protobuf:"bytes,5,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *ApplyOperation) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
 }
 
-func (x *GetClassOfServiceResponse) Reset() {
-	*x = GetClassOfServiceResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[66]
+func (x *ApplyOperation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetTelemetryCatalogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional cardinality filters, such as "single" or "per-route".
+	Cardinality []string `protobuf:"bytes,1,rep,name=cardinality,proto3" json:"cardinality,omitempty"`
+	// Optional payload schema filters, such as "arca.telemetry.routes.v1".
+	PayloadSchema []string `protobuf:"bytes,2,rep,name=payload_schema,json=payloadSchema,proto3" json:"payload_schema,omitempty"`
+	// Optional canonical path or alias filters, such as "/routes" or "/evpn".
+	Path []string `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	// When true, return only paths that are part of the default subscription set.
+	DefaultOnly bool `protobuf:"varint,4,opt,name=default_only,json=defaultOnly,proto3" json:"default_only,omitempty"`
+	// Optional payload encoding filters, such as "json".
+	Encoding      []string `protobuf:"bytes,5,rep,name=encoding,proto3" json:"encoding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTelemetryCatalogRequest) Reset() {
+	*x = GetTelemetryCatalogRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassOfServiceResponse) String() string {
+func (x *GetTelemetryCatalogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassOfServiceResponse) ProtoMessage() {}
+func (*GetTelemetryCatalogRequest) ProtoMessage() {}
 
-func (x *GetClassOfServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[66]
+func (x *GetTelemetryCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3762,69 +5602,74 @@ func (x *GetClassOfServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassOfServiceResponse.ProtoReflect.Descriptor instead.
-func (*GetClassOfServiceResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use GetTelemetryCatalogRequest.ProtoReflect.Descriptor instead.
+func (*GetTelemetryCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetClassOfServiceResponse) GetForwardingClasses() []*ClassOfServiceForwardingClass {
+func (x *GetTelemetryCatalogRequest) GetCardinality() []string {
 	if x != nil {
-		return x.ForwardingClasses
+		return x.Cardinality
 	}
 	return nil
 }
 
-func (x *GetClassOfServiceResponse) GetTrafficControlProfiles() []*ClassOfServiceTrafficControlProfile {
+func (x *GetTelemetryCatalogRequest) GetPayloadSchema() []string {
 	if x != nil {
-		return x.TrafficControlProfiles
+		return x.PayloadSchema
 	}
 	return nil
 }
 
-func (x *GetClassOfServiceResponse) GetInterfaces() []*ClassOfServiceInterface {
+func (x *GetTelemetryCatalogRequest) GetPath() []string {
 	if x != nil {
-		return x.Interfaces
+		return x.Path
 	}
 	return nil
 }
 
-func (x *GetClassOfServiceResponse) GetEnforcementStatus() string {
+func (x *GetTelemetryCatalogRequest) GetDefaultOnly() bool {
 	if x != nil {
-		return x.EnforcementStatus
+		return x.DefaultOnly
 	}
-	return ""
+	return false
 }
 
-func (x *GetClassOfServiceResponse) GetCapabilities() *ClassOfServiceCapabilities {
+func (x *GetTelemetryCatalogRequest) GetEncoding() []string {
 	if x != nil {
-		return x.Capabilities
+		return x.Encoding
 	}
 	return nil
 }
 
-type ClassOfServiceForwardingClass struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Queue         uint32                 `protobuf:"varint,2,opt,name=queue,proto3" json:"queue,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetTelemetryCatalogResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	EventSchemaVersion      string                 `protobuf:"bytes,1,opt,name=event_schema_version,json=eventSchemaVersion,proto3" json:"event_schema_version,omitempty"`
+	Encoding                string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	DefaultPaths            []string               `protobuf:"bytes,3,rep,name=default_paths,json=defaultPaths,proto3" json:"default_paths,omitempty"`
+	Paths                   []*TelemetryPath       `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	DefaultSampleIntervalMs uint32                 `protobuf:"varint,5,opt,name=default_sample_interval_ms,json=defaultSampleIntervalMs,proto3" json:"default_sample_interval_ms,omitempty"`
+	MinSampleIntervalMs     uint32                 `protobuf:"varint,6,opt,name=min_sample_interval_ms,json=minSampleIntervalMs,proto3" json:"min_sample_interval_ms,omitempty"`
+	MaxSampleIntervalMs     uint32                 `protobuf:"varint,7,opt,name=max_sample_interval_ms,json=maxSampleIntervalMs,proto3" json:"max_sample_interval_ms,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ClassOfServiceForwardingClass) Reset() {
-	*x = ClassOfServiceForwardingClass{}
-	mi := &file_api_v1_router_proto_msgTypes[67]
+func (x *GetTelemetryCatalogResponse) Reset() {
+	*x = GetTelemetryCatalogResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassOfServiceForwardingClass) String() string {
+func (x *GetTelemetryCatalogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassOfServiceForwardingClass) ProtoMessage() {}
+func (*GetTelemetryCatalogResponse) ProtoMessage() {}
 
-func (x *ClassOfServiceForwardingClass) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[67]
+func (x *GetTelemetryCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3835,50 +5680,87 @@ func (x *ClassOfServiceForwardingClass) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassOfServiceForwardingClass.ProtoReflect.Descriptor instead.
-func (*ClassOfServiceForwardingClass) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use GetTelemetryCatalogResponse.ProtoReflect.Descriptor instead.
+func (*GetTelemetryCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ClassOfServiceForwardingClass) GetName() string {
+func (x *GetTelemetryCatalogResponse) GetEventSchemaVersion() string {
 	if x != nil {
-		return x.Name
+		return x.EventSchemaVersion
 	}
 	return ""
 }
 
-func (x *ClassOfServiceForwardingClass) GetQueue() uint32 {
+func (x *GetTelemetryCatalogResponse) GetEncoding() string {
 	if x != nil {
-		return x.Queue
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *GetTelemetryCatalogResponse) GetDefaultPaths() []string {
+	if x != nil {
+		return x.DefaultPaths
+	}
+	return nil
+}
+
+func (x *GetTelemetryCatalogResponse) GetPaths() []*TelemetryPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *GetTelemetryCatalogResponse) GetDefaultSampleIntervalMs() uint32 {
+	if x != nil {
+		return x.DefaultSampleIntervalMs
 	}
 	return 0
 }
 
-type ClassOfServiceTrafficControlProfile struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ShapingRate       uint64                 `protobuf:"varint,2,opt,name=shaping_rate,json=shapingRate,proto3" json:"shaping_rate,omitempty"`
-	SchedulerMap      string                 `protobuf:"bytes,3,opt,name=scheduler_map,json=schedulerMap,proto3" json:"scheduler_map,omitempty"`
-	EnforcementStatus string                 `protobuf:"bytes,4,opt,name=enforcement_status,json=enforcementStatus,proto3" json:"enforcement_status,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetTelemetryCatalogResponse) GetMinSampleIntervalMs() uint32 {
+	if x != nil {
+		return x.MinSampleIntervalMs
+	}
+	return 0
 }
 
-func (x *ClassOfServiceTrafficControlProfile) Reset() {
-	*x = ClassOfServiceTrafficControlProfile{}
-	mi := &file_api_v1_router_proto_msgTypes[68]
+func (x *GetTelemetryCatalogResponse) GetMaxSampleIntervalMs() uint32 {
+	if x != nil {
+		return x.MaxSampleIntervalMs
+	}
+	return 0
+}
+
+type TelemetryPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Cardinality   string                 `protobuf:"bytes,3,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	Default       bool                   `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"`
+	Aliases       []string               `protobuf:"bytes,5,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	PayloadSchema string                 `protobuf:"bytes,6,opt,name=payload_schema,json=payloadSchema,proto3" json:"payload_schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TelemetryPath) Reset() {
+	*x = TelemetryPath{}
+	mi := &file_api_v1_router_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassOfServiceTrafficControlProfile) String() string {
+func (x *TelemetryPath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassOfServiceTrafficControlProfile) ProtoMessage() {}
+func (*TelemetryPath) ProtoMessage() {}
 
-func (x *ClassOfServiceTrafficControlProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[68]
+func (x *TelemetryPath) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3889,63 +5771,80 @@ func (x *ClassOfServiceTrafficControlProfile) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassOfServiceTrafficControlProfile.ProtoReflect.Descriptor instead.
-func (*ClassOfServiceTrafficControlProfile) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use TelemetryPath.ProtoReflect.Descriptor instead.
+func (*TelemetryPath) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *ClassOfServiceTrafficControlProfile) GetName() string {
+func (x *TelemetryPath) GetPath() string {
 	if x != nil {
-		return x.Name
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TelemetryPath) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TelemetryPath) GetCardinality() string {
+	if x != nil {
+		return x.Cardinality
 	}
 	return ""
 }
 
-func (x *ClassOfServiceTrafficControlProfile) GetShapingRate() uint64 {
+func (x *TelemetryPath) GetDefault() bool {
 	if x != nil {
-		return x.ShapingRate
+		return x.Default
 	}
-	return 0
+	return false
 }
 
-func (x *ClassOfServiceTrafficControlProfile) GetSchedulerMap() string {
+func (x *TelemetryPath) GetAliases() []string {
 	if x != nil {
-		return x.SchedulerMap
+		return x.Aliases
 	}
-	return ""
+	return nil
 }
 
-func (x *ClassOfServiceTrafficControlProfile) GetEnforcementStatus() string {
+func (x *TelemetryPath) GetPayloadSchema() string {
 	if x != nil {
-		return x.EnforcementStatus
+		return x.PayloadSchema
 	}
 	return ""
 }
 
-type ClassOfServiceInterface struct {
-	state                       protoimpl.MessageState `protogen:"open.v1"`
-	Name                        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	OutputTrafficControlProfile string                 `protobuf:"bytes,2,opt,name=output_traffic_control_profile,json=outputTrafficControlProfile,proto3" json:"output_traffic_control_profile,omitempty"`
-	EnforcementStatus           string                 `protobuf:"bytes,3,opt,name=enforcement_status,json=enforcementStatus,proto3" json:"enforcement_status,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type SubscribeTelemetryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional paths. Empty selects the safe default paths.
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Sample interval for repeated snapshots. Zero uses the daemon default.
+	SampleIntervalMs uint32 `protobuf:"varint,2,opt,name=sample_interval_ms,json=sampleIntervalMs,proto3" json:"sample_interval_ms,omitempty"`
+	// Send one snapshot batch and close the stream.
+	Once          bool `protobuf:"varint,3,opt,name=once,proto3" json:"once,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClassOfServiceInterface) Reset() {
-	*x = ClassOfServiceInterface{}
-	mi := &file_api_v1_router_proto_msgTypes[69]
+func (x *SubscribeTelemetryRequest) Reset() {
+	*x = SubscribeTelemetryRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassOfServiceInterface) String() string {
+func (x *SubscribeTelemetryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassOfServiceInterface) ProtoMessage() {}
+func (*SubscribeTelemetryRequest) ProtoMessage() {}
 
-func (x *ClassOfServiceInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[69]
+func (x *SubscribeTelemetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3956,53 +5855,63 @@ func (x *ClassOfServiceInterface) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassOfServiceInterface.ProtoReflect.Descriptor instead.
-func (*ClassOfServiceInterface) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use SubscribeTelemetryRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTelemetryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *ClassOfServiceInterface) GetName() string {
+func (x *SubscribeTelemetryRequest) GetPaths() []string {
 	if x != nil {
-		return x.Name
+		return x.Paths
 	}
-	return ""
+	return nil
 }
 
-func (x *ClassOfServiceInterface) GetOutputTrafficControlProfile() string {
+func (x *SubscribeTelemetryRequest) GetSampleIntervalMs() uint32 {
 	if x != nil {
-		return x.OutputTrafficControlProfile
+		return x.SampleIntervalMs
 	}
-	return ""
+	return 0
 }
 
-func (x *ClassOfServiceInterface) GetEnforcementStatus() string {
+func (x *SubscribeTelemetryRequest) GetOnce() bool {
 	if x != nil {
-		return x.EnforcementStatus
+		return x.Once
 	}
-	return ""
+	return false
 }
 
-type GetSystemInfoRequest struct {
+type TelemetryEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Encoding      string                 `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	JsonPayload   string                 `protobuf:"bytes,6,opt,name=json_payload,json=jsonPayload,proto3" json:"json_payload,omitempty"`
+	SchemaVersion string                 `protobuf:"bytes,7,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	PayloadBytes  uint64                 `protobuf:"varint,8,opt,name=payload_bytes,json=payloadBytes,proto3" json:"payload_bytes,omitempty"`
+	PayloadSchema string                 `protobuf:"bytes,9,opt,name=payload_schema,json=payloadSchema,proto3" json:"payload_schema,omitempty"`
+	Cardinality   string                 `protobuf:"bytes,10,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSystemInfoRequest) Reset() {
-	*x = GetSystemInfoRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[70]
+func (x *TelemetryEvent) Reset() {
+	*x = TelemetryEvent{}
+	mi := &file_api_v1_router_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSystemInfoRequest) String() string {
+func (x *TelemetryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemInfoRequest) ProtoMessage() {}
+func (*TelemetryEvent) ProtoMessage() {}
 
-func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[70]
+func (x *TelemetryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4013,102 +5922,109 @@ func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use TelemetryEvent.ProtoReflect.Descriptor instead.
+func (*TelemetryEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{101}
 }
 
-type GetSystemInfoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	UptimeSecs    uint64                 `protobuf:"varint,3,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TelemetryEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
 }
 
-func (x *GetSystemInfoResponse) Reset() {
-	*x = GetSystemInfoResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[71]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TelemetryEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
 }
 
-func (x *GetSystemInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TelemetryEvent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (*GetSystemInfoResponse) ProtoMessage() {}
-
-func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[71]
+func (x *TelemetryEvent) GetEventType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EventType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetSystemInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetSystemInfoResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{71}
+func (x *TelemetryEvent) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
 }
 
-func (x *GetSystemInfoResponse) GetHostname() string {
+func (x *TelemetryEvent) GetJsonPayload() string {
 	if x != nil {
-		return x.Hostname
+		return x.JsonPayload
 	}
 	return ""
 }
 
-func (x *GetSystemInfoResponse) GetVersion() string {
+func (x *TelemetryEvent) GetSchemaVersion() string {
 	if x != nil {
-		return x.Version
+		return x.SchemaVersion
 	}
 	return ""
 }
 
-func (x *GetSystemInfoResponse) GetUptimeSecs() uint64 {
+func (x *TelemetryEvent) GetPayloadBytes() uint64 {
 	if x != nil {
-		return x.UptimeSecs
+		return x.PayloadBytes
 	}
 	return 0
 }
 
-type GetTelemetryCatalogRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional cardinality filters, such as "single" or "per-route".
-	Cardinality []string `protobuf:"bytes,1,rep,name=cardinality,proto3" json:"cardinality,omitempty"`
-	// Optional payload schema filters, such as "arca.telemetry.routes.v1".
-	PayloadSchema []string `protobuf:"bytes,2,rep,name=payload_schema,json=payloadSchema,proto3" json:"payload_schema,omitempty"`
-	// Optional canonical path or alias filters, such as "/routes" or "/evpn".
-	Path []string `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
-	// When true, return only paths that are part of the default subscription set.
-	DefaultOnly bool `protobuf:"varint,4,opt,name=default_only,json=defaultOnly,proto3" json:"default_only,omitempty"`
-	// Optional payload encoding filters, such as "json".
-	Encoding      []string `protobuf:"bytes,5,rep,name=encoding,proto3" json:"encoding,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TelemetryEvent) GetPayloadSchema() string {
+	if x != nil {
+		return x.PayloadSchema
+	}
+	return ""
 }
 
-func (x *GetTelemetryCatalogRequest) Reset() {
-	*x = GetTelemetryCatalogRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[72]
+func (x *TelemetryEvent) GetCardinality() string {
+	if x != nil {
+		return x.Cardinality
+	}
+	return ""
+}
+
+type ClassOfServiceCapabilities struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	MetadataBindingSupported bool                   `protobuf:"varint,1,opt,name=metadata_binding_supported,json=metadataBindingSupported,proto3" json:"metadata_binding_supported,omitempty"`
+	QueueSchedulerSupported  bool                   `protobuf:"varint,2,opt,name=queue_scheduler_supported,json=queueSchedulerSupported,proto3" json:"queue_scheduler_supported,omitempty"`
+	PolicerSupported         bool                   `protobuf:"varint,3,opt,name=policer_supported,json=policerSupported,proto3" json:"policer_supported,omitempty"`
+	CountersSupported        bool                   `protobuf:"varint,4,opt,name=counters_supported,json=countersSupported,proto3" json:"counters_supported,omitempty"`
+	LastCheck                string                 `protobuf:"bytes,5,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`
+	LastError                string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Diagnostics              []string               `protobuf:"bytes,7,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ClassOfServiceCapabilities) Reset() {
+	*x = ClassOfServiceCapabilities{}
+	mi := &file_api_v1_router_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTelemetryCatalogRequest) String() string {
+func (x *ClassOfServiceCapabilities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTelemetryCatalogRequest) ProtoMessage() {}
+func (*ClassOfServiceCapabilities) ProtoMessage() {}
 
-func (x *GetTelemetryCatalogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[72]
+func (x *ClassOfServiceCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4119,74 +6035,82 @@ func (x *GetTelemetryCatalogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTelemetryCatalogRequest.ProtoReflect.Descriptor instead.
-func (*GetTelemetryCatalogRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ClassOfServiceCapabilities.ProtoReflect.Descriptor instead.
+func (*ClassOfServiceCapabilities) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetTelemetryCatalogRequest) GetCardinality() []string {
+func (x *ClassOfServiceCapabilities) GetMetadataBindingSupported() bool {
 	if x != nil {
-		return x.Cardinality
+		return x.MetadataBindingSupported
 	}
-	return nil
+	return false
 }
 
-func (x *GetTelemetryCatalogRequest) GetPayloadSchema() []string {
+func (x *ClassOfServiceCapabilities) GetQueueSchedulerSupported() bool {
 	if x != nil {
-		return x.PayloadSchema
+		return x.QueueSchedulerSupported
 	}
-	return nil
+	return false
 }
 
-func (x *GetTelemetryCatalogRequest) GetPath() []string {
+func (x *ClassOfServiceCapabilities) GetPolicerSupported() bool {
 	if x != nil {
-		return x.Path
+		return x.PolicerSupported
 	}
-	return nil
+	return false
 }
 
-func (x *GetTelemetryCatalogRequest) GetDefaultOnly() bool {
+func (x *ClassOfServiceCapabilities) GetCountersSupported() bool {
 	if x != nil {
-		return x.DefaultOnly
+		return x.CountersSupported
 	}
 	return false
 }
 
-func (x *GetTelemetryCatalogRequest) GetEncoding() []string {
+func (x *ClassOfServiceCapabilities) GetLastCheck() string {
 	if x != nil {
-		return x.Encoding
+		return x.LastCheck
 	}
-	return nil
+	return ""
 }
 
-type GetTelemetryCatalogResponse struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	EventSchemaVersion      string                 `protobuf:"bytes,1,opt,name=event_schema_version,json=eventSchemaVersion,proto3" json:"event_schema_version,omitempty"`
-	Encoding                string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
-	DefaultPaths            []string               `protobuf:"bytes,3,rep,name=default_paths,json=defaultPaths,proto3" json:"default_paths,omitempty"`
-	Paths                   []*TelemetryPath       `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
-	DefaultSampleIntervalMs uint32                 `protobuf:"varint,5,opt,name=default_sample_interval_ms,json=defaultSampleIntervalMs,proto3" json:"default_sample_interval_ms,omitempty"`
-	MinSampleIntervalMs     uint32                 `protobuf:"varint,6,opt,name=min_sample_interval_ms,json=minSampleIntervalMs,proto3" json:"min_sample_interval_ms,omitempty"`
-	MaxSampleIntervalMs     uint32                 `protobuf:"varint,7,opt,name=max_sample_interval_ms,json=maxSampleIntervalMs,proto3" json:"max_sample_interval_ms,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *ClassOfServiceCapabilities) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
 }
 
-func (x *GetTelemetryCatalogResponse) Reset() {
-	*x = GetTelemetryCatalogResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[73]
+func (x *ClassOfServiceCapabilities) GetDiagnostics() []string {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type GetCommitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommitId      string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommitRequest) Reset() {
+	*x = GetCommitRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTelemetryCatalogResponse) String() string {
+func (x *GetCommitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTelemetryCatalogResponse) ProtoMessage() {}
+func (*GetCommitRequest) ProtoMessage() {}
 
-func (x *GetTelemetryCatalogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[73]
+func (x *GetCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4197,87 +6121,90 @@ func (x *GetTelemetryCatalogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTelemetryCatalogResponse.ProtoReflect.Descriptor instead.
-func (*GetTelemetryCatalogResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use GetCommitRequest.ProtoReflect.Descriptor instead.
+func (*GetCommitRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetTelemetryCatalogResponse) GetEventSchemaVersion() string {
+func (x *GetCommitRequest) GetCommitId() string {
 	if x != nil {
-		return x.EventSchemaVersion
+		return x.CommitId
 	}
 	return ""
 }
 
-func (x *GetTelemetryCatalogResponse) GetEncoding() string {
-	if x != nil {
-		return x.Encoding
-	}
-	return ""
+type GetCommitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commit        *CommitDetail          `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTelemetryCatalogResponse) GetDefaultPaths() []string {
-	if x != nil {
-		return x.DefaultPaths
-	}
-	return nil
+func (x *GetCommitResponse) Reset() {
+	*x = GetCommitResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTelemetryCatalogResponse) GetPaths() []*TelemetryPath {
-	if x != nil {
-		return x.Paths
-	}
-	return nil
+func (x *GetCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetTelemetryCatalogResponse) GetDefaultSampleIntervalMs() uint32 {
+func (*GetCommitResponse) ProtoMessage() {}
+
+func (x *GetCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[104]
 	if x != nil {
-		return x.DefaultSampleIntervalMs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetTelemetryCatalogResponse) GetMinSampleIntervalMs() uint32 {
-	if x != nil {
-		return x.MinSampleIntervalMs
-	}
-	return 0
+// Deprecated: Use GetCommitResponse.ProtoReflect.Descriptor instead.
+func (*GetCommitResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetTelemetryCatalogResponse) GetMaxSampleIntervalMs() uint32 {
+func (x *GetCommitResponse) GetCommit() *CommitDetail {
 	if x != nil {
-		return x.MaxSampleIntervalMs
+		return x.Commit
 	}
-	return 0
+	return nil
 }
 
-type TelemetryPath struct {
+type CommitDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Cardinality   string                 `protobuf:"bytes,3,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
-	Default       bool                   `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"`
-	Aliases       []string               `protobuf:"bytes,5,rep,name=aliases,proto3" json:"aliases,omitempty"`
-	PayloadSchema string                 `protobuf:"bytes,6,opt,name=payload_schema,json=payloadSchema,proto3" json:"payload_schema,omitempty"`
+	CommitId      string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	IsRollback    bool                   `protobuf:"varint,5,opt,name=is_rollback,json=isRollback,proto3" json:"is_rollback,omitempty"`
+	ConfigText    string                 `protobuf:"bytes,6,opt,name=config_text,json=configText,proto3" json:"config_text,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"` // northbound interface that issued the commit (cli, netconf; empty for older entries)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TelemetryPath) Reset() {
-	*x = TelemetryPath{}
-	mi := &file_api_v1_router_proto_msgTypes[74]
+func (x *CommitDetail) Reset() {
+	*x = CommitDetail{}
+	mi := &file_api_v1_router_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TelemetryPath) String() string {
+func (x *CommitDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TelemetryPath) ProtoMessage() {}
+func (*CommitDetail) ProtoMessage() {}
 
-func (x *TelemetryPath) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[74]
+func (x *CommitDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4288,80 +6215,83 @@ func (x *TelemetryPath) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TelemetryPath.ProtoReflect.Descriptor instead.
-func (*TelemetryPath) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use CommitDetail.ProtoReflect.Descriptor instead.
+func (*CommitDetail) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *TelemetryPath) GetPath() string {
+func (x *CommitDetail) GetCommitId() string {
 	if x != nil {
-		return x.Path
+		return x.CommitId
 	}
 	return ""
 }
 
-func (x *TelemetryPath) GetDescription() string {
+func (x *CommitDetail) GetUser() string {
 	if x != nil {
-		return x.Description
+		return x.User
 	}
 	return ""
 }
 
-func (x *TelemetryPath) GetCardinality() string {
+func (x *CommitDetail) GetTimestamp() string {
 	if x != nil {
-		return x.Cardinality
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *TelemetryPath) GetDefault() bool {
+func (x *CommitDetail) GetMessage() string {
 	if x != nil {
-		return x.Default
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommitDetail) GetIsRollback() bool {
+	if x != nil {
+		return x.IsRollback
 	}
 	return false
 }
 
-func (x *TelemetryPath) GetAliases() []string {
+func (x *CommitDetail) GetConfigText() string {
 	if x != nil {
-		return x.Aliases
+		return x.ConfigText
 	}
-	return nil
+	return ""
 }
 
-func (x *TelemetryPath) GetPayloadSchema() string {
+func (x *CommitDetail) GetSource() string {
 	if x != nil {
-		return x.PayloadSchema
+		return x.Source
 	}
 	return ""
 }
 
-type SubscribeTelemetryRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional paths. Empty selects the safe default paths.
-	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
-	// Sample interval for repeated snapshots. Zero uses the daemon default.
-	SampleIntervalMs uint32 `protobuf:"varint,2,opt,name=sample_interval_ms,json=sampleIntervalMs,proto3" json:"sample_interval_ms,omitempty"`
-	// Send one snapshot batch and close the stream.
-	Once          bool `protobuf:"varint,3,opt,name=once,proto3" json:"once,omitempty"`
+type CompareCommitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCommitId  string                 `protobuf:"bytes,1,opt,name=from_commit_id,json=fromCommitId,proto3" json:"from_commit_id,omitempty"`
+	ToCommitId    string                 `protobuf:"bytes,2,opt,name=to_commit_id,json=toCommitId,proto3" json:"to_commit_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeTelemetryRequest) Reset() {
-	*x = SubscribeTelemetryRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[75]
+func (x *CompareCommitsRequest) Reset() {
+	*x = CompareCommitsRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeTelemetryRequest) String() string {
+func (x *CompareCommitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeTelemetryRequest) ProtoMessage() {}
+func (*CompareCommitsRequest) ProtoMessage() {}
 
-func (x *SubscribeTelemetryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[75]
+func (x *CompareCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4372,63 +6302,48 @@ func (x *SubscribeTelemetryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeTelemetryRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeTelemetryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *SubscribeTelemetryRequest) GetPaths() []string {
-	if x != nil {
-		return x.Paths
-	}
-	return nil
+// Deprecated: Use CompareCommitsRequest.ProtoReflect.Descriptor instead.
+func (*CompareCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *SubscribeTelemetryRequest) GetSampleIntervalMs() uint32 {
+func (x *CompareCommitsRequest) GetFromCommitId() string {
 	if x != nil {
-		return x.SampleIntervalMs
+		return x.FromCommitId
 	}
-	return 0
+	return ""
 }
 
-func (x *SubscribeTelemetryRequest) GetOnce() bool {
+func (x *CompareCommitsRequest) GetToCommitId() string {
 	if x != nil {
-		return x.Once
+		return x.ToCommitId
 	}
-	return false
+	return ""
 }
 
-type TelemetryEvent struct {
+type CompareCommitsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	Encoding      string                 `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
-	JsonPayload   string                 `protobuf:"bytes,6,opt,name=json_payload,json=jsonPayload,proto3" json:"json_payload,omitempty"`
-	SchemaVersion string                 `protobuf:"bytes,7,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	PayloadBytes  uint64                 `protobuf:"varint,8,opt,name=payload_bytes,json=payloadBytes,proto3" json:"payload_bytes,omitempty"`
-	PayloadSchema string                 `protobuf:"bytes,9,opt,name=payload_schema,json=payloadSchema,proto3" json:"payload_schema,omitempty"`
-	Cardinality   string                 `protobuf:"bytes,10,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	DiffText      string                 `protobuf:"bytes,1,opt,name=diff_text,json=diffText,proto3" json:"diff_text,omitempty"`
+	HasChanges    bool                   `protobuf:"varint,2,opt,name=has_changes,json=hasChanges,proto3" json:"has_changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TelemetryEvent) Reset() {
-	*x = TelemetryEvent{}
-	mi := &file_api_v1_router_proto_msgTypes[76]
+func (x *CompareCommitsResponse) Reset() {
+	*x = CompareCommitsResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TelemetryEvent) String() string {
+func (x *CompareCommitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TelemetryEvent) ProtoMessage() {}
+func (*CompareCommitsResponse) ProtoMessage() {}
 
-func (x *TelemetryEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[76]
+func (x *CompareCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4439,109 +6354,91 @@ func (x *TelemetryEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TelemetryEvent.ProtoReflect.Descriptor instead.
-func (*TelemetryEvent) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *TelemetryEvent) GetSequence() uint64 {
-	if x != nil {
-		return x.Sequence
-	}
-	return 0
-}
-
-func (x *TelemetryEvent) GetTimestamp() string {
-	if x != nil {
-		return x.Timestamp
-	}
-	return ""
-}
-
-func (x *TelemetryEvent) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use CompareCommitsResponse.ProtoReflect.Descriptor instead.
+func (*CompareCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *TelemetryEvent) GetEventType() string {
+func (x *CompareCommitsResponse) GetDiffText() string {
 	if x != nil {
-		return x.EventType
+		return x.DiffText
 	}
 	return ""
 }
 
-func (x *TelemetryEvent) GetEncoding() string {
+func (x *CompareCommitsResponse) GetHasChanges() bool {
 	if x != nil {
-		return x.Encoding
+		return x.HasChanges
 	}
-	return ""
+	return false
 }
 
-func (x *TelemetryEvent) GetJsonPayload() string {
-	if x != nil {
-		return x.JsonPayload
-	}
-	return ""
+type ListUserSSHKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TelemetryEvent) GetSchemaVersion() string {
-	if x != nil {
-		return x.SchemaVersion
-	}
-	return ""
+func (x *ListUserSSHKeysRequest) Reset() {
+	*x = ListUserSSHKeysRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TelemetryEvent) GetPayloadBytes() uint64 {
-	if x != nil {
-		return x.PayloadBytes
-	}
-	return 0
+func (x *ListUserSSHKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TelemetryEvent) GetPayloadSchema() string {
+func (*ListUserSSHKeysRequest) ProtoMessage() {}
+
+func (x *ListUserSSHKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[108]
 	if x != nil {
-		return x.PayloadSchema
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TelemetryEvent) GetCardinality() string {
+// Deprecated: Use ListUserSSHKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSSHKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListUserSSHKeysRequest) GetUsername() string {
 	if x != nil {
-		return x.Cardinality
+		return x.Username
 	}
 	return ""
 }
 
-type ClassOfServiceCapabilities struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	MetadataBindingSupported bool                   `protobuf:"varint,1,opt,name=metadata_binding_supported,json=metadataBindingSupported,proto3" json:"metadata_binding_supported,omitempty"`
-	QueueSchedulerSupported  bool                   `protobuf:"varint,2,opt,name=queue_scheduler_supported,json=queueSchedulerSupported,proto3" json:"queue_scheduler_supported,omitempty"`
-	PolicerSupported         bool                   `protobuf:"varint,3,opt,name=policer_supported,json=policerSupported,proto3" json:"policer_supported,omitempty"`
-	CountersSupported        bool                   `protobuf:"varint,4,opt,name=counters_supported,json=countersSupported,proto3" json:"counters_supported,omitempty"`
-	LastCheck                string                 `protobuf:"bytes,5,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`
-	LastError                string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	Diagnostics              []string               `protobuf:"bytes,7,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type ListUserSSHKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*UserSSHKey          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClassOfServiceCapabilities) Reset() {
-	*x = ClassOfServiceCapabilities{}
-	mi := &file_api_v1_router_proto_msgTypes[77]
+func (x *ListUserSSHKeysResponse) Reset() {
+	*x = ListUserSSHKeysResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassOfServiceCapabilities) String() string {
+func (x *ListUserSSHKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassOfServiceCapabilities) ProtoMessage() {}
+func (*ListUserSSHKeysResponse) ProtoMessage() {}
 
-func (x *ClassOfServiceCapabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[77]
+func (x *ListUserSSHKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4552,82 +6449,118 @@ func (x *ClassOfServiceCapabilities) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassOfServiceCapabilities.ProtoReflect.Descriptor instead.
-func (*ClassOfServiceCapabilities) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use ListUserSSHKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSSHKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ClassOfServiceCapabilities) GetMetadataBindingSupported() bool {
+func (x *ListUserSSHKeysResponse) GetKeys() []*UserSSHKey {
 	if x != nil {
-		return x.MetadataBindingSupported
+		return x.Keys
 	}
-	return false
+	return nil
 }
 
-func (x *ClassOfServiceCapabilities) GetQueueSchedulerSupported() bool {
+type UserSSHKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"` // SHA256 fingerprint as printed by ssh-keygen -l
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSSHKey) Reset() {
+	*x = UserSSHKey{}
+	mi := &file_api_v1_router_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSSHKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSSHKey) ProtoMessage() {}
+
+func (x *UserSSHKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[110]
 	if x != nil {
-		return x.QueueSchedulerSupported
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ClassOfServiceCapabilities) GetPolicerSupported() bool {
+// Deprecated: Use UserSSHKey.ProtoReflect.Descriptor instead.
+func (*UserSSHKey) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UserSSHKey) GetAlgorithm() string {
 	if x != nil {
-		return x.PolicerSupported
+		return x.Algorithm
 	}
-	return false
+	return ""
 }
 
-func (x *ClassOfServiceCapabilities) GetCountersSupported() bool {
+func (x *UserSSHKey) GetFingerprint() string {
 	if x != nil {
-		return x.CountersSupported
+		return x.Fingerprint
 	}
-	return false
+	return ""
 }
 
-func (x *ClassOfServiceCapabilities) GetLastCheck() string {
+func (x *UserSSHKey) GetComment() string {
 	if x != nil {
-		return x.LastCheck
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *ClassOfServiceCapabilities) GetLastError() string {
+func (x *UserSSHKey) GetEnabled() bool {
 	if x != nil {
-		return x.LastError
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *ClassOfServiceCapabilities) GetDiagnostics() []string {
+func (x *UserSSHKey) GetCreatedAt() string {
 	if x != nil {
-		return x.Diagnostics
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-type GetCommitRequest struct {
+type SetUserSSHKeyStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommitId      string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommitRequest) Reset() {
-	*x = GetCommitRequest{}
-	mi := &file_api_v1_router_proto_msgTypes[78]
+func (x *SetUserSSHKeyStatusRequest) Reset() {
+	*x = SetUserSSHKeyStatusRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommitRequest) String() string {
+func (x *SetUserSSHKeyStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommitRequest) ProtoMessage() {}
+func (*SetUserSSHKeyStatusRequest) ProtoMessage() {}
 
-func (x *GetCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[78]
+func (x *SetUserSSHKeyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4638,40 +6571,53 @@ func (x *GetCommitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommitRequest.ProtoReflect.Descriptor instead.
-func (*GetCommitRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use SetUserSSHKeyStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetUserSSHKeyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetCommitRequest) GetCommitId() string {
+func (x *SetUserSSHKeyStatusRequest) GetUsername() string {
 	if x != nil {
-		return x.CommitId
+		return x.Username
 	}
 	return ""
 }
 
-type GetCommitResponse struct {
+func (x *SetUserSSHKeyStatusRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *SetUserSSHKeyStatusRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetUserSSHKeyStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Commit        *CommitDetail          `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommitResponse) Reset() {
-	*x = GetCommitResponse{}
-	mi := &file_api_v1_router_proto_msgTypes[79]
+func (x *SetUserSSHKeyStatusResponse) Reset() {
+	*x = SetUserSSHKeyStatusResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommitResponse) String() string {
+func (x *SetUserSSHKeyStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommitResponse) ProtoMessage() {}
+func (*SetUserSSHKeyStatusResponse) ProtoMessage() {}
 
-func (x *GetCommitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[79]
+func (x *SetUserSSHKeyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4682,45 +6628,34 @@ func (x *GetCommitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommitResponse.ProtoReflect.Descriptor instead.
-func (*GetCommitResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *GetCommitResponse) GetCommit() *CommitDetail {
-	if x != nil {
-		return x.Commit
-	}
-	return nil
+// Deprecated: Use SetUserSSHKeyStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetUserSSHKeyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{112}
 }
 
-type CommitDetail struct {
+type RemoveUserSSHKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommitId      string                 `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
-	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	IsRollback    bool                   `protobuf:"varint,5,opt,name=is_rollback,json=isRollback,proto3" json:"is_rollback,omitempty"`
-	ConfigText    string                 `protobuf:"bytes,6,opt,name=config_text,json=configText,proto3" json:"config_text,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitDetail) Reset() {
-	*x = CommitDetail{}
-	mi := &file_api_v1_router_proto_msgTypes[80]
+func (x *RemoveUserSSHKeyRequest) Reset() {
+	*x = RemoveUserSSHKeyRequest{}
+	mi := &file_api_v1_router_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitDetail) String() string {
+func (x *RemoveUserSSHKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitDetail) ProtoMessage() {}
+func (*RemoveUserSSHKeyRequest) ProtoMessage() {}
 
-func (x *CommitDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_router_proto_msgTypes[80]
+func (x *RemoveUserSSHKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4731,51 +6666,59 @@ func (x *CommitDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitDetail.ProtoReflect.Descriptor instead.
-func (*CommitDetail) Descriptor() ([]byte, []int) {
-	return file_api_v1_router_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use RemoveUserSSHKeyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserSSHKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *CommitDetail) GetCommitId() string {
+func (x *RemoveUserSSHKeyRequest) GetUsername() string {
 	if x != nil {
-		return x.CommitId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *CommitDetail) GetUser() string {
+func (x *RemoveUserSSHKeyRequest) GetFingerprint() string {
 	if x != nil {
-		return x.User
+		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *CommitDetail) GetTimestamp() string {
-	if x != nil {
-		return x.Timestamp
-	}
-	return ""
+type RemoveUserSSHKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitDetail) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+func (x *RemoveUserSSHKeyResponse) Reset() {
+	*x = RemoveUserSSHKeyResponse{}
+	mi := &file_api_v1_router_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitDetail) GetIsRollback() bool {
-	if x != nil {
-		return x.IsRollback
-	}
-	return false
+func (x *RemoveUserSSHKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CommitDetail) GetConfigText() string {
+func (*RemoveUserSSHKeyResponse) ProtoMessage() {}
+
+func (x *RemoveUserSSHKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_router_proto_msgTypes[114]
 	if x != nil {
-		return x.ConfigText
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserSSHKeyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserSSHKeyResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_router_proto_rawDescGZIP(), []int{114}
 }
 
 var File_api_v1_router_proto protoreflect.FileDescriptor
@@ -4816,850 +6759,1167 @@ var file_api_v1_router_proto_rawDesc = []byte{
 	0x01, 0x28, 0x04, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x61, 0x73,
 	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c,
 	0x61, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x22,
+	0xb7, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x1b, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x22, 0x31, 0x0a, 0x1b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x1c, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0x7c, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2f, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x47, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x18, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0b, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x0c, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x54, 0x65, 0x78,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x4c, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x23,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54,
-	0x65, 0x78, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
-	0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x16, 0x0a,
-	0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x12, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x15,
-	0x0a, 0x13, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x37, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d,
-	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x22, 0xb7, 0x04, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73,
-	0x70, 0x65, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x78,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61,
-	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x52, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x08, 0x72, 0x78, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x6f, 0x73, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70,
-	0x76, 0x34, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76,
-	0x36, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5e, 0x0a,
-	0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x78, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5f, 0x0a,
-	0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x60,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x22, 0x47, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0a, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12,
-	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x6e, 0x65,
-	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x10, 0x42,
-	0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x50, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65,
+	0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0b, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x4c, 0x0a, 0x0c, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0x42, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x4c, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x72,
+	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0xd4, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73,
+	0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x13,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x12, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
+	0x0a, 0x12, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xd2, 0x04, 0x0a,
+	0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x74, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61,
+	0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x3d, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x78, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x3d,
+	0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x78, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x71, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x71, 0x6f, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x66, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x66, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x5e, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x78,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x5f, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x78,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x22, 0x60, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x72, 0x63, 0x61,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xa9, 0x01,
+	0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x22, 0x84,
+	0x01, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x40, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x67, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x62, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x69, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x18,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42,
+	0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x10, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x41, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x53, 0x65, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x5b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x53,
+	0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x11, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64,
+	0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x22, 0x5d, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x53, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73,
+	0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x73, 0x22,
+	0x35, 0x0a, 0x1f, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65,
+	0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0x3e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65,
-	0x63, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x65, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73,
+	0x73, 0x73, 0x22, 0x34, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x44, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4f,
+	0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x5b,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6e, 0x65,
-	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x11,
-	0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0e,
-	0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
-	0x63, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53,
-	0x65, 0x63, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54,
-	0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66,
-	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x36,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52,
+	0x50, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2d, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x3e, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x34, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x22, 0x44, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x36, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x53,
-	0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50,
-	0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x22, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65,
-	0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x72, 0x69, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x72, 0x69,
-	0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2c,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x15, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x80, 0x03, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x65, 0x65,
-	0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x70,
-	0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x70, 0x5f,
-	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x50, 0x65,
-	0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x6f, 0x77, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x78,
-	0x46, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x70,
-	0x65, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x72, 0x63,
-	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x46, 0x44, 0x50,
-	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73,
-	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe0, 0x02, 0x0a, 0x0c, 0x42, 0x46, 0x44, 0x50, 0x65,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x72, 0x66,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e,
-	0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x75,
-	0x70, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77,
-	0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x78, 0x46, 0x61,
-	0x69, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74,
-	0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
-	0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x73,
-	0x74, 0x52, 0x75, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e,
-	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x14, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0xe3, 0x08, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x72, 0x72, 0x70,
-	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x76,
-	0x72, 0x72, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12,
-	0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x74, 0x63, 0x64, 0x5f,
-	0x73, 0x79, 0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x45, 0x74, 0x63, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x30, 0x0a, 0x14, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x61, 0x6c, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x3b, 0x0a,
-	0x1a, 0x66, 0x72, 0x72, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x17, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72,
-	0x72, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x66, 0x72,
-	0x72, 0x56, 0x72, 0x72, 0x70, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x72, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x13, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72, 0x70, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x72, 0x72, 0x5f,
-	0x76, 0x72, 0x72, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72, 0x70, 0x4c, 0x61,
-	0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x72, 0x5f, 0x76,
-	0x72, 0x72, 0x70, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0d, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72, 0x70, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x68, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x69, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x62, 0x72, 0x69, 0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x80, 0x03, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x64, 0x6f, 0x77, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x77, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x78,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x46, 0x44, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe0, 0x02,
+	0x0a, 0x0c, 0x42, 0x46, 0x44, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12,
+	0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x75, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x6f,
+	0x77, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x78, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x72, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xa1, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x69, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe3, 0x08, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x76, 0x72, 0x72, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x65, 0x74, 0x63, 0x64, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x74, 0x63, 0x64, 0x53, 0x79,
+	0x6e, 0x63, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x66, 0x72, 0x72, 0x5f, 0x76, 0x72, 0x72, 0x70,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x72, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x15, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72, 0x70, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72,
+	0x72, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x66, 0x72, 0x72, 0x56,
+	0x72, 0x72, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
 	0x2d, 0x0a, 0x13, 0x66, 0x72, 0x72, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x72,
-	0x72, 0x56, 0x72, 0x72, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b,
-	0x0a, 0x12, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x63, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x70, 0x70, 0x4c,
-	0x63, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0d, 0x76,
-	0x70, 0x70, 0x5f, 0x6c, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x76, 0x70, 0x70, 0x4c, 0x63, 0x70, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12,
-	0x36, 0x0a, 0x17, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x63, 0x70, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6e,
-	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x15, 0x76, 0x70, 0x70, 0x4c, 0x63, 0x70, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x76, 0x70, 0x70, 0x5f, 0x6c,
-	0x63, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x70, 0x70, 0x4c, 0x63, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x12, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x15, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72,
-	0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x70,
-	0x65, 0x65, 0x72, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x66, 0x72, 0x72, 0x42,
-	0x66, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
-	0x27, 0x0a, 0x10, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x75, 0x70, 0x5f, 0x70, 0x65,
-	0x65, 0x72, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x72, 0x72, 0x42, 0x66,
-	0x64, 0x55, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x66, 0x72, 0x72, 0x5f,
-	0x62, 0x66, 0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x17,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x44, 0x6f, 0x77, 0x6e,
-	0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64,
-	0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x66,
-	0x72, 0x72, 0x42, 0x66, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x66,
-	0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x4c,
-	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x88, 0x03, 0x0a, 0x14, 0x52, 0x6f,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x44,
-	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d,
-	0x69, 0x70, 0x76, 0x34, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64,
-	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x09,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xb0, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
-	0x0a, 0x12, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x72, 0x63,
-	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x18,
-	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
-	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x16, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61,
-	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x1d, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0xb0,
-	0x01, 0x0a, 0x23, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68,
-	0x61, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x73, 0x68, 0x61, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4d,
-	0x61, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x72,
+	0x72, 0x56, 0x72, 0x72, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x26,
+	0x0a, 0x0f, 0x66, 0x72, 0x72, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72, 0x70,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x72, 0x72, 0x5f, 0x76, 0x72,
+	0x72, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x72, 0x72, 0x56, 0x72, 0x72, 0x70, 0x4c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x12, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x63, 0x70,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x76, 0x70, 0x70, 0x4c, 0x63, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0d, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x63, 0x70, 0x5f, 0x70, 0x61,
+	0x69, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x76, 0x70, 0x70, 0x4c, 0x63,
+	0x70, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x63,
+	0x70, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x76, 0x70, 0x70, 0x4c, 0x63, 0x70, 0x49,
+	0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x12, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x63, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x70, 0x70, 0x4c,
+	0x63, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x12, 0x66,
+	0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x4c,
+	0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x72, 0x5f,
+	0x62, 0x66, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x66, 0x72, 0x72, 0x42,
+	0x66, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x13, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66,
+	0x64, 0x5f, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x55, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x2b, 0x0a, 0x12, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x5f,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x66, 0x72, 0x72,
+	0x42, 0x66, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x18,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x66, 0x72, 0x72, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x66, 0x72, 0x72, 0x42, 0x66, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x22, 0x88, 0x03, 0x0a, 0x14, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x34,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x69, 0x70, 0x76, 0x36, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x52, 0x11, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x16, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65,
+	0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x1d, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x23, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f,
+	0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x43, 0x0a, 0x1e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
-	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x22, 0xb8, 0x01,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61,
-	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25,
-	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xec, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x72, 0x63,
-	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65,
-	0x6d, 0x65, 0x74, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
-	0x12, 0x3b, 0x0a, 0x1a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x33, 0x0a,
-	0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d,
-	0x69, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x4d, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x0d, 0x54, 0x65, 0x6c, 0x65,
-	0x6d, 0x65, 0x74, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x73, 0x0a, 0x19,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74,
-	0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12,
-	0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x6e, 0x63,
-	0x65, 0x22, 0xd1, 0x02, 0x0a, 0x0e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a,
-	0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xd2, 0x02, 0x0a, 0x1a, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f,
-	0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2b,
-	0x0a, 0x11, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
-	0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
-	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x65,
-	0x78, 0x74, 0x32, 0x9e, 0x08, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65,
-	0x64, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x1e, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a,
+	0x12, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x18, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa1, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63,
+	0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x45, 0x54, 0x43, 0x4f, 0x4e, 0x46, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x63, 0x6f, 0x6e, 0x66, 0x22, 0x55, 0x0a, 0x0f, 0x53,
+	0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x22, 0xb7, 0x02, 0x0a, 0x0d, 0x4e, 0x45, 0x54, 0x43, 0x4f, 0x4e, 0x46, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61,
+	0x6b, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68,
+	0x61, 0x6b, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x0b,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x3e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x44, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb8, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0xec, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x61,
+	0x78, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d,
+	0x73, 0x22, 0xc2, 0x01, 0x0a, 0x0d, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x73, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xd1, 0x02, 0x0a, 0x0e,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22,
+	0xd2, 0x02, 0x0a, 0x1a, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3c,
+	0x0a, 0x1a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x18, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x17, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x72, 0x63,
+	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x22, 0xd1, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73,
+	0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x68, 0x61, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x34, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x53, 0x48, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9f,
+	0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x74, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x22, 0x1a,
+	0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc0, 0x0b, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x63,
+	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
 	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x45, 0x64, 0x69, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x63,
-	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74,
-	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x65, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x55,
+	0x6e, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x45,
+	0x64, 0x69, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e,
+	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x72, 0x63,
+	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28,
 	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x68, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x44,
-	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1b,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x1e,
 	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x72,
-	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
-	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
-	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x20,
+	0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
 	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xf9, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x61, 0x72,
+	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x04, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x25, 0x2e,
 	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61,
-	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x56, 0x0a, 0x0b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x22,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x63,
-	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0xd7, 0x0c, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x25,
+	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf9, 0x02,
+	0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5c, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
+	0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x41, 0x63, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b,
+	0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd4, 0x10, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x72,
 	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x26, 0x2e,
 	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
-	0x6f, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x73, 0x12, 0x26, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x72, 0x63, 0x61,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47,
+	0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x2f, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x88, 0x02, 0x01, 0x12, 0x6d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88,
+	0x02, 0x01, 0x12, 0x70, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
 	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68,
-	0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x72,
-	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50,
-	0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
-	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x72,
+	0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x88, 0x02, 0x01, 0x12, 0x76, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65,
+	0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x72, 0x63, 0x61,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53,
+	0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x5b, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x72,
 	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x6d, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74,
-	0x12, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54,
-	0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x63,
+	0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x63,
 	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x70, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74,
-	0x12, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
-	0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x72,
-	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x76, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73,
-	0x54, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
-	0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x03, 0x88, 0x02, 0x01, 0x12, 0x5b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54,
-	0x65, 0x78, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50,
-	0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02,
-	0x01, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x12,
-	0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x59, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x72,
-	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x46, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x43, 0x50,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x88, 0x02, 0x01, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x43, 0x50, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28,
 	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x72,
-	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x43, 0x50, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x48, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x41, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x63,
+	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x26, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61,
 	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x48, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63,
+	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xe5, 0x04, 0x0a, 0x11, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72,
+	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
 	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe5, 0x04, 0x0a, 0x11, 0x44, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12,
-	0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x65,
-	0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12,
-	0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65,
-	0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61,
-	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47,
-	0x50, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65,
-	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x63,
-	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x71, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61,
-	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53,
-	0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54,
-	0x65, 0x78, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50,
-	0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x63,
-	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xe5, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c,
-	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x2a, 0x2e,
+	0x74, 0x42, 0x47, 0x50, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f,
+	0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
 	0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c,
-	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61,
-	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65,
-	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x61,
-	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
-	0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6b, 0x61, 0x6d, 0x31, 0x6f, 0x2f, 0x61,
-	0x72, 0x63, 0x61, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x74, 0x4f, 0x53, 0x50, 0x46, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x63,
+	0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x52, 0x52, 0x50, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x46, 0x44, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe5, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6e, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x12, 0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a,
+	0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x32, 0xcc, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2a, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x72,
+	0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x61,
+	0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x61, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6b,
+	0x61, 0x6d, 0x31, 0x6f, 0x2f, 0x61, 0x72, 0x63, 0x61, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5674,7 +7934,7 @@ func file_api_v1_router_proto_rawDescGZIP() []byte {
 	return file_api_v1_router_proto_rawDescData
 }
 
-var file_api_v1_router_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_api_v1_router_proto_msgTypes = make([]protoimpl.MessageInfo, 115)
 var file_api_v1_router_proto_goTypes = []any{
 	(*GetRunningRequest)(nil),                   // 0: arca.router.v1.GetRunningRequest
 	(*GetRunningResponse)(nil),                  // 1: arca.router.v1.GetRunningResponse
@@ -5686,179 +7946,248 @@ var file_api_v1_router_proto_goTypes = []any{
 	(*ReplaceCandidateResponse)(nil),            // 7: arca.router.v1.ReplaceCandidateResponse
 	(*CommitRequest)(nil),                       // 8: arca.router.v1.CommitRequest
 	(*CommitResponse)(nil),                      // 9: arca.router.v1.CommitResponse
-	(*ValidateCandidateRequest)(nil),            // 10: arca.router.v1.ValidateCandidateRequest
-	(*ValidateCandidateResponse)(nil),           // 11: arca.router.v1.ValidateCandidateResponse
-	(*DiscardRequest)(nil),                      // 12: arca.router.v1.DiscardRequest
-	(*DiscardResponse)(nil),                     // 13: arca.router.v1.DiscardResponse
-	(*RollbackRequest)(nil),                     // 14: arca.router.v1.RollbackRequest
-	(*RollbackResponse)(nil),                    // 15: arca.router.v1.RollbackResponse
-	(*DiffRequest)(nil),                         // 16: arca.router.v1.DiffRequest
-	(*DiffResponse)(nil),                        // 17: arca.router.v1.DiffResponse
-	(*ListHistoryRequest)(nil),                  // 18: arca.router.v1.ListHistoryRequest
-	(*ListHistoryResponse)(nil),                 // 19: arca.router.v1.ListHistoryResponse
-	(*CommitEntry)(nil),                         // 20: arca.router.v1.CommitEntry
-	(*CreateSessionRequest)(nil),                // 21: arca.router.v1.CreateSessionRequest
-	(*CreateSessionResponse)(nil),               // 22: arca.router.v1.CreateSessionResponse
-	(*CloseSessionRequest)(nil),                 // 23: arca.router.v1.CloseSessionRequest
-	(*CloseSessionResponse)(nil),                // 24: arca.router.v1.CloseSessionResponse
-	(*AcquireLockRequest)(nil),                  // 25: arca.router.v1.AcquireLockRequest
-	(*AcquireLockResponse)(nil),                 // 26: arca.router.v1.AcquireLockResponse
-	(*ReleaseLockRequest)(nil),                  // 27: arca.router.v1.ReleaseLockRequest
-	(*ReleaseLockResponse)(nil),                 // 28: arca.router.v1.ReleaseLockResponse
-	(*GetInterfacesRequest)(nil),                // 29: arca.router.v1.GetInterfacesRequest
-	(*GetInterfacesResponse)(nil),               // 30: arca.router.v1.GetInterfacesResponse
-	(*InterfaceState)(nil),                      // 31: arca.router.v1.InterfaceState
-	(*InterfaceRxQueue)(nil),                    // 32: arca.router.v1.InterfaceRxQueue
-	(*InterfaceTxQueue)(nil),                    // 33: arca.router.v1.InterfaceTxQueue
-	(*GetRoutesRequest)(nil),                    // 34: arca.router.v1.GetRoutesRequest
-	(*GetRoutesResponse)(nil),                   // 35: arca.router.v1.GetRoutesResponse
-	(*RouteEntry)(nil),                          // 36: arca.router.v1.RouteEntry
-	(*GetBGPNeighborsRequest)(nil),              // 37: arca.router.v1.GetBGPNeighborsRequest
-	(*GetBGPNeighborsResponse)(nil),             // 38: arca.router.v1.GetBGPNeighborsResponse
-	(*BGPNeighborState)(nil),                    // 39: arca.router.v1.BGPNeighborState
-	(*GetOSPFNeighborsRequest)(nil),             // 40: arca.router.v1.GetOSPFNeighborsRequest
-	(*GetOSPFNeighborsResponse)(nil),            // 41: arca.router.v1.GetOSPFNeighborsResponse
-	(*OSPFNeighborState)(nil),                   // 42: arca.router.v1.OSPFNeighborState
-	(*GetRouteTextRequest)(nil),                 // 43: arca.router.v1.GetRouteTextRequest
-	(*GetRouteTextResponse)(nil),                // 44: arca.router.v1.GetRouteTextResponse
-	(*GetBGPSummaryTextRequest)(nil),            // 45: arca.router.v1.GetBGPSummaryTextRequest
-	(*GetBGPSummaryTextResponse)(nil),           // 46: arca.router.v1.GetBGPSummaryTextResponse
-	(*GetBGPNeighborTextRequest)(nil),           // 47: arca.router.v1.GetBGPNeighborTextRequest
-	(*GetBGPNeighborTextResponse)(nil),          // 48: arca.router.v1.GetBGPNeighborTextResponse
-	(*GetOSPFNeighborsTextRequest)(nil),         // 49: arca.router.v1.GetOSPFNeighborsTextRequest
-	(*GetOSPFNeighborsTextResponse)(nil),        // 50: arca.router.v1.GetOSPFNeighborsTextResponse
-	(*GetVRRPTextRequest)(nil),                  // 51: arca.router.v1.GetVRRPTextRequest
-	(*GetVRRPTextResponse)(nil),                 // 52: arca.router.v1.GetVRRPTextResponse
-	(*GetBFDTextRequest)(nil),                   // 53: arca.router.v1.GetBFDTextRequest
-	(*GetBFDTextResponse)(nil),                  // 54: arca.router.v1.GetBFDTextResponse
-	(*GetBFDStatusRequest)(nil),                 // 55: arca.router.v1.GetBFDStatusRequest
-	(*GetBFDStatusResponse)(nil),                // 56: arca.router.v1.GetBFDStatusResponse
-	(*BFDPeerState)(nil),                        // 57: arca.router.v1.BFDPeerState
-	(*GetLCPReconciliationRequest)(nil),         // 58: arca.router.v1.GetLCPReconciliationRequest
-	(*GetLCPReconciliationResponse)(nil),        // 59: arca.router.v1.GetLCPReconciliationResponse
-	(*GetHAStatusRequest)(nil),                  // 60: arca.router.v1.GetHAStatusRequest
-	(*GetHAStatusResponse)(nil),                 // 61: arca.router.v1.GetHAStatusResponse
-	(*GetRoutingInstancesRequest)(nil),          // 62: arca.router.v1.GetRoutingInstancesRequest
-	(*GetRoutingInstancesResponse)(nil),         // 63: arca.router.v1.GetRoutingInstancesResponse
-	(*RoutingInstanceState)(nil),                // 64: arca.router.v1.RoutingInstanceState
-	(*GetClassOfServiceRequest)(nil),            // 65: arca.router.v1.GetClassOfServiceRequest
-	(*GetClassOfServiceResponse)(nil),           // 66: arca.router.v1.GetClassOfServiceResponse
-	(*ClassOfServiceForwardingClass)(nil),       // 67: arca.router.v1.ClassOfServiceForwardingClass
-	(*ClassOfServiceTrafficControlProfile)(nil), // 68: arca.router.v1.ClassOfServiceTrafficControlProfile
-	(*ClassOfServiceInterface)(nil),             // 69: arca.router.v1.ClassOfServiceInterface
-	(*GetSystemInfoRequest)(nil),                // 70: arca.router.v1.GetSystemInfoRequest
-	(*GetSystemInfoResponse)(nil),               // 71: arca.router.v1.GetSystemInfoResponse
-	(*GetTelemetryCatalogRequest)(nil),          // 72: arca.router.v1.GetTelemetryCatalogRequest
-	(*GetTelemetryCatalogResponse)(nil),         // 73: arca.router.v1.GetTelemetryCatalogResponse
-	(*TelemetryPath)(nil),                       // 74: arca.router.v1.TelemetryPath
-	(*SubscribeTelemetryRequest)(nil),           // 75: arca.router.v1.SubscribeTelemetryRequest
-	(*TelemetryEvent)(nil),                      // 76: arca.router.v1.TelemetryEvent
-	(*ClassOfServiceCapabilities)(nil),          // 77: arca.router.v1.ClassOfServiceCapabilities
-	(*GetCommitRequest)(nil),                    // 78: arca.router.v1.GetCommitRequest
-	(*GetCommitResponse)(nil),                   // 79: arca.router.v1.GetCommitResponse
-	(*CommitDetail)(nil),                        // 80: arca.router.v1.CommitDetail
+	(*ScheduledCommit)(nil),                     // 10: arca.router.v1.ScheduledCommit
+	(*GetScheduledCommitRequest)(nil),           // 11: arca.router.v1.GetScheduledCommitRequest
+	(*GetScheduledCommitResponse)(nil),          // 12: arca.router.v1.GetScheduledCommitResponse
+	(*ClearScheduledCommitRequest)(nil),         // 13: arca.router.v1.ClearScheduledCommitRequest
+	(*ClearScheduledCommitResponse)(nil),        // 14: arca.router.v1.ClearScheduledCommitResponse
+	(*CheckDatastoreRequest)(nil),               // 15: arca.router.v1.CheckDatastoreRequest
+	(*DatastoreIssue)(nil),                      // 16: arca.router.v1.DatastoreIssue
+	(*CheckDatastoreResponse)(nil),              // 17: arca.router.v1.CheckDatastoreResponse
+	(*ValidateCandidateRequest)(nil),            // 18: arca.router.v1.ValidateCandidateRequest
+	(*ValidateCandidateResponse)(nil),           // 19: arca.router.v1.ValidateCandidateResponse
+	(*DiscardRequest)(nil),                      // 20: arca.router.v1.DiscardRequest
+	(*DiscardResponse)(nil),                     // 21: arca.router.v1.DiscardResponse
+	(*RollbackRequest)(nil),                     // 22: arca.router.v1.RollbackRequest
+	(*RollbackResponse)(nil),                    // 23: arca.router.v1.RollbackResponse
+	(*DiffRequest)(nil),                         // 24: arca.router.v1.DiffRequest
+	(*DiffResponse)(nil),                        // 25: arca.router.v1.DiffResponse
+	(*ListHistoryRequest)(nil),                  // 26: arca.router.v1.ListHistoryRequest
+	(*ListHistoryResponse)(nil),                 // 27: arca.router.v1.ListHistoryResponse
+	(*CommitEntry)(nil),                         // 28: arca.router.v1.CommitEntry
+	(*CreateSessionRequest)(nil),                // 29: arca.router.v1.CreateSessionRequest
+	(*CreateSessionResponse)(nil),               // 30: arca.router.v1.CreateSessionResponse
+	(*CloseSessionRequest)(nil),                 // 31: arca.router.v1.CloseSessionRequest
+	(*CloseSessionResponse)(nil),                // 32: arca.router.v1.CloseSessionResponse
+	(*AcquireLockRequest)(nil),                  // 33: arca.router.v1.AcquireLockRequest
+	(*AcquireLockResponse)(nil),                 // 34: arca.router.v1.AcquireLockResponse
+	(*ReleaseLockRequest)(nil),                  // 35: arca.router.v1.ReleaseLockRequest
+	(*ReleaseLockResponse)(nil),                 // 36: arca.router.v1.ReleaseLockResponse
+	(*GetInterfacesRequest)(nil),                // 37: arca.router.v1.GetInterfacesRequest
+	(*GetInterfacesResponse)(nil),               // 38: arca.router.v1.GetInterfacesResponse
+	(*InterfaceState)(nil),                      // 39: arca.router.v1.InterfaceState
+	(*InterfaceRxQueue)(nil),                    // 40: arca.router.v1.InterfaceRxQueue
+	(*InterfaceTxQueue)(nil),                    // 41: arca.router.v1.InterfaceTxQueue
+	(*GetRoutesRequest)(nil),                    // 42: arca.router.v1.GetRoutesRequest
+	(*GetRoutesResponse)(nil),                   // 43: arca.router.v1.GetRoutesResponse
+	(*RouteEntry)(nil),                          // 44: arca.router.v1.RouteEntry
+	(*GetRouteSummaryRequest)(nil),              // 45: arca.router.v1.GetRouteSummaryRequest
+	(*GetRouteSummaryResponse)(nil),             // 46: arca.router.v1.GetRouteSummaryResponse
+	(*RouteFamilySummary)(nil),                  // 47: arca.router.v1.RouteFamilySummary
+	(*RouteProtocolCount)(nil),                  // 48: arca.router.v1.RouteProtocolCount
+	(*GetBGPNeighborsRequest)(nil),              // 49: arca.router.v1.GetBGPNeighborsRequest
+	(*GetBGPNeighborsResponse)(nil),             // 50: arca.router.v1.GetBGPNeighborsResponse
+	(*BGPNeighborState)(nil),                    // 51: arca.router.v1.BGPNeighborState
+	(*GetOSPFNeighborsRequest)(nil),             // 52: arca.router.v1.GetOSPFNeighborsRequest
+	(*GetOSPFNeighborsResponse)(nil),            // 53: arca.router.v1.GetOSPFNeighborsResponse
+	(*OSPFNeighborState)(nil),                   // 54: arca.router.v1.OSPFNeighborState
+	(*GetNeighborsRequest)(nil),                 // 55: arca.router.v1.GetNeighborsRequest
+	(*GetNeighborsResponse)(nil),                // 56: arca.router.v1.GetNeighborsResponse
+	(*NeighborState)(nil),                       // 57: arca.router.v1.NeighborState
+	(*ClearInterfaceStatisticsRequest)(nil),     // 58: arca.router.v1.ClearInterfaceStatisticsRequest
+	(*ClearInterfaceStatisticsResponse)(nil),    // 59: arca.router.v1.ClearInterfaceStatisticsResponse
+	(*GetRouteTextRequest)(nil),                 // 60: arca.router.v1.GetRouteTextRequest
+	(*GetRouteTextResponse)(nil),                // 61: arca.router.v1.GetRouteTextResponse
+	(*GetBGPSummaryTextRequest)(nil),            // 62: arca.router.v1.GetBGPSummaryTextRequest
+	(*GetBGPSummaryTextResponse)(nil),           // 63: arca.router.v1.GetBGPSummaryTextResponse
+	(*GetBGPNeighborTextRequest)(nil),           // 64: arca.router.v1.GetBGPNeighborTextRequest
+	(*GetBGPNeighborTextResponse)(nil),          // 65: arca.router.v1.GetBGPNeighborTextResponse
+	(*GetOSPFNeighborsTextRequest)(nil),         // 66: arca.router.v1.GetOSPFNeighborsTextRequest
+	(*GetOSPFNeighborsTextResponse)(nil),        // 67: arca.router.v1.GetOSPFNeighborsTextResponse
+	(*GetVRRPTextRequest)(nil),                  // 68: arca.router.v1.GetVRRPTextRequest
+	(*GetVRRPTextResponse)(nil),                 // 69: arca.router.v1.GetVRRPTextResponse
+	(*GetBFDTextRequest)(nil),                   // 70: arca.router.v1.GetBFDTextRequest
+	(*GetBFDTextResponse)(nil),                  // 71: arca.router.v1.GetBFDTextResponse
+	(*GetBFDStatusRequest)(nil),                 // 72: arca.router.v1.GetBFDStatusRequest
+	(*GetBFDStatusResponse)(nil),                // 73: arca.router.v1.GetBFDStatusResponse
+	(*BFDPeerState)(nil),                        // 74: arca.router.v1.BFDPeerState
+	(*GetLCPReconciliationRequest)(nil),         // 75: arca.router.v1.GetLCPReconciliationRequest
+	(*GetLCPReconciliationResponse)(nil),        // 76: arca.router.v1.GetLCPReconciliationResponse
+	(*GetHAStatusRequest)(nil),                  // 77: arca.router.v1.GetHAStatusRequest
+	(*GetHAStatusResponse)(nil),                 // 78: arca.router.v1.GetHAStatusResponse
+	(*GetRoutingInstancesRequest)(nil),          // 79: arca.router.v1.GetRoutingInstancesRequest
+	(*GetRoutingInstancesResponse)(nil),         // 80: arca.router.v1.GetRoutingInstancesResponse
+	(*RoutingInstanceState)(nil),                // 81: arca.router.v1.RoutingInstanceState
+	(*GetClassOfServiceRequest)(nil),            // 82: arca.router.v1.GetClassOfServiceRequest
+	(*GetClassOfServiceResponse)(nil),           // 83: arca.router.v1.GetClassOfServiceResponse
+	(*ClassOfServiceForwardingClass)(nil),       // 84: arca.router.v1.ClassOfServiceForwardingClass
+	(*ClassOfServiceTrafficControlProfile)(nil), // 85: arca.router.v1.ClassOfServiceTrafficControlProfile
+	(*ClassOfServiceInterface)(nil),             // 86: arca.router.v1.ClassOfServiceInterface
+	(*GetSystemInfoRequest)(nil),                // 87: arca.router.v1.GetSystemInfoRequest
+	(*GetSystemInfoResponse)(nil),               // 88: arca.router.v1.GetSystemInfoResponse
+	(*GetSystemHealthRequest)(nil),              // 89: arca.router.v1.GetSystemHealthRequest
+	(*GetSystemHealthResponse)(nil),             // 90: arca.router.v1.GetSystemHealthResponse
+	(*SubsystemHealth)(nil),                     // 91: arca.router.v1.SubsystemHealth
+	(*NETCONFHealth)(nil),                       // 92: arca.router.v1.NETCONFHealth
+	(*GetLastApplyRequest)(nil),                 // 93: arca.router.v1.GetLastApplyRequest
+	(*GetLastApplyResponse)(nil),                // 94: arca.router.v1.GetLastApplyResponse
+	(*PluginApply)(nil),                         // 95: arca.router.v1.PluginApply
+	(*ApplyOperation)(nil),                      // 96: arca.router.v1.ApplyOperation
+	(*GetTelemetryCatalogRequest)(nil),          // 97: arca.router.v1.GetTelemetryCatalogRequest
+	(*GetTelemetryCatalogResponse)(nil),         // 98: arca.router.v1.GetTelemetryCatalogResponse
+	(*TelemetryPath)(nil),                       // 99: arca.router.v1.TelemetryPath
+	(*SubscribeTelemetryRequest)(nil),           // 100: arca.router.v1.SubscribeTelemetryRequest
+	(*TelemetryEvent)(nil),                      // 101: arca.router.v1.TelemetryEvent
+	(*ClassOfServiceCapabilities)(nil),          // 102: arca.router.v1.ClassOfServiceCapabilities
+	(*GetCommitRequest)(nil),                    // 103: arca.router.v1.GetCommitRequest
+	(*GetCommitResponse)(nil),                   // 104: arca.router.v1.GetCommitResponse
+	(*CommitDetail)(nil),                        // 105: arca.router.v1.CommitDetail
+	(*CompareCommitsRequest)(nil),               // 106: arca.router.v1.CompareCommitsRequest
+	(*CompareCommitsResponse)(nil),              // 107: arca.router.v1.CompareCommitsResponse
+	(*ListUserSSHKeysRequest)(nil),              // 108: arca.router.v1.ListUserSSHKeysRequest
+	(*ListUserSSHKeysResponse)(nil),             // 109: arca.router.v1.ListUserSSHKeysResponse
+	(*UserSSHKey)(nil),                          // 110: arca.router.v1.UserSSHKey
+	(*SetUserSSHKeyStatusRequest)(nil),          // 111: arca.router.v1.SetUserSSHKeyStatusRequest
+	(*SetUserSSHKeyStatusResponse)(nil),         // 112: arca.router.v1.SetUserSSHKeyStatusResponse
+	(*RemoveUserSSHKeyRequest)(nil),             // 113: arca.router.v1.RemoveUserSSHKeyRequest
+	(*RemoveUserSSHKeyResponse)(nil),            // 114: arca.router.v1.RemoveUserSSHKeyResponse
 }
 var file_api_v1_router_proto_depIdxs = []int32{
-	20, // 0: arca.router.v1.ListHistoryResponse.entries:type_name -> arca.router.v1.CommitEntry
-	31, // 1: arca.router.v1.GetInterfacesResponse.interfaces:type_name -> arca.router.v1.InterfaceState
-	32, // 2: arca.router.v1.InterfaceState.rx_queues:type_name -> arca.router.v1.InterfaceRxQueue
-	33, // 3: arca.router.v1.InterfaceState.tx_queues:type_name -> arca.router.v1.InterfaceTxQueue
-	36, // 4: arca.router.v1.GetRoutesResponse.routes:type_name -> arca.router.v1.RouteEntry
-	39, // 5: arca.router.v1.GetBGPNeighborsResponse.neighbors:type_name -> arca.router.v1.BGPNeighborState
-	42, // 6: arca.router.v1.GetOSPFNeighborsResponse.neighbors:type_name -> arca.router.v1.OSPFNeighborState
-	57, // 7: arca.router.v1.GetBFDStatusResponse.peers:type_name -> arca.router.v1.BFDPeerState
-	64, // 8: arca.router.v1.GetRoutingInstancesResponse.instances:type_name -> arca.router.v1.RoutingInstanceState
-	67, // 9: arca.router.v1.GetClassOfServiceResponse.forwarding_classes:type_name -> arca.router.v1.ClassOfServiceForwardingClass
-	68, // 10: arca.router.v1.GetClassOfServiceResponse.traffic_control_profiles:type_name -> arca.router.v1.ClassOfServiceTrafficControlProfile
-	69, // 11: arca.router.v1.GetClassOfServiceResponse.interfaces:type_name -> arca.router.v1.ClassOfServiceInterface
-	77, // 12: arca.router.v1.GetClassOfServiceResponse.capabilities:type_name -> arca.router.v1.ClassOfServiceCapabilities
-	74, // 13: arca.router.v1.GetTelemetryCatalogResponse.paths:type_name -> arca.router.v1.TelemetryPath
-	80, // 14: arca.router.v1.GetCommitResponse.commit:type_name -> arca.router.v1.CommitDetail
-	0,  // 15: arca.router.v1.ConfigService.GetRunning:input_type -> arca.router.v1.GetRunningRequest
-	0,  // 16: arca.router.v1.ConfigService.GetRunningUnredacted:input_type -> arca.router.v1.GetRunningRequest
-	2,  // 17: arca.router.v1.ConfigService.GetCandidate:input_type -> arca.router.v1.GetCandidateRequest
-	4,  // 18: arca.router.v1.ConfigService.EditCandidate:input_type -> arca.router.v1.EditCandidateRequest
-	6,  // 19: arca.router.v1.ConfigService.ReplaceCandidate:input_type -> arca.router.v1.ReplaceCandidateRequest
-	8,  // 20: arca.router.v1.ConfigService.Commit:input_type -> arca.router.v1.CommitRequest
-	10, // 21: arca.router.v1.ConfigService.ValidateCandidate:input_type -> arca.router.v1.ValidateCandidateRequest
-	12, // 22: arca.router.v1.ConfigService.Discard:input_type -> arca.router.v1.DiscardRequest
-	14, // 23: arca.router.v1.ConfigService.Rollback:input_type -> arca.router.v1.RollbackRequest
-	16, // 24: arca.router.v1.ConfigService.Diff:input_type -> arca.router.v1.DiffRequest
-	18, // 25: arca.router.v1.ConfigService.ListHistory:input_type -> arca.router.v1.ListHistoryRequest
-	78, // 26: arca.router.v1.ConfigService.GetCommit:input_type -> arca.router.v1.GetCommitRequest
-	21, // 27: arca.router.v1.SessionService.CreateSession:input_type -> arca.router.v1.CreateSessionRequest
-	23, // 28: arca.router.v1.SessionService.CloseSession:input_type -> arca.router.v1.CloseSessionRequest
-	25, // 29: arca.router.v1.SessionService.AcquireLock:input_type -> arca.router.v1.AcquireLockRequest
-	27, // 30: arca.router.v1.SessionService.ReleaseLock:input_type -> arca.router.v1.ReleaseLockRequest
-	29, // 31: arca.router.v1.StateService.GetInterfaces:input_type -> arca.router.v1.GetInterfacesRequest
-	34, // 32: arca.router.v1.StateService.GetRoutes:input_type -> arca.router.v1.GetRoutesRequest
-	37, // 33: arca.router.v1.StateService.GetBGPNeighbors:input_type -> arca.router.v1.GetBGPNeighborsRequest
-	40, // 34: arca.router.v1.StateService.GetOSPFNeighbors:input_type -> arca.router.v1.GetOSPFNeighborsRequest
-	43, // 35: arca.router.v1.StateService.GetRouteText:input_type -> arca.router.v1.GetRouteTextRequest
-	45, // 36: arca.router.v1.StateService.GetBGPSummaryText:input_type -> arca.router.v1.GetBGPSummaryTextRequest
-	47, // 37: arca.router.v1.StateService.GetBGPNeighborText:input_type -> arca.router.v1.GetBGPNeighborTextRequest
-	49, // 38: arca.router.v1.StateService.GetOSPFNeighborsText:input_type -> arca.router.v1.GetOSPFNeighborsTextRequest
-	51, // 39: arca.router.v1.StateService.GetVRRPText:input_type -> arca.router.v1.GetVRRPTextRequest
-	53, // 40: arca.router.v1.StateService.GetBFDText:input_type -> arca.router.v1.GetBFDTextRequest
-	55, // 41: arca.router.v1.StateService.GetBFDStatus:input_type -> arca.router.v1.GetBFDStatusRequest
-	58, // 42: arca.router.v1.StateService.GetLCPReconciliation:input_type -> arca.router.v1.GetLCPReconciliationRequest
-	60, // 43: arca.router.v1.StateService.GetHAStatus:input_type -> arca.router.v1.GetHAStatusRequest
-	62, // 44: arca.router.v1.StateService.GetRoutingInstances:input_type -> arca.router.v1.GetRoutingInstancesRequest
-	65, // 45: arca.router.v1.StateService.GetClassOfService:input_type -> arca.router.v1.GetClassOfServiceRequest
-	70, // 46: arca.router.v1.StateService.GetSystemInfo:input_type -> arca.router.v1.GetSystemInfoRequest
-	43, // 47: arca.router.v1.DiagnosticService.GetRouteText:input_type -> arca.router.v1.GetRouteTextRequest
-	45, // 48: arca.router.v1.DiagnosticService.GetBGPSummaryText:input_type -> arca.router.v1.GetBGPSummaryTextRequest
-	47, // 49: arca.router.v1.DiagnosticService.GetBGPNeighborText:input_type -> arca.router.v1.GetBGPNeighborTextRequest
-	49, // 50: arca.router.v1.DiagnosticService.GetOSPFNeighborsText:input_type -> arca.router.v1.GetOSPFNeighborsTextRequest
-	51, // 51: arca.router.v1.DiagnosticService.GetVRRPText:input_type -> arca.router.v1.GetVRRPTextRequest
-	53, // 52: arca.router.v1.DiagnosticService.GetBFDText:input_type -> arca.router.v1.GetBFDTextRequest
-	72, // 53: arca.router.v1.TelemetryService.GetTelemetryCatalog:input_type -> arca.router.v1.GetTelemetryCatalogRequest
-	75, // 54: arca.router.v1.TelemetryService.SubscribeTelemetry:input_type -> arca.router.v1.SubscribeTelemetryRequest
-	1,  // 55: arca.router.v1.ConfigService.GetRunning:output_type -> arca.router.v1.GetRunningResponse
-	1,  // 56: arca.router.v1.ConfigService.GetRunningUnredacted:output_type -> arca.router.v1.GetRunningResponse
-	3,  // 57: arca.router.v1.ConfigService.GetCandidate:output_type -> arca.router.v1.GetCandidateResponse
-	5,  // 58: arca.router.v1.ConfigService.EditCandidate:output_type -> arca.router.v1.EditCandidateResponse
-	7,  // 59: arca.router.v1.ConfigService.ReplaceCandidate:output_type -> arca.router.v1.ReplaceCandidateResponse
-	9,  // 60: arca.router.v1.ConfigService.Commit:output_type -> arca.router.v1.CommitResponse
-	11, // 61: arca.router.v1.ConfigService.ValidateCandidate:output_type -> arca.router.v1.ValidateCandidateResponse
-	13, // 62: arca.router.v1.ConfigService.Discard:output_type -> arca.router.v1.DiscardResponse
-	15, // 63: arca.router.v1.ConfigService.Rollback:output_type -> arca.router.v1.RollbackResponse
-	17, // 64: arca.router.v1.ConfigService.Diff:output_type -> arca.router.v1.DiffResponse
-	19, // 65: arca.router.v1.ConfigService.ListHistory:output_type -> arca.router.v1.ListHistoryResponse
-	79, // 66: arca.router.v1.ConfigService.GetCommit:output_type -> arca.router.v1.GetCommitResponse
-	22, // 67: arca.router.v1.SessionService.CreateSession:output_type -> arca.router.v1.CreateSessionResponse
-	24, // 68: arca.router.v1.SessionService.CloseSession:output_type -> arca.router.v1.CloseSessionResponse
-	26, // 69: arca.router.v1.SessionService.AcquireLock:output_type -> arca.router.v1.AcquireLockResponse
-	28, // 70: arca.router.v1.SessionService.ReleaseLock:output_type -> arca.router.v1.ReleaseLockResponse
-	30, // 71: arca.router.v1.StateService.GetInterfaces:output_type -> arca.router.v1.GetInterfacesResponse
-	35, // 72: arca.router.v1.StateService.GetRoutes:output_type -> arca.router.v1.GetRoutesResponse
-	38, // 73: arca.router.v1.StateService.GetBGPNeighbors:output_type -> arca.router.v1.GetBGPNeighborsResponse
-	41, // 74: arca.router.v1.StateService.GetOSPFNeighbors:output_type -> arca.router.v1.GetOSPFNeighborsResponse
-	44, // 75: arca.router.v1.StateService.GetRouteText:output_type -> arca.router.v1.GetRouteTextResponse
-	46, // 76: arca.router.v1.StateService.GetBGPSummaryText:output_type -> arca.router.v1.GetBGPSummaryTextResponse
-	48, // 77: arca.router.v1.StateService.GetBGPNeighborText:output_type -> arca.router.v1.GetBGPNeighborTextResponse
-	50, // 78: arca.router.v1.StateService.GetOSPFNeighborsText:output_type -> arca.router.v1.GetOSPFNeighborsTextResponse
-	52, // 79: arca.router.v1.StateService.GetVRRPText:output_type -> arca.router.v1.GetVRRPTextResponse
-	54, // 80: arca.router.v1.StateService.GetBFDText:output_type -> arca.router.v1.GetBFDTextResponse
-	56, // 81: arca.router.v1.StateService.GetBFDStatus:output_type -> arca.router.v1.GetBFDStatusResponse
-	59, // 82: arca.router.v1.StateService.GetLCPReconciliation:output_type -> arca.router.v1.GetLCPReconciliationResponse
-	61, // 83: arca.router.v1.StateService.GetHAStatus:output_type -> arca.router.v1.GetHAStatusResponse
-	63, // 84: arca.router.v1.StateService.GetRoutingInstances:output_type -> arca.router.v1.GetRoutingInstancesResponse
-	66, // 85: arca.router.v1.StateService.GetClassOfService:output_type -> arca.router.v1.GetClassOfServiceResponse
-	71, // 86: arca.router.v1.StateService.GetSystemInfo:output_type -> arca.router.v1.GetSystemInfoResponse
-	44, // 87: arca.router.v1.DiagnosticService.GetRouteText:output_type -> arca.router.v1.GetRouteTextResponse
-	46, // 88: arca.router.v1.DiagnosticService.GetBGPSummaryText:output_type -> arca.router.v1.GetBGPSummaryTextResponse
-	48, // 89: arca.router.v1.DiagnosticService.GetBGPNeighborText:output_type -> arca.router.v1.GetBGPNeighborTextResponse
-	50, // 90: arca.router.v1.DiagnosticService.GetOSPFNeighborsText:output_type -> arca.router.v1.GetOSPFNeighborsTextResponse
-	52, // 91: arca.router.v1.DiagnosticService.GetVRRPText:output_type -> arca.router.v1.GetVRRPTextResponse
-	54, // 92: arca.router.v1.DiagnosticService.GetBFDText:output_type -> arca.router.v1.GetBFDTextResponse
-	73, // 93: arca.router.v1.TelemetryService.GetTelemetryCatalog:output_type -> arca.router.v1.GetTelemetryCatalogResponse
-	76, // 94: arca.router.v1.TelemetryService.SubscribeTelemetry:output_type -> arca.router.v1.TelemetryEvent
-	55, // [55:95] is the sub-list for method output_type
-	15, // [15:55] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	10,  // 0: arca.router.v1.GetScheduledCommitResponse.scheduled_commit:type_name -> arca.router.v1.ScheduledCommit
+	10,  // 1: arca.router.v1.ClearScheduledCommitResponse.scheduled_commit:type_name -> arca.router.v1.ScheduledCommit
+	16,  // 2: arca.router.v1.CheckDatastoreResponse.issues:type_name -> arca.router.v1.DatastoreIssue
+	28,  // 3: arca.router.v1.ListHistoryResponse.entries:type_name -> arca.router.v1.CommitEntry
+	39,  // 4: arca.router.v1.GetInterfacesResponse.interfaces:type_name -> arca.router.v1.InterfaceState
+	40,  // 5: arca.router.v1.InterfaceState.rx_queues:type_name -> arca.router.v1.InterfaceRxQueue
+	41,  // 6: arca.router.v1.InterfaceState.tx_queues:type_name -> arca.router.v1.InterfaceTxQueue
+	44,  // 7: arca.router.v1.GetRoutesResponse.routes:type_name -> arca.router.v1.RouteEntry
+	47,  // 8: arca.router.v1.GetRouteSummaryResponse.families:type_name -> arca.router.v1.RouteFamilySummary
+	48,  // 9: arca.router.v1.RouteFamilySummary.protocols:type_name -> arca.router.v1.RouteProtocolCount
+	51,  // 10: arca.router.v1.GetBGPNeighborsResponse.neighbors:type_name -> arca.router.v1.BGPNeighborState
+	54,  // 11: arca.router.v1.GetOSPFNeighborsResponse.neighbors:type_name -> arca.router.v1.OSPFNeighborState
+	57,  // 12: arca.router.v1.GetNeighborsResponse.neighbors:type_name -> arca.router.v1.NeighborState
+	74,  // 13: arca.router.v1.GetBFDStatusResponse.peers:type_name -> arca.router.v1.BFDPeerState
+	81,  // 14: arca.router.v1.GetRoutingInstancesResponse.instances:type_name -> arca.router.v1.RoutingInstanceState
+	84,  // 15: arca.router.v1.GetClassOfServiceResponse.forwarding_classes:type_name -> arca.router.v1.ClassOfServiceForwardingClass
+	85,  // 16: arca.router.v1.GetClassOfServiceResponse.traffic_control_profiles:type_name -> arca.router.v1.ClassOfServiceTrafficControlProfile
+	86,  // 17: arca.router.v1.GetClassOfServiceResponse.interfaces:type_name -> arca.router.v1.ClassOfServiceInterface
+	102, // 18: arca.router.v1.GetClassOfServiceResponse.capabilities:type_name -> arca.router.v1.ClassOfServiceCapabilities
+	91,  // 19: arca.router.v1.GetSystemHealthResponse.subsystems:type_name -> arca.router.v1.SubsystemHealth
+	92,  // 20: arca.router.v1.GetSystemHealthResponse.netconf:type_name -> arca.router.v1.NETCONFHealth
+	95,  // 21: arca.router.v1.GetLastApplyResponse.plugins:type_name -> arca.router.v1.PluginApply
+	96,  // 22: arca.router.v1.PluginApply.operations:type_name -> arca.router.v1.ApplyOperation
+	99,  // 23: arca.router.v1.GetTelemetryCatalogResponse.paths:type_name -> arca.router.v1.TelemetryPath
+	105, // 24: arca.router.v1.GetCommitResponse.commit:type_name -> arca.router.v1.CommitDetail
+	110, // 25: arca.router.v1.ListUserSSHKeysResponse.keys:type_name -> arca.router.v1.UserSSHKey
+	0,   // 26: arca.router.v1.ConfigService.GetRunning:input_type -> arca.router.v1.GetRunningRequest
+	0,   // 27: arca.router.v1.ConfigService.GetRunningUnredacted:input_type -> arca.router.v1.GetRunningRequest
+	2,   // 28: arca.router.v1.ConfigService.GetCandidate:input_type -> arca.router.v1.GetCandidateRequest
+	4,   // 29: arca.router.v1.ConfigService.EditCandidate:input_type -> arca.router.v1.EditCandidateRequest
+	6,   // 30: arca.router.v1.ConfigService.ReplaceCandidate:input_type -> arca.router.v1.ReplaceCandidateRequest
+	8,   // 31: arca.router.v1.ConfigService.Commit:input_type -> arca.router.v1.CommitRequest
+	18,  // 32: arca.router.v1.ConfigService.ValidateCandidate:input_type -> arca.router.v1.ValidateCandidateRequest
+	20,  // 33: arca.router.v1.ConfigService.Discard:input_type -> arca.router.v1.DiscardRequest
+	22,  // 34: arca.router.v1.ConfigService.Rollback:input_type -> arca.router.v1.RollbackRequest
+	24,  // 35: arca.router.v1.ConfigService.Diff:input_type -> arca.router.v1.DiffRequest
+	26,  // 36: arca.router.v1.ConfigService.ListHistory:input_type -> arca.router.v1.ListHistoryRequest
+	103, // 37: arca.router.v1.ConfigService.GetCommit:input_type -> arca.router.v1.GetCommitRequest
+	106, // 38: arca.router.v1.ConfigService.CompareCommits:input_type -> arca.router.v1.CompareCommitsRequest
+	11,  // 39: arca.router.v1.ConfigService.GetScheduledCommit:input_type -> arca.router.v1.GetScheduledCommitRequest
+	13,  // 40: arca.router.v1.ConfigService.ClearScheduledCommit:input_type -> arca.router.v1.ClearScheduledCommitRequest
+	15,  // 41: arca.router.v1.ConfigService.CheckDatastore:input_type -> arca.router.v1.CheckDatastoreRequest
+	29,  // 42: arca.router.v1.SessionService.CreateSession:input_type -> arca.router.v1.CreateSessionRequest
+	31,  // 43: arca.router.v1.SessionService.CloseSession:input_type -> arca.router.v1.CloseSessionRequest
+	33,  // 44: arca.router.v1.SessionService.AcquireLock:input_type -> arca.router.v1.AcquireLockRequest
+	35,  // 45: arca.router.v1.SessionService.ReleaseLock:input_type -> arca.router.v1.ReleaseLockRequest
+	37,  // 46: arca.router.v1.StateService.GetInterfaces:input_type -> arca.router.v1.GetInterfacesRequest
+	42,  // 47: arca.router.v1.StateService.GetRoutes:input_type -> arca.router.v1.GetRoutesRequest
+	45,  // 48: arca.router.v1.StateService.GetRouteSummary:input_type -> arca.router.v1.GetRouteSummaryRequest
+	49,  // 49: arca.router.v1.StateService.GetBGPNeighbors:input_type -> arca.router.v1.GetBGPNeighborsRequest
+	52,  // 50: arca.router.v1.StateService.GetOSPFNeighbors:input_type -> arca.router.v1.GetOSPFNeighborsRequest
+	55,  // 51: arca.router.v1.StateService.GetNeighbors:input_type -> arca.router.v1.GetNeighborsRequest
+	58,  // 52: arca.router.v1.StateService.ClearInterfaceStatistics:input_type -> arca.router.v1.ClearInterfaceStatisticsRequest
+	60,  // 53: arca.router.v1.StateService.GetRouteText:input_type -> arca.router.v1.GetRouteTextRequest
+	62,  // 54: arca.router.v1.StateService.GetBGPSummaryText:input_type -> arca.router.v1.GetBGPSummaryTextRequest
+	64,  // 55: arca.router.v1.StateService.GetBGPNeighborText:input_type -> arca.router.v1.GetBGPNeighborTextRequest
+	66,  // 56: arca.router.v1.StateService.GetOSPFNeighborsText:input_type -> arca.router.v1.GetOSPFNeighborsTextRequest
+	68,  // 57: arca.router.v1.StateService.GetVRRPText:input_type -> arca.router.v1.GetVRRPTextRequest
+	70,  // 58: arca.router.v1.StateService.GetBFDText:input_type -> arca.router.v1.GetBFDTextRequest
+	72,  // 59: arca.router.v1.StateService.GetBFDStatus:input_type -> arca.router.v1.GetBFDStatusRequest
+	75,  // 60: arca.router.v1.StateService.GetLCPReconciliation:input_type -> arca.router.v1.GetLCPReconciliationRequest
+	77,  // 61: arca.router.v1.StateService.GetHAStatus:input_type -> arca.router.v1.GetHAStatusRequest
+	79,  // 62: arca.router.v1.StateService.GetRoutingInstances:input_type -> arca.router.v1.GetRoutingInstancesRequest
+	82,  // 63: arca.router.v1.StateService.GetClassOfService:input_type -> arca.router.v1.GetClassOfServiceRequest
+	87,  // 64: arca.router.v1.StateService.GetSystemInfo:input_type -> arca.router.v1.GetSystemInfoRequest
+	89,  // 65: arca.router.v1.StateService.GetSystemHealth:input_type -> arca.router.v1.GetSystemHealthRequest
+	93,  // 66: arca.router.v1.StateService.GetLastApply:input_type -> arca.router.v1.GetLastApplyRequest
+	60,  // 67: arca.router.v1.DiagnosticService.GetRouteText:input_type -> arca.router.v1.GetRouteTextRequest
+	62,  // 68: arca.router.v1.DiagnosticService.GetBGPSummaryText:input_type -> arca.router.v1.GetBGPSummaryTextRequest
+	64,  // 69: arca.router.v1.DiagnosticService.GetBGPNeighborText:input_type -> arca.router.v1.GetBGPNeighborTextRequest
+	66,  // 70: arca.router.v1.DiagnosticService.GetOSPFNeighborsText:input_type -> arca.router.v1.GetOSPFNeighborsTextRequest
+	68,  // 71: arca.router.v1.DiagnosticService.GetVRRPText:input_type -> arca.router.v1.GetVRRPTextRequest
+	70,  // 72: arca.router.v1.DiagnosticService.GetBFDText:input_type -> arca.router.v1.GetBFDTextRequest
+	97,  // 73: arca.router.v1.TelemetryService.GetTelemetryCatalog:input_type -> arca.router.v1.GetTelemetryCatalogRequest
+	100, // 74: arca.router.v1.TelemetryService.SubscribeTelemetry:input_type -> arca.router.v1.SubscribeTelemetryRequest
+	108, // 75: arca.router.v1.SecurityService.ListUserSSHKeys:input_type -> arca.router.v1.ListUserSSHKeysRequest
+	111, // 76: arca.router.v1.SecurityService.SetUserSSHKeyStatus:input_type -> arca.router.v1.SetUserSSHKeyStatusRequest
+	113, // 77: arca.router.v1.SecurityService.RemoveUserSSHKey:input_type -> arca.router.v1.RemoveUserSSHKeyRequest
+	1,   // 78: arca.router.v1.ConfigService.GetRunning:output_type -> arca.router.v1.GetRunningResponse
+	1,   // 79: arca.router.v1.ConfigService.GetRunningUnredacted:output_type -> arca.router.v1.GetRunningResponse
+	3,   // 80: arca.router.v1.ConfigService.GetCandidate:output_type -> arca.router.v1.GetCandidateResponse
+	5,   // 81: arca.router.v1.ConfigService.EditCandidate:output_type -> arca.router.v1.EditCandidateResponse
+	7,   // 82: arca.router.v1.ConfigService.ReplaceCandidate:output_type -> arca.router.v1.ReplaceCandidateResponse
+	9,   // 83: arca.router.v1.ConfigService.Commit:output_type -> arca.router.v1.CommitResponse
+	19,  // 84: arca.router.v1.ConfigService.ValidateCandidate:output_type -> arca.router.v1.ValidateCandidateResponse
+	21,  // 85: arca.router.v1.ConfigService.Discard:output_type -> arca.router.v1.DiscardResponse
+	23,  // 86: arca.router.v1.ConfigService.Rollback:output_type -> arca.router.v1.RollbackResponse
+	25,  // 87: arca.router.v1.ConfigService.Diff:output_type -> arca.router.v1.DiffResponse
+	27,  // 88: arca.router.v1.ConfigService.ListHistory:output_type -> arca.router.v1.ListHistoryResponse
+	104, // 89: arca.router.v1.ConfigService.GetCommit:output_type -> arca.router.v1.GetCommitResponse
+	107, // 90: arca.router.v1.ConfigService.CompareCommits:output_type -> arca.router.v1.CompareCommitsResponse
+	12,  // 91: arca.router.v1.ConfigService.GetScheduledCommit:output_type -> arca.router.v1.GetScheduledCommitResponse
+	14,  // 92: arca.router.v1.ConfigService.ClearScheduledCommit:output_type -> arca.router.v1.ClearScheduledCommitResponse
+	17,  // 93: arca.router.v1.ConfigService.CheckDatastore:output_type -> arca.router.v1.CheckDatastoreResponse
+	30,  // 94: arca.router.v1.SessionService.CreateSession:output_type -> arca.router.v1.CreateSessionResponse
+	32,  // 95: arca.router.v1.SessionService.CloseSession:output_type -> arca.router.v1.CloseSessionResponse
+	34,  // 96: arca.router.v1.SessionService.AcquireLock:output_type -> arca.router.v1.AcquireLockResponse
+	36,  // 97: arca.router.v1.SessionService.ReleaseLock:output_type -> arca.router.v1.ReleaseLockResponse
+	38,  // 98: arca.router.v1.StateService.GetInterfaces:output_type -> arca.router.v1.GetInterfacesResponse
+	43,  // 99: arca.router.v1.StateService.GetRoutes:output_type -> arca.router.v1.GetRoutesResponse
+	46,  // 100: arca.router.v1.StateService.GetRouteSummary:output_type -> arca.router.v1.GetRouteSummaryResponse
+	50,  // 101: arca.router.v1.StateService.GetBGPNeighbors:output_type -> arca.router.v1.GetBGPNeighborsResponse
+	53,  // 102: arca.router.v1.StateService.GetOSPFNeighbors:output_type -> arca.router.v1.GetOSPFNeighborsResponse
+	56,  // 103: arca.router.v1.StateService.GetNeighbors:output_type -> arca.router.v1.GetNeighborsResponse
+	59,  // 104: arca.router.v1.StateService.ClearInterfaceStatistics:output_type -> arca.router.v1.ClearInterfaceStatisticsResponse
+	61,  // 105: arca.router.v1.StateService.GetRouteText:output_type -> arca.router.v1.GetRouteTextResponse
+	63,  // 106: arca.router.v1.StateService.GetBGPSummaryText:output_type -> arca.router.v1.GetBGPSummaryTextResponse
+	65,  // 107: arca.router.v1.StateService.GetBGPNeighborText:output_type -> arca.router.v1.GetBGPNeighborTextResponse
+	67,  // 108: arca.router.v1.StateService.GetOSPFNeighborsText:output_type -> arca.router.v1.GetOSPFNeighborsTextResponse
+	69,  // 109: arca.router.v1.StateService.GetVRRPText:output_type -> arca.router.v1.GetVRRPTextResponse
+	71,  // 110: arca.router.v1.StateService.GetBFDText:output_type -> arca.router.v1.GetBFDTextResponse
+	73,  // 111: arca.router.v1.StateService.GetBFDStatus:output_type -> arca.router.v1.GetBFDStatusResponse
+	76,  // 112: arca.router.v1.StateService.GetLCPReconciliation:output_type -> arca.router.v1.GetLCPReconciliationResponse
+	78,  // 113: arca.router.v1.StateService.GetHAStatus:output_type -> arca.router.v1.GetHAStatusResponse
+	80,  // 114: arca.router.v1.StateService.GetRoutingInstances:output_type -> arca.router.v1.GetRoutingInstancesResponse
+	83,  // 115: arca.router.v1.StateService.GetClassOfService:output_type -> arca.router.v1.GetClassOfServiceResponse
+	88,  // 116: arca.router.v1.StateService.GetSystemInfo:output_type -> arca.router.v1.GetSystemInfoResponse
+	90,  // 117: arca.router.v1.StateService.GetSystemHealth:output_type -> arca.router.v1.GetSystemHealthResponse
+	94,  // 118: arca.router.v1.StateService.GetLastApply:output_type -> arca.router.v1.GetLastApplyResponse
+	61,  // 119: arca.router.v1.DiagnosticService.GetRouteText:output_type -> arca.router.v1.GetRouteTextResponse
+	63,  // 120: arca.router.v1.DiagnosticService.GetBGPSummaryText:output_type -> arca.router.v1.GetBGPSummaryTextResponse
+	65,  // 121: arca.router.v1.DiagnosticService.GetBGPNeighborText:output_type -> arca.router.v1.GetBGPNeighborTextResponse
+	67,  // 122: arca.router.v1.DiagnosticService.GetOSPFNeighborsText:output_type -> arca.router.v1.GetOSPFNeighborsTextResponse
+	69,  // 123: arca.router.v1.DiagnosticService.GetVRRPText:output_type -> arca.router.v1.GetVRRPTextResponse
+	71,  // 124: arca.router.v1.DiagnosticService.GetBFDText:output_type -> arca.router.v1.GetBFDTextResponse
+	98,  // 125: arca.router.v1.TelemetryService.GetTelemetryCatalog:output_type -> arca.router.v1.GetTelemetryCatalogResponse
+	101, // 126: arca.router.v1.TelemetryService.SubscribeTelemetry:output_type -> arca.router.v1.TelemetryEvent
+	109, // 127: arca.router.v1.SecurityService.ListUserSSHKeys:output_type -> arca.router.v1.ListUserSSHKeysResponse
+	112, // 128: arca.router.v1.SecurityService.SetUserSSHKeyStatus:output_type -> arca.router.v1.SetUserSSHKeyStatusResponse
+	114, // 129: arca.router.v1.SecurityService.RemoveUserSSHKey:output_type -> arca.router.v1.RemoveUserSSHKeyResponse
+	78,  // [78:130] is the sub-list for method output_type
+	26,  // [26:78] is the sub-list for method input_type
+	26,  // [26:26] is the sub-list for extension type_name
+	26,  // [26:26] is the sub-list for extension extendee
+	0,   // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_router_proto_init() }
@@ -5872,9 +8201,9 @@ func file_api_v1_router_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_router_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   81,
+			NumMessages:   115,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   6,
 		},
 		GoTypes:           file_api_v1_router_proto_goTypes,
 		DependencyIndexes: file_api_v1_router_proto_depIdxs,
@@ -31,6 +31,10 @@ const (
 	ConfigService_Diff_FullMethodName                 = "/arca.router.v1.ConfigService/Diff"
 	ConfigService_ListHistory_FullMethodName          = "/arca.router.v1.ConfigService/ListHistory"
 	ConfigService_GetCommit_FullMethodName            = "/arca.router.v1.ConfigService/GetCommit"
+	ConfigService_CompareCommits_FullMethodName       = "/arca.router.v1.ConfigService/CompareCommits"
+	ConfigService_GetScheduledCommit_FullMethodName   = "/arca.router.v1.ConfigService/GetScheduledCommit"
+	ConfigService_ClearScheduledCommit_FullMethodName = "/arca.router.v1.ConfigService/ClearScheduledCommit"
+	ConfigService_CheckDatastore_FullMethodName       = "/arca.router.v1.ConfigService/CheckDatastore"
 )
 
 // ConfigServiceClient is the client API for ConfigService service.
@@ -65,6 +69,16 @@ type ConfigServiceClient interface {
 	ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
 	// GetCommit returns one archived commit, including configuration text.
 	GetCommit(ctx context.Context, in *GetCommitRequest, opts ...grpc.CallOption) (*GetCommitResponse, error)
+	// CompareCommits returns the diff between two archived commits, with
+	// secret values redacted.
+	CompareCommits(ctx context.Context, in *CompareCommitsRequest, opts ...grpc.CallOption) (*CompareCommitsResponse, error)
+	// GetScheduledCommit returns the commit scheduled with "commit at", if any.
+	GetScheduledCommit(ctx context.Context, in *GetScheduledCommitRequest, opts ...grpc.CallOption) (*GetScheduledCommitResponse, error)
+	// ClearScheduledCommit cancels the commit scheduled with "commit at".
+	ClearScheduledCommit(ctx context.Context, in *ClearScheduledCommitRequest, opts ...grpc.CallOption) (*ClearScheduledCommitResponse, error)
+	// CheckDatastore checks the configuration datastore for corruption and
+	// orphaned records, optionally removing orphaned candidates and locks.
+	CheckDatastore(ctx context.Context, in *CheckDatastoreRequest, opts ...grpc.CallOption) (*CheckDatastoreResponse, error)
 }
 
 type configServiceClient struct {
@@ -195,6 +209,46 @@ func (c *configServiceClient) GetCommit(ctx context.Context, in *GetCommitReques
 	return out, nil
 }
 
+func (c *configServiceClient) CompareCommits(ctx context.Context, in *CompareCommitsRequest, opts ...grpc.CallOption) (*CompareCommitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareCommitsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CompareCommits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetScheduledCommit(ctx context.Context, in *GetScheduledCommitRequest, opts ...grpc.CallOption) (*GetScheduledCommitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduledCommitResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetScheduledCommit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ClearScheduledCommit(ctx context.Context, in *ClearScheduledCommitRequest, opts ...grpc.CallOption) (*ClearScheduledCommitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearScheduledCommitResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ClearScheduledCommit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CheckDatastore(ctx context.Context, in *CheckDatastoreRequest, opts ...grpc.CallOption) (*CheckDatastoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDatastoreResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CheckDatastore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigServiceServer is the server API for ConfigService service.
 // All implementations must embed UnimplementedConfigServiceServer
 // for forward compatibility.
@@ -227,6 +281,16 @@ type ConfigServiceServer interface {
 	ListHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
 	// GetCommit returns one archived commit, including configuration text.
 	GetCommit(context.Context, *GetCommitRequest) (*GetCommitResponse, error)
+	// CompareCommits returns the diff between two archived commits, with
+	// secret values redacted.
+	CompareCommits(context.Context, *CompareCommitsRequest) (*CompareCommitsResponse, error)
+	// GetScheduledCommit returns the commit scheduled with "commit at", if any.
+	GetScheduledCommit(context.Context, *GetScheduledCommitRequest) (*GetScheduledCommitResponse, error)
+	// ClearScheduledCommit cancels the commit scheduled with "commit at".
+	ClearScheduledCommit(context.Context, *ClearScheduledCommitRequest) (*ClearScheduledCommitResponse, error)
+	// CheckDatastore checks the configuration datastore for corruption and
+	// orphaned records, optionally removing orphaned candidates and locks.
+	CheckDatastore(context.Context, *CheckDatastoreRequest) (*CheckDatastoreResponse, error)
 	mustEmbedUnimplementedConfigServiceServer()
 }
 
@@ -273,6 +337,18 @@ func (UnimplementedConfigServiceServer) ListHistory(context.Context, *ListHistor
 func (UnimplementedConfigServiceServer) GetCommit(context.Context, *GetCommitRequest) (*GetCommitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommit not implemented")
 }
+func (UnimplementedConfigServiceServer) CompareCommits(context.Context, *CompareCommitsRequest) (*CompareCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareCommits not implemented")
+}
+func (UnimplementedConfigServiceServer) GetScheduledCommit(context.Context, *GetScheduledCommitRequest) (*GetScheduledCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledCommit not implemented")
+}
+func (UnimplementedConfigServiceServer) ClearScheduledCommit(context.Context, *ClearScheduledCommitRequest) (*ClearScheduledCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearScheduledCommit not implemented")
+}
+func (UnimplementedConfigServiceServer) CheckDatastore(context.Context, *CheckDatastoreRequest) (*CheckDatastoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDatastore not implemented")
+}
 func (UnimplementedConfigServiceServer) mustEmbedUnimplementedConfigServiceServer() {}
 func (UnimplementedConfigServiceServer) testEmbeddedByValue()                       {}
 
@@ -510,6 +586,78 @@ func _ConfigService_GetCommit_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigService_CompareCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CompareCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CompareCommits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CompareCommits(ctx, req.(*CompareCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetScheduledCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetScheduledCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetScheduledCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetScheduledCommit(ctx, req.(*GetScheduledCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ClearScheduledCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearScheduledCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ClearScheduledCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ClearScheduledCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ClearScheduledCommit(ctx, req.(*ClearScheduledCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_CheckDatastore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDatastoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CheckDatastore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CheckDatastore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CheckDatastore(ctx, req.(*CheckDatastoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConfigService_ServiceDesc is the grpc.ServiceDesc for ConfigService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -565,6 +713,22 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommit",
 			Handler:    _ConfigService_GetCommit_Handler,
 		},
+		{
+			MethodName: "CompareCommits",
+			Handler:    _ConfigService_CompareCommits_Handler,
+		},
+		{
+			MethodName: "GetScheduledCommit",
+			Handler:    _ConfigService_GetScheduledCommit_Handler,
+		},
+		{
+			MethodName: "ClearScheduledCommit",
+			Handler:    _ConfigService_ClearScheduledCommit_Handler,
+		},
+		{
+			MethodName: "CheckDatastore",
+			Handler:    _ConfigService_CheckDatastore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/router.proto",
@@ -587,7 +751,8 @@ type SessionServiceClient interface {
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
 	// CloseSession closes a configuration session.
 	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
-	// AcquireLock acquires the candidate lock for a session.
+	// AcquireLock enters configuration mode for a session, taking the
+	// candidate lock in exclusive mode.
 	AcquireLock(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (*AcquireLockResponse, error)
 	// ReleaseLock releases the candidate lock.
 	ReleaseLock(ctx context.Context, in *ReleaseLockRequest, opts ...grpc.CallOption) (*ReleaseLockResponse, error)
@@ -651,7 +816,8 @@ type SessionServiceServer interface {
 	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
 	// CloseSession closes a configuration session.
 	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
-	// AcquireLock acquires the candidate lock for a session.
+	// AcquireLock enters configuration mode for a session, taking the
+	// candidate lock in exclusive mode.
 	AcquireLock(context.Context, *AcquireLockRequest) (*AcquireLockResponse, error)
 	// ReleaseLock releases the candidate lock.
 	ReleaseLock(context.Context, *ReleaseLockRequest) (*ReleaseLockResponse, error)
@@ -799,22 +965,27 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	StateService_GetInterfaces_FullMethodName        = "/arca.router.v1.StateService/GetInterfaces"
-	StateService_GetRoutes_FullMethodName            = "/arca.router.v1.StateService/GetRoutes"
-	StateService_GetBGPNeighbors_FullMethodName      = "/arca.router.v1.StateService/GetBGPNeighbors"
-	StateService_GetOSPFNeighbors_FullMethodName     = "/arca.router.v1.StateService/GetOSPFNeighbors"
-	StateService_GetRouteText_FullMethodName         = "/arca.router.v1.StateService/GetRouteText"
-	StateService_GetBGPSummaryText_FullMethodName    = "/arca.router.v1.StateService/GetBGPSummaryText"
-	StateService_GetBGPNeighborText_FullMethodName   = "/arca.router.v1.StateService/GetBGPNeighborText"
-	StateService_GetOSPFNeighborsText_FullMethodName = "/arca.router.v1.StateService/GetOSPFNeighborsText"
-	StateService_GetVRRPText_FullMethodName          = "/arca.router.v1.StateService/GetVRRPText"
-	StateService_GetBFDText_FullMethodName           = "/arca.router.v1.StateService/GetBFDText"
-	StateService_GetBFDStatus_FullMethodName         = "/arca.router.v1.StateService/GetBFDStatus"
-	StateService_GetLCPReconciliation_FullMethodName = "/arca.router.v1.StateService/GetLCPReconciliation"
-	StateService_GetHAStatus_FullMethodName          = "/arca.router.v1.StateService/GetHAStatus"
-	StateService_GetRoutingInstances_FullMethodName  = "/arca.router.v1.StateService/GetRoutingInstances"
-	StateService_GetClassOfService_FullMethodName    = "/arca.router.v1.StateService/GetClassOfService"
-	StateService_GetSystemInfo_FullMethodName        = "/arca.router.v1.StateService/GetSystemInfo"
+	StateService_GetInterfaces_FullMethodName            = "/arca.router.v1.StateService/GetInterfaces"
+	StateService_GetRoutes_FullMethodName                = "/arca.router.v1.StateService/GetRoutes"
+	StateService_GetRouteSummary_FullMethodName          = "/arca.router.v1.StateService/GetRouteSummary"
+	StateService_GetBGPNeighbors_FullMethodName          = "/arca.router.v1.StateService/GetBGPNeighbors"
+	StateService_GetOSPFNeighbors_FullMethodName         = "/arca.router.v1.StateService/GetOSPFNeighbors"
+	StateService_GetNeighbors_FullMethodName             = "/arca.router.v1.StateService/GetNeighbors"
+	StateService_ClearInterfaceStatistics_FullMethodName = "/arca.router.v1.StateService/ClearInterfaceStatistics"
+	StateService_GetRouteText_FullMethodName             = "/arca.router.v1.StateService/GetRouteText"
+	StateService_GetBGPSummaryText_FullMethodName        = "/arca.router.v1.StateService/GetBGPSummaryText"
+	StateService_GetBGPNeighborText_FullMethodName       = "/arca.router.v1.StateService/GetBGPNeighborText"
+	StateService_GetOSPFNeighborsText_FullMethodName     = "/arca.router.v1.StateService/GetOSPFNeighborsText"
+	StateService_GetVRRPText_FullMethodName              = "/arca.router.v1.StateService/GetVRRPText"
+	StateService_GetBFDText_FullMethodName               = "/arca.router.v1.StateService/GetBFDText"
+	StateService_GetBFDStatus_FullMethodName             = "/arca.router.v1.StateService/GetBFDStatus"
+	StateService_GetLCPReconciliation_FullMethodName     = "/arca.router.v1.StateService/GetLCPReconciliation"
+	StateService_GetHAStatus_FullMethodName              = "/arca.router.v1.StateService/GetHAStatus"
+	StateService_GetRoutingInstances_FullMethodName      = "/arca.router.v1.StateService/GetRoutingInstances"
+	StateService_GetClassOfService_FullMethodName        = "/arca.router.v1.StateService/GetClassOfService"
+	StateService_GetSystemInfo_FullMethodName            = "/arca.router.v1.StateService/GetSystemInfo"
+	StateService_GetSystemHealth_FullMethodName          = "/arca.router.v1.StateService/GetSystemHealth"
+	StateService_GetLastApply_FullMethodName             = "/arca.router.v1.StateService/GetLastApply"
 )
 
 // StateServiceClient is the client API for StateService service.
@@ -827,10 +998,16 @@ type StateServiceClient interface {
 	GetInterfaces(ctx context.Context, in *GetInterfacesRequest, opts ...grpc.CallOption) (*GetInterfacesResponse, error)
 	// GetRoutes returns the routing table.
 	GetRoutes(ctx context.Context, in *GetRoutesRequest, opts ...grpc.CallOption) (*GetRoutesResponse, error)
+	// GetRouteSummary returns the route count per protocol of each address family.
+	GetRouteSummary(ctx context.Context, in *GetRouteSummaryRequest, opts ...grpc.CallOption) (*GetRouteSummaryResponse, error)
 	// GetBGPNeighbors returns BGP neighbor state.
 	GetBGPNeighbors(ctx context.Context, in *GetBGPNeighborsRequest, opts ...grpc.CallOption) (*GetBGPNeighborsResponse, error)
 	// GetOSPFNeighbors returns OSPFv2 or OSPFv3 neighbor state.
 	GetOSPFNeighbors(ctx context.Context, in *GetOSPFNeighborsRequest, opts ...grpc.CallOption) (*GetOSPFNeighborsResponse, error)
+	// GetNeighbors returns the VPP IPv4 ARP or IPv6 neighbor table.
+	GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error)
+	// ClearInterfaceStatistics resets VPP interface counters.
+	ClearInterfaceStatistics(ctx context.Context, in *ClearInterfaceStatisticsRequest, opts ...grpc.CallOption) (*ClearInterfaceStatisticsResponse, error)
 	// Deprecated: Do not use.
 	// GetRouteText returns FRR route output for legacy CLI display.
 	// Deprecated: use DiagnosticService.GetRouteText for raw text diagnostics.
@@ -867,6 +1044,10 @@ type StateServiceClient interface {
 	GetClassOfService(ctx context.Context, in *GetClassOfServiceRequest, opts ...grpc.CallOption) (*GetClassOfServiceResponse, error)
 	// GetSystemInfo returns system information.
 	GetSystemInfo(ctx context.Context, in *GetSystemInfoRequest, opts ...grpc.CallOption) (*GetSystemInfoResponse, error)
+	// GetSystemHealth returns the daemon build, uptime, and subsystem health.
+	GetSystemHealth(ctx context.Context, in *GetSystemHealthRequest, opts ...grpc.CallOption) (*GetSystemHealthResponse, error)
+	// GetLastApply returns the outcome of the last configuration apply.
+	GetLastApply(ctx context.Context, in *GetLastApplyRequest, opts ...grpc.CallOption) (*GetLastApplyResponse, error)
 }
 
 type stateServiceClient struct {
@@ -897,6 +1078,16 @@ func (c *stateServiceClient) GetRoutes(ctx context.Context, in *GetRoutesRequest
 	return out, nil
 }
 
+func (c *stateServiceClient) GetRouteSummary(ctx context.Context, in *GetRouteSummaryRequest, opts ...grpc.CallOption) (*GetRouteSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRouteSummaryResponse)
+	err := c.cc.Invoke(ctx, StateService_GetRouteSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stateServiceClient) GetBGPNeighbors(ctx context.Context, in *GetBGPNeighborsRequest, opts ...grpc.CallOption) (*GetBGPNeighborsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBGPNeighborsResponse)
@@ -917,6 +1108,26 @@ func (c *stateServiceClient) GetOSPFNeighbors(ctx context.Context, in *GetOSPFNe
 	return out, nil
 }
 
+func (c *stateServiceClient) GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNeighborsResponse)
+	err := c.cc.Invoke(ctx, StateService_GetNeighbors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateServiceClient) ClearInterfaceStatistics(ctx context.Context, in *ClearInterfaceStatisticsRequest, opts ...grpc.CallOption) (*ClearInterfaceStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearInterfaceStatisticsResponse)
+	err := c.cc.Invoke(ctx, StateService_ClearInterfaceStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *stateServiceClient) GetRouteText(ctx context.Context, in *GetRouteTextRequest, opts ...grpc.CallOption) (*GetRouteTextResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
@@ -1043,6 +1254,26 @@ func (c *stateServiceClient) GetSystemInfo(ctx context.Context, in *GetSystemInf
 	return out, nil
 }
 
+func (c *stateServiceClient) GetSystemHealth(ctx context.Context, in *GetSystemHealthRequest, opts ...grpc.CallOption) (*GetSystemHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemHealthResponse)
+	err := c.cc.Invoke(ctx, StateService_GetSystemHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateServiceClient) GetLastApply(ctx context.Context, in *GetLastApplyRequest, opts ...grpc.CallOption) (*GetLastApplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLastApplyResponse)
+	err := c.cc.Invoke(ctx, StateService_GetLastApply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StateServiceServer is the server API for StateService service.
 // All implementations must embed UnimplementedStateServiceServer
 // for forward compatibility.
@@ -1053,10 +1284,16 @@ type StateServiceServer interface {
 	GetInterfaces(context.Context, *GetInterfacesRequest) (*GetInterfacesResponse, error)
 	// GetRoutes returns the routing table.
 	GetRoutes(context.Context, *GetRoutesRequest) (*GetRoutesResponse, error)
+	// GetRouteSummary returns the route count per protocol of each address family.
+	GetRouteSummary(context.Context, *GetRouteSummaryRequest) (*GetRouteSummaryResponse, error)
 	// GetBGPNeighbors returns BGP neighbor state.
 	GetBGPNeighbors(context.Context, *GetBGPNeighborsRequest) (*GetBGPNeighborsResponse, error)
 	// GetOSPFNeighbors returns OSPFv2 or OSPFv3 neighbor state.
 	GetOSPFNeighbors(context.Context, *GetOSPFNeighborsRequest) (*GetOSPFNeighborsResponse, error)
+	// GetNeighbors returns the VPP IPv4 ARP or IPv6 neighbor table.
+	GetNeighbors(context.Context, *GetNeighborsRequest) (*GetNeighborsResponse, error)
+	// ClearInterfaceStatistics resets VPP interface counters.
+	ClearInterfaceStatistics(context.Context, *ClearInterfaceStatisticsRequest) (*ClearInterfaceStatisticsResponse, error)
 	// Deprecated: Do not use.
 	// GetRouteText returns FRR route output for legacy CLI display.
 	// Deprecated: use DiagnosticService.GetRouteText for raw text diagnostics.
@@ -1093,6 +1330,10 @@ type StateServiceServer interface {
 	GetClassOfService(context.Context, *GetClassOfServiceRequest) (*GetClassOfServiceResponse, error)
 	// GetSystemInfo returns system information.
 	GetSystemInfo(context.Context, *GetSystemInfoRequest) (*GetSystemInfoResponse, error)
+	// GetSystemHealth returns the daemon build, uptime, and subsystem health.
+	GetSystemHealth(context.Context, *GetSystemHealthRequest) (*GetSystemHealthResponse, error)
+	// GetLastApply returns the outcome of the last configuration apply.
+	GetLastApply(context.Context, *GetLastApplyRequest) (*GetLastApplyResponse, error)
 	mustEmbedUnimplementedStateServiceServer()
 }
 
@@ -1109,12 +1350,21 @@ func (UnimplementedStateServiceServer) GetInterfaces(context.Context, *GetInterf
 func (UnimplementedStateServiceServer) GetRoutes(context.Context, *GetRoutesRequest) (*GetRoutesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoutes not implemented")
 }
+func (UnimplementedStateServiceServer) GetRouteSummary(context.Context, *GetRouteSummaryRequest) (*GetRouteSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteSummary not implemented")
+}
 func (UnimplementedStateServiceServer) GetBGPNeighbors(context.Context, *GetBGPNeighborsRequest) (*GetBGPNeighborsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBGPNeighbors not implemented")
 }
 func (UnimplementedStateServiceServer) GetOSPFNeighbors(context.Context, *GetOSPFNeighborsRequest) (*GetOSPFNeighborsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOSPFNeighbors not implemented")
 }
+func (UnimplementedStateServiceServer) GetNeighbors(context.Context, *GetNeighborsRequest) (*GetNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNeighbors not implemented")
+}
+func (UnimplementedStateServiceServer) ClearInterfaceStatistics(context.Context, *ClearInterfaceStatisticsRequest) (*ClearInterfaceStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearInterfaceStatistics not implemented")
+}
 func (UnimplementedStateServiceServer) GetRouteText(context.Context, *GetRouteTextRequest) (*GetRouteTextResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRouteText not implemented")
 }
@@ -1151,6 +1401,12 @@ func (UnimplementedStateServiceServer) GetClassOfService(context.Context, *GetCl
 func (UnimplementedStateServiceServer) GetSystemInfo(context.Context, *GetSystemInfoRequest) (*GetSystemInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSystemInfo not implemented")
 }
+func (UnimplementedStateServiceServer) GetSystemHealth(context.Context, *GetSystemHealthRequest) (*GetSystemHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemHealth not implemented")
+}
+func (UnimplementedStateServiceServer) GetLastApply(context.Context, *GetLastApplyRequest) (*GetLastApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastApply not implemented")
+}
 func (UnimplementedStateServiceServer) mustEmbedUnimplementedStateServiceServer() {}
 func (UnimplementedStateServiceServer) testEmbeddedByValue()                      {}
 
@@ -1208,6 +1464,24 @@ func _StateService_GetRoutes_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_GetRouteSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetRouteSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_GetRouteSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetRouteSummary(ctx, req.(*GetRouteSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StateService_GetBGPNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBGPNeighborsRequest)
 	if err := dec(in); err != nil {
@@ -1244,6 +1518,42 @@ func _StateService_GetOSPFNeighbors_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_GetNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_GetNeighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetNeighbors(ctx, req.(*GetNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateService_ClearInterfaceStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearInterfaceStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).ClearInterfaceStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_ClearInterfaceStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).ClearInterfaceStatistics(ctx, req.(*ClearInterfaceStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StateService_GetRouteText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRouteTextRequest)
 	if err := dec(in); err != nil {
@@ -1460,6 +1770,42 @@ func _StateService_GetSystemInfo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_GetSystemHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetSystemHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_GetSystemHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetSystemHealth(ctx, req.(*GetSystemHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateService_GetLastApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetLastApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_GetLastApply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetLastApply(ctx, req.(*GetLastApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StateService_ServiceDesc is the grpc.ServiceDesc for StateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1475,6 +1821,10 @@ var StateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoutes",
 			Handler:    _StateService_GetRoutes_Handler,
 		},
+		{
+			MethodName: "GetRouteSummary",
+			Handler:    _StateService_GetRouteSummary_Handler,
+		},
 		{
 			MethodName: "GetBGPNeighbors",
 			Handler:    _StateService_GetBGPNeighbors_Handler,
@@ -1483,6 +1833,14 @@ var StateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOSPFNeighbors",
 			Handler:    _StateService_GetOSPFNeighbors_Handler,
 		},
+		{
+			MethodName: "GetNeighbors",
+			Handler:    _StateService_GetNeighbors_Handler,
+		},
+		{
+			MethodName: "ClearInterfaceStatistics",
+			Handler:    _StateService_ClearInterfaceStatistics_Handler,
+		},
 		{
 			MethodName: "GetRouteText",
 			Handler:    _StateService_GetRouteText_Handler,
@@ -1531,6 +1889,14 @@ var StateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSystemInfo",
 			Handler:    _StateService_GetSystemInfo_Handler,
 		},
+		{
+			MethodName: "GetSystemHealth",
+			Handler:    _StateService_GetSystemHealth_Handler,
+		},
+		{
+			MethodName: "GetLastApply",
+			Handler:    _StateService_GetLastApply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/router.proto",
@@ -1999,3 +2365,191 @@ var TelemetryService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "api/v1/router.proto",
 }
+
+const (
+	SecurityService_ListUserSSHKeys_FullMethodName     = "/arca.router.v1.SecurityService/ListUserSSHKeys"
+	SecurityService_SetUserSSHKeyStatus_FullMethodName = "/arca.router.v1.SecurityService/SetUserSSHKeyStatus"
+	SecurityService_RemoveUserSSHKey_FullMethodName    = "/arca.router.v1.SecurityService/RemoveUserSSHKey"
+)
+
+// SecurityServiceClient is the client API for SecurityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SecurityService manages NETCONF user credentials stored by the daemon.
+type SecurityServiceClient interface {
+	// ListUserSSHKeys lists the SSH public keys registered for a user.
+	ListUserSSHKeys(ctx context.Context, in *ListUserSSHKeysRequest, opts ...grpc.CallOption) (*ListUserSSHKeysResponse, error)
+	// SetUserSSHKeyStatus enables or disables one of a user's SSH public keys.
+	SetUserSSHKeyStatus(ctx context.Context, in *SetUserSSHKeyStatusRequest, opts ...grpc.CallOption) (*SetUserSSHKeyStatusResponse, error)
+	// RemoveUserSSHKey deletes one of a user's SSH public keys.
+	RemoveUserSSHKey(ctx context.Context, in *RemoveUserSSHKeyRequest, opts ...grpc.CallOption) (*RemoveUserSSHKeyResponse, error)
+}
+
+type securityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSecurityServiceClient(cc grpc.ClientConnInterface) SecurityServiceClient {
+	return &securityServiceClient{cc}
+}
+
+func (c *securityServiceClient) ListUserSSHKeys(ctx context.Context, in *ListUserSSHKeysRequest, opts ...grpc.CallOption) (*ListUserSSHKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserSSHKeysResponse)
+	err := c.cc.Invoke(ctx, SecurityService_ListUserSSHKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) SetUserSSHKeyStatus(ctx context.Context, in *SetUserSSHKeyStatusRequest, opts ...grpc.CallOption) (*SetUserSSHKeyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserSSHKeyStatusResponse)
+	err := c.cc.Invoke(ctx, SecurityService_SetUserSSHKeyStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) RemoveUserSSHKey(ctx context.Context, in *RemoveUserSSHKeyRequest, opts ...grpc.CallOption) (*RemoveUserSSHKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveUserSSHKeyResponse)
+	err := c.cc.Invoke(ctx, SecurityService_RemoveUserSSHKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SecurityServiceServer is the server API for SecurityService service.
+// All implementations must embed UnimplementedSecurityServiceServer
+// for forward compatibility.
+//
+// SecurityService manages NETCONF user credentials stored by the daemon.
+type SecurityServiceServer interface {
+	// ListUserSSHKeys lists the SSH public keys registered for a user.
+	ListUserSSHKeys(context.Context, *ListUserSSHKeysRequest) (*ListUserSSHKeysResponse, error)
+	// SetUserSSHKeyStatus enables or disables one of a user's SSH public keys.
+	SetUserSSHKeyStatus(context.Context, *SetUserSSHKeyStatusRequest) (*SetUserSSHKeyStatusResponse, error)
+	// RemoveUserSSHKey deletes one of a user's SSH public keys.
+	RemoveUserSSHKey(context.Context, *RemoveUserSSHKeyRequest) (*RemoveUserSSHKeyResponse, error)
+	mustEmbedUnimplementedSecurityServiceServer()
+}
+
+// UnimplementedSecurityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSecurityServiceServer struct{}
+
+func (UnimplementedSecurityServiceServer) ListUserSSHKeys(context.Context, *ListUserSSHKeysRequest) (*ListUserSSHKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSSHKeys not implemented")
+}
+func (UnimplementedSecurityServiceServer) SetUserSSHKeyStatus(context.Context, *SetUserSSHKeyStatusRequest) (*SetUserSSHKeyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserSSHKeyStatus not implemented")
+}
+func (UnimplementedSecurityServiceServer) RemoveUserSSHKey(context.Context, *RemoveUserSSHKeyRequest) (*RemoveUserSSHKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserSSHKey not implemented")
+}
+func (UnimplementedSecurityServiceServer) mustEmbedUnimplementedSecurityServiceServer() {}
+func (UnimplementedSecurityServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeSecurityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SecurityServiceServer will
+// result in compilation errors.
+type UnsafeSecurityServiceServer interface {
+	mustEmbedUnimplementedSecurityServiceServer()
+}
+
+func RegisterSecurityServiceServer(s grpc.ServiceRegistrar, srv SecurityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSecurityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SecurityService_ServiceDesc, srv)
+}
+
+func _SecurityService_ListUserSSHKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSSHKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).ListUserSSHKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_ListUserSSHKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).ListUserSSHKeys(ctx, req.(*ListUserSSHKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_SetUserSSHKeyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserSSHKeyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).SetUserSSHKeyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_SetUserSSHKeyStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).SetUserSSHKeyStatus(ctx, req.(*SetUserSSHKeyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_RemoveUserSSHKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserSSHKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).RemoveUserSSHKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_RemoveUserSSHKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).RemoveUserSSHKey(ctx, req.(*RemoveUserSSHKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SecurityService_ServiceDesc is the grpc.ServiceDesc for SecurityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SecurityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "arca.router.v1.SecurityService",
+	HandlerType: (*SecurityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUserSSHKeys",
+			Handler:    _SecurityService_ListUserSSHKeys_Handler,
+		},
+		{
+			MethodName: "SetUserSSHKeyStatus",
+			Handler:    _SecurityService_SetUserSSHKeyStatus_Handler,
+		},
+		{
+			MethodName: "RemoveUserSSHKey",
+			Handler:    _SecurityService_RemoveUserSSHKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/router.proto",
+}
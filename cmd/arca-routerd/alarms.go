@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	nbgrpc "github.com/akam1o/arca-router/internal/northbound/grpc"
+	sbvpp "github.com/akam1o/arca-router/internal/southbound/vpp"
+	"github.com/akam1o/arca-router/pkg/datastore"
+	"github.com/akam1o/arca-router/pkg/logger"
+)
+
+const alarmSaveTimeout = 5 * time.Second
+
+// alarmRuntimeSource is the VPP plugin's alarm evaluator.
+type alarmRuntimeSource interface {
+	Alarms() []sbvpp.Alarm
+	SetAlarmObserver(observer func([]sbvpp.Alarm))
+	RestoreAlarms(alarms []sbvpp.Alarm)
+}
+
+// attachAlarmStore restores the alarms persisted by the previous run into
+// source and persists the active alarms each time one is raised or cleared.
+// Backends without alarm support are left alone.
+func attachAlarmStore(ctx context.Context, source alarmRuntimeSource, ds datastore.Datastore, log *logger.Logger) {
+	alarmStore, ok := ds.(datastore.AlarmStore)
+	if !ok {
+		log.Warn("Datastore does not persist alarms")
+		return
+	}
+
+	stored, err := alarmStore.ListAlarms(ctx)
+	if err != nil {
+		log.Error("Failed to load active alarms", slog.Any("error", err))
+	} else {
+		alarms := make([]sbvpp.Alarm, 0, len(stored))
+		for _, alarm := range stored {
+			alarms = append(alarms, sbvpp.Alarm(alarm))
+		}
+		source.RestoreAlarms(alarms)
+	}
+
+	source.SetAlarmObserver(func(alarms []sbvpp.Alarm) {
+		active := make([]datastore.Alarm, 0, len(alarms))
+		for _, alarm := range alarms {
+			active = append(active, datastore.Alarm(alarm))
+		}
+		log.Info("Active alarms changed", slog.Int("alarms", len(active)))
+		saveCtx, cancel := context.WithTimeout(context.Background(), alarmSaveTimeout)
+		defer cancel()
+		if err := alarmStore.ReplaceAlarms(saveCtx, active); err != nil {
+			log.Error("Failed to persist active alarms", slog.Any("error", err))
+		}
+	})
+}
+
+type grpcAlarmSource struct {
+	source alarmRuntimeSource
+}
+
+func newGRPCAlarmSource(source alarmRuntimeSource) *grpcAlarmSource {
+	if source == nil {
+		return nil
+	}
+	return &grpcAlarmSource{source: source}
+}
+
+func (s *grpcAlarmSource) AlarmInfos() []nbgrpc.AlarmInfo {
+	alarms := s.source.Alarms()
+	infos := make([]nbgrpc.AlarmInfo, 0, len(alarms))
+	for _, alarm := range alarms {
+		infos = append(infos, nbgrpc.AlarmInfo{
+			Type:        alarm.Type,
+			Object:      alarm.Object,
+			Description: alarm.Description,
+			RaisedAt:    alarm.RaisedAt.UTC(),
+		})
+	}
+	return infos
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/pkg/logger"
+)
+
+const healthProbeTimeout = 2 * time.Second
+
+// Initial apply states reported by the health endpoint.
+const (
+	initialApplyPending = "pending"
+	initialApplyOK      = "ok"
+	initialApplyFailed  = "failed"
+)
+
+// healthProbe checks that one dependency of the daemon is reachable.
+type healthProbe struct {
+	name  string
+	check func(context.Context) error
+}
+
+// daemonHealth tracks startup progress and the dependencies probed by the
+// health endpoint. It is created before the initial configuration is applied
+// so orchestrators see the daemon as alive but not ready during startup.
+type daemonHealth struct {
+	mu                sync.RWMutex
+	initialApply      string
+	initialApplyError string
+	probes            []healthProbe
+	lastApply         func() engine.ApplyResult
+}
+
+type healthCheckReport struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type healthLastApplyReport struct {
+	Time    string `json:"time"`
+	Version uint64 `json:"version"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+type healthReport struct {
+	Ready             bool                   `json:"ready"`
+	InitialApply      string                 `json:"initial_apply"`
+	InitialApplyError string                 `json:"initial_apply_error,omitempty"`
+	Checks            []healthCheckReport    `json:"checks"`
+	LastApply         *healthLastApplyReport `json:"last_apply,omitempty"`
+}
+
+func newDaemonHealth() *daemonHealth {
+	return &daemonHealth{initialApply: initialApplyPending}
+}
+
+// setRuntime installs the dependency probes and the last-apply source once
+// the daemon runtime exists.
+func (h *daemonHealth) setRuntime(probes []healthProbe, lastApply func() engine.ApplyResult) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.probes = append([]healthProbe(nil), probes...)
+	h.lastApply = lastApply
+}
+
+// setInitialApply records the outcome of the startup configuration apply.
+func (h *daemonHealth) setInitialApply(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err != nil {
+		h.initialApply = initialApplyFailed
+		h.initialApplyError = err.Error()
+		return
+	}
+	h.initialApply = initialApplyOK
+	h.initialApplyError = ""
+}
+
+// report probes every dependency. The daemon is ready once the initial
+// configuration has been applied and every probe succeeds.
+func (h *daemonHealth) report(ctx context.Context) healthReport {
+	h.mu.RLock()
+	report := healthReport{
+		InitialApply:      h.initialApply,
+		InitialApplyError: h.initialApplyError,
+		Checks:            []healthCheckReport{},
+	}
+	probes := append([]healthProbe(nil), h.probes...)
+	lastApply := h.lastApply
+	h.mu.RUnlock()
+
+	ready := report.InitialApply == initialApplyOK
+	for _, probe := range probes {
+		check := healthCheckReport{Name: probe.name, Status: "up"}
+		probeCtx, cancel := context.WithTimeout(ctx, healthProbeTimeout)
+		if err := probe.check(probeCtx); err != nil {
+			check.Status = "down"
+			check.Detail = err.Error()
+			ready = false
+		}
+		cancel()
+		report.Checks = append(report.Checks, check)
+	}
+	if lastApply != nil {
+		if result := lastApply(); !result.Time.IsZero() {
+			report.LastApply = &healthLastApplyReport{
+				Time:    result.Time.UTC().Format(time.RFC3339),
+				Version: result.Version,
+				Status:  "ok",
+			}
+			if result.Err != nil {
+				report.LastApply.Status = "failed"
+				report.LastApply.Error = result.Err.Error()
+			}
+		}
+	}
+	report.Ready = ready
+	return report
+}
+
+func (h *daemonHealth) handleLive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write([]byte("ok\n"))
+	}
+}
+
+func (h *daemonHealth) handleReady(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	report := h.report(r.Context())
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
+
+func (h *daemonHealth) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", h.handleLive)
+	mux.HandleFunc("/readyz", h.handleReady)
+	return mux
+}
+
+func startHealthServerWithShutdown(ctx context.Context, listenAddr string, health *daemonHealth, log *logger.Logger) (<-chan error, func(context.Context) error, error) {
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listen health endpoint: %w", err)
+	}
+
+	srv := newObservabilityHTTPServer(health.handler())
+	shutdown := srv.Shutdown
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("Health endpoint started", slog.String("listen", lis.Addr().String()))
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+			return
+		}
+		errCh <- nil
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(shutdownCtx); err != nil {
+			log.Error("Health endpoint shutdown failed", slog.Any("error", err))
+		}
+	}()
+
+	return errCh, shutdown, nil
+}
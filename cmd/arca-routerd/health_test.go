@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/engine"
+)
+
+func getReadiness(t *testing.T, health *daemonHealth) (int, healthReport) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	health.handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var report healthReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatalf("decode /readyz body %q: %v", rec.Body.String(), err)
+	}
+	return rec.Code, report
+}
+
+func TestHealthReadinessTransitionsAfterInitialApply(t *testing.T) {
+	health := newDaemonHealth()
+
+	rec := httptest.NewRecorder()
+	health.handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("/healthz during startup = %d, want 200", rec.Code)
+	}
+
+	code, report := getReadiness(t, health)
+	if code != http.StatusServiceUnavailable || report.Ready || report.InitialApply != initialApplyPending {
+		t.Fatalf("/readyz before initial apply = %d %#v, want 503 pending", code, report)
+	}
+
+	frrErr := errors.New("vtysh: connection refused")
+	applied := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	health.setRuntime([]healthProbe{
+		{name: "vpp", check: func(context.Context) error { return nil }},
+		{name: "frr", check: func(context.Context) error { return frrErr }},
+	}, func() engine.ApplyResult {
+		return engine.ApplyResult{Time: applied, Version: 3}
+	})
+	health.setInitialApply(nil)
+
+	code, report = getReadiness(t, health)
+	if code != http.StatusServiceUnavailable || report.Ready {
+		t.Fatalf("/readyz with FRR down = %d %#v, want 503", code, report)
+	}
+	if len(report.Checks) != 2 || report.Checks[1].Status != "down" || report.Checks[1].Detail != frrErr.Error() {
+		t.Fatalf("/readyz checks = %#v, want frr down with detail", report.Checks)
+	}
+
+	frrErr = nil
+	code, report = getReadiness(t, health)
+	if code != http.StatusOK || !report.Ready || report.InitialApply != initialApplyOK {
+		t.Fatalf("/readyz after initial apply = %d %#v, want 200 ready", code, report)
+	}
+	if report.LastApply == nil || report.LastApply.Status != "ok" || report.LastApply.Version != 3 ||
+		report.LastApply.Time != "2026-01-02T03:04:05Z" {
+		t.Fatalf("/readyz last apply = %#v, want ok version 3", report.LastApply)
+	}
+}
+
+func TestHealthReadinessStaysFalseAfterFailedInitialApply(t *testing.T) {
+	health := newDaemonHealth()
+	health.setInitialApply(errors.New("apply initial config: plugin vpp apply failed"))
+
+	code, report := getReadiness(t, health)
+	if code != http.StatusServiceUnavailable || report.Ready || report.InitialApply != initialApplyFailed {
+		t.Fatalf("/readyz after failed initial apply = %d %#v, want 503 failed", code, report)
+	}
+	if report.InitialApplyError == "" {
+		t.Fatal("/readyz did not report the initial apply error")
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/pkg/datastore"
+	"github.com/akam1o/arca-router/pkg/logger"
+)
+
+const lastApplySaveTimeout = 5 * time.Second
+
+// attachLastApplyStore restores the apply result persisted by the previous
+// run into eng and persists every later result, so an apply that failed to
+// program the data plane stays visible after a restart. Backends without
+// apply result support are left alone.
+func attachLastApplyStore(ctx context.Context, eng *engine.Engine, ds datastore.Datastore, log *logger.Logger) {
+	resultStore, ok := ds.(datastore.ApplyResultStore)
+	if !ok {
+		log.Warn("Datastore does not persist apply results")
+		return
+	}
+
+	stored, err := resultStore.GetLastApply(ctx)
+	var dsErr *datastore.Error
+	switch {
+	case err == nil:
+		eng.RestoreLastApply(engineApplyResult(stored))
+	case errors.As(err, &dsErr) && dsErr.Code == datastore.ErrCodeNotFound:
+	default:
+		log.Error("Failed to load last apply result", slog.Any("error", err))
+	}
+
+	eng.SetApplyObserver(func(result engine.ApplyResult) {
+		saveCtx, cancel := context.WithTimeout(context.Background(), lastApplySaveTimeout)
+		defer cancel()
+		if err := resultStore.SaveLastApply(saveCtx, datastoreApplyResult(result)); err != nil {
+			log.Error("Failed to persist apply result", slog.Any("error", err))
+		}
+	})
+}
+
+func datastoreApplyResult(result engine.ApplyResult) *datastore.ApplyResult {
+	stored := &datastore.ApplyResult{
+		AppliedAt: result.Time,
+		Version:   result.Version,
+		Plugins:   make([]datastore.PluginApplyResult, 0, len(result.Plugins)),
+	}
+	if result.Err != nil {
+		stored.Error = result.Err.Error()
+	}
+	for _, plugin := range result.Plugins {
+		storedPlugin := datastore.PluginApplyResult{
+			Plugin: plugin.Plugin,
+			Status: plugin.Status,
+			Error:  plugin.Error,
+		}
+		for _, operation := range plugin.Operations {
+			storedPlugin.Operations = append(storedPlugin.Operations, datastore.ApplyOperationResult{
+				Operation: operation.Operation,
+				Error:     operation.Error,
+			})
+		}
+		stored.Plugins = append(stored.Plugins, storedPlugin)
+	}
+	return stored
+}
+
+func engineApplyResult(stored *datastore.ApplyResult) engine.ApplyResult {
+	result := engine.ApplyResult{
+		Time:    stored.AppliedAt,
+		Version: stored.Version,
+		Plugins: make([]engine.PluginApplyResult, 0, len(stored.Plugins)),
+	}
+	if stored.Error != "" {
+		result.Err = errors.New(stored.Error)
+	}
+	for _, plugin := range stored.Plugins {
+		resultPlugin := engine.PluginApplyResult{
+			Plugin: plugin.Plugin,
+			Status: plugin.Status,
+			Error:  plugin.Error,
+		}
+		for _, operation := range plugin.Operations {
+			resultPlugin.Operations = append(resultPlugin.Operations, engine.OperationResult{
+				Operation: operation.Operation,
+				Error:     operation.Error,
+			})
+		}
+		result.Plugins = append(result.Plugins, resultPlugin)
+	}
+	return result
+}
@@ -61,7 +61,11 @@ var grpcSocketUmaskMu sync.Mutex
 
 type daemonFlags struct {
 	configPath       string
+	includeDirs      string
+	configMaxSize    int64
+	configMaxStmts   int
 	hardwarePath     string
+	ifIndexPath      string
 	datastorePath    string
 	datastoreMode    string
 	etcdEndpoints    string
@@ -73,30 +77,45 @@ type daemonFlags struct {
 	etcdCertFile     string
 	etcdKeyFile      string
 	etcdCAFile       string
-	logLevel         string
-	version          bool
-	mockVPP          bool
-	vppAPISocket     string
-	vppStatsSocket   string
+
+	sqliteBusyTimeout        time.Duration
+	sqliteSynchronous        string
+	sqliteCheckpointInterval time.Duration
+	logLevel                 string
+	version                  bool
+	mockVPP                  bool
+	vppAPISocket             string
+	vppStatsSocket           string
 
 	// NETCONF settings.
-	netconfListen   string
-	netconfXPath    bool
-	hostKeyPath     string
-	userDBPath      string
-	grpcSocket      string
-	grpcListen      string
-	grpcTLSCert     string
-	grpcTLSKey      string
-	grpcClientCA    string
-	grpcClientID    string
-	grpcClientRole  string
-	metricsListen   string
-	webListen       string
-	webAPITokenFile string
-	snmpListen      string
-	snmpCommunity   string
-	frrApplyMode    string
+	netconfListen        string
+	netconfXPath         bool
+	netconfJSON          bool
+	netconfDSRetries     int
+	netconfDSBreakerOpen time.Duration
+	netconfMaxReply      int
+	netconfMaxConfig     int
+	netconfMaxFilter     int
+	netconfReplyTimeout  time.Duration
+	netconfDrainTimeout  time.Duration
+	hostKeyPath          string
+	userDBPath           string
+	grpcSocket           string
+	grpcListen           string
+	grpcTLSCert          string
+	grpcTLSKey           string
+	grpcClientCA         string
+	grpcClientID         string
+	grpcClientRole       string
+	metricsListen        string
+	healthListen         string
+	webListen            string
+	webAPITokenFile      string
+	snmpListen           string
+	snmpCommunity        string
+	frrApplyMode         string
+	setSystemHostname    bool
+	commitTimeout        time.Duration
 }
 
 func main() {
@@ -140,8 +159,16 @@ func parseFlags() *daemonFlags {
 
 	flag.StringVar(&f.configPath, "config", "/etc/arca-router/arca-router.conf",
 		"Path to configuration file")
+	flag.StringVar(&f.includeDirs, "config-include-dirs", "",
+		"Comma-separated directories @include may read from (default: the configuration file's directory)")
+	flag.Int64Var(&f.configMaxSize, "config-max-size", config.DefaultMaxConfigBytes,
+		"Maximum configuration text size in bytes accepted by the parser (negative disables the limit)")
+	flag.IntVar(&f.configMaxStmts, "config-max-statements", config.DefaultMaxConfigStatements,
+		"Maximum number of statements accepted by the configuration parser (negative disables the limit)")
 	flag.StringVar(&f.hardwarePath, "hardware", "/etc/arca-router/hardware.yaml",
 		"Path to hardware configuration file")
+	flag.StringVar(&f.ifIndexPath, "interface-index", pkgvpp.DefaultInterfaceIndexPath,
+		"Path to the stable interface index file")
 	flag.StringVar(&f.datastorePath, "datastore", "/var/lib/arca-router/config.db",
 		"Path to configuration datastore (SQLite)")
 	flag.StringVar(&f.datastoreMode, "datastore-backend", string(datastore.BackendSQLite),
@@ -164,6 +191,12 @@ func parseFlags() *daemonFlags {
 		"etcd TLS client key path")
 	flag.StringVar(&f.etcdCAFile, "etcd-ca", "",
 		"etcd TLS CA certificate path")
+	flag.DurationVar(&f.sqliteBusyTimeout, "sqlite-busy-timeout", datastore.DefaultSQLiteBusyTimeout,
+		"How long SQLite waits on a locked database before failing (config datastore and NETCONF user database)")
+	flag.StringVar(&f.sqliteSynchronous, "sqlite-synchronous", "",
+		"SQLite synchronous mode: OFF, NORMAL, FULL, or EXTRA (default: FULL for the config datastore, NORMAL for the user database)")
+	flag.DurationVar(&f.sqliteCheckpointInterval, "sqlite-wal-checkpoint-interval", datastore.DefaultSQLiteWALCheckpointInterval,
+		"Interval between SQLite WAL checkpoints that truncate the WAL file (0 disables)")
 	flag.StringVar(&f.logLevel, "log-level", "info",
 		"Log level (debug, info, warn, error)")
 	flag.BoolVar(&f.version, "version", false,
@@ -174,9 +207,25 @@ func parseFlags() *daemonFlags {
 
 	// NETCONF flags
 	flag.StringVar(&f.netconfListen, "netconf-listen", "",
-		"NETCONF/SSH listen address (overrides security netconf ssh listen-address/port and enables NETCONF)")
+		"NETCONF/SSH listen addresses, comma-separated (overrides security netconf ssh listen-address/listen/port and enables NETCONF)")
 	flag.BoolVar(&f.netconfXPath, "netconf-standard-xpath", true,
 		"Advertise the standard NETCONF :xpath capability (enabled by default; set false to suppress)")
+	flag.BoolVar(&f.netconfJSON, "netconf-json-encoding", true,
+		"Allow get-config replies encoded as JSON (enabled by default; set false to reject them)")
+	flag.IntVar(&f.netconfDSRetries, "netconf-datastore-retries", netconf.DefaultDatastoreRetryPolicy().Attempts,
+		"Attempts per NETCONF datastore operation before it fails, with exponential backoff between attempts")
+	flag.DurationVar(&f.netconfDSBreakerOpen, "netconf-datastore-breaker-open", netconf.DefaultDatastoreRetryPolicy().OpenDuration,
+		"How long NETCONF RPCs are rejected with resource-denied after repeated datastore failures")
+	flag.IntVar(&f.netconfMaxReply, "netconf-max-reply-size", netconf.DefaultMessageSizeLimits().ConfigReply,
+		"Maximum NETCONF get-config reply size in bytes (larger replies are streamed)")
+	flag.IntVar(&f.netconfMaxConfig, "netconf-max-config-size", netconf.DefaultMessageSizeLimits().ConfigInput,
+		"Maximum NETCONF edit-config, copy-config, and validate <config> size in bytes (at most the default)")
+	flag.IntVar(&f.netconfMaxFilter, "netconf-max-filter-size", netconf.DefaultMessageSizeLimits().Filter,
+		"Maximum NETCONF get and get-config filter size in bytes (at most the default)")
+	flag.DurationVar(&f.netconfReplyTimeout, "netconf-reply-timeout", netconf.DefaultReplyWriteTimeout,
+		"Close a NETCONF session when a reply write makes no progress for this long (client not reading)")
+	flag.DurationVar(&f.netconfDrainTimeout, "netconf-drain-timeout", netconf.DefaultDrainTimeout,
+		"On shutdown, wait this long for NETCONF sessions to close before closing them (0 closes them at once)")
 	flag.StringVar(&f.hostKeyPath, "host-key", "/var/lib/arca-router/ssh_host_ed25519_key",
 		"Path to SSH host key")
 	flag.StringVar(&f.userDBPath, "user-db", "/var/lib/arca-router/users.db",
@@ -197,6 +246,8 @@ func parseFlags() *daemonFlags {
 		"Comma-separated gRPC client certificate identity=role mappings for method-level RBAC (required with --grpc-listen)")
 	flag.StringVar(&f.metricsListen, "metrics-listen", "",
 		"Prometheus metrics listen address (overrides system services prometheus config; disabled when empty and config disabled)")
+	flag.StringVar(&f.healthListen, "health-listen", "",
+		"Health and readiness probe listen address serving /healthz and /readyz (disabled when empty)")
 	flag.StringVar(&f.webListen, "web-listen", "",
 		"Web UI listen address (overrides system services web-ui config; disabled when empty and config disabled)")
 	flag.StringVar(&f.webAPITokenFile, "web-api-token-file", "",
@@ -207,6 +258,10 @@ func parseFlags() *daemonFlags {
 		"SNMPv2c read-only community (overrides system services snmp config; required when SNMP is enabled)")
 	flag.StringVar(&f.frrApplyMode, "frr-apply-mode", string(pkgfrr.BackendModeTransactional),
 		"FRR apply backend: transactional or file")
+	flag.BoolVar(&f.setSystemHostname, "set-system-hostname", false,
+		"Also set the OS hostname from system host-name on commit")
+	flag.DurationVar(&f.commitTimeout, "commit-timeout", engine.DefaultApplyTimeout,
+		"Maximum time a commit may spend applying changes to VPP and FRR before it is rolled back (0 disables)")
 
 	flag.Parse()
 	return f
@@ -254,7 +309,7 @@ func openConfigStore(f *daemonFlags) (*storesqlite.Store, *datastore.ProcessLock
 		}
 		return nil, nil, nil, err
 	}
-	return storesqlite.New(ds, storesqlite.WithLegacyTextParser(parseLegacyRouterConfigText)), processLock, cfg, nil
+	return storesqlite.New(ds, storesqlite.WithLegacyTextParser(newLegacyRouterConfigTextParser(parserOptionsFromFlags(f)))), processLock, cfg, nil
 }
 
 func buildDatastoreConfig(f *daemonFlags) (*datastore.Config, error) {
@@ -273,8 +328,9 @@ func buildDatastoreConfig(f *daemonFlags) (*datastore.Config, error) {
 			path = "/var/lib/arca-router/config.db"
 		}
 		return &datastore.Config{
-			Backend:    datastore.BackendSQLite,
-			SQLitePath: path,
+			Backend:       datastore.BackendSQLite,
+			SQLitePath:    path,
+			SQLiteOptions: sqliteOptionsFromFlags(f),
 		}, nil
 	case datastore.BackendEtcd:
 		endpoints := parseCommaList(f.etcdEndpoints)
@@ -332,6 +388,19 @@ func parseCommaList(value string) []string {
 	return result
 }
 
+func sqliteOptionsFromFlags(f *daemonFlags) datastore.SQLiteOptions {
+	interval := f.sqliteCheckpointInterval
+	if interval == 0 {
+		// The flag already carries the default, so an explicit 0 means off.
+		interval = -1
+	}
+	return datastore.SQLiteOptions{
+		BusyTimeout:           f.sqliteBusyTimeout,
+		Synchronous:           f.sqliteSynchronous,
+		WALCheckpointInterval: interval,
+	}
+}
+
 func buildEtcdTLSConfig(f *daemonFlags) (*datastore.TLSConfig, error) {
 	hasTLS := f.etcdCertFile != "" || f.etcdKeyFile != "" || f.etcdCAFile != ""
 	if !hasTLS {
@@ -357,17 +426,35 @@ func vppClientOptionsFromFlags(f *daemonFlags) pkgvpp.GovppClientOptions {
 func run(ctx context.Context, f *daemonFlags, log *logger.Logger) error {
 	logDaemonConfiguration(f, log)
 
+	// The health endpoint starts first so probes can observe the daemon as
+	// alive but not ready while the initial configuration is applied.
+	health := newDaemonHealth()
+	var healthErr <-chan error
+	if listen := strings.TrimSpace(f.healthListen); listen != "" {
+		var healthStop func(context.Context) error
+		var err error
+		healthErr, healthStop, err = startHealthServerWithShutdown(ctx, listen, health, log)
+		if err != nil {
+			return err
+		}
+		defer stopDaemonEndpoint(log, "health endpoint", healthStop)
+	}
+
 	runtime, err := newDaemonRuntime(ctx, f, log)
 	if err != nil {
+		health.setInitialApply(err)
 		return err
 	}
 	defer runtime.Close(log)
+	health.setRuntime(runtime.healthProbes(), runtime.engine.LastApply)
+	health.setInitialApply(nil)
 
 	managementPlane, err := startDaemonManagementPlane(ctx, f, runtime, log)
 	if err != nil {
 		return err
 	}
 	defer managementPlane.Stop(log)
+	managementPlane.healthErr = healthErr
 
 	return managementPlane.Wait(ctx, log)
 }
@@ -384,9 +471,11 @@ func logDaemonConfiguration(f *daemonFlags, log *logger.Logger) {
 		slog.String("netconf_listen", f.netconfListen),
 		slog.String("grpc_socket", f.grpcSocket),
 		slog.String("metrics_listen", f.metricsListen),
+		slog.String("health_listen", f.healthListen),
 		slog.String("web_listen", f.webListen),
 		slog.String("snmp_listen", f.snmpListen),
 		slog.String("frr_apply_mode", f.frrApplyMode),
+		slog.Duration("commit_timeout", f.commitTimeout),
 	)
 }
 
@@ -398,6 +487,7 @@ type daemonRuntime struct {
 	plugins         []engine.Plugin
 	vppPlugin       *sbvpp.VPPPlugin
 	frrPlugin       *sbfrr.FRRPlugin
+	userAccounts    *userAccountSyncPlugin
 	configSync      configSyncRuntimeSource
 }
 
@@ -441,14 +531,22 @@ func newDaemonRuntime(ctx context.Context, f *daemonFlags, log *logger.Logger) (
 
 	clusterPlugin := newClusterSyncPlugin(datastoreConfig)
 	vppPlugin := sbvpp.NewVPPPlugin(vppClient, hwConfig, slog.Default())
+	vppPlugin.SetInterfaceIndexPath(f.ifIndexPath)
 	frrPlugin := sbfrr.NewFRRPluginWithApplyMode(slog.Default(), frrApplyMode)
 
-	plugins := []engine.Plugin{clusterPlugin, vppPlugin, frrPlugin}
+	userAccountsPlugin := newUserAccountSyncPlugin()
+	hostnamePlugin := newSystemHostnamePlugin(f.setSystemHostname)
+
+	plugins := []engine.Plugin{clusterPlugin, vppPlugin, frrPlugin, userAccountsPlugin, hostnamePlugin}
 	runtime.vppPlugin = vppPlugin
 	runtime.frrPlugin = frrPlugin
+	runtime.userAccounts = userAccountsPlugin
 
 	eng := engine.NewEngine(plugins, slog.Default())
+	eng.SetApplyTimeout(f.commitTimeout)
 	runtime.engine = eng
+	attachLastApplyStore(ctx, eng, configStore.Legacy(), log)
+	attachAlarmStore(ctx, vppPlugin, configStore.Legacy(), log)
 
 	log.Info("Initializing engine plugins")
 	for _, p := range plugins {
@@ -485,6 +583,18 @@ func newDaemonRuntime(ctx context.Context, f *daemonFlags, log *logger.Logger) (
 	return runtime, nil
 }
 
+// healthProbes returns the dependency checks reported by the readiness
+// endpoint: VPP API connectivity and FRR vtysh reachability.
+func (r *daemonRuntime) healthProbes() []healthProbe {
+	return []healthProbe{
+		{name: "vpp", check: r.vppPlugin.HealthCheck},
+		{name: "frr", check: func(ctx context.Context) error {
+			_, err := pkgfrr.ShowRunningConfig(ctx)
+			return err
+		}},
+	}
+}
+
 func (r *daemonRuntime) Close(log *logger.Logger) {
 	if r == nil {
 		return
@@ -511,6 +621,7 @@ type daemonManagementPlane struct {
 	grpcServer    *nbgrpc.Server
 	grpcListener  net.Listener
 	netconfServer *netconf.SSHServer
+	netconfDrain  time.Duration
 	grpcErr       <-chan error
 	metricsErr    <-chan error
 	metricsStop   func(context.Context) error
@@ -518,10 +629,11 @@ type daemonManagementPlane struct {
 	webStop       func(context.Context) error
 	snmpErr       <-chan error
 	snmpStop      func(context.Context) error
+	healthErr     <-chan error
 }
 
 func startDaemonManagementPlane(ctx context.Context, f *daemonFlags, runtime *daemonRuntime, log *logger.Logger) (_ *daemonManagementPlane, err error) {
-	plane := &daemonManagementPlane{}
+	plane := &daemonManagementPlane{netconfDrain: f.netconfDrainTimeout}
 	defer func() {
 		if err != nil {
 			plane.Stop(log)
@@ -529,19 +641,26 @@ func startDaemonManagementPlane(ctx context.Context, f *daemonFlags, runtime *da
 	}()
 
 	netconfListen := effectiveNETCONFListen(f.netconfListen, runtime.engine.RunningSnapshot())
-	if f.hostKeyPath != "" && netconfListen != "" {
+	if f.hostKeyPath != "" && len(netconfListen) > 0 {
 		plane.netconfServer, err = startNETCONFServer(
 			ctx,
 			f,
 			runtime.datastoreConfig,
 			runtime.engine,
-			newNETCONFOperationalStateProvider(runtime.vppPlugin, runtime.frrPlugin),
+			newNETCONFOperationalStateProvider(runtime.vppPlugin, runtime.frrPlugin, runtime.engine.LastApply),
 			log,
 			netconfListen,
 		)
 		if err != nil {
 			return nil, err
 		}
+		var running *model.RouterConfig
+		if snap := runtime.engine.RunningSnapshot(); snap != nil {
+			running = snap.Config
+		}
+		if err := runtime.userAccounts.Attach(plane.netconfServer.UserDatabase(), running); err != nil {
+			return nil, fmt.Errorf("sync NETCONF user account state: %w", err)
+		}
 	}
 
 	lis, grpcServerOptions, grpcTransport, err := listenGRPCAPI(f)
@@ -555,12 +674,25 @@ func startDaemonManagementPlane(ctx context.Context, f *daemonFlags, runtime *da
 	)
 
 	grpcServer := nbgrpc.NewServer(runtime.engine, runtime.configStore, slog.Default())
-	grpcServer.SetConfigTextParser(parseLegacyRouterConfigText)
+	grpcServer.SetConfigTextParser(newLegacyRouterConfigTextParser(parserOptionsFromFlags(f)))
+	grpcServer.SetBuildInfo(nbgrpc.BuildInfo{Version: Version, Commit: Commit, BuildDate: BuildDate})
 	grpcServer.SetInterfaceStateCollector(runtime.vppPlugin)
 	grpcServer.SetLCPReconciliationSource(newGRPCLCPReconciliationSource(runtime.vppPlugin))
+	grpcServer.SetAlarmSource(newGRPCAlarmSource(runtime.vppPlugin))
 	grpcServer.SetBFDOperationalSource(runtime.frrPlugin)
 	grpcServer.SetQoSCapabilitySource(runtime.vppPlugin)
+	if plane.netconfServer != nil {
+		// A nil *UserDatabase would make a non-nil interface value, so the
+		// key RPCs would not report the store as unavailable.
+		if userDB := plane.netconfServer.UserDatabase(); userDB != nil {
+			grpcServer.SetUserKeyStore(userDB)
+		}
+		grpcServer.SetNETCONFMetricsSource(plane.netconfServer)
+	}
 	plane.grpcServer = grpcServer
+	if err := grpcServer.RestoreScheduledCommit(ctx); err != nil {
+		log.Error("Failed to restore scheduled commit", slog.Any("error", err))
+	}
 
 	webAPITokens, err := loadWebAPITokens(f.webAPITokenFile)
 	if err != nil {
@@ -617,6 +749,13 @@ func (p *daemonManagementPlane) Stop(log *logger.Logger) {
 	if p == nil {
 		return
 	}
+	// NETCONF is drained first so open sessions can finish their RPCs while
+	// the rest of the management plane still serves them.
+	if p.netconfServer != nil {
+		if err := p.netconfServer.Drain(p.netconfDrain); err != nil {
+			log.Error("Failed to stop NETCONF server", slog.Any("error", err))
+		}
+	}
 	stopDaemonEndpoint(log, "metrics endpoint", p.metricsStop)
 	stopDaemonEndpoint(log, "web endpoint", p.webStop)
 	stopDaemonEndpoint(log, "SNMP endpoint", p.snmpStop)
@@ -626,11 +765,6 @@ func (p *daemonManagementPlane) Stop(log *logger.Logger) {
 	if p.grpcListener != nil {
 		_ = p.grpcListener.Close()
 	}
-	if p.netconfServer != nil {
-		if err := p.netconfServer.Stop(); err != nil {
-			log.Error("Failed to stop NETCONF server", slog.Any("error", err))
-		}
-	}
 }
 
 func stopDaemonEndpoint(log *logger.Logger, name string, stop func(context.Context) error) {
@@ -663,34 +797,58 @@ func (p *daemonManagementPlane) Wait(ctx context.Context, log *logger.Logger) er
 		if err != nil {
 			return fmt.Errorf("SNMP endpoint stopped: %w", err)
 		}
+	case err := <-p.healthErr:
+		if err != nil {
+			return fmt.Errorf("health endpoint stopped: %w", err)
+		}
 	}
 
 	return nil
 }
 
-func effectiveNETCONFListen(flagValue string, snapshot *model.ConfigSnapshot) string {
-	if listen := strings.TrimSpace(flagValue); listen != "" {
-		return listen
+// effectiveNETCONFListen returns the NETCONF/SSH listen addresses, or nil
+// when NETCONF is disabled. The flag accepts a comma-separated list; the
+// configuration combines listen-address with every listen entry.
+func effectiveNETCONFListen(flagValue string, snapshot *model.ConfigSnapshot) []string {
+	var addrs []string
+	for _, listen := range strings.Split(flagValue, ",") {
+		if listen = strings.TrimSpace(listen); listen != "" {
+			addrs = append(addrs, listen)
+		}
+	}
+	if len(addrs) > 0 {
+		return addrs
 	}
 	ssh := snapshotNETCONFSSHConfig(snapshot)
 	if ssh == nil {
-		return ""
+		return nil
 	}
 	if ssh.EnabledSet && !ssh.Enabled {
-		return ""
-	}
-	if !ssh.Enabled && strings.TrimSpace(ssh.ListenAddress) == "" && ssh.Port == 0 {
-		return ""
+		return nil
 	}
-	addr := strings.TrimSpace(ssh.ListenAddress)
-	if addr == "" {
-		addr = "127.0.0.1"
+	if !ssh.Enabled && strings.TrimSpace(ssh.ListenAddress) == "" && len(ssh.Listen) == 0 && ssh.Port == 0 {
+		return nil
 	}
 	port := ssh.Port
 	if port == 0 {
 		port = defaultNETCONFPort
 	}
-	return net.JoinHostPort(addr, strconv.Itoa(port))
+	hosts := ssh.Listen
+	if addr := strings.TrimSpace(ssh.ListenAddress); addr != "" || len(hosts) == 0 {
+		if addr == "" {
+			addr = "127.0.0.1"
+		}
+		hosts = append([]string{addr}, hosts...)
+	}
+	seen := make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		addr := net.JoinHostPort(strings.TrimSpace(host), strconv.Itoa(port))
+		if !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
 }
 
 func snapshotNETCONFSSHConfig(snapshot *model.ConfigSnapshot) *model.NETCONFSSHConfig {
@@ -708,27 +866,38 @@ func startNETCONFServer(
 	eng *engine.Engine,
 	stateProvider netconf.OperationalStateProvider,
 	log *logger.Logger,
-	listenAddr string,
+	listenAddrs []string,
 ) (*netconf.SSHServer, error) {
 	log.Info("Starting NETCONF server",
-		slog.String("listen", listenAddr),
+		slog.String("listen", strings.Join(listenAddrs, ",")),
 		slog.Bool("standard_xpath", f.netconfXPath),
 	)
 	ncConfig := netconf.DefaultSSHConfig()
-	ncConfig.ListenAddr = listenAddr
+	ncConfig.ListenAddr = listenAddrs[0]
+	ncConfig.ListenAddrs = listenAddrs
 	ncConfig.HostKeyPath = f.hostKeyPath
 	ncConfig.UserDBPath = f.userDBPath
+	ncConfig.UserDBSQLite = sqliteOptionsFromFlags(f)
 	ncConfig.DatastorePath = f.datastorePath
 	ncConfig.DatastoreConfig = datastoreConfig
 	ncConfig.SkipDatastoreStartupCleanup = true
 	ncConfig.AdvertiseStandardXPath = f.netconfXPath
 	ncConfig.DisableStandardXPath = !f.netconfXPath
+	ncConfig.DisableJSONEncoding = !f.netconfJSON
+	ncConfig.DatastoreRetry.Attempts = f.netconfDSRetries
+	ncConfig.DatastoreRetry.OpenDuration = f.netconfDSBreakerOpen
+	ncConfig.MessageSizeLimits = netconf.MessageSizeLimits{
+		ConfigReply: f.netconfMaxReply,
+		ConfigInput: f.netconfMaxConfig,
+		Filter:      f.netconfMaxFilter,
+	}
+	ncConfig.ReplyWriteTimeout = f.netconfReplyTimeout
 
 	server, err := netconf.NewSSHServer(ncConfig)
 	if err != nil {
 		return nil, fmt.Errorf("create NETCONF server: %w", err)
 	}
-	server.SetCommitHook(newNETCONFCommitHook(eng))
+	server.SetCommitHook(newNETCONFCommitHook(eng, parserOptionsFromFlags(f)))
 	server.SetOperationalStateProvider(stateProvider)
 	if err := server.Start(ctx); err != nil {
 		_ = server.Stop()
@@ -995,17 +1164,17 @@ func loadInitialConfig(ctx context.Context, f *daemonFlags, st internalstore.Con
 		}
 	}
 
-	file, err := os.Open(f.configPath)
-	if err != nil {
+	if _, err := os.Stat(f.configPath); err != nil {
 		if os.IsNotExist(err) {
 			log.Warn("Config file not found, using empty config", slog.String("path", f.configPath))
 			return model.NewSnapshot(model.NewRouterConfig(), 1, "system", "initial startup"), "empty", nil
 		}
 		return nil, "", fmt.Errorf("open config %s: %w", f.configPath, err)
 	}
-	defer func() { _ = file.Close() }()
 
-	legacyCfg, err := parseLegacyConfig(file)
+	opts := parserOptionsFromFlags(f)
+	opts.IncludeDirs = splitIncludeDirs(f.includeDirs)
+	legacyCfg, err := config.ParseFile(f.configPath, opts)
 	if err != nil {
 		return nil, "", fmt.Errorf("parse config %s: %w", f.configPath, err)
 	}
@@ -1071,25 +1240,49 @@ func initialSnapshotVersion(snap *model.ConfigSnapshot) uint64 {
 	return 1
 }
 
-func parseLegacyConfig(r io.Reader) (*config.Config, error) {
-	parser := config.NewParser(r)
+// splitIncludeDirs parses the -config-include-dirs flag value.
+func splitIncludeDirs(value string) []string {
+	var dirs []string
+	for _, dir := range strings.Split(value, ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// parserOptionsFromFlags returns the configuration parser input limits set by
+// -config-max-size and -config-max-statements.
+func parserOptionsFromFlags(f *daemonFlags) config.ParserOptions {
+	return config.ParserOptions{
+		MaxInputBytes: f.configMaxSize,
+		MaxStatements: f.configMaxStmts,
+	}
+}
+
+func parseLegacyConfig(r io.Reader, opts config.ParserOptions) (*config.Config, error) {
+	parser := config.NewParserWithOptions(r, opts)
 	return parser.Parse()
 }
 
-func parseLegacyRouterConfigText(text string) (*model.RouterConfig, error) {
-	legacyCfg, err := parseLegacyConfig(strings.NewReader(text))
-	if err != nil {
-		return nil, err
+// newLegacyRouterConfigTextParser returns a parser for configuration text
+// submitted at runtime, bounded by opts.
+func newLegacyRouterConfigTextParser(opts config.ParserOptions) func(string) (*model.RouterConfig, error) {
+	return func(text string) (*model.RouterConfig, error) {
+		legacyCfg, err := parseLegacyConfig(strings.NewReader(text), opts)
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(legacyCfg), nil
 	}
-	return model.FromLegacyConfig(legacyCfg), nil
 }
 
-func newNETCONFCommitHook(eng *engine.Engine) netconf.CommitHook {
+func newNETCONFCommitHook(eng *engine.Engine, opts config.ParserOptions) netconf.CommitHook {
 	return func(ctx context.Context, req *netconf.CommitHookRequest, persist func(context.Context) (string, error)) (string, error) {
 		if req == nil {
 			return "", fmt.Errorf("commit request is nil")
 		}
-		legacyCfg, err := parseLegacyConfig(strings.NewReader(req.ConfigText))
+		legacyCfg, err := parseLegacyConfig(strings.NewReader(req.ConfigText), opts)
 		if err != nil {
 			return "", fmt.Errorf("parse candidate config: %w", err)
 		}
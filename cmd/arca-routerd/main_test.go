@@ -23,6 +23,7 @@ import (
 	"github.com/akam1o/arca-router/internal/engine"
 	"github.com/akam1o/arca-router/internal/model"
 	"github.com/akam1o/arca-router/internal/store"
+	"github.com/akam1o/arca-router/pkg/config"
 	"github.com/akam1o/arca-router/pkg/datastore"
 	"github.com/akam1o/arca-router/pkg/logger"
 	"github.com/akam1o/arca-router/pkg/netconf"
@@ -226,6 +227,63 @@ func TestDaemonManagementPlaneStopStopsAuxiliaryEndpoints(t *testing.T) {
 	}
 }
 
+func TestDaemonManagementPlaneStopDrainsNETCONF(t *testing.T) {
+	dir := t.TempDir()
+	cfg := netconf.DefaultSSHConfig()
+	cfg.ListenAddr = "127.0.0.1:0"
+	cfg.HostKeyPath = filepath.Join(dir, "ssh_host_ed25519_key")
+	cfg.UserDBPath = filepath.Join(dir, "users.db")
+	cfg.DatastorePath = filepath.Join(dir, "config.db")
+	server, err := netconf.NewSSHServer(cfg)
+	if err != nil {
+		t.Fatalf("NewSSHServer() error = %v", err)
+	}
+	t.Cleanup(func() { _ = server.Stop() })
+	if err := server.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	conn, err := net.Dial("tcp", server.GetMetrics().ListenAddrs[0])
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+	waitForDaemonCondition(t, func() bool { return server.GetMetrics().ActiveConnections == 1 })
+
+	plane := &daemonManagementPlane{netconfServer: server, netconfDrain: 10 * time.Second}
+	stopped := make(chan struct{})
+	go func() {
+		plane.Stop(testDaemonLogger())
+		close(stopped)
+	}()
+	waitForDaemonCondition(t, func() bool { return server.GetMetrics().IsDraining })
+	select {
+	case <-stopped:
+		t.Fatal("Stop() returned with a NETCONF connection still open")
+	default:
+	}
+
+	_ = conn.Close()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop() did not return after the last NETCONF connection closed")
+	}
+	if metrics := server.GetMetrics(); metrics.IsDraining || metrics.IsListening {
+		t.Fatalf("GetMetrics() after Stop() = %+v, want stopped", metrics)
+	}
+}
+
+func waitForDaemonCondition(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func TestLoadInitialConfigPrefersDatastore(t *testing.T) {
 	configPath := filepath.Join(t.TempDir(), "arca-router.conf")
 	if err := os.WriteFile(configPath, []byte("set system host-name file-router\n"), 0600); err != nil {
@@ -266,6 +324,32 @@ func TestLoadInitialConfigFallsBackToFile(t *testing.T) {
 	}
 }
 
+func TestLoadInitialConfigAppliesParserLimitFlags(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "arca-router.conf")
+	text := "set system host-name file-router\nset system domain-name example.net\n"
+	if err := os.WriteFile(configPath, []byte(text), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	f := &daemonFlags{configPath: configPath, configMaxStmts: 1}
+	_, _, err := loadInitialConfig(context.Background(), f, &initialConfigStore{}, testDaemonLogger())
+	if err == nil || !strings.Contains(err.Error(), "maximum of 1 statements") {
+		t.Fatalf("loadInitialConfig() error = %v, want statement limit error", err)
+	}
+
+	f = &daemonFlags{configPath: configPath, configMaxSize: int64(len(text) - 1)}
+	_, _, err = loadInitialConfig(context.Background(), f, &initialConfigStore{}, testDaemonLogger())
+	if err == nil || !strings.Contains(err.Error(), "exceeds maximum size") {
+		t.Fatalf("loadInitialConfig() error = %v, want input size limit error", err)
+	}
+
+	hook := newNETCONFCommitHook(engine.NewEngine(nil, slog.Default()), parserOptionsFromFlags(&daemonFlags{configMaxStmts: 1}))
+	_, err = hook(context.Background(), &netconf.CommitHookRequest{ConfigText: text}, nil)
+	if err == nil || !strings.Contains(err.Error(), "maximum of 1 statements") {
+		t.Fatalf("commit hook error = %v, want statement limit error", err)
+	}
+}
+
 func TestLoadInitialConfigRejectsConfigOpenError(t *testing.T) {
 	_, _, err := loadInitialConfig(context.Background(), &daemonFlags{configPath: "\x00"}, &initialConfigStore{}, testDaemonLogger())
 	if err == nil {
@@ -800,7 +884,7 @@ func TestBuildGRPCServerTLSConfigRejectsInsecureKeyPermissions(t *testing.T) {
 }
 
 func TestEffectiveNETCONFListenUsesFlagOverride(t *testing.T) {
-	got := effectiveNETCONFListen(":2830", nil)
+	got := strings.Join(effectiveNETCONFListen(":2830", nil), ",")
 	if got != ":2830" {
 		t.Fatalf("effectiveNETCONFListen() = %q, want %q", got, ":2830")
 	}
@@ -867,7 +951,7 @@ func TestEffectiveNETCONFListenUsesConfigPort(t *testing.T) {
 		},
 	}
 
-	got := effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test"))
+	got := strings.Join(effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")), ",")
 	if got != "127.0.0.1:1830" {
 		t.Fatalf("effectiveNETCONFListen() = %q, want %q", got, "127.0.0.1:1830")
 	}
@@ -885,7 +969,7 @@ func TestEffectiveNETCONFListenUsesConfigListenAddress(t *testing.T) {
 		},
 	}
 
-	got := effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test"))
+	got := strings.Join(effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")), ",")
 	if got != "192.0.2.10:1830" {
 		t.Fatalf("effectiveNETCONFListen() = %q, want %q", got, "192.0.2.10:1830")
 	}
@@ -899,14 +983,14 @@ func TestEffectiveNETCONFListenUsesEnabledDefault(t *testing.T) {
 		},
 	}
 
-	got := effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test"))
+	got := strings.Join(effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")), ",")
 	if got != "127.0.0.1:830" {
 		t.Fatalf("effectiveNETCONFListen() = %q, want %q", got, "127.0.0.1:830")
 	}
 }
 
 func TestEffectiveNETCONFListenDisabledByDefault(t *testing.T) {
-	if got := effectiveNETCONFListen("", nil); got != "" {
+	if got := strings.Join(effectiveNETCONFListen("", nil), ","); got != "" {
 		t.Fatalf("effectiveNETCONFListen() = %q, want empty", got)
 	}
 }
@@ -922,7 +1006,7 @@ func TestEffectiveNETCONFListenIgnoresPortWhenDisabled(t *testing.T) {
 		},
 	}
 
-	if got := effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")); got != "" {
+	if got := strings.Join(effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")), ","); got != "" {
 		t.Fatalf("effectiveNETCONFListen() = %q, want empty", got)
 	}
 }
@@ -933,7 +1017,7 @@ func TestEffectiveNETCONFListenIgnoresEmptySSHConfig(t *testing.T) {
 		NETCONF: &model.NETCONFSecurityConfig{SSH: &model.NETCONFSSHConfig{}},
 	}
 
-	if got := effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")); got != "" {
+	if got := strings.Join(effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")), ","); got != "" {
 		t.Fatalf("effectiveNETCONFListen() = %q, want empty", got)
 	}
 }
@@ -1081,7 +1165,7 @@ func TestNETCONFCommitHookAppliesEngineBeforePersist(t *testing.T) {
 		Interfaces: map[string]*model.InterfaceConfig{},
 	}, 1)
 
-	hook := newNETCONFCommitHook(eng)
+	hook := newNETCONFCommitHook(eng, config.DefaultParserOptions())
 	persistCalled := false
 	commitID, err := hook(context.Background(), &netconf.CommitHookRequest{
 		User:       "alice",
@@ -1112,7 +1196,7 @@ func TestNETCONFCommitHookRejectsNoopCandidate(t *testing.T) {
 		Interfaces: map[string]*model.InterfaceConfig{},
 	}, 1)
 
-	hook := newNETCONFCommitHook(eng)
+	hook := newNETCONFCommitHook(eng, config.DefaultParserOptions())
 	persistCalled := false
 	_, err := hook(context.Background(), &netconf.CommitHookRequest{
 		User:       "alice",
@@ -1140,7 +1224,7 @@ func TestNETCONFCommitHookRollsBackEngineWhenPersistFails(t *testing.T) {
 		Interfaces: map[string]*model.InterfaceConfig{},
 	}, 1)
 
-	hook := newNETCONFCommitHook(eng)
+	hook := newNETCONFCommitHook(eng, config.DefaultParserOptions())
 	_, err := hook(context.Background(), &netconf.CommitHookRequest{
 		User:       "alice",
 		Message:    "NETCONF commit by alice",
@@ -1155,3 +1239,25 @@ func TestNETCONFCommitHookRollsBackEngineWhenPersistFails(t *testing.T) {
 		t.Fatalf("engine hostname after failed persist = %q, want router1", got)
 	}
 }
+
+func TestEffectiveNETCONFListenCombinesListenAddresses(t *testing.T) {
+	cfg := model.NewRouterConfig()
+	cfg.Security = &model.SecurityConfig{
+		NETCONF: &model.NETCONFSecurityConfig{
+			SSH: &model.NETCONFSSHConfig{
+				ListenAddress: "127.0.0.1",
+				Listen:        []string{"192.0.2.10", "2001:db8::10", "127.0.0.1"},
+				Port:          1830,
+			},
+		},
+	}
+
+	got := strings.Join(effectiveNETCONFListen("", model.NewSnapshot(cfg, 1, "test", "test")), ",")
+	want := "127.0.0.1:1830,192.0.2.10:1830,[2001:db8::10]:1830"
+	if got != want {
+		t.Fatalf("effectiveNETCONFListen() = %q, want %q", got, want)
+	}
+	if got := strings.Join(effectiveNETCONFListen("127.0.0.1:2830, [::1]:2830", nil), ","); got != "127.0.0.1:2830,[::1]:2830" {
+		t.Fatalf("effectiveNETCONFListen() = %q, want flag list", got)
+	}
+}
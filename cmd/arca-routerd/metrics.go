@@ -68,7 +68,10 @@ type routerMetrics struct {
 	NETCONFTotalConns                      uint64
 	NETCONFSuccess                         uint64
 	NETCONFFailures                        uint64
+	NETCONFReapedSessions                  uint64
+	NETCONFReplyWriteTimeouts              uint64
 	NETCONFListening                       bool
+	NETCONFDraining                        bool
 	RunningHostname                        string
 	DatastoreBackend                       string
 	DatastoreEtcdEndpoints                 []string
@@ -193,7 +196,10 @@ func (s metricsSource) snapshot(now time.Time) routerMetrics {
 		metrics.NETCONFTotalConns = nc.TotalConnections
 		metrics.NETCONFSuccess = nc.SuccessfulHandshakes
 		metrics.NETCONFFailures = nc.FailedHandshakes
+		metrics.NETCONFReapedSessions = nc.ReapedSessions
+		metrics.NETCONFReplyWriteTimeouts = nc.ReplyWriteTimeouts
 		metrics.NETCONFListening = nc.IsListening
+		metrics.NETCONFDraining = nc.IsDraining
 	}
 	if s.frr != nil {
 		vrrp := s.frr.VRRPOperationalStatus()
@@ -669,15 +675,24 @@ func (s metricsSource) handleMetrics(w http.ResponseWriter, r *http.Request) {
 	writeMetricType(&b, "arca_router_netconf_successful_handshakes", "counter")
 	writeMetricHelp(&b, "arca_router_netconf_failed_handshakes", "Total failed NETCONF SSH handshakes.")
 	writeMetricType(&b, "arca_router_netconf_failed_handshakes", "counter")
+	writeMetricHelp(&b, "arca_router_netconf_reaped_sessions", "Total NETCONF sessions closed because their SSH channel stopped answering keepalive probes.")
+	writeMetricType(&b, "arca_router_netconf_reaped_sessions", "counter")
+	writeMetricHelp(&b, "arca_router_netconf_reply_write_timeouts", "Total NETCONF sessions closed because the client stopped reading replies.")
+	writeMetricType(&b, "arca_router_netconf_reply_write_timeouts", "counter")
 	writeMetricHelp(&b, "arca_router_netconf_listening", "Whether the NETCONF SSH server is listening.")
 	writeMetricType(&b, "arca_router_netconf_listening", "gauge")
+	writeMetricHelp(&b, "arca_router_netconf_draining", "Whether the NETCONF SSH server is draining existing sessions.")
+	writeMetricType(&b, "arca_router_netconf_draining", "gauge")
 
 	writeMetricValue(&b, "arca_router_netconf_active_sessions", float64(metrics.NETCONFActiveSessions))
 	writeMetricValue(&b, "arca_router_netconf_active_connections", float64(metrics.NETCONFActiveConns))
 	writeMetricValue(&b, "arca_router_netconf_total_connections", float64(metrics.NETCONFTotalConns))
 	writeMetricValue(&b, "arca_router_netconf_successful_handshakes", float64(metrics.NETCONFSuccess))
 	writeMetricValue(&b, "arca_router_netconf_failed_handshakes", float64(metrics.NETCONFFailures))
+	writeMetricValue(&b, "arca_router_netconf_reaped_sessions", float64(metrics.NETCONFReapedSessions))
+	writeMetricValue(&b, "arca_router_netconf_reply_write_timeouts", float64(metrics.NETCONFReplyWriteTimeouts))
 	writeMetricBool(&b, "arca_router_netconf_listening", metrics.NETCONFListening)
+	writeMetricBool(&b, "arca_router_netconf_draining", metrics.NETCONFDraining)
 
 	_, _ = w.Write([]byte(b.String()))
 }
@@ -275,6 +275,7 @@ func TestMetricsEndpointExportsRouterMetrics(t *testing.T) {
 		"arca_router_class_of_service_capability_error 0",
 		"arca_router_class_of_service_capability_last_check_timestamp_seconds 1700000500",
 		"arca_router_netconf_listening 0",
+		"arca_router_netconf_draining 0",
 	} {
 		if !strings.Contains(text, want) {
 			t.Fatalf("/metrics missing %q:\n%s", want, text)
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 
+	"github.com/akam1o/arca-router/internal/engine"
 	"github.com/akam1o/arca-router/internal/model"
 	sbfrr "github.com/akam1o/arca-router/internal/southbound/frr"
 	pkgfrr "github.com/akam1o/arca-router/pkg/frr"
@@ -20,16 +21,17 @@ type netconfBFDStatusSource interface {
 type netconfOperationalStateProvider struct {
 	collector   interfaceStateCollector
 	bfdSource   netconfBFDStatusSource
+	lastApply   func() engine.ApplyResult
 	routeReader pkgfrr.RouteStatusReader
 	bgpReader   pkgfrr.BGPSummaryStatusReader
 	ospfReader  pkgfrr.OSPFNeighborStatusReader
 }
 
-func newNETCONFOperationalStateProvider(collector interfaceStateCollector, bfdSource netconfBFDStatusSource) netconf.OperationalStateProvider {
-	if collector == nil && bfdSource == nil {
+func newNETCONFOperationalStateProvider(collector interfaceStateCollector, bfdSource netconfBFDStatusSource, lastApply func() engine.ApplyResult) netconf.OperationalStateProvider {
+	if collector == nil && bfdSource == nil && lastApply == nil {
 		return nil
 	}
-	provider := &netconfOperationalStateProvider{collector: collector, bfdSource: bfdSource}
+	provider := &netconfOperationalStateProvider{collector: collector, bfdSource: bfdSource, lastApply: lastApply}
 	if bfdSource != nil {
 		provider.routeReader = pkgfrr.NewVtyshRouteStatusReader()
 		provider.bgpReader = pkgfrr.NewVtyshBGPSummaryStatusReader()
@@ -38,6 +40,39 @@ func newNETCONFOperationalStateProvider(collector interfaceStateCollector, bfdSo
 	return provider
 }
 
+func (p *netconfOperationalStateProvider) LastApply(context.Context) (*netconf.LastApplyOperationalState, error) {
+	if p.lastApply == nil {
+		return nil, nil
+	}
+	result := p.lastApply()
+	if result.Time.IsZero() {
+		return nil, nil
+	}
+	state := &netconf.LastApplyOperationalState{
+		Time:    result.Time,
+		Version: result.Version,
+		Plugins: make([]netconf.PluginApplyOperationalState, 0, len(result.Plugins)),
+	}
+	if result.Err != nil {
+		state.Error = result.Err.Error()
+	}
+	for _, plugin := range result.Plugins {
+		pluginState := netconf.PluginApplyOperationalState{
+			Name:   plugin.Plugin,
+			Status: plugin.Status,
+			Error:  plugin.Error,
+		}
+		for _, operation := range plugin.Operations {
+			pluginState.Operations = append(pluginState.Operations, netconf.ApplyOperationOperationalState{
+				Operation: operation.Operation,
+				Error:     operation.Error,
+			})
+		}
+		state.Plugins = append(state.Plugins, pluginState)
+	}
+	return state, nil
+}
+
 func (p *netconfOperationalStateProvider) InterfaceStates(ctx context.Context) (map[string]*netconf.InterfaceOperationalState, error) {
 	if p.collector == nil {
 		return nil, nil
@@ -61,10 +96,12 @@ func (p *netconfOperationalStateProvider) InterfaceStates(ctx context.Context) (
 		}
 		converted := &netconf.InterfaceOperationalState{
 			Name:        stateName,
+			IfIndex:     state.IfIndex,
 			AdminStatus: state.AdminStatus,
 			OperStatus:  state.OperStatus,
 			MAC:         state.MAC,
 			QoSProfile:  state.QoSProfile,
+			Addresses:   append([]string(nil), state.Addresses...),
 			IPv4TableID: state.IPv4TableID,
 			IPv6TableID: state.IPv6TableID,
 		}
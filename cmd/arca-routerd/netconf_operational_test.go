@@ -2,12 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/akam1o/arca-router/internal/engine"
 	"github.com/akam1o/arca-router/internal/model"
 	sbfrr "github.com/akam1o/arca-router/internal/southbound/frr"
 	pkgfrr "github.com/akam1o/arca-router/pkg/frr"
+	"github.com/akam1o/arca-router/pkg/netconf"
 )
 
 type fakeInterfaceStateCollector struct {
@@ -57,8 +61,42 @@ func (r *fakeNETCONFOSPFNeighborStatusReader) ReadOSPFNeighborStatus(ctx context
 }
 
 func TestNewNETCONFOperationalStateProviderNilCollector(t *testing.T) {
-	if provider := newNETCONFOperationalStateProvider(nil, nil); provider != nil {
-		t.Fatalf("newNETCONFOperationalStateProvider(nil, nil) = %#v, want nil", provider)
+	if provider := newNETCONFOperationalStateProvider(nil, nil, nil); provider != nil {
+		t.Fatalf("newNETCONFOperationalStateProvider(nil, nil, nil) = %#v, want nil", provider)
+	}
+}
+
+func TestNETCONFOperationalStateProviderReportsLastApply(t *testing.T) {
+	result := engine.ApplyResult{}
+	provider := newNETCONFOperationalStateProvider(nil, nil, func() engine.ApplyResult { return result })
+	lastApplyProvider, ok := provider.(netconf.LastApplyProvider)
+	if !ok {
+		t.Fatalf("provider %T does not implement netconf.LastApplyProvider", provider)
+	}
+	if state, err := lastApplyProvider.LastApply(context.Background()); err != nil || state != nil {
+		t.Fatalf("LastApply() before any apply = %#v, %v; want nil", state, err)
+	}
+
+	result = engine.ApplyResult{
+		Time:    time.Date(2026, 5, 14, 6, 0, 0, 0, time.UTC),
+		Version: 3,
+		Err:     errors.New("apply boom"),
+		Plugins: []engine.PluginApplyResult{{Plugin: "vpp", Status: engine.PluginFailed, Error: "apply boom", Operations: []engine.OperationResult{
+			{Operation: "create interface ge-0/0/0"},
+			{Operation: "apply interface ge-0/0/0 addresses", Error: "apply boom"},
+		}}},
+	}
+	state, err := lastApplyProvider.LastApply(context.Background())
+	if err != nil {
+		t.Fatalf("LastApply() error = %v", err)
+	}
+	want := netconf.PluginApplyOperationalState{Name: "vpp", Status: "failed", Error: "apply boom", Operations: []netconf.ApplyOperationOperationalState{
+		{Operation: "create interface ge-0/0/0"},
+		{Operation: "apply interface ge-0/0/0 addresses", Error: "apply boom"},
+	}}
+	if state == nil || state.Version != 3 || state.Error != "apply boom" || len(state.Plugins) != 1 ||
+		!reflect.DeepEqual(state.Plugins[0], want) {
+		t.Fatalf("LastApply() = %#v, want failed vpp apply", state)
 	}
 }
 
@@ -71,6 +109,7 @@ func TestNETCONFOperationalStateProviderConvertsInterfaceState(t *testing.T) {
 				OperStatus:  "down",
 				MAC:         "02:00:00:00:00:01",
 				QoSProfile:  "WAN",
+				Addresses:   []string{"192.0.2.1/24"},
 				IPv4TableID: 100,
 				IPv6TableID: 100,
 				Counters: &model.InterfaceCounters{
@@ -92,7 +131,7 @@ func TestNETCONFOperationalStateProviderConvertsInterfaceState(t *testing.T) {
 				},
 			},
 		},
-	}, nil)
+	}, nil, nil)
 
 	states, err := provider.InterfaceStates(context.Background())
 	if err != nil {
@@ -105,6 +144,9 @@ func TestNETCONFOperationalStateProviderConvertsInterfaceState(t *testing.T) {
 	if state.AdminStatus != "up" || state.OperStatus != "down" || state.MAC != "02:00:00:00:00:01" || state.QoSProfile != "WAN" || state.IPv4TableID != 100 || state.IPv6TableID != 100 {
 		t.Fatalf("state = %#v", state)
 	}
+	if len(state.Addresses) != 1 || state.Addresses[0] != "192.0.2.1/24" {
+		t.Fatalf("addresses = %v, want [192.0.2.1/24]", state.Addresses)
+	}
 	if state.Counters == nil || state.Counters.RxPackets != 10 || state.Counters.TxPackets != 20 ||
 		state.Counters.RxBytes != 1000 || state.Counters.TxBytes != 2000 ||
 		state.Counters.RxErrors != 1 || state.Counters.TxErrors != 2 || state.Counters.Drops != 3 {
@@ -243,7 +285,7 @@ func TestNETCONFOperationalStateProviderConvertsBFDStatus(t *testing.T) {
 				RxFailPackets:     3,
 			},
 		},
-	}})
+	}}, nil)
 
 	status, err := provider.BFDStatus(context.Background())
 	if err != nil {
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/internal/model"
+)
+
+// systemHostnamePlugin mirrors "set system host-name" into the OS hostname
+// when -set-system-hostname is given. FRR receives the hostname through its
+// own plugin either way. Deleting host-name leaves the OS hostname alone.
+type systemHostnamePlugin struct {
+	mu       sync.Mutex
+	enabled  bool
+	get      func() (string, error)
+	set      func(ctx context.Context, name string) error
+	previous string
+}
+
+func newSystemHostnamePlugin(enabled bool) *systemHostnamePlugin {
+	return &systemHostnamePlugin{enabled: enabled, get: os.Hostname, set: setOSHostname}
+}
+
+func (p *systemHostnamePlugin) Name() string { return "system-hostname" }
+
+func (p *systemHostnamePlugin) Init(ctx context.Context) error { return nil }
+
+func (p *systemHostnamePlugin) Close() error { return nil }
+
+func (p *systemHostnamePlugin) HealthCheck(ctx context.Context) error { return nil }
+
+func (p *systemHostnamePlugin) ValidateChanges(ctx context.Context, diff *engine.ConfigDiff) error {
+	return nil
+}
+
+func (p *systemHostnamePlugin) ApplyChanges(ctx context.Context, diff *engine.ConfigDiff) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.previous = ""
+	if !p.enabled || diff == nil || !diff.SystemChanged {
+		return nil
+	}
+	name := systemHostName(diff.NewSystem)
+	if name == "" || name == systemHostName(diff.OldSystem) {
+		return nil
+	}
+	current, err := p.get()
+	if err != nil {
+		return fmt.Errorf("read OS hostname: %w", err)
+	}
+	if current == name {
+		return nil
+	}
+	p.previous = current
+	if err := p.set(ctx, name); err != nil {
+		return fmt.Errorf("set OS hostname %s: %w", name, err)
+	}
+	return nil
+}
+
+func (p *systemHostnamePlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	previous := p.previous
+	p.previous = ""
+	if previous == "" {
+		return nil
+	}
+	if err := p.set(ctx, previous); err != nil {
+		return fmt.Errorf("restore OS hostname %s: %w", previous, err)
+	}
+	return nil
+}
+
+func systemHostName(system *model.SystemConfig) string {
+	if system == nil {
+		return ""
+	}
+	return system.HostName
+}
+
+func setOSHostname(ctx context.Context, name string) error {
+	output, err := exec.CommandContext(ctx, "hostnamectl", "set-hostname", name).CombinedOutput()
+	if err != nil {
+		if detail := strings.TrimSpace(string(output)); detail != "" {
+			return fmt.Errorf("hostnamectl: %s: %w", detail, err)
+		}
+		return fmt.Errorf("hostnamectl: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/internal/model"
+)
+
+func TestSystemHostnamePluginSetsAndRestoresOSHostname(t *testing.T) {
+	hostname := "localhost"
+	var calls []string
+	plugin := newSystemHostnamePlugin(true)
+	plugin.get = func() (string, error) { return hostname, nil }
+	plugin.set = func(ctx context.Context, name string) error {
+		calls = append(calls, name)
+		hostname = name
+		return nil
+	}
+	ctx := context.Background()
+
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), hostNameConfig("edge-1"))); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if hostname != "edge-1" {
+		t.Fatalf("OS hostname = %q, want edge-1", hostname)
+	}
+	if err := plugin.RollbackChanges(ctx, nil); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if hostname != "localhost" {
+		t.Fatalf("OS hostname after rollback = %q, want localhost", hostname)
+	}
+
+	calls = nil
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(hostNameConfig("edge-1"), model.NewRouterConfig())); err != nil {
+		t.Fatalf("ApplyChanges(delete host-name) error = %v", err)
+	}
+	if err := plugin.RollbackChanges(ctx, nil); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if len(calls) != 0 {
+		t.Fatalf("set calls after deleting host-name = %v, want none", calls)
+	}
+}
+
+func TestSystemHostnamePluginDisabledLeavesOSHostname(t *testing.T) {
+	plugin := newSystemHostnamePlugin(false)
+	plugin.get = func() (string, error) { return "", errors.New("unexpected read") }
+	plugin.set = func(ctx context.Context, name string) error { return errors.New("unexpected set") }
+	eng := engine.NewEngine([]engine.Plugin{plugin}, slog.Default())
+
+	if err := eng.Apply(context.Background(), hostNameConfig("edge-1"), "admin", "set host-name"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+}
+
+func hostNameConfig(name string) *model.RouterConfig {
+	cfg := model.NewRouterConfig()
+	cfg.System = &model.SystemConfig{HostName: name}
+	return cfg
+}
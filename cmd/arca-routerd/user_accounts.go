@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/internal/model"
+	"github.com/akam1o/arca-router/pkg/netconf"
+)
+
+// userAccountStore is the subset of the NETCONF user database that account
+// sync needs.
+type userAccountStore interface {
+	GetUser(username string) (*netconf.User, error)
+	UpdateUser(username, passwordHash, role string, enabled bool) error
+}
+
+// userAccountSyncPlugin mirrors "set security users user <u> disable" into
+// the NETCONF user database so that a disabled account fails authentication
+// without being deleted. The database is attached once the NETCONF server
+// starts; commits before that only affect web authentication.
+type userAccountSyncPlugin struct {
+	mu    sync.Mutex
+	store userAccountStore
+}
+
+func newUserAccountSyncPlugin() *userAccountSyncPlugin {
+	return &userAccountSyncPlugin{}
+}
+
+func (p *userAccountSyncPlugin) Name() string { return "user-account-sync" }
+
+func (p *userAccountSyncPlugin) Init(ctx context.Context) error { return nil }
+
+func (p *userAccountSyncPlugin) Close() error { return nil }
+
+func (p *userAccountSyncPlugin) HealthCheck(ctx context.Context) error { return nil }
+
+func (p *userAccountSyncPlugin) ValidateChanges(ctx context.Context, diff *engine.ConfigDiff) error {
+	return nil
+}
+
+// Attach installs the user database and disables the accounts that the
+// running configuration already marks as disabled.
+func (p *userAccountSyncPlugin) Attach(store userAccountStore, running *model.RouterConfig) error {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.store = store
+	if store == nil || running == nil {
+		return nil
+	}
+	return syncUserAccountStates(store, nil, running.Security)
+}
+
+func (p *userAccountSyncPlugin) ApplyChanges(ctx context.Context, diff *engine.ConfigDiff) error {
+	if diff == nil || !diff.SecurityChanged {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.store == nil {
+		return nil
+	}
+	return syncUserAccountStates(p.store, diff.OldSecurity, diff.NewSecurity)
+}
+
+func (p *userAccountSyncPlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff) error {
+	if diff == nil || !diff.SecurityChanged {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.store == nil {
+		return nil
+	}
+	return syncUserAccountStates(p.store, diff.NewSecurity, diff.OldSecurity)
+}
+
+// syncUserAccountStates disables database accounts that next marks as
+// disabled and re-enables accounts whose disable was removed. Accounts the
+// configuration never disabled are left alone, so state managed directly in
+// the user database is preserved. Configured users without a database
+// account are skipped.
+func syncUserAccountStates(store userAccountStore, prev, next *model.SecurityConfig) error {
+	wasDisabled := disabledUserSet(prev)
+	isDisabled := disabledUserSet(next)
+	names := make([]string, 0, len(wasDisabled)+len(isDisabled))
+	for name := range isDisabled {
+		names = append(names, name)
+	}
+	for name := range wasDisabled {
+		if _, ok := isDisabled[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, disable := isDisabled[name]
+		user, err := store.GetUser(name)
+		if errors.Is(err, netconf.ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("look up user %s: %w", name, err)
+		}
+		if user.Enabled == !disable {
+			continue
+		}
+		if err := store.UpdateUser(name, "", "", !disable); err != nil {
+			return fmt.Errorf("update user %s account state: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func disabledUserSet(sec *model.SecurityConfig) map[string]struct{} {
+	disabled := map[string]struct{}{}
+	if sec == nil {
+		return disabled
+	}
+	for name, user := range sec.Users {
+		if user != nil && user.Disabled {
+			disabled[name] = struct{}{}
+		}
+	}
+	return disabled
+}
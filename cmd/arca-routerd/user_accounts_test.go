@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/internal/model"
+	"github.com/akam1o/arca-router/pkg/auth"
+	"github.com/akam1o/arca-router/pkg/logger"
+	"github.com/akam1o/arca-router/pkg/netconf"
+)
+
+func TestUserAccountSyncDisablesAndReenablesNETCONFUser(t *testing.T) {
+	userDB, err := netconf.NewUserDatabase(filepath.Join(t.TempDir(), "users.db"), logger.New("test", logger.DefaultConfig()))
+	if err != nil {
+		t.Fatalf("NewUserDatabase() error = %v", err)
+	}
+	t.Cleanup(func() { _ = userDB.Close() })
+	const password = "alice-password-123"
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+	if err := userDB.CreateUser("alice", hash, netconf.RoleOperator); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	plugin := newUserAccountSyncPlugin()
+	eng := engine.NewEngine([]engine.Plugin{plugin}, slog.Default())
+	if err := plugin.Attach(userDB, model.NewRouterConfig()); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if err := eng.Apply(ctx, userAccountConfig(hash, true), "admin", "disable alice"); err != nil {
+		t.Fatalf("Apply(disable) error = %v", err)
+	}
+	if _, reason, err := userDB.VerifyPasswordWithReason("alice", password); err == nil || reason != "user_disabled" {
+		t.Fatalf("VerifyPasswordWithReason() reason = %q, err = %v; want user_disabled", reason, err)
+	}
+	user, err := userDB.GetUser("alice")
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if user.Role != netconf.RoleOperator || user.PasswordHash != hash {
+		t.Fatalf("disable changed account: role=%q hash changed=%v", user.Role, user.PasswordHash != hash)
+	}
+
+	if err := eng.Apply(ctx, userAccountConfig(hash, false), "admin", "enable alice"); err != nil {
+		t.Fatalf("Apply(enable) error = %v", err)
+	}
+	if _, reason, err := userDB.VerifyPasswordWithReason("alice", password); err != nil {
+		t.Fatalf("VerifyPasswordWithReason() after re-enable reason = %q, err = %v", reason, err)
+	}
+}
+
+func TestUserAccountSyncAttachAppliesRunningDisableAndSkipsUnknownUsers(t *testing.T) {
+	store := &fakeUserAccountStore{users: map[string]*netconf.User{
+		"alice": {Username: "alice", Enabled: true},
+	}}
+	cfg := userAccountConfig("", true)
+	cfg.Security.Users["ghost"] = &model.UserConfig{Role: "read-only", Disabled: true}
+
+	if err := newUserAccountSyncPlugin().Attach(store, cfg); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	if store.users["alice"].Enabled {
+		t.Fatal("alice remains enabled after attach")
+	}
+	if len(store.updates) != 1 || store.updates[0] != "alice" {
+		t.Fatalf("updates = %v, want only alice", store.updates)
+	}
+}
+
+func userAccountConfig(passwordHash string, disabled bool) *model.RouterConfig {
+	cfg := model.NewRouterConfig()
+	cfg.Security = &model.SecurityConfig{Users: map[string]*model.UserConfig{
+		"alice": {Password: passwordHash, Role: "operator", Disabled: disabled},
+	}}
+	return cfg
+}
+
+type fakeUserAccountStore struct {
+	users   map[string]*netconf.User
+	updates []string
+}
+
+func (s *fakeUserAccountStore) GetUser(username string) (*netconf.User, error) {
+	user, ok := s.users[username]
+	if !ok {
+		return nil, netconf.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (s *fakeUserAccountStore) UpdateUser(username, passwordHash, role string, enabled bool) error {
+	s.users[username].Enabled = enabled
+	s.updates = append(s.updates, username)
+	return nil
+}
@@ -401,6 +401,7 @@ type webCommitEntry struct {
 type webAuthUser struct {
 	PasswordHash string
 	Role         string
+	Disabled     bool
 }
 
 type webAPIToken struct {
@@ -219,8 +219,10 @@ func authenticateWebUser(w http.ResponseWriter, r *http.Request, users map[strin
 	if found {
 		passwordHash = user.PasswordHash
 	}
+	// Disabled accounts still pay for the hash comparison so their
+	// rejection is indistinguishable from a wrong password.
 	valid, err := auth.VerifyPassword(password, passwordHash)
-	if err != nil || !found || !valid {
+	if err != nil || !found || !valid || user.Disabled {
 		writeWebAuthChallenge(w)
 		return "", "", false
 	}
@@ -327,6 +329,7 @@ func (s metricsSource) webAuthUsers() map[string]webAuthUser {
 		users[username] = webAuthUser{
 			PasswordHash: user.Password,
 			Role:         role,
+			Disabled:     user.Disabled,
 		}
 	}
 	if len(users) == 0 {
@@ -1005,6 +1005,22 @@ func TestWebEndpointAcceptsReadOnlyBasicAuth(t *testing.T) {
 	}
 }
 
+func TestWebEndpointRejectsDisabledUser(t *testing.T) {
+	source := newWebAuthTestSource(t, "monitor", "secret", "read-only")
+	cfg := source.engine.RunningSnapshot().Config
+	cfg.Security.Users["monitor"].Disabled = true
+	source.engine.InitializeRunning(cfg, 43)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/config", nil)
+	req.SetBasicAuth("monitor", "secret")
+	rec := httptest.NewRecorder()
+	source.handleWebConfig(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
 func TestWebConfigEndpointRedactsWriterRole(t *testing.T) {
 	source := newWebAuthTestSource(t, "operator", "secret", "operator")
 
@@ -2607,7 +2623,7 @@ func newWebConfigAPITestSource(t *testing.T, role string) (metricsSource, *engin
 	}
 	eng.InitializeRunning(cfg, 42)
 	configAPI := nbgrpc.NewServer(eng, nil, slog.Default())
-	configAPI.SetConfigTextParser(parseLegacyRouterConfigText)
+	configAPI.SetConfigTextParser(newLegacyRouterConfigTextParser(pkgconfig.DefaultParserOptions()))
 	return metricsSource{
 		startedAt: time.Now().Add(-2 * time.Minute),
 		engine:    eng,
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+)
+
+// alarmClient is implemented by clients that report active system alarms.
+type alarmClient interface {
+	GetSystemAlarms(context.Context) (*grpcclient.SystemAlarmsInfo, error)
+}
+
+func showSystemAlarms(ctx context.Context, client showClient) error {
+	reporter, ok := client.(alarmClient)
+	if !ok {
+		return fmt.Errorf("system alarms are not supported by this client")
+	}
+	info, err := reporter.GetSystemAlarms(ctx)
+	if err != nil {
+		return err
+	}
+	printSystemAlarms(info)
+	return nil
+}
+
+func printSystemAlarms(info *grpcclient.SystemAlarmsInfo) {
+	if info == nil || len(info.Alarms) == 0 {
+		fmt.Println("No alarms currently active")
+		return
+	}
+	fmt.Printf("%d alarms currently active\n", len(info.Alarms))
+	fmt.Printf("%-25s %-22s %-16s %s\n", "Alarm time", "Type", "Object", "Description")
+	for _, alarm := range info.Alarms {
+		fmt.Printf("%-25s %-22s %-16s %s\n", formatCommitAtTime(alarm.RaisedAt), alarm.Type, alarm.Object, alarm.Description)
+	}
+}
@@ -479,7 +479,7 @@ func parseInterfaceImpactChange(sign byte, line string) (changeImpactInterfaceCh
 				change.value = tokens[i+1]
 			}
 			return change, change.name != ""
-		case "description", "mtu", "speed":
+		case "description", "mtu", "speed", "bandwidth":
 			change.operation = tokens[i]
 			if i+1 < len(tokens) {
 				change.value = tokens[i+1]
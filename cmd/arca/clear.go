@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+// interfaceStatisticsClient is implemented by clients that can reset
+// interface counters.
+type interfaceStatisticsClient interface {
+	ClearInterfaceStatistics(context.Context, string) error
+}
+
+// runClear runs the operational "clear" commands.
+func runClear(ctx context.Context, client showClient, args []string) error {
+	if len(args) > 0 && args[0] == "interfaces" {
+		return clearInterfaceStatistics(ctx, client, args[1:])
+	}
+	return clearScheduledCommit(ctx, client, args)
+}
+
+// clearInterfaceStatistics resets the counters of one interface, or of all
+// interfaces when no name is given.
+func clearInterfaceStatistics(ctx context.Context, client showClient, args []string) error {
+	if len(args) < 1 || len(args) > 2 || args[0] != "statistics" {
+		return fmt.Errorf("usage: clear interfaces statistics [<name>]")
+	}
+	clearer, ok := client.(interfaceStatisticsClient)
+	if !ok {
+		return fmt.Errorf("clearing interface statistics is not supported by this client")
+	}
+	var name string
+	if len(args) == 2 {
+		name = args[1]
+	}
+	if err := clearer.ClearInterfaceStatistics(ctx, name); err != nil {
+		return fmt.Errorf("clear interface statistics: %w", err)
+	}
+	if name == "" {
+		fmt.Println("Statistics cleared on all interfaces")
+	} else {
+		fmt.Printf("Statistics cleared on %s\n", name)
+	}
+	return nil
+}
@@ -18,7 +18,7 @@ func routeTextOptions(args []string) (protocol, addressFamily string, err error)
 		return "", addressFamily, nil
 	}
 	if args[0] != "protocol" {
-		return "", "", fmt.Errorf("'show route' accepts '[inet|inet6] protocol <proto>' or no arguments")
+		return "", "", fmt.Errorf("'show route' accepts '[inet|inet6] protocol <proto>', '[inet|inet6] summary', or no arguments")
 	}
 	if len(args) < 2 {
 		return "", "", fmt.Errorf("'protocol' requires a protocol name")
@@ -107,6 +107,29 @@ func bfdStatusRequested(args []string) (bool, error) {
 	return true, nil
 }
 
+// neighborTableOptions parses "arp [interface <name>]" and
+// "ipv6 neighbors [interface <name>]".
+func neighborTableOptions(args []string) (addressFamily, nameFilter string, err error) {
+	command := "show arp"
+	addressFamily = routeAddressFamilyIPv4
+	rest := args[1:]
+	if args[0] == "ipv6" {
+		if len(args) < 2 || args[1] != "neighbors" {
+			return "", "", fmt.Errorf("'show ipv6' requires 'neighbors' subcommand")
+		}
+		command = "show ipv6 neighbors"
+		addressFamily = routeAddressFamilyIPv6
+		rest = args[2:]
+	}
+	if len(rest) == 0 {
+		return addressFamily, "", nil
+	}
+	if len(rest) != 2 || rest[0] != "interface" {
+		return "", "", fmt.Errorf("'%s' accepts 'interface <name>' or no arguments", command)
+	}
+	return addressFamily, rest[1], nil
+}
+
 func routingInstancesNameFilter(args []string) (string, error) {
 	if len(args) == 0 {
 		return "", nil
@@ -213,7 +236,11 @@ func filterInput(r rune) (rune, bool) {
 func createCompleter() *readline.PrefixCompleter {
 	return readline.NewPrefixCompleter(
 		readline.PcItem("help"),
-		readline.PcItem("configure"),
+		readline.PcItem("configure",
+			readline.PcItem("exclusive"),
+			readline.PcItem("private"),
+			readline.PcItem("shared"),
+		),
 		readline.PcItem("exit"),
 		readline.PcItem("quit"),
 		readline.PcItem("show",
@@ -221,6 +248,14 @@ func createCompleter() *readline.PrefixCompleter {
 				readline.PcItem("rollback"),
 			),
 			readline.PcItem("compatibility"),
+			readline.PcItem("system",
+				readline.PcItem("information"),
+				readline.PcItem("commit",
+					readline.PcItem("last"),
+					readline.PcItem("compare"),
+				),
+				readline.PcItem("alarms"),
+			),
 			readline.PcItem("interfaces"),
 			readline.PcItem("bgp",
 				readline.PcItem("summary"),
@@ -233,6 +268,11 @@ func createCompleter() *readline.PrefixCompleter {
 			readline.PcItem("lcp"),
 			readline.PcItem("ha"),
 			readline.PcItem("class-of-service"),
+			readline.PcItem("security",
+				readline.PcItem("users",
+					readline.PcItem("user"),
+				),
+			),
 			readline.PcItem("evpn"),
 			readline.PcItem("telemetry",
 				readline.PcItem("path"),
@@ -242,14 +282,18 @@ func createCompleter() *readline.PrefixCompleter {
 			),
 			readline.PcItem("route",
 				readline.PcItem("protocol"),
+				readline.PcItem("summary"),
 			),
 			readline.PcItem("compare"),
-			readline.PcItem("history"),
+			readline.PcItem("history",
+				readline.PcItem("skip"),
+			),
 		),
 		readline.PcItem("set",
 			readline.PcItem("system",
 				readline.PcItem("host-name"),
 			),
+			readline.PcItem("groups"),
 			readline.PcItem("interfaces"),
 			readline.PcItem("routing-options",
 				readline.PcItem("autonomous-system"),
@@ -257,6 +301,13 @@ func createCompleter() *readline.PrefixCompleter {
 				readline.PcItem("static",
 					readline.PcItem("route"),
 				),
+				readline.PcItem("administrative-distance",
+					readline.PcItem("protocol",
+						readline.PcItem("bgp"),
+						readline.PcItem("ospf"),
+						readline.PcItem("ospf3"),
+					),
+				),
 			),
 			readline.PcItem("protocols",
 				readline.PcItem("bgp",
@@ -264,6 +315,7 @@ func createCompleter() *readline.PrefixCompleter {
 				),
 				readline.PcItem("ospf",
 					readline.PcItem("router-id"),
+					readline.PcItem("reference-bandwidth"),
 					readline.PcItem("area"),
 				),
 			),
@@ -274,16 +326,61 @@ func createCompleter() *readline.PrefixCompleter {
 			readline.PcItem("routing-options"),
 			readline.PcItem("protocols"),
 		),
+		readline.PcItem("deactivate",
+			readline.PcItem("interfaces"),
+			readline.PcItem("routing-options"),
+			readline.PcItem("protocols"),
+			readline.PcItem("policy-options"),
+		),
+		readline.PcItem("activate",
+			readline.PcItem("interfaces"),
+			readline.PcItem("routing-options"),
+			readline.PcItem("protocols"),
+			readline.PcItem("policy-options"),
+		),
+		readline.PcItem("insert",
+			readline.PcItem("policy-options",
+				readline.PcItem("policy-statement"),
+				readline.PcItem("prefix-list"),
+			),
+		),
 		readline.PcItem("commit",
 			readline.PcItem("check"),
 			readline.PcItem("and-quit"),
 			readline.PcItem("comment"),
+			readline.PcItem("confirmed",
+				readline.PcItem("persist"),
+			),
+			readline.PcItem("persist-id"),
+			readline.PcItem("at"),
+		),
+		readline.PcItem("clear",
+			readline.PcItem("system",
+				readline.PcItem("commit"),
+			),
+			readline.PcItem("interfaces",
+				readline.PcItem("statistics"),
+			),
 		),
 		readline.PcItem("check",
 			readline.PcItem("upgrade",
 				readline.PcItem("backup"),
 			),
 		),
+		readline.PcItem("request",
+			readline.PcItem("security",
+				readline.PcItem("users",
+					readline.PcItem("user"),
+				),
+			),
+			readline.PcItem("system",
+				readline.PcItem("datastore",
+					readline.PcItem("check",
+						readline.PcItem("repair"),
+					),
+				),
+			),
+		),
 		readline.PcItem("backup",
 			readline.PcItem("configuration",
 				readline.PcItem("rollback"),
@@ -294,6 +391,12 @@ func createCompleter() *readline.PrefixCompleter {
 				readline.PcItem("rollback"),
 			),
 		),
+		readline.PcItem("load",
+			readline.PcItem("patch"),
+		),
+		readline.PcItem("replace",
+			readline.PcItem("pattern"),
+		),
 		readline.PcItem("rollback"),
 		readline.PcItem("discard-changes"),
 		readline.PcItem("compare"),
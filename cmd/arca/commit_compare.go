@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+)
+
+// commitCompareHistoryLimit bounds the history searched when resolving a
+// short commit ID. It matches the daemon's history page limit.
+const commitCompareHistoryLimit = 1000
+
+// showCommitCompare prints what changed from commit fromID to commit toID,
+// as diffed by the daemon's datastore, after a header that identifies both
+// commits.
+func showCommitCompare(ctx context.Context, client showClient, fromID, toID string) error {
+	diffText, from, to, err := commitCompareDiff(ctx, client, fromID, toID)
+	if err != nil {
+		return err
+	}
+	printCommitCompareEntry("From", from)
+	printCommitCompareEntry("To", to)
+	fmt.Println()
+	if diffText == "" {
+		fmt.Println("No changes")
+	} else {
+		fmt.Println(diffText)
+	}
+	return nil
+}
+
+func commitCompareDiff(ctx context.Context, client showClient, fromID, toID string) (string, grpcclient.CommitInfo, grpcclient.CommitInfo, error) {
+	from, err := resolveCommit(ctx, client, fromID)
+	if err != nil {
+		return "", grpcclient.CommitInfo{}, grpcclient.CommitInfo{}, err
+	}
+	to, err := resolveCommit(ctx, client, toID)
+	if err != nil {
+		return "", grpcclient.CommitInfo{}, grpcclient.CommitInfo{}, err
+	}
+	diffText, _, err := client.CompareCommits(ctx, from.CommitID, to.CommitID)
+	if err != nil {
+		return "", grpcclient.CommitInfo{}, grpcclient.CommitInfo{}, fmt.Errorf("failed to compare commits: %w", err)
+	}
+	return diffText, from, to, nil
+}
+
+// resolveCommit loads a commit by its full ID or by a unique prefix of it,
+// such as the short ID printed by "show history". Full IDs are loaded
+// directly, so only prefixes are limited to the recent history.
+func resolveCommit(ctx context.Context, client showClient, id string) (grpcclient.CommitInfo, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return grpcclient.CommitInfo{}, fmt.Errorf("commit ID is required")
+	}
+	if isFullCommitID(id) {
+		commit, err := client.GetCommit(ctx, id)
+		if err != nil {
+			return grpcclient.CommitInfo{}, fmt.Errorf("failed to load commit %s: %w", id, err)
+		}
+		return commit, nil
+	}
+	history, err := client.ListHistory(ctx, commitCompareHistoryLimit, 0)
+	if err != nil {
+		return grpcclient.CommitInfo{}, fmt.Errorf("failed to load commit history: %w", err)
+	}
+	match := ""
+	for _, entry := range history {
+		if entry.CommitID == id {
+			match = id
+			break
+		}
+		if strings.HasPrefix(entry.CommitID, id) {
+			if match != "" {
+				return grpcclient.CommitInfo{}, fmt.Errorf("commit ID %s is ambiguous", id)
+			}
+			match = entry.CommitID
+		}
+	}
+	if match == "" {
+		return grpcclient.CommitInfo{}, fmt.Errorf("commit %s not found", id)
+	}
+	commit, err := client.GetCommit(ctx, match)
+	if err != nil {
+		return grpcclient.CommitInfo{}, fmt.Errorf("failed to load commit %s: %w", id, err)
+	}
+	return commit, nil
+}
+
+// isFullCommitID reports whether id is a complete commit ID rather than a
+// prefix of one. Commit IDs are UUIDs in their canonical string form.
+func isFullCommitID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil && len(id) == len(uuid.Nil.String())
+}
+
+func printCommitCompareEntry(label string, commit grpcclient.CommitInfo) {
+	timestamp := "-"
+	if !commit.Timestamp.IsZero() {
+		timestamp = commit.Timestamp.Local().Format("2006-01-02 15:04:05")
+	}
+	fmt.Printf("%-4s  %-8s  %-19s  %-16s  %s\n", label,
+		shortCommitID(commit.CommitID), timestamp, formatRouteValue(commit.User), strings.TrimSpace(commit.Message))
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/akam1o/arca-router/internal/model"
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+	sbvpp "github.com/akam1o/arca-router/internal/southbound/vpp"
 	configcli "github.com/akam1o/arca-router/pkg/cli"
 	pkgconfig "github.com/akam1o/arca-router/pkg/config"
+	pkgfrr "github.com/akam1o/arca-router/pkg/frr"
 )
 
 func (sh *interactiveShell) cmdBackup(ctx context.Context, args []string) error {
@@ -54,6 +59,9 @@ func (sh *interactiveShell) cmdRestore(ctx context.Context, args []string) error
 		if err := validateRestorableConfigurationText(text); err != nil {
 			return fmt.Errorf("validate configuration backup: %w", err)
 		}
+		if err := sh.authorizeCandidateReplacement(ctx, text); err != nil {
+			return err
+		}
 		if err := sh.client.ReplaceCandidate(ctx, sh.sessionID, text); err != nil {
 			return fmt.Errorf("restore configuration: %w", err)
 		}
@@ -72,6 +80,9 @@ func (sh *interactiveShell) cmdRestore(ctx context.Context, args []string) error
 		if err := validateRestorableConfigurationText(text); err != nil {
 			return fmt.Errorf("validate rollback configuration: %w", err)
 		}
+		if err := sh.authorizeCandidateReplacement(ctx, text); err != nil {
+			return err
+		}
 		if err := sh.client.ReplaceCandidate(ctx, sh.sessionID, text); err != nil {
 			return fmt.Errorf("restore configuration: %w", err)
 		}
@@ -81,6 +92,146 @@ func (sh *interactiveShell) cmdRestore(ctx context.Context, args []string) error
 	return fmt.Errorf("usage: restore configuration <path> | restore configuration rollback <N>")
 }
 
+// authorizeCandidateReplacement checks that the shell's role may replace the
+// candidate with text, which must not touch the security hierarchy for an
+// operator. The daemon enforces the same rule; checking first gives a clear
+// error before anything is sent.
+func (sh *interactiveShell) authorizeCandidateReplacement(ctx context.Context, text string) error {
+	if sh.commandRole() == "admin" {
+		return nil
+	}
+	candidate, err := sh.client.GetCandidate(ctx, sh.sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load candidate configuration: %w", err)
+	}
+	return configcli.AuthorizeConfigReplacement(sh.commandRole(), candidate, text)
+}
+
+// authorizeRollback checks that the shell's role may roll the running
+// configuration back to commitID.
+func (sh *interactiveShell) authorizeRollback(ctx context.Context, commitID string) error {
+	if sh.commandRole() == "admin" {
+		return nil
+	}
+	running, err := runningConfigurationBackupText(ctx, sh.client)
+	if err != nil {
+		return fmt.Errorf("failed to load running configuration: %w", err)
+	}
+	target, err := sh.client.GetCommit(ctx, commitID)
+	if err != nil {
+		return fmt.Errorf("failed to load rollback commit: %w", err)
+	}
+	return configcli.AuthorizeConfigReplacement(sh.commandRole(), running, target.ConfigText)
+}
+
+// cmdLoad applies a patch saved from "show | compare" to the candidate. Each
+// statement is authorized like the set or delete command it stands for, and
+// the patch is applied only if every removed statement is still present.
+func (sh *interactiveShell) cmdLoad(ctx context.Context, args []string) error {
+	if sh.mode != modeConfiguration {
+		return fmt.Errorf("'load' command only available in configuration mode")
+	}
+	if len(args) != 2 || args[0] != "patch" {
+		return fmt.Errorf("usage: load patch <path>")
+	}
+	data, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("read patch: %w", err)
+	}
+	text := string(data)
+	if pkgconfig.ContainsRedactedSecretValue(text) {
+		return fmt.Errorf("patch with redacted values cannot be loaded")
+	}
+	patch, err := configcli.ParsePatch(text)
+	if err != nil {
+		return fmt.Errorf("parse patch: %w", err)
+	}
+	if len(patch) == 0 {
+		fmt.Println("No changes")
+		return nil
+	}
+	added, removed := 0, 0
+	for _, line := range patch {
+		command := "set"
+		if line.Remove {
+			command = "delete"
+			removed++
+		} else {
+			added++
+		}
+		if err := configcli.AuthorizeCommand(sh.commandRole(), command, line.Path()); err != nil {
+			return fmt.Errorf("%s: %w", line, err)
+		}
+	}
+
+	candidate, err := sh.client.GetCandidate(ctx, sh.sessionID)
+	if err != nil {
+		return err
+	}
+	patched, err := configcli.ApplyPatch(candidate, patch)
+	if err != nil {
+		return err
+	}
+	if err := sh.client.ReplaceCandidate(ctx, sh.sessionID, patched); err != nil {
+		return fmt.Errorf("load patch: %w", err)
+	}
+	fmt.Printf("patch loaded from %s: %d added, %d removed\n", args[1], added, removed)
+	return nil
+}
+
+// cmdReplace implements "replace pattern <old> [with] <new>": a regexp
+// rename of descriptions and policy names across the candidate. The affected
+// statements are previewed and the rename is applied only once confirmed.
+func (sh *interactiveShell) cmdReplace(ctx context.Context, args []string) error {
+	if sh.mode != modeConfiguration {
+		return fmt.Errorf("'replace' command only available in configuration mode")
+	}
+	if len(args) == 4 && args[2] == "with" {
+		args = []string{args[0], args[1], args[3]}
+	}
+	if len(args) != 3 || args[0] != "pattern" {
+		return fmt.Errorf("usage: replace pattern <old> [with] <new>")
+	}
+
+	candidate, err := sh.client.GetCandidate(ctx, sh.sessionID)
+	if err != nil {
+		return err
+	}
+	replaced, changes, err := configcli.ReplacePattern(candidate, args[1], args[2])
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Println("No matching values")
+		return nil
+	}
+	for _, change := range changes {
+		for _, step := range []struct{ command, statement string }{{"delete", change.Old}, {"set", change.New}} {
+			tokens, err := configcli.TokenizeCommand(step.statement)
+			if err != nil {
+				return err
+			}
+			if err := configcli.AuthorizeCommand(sh.commandRole(), step.command, tokens[1:]); err != nil {
+				return fmt.Errorf("%s: %w", step.statement, err)
+			}
+		}
+	}
+
+	fmt.Printf("%d statement(s) will change:\n", len(changes))
+	for _, change := range changes {
+		fmt.Printf("- %s\n+ %s\n", change.Old, change.New)
+	}
+	if !sh.confirm("Apply these changes?") {
+		fmt.Println("replace canceled")
+		return nil
+	}
+	if err := sh.client.ReplaceCandidate(ctx, sh.sessionID, replaced); err != nil {
+		return fmt.Errorf("replace pattern: %w", err)
+	}
+	fmt.Printf("%d statement(s) changed\n", len(changes))
+	return nil
+}
+
 func (sh *interactiveShell) writeConfigurationBackup(path, text string) error {
 	if err := writeConfigBackupFile(path, text); err != nil {
 		return err
@@ -134,6 +285,25 @@ func validateRestorableConfigurationText(text string) error {
 	return validateConfigurationText(text)
 }
 
+// redactedConfigurationText masks credential material in configuration text
+// before it is displayed by re-serializing it through pkgconfig.RedactSecrets.
+// Text that is already redacted, such as running text from the daemon, passes
+// through unchanged apart from formatting.
+func redactedConfigurationText(text string) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return text, nil
+	}
+	cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		return "", fmt.Errorf("parse config for display: %w", err)
+	}
+	redacted, err := pkgconfig.ToSetCommandsRedactedWithError(cfg)
+	if err != nil {
+		return "", fmt.Errorf("redact config for display: %w", err)
+	}
+	return redacted, nil
+}
+
 func (sh *interactiveShell) cmdSet(ctx context.Context, args []string) error {
 	if sh.mode != modeConfiguration {
 		return fmt.Errorf("'set' command only available in configuration mode")
@@ -161,6 +331,139 @@ func (sh *interactiveShell) cmdDelete(ctx context.Context, args []string) error
 	return nil
 }
 
+// cmdActivation handles "activate" and "deactivate", which toggle whether a
+// configuration subtree is applied without deleting it.
+func (sh *interactiveShell) cmdActivation(ctx context.Context, action string, args []string) error {
+	if sh.mode != modeConfiguration {
+		return fmt.Errorf("'%s' command only available in configuration mode", action)
+	}
+	if len(args) == 0 && len(sh.editPath) == 0 {
+		return fmt.Errorf("'%s' requires arguments", action)
+	}
+	fullPath := append(append([]string(nil), sh.editPath...), args...)
+	if err := sh.client.EditCandidate(ctx, sh.sessionID, action+" "+configcli.NormalizeConfigPath(fullPath)); err != nil {
+		return err
+	}
+	fmt.Println("[edit]")
+	return nil
+}
+
+// Output forms of show configuration selected with "| display <form>".
+const (
+	displayHierarchical = ""
+	displaySet          = "set"
+	displayFRR          = "frr"
+	displayVPP          = "vpp"
+)
+
+// cutDisplay strips a trailing "| display <form>" from show configuration
+// arguments and returns the form, or displayHierarchical when there is none.
+func cutDisplay(args []string) ([]string, string) {
+	n := len(args) - 3
+	if n < 0 || args[n] != "|" || args[n+1] != "display" {
+		return args, displayHierarchical
+	}
+	switch form := args[n+2]; form {
+	case displaySet, displayFRR, displayVPP:
+		return args[:n], form
+	default:
+		return args, displayHierarchical
+	}
+}
+
+// configurationDisplayText formats set-command text for show configuration:
+// the hierarchical curly-brace view by default, flat set commands with
+// inactive markers for "| display set", or the FRR configuration and VPP
+// operations the configuration would produce for "| display frr" and
+// "| display vpp". Rendering never applies anything.
+func configurationDisplayText(text, display string) (string, error) {
+	switch display {
+	case displaySet:
+		return markInactiveStatements(text), nil
+	case displayFRR, displayVPP:
+		return renderConfiguration(text, display)
+	default:
+		return strings.TrimSuffix(pkgconfig.SetCommandsToHierarchical(text), "\n"), nil
+	}
+}
+
+// renderConfiguration translates set-command text the way the southbound
+// plugins do on commit: the FRR configuration file for displayFRR and the
+// VPP operation summary for displayVPP.
+func renderConfiguration(text, display string) (string, error) {
+	legacyCfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		return "", fmt.Errorf("parse configuration: %w", err)
+	}
+	routerCfg := model.FromLegacyConfig(legacyCfg)
+	if display == displayVPP {
+		// RenderOperations skips deactivated statements itself.
+		ops, err := sbvpp.RenderOperations(routerCfg)
+		if err != nil {
+			return "", fmt.Errorf("render VPP operations: %w", err)
+		}
+		return strings.Join(ops, "\n"), nil
+	}
+	cfg, err := routerCfg.ActiveConfig()
+	if err != nil {
+		return "", err
+	}
+	frrConfig, err := pkgfrr.GenerateFRRConfig(cfg.ToLegacyConfig())
+	if err != nil {
+		return "", fmt.Errorf("generate FRR config: %w", err)
+	}
+	content, err := pkgfrr.GenerateFRRConfigFile(frrConfig)
+	if err != nil {
+		return "", fmt.Errorf("generate FRR config file: %w", err)
+	}
+	return strings.TrimSuffix(content, "\n"), nil
+}
+
+// markInactiveStatements prefixes set statements that fall under a
+// deactivated path with "inactive:" for display.
+func markInactiveStatements(text string) string {
+	lines := strings.Split(text, "\n")
+	var inactive []string
+	for _, line := range lines {
+		if path, ok := strings.CutPrefix(strings.TrimSpace(line), "deactivate "); ok {
+			inactive = append(inactive, path)
+		}
+	}
+	if len(inactive) == 0 {
+		return text
+	}
+	for i, line := range lines {
+		if pkgconfig.IsInactiveStatement(strings.TrimSpace(line), inactive) {
+			lines[i] = "inactive: " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (sh *interactiveShell) cmdInsert(ctx context.Context, args []string) error {
+	if sh.mode != modeConfiguration {
+		return fmt.Errorf("'insert' command only available in configuration mode")
+	}
+	if _, err := configcli.ParseInsertCommand(args, sh.editPath); err != nil {
+		return err
+	}
+	fullPath := append(append([]string(nil), sh.editPath...), args...)
+	insertCmd := "insert " + configcli.NormalizeConfigPath(fullPath)
+	if err := sh.client.EditCandidate(ctx, sh.sessionID, insertCmd); err != nil {
+		return err
+	}
+	fmt.Println("[edit]")
+	return nil
+}
+
+// confirmedCommitClient is implemented by clients that support confirmed
+// commits and persist-id confirmation.
+type confirmedCommitClient interface {
+	CommitWithOptions(context.Context, string, string, string, grpcclient.ConfirmedCommitOptions) (grpcclient.CommitResult, error)
+}
+
+const maxConfirmedCommitMinutes = 1440
+
 func (sh *interactiveShell) cmdCommit(ctx context.Context, args []string) error {
 	if sh.mode != modeConfiguration {
 		return fmt.Errorf("'commit' command only available in configuration mode")
@@ -169,6 +472,8 @@ func (sh *interactiveShell) cmdCommit(ctx context.Context, args []string) error
 	message := ""
 	check := false
 	andQuit := false
+	var commitAt time.Time
+	var confirmOpts grpcclient.ConfirmedCommitOptions
 
 	for i := 0; i < len(args); i++ {
 		switch args[i] {
@@ -183,6 +488,41 @@ func (sh *interactiveShell) cmdCommit(ctx context.Context, args []string) error
 			} else {
 				return fmt.Errorf("'comment' requires an argument")
 			}
+		case "confirmed":
+			confirmOpts.Confirmed = true
+			if i+1 < len(args) {
+				if minutes, err := strconv.Atoi(args[i+1]); err == nil {
+					if minutes < 1 || minutes > maxConfirmedCommitMinutes {
+						return fmt.Errorf("confirmed commit timeout must be between 1 and %d minutes", maxConfirmedCommitMinutes)
+					}
+					confirmOpts.Timeout = time.Duration(minutes) * time.Minute
+					i++
+				}
+			}
+		case "persist":
+			if i+1 < len(args) {
+				confirmOpts.Persist = args[i+1]
+				i++
+			} else {
+				return fmt.Errorf("'persist' requires an argument")
+			}
+		case "at":
+			if i+1 >= len(args) {
+				return fmt.Errorf("'at' requires a time: %s", commitAtFormats)
+			}
+			at, err := parseCommitAtTime(args[i+1], time.Now())
+			if err != nil {
+				return err
+			}
+			commitAt = at
+			i++
+		case "persist-id":
+			if i+1 < len(args) {
+				confirmOpts.PersistID = args[i+1]
+				i++
+			} else {
+				return fmt.Errorf("'persist-id' requires an argument")
+			}
 		default:
 			return fmt.Errorf("unknown commit option: %s", args[i])
 		}
@@ -194,8 +534,24 @@ func (sh *interactiveShell) cmdCommit(ctx context.Context, args []string) error
 	if check && message != "" {
 		return fmt.Errorf("'check' and 'comment' cannot be used together")
 	}
+	if check && (confirmOpts.Confirmed || confirmOpts.PersistID != "") {
+		return fmt.Errorf("'check' cannot be used with a confirmed commit")
+	}
+	if confirmOpts.Persist != "" && !confirmOpts.Confirmed {
+		return fmt.Errorf("'persist' requires 'confirmed'")
+	}
+	if !commitAt.IsZero() && (check || confirmOpts.Confirmed || confirmOpts.PersistID != "") {
+		return fmt.Errorf("'at' cannot be used with 'check' or a confirmed commit")
+	}
 
 	if check {
+		lines, errorCount := sh.candidateValidationIssues(ctx)
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+		if errorCount > 0 {
+			return fmt.Errorf("configuration check failed: %d error(s)", errorCount)
+		}
 		if err := sh.client.ValidateCandidate(ctx, sh.sessionID); err != nil {
 			return fmt.Errorf("configuration check failed: %w", err)
 		}
@@ -206,20 +562,39 @@ func (sh *interactiveShell) cmdCommit(ctx context.Context, args []string) error
 		return nil
 	}
 
+	if !commitAt.IsZero() {
+		return sh.scheduleCommit(ctx, message, commitAt, andQuit)
+	}
+
 	diffText, hasChanges, diffErr := sh.client.Diff(ctx, sh.sessionID)
 	for _, warning := range commitRollbackArchiveWarnings(ctx, sh.client) {
 		fmt.Println(warning)
 	}
 	user := currentUsername()
 
-	commitID, version, err := sh.client.Commit(ctx, sh.sessionID, user, message)
+	var result grpcclient.CommitResult
+	var err error
+	if confirmOpts.Confirmed || confirmOpts.PersistID != "" {
+		confirmer, ok := sh.client.(confirmedCommitClient)
+		if !ok {
+			return fmt.Errorf("confirmed commit is not supported by this client")
+		}
+		result, err = confirmer.CommitWithOptions(ctx, sh.sessionID, user, message, confirmOpts)
+	} else {
+		result.CommitID, result.Version, err = sh.client.Commit(ctx, sh.sessionID, user, message)
+	}
 	if err != nil {
 		if diagErr := sh.printCommitFailureDiagnostics(ctx, diffText, hasChanges, diffErr); diagErr != nil {
 			return fmt.Errorf("commit failed: %w (diagnostics unavailable: %v)", err, diagErr)
 		}
 		return fmt.Errorf("commit failed: %w", err)
 	}
-	fmt.Printf("commit complete (id: %s, version: %d)\n", shortCommitID(commitID), version)
+	fmt.Printf("commit complete (id: %s, version: %d)\n", shortCommitID(result.CommitID), result.Version)
+	if result.PersistID != "" {
+		fmt.Printf("commit confirmed will be automatically rolled back at %s unless confirmed\n",
+			result.ConfirmExpiresAt.Local().Format("2006-01-02 15:04:05 MST"))
+		fmt.Printf("persist-id: %s (confirm from any session with 'commit persist-id %s')\n", result.PersistID, result.PersistID)
+	}
 	if diagErr := sh.printPostCommitDiagnostics(ctx, diffText, hasChanges, diffErr); diagErr != nil {
 		fmt.Printf("post-commit diagnostics unavailable: %v\n", diagErr)
 	}
@@ -232,6 +607,24 @@ func (sh *interactiveShell) cmdCommit(ctx context.Context, args []string) error
 	return nil
 }
 
+func (sh *interactiveShell) scheduleCommit(ctx context.Context, message string, at time.Time, andQuit bool) error {
+	scheduler, ok := sh.client.(scheduledCommitClient)
+	if !ok {
+		return fmt.Errorf("scheduled commits are not supported by this client")
+	}
+	scheduledAt, err := scheduler.ScheduleCommit(ctx, sh.sessionID, currentUsername(), message, at)
+	if err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	fmt.Printf("configuration check succeeds\ncommit will be executed at %s\n", formatCommitAtTime(scheduledAt))
+	if andQuit {
+		if err := sh.releaseConfigurationLock(ctx); err != nil {
+			return fmt.Errorf("commit scheduled but failed to exit configuration mode: %w", err)
+		}
+	}
+	return nil
+}
+
 func (sh *interactiveShell) cmdRollback(ctx context.Context, args []string) error {
 	if sh.mode != modeConfiguration {
 		return fmt.Errorf("'rollback' command only available in configuration mode")
@@ -260,6 +653,9 @@ func (sh *interactiveShell) cmdRollback(ctx context.Context, args []string) erro
 		return fmt.Errorf("not enough history for rollback %d (only %d commits available)", rollbackNum, availableCommits)
 	}
 	target := history[rollbackNum]
+	if err := sh.authorizeRollback(ctx, target.CommitID); err != nil {
+		return err
+	}
 	user := currentUsername()
 	newCommitID, version, err := sh.client.Rollback(ctx, sh.sessionID, target.CommitID, user, fmt.Sprintf("CLI rollback %d", rollbackNum))
 	if err != nil {
@@ -296,6 +692,53 @@ func (sh *interactiveShell) cmdCompare(ctx context.Context) error {
 	return nil
 }
 
+// cmdCompareRollback prints what "rollback N" would change without applying
+// it: the candidate (or running, outside configuration mode) against commit N.
+func (sh *interactiveShell) cmdCompareRollback(ctx context.Context, args []string) error {
+	if len(args) != 2 || args[0] != "rollback" {
+		return fmt.Errorf("usage: show | compare rollback <N>")
+	}
+	rollbackNum, err := parseRollbackNumber(args[1])
+	if err != nil {
+		return err
+	}
+	diffText, err := sh.rollbackCompareDiff(ctx, rollbackNum)
+	if err != nil {
+		return err
+	}
+	if diffText == "" {
+		fmt.Println("No changes")
+	} else {
+		fmt.Println(diffText)
+	}
+	return nil
+}
+
+func (sh *interactiveShell) rollbackCompareDiff(ctx context.Context, rollbackNum int) (string, error) {
+	archived, err := sh.archivedConfiguration(ctx, rollbackNum)
+	if err != nil {
+		return "", err
+	}
+
+	var current string
+	if sh.mode == modeConfiguration {
+		current, err = sh.client.GetCandidate(ctx, sh.sessionID)
+	} else {
+		current, _, err = sh.client.GetRunning(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if current, err = redactedConfigurationText(current); err != nil {
+		return "", err
+	}
+	if archived, err = redactedConfigurationText(archived); err != nil {
+		return "", err
+	}
+	return grpcclient.LineDiff(current, archived), nil
+}
+
 func (sh *interactiveShell) printChangeImpactPreview(ctx context.Context) error {
 	diffText, hasChanges, err := sh.client.Diff(ctx, sh.sessionID)
 	if err != nil {
@@ -310,6 +753,31 @@ func (sh *interactiveShell) printChangeImpactPreview(ctx context.Context) error
 	return nil
 }
 
+// candidateValidationIssues validates the candidate locally and returns every
+// error and warning, so 'commit check' reports all problems at once instead
+// of only the first one the server rejects. Retrieval or parse failures are
+// left to the server-side validation that follows.
+func (sh *interactiveShell) candidateValidationIssues(ctx context.Context) ([]string, int) {
+	text, err := sh.client.GetCandidate(ctx, sh.sessionID)
+	if err != nil || strings.TrimSpace(text) == "" {
+		return nil, 0
+	}
+	return configurationValidationIssues(text)
+}
+
+func configurationValidationIssues(text string) ([]string, int) {
+	cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		return nil, 0
+	}
+	result := cfg.ValidateAll()
+	lines := make([]string, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		lines = append(lines, issue.String())
+	}
+	return lines, len(result.Errors())
+}
+
 func (sh *interactiveShell) printCommitFailureDiagnostics(ctx context.Context, diffText string, hasChanges bool, diffErr error) error {
 	if diffErr != nil {
 		return diffErr
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+)
+
+// datastoreCheckClient is implemented by clients that can check the
+// daemon's configuration datastore.
+type datastoreCheckClient interface {
+	CheckDatastore(context.Context, string, bool) ([]grpcclient.DatastoreIssue, error)
+}
+
+const requestDatastoreUsage = "usage: request system datastore check [repair]"
+
+// parseRequestDatastoreArgs parses "request system datastore check [repair]"
+// and reports whether repair was requested.
+func parseRequestDatastoreArgs(args []string) (bool, error) {
+	if len(args) < 3 || len(args) > 4 || args[0] != "system" || args[1] != "datastore" || args[2] != "check" {
+		return false, errors.New(requestDatastoreUsage)
+	}
+	if len(args) == 4 && args[3] != "repair" {
+		return false, errors.New(requestDatastoreUsage)
+	}
+	return len(args) == 4, nil
+}
+
+func runDatastoreCheck(ctx context.Context, client showClient, repair bool) error {
+	checker, ok := client.(datastoreCheckClient)
+	if !ok {
+		return fmt.Errorf("datastore checks are not supported by this client")
+	}
+	issues, err := checker.CheckDatastore(ctx, currentUsername(), repair)
+	if err != nil {
+		return err
+	}
+	printDatastoreIssues(issues)
+	return nil
+}
+
+func printDatastoreIssues(issues []grpcclient.DatastoreIssue) {
+	if len(issues) == 0 {
+		fmt.Println("datastore check: no issues found")
+		return
+	}
+	fmt.Printf("%-10s %-24s %-9s %s\n", "Check", "Object", "Status", "Description")
+	repaired := 0
+	for _, issue := range issues {
+		object := issue.Object
+		if object == "" {
+			object = "-"
+		}
+		status := "found"
+		if issue.Repaired {
+			status = "repaired"
+			repaired++
+		}
+		fmt.Printf("%-10s %-24s %-9s %s\n", issue.Check, object, status, issue.Description)
+	}
+	fmt.Printf("datastore check: %d issue(s) found, %d repaired\n", len(issues), repaired)
+}
+
+// parseRequestArgs parses an operational "request" command and returns the
+// function that runs it.
+func parseRequestArgs(args []string) (func(context.Context, showClient) error, error) {
+	if len(args) > 0 && args[0] == "system" {
+		repair, err := parseRequestDatastoreArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, client showClient) error {
+			return runDatastoreCheck(ctx, client, repair)
+		}, nil
+	}
+	req, err := parseRequestSecurityArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, client showClient) error {
+		return runUserSSHKeyAction(ctx, client, req)
+	}, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultCLIHistorySize matches the readline default history limit.
+const defaultCLIHistorySize = 500
+
+// cliHistoryPath returns the interactive history file for an arca session
+// user, as authenticated by the daemon. History lives under the XDG state
+// directory (~/.local/state by default) so that it survives reboots and is
+// never shared between users.
+func cliHistoryPath(username string) (string, error) {
+	stateDir := strings.TrimSpace(os.Getenv("XDG_STATE_HOME"))
+	if stateDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolve home directory: %w", err)
+		}
+		stateDir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(stateDir, "arca", "cli-history", cliHistoryFileName(username)), nil
+}
+
+func cliHistoryFileName(username string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, strings.TrimSpace(username))
+	if strings.Trim(name, "_") == "" {
+		return "default"
+	}
+	return name
+}
+
+// prepareCLIHistoryFile creates the history file with owner-only permissions
+// before readline opens it, and returns its path. A directory or file left
+// with wider permissions by an earlier version is tightened.
+func prepareCLIHistoryFile(username string) (string, error) {
+	path, err := cliHistoryPath(username)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("create history directory: %w", err)
+	}
+	if err := os.Chmod(dir, 0o700); err != nil {
+		return "", fmt.Errorf("restrict history directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("open history file: %w", err)
+	}
+	if err := file.Chmod(0o600); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("restrict history file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("close history file: %w", err)
+	}
+	return path, nil
+}
+
+// readlineHistoryFile resolves the readline history file for username. A
+// size of zero disables history, so no file is used.
+func readlineHistoryFile(username string, size int) (string, error) {
+	if size < 0 {
+		return "", fmt.Errorf("history size must not be negative: %d", size)
+	}
+	if size == 0 {
+		return "", nil
+	}
+	return prepareCLIHistoryFile(username)
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"strings"
@@ -21,11 +23,23 @@ type interactiveShell struct {
 	rl        *readline.Instance
 	hostname  string
 	username  string
+	role      string
 	mode      cliMode
 	sessionID string
 	hasLock   bool
 	editPath  []string
 	flags     *cliFlags
+	// configMode is the candidate mode of configuration mode: exclusive,
+	// private, or shared
+	configMode string
+	// stdin answers confirmation prompts; nil reads os.Stdin
+	stdin io.Reader
+	// prompt receives confirmation prompts; nil writes to os.Stdout. It is
+	// set while show output is captured for paging so prompts stay visible.
+	prompt io.Writer
+	// nonInteractive declines confirmation prompts without reading stdin
+	// unless -force is given
+	nonInteractive bool
 }
 
 type interactiveClient interface {
@@ -48,11 +62,14 @@ type showClient interface {
 	GetRunning(context.Context) (string, uint64, error)
 	ListHistory(context.Context, int, int) ([]grpcclient.CommitInfo, error)
 	GetCommit(context.Context, string) (grpcclient.CommitInfo, error)
+	CompareCommits(context.Context, string, string) (string, bool, error)
 	GetInterfaces(context.Context, string) ([]grpcclient.InterfaceInfo, error)
 	GetRoutingInstances(context.Context) ([]grpcclient.RoutingInstanceInfo, error)
 	GetRoutes(context.Context, string, string) ([]grpcclient.RouteInfo, error)
+	GetRouteSummary(context.Context) ([]grpcclient.RouteFamilySummaryInfo, error)
 	GetBGPNeighbors(context.Context) ([]grpcclient.BGPNeighborInfo, error)
 	GetOSPFNeighbors(context.Context, string) ([]grpcclient.OSPFNeighborInfo, error)
+	GetNeighbors(context.Context, string, string) ([]grpcclient.NeighborInfo, error)
 	GetRouteText(context.Context, string, string) (string, error)
 	GetBGPSummaryText(context.Context) (string, error)
 	GetBGPNeighborText(context.Context, string) (string, error)
@@ -62,6 +79,7 @@ type showClient interface {
 	GetBFDStatus(context.Context) (*grpcclient.BFDStatusInfo, error)
 	GetLCPReconciliation(context.Context) (*grpcclient.LCPReconciliationInfo, error)
 	GetHAStatus(context.Context) (*grpcclient.HAStatusInfo, error)
+	GetSystemHealth(context.Context) (*grpcclient.SystemHealthInfo, error)
 	GetClassOfService(context.Context) (*grpcclient.ClassOfServiceInfo, error)
 	GetTelemetryCatalog(context.Context) (grpcclient.TelemetryCatalog, error)
 	GetFilteredTelemetryCatalog(context.Context, []string, []string) (grpcclient.TelemetryCatalog, error)
@@ -78,6 +96,11 @@ const (
 )
 
 func runInteractive(ctx context.Context, f *cliFlags) int {
+	if f.historySize < 0 {
+		fmt.Fprintf(os.Stderr, "Error: history size must not be negative: %d\n", f.historySize)
+		return ExitUsageError
+	}
+
 	client, err := dialGRPC(f)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: failed to connect to arca-routerd: %v\n", err)
@@ -87,25 +110,46 @@ func runInteractive(ctx context.Context, f *cliFlags) int {
 
 	// Get hostname from daemon
 	hostname := "arca-router"
+	role := ""
+	username := currentUsername()
 	info, err := client.GetSystemInfo(ctx)
-	if err == nil && info.Hostname != "" {
-		hostname = info.Hostname
+	if err == nil {
+		if info.Hostname != "" {
+			hostname = info.Hostname
+		}
+		role = info.Role
+		if info.User != "" {
+			username = info.User
+		}
+	}
+	if role == "" {
+		fmt.Fprintln(os.Stderr, "Warning: arca-routerd did not report your role; configuration commands are disabled")
 	}
 
-	username := currentUsername()
+	// History is kept per authenticated session user, so operators sharing
+	// a local account do not see each other's commands.
+	historyFile, err := readlineHistoryFile(username, f.historySize)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: command history will not be saved: %v\n", err)
+	}
 
 	sh := &interactiveShell{
 		client:   client,
 		hostname: hostname,
 		username: username,
+		role:     role,
 		mode:     modeOperational,
 		flags:    f,
+		// Piped or scripted input cannot answer a prompt: the commands
+		// that follow would be consumed as the answer.
+		nonInteractive: f.nonInteractive || !readline.IsTerminal(int(os.Stdin.Fd())),
 	}
 
 	completer := createCompleter()
 	rl, err := readline.NewEx(&readline.Config{
 		Prompt:              sh.buildPrompt(),
-		HistoryFile:         "/tmp/.arca-history",
+		HistoryFile:         historyFile,
+		HistoryLimit:        f.historySize,
 		AutoComplete:        completer,
 		InterruptPrompt:     "^C",
 		EOFPrompt:           "exit",
@@ -116,6 +160,9 @@ func runInteractive(ctx context.Context, f *cliFlags) int {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		return ExitOperationError
 	}
+	if f.historySize == 0 {
+		rl.HistoryDisable()
+	}
 	sh.rl = rl
 	defer func() { _ = rl.Close() }()
 
@@ -168,6 +215,16 @@ func (sh *interactiveShell) buildPrompt() string {
 	return fmt.Sprintf("%s# ", sh.hostname)
 }
 
+// commandRole is the role used for local command checks. A role the daemon
+// did not report is treated as read-only, so configuration is refused rather
+// than assumed to be allowed.
+func (sh *interactiveShell) commandRole() string {
+	if sh.role == "" {
+		return "read-only"
+	}
+	return sh.role
+}
+
 func (sh *interactiveShell) ensureConfigurationSession(ctx context.Context) error {
 	if sh.sessionID != "" {
 		return nil
@@ -187,7 +244,32 @@ func (sh *interactiveShell) ensureConfigurationSession(ctx context.Context) erro
 	return nil
 }
 
+// processCommand runs one command line. Output of show commands is paged
+// when the shell writes to a terminal, unless the line ends in "| no-more".
 func (sh *interactiveShell) processCommand(ctx context.Context, line string) error {
+	line, noMore := cutNoMore(line)
+	height := sh.terminalPageHeight(noMore)
+	if height == 0 || !isPagedCommand(line) {
+		return sh.runCommand(ctx, line)
+	}
+	prompt := sh.promptOutput()
+	output, err := captureStdout(func() error {
+		saved := sh.prompt
+		sh.prompt = prompt
+		defer func() { sh.prompt = saved }()
+		return sh.runCommand(ctx, line)
+	})
+	input := sh.stdin
+	if input == nil {
+		input = os.Stdin
+	}
+	if pageErr := pageOutput(os.Stdout, input, output, height); pageErr != nil && err == nil {
+		err = pageErr
+	}
+	return err
+}
+
+func (sh *interactiveShell) runCommand(ctx context.Context, line string) error {
 	// Handle pipe commands
 	if hasPipeOutsideQuotes(line) {
 		parts := strings.SplitN(line, "|", 2)
@@ -196,6 +278,20 @@ func (sh *interactiveShell) processCommand(ctx context.Context, line string) err
 		if left == "show" && right == "compare" {
 			return sh.cmdCompare(ctx)
 		}
+		if left == "show" && strings.HasPrefix(right, "compare ") {
+			return sh.cmdCompareRollback(ctx, strings.Fields(right)[1:])
+		}
+		if form, ok := strings.CutPrefix(right, "display "); ok && strings.HasPrefix(left, "show configuration") {
+			parts, err := configcli.TokenizeCommand(left)
+			if err != nil {
+				return fmt.Errorf("parse command: %w", err)
+			}
+			args := append(parts[1:], "|", "display", strings.TrimSpace(form))
+			if _, display := cutDisplay(args); display == displayHierarchical {
+				return fmt.Errorf("unsupported pipe command: %s | %s", left, right)
+			}
+			return sh.cmdShow(ctx, args)
+		}
 		return fmt.Errorf("unsupported pipe command: %s | %s", left, right)
 	}
 
@@ -210,18 +306,22 @@ func (sh *interactiveShell) processCommand(ctx context.Context, line string) err
 	cmd := parts[0]
 	args := parts[1:]
 
+	if err := configcli.AuthorizeCommand(sh.commandRole(), cmd, append(append([]string{}, sh.editPath...), args...)); err != nil {
+		return err
+	}
+
 	switch cmd {
 	case "help", "?":
 		sh.showHelp()
 		return nil
 	case "exit", "quit":
 		if sh.mode == modeConfiguration {
-			fmt.Println("Warning: Exiting configuration mode. Uncommitted changes will be lost.")
-			fmt.Print("Exit anyway? [yes/no]: ")
-			reader := bufio.NewReader(os.Stdin)
-			response, _ := reader.ReadString('\n')
-			response = strings.TrimSpace(strings.ToLower(response))
-			if response != "yes" && response != "y" {
+			if sh.configMode == grpcclient.CandidateModeShared {
+				fmt.Println("Warning: Exiting configuration mode. Uncommitted shared changes are lost when the last shared session exits.")
+			} else {
+				fmt.Println("Warning: Exiting configuration mode. Uncommitted changes will be lost.")
+			}
+			if !sh.confirm("Exit anyway?") {
 				return nil
 			}
 			if err := sh.exitConfigurationMode(ctx); err != nil {
@@ -231,15 +331,26 @@ func (sh *interactiveShell) processCommand(ctx context.Context, line string) err
 		}
 		return fmt.Errorf("exit")
 	case "configure":
-		return sh.cmdConfigure(ctx)
+		return sh.cmdConfigure(ctx, args)
 	case "show":
 		return sh.cmdShow(ctx, args)
 	case "check":
 		return sh.cmdCheck(ctx, args)
+	case "request":
+		return sh.cmdRequest(ctx, args)
+	case "clear":
+		if sh.mode != modeOperational {
+			return fmt.Errorf("'clear' only available in operational mode")
+		}
+		return runClear(ctx, sh.client, args)
 	case "set":
 		return sh.cmdSet(ctx, args)
 	case "delete":
 		return sh.cmdDelete(ctx, args)
+	case "insert":
+		return sh.cmdInsert(ctx, args)
+	case "activate", "deactivate":
+		return sh.cmdActivation(ctx, cmd, args)
 	case "commit":
 		return sh.cmdCommit(ctx, args)
 	case "rollback":
@@ -248,6 +359,10 @@ func (sh *interactiveShell) processCommand(ctx context.Context, line string) err
 		return sh.cmdBackup(ctx, args)
 	case "restore":
 		return sh.cmdRestore(ctx, args)
+	case "load":
+		return sh.cmdLoad(ctx, args)
+	case "replace":
+		return sh.cmdReplace(ctx, args)
 	case "compare":
 		return sh.cmdCompare(ctx)
 	case "discard-changes":
@@ -265,32 +380,80 @@ func (sh *interactiveShell) processCommand(ctx context.Context, line string) err
 
 // --- Command handlers ---
 
-func (sh *interactiveShell) cmdConfigure(ctx context.Context) error {
+// candidateModeClient is implemented by clients that can enter
+// configuration mode with a private or shared candidate.
+type candidateModeClient interface {
+	AcquireLockWithMode(context.Context, string, string, string) error
+}
+
+const configureUsage = "usage: configure [exclusive | private | shared]"
+
+// cmdConfigure enters configuration mode. Plain "configure" and "configure
+// exclusive" take the candidate lock; "configure private" edits a private
+// candidate alongside other sessions; "configure shared" edits the candidate
+// common to all shared sessions.
+func (sh *interactiveShell) cmdConfigure(ctx context.Context, args []string) error {
 	if sh.mode == modeConfiguration {
 		fmt.Println("Already in configuration mode")
 		return nil
 	}
+	mode := ""
+	if len(args) > 1 {
+		return errors.New(configureUsage)
+	}
+	if len(args) == 1 {
+		switch args[0] {
+		case grpcclient.CandidateModeExclusive, grpcclient.CandidateModePrivate, grpcclient.CandidateModeShared:
+			mode = args[0]
+		default:
+			return errors.New(configureUsage)
+		}
+	}
 	if err := sh.ensureConfigurationSession(ctx); err != nil {
 		return err
 	}
 
 	// Acquire candidate lock via gRPC
-	if err := sh.client.AcquireLock(ctx, sh.sessionID, currentUsername()); err != nil {
-		return fmt.Errorf("failed to acquire candidate lock: %w", err)
+	if mode == "" || mode == grpcclient.CandidateModeExclusive {
+		if err := sh.client.AcquireLock(ctx, sh.sessionID, currentUsername()); err != nil {
+			return fmt.Errorf("failed to acquire candidate lock: %w", err)
+		}
+	} else {
+		modeClient, ok := sh.client.(candidateModeClient)
+		if !ok {
+			return fmt.Errorf("%s configuration mode is not supported by this client", mode)
+		}
+		if err := modeClient.AcquireLockWithMode(ctx, sh.sessionID, currentUsername(), mode); err != nil {
+			return fmt.Errorf("failed to enter %s configuration mode: %w", mode, err)
+		}
 	}
 	sh.hasLock = true
+	sh.configMode = mode
+	if mode == "" {
+		sh.configMode = grpcclient.CandidateModeExclusive
+	}
 
 	sh.mode = modeConfiguration
 	fmt.Println("Entering configuration mode")
+	switch mode {
+	case grpcclient.CandidateModePrivate:
+		fmt.Println("Other users may commit changes; discard the candidate if it becomes stale")
+	case grpcclient.CandidateModeShared:
+		fmt.Println("The candidate is shared with other users in shared configuration mode")
+	}
 	return nil
 }
 
+// exitConfigurationMode discards the candidate and leaves configuration
+// mode. The shared candidate is left to the other shared sessions.
 func (sh *interactiveShell) exitConfigurationMode(ctx context.Context) error {
 	if sh.sessionID == "" {
 		return fmt.Errorf("configuration session is not available")
 	}
-	if err := sh.client.Discard(ctx, sh.sessionID); err != nil {
-		return fmt.Errorf("discard changes: %w", err)
+	if sh.configMode != grpcclient.CandidateModeShared {
+		if err := sh.client.Discard(ctx, sh.sessionID); err != nil {
+			return fmt.Errorf("discard changes: %w", err)
+		}
 	}
 	return sh.releaseConfigurationLock(ctx)
 }
@@ -305,6 +468,7 @@ func (sh *interactiveShell) releaseConfigurationLock(ctx context.Context) error
 	sh.mode = modeOperational
 	sh.editPath = nil
 	sh.hasLock = false
+	sh.configMode = ""
 	return nil
 }
 
@@ -326,6 +490,17 @@ func (sh *interactiveShell) cmdCheck(ctx context.Context, args []string) error {
 	return nil
 }
 
+func (sh *interactiveShell) cmdRequest(ctx context.Context, args []string) error {
+	if sh.mode != modeOperational {
+		return fmt.Errorf("'request' only available in operational mode")
+	}
+	run, err := parseRequestArgs(args)
+	if err != nil {
+		return err
+	}
+	return run(ctx, sh.client)
+}
+
 func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 	if len(args) == 0 {
 		if sh.mode == modeConfiguration {
@@ -334,14 +509,18 @@ func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 			if err != nil {
 				return err
 			}
-			fmt.Println(text)
+			text, err = redactedConfigurationText(text)
+			if err != nil {
+				return err
+			}
+			fmt.Println(markInactiveStatements(text))
 		} else {
 			// Show running config
 			text, _, err := sh.client.GetRunning(ctx)
 			if err != nil {
 				return err
 			}
-			fmt.Println(text)
+			fmt.Println(markInactiveStatements(text))
 		}
 		return nil
 	}
@@ -349,44 +528,40 @@ func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 	subcmd := args[0]
 	switch subcmd {
 	case "configuration":
-		if len(args) > 1 {
-			return sh.cmdShowArchivedConfiguration(ctx, args[1:])
+		args, display := cutDisplay(args[1:])
+		if len(args) > 0 {
+			return sh.cmdShowArchivedConfiguration(ctx, args, display)
 		}
 		var text string
 		var err error
 		if sh.mode == modeConfiguration {
 			text, err = sh.client.GetCandidate(ctx, sh.sessionID)
+			if err == nil {
+				text, err = redactedConfigurationText(text)
+			}
 		} else {
 			text, _, err = sh.client.GetRunning(ctx)
 		}
 		if err != nil {
 			return err
 		}
-		fmt.Println(text)
-		return nil
+		return printConfigurationDisplay(text, display)
 
 	case "compare":
 		return sh.cmdCompare(ctx)
 
 	case "history":
-		limit := 10
-		if len(args) > 1 {
-			var err error
-			limit, err = parseHistoryLimit(args[1])
-			if err != nil {
-				return err
-			}
+		limit, offset, err := parseHistoryPage(args[1:])
+		if err != nil {
+			return err
 		}
-		entries, err := sh.client.ListHistory(ctx, limit, 0)
+		entries, err := sh.client.ListHistory(ctx, limit, offset)
 		if err != nil {
 			return err
 		}
-		for _, e := range entries {
-			rb := ""
-			if e.IsRollback {
-				rb = " (rollback)"
-			}
-			fmt.Printf("  %s  %s  by %s%s  %s\n", shortCommitID(e.CommitID), e.Timestamp, e.User, rb, e.Message)
+		printCommitHistory(entries)
+		if len(entries) == limit {
+			fmt.Printf("\nOlder commits: show history %d skip %d\n", limit, offset+limit)
 		}
 		return nil
 
@@ -502,6 +677,21 @@ func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 		printOSPFNeighbors(neighbors)
 		return nil
 
+	case "arp", "ipv6":
+		if sh.mode == modeConfiguration {
+			return fmt.Errorf("'show %s' not available in configuration mode", subcmd)
+		}
+		addressFamily, nameFilter, err := neighborTableOptions(args)
+		if err != nil {
+			return err
+		}
+		neighbors, err := sh.client.GetNeighbors(ctx, addressFamily, nameFilter)
+		if err != nil {
+			return err
+		}
+		printNeighbors(neighbors)
+		return nil
+
 	case "vrrp":
 		if sh.mode == modeConfiguration {
 			return fmt.Errorf("'show vrrp' not available in configuration mode")
@@ -551,6 +741,32 @@ func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 		printLCPReconciliation(info)
 		return nil
 
+	case "system":
+		if sh.mode == modeConfiguration {
+			return fmt.Errorf("'show system' not available in configuration mode")
+		}
+		if len(args) == 2 && args[1] == "commit" {
+			return showScheduledCommit(ctx, sh.client)
+		}
+		if len(args) == 3 && args[1] == "commit" && args[2] == "last" {
+			return showLastApply(ctx, sh.client)
+		}
+		if len(args) == 5 && args[1] == "commit" && args[2] == "compare" {
+			return showCommitCompare(ctx, sh.client, args[3], args[4])
+		}
+		if len(args) == 2 && args[1] == "alarms" {
+			return showSystemAlarms(ctx, sh.client)
+		}
+		if len(args) != 2 || args[1] != "information" {
+			return fmt.Errorf("usage: show system (information|commit [last|compare <id1> <id2>]|alarms)")
+		}
+		info, err := sh.client.GetSystemHealth(ctx)
+		if err != nil {
+			return err
+		}
+		printSystemInformation(info)
+		return nil
+
 	case "ha":
 		if sh.mode == modeConfiguration {
 			return fmt.Errorf("'show ha' not available in configuration mode")
@@ -573,6 +789,16 @@ func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 		printClassOfService(info)
 		return nil
 
+	case "security":
+		if sh.mode == modeConfiguration {
+			return fmt.Errorf("'show security' not available in configuration mode")
+		}
+		req, err := parseShowSecurityArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		return showSecurity(ctx, sh.client, req)
+
 	case "evpn":
 		if sh.mode == modeConfiguration {
 			return fmt.Errorf("'show evpn' not available in configuration mode")
@@ -589,6 +815,12 @@ func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 		if sh.mode == modeConfiguration {
 			return fmt.Errorf("'show route' not available in configuration mode")
 		}
+		if families, ok, err := routeSummaryOptions(args[1:]); ok {
+			if err != nil {
+				return err
+			}
+			return showRouteSummary(ctx, sh.client, families)
+		}
 		protoFilter, addressFamily, err := routeTextOptions(args[1:])
 		if err != nil {
 			return err
@@ -605,7 +837,7 @@ func (sh *interactiveShell) cmdShow(ctx context.Context, args []string) error {
 	}
 }
 
-func (sh *interactiveShell) cmdShowArchivedConfiguration(ctx context.Context, args []string) error {
+func (sh *interactiveShell) cmdShowArchivedConfiguration(ctx context.Context, args []string, display string) error {
 	if len(args) != 2 || args[0] != "rollback" {
 		return fmt.Errorf("usage: show configuration rollback <N>")
 	}
@@ -617,7 +849,21 @@ func (sh *interactiveShell) cmdShowArchivedConfiguration(ctx context.Context, ar
 	if err != nil {
 		return err
 	}
-	fmt.Println(text)
+	text, err = redactedConfigurationText(text)
+	if err != nil {
+		return err
+	}
+	return printConfigurationDisplay(text, display)
+}
+
+// printConfigurationDisplay prints configuration text in a show
+// configuration display form.
+func printConfigurationDisplay(text, display string) error {
+	output, err := configurationDisplayText(text, display)
+	if err != nil {
+		return err
+	}
+	fmt.Println(output)
 	return nil
 }
 
@@ -665,3 +911,46 @@ func archivedConfigurationText(ctx context.Context, client showClient, rollbackN
 	}
 	return detail.ConfigText, nil
 }
+
+// promptOutput returns the writer confirmation prompts are printed to.
+func (sh *interactiveShell) promptOutput() io.Writer {
+	if sh.prompt != nil {
+		return sh.prompt
+	}
+	return os.Stdout
+}
+
+// defaultConfirmWord is the answer that confirms a prompt unless
+// -confirm-word sets another.
+const defaultConfirmWord = "yes"
+
+// confirm prints prompt and reports whether the user answered with the
+// confirmation word (default "yes"). With -force the prompt is answered yes;
+// in non-interactive mode it is declined without reading stdin.
+func (sh *interactiveShell) confirm(prompt string) bool {
+	word := defaultConfirmWord
+	if sh.flags != nil && strings.TrimSpace(sh.flags.confirmWord) != "" {
+		word = strings.TrimSpace(sh.flags.confirmWord)
+	}
+	out := sh.promptOutput()
+	fmt.Fprintf(out, "%s [%s/no]: ", prompt, word)
+	if sh.flags != nil && sh.flags.force {
+		fmt.Fprintln(out, word+" (-force)")
+		return true
+	}
+	if sh.nonInteractive {
+		fmt.Fprintln(out, "no (non-interactive; use -force to confirm)")
+		return false
+	}
+
+	input := sh.stdin
+	if input == nil {
+		input = os.Stdin
+	}
+	response, _ := bufio.NewReader(input).ReadString('\n')
+	response = strings.TrimSpace(response)
+	if strings.EqualFold(response, word) {
+		return true
+	}
+	return word == defaultConfirmWord && strings.EqualFold(response, "y")
+}
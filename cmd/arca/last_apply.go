@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+)
+
+// lastApplyClient is implemented by clients that report the last apply result.
+type lastApplyClient interface {
+	GetLastApply(context.Context) (*grpcclient.LastApplyInfo, error)
+}
+
+func showLastApply(ctx context.Context, client showClient) error {
+	reporter, ok := client.(lastApplyClient)
+	if !ok {
+		return fmt.Errorf("last apply results are not supported by this client")
+	}
+	info, err := reporter.GetLastApply(ctx)
+	if err != nil {
+		return err
+	}
+	printLastApply(info)
+	return nil
+}
+
+func printLastApply(info *grpcclient.LastApplyInfo) {
+	if info == nil || !info.Recorded {
+		fmt.Println("No configuration apply recorded")
+		return
+	}
+	fmt.Printf("%-18s %s\n", "Applied at", formatCommitAtTime(info.Time))
+	fmt.Printf("%-18s %d\n", "Version", info.Version)
+	fmt.Printf("%-18s %s\n", "Status", info.Status)
+	if info.Error != "" {
+		fmt.Printf("%-18s %s\n", "Error", info.Error)
+	}
+	if len(info.Plugins) == 0 {
+		return
+	}
+	fmt.Println("Plugins")
+	for _, plugin := range info.Plugins {
+		line := fmt.Sprintf("  %-16s %s", plugin.Plugin, plugin.Status)
+		if plugin.Error != "" {
+			line += " (" + plugin.Error + ")"
+		}
+		fmt.Println(line)
+		for _, operation := range plugin.Operations {
+			status := "ok"
+			if operation.Error != "" {
+				status = "failed (" + operation.Error + ")"
+			}
+			fmt.Printf("    %-40s %s\n", operation.Operation, status)
+		}
+	}
+}
@@ -30,6 +30,8 @@ const (
 
 	checkUpgradeUsage = "usage: check upgrade [backup <path>]"
 
+	defaultHistoryPageSize = 10
+
 	maxChangeImpactInterfaceDetails = 5
 	maxChangeImpactRouteDetails     = 5
 	maxChangeImpactPolicyDetails    = 5
@@ -44,6 +46,10 @@ type cliFlags struct {
 	grpcServerName string
 	grpcClientCert string
 	grpcClientKey  string
+	historySize    int
+	nonInteractive bool
+	force          bool
+	confirmWord    string
 	debug          bool
 	showHelp       bool
 	showVersion    bool
@@ -80,6 +86,10 @@ func parseFlags() *cliFlags {
 	flag.StringVar(&f.grpcServerName, "grpc-server-name", "", "Expected gRPC TLS server name")
 	flag.StringVar(&f.grpcClientCert, "grpc-client-cert", "", "Client certificate path for gRPC mTLS")
 	flag.StringVar(&f.grpcClientKey, "grpc-client-key", "", "Client private key path for gRPC mTLS")
+	flag.IntVar(&f.historySize, "history-size", defaultCLIHistorySize, "Maximum interactive command history entries per user (0 disables history)")
+	flag.BoolVar(&f.nonInteractive, "non-interactive", false, "Never wait for answers to confirmation prompts (implied when stdin is not a terminal)")
+	flag.BoolVar(&f.force, "force", false, "Answer yes to confirmation prompts")
+	flag.StringVar(&f.confirmWord, "confirm-word", defaultConfirmWord, "Answer that confirms a prompt")
 	flag.BoolVar(&f.debug, "debug", false, "Enable debug output")
 	flag.BoolVar(&f.showHelp, "help", false, "Show help")
 	flag.BoolVar(&f.showHelp, "h", false, "Show help (shorthand)")
@@ -106,6 +116,14 @@ Commands:
                     Save running configuration to a new file
   backup configuration rollback <N> <path>
                     Save archived configuration to a new file
+  request security users user <name> ssh-key (disable|enable|delete) <fingerprint>
+                    Disable, re-enable, or delete a user's SSH key
+  request system datastore check [repair]
+                    Check the datastore; repair removes orphaned candidates and locks
+  clear system commit
+                    Cancel the commit scheduled with 'commit at'
+  clear interfaces statistics [<name>]
+                    Reset the counters of one or all interfaces
 
 Show subcommands:
   configuration               Show full configuration
@@ -113,6 +131,12 @@ Show subcommands:
   configuration interfaces    Show interface configuration
   configuration protocols     Show routing protocol configuration
   compatibility               Show v0.10 compatibility policy
+  system information          Show version, uptime, and VPP/FRR/datastore/NETCONF health
+  system commit               Show the commit scheduled with 'commit at'
+  system commit last          Show the outcome of the last data-plane apply
+  system commit compare <id1> <id2>
+                              Show the set-format diff between two commits
+  system alarms               Show active interface alarms
   interfaces                  Show interface status
   interfaces <name>           Show specific interface details
   routing-instances [name]    Show routing-instance table mapping
@@ -122,10 +146,16 @@ Show subcommands:
   bgp neighbor <ip>           Show raw BGP neighbor details
   ospf neighbor               Show OSPFv2 neighbors
   ospf3 neighbor              Show OSPFv3 neighbors
+  arp [interface <name>]      Show the VPP ARP table
+  ipv6 neighbors [interface <name>]
+                              Show the VPP IPv6 neighbor table
   vrrp                        Show VRRP status
   bfd status                  Show BFD operational state
   bfd [brief|counters]        Show raw BFD status
   bfd peer <ip> [counters]    Show BFD peer details
+  security users              Show configured users and account status
+  security users user <name> ssh-keys
+                              Show a user's SSH keys and fingerprints
   evpn                        Show EVPN/VXLAN overlay intent
   telemetry paths [live] [default] [path <path>] [cardinality <hint>] [payload-schema <id>] [encoding <encoding>]
                               Show supported telemetry path catalog
@@ -133,6 +163,7 @@ Show subcommands:
                               Show telemetry events as JSON lines
   route [inet|inet6]                 Show routing table
   route [inet|inet6] protocol <proto> Show routes by protocol
+  route [inet|inet6] summary          Show route counts by protocol
 
 Options:
   -socket <path>             arca-routerd gRPC socket (default: %s)
@@ -141,11 +172,16 @@ Options:
   -grpc-server-name <name>   Expected gRPC TLS server name
   -grpc-client-cert <path>   Client certificate for gRPC mTLS
   -grpc-client-key <path>    Client private key for gRPC mTLS
+  -history-size <N>          Interactive history entries kept per user (default: %d, 0 disables)
+  -non-interactive           Never wait for answers to confirmation prompts; they are
+                             declined unless -force is given (implied when stdin is not a terminal)
+  -force                     Answer yes to confirmation prompts
+  -confirm-word <word>       Answer that confirms a prompt (default: %s)
   -debug                     Enable debug output
   -help, -h                  Show this help message
   -version, -v               Show version information
 
-`, defaultSocket)
+`, defaultSocket, defaultCLIHistorySize, defaultConfirmWord)
 }
 
 func debugLog(f *cliFlags, format string, args ...interface{}) {
@@ -192,6 +228,29 @@ func parseHistoryLimit(raw string) (int, error) {
 	return limit, nil
 }
 
+// parseHistoryPage parses the arguments of "show history [N] [skip M]" into
+// the page size and the number of newer commits to skip.
+func parseHistoryPage(args []string) (limit, offset int, err error) {
+	limit = defaultHistoryPageSize
+	if len(args) > 0 && args[0] != "skip" {
+		if limit, err = parseHistoryLimit(args[0]); err != nil {
+			return 0, 0, err
+		}
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		return limit, 0, nil
+	}
+	if len(args) != 2 || args[0] != "skip" {
+		return 0, 0, fmt.Errorf("usage: show history [N] [skip M]")
+	}
+	offset, err = strconv.Atoi(args[1])
+	if err != nil || offset < 0 {
+		return 0, 0, fmt.Errorf("invalid skip: %s", args[1])
+	}
+	return limit, offset, nil
+}
+
 func parseRollbackNumber(raw string) (int, error) {
 	rollbackNum, err := strconv.Atoi(raw)
 	if err != nil || rollbackNum < 0 {
@@ -224,6 +283,14 @@ func runOneShotCommand(ctx context.Context, f *cliFlags, args []string) int {
 		return oneShotShow(ctx, client, args[1:], f)
 	case "check":
 		return oneShotCheck(ctx, client, args[1:])
+	case "request":
+		return oneShotRequest(ctx, client, args[1:])
+	case "clear":
+		if err := runClear(ctx, client, args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return ExitOperationError
+		}
+		return ExitSuccess
 	case "backup":
 		return oneShotBackup(ctx, client, args[1:])
 	case "version":
@@ -256,6 +323,19 @@ func oneShotCheck(ctx context.Context, client showClient, args []string) int {
 	return ExitSuccess
 }
 
+func oneShotRequest(ctx context.Context, client showClient, args []string) int {
+	run, err := parseRequestArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return ExitUsageError
+	}
+	if err := run(ctx, client); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return ExitOperationError
+	}
+	return ExitSuccess
+}
+
 func oneShotBackup(ctx context.Context, client showClient, args []string) int {
 	var text, path string
 	var err error
@@ -318,9 +398,10 @@ func oneShotShow(ctx context.Context, client showClient, args []string, f *cliFl
 	subcmd := args[0]
 	switch subcmd {
 	case "configuration":
+		args, display := cutDisplay(args)
 		if len(args) > 1 {
 			if len(args) != 3 || args[1] != "rollback" {
-				fmt.Fprintln(os.Stderr, "Error: usage: show configuration rollback <N>")
+				fmt.Fprintln(os.Stderr, "Error: usage: show configuration [rollback <N>] [| display set|frr|vpp]")
 				return ExitUsageError
 			}
 			rollbackNum, err := parseRollbackNumber(args[2])
@@ -329,11 +410,17 @@ func oneShotShow(ctx context.Context, client showClient, args []string, f *cliFl
 				return ExitUsageError
 			}
 			text, err := archivedConfigurationText(ctx, client, rollbackNum)
+			if err == nil {
+				text, err = redactedConfigurationText(text)
+			}
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				return ExitOperationError
 			}
-			fmt.Println(text)
+			if err := printConfigurationDisplay(text, display); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return ExitOperationError
+			}
 			return ExitSuccess
 		}
 		debugLog(f, "Fetching running configuration via gRPC")
@@ -342,7 +429,10 @@ func oneShotShow(ctx context.Context, client showClient, args []string, f *cliFl
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			return ExitOperationError
 		}
-		fmt.Println(text)
+		if err := printConfigurationDisplay(text, display); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return ExitOperationError
+		}
 		return ExitSuccess
 
 	case "interfaces":
@@ -450,6 +540,20 @@ func oneShotShow(ctx context.Context, client showClient, args []string, f *cliFl
 		printOSPFNeighbors(neighbors)
 		return ExitSuccess
 
+	case "arp", "ipv6":
+		addressFamily, nameFilter, err := neighborTableOptions(args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return ExitUsageError
+		}
+		neighbors, err := client.GetNeighbors(ctx, addressFamily, nameFilter)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return ExitOperationError
+		}
+		printNeighbors(neighbors)
+		return ExitSuccess
+
 	case "vrrp":
 		output, err := client.GetVRRPText(ctx)
 		if err != nil {
@@ -496,6 +600,47 @@ func oneShotShow(ctx context.Context, client showClient, args []string, f *cliFl
 		printLCPReconciliation(info)
 		return ExitSuccess
 
+	case "system":
+		if len(args) == 2 && args[1] == "commit" {
+			if err := showScheduledCommit(ctx, client); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return ExitOperationError
+			}
+			return ExitSuccess
+		}
+		if len(args) == 3 && args[1] == "commit" && args[2] == "last" {
+			if err := showLastApply(ctx, client); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return ExitOperationError
+			}
+			return ExitSuccess
+		}
+		if len(args) == 5 && args[1] == "commit" && args[2] == "compare" {
+			if err := showCommitCompare(ctx, client, args[3], args[4]); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return ExitOperationError
+			}
+			return ExitSuccess
+		}
+		if len(args) == 2 && args[1] == "alarms" {
+			if err := showSystemAlarms(ctx, client); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return ExitOperationError
+			}
+			return ExitSuccess
+		}
+		if len(args) != 2 || args[1] != "information" {
+			fmt.Fprintln(os.Stderr, "Error: usage: show system (information|commit [last|compare <id1> <id2>]|alarms)")
+			return ExitUsageError
+		}
+		info, err := client.GetSystemHealth(ctx)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return ExitOperationError
+		}
+		printSystemInformation(info)
+		return ExitSuccess
+
 	case "ha":
 		info, err := client.GetHAStatus(ctx)
 		if err != nil {
@@ -514,6 +659,18 @@ func oneShotShow(ctx context.Context, client showClient, args []string, f *cliFl
 		printClassOfService(info)
 		return ExitSuccess
 
+	case "security":
+		req, err := parseShowSecurityArgs(args[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return ExitUsageError
+		}
+		if err := showSecurity(ctx, client, req); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return ExitOperationError
+		}
+		return ExitSuccess
+
 	case "evpn":
 		if err := showEVPN(ctx, client); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -532,6 +689,17 @@ func oneShotShow(ctx context.Context, client showClient, args []string, f *cliFl
 		return ExitSuccess
 
 	case "route":
+		if families, ok, err := routeSummaryOptions(args[1:]); ok {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return ExitUsageError
+			}
+			if err := showRouteSummary(ctx, client, families); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return ExitOperationError
+			}
+			return ExitSuccess
+		}
 		protoFilter, addressFamily, err := routeTextOptions(args[1:])
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -1,17 +1,24 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/internal/model"
 	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+	sqlitestore "github.com/akam1o/arca-router/internal/store/sqlite"
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
 )
 
 func TestDialGRPCRejectsTLSFlagsWithoutAddress(t *testing.T) {
@@ -37,6 +44,7 @@ type fakeInteractiveClient struct {
 	commitDetails         map[string]grpcclient.CommitInfo
 	listHistoryErr        error
 	getCommitErr          error
+	compareDiffText       string
 	runningText           string
 	runningUnredactedText string
 	runningVersion        uint64
@@ -47,6 +55,9 @@ type fakeInteractiveClient struct {
 	routePrefix           string
 	routeStateProto       string
 	routes                []grpcclient.RouteInfo
+	routesErr             error
+	routeSummaries        []grpcclient.RouteFamilySummaryInfo
+	routeSummaryErr       error
 	routingInstances      []grpcclient.RoutingInstanceInfo
 	bgpNeighbors          []grpcclient.BGPNeighborInfo
 	bgpSummaryText        string
@@ -54,6 +65,9 @@ type fakeInteractiveClient struct {
 	ospfNeighbors         []grpcclient.OSPFNeighborInfo
 	ospfText              string
 	ospfFamily            string
+	neighbors             []grpcclient.NeighborInfo
+	neighborFamily        string
+	neighborFilter        string
 	vrrpText              string
 	bfdText               string
 	bfdInfo               *grpcclient.BFDStatusInfo
@@ -69,6 +83,15 @@ type fakeInteractiveClient struct {
 	diffText              string
 	diffHasChanges        bool
 	diffErr               error
+	scheduledCommit       *grpcclient.ScheduledCommitInfo
+	clearStatisticsErr    error
+	clearedStatistics     []string
+	lastApply             *grpcclient.LastApplyInfo
+	alarms                []grpcclient.AlarmInfo
+	userSSHKeys           map[string][]grpcclient.UserSSHKey
+	userSSHKeyActions     []string
+	datastoreRepairs      []bool
+	candidateModes        []string
 
 	createSessionCalls            int
 	closeSessionCalls             int
@@ -76,13 +99,16 @@ type fakeInteractiveClient struct {
 	discardCalls                  int
 	releaseLockCalls              int
 	commitCalls                   int
+	commitOptions                 []grpcclient.ConfirmedCommitOptions
 	diffCalls                     int
 	routeCalls                    int
+	routeSummaryCalls             int
 	bfdStatusCalls                int
 	cosCalls                      int
 	routingCalls                  int
 	bgpNeighborCalls              int
 	ospfNeighborCalls             int
+	neighborCalls                 int
 	listHistoryCalls              int
 	listHistoryLimit              int
 	listHistoryOffset             int
@@ -129,6 +155,25 @@ func (f *fakeInteractiveClient) GetRunning(ctx context.Context) (string, uint64,
 	return f.runningText, f.runningVersion, nil
 }
 
+func (f *fakeInteractiveClient) ListUserSSHKeys(ctx context.Context, username string) ([]grpcclient.UserSSHKey, error) {
+	return f.userSSHKeys[username], nil
+}
+
+func (f *fakeInteractiveClient) SetUserSSHKeyStatus(ctx context.Context, username, fingerprint string, enabled bool) error {
+	f.userSSHKeyActions = append(f.userSSHKeyActions, fmt.Sprintf("status %s %s %t", username, fingerprint, enabled))
+	return nil
+}
+
+func (f *fakeInteractiveClient) RemoveUserSSHKey(ctx context.Context, username, fingerprint string) error {
+	f.userSSHKeyActions = append(f.userSSHKeyActions, fmt.Sprintf("remove %s %s", username, fingerprint))
+	return nil
+}
+
+func (f *fakeInteractiveClient) CheckDatastore(ctx context.Context, user string, repair bool) ([]grpcclient.DatastoreIssue, error) {
+	f.datastoreRepairs = append(f.datastoreRepairs, repair)
+	return []grpcclient.DatastoreIssue{{Check: "candidate", Object: "orphan", Description: "candidate is based on a commit that does not exist", Repaired: repair}}, nil
+}
+
 func (f *fakeInteractiveClient) GetRunningUnredacted(ctx context.Context) (string, uint64, error) {
 	f.getRunningUnredactedCalls++
 	if f.runningUnredactedText != "" {
@@ -160,6 +205,59 @@ func (f *fakeInteractiveClient) Commit(ctx context.Context, sessionID, user, mes
 	return "commit-1234567890", 2, nil
 }
 
+func (f *fakeInteractiveClient) ScheduleCommit(ctx context.Context, sessionID, user, message string, at time.Time) (time.Time, error) {
+	if f.commitErr != nil {
+		return time.Time{}, f.commitErr
+	}
+	f.scheduledCommit = &grpcclient.ScheduledCommitInfo{ScheduledAt: at, CreatedAt: time.Now(), User: user, Message: message}
+	return at, nil
+}
+
+func (f *fakeInteractiveClient) GetScheduledCommit(context.Context) (*grpcclient.ScheduledCommitInfo, error) {
+	return f.scheduledCommit, nil
+}
+
+func (f *fakeInteractiveClient) GetLastApply(context.Context) (*grpcclient.LastApplyInfo, error) {
+	if f.lastApply == nil {
+		return &grpcclient.LastApplyInfo{}, nil
+	}
+	return f.lastApply, nil
+}
+
+func (f *fakeInteractiveClient) GetSystemAlarms(context.Context) (*grpcclient.SystemAlarmsInfo, error) {
+	return &grpcclient.SystemAlarmsInfo{Alarms: f.alarms}, nil
+}
+
+func (f *fakeInteractiveClient) ClearScheduledCommit(context.Context, string) (*grpcclient.ScheduledCommitInfo, error) {
+	if f.scheduledCommit == nil {
+		return nil, errors.New("no commit is scheduled")
+	}
+	cleared := f.scheduledCommit
+	f.scheduledCommit = nil
+	return cleared, nil
+}
+
+func (f *fakeInteractiveClient) ClearInterfaceStatistics(ctx context.Context, name string) error {
+	if f.clearStatisticsErr != nil {
+		return f.clearStatisticsErr
+	}
+	f.clearedStatistics = append(f.clearedStatistics, name)
+	return nil
+}
+
+func (f *fakeInteractiveClient) CommitWithOptions(ctx context.Context, sessionID, user, message string, opts grpcclient.ConfirmedCommitOptions) (grpcclient.CommitResult, error) {
+	f.commitOptions = append(f.commitOptions, opts)
+	if f.commitErr != nil {
+		return grpcclient.CommitResult{}, f.commitErr
+	}
+	result := grpcclient.CommitResult{CommitID: "commit-1234567890", Version: 2}
+	if opts.Confirmed {
+		result.PersistID = "persist-1"
+		result.ConfirmExpiresAt = time.Now().Add(opts.Timeout)
+	}
+	return result, nil
+}
+
 func (f *fakeInteractiveClient) ValidateCandidate(ctx context.Context, sessionID string) error {
 	f.validateCalls++
 	return nil
@@ -190,6 +288,10 @@ func (f *fakeInteractiveClient) ListHistory(ctx context.Context, limit, offset i
 	return f.history, nil
 }
 
+func (f *fakeInteractiveClient) CompareCommits(ctx context.Context, fromID, toID string) (string, bool, error) {
+	return f.compareDiffText, f.compareDiffText != "", nil
+}
+
 func (f *fakeInteractiveClient) GetCommit(ctx context.Context, commitID string) (grpcclient.CommitInfo, error) {
 	f.getCommitCalls++
 	f.getCommitID = commitID
@@ -212,6 +314,11 @@ func (f *fakeInteractiveClient) AcquireLock(ctx context.Context, sessionID, user
 	return f.acquireLockErr
 }
 
+func (f *fakeInteractiveClient) AcquireLockWithMode(ctx context.Context, sessionID, user, mode string) error {
+	f.candidateModes = append(f.candidateModes, mode)
+	return f.acquireLockErr
+}
+
 func (f *fakeInteractiveClient) ReleaseLock(ctx context.Context, sessionID string) error {
 	f.releaseLockCalls++
 	return f.releaseLockErr
@@ -225,7 +332,12 @@ func (f *fakeInteractiveClient) GetRoutes(ctx context.Context, prefixFilter, pro
 	f.routeCalls++
 	f.routePrefix = prefixFilter
 	f.routeStateProto = protoFilter
-	return f.routes, nil
+	return f.routes, f.routesErr
+}
+
+func (f *fakeInteractiveClient) GetRouteSummary(ctx context.Context) ([]grpcclient.RouteFamilySummaryInfo, error) {
+	f.routeSummaryCalls++
+	return f.routeSummaries, f.routeSummaryErr
 }
 
 func (f *fakeInteractiveClient) GetRoutingInstances(ctx context.Context) ([]grpcclient.RoutingInstanceInfo, error) {
@@ -244,6 +356,13 @@ func (f *fakeInteractiveClient) GetOSPFNeighbors(ctx context.Context, addressFam
 	return f.ospfNeighbors, nil
 }
 
+func (f *fakeInteractiveClient) GetNeighbors(ctx context.Context, addressFamily, nameFilter string) ([]grpcclient.NeighborInfo, error) {
+	f.neighborCalls++
+	f.neighborFamily = addressFamily
+	f.neighborFilter = nameFilter
+	return f.neighbors, nil
+}
+
 func (f *fakeInteractiveClient) GetRouteText(ctx context.Context, protoFilter, addressFamily string) (string, error) {
 	f.routeProtocol = protoFilter
 	f.routeFamily = addressFamily
@@ -314,6 +433,10 @@ func (f *fakeInteractiveClient) GetHAStatus(ctx context.Context) (*grpcclient.HA
 	return &grpcclient.HAStatusInfo{}, nil
 }
 
+func (f *fakeInteractiveClient) GetSystemHealth(ctx context.Context) (*grpcclient.SystemHealthInfo, error) {
+	return &grpcclient.SystemHealthInfo{}, nil
+}
+
 func (f *fakeInteractiveClient) GetClassOfService(ctx context.Context) (*grpcclient.ClassOfServiceInfo, error) {
 	f.cosCalls++
 	if f.cosErr != nil {
@@ -406,6 +529,38 @@ func evpnTelemetryTestEvent() *grpcclient.TelemetryEvent {
 	}
 }
 
+func TestCmdConfigureSharedModeKeepsCandidateOnExit(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{createSessionID: "session-2"}
+	sh := &interactiveShell{client: client, hostname: "router", mode: modeOperational}
+
+	if err := sh.cmdConfigure(ctx, []string{"shared"}); err != nil {
+		t.Fatalf("cmdConfigure(shared) error = %v", err)
+	}
+	if !reflect.DeepEqual(client.candidateModes, []string{"shared"}) || client.acquireLockCalls != 0 {
+		t.Fatalf("modes = %v, AcquireLock calls = %d; want shared mode without the exclusive lock", client.candidateModes, client.acquireLockCalls)
+	}
+	if err := sh.exitConfigurationMode(ctx); err != nil {
+		t.Fatalf("exitConfigurationMode() error = %v", err)
+	}
+	if client.discardCalls != 0 || client.releaseLockCalls != 1 {
+		t.Fatalf("Discard calls = %d, ReleaseLock calls = %d; want shared candidate left to other sessions", client.discardCalls, client.releaseLockCalls)
+	}
+
+	if err := sh.cmdConfigure(ctx, []string{"private"}); err != nil {
+		t.Fatalf("cmdConfigure(private) error = %v", err)
+	}
+	if err := sh.exitConfigurationMode(ctx); err != nil {
+		t.Fatalf("exitConfigurationMode() error = %v", err)
+	}
+	if client.discardCalls != 1 {
+		t.Fatalf("Discard calls = %d, want private candidate discarded on exit", client.discardCalls)
+	}
+	if err := sh.cmdConfigure(ctx, []string{"batch"}); err == nil || !strings.Contains(err.Error(), configureUsage) {
+		t.Fatalf("cmdConfigure(batch) error = %v, want usage", err)
+	}
+}
+
 func TestCmdConfigureCreatesSessionLazily(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{createSessionID: "session-2"}
@@ -415,7 +570,7 @@ func TestCmdConfigureCreatesSessionLazily(t *testing.T) {
 		mode:     modeOperational,
 	}
 
-	err := sh.cmdConfigure(ctx)
+	err := sh.cmdConfigure(ctx, nil)
 	if err != nil {
 		t.Fatalf("cmdConfigure() error = %v", err)
 	}
@@ -442,7 +597,7 @@ func TestCmdConfigureStopsOnSessionCreateFailure(t *testing.T) {
 		mode:     modeOperational,
 	}
 
-	err := sh.cmdConfigure(ctx)
+	err := sh.cmdConfigure(ctx, nil)
 	if err == nil || !strings.Contains(err.Error(), "failed to create configuration session") {
 		t.Fatalf("cmdConfigure() error = %v, want session create failure", err)
 	}
@@ -478,6 +633,65 @@ func TestExitConfigurationModeStopsOnDiscardFailure(t *testing.T) {
 	}
 }
 
+func TestExitConfigurationModeNonInteractiveDoesNotBlock(t *testing.T) {
+	// A pipe nobody writes to stands in for scripted stdin; reading it
+	// would block forever.
+	stdin, stdinWriter := io.Pipe()
+	defer func() { _ = stdinWriter.Close() }()
+	client := &fakeInteractiveClient{}
+	sh := &interactiveShell{
+		client:         client,
+		hostname:       "router",
+		mode:           modeConfiguration,
+		sessionID:      "session-1",
+		hasLock:        true,
+		flags:          &cliFlags{},
+		stdin:          stdin,
+		nonInteractive: true,
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- sh.processCommand(context.Background(), "exit") }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("processCommand(exit) error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("exit blocked waiting for a confirmation answer")
+	}
+	if sh.mode != modeConfiguration || client.discardCalls != 0 {
+		t.Fatalf("mode = %v, discard calls = %d; want configuration mode kept without -force", sh.mode, client.discardCalls)
+	}
+
+	sh.flags.force = true
+	if err := sh.processCommand(context.Background(), "exit"); err != nil {
+		t.Fatalf("processCommand(exit) with -force error = %v", err)
+	}
+	if sh.mode != modeOperational || client.discardCalls != 1 || client.releaseLockCalls != 1 {
+		t.Fatalf("mode = %v, discard/release calls = %d/%d; want exit with -force", sh.mode, client.discardCalls, client.releaseLockCalls)
+	}
+}
+
+func TestConfirmUsesConfiguredWord(t *testing.T) {
+	for _, tt := range []struct {
+		word   string
+		answer string
+		want   bool
+	}{
+		{word: "", answer: "y\n", want: true},
+		{word: "", answer: "YES\n", want: true},
+		{word: "", answer: "no\n", want: false},
+		{word: "router1", answer: "yes\n", want: false},
+		{word: "router1", answer: "router1\n", want: true},
+	} {
+		sh := &interactiveShell{flags: &cliFlags{confirmWord: tt.word}, stdin: strings.NewReader(tt.answer)}
+		if got := sh.confirm("Exit anyway?"); got != tt.want {
+			t.Errorf("confirm() with word %q and answer %q = %v, want %v", tt.word, tt.answer, got, tt.want)
+		}
+	}
+}
+
 func TestExitConfigurationModeKeepsStateOnReleaseFailure(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{releaseLockErr: errors.New("release failed")}
@@ -546,6 +760,139 @@ func TestCommitAndQuitKeepsConfigurationModeOnReleaseFailure(t *testing.T) {
 	}
 }
 
+func TestCommitConfirmedPassesTimeoutAndPersist(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+		hasLock:   true,
+	}
+
+	if err := sh.cmdCommit(ctx, []string{"confirmed", "5", "persist", "token-1"}); err != nil {
+		t.Fatalf("cmdCommit(confirmed) error = %v", err)
+	}
+	if err := sh.cmdCommit(ctx, []string{"persist-id", "token-1"}); err != nil {
+		t.Fatalf("cmdCommit(persist-id) error = %v", err)
+	}
+
+	want := []grpcclient.ConfirmedCommitOptions{
+		{Confirmed: true, Timeout: 5 * time.Minute, Persist: "token-1"},
+		{PersistID: "token-1"},
+	}
+	if !reflect.DeepEqual(client.commitOptions, want) {
+		t.Fatalf("commit options = %#v, want %#v", client.commitOptions, want)
+	}
+	if client.commitCalls != 0 {
+		t.Fatalf("plain commit calls = %d, want 0", client.commitCalls)
+	}
+}
+
+func TestCommitConfirmedRejectsInvalidOptions(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"confirmed", "0"}, want: "between 1 and 1440 minutes"},
+		{args: []string{"persist", "token-1"}, want: "'persist' requires 'confirmed'"},
+		{args: []string{"check", "confirmed"}, want: "'check' cannot be used with a confirmed commit"},
+		{args: []string{"persist-id"}, want: "'persist-id' requires an argument"},
+	}
+
+	for _, tt := range tests {
+		client := &fakeInteractiveClient{}
+		sh := &interactiveShell{client: client, mode: modeConfiguration, sessionID: "session-1", hasLock: true}
+		err := sh.cmdCommit(context.Background(), tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Fatalf("cmdCommit(%q) error = %v, want %q", tt.args, err, tt.want)
+		}
+		if len(client.commitOptions) != 0 || client.commitCalls != 0 {
+			t.Fatalf("cmdCommit(%q) committed despite invalid options", tt.args)
+		}
+	}
+}
+
+func TestParseCommitAtTime(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 30, 0, 0, time.Local)
+	tests := []struct {
+		raw  string
+		want time.Time
+	}{
+		{raw: "2026-03-11 02:00", want: time.Date(2026, 3, 11, 2, 0, 0, 0, time.Local)},
+		{raw: "2026-03-10 14:30:30", want: time.Date(2026, 3, 10, 14, 30, 30, 0, time.Local)},
+		{raw: "23:15", want: time.Date(2026, 3, 10, 23, 15, 0, 0, time.Local)},
+		{raw: "02:00:15", want: time.Date(2026, 3, 11, 2, 0, 15, 0, time.Local)},
+	}
+	for _, tt := range tests {
+		got, err := parseCommitAtTime(tt.raw, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Fatalf("parseCommitAtTime(%q) = %v, %v; want %v", tt.raw, got, err, tt.want)
+		}
+	}
+
+	for _, raw := range []string{"2026-03-10 14:00", "tomorrow", "2026-03-10T23:00"} {
+		if _, err := parseCommitAtTime(raw, now); err == nil {
+			t.Fatalf("parseCommitAtTime(%q) error = nil, want rejection", raw)
+		}
+	}
+}
+
+func TestCommitAtSchedulesAndClearCancels(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{}
+	sh := &interactiveShell{client: client, role: "admin", mode: modeConfiguration, sessionID: "session-1", hasLock: true}
+
+	at := time.Now().Add(time.Hour).Truncate(time.Minute).Add(time.Minute)
+	if err := sh.cmdCommit(ctx, []string{"at", at.Format("2006-01-02 15:04"), "comment", "window"}); err != nil {
+		t.Fatalf("cmdCommit(at) error = %v", err)
+	}
+	if client.commitCalls != 0 || len(client.commitOptions) != 0 {
+		t.Fatal("commit at committed immediately")
+	}
+	if client.scheduledCommit == nil || !client.scheduledCommit.ScheduledAt.Equal(at) || client.scheduledCommit.Message != "window" {
+		t.Fatalf("scheduled commit = %#v, want window at %v", client.scheduledCommit, at)
+	}
+	if err := sh.cmdCommit(ctx, []string{"at", "02:00", "confirmed"}); err == nil || !strings.Contains(err.Error(), "'at' cannot be used") {
+		t.Fatalf("cmdCommit(at confirmed) error = %v, want rejection", err)
+	}
+
+	sh.mode = modeOperational
+	if err := sh.processCommand(ctx, "clear system commit"); err != nil {
+		t.Fatalf("clear system commit error = %v", err)
+	}
+	if client.scheduledCommit != nil {
+		t.Fatal("scheduled commit remained after clear system commit")
+	}
+	if err := sh.processCommand(ctx, "clear system commit"); err == nil {
+		t.Fatal("second clear system commit error = nil, want no scheduled commit")
+	}
+}
+
+func TestClearInterfacesStatistics(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{}
+	sh := &interactiveShell{client: client, role: "admin", mode: modeOperational, sessionID: "session-1"}
+
+	for _, line := range []string{"clear interfaces statistics ge-0/0/0", "clear interfaces statistics"} {
+		if err := sh.processCommand(ctx, line); err != nil {
+			t.Fatalf("%s error = %v", line, err)
+		}
+	}
+	if len(client.clearedStatistics) != 2 || client.clearedStatistics[0] != "ge-0/0/0" || client.clearedStatistics[1] != "" {
+		t.Fatalf("cleared statistics = %q, want ge-0/0/0 then all", client.clearedStatistics)
+	}
+	if err := sh.processCommand(ctx, "clear interfaces counters"); err == nil || !strings.Contains(err.Error(), "usage: clear interfaces statistics") {
+		t.Fatalf("clear interfaces counters error = %v, want usage", err)
+	}
+
+	client.clearStatisticsErr = errors.New("rpc error: code = Unavailable desc = failed to clear interface counters in VPP")
+	if err := sh.processCommand(ctx, "clear interfaces statistics"); err == nil || !strings.Contains(err.Error(), "Unavailable") {
+		t.Fatalf("clear interfaces statistics error = %v, want VPP failure", err)
+	}
+}
+
 func TestCommitFailureCollectsDiagnostics(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{
@@ -741,6 +1088,7 @@ func TestCmdSetQuotesValuesWithSpaces(t *testing.T) {
 	sh := &interactiveShell{
 		client:    client,
 		hostname:  "router",
+		role:      "admin",
 		mode:      modeConfiguration,
 		sessionID: "session-1",
 		hasLock:   true,
@@ -758,12 +1106,69 @@ func TestCmdSetQuotesValuesWithSpaces(t *testing.T) {
 	}
 }
 
+func TestProcessCommandEnforcesRole(t *testing.T) {
+	ctx := context.Background()
+
+	client := &fakeInteractiveClient{}
+	readOnly := &interactiveShell{client: client, hostname: "router", role: "read-only", mode: modeOperational}
+	for _, line := range []string{"configure", "set system host-name r1", "commit", "rollback 1"} {
+		err := readOnly.processCommand(ctx, line)
+		if err == nil || !strings.Contains(err.Error(), "permission denied: read-only role") {
+			t.Fatalf("read-only processCommand(%q) error = %v, want permission denied", line, err)
+		}
+	}
+	if client.createSessionCalls != 0 || client.acquireLockCalls != 0 {
+		t.Fatalf("read-only configure created session/lock: sessions=%d locks=%d", client.createSessionCalls, client.acquireLockCalls)
+	}
+
+	client = &fakeInteractiveClient{}
+	operator := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		role:      "operator",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+		hasLock:   true,
+	}
+	if err := operator.processCommand(ctx, "set interfaces ge-0/0/0 description WAN"); err != nil {
+		t.Fatalf("operator set interfaces error = %v", err)
+	}
+	if err := operator.processCommand(ctx, "set security users user bob role admin"); err == nil ||
+		!strings.Contains(err.Error(), "cannot change security configuration") {
+		t.Fatalf("operator set security users error = %v, want permission denied", err)
+	}
+	operator.editPath = []string{"security"}
+	if err := operator.processCommand(ctx, "delete users user bob"); err == nil {
+		t.Fatal("operator delete under [edit security] error = nil, want permission denied")
+	}
+	if len(client.editTexts) != 1 {
+		t.Fatalf("operator EditCandidate calls = %v, want only the interfaces edit", client.editTexts)
+	}
+
+	client = &fakeInteractiveClient{}
+	admin := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		role:      "admin",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+		hasLock:   true,
+	}
+	if err := admin.processCommand(ctx, "set security users user bob role operator"); err != nil {
+		t.Fatalf("admin set security users error = %v", err)
+	}
+	if len(client.editTexts) != 1 || client.editTexts[0] != "set security users user bob role operator" {
+		t.Fatalf("admin EditCandidate calls = %v", client.editTexts)
+	}
+}
+
 func TestProcessCommandSplitsTabsLikeSharedTokenizer(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{}
 	sh := &interactiveShell{
 		client:    client,
 		hostname:  "router",
+		role:      "admin",
 		mode:      modeConfiguration,
 		sessionID: "session-1",
 		hasLock:   true,
@@ -843,6 +1248,44 @@ func TestCommitCheckBuildsChangeImpactPreview(t *testing.T) {
 	}
 }
 
+func TestCommitCheckReportsEveryValidationIssue(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{
+		candidateText: strings.Join([]string{
+			"set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/24",
+			"set interfaces ge-0/0/1 unit 0 family inet address 10.0.0.1/24",
+			"set routing-options router-id 192.0.2.1",
+			"set protocols bgp group ibgp type internal",
+			"set protocols bgp group ibgp neighbor 192.0.2.2 peer-as 65000",
+		}, "\n"),
+	}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+		hasLock:   true,
+	}
+
+	err := sh.cmdCommit(ctx, []string{"check"})
+	if err == nil || err.Error() != "configuration check failed: 2 error(s)" {
+		t.Fatalf("cmdCommit(check) error = %v, want two collected errors", err)
+	}
+	if client.validateCalls != 0 || client.commitCalls != 0 {
+		t.Fatalf("validate/commit calls = %d/%d, want 0/0 after local errors", client.validateCalls, client.commitCalls)
+	}
+
+	lines, errorCount := configurationValidationIssues(client.candidateText)
+	if errorCount != 2 || len(lines) != 3 {
+		t.Fatalf("configurationValidationIssues() = %q, %d, want two errors and one warning", lines, errorCount)
+	}
+	if !strings.HasPrefix(lines[0], "error: ") || !strings.Contains(lines[0], "Duplicate address 10.0.0.1") ||
+		!strings.HasPrefix(lines[1], "error: ") || !strings.Contains(lines[1], "autonomous-system") ||
+		!strings.HasPrefix(lines[2], "warning: BGP neighbor 192.0.2.2") {
+		t.Fatalf("configurationValidationIssues() = %q, want duplicate-address and AS errors plus local-address warning", lines)
+	}
+}
+
 func TestCommitCheckRunsClassOfServicePreflight(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{
@@ -1172,7 +1615,7 @@ func TestUpgradePreflightLinesReportsReadyState(t *testing.T) {
 		"running config: version 7",
 		"running validation: ok",
 		"supported direct upgrade sources: v0.8.x, v0.9.x",
-		"datastore schema guard: SQLite schema 1-2 accepted",
+		"datastore schema guard: SQLite schema 1-4 accepted",
 		"rollback archive: latest commit 12345678 available",
 		"rollback archive validation: ok",
 		"telemetry catalog:",
@@ -1199,7 +1642,7 @@ func TestUpgradeCompatibilityPreflightLines(t *testing.T) {
 		"supported direct upgrade sources: v0.8.x, v0.9.x",
 		"unsupported direct upgrades: v0.7.x and older",
 		"API compatibility: arca.router.v1, arca.telemetry.v1",
-		"datastore schema guard: SQLite schema 1-2 accepted",
+		"datastore schema guard: SQLite schema 1-4 accepted",
 	} {
 		if !strings.Contains(got, want) {
 			t.Fatalf("upgradeCompatibilityPreflightLines() = %q, want substring %q", got, want)
@@ -1472,6 +1915,67 @@ func TestShowHistoryRejectsInvalidLimit(t *testing.T) {
 	}
 }
 
+func TestShowHistoryPagesWithSkip(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		args       []string
+		wantLimit  int
+		wantOffset int
+	}{
+		{args: []string{"history"}, wantLimit: 10},
+		{args: []string{"history", "20"}, wantLimit: 20},
+		{args: []string{"history", "20", "skip", "20"}, wantLimit: 20, wantOffset: 20},
+		{args: []string{"history", "skip", "5"}, wantLimit: 10, wantOffset: 5},
+	} {
+		client := &fakeInteractiveClient{}
+		sh := &interactiveShell{
+			client:    client,
+			hostname:  "router",
+			mode:      modeConfiguration,
+			sessionID: "session-1",
+		}
+		if err := sh.cmdShow(ctx, tt.args); err != nil {
+			t.Fatalf("cmdShow(%v) error = %v", tt.args, err)
+		}
+		if client.listHistoryCalls != 1 || client.listHistoryLimit != tt.wantLimit || client.listHistoryOffset != tt.wantOffset {
+			t.Fatalf("cmdShow(%v) ListHistory calls/limit/offset = %d/%d/%d, want 1/%d/%d",
+				tt.args, client.listHistoryCalls, client.listHistoryLimit, client.listHistoryOffset, tt.wantLimit, tt.wantOffset)
+		}
+	}
+
+	client := &fakeInteractiveClient{history: []grpcclient.CommitInfo{
+		{CommitID: "commit-3", User: "alice"},
+		{CommitID: "commit-2", User: "alice"},
+	}}
+	sh := &interactiveShell{client: client, hostname: "router", mode: modeOperational, sessionID: "session-1"}
+	output, err := captureStdout(func() error {
+		return sh.cmdShow(ctx, []string{"history", "2", "skip", "4"})
+	})
+	if err != nil {
+		t.Fatalf("cmdShow(history 2 skip 4) error = %v", err)
+	}
+	if !strings.Contains(output, "Older commits: show history 2 skip 6") {
+		t.Fatalf("full history page output missing next page hint:\n%s", output)
+	}
+
+	for _, args := range [][]string{
+		{"history", "20", "skip", "-1"},
+		{"history", "20", "skip", "x"},
+		{"history", "20", "skip"},
+		{"history", "20", "older"},
+	} {
+		client := &fakeInteractiveClient{}
+		sh := &interactiveShell{client: client, hostname: "router", mode: modeOperational, sessionID: "session-1"}
+		if err := sh.cmdShow(ctx, args); err == nil {
+			t.Fatalf("cmdShow(%v) error = nil, want usage error", args)
+		}
+		if client.listHistoryCalls != 0 {
+			t.Fatalf("ListHistory calls for %v = %d, want 0", args, client.listHistoryCalls)
+		}
+	}
+}
+
 func TestShowConfigurationRollbackUsesArchivedConfig(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{
@@ -1505,36 +2009,187 @@ func TestShowConfigurationRollbackUsesArchivedConfig(t *testing.T) {
 	}
 }
 
-func TestShowConfigurationRollbackZeroFallsBackToRunning(t *testing.T) {
-	ctx := context.Background()
-	client := &fakeInteractiveClient{runningText: "set system host-name running"}
-	sh := &interactiveShell{
-		client:    client,
-		hostname:  "router",
-		mode:      modeOperational,
-		sessionID: "session-1",
+func TestConfigurationDisplayTextDefaultsToHierarchical(t *testing.T) {
+	text := "set system host-name router\nset interfaces ge-0/0/0 description uplink\ndeactivate interfaces ge-0/0/0\n"
+
+	args, display := cutDisplay([]string{"configuration"})
+	if display != displayHierarchical || len(args) != 1 {
+		t.Fatalf("cutDisplay(configuration) = %v, %q, want unchanged", args, display)
+	}
+	want := "system {\n    host-name router;\n}\ninterfaces {\n    inactive: ge-0/0/0 {\n        description uplink;\n    }\n}"
+	if got, err := configurationDisplayText(text, display); err != nil || got != want {
+		t.Fatalf("configurationDisplayText() = %q, %v, want %q", got, err, want)
 	}
 
-	if err := sh.cmdShow(ctx, []string{"configuration", "rollback", "0"}); err != nil {
-		t.Fatalf("cmdShow(configuration rollback 0) error = %v", err)
+	args, display = cutDisplay([]string{"configuration", "rollback", "1", "|", "display", "set"})
+	if display != displaySet || strings.Join(args, " ") != "configuration rollback 1" {
+		t.Fatalf("cutDisplay(| display set) = %v, %q, want rollback args and set", args, display)
 	}
-	if client.listHistoryCalls != 1 || client.listHistoryLimit != 1 {
-		t.Fatalf("ListHistory calls/limit = %d/%d, want 1/1", client.listHistoryCalls, client.listHistoryLimit)
+	if got, err := configurationDisplayText(text, display); err != nil || !strings.Contains(got, "inactive: set interfaces ge-0/0/0 description uplink") {
+		t.Fatalf("configurationDisplayText(display set) = %q, %v, want flat set commands", got, err)
 	}
-	if client.getRunningCalls != 1 {
-		t.Fatalf("GetRunning calls = %d, want 1 fallback", client.getRunningCalls)
+}
+
+func TestShowConfigurationDisplayFRRAndVPP(t *testing.T) {
+	running := `set system host-name edge1
+set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set routing-options autonomous-system 65000
+set routing-options router-id 192.0.2.1
+set policy-options policy-statement EXPORT term STATIC from protocol static
+set policy-options policy-statement EXPORT term STATIC then accept
+set protocols bgp group UPSTREAM type external
+set protocols bgp group UPSTREAM export EXPORT
+set protocols bgp group UPSTREAM neighbor 192.0.2.2 peer-as 65001
+`
+	args, display := cutDisplay([]string{"configuration", "|", "display", "frr"})
+	if display != displayFRR || len(args) != 1 {
+		t.Fatalf("cutDisplay(| display frr) = %v, %q, want frr", args, display)
+	}
+	output, err := configurationDisplayText(running, display)
+	if err != nil {
+		t.Fatalf("configurationDisplayText(display frr) error = %v", err)
+	}
+	for _, want := range []string{
+		"hostname edge1\n",
+		"router bgp 65000\n",
+		" neighbor 192.0.2.2 remote-as 65001\n",
+		" neighbor 192.0.2.2 route-map EXPORT out\n",
+		"route-map EXPORT permit 10\n",
+		" match source-protocol static\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("display frr output missing %q:\n%s", want, output)
+		}
+	}
+
+	output, err = configurationDisplayText(running, displayVPP)
+	if err != nil {
+		t.Fatalf("configurationDisplayText(display vpp) error = %v", err)
+	}
+	output += "\n"
+	for _, want := range []string{
+		"set interface state ge-0/0/0 up\n",
+		"lcp create ge-0/0/0 host-if ge0-0-0\n",
+		"set interface ip address ge-0/0/0 192.0.2.1/24\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("display vpp output missing %q:\n%s", want, output)
+		}
+	}
+
+	sh := &interactiveShell{client: &fakeInteractiveClient{runningText: running}, mode: modeOperational}
+	if err := sh.processCommand(context.Background(), "show configuration | display xml"); err == nil || !strings.Contains(err.Error(), "unsupported pipe command") {
+		t.Fatalf("show configuration | display xml error = %v, want unsupported pipe", err)
 	}
 }
 
-func TestShowConfigurationRollbackRejectsMissingArchive(t *testing.T) {
+func TestRollbackCompareDiffShowsChangesWithoutApplying(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{
+		candidateText: "set system host-name candidate\nset interfaces ge-0/0/0 description uplink\n",
 		history: []grpcclient.CommitInfo{
 			{CommitID: "commit-new"},
 			{CommitID: "commit-old"},
 		},
 		commitDetails: map[string]grpcclient.CommitInfo{
-			"commit-old": {CommitID: "commit-old"},
+			"commit-old": {CommitID: "commit-old", ConfigText: "set system host-name old\nset interfaces ge-0/0/0 description uplink\n"},
+		},
+	}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+	}
+
+	got, err := sh.rollbackCompareDiff(ctx, 1)
+	if err != nil {
+		t.Fatalf("rollbackCompareDiff(1) error = %v", err)
+	}
+	want := "+ set system host-name old\n- set system host-name candidate"
+	if got != want {
+		t.Fatalf("rollbackCompareDiff(1) = %q, want %q", got, want)
+	}
+	if client.getCandidateCalls != 1 || client.getCommitID != "commit-old" {
+		t.Fatalf("GetCandidate calls/GetCommit id = %d/%q, want 1/commit-old", client.getCandidateCalls, client.getCommitID)
+	}
+	if client.rollbackCalls != 0 || len(client.replaceTexts) != 0 {
+		t.Fatalf("rollback/replace calls = %d/%d, want compare to leave the candidate untouched", client.rollbackCalls, len(client.replaceTexts))
+	}
+
+	err = sh.processCommand(ctx, "show | compare rollback 5")
+	if err == nil || !strings.Contains(err.Error(), "not enough history for rollback 5 (only 1 commits available)") {
+		t.Fatalf("processCommand(show | compare rollback 5) error = %v, want history bound error", err)
+	}
+}
+
+func TestRedactedConfigurationTextMasksCandidateSecrets(t *testing.T) {
+	text := strings.Join([]string{
+		"set system services snmp community private-community",
+		"set security users user admin password plain-password",
+		"set security users user admin role admin",
+		`set security users user admin ssh-key "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIadminkey"`,
+	}, "\n")
+
+	got, err := redactedConfigurationText(text)
+	if err != nil {
+		t.Fatalf("redactedConfigurationText() error = %v", err)
+	}
+	for _, secret := range []string{"private-community", "plain-password", "AAAAC3NzaC1lZDI1NTE5AAAAIadminkey", "$argon2id$"} {
+		if strings.Contains(got, secret) {
+			t.Fatalf("redactedConfigurationText() leaked %q:\n%s", secret, got)
+		}
+	}
+	if !strings.Contains(got, "set security users user admin role admin") {
+		t.Fatalf("redactedConfigurationText() = %q, want non-secret lines preserved", got)
+	}
+
+	already := "set system services snmp community \"<redacted>\""
+	if got, err := redactedConfigurationText(already); err != nil || strings.TrimSpace(got) != already {
+		t.Fatalf("redactedConfigurationText(redacted) = %q, %v; want unchanged", got, err)
+	}
+
+	// A marker on one secret must not stop the others from being masked.
+	mixed := already + "\nset security users user alice password \"$6$realhash\""
+	got, err = redactedConfigurationText(mixed)
+	if err != nil {
+		t.Fatalf("redactedConfigurationText(mixed) error = %v", err)
+	}
+	if strings.Contains(got, "$6$realhash") || !strings.Contains(got, "set security users user alice password") {
+		t.Fatalf("redactedConfigurationText(mixed) = %q, want the password masked", got)
+	}
+}
+
+func TestShowConfigurationRollbackZeroFallsBackToRunning(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{runningText: "set system host-name running"}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeOperational,
+		sessionID: "session-1",
+	}
+
+	if err := sh.cmdShow(ctx, []string{"configuration", "rollback", "0"}); err != nil {
+		t.Fatalf("cmdShow(configuration rollback 0) error = %v", err)
+	}
+	if client.listHistoryCalls != 1 || client.listHistoryLimit != 1 {
+		t.Fatalf("ListHistory calls/limit = %d/%d, want 1/1", client.listHistoryCalls, client.listHistoryLimit)
+	}
+	if client.getRunningCalls != 1 {
+		t.Fatalf("GetRunning calls = %d, want 1 fallback", client.getRunningCalls)
+	}
+}
+
+func TestShowConfigurationRollbackRejectsMissingArchive(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{
+		history: []grpcclient.CommitInfo{
+			{CommitID: "commit-new"},
+			{CommitID: "commit-old"},
+		},
+		commitDetails: map[string]grpcclient.CommitInfo{
+			"commit-old": {CommitID: "commit-old"},
 		},
 	}
 	sh := &interactiveShell{
@@ -1698,6 +2353,7 @@ func TestRestoreConfigurationBackupReplacesCandidate(t *testing.T) {
 	sh := &interactiveShell{
 		client:    client,
 		hostname:  "router",
+		role:      "admin",
 		mode:      modeConfiguration,
 		sessionID: "session-1",
 	}
@@ -1710,6 +2366,51 @@ func TestRestoreConfigurationBackupReplacesCandidate(t *testing.T) {
 	}
 }
 
+func TestOperatorRestoreAndRollbackCannotChangeSecurity(t *testing.T) {
+	ctx := context.Background()
+	current := "set system host-name router\nset security netconf ssh port 830\n"
+	backupPath := t.TempDir() + "/backup.conf"
+	if err := os.WriteFile(backupPath, []byte("set system host-name router\nset security netconf ssh port 1830\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	client := &fakeInteractiveClient{
+		candidateText: current,
+		runningText:   current,
+		history:       []grpcclient.CommitInfo{{CommitID: "commit-2"}, {CommitID: "commit-1"}},
+		commitDetails: map[string]grpcclient.CommitInfo{
+			"commit-1": {CommitID: "commit-1", ConfigText: "set system host-name router\n"},
+		},
+	}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		role:      "operator",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+	}
+
+	err := sh.processCommand(ctx, "restore configuration "+backupPath)
+	if err == nil || !strings.Contains(err.Error(), "cannot change security configuration") {
+		t.Fatalf("operator restore error = %v, want security permission denied", err)
+	}
+	if len(client.replaceTexts) != 0 {
+		t.Fatalf("ReplaceCandidate texts = %#v, want none", client.replaceTexts)
+	}
+
+	err = sh.processCommand(ctx, "rollback 1")
+	if err == nil || !strings.Contains(err.Error(), "cannot change security configuration") {
+		t.Fatalf("operator rollback error = %v, want security permission denied", err)
+	}
+	if client.rollbackCalls != 0 {
+		t.Fatalf("Rollback calls = %d, want 0", client.rollbackCalls)
+	}
+
+	unknown := &interactiveShell{client: client, hostname: "router", mode: modeConfiguration, sessionID: "session-1"}
+	if err := unknown.processCommand(ctx, "set system host-name other"); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("set with unreported role error = %v, want permission denied", err)
+	}
+}
+
 func TestRestoreConfigurationBackupValidatesBeforeReplace(t *testing.T) {
 	ctx := context.Background()
 	backupPath := t.TempDir() + "/backup.conf"
@@ -1767,6 +2468,7 @@ func TestRestoreConfigurationBackupAllowsRedactedMarkerInDescription(t *testing.
 	sh := &interactiveShell{
 		client:    client,
 		hostname:  "router",
+		role:      "admin",
 		mode:      modeConfiguration,
 		sessionID: "session-1",
 	}
@@ -1793,6 +2495,7 @@ func TestRestoreConfigurationRollbackReplacesCandidate(t *testing.T) {
 	sh := &interactiveShell{
 		client:    client,
 		hostname:  "router",
+		role:      "admin",
 		mode:      modeConfiguration,
 		sessionID: "session-1",
 	}
@@ -1902,6 +2605,137 @@ func TestRestoreConfigurationRejectsInvalidUsage(t *testing.T) {
 	}
 }
 
+func TestLoadPatchRoundTripsCompareOutput(t *testing.T) {
+	running := "set system host-name router1\n" +
+		"set interfaces ge-0/0/0 description \"old uplink\"\n" +
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24\n" +
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/0\n"
+	candidate := "set system host-name router2\n" +
+		"set interfaces ge-0/0/0 description \"new uplink\"\n" +
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24\n" +
+		"set routing-options static route 0.0.0.0/0 next-hop 192.0.2.254\n" +
+		"deactivate protocols ospf\n" +
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/0\n"
+	patchPath := t.TempDir() + "/change.patch"
+	if err := os.WriteFile(patchPath, []byte(grpcclient.LineDiff(running, candidate)+"\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	client := &fakeInteractiveClient{candidateText: running}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		role:      "admin",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+	}
+
+	if err := sh.cmdLoad(context.Background(), []string{"patch", patchPath}); err != nil {
+		t.Fatalf("cmdLoad(patch) error = %v", err)
+	}
+	if len(client.replaceTexts) != 1 {
+		t.Fatalf("ReplaceCandidate texts = %#v, want one patched candidate", client.replaceTexts)
+	}
+	if diff := grpcclient.LineDiff(client.replaceTexts[0], candidate); diff != "" {
+		t.Fatalf("patched candidate differs from compared candidate:\n%s", diff)
+	}
+}
+
+func TestLoadPatchReportsConflicts(t *testing.T) {
+	patchPath := t.TempDir() + "/change.patch"
+	patch := "- set system host-name router1\n+ set system host-name router2\n"
+	if err := os.WriteFile(patchPath, []byte(patch), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	client := &fakeInteractiveClient{candidateText: "set system host-name router3\n"}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		role:      "admin",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+	}
+
+	err := sh.cmdLoad(context.Background(), []string{"patch", patchPath})
+	if err == nil || !strings.Contains(err.Error(), "set system host-name router1: statement does not exist") {
+		t.Fatalf("cmdLoad(patch) error = %v, want conflict", err)
+	}
+	if len(client.replaceTexts) != 0 {
+		t.Fatalf("ReplaceCandidate texts = %#v, want none for conflicting patch", client.replaceTexts)
+	}
+}
+
+func TestReplacePatternRenamesPolicyAfterConfirmation(t *testing.T) {
+	candidate := "set policy-options policy-statement EXPORT-OLD term ALL then accept\n" +
+		"set protocols bgp group UPSTREAM type external\n" +
+		"set protocols bgp group UPSTREAM export EXPORT-OLD\n" +
+		"set protocols bgp group UPSTREAM neighbor 192.0.2.1 peer-as 65001\n" +
+		"set protocols bgp group PEERS type external\n" +
+		"set protocols bgp group PEERS export EXPORT-OLD\n" +
+		"set protocols bgp group PEERS neighbor 198.51.100.1 peer-as 65002\n"
+	newShell := func(answer string) (*interactiveShell, *fakeInteractiveClient) {
+		client := &fakeInteractiveClient{candidateText: candidate}
+		return &interactiveShell{
+			client:    client,
+			hostname:  "router",
+			role:      "admin",
+			mode:      modeConfiguration,
+			sessionID: "session-1",
+			stdin:     strings.NewReader(answer),
+		}, client
+	}
+
+	sh, client := newShell("no\n")
+	if err := sh.cmdReplace(context.Background(), []string{"pattern", "EXPORT-OLD", "with", "EXPORT-NEW"}); err != nil {
+		t.Fatalf("cmdReplace() error = %v", err)
+	}
+	if len(client.replaceTexts) != 0 {
+		t.Fatalf("ReplaceCandidate texts = %#v, want none without confirmation", client.replaceTexts)
+	}
+
+	sh, client = newShell("yes\n")
+	if err := sh.cmdReplace(context.Background(), []string{"pattern", "EXPORT-OLD", "EXPORT-NEW"}); err != nil {
+		t.Fatalf("cmdReplace() error = %v", err)
+	}
+	if len(client.replaceTexts) != 1 {
+		t.Fatalf("ReplaceCandidate texts = %#v, want one replaced candidate", client.replaceTexts)
+	}
+	for _, line := range []string{
+		"set policy-options policy-statement EXPORT-NEW term ALL then accept",
+		"set protocols bgp group UPSTREAM export EXPORT-NEW",
+		"set protocols bgp group PEERS export EXPORT-NEW",
+	} {
+		if !strings.Contains(client.replaceTexts[0], line+"\n") {
+			t.Errorf("replaced candidate missing %q:\n%s", line, client.replaceTexts[0])
+		}
+	}
+	if strings.Contains(client.replaceTexts[0], "EXPORT-OLD") {
+		t.Errorf("replaced candidate still references EXPORT-OLD:\n%s", client.replaceTexts[0])
+	}
+}
+
+func TestLoadPatchAuthorizesEachStatement(t *testing.T) {
+	patchPath := t.TempDir() + "/change.patch"
+	if err := os.WriteFile(patchPath, []byte("+ set security ssh permit-root-login\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	client := &fakeInteractiveClient{}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+		role:      "operator",
+	}
+
+	err := sh.cmdLoad(context.Background(), []string{"patch", patchPath})
+	if err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("cmdLoad(patch) error = %v, want permission denied", err)
+	}
+	if len(client.replaceTexts) != 0 {
+		t.Fatalf("ReplaceCandidate texts = %#v, want none for denied patch", client.replaceTexts)
+	}
+}
+
 func TestOneShotBackupConfigurationWritesRunningConfig(t *testing.T) {
 	backupPath := t.TempDir() + "/running.conf"
 	client := &fakeInteractiveClient{
@@ -2009,6 +2843,44 @@ func TestCmdShowOSPF3NeighborReturnsOutput(t *testing.T) {
 	}
 }
 
+func TestCmdShowNeighborTables(t *testing.T) {
+	tests := []struct {
+		args       []string
+		wantFamily string
+		wantFilter string
+	}{
+		{args: []string{"arp"}, wantFamily: routeAddressFamilyIPv4},
+		{args: []string{"arp", "interface", "ge-0/0/0"}, wantFamily: routeAddressFamilyIPv4, wantFilter: "ge-0/0/0"},
+		{args: []string{"ipv6", "neighbors"}, wantFamily: routeAddressFamilyIPv6},
+	}
+	for _, tt := range tests {
+		client := &fakeInteractiveClient{neighbors: []grpcclient.NeighborInfo{{Address: "192.0.2.10", MAC: "00:11:22:33:44:55", Static: true}}}
+		sh := &interactiveShell{client: client, hostname: "router", mode: modeOperational, sessionID: "session-1"}
+		if err := sh.cmdShow(context.Background(), tt.args); err != nil {
+			t.Fatalf("cmdShow(%v) error = %v", tt.args, err)
+		}
+		if client.neighborCalls != 1 || client.neighborFamily != tt.wantFamily || client.neighborFilter != tt.wantFilter {
+			t.Fatalf("cmdShow(%v) neighbor request = %d/%q/%q, want 1/%q/%q",
+				tt.args, client.neighborCalls, client.neighborFamily, client.neighborFilter, tt.wantFamily, tt.wantFilter)
+		}
+	}
+
+	for _, args := range [][]string{{"ipv6"}, {"ipv6", "route"}, {"arp", "interface"}, {"arp", "ge-0/0/0"}} {
+		sh := &interactiveShell{client: &fakeInteractiveClient{}, hostname: "router", mode: modeOperational}
+		if err := sh.cmdShow(context.Background(), args); err == nil {
+			t.Fatalf("cmdShow(%v) error = nil, want usage error", args)
+		}
+	}
+
+	client := &fakeInteractiveClient{}
+	if code := oneShotShow(context.Background(), client, []string{"ipv6", "neighbors"}, &cliFlags{}); code != ExitSuccess {
+		t.Fatalf("oneShotShow(ipv6 neighbors) = %d, want %d", code, ExitSuccess)
+	}
+	if client.neighborFamily != routeAddressFamilyIPv6 {
+		t.Fatalf("oneShotShow(ipv6 neighbors) family = %q, want %q", client.neighborFamily, routeAddressFamilyIPv6)
+	}
+}
+
 func TestCmdShowVRRPReturnsOutput(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{}
@@ -2092,6 +2964,151 @@ func TestCmdShowLCPReturnsOutput(t *testing.T) {
 	}
 }
 
+func TestCmdShowSystemCommitLastReturnsOutput(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{lastApply: &grpcclient.LastApplyInfo{
+		Recorded: true,
+		Time:     time.Now(),
+		Version:  3,
+		Status:   "failed",
+		Error:    "plugin vpp apply failed (rollback succeeded): address rejected",
+		Plugins: []grpcclient.PluginApplyInfo{
+			{Plugin: "vpp", Status: "failed", Error: "address rejected", Operations: []grpcclient.ApplyOperationInfo{
+				{Operation: "create interface ge-0/0/0"},
+				{Operation: "apply interface ge-0/0/0 addresses", Error: "address rejected"},
+			}},
+			{Plugin: "frr", Status: "not-applied"},
+		},
+	}}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeOperational,
+		sessionID: "session-1",
+	}
+
+	if err := sh.cmdShow(ctx, []string{"system", "commit", "last"}); err != nil {
+		t.Fatalf("cmdShow(system commit last) error = %v", err)
+	}
+	if err := sh.cmdShow(ctx, []string{"system", "commit", "first"}); err == nil {
+		t.Fatal("cmdShow(system commit first) error = nil, want usage error")
+	}
+}
+
+func TestCmdShowSystemAlarmsReturnsOutput(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{alarms: []grpcclient.AlarmInfo{
+		{Type: "interface-link-down", Object: "ge-0/0/1", Description: "link is down", RaisedAt: time.Now()},
+	}}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeOperational,
+		sessionID: "session-1",
+	}
+
+	if err := sh.cmdShow(ctx, []string{"system", "alarms"}); err != nil {
+		t.Fatalf("cmdShow(system alarms) error = %v", err)
+	}
+	if err := sh.cmdShow(ctx, []string{"system", "alarms", "all"}); err == nil {
+		t.Fatal("cmdShow(system alarms all) error = nil, want usage error")
+	}
+}
+
+// sqliteHistoryClient serves commit history from a daemon server backed by
+// the SQLite store.
+type sqliteHistoryClient struct {
+	*fakeInteractiveClient
+	server       *grpcclient.Server
+	historyCalls int
+}
+
+func (c *sqliteHistoryClient) ListHistory(ctx context.Context, limit, offset int) ([]grpcclient.CommitInfo, error) {
+	c.historyCalls++
+	return c.server.ListHistory(ctx, limit, offset)
+}
+
+func (c *sqliteHistoryClient) GetCommit(ctx context.Context, commitID string) (grpcclient.CommitInfo, error) {
+	return c.server.GetCommit(ctx, commitID)
+}
+
+func (c *sqliteHistoryClient) CompareCommits(ctx context.Context, fromID, toID string) (string, bool, error) {
+	return c.server.CompareCommits(ctx, fromID, toID)
+}
+
+func TestShowSystemCommitCompareOverSQLiteHistory(t *testing.T) {
+	ctx := context.Background()
+	st, err := sqlitestore.NewFromPath(filepath.Join(t.TempDir(), "config.db"), sqlitestore.WithLegacyTextParser(
+		func(text string) (*model.RouterConfig, error) {
+			cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+			if err != nil {
+				return nil, err
+			}
+			return model.FromLegacyConfig(cfg), nil
+		}))
+	if err != nil {
+		t.Fatalf("NewFromPath() error = %v", err)
+	}
+	t.Cleanup(func() { _ = st.Close() })
+
+	hostnames := []string{"router1", "router2", "router3"}
+	ids := make([]string, 0, len(hostnames))
+	for i, hostname := range hostnames {
+		cfg := model.NewRouterConfig()
+		cfg.System = &model.SystemConfig{HostName: hostname}
+		if i > 0 {
+			cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{Description: "uplink " + hostname}
+			cfg.System.Services = &model.SystemServicesConfig{SNMP: &model.SNMPConfig{Community: "secret-" + hostname}}
+		}
+		id, err := st.SaveCommit(ctx, model.NewSnapshot(cfg, uint64(i+1), "alice", "change "+hostname))
+		if err != nil {
+			t.Fatalf("SaveCommit(%s) error = %v", hostname, err)
+		}
+		ids = append(ids, id)
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := &sqliteHistoryClient{
+		fakeInteractiveClient: &fakeInteractiveClient{},
+		server:                grpcclient.NewServer(engine.NewEngine(nil, logger), st, logger),
+	}
+
+	diffText, from, to, err := commitCompareDiff(ctx, client, ids[0], shortCommitID(ids[2]))
+	if err != nil {
+		t.Fatalf("commitCompareDiff() error = %v", err)
+	}
+	if from.CommitID != ids[0] || to.CommitID != ids[2] || to.User != "alice" || to.Message != "change router3" {
+		t.Fatalf("compared commits = %+v, %+v; want first and third commit", from, to)
+	}
+	want := strings.Join([]string{
+		"- set system host-name router1",
+		"+ set system host-name router3",
+		"+ set system services snmp community <redacted>",
+		`+ set interfaces ge-0/0/0 description "uplink router3"`,
+	}, "\n")
+	if diffText != want {
+		t.Fatalf("diff =\n%s\nwant\n%s", diffText, want)
+	}
+
+	// Full IDs are loaded directly rather than searched for in the history,
+	// so commits older than the searched history still resolve.
+	client.historyCalls = 0
+	if _, _, _, err := commitCompareDiff(ctx, client, ids[0], ids[2]); err != nil {
+		t.Fatalf("commitCompareDiff(full IDs) error = %v", err)
+	}
+	if client.historyCalls != 0 {
+		t.Fatalf("ListHistory() calls = %d, want none for full commit IDs", client.historyCalls)
+	}
+
+	sh := &interactiveShell{client: client, hostname: "router", mode: modeOperational, sessionID: "session-1"}
+	if err := sh.cmdShow(ctx, []string{"system", "commit", "compare", ids[0], ids[2]}); err != nil {
+		t.Fatalf("cmdShow(system commit compare) error = %v", err)
+	}
+	if err := sh.cmdShow(ctx, []string{"system", "commit", "compare", ids[0], "missing"}); err == nil || !strings.Contains(err.Error(), "commit missing not found") {
+		t.Fatalf("cmdShow(compare missing) error = %v, want not found", err)
+	}
+}
+
 func TestCmdShowHAReturnsOutput(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{haInfo: &grpcclient.HAStatusInfo{
@@ -2207,6 +3224,72 @@ func TestCmdShowBGPNeighborsUsesStructuredState(t *testing.T) {
 	}
 }
 
+func TestCmdShowProtocolNeighborsRenderFRRState(t *testing.T) {
+	ctx := context.Background()
+	// State as parsed from captured FRR "show bgp summary json" and
+	// "show ip ospf neighbor json" output.
+	client := &fakeInteractiveClient{
+		bgpNeighbors: []grpcclient.BGPNeighborInfo{
+			{PeerAddress: "192.0.2.2", PeerAS: 65001, State: "Established", UptimeSecs: 3723, PrefixReceived: 3, PrefixSent: 2},
+			{PeerAddress: "192.0.2.6", PeerAS: 65002, State: "Active"},
+			{PeerAddress: "ge0-0-1", PeerAS: 65003, State: "Established", UptimeSecs: 300, PrefixReceived: 3, PrefixSent: 5},
+		},
+		ospfNeighbors: []grpcclient.OSPFNeighborInfo{
+			{RouterID: "10.0.0.2", Address: "192.0.2.2", Interface: "ge0-0-0", State: "Full", Role: "DR", Priority: 1, DeadTimeSecs: 33, UptimeSecs: 3723},
+			{RouterID: "10.0.0.3", Address: "192.0.2.3", Interface: "ge0-0-0", State: "2-Way", Role: "DROther", DeadTimeSecs: 39, UptimeSecs: 1},
+		},
+	}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		mode:      modeOperational,
+		sessionID: "session-1",
+	}
+
+	output, err := captureStdout(func() error {
+		return sh.cmdShow(ctx, []string{"bgp", "neighbors"})
+	})
+	if err != nil {
+		t.Fatalf("cmdShow(bgp neighbors) error = %v", err)
+	}
+	for _, row := range [][]string{
+		{"Peer", "AS", "State", "Uptime", "Prefixes", "in", "Prefixes", "out"},
+		{"192.0.2.2", "65001", "Established", "1h02m03s", "3", "2"},
+		{"192.0.2.6", "65002", "Active", "-", "0", "0"},
+		{"ge0-0-1", "65003", "Established", "5m00s", "3", "5"},
+	} {
+		if !outputHasRow(output, row) {
+			t.Fatalf("show bgp neighbors output missing row %v:\n%s", row, output)
+		}
+	}
+
+	output, err = captureStdout(func() error {
+		return sh.cmdShow(ctx, []string{"ospf", "neighbor"})
+	})
+	if err != nil {
+		t.Fatalf("cmdShow(ospf neighbor) error = %v", err)
+	}
+	for _, row := range [][]string{
+		{"Router", "ID", "Address", "Interface", "State", "Role", "Dead", "Uptime"},
+		{"10.0.0.2", "192.0.2.2", "ge0-0-0", "Full", "DR", "33s", "1h02m03s"},
+		{"10.0.0.3", "192.0.2.3", "ge0-0-0", "2-Way", "DROther", "39s", "1s"},
+	} {
+		if !outputHasRow(output, row) {
+			t.Fatalf("show ospf neighbor output missing row %v:\n%s", row, output)
+		}
+	}
+}
+
+// outputHasRow reports whether a line of output consists of exactly fields.
+func outputHasRow(output string, fields []string) bool {
+	for _, line := range strings.Split(output, "\n") {
+		if reflect.DeepEqual(strings.Fields(line), fields) {
+			return true
+		}
+	}
+	return false
+}
+
 func TestCmdShowRoutesUsesStructuredState(t *testing.T) {
 	ctx := context.Background()
 	client := &fakeInteractiveClient{routes: []grpcclient.RouteInfo{
@@ -2234,6 +3317,74 @@ func TestCmdShowRoutesUsesStructuredState(t *testing.T) {
 	}
 }
 
+func TestCmdShowRouteSummaryCountsRoutesByProtocol(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{routeSummaries: []grpcclient.RouteFamilySummaryInfo{
+		{
+			Family: routeAddressFamilyIPv4,
+			Protocols: []grpcclient.RouteProtocolCountInfo{
+				{Protocol: "bgp", Routes: 2, FIBRoutes: 1},
+				{Protocol: "connected", Routes: 2, FIBRoutes: 2},
+				{Protocol: "ospf", Routes: 1, FIBRoutes: 1},
+				{Protocol: "rip", Routes: 1, FIBRoutes: 1},
+				{Protocol: "static", Routes: 1, FIBRoutes: 1},
+			},
+			Total: 7,
+		},
+		{
+			Family: routeAddressFamilyIPv6,
+			Protocols: []grpcclient.RouteProtocolCountInfo{
+				{Protocol: "connected", Routes: 1, FIBRoutes: 1},
+				{Protocol: "ospf6", Routes: 1, FIBRoutes: 1},
+			},
+			Total: 2,
+		},
+	}}
+
+	inet := routeSummaryFromInfo(client.routeSummaries, routeAddressFamilyIPv4)
+	want := map[string]int{"connected": 2, "static": 1, "bgp": 2, "ospf": 1, "rip": 1}
+	if !reflect.DeepEqual(inet.counts, want) || inet.total != 7 {
+		t.Fatalf("routeSummaryFromInfo(inet) = %v total %d, want %v total 7", inet.counts, inet.total, want)
+	}
+	inet6 := routeSummaryFromInfo(client.routeSummaries, routeAddressFamilyIPv6)
+	want = map[string]int{"connected": 1, "ospf": 1}
+	if !reflect.DeepEqual(inet6.counts, want) || inet6.total != 2 {
+		t.Fatalf("routeSummaryFromInfo(inet6) = %v total %d, want %v total 2", inet6.counts, inet6.total, want)
+	}
+
+	for _, tt := range []struct {
+		args     []string
+		families []string
+		ok       bool
+		wantErr  bool
+	}{
+		{args: []string{"summary"}, families: []string{"inet", "inet6"}, ok: true},
+		{args: []string{"inet6", "summary"}, families: []string{"inet6"}, ok: true},
+		{args: []string{"summary", "extra"}, ok: true, wantErr: true},
+		{args: []string{"protocol", "bgp"}},
+		{args: nil},
+	} {
+		families, ok, err := routeSummaryOptions(tt.args)
+		if ok != tt.ok || (err != nil) != tt.wantErr || (!tt.wantErr && !reflect.DeepEqual(families, tt.families)) {
+			t.Fatalf("routeSummaryOptions(%v) = %v, %t, %v", tt.args, families, ok, err)
+		}
+	}
+
+	sh := &interactiveShell{client: client, mode: modeOperational}
+	if err := sh.cmdShow(ctx, []string{"route", "summary"}); err != nil {
+		t.Fatalf("cmdShow(route summary) error = %v", err)
+	}
+	if client.routeSummaryCalls != 1 || client.routeCalls != 0 || client.routeFamily != "" {
+		t.Fatalf("summary calls = %d, route calls = %d, raw family %q, want one summary query and no route table",
+			client.routeSummaryCalls, client.routeCalls, client.routeFamily)
+	}
+
+	client.routeSummaryErr = errors.New("connection refused")
+	if err := sh.cmdShow(ctx, []string{"route", "inet", "summary"}); err != nil {
+		t.Fatalf("cmdShow(route summary) with unreachable backend error = %v, want degraded output", err)
+	}
+}
+
 func TestInterfaceQueueSummary(t *testing.T) {
 	got := interfaceQueueSummary(grpcclient.InterfaceInfo{
 		RxQueues: []grpcclient.InterfaceRxQueueInfo{
@@ -2428,6 +3579,77 @@ func TestOneShotShowClassOfServiceReturnsSuccess(t *testing.T) {
 	}
 }
 
+func TestOneShotShowSecurityUsers(t *testing.T) {
+	client := &fakeInteractiveClient{runningText: strings.Join([]string{
+		`set security users user alice role operator`,
+		`set security users user alice ssh-key "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBfakekey alice@example"`,
+		`set security users user alice disable`,
+	}, "\n")}
+	if code := oneShotShow(context.Background(), client, []string{"security", "users"}, &cliFlags{}); code != ExitSuccess {
+		t.Fatalf("oneShotShow(security users) = %d, want %d", code, ExitSuccess)
+	}
+	if code := oneShotShow(context.Background(), client, []string{"security"}, &cliFlags{}); code != ExitUsageError {
+		t.Fatalf("oneShotShow(security) = %d, want %d", code, ExitUsageError)
+	}
+}
+
+func TestUserSSHKeyCommands(t *testing.T) {
+	const fingerprint = "SHA256:2tmwgZWFcnVZgTqlDwiwMrOxD2BP+JM5ZL6kbKOvb+Y"
+	client := &fakeInteractiveClient{userSSHKeys: map[string][]grpcclient.UserSSHKey{
+		"alice": {{Algorithm: "ssh-ed25519", Fingerprint: fingerprint, Comment: "alice@laptop", Enabled: true}},
+	}}
+	ctx := context.Background()
+	if code := oneShotShow(ctx, client, []string{"security", "users", "user", "alice", "ssh-keys"}, &cliFlags{}); code != ExitSuccess {
+		t.Fatalf("oneShotShow(ssh-keys) = %d, want %d", code, ExitSuccess)
+	}
+
+	for _, action := range []string{"disable", "enable", "delete"} {
+		args := []string{"security", "users", "user", "alice", "ssh-key", action, fingerprint}
+		if code := oneShotRequest(ctx, client, args); code != ExitSuccess {
+			t.Fatalf("oneShotRequest(%s) = %d, want %d", action, code, ExitSuccess)
+		}
+	}
+	want := []string{
+		"status alice " + fingerprint + " false",
+		"status alice " + fingerprint + " true",
+		"remove alice " + fingerprint,
+	}
+	if !reflect.DeepEqual(client.userSSHKeyActions, want) {
+		t.Fatalf("actions = %v, want %v", client.userSSHKeyActions, want)
+	}
+
+	if code := oneShotRequest(ctx, client, []string{"security", "users", "user", "alice", "ssh-key", "revoke", fingerprint}); code != ExitUsageError {
+		t.Fatalf("oneShotRequest(revoke) = %d, want %d", code, ExitUsageError)
+	}
+	if _, err := parseShowSecurityArgs([]string{"users", "user", "alice"}); err == nil {
+		t.Fatal("parseShowSecurityArgs(missing ssh-keys) error = nil, want usage error")
+	}
+}
+
+func TestRequestSystemDatastoreCheck(t *testing.T) {
+	client := &fakeInteractiveClient{}
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"system", "datastore", "check"},
+		{"system", "datastore", "check", "repair"},
+	} {
+		if code := oneShotRequest(ctx, client, args); code != ExitSuccess {
+			t.Fatalf("oneShotRequest(%v) = %d, want %d", args, code, ExitSuccess)
+		}
+	}
+	if want := []bool{false, true}; !reflect.DeepEqual(client.datastoreRepairs, want) {
+		t.Fatalf("repairs = %v, want %v", client.datastoreRepairs, want)
+	}
+	for _, args := range [][]string{
+		{"system", "datastore"},
+		{"system", "datastore", "check", "fix"},
+	} {
+		if code := oneShotRequest(ctx, client, args); code != ExitUsageError {
+			t.Fatalf("oneShotRequest(%v) = %d, want %d", args, code, ExitUsageError)
+		}
+	}
+}
+
 func TestOneShotShowEVPNReturnsSuccess(t *testing.T) {
 	client := &fakeInteractiveClient{telemetryEvents: []*grpcclient.TelemetryEvent{evpnTelemetryTestEvent()}}
 	code := oneShotShow(context.Background(), client, []string{"evpn"}, &cliFlags{})
@@ -3063,3 +4285,238 @@ func TestRollbackRejectsInvalidNumber(t *testing.T) {
 		}
 	}
 }
+
+func TestReadlineHistoryFileIsPerUser(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", stateDir)
+
+	alice, err := readlineHistoryFile("alice", defaultCLIHistorySize)
+	if err != nil {
+		t.Fatalf("readlineHistoryFile(alice) error = %v", err)
+	}
+	bob, err := readlineHistoryFile("../bob", defaultCLIHistorySize)
+	if err != nil {
+		t.Fatalf("readlineHistoryFile(../bob) error = %v", err)
+	}
+	historyDir := filepath.Join(stateDir, "arca", "cli-history")
+	if alice != filepath.Join(historyDir, "alice") || bob != filepath.Join(historyDir, "___bob") {
+		t.Fatalf("history files = %q, %q; want separate files under %s", alice, bob, historyDir)
+	}
+
+	dirInfo, err := os.Stat(historyDir)
+	if err != nil {
+		t.Fatalf("Stat(history dir) error = %v", err)
+	}
+	if perm := dirInfo.Mode().Perm(); perm != 0o700 {
+		t.Fatalf("history dir mode = %o, want 700", perm)
+	}
+	fileInfo, err := os.Stat(alice)
+	if err != nil {
+		t.Fatalf("Stat(history file) error = %v", err)
+	}
+	if perm := fileInfo.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("history file mode = %o, want 600", perm)
+	}
+
+	if path, err := readlineHistoryFile("alice", 0); err != nil || path != "" {
+		t.Fatalf("readlineHistoryFile(size 0) = %q, %v; want history disabled", path, err)
+	}
+	if _, err := readlineHistoryFile("alice", -1); err == nil {
+		t.Fatal("readlineHistoryFile(size -1) error = nil, want error")
+	}
+}
+
+func TestReadlineHistoryFileTightensExistingPermissions(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", stateDir)
+	historyDir := filepath.Join(stateDir, "arca", "cli-history")
+	if err := os.MkdirAll(historyDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.Chmod(historyDir, 0o755); err != nil {
+		t.Fatalf("Chmod(dir) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(historyDir, "alice"), []byte("show version\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	path, err := readlineHistoryFile("alice", defaultCLIHistorySize)
+	if err != nil {
+		t.Fatalf("readlineHistoryFile(alice) error = %v", err)
+	}
+	if info, err := os.Stat(historyDir); err != nil || info.Mode().Perm() != 0o700 {
+		t.Fatalf("history dir stat = %v, %v; want mode 700", info, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("history file stat = %v, %v; want mode 600", info, err)
+	}
+}
+
+func TestCmdInsertSendsInsertWithEditPath(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		role:      "admin",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+		hasLock:   true,
+		editPath:  []string{"policy-options", "policy-statement", "EXPORT"},
+	}
+
+	if err := sh.processCommand(ctx, "insert term T3 before term T1"); err != nil {
+		t.Fatalf("processCommand() error = %v", err)
+	}
+	want := "insert policy-options policy-statement EXPORT term T3 before term T1"
+	if len(client.editTexts) != 1 || client.editTexts[0] != want {
+		t.Fatalf("EditCandidate configs = %#v, want %q", client.editTexts, want)
+	}
+
+	if err := sh.processCommand(ctx, "insert term T3 first"); err != nil {
+		t.Fatalf("processCommand(insert first) error = %v", err)
+	}
+	if err := sh.processCommand(ctx, "insert term T1 last"); err != nil {
+		t.Fatalf("processCommand(insert last) error = %v", err)
+	}
+	wantTexts := []string{
+		want,
+		"insert policy-options policy-statement EXPORT term T3 first",
+		"insert policy-options policy-statement EXPORT term T1 last",
+	}
+	if !reflect.DeepEqual(client.editTexts, wantTexts) {
+		t.Fatalf("EditCandidate configs = %#v, want %#v", client.editTexts, wantTexts)
+	}
+
+	if err := sh.processCommand(ctx, "insert term T3"); err == nil {
+		t.Fatal("processCommand(insert without position) error = nil")
+	}
+	if len(client.editTexts) != len(wantTexts) {
+		t.Fatalf("EditCandidate calls = %d, want invalid insert rejected locally", len(client.editTexts))
+	}
+}
+
+func TestMarkInactiveStatements(t *testing.T) {
+	text := strings.Join([]string{
+		"set protocols bgp group EXTERNAL type external",
+		"set protocols bgp group EXTERNAL2 type external",
+		"deactivate protocols bgp group EXTERNAL",
+	}, "\n")
+	want := strings.Join([]string{
+		"inactive: set protocols bgp group EXTERNAL type external",
+		"set protocols bgp group EXTERNAL2 type external",
+		"deactivate protocols bgp group EXTERNAL",
+	}, "\n")
+	if got := markInactiveStatements(text); got != want {
+		t.Fatalf("markInactiveStatements() = %q, want %q", got, want)
+	}
+}
+
+func TestCmdDeactivateSendsPathWithEditPath(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeInteractiveClient{}
+	sh := &interactiveShell{
+		client:    client,
+		hostname:  "router",
+		role:      "admin",
+		mode:      modeConfiguration,
+		sessionID: "session-1",
+		hasLock:   true,
+		editPath:  []string{"protocols", "bgp"},
+	}
+
+	for _, line := range []string{"deactivate group EXTERNAL", "activate group EXTERNAL"} {
+		if err := sh.processCommand(ctx, line); err != nil {
+			t.Fatalf("processCommand(%q) error = %v", line, err)
+		}
+	}
+	want := []string{"deactivate protocols bgp group EXTERNAL", "activate protocols bgp group EXTERNAL"}
+	if !reflect.DeepEqual(client.editTexts, want) {
+		t.Fatalf("EditCandidate configs = %#v, want %#v", client.editTexts, want)
+	}
+}
+
+func TestNoMoreDisablesPaging(t *testing.T) {
+	for _, tt := range []struct {
+		line       string
+		want       string
+		wantNoMore bool
+	}{
+		{line: "show route | no-more", want: "show route", wantNoMore: true},
+		{line: "show configuration | display set |no-more", want: "show configuration | display set", wantNoMore: true},
+		{line: "show configuration | display set", want: "show configuration | display set"},
+		{line: `set interfaces ge-0/0/0 description "a | no-more"`, want: `set interfaces ge-0/0/0 description "a | no-more"`},
+	} {
+		got, noMore := cutNoMore(tt.line)
+		if got != tt.want || noMore != tt.wantNoMore {
+			t.Errorf("cutNoMore(%q) = %q, %v; want %q, %v", tt.line, got, noMore, tt.want, tt.wantNoMore)
+		}
+	}
+
+	if got := pageHeight(false, true, 25); got != 24 {
+		t.Errorf("pageHeight(terminal) = %d, want 24", got)
+	}
+	if got := pageHeight(true, true, 25); got != 0 {
+		t.Errorf("pageHeight(no-more) = %d, want 0", got)
+	}
+	if got := pageHeight(false, false, 25); got != 0 {
+		t.Errorf("pageHeight(non-TTY) = %d, want 0", got)
+	}
+	if isPagedCommand("show telemetry path /interfaces") || isPagedCommand("commit") || !isPagedCommand("show route") {
+		t.Error("isPagedCommand() pages the wrong commands")
+	}
+
+	// Tests do not run attached to a terminal, so show output is not paged.
+	sh := &interactiveShell{stdin: strings.NewReader("")}
+	if got := sh.terminalPageHeight(false); got != 0 {
+		t.Errorf("terminalPageHeight() without a terminal = %d, want 0", got)
+	}
+}
+
+func TestCaptureStdoutKeepsPromptsVisible(t *testing.T) {
+	var prompt bytes.Buffer
+	sh := &interactiveShell{
+		flags:  &cliFlags{force: true},
+		stdin:  strings.NewReader(""),
+		prompt: &prompt,
+	}
+	output, err := captureStdout(func() error {
+		fmt.Println("before")
+		sh.confirm("Apply these changes?")
+		fmt.Println("after")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("captureStdout() error = %v", err)
+	}
+	if output != "before\nafter\n" {
+		t.Errorf("captured output = %q, want show output only", output)
+	}
+	if want := "Apply these changes? [yes/no]: yes (-force)\n"; prompt.String() != want {
+		t.Errorf("prompt output = %q, want %q", prompt.String(), want)
+	}
+}
+
+func TestCaptureStdoutRestoresStdoutOnPanic(t *testing.T) {
+	stdout := os.Stdout
+	func() {
+		defer func() { _ = recover() }()
+		_, _ = captureStdout(func() error { panic("show handler failed") })
+	}()
+	if os.Stdout != stdout {
+		os.Stdout = stdout
+		t.Fatal("captureStdout() left os.Stdout redirected after a panic")
+	}
+}
+
+func TestPageOutputStopsOnQuit(t *testing.T) {
+	text := "1\n2\n3\n4\n5\n"
+	var out bytes.Buffer
+	if err := pageOutput(&out, strings.NewReader("\nq\n"), text, 2); err != nil {
+		t.Fatalf("pageOutput() error = %v", err)
+	}
+	want := "1\n2\n" + pagerPrompt + "3\n4\n" + pagerPrompt
+	if out.String() != want {
+		t.Errorf("pageOutput() = %q, want %q", out.String(), want)
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/chzyer/readline"
+)
+
+// noMorePipe is the pipe command that disables paging of one command.
+const noMorePipe = "no-more"
+
+const pagerPrompt = "--More-- (Enter for next page, q to quit)"
+
+// cutNoMore removes a trailing "| no-more" from an interactive command line
+// and reports whether it was present.
+func cutNoMore(line string) (string, bool) {
+	idx := strings.LastIndex(line, "|")
+	if idx < 0 || strings.TrimSpace(line[idx+1:]) != noMorePipe {
+		return line, false
+	}
+	left := line[:idx]
+	if strings.Count(left, `"`)%2 != 0 {
+		// The pipe is inside a quoted value.
+		return line, false
+	}
+	return strings.TrimSpace(left), true
+}
+
+// pageHeight returns the number of output lines shown per page, or 0 when
+// output is not paged: with "| no-more", when the shell is not attached to
+// a terminal, or when the terminal is too small to hold a page and the
+// prompt.
+func pageHeight(noMore, terminal bool, rows int) int {
+	if noMore || !terminal || rows < 2 {
+		return 0
+	}
+	return rows - 1
+}
+
+// isPagedCommand reports whether the output of line is paged. Telemetry
+// streams run until interrupted and are always written directly.
+func isPagedCommand(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || fields[0] != "show" {
+		return false
+	}
+	return len(fields) < 2 || fields[1] != "telemetry"
+}
+
+// terminalPageHeight returns the page height for the terminal the shell
+// writes to.
+func (sh *interactiveShell) terminalPageHeight(noMore bool) int {
+	fd := int(os.Stdout.Fd())
+	terminal := !sh.nonInteractive && readline.IsTerminal(fd)
+	rows := 0
+	if terminal {
+		_, rows, _ = readline.GetSize(fd)
+	}
+	return pageHeight(noMore, terminal, rows)
+}
+
+// pageOutput writes text to out height lines at a time, waiting for a line
+// from in between pages. Entering "q" stops the output.
+func pageOutput(out io.Writer, in io.Reader, text string, height int) error {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	reader := bufio.NewReader(in)
+	for start := 0; start < len(lines); start += height {
+		if start > 0 {
+			if _, err := fmt.Fprint(out, pagerPrompt); err != nil {
+				return err
+			}
+			response, err := reader.ReadString('\n')
+			if strings.EqualFold(strings.TrimSpace(response), "q") {
+				return nil
+			}
+			if err != nil {
+				fmt.Fprintln(out)
+				return nil
+			}
+		}
+		end := min(start+height, len(lines))
+		if _, err := io.WriteString(out, strings.Join(lines[start:end], "")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// captureStdout runs fn with os.Stdout redirected and returns what fn wrote.
+// os.Stdout is restored even if fn panics.
+func captureStdout(fn func() error) (output string, err error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(&buf, r)
+		close(done)
+	}()
+
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+		_ = w.Close()
+		<-done
+		_ = r.Close()
+		output = buf.String()
+	}()
+	return "", fn()
+}
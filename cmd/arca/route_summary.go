@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+)
+
+// routeSummaryProtocols lists the protocols always reported by
+// "show route summary", in display order; other protocols follow sorted.
+var routeSummaryProtocols = []string{"connected", "static", "bgp", "ospf"}
+
+// routeSummaryOptions parses "show route [inet|inet6] summary". It reports
+// ok=false when args do not request a summary, and returns the address
+// families to summarize, both when no family is given.
+func routeSummaryOptions(args []string) (families []string, ok bool, err error) {
+	families = []string{routeAddressFamilyIPv4, routeAddressFamilyIPv6}
+	if len(args) > 0 && isRouteAddressFamily(args[0]) {
+		families = []string{args[0]}
+		args = args[1:]
+	}
+	if len(args) == 0 || args[0] != "summary" {
+		return nil, false, nil
+	}
+	if len(args) > 1 {
+		return nil, true, fmt.Errorf("'show route summary' does not accept extra arguments")
+	}
+	return families, true, nil
+}
+
+// routeSummary is the route count per protocol of one address family.
+type routeSummary struct {
+	family string
+	counts map[string]int
+	total  int
+}
+
+// routeSummaryFromInfo converts the daemon's route counts for family; a family
+// the daemon did not report yields an empty table.
+func routeSummaryFromInfo(families []grpcclient.RouteFamilySummaryInfo, family string) routeSummary {
+	summary := routeSummary{family: family, counts: make(map[string]int)}
+	for _, info := range families {
+		if info.Family != family {
+			continue
+		}
+		for _, count := range info.Protocols {
+			summary.counts[routeSummaryProtocol(count.Protocol, family)] += int(count.Routes)
+		}
+		summary.total += int(info.Total)
+	}
+	return summary
+}
+
+// routeSummaryProtocol folds OSPFv3 into the ospf row of the inet6 table.
+func routeSummaryProtocol(protocol, family string) string {
+	protocol = strings.ToLower(strings.TrimSpace(protocol))
+	if protocol == "" {
+		return "unknown"
+	}
+	if family == routeAddressFamilyIPv6 && protocol == "ospf6" {
+		return "ospf"
+	}
+	return protocol
+}
+
+// showRouteSummary prints route counts per protocol as counted by FRR, so the
+// routing table itself is never transferred. An unreachable routing backend is
+// reported instead of failing the command.
+func showRouteSummary(ctx context.Context, client showClient, families []string) error {
+	summaries, err := client.GetRouteSummary(ctx)
+	if err != nil {
+		fmt.Printf("Route summary unavailable: %v\n", err)
+		return nil
+	}
+	for i, family := range families {
+		if i > 0 {
+			fmt.Println()
+		}
+		printRouteSummary(routeSummaryFromInfo(summaries, family))
+	}
+	return nil
+}
+
+func printRouteSummary(summary routeSummary) {
+	protocols := append([]string(nil), routeSummaryProtocols...)
+	listed := make(map[string]bool, len(protocols))
+	for _, protocol := range protocols {
+		listed[protocol] = true
+	}
+	var others []string
+	for protocol := range summary.counts {
+		if !listed[protocol] {
+			others = append(others, protocol)
+		}
+	}
+	sort.Strings(others)
+	protocols = append(protocols, others...)
+
+	fmt.Printf("Route summary (%s)\n", summary.family)
+	fmt.Printf("%-12s %8s\n", "Protocol", "Routes")
+	fmt.Println(strings.Repeat("-", 21))
+	for _, protocol := range protocols {
+		fmt.Printf("%-12s %8d\n", protocol, summary.counts[protocol])
+	}
+	fmt.Println(strings.Repeat("-", 21))
+	fmt.Printf("%-12s %8d\n", "Total", summary.total)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+)
+
+// scheduledCommitClient is implemented by clients that support "commit at".
+type scheduledCommitClient interface {
+	ScheduleCommit(context.Context, string, string, string, time.Time) (time.Time, error)
+	GetScheduledCommit(context.Context) (*grpcclient.ScheduledCommitInfo, error)
+	ClearScheduledCommit(context.Context, string) (*grpcclient.ScheduledCommitInfo, error)
+}
+
+const commitAtFormats = `"YYYY-MM-DD HH:MM[:SS]" or "HH:MM[:SS]"`
+
+// commitAtLayouts are the accepted "commit at" time formats, interpreted in
+// local time. A time of day without a date means the next occurrence of that
+// time.
+var commitAtLayouts = []struct {
+	layout    string
+	timeOfDay bool
+}{
+	{"2006-01-02 15:04:05", false},
+	{"2006-01-02 15:04", false},
+	{"15:04:05", true},
+	{"15:04", true},
+}
+
+// parseCommitAtTime parses a "commit at" time relative to now and rejects
+// times that are not in the future.
+func parseCommitAtTime(raw string, now time.Time) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	for _, format := range commitAtLayouts {
+		parsed, err := time.ParseInLocation(format.layout, raw, now.Location())
+		if err != nil {
+			continue
+		}
+		if format.timeOfDay {
+			parsed = time.Date(now.Year(), now.Month(), now.Day(),
+				parsed.Hour(), parsed.Minute(), parsed.Second(), 0, now.Location())
+			if !parsed.After(now) {
+				parsed = parsed.AddDate(0, 0, 1)
+			}
+		}
+		if !parsed.After(now) {
+			return time.Time{}, fmt.Errorf("commit time %s is not in the future", parsed.Format("2006-01-02 15:04:05 MST"))
+		}
+		return parsed, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid commit time %q: use %s", raw, commitAtFormats)
+}
+
+func showScheduledCommit(ctx context.Context, client showClient) error {
+	scheduler, ok := client.(scheduledCommitClient)
+	if !ok {
+		return fmt.Errorf("scheduled commits are not supported by this client")
+	}
+	info, err := scheduler.GetScheduledCommit(ctx)
+	if err != nil {
+		return err
+	}
+	printScheduledCommit(info)
+	return nil
+}
+
+func clearScheduledCommit(ctx context.Context, client showClient, args []string) error {
+	if len(args) != 2 || args[0] != "system" || args[1] != "commit" {
+		return fmt.Errorf("usage: clear system commit")
+	}
+	scheduler, ok := client.(scheduledCommitClient)
+	if !ok {
+		return fmt.Errorf("scheduled commits are not supported by this client")
+	}
+	info, err := scheduler.ClearScheduledCommit(ctx, currentUsername())
+	if err != nil {
+		return err
+	}
+	if info != nil {
+		fmt.Printf("commit scheduled for %s cleared\n", formatCommitAtTime(info.ScheduledAt))
+	}
+	return nil
+}
+
+func printScheduledCommit(info *grpcclient.ScheduledCommitInfo) {
+	if info == nil {
+		fmt.Println("No commit scheduled")
+		return
+	}
+	fmt.Printf("%-18s %s\n", "Scheduled for", formatCommitAtTime(info.ScheduledAt))
+	fmt.Printf("%-18s %s\n", "Requested by", info.User)
+	fmt.Printf("%-18s %s\n", "Requested at", formatCommitAtTime(info.CreatedAt))
+	if info.Message != "" {
+		fmt.Printf("%-18s %s\n", "Comment", info.Message)
+	}
+}
+
+func formatCommitAtTime(t time.Time) string {
+	return t.Local().Format("2006-01-02 15:04:05 MST")
+}
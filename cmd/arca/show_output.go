@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/akam1o/arca-router/internal/compat"
 	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
 )
 
 func (sh *interactiveShell) cmdEdit(args []string) error {
@@ -58,10 +60,13 @@ func (sh *interactiveShell) showHelp() {
 		fmt.Println("  backup configuration <path>   Save running configuration to a file")
 		fmt.Println("  backup configuration rollback <N> <path> Save archived config to a file")
 		fmt.Println("  check upgrade [backup <path>] Run upgrade preflight checks")
-		fmt.Println("  configure                     Enter configuration mode")
+		fmt.Println("  configure [exclusive|private|shared] Enter configuration mode")
 		fmt.Println("  show configuration            Show running configuration")
 		fmt.Println("  show configuration rollback <N> Show archived config N commits back")
+		fmt.Println("  show configuration | display set Show configuration as set commands")
+		fmt.Println("  show configuration | display frr|vpp Show the FRR config or VPP operations it produces")
 		fmt.Println("  show interfaces [<name>]      Show interface status")
+		fmt.Println("  show ... | no-more            Print show output without paging")
 		fmt.Println("  show routing-instances [name] Show routing-instance table mapping")
 		fmt.Println("  show routes [prefix <cidr>] [protocol <proto>] Show route status")
 		fmt.Println("  show bgp neighbors            Show BGP neighbor status")
@@ -69,6 +74,8 @@ func (sh *interactiveShell) showHelp() {
 		fmt.Println("  show bgp neighbor <ip>        Show raw BGP neighbor details")
 		fmt.Println("  show ospf neighbor            Show OSPFv2 neighbors")
 		fmt.Println("  show ospf3 neighbor           Show OSPFv3 neighbors")
+		fmt.Println("  show arp [interface <name>]   Show the VPP ARP table")
+		fmt.Println("  show ipv6 neighbors [interface <name>] Show the VPP IPv6 neighbor table")
 		fmt.Println("  show vrrp                     Show VRRP status")
 		fmt.Println("  show bfd status               Show BFD operational state")
 		fmt.Println("  show bfd [brief|counters]     Show raw BFD status")
@@ -79,8 +86,20 @@ func (sh *interactiveShell) showHelp() {
 		fmt.Println("  show lcp                      Show VPP LCP reconciliation status")
 		fmt.Println("  show ha                       Show HA convergence status")
 		fmt.Println("  show class-of-service         Show class-of-service intent")
+		fmt.Println("  show security users           Show configured users and account status")
+		fmt.Println("  show security users user <u> ssh-keys Show a user's SSH key fingerprints")
+		fmt.Println("  request security users user <u> ssh-key (disable|enable|delete) <fp>")
+		fmt.Println("                                Revoke or restore a user's SSH key")
+		fmt.Println("  show system commit            Show the commit scheduled with 'commit at'")
+		fmt.Println("  show system commit last       Show the outcome of the last data-plane apply")
+		fmt.Println("  show system commit compare <id1> <id2>")
+		fmt.Println("                                Show the set-format diff between two commits")
+		fmt.Println("  show system alarms            Show active interface alarms")
+		fmt.Println("  clear system commit           Cancel the commit scheduled with 'commit at'")
+		fmt.Println("  clear interfaces statistics [<name>] Reset the counters of one or all interfaces")
 		fmt.Println("  show route [inet|inet6]                 Show routing table")
 		fmt.Println("  show route [inet|inet6] protocol <proto> Show routes by protocol")
+		fmt.Println("  show route [inet|inet6] summary          Show route counts by protocol")
 		fmt.Println("  exit, quit                    Exit interactive CLI")
 	} else {
 		fmt.Println("Configuration mode commands:")
@@ -89,18 +108,30 @@ func (sh *interactiveShell) showHelp() {
 		fmt.Println("  backup configuration rollback <N> <path> Save archived config to a file")
 		fmt.Println("  set <config>              Add or modify configuration")
 		fmt.Println("  delete <config>           Delete configuration")
+		fmt.Println("  insert <term|prefix> (before|after) <ref>|first|last Reorder policy terms or prefix-list entries")
+		fmt.Println("  deactivate <config>       Keep configuration but do not apply it")
+		fmt.Println("  activate <config>         Re-enable deactivated configuration")
 		fmt.Println("  restore configuration <path> Replace candidate from a backup file")
 		fmt.Println("  restore configuration rollback <N> Replace candidate from archived config")
+		fmt.Println("  load patch <path>         Apply a saved 'show | compare' diff to the candidate")
+		fmt.Println("  replace pattern <old> [with] <new> Rename descriptions and policy names by regexp")
 		fmt.Println("  show                      Show candidate configuration")
+		fmt.Println("  show configuration [| display set] Show candidate configuration")
+		fmt.Println("  show configuration | display frr|vpp Show the FRR config or VPP operations it produces")
 		fmt.Println("  show configuration rollback <N> Show archived config N commits back")
 		fmt.Println("  show | compare            Show differences from running config")
+		fmt.Println("  show | compare rollback N Show what rollback N would change")
+		fmt.Println("  show ... | no-more        Print show output without paging")
 		fmt.Println("  commit                    Commit candidate configuration")
 		fmt.Println("  commit check              Validate and preview impact without committing")
 		fmt.Println("  commit and-quit           Commit and exit configuration mode")
 		fmt.Println("  commit comment <msg>      Commit with custom message")
+		fmt.Println("  commit confirmed [min]    Commit with automatic rollback unless confirmed")
+		fmt.Println("  commit persist-id <id>    Confirm a pending confirmed commit from any session")
+		fmt.Println("  commit at \"<time>\"       Schedule the commit for a later time")
 		fmt.Println("  rollback <N>              Roll back N commits")
 		fmt.Println("  discard-changes           Discard all candidate changes")
-		fmt.Println("  show history [N]          Show last N commits")
+		fmt.Println("  show history [N] [skip M] Show N commits, skipping the M newest")
 		fmt.Println("  edit <path>               Navigate to configuration hierarchy")
 		fmt.Println("  up                        Move up one level in hierarchy")
 		fmt.Println("  top                       Move to top level of hierarchy")
@@ -119,16 +150,23 @@ func printInterfaces(ifaces []grpcclient.InterfaceInfo) {
 		fmt.Println("No interfaces found")
 		return
 	}
-	fmt.Printf("%-20s %-8s %-8s %-6s %-18s %-10s %-12s %-12s %-16s %-15s %s\n",
-		"Interface", "Admin", "Oper", "MTU", "MAC", "Speed", "RX-Packets", "TX-Packets", "QoS", "Tables", "Queues")
-	fmt.Println(strings.Repeat("-", 159))
+	fmt.Printf("%-20s %-7s %-8s %-8s %-6s %-18s %-10s %-12s %-12s %-16s %-15s %s\n",
+		"Interface", "Index", "Admin", "Oper", "MTU", "MAC", "Speed", "RX-Packets", "TX-Packets", "QoS", "Tables", "Queues")
+	fmt.Println(strings.Repeat("-", 167))
 	for _, iface := range ifaces {
-		fmt.Printf("%-20s %-8s %-8s %-6d %-18s %-10d %-12d %-12d %-16s %-15s %s\n",
-			iface.Name, iface.AdminStatus, iface.OperStatus,
+		fmt.Printf("%-20s %-7s %-8s %-8s %-6d %-18s %-10d %-12d %-12d %-16s %-15s %s\n",
+			iface.Name, interfaceIfIndex(iface), iface.AdminStatus, iface.OperStatus,
 			iface.MTU, iface.MAC, iface.Speed, iface.RxPackets, iface.TxPackets, interfaceQoSProfile(iface), interfaceTableSummary(iface), interfaceQueueSummary(iface))
 	}
 }
 
+func interfaceIfIndex(iface grpcclient.InterfaceInfo) string {
+	if iface.IfIndex == 0 {
+		return "-"
+	}
+	return strconv.FormatUint(uint64(iface.IfIndex), 10)
+}
+
 func interfaceQoSProfile(iface grpcclient.InterfaceInfo) string {
 	if iface.QoSProfile == "" {
 		return "-"
@@ -268,6 +306,32 @@ func printRoutes(routes []grpcclient.RouteInfo) {
 	}
 }
 
+func printCommitHistory(entries []grpcclient.CommitInfo) {
+	if len(entries) == 0 {
+		fmt.Println("No commit history")
+		return
+	}
+	fmt.Printf("%-8s  %-19s  %-16s  %-8s  %s\n", "Commit", "Timestamp", "User", "Source", "Message")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, entry := range entries {
+		message := entry.Message
+		if entry.IsRollback {
+			message += " (rollback)"
+		}
+		timestamp := "-"
+		if !entry.Timestamp.IsZero() {
+			timestamp = entry.Timestamp.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%-8s  %-19s  %-16s  %-8s  %s\n",
+			shortCommitID(entry.CommitID),
+			timestamp,
+			formatRouteValue(entry.User),
+			formatRouteValue(entry.Source),
+			strings.TrimSpace(message),
+		)
+	}
+}
+
 func formatRouteValue(value string) string {
 	if value == "" {
 		return "-"
@@ -316,6 +380,28 @@ func printOSPFNeighbors(neighbors []grpcclient.OSPFNeighborInfo) {
 	}
 }
 
+func printNeighbors(neighbors []grpcclient.NeighborInfo) {
+	if len(neighbors) == 0 {
+		fmt.Println("No neighbor entries found")
+		return
+	}
+	fmt.Printf("%-39s %-17s %-24s %-8s %-10s\n", "Address", "MAC", "Interface", "Type", "Age")
+	fmt.Println(strings.Repeat("-", 102))
+	for _, neighbor := range neighbors {
+		kind := "dynamic"
+		if neighbor.Static {
+			kind = "static"
+		}
+		fmt.Printf("%-39s %-17s %-24s %-8s %-10s\n",
+			neighbor.Address,
+			formatBGPValue(neighbor.MAC),
+			formatBGPValue(neighbor.Interface),
+			kind,
+			formatBGPUptime(neighbor.AgeSecs),
+		)
+	}
+}
+
 func formatBGPValue(value string) string {
 	if value == "" {
 		return "-"
@@ -345,6 +431,35 @@ func formatBGPUptime(seconds uint64) string {
 	return fmt.Sprintf("%ds", seconds)
 }
 
+func printSystemInformation(info *grpcclient.SystemHealthInfo) {
+	if info == nil {
+		fmt.Println("No system information found")
+		return
+	}
+	if info.Hostname != "" {
+		fmt.Printf("%-18s %s\n", "Hostname", info.Hostname)
+	}
+	fmt.Printf("%-18s %s\n", "Version", info.Version)
+	fmt.Printf("%-18s %s\n", "Commit", info.Commit)
+	fmt.Printf("%-18s %s\n", "Build date", info.BuildDate)
+	fmt.Printf("%-18s %s\n", "Uptime", formatBGPUptime(info.UptimeSecs))
+	fmt.Println("Subsystems")
+	for _, subsystem := range info.Subsystems {
+		line := fmt.Sprintf("  %-16s %s", subsystem.Name, subsystem.Status)
+		if subsystem.Detail != "" {
+			line += " (" + subsystem.Detail + ")"
+		}
+		fmt.Println(line)
+	}
+	if nc := info.NETCONF; nc != nil {
+		fmt.Println("NETCONF")
+		fmt.Printf("  %-16s %s\n", "Listening", yesNo(nc.Listening))
+		fmt.Printf("  %-16s %d\n", "Sessions", nc.ActiveSessions)
+		fmt.Printf("  %-16s %d active, %d total\n", "Connections", nc.ActiveConnections, nc.TotalConnections)
+		fmt.Printf("  %-16s %d ok, %d failed\n", "Handshakes", nc.SuccessfulHandshakes, nc.FailedHandshakes)
+	}
+}
+
 func printLCPReconciliation(info *grpcclient.LCPReconciliationInfo) {
 	if info == nil {
 		fmt.Println("No LCP reconciliation status found")
@@ -544,6 +659,61 @@ type evpnTelemetryCounts struct {
 	multicast int
 }
 
+// showSecurityUsers lists the users in the running configuration with their
+// role and whether the account is enabled.
+func showSecurityUsers(ctx context.Context, client showClient) error {
+	text, err := runningConfigurationBackupText(ctx, client)
+	if err != nil {
+		return err
+	}
+	cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		return fmt.Errorf("parse running config: %w", err)
+	}
+	var users map[string]*pkgconfig.UserConfig
+	if cfg.Security != nil {
+		users = cfg.Security.Users
+	}
+	printSecurityUsers(users)
+	return nil
+}
+
+func printSecurityUsers(users map[string]*pkgconfig.UserConfig) {
+	names := make([]string, 0, len(users))
+	for name, user := range users {
+		if user != nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		fmt.Println("No security users configured")
+		return
+	}
+	sort.Strings(names)
+
+	fmt.Printf("%-24s %-10s %-9s %s\n", "User", "Role", "Status", "Authentication")
+	fmt.Println(strings.Repeat("-", 62))
+	for _, name := range names {
+		user := users[name]
+		role := user.Role
+		if role == "" {
+			role = "read-only"
+		}
+		status := "enabled"
+		if user.Disabled {
+			status = "disabled"
+		}
+		var methods []string
+		if user.Password != "" {
+			methods = append(methods, "password")
+		}
+		if user.SSHKey != "" {
+			methods = append(methods, "ssh-key")
+		}
+		fmt.Printf("%-24s %-10s %-9s %s\n", name, role, status, formatRouteValue(strings.Join(methods, ",")))
+	}
+}
+
 func showEVPN(ctx context.Context, client showClient) error {
 	snapshot, err := fetchEVPNTelemetrySnapshot(ctx, client)
 	if err != nil {
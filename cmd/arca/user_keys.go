@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	grpcclient "github.com/akam1o/arca-router/internal/northbound/grpc"
+)
+
+// userSSHKeyClient manages the SSH public keys stored in the daemon's NETCONF
+// user database.
+type userSSHKeyClient interface {
+	ListUserSSHKeys(context.Context, string) ([]grpcclient.UserSSHKey, error)
+	SetUserSSHKeyStatus(context.Context, string, string, bool) error
+	RemoveUserSSHKey(context.Context, string, string) error
+}
+
+const (
+	showSecurityUsage    = "usage: show security users [user <name> ssh-keys]"
+	requestSecurityUsage = "usage: request security users user <name> ssh-key (disable|enable|delete) <fingerprint>"
+)
+
+// showSecurityRequest is a parsed "show security ..." command.
+type showSecurityRequest struct {
+	username string // set when listing one user's SSH keys
+}
+
+func parseShowSecurityArgs(args []string) (showSecurityRequest, error) {
+	switch {
+	case len(args) == 1 && args[0] == "users":
+		return showSecurityRequest{}, nil
+	case len(args) == 4 && args[0] == "users" && args[1] == "user" && args[3] == "ssh-keys":
+		return showSecurityRequest{username: args[2]}, nil
+	default:
+		return showSecurityRequest{}, errors.New(showSecurityUsage)
+	}
+}
+
+func showSecurity(ctx context.Context, client showClient, req showSecurityRequest) error {
+	if req.username == "" {
+		return showSecurityUsers(ctx, client)
+	}
+	keyClient, ok := client.(userSSHKeyClient)
+	if !ok {
+		return fmt.Errorf("SSH key management is not supported by this client")
+	}
+	keys, err := keyClient.ListUserSSHKeys(ctx, req.username)
+	if err != nil {
+		return err
+	}
+	printUserSSHKeys(req.username, keys)
+	return nil
+}
+
+func printUserSSHKeys(username string, keys []grpcclient.UserSSHKey) {
+	if len(keys) == 0 {
+		fmt.Printf("No SSH keys registered for user %s\n", username)
+		return
+	}
+	fmt.Printf("%-12s %-52s %-9s %-19s %s\n", "Algorithm", "Fingerprint", "Status", "Added", "Comment")
+	fmt.Println(strings.Repeat("-", 110))
+	for _, key := range keys {
+		status := "enabled"
+		if !key.Enabled {
+			status = "disabled"
+		}
+		added := "-"
+		if !key.CreatedAt.IsZero() {
+			added = key.CreatedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%-12s %-52s %-9s %-19s %s\n",
+			key.Algorithm, key.Fingerprint, status, added, formatRouteValue(key.Comment))
+	}
+}
+
+// userSSHKeyAction is a parsed "request security users user <u> ssh-key ..."
+// command.
+type userSSHKeyAction struct {
+	username    string
+	action      string
+	fingerprint string
+}
+
+func parseRequestSecurityArgs(args []string) (userSSHKeyAction, error) {
+	if len(args) != 7 || args[0] != "security" || args[1] != "users" || args[2] != "user" || args[4] != "ssh-key" {
+		return userSSHKeyAction{}, errors.New(requestSecurityUsage)
+	}
+	switch args[5] {
+	case "disable", "enable", "delete":
+	default:
+		return userSSHKeyAction{}, errors.New(requestSecurityUsage)
+	}
+	return userSSHKeyAction{username: args[3], action: args[5], fingerprint: args[6]}, nil
+}
+
+func runUserSSHKeyAction(ctx context.Context, client showClient, req userSSHKeyAction) error {
+	keyClient, ok := client.(userSSHKeyClient)
+	if !ok {
+		return fmt.Errorf("SSH key management is not supported by this client")
+	}
+	switch req.action {
+	case "delete":
+		if err := keyClient.RemoveUserSSHKey(ctx, req.username, req.fingerprint); err != nil {
+			return err
+		}
+		fmt.Printf("SSH key %s deleted for user %s\n", req.fingerprint, req.username)
+	default:
+		enabled := req.action == "enable"
+		if err := keyClient.SetUserSSHKeyStatus(ctx, req.username, req.fingerprint, enabled); err != nil {
+			return err
+		}
+		fmt.Printf("SSH key %s %sd for user %s\n", req.fingerprint, req.action, req.username)
+	}
+	return nil
+}
@@ -45,17 +45,20 @@ func NewAuthorizer() *Authorizer {
 
 // operationPermissions maps operations to the minimum required role.
 var operationPermissions = map[string][]string{
-	"get-config":      {RoleReadOnly, RoleOperator, RoleAdmin},
-	"get":             {RoleReadOnly, RoleOperator, RoleAdmin},
-	"edit-config":     {RoleOperator, RoleAdmin},
-	"lock":            {RoleOperator, RoleAdmin},
-	"unlock":          {RoleOperator, RoleAdmin},
-	"commit":          {RoleOperator, RoleAdmin},
-	"discard-changes": {RoleOperator, RoleAdmin},
-	"validate":        {RoleOperator, RoleAdmin},
-	"copy-config":     {RoleOperator, RoleAdmin},
-	"close-session":   {RoleOperator, RoleAdmin},
-	"kill-session":    {RoleAdmin},
+	"get-config":       {RoleReadOnly, RoleOperator, RoleAdmin},
+	"get":              {RoleReadOnly, RoleOperator, RoleAdmin},
+	"edit-config":      {RoleOperator, RoleAdmin},
+	"lock":             {RoleOperator, RoleAdmin},
+	"unlock":           {RoleOperator, RoleAdmin},
+	"commit":           {RoleOperator, RoleAdmin},
+	"discard-changes":  {RoleOperator, RoleAdmin},
+	"validate":         {RoleOperator, RoleAdmin},
+	"copy-config":      {RoleOperator, RoleAdmin},
+	"close-session":    {RoleOperator, RoleAdmin},
+	"clear-statistics": {RoleOperator, RoleAdmin},
+	"kill-session":     {RoleAdmin},
+	"manage-users":     {RoleAdmin},
+	"manage-datastore": {RoleAdmin},
 }
 
 // IsPermitted checks if a role is allowed to perform an operation.
@@ -4,7 +4,7 @@ package compat
 
 const (
 	PolicyPhase               = "v0.10.x stabilization and compatibility"
-	CurrentSQLiteSchema       = 2
+	CurrentSQLiteSchema       = 4
 	TelemetryEventSchema      = "arca.telemetry.v1"
 	NMSOperationalSchema      = "arca.nms.operational.v1"
 	NMSTelemetryCatalogSchema = "arca.nms.telemetry-catalog.v1"
@@ -64,8 +64,8 @@ func ComponentMatrix() []ComponentSupport {
 		},
 		{
 			Component: "SQLite datastore",
-			Supported: "schema 1-2",
-			Required:  "current schema 2",
+			Supported: "schema 1-4",
+			Required:  "current schema 4",
 			Notes:     "newer schemas are rejected so older binaries do not silently open a future datastore",
 		},
 		{
@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"fmt"
+	"maps"
 	"reflect"
 	"sort"
 
@@ -38,6 +40,9 @@ type ConfigDiff struct {
 	MPLSChanged  bool
 	OldMPLS      *model.MPLSConfig
 	NewMPLS      *model.MPLSConfig
+	RIPChanged   bool
+	OldRIP       *model.RIPConfig
+	NewRIP       *model.RIPConfig
 	VRRPChanged  bool
 	OldVRRP      *model.VRRPConfig
 	NewVRRP      *model.VRRPConfig
@@ -65,6 +70,9 @@ type ConfigDiff struct {
 	ClassOfServiceChanged bool
 	OldClassOfService     *model.ClassOfServiceConfig
 	NewClassOfService     *model.ClassOfServiceConfig
+	FirewallChanged       bool
+	OldFirewall           *model.FirewallConfig
+	NewFirewall           *model.FirewallConfig
 
 	// System changes
 	SystemChanged bool
@@ -75,6 +83,22 @@ type ConfigDiff struct {
 	SecurityChanged bool
 	OldSecurity     *model.SecurityConfig
 	NewSecurity     *model.SecurityConfig
+
+	// InactiveChanged reports that the set of deactivated paths changed
+	InactiveChanged bool
+}
+
+// InterfaceMTU is the configured link MTU and per-family IP MTUs of an
+// interface. A zero value means the dataplane default applies.
+type InterfaceMTU struct {
+	Link  int
+	Inet  int
+	Inet6 int
+}
+
+// IsZero reports whether no MTU is configured.
+func (m InterfaceMTU) IsZero() bool {
+	return m == InterfaceMTU{}
 }
 
 // InterfaceChange describes what changed on a specific interface.
@@ -83,8 +107,33 @@ type InterfaceChange struct {
 	DescriptionChanged bool
 	OldDescription     string
 	NewDescription     string
+	PromiscuousChanged bool
+	NewPromiscuous     bool
+	RxModeChanged      bool
+	OldRxMode          string
+	NewRxMode          string
+	MTUChanged         bool
+	OldMTU             InterfaceMTU
+	NewMTU             InterfaceMTU
+	PolicerChanged     bool
+	OldInputPolicer    string
+	NewInputPolicer    string
+	OldOutputPolicer   string
+	NewOutputPolicer   string
+	TunnelChanged      bool
+	OldTunnel          model.TunnelConfig
+	NewTunnel          model.TunnelConfig
+	HoldTimeChanged    bool
+	BandwidthChanged   bool
+	NewBandwidth       uint64
+	QueuesChanged      bool
+	RxPlacementChanged bool
+	OldRxPlacement     map[int]int
+	NewRxPlacement     map[int]int
 	AddressesAdded     []UnitAddress
 	AddressesRemoved   []UnitAddress
+	NeighborsChanged   bool
+	RAChanged          bool
 }
 
 // UnitAddress identifies an address on a specific unit/family.
@@ -105,6 +154,7 @@ func (d *ConfigDiff) HasChanges() bool {
 		d.OSPFChanged ||
 		d.OSPF3Changed ||
 		d.MPLSChanged ||
+		d.RIPChanged ||
 		d.VRRPChanged ||
 		d.StaticRoutesChanged ||
 		d.RoutingChanged ||
@@ -112,8 +162,10 @@ func (d *ConfigDiff) HasChanges() bool {
 		d.PolicyChanged ||
 		d.ChassisChanged ||
 		d.ClassOfServiceChanged ||
+		d.FirewallChanged ||
 		d.SystemChanged ||
-		d.SecurityChanged
+		d.SecurityChanged ||
+		d.InactiveChanged
 }
 
 // Clone returns an independent diff with cloned old and new configuration trees.
@@ -147,6 +199,7 @@ func ComputeDiff(old, new *model.RouterConfig) *ConfigDiff {
 	computeAdvancedDiff(old, new, diff)
 	computeSystemDiff(old, new, diff)
 	computeSecurityDiff(old, new, diff)
+	diff.InactiveChanged = !stringSetEqual(old.Inactive, new.Inactive)
 
 	return diff
 }
@@ -186,6 +239,75 @@ func diffInterface(name string, old, new *model.InterfaceConfig) *InterfaceChang
 		hasChange = true
 	}
 
+	if newPromiscuous := interfacePromiscuous(new); newPromiscuous != interfacePromiscuous(old) {
+		change.PromiscuousChanged = true
+		change.NewPromiscuous = newPromiscuous
+		hasChange = true
+	}
+
+	oldRxMode := interfaceRxMode(old)
+	newRxMode := interfaceRxMode(new)
+	if oldRxMode != newRxMode {
+		change.RxModeChanged = true
+		change.OldRxMode = oldRxMode
+		change.NewRxMode = newRxMode
+		hasChange = true
+	}
+
+	oldMTU := ConfiguredInterfaceMTU(old)
+	newMTU := ConfiguredInterfaceMTU(new)
+	if oldMTU != newMTU {
+		change.MTUChanged = true
+		change.OldMTU = oldMTU
+		change.NewMTU = newMTU
+		hasChange = true
+	}
+
+	oldInput, oldOutput := interfacePolicers(old)
+	newInput, newOutput := interfacePolicers(new)
+	if oldInput != newInput || oldOutput != newOutput {
+		change.PolicerChanged = true
+		change.OldInputPolicer = oldInput
+		change.NewInputPolicer = newInput
+		change.OldOutputPolicer = oldOutput
+		change.NewOutputPolicer = newOutput
+		hasChange = true
+	}
+
+	oldTunnel := interfaceTunnel(old)
+	newTunnel := interfaceTunnel(new)
+	if oldTunnel != newTunnel {
+		change.TunnelChanged = true
+		change.OldTunnel = oldTunnel
+		change.NewTunnel = newTunnel
+		hasChange = true
+	}
+
+	if interfaceHoldTime(new) != interfaceHoldTime(old) {
+		change.HoldTimeChanged = true
+		hasChange = true
+	}
+
+	if newBandwidth := interfaceBandwidth(new); newBandwidth != interfaceBandwidth(old) {
+		change.BandwidthChanged = true
+		change.NewBandwidth = newBandwidth
+		hasChange = true
+	}
+
+	if interfaceQueues(old) != interfaceQueues(new) {
+		change.QueuesChanged = true
+		hasChange = true
+	}
+
+	oldPlacement := interfaceRxPlacement(old)
+	newPlacement := interfaceRxPlacement(new)
+	if !maps.Equal(oldPlacement, newPlacement) {
+		change.RxPlacementChanged = true
+		change.OldRxPlacement = oldPlacement
+		change.NewRxPlacement = newPlacement
+		hasChange = true
+	}
+
 	// Compute address changes
 	oldAddrs := collectAddresses(old)
 	newAddrs := collectAddresses(new)
@@ -203,6 +325,16 @@ func diffInterface(name string, old, new *model.InterfaceConfig) *InterfaceChang
 		}
 	}
 
+	if !reflect.DeepEqual(collectNeighbors(old), collectNeighbors(new)) {
+		change.NeighborsChanged = true
+		hasChange = true
+	}
+
+	if !reflect.DeepEqual(ConfiguredRouterAdvertisement(old), ConfiguredRouterAdvertisement(new)) {
+		change.RAChanged = true
+		hasChange = true
+	}
+
 	if !hasChange {
 		return nil
 	}
@@ -216,6 +348,99 @@ func interfaceDescription(iface *model.InterfaceConfig) string {
 	return iface.Description
 }
 
+func interfacePromiscuous(iface *model.InterfaceConfig) bool {
+	return iface != nil && iface.Promiscuous
+}
+
+func interfaceTunnel(iface *model.InterfaceConfig) model.TunnelConfig {
+	if iface == nil || iface.Tunnel == nil {
+		return model.TunnelConfig{}
+	}
+	return *iface.Tunnel
+}
+
+func interfaceHoldTime(iface *model.InterfaceConfig) model.HoldTime {
+	if iface == nil || iface.HoldTime == nil {
+		return model.HoldTime{}
+	}
+	return *iface.HoldTime
+}
+
+func interfaceBandwidth(iface *model.InterfaceConfig) uint64 {
+	if iface == nil {
+		return 0
+	}
+	return iface.Bandwidth
+}
+
+// interfaceQueues returns the configured RX and TX queue counts.
+func interfaceQueues(iface *model.InterfaceConfig) [2]int {
+	if iface == nil || iface.GigEtherOptions == nil {
+		return [2]int{}
+	}
+	return [2]int{iface.GigEtherOptions.RxQueues, iface.GigEtherOptions.TxQueues}
+}
+
+func interfaceRxPlacement(iface *model.InterfaceConfig) map[int]int {
+	if iface == nil || iface.GigEtherOptions == nil {
+		return nil
+	}
+	return iface.GigEtherOptions.RxPlacement
+}
+
+func interfaceRxMode(iface *model.InterfaceConfig) string {
+	if iface == nil {
+		return ""
+	}
+	return iface.RxMode
+}
+
+// ConfiguredInterfaceMTU returns the MTUs configured on an interface. Validation keeps
+// a family MTU identical across units, so the first one found is used.
+func ConfiguredInterfaceMTU(iface *model.InterfaceConfig) InterfaceMTU {
+	var mtu InterfaceMTU
+	if iface == nil {
+		return mtu
+	}
+	mtu.Link = iface.MTU
+	for _, unit := range iface.Units {
+		if unit == nil {
+			continue
+		}
+		if family := unit.Family["inet"]; family != nil && family.MTU != 0 {
+			mtu.Inet = family.MTU
+		}
+		if family := unit.Family["inet6"]; family != nil && family.MTU != 0 {
+			mtu.Inet6 = family.MTU
+		}
+	}
+	return mtu
+}
+
+// ConfiguredRouterAdvertisement returns the router advertisement configured on
+// an interface. Validation allows it on a single inet6 unit.
+func ConfiguredRouterAdvertisement(iface *model.InterfaceConfig) *model.RouterAdvertisement {
+	if iface == nil {
+		return nil
+	}
+	for _, unit := range iface.Units {
+		if unit == nil {
+			continue
+		}
+		if family := unit.Family["inet6"]; family != nil && family.RouterAdvertisement != nil {
+			return family.RouterAdvertisement
+		}
+	}
+	return nil
+}
+
+func interfacePolicers(iface *model.InterfaceConfig) (input, output string) {
+	if iface == nil {
+		return "", ""
+	}
+	return iface.InputPolicer, iface.OutputPolicer
+}
+
 func collectAddresses(ic *model.InterfaceConfig) []UnitAddress {
 	var result []UnitAddress
 	if ic == nil {
@@ -241,6 +466,29 @@ func collectAddresses(ic *model.InterfaceConfig) []UnitAddress {
 	return result
 }
 
+// collectNeighbors returns the static neighbors of an interface keyed by
+// "unit/family/ip" with the MAC address as value.
+func collectNeighbors(ic *model.InterfaceConfig) map[string]string {
+	result := make(map[string]string)
+	if ic == nil {
+		return result
+	}
+	for unitNum, unit := range ic.Units {
+		if unit == nil {
+			continue
+		}
+		for familyName, family := range unit.Family {
+			if family == nil {
+				continue
+			}
+			for ip, mac := range family.Neighbors {
+				result[fmt.Sprintf("%d/%s/%s", unitNum, familyName, ip)] = mac
+			}
+		}
+	}
+	return result
+}
+
 func containsAddress(addrs []UnitAddress, target UnitAddress) bool {
 	for _, a := range addrs {
 		if a.UnitNum == target.UnitNum && a.Family == target.Family && a.Address == target.Address {
@@ -299,6 +547,14 @@ func computeProtocolDiff(old, new *model.RouterConfig, diff *ConfigDiff) {
 		diff.NewMPLS = newMPLS
 	}
 
+	oldRIP := getRIP(old)
+	newRIP := getRIP(new)
+	if !reflect.DeepEqual(oldRIP, newRIP) {
+		diff.RIPChanged = true
+		diff.OldRIP = oldRIP
+		diff.NewRIP = newRIP
+	}
+
 	oldVRRP := getVRRP(old)
 	newVRRP := getVRRP(new)
 	if !reflect.DeepEqual(oldVRRP, newVRRP) {
@@ -347,6 +603,11 @@ func computeAdvancedDiff(old, new *model.RouterConfig, diff *ConfigDiff) {
 		diff.OldClassOfService = old.ClassOfService
 		diff.NewClassOfService = new.ClassOfService
 	}
+	if !reflect.DeepEqual(old.Firewall, new.Firewall) {
+		diff.FirewallChanged = true
+		diff.OldFirewall = old.Firewall
+		diff.NewFirewall = new.Firewall
+	}
 }
 
 func computeSystemDiff(old, new *model.RouterConfig, diff *ConfigDiff) {
@@ -403,6 +664,13 @@ func getOSPF3(c *model.RouterConfig) *model.OSPFConfig {
 	return c.Protocols.OSPF3
 }
 
+func getRIP(c *model.RouterConfig) *model.RIPConfig {
+	if c.Protocols == nil {
+		return nil
+	}
+	return c.Protocols.RIP
+}
+
 func getMPLS(c *model.RouterConfig) *model.MPLSConfig {
 	if c.Protocols == nil {
 		return nil
@@ -439,7 +707,8 @@ func bgpEqual(a, b *model.BGPConfig) bool {
 		if !ok {
 			return false
 		}
-		if ag.Type != bg.Type || ag.Import != bg.Import || ag.Export != bg.Export {
+		if ag.Type != bg.Type || ag.Import != bg.Import || ag.Export != bg.Export || ag.Cluster != bg.Cluster ||
+			ag.NextHopSelf != bg.NextHopSelf || ag.RemovePrivateAS != bg.RemovePrivateAS {
 			return false
 		}
 		if len(ag.Neighbors) != len(bg.Neighbors) {
@@ -451,7 +720,9 @@ func bgpEqual(a, b *model.BGPConfig) bool {
 				return false
 			}
 			if an.PeerAS != bn.PeerAS || an.Description != bn.Description || an.LocalAddress != bn.LocalAddress ||
-				an.BFD != bn.BFD || an.BFDProfile != bn.BFDProfile {
+				an.UpdateSource != bn.UpdateSource || an.BFD != bn.BFD || an.BFDProfile != bn.BFDProfile ||
+				an.Cluster != bn.Cluster || an.RouteReflectorClient != bn.RouteReflectorClient ||
+				an.Shutdown != bn.Shutdown || an.NextHopSelf != bn.NextHopSelf || an.RemovePrivateAS != bn.RemovePrivateAS {
 				return false
 			}
 		}
@@ -507,7 +778,8 @@ func routingEqual(a, b *model.RoutingConfig) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return a.AutonomousSystem == b.AutonomousSystem && a.RouterID == b.RouterID
+	return a.AutonomousSystem == b.AutonomousSystem && a.RouterID == b.RouterID &&
+		reflect.DeepEqual(a.AdministrativeDistance, b.AdministrativeDistance)
 }
 
 func staticRoutesEqual(a, b []*model.StaticRoute) bool {
@@ -535,3 +807,14 @@ func systemEqual(a, b *model.SystemConfig) bool {
 func securityEqual(a, b *model.SecurityConfig) bool {
 	return reflect.DeepEqual(a, b)
 }
+
+func stringSetEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	as := append([]string(nil), a...)
+	bs := append([]string(nil), b...)
+	sort.Strings(as)
+	sort.Strings(bs)
+	return reflect.DeepEqual(as, bs)
+}
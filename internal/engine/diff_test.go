@@ -93,13 +93,14 @@ func TestComputeDiffDetectsV06AdvancedChanges(t *testing.T) {
 }
 
 func TestComputeDiffDetectsOSPF3Changes(t *testing.T) {
+	metric := 20
 	newCfg := model.NewRouterConfig()
 	newCfg.Protocols = &model.ProtocolsConfig{
 		OSPF3: &model.OSPFConfig{
 			Areas: map[string]*model.OSPFArea{
 				"0.0.0.0": {
 					Interfaces: map[string]*model.OSPFInterface{
-						"ge-0/0/0": {Metric: 20},
+						"ge-0/0/0": {Metric: &metric},
 					},
 				},
 			},
@@ -174,12 +175,13 @@ func TestComputeDiffDetectsBGPBFDBindingChanges(t *testing.T) {
 }
 
 func TestComputeDiffDetectsOSPFBFDBindingChanges(t *testing.T) {
+	metric := 10
 	oldCfg := model.NewRouterConfig()
 	oldCfg.Protocols = &model.ProtocolsConfig{
 		OSPF: &model.OSPFConfig{Areas: map[string]*model.OSPFArea{
 			"0.0.0.0": {
 				Interfaces: map[string]*model.OSPFInterface{
-					"ge-0/0/0": {Metric: 10},
+					"ge-0/0/0": {Metric: &metric},
 				},
 			},
 		}},
@@ -11,12 +11,21 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/akam1o/arca-router/internal/model"
 )
 
 var ErrConfigValidation = errors.New("configuration validation error")
 
+// ErrApplyTimeout is wrapped by the error of an apply whose southbound
+// plugins did not finish within the apply timeout.
+var ErrApplyTimeout = errors.New("configuration apply timed out")
+
+// DefaultApplyTimeout bounds how long the southbound plugins may take to
+// validate and apply one configuration change.
+const DefaultApplyTimeout = 60 * time.Second
+
 type configValidationError struct {
 	cause error
 }
@@ -39,6 +48,36 @@ type Engine struct {
 	plugins []Plugin
 	log     *slog.Logger
 	version uint64
+
+	applyTimeout  time.Duration
+	lastApply     ApplyResult
+	applyObserver func(ApplyResult)
+}
+
+// ApplyResult records the outcome of the most recent apply that reached the
+// southbound plugins.
+type ApplyResult struct {
+	Time    time.Time
+	Version uint64
+	Err     error
+	Plugins []PluginApplyResult // per-plugin outcome, in apply order
+}
+
+// Plugin outcomes recorded in PluginApplyResult.Status.
+const (
+	PluginApplied        = "applied"         // changes programmed and kept
+	PluginFailed         = "failed"          // validation or apply returned an error
+	PluginRolledBack     = "rolled-back"     // changes programmed, then undone after another plugin failed
+	PluginRollbackFailed = "rollback-failed" // undoing the changes failed; the plugin may be partially programmed
+	PluginNotApplied     = "not-applied"     // never reached because an earlier plugin failed
+)
+
+// PluginApplyResult is the outcome of an apply for one southbound plugin.
+type PluginApplyResult struct {
+	Plugin     string
+	Status     string
+	Error      string
+	Operations []OperationResult // steps reported by the plugin, in order
 }
 
 // ApplyError describes a failed configuration apply phase with rollback status.
@@ -87,8 +126,65 @@ func (e *ApplyError) Unwrap() error {
 // NewEngine creates a new Engine with the given plugins and logger.
 func NewEngine(plugins []Plugin, log *slog.Logger) *Engine {
 	return &Engine{
-		plugins: plugins,
-		log:     log,
+		plugins:      plugins,
+		log:          log,
+		applyTimeout: DefaultApplyTimeout,
+	}
+}
+
+// SetApplyTimeout sets how long the southbound plugins may take to validate
+// and apply one configuration change. An apply that runs past it is aborted
+// and rolled back. Zero or a negative value disables the timeout.
+func (e *Engine) SetApplyTimeout(timeout time.Duration) {
+	e.applyMu.Lock()
+	defer e.applyMu.Unlock()
+	e.applyTimeout = timeout
+}
+
+// LastApply returns the outcome of the most recent apply that programmed the
+// southbound plugins. Its Time is zero until the first such apply.
+func (e *Engine) LastApply() ApplyResult {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.lastApply
+}
+
+// SetApplyObserver registers fn to be called with every recorded apply
+// result, for example to persist it. fn runs synchronously at the end of
+// Apply and must not call back into Apply.
+func (e *Engine) SetApplyObserver(fn func(ApplyResult)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.applyObserver = fn
+}
+
+// RestoreLastApply sets the result reported by LastApply until the next
+// apply reaches the plugins. It is used at startup to surface the result
+// persisted by a previous run and does nothing once an apply was recorded.
+func (e *Engine) RestoreLastApply(result ApplyResult) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.lastApply.Time.IsZero() {
+		return
+	}
+	result.Plugins = append([]PluginApplyResult(nil), result.Plugins...)
+	e.lastApply = result
+}
+
+func (e *Engine) recordApply(err error, plugins []PluginApplyResult) {
+	e.mu.Lock()
+	result := ApplyResult{
+		Time:    time.Now(),
+		Version: e.version,
+		Err:     err,
+		Plugins: append([]PluginApplyResult(nil), plugins...),
+	}
+	e.lastApply = result
+	observer := e.applyObserver
+	e.mu.Unlock()
+
+	if observer != nil {
+		observer(result)
 	}
 }
 
@@ -105,6 +201,18 @@ func (e *Engine) Running() *model.RouterConfig {
 	return model.NewRouterConfig()
 }
 
+// ActiveRunning returns a copy of the running configuration with deactivated
+// subtrees removed, which is what the southbound plugins have programmed.
+func (e *Engine) ActiveRunning() *model.RouterConfig {
+	cfg := e.Running()
+	active, err := cfg.ActiveConfig()
+	if err != nil {
+		e.log.Warn("Failed to compute active running configuration", slog.Any("error", err))
+		return cfg
+	}
+	return active
+}
+
 // RunningSnapshot returns the current running snapshot (version, hash, etc.).
 func (e *Engine) RunningSnapshot() *model.ConfigSnapshot {
 	e.mu.RLock()
@@ -133,7 +241,10 @@ func (e *Engine) Validate(ctx context.Context, candidate *model.RouterConfig) er
 	plugins := append([]Plugin(nil), e.plugins...)
 	e.mu.RUnlock()
 
-	diff := ComputeDiff(oldCfg, candidate.Clone())
+	diff, err := computeActiveDiff(oldCfg, candidate.Clone())
+	if err != nil {
+		return configValidationError{cause: err}
+	}
 	for _, p := range plugins {
 		if err := p.ValidateChanges(ctx, diff.Clone()); err != nil {
 			return fmt.Errorf("plugin %s validation failed: %w", p.Name(), err)
@@ -145,7 +256,7 @@ func (e *Engine) Validate(ctx context.Context, candidate *model.RouterConfig) er
 // Apply validates and atomically applies a new configuration.
 // It computes the diff from the current running config, validates through all
 // plugins, and applies changes transactionally (rollback on failure).
-func (e *Engine) Apply(ctx context.Context, candidate *model.RouterConfig, author, message string) error {
+func (e *Engine) Apply(ctx context.Context, candidate *model.RouterConfig, author, message string) (err error) {
 	if candidate == nil {
 		return fmt.Errorf("configuration is nil")
 	}
@@ -168,10 +279,20 @@ func (e *Engine) Apply(ctx context.Context, candidate *model.RouterConfig, autho
 	plugins := append([]Plugin(nil), e.plugins...)
 	e.mu.RUnlock()
 
-	diff := ComputeDiff(oldCfg, candidate.Clone())
+	if !ComputeDiff(oldCfg, candidate.Clone()).HasChanges() {
+		e.log.Info("No configuration changes detected")
+		return nil
+	}
 
+	// Plugins only see the active configuration; deactivated statements are
+	// kept in running but never programmed.
+	diff, err := computeActiveDiff(oldCfg, candidate.Clone())
+	if err != nil {
+		return configValidationError{cause: err}
+	}
 	if !diff.HasChanges() {
-		e.log.Info("No configuration changes detected")
+		e.log.Info("Only inactive configuration changed, skipping plugin apply")
+		e.setRunning(candidate, author, message)
 		return nil
 	}
 
@@ -182,13 +303,31 @@ func (e *Engine) Apply(ctx context.Context, candidate *model.RouterConfig, autho
 		slog.Bool("bgp_changed", diff.BGPChanged),
 		slog.Bool("ospf_changed", diff.OSPFChanged),
 		slog.Bool("ospf3_changed", diff.OSPF3Changed),
+		slog.Bool("rip_changed", diff.RIPChanged),
 		slog.Bool("policy_changed", diff.PolicyChanged),
 		slog.Bool("static_routes_changed", diff.StaticRoutesChanged),
 	)
+	results := make([]PluginApplyResult, len(plugins))
+	for i, p := range plugins {
+		results[i] = PluginApplyResult{Plugin: p.Name(), Status: PluginNotApplied}
+	}
+	defer func() { e.recordApply(err, results) }()
+
+	// The deadline covers the plugin phases only; rollback runs on a context
+	// of its own so that a timed-out apply can still be undone.
+	pluginCtx := ctx
+	if e.applyTimeout > 0 {
+		var cancel context.CancelFunc
+		pluginCtx, cancel = context.WithTimeout(ctx, e.applyTimeout)
+		defer cancel()
+	}
 
 	// Phase 1: Validate across all plugins (dry-run)
-	for _, p := range plugins {
-		if err := p.ValidateChanges(ctx, diff.Clone()); err != nil {
+	for i, p := range plugins {
+		if err := p.ValidateChanges(pluginCtx, diff.Clone()); err != nil {
+			err = e.applyTimeoutError(ctx, pluginCtx, err)
+			results[i].Status = PluginFailed
+			results[i].Error = err.Error()
 			return fmt.Errorf("plugin %s validation failed: %w", p.Name(), err)
 		}
 	}
@@ -196,21 +335,32 @@ func (e *Engine) Apply(ctx context.Context, candidate *model.RouterConfig, autho
 	// Phase 2: Apply with rollback-on-failure
 	tx := &transaction{
 		applied: make([]appliedPlugin, 0, len(plugins)),
+		results: results,
 		log:     e.log,
 	}
 
-	for _, p := range plugins {
+	for i, p := range plugins {
 		applyDiff := diff.Clone()
 		rollbackDiff := diff.Clone()
 		tx.applied = append(tx.applied, appliedPlugin{
 			plugin: p,
 			diff:   rollbackDiff,
+			index:  i,
 		})
-		if err := p.ApplyChanges(ctx, applyDiff); err != nil {
+		results[i].Status = PluginApplied
+		recorder := &operationRecorder{}
+		err := p.ApplyChanges(context.WithValue(pluginCtx, operationRecorderKey{}, recorder), applyDiff)
+		results[i].Operations = recorder.results()
+		if err != nil {
+			err = e.applyTimeoutError(ctx, pluginCtx, err)
+			results[i].Status = PluginFailed
+			results[i].Error = err.Error()
 			e.log.Error("Plugin apply failed, initiating rollback",
 				slog.String("plugin", p.Name()),
 				slog.Any("error", err))
-			rollbackErr := tx.rollback(ctx)
+			rollbackCtx, cancel := e.rollbackContext(ctx)
+			rollbackErr := tx.rollback(rollbackCtx)
+			cancel()
 			diagnostics := rollbackDiagnostics(rollbackErr)
 			return &ApplyError{
 				Plugin:              p.Name(),
@@ -224,6 +374,32 @@ func (e *Engine) Apply(ctx context.Context, candidate *model.RouterConfig, autho
 	}
 
 	// Phase 3: Commit — update running config
+	e.setRunning(candidate, author, message)
+	return nil
+}
+
+// applyTimeoutError wraps a plugin error with ErrApplyTimeout when the apply
+// timeout, rather than the caller, ended pluginCtx.
+func (e *Engine) applyTimeoutError(ctx, pluginCtx context.Context, err error) error {
+	if ctx.Err() != nil || !errors.Is(pluginCtx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	e.log.Error("Plugin apply exceeded the apply timeout",
+		slog.Duration("timeout", e.applyTimeout))
+	return fmt.Errorf("%w after %s: %w", ErrApplyTimeout, e.applyTimeout, err)
+}
+
+// rollbackContext returns the context used to roll back a failed apply. It
+// outlives a cancelled or timed-out apply, bounded by the apply timeout.
+func (e *Engine) rollbackContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx = context.WithoutCancel(ctx)
+	if e.applyTimeout > 0 {
+		return context.WithTimeout(ctx, e.applyTimeout)
+	}
+	return ctx, func() {}
+}
+
+func (e *Engine) setRunning(candidate *model.RouterConfig, author, message string) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.version++
@@ -233,8 +409,20 @@ func (e *Engine) Apply(ctx context.Context, candidate *model.RouterConfig, autho
 		slog.Uint64("version", e.version),
 		slog.String("author", author),
 	)
+}
 
-	return nil
+// computeActiveDiff computes the diff between the active views of two
+// configurations, with deactivated subtrees removed.
+func computeActiveDiff(old, new *model.RouterConfig) (*ConfigDiff, error) {
+	oldActive, err := old.ActiveConfig()
+	if err != nil {
+		return nil, fmt.Errorf("running configuration: %w", err)
+	}
+	newActive, err := new.ActiveConfig()
+	if err != nil {
+		return nil, err
+	}
+	return ComputeDiff(oldActive, newActive), nil
 }
 
 // InitializeRunning sets the initial running configuration without applying a diff.
@@ -251,12 +439,14 @@ func (e *Engine) InitializeRunning(cfg *model.RouterConfig, version uint64) {
 // transaction tracks which plugins have been applied so we can rollback on failure.
 type transaction struct {
 	applied []appliedPlugin
+	results []PluginApplyResult // indexed by appliedPlugin.index
 	log     *slog.Logger
 }
 
 type appliedPlugin struct {
 	plugin Plugin
 	diff   *ConfigDiff
+	index  int
 }
 
 func (t *transaction) rollback(ctx context.Context) error {
@@ -270,11 +460,32 @@ func (t *transaction) rollback(ctx context.Context) error {
 			t.log.Error("Plugin rollback failed (manual intervention may be required)",
 				slog.String("plugin", p.Name()),
 				slog.Any("error", err))
+			t.setResult(applied.index, PluginRollbackFailed, "rollback: "+err.Error())
+			continue
 		}
+		t.setResult(applied.index, PluginRolledBack, "")
 	}
 	return errors.Join(rollbackErrs...)
 }
 
+// setResult records the rollback outcome of a plugin. A plugin whose apply
+// failed keeps its failed status unless its rollback fails as well.
+func (t *transaction) setResult(index int, status, detail string) {
+	if index < 0 || index >= len(t.results) {
+		return
+	}
+	result := &t.results[index]
+	switch {
+	case status == PluginRolledBack && result.Status == PluginFailed:
+		return
+	case result.Error != "" && detail != "":
+		result.Error += "; " + detail
+	case detail != "":
+		result.Error = detail
+	}
+	result.Status = status
+}
+
 func rollbackDiagnostics(err error) []string {
 	if err == nil {
 		return nil
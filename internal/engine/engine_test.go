@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -203,6 +204,103 @@ func TestApplyErrorReportsRollbackSucceeded(t *testing.T) {
 	}
 }
 
+func TestLastApplyRecordsPluginOutcome(t *testing.T) {
+	plugin := &scriptedPlugin{name: "vpp"}
+	eng := NewEngine([]Plugin{plugin}, slog.Default())
+	if got := eng.LastApply(); !got.Time.IsZero() {
+		t.Fatalf("LastApply() before any apply = %#v, want zero", got)
+	}
+
+	candidate := &model.RouterConfig{System: &model.SystemConfig{HostName: "router1"}}
+	if err := eng.Apply(context.Background(), candidate, "alice", "first"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if got := eng.LastApply(); got.Time.IsZero() || got.Err != nil || got.Version != 1 {
+		t.Fatalf("LastApply() after success = %#v, want version 1 without error", got)
+	}
+
+	plugin.applyErr = errors.New("apply boom")
+	candidate = &model.RouterConfig{System: &model.SystemConfig{HostName: "router2"}}
+	if err := eng.Apply(context.Background(), candidate, "alice", "second"); err == nil {
+		t.Fatal("Apply() error = nil, want plugin failure")
+	}
+	if got := eng.LastApply(); got.Err == nil || !strings.Contains(got.Err.Error(), "apply boom") || got.Version != 1 {
+		t.Fatalf("LastApply() after failure = %#v, want apply boom at version 1", got)
+	}
+}
+
+func TestLastApplyRecordsPerPluginStatus(t *testing.T) {
+	first := &scriptedPlugin{name: "first", operations: []string{"create interface ge-0/0/0"}}
+	second := &scriptedPlugin{name: "second", rollbackErr: errors.New("undo failed")}
+	third := &scriptedPlugin{
+		name:       "third",
+		applyErr:   errors.New("apply boom"),
+		operations: []string{"create interface ge-0/0/1", "apply interface ge-0/0/1 addresses"},
+	}
+	fourth := &scriptedPlugin{name: "fourth"}
+	eng := NewEngine([]Plugin{first, second, third, fourth}, slog.Default())
+	var observed []ApplyResult
+	eng.SetApplyObserver(func(result ApplyResult) { observed = append(observed, result) })
+
+	candidate := &model.RouterConfig{System: &model.SystemConfig{HostName: "router1"}}
+	if err := eng.Apply(context.Background(), candidate, "alice", "partial"); err == nil {
+		t.Fatal("Apply() error = nil, want plugin failure")
+	}
+
+	want := []PluginApplyResult{
+		{Plugin: "first", Status: PluginRolledBack, Operations: []OperationResult{
+			{Operation: "create interface ge-0/0/0"},
+		}},
+		{Plugin: "second", Status: PluginRollbackFailed, Error: "rollback: undo failed"},
+		{Plugin: "third", Status: PluginFailed, Error: "apply boom", Operations: []OperationResult{
+			{Operation: "create interface ge-0/0/1"},
+			{Operation: "apply interface ge-0/0/1 addresses", Error: "apply boom"},
+		}},
+		{Plugin: "fourth", Status: PluginNotApplied},
+	}
+	got := eng.LastApply().Plugins
+	if len(got) != len(want) {
+		t.Fatalf("LastApply().Plugins = %#v, want %#v", got, want)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("Plugins[%d] = %#v, want %#v", i, got[i], want[i])
+		}
+	}
+	if len(observed) != 1 || len(observed[0].Plugins) != len(want) || observed[0].Err == nil {
+		t.Fatalf("observer results = %#v, want the failed apply", observed)
+	}
+
+	third.applyErr = nil
+	second.rollbackErr = nil
+	if err := eng.Apply(context.Background(), candidate, "alice", "retry"); err != nil {
+		t.Fatalf("Apply() retry error = %v", err)
+	}
+	for _, result := range eng.LastApply().Plugins {
+		if result.Status != PluginApplied || result.Error != "" {
+			t.Fatalf("LastApply().Plugins after retry = %#v, want all applied", eng.LastApply().Plugins)
+		}
+	}
+}
+
+func TestRestoreLastApplyOnlyBeforeFirstApply(t *testing.T) {
+	eng := NewEngine([]Plugin{&scriptedPlugin{name: "vpp"}}, slog.Default())
+	restored := ApplyResult{Time: time.Unix(1700000000, 0), Version: 4, Err: errors.New("apply boom")}
+	eng.RestoreLastApply(restored)
+	if got := eng.LastApply(); !got.Time.Equal(restored.Time) || got.Version != 4 || got.Err == nil {
+		t.Fatalf("LastApply() after restore = %#v, want %#v", got, restored)
+	}
+
+	candidate := &model.RouterConfig{System: &model.SystemConfig{HostName: "router1"}}
+	if err := eng.Apply(context.Background(), candidate, "alice", "first"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	eng.RestoreLastApply(restored)
+	if got := eng.LastApply(); got.Err != nil || got.Version != 1 {
+		t.Fatalf("LastApply() after apply and restore = %#v, want the new apply", got)
+	}
+}
+
 func TestApplyErrorReportsRollbackFailure(t *testing.T) {
 	first := &scriptedPlugin{name: "first", rollbackErr: errors.New("undo failed")}
 	second := &scriptedPlugin{name: "second", applyErr: errors.New("apply boom")}
@@ -365,6 +463,7 @@ type scriptedPlugin struct {
 	validateErr   error
 	applyErr      error
 	rollbackErr   error
+	operations    []string // reported from ApplyChanges; the last one fails with applyErr
 	validateCalls int
 	applyCalls    int
 	rollbackCalls int
@@ -383,8 +482,15 @@ func (p *scriptedPlugin) ValidateChanges(context.Context, *ConfigDiff) error {
 	return p.validateErr
 }
 
-func (p *scriptedPlugin) ApplyChanges(context.Context, *ConfigDiff) error {
+func (p *scriptedPlugin) ApplyChanges(ctx context.Context, _ *ConfigDiff) error {
 	p.applyCalls++
+	for i, operation := range p.operations {
+		var err error
+		if i == len(p.operations)-1 {
+			err = p.applyErr
+		}
+		RecordOperation(ctx, operation, err)
+	}
 	return p.applyErr
 }
 
@@ -419,3 +525,83 @@ func (p *blockingApplyPlugin) ApplyChanges(ctx context.Context, diff *ConfigDiff
 }
 
 func (p *blockingApplyPlugin) RollbackChanges(context.Context, *ConfigDiff) error { return nil }
+
+func TestApplyExcludesDeactivatedSubtreesFromPlugins(t *testing.T) {
+	plugin := &capturingDiffPlugin{}
+	eng := NewEngine([]Plugin{plugin}, slog.Default())
+	eng.InitializeRunning(model.NewRouterConfig(), 1)
+
+	candidate := model.NewRouterConfig()
+	candidate.Routing = &model.RoutingConfig{AutonomousSystem: 65000}
+	candidate.Protocols = &model.ProtocolsConfig{BGP: &model.BGPConfig{Groups: map[string]*model.BGPGroup{
+		"EXTERNAL": {Type: "external", Neighbors: map[string]*model.BGPNeighbor{"203.0.113.1": {PeerAS: 65001}}},
+		"INTERNAL": {Type: "internal", Neighbors: map[string]*model.BGPNeighbor{"192.0.2.2": {PeerAS: 65000}}},
+	}}}
+	candidate.Inactive = []string{"protocols bgp group EXTERNAL"}
+
+	if err := eng.Apply(context.Background(), candidate, "alice", "deactivate"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if plugin.applied == nil || plugin.applied.Protocols == nil || plugin.applied.Protocols.BGP == nil {
+		t.Fatalf("plugin applied config = %#v, want BGP", plugin.applied)
+	}
+	if _, ok := plugin.applied.Protocols.BGP.Groups["EXTERNAL"]; ok {
+		t.Fatal("plugin received deactivated BGP group")
+	}
+	if _, ok := plugin.applied.Protocols.BGP.Groups["INTERNAL"]; !ok {
+		t.Fatal("plugin did not receive active BGP group")
+	}
+	running := eng.Running()
+	if _, ok := running.Protocols.BGP.Groups["EXTERNAL"]; !ok || len(running.Inactive) != 1 {
+		t.Fatalf("running config lost deactivated group: %#v", running.Inactive)
+	}
+
+	// Changing only inactive configuration updates running without touching plugins.
+	plugin.applied = nil
+	edited := eng.Running()
+	edited.Protocols.BGP.Groups["EXTERNAL"].Neighbors["203.0.113.1"].PeerAS = 65002
+	if err := eng.Apply(context.Background(), edited, "alice", "edit inactive"); err != nil {
+		t.Fatalf("Apply(inactive edit) error = %v", err)
+	}
+	if plugin.applied != nil {
+		t.Fatal("plugin applied a change that only touched inactive configuration")
+	}
+	if got := eng.RunningSnapshot().Version; got != 3 {
+		t.Fatalf("running version = %d, want 3", got)
+	}
+
+	// Activating the group programs it.
+	activated := eng.Running()
+	activated.Inactive = nil
+	if err := eng.Apply(context.Background(), activated, "alice", "activate"); err != nil {
+		t.Fatalf("Apply(activate) error = %v", err)
+	}
+	if plugin.applied == nil || plugin.applied.Protocols.BGP.Groups["EXTERNAL"] == nil {
+		t.Fatal("plugin did not receive activated BGP group")
+	}
+}
+
+type capturingDiffPlugin struct {
+	applied *model.RouterConfig
+}
+
+func (p *capturingDiffPlugin) Name() string { return "capturing" }
+
+func (p *capturingDiffPlugin) Init(ctx context.Context) error { return nil }
+
+func (p *capturingDiffPlugin) Close() error { return nil }
+
+func (p *capturingDiffPlugin) HealthCheck(ctx context.Context) error { return nil }
+
+func (p *capturingDiffPlugin) ValidateChanges(ctx context.Context, diff *ConfigDiff) error {
+	return nil
+}
+
+func (p *capturingDiffPlugin) ApplyChanges(ctx context.Context, diff *ConfigDiff) error {
+	p.applied = diff.NewConfig
+	return nil
+}
+
+func (p *capturingDiffPlugin) RollbackChanges(ctx context.Context, diff *ConfigDiff) error {
+	return nil
+}
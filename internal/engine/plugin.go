@@ -1,6 +1,9 @@
 package engine
 
-import "context"
+import (
+	"context"
+	"sync"
+)
 
 // Plugin is the interface that southbound integrations (VPP, FRR) must implement.
 // The engine calls these methods during the diff-based commit workflow:
@@ -25,7 +28,8 @@ type Plugin interface {
 	// Returns an error if the changes cannot be applied.
 	ValidateChanges(ctx context.Context, diff *ConfigDiff) error
 
-	// ApplyChanges applies the diff to the underlying system.
+	// ApplyChanges applies the diff to the underlying system. Plugins that
+	// apply in several steps report each one with RecordOperation.
 	ApplyChanges(ctx context.Context, diff *ConfigDiff) error
 
 	// RollbackChanges undoes previously applied changes.
@@ -33,3 +37,42 @@ type Plugin interface {
 	// Implementations must tolerate rollback after a partial or no-op apply.
 	RollbackChanges(ctx context.Context, diff *ConfigDiff) error
 }
+
+// OperationResult is the outcome of one step a plugin performed while
+// applying changes, such as creating an interface.
+type OperationResult struct {
+	Operation string
+	Error     string // empty when the operation succeeded
+}
+
+type operationRecorderKey struct{}
+
+// operationRecorder collects the operations one plugin reports from
+// ApplyChanges.
+type operationRecorder struct {
+	mu         sync.Mutex
+	operations []OperationResult
+}
+
+func (r *operationRecorder) results() []OperationResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]OperationResult(nil), r.operations...)
+}
+
+// RecordOperation records the outcome of one apply step in the
+// PluginApplyResult of the plugin whose ApplyChanges received ctx. It does
+// nothing when ctx was not passed in by the engine.
+func RecordOperation(ctx context.Context, operation string, err error) {
+	recorder, ok := ctx.Value(operationRecorderKey{}).(*operationRecorder)
+	if !ok {
+		return
+	}
+	result := OperationResult{Operation: operation}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	recorder.mu.Lock()
+	recorder.operations = append(recorder.operations, result)
+	recorder.mu.Unlock()
+}
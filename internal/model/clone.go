@@ -1,5 +1,7 @@
 package model
 
+import "maps"
+
 // Clone returns a deep copy of the router configuration.
 func (c *RouterConfig) Clone() *RouterConfig {
 	if c == nil {
@@ -36,9 +38,19 @@ func (c *RouterConfig) Clone() *RouterConfig {
 	if c.ClassOfService != nil {
 		clone.ClassOfService = c.ClassOfService.Clone()
 	}
+	if c.Firewall != nil {
+		clone.Firewall = c.Firewall.Clone()
+	}
 	if c.Security != nil {
 		clone.Security = c.Security.Clone()
 	}
+	clone.Inactive = append([]string(nil), c.Inactive...)
+	if c.Groups != nil {
+		clone.Groups = make(map[string]*RouterConfig, len(c.Groups))
+		for name, group := range c.Groups {
+			clone.Groups[name] = group.Clone()
+		}
+	}
 	return clone
 }
 
@@ -51,6 +63,16 @@ func (c *SystemConfig) Clone() *SystemConfig {
 	if c.Services != nil {
 		clone.Services = c.Services.Clone()
 	}
+	if c.Scripts != nil {
+		clone.Scripts = &SystemScriptsConfig{
+			CommitPre:  append([]string(nil), c.Scripts.CommitPre...),
+			CommitPost: append([]string(nil), c.Scripts.CommitPost...),
+		}
+	}
+	if c.Alarms != nil {
+		alarms := *c.Alarms
+		clone.Alarms = &alarms
+	}
 	return clone
 }
 
@@ -155,7 +177,29 @@ func (c *InterfaceConfig) Clone() *InterfaceConfig {
 	if c == nil {
 		return nil
 	}
-	clone := &InterfaceConfig{Description: c.Description}
+	clone := &InterfaceConfig{
+		Description:   c.Description,
+		Promiscuous:   c.Promiscuous,
+		RxMode:        c.RxMode,
+		MTU:           c.MTU,
+		Bandwidth:     c.Bandwidth,
+		InputPolicer:  c.InputPolicer,
+		OutputPolicer: c.OutputPolicer,
+	}
+	if c.Tunnel != nil {
+		tunnel := *c.Tunnel
+		clone.Tunnel = &tunnel
+	}
+	if c.HoldTime != nil {
+		holdTime := *c.HoldTime
+		clone.HoldTime = &holdTime
+	}
+	if c.GigEtherOptions != nil {
+		opts := *c.GigEtherOptions
+		opts.RxPlacement = maps.Clone(c.GigEtherOptions.RxPlacement)
+		clone.GigEtherOptions = &opts
+	}
+	clone.ApplyGroups = append([]string(nil), c.ApplyGroups...)
 	if c.Units != nil {
 		clone.Units = make(map[int]*Unit, len(c.Units))
 		for unitNum, unit := range c.Units {
@@ -185,7 +229,38 @@ func (a *AddressFamily) Clone() *AddressFamily {
 	if a == nil {
 		return nil
 	}
-	return &AddressFamily{Addresses: append([]string(nil), a.Addresses...)}
+	clone := &AddressFamily{
+		Addresses:           append([]string(nil), a.Addresses...),
+		MTU:                 a.MTU,
+		RouterAdvertisement: a.RouterAdvertisement.Clone(),
+	}
+	if a.Neighbors != nil {
+		clone.Neighbors = make(map[string]string, len(a.Neighbors))
+		for ip, mac := range a.Neighbors {
+			clone.Neighbors[ip] = mac
+		}
+	}
+	return clone
+}
+
+// Clone returns a deep copy of the router advertisement configuration.
+func (r *RouterAdvertisement) Clone() *RouterAdvertisement {
+	if r == nil {
+		return nil
+	}
+	clone := *r
+	if r.Prefixes != nil {
+		clone.Prefixes = make(map[string]*RouterAdvertisementPrefix, len(r.Prefixes))
+		for prefix, lifetimes := range r.Prefixes {
+			if lifetimes == nil {
+				clone.Prefixes[prefix] = nil
+				continue
+			}
+			copied := *lifetimes
+			clone.Prefixes[prefix] = &copied
+		}
+	}
+	return &clone
 }
 
 // Clone returns a deep copy of the protocol configuration.
@@ -200,6 +275,7 @@ func (c *ProtocolsConfig) Clone() *ProtocolsConfig {
 		OSPF:  c.OSPF.Clone(),
 		OSPF3: c.OSPF3.Clone(),
 		MPLS:  c.MPLS.Clone(),
+		RIP:   c.RIP.Clone(),
 		VRRP:  c.VRRP.Clone(),
 	}
 }
@@ -294,7 +370,7 @@ func (c *BGPConfig) Clone() *BGPConfig {
 	if c == nil {
 		return nil
 	}
-	clone := &BGPConfig{}
+	clone := &BGPConfig{RouterID: c.RouterID}
 	if c.Groups != nil {
 		clone.Groups = make(map[string]*BGPGroup, len(c.Groups))
 		for name, group := range c.Groups {
@@ -333,7 +409,7 @@ func (c *OSPFConfig) Clone() *OSPFConfig {
 	if c == nil {
 		return nil
 	}
-	clone := &OSPFConfig{RouterID: c.RouterID}
+	clone := &OSPFConfig{RouterID: c.RouterID, ReferenceBandwidth: c.ReferenceBandwidth}
 	if c.Areas != nil {
 		clone.Areas = make(map[string]*OSPFArea, len(c.Areas))
 		for name, area := range c.Areas {
@@ -357,6 +433,10 @@ func (a *OSPFArea) Clone() *OSPFArea {
 				continue
 			}
 			i := *iface
+			if iface.Metric != nil {
+				metric := *iface.Metric
+				i.Metric = &metric
+			}
 			if iface.Priority != nil {
 				priority := *iface.Priority
 				i.Priority = &priority
@@ -367,6 +447,42 @@ func (a *OSPFArea) Clone() *OSPFArea {
 	return clone
 }
 
+// Clone returns a deep copy of the RIP configuration.
+func (c *RIPConfig) Clone() *RIPConfig {
+	if c == nil {
+		return nil
+	}
+	clone := &RIPConfig{
+		UpdateInterval: c.UpdateInterval,
+		RouteTimeout:   c.RouteTimeout,
+		Holddown:       c.Holddown,
+		DefaultMetric:  c.DefaultMetric,
+	}
+	if c.Groups != nil {
+		clone.Groups = make(map[string]*RIPGroup, len(c.Groups))
+		for name, group := range c.Groups {
+			if group == nil {
+				clone.Groups[name] = nil
+				continue
+			}
+			g := &RIPGroup{}
+			if group.Neighbors != nil {
+				g.Neighbors = make(map[string]*RIPNeighbor, len(group.Neighbors))
+				for ifName, neighbor := range group.Neighbors {
+					if neighbor == nil {
+						g.Neighbors[ifName] = nil
+						continue
+					}
+					n := *neighbor
+					g.Neighbors[ifName] = &n
+				}
+			}
+			clone.Groups[name] = g
+		}
+	}
+	return clone
+}
+
 // Clone returns a deep copy of the routing configuration.
 func (c *RoutingConfig) Clone() *RoutingConfig {
 	if c == nil {
@@ -376,6 +492,21 @@ func (c *RoutingConfig) Clone() *RoutingConfig {
 		AutonomousSystem: c.AutonomousSystem,
 		RouterID:         c.RouterID,
 	}
+	if ad := c.AdministrativeDistance; ad != nil {
+		clone.AdministrativeDistance = &AdministrativeDistance{}
+		if ad.BGP != nil {
+			bgp := *ad.BGP
+			clone.AdministrativeDistance.BGP = &bgp
+		}
+		if ad.OSPF != nil {
+			ospf := *ad.OSPF
+			clone.AdministrativeDistance.OSPF = &ospf
+		}
+		if ad.OSPF3 != nil {
+			ospf3 := *ad.OSPF3
+			clone.AdministrativeDistance.OSPF3 = &ospf3
+		}
+	}
 	if c.StaticRoutes != nil {
 		clone.StaticRoutes = make([]*StaticRoute, len(c.StaticRoutes))
 		for i, route := range c.StaticRoutes {
@@ -464,12 +595,21 @@ func (p *PolicyMatchConditions) Clone() *PolicyMatchConditions {
 	if p == nil {
 		return nil
 	}
-	return &PolicyMatchConditions{
+	clone := &PolicyMatchConditions{
 		PrefixLists: append([]string(nil), p.PrefixLists...),
 		Protocol:    p.Protocol,
 		Neighbor:    p.Neighbor,
 		ASPath:      p.ASPath,
 	}
+	for _, filter := range p.RouteFilters {
+		if filter == nil {
+			clone.RouteFilters = append(clone.RouteFilters, nil)
+			continue
+		}
+		copied := *filter
+		clone.RouteFilters = append(clone.RouteFilters, &copied)
+	}
+	return clone
 }
 
 // Clone returns a deep copy of the policy actions.
@@ -524,6 +664,7 @@ func (c *NETCONFSecurityConfig) Clone() *NETCONFSecurityConfig {
 	clone := &NETCONFSecurityConfig{}
 	if c.SSH != nil {
 		ssh := *c.SSH
+		ssh.Listen = append([]string(nil), c.SSH.Listen...)
 		clone.SSH = &ssh
 	}
 	return clone
@@ -570,3 +711,23 @@ func (c *ClassOfServiceConfig) Clone() *ClassOfServiceConfig {
 	}
 	return clone
 }
+
+// Clone returns a deep copy of the firewall configuration.
+func (c *FirewallConfig) Clone() *FirewallConfig {
+	if c == nil {
+		return nil
+	}
+	clone := &FirewallConfig{}
+	if c.Policers != nil {
+		clone.Policers = make(map[string]*Policer, len(c.Policers))
+		for name, policer := range c.Policers {
+			if policer == nil {
+				clone.Policers[name] = nil
+				continue
+			}
+			p := *policer
+			clone.Policers[name] = &p
+		}
+	}
+	return clone
+}
@@ -22,13 +22,34 @@ type RouterConfig struct {
 	RoutingInstances map[string]*RoutingInstance `json:"routing-instances,omitempty"`
 	Policy           *PolicyConfig               `json:"policy-options,omitempty"`
 	ClassOfService   *ClassOfServiceConfig       `json:"class-of-service,omitempty"`
+	Firewall         *FirewallConfig             `json:"firewall,omitempty"`
 	Security         *SecurityConfig             `json:"security,omitempty"`
+	// Inactive lists deactivated configuration paths. Deactivated subtrees
+	// stay in the configuration but are excluded from the active config.
+	Inactive []string `json:"inactive,omitempty"`
+	// Groups holds configuration groups whose interfaces, keyed by name or
+	// <pattern>, are inherited by the interfaces that apply the group.
+	Groups map[string]*RouterConfig `json:"groups,omitempty"`
 }
 
 // SystemConfig holds system-level settings.
 type SystemConfig struct {
 	HostName string                `json:"host-name,omitempty"`
 	Services *SystemServicesConfig `json:"services,omitempty"`
+	Scripts  *SystemScriptsConfig  `json:"scripts,omitempty"`
+	Alarms   *SystemAlarmsConfig   `json:"alarms,omitempty"`
+}
+
+// SystemAlarmsConfig holds the thresholds of the built-in health alarms.
+type SystemAlarmsConfig struct {
+	InterfaceErrorRate uint64 `json:"interface-error-rate,omitempty"` // errors per second; 0 disables
+	InterfaceLinkDown  bool   `json:"interface-link-down,omitempty"`
+}
+
+// SystemScriptsConfig holds operator-supplied commit scripts.
+type SystemScriptsConfig struct {
+	CommitPre  []string `json:"commit-pre,omitempty"`
+	CommitPost []string `json:"commit-post,omitempty"`
 }
 
 // SystemServicesConfig holds system service settings.
@@ -90,8 +111,39 @@ type EtcdSyncConfig struct {
 
 // InterfaceConfig represents a physical or logical interface.
 type InterfaceConfig struct {
-	Description string        `json:"description,omitempty"`
-	Units       map[int]*Unit `json:"units,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	Promiscuous   bool          `json:"promiscuous,omitempty"`
+	RxMode        string        `json:"rx-mode,omitempty"`
+	MTU           int           `json:"mtu,omitempty"`
+	Bandwidth     uint64        `json:"bandwidth,omitempty"`
+	InputPolicer  string        `json:"input-policer,omitempty"`
+	OutputPolicer string        `json:"output-policer,omitempty"`
+	Tunnel        *TunnelConfig `json:"tunnel,omitempty"`
+	HoldTime      *HoldTime     `json:"hold-time,omitempty"`
+	ApplyGroups   []string      `json:"apply-groups,omitempty"`
+	Units         map[int]*Unit `json:"units,omitempty"`
+
+	GigEtherOptions *GigEtherOptions `json:"gigether-options,omitempty"`
+}
+
+// GigEtherOptions holds the RX/TX queue counts of a physical interface and
+// the VPP worker each pinned RX queue is placed on, keyed by queue ID.
+type GigEtherOptions struct {
+	RxQueues    int         `json:"rxq,omitempty"`
+	TxQueues    int         `json:"txq,omitempty"`
+	RxPlacement map[int]int `json:"rx-placement,omitempty"`
+}
+
+// TunnelConfig holds the endpoints of a gr- (GRE) or ip- (IP-in-IP) interface.
+type TunnelConfig struct {
+	Source      string `json:"source,omitempty"`
+	Destination string `json:"destination,omitempty"`
+}
+
+// HoldTime holds the link debounce delays of an interface in milliseconds.
+type HoldTime struct {
+	Up   int `json:"up"`
+	Down int `json:"down"`
 }
 
 // Unit represents a logical sub-interface.
@@ -102,6 +154,28 @@ type Unit struct {
 // AddressFamily represents inet or inet6 address configuration.
 type AddressFamily struct {
 	Addresses []string `json:"addresses,omitempty"`
+	// Neighbors maps static ARP/ND neighbor IP addresses to MAC addresses.
+	Neighbors map[string]string `json:"neighbors,omitempty"`
+	// MTU is the L3 MTU for the family; 0 follows the interface MTU.
+	MTU int `json:"mtu,omitempty"`
+	// RouterAdvertisement enables IPv6 router advertisements (inet6 only).
+	RouterAdvertisement *RouterAdvertisement `json:"router-advertisement,omitempty"`
+}
+
+// RouterAdvertisement configures IPv6 router advertisements on an interface.
+// Zero intervals and lifetimes use the dataplane defaults.
+type RouterAdvertisement struct {
+	ManagedConfiguration       bool                                  `json:"managed-configuration,omitempty"`
+	OtherStatefulConfiguration bool                                  `json:"other-stateful-configuration,omitempty"`
+	MaxAdvertisementInterval   int                                   `json:"max-advertisement-interval,omitempty"`
+	MinAdvertisementInterval   int                                   `json:"min-advertisement-interval,omitempty"`
+	Prefixes                   map[string]*RouterAdvertisementPrefix `json:"prefixes,omitempty"`
+}
+
+// RouterAdvertisementPrefix holds the lifetimes of an advertised prefix.
+type RouterAdvertisementPrefix struct {
+	ValidLifetime     int `json:"valid-lifetime,omitempty"`
+	PreferredLifetime int `json:"preferred-lifetime,omitempty"`
 }
 
 // ProtocolsConfig holds routing protocol configurations.
@@ -112,6 +186,7 @@ type ProtocolsConfig struct {
 	OSPF  *OSPFConfig `json:"ospf,omitempty"`
 	OSPF3 *OSPFConfig `json:"ospf3,omitempty"`
 	MPLS  *MPLSConfig `json:"mpls,omitempty"`
+	RIP   *RIPConfig  `json:"rip,omitempty"`
 	VRRP  *VRRPConfig `json:"vrrp,omitempty"`
 }
 
@@ -187,30 +262,41 @@ type VRRPGroup struct {
 
 // BGPConfig represents BGP configuration.
 type BGPConfig struct {
-	Groups map[string]*BGPGroup `json:"groups,omitempty"`
+	RouterID string               `json:"router-id,omitempty"`
+	Groups   map[string]*BGPGroup `json:"groups,omitempty"`
 }
 
 // BGPGroup represents a BGP peer group.
 type BGPGroup struct {
-	Type      string                  `json:"type,omitempty"`
-	Neighbors map[string]*BGPNeighbor `json:"neighbors,omitempty"`
-	Import    string                  `json:"import,omitempty"`
-	Export    string                  `json:"export,omitempty"`
+	Type            string                  `json:"type,omitempty"`
+	Neighbors       map[string]*BGPNeighbor `json:"neighbors,omitempty"`
+	Import          string                  `json:"import,omitempty"`
+	Export          string                  `json:"export,omitempty"`
+	Cluster         string                  `json:"cluster,omitempty"`
+	NextHopSelf     bool                    `json:"next-hop-self,omitempty"`
+	RemovePrivateAS bool                    `json:"remove-private-as,omitempty"`
 }
 
 // BGPNeighbor represents a BGP peer.
 type BGPNeighbor struct {
-	PeerAS       uint32 `json:"peer-as"`
-	Description  string `json:"description,omitempty"`
-	LocalAddress string `json:"local-address,omitempty"`
-	BFD          bool   `json:"bfd,omitempty"`
-	BFDProfile   string `json:"bfd-profile,omitempty"`
+	PeerAS               uint32 `json:"peer-as"`
+	Description          string `json:"description,omitempty"`
+	LocalAddress         string `json:"local-address,omitempty"`
+	UpdateSource         string `json:"update-source,omitempty"`
+	BFD                  bool   `json:"bfd,omitempty"`
+	BFDProfile           string `json:"bfd-profile,omitempty"`
+	Cluster              string `json:"cluster,omitempty"`
+	RouteReflectorClient bool   `json:"route-reflector-client,omitempty"`
+	Shutdown             bool   `json:"shutdown,omitempty"`
+	NextHopSelf          bool   `json:"next-hop-self,omitempty"`
+	RemovePrivateAS      bool   `json:"remove-private-as,omitempty"`
 }
 
 // OSPFConfig represents OSPF configuration.
 type OSPFConfig struct {
-	RouterID string               `json:"router-id,omitempty"`
-	Areas    map[string]*OSPFArea `json:"areas,omitempty"`
+	RouterID           string               `json:"router-id,omitempty"`
+	ReferenceBandwidth uint64               `json:"reference-bandwidth,omitempty"`
+	Areas              map[string]*OSPFArea `json:"areas,omitempty"`
 }
 
 // OSPFArea represents an OSPF area.
@@ -221,17 +307,62 @@ type OSPFArea struct {
 // OSPFInterface represents OSPF per-interface settings.
 type OSPFInterface struct {
 	Passive    bool   `json:"passive,omitempty"`
-	Metric     int    `json:"metric,omitempty"`
+	Metric     *int   `json:"metric,omitempty"`
 	Priority   *int   `json:"priority,omitempty"`
 	BFD        bool   `json:"bfd,omitempty"`
 	BFDProfile string `json:"bfd-profile,omitempty"`
 }
 
+// RIPConfig represents RIPv2 configuration.
+type RIPConfig struct {
+	UpdateInterval int                  `json:"update-interval,omitempty"`
+	RouteTimeout   int                  `json:"route-timeout,omitempty"`
+	Holddown       int                  `json:"holddown,omitempty"`
+	DefaultMetric  int                  `json:"default-metric,omitempty"`
+	Groups         map[string]*RIPGroup `json:"groups,omitempty"`
+}
+
+// RIPGroup represents a RIP neighbor group keyed by interface name.
+type RIPGroup struct {
+	Neighbors map[string]*RIPNeighbor `json:"neighbors,omitempty"`
+}
+
+// RIPNeighbor represents RIP per-interface settings.
+type RIPNeighbor struct {
+	Passive bool `json:"passive,omitempty"`
+}
+
 // RoutingConfig holds routing options.
 type RoutingConfig struct {
 	AutonomousSystem uint32         `json:"autonomous-system,omitempty"`
 	RouterID         string         `json:"router-id,omitempty"`
 	StaticRoutes     []*StaticRoute `json:"static-routes,omitempty"`
+
+	AdministrativeDistance *AdministrativeDistance `json:"administrative-distance,omitempty"`
+}
+
+// AdministrativeDistance holds per-protocol route distances. A zero distance
+// keeps the FRR default.
+type AdministrativeDistance struct {
+	BGP   *BGPDistance  `json:"bgp,omitempty"`
+	OSPF  *OSPFDistance `json:"ospf,omitempty"`
+	OSPF3 *OSPFDistance `json:"ospf3,omitempty"`
+}
+
+// BGPDistance holds the external, internal, and local BGP route distances.
+type BGPDistance struct {
+	External int `json:"external,omitempty"`
+	Internal int `json:"internal,omitempty"`
+	Local    int `json:"local,omitempty"`
+}
+
+// OSPFDistance holds the distance of all OSPF routes and its per route type
+// overrides.
+type OSPFDistance struct {
+	Default   int `json:"default,omitempty"`
+	IntraArea int `json:"intra-area,omitempty"`
+	InterArea int `json:"inter-area,omitempty"`
+	External  int `json:"external,omitempty"`
 }
 
 // StaticRoute represents a static route entry.
@@ -282,10 +413,19 @@ type PolicyTerm struct {
 
 // PolicyMatchConditions represents match conditions.
 type PolicyMatchConditions struct {
-	PrefixLists []string `json:"prefix-lists,omitempty"`
-	Protocol    string   `json:"protocol,omitempty"`
-	Neighbor    string   `json:"neighbor,omitempty"`
-	ASPath      string   `json:"as-path,omitempty"`
+	PrefixLists  []string       `json:"prefix-lists,omitempty"`
+	Protocol     string         `json:"protocol,omitempty"`
+	Neighbor     string         `json:"neighbor,omitempty"`
+	ASPath       string         `json:"as-path,omitempty"`
+	RouteFilters []*RouteFilter `json:"route-filters,omitempty"`
+}
+
+// RouteFilter represents an inline prefix match with a length match type.
+type RouteFilter struct {
+	Prefix    string `json:"prefix"`
+	MatchType string `json:"match-type"`
+	MinLength int    `json:"min-length,omitempty"`
+	MaxLength int    `json:"max-length,omitempty"`
 }
 
 // PolicyActions represents policy actions.
@@ -309,10 +449,11 @@ type NETCONFSecurityConfig struct {
 
 // NETCONFSSHConfig holds NETCONF SSH settings.
 type NETCONFSSHConfig struct {
-	Enabled       bool   `json:"enabled,omitempty"`
-	EnabledSet    bool   `json:"-"`
-	ListenAddress string `json:"listen-address,omitempty"`
-	Port          int    `json:"port,omitempty"`
+	Enabled       bool     `json:"enabled,omitempty"`
+	EnabledSet    bool     `json:"-"`
+	ListenAddress string   `json:"listen-address,omitempty"`
+	Listen        []string `json:"listen,omitempty"`
+	Port          int      `json:"port,omitempty"`
 }
 
 // UserConfig represents a user account.
@@ -320,6 +461,7 @@ type UserConfig struct {
 	Password string `json:"password,omitempty"`
 	Role     string `json:"role,omitempty"`
 	SSHKey   string `json:"ssh-key,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
 }
 
 // RateLimitConfig holds rate limiting settings.
@@ -351,6 +493,19 @@ type CoSInterface struct {
 	OutputTrafficControlProfile string `json:"output-traffic-control-profile,omitempty"`
 }
 
+// FirewallConfig represents firewall configuration.
+type FirewallConfig struct {
+	Policers map[string]*Policer `json:"policers,omitempty"`
+}
+
+// Policer represents a single-rate two-color policer. Limits are in bits per
+// second and bytes.
+type Policer struct {
+	BandwidthLimit uint64 `json:"bandwidth-limit,omitempty"`
+	BurstSizeLimit uint64 `json:"burst-size-limit,omitempty"`
+	Action         string `json:"then,omitempty"`
+}
+
 // NewRouterConfig creates an empty RouterConfig with initialized maps.
 func NewRouterConfig() *RouterConfig {
 	return &RouterConfig{
@@ -365,6 +520,8 @@ type ConfigSnapshot struct {
 	Hash      [32]byte      `json:"hash"`
 	Author    string        `json:"author"`
 	Message   string        `json:"message,omitempty"`
+	Source    string        `json:"source,omitempty"`    // northbound interface that produced the snapshot
+	SourceIP  string        `json:"source_ip,omitempty"` // client address recorded in the audit log
 	CreatedAt time.Time     `json:"created_at"`
 }
 
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"maps"
+
 	"github.com/akam1o/arca-router/pkg/config"
 )
 
@@ -45,6 +47,18 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 				c.System.Services = services
 			}
 		}
+		if old.System.Scripts != nil {
+			c.System.Scripts = &SystemScriptsConfig{
+				CommitPre:  append([]string(nil), old.System.Scripts.CommitPre...),
+				CommitPost: append([]string(nil), old.System.Scripts.CommitPost...),
+			}
+		}
+		if old.System.Alarms != nil {
+			c.System.Alarms = &SystemAlarmsConfig{
+				InterfaceErrorRate: old.System.Alarms.InterfaceErrorRate,
+				InterfaceLinkDown:  old.System.Alarms.InterfaceLinkDown,
+			}
+		}
 	}
 
 	if old.Chassis != nil && old.Chassis.Cluster != nil {
@@ -73,16 +87,44 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 	// Interfaces
 	for name, iface := range old.Interfaces {
 		ic := &InterfaceConfig{
-			Description: iface.Description,
-			Units:       make(map[int]*Unit),
+			Description:   iface.Description,
+			Promiscuous:   iface.Promiscuous,
+			RxMode:        iface.RxMode,
+			MTU:           iface.MTU,
+			Bandwidth:     iface.Bandwidth,
+			InputPolicer:  iface.InputPolicer,
+			OutputPolicer: iface.OutputPolicer,
+			Units:         make(map[int]*Unit),
+		}
+		if iface.Tunnel != nil {
+			ic.Tunnel = &TunnelConfig{Source: iface.Tunnel.Source, Destination: iface.Tunnel.Destination}
 		}
+		if iface.HoldTime != nil {
+			ic.HoldTime = &HoldTime{Up: iface.HoldTime.Up, Down: iface.HoldTime.Down}
+		}
+		if opts := iface.GigEtherOptions; opts != nil {
+			ic.GigEtherOptions = &GigEtherOptions{
+				RxQueues:    opts.RxQueues,
+				TxQueues:    opts.TxQueues,
+				RxPlacement: maps.Clone(opts.RxPlacement),
+			}
+		}
+		ic.ApplyGroups = append([]string(nil), iface.ApplyGroups...)
 		for unitNum, unit := range iface.Units {
 			u := &Unit{Family: make(map[string]*AddressFamily)}
 			for familyName, family := range unit.Family {
 				af := &AddressFamily{
-					Addresses: make([]string, len(family.Addresses)),
+					Addresses:           make([]string, len(family.Addresses)),
+					MTU:                 family.MTU,
+					RouterAdvertisement: routerAdvertisementFromLegacy(family.RouterAdvertisement),
 				}
 				copy(af.Addresses, family.Addresses)
+				if len(family.Neighbors) > 0 {
+					af.Neighbors = make(map[string]string, len(family.Neighbors))
+					for ip, mac := range family.Neighbors {
+						af.Neighbors[ip] = mac
+					}
+				}
 				u.Family[familyName] = af
 			}
 			ic.Units[unitNum] = u
@@ -93,8 +135,9 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 	// Routing options
 	if old.RoutingOptions != nil {
 		c.Routing = &RoutingConfig{
-			AutonomousSystem: old.RoutingOptions.AutonomousSystem,
-			RouterID:         old.RoutingOptions.RouterID,
+			AutonomousSystem:       old.RoutingOptions.AutonomousSystem,
+			RouterID:               old.RoutingOptions.RouterID,
+			AdministrativeDistance: administrativeDistanceFromLegacy(old.RoutingOptions.AdministrativeDistance),
 		}
 		for _, sr := range old.RoutingOptions.StaticRoutes {
 			c.Routing.StaticRoutes = append(c.Routing.StaticRoutes, &StaticRoute{
@@ -119,22 +162,32 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 
 		if old.Protocols.BGP != nil {
 			c.Protocols.BGP = &BGPConfig{
-				Groups: make(map[string]*BGPGroup),
+				RouterID: old.Protocols.BGP.RouterID,
+				Groups:   make(map[string]*BGPGroup),
 			}
 			for gName, g := range old.Protocols.BGP.Groups {
 				bg := &BGPGroup{
-					Type:      g.Type,
-					Import:    g.Import,
-					Export:    g.Export,
-					Neighbors: make(map[string]*BGPNeighbor),
+					Type:            g.Type,
+					Import:          g.Import,
+					Export:          g.Export,
+					Cluster:         g.Cluster,
+					NextHopSelf:     g.NextHopSelf,
+					RemovePrivateAS: g.RemovePrivateAS,
+					Neighbors:       make(map[string]*BGPNeighbor),
 				}
 				for _, n := range g.Neighbors {
 					bg.Neighbors[n.IP] = &BGPNeighbor{
-						PeerAS:       n.PeerAS,
-						Description:  n.Description,
-						LocalAddress: n.LocalAddress,
-						BFD:          n.BFD,
-						BFDProfile:   n.BFDProfile,
+						PeerAS:               n.PeerAS,
+						Description:          n.Description,
+						LocalAddress:         n.LocalAddress,
+						UpdateSource:         n.UpdateSource,
+						BFD:                  n.BFD,
+						BFDProfile:           n.BFDProfile,
+						Cluster:              n.Cluster,
+						RouteReflectorClient: n.RouteReflectorClient,
+						Shutdown:             n.Shutdown,
+						NextHopSelf:          n.NextHopSelf,
+						RemovePrivateAS:      n.RemovePrivateAS,
 					}
 				}
 				c.Protocols.BGP.Groups[gName] = bg
@@ -154,6 +207,9 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 		if old.Protocols.MPLS != nil {
 			c.Protocols.MPLS = &MPLSConfig{Interfaces: append([]string{}, old.Protocols.MPLS.Interfaces...)}
 		}
+		if old.Protocols.RIP != nil {
+			c.Protocols.RIP = ripFromLegacy(old.Protocols.RIP)
+		}
 		if old.Protocols.VRRP != nil {
 			c.Protocols.VRRP = &VRRPConfig{Groups: make(map[string]*VRRPGroup)}
 			for name, group := range old.Protocols.VRRP.Groups {
@@ -211,6 +267,17 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 						Neighbor:    t.From.Neighbor,
 						ASPath:      t.From.ASPath,
 					}
+					for _, f := range t.From.RouteFilters {
+						if f == nil {
+							continue
+						}
+						term.From.RouteFilters = append(term.From.RouteFilters, &RouteFilter{
+							Prefix:    f.Prefix,
+							MatchType: f.MatchType,
+							MinLength: f.MinLength,
+							MaxLength: f.MaxLength,
+						})
+					}
 				}
 				if t.Then != nil {
 					term.Then = &PolicyActions{
@@ -234,6 +301,7 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 					Enabled:       old.Security.NETCONF.SSH.Enabled,
 					EnabledSet:    old.Security.NETCONF.SSH.EnabledSet,
 					ListenAddress: old.Security.NETCONF.SSH.ListenAddress,
+					Listen:        append([]string(nil), old.Security.NETCONF.SSH.Listen...),
 					Port:          old.Security.NETCONF.SSH.Port,
 				},
 			}
@@ -245,6 +313,7 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 					Password: u.Password,
 					Role:     u.Role,
 					SSHKey:   u.SSHKey,
+					Disabled: u.Disabled,
 				}
 			}
 		}
@@ -285,6 +354,28 @@ func FromLegacyConfig(old *config.Config) *RouterConfig {
 		}
 	}
 
+	if old.Firewall != nil {
+		c.Firewall = &FirewallConfig{Policers: make(map[string]*Policer)}
+		for name, policer := range old.Firewall.Policers {
+			if policer == nil {
+				continue
+			}
+			c.Firewall.Policers[name] = &Policer{
+				BandwidthLimit: policer.BandwidthLimit,
+				BurstSizeLimit: policer.BurstSizeLimit,
+				Action:         policer.Action,
+			}
+		}
+	}
+
+	c.Inactive = append([]string(nil), old.Inactive...)
+	if old.Groups != nil {
+		c.Groups = make(map[string]*RouterConfig, len(old.Groups))
+		for name, group := range old.Groups {
+			c.Groups[name] = FromLegacyConfig(group)
+		}
+	}
+
 	return c
 }
 
@@ -293,8 +384,9 @@ func ospfFromLegacy(old *config.OSPFConfig) *OSPFConfig {
 		return nil
 	}
 	ospf := &OSPFConfig{
-		RouterID: old.RouterID,
-		Areas:    make(map[string]*OSPFArea),
+		RouterID:           old.RouterID,
+		ReferenceBandwidth: old.ReferenceBandwidth,
+		Areas:              make(map[string]*OSPFArea),
 	}
 	for aID, a := range old.Areas {
 		if a == nil {
@@ -311,12 +403,15 @@ func ospfFromLegacy(old *config.OSPFConfig) *OSPFConfig {
 			}
 			oi := &OSPFInterface{
 				Passive:    i.Passive,
-				Metric:     i.Metric,
 				BFD:        i.BFD,
 				BFDProfile: i.BFDProfile,
 			}
-			if i.PrioritySet || i.Priority != 0 {
-				p := i.Priority
+			if i.Metric != nil {
+				metric := *i.Metric
+				oi.Metric = &metric
+			}
+			if i.Priority != nil {
+				p := *i.Priority
 				oi.Priority = &p
 			}
 			area.Interfaces[iName] = oi
@@ -326,6 +421,54 @@ func ospfFromLegacy(old *config.OSPFConfig) *OSPFConfig {
 	return ospf
 }
 
+func ripFromLegacy(old *config.RIPConfig) *RIPConfig {
+	if old == nil {
+		return nil
+	}
+	rip := &RIPConfig{
+		UpdateInterval: old.UpdateInterval,
+		RouteTimeout:   old.RouteTimeout,
+		Holddown:       old.Holddown,
+		DefaultMetric:  old.DefaultMetric,
+		Groups:         make(map[string]*RIPGroup),
+	}
+	for gName, g := range old.Groups {
+		if g == nil {
+			rip.Groups[gName] = nil
+			continue
+		}
+		group := &RIPGroup{Neighbors: make(map[string]*RIPNeighbor)}
+		for ifName, n := range g.Neighbors {
+			if n == nil {
+				group.Neighbors[ifName] = nil
+				continue
+			}
+			group.Neighbors[ifName] = &RIPNeighbor{Passive: n.Passive}
+		}
+		rip.Groups[gName] = group
+	}
+	return rip
+}
+
+func administrativeDistanceFromLegacy(old *config.AdministrativeDistance) *AdministrativeDistance {
+	if old == nil {
+		return nil
+	}
+	ad := &AdministrativeDistance{}
+	if old.BGP != nil {
+		ad.BGP = &BGPDistance{External: old.BGP.External, Internal: old.BGP.Internal, Local: old.BGP.Local}
+	}
+	if old.OSPF != nil {
+		ospf := OSPFDistance(*old.OSPF)
+		ad.OSPF = &ospf
+	}
+	if old.OSPF3 != nil {
+		ospf3 := OSPFDistance(*old.OSPF3)
+		ad.OSPF3 = &ospf3
+	}
+	return ad
+}
+
 func bfdFromLegacy(old *config.BFDConfig) *BFDConfig {
 	if old == nil {
 		return nil
@@ -372,6 +515,32 @@ func bfdFromLegacy(old *config.BFDConfig) *BFDConfig {
 	return bfd
 }
 
+func routerAdvertisementFromLegacy(old *config.RouterAdvertisement) *RouterAdvertisement {
+	if old == nil {
+		return nil
+	}
+	ra := &RouterAdvertisement{
+		ManagedConfiguration:       old.ManagedConfiguration,
+		OtherStatefulConfiguration: old.OtherStatefulConfiguration,
+		MaxAdvertisementInterval:   old.MaxAdvertisementInterval,
+		MinAdvertisementInterval:   old.MinAdvertisementInterval,
+	}
+	if old.Prefixes != nil {
+		ra.Prefixes = make(map[string]*RouterAdvertisementPrefix, len(old.Prefixes))
+		for prefix, lifetimes := range old.Prefixes {
+			if lifetimes == nil {
+				ra.Prefixes[prefix] = nil
+				continue
+			}
+			ra.Prefixes[prefix] = &RouterAdvertisementPrefix{
+				ValidLifetime:     lifetimes.ValidLifetime,
+				PreferredLifetime: lifetimes.PreferredLifetime,
+			}
+		}
+	}
+	return ra
+}
+
 func evpnFromLegacy(old *config.EVPNConfig) *EVPNConfig {
 	if old == nil {
 		return nil
@@ -444,6 +613,18 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 				old.System.Services = services
 			}
 		}
+		if c.System.Scripts != nil {
+			old.System.Scripts = &config.SystemScriptsConfig{
+				CommitPre:  append([]string(nil), c.System.Scripts.CommitPre...),
+				CommitPost: append([]string(nil), c.System.Scripts.CommitPost...),
+			}
+		}
+		if c.System.Alarms != nil {
+			old.System.Alarms = &config.SystemAlarmsConfig{
+				InterfaceErrorRate: c.System.Alarms.InterfaceErrorRate,
+				InterfaceLinkDown:  c.System.Alarms.InterfaceLinkDown,
+			}
+		}
 	}
 
 	if c.Chassis != nil && c.Chassis.Cluster != nil {
@@ -474,11 +655,39 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 	for name, ic := range c.Interfaces {
 		iface := old.GetOrCreateInterface(name)
 		iface.Description = ic.Description
+		iface.Promiscuous = ic.Promiscuous
+		iface.RxMode = ic.RxMode
+		iface.MTU = ic.MTU
+		iface.Bandwidth = ic.Bandwidth
+		iface.InputPolicer = ic.InputPolicer
+		iface.OutputPolicer = ic.OutputPolicer
+		if ic.Tunnel != nil {
+			iface.Tunnel = &config.Tunnel{Source: ic.Tunnel.Source, Destination: ic.Tunnel.Destination}
+		}
+		if ic.HoldTime != nil {
+			iface.HoldTime = &config.HoldTime{Up: ic.HoldTime.Up, Down: ic.HoldTime.Down}
+		}
+		if opts := ic.GigEtherOptions; opts != nil {
+			iface.GigEtherOptions = &config.GigEtherOptions{
+				RxQueues:    opts.RxQueues,
+				TxQueues:    opts.TxQueues,
+				RxPlacement: maps.Clone(opts.RxPlacement),
+			}
+		}
+		iface.ApplyGroups = append([]string(nil), ic.ApplyGroups...)
 		for unitNum, u := range ic.Units {
 			unit := iface.GetOrCreateUnit(unitNum)
 			for familyName, af := range u.Family {
 				family := unit.GetOrCreateFamily(familyName)
 				family.Addresses = append(family.Addresses, af.Addresses...)
+				family.MTU = af.MTU
+				family.RouterAdvertisement = routerAdvertisementToLegacy(af.RouterAdvertisement)
+				if len(af.Neighbors) > 0 {
+					family.Neighbors = make(map[string]string, len(af.Neighbors))
+					for ip, mac := range af.Neighbors {
+						family.Neighbors[ip] = mac
+					}
+				}
 			}
 		}
 	}
@@ -486,8 +695,9 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 	// Routing
 	if c.Routing != nil {
 		old.RoutingOptions = &config.RoutingOptions{
-			AutonomousSystem: c.Routing.AutonomousSystem,
-			RouterID:         c.Routing.RouterID,
+			AutonomousSystem:       c.Routing.AutonomousSystem,
+			RouterID:               c.Routing.RouterID,
+			AdministrativeDistance: administrativeDistanceToLegacy(c.Routing.AdministrativeDistance),
 		}
 		for _, sr := range c.Routing.StaticRoutes {
 			old.RoutingOptions.StaticRoutes = append(old.RoutingOptions.StaticRoutes, &config.StaticRoute{
@@ -510,23 +720,33 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 		}
 		if c.Protocols.BGP != nil {
 			old.Protocols.BGP = &config.BGPConfig{
-				Groups: make(map[string]*config.BGPGroup),
+				RouterID: c.Protocols.BGP.RouterID,
+				Groups:   make(map[string]*config.BGPGroup),
 			}
 			for gName, g := range c.Protocols.BGP.Groups {
 				bg := &config.BGPGroup{
-					Type:      g.Type,
-					Import:    g.Import,
-					Export:    g.Export,
-					Neighbors: make(map[string]*config.BGPNeighbor),
+					Type:            g.Type,
+					Import:          g.Import,
+					Export:          g.Export,
+					Cluster:         g.Cluster,
+					NextHopSelf:     g.NextHopSelf,
+					RemovePrivateAS: g.RemovePrivateAS,
+					Neighbors:       make(map[string]*config.BGPNeighbor),
 				}
 				for ip, n := range g.Neighbors {
 					bg.Neighbors[ip] = &config.BGPNeighbor{
-						IP:           ip,
-						PeerAS:       n.PeerAS,
-						Description:  n.Description,
-						LocalAddress: n.LocalAddress,
-						BFD:          n.BFD,
-						BFDProfile:   n.BFDProfile,
+						IP:                   ip,
+						PeerAS:               n.PeerAS,
+						Description:          n.Description,
+						LocalAddress:         n.LocalAddress,
+						UpdateSource:         n.UpdateSource,
+						BFD:                  n.BFD,
+						BFDProfile:           n.BFDProfile,
+						Cluster:              n.Cluster,
+						RouteReflectorClient: n.RouteReflectorClient,
+						Shutdown:             n.Shutdown,
+						NextHopSelf:          n.NextHopSelf,
+						RemovePrivateAS:      n.RemovePrivateAS,
 					}
 				}
 				old.Protocols.BGP.Groups[gName] = bg
@@ -544,6 +764,9 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 		if c.Protocols.MPLS != nil {
 			old.Protocols.MPLS = &config.MPLSConfig{Interfaces: append([]string{}, c.Protocols.MPLS.Interfaces...)}
 		}
+		if c.Protocols.RIP != nil {
+			old.Protocols.RIP = ripToLegacy(c.Protocols.RIP)
+		}
 		if c.Protocols.VRRP != nil {
 			old.Protocols.VRRP = &config.VRRPConfig{Groups: make(map[string]*config.VRRPGroup)}
 			for name, group := range c.Protocols.VRRP.Groups {
@@ -604,6 +827,17 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 						Neighbor:    t.From.Neighbor,
 						ASPath:      t.From.ASPath,
 					}
+					for _, f := range t.From.RouteFilters {
+						if f == nil {
+							continue
+						}
+						term.From.RouteFilters = append(term.From.RouteFilters, &config.RouteFilter{
+							Prefix:    f.Prefix,
+							MatchType: f.MatchType,
+							MinLength: f.MinLength,
+							MaxLength: f.MaxLength,
+						})
+					}
 				}
 				if t.Then != nil {
 					term.Then = &config.PolicyActions{
@@ -628,6 +862,7 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 					Enabled:       c.Security.NETCONF.SSH.Enabled,
 					EnabledSet:    c.Security.NETCONF.SSH.EnabledSet,
 					ListenAddress: c.Security.NETCONF.SSH.ListenAddress,
+					Listen:        append([]string(nil), c.Security.NETCONF.SSH.Listen...),
 					Port:          c.Security.NETCONF.SSH.Port,
 				}
 			}
@@ -640,6 +875,7 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 					Password: u.Password,
 					Role:     u.Role,
 					SSHKey:   u.SSHKey,
+					Disabled: u.Disabled,
 				}
 			}
 		}
@@ -684,9 +920,82 @@ func (c *RouterConfig) ToLegacyConfig() *config.Config {
 		}
 	}
 
+	if c.Firewall != nil {
+		old.Firewall = &config.FirewallConfig{Policers: make(map[string]*config.Policer)}
+		for name, policer := range c.Firewall.Policers {
+			if policer == nil {
+				continue
+			}
+			old.Firewall.Policers[name] = &config.Policer{
+				Name:           name,
+				BandwidthLimit: policer.BandwidthLimit,
+				BurstSizeLimit: policer.BurstSizeLimit,
+				Action:         policer.Action,
+			}
+		}
+	}
+
+	old.Inactive = append([]string(nil), c.Inactive...)
+	if c.Groups != nil {
+		old.Groups = make(map[string]*config.Config, len(c.Groups))
+		for name, group := range c.Groups {
+			old.Groups[name] = group.ToLegacyConfig()
+		}
+	}
+
 	return old
 }
 
+// ActiveConfig returns the configuration with applied groups expanded and
+// deactivated subtrees removed. The receiver is returned as-is when nothing
+// is deactivated and no group is applied.
+func (c *RouterConfig) ActiveConfig() (*RouterConfig, error) {
+	if c == nil || (len(c.Inactive) == 0 && !c.appliesGroups()) {
+		return c, nil
+	}
+	active, err := c.ToLegacyConfig().ActiveConfig()
+	if err != nil {
+		return nil, err
+	}
+	return FromLegacyConfig(active), nil
+}
+
+// appliesGroups reports whether any interface applies a configuration group.
+func (c *RouterConfig) appliesGroups() bool {
+	for _, iface := range c.Interfaces {
+		if iface != nil && len(iface.ApplyGroups) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func routerAdvertisementToLegacy(c *RouterAdvertisement) *config.RouterAdvertisement {
+	if c == nil {
+		return nil
+	}
+	ra := &config.RouterAdvertisement{
+		ManagedConfiguration:       c.ManagedConfiguration,
+		OtherStatefulConfiguration: c.OtherStatefulConfiguration,
+		MaxAdvertisementInterval:   c.MaxAdvertisementInterval,
+		MinAdvertisementInterval:   c.MinAdvertisementInterval,
+	}
+	if c.Prefixes != nil {
+		ra.Prefixes = make(map[string]*config.RouterAdvertisementPrefix, len(c.Prefixes))
+		for prefix, lifetimes := range c.Prefixes {
+			if lifetimes == nil {
+				ra.Prefixes[prefix] = nil
+				continue
+			}
+			ra.Prefixes[prefix] = &config.RouterAdvertisementPrefix{
+				ValidLifetime:     lifetimes.ValidLifetime,
+				PreferredLifetime: lifetimes.PreferredLifetime,
+			}
+		}
+	}
+	return ra
+}
+
 func evpnToLegacy(c *EVPNConfig) *config.EVPNConfig {
 	if c == nil {
 		return nil
@@ -724,8 +1033,9 @@ func ospfToLegacy(c *OSPFConfig) *config.OSPFConfig {
 		return nil
 	}
 	ospf := &config.OSPFConfig{
-		RouterID: c.RouterID,
-		Areas:    make(map[string]*config.OSPFArea),
+		RouterID:           c.RouterID,
+		ReferenceBandwidth: c.ReferenceBandwidth,
+		Areas:              make(map[string]*config.OSPFArea),
 	}
 	for aID, a := range c.Areas {
 		if a == nil {
@@ -744,13 +1054,16 @@ func ospfToLegacy(c *OSPFConfig) *config.OSPFConfig {
 			oi := &config.OSPFInterface{
 				Name:       iName,
 				Passive:    i.Passive,
-				Metric:     i.Metric,
 				BFD:        i.BFD,
 				BFDProfile: i.BFDProfile,
 			}
+			if i.Metric != nil {
+				metric := *i.Metric
+				oi.Metric = &metric
+			}
 			if i.Priority != nil {
-				oi.Priority = *i.Priority
-				oi.PrioritySet = true
+				priority := *i.Priority
+				oi.Priority = &priority
 			}
 			area.Interfaces[iName] = oi
 		}
@@ -759,6 +1072,54 @@ func ospfToLegacy(c *OSPFConfig) *config.OSPFConfig {
 	return ospf
 }
 
+func ripToLegacy(c *RIPConfig) *config.RIPConfig {
+	if c == nil {
+		return nil
+	}
+	rip := &config.RIPConfig{
+		UpdateInterval: c.UpdateInterval,
+		RouteTimeout:   c.RouteTimeout,
+		Holddown:       c.Holddown,
+		DefaultMetric:  c.DefaultMetric,
+		Groups:         make(map[string]*config.RIPGroup),
+	}
+	for gName, g := range c.Groups {
+		if g == nil {
+			rip.Groups[gName] = nil
+			continue
+		}
+		group := &config.RIPGroup{Name: gName, Neighbors: make(map[string]*config.RIPNeighbor)}
+		for ifName, n := range g.Neighbors {
+			if n == nil {
+				group.Neighbors[ifName] = nil
+				continue
+			}
+			group.Neighbors[ifName] = &config.RIPNeighbor{Name: ifName, Passive: n.Passive}
+		}
+		rip.Groups[gName] = group
+	}
+	return rip
+}
+
+func administrativeDistanceToLegacy(c *AdministrativeDistance) *config.AdministrativeDistance {
+	if c == nil {
+		return nil
+	}
+	ad := &config.AdministrativeDistance{}
+	if c.BGP != nil {
+		ad.BGP = &config.BGPDistance{External: c.BGP.External, Internal: c.BGP.Internal, Local: c.BGP.Local}
+	}
+	if c.OSPF != nil {
+		ospf := config.OSPFDistance(*c.OSPF)
+		ad.OSPF = &ospf
+	}
+	if c.OSPF3 != nil {
+		ospf3 := config.OSPFDistance(*c.OSPF3)
+		ad.OSPF3 = &ospf3
+	}
+	return ad
+}
+
 func bfdToLegacy(c *BFDConfig) *config.BFDConfig {
 	if c == nil {
 		return nil
@@ -1,12 +1,14 @@
 package model
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/akam1o/arca-router/pkg/config"
 )
 
 func TestOSPF3LegacyConversion(t *testing.T) {
+	metric := 20
 	priority := 0
 	cfg := FromLegacyConfig(&config.Config{
 		Protocols: &config.ProtocolConfig{
@@ -17,10 +19,9 @@ func TestOSPF3LegacyConversion(t *testing.T) {
 						AreaID: "0.0.0.0",
 						Interfaces: map[string]*config.OSPFInterface{
 							"ge-0/0/0": {
-								Name:        "ge-0/0/0",
-								Metric:      20,
-								Priority:    priority,
-								PrioritySet: true,
+								Name:     "ge-0/0/0",
+								Metric:   &metric,
+								Priority: &priority,
 							},
 						},
 					},
@@ -39,7 +40,7 @@ func TestOSPF3LegacyConversion(t *testing.T) {
 
 	legacy := cfg.ToLegacyConfig()
 	ospfIface := legacy.Protocols.OSPF3.Areas["0.0.0.0"].Interfaces["ge-0/0/0"]
-	if !ospfIface.PrioritySet || ospfIface.Priority != 0 {
+	if ospfIface.Priority == nil || *ospfIface.Priority != 0 {
 		t.Fatalf("legacy OSPF3 interface = %#v, want explicit priority 0", ospfIface)
 	}
 }
@@ -59,3 +60,71 @@ func TestResolveRouterIDOSPF3(t *testing.T) {
 		t.Fatalf("ResolveRouterID(ospf3 fallback) = %q, want 10.0.1.1", got)
 	}
 }
+
+func TestResolveRouterIDBGPPrecedence(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Routing = &RoutingConfig{RouterID: "10.0.1.1"}
+	cfg.Protocols = &ProtocolsConfig{
+		BGP:   &BGPConfig{RouterID: "192.0.2.1"},
+		OSPF3: &OSPFConfig{},
+	}
+
+	if got := cfg.ResolveRouterID("bgp"); got != "192.0.2.1" {
+		t.Fatalf("ResolveRouterID(bgp) = %q, want 192.0.2.1", got)
+	}
+	if got := cfg.ResolveRouterID("ospf3"); got != "10.0.1.1" {
+		t.Fatalf("ResolveRouterID(ospf3) = %q, want routing-options 10.0.1.1", got)
+	}
+
+	cfg.Routing.RouterID = ""
+	if got := cfg.ResolveRouterID("ospf3"); got != "192.0.2.1" {
+		t.Fatalf("ResolveRouterID(ospf3 derived) = %q, want BGP 192.0.2.1", got)
+	}
+	if got := cfg.ResolveRouterID("ospf"); got != "" {
+		t.Fatalf("ResolveRouterID(ospf) = %q, want empty", got)
+	}
+}
+
+func TestResolveRouterIDAuto(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Routing = &RoutingConfig{AutonomousSystem: 65000, RouterID: config.RouterIDAuto}
+	cfg.Protocols = &ProtocolsConfig{BGP: &BGPConfig{}}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "router-id auto") {
+		t.Fatalf("Validate() without lo0 error = %v, want router-id auto error", err)
+	}
+
+	cfg.Interfaces["lo0"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"10.255.0.1/32"}}}},
+	}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if got := cfg.ResolveRouterID("bgp"); got != "10.255.0.1" {
+		t.Fatalf("ResolveRouterID(bgp) = %q, want lo0 address 10.255.0.1", got)
+	}
+	cfg.Protocols.BGP.RouterID = "192.0.2.1"
+	if got := cfg.ResolveRouterID("bgp"); got != "192.0.2.1" {
+		t.Fatalf("ResolveRouterID(bgp) = %q, want explicit 192.0.2.1", got)
+	}
+}
+
+func TestValidateOSPF3RequiresInet6Address(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+	}}
+	cfg.Protocols = &ProtocolsConfig{
+		OSPF3: &OSPFConfig{Areas: map[string]*OSPFArea{
+			"0.0.0.0": {Interfaces: map[string]*OSPFInterface{"ge-0/0/0": {}}},
+		}},
+	}
+
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "no inet6 address") {
+		t.Fatalf("Validate() error = %v, want missing inet6 address error", err)
+	}
+
+	cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"] = &AddressFamily{Addresses: []string{"2001:db8::1/64"}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+}
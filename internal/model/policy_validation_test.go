@@ -35,6 +35,36 @@ func TestValidatePolicyAcceptsIPv6PrefixListReference(t *testing.T) {
 	}
 }
 
+func TestValidatePolicyCommunityForms(t *testing.T) {
+	tests := []struct {
+		community string
+		wantErr   bool
+	}{
+		{"65000:100", false},
+		{"65000:1:2", false},
+		{"target:65000:100", false},
+		{"65536:1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.community, func(t *testing.T) {
+			cfg := NewRouterConfig()
+			cfg.Policy = &PolicyConfig{
+				PolicyStatements: map[string]*PolicyStatement{
+					"TAG": {
+						Terms: []*PolicyTerm{
+							{Name: "SET", Then: &PolicyActions{Community: tt.community}},
+						},
+					},
+				},
+			}
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidatePolicyRejectsUnknownPrefixListReference(t *testing.T) {
 	cfg := NewRouterConfig()
 	cfg.Policy = &PolicyConfig{
@@ -14,12 +14,14 @@ type OperationalState struct {
 // InterfaceState holds live interface counters and status.
 type InterfaceState struct {
 	Name        string             `json:"name"`
-	AdminStatus string             `json:"admin-status"` // "up" | "down"
-	OperStatus  string             `json:"oper-status"`  // "up" | "down"
+	IfIndex     uint32             `json:"if-index,omitempty"` // stable logical index, persisted across restarts
+	AdminStatus string             `json:"admin-status"`       // "up" | "down"
+	OperStatus  string             `json:"oper-status"`        // "up" | "down"
 	Speed       uint64             `json:"speed,omitempty"`
 	MTU         uint32             `json:"mtu,omitempty"`
 	MAC         string             `json:"mac,omitempty"`
 	QoSProfile  string             `json:"qos-profile,omitempty"`
+	Addresses   []string           `json:"addresses,omitempty"` // CIDR prefixes programmed in the dataplane
 	IPv4TableID uint32             `json:"ipv4-table-id,omitempty"`
 	IPv6TableID uint32             `json:"ipv6-table-id,omitempty"`
 	Counters    *InterfaceCounters `json:"counters,omitempty"`
@@ -3,11 +3,15 @@ package model
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	pkgauth "github.com/akam1o/arca-router/pkg/auth"
+	"github.com/akam1o/arca-router/pkg/config"
 	"github.com/akam1o/arca-router/pkg/security"
 )
 
@@ -20,6 +24,19 @@ func (c *RouterConfig) Validate() error {
 	if c == nil {
 		return fmt.Errorf("configuration is nil")
 	}
+	if len(c.Inactive) > 0 || c.appliesGroups() {
+		// Deactivated statements are not validated and inherited group
+		// statements are validated where they apply, matching how the
+		// configuration is applied.
+		if err := c.validateGroupReferences(); err != nil {
+			return err
+		}
+		active, err := c.ActiveConfig()
+		if err != nil {
+			return err
+		}
+		return active.Validate()
+	}
 
 	if err := c.validateSystem(); err != nil {
 		return err
@@ -45,6 +62,9 @@ func (c *RouterConfig) Validate() error {
 	if err := c.validateClassOfService(); err != nil {
 		return err
 	}
+	if err := c.validateFirewall(); err != nil {
+		return err
+	}
 	if err := c.validateSecurity(); err != nil {
 		return err
 	}
@@ -95,6 +115,56 @@ func (c *RouterConfig) validateInterfaces() error {
 		if iface == nil {
 			return fmt.Errorf("interface %s is nil", name)
 		}
+		if !utf8.ValidString(iface.Description) {
+			return fmt.Errorf("interface %s: description is not valid UTF-8", name)
+		}
+		if n := utf8.RuneCountInString(iface.Description); n > config.MaxInterfaceDescriptionLength {
+			return fmt.Errorf("interface %s: description is %d characters, maximum is %d",
+				name, n, config.MaxInterfaceDescriptionLength)
+		}
+		if iface.RxMode != "" && !config.ValidInterfaceRxMode(iface.RxMode) {
+			return fmt.Errorf("interface %s: invalid rx-mode %q: must be polling, interrupt, or adaptive", name, iface.RxMode)
+		}
+		if iface.MTU != 0 && (iface.MTU < config.MinInterfaceMTU || iface.MTU > config.MaxInterfaceMTU) {
+			return fmt.Errorf("interface %s: mtu must be %d-%d, got %d", name, config.MinInterfaceMTU, config.MaxInterfaceMTU, iface.MTU)
+		}
+		if iface.Bandwidth != 0 && (iface.Bandwidth < config.MinInterfaceBandwidth || iface.Bandwidth > config.MaxInterfaceBandwidth) {
+			return fmt.Errorf("interface %s: bandwidth must be %s-%s bits per second, got %d", name,
+				config.FormatUnitValue(config.MinInterfaceBandwidth), config.FormatUnitValue(config.MaxInterfaceBandwidth), iface.Bandwidth)
+		}
+		if config.TunnelInterfaceType(name) == "" {
+			if iface.Tunnel != nil {
+				return fmt.Errorf("interface %s: tunnel is only valid on gr- and ip- interfaces", name)
+			}
+		} else {
+			var source, destination string
+			if iface.Tunnel != nil {
+				source, destination = iface.Tunnel.Source, iface.Tunnel.Destination
+			}
+			if err := config.CheckTunnelEndpoints(source, destination); err != nil {
+				return fmt.Errorf("interface %s: %w", name, err)
+			}
+		}
+		if iface.HoldTime != nil {
+			if err := config.CheckHoldTime(iface.HoldTime.Up, iface.HoldTime.Down); err != nil {
+				return fmt.Errorf("interface %s: %w", name, err)
+			}
+		}
+		if opts := iface.GigEtherOptions; opts != nil {
+			if config.TunnelInterfaceType(name) != "" {
+				return fmt.Errorf("interface %s: gigether-options are only valid on physical interfaces", name)
+			}
+			if err := config.CheckGigEtherOptions(opts.RxQueues, opts.TxQueues, opts.RxPlacement); err != nil {
+				return fmt.Errorf("interface %s: %w", name, err)
+			}
+		}
+		// An unset link MTU leaves the VPP default in place.
+		linkMTU := iface.MTU
+		if linkMTU == 0 {
+			linkMTU = config.DefaultInterfaceMTU
+		}
+		familyMTU := make(map[string]int)
+		raUnit := -1
 		for unitNum, unit := range iface.Units {
 			if unitNum < 0 {
 				return fmt.Errorf("interface %s: unit number must be non-negative, got %d", name, unitNum)
@@ -109,12 +179,101 @@ func (c *RouterConfig) validateInterfaces() error {
 				if family == nil {
 					return fmt.Errorf("interface %s unit %d family %s is nil", name, unitNum, familyName)
 				}
+				if family.MTU != 0 {
+					if family.MTU < config.MinFamilyMTU(familyName) || family.MTU > linkMTU {
+						return fmt.Errorf("interface %s unit %d family %s: mtu %d must be between %d and the interface MTU %d",
+							name, unitNum, familyName, family.MTU, config.MinFamilyMTU(familyName), linkMTU)
+					}
+					if other, ok := familyMTU[familyName]; ok && other != family.MTU {
+						return fmt.Errorf("interface %s family %s: mtu differs between units: %d and %d", name, familyName, other, family.MTU)
+					}
+					familyMTU[familyName] = family.MTU
+				}
+				if family.RouterAdvertisement != nil {
+					if familyName != "inet6" {
+						return fmt.Errorf("interface %s unit %d family %s: router-advertisement is only supported for inet6", name, unitNum, familyName)
+					}
+					if raUnit >= 0 {
+						return fmt.Errorf("interface %s: router-advertisement configured on units %d and %d", name, min(raUnit, unitNum), max(raUnit, unitNum))
+					}
+					raUnit = unitNum
+					if err := routerAdvertisementToLegacy(family.RouterAdvertisement).Check(); err != nil {
+						return fmt.Errorf("interface %s unit %d family inet6: router-advertisement: %w", name, unitNum, err)
+					}
+				}
 				for _, addr := range family.Addresses {
 					if _, _, err := net.ParseCIDR(addr); err != nil {
 						return fmt.Errorf("interface %s unit %d family %s: invalid address %q: %w",
 							name, unitNum, familyName, addr, err)
 					}
 				}
+				for ip, mac := range family.Neighbors {
+					if net.ParseIP(ip) == nil {
+						return fmt.Errorf("interface %s unit %d family %s: invalid neighbor address %q",
+							name, unitNum, familyName, ip)
+					}
+					if hw, err := net.ParseMAC(mac); err != nil || len(hw) != 6 {
+						return fmt.Errorf("interface %s unit %d family %s: invalid MAC %q for neighbor %s",
+							name, unitNum, familyName, mac, ip)
+					}
+				}
+			}
+		}
+	}
+	return c.validateInterfaceAddressUniqueness()
+}
+
+// validateInterfaceAddressUniqueness rejects the same IP address assigned to
+// more than one interface unit of one routing instance, which VPP refuses at
+// apply time.
+func (c *RouterConfig) validateInterfaceAddressUniqueness() error {
+	var addrs []config.InterfaceAddress
+	names := make([]string, 0, len(c.Interfaces))
+	for name := range c.Interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		iface := c.Interfaces[name]
+		unitNums := make([]int, 0, len(iface.Units))
+		for unitNum := range iface.Units {
+			unitNums = append(unitNums, unitNum)
+		}
+		sort.Ints(unitNums)
+		for _, unitNum := range unitNums {
+			unit := iface.Units[unitNum]
+			familyNames := make([]string, 0, len(unit.Family))
+			for familyName := range unit.Family {
+				familyNames = append(familyNames, familyName)
+			}
+			sort.Strings(familyNames)
+			for _, familyName := range familyNames {
+				for _, addr := range unit.Family[familyName].Addresses {
+					addrs = append(addrs, config.InterfaceAddress{Interface: name, Unit: unitNum, Family: familyName, Address: addr})
+				}
+			}
+		}
+	}
+	instanceInterfaces := make(map[string][]string)
+	for name, instance := range c.RoutingInstances {
+		if instance != nil {
+			instanceInterfaces[name] = instance.Interfaces
+		}
+	}
+	if dup := config.FindDuplicateInterfaceAddress(addrs, instanceInterfaces); dup != nil {
+		return fmt.Errorf("duplicate %s", dup)
+	}
+	return nil
+}
+
+func (c *RouterConfig) validateGroupReferences() error {
+	for name, iface := range c.Interfaces {
+		if iface == nil {
+			continue
+		}
+		for _, group := range iface.ApplyGroups {
+			if _, ok := c.Groups[group]; !ok {
+				return fmt.Errorf("interface %s: apply-groups references undefined group %s", name, group)
 			}
 		}
 	}
@@ -125,11 +284,21 @@ func (c *RouterConfig) validateRouting() error {
 	if c.Routing == nil {
 		return nil
 	}
-	if c.Routing.RouterID != "" {
+	if c.Routing.RouterID == config.RouterIDAuto {
+		if c.loopbackRouterID() == "" {
+			return fmt.Errorf("routing-options: router-id auto found no /32 inet address on %s unit 0", config.LoopbackInterface)
+		}
+	} else if c.Routing.RouterID != "" {
 		if net.ParseIP(c.Routing.RouterID) == nil {
 			return fmt.Errorf("routing-options: invalid router-id %q", c.Routing.RouterID)
 		}
 	}
+	if ad := c.Routing.AdministrativeDistance; ad != nil {
+		legacy := &config.RoutingOptions{AdministrativeDistance: administrativeDistanceToLegacy(ad)}
+		if err := legacy.Validate(); err != nil {
+			return fmt.Errorf("routing-options: %w", err)
+		}
+	}
 	for _, route := range c.Routing.StaticRoutes {
 		if route == nil {
 			return fmt.Errorf("static route entry is nil")
@@ -301,6 +470,11 @@ func (c *RouterConfig) validateProtocols() error {
 			}
 		}
 	}
+	if rip := c.Protocols.RIP; rip != nil {
+		if err := c.validateRIP(rip); err != nil {
+			return err
+		}
+	}
 	if vrrp := c.Protocols.VRRP; vrrp != nil {
 		for name, group := range vrrp.Groups {
 			if group == nil {
@@ -490,6 +664,9 @@ func (c *RouterConfig) validateOSPF(protocol string, ospf *OSPFConfig) error {
 			if err := c.validateInterfaceReference(fmt.Sprintf("%s area %s", protocol, areaName), ifName); err != nil {
 				return err
 			}
+			if protocol == "ospf3" && !c.Interfaces[ifName].hasFamilyAddress("inet6") {
+				return fmt.Errorf("%s area %s: interface %q has no inet6 address", protocol, areaName, ifName)
+			}
 			if area.Interfaces[ifName] != nil && area.Interfaces[ifName].BFDProfile != "" {
 				if err := c.validateBFDProfileReference(fmt.Sprintf("%s area %s interface %s", protocol, areaName, ifName), area.Interfaces[ifName].BFDProfile); err != nil {
 					return err
@@ -497,6 +674,65 @@ func (c *RouterConfig) validateOSPF(protocol string, ospf *OSPFConfig) error {
 			}
 		}
 	}
+	return validateOSPFInterfaceAreas(protocol, ospf)
+}
+
+// validateOSPFInterfaceAreas rejects an interface assigned to more than one
+// area; FRR binds each interface to a single area.
+func validateOSPFInterfaceAreas(protocol string, ospf *OSPFConfig) error {
+	areaNames := make([]string, 0, len(ospf.Areas))
+	for areaName := range ospf.Areas {
+		areaNames = append(areaNames, areaName)
+	}
+	sort.Strings(areaNames)
+	assigned := make(map[string]string)
+	for _, areaName := range areaNames {
+		ifNames := make([]string, 0, len(ospf.Areas[areaName].Interfaces))
+		for ifName := range ospf.Areas[areaName].Interfaces {
+			ifNames = append(ifNames, ifName)
+		}
+		sort.Strings(ifNames)
+		for _, ifName := range ifNames {
+			if first, ok := assigned[ifName]; ok {
+				return fmt.Errorf("%s: interface %q is assigned to both area %s and area %s", protocol, ifName, first, areaName)
+			}
+			assigned[ifName] = areaName
+		}
+	}
+	return nil
+}
+
+func (c *RouterConfig) validateRIP(rip *RIPConfig) error {
+	for name, value := range map[string]int{
+		"update-interval": rip.UpdateInterval,
+		"route-timeout":   rip.RouteTimeout,
+		"holddown":        rip.Holddown,
+	} {
+		if value != 0 && (value < 5 || value > 65535) {
+			return fmt.Errorf("rip: %s must be 5-65535, got %d", name, value)
+		}
+	}
+	if rip.DefaultMetric < 0 || rip.DefaultMetric > 16 {
+		return fmt.Errorf("rip: default-metric must be 1-16, got %d", rip.DefaultMetric)
+	}
+	owner := make(map[string]string)
+	for groupName, group := range rip.Groups {
+		if group == nil {
+			return fmt.Errorf("rip group %s is nil", groupName)
+		}
+		for ifName := range group.Neighbors {
+			if err := c.validateInterfaceReference(fmt.Sprintf("rip group %s", groupName), ifName); err != nil {
+				return err
+			}
+			if other, ok := owner[ifName]; ok {
+				return fmt.Errorf("rip group %s: interface %q is already in group %s", groupName, ifName, other)
+			}
+			owner[ifName] = groupName
+			if !c.Interfaces[ifName].hasFamilyAddress("inet") {
+				return fmt.Errorf("rip group %s: interface %q has no inet address", groupName, ifName)
+			}
+		}
+	}
 	return nil
 }
 
@@ -505,6 +741,9 @@ func (c *RouterConfig) validateBGP(bgp *BGPConfig) error {
 	if c.Routing == nil || c.Routing.AutonomousSystem == 0 {
 		return fmt.Errorf("bgp: routing-options autonomous-system is required")
 	}
+	if bgp.RouterID != "" && !isIPv4Literal(bgp.RouterID) {
+		return fmt.Errorf("bgp: router-id must be an IPv4 address, got %q", bgp.RouterID)
+	}
 	for groupName, group := range bgp.Groups {
 		if group == nil {
 			return fmt.Errorf("bgp group %s is nil", groupName)
@@ -523,11 +762,30 @@ func (c *RouterConfig) validateBGP(bgp *BGPConfig) error {
 			if neighbor.PeerAS == 0 {
 				return fmt.Errorf("bgp group %s neighbor %s: peer-as is required", groupName, ip)
 			}
+			if neighbor.LocalAddress != "" {
+				if err := c.validateBGPLocalAddress(groupName, ip, neighbor.LocalAddress); err != nil {
+					return err
+				}
+			}
+			if neighbor.UpdateSource != "" {
+				if err := c.validateBGPUpdateSource(groupName, ip, neighbor); err != nil {
+					return err
+				}
+			}
 			if neighbor.BFDProfile != "" {
 				if err := c.validateBFDProfileReference(fmt.Sprintf("bgp group %s neighbor %s", groupName, ip), neighbor.BFDProfile); err != nil {
 					return err
 				}
 			}
+			if neighbor.Cluster != "" && !isIPv4Literal(neighbor.Cluster) {
+				return fmt.Errorf("bgp group %s neighbor %s: cluster %q must be an IPv4 address", groupName, ip, neighbor.Cluster)
+			}
+			if (neighbor.RouteReflectorClient || neighbor.Cluster != "" || group.Cluster != "") && group.Type != "internal" {
+				return fmt.Errorf("bgp group %s neighbor %s: route-reflector clients require an internal group", groupName, ip)
+			}
+		}
+		if group.Cluster != "" && !isIPv4Literal(group.Cluster) {
+			return fmt.Errorf("bgp group %s: cluster %q must be an IPv4 address", groupName, group.Cluster)
 		}
 		if group.Import != "" {
 			if err := c.validatePolicyStatementReference(fmt.Sprintf("bgp group %s import", groupName), group.Import); err != nil {
@@ -543,6 +801,59 @@ func (c *RouterConfig) validateBGP(bgp *BGPConfig) error {
 	return nil
 }
 
+// validateBGPLocalAddress requires an IPv6 link-local local-address to name
+// the interface it is used on as a zone, for example fe80::1%ge-0/0/0.
+func (c *RouterConfig) validateBGPLocalAddress(groupName, ip, localAddress string) error {
+	addr, err := netip.ParseAddr(localAddress)
+	if err != nil {
+		return fmt.Errorf("bgp group %s neighbor %s: invalid local-address %q", groupName, ip, localAddress)
+	}
+	if !addr.Is6() || !addr.IsLinkLocalUnicast() {
+		if addr.Zone() != "" {
+			return fmt.Errorf("bgp group %s neighbor %s: only a link-local local-address may name an interface, got %q", groupName, ip, localAddress)
+		}
+		return nil
+	}
+	if addr.Zone() == "" {
+		return fmt.Errorf("bgp group %s neighbor %s: link-local local-address %s requires an interface, for example %s%%ge-0/0/0", groupName, ip, localAddress, localAddress)
+	}
+	return c.validateInterfaceReference(fmt.Sprintf("bgp group %s neighbor %s local-address", groupName, ip), addr.Zone())
+}
+
+// validateBGPUpdateSource requires a neighbor's update-source to name a
+// configured interface or an address configured on one.
+func (c *RouterConfig) validateBGPUpdateSource(groupName, ip string, neighbor *BGPNeighbor) error {
+	context := fmt.Sprintf("bgp group %s neighbor %s update-source", groupName, ip)
+	if neighbor.LocalAddress != "" {
+		return fmt.Errorf("bgp group %s neighbor %s: local-address and update-source are mutually exclusive", groupName, ip)
+	}
+	source := net.ParseIP(neighbor.UpdateSource)
+	if source == nil {
+		return c.validateInterfaceReference(context, neighbor.UpdateSource)
+	}
+	for _, iface := range c.Interfaces {
+		if iface == nil {
+			continue
+		}
+		for _, unit := range iface.Units {
+			if unit == nil {
+				continue
+			}
+			for _, family := range unit.Family {
+				if family == nil {
+					continue
+				}
+				for _, addr := range family.Addresses {
+					if ip, _, err := net.ParseCIDR(addr); err == nil && ip.Equal(source) {
+						return nil
+					}
+				}
+			}
+		}
+	}
+	return fmt.Errorf("%s: address %s is not configured on any interface", context, neighbor.UpdateSource)
+}
+
 func (c *RouterConfig) validateBFDProfileReference(context, profileName string) error {
 	if strings.TrimSpace(profileName) == "" {
 		return fmt.Errorf("%s: empty BFD profile reference", context)
@@ -604,6 +915,15 @@ func (c *RouterConfig) validatePolicyStatement(name string, statement *PolicySta
 					return fmt.Errorf("policy-statement %s term %s: prefix-list %q not found in policy-options", name, term.Name, listName)
 				}
 			}
+			for _, filter := range term.From.RouteFilters {
+				if filter == nil {
+					return fmt.Errorf("policy-statement %s term %s: nil route-filter", name, term.Name)
+				}
+				routeFilter := config.RouteFilter{Prefix: filter.Prefix, MatchType: filter.MatchType, MinLength: filter.MinLength, MaxLength: filter.MaxLength}
+				if err := routeFilter.Validate(); err != nil {
+					return fmt.Errorf("policy-statement %s term %s: %w", name, term.Name, err)
+				}
+			}
 			if term.From.Protocol != "" && !isValidRoutePolicyProtocol(term.From.Protocol) {
 				return fmt.Errorf("policy-statement %s term %s: invalid protocol %q", name, term.Name, term.From.Protocol)
 			}
@@ -633,21 +953,7 @@ func isValidRoutePolicyProtocol(protocol string) bool {
 }
 
 func isValidPolicyCommunity(community string) bool {
-	switch community {
-	case "no-export", "no-advertise", "local-AS", "no-peer":
-		return true
-	default:
-		parts := strings.Split(community, ":")
-		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return false
-		}
-		asn, err := strconv.ParseUint(parts[0], 10, 32)
-		if err != nil || asn > 65535 {
-			return false
-		}
-		value, err := strconv.ParseUint(parts[1], 10, 32)
-		return err == nil && value <= 65535
-	}
+	return config.ValidateCommunity(community) == nil
 }
 
 func (c *RouterConfig) validateClassOfService() error {
@@ -678,6 +984,60 @@ func (c *RouterConfig) validateClassOfService() error {
 	return nil
 }
 
+func (c *RouterConfig) validateFirewall() error {
+	if c.Firewall != nil {
+		for name, policer := range c.Firewall.Policers {
+			if policer == nil {
+				return fmt.Errorf("firewall policer %s is nil", name)
+			}
+			if len(name) > config.MaxPolicerNameLength {
+				return fmt.Errorf("firewall policer %s: name exceeds %d characters", name, config.MaxPolicerNameLength)
+			}
+			if policer.BandwidthLimit < config.MinPolicerBandwidthLimit || policer.BandwidthLimit > config.MaxPolicerBandwidthLimit {
+				return fmt.Errorf("firewall policer %s: bandwidth-limit must be %s-%s bps, got %d",
+					name, config.FormatUnitValue(config.MinPolicerBandwidthLimit), config.FormatUnitValue(config.MaxPolicerBandwidthLimit), policer.BandwidthLimit)
+			}
+			if policer.BurstSizeLimit < config.MinPolicerBurstSizeLimit || policer.BurstSizeLimit > config.MaxPolicerBurstSizeLimit {
+				return fmt.Errorf("firewall policer %s: burst-size-limit must be %s-%s bytes, got %d",
+					name, config.FormatUnitValue(config.MinPolicerBurstSizeLimit), config.FormatUnitValue(config.MaxPolicerBurstSizeLimit), policer.BurstSizeLimit)
+			}
+			if policer.Action != "" && policer.Action != config.PolicerActionDiscard {
+				return fmt.Errorf("firewall policer %s: unsupported action %q: must be discard", name, policer.Action)
+			}
+		}
+	}
+	for name, iface := range c.Interfaces {
+		if iface == nil {
+			continue
+		}
+		for direction, policer := range map[string]string{"input": iface.InputPolicer, "output": iface.OutputPolicer} {
+			if policer == "" {
+				continue
+			}
+			if c.Firewall == nil || c.Firewall.Policers[policer] == nil {
+				return fmt.Errorf("interface %s: %s policer %q not found", name, direction, policer)
+			}
+		}
+	}
+	return nil
+}
+
+// hasFamilyAddress reports whether any unit carries an address in family.
+func (iface *InterfaceConfig) hasFamilyAddress(family string) bool {
+	if iface == nil {
+		return false
+	}
+	for _, unit := range iface.Units {
+		if unit == nil {
+			continue
+		}
+		if fam := unit.Family[family]; fam != nil && len(fam.Addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *RouterConfig) validateInterfaceReference(context, ifName string) error {
 	if !junosIfacePattern.MatchString(ifName) {
 		return fmt.Errorf("%s: invalid interface name %q", context, ifName)
@@ -716,6 +1076,11 @@ func (c *RouterConfig) validateSecurity() error {
 		if ssh.ListenAddress != "" && ssh.ListenAddress != "localhost" && net.ParseIP(ssh.ListenAddress) == nil {
 			return fmt.Errorf("security netconf ssh: invalid listen-address %q", ssh.ListenAddress)
 		}
+		for _, addr := range ssh.Listen {
+			if addr != "localhost" && net.ParseIP(addr) == nil {
+				return fmt.Errorf("security netconf ssh: invalid listen %q", addr)
+			}
+		}
 		port := ssh.Port
 		if port < 0 || port > 65535 {
 			return fmt.Errorf("security netconf ssh port must be 0-65535, got %d", port)
@@ -782,9 +1147,17 @@ func validateSecurityRateLimitValue(name string, value int) error {
 }
 
 // ResolveRouterID returns the effective router-id for a given protocol,
-// applying the Junos-style fallback: protocol-specific → global routing-options.
+// applying the Junos-style fallback: protocol-specific → global routing-options
+// (derived from lo0 with "router-id auto").
+// OSPFv3 additionally derives its router-id from the BGP router-id so that
+// IPv6-only deployments need not set routing-options router-id.
 func (c *RouterConfig) ResolveRouterID(protocol string) string {
-	switch strings.ToLower(protocol) {
+	protocol = strings.ToLower(protocol)
+	switch protocol {
+	case "bgp":
+		if c.Protocols != nil && c.Protocols.BGP != nil && c.Protocols.BGP.RouterID != "" {
+			return c.Protocols.BGP.RouterID
+		}
 	case "ospf":
 		if c.Protocols != nil && c.Protocols.OSPF != nil && c.Protocols.OSPF.RouterID != "" {
 			return c.Protocols.OSPF.RouterID
@@ -794,8 +1167,31 @@ func (c *RouterConfig) ResolveRouterID(protocol string) string {
 			return c.Protocols.OSPF3.RouterID
 		}
 	}
-	if c.Routing != nil && c.Routing.RouterID != "" {
+	if c.Routing != nil && c.Routing.RouterID == config.RouterIDAuto {
+		if routerID := c.loopbackRouterID(); routerID != "" {
+			return routerID
+		}
+	} else if c.Routing != nil && c.Routing.RouterID != "" {
 		return c.Routing.RouterID
 	}
+	if protocol == "ospf3" && c.Protocols != nil && c.Protocols.BGP != nil {
+		return c.Protocols.BGP.RouterID
+	}
 	return ""
 }
+
+// loopbackRouterID returns the router ID "router-id auto" derives from the
+// inet addresses of lo0 unit 0.
+func (c *RouterConfig) loopbackRouterID() string {
+	iface := c.Interfaces[config.LoopbackInterface]
+	if iface == nil || iface.Units[0] == nil || iface.Units[0].Family["inet"] == nil {
+		return ""
+	}
+	return config.LoopbackRouterID(iface.Units[0].Family["inet"].Addresses)
+}
+
+// isIPv4Literal reports whether value is a dotted-quad IPv4 address.
+func isIPv4Literal(value string) bool {
+	ip := net.ParseIP(value)
+	return ip != nil && ip.To4() != nil && !strings.Contains(value, ":")
+}
@@ -93,3 +93,154 @@ func TestValidateRejectsNilNestedConfigEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRejectsDuplicateInterfaceAddresses(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+	}}
+	cfg.Interfaces["ge-0/0/1"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+	}}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil, want duplicate address error")
+	}
+	want := "duplicate address 192.0.2.1 on interface ge-0/0/0 unit 0 family inet and interface ge-0/0/1 unit 0 family inet"
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("Validate() error = %v, want %q", err, want)
+	}
+}
+
+func TestValidateAllowsInterfaceAddressReuseAcrossRoutingInstances(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+	}}
+	cfg.Interfaces["ge-0/0/1"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+	}}
+	cfg.RoutingInstances = map[string]*RoutingInstance{
+		"RED": {InstanceType: "vrf", Interfaces: []string{"ge-0/0/1"}},
+	}
+
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want the address reused in routing-instance RED to be accepted", err)
+	}
+}
+
+func TestValidateAppliesInterfaceGroups(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Groups = map[string]*RouterConfig{
+		"GLOBAL-IFACE": {Interfaces: map[string]*InterfaceConfig{"<*>": {MTU: 9000}}},
+	}
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{ApplyGroups: []string{"GLOBAL-IFACE"}}
+	cfg.Interfaces["ge-0/0/1"] = &InterfaceConfig{ApplyGroups: []string{"GLOBAL-IFACE"}, MTU: 1500}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	active, err := cfg.ActiveConfig()
+	if err != nil {
+		t.Fatalf("ActiveConfig() error = %v", err)
+	}
+	if got := active.Interfaces["ge-0/0/0"].MTU; got != 9000 {
+		t.Errorf("ge-0/0/0 MTU = %d, want inherited 9000", got)
+	}
+	if got := active.Interfaces["ge-0/0/1"].MTU; got != 1500 {
+		t.Errorf("ge-0/0/1 MTU = %d, want explicit 1500", got)
+	}
+
+	cfg.Interfaces["ge-0/0/2"] = &InterfaceConfig{ApplyGroups: []string{"MISSING"}}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "undefined group MISSING") {
+		t.Fatalf("Validate() error = %v, want undefined group MISSING", err)
+	}
+}
+
+func TestValidateInterfaceDescriptionCountsCharacters(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{Description: strings.Repeat("界", 255)}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want 255-character description accepted", err)
+	}
+
+	cfg.Interfaces["ge-0/0/0"].Description += "界"
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "description is 256 characters, maximum is 255") {
+		t.Fatalf("Validate() error = %v, want description length error", err)
+	}
+}
+
+func TestValidateRejectsOSPFInterfaceInTwoAreas(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+	}}
+	cfg.Protocols = &ProtocolsConfig{OSPF: &OSPFConfig{Areas: map[string]*OSPFArea{
+		"0.0.0.0": {Interfaces: map[string]*OSPFInterface{"ge-0/0/0": {}}},
+		"0.0.0.1": {Interfaces: map[string]*OSPFInterface{"ge-0/0/0": {}}},
+	}}}
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), `ospf: interface "ge-0/0/0" is assigned to both area 0.0.0.0 and area 0.0.0.1`) {
+		t.Fatalf("Validate() error = %v, want duplicate area assignment", err)
+	}
+}
+
+func TestValidateRejectsIPMTUAboveInterfaceMTU(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{MTU: 1500, Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}, MTU: 1500}}},
+	}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet"].MTU = 9000
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "mtu 9000 must be between 68 and the interface MTU 1500") {
+		t.Fatalf("Validate() error = %v, want IP MTU above interface MTU", err)
+	}
+}
+
+func TestValidateIPMTUAgainstDefaultInterfaceMTU(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}, MTU: 9000}}},
+	}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want IP MTU equal to the default link MTU accepted", err)
+	}
+
+	cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet"].MTU = 9001
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "mtu 9001 must be between 68 and the interface MTU 9000") {
+		t.Fatalf("Validate() error = %v, want IP MTU above the default link MTU", err)
+	}
+}
+
+func TestValidateRouterAdvertisement(t *testing.T) {
+	cfg := NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &InterfaceConfig{Units: map[int]*Unit{
+		0: {Family: map[string]*AddressFamily{"inet6": {
+			Addresses:           []string{"2001:db8::1/64"},
+			RouterAdvertisement: &RouterAdvertisement{MaxAdvertisementInterval: 30, MinAdvertisementInterval: 10},
+		}}},
+		1: {Family: map[string]*AddressFamily{"inet6": {Addresses: []string{"2001:db8:1::1/64"}}}},
+	}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].RouterAdvertisement.MinAdvertisementInterval = 25
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "min-advertisement-interval 25") {
+		t.Fatalf("Validate() error = %v, want min interval above 3/4 of max", err)
+	}
+
+	cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].RouterAdvertisement.MinAdvertisementInterval = 0
+	cfg.Interfaces["ge-0/0/0"].Units[1].Family["inet6"].RouterAdvertisement = &RouterAdvertisement{}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "router-advertisement configured on units 0 and 1") {
+		t.Fatalf("Validate() error = %v, want router-advertisement on two units", err)
+	}
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"time"
+)
+
+// AlarmInfo is one active system alarm.
+type AlarmInfo struct {
+	Type        string    `json:"type"`
+	Object      string    `json:"object"`
+	Description string    `json:"description"`
+	RaisedAt    time.Time `json:"raised_at"`
+}
+
+// SystemAlarmsInfo lists the active system alarms, used by "show system
+// alarms".
+type SystemAlarmsInfo struct {
+	Alarms []AlarmInfo `json:"alarms"`
+}
+
+type alarmSource interface {
+	AlarmInfos() []AlarmInfo
+}
+
+// SetAlarmSource installs the source of active system alarms.
+func (s *Server) SetAlarmSource(source alarmSource) {
+	s.alarmSource = source
+}
+
+// GetSystemAlarms returns the active system alarms ordered by object and
+// type. It is empty when no alarm source is installed.
+func (s *Server) GetSystemAlarms(context.Context) (*SystemAlarmsInfo, error) {
+	info := &SystemAlarmsInfo{Alarms: []AlarmInfo{}}
+	if s.alarmSource == nil {
+		return info, nil
+	}
+	info.Alarms = append(info.Alarms, s.alarmSource.AlarmInfos()...)
+	return info, nil
+}
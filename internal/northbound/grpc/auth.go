@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	internalauth "github.com/akam1o/arca-router/internal/auth"
+	"github.com/akam1o/arca-router/pkg/cli"
 	googlegrpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -31,6 +32,10 @@ var grpcMethodOperations = map[string]string{
 	"/arca.router.v1.ConfigService/Diff":                 "copy-config",
 	"/arca.router.v1.ConfigService/ListHistory":          "get-config",
 	"/arca.router.v1.ConfigService/GetCommit":            "copy-config",
+	"/arca.router.v1.ConfigService/CompareCommits":       "get-config",
+	"/arca.router.v1.ConfigService/GetScheduledCommit":   "get-config",
+	"/arca.router.v1.ConfigService/ClearScheduledCommit": "commit",
+	"/arca.router.v1.ConfigService/CheckDatastore":       "manage-datastore",
 
 	"/arca.router.v1.SessionService/CreateSession": "edit-config",
 	"/arca.router.v1.SessionService/CloseSession":  "close-session",
@@ -39,8 +44,11 @@ var grpcMethodOperations = map[string]string{
 
 	"/arca.router.v1.StateService/GetInterfaces":             "get",
 	"/arca.router.v1.StateService/GetRoutes":                 "get",
+	"/arca.router.v1.StateService/GetRouteSummary":           "get",
 	"/arca.router.v1.StateService/GetBGPNeighbors":           "get",
 	"/arca.router.v1.StateService/GetOSPFNeighbors":          "get",
+	"/arca.router.v1.StateService/GetNeighbors":              "get",
+	"/arca.router.v1.StateService/ClearInterfaceStatistics":  "clear-statistics",
 	"/arca.router.v1.StateService/GetRouteText":              "get",
 	"/arca.router.v1.StateService/GetBGPSummaryText":         "get",
 	"/arca.router.v1.StateService/GetBGPNeighborText":        "get",
@@ -53,6 +61,8 @@ var grpcMethodOperations = map[string]string{
 	"/arca.router.v1.StateService/GetRoutingInstances":       "get",
 	"/arca.router.v1.StateService/GetClassOfService":         "get",
 	"/arca.router.v1.StateService/GetSystemInfo":             "get",
+	"/arca.router.v1.StateService/GetSystemHealth":           "get",
+	"/arca.router.v1.StateService/GetLastApply":              "get",
 	"/arca.router.v1.DiagnosticService/GetRouteText":         "get",
 	"/arca.router.v1.DiagnosticService/GetBGPSummaryText":    "get",
 	"/arca.router.v1.DiagnosticService/GetBGPNeighborText":   "get",
@@ -61,6 +71,9 @@ var grpcMethodOperations = map[string]string{
 	"/arca.router.v1.DiagnosticService/GetBFDText":           "get",
 	"/arca.router.v1.TelemetryService/GetTelemetryCatalog":   "get",
 	"/arca.router.v1.TelemetryService/SubscribeTelemetry":    "get",
+	"/arca.router.v1.SecurityService/ListUserSSHKeys":        "manage-users",
+	"/arca.router.v1.SecurityService/SetUserSSHKeyStatus":    "manage-users",
+	"/arca.router.v1.SecurityService/RemoveUserSSHKey":       "manage-users",
 }
 
 // ParseTLSClientRoles parses identity=role pairs used by the daemon's
@@ -111,10 +124,11 @@ func NewTLSClientRoleUnaryInterceptor(roles map[string]string, allowedIdentities
 	authorizer := internalauth.NewAuthorizer()
 	allowed := grpcAllowedIdentitySet(allowedIdentities)
 	return func(ctx context.Context, req any, info *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (any, error) {
-		if err := authorizeGRPCMethod(ctx, info.FullMethod, roles, allowed, authorizer); err != nil {
+		role, err := authorizeGRPCMethod(ctx, info.FullMethod, roles, allowed, authorizer)
+		if err != nil {
 			return nil, err
 		}
-		return handler(ctx, req)
+		return handler(contextWithGRPCRole(ctx, role), req)
 	}
 }
 
@@ -124,27 +138,56 @@ func NewTLSClientRoleStreamInterceptor(roles map[string]string, allowedIdentitie
 	authorizer := internalauth.NewAuthorizer()
 	allowed := grpcAllowedIdentitySet(allowedIdentities)
 	return func(srv any, stream googlegrpc.ServerStream, info *googlegrpc.StreamServerInfo, handler googlegrpc.StreamHandler) error {
-		if err := authorizeGRPCMethod(stream.Context(), info.FullMethod, roles, allowed, authorizer); err != nil {
+		if _, err := authorizeGRPCMethod(stream.Context(), info.FullMethod, roles, allowed, authorizer); err != nil {
 			return err
 		}
 		return handler(srv, stream)
 	}
 }
 
-func authorizeGRPCMethod(ctx context.Context, method string, roles map[string]string, allowedIdentities map[string]struct{}, authorizer *internalauth.Authorizer) error {
+func authorizeGRPCMethod(ctx context.Context, method string, roles map[string]string, allowedIdentities map[string]struct{}, authorizer *internalauth.Authorizer) (string, error) {
 	if len(roles) == 0 {
-		return status.Error(codes.Unauthenticated, "gRPC client certificate role mapping is not configured")
+		return "", status.Error(codes.Unauthenticated, "gRPC client certificate role mapping is not configured")
 	}
 	operation, ok := grpcMethodOperations[method]
 	if !ok {
-		return status.Errorf(codes.PermissionDenied, "gRPC method %s is not authorized", method)
+		return "", status.Errorf(codes.PermissionDenied, "gRPC method %s is not authorized", method)
 	}
 	role, ok := grpcTLSClientRole(ctx, roles, allowedIdentities)
 	if !ok {
-		return status.Error(codes.Unauthenticated, "gRPC client certificate identity is not mapped to a role")
+		return "", status.Error(codes.Unauthenticated, "gRPC client certificate identity is not mapped to a role")
 	}
 	if !authorizer.IsPermitted(role, operation) {
-		return status.Errorf(codes.PermissionDenied, "gRPC role %s is not permitted to perform %s", role, operation)
+		return "", status.Errorf(codes.PermissionDenied, "gRPC role %s is not permitted to perform %s", role, operation)
+	}
+	return role, nil
+}
+
+type grpcRoleContextKey struct{}
+
+func contextWithGRPCRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, grpcRoleContextKey{}, role)
+}
+
+// grpcCallerRole returns the role authorized for the current call. Calls
+// over the local Unix socket are not role-mapped and are treated as admin; a
+// TLS call that reached the server without a mapped role has no role.
+func grpcCallerRole(ctx context.Context) string {
+	if role, ok := ctx.Value(grpcRoleContextKey{}).(string); ok {
+		return role
+	}
+	if _, ok := grpcTLSConnectionState(ctx); ok {
+		return ""
+	}
+	return internalauth.RoleAdmin
+}
+
+// authorizeConfigChange enforces the CLI's statement rule on a whole change
+// for the authenticated caller: operators may not change the security
+// hierarchy, and callers without a known role may change nothing.
+func authorizeConfigChange(ctx context.Context, oldText, newText string) error {
+	if err := cli.AuthorizeConfigReplacement(grpcCallerRole(ctx), oldText, newText); err != nil {
+		return newPermissionDeniedError(err)
 	}
 	return nil
 }
@@ -91,6 +91,36 @@ func TestTLSClientRoleUnaryInterceptorAllowsReadOnlyRead(t *testing.T) {
 	}
 }
 
+func TestTLSClientRoleUnaryInterceptorReportsCallerRole(t *testing.T) {
+	roles := map[string]string{"monitor": internalauth.RoleReadOnly}
+	interceptor := NewTLSClientRoleUnaryInterceptor(roles)
+	srv := &Server{}
+
+	resp, err := interceptor(
+		grpcAuthTestContext(t, grpcAuthTestCert{CommonName: "monitor"}),
+		nil,
+		&googlegrpc.UnaryServerInfo{FullMethod: "/arca.router.v1.StateService/GetSystemInfo"},
+		func(ctx context.Context, _ any) (any, error) {
+			return srv.GetSystemInfo(ctx)
+		},
+	)
+	if err != nil {
+		t.Fatalf("interceptor() error = %v", err)
+	}
+	if info := resp.(*SystemInfo); info.Role != internalauth.RoleReadOnly || info.User != "monitor" {
+		t.Fatalf("GetSystemInfo() role, user = %q, %q; want %q, monitor", info.Role, info.User, internalauth.RoleReadOnly)
+	}
+
+	// Local socket calls bypass the interceptor and have admin access.
+	info, err := srv.GetSystemInfo(context.Background())
+	if err != nil {
+		t.Fatalf("GetSystemInfo() error = %v", err)
+	}
+	if info.Role != internalauth.RoleAdmin {
+		t.Fatalf("GetSystemInfo() local role = %q, want %q", info.Role, internalauth.RoleAdmin)
+	}
+}
+
 func TestTLSClientRoleUnaryInterceptorRejectsMissingRoleMappings(t *testing.T) {
 	interceptor := NewTLSClientRoleUnaryInterceptor(nil)
 	called := false
@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
@@ -31,6 +32,7 @@ type Client struct {
 	state      apiv1.StateServiceClient
 	diagnostic apiv1.DiagnosticServiceClient
 	telemetry  apiv1.TelemetryServiceClient
+	security   apiv1.SecurityServiceClient
 }
 
 // TLSClientOptions configures TLS verification for TCP gRPC connections.
@@ -130,6 +132,7 @@ func clientFromConn(ctx context.Context, conn *googlegrpc.ClientConn, target str
 		state:      apiv1.NewStateServiceClient(conn),
 		diagnostic: apiv1.NewDiagnosticServiceClient(conn),
 		telemetry:  apiv1.NewTelemetryServiceClient(conn),
+		security:   apiv1.NewSecurityServiceClient(conn),
 	}, nil
 }
 
@@ -220,6 +223,106 @@ func (c *Client) Commit(ctx context.Context, sessionID, user, message string) (c
 	return resp.GetCommitId(), resp.GetVersion(), nil
 }
 
+// CommitWithOptions commits the candidate configuration, optionally as a
+// confirmed commit or as the confirmation of a pending confirmed commit.
+func (c *Client) CommitWithOptions(ctx context.Context, sessionID, user, message string, opts ConfirmedCommitOptions) (CommitResult, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.config.Commit(ctx, &apiv1.CommitRequest{
+		SessionId:             sessionID,
+		User:                  user,
+		Message:               message,
+		Confirmed:             opts.Confirmed,
+		ConfirmTimeoutSeconds: uint32(opts.Timeout / time.Second),
+		Persist:               opts.Persist,
+		PersistId:             opts.PersistID,
+	})
+	if err != nil {
+		return CommitResult{}, err
+	}
+	return CommitResult{
+		CommitID:         resp.GetCommitId(),
+		Version:          resp.GetVersion(),
+		PersistID:        resp.GetPersistId(),
+		ConfirmExpiresAt: parseProtoTimestamp(resp.GetConfirmExpiresAt()),
+	}, nil
+}
+
+// ScheduleCommit schedules the candidate configuration to be committed at the
+// given time and returns the scheduled time.
+func (c *Client) ScheduleCommit(ctx context.Context, sessionID, user, message string, at time.Time) (time.Time, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.config.Commit(ctx, &apiv1.CommitRequest{
+		SessionId: sessionID,
+		User:      user,
+		Message:   message,
+		CommitAt:  at.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parseProtoTimestamp(resp.GetScheduledAt()), nil
+}
+
+// GetScheduledCommit returns the commit scheduled with "commit at", or nil
+// when no commit is scheduled.
+func (c *Client) GetScheduledCommit(ctx context.Context) (*ScheduledCommitInfo, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.config.GetScheduledCommit(ctx, &apiv1.GetScheduledCommitRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return scheduledCommitFromProto(resp.GetScheduledCommit()), nil
+}
+
+// ClearScheduledCommit cancels the commit scheduled with "commit at" and
+// returns it.
+func (c *Client) ClearScheduledCommit(ctx context.Context, user string) (*ScheduledCommitInfo, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.config.ClearScheduledCommit(ctx, &apiv1.ClearScheduledCommitRequest{User: user})
+	if err != nil {
+		return nil, err
+	}
+	return scheduledCommitFromProto(resp.GetScheduledCommit()), nil
+}
+
+// CheckDatastore checks the configuration datastore for corruption and
+// orphaned records. With repair, orphaned candidates and expired locks are
+// removed.
+func (c *Client) CheckDatastore(ctx context.Context, user string, repair bool) ([]DatastoreIssue, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.config.CheckDatastore(ctx, &apiv1.CheckDatastoreRequest{User: user, Repair: repair})
+	if err != nil {
+		return nil, err
+	}
+	issues := make([]DatastoreIssue, 0, len(resp.GetIssues()))
+	for _, issue := range resp.GetIssues() {
+		issues = append(issues, DatastoreIssue{
+			Check:       issue.GetCheck(),
+			Object:      issue.GetObject(),
+			Description: issue.GetDescription(),
+			Repaired:    issue.GetRepaired(),
+		})
+	}
+	return issues, nil
+}
+
+func scheduledCommitFromProto(scheduled *apiv1.ScheduledCommit) *ScheduledCommitInfo {
+	if scheduled == nil {
+		return nil
+	}
+	return &ScheduledCommitInfo{
+		ScheduledAt: parseProtoTimestamp(scheduled.GetScheduledAt()),
+		CreatedAt:   parseProtoTimestamp(scheduled.GetCreatedAt()),
+		User:        scheduled.GetUser(),
+		Message:     scheduled.GetMessage(),
+	}
+}
+
 // ValidateCandidate validates a session's candidate configuration without committing it.
 func (c *Client) ValidateCandidate(ctx context.Context, sessionID string) error {
 	ctx, cancel := contextWithDefaultTimeout(ctx)
@@ -285,6 +388,17 @@ func (c *Client) GetCommit(ctx context.Context, commitID string) (CommitInfo, er
 	return commitInfoFromProtoDetail(resp.GetCommit()), nil
 }
 
+// CompareCommits returns the diff between two archived commits.
+func (c *Client) CompareCommits(ctx context.Context, fromID, toID string) (diffText string, hasChanges bool, err error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.config.CompareCommits(ctx, &apiv1.CompareCommitsRequest{FromCommitId: fromID, ToCommitId: toID})
+	if err != nil {
+		return "", false, err
+	}
+	return resp.GetDiffText(), resp.GetHasChanges(), nil
+}
+
 // --- Session operations ---
 
 // CreateSession creates a new configuration session.
@@ -308,11 +422,18 @@ func (c *Client) CloseSession(ctx context.Context, sessionID string) error {
 
 // AcquireLock acquires the candidate lock.
 func (c *Client) AcquireLock(ctx context.Context, sessionID, user string) error {
+	return c.AcquireLockWithMode(ctx, sessionID, user, "")
+}
+
+// AcquireLockWithMode enters configuration mode with the given candidate
+// mode: exclusive, private, or shared. An empty mode selects exclusive.
+func (c *Client) AcquireLockWithMode(ctx context.Context, sessionID, user, mode string) error {
 	ctx, cancel := contextWithDefaultTimeout(ctx)
 	defer cancel()
 	_, err := c.session.AcquireLock(ctx, &apiv1.AcquireLockRequest{
 		SessionId: sessionID,
 		User:      user,
+		Mode:      mode,
 	})
 	return err
 }
@@ -352,6 +473,17 @@ func (c *Client) GetRoutes(ctx context.Context, prefixFilter, protoFilter string
 	return routeInfosFromProto(resp.GetRoutes()), nil
 }
 
+// GetRouteSummary returns the route count per protocol of each address family.
+func (c *Client) GetRouteSummary(ctx context.Context) ([]RouteFamilySummaryInfo, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.state.GetRouteSummary(ctx, &apiv1.GetRouteSummaryRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return routeFamilySummaryInfosFromProto(resp.GetFamilies()), nil
+}
+
 // GetBGPNeighbors returns BGP neighbor state.
 func (c *Client) GetBGPNeighbors(ctx context.Context) ([]BGPNeighborInfo, error) {
 	ctx, cancel := contextWithDefaultTimeout(ctx)
@@ -374,6 +506,39 @@ func (c *Client) GetOSPFNeighbors(ctx context.Context, addressFamily string) ([]
 	return ospfNeighborInfosFromProto(resp.GetNeighbors()), nil
 }
 
+// GetNeighbors returns the VPP ARP (inet) or IPv6 neighbor (inet6) table.
+func (c *Client) GetNeighbors(ctx context.Context, addressFamily, nameFilter string) ([]NeighborInfo, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.state.GetNeighbors(ctx, &apiv1.GetNeighborsRequest{
+		AddressFamily: addressFamily,
+		NameFilter:    nameFilter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]NeighborInfo, 0, len(resp.GetNeighbors()))
+	for _, neighbor := range resp.GetNeighbors() {
+		infos = append(infos, NeighborInfo{
+			Interface: neighbor.GetInterface(),
+			Address:   neighbor.GetAddress(),
+			MAC:       neighbor.GetMac(),
+			Static:    neighbor.GetStatic(),
+			AgeSecs:   neighbor.GetAgeSecs(),
+		})
+	}
+	return infos, nil
+}
+
+// ClearInterfaceStatistics resets the counters of interface name, or of
+// every interface when name is empty.
+func (c *Client) ClearInterfaceStatistics(ctx context.Context, name string) error {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	_, err := c.state.ClearInterfaceStatistics(ctx, &apiv1.ClearInterfaceStatisticsRequest{Name: name})
+	return err
+}
+
 // GetRouteText returns FRR routing table output.
 func (c *Client) GetRouteText(ctx context.Context, protoFilter, addressFamily string) (string, error) {
 	ctx, cancel := contextWithDefaultTimeout(ctx)
@@ -642,9 +807,153 @@ func (c *Client) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
 		Hostname:   resp.GetHostname(),
 		Version:    resp.GetVersion(),
 		UptimeSecs: resp.GetUptimeSecs(),
+		Role:       resp.GetRole(),
+		User:       resp.GetUser(),
 	}, nil
 }
 
+// GetSystemHealth returns the daemon build, uptime, and subsystem health.
+func (c *Client) GetSystemHealth(ctx context.Context) (*SystemHealthInfo, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.state.GetSystemHealth(ctx, &apiv1.GetSystemHealthRequest{})
+	if err != nil {
+		return nil, err
+	}
+	info := &SystemHealthInfo{
+		Hostname:   resp.GetHostname(),
+		Version:    resp.GetVersion(),
+		Commit:     resp.GetCommit(),
+		BuildDate:  resp.GetBuildDate(),
+		UptimeSecs: resp.GetUptimeSecs(),
+		Subsystems: make([]SubsystemHealth, 0, len(resp.GetSubsystems())),
+	}
+	for _, subsystem := range resp.GetSubsystems() {
+		info.Subsystems = append(info.Subsystems, SubsystemHealth{
+			Name:   subsystem.GetName(),
+			Status: subsystem.GetStatus(),
+			Detail: subsystem.GetDetail(),
+		})
+	}
+	if netconfHealth := resp.GetNetconf(); netconfHealth != nil {
+		info.NETCONF = &NETCONFHealthInfo{
+			Listening:            netconfHealth.GetListening(),
+			ListenAddrs:          append([]string(nil), netconfHealth.GetListenAddrs()...),
+			ActiveSessions:       int(netconfHealth.GetActiveSessions()),
+			ActiveConnections:    int32(netconfHealth.GetActiveConnections()),
+			TotalConnections:     netconfHealth.GetTotalConnections(),
+			SuccessfulHandshakes: netconfHealth.GetSuccessfulHandshakes(),
+			FailedHandshakes:     netconfHealth.GetFailedHandshakes(),
+		}
+	}
+	return info, nil
+}
+
+// GetLastApply returns the outcome of the last configuration apply.
+func (c *Client) GetLastApply(ctx context.Context) (*LastApplyInfo, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.state.GetLastApply(ctx, &apiv1.GetLastApplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	info := &LastApplyInfo{
+		Recorded: resp.GetRecorded(),
+		Version:  resp.GetVersion(),
+		Status:   resp.GetStatus(),
+		Error:    resp.GetError(),
+	}
+	if rawTime := resp.GetTime(); rawTime != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, rawTime)
+		if err == nil {
+			info.Time = parsed
+		}
+	}
+	for _, plugin := range resp.GetPlugins() {
+		pluginInfo := PluginApplyInfo{
+			Plugin: plugin.GetPlugin(),
+			Status: plugin.GetStatus(),
+			Error:  plugin.GetError(),
+		}
+		for _, operation := range plugin.GetOperations() {
+			pluginInfo.Operations = append(pluginInfo.Operations, ApplyOperationInfo{
+				Operation: operation.GetOperation(),
+				Error:     operation.GetError(),
+			})
+		}
+		info.Plugins = append(info.Plugins, pluginInfo)
+	}
+	return info, nil
+}
+
+// GetSystemAlarms returns the active system alarms. It is served as a
+// one-shot sample of the /system/alarms telemetry path.
+func (c *Client) GetSystemAlarms(ctx context.Context) (*SystemAlarmsInfo, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	stream, err := c.SubscribeTelemetry(ctx, []string{"/system/alarms"}, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	event, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if event.EventType != telemetryEventTypeSnapshot {
+		return nil, fmt.Errorf("system alarms unavailable: %s", event.JSONPayload)
+	}
+	info := &SystemAlarmsInfo{}
+	if err := json.Unmarshal([]byte(event.JSONPayload), info); err != nil {
+		return nil, fmt.Errorf("decode system alarms: %w", err)
+	}
+	return info, nil
+}
+
+// ListUserSSHKeys returns the SSH public keys registered for a NETCONF user.
+func (c *Client) ListUserSSHKeys(ctx context.Context, username string) ([]UserSSHKey, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	resp, err := c.security.ListUserSSHKeys(ctx, &apiv1.ListUserSSHKeysRequest{Username: username})
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]UserSSHKey, 0, len(resp.GetKeys()))
+	for _, key := range resp.GetKeys() {
+		createdAt, _ := time.Parse(time.RFC3339, key.GetCreatedAt())
+		keys = append(keys, UserSSHKey{
+			Algorithm:   key.GetAlgorithm(),
+			Fingerprint: key.GetFingerprint(),
+			Comment:     key.GetComment(),
+			Enabled:     key.GetEnabled(),
+			CreatedAt:   createdAt,
+		})
+	}
+	return keys, nil
+}
+
+// SetUserSSHKeyStatus enables or disables one of a user's SSH public keys.
+func (c *Client) SetUserSSHKeyStatus(ctx context.Context, username, fingerprint string, enabled bool) error {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	_, err := c.security.SetUserSSHKeyStatus(ctx, &apiv1.SetUserSSHKeyStatusRequest{
+		Username:    username,
+		Fingerprint: fingerprint,
+		Enabled:     enabled,
+	})
+	return err
+}
+
+// RemoveUserSSHKey deletes one of a user's SSH public keys.
+func (c *Client) RemoveUserSSHKey(ctx context.Context, username, fingerprint string) error {
+	ctx, cancel := contextWithDefaultTimeout(ctx)
+	defer cancel()
+	_, err := c.security.RemoveUserSSHKey(ctx, &apiv1.RemoveUserSSHKeyRequest{
+		Username:    username,
+		Fingerprint: fingerprint,
+	})
+	return err
+}
+
 // TelemetryReceiver receives structured telemetry events.
 type TelemetryReceiver interface {
 	Recv() (*TelemetryEvent, error)
@@ -780,6 +1089,7 @@ func commitInfosFromProto(entries []*apiv1.CommitEntry) []CommitInfo {
 			User:       entry.GetUser(),
 			Timestamp:  parseProtoTimestamp(entry.GetTimestamp()),
 			Message:    entry.GetMessage(),
+			Source:     entry.GetSource(),
 			IsRollback: entry.GetIsRollback(),
 		})
 	}
@@ -795,6 +1105,7 @@ func commitInfoFromProtoDetail(entry *apiv1.CommitDetail) CommitInfo {
 		User:       entry.GetUser(),
 		Timestamp:  parseProtoTimestamp(entry.GetTimestamp()),
 		Message:    entry.GetMessage(),
+		Source:     entry.GetSource(),
 		IsRollback: entry.GetIsRollback(),
 		ConfigText: entry.GetConfigText(),
 	}
@@ -813,6 +1124,7 @@ func interfaceInfosFromProto(interfaces []*apiv1.InterfaceState) []InterfaceInfo
 	for _, iface := range interfaces {
 		infos = append(infos, InterfaceInfo{
 			Name:        iface.GetName(),
+			IfIndex:     iface.GetIfIndex(),
 			AdminStatus: iface.GetAdminStatus(),
 			OperStatus:  iface.GetOperStatus(),
 			Speed:       iface.GetSpeed(),
@@ -873,6 +1185,22 @@ func routeInfosFromProto(routes []*apiv1.RouteEntry) []RouteInfo {
 	return infos
 }
 
+func routeFamilySummaryInfosFromProto(families []*apiv1.RouteFamilySummary) []RouteFamilySummaryInfo {
+	infos := make([]RouteFamilySummaryInfo, 0, len(families))
+	for _, family := range families {
+		info := RouteFamilySummaryInfo{Family: family.GetFamily(), Total: family.GetTotal()}
+		for _, count := range family.GetProtocols() {
+			info.Protocols = append(info.Protocols, RouteProtocolCountInfo{
+				Protocol:  count.GetProtocol(),
+				Routes:    count.GetRoutes(),
+				FIBRoutes: count.GetFibRoutes(),
+			})
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
 func bgpNeighborInfosFromProto(neighbors []*apiv1.BGPNeighborState) []BGPNeighborInfo {
 	infos := make([]BGPNeighborInfo, 0, len(neighbors))
 	for _, neighbor := range neighbors {
@@ -933,10 +1261,46 @@ type CommitInfo struct {
 	User       string
 	Timestamp  time.Time
 	Message    string
+	Source     string
 	IsRollback bool
 	ConfigText string
 }
 
+// ConfirmedCommitOptions selects confirmed-commit behavior for a commit.
+// A confirmed commit is rolled back automatically unless a confirming commit
+// arrives before Timeout, either from the same session or from any session
+// presenting its PersistID.
+type ConfirmedCommitOptions struct {
+	Confirmed bool
+	Timeout   time.Duration // 0 = default confirmed commit timeout
+	Persist   string        // persist token to assign (empty = generated)
+	PersistID string        // persist token of the pending confirmed commit
+}
+
+// CommitResult describes a completed commit.
+type CommitResult struct {
+	CommitID         string
+	Version          uint64
+	PersistID        string    // set while a confirmed commit is pending
+	ConfirmExpiresAt time.Time // rollback time while a confirmed commit is pending
+}
+
+// ScheduledCommitInfo describes the commit scheduled with "commit at".
+type ScheduledCommitInfo struct {
+	ScheduledAt time.Time
+	CreatedAt   time.Time
+	User        string
+	Message     string
+}
+
+// DatastoreIssue is one problem found by a datastore integrity check.
+type DatastoreIssue struct {
+	Check       string `json:"check"`
+	Object      string `json:"object,omitempty"`
+	Description string `json:"description"`
+	Repaired    bool   `json:"repaired,omitempty"`
+}
+
 // AuditLogOptions controls audit event export filtering.
 type AuditLogOptions struct {
 	Limit     int
@@ -967,6 +1331,7 @@ type AuditEventInfo struct {
 // InterfaceInfo represents interface operational state.
 type InterfaceInfo struct {
 	Name        string
+	IfIndex     uint32
 	AdminStatus string
 	OperStatus  string
 	Speed       uint64
@@ -1101,6 +1466,21 @@ type RouteInfo struct {
 	Active    bool
 }
 
+// RouteFamilySummaryInfo holds the route counts of one address family.
+type RouteFamilySummaryInfo struct {
+	Family    string
+	Protocols []RouteProtocolCountInfo
+	Total     uint32
+}
+
+// RouteProtocolCountInfo is the number of RIB and installed FIB routes of one
+// protocol.
+type RouteProtocolCountInfo struct {
+	Protocol  string
+	Routes    uint32
+	FIBRoutes uint32
+}
+
 // BGPNeighborInfo represents BGP neighbor state.
 type BGPNeighborInfo struct {
 	PeerAddress    string
@@ -1111,6 +1491,15 @@ type BGPNeighborInfo struct {
 	PrefixSent     uint32
 }
 
+// NeighborInfo represents a VPP ARP or IPv6 neighbor table entry.
+type NeighborInfo struct {
+	Interface string
+	Address   string
+	MAC       string
+	Static    bool
+	AgeSecs   uint64
+}
+
 // OSPFNeighborInfo represents OSPFv2 or OSPFv3 neighbor state.
 type OSPFNeighborInfo struct {
 	RouterID     string
@@ -1156,9 +1545,22 @@ type BFDPeerInfo struct {
 	RxFailPackets     uint64
 }
 
+// UserSSHKey describes an SSH public key registered for a NETCONF user.
+type UserSSHKey struct {
+	Algorithm   string
+	Fingerprint string
+	Comment     string
+	Enabled     bool
+	CreatedAt   time.Time
+}
+
 // SystemInfo represents system information.
 type SystemInfo struct {
 	Hostname   string
 	Version    string
 	UptimeSecs uint64
+	// Role is the caller's authorization role as seen by the daemon.
+	Role string
+	// User is the caller's authenticated identity as seen by the daemon.
+	User string
 }
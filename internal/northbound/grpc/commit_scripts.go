@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/akam1o/arca-router/internal/model"
+	"github.com/akam1o/arca-router/internal/store"
+	"github.com/akam1o/arca-router/pkg/commitscript"
+)
+
+// SetCommitScriptRunner overrides the runner used for configured commit
+// scripts. Without one, scripts run from commitscript.DefaultDir.
+func (s *Server) SetCommitScriptRunner(r *commitscript.Runner) {
+	s.commitScripts = r
+}
+
+func (s *Server) commitScriptRunner() *commitscript.Runner {
+	if s.commitScripts != nil {
+		return s.commitScripts
+	}
+	return commitscript.NewRunner()
+}
+
+// runPreCommitScripts runs the candidate's pre-commit scripts and returns the
+// commit message with their output appended. A failing script aborts the
+// commit.
+func (s *Server) runPreCommitScripts(ctx context.Context, cfg *model.RouterConfig, user, message, configText string) (string, error) {
+	if cfg.System == nil || cfg.System.Scripts == nil {
+		return message, nil
+	}
+	message, err := s.commitScriptRunner().RunPreCommit(ctx, commitscript.Request{
+		User:       user,
+		Source:     grpcCommitSource,
+		ConfigText: configText,
+	}, cfg.System.Scripts.CommitPre, message)
+	if err != nil {
+		s.log.Warn("commit aborted by pre-commit script", slog.String("user", user), slog.Any("error", err))
+		return "", newConfigInputErrorf("%s", err)
+	}
+	return message, nil
+}
+
+// runPostCommitScripts runs the committed configuration's post-commit
+// scripts and appends their output and exit status to the commit record.
+// The commit has already succeeded, so failures are only logged.
+func (s *Server) runPostCommitScripts(ctx context.Context, cfg *model.RouterConfig, user, configText, commitID string) {
+	if cfg.System == nil || cfg.System.Scripts == nil {
+		return
+	}
+	var notes commitscript.NoteAppender
+	if noteStore, ok := s.store.(store.CommitNoteStore); ok {
+		notes = noteStore
+	}
+	record, err := s.commitScriptRunner().RunPostCommit(ctx, commitscript.Request{
+		User:       user,
+		Source:     grpcCommitSource,
+		ConfigText: configText,
+	}, cfg.System.Scripts.CommitPost, notes, commitID)
+	if record != "" {
+		s.log.Info("post-commit scripts finished", slog.String("user", user), slog.String("record", record))
+	}
+	if err != nil {
+		s.log.Warn("failed to record post-commit script output",
+			slog.String("commit_id", commitID), slog.Any("error", err))
+	}
+}
@@ -0,0 +1,248 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/model"
+	"github.com/akam1o/arca-router/internal/store"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultConfirmedCommitTimeout  = 10 * time.Minute
+	maxConfirmedCommitTimeout      = 24 * time.Hour
+	confirmedCommitRollbackTimeout = 2 * time.Minute
+	confirmedCommitRetryInterval   = 10 * time.Second
+)
+
+// pendingConfirmedCommit tracks a confirmed commit awaiting confirmation.
+// The state is held in memory: a daemon restart keeps the committed
+// configuration and drops the pending rollback.
+type pendingConfirmedCommit struct {
+	commitID         string
+	sessionID        string
+	user             string
+	persistID        string
+	rollback         *model.ConfigSnapshot
+	rollbackCommitID string
+	expiresAt        time.Time
+	timer            *time.Timer
+}
+
+// CommitWithOptions promotes the candidate configuration to running with
+// optional confirmed-commit semantics. A pending confirmed commit is confirmed
+// by a commit from its issuing session, or from any session that presents its
+// persist ID; the confirming commit may carry no further changes.
+func (s *Server) CommitWithOptions(ctx context.Context, sessionID, user, message string, opts ConfirmedCommitOptions) (CommitResult, error) {
+	if opts.Timeout < 0 || opts.Timeout > maxConfirmedCommitTimeout {
+		return CommitResult{}, newConfigInputErrorf("confirmed commit timeout must be between 1 second and %s", maxConfirmedCommitTimeout)
+	}
+	if !opts.Confirmed && (opts.Timeout != 0 || opts.Persist != "") {
+		return CommitResult{}, newConfigInputErrorf("confirm timeout and persist require a confirmed commit")
+	}
+	session, candidate, err := s.sessions.Candidate(sessionID)
+	if err != nil {
+		return CommitResult{}, err
+	}
+
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	if err := s.checkNoScheduledCommitLocked(); err != nil {
+		return CommitResult{}, err
+	}
+
+	pending := s.pendingConfirm
+	if err := authorizeConfirmedCommit(pending, sessionID, opts.PersistID); err != nil {
+		return CommitResult{}, err
+	}
+
+	beforeSnap := s.engine.RunningSnapshot()
+	beforeCommitID := ""
+	if opts.Confirmed && pending == nil {
+		beforeCommitID = s.latestRunningCommitID(ctx)
+	}
+
+	var result CommitResult
+	if pending != nil && !s.sessionHasCandidateChanges(candidate) {
+		result.CommitID = pending.commitID
+		if snap := s.engine.RunningSnapshot(); snap != nil {
+			result.Version = snap.Version
+		}
+	} else {
+		result.CommitID, result.Version, err = s.commitSession(ctx, sessionID, user, message)
+		if err != nil {
+			return CommitResult{}, err
+		}
+	}
+
+	if !opts.Confirmed {
+		if pending != nil {
+			s.clearPendingConfirmLocked()
+			s.log.Info("confirmed commit confirmed",
+				slog.String("commit_id", pending.commitID),
+				slog.String("session_id", sessionID))
+		}
+		return result, nil
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultConfirmedCommitTimeout
+	}
+	next := &pendingConfirmedCommit{
+		commitID:         result.CommitID,
+		sessionID:        sessionID,
+		user:             sessionAuditUser(session, user),
+		persistID:        strings.TrimSpace(opts.Persist),
+		rollback:         beforeSnap,
+		rollbackCommitID: beforeCommitID,
+		expiresAt:        time.Now().Add(timeout),
+	}
+	if pending != nil {
+		// A follow-up confirmed commit keeps the original rollback target.
+		next.rollback = pending.rollback
+		next.rollbackCommitID = pending.rollbackCommitID
+		if next.persistID == "" {
+			next.persistID = pending.persistID
+		}
+	}
+	if next.persistID == "" {
+		next.persistID = uuid.NewString()
+	}
+	s.clearPendingConfirmLocked()
+	next.timer = time.AfterFunc(timeout, func() {
+		s.expireConfirmedCommit(next)
+	})
+	s.pendingConfirm = next
+	s.log.Info("confirmed commit pending",
+		slog.String("commit_id", next.commitID),
+		slog.String("session_id", sessionID),
+		slog.Time("expires_at", next.expiresAt))
+
+	result.PersistID = next.persistID
+	result.ConfirmExpiresAt = next.expiresAt
+	return result, nil
+}
+
+func authorizeConfirmedCommit(pending *pendingConfirmedCommit, sessionID, persistID string) error {
+	if persistID != "" {
+		if pending == nil || pending.persistID != persistID {
+			return newConfigInputErrorf("persist-id does not match a pending confirmed commit")
+		}
+		return nil
+	}
+	if pending != nil && pending.sessionID != sessionID {
+		return newCandidateConflictErrorf("confirmed commit %s from another session is pending; confirm it with its persist-id", pending.commitID)
+	}
+	return nil
+}
+
+func (s *Server) sessionHasCandidateChanges(session *Session) bool {
+	session.mu.RLock()
+	hasLock := session.HasLock
+	hasCandidate := session.CandidateBaseSet
+	candidateText := session.CandidateText
+	session.mu.RUnlock()
+	if !hasLock || !hasCandidate {
+		return false
+	}
+	cfg, err := s.parseConfigText(candidateText)
+	if err != nil {
+		// Let the commit path report the parse error.
+		return true
+	}
+	return s.hasCandidateChanges(cfg)
+}
+
+func (s *Server) clearPendingConfirmLocked() {
+	if s.pendingConfirm != nil && s.pendingConfirm.timer != nil {
+		s.pendingConfirm.timer.Stop()
+	}
+	s.pendingConfirm = nil
+}
+
+func (s *Server) stopConfirmedCommitTimer() {
+	s.confirmMu.Lock()
+	if s.pendingConfirm != nil && s.pendingConfirm.timer != nil {
+		s.pendingConfirm.timer.Stop()
+	}
+	s.confirmMu.Unlock()
+}
+
+// expireConfirmedCommit restores the configuration that was running before
+// pending when it was not confirmed in time.
+func (s *Server) expireConfirmedCommit(pending *pendingConfirmedCommit) {
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	if s.pendingConfirm != pending {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), confirmedCommitRollbackTimeout)
+	defer cancel()
+	if err := s.restoreConfirmedCommit(ctx, pending); err != nil {
+		s.log.Error("confirmed commit rollback failed; retrying",
+			slog.String("commit_id", pending.commitID),
+			slog.Duration("retry_in", confirmedCommitRetryInterval),
+			slog.Any("error", err))
+		pending.timer = time.AfterFunc(confirmedCommitRetryInterval, func() {
+			s.expireConfirmedCommit(pending)
+		})
+		return
+	}
+	s.pendingConfirm = nil
+	s.log.Warn("confirmed commit was not confirmed and has been rolled back",
+		slog.String("commit_id", pending.commitID),
+		slog.String("rollback_commit_id", pending.rollbackCommitID))
+}
+
+func (s *Server) restoreConfirmedCommit(ctx context.Context, pending *pendingConfirmedCommit) error {
+	cfg := model.NewRouterConfig()
+	if pending.rollback != nil && pending.rollback.Config != nil {
+		cfg = pending.rollback.Config
+	}
+	if !s.hasCandidateChanges(cfg) {
+		return nil
+	}
+	message := fmt.Sprintf("confirmed commit %s timed out", pending.commitID)
+
+	var prepared store.PreparedCommit
+	if s.store != nil {
+		version := uint64(1)
+		if current := s.engine.RunningSnapshot(); current != nil {
+			version = current.Version + 1
+		}
+		rollbackSnap := newCommitSnapshot(ctx, cfg, version, pending.user, message)
+		var err error
+		if rollbackStore, ok := s.store.(store.RollbackPreparer); ok && pending.rollbackCommitID != "" {
+			prepared, err = rollbackStore.PrepareRollback(ctx, rollbackSnap, pending.rollbackCommitID)
+		} else {
+			prepared, err = s.store.PrepareCommit(ctx, rollbackSnap)
+		}
+		if err != nil {
+			return fmt.Errorf("prepare rollback persistence: %w", err)
+		}
+	}
+
+	beforeSnap := s.engine.RunningSnapshot()
+	if err := s.engine.Apply(ctx, cfg, pending.user, message); err != nil {
+		if prepared != nil {
+			_ = prepared.Abort(context.Background())
+		}
+		return err
+	}
+	if prepared != nil {
+		if _, err := prepared.Commit(ctx); err != nil {
+			_ = prepared.Abort(context.Background())
+			if rollbackErr := s.rollbackToSnapshot(context.Background(), beforeSnap, pending.user); rollbackErr != nil {
+				return fmt.Errorf("persist rollback after apply: %w (engine rollback failed: %v)", err, rollbackErr)
+			}
+			return fmt.Errorf("persist rollback after apply: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/internal/model"
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
+)
+
+func TestConfirmedCommitConfirmedByPersistIDFromAnotherSession(t *testing.T) {
+	srv, eng := newConfirmedCommitTestServer(t)
+	ctx := context.Background()
+	issuer := stageConfirmedCommitSession(t, srv, "set system host-name router2")
+
+	result, err := srv.CommitWithOptions(ctx, issuer, "alice", "trial", ConfirmedCommitOptions{Confirmed: true, Timeout: time.Minute})
+	if err != nil {
+		t.Fatalf("CommitWithOptions(confirmed) error = %v", err)
+	}
+	if result.PersistID == "" || result.ConfirmExpiresAt.IsZero() {
+		t.Fatalf("CommitWithOptions(confirmed) = %#v, want generated persist-id and expiry", result)
+	}
+
+	other, err := srv.CreateSession(ctx, "bob")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	if _, _, err := srv.Commit(ctx, other, "bob", ""); err == nil || !errors.Is(err, ErrCandidateConflict) {
+		t.Fatalf("Commit() from other session error = %v, want candidate conflict", err)
+	}
+	if _, err := srv.CommitWithOptions(ctx, other, "bob", "", ConfirmedCommitOptions{PersistID: "wrong"}); err == nil || !errors.Is(err, ErrConfigInput) {
+		t.Fatalf("CommitWithOptions(wrong persist-id) error = %v, want config input error", err)
+	}
+
+	confirm, err := srv.CommitWithOptions(ctx, other, "bob", "", ConfirmedCommitOptions{PersistID: result.PersistID})
+	if err != nil {
+		t.Fatalf("CommitWithOptions(persist-id) error = %v", err)
+	}
+	if confirm.CommitID != result.CommitID || confirm.PersistID != "" {
+		t.Fatalf("confirming commit = %#v, want confirmation of %s", confirm, result.CommitID)
+	}
+	if srv.pendingConfirm != nil {
+		t.Fatal("pending confirmed commit remained after confirmation")
+	}
+	if got := eng.Running().System.HostName; got != "router2" {
+		t.Fatalf("running hostname = %q, want router2", got)
+	}
+}
+
+func TestConfirmedCommitRollsBackWhenNotConfirmed(t *testing.T) {
+	srv, eng := newConfirmedCommitTestServer(t)
+	ctx := context.Background()
+	issuer := stageConfirmedCommitSession(t, srv, "set system host-name router2")
+
+	if _, err := srv.CommitWithOptions(ctx, issuer, "alice", "trial", ConfirmedCommitOptions{Confirmed: true, Persist: "token-1"}); err != nil {
+		t.Fatalf("CommitWithOptions(confirmed) error = %v", err)
+	}
+	pending := srv.pendingConfirm
+	if pending == nil || pending.persistID != "token-1" {
+		t.Fatalf("pending confirmed commit = %#v, want persist token-1", pending)
+	}
+
+	srv.expireConfirmedCommit(pending)
+
+	if srv.pendingConfirm != nil {
+		t.Fatal("pending confirmed commit remained after expiry")
+	}
+	if got := eng.Running().System.HostName; got != "router1" {
+		t.Fatalf("running hostname = %q, want rolled back router1", got)
+	}
+}
+
+func TestCommitWithOptionsRejectsPersistWithoutConfirmed(t *testing.T) {
+	srv, _ := newConfirmedCommitTestServer(t)
+	issuer := stageConfirmedCommitSession(t, srv, "set system host-name router2")
+
+	_, err := srv.CommitWithOptions(context.Background(), issuer, "alice", "", ConfirmedCommitOptions{Persist: "token-1"})
+	if err == nil || !strings.Contains(err.Error(), "require a confirmed commit") {
+		t.Fatalf("CommitWithOptions() error = %v, want confirmed requirement", err)
+	}
+}
+
+func newConfirmedCommitTestServer(t *testing.T) (*Server, *engine.Engine) {
+	t.Helper()
+
+	oldParser := ConfigTextParser
+	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
+		cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(cfg), nil
+	}
+	t.Cleanup(func() { ConfigTextParser = oldParser })
+
+	eng := engine.NewEngine(nil, testLogger())
+	eng.InitializeRunning(&model.RouterConfig{
+		System:     &model.SystemConfig{HostName: "router1"},
+		Interfaces: map[string]*model.InterfaceConfig{},
+	}, 1)
+	srv := NewServer(eng, &fakeStore{commitID: "commit-trial"}, testLogger())
+	t.Cleanup(srv.stopConfirmedCommitTimer)
+	return srv, eng
+}
+
+func stageConfirmedCommitSession(t *testing.T, srv *Server, command string) string {
+	t.Helper()
+
+	ctx := context.Background()
+	sessionID, err := srv.CreateSession(ctx, "alice")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	if err := srv.AcquireLock(ctx, sessionID, "alice"); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := srv.EditCandidate(ctx, sessionID, command); err != nil {
+		t.Fatalf("EditCandidate() error = %v", err)
+	}
+	return sessionID
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/akam1o/arca-router/internal/store"
+)
+
+// CheckDatastore checks the configuration store for corruption and orphaned
+// records. With repair, orphaned candidates and expired locks are removed;
+// other issues are only reported.
+func (s *Server) CheckDatastore(ctx context.Context, user string, repair bool) ([]DatastoreIssue, error) {
+	checker, ok := s.store.(store.IntegrityStore)
+	if !ok {
+		return nil, fmt.Errorf("datastore does not support integrity checks")
+	}
+	found, err := checker.CheckIntegrity(ctx, repair)
+	if err != nil {
+		return nil, fmt.Errorf("check datastore: %w", err)
+	}
+
+	issues := make([]DatastoreIssue, 0, len(found))
+	repaired := 0
+	for _, issue := range found {
+		issues = append(issues, DatastoreIssue(issue))
+		if issue.Repaired {
+			repaired++
+		}
+	}
+	s.log.Info("datastore checked",
+		slog.String("user", user),
+		slog.Bool("repair", repair),
+		slog.Int("issues", len(issues)),
+		slog.Int("repaired", repaired))
+	return issues, nil
+}
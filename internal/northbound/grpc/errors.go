@@ -10,6 +10,9 @@ var (
 	ErrCandidateConflict        = errors.New("candidate conflict")
 	ErrCommitHistoryUnavailable = errors.New("commit history unavailable")
 	ErrSessionNotFound          = errors.New("session not found")
+	ErrUserKeyNotFound          = errors.New("user SSH key not found")
+	ErrUserDatabaseUnavailable  = errors.New("user database unavailable")
+	ErrPermissionDenied         = errors.New("permission denied")
 )
 
 type classifiedError struct {
@@ -51,6 +54,14 @@ func newCandidateConflictErrorf(format string, args ...any) error {
 	}
 }
 
+func newPermissionDeniedError(cause error) error {
+	return classifiedError{
+		kind:  ErrPermissionDenied,
+		cause: cause,
+		msg:   cause.Error(),
+	}
+}
+
 func newSessionNotFoundErrorf(format string, args ...any) error {
 	return classifiedError{
 		kind: ErrSessionNotFound,
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"time"
+)
+
+// PluginApplyInfo is the outcome of the last apply for one southbound plugin.
+type PluginApplyInfo struct {
+	Plugin     string               `json:"plugin"`
+	Status     string               `json:"status"`
+	Error      string               `json:"error,omitempty"`
+	Operations []ApplyOperationInfo `json:"operations,omitempty"`
+}
+
+// ApplyOperationInfo is the outcome of one step of a plugin apply.
+type ApplyOperationInfo struct {
+	Operation string `json:"operation"`
+	Error     string `json:"error,omitempty"`
+}
+
+// LastApplyInfo describes the last configuration apply that reached the
+// southbound plugins, used by "show system commit last". A commit can be
+// stored in the datastore while its apply failed to program the data plane.
+type LastApplyInfo struct {
+	Recorded bool              `json:"recorded"`
+	Time     time.Time         `json:"time"`
+	Version  uint64            `json:"version"`
+	Status   string            `json:"status,omitempty"`
+	Error    string            `json:"error,omitempty"`
+	Plugins  []PluginApplyInfo `json:"plugins,omitempty"`
+}
+
+// Overall last apply states reported in LastApplyInfo.Status.
+const (
+	lastApplyStatusOK     = "ok"
+	lastApplyStatusFailed = "failed"
+)
+
+// GetLastApply returns the outcome of the last apply recorded by the engine.
+func (s *Server) GetLastApply(context.Context) (*LastApplyInfo, error) {
+	info := &LastApplyInfo{}
+	if s.engine == nil {
+		return info, nil
+	}
+	result := s.engine.LastApply()
+	if result.Time.IsZero() {
+		return info, nil
+	}
+	info.Recorded = true
+	info.Time = result.Time.UTC()
+	info.Version = result.Version
+	info.Status = lastApplyStatusOK
+	if result.Err != nil {
+		info.Status = lastApplyStatusFailed
+		info.Error = result.Err.Error()
+	}
+	for _, plugin := range result.Plugins {
+		pluginInfo := PluginApplyInfo{
+			Plugin: plugin.Plugin,
+			Status: plugin.Status,
+			Error:  plugin.Error,
+		}
+		for _, operation := range plugin.Operations {
+			pluginInfo.Operations = append(pluginInfo.Operations, ApplyOperationInfo{
+				Operation: operation.Operation,
+				Error:     operation.Error,
+			})
+		}
+		info.Plugins = append(info.Plugins, pluginInfo)
+	}
+	return info, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"net"
 	"strings"
 	"time"
 
@@ -72,11 +73,83 @@ func (a *configServiceAdapter) ReplaceCandidate(ctx context.Context, req *apiv1.
 
 func (a *configServiceAdapter) Commit(ctx context.Context, req *apiv1.CommitRequest) (*apiv1.CommitResponse, error) {
 	ctx = grpcCorrelationContext(ctx)
-	commitID, version, err := a.server.Commit(ctx, req.GetSessionId(), grpcRequestUser(ctx, req.GetUser()), req.GetMessage())
+	if req.GetCommitAt() != "" {
+		return a.scheduleCommit(ctx, req)
+	}
+	result, err := a.server.CommitWithOptions(ctx, req.GetSessionId(), grpcRequestUser(ctx, req.GetUser()), req.GetMessage(), ConfirmedCommitOptions{
+		Confirmed: req.GetConfirmed(),
+		Timeout:   time.Duration(req.GetConfirmTimeoutSeconds()) * time.Second,
+		Persist:   req.GetPersist(),
+		PersistID: req.GetPersistId(),
+	})
 	if err != nil {
 		return nil, configEditStatusError(err)
 	}
-	return &apiv1.CommitResponse{CommitId: commitID, Version: version}, nil
+	resp := &apiv1.CommitResponse{CommitId: result.CommitID, Version: result.Version, PersistId: result.PersistID}
+	if !result.ConfirmExpiresAt.IsZero() {
+		resp.ConfirmExpiresAt = result.ConfirmExpiresAt.UTC().Format(time.RFC3339Nano)
+	}
+	return resp, nil
+}
+
+func (a *configServiceAdapter) scheduleCommit(ctx context.Context, req *apiv1.CommitRequest) (*apiv1.CommitResponse, error) {
+	if req.GetConfirmed() || req.GetPersistId() != "" {
+		return nil, status.Error(codes.InvalidArgument, "a scheduled commit cannot be a confirmed commit")
+	}
+	at, err := time.Parse(time.RFC3339Nano, req.GetCommitAt())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid commit time %q: want RFC 3339", req.GetCommitAt())
+	}
+	info, err := a.server.ScheduleCommit(ctx, req.GetSessionId(), grpcRequestUser(ctx, req.GetUser()), req.GetMessage(), at)
+	if err != nil {
+		return nil, configEditStatusError(err)
+	}
+	return &apiv1.CommitResponse{ScheduledAt: info.ScheduledAt.UTC().Format(time.RFC3339Nano)}, nil
+}
+
+func (a *configServiceAdapter) GetScheduledCommit(ctx context.Context, _ *apiv1.GetScheduledCommitRequest) (*apiv1.GetScheduledCommitResponse, error) {
+	info, err := a.server.GetScheduledCommit(ctx)
+	if err != nil {
+		return nil, configEditStatusError(err)
+	}
+	return &apiv1.GetScheduledCommitResponse{ScheduledCommit: scheduledCommitToProto(info)}, nil
+}
+
+func (a *configServiceAdapter) ClearScheduledCommit(ctx context.Context, req *apiv1.ClearScheduledCommitRequest) (*apiv1.ClearScheduledCommitResponse, error) {
+	info, err := a.server.ClearScheduledCommit(ctx, grpcRequestUser(ctx, req.GetUser()))
+	if err != nil {
+		return nil, configEditStatusError(err)
+	}
+	return &apiv1.ClearScheduledCommitResponse{ScheduledCommit: scheduledCommitToProto(info)}, nil
+}
+
+func (a *configServiceAdapter) CheckDatastore(ctx context.Context, req *apiv1.CheckDatastoreRequest) (*apiv1.CheckDatastoreResponse, error) {
+	issues, err := a.server.CheckDatastore(ctx, grpcRequestUser(ctx, req.GetUser()), req.GetRepair())
+	if err != nil {
+		return nil, configEditStatusError(err)
+	}
+	resp := &apiv1.CheckDatastoreResponse{Issues: make([]*apiv1.DatastoreIssue, 0, len(issues))}
+	for _, issue := range issues {
+		resp.Issues = append(resp.Issues, &apiv1.DatastoreIssue{
+			Check:       issue.Check,
+			Object:      issue.Object,
+			Description: issue.Description,
+			Repaired:    issue.Repaired,
+		})
+	}
+	return resp, nil
+}
+
+func scheduledCommitToProto(info *ScheduledCommitInfo) *apiv1.ScheduledCommit {
+	if info == nil {
+		return nil
+	}
+	return &apiv1.ScheduledCommit{
+		ScheduledAt: info.ScheduledAt.UTC().Format(time.RFC3339Nano),
+		CreatedAt:   info.CreatedAt.UTC().Format(time.RFC3339Nano),
+		User:        info.User,
+		Message:     info.Message,
+	}
 }
 
 func (a *configServiceAdapter) ValidateCandidate(ctx context.Context, req *apiv1.ValidateCandidateRequest) (*apiv1.ValidateCandidateResponse, error) {
@@ -134,12 +207,16 @@ func configEditStatusError(err error) error {
 	switch {
 	case errors.Is(err, ErrConfigInput), errors.Is(err, engine.ErrConfigValidation):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
 	case errors.Is(err, ErrSessionNotFound):
 		return status.Error(codes.NotFound, "configuration session not found")
 	case errors.Is(err, ErrCandidateConflict):
 		return status.Error(codes.FailedPrecondition, "configuration candidate is unavailable")
 	case errors.Is(err, ErrCommitHistoryUnavailable):
 		return status.Error(codes.Unavailable, "commit history is unavailable")
+	case errors.Is(err, engine.ErrApplyTimeout):
+		return configApplyTimeoutStatusError(err)
 	case errors.Is(err, context.DeadlineExceeded):
 		return status.Error(codes.DeadlineExceeded, "configuration operation timed out")
 	case errors.Is(err, context.Canceled):
@@ -169,6 +246,19 @@ func configApplyStatusError(err error) error {
 	return status.Error(codes.Aborted, "configuration apply failed")
 }
 
+// configApplyTimeoutStatusError reports an apply that ran past the engine
+// apply timeout, together with the outcome of its rollback.
+func configApplyTimeoutStatusError(err error) error {
+	var applyErr *engine.ApplyError
+	if errors.As(err, &applyErr) && applyErr.RollbackAttempted {
+		if applyErr.RollbackSucceeded {
+			return status.Error(codes.DeadlineExceeded, "configuration apply timed out; rollback succeeded")
+		}
+		return status.Error(codes.DeadlineExceeded, "configuration apply timed out; rollback failed")
+	}
+	return status.Error(codes.DeadlineExceeded, "configuration apply timed out")
+}
+
 func datastoreStatusError(err error) error {
 	var datastoreErr *datastore.Error
 	if !errors.As(err, &datastoreErr) {
@@ -204,6 +294,7 @@ func (a *configServiceAdapter) ListHistory(ctx context.Context, req *apiv1.ListH
 			User:       entry.User,
 			Timestamp:  entry.Timestamp.Format(time.RFC3339Nano),
 			Message:    entry.Message,
+			Source:     entry.Source,
 			IsRollback: entry.IsRollback,
 		})
 	}
@@ -218,12 +309,21 @@ func (a *configServiceAdapter) GetCommit(ctx context.Context, req *apiv1.GetComm
 	return &apiv1.GetCommitResponse{Commit: commitDetailFromInfo(entry)}, nil
 }
 
+func (a *configServiceAdapter) CompareCommits(ctx context.Context, req *apiv1.CompareCommitsRequest) (*apiv1.CompareCommitsResponse, error) {
+	diffText, hasChanges, err := a.server.CompareCommits(ctx, req.GetFromCommitId(), req.GetToCommitId())
+	if err != nil {
+		return nil, configEditStatusError(err)
+	}
+	return &apiv1.CompareCommitsResponse{DiffText: diffText, HasChanges: hasChanges}, nil
+}
+
 func commitDetailFromInfo(entry CommitInfo) *apiv1.CommitDetail {
 	return &apiv1.CommitDetail{
 		CommitId:   entry.CommitID,
 		User:       entry.User,
 		Timestamp:  entry.Timestamp.Format(time.RFC3339Nano),
 		Message:    entry.Message,
+		Source:     entry.Source,
 		IsRollback: entry.IsRollback,
 		ConfigText: entry.ConfigText,
 	}
@@ -257,6 +357,19 @@ func grpcAuthenticatedUser(ctx context.Context) string {
 	return ""
 }
 
+// grpcPeerSource returns the client address recorded in audit entries: the
+// host of a TCP peer, or the socket and credentials of a local CLI peer.
+func grpcPeerSource(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
+
 func grpcTLSUser(state tls.ConnectionState) string {
 	identities := grpcTLSIdentities(state)
 	if len(identities) == 0 {
@@ -302,7 +415,7 @@ func (a *sessionServiceAdapter) CloseSession(ctx context.Context, req *apiv1.Clo
 }
 
 func (a *sessionServiceAdapter) AcquireLock(ctx context.Context, req *apiv1.AcquireLockRequest) (*apiv1.AcquireLockResponse, error) {
-	if err := a.server.AcquireLock(ctx, req.GetSessionId(), grpcRequestUser(ctx, req.GetUser())); err != nil {
+	if err := a.server.AcquireLockWithMode(ctx, req.GetSessionId(), grpcRequestUser(ctx, req.GetUser()), req.GetMode()); err != nil {
 		return nil, sessionStatusError(err)
 	}
 	return &apiv1.AcquireLockResponse{}, nil
@@ -321,6 +434,8 @@ func sessionStatusError(err error) error {
 		return status.Error(codes.NotFound, "configuration session not found")
 	case errors.Is(err, ErrCandidateConflict):
 		return status.Error(codes.FailedPrecondition, "configuration candidate is unavailable")
+	case errors.Is(err, ErrConfigInput):
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, context.DeadlineExceeded):
 		return status.Error(codes.DeadlineExceeded, "session operation timed out")
 	case errors.Is(err, context.Canceled):
@@ -345,6 +460,7 @@ func (a *stateServiceAdapter) GetInterfaces(ctx context.Context, req *apiv1.GetI
 	for _, iface := range interfaces {
 		resp.Interfaces = append(resp.Interfaces, &apiv1.InterfaceState{
 			Name:        iface.Name,
+			IfIndex:     iface.IfIndex,
 			AdminStatus: iface.AdminStatus,
 			OperStatus:  iface.OperStatus,
 			Speed:       iface.Speed,
@@ -409,6 +525,26 @@ func (a *stateServiceAdapter) GetRoutes(ctx context.Context, req *apiv1.GetRoute
 	return resp, nil
 }
 
+func (a *stateServiceAdapter) GetRouteSummary(ctx context.Context, _ *apiv1.GetRouteSummaryRequest) (*apiv1.GetRouteSummaryResponse, error) {
+	families, err := a.server.GetRouteSummary(ctx)
+	if err != nil {
+		return nil, stateStatusError(err)
+	}
+	resp := &apiv1.GetRouteSummaryResponse{Families: make([]*apiv1.RouteFamilySummary, 0, len(families))}
+	for _, family := range families {
+		summary := &apiv1.RouteFamilySummary{Family: family.Family, Total: family.Total}
+		for _, count := range family.Protocols {
+			summary.Protocols = append(summary.Protocols, &apiv1.RouteProtocolCount{
+				Protocol:  count.Protocol,
+				Routes:    count.Routes,
+				FibRoutes: count.FIBRoutes,
+			})
+		}
+		resp.Families = append(resp.Families, summary)
+	}
+	return resp, nil
+}
+
 func (a *stateServiceAdapter) GetBGPNeighbors(ctx context.Context, _ *apiv1.GetBGPNeighborsRequest) (*apiv1.GetBGPNeighborsResponse, error) {
 	neighbors, err := a.server.GetBGPNeighbors(ctx)
 	if err != nil {
@@ -449,6 +585,36 @@ func (a *stateServiceAdapter) GetOSPFNeighbors(ctx context.Context, req *apiv1.G
 	return resp, nil
 }
 
+func (a *stateServiceAdapter) ClearInterfaceStatistics(ctx context.Context, req *apiv1.ClearInterfaceStatisticsRequest) (*apiv1.ClearInterfaceStatisticsResponse, error) {
+	if err := a.server.ClearInterfaceStatistics(ctx, req.GetName()); err != nil {
+		if isStateInputError(err) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, stateStatusError(err)
+		}
+		// Report the dataplane as unavailable rather than hiding the failure
+		// behind a generic internal error; counters were not cleared.
+		return nil, status.Error(codes.Unavailable, "failed to clear interface counters in VPP")
+	}
+	return &apiv1.ClearInterfaceStatisticsResponse{}, nil
+}
+
+func (a *stateServiceAdapter) GetNeighbors(ctx context.Context, req *apiv1.GetNeighborsRequest) (*apiv1.GetNeighborsResponse, error) {
+	neighbors, err := a.server.GetNeighbors(ctx, req.GetAddressFamily(), req.GetNameFilter())
+	if err != nil {
+		return nil, stateStatusError(err)
+	}
+	resp := &apiv1.GetNeighborsResponse{Neighbors: make([]*apiv1.NeighborState, 0, len(neighbors))}
+	for _, neighbor := range neighbors {
+		resp.Neighbors = append(resp.Neighbors, &apiv1.NeighborState{
+			Interface: neighbor.Interface,
+			Address:   neighbor.Address,
+			Mac:       neighbor.MAC,
+			Static:    neighbor.Static,
+			AgeSecs:   neighbor.AgeSecs,
+		})
+	}
+	return resp, nil
+}
+
 func (a *stateServiceAdapter) GetRouteText(ctx context.Context, req *apiv1.GetRouteTextRequest) (*apiv1.GetRouteTextResponse, error) {
 	output, err := a.server.GetRouteText(ctx, req.GetProtocolFilter(), req.GetAddressFamily())
 	if err != nil {
@@ -671,9 +837,78 @@ func (a *stateServiceAdapter) GetSystemInfo(ctx context.Context, _ *apiv1.GetSys
 		Hostname:   info.Hostname,
 		Version:    info.Version,
 		UptimeSecs: info.UptimeSecs,
+		Role:       info.Role,
+		User:       info.User,
 	}, nil
 }
 
+func (a *stateServiceAdapter) GetSystemHealth(ctx context.Context, _ *apiv1.GetSystemHealthRequest) (*apiv1.GetSystemHealthResponse, error) {
+	info, err := a.server.GetSystemHealth(ctx)
+	if err != nil {
+		return nil, stateStatusError(err)
+	}
+	resp := &apiv1.GetSystemHealthResponse{
+		Hostname:   info.Hostname,
+		Version:    info.Version,
+		Commit:     info.Commit,
+		BuildDate:  info.BuildDate,
+		UptimeSecs: info.UptimeSecs,
+		Subsystems: make([]*apiv1.SubsystemHealth, 0, len(info.Subsystems)),
+	}
+	for _, subsystem := range info.Subsystems {
+		resp.Subsystems = append(resp.Subsystems, &apiv1.SubsystemHealth{
+			Name:   subsystem.Name,
+			Status: subsystem.Status,
+			Detail: subsystem.Detail,
+		})
+	}
+	if info.NETCONF != nil {
+		resp.Netconf = &apiv1.NETCONFHealth{
+			Listening:            info.NETCONF.Listening,
+			ListenAddrs:          append([]string(nil), info.NETCONF.ListenAddrs...),
+			ActiveSessions:       uint32(info.NETCONF.ActiveSessions),
+			ActiveConnections:    uint32(info.NETCONF.ActiveConnections),
+			TotalConnections:     info.NETCONF.TotalConnections,
+			SuccessfulHandshakes: info.NETCONF.SuccessfulHandshakes,
+			FailedHandshakes:     info.NETCONF.FailedHandshakes,
+		}
+	}
+	return resp, nil
+}
+
+func (a *stateServiceAdapter) GetLastApply(ctx context.Context, _ *apiv1.GetLastApplyRequest) (*apiv1.GetLastApplyResponse, error) {
+	info, err := a.server.GetLastApply(ctx)
+	if err != nil {
+		return nil, stateStatusError(err)
+	}
+	resp := &apiv1.GetLastApplyResponse{
+		Recorded: info.Recorded,
+		Version:  info.Version,
+		Status:   info.Status,
+		Error:    info.Error,
+		Plugins:  make([]*apiv1.PluginApply, 0, len(info.Plugins)),
+	}
+	if !info.Time.IsZero() {
+		resp.Time = info.Time.UTC().Format(time.RFC3339Nano)
+	}
+	for _, plugin := range info.Plugins {
+		pluginResp := &apiv1.PluginApply{
+			Plugin:     plugin.Plugin,
+			Status:     plugin.Status,
+			Error:      plugin.Error,
+			Operations: make([]*apiv1.ApplyOperation, 0, len(plugin.Operations)),
+		}
+		for _, operation := range plugin.Operations {
+			pluginResp.Operations = append(pluginResp.Operations, &apiv1.ApplyOperation{
+				Operation: operation.Operation,
+				Error:     operation.Error,
+			})
+		}
+		resp.Plugins = append(resp.Plugins, pluginResp)
+	}
+	return resp, nil
+}
+
 func stateStatusError(err error) error {
 	switch {
 	case isStateInputError(err):
@@ -697,9 +932,60 @@ func isStateInputError(err error) bool {
 		strings.HasPrefix(msg, "invalid address family") ||
 		strings.HasPrefix(msg, "invalid BGP neighbor address") ||
 		strings.HasPrefix(msg, "invalid BFD peer address") ||
+		strings.HasPrefix(msg, "unknown interface") ||
 		strings.Contains(msg, "does not support brief")
 }
 
+type securityServiceAdapter struct {
+	apiv1.UnimplementedSecurityServiceServer
+	server *Server
+}
+
+func (a *securityServiceAdapter) ListUserSSHKeys(ctx context.Context, req *apiv1.ListUserSSHKeysRequest) (*apiv1.ListUserSSHKeysResponse, error) {
+	keys, err := a.server.ListUserSSHKeys(ctx, req.GetUsername())
+	if err != nil {
+		return nil, userKeyStatusError(err)
+	}
+	resp := &apiv1.ListUserSSHKeysResponse{Keys: make([]*apiv1.UserSSHKey, 0, len(keys))}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, &apiv1.UserSSHKey{
+			Algorithm:   key.Algorithm,
+			Fingerprint: key.Fingerprint,
+			Comment:     key.Comment,
+			Enabled:     key.Enabled,
+			CreatedAt:   key.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (a *securityServiceAdapter) SetUserSSHKeyStatus(ctx context.Context, req *apiv1.SetUserSSHKeyStatusRequest) (*apiv1.SetUserSSHKeyStatusResponse, error) {
+	if err := a.server.SetUserSSHKeyStatus(ctx, req.GetUsername(), req.GetFingerprint(), req.GetEnabled()); err != nil {
+		return nil, userKeyStatusError(err)
+	}
+	return &apiv1.SetUserSSHKeyStatusResponse{}, nil
+}
+
+func (a *securityServiceAdapter) RemoveUserSSHKey(ctx context.Context, req *apiv1.RemoveUserSSHKeyRequest) (*apiv1.RemoveUserSSHKeyResponse, error) {
+	if err := a.server.RemoveUserSSHKey(ctx, req.GetUsername(), req.GetFingerprint()); err != nil {
+		return nil, userKeyStatusError(err)
+	}
+	return &apiv1.RemoveUserSSHKeyResponse{}, nil
+}
+
+func userKeyStatusError(err error) error {
+	switch {
+	case errors.Is(err, ErrConfigInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrUserKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrUserDatabaseUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
 type telemetryServiceAdapter struct {
 	apiv1.UnimplementedTelemetryServiceServer
 	server *Server
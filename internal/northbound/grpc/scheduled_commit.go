@@ -0,0 +1,289 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/store"
+)
+
+const scheduledCommitTimeout = 2 * time.Minute
+
+// pendingScheduledCommit tracks the commit scheduled with "commit at". The
+// scheduled candidate is persisted when the store supports it so the commit
+// still runs after a daemon restart.
+type pendingScheduledCommit struct {
+	commit store.ScheduledCommit
+	timer  *time.Timer
+}
+
+// ScheduleCommit validates the session candidate and schedules it to be
+// committed at the given time. The candidate is handed over to the scheduler,
+// so the session continues from the running configuration. Only one commit
+// may be scheduled at a time, and regular commits are rejected until it runs
+// or is cleared.
+func (s *Server) ScheduleCommit(ctx context.Context, sessionID, user, message string, at time.Time) (ScheduledCommitInfo, error) {
+	now := time.Now()
+	if !at.After(now) {
+		return ScheduledCommitInfo{}, newConfigInputErrorf("commit time %s is not in the future", at.Format(time.RFC3339))
+	}
+	member, session, err := s.sessions.Candidate(sessionID)
+	if err != nil {
+		return ScheduledCommitInfo{}, err
+	}
+
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	if err := s.checkNoScheduledCommitLocked(); err != nil {
+		return ScheduledCommitInfo{}, err
+	}
+	if s.pendingConfirm != nil {
+		return ScheduledCommitInfo{}, newCandidateConflictErrorf("confirmed commit %s is pending; confirm it before scheduling a commit", s.pendingConfirm.commitID)
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.HasLock {
+		return ScheduledCommitInfo{}, newCandidateConflictErrorf("session %s does not hold the candidate lock", sessionID)
+	}
+	if !session.CandidateBaseSet {
+		return ScheduledCommitInfo{}, newConfigInputErrorf("no candidate configuration to commit")
+	}
+	if err := s.ensureCandidateBaseCurrentLocked(session); err != nil {
+		return ScheduledCommitInfo{}, err
+	}
+	candidateText := session.CandidateText
+	cfg, err := s.parseConfigText(candidateText)
+	if err != nil {
+		return ScheduledCommitInfo{}, wrapConfigInputErrorf(err, "parse candidate config")
+	}
+	if err := s.engine.Validate(ctx, cfg); err != nil {
+		return ScheduledCommitInfo{}, err
+	}
+	if !s.hasCandidateChanges(cfg) {
+		return ScheduledCommitInfo{}, newConfigInputErrorf("no configuration changes to commit")
+	}
+
+	pending := &pendingScheduledCommit{commit: store.ScheduledCommit{
+		ConfigText:  candidateText,
+		SessionID:   sessionID,
+		User:        sessionAuditUser(member, user),
+		Message:     message,
+		CreatedAt:   now,
+		ScheduledAt: at,
+		BaseVersion: session.CandidateBaseVersion,
+	}}
+	if scheduler, ok := s.store.(store.ScheduledCommitStore); ok {
+		if err := scheduler.SaveScheduledCommit(ctx, &pending.commit); err != nil {
+			return ScheduledCommitInfo{}, fmt.Errorf("persist scheduled commit: %w", err)
+		}
+	}
+	if err := s.resetSessionCandidateLocked(session); err != nil {
+		return ScheduledCommitInfo{}, err
+	}
+	s.armScheduledCommitLocked(pending)
+	s.log.Info("commit scheduled",
+		slog.String("session_id", sessionID),
+		slog.String("user", pending.commit.User),
+		slog.Time("scheduled_at", at))
+	return scheduledCommitInfo(pending), nil
+}
+
+// GetScheduledCommit returns the commit scheduled with "commit at", or nil
+// when no commit is scheduled.
+func (s *Server) GetScheduledCommit(ctx context.Context) (*ScheduledCommitInfo, error) {
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	if s.pendingSchedule == nil {
+		return nil, nil
+	}
+	info := scheduledCommitInfo(s.pendingSchedule)
+	return &info, nil
+}
+
+// ClearScheduledCommit cancels the commit scheduled with "commit at" and
+// returns it.
+func (s *Server) ClearScheduledCommit(ctx context.Context, user string) (*ScheduledCommitInfo, error) {
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	pending := s.pendingSchedule
+	if pending == nil {
+		return nil, newConfigInputErrorf("no commit is scheduled")
+	}
+	if scheduler, ok := s.store.(store.ScheduledCommitStore); ok {
+		if err := scheduler.ClearScheduledCommit(ctx); err != nil {
+			return nil, fmt.Errorf("clear scheduled commit: %w", err)
+		}
+	}
+	s.clearPendingScheduleLocked()
+	s.log.Info("scheduled commit cleared",
+		slog.String("user", user),
+		slog.Time("scheduled_at", pending.commit.ScheduledAt))
+	info := scheduledCommitInfo(pending)
+	return &info, nil
+}
+
+// RestoreScheduledCommit re-arms a commit scheduled before the daemon
+// restarted. A commit whose time passed while the daemon was down runs
+// immediately.
+func (s *Server) RestoreScheduledCommit(ctx context.Context) error {
+	scheduler, ok := s.store.(store.ScheduledCommitStore)
+	if !ok {
+		return nil
+	}
+	scheduled, err := scheduler.GetScheduledCommit(ctx)
+	if err != nil {
+		return fmt.Errorf("load scheduled commit: %w", err)
+	}
+	if scheduled == nil {
+		return nil
+	}
+
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	s.clearPendingScheduleLocked()
+	s.armScheduledCommitLocked(&pendingScheduledCommit{commit: *scheduled})
+	s.log.Info("scheduled commit restored", slog.Time("scheduled_at", scheduled.ScheduledAt))
+	return nil
+}
+
+func scheduledCommitInfo(pending *pendingScheduledCommit) ScheduledCommitInfo {
+	return ScheduledCommitInfo{
+		ScheduledAt: pending.commit.ScheduledAt,
+		CreatedAt:   pending.commit.CreatedAt,
+		User:        pending.commit.User,
+		Message:     pending.commit.Message,
+	}
+}
+
+func (s *Server) checkNoScheduledCommitLocked() error {
+	if s.pendingSchedule == nil {
+		return nil
+	}
+	return newCandidateConflictErrorf("a commit is scheduled for %s; clear it with 'clear system commit' first",
+		s.pendingSchedule.commit.ScheduledAt.Format(time.RFC3339))
+}
+
+func (s *Server) armScheduledCommitLocked(pending *pendingScheduledCommit) {
+	delay := time.Until(pending.commit.ScheduledAt)
+	if delay < 0 {
+		delay = 0
+	}
+	pending.timer = time.AfterFunc(delay, func() {
+		s.runScheduledCommit(pending)
+	})
+	s.pendingSchedule = pending
+}
+
+func (s *Server) clearPendingScheduleLocked() {
+	if s.pendingSchedule != nil && s.pendingSchedule.timer != nil {
+		s.pendingSchedule.timer.Stop()
+	}
+	s.pendingSchedule = nil
+}
+
+func (s *Server) stopScheduledCommitTimer() {
+	s.confirmMu.Lock()
+	if s.pendingSchedule != nil && s.pendingSchedule.timer != nil {
+		s.pendingSchedule.timer.Stop()
+	}
+	s.confirmMu.Unlock()
+}
+
+// runScheduledCommit commits the scheduled candidate. A scheduled commit that
+// fails is dropped and logged rather than retried, like an interactive commit
+// that fails.
+func (s *Server) runScheduledCommit(pending *pendingScheduledCommit) {
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	if s.pendingSchedule != pending {
+		return
+	}
+	s.pendingSchedule = nil
+
+	ctx, cancel := context.WithTimeout(context.Background(), scheduledCommitTimeout)
+	defer cancel()
+	commitID, err := s.applyScheduledCommit(ctx, pending.commit)
+	if scheduler, ok := s.store.(store.ScheduledCommitStore); ok {
+		if clearErr := scheduler.ClearScheduledCommit(ctx); clearErr != nil {
+			s.log.Error("failed to clear scheduled commit", slog.Any("error", clearErr))
+		}
+	}
+	if err != nil {
+		s.log.Error("scheduled commit failed",
+			slog.String("user", pending.commit.User),
+			slog.Time("scheduled_at", pending.commit.ScheduledAt),
+			slog.Any("error", err))
+		return
+	}
+	s.log.Info("scheduled commit complete",
+		slog.String("commit_id", commitID),
+		slog.String("user", pending.commit.User),
+		slog.Time("scheduled_at", pending.commit.ScheduledAt))
+}
+
+// applyScheduledCommit commits the scheduled candidate. The candidate is a
+// full configuration snapshot, so it is abandoned when running has moved on
+// since it was scheduled, for example through a NETCONF commit, instead of
+// silently reverting those changes.
+func (s *Server) applyScheduledCommit(ctx context.Context, scheduled store.ScheduledCommit) (string, error) {
+	if scheduled.BaseVersion != 0 {
+		var version uint64
+		if snap := s.engine.RunningSnapshot(); snap != nil {
+			version = snap.Version
+		}
+		if version != scheduled.BaseVersion {
+			return "", newCandidateConflictErrorf("running configuration changed from version %d to %d after the commit was scheduled", scheduled.BaseVersion, version)
+		}
+	}
+	cfg, err := s.parseConfigText(scheduled.ConfigText)
+	if err != nil {
+		return "", wrapConfigInputErrorf(err, "parse scheduled config")
+	}
+	if err := s.engine.Validate(ctx, cfg); err != nil {
+		return "", err
+	}
+	if !s.hasCandidateChanges(cfg) {
+		return "", nil
+	}
+	user := scheduled.User
+	message, err := s.runPreCommitScripts(ctx, cfg, user, scheduled.Message, scheduled.ConfigText)
+	if err != nil {
+		return "", err
+	}
+
+	var prepared store.PreparedCommit
+	if s.store != nil {
+		version := uint64(1)
+		if current := s.engine.RunningSnapshot(); current != nil {
+			version = current.Version + 1
+		}
+		prepared, err = s.store.PrepareCommit(ctx, newCommitSnapshot(ctx, cfg, version, user, message))
+		if err != nil {
+			return "", fmt.Errorf("prepare commit persistence: %w", err)
+		}
+	}
+
+	beforeSnap := s.engine.RunningSnapshot()
+	if err := s.engine.Apply(ctx, cfg, user, message); err != nil {
+		if prepared != nil {
+			_ = prepared.Abort(context.Background())
+		}
+		return "", err
+	}
+	commitID := ""
+	if prepared != nil {
+		commitID, err = prepared.Commit(ctx)
+		if err != nil {
+			_ = prepared.Abort(context.Background())
+			if rollbackErr := s.rollbackToSnapshot(context.Background(), beforeSnap, user); rollbackErr != nil {
+				return "", fmt.Errorf("persist commit after apply: %w (rollback failed: %v)", err, rollbackErr)
+			}
+			return "", fmt.Errorf("persist commit after apply: %w", err)
+		}
+	}
+	s.runPostCommitScripts(ctx, cfg, user, scheduled.ConfigText, commitID)
+	return commitID, nil
+}
@@ -0,0 +1,206 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/model"
+	"github.com/akam1o/arca-router/internal/store"
+)
+
+type fakeScheduledCommitStore struct {
+	*fakeStore
+	scheduled *store.ScheduledCommit
+}
+
+func (f *fakeScheduledCommitStore) GetScheduledCommit(context.Context) (*store.ScheduledCommit, error) {
+	return f.scheduled, nil
+}
+
+func (f *fakeScheduledCommitStore) SaveScheduledCommit(_ context.Context, scheduled *store.ScheduledCommit) error {
+	saved := *scheduled
+	f.scheduled = &saved
+	return nil
+}
+
+func (f *fakeScheduledCommitStore) ClearScheduledCommit(context.Context) error {
+	f.scheduled = nil
+	return nil
+}
+
+func TestScheduledCommitRunsAtScheduledTime(t *testing.T) {
+	srv, eng := newConfirmedCommitTestServer(t)
+	scheduleStore := &fakeScheduledCommitStore{fakeStore: &fakeStore{commitID: "commit-scheduled"}}
+	srv.store = scheduleStore
+	t.Cleanup(srv.stopScheduledCommitTimer)
+	ctx := context.Background()
+	sessionID := stageConfirmedCommitSession(t, srv, "set system host-name router2")
+
+	if _, err := srv.ScheduleCommit(ctx, sessionID, "alice", "window", time.Now().Add(-time.Minute)); err == nil || !errors.Is(err, ErrConfigInput) {
+		t.Fatalf("ScheduleCommit(past) error = %v, want config input error", err)
+	}
+
+	at := time.Now().Add(time.Hour)
+	info, err := srv.ScheduleCommit(ctx, sessionID, "alice", "window", at)
+	if err != nil {
+		t.Fatalf("ScheduleCommit() error = %v", err)
+	}
+	if !info.ScheduledAt.Equal(at) || info.User != "alice" || info.Message != "window" {
+		t.Fatalf("ScheduleCommit() = %#v, want alice at %v", info, at)
+	}
+	if scheduleStore.scheduled == nil || !scheduleStore.scheduled.ScheduledAt.Equal(at) {
+		t.Fatalf("persisted scheduled commit = %#v, want commit at %v", scheduleStore.scheduled, at)
+	}
+	if got := eng.Running().System.HostName; got != "router1" {
+		t.Fatalf("running hostname before scheduled time = %q, want router1", got)
+	}
+
+	if err := srv.EditCandidate(ctx, sessionID, "set system host-name router3"); err != nil {
+		t.Fatalf("EditCandidate() error = %v", err)
+	}
+	if _, _, err := srv.Commit(ctx, sessionID, "alice", ""); err == nil || !errors.Is(err, ErrCandidateConflict) {
+		t.Fatalf("Commit() with scheduled commit pending error = %v, want candidate conflict", err)
+	}
+
+	srv.runScheduledCommit(srv.pendingSchedule)
+
+	if got := eng.Running().System.HostName; got != "router2" {
+		t.Fatalf("running hostname after scheduled commit = %q, want router2", got)
+	}
+	if srv.pendingSchedule != nil || scheduleStore.scheduled != nil {
+		t.Fatal("scheduled commit remained after it ran")
+	}
+}
+
+func TestScheduledCommitAbortsWhenRunningChanged(t *testing.T) {
+	srv, eng := newConfirmedCommitTestServer(t)
+	scheduleStore := &fakeScheduledCommitStore{fakeStore: &fakeStore{commitID: "commit-scheduled"}}
+	srv.store = scheduleStore
+	t.Cleanup(srv.stopScheduledCommitTimer)
+	ctx := context.Background()
+	sessionID := stageConfirmedCommitSession(t, srv, "set system host-name router2")
+
+	if _, err := srv.ScheduleCommit(ctx, sessionID, "alice", "window", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("ScheduleCommit() error = %v", err)
+	}
+	if got := scheduleStore.scheduled.BaseVersion; got != 1 {
+		t.Fatalf("persisted base version = %d, want 1", got)
+	}
+
+	// A NETCONF commit applies directly to the engine while the commit is
+	// pending.
+	netconfCfg := &model.RouterConfig{
+		System:     &model.SystemConfig{HostName: "router-netconf"},
+		Interfaces: map[string]*model.InterfaceConfig{},
+	}
+	if err := eng.Apply(ctx, netconfCfg, "bob", "netconf"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	pending := srv.pendingSchedule
+	if _, err := srv.applyScheduledCommit(ctx, pending.commit); err == nil || !errors.Is(err, ErrCandidateConflict) {
+		t.Fatalf("applyScheduledCommit() after NETCONF commit error = %v, want candidate conflict", err)
+	}
+	srv.runScheduledCommit(pending)
+
+	if got := eng.Running().System.HostName; got != "router-netconf" {
+		t.Fatalf("running hostname after stale scheduled commit = %q, want router-netconf", got)
+	}
+	if srv.pendingSchedule != nil || scheduleStore.scheduled != nil {
+		t.Fatal("stale scheduled commit remained after it was abandoned")
+	}
+}
+
+func TestClearScheduledCommitCancelsCommit(t *testing.T) {
+	srv, eng := newConfirmedCommitTestServer(t)
+	scheduleStore := &fakeScheduledCommitStore{fakeStore: &fakeStore{commitID: "commit-scheduled"}}
+	srv.store = scheduleStore
+	t.Cleanup(srv.stopScheduledCommitTimer)
+	ctx := context.Background()
+	sessionID := stageConfirmedCommitSession(t, srv, "set system host-name router2")
+
+	if _, err := srv.ScheduleCommit(ctx, sessionID, "alice", "", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("ScheduleCommit() error = %v", err)
+	}
+	pending := srv.pendingSchedule
+
+	cleared, err := srv.ClearScheduledCommit(ctx, "bob")
+	if err != nil {
+		t.Fatalf("ClearScheduledCommit() error = %v", err)
+	}
+	if cleared == nil || cleared.User != "alice" {
+		t.Fatalf("ClearScheduledCommit() = %#v, want alice's commit", cleared)
+	}
+	if info, err := srv.GetScheduledCommit(ctx); err != nil || info != nil {
+		t.Fatalf("GetScheduledCommit() after clear = %#v, %v; want none", info, err)
+	}
+	if scheduleStore.scheduled != nil {
+		t.Fatal("persisted scheduled commit remained after clear")
+	}
+	if _, err := srv.ClearScheduledCommit(ctx, "bob"); err == nil || !errors.Is(err, ErrConfigInput) {
+		t.Fatalf("second ClearScheduledCommit() error = %v, want config input error", err)
+	}
+
+	// A timer that fires after the commit was cleared does nothing.
+	srv.runScheduledCommit(pending)
+	if got := eng.Running().System.HostName; got != "router1" {
+		t.Fatalf("running hostname after cleared commit = %q, want router1", got)
+	}
+}
+
+func TestRestoreScheduledCommitRearmsPersistedCommit(t *testing.T) {
+	srv, _ := newConfirmedCommitTestServer(t)
+	at := time.Now().Add(time.Hour)
+	srv.store = &fakeScheduledCommitStore{
+		fakeStore: &fakeStore{},
+		scheduled: &store.ScheduledCommit{ConfigText: "set system host-name router2\n", User: "alice", ScheduledAt: at},
+	}
+	t.Cleanup(srv.stopScheduledCommitTimer)
+
+	if err := srv.RestoreScheduledCommit(context.Background()); err != nil {
+		t.Fatalf("RestoreScheduledCommit() error = %v", err)
+	}
+	info, err := srv.GetScheduledCommit(context.Background())
+	if err != nil || info == nil || !info.ScheduledAt.Equal(at) || info.User != "alice" {
+		t.Fatalf("GetScheduledCommit() after restore = %#v, %v; want alice at %v", info, err, at)
+	}
+}
+
+func TestRollbackRejectedWhileCommitScheduled(t *testing.T) {
+	srv, eng := newConfirmedCommitTestServer(t)
+	scheduleStore := &fakeScheduledCommitStore{fakeStore: &fakeStore{
+		commitID: "commit-rollback",
+		commits: map[string]*store.CommitRecord{
+			"commit-old": {CommitID: "commit-old", Config: &model.RouterConfig{
+				System:     &model.SystemConfig{HostName: "router0"},
+				Interfaces: map[string]*model.InterfaceConfig{},
+			}},
+		},
+	}}
+	srv.store = scheduleStore
+	t.Cleanup(srv.stopScheduledCommitTimer)
+	ctx := context.Background()
+	sessionID := stageConfirmedCommitSession(t, srv, "set system host-name router2")
+
+	if _, err := srv.ScheduleCommit(ctx, sessionID, "alice", "window", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("ScheduleCommit() error = %v", err)
+	}
+	if _, _, err := srv.Rollback(ctx, sessionID, "commit-old", "bob", ""); err == nil || !errors.Is(err, ErrCandidateConflict) {
+		t.Fatalf("Rollback() with scheduled commit pending error = %v, want candidate conflict", err)
+	}
+	if got := eng.Running().System.HostName; got != "router1" {
+		t.Fatalf("running hostname after rejected rollback = %q, want router1", got)
+	}
+
+	if _, err := srv.ClearScheduledCommit(ctx, "bob"); err != nil {
+		t.Fatalf("ClearScheduledCommit() error = %v", err)
+	}
+	if _, _, err := srv.Rollback(ctx, sessionID, "commit-old", "bob", ""); err != nil {
+		t.Fatalf("Rollback() after clear error = %v", err)
+	}
+	if got := eng.Running().System.HostName; got != "router0" {
+		t.Fatalf("running hostname after rollback = %q, want router0", got)
+	}
+}
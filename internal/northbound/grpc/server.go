@@ -22,7 +22,9 @@ import (
 	sbvpp "github.com/akam1o/arca-router/internal/southbound/vpp"
 	"github.com/akam1o/arca-router/internal/store"
 	"github.com/akam1o/arca-router/pkg/cli"
+	"github.com/akam1o/arca-router/pkg/commitscript"
 	pkgconfig "github.com/akam1o/arca-router/pkg/config"
+	"github.com/akam1o/arca-router/pkg/datastore"
 	pkgfrr "github.com/akam1o/arca-router/pkg/frr"
 	pkgvpp "github.com/akam1o/arca-router/pkg/vpp"
 	"github.com/google/uuid"
@@ -41,11 +43,22 @@ type Server struct {
 	stateCollector interfaceStateCollector
 	lcpSource      lcpReconciliationSource
 	haSource       haStatusSource
+	userKeys       userKeyStore
 	bfdSource      bfdOperationalSource
 	qosSource      qosCapabilitySource
+	alarmSource    alarmSource
 	routeReader    pkgfrr.RouteStatusReader
+	routeSummary   pkgfrr.RouteSummaryReader
 	bgpReader      pkgfrr.BGPSummaryStatusReader
 	ospfReader     pkgfrr.OSPFNeighborStatusReader
+	commitScripts  *commitscript.Runner
+	netconfMetrics netconfMetricsSource
+	buildInfo      BuildInfo
+	startedAt      time.Time
+
+	confirmMu       sync.Mutex
+	pendingConfirm  *pendingConfirmedCommit
+	pendingSchedule *pendingScheduledCommit
 }
 
 var (
@@ -66,6 +79,12 @@ type interfaceStateCollector interface {
 	CollectState(ctx context.Context) (map[string]*model.InterfaceState, error)
 }
 
+// interfaceCounterClearer is implemented by state collectors that can reset
+// the counters of the interfaces they manage.
+type interfaceCounterClearer interface {
+	ClearInterfaceCounters(ctx context.Context, name string) error
+}
+
 type lcpReconciliationSource interface {
 	LCPReconciliationInfo() LCPReconciliationInfo
 }
@@ -91,8 +110,10 @@ func NewServer(eng *engine.Engine, st store.ConfigStore, log *slog.Logger) *Serv
 		log:          log.With("component", "grpc"),
 		configParser: ConfigTextParser,
 		routeReader:  newOperationalRouteStatusReader(),
+		routeSummary: newOperationalRouteSummaryReader(),
 		bgpReader:    newOperationalBGPSummaryStatusReader(),
 		ospfReader:   newOperationalOSPFNeighborStatusReader(),
+		startedAt:    time.Now(),
 	}
 }
 
@@ -117,6 +138,7 @@ func (s *Server) ServeWithOptions(lis net.Listener, opts ...googlegrpc.ServerOpt
 	apiv1.RegisterStateServiceServer(s.server, stateAdapter)
 	apiv1.RegisterDiagnosticServiceServer(s.server, stateAdapter)
 	apiv1.RegisterTelemetryServiceServer(s.server, &telemetryServiceAdapter{server: s})
+	apiv1.RegisterSecurityServiceServer(s.server, &securityServiceAdapter{server: s})
 	s.log.Info("gRPC server starting", slog.String("address", lis.Addr().String()))
 	return s.server.Serve(lis)
 }
@@ -126,6 +148,8 @@ func (s *Server) Stop() {
 	if s.server != nil {
 		s.server.GracefulStop()
 	}
+	s.stopConfirmedCommitTimer()
+	s.stopScheduledCommitTimer()
 }
 
 // SetInterfaceStateCollector installs a managed interface state source.
@@ -157,6 +181,10 @@ func newOperationalRouteStatusReader() pkgfrr.RouteStatusReader {
 	return pkgfrr.NewVtyshRouteStatusReaderWithRunner(runOperationalVtyshBytesCommand)
 }
 
+func newOperationalRouteSummaryReader() pkgfrr.RouteSummaryReader {
+	return pkgfrr.NewVtyshRouteSummaryReaderWithRunner(runOperationalVtyshBytesCommand)
+}
+
 func newOperationalBGPSummaryStatusReader() pkgfrr.BGPSummaryStatusReader {
 	return pkgfrr.NewVtyshBGPSummaryStatusReaderWithRunner(runOperationalVtyshBytesCommand)
 }
@@ -188,7 +216,7 @@ func (s *Server) GetRunningUnredacted(ctx context.Context) (configText string, v
 
 // GetCandidate returns the session candidate configuration.
 func (s *Server) GetCandidate(ctx context.Context, sessionID string) (string, error) {
-	session, err := s.sessions.Get(sessionID)
+	_, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		return "", err
 	}
@@ -199,7 +227,7 @@ func (s *Server) GetCandidate(ctx context.Context, sessionID string) (string, er
 
 // EditCandidate applies set-command text to a session's candidate config.
 func (s *Server) EditCandidate(ctx context.Context, sessionID, configText string) error {
-	session, err := s.sessions.Get(sessionID)
+	member, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		return err
 	}
@@ -217,6 +245,14 @@ func (s *Server) EditCandidate(ctx context.Context, sessionID, configText string
 	if err != nil {
 		return wrapConfigInputErrorf(err, "edit candidate config")
 	}
+	if err := authorizeConfigChange(ctx, session.CandidateText, updated); err != nil {
+		return err
+	}
+	operation := "edit"
+	if fields := strings.Fields(configText); len(fields) > 0 {
+		operation = fields[0]
+	}
+	s.auditConfigEdit(ctx, member, operation, session.CandidateText, updated)
 	session.CandidateText = updated
 	return nil
 }
@@ -230,7 +266,7 @@ func (s *Server) ReplaceCandidate(ctx context.Context, sessionID, configText str
 // running config still matches the caller's expected base version. A zero
 // expected version preserves the legacy unconditional replacement behavior.
 func (s *Server) ReplaceCandidateWithBase(ctx context.Context, sessionID, configText string, expectedBaseVersion uint64) error {
-	session, err := s.sessions.Get(sessionID)
+	member, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		return err
 	}
@@ -258,26 +294,52 @@ func (s *Server) ReplaceCandidateWithBase(ctx context.Context, sessionID, config
 	if err != nil {
 		return fmt.Errorf("serialize replacement config: %w", err)
 	}
+	if err := authorizeConfigChange(ctx, session.CandidateText, text); err != nil {
+		return err
+	}
 	if !session.CandidateBaseSet {
 		s.setSessionCandidateBaseLocked(session, s.engine.RunningSnapshot())
 	}
+	s.auditConfigEdit(ctx, member, "replace", session.CandidateText, text)
 	session.CandidateText = text
 	return nil
 }
 
 // Commit promotes the candidate configuration to running.
 func (s *Server) Commit(ctx context.Context, sessionID, user, message string) (string, uint64, error) {
-	session, err := s.sessions.Get(sessionID)
+	result, err := s.CommitWithOptions(ctx, sessionID, user, message, ConfirmedCommitOptions{})
 	if err != nil {
 		return "", 0, err
 	}
+	return result.CommitID, result.Version, nil
+}
+
+// grpcCommitSource is the commit source recorded for commits issued through
+// this service, which backs the arca CLI.
+const grpcCommitSource = datastore.CommitSourceCLI
+
+// newCommitSnapshot builds the snapshot persisted for a commit issued through
+// this service. The client address in ctx, if any, is recorded in the commit
+// audit entry.
+func newCommitSnapshot(ctx context.Context, cfg *model.RouterConfig, version uint64, user, message string) *model.ConfigSnapshot {
+	snap := model.NewSnapshot(cfg, version, user, message)
+	snap.Source = grpcCommitSource
+	snap.SourceIP = grpcPeerSource(ctx)
+	return snap
+}
+
+func (s *Server) commitSession(ctx context.Context, sessionID, user, message string) (string, uint64, error) {
+	member, session, err := s.sessions.Candidate(sessionID)
+	if err != nil {
+		return "", 0, err
+	}
+	user = sessionAuditUser(member, user)
 
 	session.mu.Lock()
 	defer session.mu.Unlock()
 	if !session.HasLock {
 		return "", 0, newCandidateConflictErrorf("session %s does not hold the candidate lock", sessionID)
 	}
-	user = sessionAuditUser(session, user)
 
 	// Parse the candidate config text using the existing pkg/config parser
 	candidateText := session.CandidateText
@@ -300,6 +362,10 @@ func (s *Server) Commit(ctx context.Context, sessionID, user, message string) (s
 	if !s.hasCandidateChanges(newCfg) {
 		return "", 0, newConfigInputErrorf("no configuration changes to commit")
 	}
+	message, err = s.runPreCommitScripts(ctx, newCfg, user, message, candidateText)
+	if err != nil {
+		return "", 0, err
+	}
 
 	var prepared store.PreparedCommit
 	if s.store != nil {
@@ -307,7 +373,7 @@ func (s *Server) Commit(ctx context.Context, sessionID, user, message string) (s
 		if current := s.engine.RunningSnapshot(); current != nil {
 			version = current.Version + 1
 		}
-		prepared, err = s.store.PrepareCommit(ctx, model.NewSnapshot(newCfg, version, user, message))
+		prepared, err = s.store.PrepareCommit(ctx, newCommitSnapshot(ctx, newCfg, version, user, message))
 		if err != nil {
 			return "", 0, fmt.Errorf("prepare commit persistence: %w", err)
 		}
@@ -355,12 +421,13 @@ func (s *Server) Commit(ctx context.Context, sessionID, user, message string) (s
 	if err := s.resetSessionCandidateLocked(session); err != nil {
 		return "", 0, err
 	}
+	s.runPostCommitScripts(ctx, newCfg, user, candidateText, commitID)
 	return commitID, snap.Version, nil
 }
 
 // ValidateCandidate parses and validates the session candidate without applying it.
 func (s *Server) ValidateCandidate(ctx context.Context, sessionID string) error {
-	session, err := s.sessions.Get(sessionID)
+	_, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		return err
 	}
@@ -384,14 +451,16 @@ func (s *Server) ValidateCandidate(ctx context.Context, sessionID string) error
 
 // Discard clears the candidate configuration for a session.
 func (s *Server) Discard(ctx context.Context, sessionID string) error {
-	session, err := s.sessions.Get(sessionID)
+	_, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		return err
 	}
 	return s.resetSessionCandidate(session)
 }
 
-// Rollback reverts running configuration to a previous commit.
+// Rollback reverts running configuration to a previous commit. Like a
+// regular commit it is rejected while a commit is scheduled, whose full
+// configuration would otherwise silently undo the rollback when it runs.
 func (s *Server) Rollback(ctx context.Context, sessionID, commitID, user, message string) (string, uint64, error) {
 	if s.store == nil {
 		return "", 0, fmt.Errorf("%w: commit history is unavailable", ErrCommitHistoryUnavailable)
@@ -399,10 +468,17 @@ func (s *Server) Rollback(ctx context.Context, sessionID, commitID, user, messag
 	if commitID == "" {
 		return "", 0, newConfigInputErrorf("commit ID is required")
 	}
-	session, err := s.sessions.Get(sessionID)
+	member, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		return "", 0, err
 	}
+	user = sessionAuditUser(member, user)
+
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	if err := s.checkNoScheduledCommitLocked(); err != nil {
+		return "", 0, err
+	}
 
 	session.mu.Lock()
 	defer session.mu.Unlock()
@@ -412,7 +488,6 @@ func (s *Server) Rollback(ctx context.Context, sessionID, commitID, user, messag
 	if err := s.ensureCandidateBaseCurrentLocked(session); err != nil {
 		return "", 0, err
 	}
-	user = sessionAuditUser(session, user)
 	if message == "" {
 		message = fmt.Sprintf("rollback to commit %s", commitID)
 	}
@@ -431,12 +506,23 @@ func (s *Server) Rollback(ctx context.Context, sessionID, commitID, user, messag
 	if !s.hasCandidateChanges(newCfg) {
 		return "", 0, newConfigInputErrorf("rollback target matches running configuration")
 	}
+	runningText, _, err := s.runningText(false)
+	if err != nil {
+		return "", 0, err
+	}
+	targetText, err := pkgconfig.ToSetCommandsWithError(newCfg.ToLegacyConfig())
+	if err != nil {
+		return "", 0, fmt.Errorf("serialize rollback config: %w", err)
+	}
+	if err := authorizeConfigChange(ctx, runningText, targetText); err != nil {
+		return "", 0, err
+	}
 
 	version := uint64(1)
 	if current := s.engine.RunningSnapshot(); current != nil {
 		version = current.Version + 1
 	}
-	rollbackSnap := model.NewSnapshot(newCfg, version, user, message)
+	rollbackSnap := newCommitSnapshot(ctx, newCfg, version, user, message)
 	var prepared store.PreparedCommit
 	if rollbackStore, ok := s.store.(store.RollbackPreparer); ok {
 		prepared, err = rollbackStore.PrepareRollback(ctx, rollbackSnap, commitID)
@@ -488,7 +574,7 @@ func (s *Server) Rollback(ctx context.Context, sessionID, commitID, user, messag
 
 // Diff returns a simple line-oriented diff between running and candidate config.
 func (s *Server) Diff(ctx context.Context, sessionID string) (string, bool, error) {
-	session, err := s.sessions.Get(sessionID)
+	_, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		return "", false, err
 	}
@@ -499,7 +585,7 @@ func (s *Server) Diff(ctx context.Context, sessionID string) (string, bool, erro
 	session.mu.RLock()
 	candidate := session.CandidateText
 	session.mu.RUnlock()
-	diff := lineDiff(running, candidate)
+	diff := LineDiff(running, candidate)
 	return diff, diff != "", nil
 }
 
@@ -526,6 +612,7 @@ func (s *Server) ListHistory(ctx context.Context, limit, offset int) ([]CommitIn
 			User:       r.Author,
 			Timestamp:  r.Timestamp,
 			Message:    r.Message,
+			Source:     r.Source,
 			IsRollback: r.IsRollback,
 		})
 	}
@@ -559,11 +646,44 @@ func (s *Server) GetCommit(ctx context.Context, commitID string) (CommitInfo, er
 		User:       record.Author,
 		Timestamp:  record.Timestamp,
 		Message:    record.Message,
+		Source:     record.Source,
 		IsRollback: record.IsRollback,
 		ConfigText: configText,
 	}, nil
 }
 
+// CompareCommits returns the diff from commit fromID to commit toID as
+// computed by the datastore, with secret values redacted.
+func (s *Server) CompareCommits(ctx context.Context, fromID, toID string) (string, bool, error) {
+	fromID = strings.TrimSpace(fromID)
+	toID = strings.TrimSpace(toID)
+	if fromID == "" || toID == "" {
+		return "", false, newConfigInputErrorf("two commit IDs are required")
+	}
+	comparer, ok := s.store.(store.CommitCompareStore)
+	if !ok {
+		return "", false, fmt.Errorf("%w: commit comparison is unavailable", ErrCommitHistoryUnavailable)
+	}
+	diff, err := comparer.CompareCommits(ctx, fromID, toID)
+	if err != nil {
+		return "", false, err
+	}
+	diff = redactDiffText(diff)
+	return diff, diff != "", nil
+}
+
+// redactDiffText redacts secret values in the set commands of a line diff,
+// keeping each line's change marker.
+func redactDiffText(diff string) string {
+	lines := strings.Split(strings.TrimRight(diff, "\n"), "\n")
+	for i, line := range lines {
+		if len(line) > 2 {
+			lines[i] = line[:2] + pkgconfig.RedactSecretCommand(line[2:])
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 func commitRecordConfigText(record *store.CommitRecord) (string, error) {
 	if record == nil {
 		return "", nil
@@ -648,10 +768,17 @@ func (s *Server) CloseSession(ctx context.Context, sessionID string) error {
 
 // AcquireLock acquires the exclusive candidate lock for a session.
 func (s *Server) AcquireLock(ctx context.Context, sessionID, user string) error {
-	if err := s.sessions.AcquireLock(sessionID); err != nil {
+	return s.AcquireLockWithMode(ctx, sessionID, user, CandidateModeExclusive)
+}
+
+// AcquireLockWithMode enters configuration mode for a session with the given
+// candidate mode (CandidateMode*). A session entering shared mode joins the
+// shared candidate, which is created from running when it does not exist.
+func (s *Server) AcquireLockWithMode(ctx context.Context, sessionID, user, mode string) error {
+	if err := s.sessions.AcquireLockWithMode(sessionID, mode); err != nil {
 		return err
 	}
-	session, err := s.sessions.Get(sessionID)
+	_, session, err := s.sessions.Candidate(sessionID)
 	if err != nil {
 		_ = s.sessions.ReleaseLock(sessionID)
 		return err
@@ -709,6 +836,7 @@ func (s *Server) getCollectedInterfaces(ctx context.Context, nameFilter string)
 		}
 		info := InterfaceInfo{
 			Name:        name,
+			IfIndex:     state.IfIndex,
 			AdminStatus: state.AdminStatus,
 			OperStatus:  state.OperStatus,
 			Speed:       state.Speed,
@@ -895,6 +1023,34 @@ func (s *Server) GetRoutes(ctx context.Context, prefixFilter, protoFilter string
 	return routes, nil
 }
 
+// GetRouteSummary returns the route count per protocol of each address family.
+func (s *Server) GetRouteSummary(ctx context.Context) ([]RouteFamilySummaryInfo, error) {
+	reader := s.routeSummary
+	if reader == nil {
+		reader = newOperationalRouteSummaryReader()
+	}
+	summary, err := reader.ReadRouteSummary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if summary == nil {
+		return nil, nil
+	}
+	families := make([]RouteFamilySummaryInfo, 0, len(summary.Families))
+	for _, family := range summary.Families {
+		info := RouteFamilySummaryInfo{Family: family.Family, Total: family.Total}
+		for _, count := range family.Protocols {
+			info.Protocols = append(info.Protocols, RouteProtocolCountInfo{
+				Protocol:  count.Protocol,
+				Routes:    count.Routes,
+				FIBRoutes: count.FIBRoutes,
+			})
+		}
+		families = append(families, info)
+	}
+	return families, nil
+}
+
 // GetBGPNeighbors returns BGP neighbor state.
 func (s *Server) GetBGPNeighbors(ctx context.Context) ([]BGPNeighborInfo, error) {
 	reader := s.bgpReader
@@ -961,6 +1117,111 @@ func (s *Server) GetOSPFNeighbors(ctx context.Context, addressFamily string) ([]
 	return neighbors, nil
 }
 
+// GetNeighbors returns the VPP IPv4 ARP or IPv6 neighbor table.
+func (s *Server) GetNeighbors(ctx context.Context, addressFamily, nameFilter string) ([]NeighborInfo, error) {
+	family, err := normalizeAddressFamily(addressFamily)
+	if err != nil {
+		return nil, err
+	}
+
+	client := newOperationalVPPClient()
+	if err := client.Connect(ctx); err != nil {
+		return nil, fmt.Errorf("connect to VPP: %w", err)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			s.log.Debug("failed to close VPP client", slog.Any("error", err))
+		}
+	}()
+
+	ifaces, err := client.ListInterfaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list VPP interfaces: %w", err)
+	}
+	names := make(map[uint32]string, len(ifaces))
+	for _, iface := range ifaces {
+		if iface != nil {
+			names[iface.SwIfIndex] = iface.Name
+		}
+	}
+
+	entries, err := client.GetNeighbors(ctx, pkgvpp.AllInterfaces)
+	if err != nil {
+		return nil, fmt.Errorf("list VPP neighbors: %w", err)
+	}
+	neighbors := make([]NeighborInfo, 0, len(entries))
+	for _, entry := range entries {
+		if (entry.IP.To4() != nil) != (family == addressFamilyIPv4) {
+			continue
+		}
+		name, ok := names[entry.SwIfIndex]
+		if !ok {
+			name = fmt.Sprintf("sw_if_index %d", entry.SwIfIndex)
+		}
+		if nameFilter != "" && name != nameFilter {
+			continue
+		}
+		neighbors = append(neighbors, NeighborInfo{
+			Interface: name,
+			Address:   entry.IP.String(),
+			MAC:       entry.MAC.String(),
+			Static:    entry.Static,
+			AgeSecs:   uint64(entry.Age / time.Second),
+		})
+	}
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].Interface != neighbors[j].Interface {
+			return neighbors[i].Interface < neighbors[j].Interface
+		}
+		a, _ := netip.ParseAddr(neighbors[i].Address)
+		b, _ := netip.ParseAddr(neighbors[j].Address)
+		return a.Less(b)
+	})
+	return neighbors, nil
+}
+
+// ClearInterfaceStatistics resets the VPP counters of interface name, or of
+// every interface when name is empty. A configured state collector resolves
+// configured interface names; otherwise name is a VPP interface name.
+func (s *Server) ClearInterfaceStatistics(ctx context.Context, name string) error {
+	if clearer, ok := s.stateCollector.(interfaceCounterClearer); ok {
+		return clearer.ClearInterfaceCounters(ctx, name)
+	}
+
+	client := newOperationalVPPClient()
+	if err := client.Connect(ctx); err != nil {
+		return fmt.Errorf("connect to VPP: %w", err)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			s.log.Debug("failed to close VPP client", slog.Any("error", err))
+		}
+	}()
+
+	ifIndex := pkgvpp.AllInterfaces
+	if name != "" {
+		ifaces, err := client.ListInterfaces(ctx)
+		if err != nil {
+			return fmt.Errorf("list VPP interfaces: %w", err)
+		}
+		found := false
+		for _, iface := range ifaces {
+			if iface != nil && iface.Name == name {
+				ifIndex = iface.SwIfIndex
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown interface %s", name)
+		}
+	}
+	if err := client.ClearInterfaceStats(ctx, ifIndex); err != nil {
+		return fmt.Errorf("clear VPP interface counters: %w", err)
+	}
+	return nil
+}
+
 // GetRouteText returns FRR routing table output.
 func (s *Server) GetRouteText(ctx context.Context, protoFilter, addressFamily string) (string, error) {
 	family, err := normalizeAddressFamily(addressFamily)
@@ -1100,7 +1361,7 @@ func (s *Server) GetRoutingInstances(ctx context.Context) ([]RoutingInstanceInfo
 	if s.engine == nil {
 		return nil, nil
 	}
-	cfg := s.engine.Running()
+	cfg := s.engine.ActiveRunning()
 	if cfg == nil || len(cfg.RoutingInstances) == 0 {
 		return nil, nil
 	}
@@ -1139,7 +1400,7 @@ func (s *Server) GetClassOfService(ctx context.Context) (*ClassOfServiceInfo, er
 	if s.engine == nil {
 		return info, nil
 	}
-	cfg := s.engine.Running()
+	cfg := s.engine.ActiveRunning()
 	if cfg == nil || cfg.ClassOfService == nil {
 		return info, nil
 	}
@@ -1207,11 +1468,16 @@ func (s *Server) applyClassOfServiceCapabilities(info *ClassOfServiceInfo) {
 
 // GetSystemInfo returns basic system information.
 func (s *Server) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
-	info := &SystemInfo{Version: "unknown"}
+	info := &SystemInfo{
+		Version:    buildInfoField(s.buildInfo.Version),
+		UptimeSecs: s.uptimeSecs(),
+		Role:       grpcCallerRole(ctx),
+		User:       grpcAuthenticatedUser(ctx),
+	}
 	if s.engine == nil {
 		return info, nil
 	}
-	cfg := s.engine.Running()
+	cfg := s.engine.ActiveRunning()
 	if cfg.System != nil {
 		info.Hostname = cfg.System.HostName
 	}
@@ -1448,6 +1714,32 @@ func (s *Server) latestRunningCommitID(ctx context.Context) string {
 	return records[0].CommitID
 }
 
+// auditConfigEdit records a candidate edit in the audit log. Audit failures
+// are logged and do not fail the edit.
+func (s *Server) auditConfigEdit(ctx context.Context, member *Session, operation, oldText, newText string) {
+	if s.store == nil || oldText == newText {
+		return
+	}
+	event := &store.AuditEvent{
+		Timestamp: time.Now(),
+		User:      sessionAuditUser(member, ""),
+		SourceIP:  grpcPeerSource(ctx),
+		Action:    "edit_config",
+		Result:    "success",
+		Details: map[string]any{
+			"target":    "candidate",
+			"operation": operation,
+			"changes":   datastore.AuditChanges(oldText, newText),
+		},
+	}
+	if member != nil {
+		event.SessionID = member.ID
+	}
+	if err := s.store.AuditLog(ctx, event); err != nil {
+		s.log.Warn("Failed to record candidate edit audit event", slog.String("operation", operation), slog.Any("error", err))
+	}
+}
+
 func sessionAuditUser(session *Session, requestedUser string) string {
 	if session != nil && strings.TrimSpace(session.User) != "" {
 		return session.User
@@ -1544,40 +1836,82 @@ func applyCandidateCommand(candidate, commandText string) (string, error) {
 		if command == "" {
 			continue
 		}
-		parts, err := cli.TokenizeCommand(command)
+		expanded, err := cli.ExpandValueList(command)
 		if err != nil {
 			return "", err
 		}
-		if len(parts) == 0 {
-			continue
-		}
-		switch parts[0] {
-		case "set":
-			if len(parts) < 2 {
-				return "", fmt.Errorf("'set' requires arguments")
-			}
-			line := "set " + cli.NormalizeConfigPath(parts[1:])
-			if rules := replacementRules(parts[1:]); len(rules) > 0 {
-				lines = removeMatchingRules(lines, rules)
-			}
-			if containsLine(lines, line) {
+		for _, parts := range expanded {
+			if len(parts) == 0 {
 				continue
 			}
-			lines = append(lines, line)
-		case "delete":
-			prefix, err := cli.ParseDeleteCommand(parts[1:], nil)
-			if err != nil {
-				return "", err
-			}
-			filtered := lines[:0]
-			for _, line := range lines {
-				if !cli.MatchesPrefix(line, prefix) {
-					filtered = append(filtered, line)
+			switch parts[0] {
+			case "set":
+				if len(parts) < 2 {
+					return "", fmt.Errorf("'set' requires arguments")
+				}
+				line := "set " + cli.NormalizeConfigPath(parts[1:])
+				if pkgconfig.IsRedactedSecretLine(line) {
+					return "", fmt.Errorf("the redacted marker cannot be set as a secret value")
+				}
+				if rules := replacementRules(parts[1:]); len(rules) > 0 {
+					lines = removeMatchingRules(lines, rules)
+				}
+				if containsLine(lines, line) {
+					continue
+				}
+				lines = append(lines, line)
+			case "delete":
+				prefix, err := cli.ParseDeleteCommand(parts[1:], nil)
+				if err != nil {
+					return "", err
+				}
+				if cli.IsInterfaceAddressPath(parts[1:]) {
+					if lines, err = cli.DeleteInterfaceAddress(lines, parts[1:]); err != nil {
+						return "", err
+					}
+					continue
+				}
+				inactivePrefix := "deactivate " + strings.TrimPrefix(prefix, "set ")
+				filtered := lines[:0]
+				for _, line := range lines {
+					if !cli.MatchesPrefix(line, prefix) && !cli.MatchesPrefix(line, inactivePrefix) {
+						filtered = append(filtered, line)
+					}
 				}
+				lines = filtered
+			case "deactivate":
+				if len(parts) < 2 {
+					return "", fmt.Errorf("'deactivate' requires arguments")
+				}
+				path := cli.NormalizeConfigPath(parts[1:])
+				if !containsPrefix(lines, "set "+path) {
+					return "", fmt.Errorf("%s: statement does not exist", path)
+				}
+				if line := "deactivate " + path; !containsLine(lines, line) {
+					lines = append(lines, line)
+				}
+			case "activate":
+				if len(parts) < 2 {
+					return "", fmt.Errorf("'activate' requires arguments")
+				}
+				line := "deactivate " + cli.NormalizeConfigPath(parts[1:])
+				if !containsLine(lines, line) {
+					return "", fmt.Errorf("%s: statement is not deactivated", cli.NormalizeConfigPath(parts[1:]))
+				}
+				lines = removeMatchingRules(lines, []replacementRule{func(candidate string) bool {
+					return candidate == line
+				}})
+			case "insert":
+				insert, err := cli.ParseInsertCommand(parts[1:], nil)
+				if err != nil {
+					return "", err
+				}
+				if lines, err = cli.ApplyInsert(lines, insert); err != nil {
+					return "", err
+				}
+			default:
+				return "", fmt.Errorf("unsupported candidate command: %s", parts[0])
 			}
-			lines = filtered
-		default:
-			return "", fmt.Errorf("unsupported candidate command: %s", parts[0])
 		}
 	}
 	return strings.Join(lines, "\n"), nil
@@ -1602,6 +1936,15 @@ func removeMatchingRules(lines []string, rules []replacementRule) []string {
 	return filtered
 }
 
+func containsPrefix(lines []string, prefix string) bool {
+	for _, line := range lines {
+		if cli.MatchesPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func containsLine(lines []string, target string) bool {
 	for _, line := range lines {
 		if line == target {
@@ -1669,6 +2012,9 @@ func replacementPrefixes(path []string) []string {
 			return prefix(4)
 		}
 	}
+	if len(path) >= 5 && path[0] == "system" && path[1] == "alarms" && path[2] == "interface" && path[3] == "error-rate" {
+		return prefix(4)
+	}
 	if len(path) >= 4 && path[0] == "security" && path[1] == "netconf" && path[2] == "ssh" && path[3] == "port" {
 		return prefix(4)
 	}
@@ -1689,9 +2035,66 @@ func replacementPrefixes(path []string) []string {
 			}
 		}
 	}
-	if len(path) >= 4 && path[0] == "interfaces" && path[2] == "description" {
+	if len(path) >= 4 && path[0] == "interfaces" && (path[2] == "description" || path[2] == "rx-mode" || path[2] == "mtu" || path[2] == "bandwidth") {
 		return prefix(3)
 	}
+	if len(path) >= 5 && path[0] == "interfaces" && path[2] == "tunnel" && (path[3] == "source" || path[3] == "destination") {
+		return prefix(4)
+	}
+	if len(path) >= 5 && path[0] == "interfaces" && path[2] == "gigether-options" {
+		switch path[3] {
+		case "rxq", "txq":
+			return prefix(4)
+		case "rx-queue":
+			if len(path) >= 7 && path[5] == "worker" {
+				return prefix(5)
+			}
+		}
+	}
+	if len(path) >= 5 && path[0] == "interfaces" && path[2] == "hold-time" {
+		var prefixes []string
+		for i := 3; i+1 < len(path); i += 2 {
+			if path[i] == "up" || path[i] == "down" {
+				prefixes = append(prefixes, "set "+cli.NormalizeConfigPath([]string{path[0], path[1], path[2], path[i]}))
+			}
+		}
+		return prefixes
+	}
+	if len(path) >= 8 && path[0] == "interfaces" && path[2] == "unit" && path[4] == "family" && path[6] == "mtu" {
+		return prefix(7)
+	}
+	if len(path) >= 9 && path[0] == "interfaces" && path[2] == "unit" && path[4] == "family" && path[6] == "router-advertisement" {
+		switch path[7] {
+		case "max-advertisement-interval", "min-advertisement-interval":
+			return prefix(8)
+		case "prefix":
+			if len(path) >= 11 && (path[9] == "valid-lifetime" || path[9] == "preferred-lifetime") {
+				return prefix(10)
+			}
+		}
+	}
+	if len(path) >= 5 && path[0] == "interfaces" && path[2] == "policer" {
+		return prefix(4)
+	}
+	if len(path) >= 5 && path[0] == "firewall" && path[1] == "policer" {
+		// The if-exceeding limits and the then action may share one line.
+		var prefixes []string
+		for i := 3; i+1 < len(path); {
+			switch {
+			case path[i] == "then":
+				prefixes = append(prefixes, "set "+cli.NormalizeConfigPath([]string{path[0], path[1], path[2], path[i]}))
+				i += 2
+			case path[i] == "if-exceeding":
+				i++
+			case (path[i] == "bandwidth-limit" || path[i] == "burst-size-limit") && i+1 < len(path):
+				prefixes = append(prefixes, "set "+cli.NormalizeConfigPath([]string{path[0], path[1], path[2], "if-exceeding", path[i]}))
+				i += 2
+			default:
+				return prefixes
+			}
+		}
+		return prefixes
+	}
 	if len(path) >= 3 && path[0] == "routing-options" {
 		switch path[1] {
 		case "router-id", "autonomous-system":
@@ -1721,6 +2124,11 @@ func replacementPrefixes(path []string) []string {
 			}
 		case "mpls":
 			return nil
+		case "rip":
+			switch path[2] {
+			case "update-interval", "route-timeout", "holddown", "default-metric":
+				return prefix(3)
+			}
 		case "vrrp":
 			if len(path) >= 5 && path[2] == "group" {
 				switch path[4] {
@@ -1739,14 +2147,17 @@ func replacementPrefixes(path []string) []string {
 				}
 			}
 		case "bgp":
+			if path[2] == "router-id" {
+				return prefix(3)
+			}
 			if len(path) >= 5 && path[2] == "group" {
 				switch path[4] {
-				case "type", "import", "export":
+				case "type", "import", "export", "cluster":
 					return prefix(5)
 				case "neighbor":
 					if len(path) >= 8 {
 						switch path[6] {
-						case "peer-as", "description", "local-address", "bfd":
+						case "peer-as", "description", "local-address", "update-source", "bfd", "cluster", "shutdown":
 							return prefix(7)
 						}
 					}
@@ -1832,7 +2243,10 @@ func normalizeConfigLines(text string) []string {
 	return lines
 }
 
-func lineDiff(oldText, newText string) string {
+// LineDiff renders the set-format diff used by "show | compare": removed
+// lines are prefixed with "- ", added lines with "+ ", sorted by text. The
+// output can be saved and applied to another candidate with "load patch".
+func LineDiff(oldText, newText string) string {
 	oldSet := make(map[string]struct{})
 	for _, line := range normalizeConfigLines(oldText) {
 		oldSet[line] = struct{}{}
@@ -1882,12 +2296,29 @@ func parseConfigText(text string) (*model.RouterConfig, error) {
 
 const defaultSessionTTL = 30 * time.Minute
 
+// Candidate modes selected when a session enters configuration mode.
+const (
+	// CandidateModeExclusive edits a private candidate while holding the
+	// candidate lock, so no other session can enter configuration mode.
+	CandidateModeExclusive = "exclusive"
+	// CandidateModePrivate edits a private candidate alongside other private
+	// and shared sessions. A commit fails as stale once another session has
+	// changed the running configuration.
+	CandidateModePrivate = "private"
+	// CandidateModeShared edits the candidate common to all shared sessions.
+	CandidateModeShared = "shared"
+)
+
+// sharedCandidateID identifies the shared candidate in errors.
+const sharedCandidateID = "shared"
+
 // Session represents an active configuration session.
 type Session struct {
 	mu                   sync.RWMutex
 	ID                   string
 	User                 string
-	HasLock              bool
+	HasLock              bool   // whether the session is in configuration mode
+	Mode                 string // candidate mode while HasLock is set
 	CandidateText        string
 	CandidateBaseVersion uint64
 	CandidateBaseHash    [32]byte
@@ -1896,11 +2327,13 @@ type Session struct {
 	LastActiveAt         time.Time
 }
 
-// SessionManager manages active sessions with exclusive locking.
+// SessionManager manages active sessions, the exclusive candidate lock, and
+// the shared candidate.
 type SessionManager struct {
 	mu       sync.Mutex
 	sessions map[string]*Session
-	lockHeld string // session ID holding the candidate lock
+	lockHeld string   // session ID holding the candidate lock
+	shared   *Session // candidate of the shared sessions, nil when none
 	ttl      time.Duration
 	now      func() time.Time
 }
@@ -1953,6 +2386,26 @@ func (m *SessionManager) Get(id string) (*Session, error) {
 	return s, nil
 }
 
+// Candidate returns a session and the session holding its candidate
+// configuration: the shared candidate for a session in shared mode, and the
+// session itself otherwise.
+func (m *SessionManager) Candidate(id string) (session, candidate *Session, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.expireLocked(now)
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, nil, newSessionNotFoundErrorf("session %s not found", id)
+	}
+	s.LastActiveAt = now
+	if s.HasLock && s.Mode == CandidateModeShared && m.shared != nil {
+		return s, m.shared, nil
+	}
+	return s, s, nil
+}
+
 // Close closes a session and releases any held lock.
 func (m *SessionManager) Close(id string) error {
 	m.mu.Lock()
@@ -1967,8 +2420,7 @@ func (m *SessionManager) Close(id string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.HasLock {
-		s.HasLock = false
-		m.lockHeld = ""
+		m.releaseLocked(id, s)
 	}
 	delete(m.sessions, id)
 	return nil
@@ -1976,6 +2428,23 @@ func (m *SessionManager) Close(id string) error {
 
 // AcquireLock acquires the exclusive candidate lock for a session.
 func (m *SessionManager) AcquireLock(id string) error {
+	return m.AcquireLockWithMode(id, CandidateModeExclusive)
+}
+
+// AcquireLockWithMode enters configuration mode for a session with the given
+// candidate mode. Exclusive mode requires that no other session is in
+// configuration mode; private and shared mode require that no session holds
+// the exclusive lock. An empty mode selects exclusive mode.
+func (m *SessionManager) AcquireLockWithMode(id, mode string) error {
+	if mode == "" {
+		mode = CandidateModeExclusive
+	}
+	switch mode {
+	case CandidateModeExclusive, CandidateModePrivate, CandidateModeShared:
+	default:
+		return newConfigInputErrorf("unknown candidate mode %q", mode)
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -1988,11 +2457,34 @@ func (m *SessionManager) AcquireLock(id string) error {
 	if m.lockHeld != "" && m.lockHeld != id {
 		return newCandidateConflictErrorf("candidate lock held by session %s", m.lockHeld)
 	}
+	if s.HasLock && s.Mode != mode {
+		return newCandidateConflictErrorf("session %s is already in %s configuration mode", id, s.Mode)
+	}
+	if mode == CandidateModeExclusive {
+		for otherID, other := range m.sessions {
+			if otherID != id && other.HasLock {
+				return newCandidateConflictErrorf("session %s is in %s configuration mode", otherID, other.Mode)
+			}
+		}
+	}
+	if mode == CandidateModeShared && m.shared == nil {
+		m.shared = &Session{ID: sharedCandidateID, CreatedAt: now, LastActiveAt: now}
+	}
+	if mode == CandidateModeShared {
+		m.shared.mu.Lock()
+		m.shared.HasLock = true
+		m.shared.Mode = CandidateModeShared
+		m.shared.mu.Unlock()
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.HasLock = true
+	s.Mode = mode
 	s.LastActiveAt = now
-	m.lockHeld = id
+	if mode == CandidateModeExclusive {
+		m.lockHeld = id
+	}
 	return nil
 }
 
@@ -2012,12 +2504,35 @@ func (m *SessionManager) ReleaseLock(id string) error {
 	if !s.HasLock {
 		return newCandidateConflictErrorf("session %s does not hold the candidate lock", id)
 	}
-	s.HasLock = false
+	m.releaseLocked(id, s)
 	s.LastActiveAt = now
-	m.lockHeld = ""
 	return nil
 }
 
+// releaseLocked takes session s out of configuration mode. The shared
+// candidate is dropped when its last shared session leaves. The caller holds
+// m.mu and s.mu.
+func (m *SessionManager) releaseLocked(id string, s *Session) {
+	mode := s.Mode
+	s.HasLock = false
+	s.Mode = ""
+	if m.lockHeld == id {
+		m.lockHeld = ""
+	}
+	if mode != CandidateModeShared || m.shared == nil {
+		return
+	}
+	for otherID, other := range m.sessions {
+		if otherID != id && other.HasLock && other.Mode == CandidateModeShared {
+			return
+		}
+	}
+	m.shared.mu.Lock()
+	m.shared.HasLock = false
+	m.shared.mu.Unlock()
+	m.shared = nil
+}
+
 func (m *SessionManager) expireLocked(now time.Time) {
 	if m.ttl <= 0 {
 		return
@@ -2032,10 +2547,7 @@ func (m *SessionManager) expireLocked(now time.Time) {
 		}
 		session.mu.Lock()
 		if session.HasLock {
-			session.HasLock = false
-			if m.lockHeld == id {
-				m.lockHeld = ""
-			}
+			m.releaseLocked(id, session)
 		}
 		session.mu.Unlock()
 		delete(m.sessions, id)
@@ -9,6 +9,8 @@ import (
 	"log/slog"
 	"net"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -21,11 +23,13 @@ import (
 	sbfrr "github.com/akam1o/arca-router/internal/southbound/frr"
 	sbvpp "github.com/akam1o/arca-router/internal/southbound/vpp"
 	"github.com/akam1o/arca-router/internal/store"
+	"github.com/akam1o/arca-router/pkg/commitscript"
 	pkgconfig "github.com/akam1o/arca-router/pkg/config"
 	pkgdatastore "github.com/akam1o/arca-router/pkg/datastore"
 	pkgvpp "github.com/akam1o/arca-router/pkg/vpp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -147,6 +151,172 @@ func TestServerConfigTextParserIsInstanceScoped(t *testing.T) {
 	}
 }
 
+func TestGetSystemHealthReportsDownSubsystems(t *testing.T) {
+	oldVPPClient := newOperationalVPPClient
+	newOperationalVPPClient = func() pkgvpp.Client { return pkgvpp.NewMockClient() }
+	t.Cleanup(func() { newOperationalVPPClient = oldVPPClient })
+	oldVtysh := runOperationalVtyshCommand
+	runOperationalVtyshCommand = func(ctx context.Context, command string) (string, error) {
+		return "", errors.New("vtysh: connection refused")
+	}
+	t.Cleanup(func() { runOperationalVtyshCommand = oldVtysh })
+
+	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
+	srv.SetBuildInfo(BuildInfo{Version: "1.2.3", Commit: "abc1234"})
+
+	info, err := srv.GetSystemHealth(context.Background())
+	if err != nil {
+		t.Fatalf("GetSystemHealth() error = %v", err)
+	}
+	if info.Version != "1.2.3" || info.Commit != "abc1234" || info.BuildDate != "unknown" {
+		t.Fatalf("build info = %q/%q/%q, want 1.2.3/abc1234/unknown", info.Version, info.Commit, info.BuildDate)
+	}
+	want := map[string]string{
+		"vpp":       SubsystemStatusUp,
+		"frr":       SubsystemStatusDown,
+		"datastore": SubsystemStatusUp,
+		"netconf":   SubsystemStatusNotRunning,
+	}
+	if len(info.Subsystems) != len(want) {
+		t.Fatalf("subsystems = %+v, want %d entries", info.Subsystems, len(want))
+	}
+	for _, subsystem := range info.Subsystems {
+		if subsystem.Status != want[subsystem.Name] {
+			t.Fatalf("%s status = %q, want %q", subsystem.Name, subsystem.Status, want[subsystem.Name])
+		}
+		switch subsystem.Name {
+		case "vpp":
+			if !strings.HasPrefix(subsystem.Detail, "24.10-mock") {
+				t.Fatalf("vpp detail = %q, want mock version", subsystem.Detail)
+			}
+		case "frr":
+			if !strings.Contains(subsystem.Detail, "connection refused") {
+				t.Fatalf("frr detail = %q, want probe error", subsystem.Detail)
+			}
+		}
+	}
+	if info.NETCONF != nil {
+		t.Fatalf("NETCONF = %+v, want nil without a metrics source", info.NETCONF)
+	}
+
+	resp, err := (&stateServiceAdapter{server: srv}).GetSystemHealth(context.Background(), &apiv1.GetSystemHealthRequest{})
+	if err != nil {
+		t.Fatalf("adapter GetSystemHealth() error = %v", err)
+	}
+	if resp.GetVersion() != "1.2.3" || len(resp.GetSubsystems()) != len(want) || resp.GetNetconf() != nil {
+		t.Fatalf("adapter GetSystemHealth() = %+v, want version 1.2.3 with %d subsystems and no NETCONF", resp, len(want))
+	}
+	for _, subsystem := range resp.GetSubsystems() {
+		if subsystem.GetStatus() != want[subsystem.GetName()] {
+			t.Fatalf("adapter %s status = %q, want %q", subsystem.GetName(), subsystem.GetStatus(), want[subsystem.GetName()])
+		}
+	}
+
+	var event TelemetryEvent
+	err = srv.SubscribeTelemetry(context.Background(), []string{"/system/information"}, 0, true, func(e TelemetryEvent) error {
+		event = e
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("SubscribeTelemetry() error = %v", err)
+	}
+	if event.Path != "/system/health" || event.EventType != telemetryEventTypeSnapshot {
+		t.Fatalf("event = %+v, want /system/health snapshot", event)
+	}
+	var payload SystemHealthInfo
+	if err := json.Unmarshal([]byte(event.JSONPayload), &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.Version != "1.2.3" || len(payload.Subsystems) != len(want) {
+		t.Fatalf("payload = %+v", payload)
+	}
+}
+
+func TestLastApplyTelemetryReportsPluginOutcome(t *testing.T) {
+	eng := engine.NewEngine(nil, testLogger())
+	srv := NewServer(eng, &fakeStore{}, testLogger())
+	if info, err := srv.GetLastApply(context.Background()); err != nil || info.Recorded {
+		t.Fatalf("GetLastApply() = %+v, %v; want nothing recorded", info, err)
+	}
+
+	eng.RestoreLastApply(engine.ApplyResult{
+		Time:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Version: 7,
+		Err:     errors.New("plugin vpp apply failed (rollback succeeded): address rejected"),
+		Plugins: []engine.PluginApplyResult{
+			{Plugin: "vpp", Status: engine.PluginFailed, Error: "address rejected", Operations: []engine.OperationResult{
+				{Operation: "apply interface ge-0/0/0 addresses", Error: "address rejected"},
+			}},
+			{Plugin: "frr", Status: engine.PluginNotApplied},
+		},
+	})
+	var event TelemetryEvent
+	err := srv.SubscribeTelemetry(context.Background(), []string{"/last-apply"}, 0, true, func(e TelemetryEvent) error {
+		event = e
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("SubscribeTelemetry() error = %v", err)
+	}
+	if event.Path != "/config/last-apply" || event.EventType != telemetryEventTypeSnapshot {
+		t.Fatalf("event = %+v, want /config/last-apply snapshot", event)
+	}
+	var payload LastApplyInfo
+	if err := json.Unmarshal([]byte(event.JSONPayload), &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	want := PluginApplyInfo{Plugin: "vpp", Status: "failed", Error: "address rejected", Operations: []ApplyOperationInfo{
+		{Operation: "apply interface ge-0/0/0 addresses", Error: "address rejected"},
+	}}
+	if !payload.Recorded || payload.Status != "failed" || payload.Version != 7 || len(payload.Plugins) != 2 ||
+		!reflect.DeepEqual(payload.Plugins[0], want) {
+		t.Fatalf("payload = %+v, want failed vpp apply", payload)
+	}
+
+	resp, err := (&stateServiceAdapter{server: srv}).GetLastApply(context.Background(), &apiv1.GetLastApplyRequest{})
+	if err != nil {
+		t.Fatalf("adapter GetLastApply() error = %v", err)
+	}
+	if !resp.GetRecorded() || resp.GetTime() != "2026-01-02T03:04:05Z" || resp.GetVersion() != 7 || len(resp.GetPlugins()) != 2 {
+		t.Fatalf("adapter GetLastApply() = %+v, want recorded version 7 with two plugins", resp)
+	}
+	if ops := resp.GetPlugins()[0].GetOperations(); len(ops) != 1 || ops[0].GetError() != "address rejected" {
+		t.Fatalf("adapter vpp operations = %+v, want the failed address step", ops)
+	}
+}
+
+type fakeAlarmSource []AlarmInfo
+
+func (s fakeAlarmSource) AlarmInfos() []AlarmInfo { return s }
+
+func TestSystemAlarmsTelemetryListsActiveAlarms(t *testing.T) {
+	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
+	if info, err := srv.GetSystemAlarms(context.Background()); err != nil || len(info.Alarms) != 0 {
+		t.Fatalf("GetSystemAlarms() without source = %+v, %v; want no alarms", info, err)
+	}
+
+	raisedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	srv.SetAlarmSource(fakeAlarmSource{{Type: "interface-link-down", Object: "ge-0/0/0", Description: "link is down", RaisedAt: raisedAt}})
+	var event TelemetryEvent
+	err := srv.SubscribeTelemetry(context.Background(), []string{"/alarms"}, 0, true, func(e TelemetryEvent) error {
+		event = e
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("SubscribeTelemetry() error = %v", err)
+	}
+	if event.Path != "/system/alarms" || event.EventType != telemetryEventTypeSnapshot {
+		t.Fatalf("event = %+v, want /system/alarms snapshot", event)
+	}
+	var payload SystemAlarmsInfo
+	if err := json.Unmarshal([]byte(event.JSONPayload), &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if len(payload.Alarms) != 1 || payload.Alarms[0].Object != "ge-0/0/0" || !payload.Alarms[0].RaisedAt.Equal(raisedAt) {
+		t.Fatalf("payload = %+v, want ge-0/0/0 link-down alarm", payload)
+	}
+}
+
 func TestStateAdapterRedactsOperationalErrors(t *testing.T) {
 	oldVtysh := runOperationalVtyshCommand
 	runOperationalVtyshCommand = func(ctx context.Context, command string) (string, error) {
@@ -298,6 +468,7 @@ type fakeStore struct {
 	listCalls     int
 	listOpts      *store.ListOptions
 	auditOpts     *store.AuditOptions
+	auditEvents   []*store.AuditEvent
 	correlationID string
 }
 
@@ -388,6 +559,7 @@ func (f *fakeStore) ListCommits(ctx context.Context, opts *store.ListOptions) ([
 }
 
 func (f *fakeStore) AuditLog(ctx context.Context, event *store.AuditEvent) error {
+	f.auditEvents = append(f.auditEvents, event)
 	return nil
 }
 
@@ -734,6 +906,113 @@ func TestCommitUsesSessionUserForAudit(t *testing.T) {
 	}
 }
 
+func TestCommitAbortedByPreCommitScript(t *testing.T) {
+	oldParser := ConfigTextParser
+	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
+		cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(cfg), nil
+	}
+	t.Cleanup(func() { ConfigTextParser = oldParser })
+
+	dir := t.TempDir()
+	script := filepath.Join(dir, "deny")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\necho \"denied for $ARCA_COMMIT_USER\"\nexit 2\n"), 0o755); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	eng := engine.NewEngine(nil, testLogger())
+	eng.InitializeRunning(&model.RouterConfig{
+		System: &model.SystemConfig{HostName: "router1"},
+	}, 1)
+	st := &fakeStore{commitID: "commit-1"}
+	srv := NewServer(eng, st, testLogger())
+	srv.SetCommitScriptRunner(&commitscript.Runner{Dir: dir, Timeout: 5 * time.Second})
+	ctx := context.Background()
+
+	sessionID, err := srv.CreateSession(ctx, "alice")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	if err := srv.AcquireLock(ctx, sessionID, "alice"); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := srv.ReplaceCandidate(ctx, sessionID, "set system host-name router2\nset system scripts commit pre "+script); err != nil {
+		t.Fatalf("ReplaceCandidate() error = %v", err)
+	}
+	_, _, err = srv.Commit(ctx, sessionID, "alice", "")
+	if err == nil || !strings.Contains(err.Error(), "commit aborted: pre-commit script "+script+": exit status 2\ndenied for alice") {
+		t.Fatalf("Commit() error = %v, want aborted pre-commit script", err)
+	}
+	if st.saved != nil {
+		t.Fatalf("persisted commit = %#v, want none after aborting script", st.saved)
+	}
+	if snap := eng.RunningSnapshot(); snap == nil || snap.Config.System.HostName != "router1" {
+		t.Fatalf("running snapshot = %#v, want unchanged running config", snap)
+	}
+}
+
+type noteRecordingStore struct {
+	*fakeStore
+	notes map[string]string
+}
+
+func (s *noteRecordingStore) AppendCommitNote(ctx context.Context, commitID, note string) error {
+	if s.notes == nil {
+		s.notes = make(map[string]string)
+	}
+	s.notes[commitID] += note
+	return nil
+}
+
+func TestCommitRecordsPostCommitScriptOutput(t *testing.T) {
+	oldParser := ConfigTextParser
+	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
+		cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(cfg), nil
+	}
+	t.Cleanup(func() { ConfigTextParser = oldParser })
+
+	dir := t.TempDir()
+	script := filepath.Join(dir, "notify")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\necho \"notify failed\"\nexit 3\n"), 0o755); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	eng := engine.NewEngine(nil, testLogger())
+	eng.InitializeRunning(&model.RouterConfig{
+		System: &model.SystemConfig{HostName: "router1"},
+	}, 1)
+	st := &noteRecordingStore{fakeStore: &fakeStore{commitID: "commit-1"}}
+	srv := NewServer(eng, st, testLogger())
+	srv.SetCommitScriptRunner(&commitscript.Runner{Dir: dir, Timeout: 5 * time.Second})
+	ctx := context.Background()
+
+	sessionID, err := srv.CreateSession(ctx, "alice")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	if err := srv.AcquireLock(ctx, sessionID, "alice"); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := srv.ReplaceCandidate(ctx, sessionID, "set system host-name router2\nset system scripts commit post "+script); err != nil {
+		t.Fatalf("ReplaceCandidate() error = %v", err)
+	}
+	commitID, _, err := srv.Commit(ctx, sessionID, "alice", "")
+	if err != nil {
+		t.Fatalf("Commit() error = %v, want success despite failing post-commit script", err)
+	}
+	want := "post-commit script " + script + ": exit status 3\nnotify failed"
+	if got := st.notes[commitID]; !strings.Contains(got, want) {
+		t.Fatalf("commit %s note = %q, want %q", commitID, got, want)
+	}
+}
+
 func TestCommitPropagatesGRPCCorrelationID(t *testing.T) {
 	oldParser := ConfigTextParser
 	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
@@ -1035,6 +1314,125 @@ func TestSubscribeTelemetryRejectsUnsupportedPath(t *testing.T) {
 	}
 }
 
+func TestTelemetryPublisherStreamsInterfaceCounters(t *testing.T) {
+	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
+	ctx := context.Background()
+
+	vppClient := pkgvpp.NewMockClient()
+	if err := vppClient.Connect(ctx); err != nil {
+		t.Fatalf("mock VPP Connect() error = %v", err)
+	}
+	iface, err := vppClient.CreateInterface(ctx, &pkgvpp.CreateInterfaceRequest{Type: pkgvpp.InterfaceTypeTap})
+	if err != nil {
+		t.Fatalf("mock VPP CreateInterface() error = %v", err)
+	}
+	if err := vppClient.SetInterfaceUp(ctx, iface.SwIfIndex); err != nil {
+		t.Fatalf("mock VPP SetInterfaceUp() error = %v", err)
+	}
+	vppClient.SetInterfaceCounters(iface.SwIfIndex, pkgvpp.InterfaceCounters{
+		RxPackets: 10,
+		TxPackets: 20,
+		RxBytes:   1500,
+		TxBytes:   3000,
+		RxErrors:  1,
+		TxErrors:  2,
+	})
+	if err := vppClient.Close(); err != nil {
+		t.Fatalf("mock VPP Close() error = %v", err)
+	}
+	oldVPPClient := newOperationalVPPClient
+	newOperationalVPPClient = func() pkgvpp.Client { return vppClient }
+	t.Cleanup(func() { newOperationalVPPClient = oldVPPClient })
+
+	publisher, err := NewTelemetryPublisher(srv, []string{"/interfaces/counters"}, 0)
+	if err != nil {
+		t.Fatalf("NewTelemetryPublisher() error = %v", err)
+	}
+	if paths := publisher.Paths(); len(paths) != 1 || paths[0] != "/interfaces/interface/state/counters" {
+		t.Fatalf("Paths() = %#v, want canonical counters path", paths)
+	}
+	if publisher.Interval() != defaultTelemetrySampleInterval {
+		t.Fatalf("Interval() = %s, want %s", publisher.Interval(), defaultTelemetrySampleInterval)
+	}
+
+	consumer := publisher.Subscribe(1)
+	removed := publisher.Subscribe(1)
+	publisher.Unsubscribe(removed)
+	if _, ok := <-removed.Events(); ok {
+		t.Fatal("unsubscribed channel is still open")
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() { done <- publisher.Run(runCtx) }()
+
+	var event TelemetryEvent
+	select {
+	case event = <-consumer.Events():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for telemetry event")
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if _, ok := <-consumer.Events(); ok {
+		t.Fatal("subscriber channel still open after Run returned")
+	}
+
+	if event.Path != "/interfaces/interface/state/counters" || event.EventType != telemetryEventTypeSnapshot ||
+		event.Cardinality != "per-interface" || event.PayloadSchema != "arca.telemetry.interfaces.counters.v1" {
+		t.Fatalf("event = %#v, want interface counters snapshot", event)
+	}
+	var payload struct {
+		Interfaces []InterfaceCountersInfo `json:"interfaces"`
+	}
+	if err := json.Unmarshal([]byte(event.JSONPayload), &payload); err != nil {
+		t.Fatalf("counters payload is invalid JSON: %v", err)
+	}
+	want := InterfaceCountersInfo{
+		Name:       iface.Name,
+		OperStatus: "up",
+		InPkts:     10,
+		OutPkts:    20,
+		InOctets:   1500,
+		OutOctets:  3000,
+		InErrors:   1,
+		OutErrors:  2,
+	}
+	if len(payload.Interfaces) != 1 || payload.Interfaces[0] != want {
+		t.Fatalf("counters payload = %#v, want %#v", payload.Interfaces, want)
+	}
+
+	if late := publisher.Subscribe(1); late != nil {
+		if _, ok := <-late.Events(); ok {
+			t.Fatal("subscription after Run returned is open")
+		}
+	}
+}
+
+func TestTelemetryPublisherDropsEventsForSlowSubscriber(t *testing.T) {
+	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
+	publisher, err := NewTelemetryPublisher(srv, nil, 0)
+	if err != nil {
+		t.Fatalf("NewTelemetryPublisher() error = %v", err)
+	}
+	sub := publisher.Subscribe(1)
+	for i := uint64(1); i <= 3; i++ {
+		publisher.publish(TelemetryEvent{Sequence: i})
+	}
+	if got := (<-sub.Events()).Sequence; got != 1 {
+		t.Fatalf("first event sequence = %d, want 1", got)
+	}
+	if sub.Dropped() != 2 {
+		t.Fatalf("Dropped() = %d, want 2", sub.Dropped())
+	}
+
+	if _, err := NewTelemetryPublisher(srv, []string{"/unsupported"}, 0); err == nil {
+		t.Fatal("NewTelemetryPublisher(/unsupported) error = nil, want unsupported path")
+	}
+}
+
 func TestTelemetryAdapterClassifiesUnsupportedPath(t *testing.T) {
 	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
 	adapter := &telemetryServiceAdapter{server: srv}
@@ -1306,7 +1704,7 @@ func routeInfoByPrefix(routes []RouteInfo, prefix string) (RouteInfo, bool) {
 	return RouteInfo{}, false
 }
 
-func TestOperationalStateEndpointsReadVPPAndFRR(t *testing.T) {
+func TestGetNeighborsReadsVPPNeighborTable(t *testing.T) {
 	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
 	ctx := context.Background()
 
@@ -1318,34 +1716,11 @@ func TestOperationalStateEndpointsReadVPPAndFRR(t *testing.T) {
 	if err != nil {
 		t.Fatalf("mock VPP CreateInterface() error = %v", err)
 	}
-	if err := vppClient.SetInterfaceUp(ctx, iface.SwIfIndex); err != nil {
-		t.Fatalf("mock VPP SetInterfaceUp() error = %v", err)
-	}
-	vppClient.SetInterfaceCounters(iface.SwIfIndex, pkgvpp.InterfaceCounters{
-		RxPackets: 100,
-		TxPackets: 200,
-		RxBytes:   1000,
-		TxBytes:   2000,
-		RxErrors:  1,
-		TxErrors:  2,
-	})
-	vppClient.SetInterfaceQueuePlacements(iface.SwIfIndex, pkgvpp.InterfaceQueuePlacements{
-		Rx: []pkgvpp.InterfaceRxQueuePlacement{
-			{QueueID: 0, WorkerID: 1, Mode: "polling"},
-		},
-		Tx: []pkgvpp.InterfaceTxQueuePlacement{
-			{QueueID: 0, Shared: true, Threads: []uint32{0, 2}},
-		},
-	})
-	if err := vppClient.SetQoSProfile(ctx, iface.SwIfIndex, pkgvpp.QoSProfile{Name: "WAN"}); err != nil {
-		t.Fatalf("mock VPP SetQoSProfile() error = %v", err)
-	}
-	for _, isIPv6 := range []bool{false, true} {
-		if err := vppClient.AddIPTable(ctx, pkgvpp.IPTable{ID: 100, IsIPv6: isIPv6, Name: "BLUE"}); err != nil {
-			t.Fatalf("mock VPP AddIPTable(IPv6=%t) error = %v", isIPv6, err)
-		}
-		if err := vppClient.SetInterfaceTable(ctx, iface.SwIfIndex, 100, isIPv6); err != nil {
-			t.Fatalf("mock VPP SetInterfaceTable(IPv6=%t) error = %v", isIPv6, err)
+	mac, _ := net.ParseMAC("00:11:22:33:44:55")
+	for _, ip := range []string{"192.0.2.10", "192.0.2.9", "2001:db8::10"} {
+		neighbor := pkgvpp.Neighbor{SwIfIndex: iface.SwIfIndex, IP: net.ParseIP(ip), MAC: mac, Static: ip != "192.0.2.9"}
+		if err := vppClient.AddNeighbor(ctx, neighbor); err != nil {
+			t.Fatalf("mock VPP AddNeighbor(%s) error = %v", ip, err)
 		}
 	}
 	if err := vppClient.Close(); err != nil {
@@ -1356,30 +1731,170 @@ func TestOperationalStateEndpointsReadVPPAndFRR(t *testing.T) {
 	newOperationalVPPClient = func() pkgvpp.Client { return vppClient }
 	t.Cleanup(func() { newOperationalVPPClient = oldVPPClient })
 
-	ifaces, err := srv.GetInterfaces(ctx, "")
+	arp, err := srv.GetNeighbors(ctx, "", "")
 	if err != nil {
-		t.Fatalf("GetInterfaces() error = %v", err)
+		t.Fatalf("GetNeighbors(inet) error = %v", err)
 	}
-	if len(ifaces) != 1 {
-		t.Fatalf("GetInterfaces() returned %d interfaces, want 1", len(ifaces))
+	if len(arp) != 2 || arp[0].Address != "192.0.2.9" || arp[0].Static || arp[1].Address != "192.0.2.10" || !arp[1].Static {
+		t.Fatalf("GetNeighbors(inet) = %#v, want two IPv4 entries in address order", arp)
 	}
-	if ifaces[0].Name != iface.Name || ifaces[0].AdminStatus != "up" || ifaces[0].OperStatus != "up" {
-		t.Fatalf("GetInterfaces()[0] = %#v, want operational VPP state", ifaces[0])
+	if arp[0].Interface != iface.Name || arp[0].MAC != "00:11:22:33:44:55" {
+		t.Fatalf("GetNeighbors(inet)[0] = %#v, want interface %s", arp[0], iface.Name)
 	}
-	if ifaces[0].RxPackets != 100 || ifaces[0].TxPackets != 200 || ifaces[0].RxBytes != 1000 || ifaces[0].TxBytes != 2000 || ifaces[0].RxErrors != 1 || ifaces[0].TxErrors != 2 {
-		t.Fatalf("GetInterfaces()[0] counters = %#v, want VPP counters", ifaces[0])
+
+	nd, err := srv.GetNeighbors(ctx, "inet6", "")
+	if err != nil {
+		t.Fatalf("GetNeighbors(inet6) error = %v", err)
 	}
-	if ifaces[0].QoSProfile != "WAN" {
-		t.Fatalf("GetInterfaces()[0] QoSProfile = %q, want WAN", ifaces[0].QoSProfile)
+	if len(nd) != 1 || nd[0].Address != "2001:db8::10" {
+		t.Fatalf("GetNeighbors(inet6) = %#v, want one IPv6 entry", nd)
 	}
-	if ifaces[0].IPv4TableID != 100 || ifaces[0].IPv6TableID != 100 {
-		t.Fatalf("GetInterfaces()[0] table IDs = %d/%d, want 100/100", ifaces[0].IPv4TableID, ifaces[0].IPv6TableID)
+
+	if filtered, err := srv.GetNeighbors(ctx, "inet", "missing"); err != nil || len(filtered) != 0 {
+		t.Fatalf("GetNeighbors(name filter) = %#v, %v, want no entries", filtered, err)
 	}
-	if len(ifaces[0].RxQueues) != 1 || ifaces[0].RxQueues[0].WorkerID != 1 || ifaces[0].RxQueues[0].Mode != "polling" {
-		t.Fatalf("GetInterfaces()[0] RX queues = %#v, want VPP queue placement", ifaces[0].RxQueues)
+	if _, err := srv.GetNeighbors(ctx, "mpls", ""); err == nil {
+		t.Fatal("GetNeighbors(mpls) error = nil, want invalid family error")
 	}
-	if len(ifaces[0].TxQueues) != 1 || !ifaces[0].TxQueues[0].Shared || len(ifaces[0].TxQueues[0].Threads) != 2 || ifaces[0].TxQueues[0].Threads[1] != 2 {
-		t.Fatalf("GetInterfaces()[0] TX queues = %#v, want VPP queue placement", ifaces[0].TxQueues)
+}
+
+func TestClearInterfaceStatisticsResetsVPPCounters(t *testing.T) {
+	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
+	ctx := context.Background()
+
+	vppClient := pkgvpp.NewMockClient()
+	if err := vppClient.Connect(ctx); err != nil {
+		t.Fatalf("mock VPP Connect() error = %v", err)
+	}
+	var ifaces []*pkgvpp.Interface
+	for i := 0; i < 2; i++ {
+		iface, err := vppClient.CreateInterface(ctx, &pkgvpp.CreateInterfaceRequest{Type: pkgvpp.InterfaceTypeTap})
+		if err != nil {
+			t.Fatalf("mock VPP CreateInterface() error = %v", err)
+		}
+		vppClient.SetInterfaceCounters(iface.SwIfIndex, pkgvpp.InterfaceCounters{RxPackets: 10})
+		ifaces = append(ifaces, iface)
+	}
+	if err := vppClient.Close(); err != nil {
+		t.Fatalf("mock VPP Close() error = %v", err)
+	}
+	remainingCounters := func() map[uint32]pkgvpp.InterfaceCounters {
+		t.Helper()
+		if err := vppClient.Connect(ctx); err != nil {
+			t.Fatalf("mock VPP Connect() error = %v", err)
+		}
+		defer func() { _ = vppClient.Close() }()
+		counters, err := vppClient.ListInterfaceCounters(ctx)
+		if err != nil {
+			t.Fatalf("mock VPP ListInterfaceCounters() error = %v", err)
+		}
+		return counters
+	}
+
+	oldVPPClient := newOperationalVPPClient
+	newOperationalVPPClient = func() pkgvpp.Client { return vppClient }
+	t.Cleanup(func() { newOperationalVPPClient = oldVPPClient })
+
+	if err := srv.ClearInterfaceStatistics(ctx, ifaces[0].Name); err != nil {
+		t.Fatalf("ClearInterfaceStatistics(%s) error = %v", ifaces[0].Name, err)
+	}
+	counters := remainingCounters()
+	if _, ok := counters[ifaces[0].SwIfIndex]; ok || len(counters) != 1 {
+		t.Fatalf("counters = %#v, want only %s left", counters, ifaces[1].Name)
+	}
+	if err := srv.ClearInterfaceStatistics(ctx, ""); err != nil {
+		t.Fatalf("ClearInterfaceStatistics(all) error = %v", err)
+	}
+	if counters := remainingCounters(); len(counters) != 0 {
+		t.Fatalf("counters = %#v, want none", counters)
+	}
+
+	adapter := &stateServiceAdapter{server: srv}
+	_, err := adapter.ClearInterfaceStatistics(ctx, &apiv1.ClearInterfaceStatisticsRequest{Name: "missing"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ClearInterfaceStatistics(missing) error = %v, want InvalidArgument", err)
+	}
+	vppClient.ConnectError = errors.New("dial unix /run/vpp/api.sock: connection refused")
+	_, err = adapter.ClearInterfaceStatistics(ctx, &apiv1.ClearInterfaceStatisticsRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("ClearInterfaceStatistics() with VPP down error = %v, want Unavailable", err)
+	}
+}
+
+func TestOperationalStateEndpointsReadVPPAndFRR(t *testing.T) {
+	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
+	ctx := context.Background()
+
+	vppClient := pkgvpp.NewMockClient()
+	if err := vppClient.Connect(ctx); err != nil {
+		t.Fatalf("mock VPP Connect() error = %v", err)
+	}
+	iface, err := vppClient.CreateInterface(ctx, &pkgvpp.CreateInterfaceRequest{Type: pkgvpp.InterfaceTypeTap})
+	if err != nil {
+		t.Fatalf("mock VPP CreateInterface() error = %v", err)
+	}
+	if err := vppClient.SetInterfaceUp(ctx, iface.SwIfIndex); err != nil {
+		t.Fatalf("mock VPP SetInterfaceUp() error = %v", err)
+	}
+	vppClient.SetInterfaceCounters(iface.SwIfIndex, pkgvpp.InterfaceCounters{
+		RxPackets: 100,
+		TxPackets: 200,
+		RxBytes:   1000,
+		TxBytes:   2000,
+		RxErrors:  1,
+		TxErrors:  2,
+	})
+	vppClient.SetInterfaceQueuePlacements(iface.SwIfIndex, pkgvpp.InterfaceQueuePlacements{
+		Rx: []pkgvpp.InterfaceRxQueuePlacement{
+			{QueueID: 0, WorkerID: 1, Mode: "polling"},
+		},
+		Tx: []pkgvpp.InterfaceTxQueuePlacement{
+			{QueueID: 0, Shared: true, Threads: []uint32{0, 2}},
+		},
+	})
+	if err := vppClient.SetQoSProfile(ctx, iface.SwIfIndex, pkgvpp.QoSProfile{Name: "WAN"}); err != nil {
+		t.Fatalf("mock VPP SetQoSProfile() error = %v", err)
+	}
+	for _, isIPv6 := range []bool{false, true} {
+		if err := vppClient.AddIPTable(ctx, pkgvpp.IPTable{ID: 100, IsIPv6: isIPv6, Name: "BLUE"}); err != nil {
+			t.Fatalf("mock VPP AddIPTable(IPv6=%t) error = %v", isIPv6, err)
+		}
+		if err := vppClient.SetInterfaceTable(ctx, iface.SwIfIndex, 100, isIPv6); err != nil {
+			t.Fatalf("mock VPP SetInterfaceTable(IPv6=%t) error = %v", isIPv6, err)
+		}
+	}
+	if err := vppClient.Close(); err != nil {
+		t.Fatalf("mock VPP Close() error = %v", err)
+	}
+
+	oldVPPClient := newOperationalVPPClient
+	newOperationalVPPClient = func() pkgvpp.Client { return vppClient }
+	t.Cleanup(func() { newOperationalVPPClient = oldVPPClient })
+
+	ifaces, err := srv.GetInterfaces(ctx, "")
+	if err != nil {
+		t.Fatalf("GetInterfaces() error = %v", err)
+	}
+	if len(ifaces) != 1 {
+		t.Fatalf("GetInterfaces() returned %d interfaces, want 1", len(ifaces))
+	}
+	if ifaces[0].Name != iface.Name || ifaces[0].AdminStatus != "up" || ifaces[0].OperStatus != "up" {
+		t.Fatalf("GetInterfaces()[0] = %#v, want operational VPP state", ifaces[0])
+	}
+	if ifaces[0].RxPackets != 100 || ifaces[0].TxPackets != 200 || ifaces[0].RxBytes != 1000 || ifaces[0].TxBytes != 2000 || ifaces[0].RxErrors != 1 || ifaces[0].TxErrors != 2 {
+		t.Fatalf("GetInterfaces()[0] counters = %#v, want VPP counters", ifaces[0])
+	}
+	if ifaces[0].QoSProfile != "WAN" {
+		t.Fatalf("GetInterfaces()[0] QoSProfile = %q, want WAN", ifaces[0].QoSProfile)
+	}
+	if ifaces[0].IPv4TableID != 100 || ifaces[0].IPv6TableID != 100 {
+		t.Fatalf("GetInterfaces()[0] table IDs = %d/%d, want 100/100", ifaces[0].IPv4TableID, ifaces[0].IPv6TableID)
+	}
+	if len(ifaces[0].RxQueues) != 1 || ifaces[0].RxQueues[0].WorkerID != 1 || ifaces[0].RxQueues[0].Mode != "polling" {
+		t.Fatalf("GetInterfaces()[0] RX queues = %#v, want VPP queue placement", ifaces[0].RxQueues)
+	}
+	if len(ifaces[0].TxQueues) != 1 || !ifaces[0].TxQueues[0].Shared || len(ifaces[0].TxQueues[0].Threads) != 2 || ifaces[0].TxQueues[0].Threads[1] != 2 {
+		t.Fatalf("GetInterfaces()[0] TX queues = %#v, want VPP queue placement", ifaces[0].TxQueues)
 	}
 
 	var commands []string
@@ -1520,6 +2035,49 @@ func TestGetRoutesUsesFRRJSON(t *testing.T) {
 	}
 }
 
+func TestGetRouteSummaryUsesFRRSummaryJSON(t *testing.T) {
+	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
+	adapter := &stateServiceAdapter{server: srv}
+	ctx := context.Background()
+
+	var commands []string
+	oldVtysh := runOperationalVtyshCommand
+	runOperationalVtyshCommand = func(ctx context.Context, command string) (string, error) {
+		commands = append(commands, command)
+		switch command {
+		case "show ip route summary json":
+			return `{"routes":[
+				{"fib":2,"rib":2,"type":"connected"},
+				{"fib":3,"rib":3,"type":"ebgp"},
+				{"fib":1,"rib":1,"type":"ibgp"}
+			],"routesTotal":6,"routesTotalFib":6}`, nil
+		case "show ipv6 route summary json":
+			return `{"routes":[{"fib":1,"rib":1,"type":"ospf6"}],"routesTotal":1,"routesTotalFib":1}`, nil
+		default:
+			t.Fatalf("unexpected vtysh command %q", command)
+			return "", nil
+		}
+	}
+	t.Cleanup(func() { runOperationalVtyshCommand = oldVtysh })
+
+	resp, err := adapter.GetRouteSummary(ctx, &apiv1.GetRouteSummaryRequest{})
+	if err != nil {
+		t.Fatalf("GetRouteSummary() error = %v", err)
+	}
+	if len(commands) != 2 {
+		t.Fatalf("vtysh commands = %v, want only the two summary commands", commands)
+	}
+	families := resp.GetFamilies()
+	if len(families) != 2 || families[0].GetFamily() != "inet" || families[0].GetTotal() != 6 ||
+		families[1].GetFamily() != "inet6" || families[1].GetTotal() != 1 {
+		t.Fatalf("families = %v, want inet total 6 and inet6 total 1", families)
+	}
+	if got := families[0].GetProtocols(); len(got) != 2 || got[0].GetProtocol() != "bgp" ||
+		got[0].GetRoutes() != 4 || got[0].GetFibRoutes() != 4 {
+		t.Fatalf("inet protocols = %v, want eBGP and iBGP folded into bgp", got)
+	}
+}
+
 func TestGetBGPNeighborsUsesFRRJSON(t *testing.T) {
 	srv := NewServer(engine.NewEngine(nil, testLogger()), &fakeStore{}, testLogger())
 	ctx := context.Background()
@@ -2304,6 +2862,64 @@ func TestListHistoryBoundsLimit(t *testing.T) {
 	}
 }
 
+func TestConfigChangesRecordAuditUserSourceAndChanges(t *testing.T) {
+	oldParser := ConfigTextParser
+	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
+		cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(cfg), nil
+	}
+	t.Cleanup(func() { ConfigTextParser = oldParser })
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 50051},
+	})
+	eng := engine.NewEngine(nil, testLogger())
+	eng.InitializeRunning(&model.RouterConfig{
+		System:     &model.SystemConfig{HostName: "router1"},
+		Interfaces: map[string]*model.InterfaceConfig{},
+	}, 1)
+	st := &fakeStore{commitID: "commit-1"}
+	srv := NewServer(eng, st, testLogger())
+
+	sessionID, err := srv.CreateSession(ctx, "alice")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	if err := srv.AcquireLock(ctx, sessionID, "alice"); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := srv.EditCandidate(ctx, sessionID, "set system host-name router2"); err != nil {
+		t.Fatalf("EditCandidate() error = %v", err)
+	}
+	if err := srv.EditCandidate(ctx, sessionID, "set system services snmp community s3cret"); err != nil {
+		t.Fatalf("EditCandidate() error = %v", err)
+	}
+
+	if len(st.auditEvents) != 2 {
+		t.Fatalf("audit events = %d, want one per edit", len(st.auditEvents))
+	}
+	event := st.auditEvents[0]
+	if event.Action != "edit_config" || event.User != "alice" || event.SessionID != sessionID || event.SourceIP != "192.0.2.10" {
+		t.Fatalf("audit event = %+v, want alice's edit from 192.0.2.10", event)
+	}
+	if event.Details["operation"] != "set" || event.Details["changes"] != "+ set system host-name router2; - set system host-name router1" {
+		t.Fatalf("audit details = %v, want host-name change", event.Details)
+	}
+	if changes := st.auditEvents[1].Details["changes"]; changes != "+ set system services snmp community <redacted>" {
+		t.Fatalf("audit changes = %v, want redacted SNMP community", changes)
+	}
+
+	if _, _, err := srv.Commit(ctx, sessionID, "alice", "rename"); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if st.saved == nil || st.saved.Author != "alice" || st.saved.SourceIP != "192.0.2.10" {
+		t.Fatalf("commit snapshot = %+v, want alice from 192.0.2.10 for the commit audit entry", st.saved)
+	}
+}
+
 func TestListAuditEventsBoundsLimit(t *testing.T) {
 	eng := engine.NewEngine(nil, testLogger())
 	st := &fakeStore{}
@@ -2772,6 +3388,81 @@ func TestCommitRollsBackEngineWhenPersistenceFails(t *testing.T) {
 	}
 }
 
+// blockingVPPPlugin stands in for a VPP plugin whose apply never completes.
+type blockingVPPPlugin struct {
+	rollbackCalls int
+}
+
+func (p *blockingVPPPlugin) Name() string { return "vpp" }
+
+func (p *blockingVPPPlugin) Init(context.Context) error { return nil }
+
+func (p *blockingVPPPlugin) Close() error { return nil }
+
+func (p *blockingVPPPlugin) HealthCheck(context.Context) error { return nil }
+
+func (p *blockingVPPPlugin) ValidateChanges(context.Context, *engine.ConfigDiff) error { return nil }
+
+func (p *blockingVPPPlugin) ApplyChanges(ctx context.Context, _ *engine.ConfigDiff) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (p *blockingVPPPlugin) RollbackChanges(ctx context.Context, _ *engine.ConfigDiff) error {
+	p.rollbackCalls++
+	return ctx.Err()
+}
+
+func TestCommitTimesOutAndRollsBackWhenApplyBlocks(t *testing.T) {
+	oldParser := ConfigTextParser
+	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
+		cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(cfg), nil
+	}
+	t.Cleanup(func() { ConfigTextParser = oldParser })
+
+	vpp := &blockingVPPPlugin{}
+	eng := engine.NewEngine([]engine.Plugin{vpp}, testLogger())
+	eng.SetApplyTimeout(50 * time.Millisecond)
+	eng.InitializeRunning(&model.RouterConfig{
+		System:     &model.SystemConfig{HostName: "router1"},
+		Interfaces: map[string]*model.InterfaceConfig{},
+	}, 1)
+	st := &fakeStore{commitID: "commit-1"}
+	srv := NewServer(eng, st, testLogger())
+	ctx := context.Background()
+	sessionID, err := srv.CreateSession(ctx, "alice")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	if err := srv.AcquireLock(ctx, sessionID, "alice"); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := srv.EditCandidate(ctx, sessionID, "set system host-name router2"); err != nil {
+		t.Fatalf("EditCandidate() error = %v", err)
+	}
+
+	_, _, err = srv.Commit(ctx, sessionID, "alice", "stuck data plane")
+	if !errors.Is(err, engine.ErrApplyTimeout) || !strings.Contains(err.Error(), "timed out after 50ms") {
+		t.Fatalf("Commit() error = %v, want apply timeout", err)
+	}
+	if statusErr := configEditStatusError(err); status.Code(statusErr) != codes.DeadlineExceeded || !strings.Contains(statusErr.Error(), "rollback succeeded") {
+		t.Fatalf("status = %v, want deadline exceeded with rollback succeeded", statusErr)
+	}
+	if vpp.rollbackCalls != 1 {
+		t.Fatalf("rollback calls = %d, want 1", vpp.rollbackCalls)
+	}
+	if !st.aborted {
+		t.Fatal("Commit() did not abort prepared persistence after apply timeout")
+	}
+	if got := eng.Running().System.HostName; got != "router1" {
+		t.Fatalf("engine running hostname = %q, want unchanged router1", got)
+	}
+}
+
 func TestRollbackAppliesCommitConfig(t *testing.T) {
 	oldParser := ConfigTextParser
 	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
@@ -2827,6 +3518,92 @@ func TestRollbackAppliesCommitConfig(t *testing.T) {
 	}
 }
 
+func TestSecurityChangesRequireAdminRoleOnBulkPaths(t *testing.T) {
+	parse := func(text string) (*model.RouterConfig, error) {
+		cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(cfg), nil
+	}
+	oldParser := ConfigTextParser
+	ConfigTextParser = parse
+	t.Cleanup(func() { ConfigTextParser = oldParser })
+
+	running, err := parse("set system host-name router2\nset security netconf ssh port 830\n")
+	if err != nil {
+		t.Fatalf("parse running config: %v", err)
+	}
+	target, err := parse("set system host-name router1\nset security netconf ssh port 1830\n")
+	if err != nil {
+		t.Fatalf("parse rollback config: %v", err)
+	}
+	eng := engine.NewEngine(nil, testLogger())
+	eng.InitializeRunning(running, 2)
+	st := &fakeStore{
+		commitID: "rollback-1",
+		commits: map[string]*store.CommitRecord{
+			"commit-old": {CommitID: "commit-old", Config: target},
+		},
+	}
+	srv := NewServer(eng, st, testLogger())
+	operator := contextWithGRPCRole(context.Background(), "operator")
+	sessionID, err := srv.CreateSession(operator, "oper")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	if err := srv.AcquireLock(operator, sessionID, "oper"); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+
+	wantDenied := func(name string, err error) {
+		t.Helper()
+		if !errors.Is(err, ErrPermissionDenied) {
+			t.Fatalf("%s error = %v, want permission denied", name, err)
+		}
+		if code := status.Code(configEditStatusError(err)); code != codes.PermissionDenied {
+			t.Fatalf("%s status = %s, want PermissionDenied", name, code)
+		}
+	}
+
+	wantDenied("operator EditCandidate(security)", srv.EditCandidate(operator, sessionID, "set security netconf ssh port 1830"))
+	if err := srv.EditCandidate(operator, sessionID, "set system host-name edited"); err != nil {
+		t.Fatalf("operator EditCandidate(host-name) error = %v", err)
+	}
+
+	// restore configuration and replace both reach the daemon as a
+	// candidate replacement.
+	wantDenied("operator ReplaceCandidate(security)",
+		srv.ReplaceCandidate(operator, sessionID, "set system host-name restored\nset security netconf ssh port 1830\n"))
+	if err := srv.ReplaceCandidate(operator, sessionID, "set system host-name restored\nset security netconf ssh port 830\n"); err != nil {
+		t.Fatalf("operator ReplaceCandidate(host-name) error = %v", err)
+	}
+	candidate, err := srv.GetCandidate(operator, sessionID)
+	if err != nil {
+		t.Fatalf("GetCandidate() error = %v", err)
+	}
+	if strings.Contains(candidate, "port 1830") {
+		t.Fatalf("candidate = %q, want security change rejected", candidate)
+	}
+
+	_, _, err = srv.Rollback(operator, sessionID, "commit-old", "oper", "")
+	wantDenied("operator Rollback", err)
+	if got := eng.Running().System.HostName; got != "router2" {
+		t.Fatalf("running hostname after denied rollback = %q, want router2", got)
+	}
+
+	wantDenied("unknown role EditCandidate",
+		srv.EditCandidate(contextWithGRPCRole(context.Background(), ""), sessionID, "set system host-name unknown"))
+
+	admin := contextWithGRPCRole(context.Background(), "admin")
+	if _, _, err := srv.Rollback(admin, sessionID, "commit-old", "root", ""); err != nil {
+		t.Fatalf("admin Rollback() error = %v", err)
+	}
+	if got := eng.Running().System.HostName; got != "router1" {
+		t.Fatalf("running hostname after admin rollback = %q, want router1", got)
+	}
+}
+
 func TestRollbackDoesNotApplyEngineWhenPersistencePrepareFails(t *testing.T) {
 	eng := engine.NewEngine(nil, testLogger())
 	eng.InitializeRunning(&model.RouterConfig{
@@ -2998,6 +3775,46 @@ func TestApplyCandidateCommandPreservesOSPFInterfaceAttributes(t *testing.T) {
 	}
 }
 
+func TestApplyCandidateCommandRejectsRedactedMarker(t *testing.T) {
+	for _, command := range []string{
+		`set system services snmp community "<redacted>"`,
+		"set security users user alice password <redacted>",
+	} {
+		if _, err := applyCandidateCommand("", command); err == nil || !strings.Contains(err.Error(), "redacted marker") {
+			t.Fatalf("applyCandidateCommand(%q) error = %v, want redacted marker rejected", command, err)
+		}
+	}
+}
+
+func TestApplyCandidateCommandExpandsValueList(t *testing.T) {
+	candidate := "set policy-options prefix-list PL 192.168.0.0/16"
+
+	updated, err := applyCandidateCommand(candidate, "set policy-options prefix-list PL [ 10.0.0.0/8 172.16.0.0/12 ]")
+	if err != nil {
+		t.Fatalf("applyCandidateCommand(set) error = %v", err)
+	}
+	want := strings.Join([]string{
+		"set policy-options prefix-list PL 192.168.0.0/16",
+		"set policy-options prefix-list PL 10.0.0.0/8",
+		"set policy-options prefix-list PL 172.16.0.0/12",
+	}, "\n")
+	if updated != want {
+		t.Fatalf("candidate after set =\n%s\nwant\n%s", updated, want)
+	}
+
+	updated, err = applyCandidateCommand(updated, "delete policy-options prefix-list PL [ 10.0.0.0/8 192.168.0.0/16 ]")
+	if err != nil {
+		t.Fatalf("applyCandidateCommand(delete) error = %v", err)
+	}
+	if want := "set policy-options prefix-list PL 172.16.0.0/12"; updated != want {
+		t.Fatalf("candidate after delete =\n%s\nwant\n%s", updated, want)
+	}
+
+	if _, err := applyCandidateCommand(updated, "set policy-options prefix-list PL [ 10.0.0.0/8"); err == nil {
+		t.Fatal("applyCandidateCommand(unterminated list) error = nil, want error")
+	}
+}
+
 func TestApplyCandidateCommandReplacesBFDAttributes(t *testing.T) {
 	candidate := strings.Join([]string{
 		"set protocols bfd profile fast receive-interval 150",
@@ -3172,3 +3989,267 @@ func TestApplyCandidateCommandPreservesRoutingInstancePolicyLists(t *testing.T)
 		}
 	}
 }
+
+func TestApplyCandidateCommandInsertReordersPolicyTermsAndPrefixes(t *testing.T) {
+	candidate := strings.Join([]string{
+		"set policy-options prefix-list PL 192.0.2.0/24",
+		"set policy-options prefix-list PL 198.51.100.0/24",
+		"set policy-options policy-statement EXPORT term T1 then accept",
+		"set policy-options policy-statement EXPORT term T2 then reject",
+	}, "\n")
+
+	updated, err := applyCandidateCommand(candidate, strings.Join([]string{
+		"set policy-options policy-statement EXPORT term T3 from protocol static",
+		"set policy-options policy-statement EXPORT term T3 then accept",
+		"insert policy-options policy-statement EXPORT term T3 before term T1",
+		"insert policy-options prefix-list PL 198.51.100.0/24 before 192.0.2.0/24",
+		"insert policy-options policy-statement EXPORT term T2 first",
+	}, "\n"))
+	if err != nil {
+		t.Fatalf("applyCandidateCommand() error = %v", err)
+	}
+
+	cfg, err := pkgconfig.NewParser(strings.NewReader(updated)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	var terms []string
+	for _, term := range cfg.PolicyOptions.PolicyStatements["EXPORT"].Terms {
+		terms = append(terms, term.Name)
+	}
+	if got := strings.Join(terms, ","); got != "T2,T3,T1" {
+		t.Fatalf("term order = %s, want T2,T3,T1", got)
+	}
+	if got := strings.Join(cfg.PolicyOptions.PrefixLists["PL"].Prefixes, ","); got != "198.51.100.0/24,192.0.2.0/24" {
+		t.Fatalf("prefix order = %s, want 198.51.100.0/24,192.0.2.0/24", got)
+	}
+
+	// The order survives serialization of the committed config.
+	text, err := pkgconfig.ToSetCommandsWithError(cfg)
+	if err != nil {
+		t.Fatalf("ToSetCommandsWithError() error = %v", err)
+	}
+	first := strings.Index(text, "prefix-list PL 198.51.100.0/24")
+	second := strings.Index(text, "prefix-list PL 192.0.2.0/24")
+	if first < 0 || second < 0 || first > second {
+		t.Fatalf("serialized prefix-list order lost:\n%s", text)
+	}
+
+	last, err := applyCandidateCommand(updated, "insert policy-options prefix-list PL 198.51.100.0/24 last")
+	if err != nil {
+		t.Fatalf("applyCandidateCommand(last) error = %v", err)
+	}
+	if first, second := strings.Index(last, "PL 192.0.2.0/24"), strings.Index(last, "PL 198.51.100.0/24"); first > second {
+		t.Fatalf("insert last did not move the prefix after the others:\n%s", last)
+	}
+
+	if _, err := applyCandidateCommand(candidate, "insert policy-options policy-statement EXPORT term T2 after term MISSING"); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Fatalf("applyCandidateCommand(missing anchor) error = %v, want does not exist", err)
+	}
+}
+
+func TestApplyCandidateCommandDeletesSingleInterfaceAddress(t *testing.T) {
+	candidate := strings.Join([]string{
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.168.1.1/24",
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.168.1.10/24",
+		"deactivate interfaces ge-0/0/0 unit 0 family inet address 192.168.1.1/24",
+	}, "\n")
+
+	updated, err := applyCandidateCommand(candidate, "delete interfaces ge-0/0/0 unit 0 family inet address 192.168.1.1/24")
+	if err != nil {
+		t.Fatalf("applyCandidateCommand(delete address) error = %v", err)
+	}
+	if want := "set interfaces ge-0/0/0 unit 0 family inet address 192.168.1.10/24"; updated != want {
+		t.Fatalf("updated candidate = %q, want %q", updated, want)
+	}
+
+	if _, err := applyCandidateCommand(updated, "delete interfaces ge-0/0/0 unit 0 family inet address 192.168.1.1/24"); err == nil || !strings.Contains(err.Error(), "is not configured") {
+		t.Fatalf("delete missing address error = %v, want not configured", err)
+	}
+}
+
+func TestApplyCandidateCommandDeactivatesAndActivatesSubtree(t *testing.T) {
+	candidate := strings.Join([]string{
+		"set protocols bgp group EXTERNAL type external",
+		"set protocols bgp group EXTERNAL neighbor 203.0.113.1 peer-as 65001",
+	}, "\n")
+
+	updated, err := applyCandidateCommand(candidate, "deactivate protocols bgp group EXTERNAL")
+	if err != nil {
+		t.Fatalf("applyCandidateCommand(deactivate) error = %v", err)
+	}
+	if !strings.Contains(updated, "deactivate protocols bgp group EXTERNAL") || !strings.Contains(updated, "neighbor 203.0.113.1") {
+		t.Fatalf("deactivated candidate = %q, want statements kept and marked inactive", updated)
+	}
+	if again, err := applyCandidateCommand(updated, "deactivate protocols bgp group EXTERNAL"); err != nil || again != updated {
+		t.Fatalf("repeated deactivate = %q, %v, want unchanged candidate", again, err)
+	}
+
+	activated, err := applyCandidateCommand(updated, "activate protocols bgp group EXTERNAL")
+	if err != nil {
+		t.Fatalf("applyCandidateCommand(activate) error = %v", err)
+	}
+	if activated != candidate {
+		t.Fatalf("activated candidate = %q, want %q", activated, candidate)
+	}
+
+	deleted, err := applyCandidateCommand(updated, "delete protocols bgp")
+	if err != nil {
+		t.Fatalf("applyCandidateCommand(delete) error = %v", err)
+	}
+	if deleted != "" {
+		t.Fatalf("deleted candidate = %q, want inactive marker removed with subtree", deleted)
+	}
+
+	if _, err := applyCandidateCommand(candidate, "deactivate protocols ospf"); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Fatalf("deactivate missing statement error = %v", err)
+	}
+	if _, err := applyCandidateCommand(candidate, "activate protocols bgp group EXTERNAL"); err == nil || !strings.Contains(err.Error(), "not deactivated") {
+		t.Fatalf("activate active statement error = %v", err)
+	}
+}
+
+func newCandidateModeTestServer(t *testing.T) *Server {
+	t.Helper()
+	oldParser := ConfigTextParser
+	ConfigTextParser = func(text string) (*model.RouterConfig, error) {
+		cfg, err := pkgconfig.NewParser(strings.NewReader(text)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return model.FromLegacyConfig(cfg), nil
+	}
+	t.Cleanup(func() { ConfigTextParser = oldParser })
+
+	eng := engine.NewEngine(nil, testLogger())
+	eng.InitializeRunning(&model.RouterConfig{
+		System: &model.SystemConfig{HostName: "router1"},
+	}, 1)
+	return NewServer(eng, &fakeStore{commitID: "commit-1"}, testLogger())
+}
+
+func enterCandidateMode(t *testing.T, srv *Server, user, mode string) string {
+	t.Helper()
+	ctx := context.Background()
+	sessionID, err := srv.CreateSession(ctx, user)
+	if err != nil {
+		t.Fatalf("CreateSession(%s) error = %v", user, err)
+	}
+	if err := srv.AcquireLockWithMode(ctx, sessionID, user, mode); err != nil {
+		t.Fatalf("AcquireLockWithMode(%s, %s) error = %v", user, mode, err)
+	}
+	return sessionID
+}
+
+func TestExclusiveModeBlocksOtherConfigurationSessions(t *testing.T) {
+	srv := newCandidateModeTestServer(t)
+	ctx := context.Background()
+	exclusiveID := enterCandidateMode(t, srv, "alice", CandidateModeExclusive)
+
+	otherID, err := srv.CreateSession(ctx, "bob")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	for _, mode := range []string{CandidateModeExclusive, CandidateModePrivate, CandidateModeShared} {
+		if err := srv.AcquireLockWithMode(ctx, otherID, "bob", mode); !errors.Is(err, ErrCandidateConflict) {
+			t.Fatalf("AcquireLockWithMode(%s) error = %v, want ErrCandidateConflict", mode, err)
+		}
+	}
+	if err := srv.ReleaseLock(ctx, exclusiveID); err != nil {
+		t.Fatalf("ReleaseLock() error = %v", err)
+	}
+
+	privateID := enterCandidateMode(t, srv, "bob", CandidateModePrivate)
+	if err := srv.AcquireLockWithMode(ctx, exclusiveID, "alice", CandidateModeExclusive); !errors.Is(err, ErrCandidateConflict) {
+		t.Fatalf("AcquireLockWithMode(exclusive) with a private session error = %v, want ErrCandidateConflict", err)
+	}
+	if err := srv.AcquireLockWithMode(ctx, privateID, "bob", "bogus"); !errors.Is(err, ErrConfigInput) {
+		t.Fatalf("AcquireLockWithMode(bogus) error = %v, want ErrConfigInput", err)
+	}
+}
+
+func TestPrivateModeIsolatesCandidates(t *testing.T) {
+	srv := newCandidateModeTestServer(t)
+	ctx := context.Background()
+	aliceID := enterCandidateMode(t, srv, "alice", CandidateModePrivate)
+	bobID := enterCandidateMode(t, srv, "bob", CandidateModePrivate)
+
+	if err := srv.EditCandidate(ctx, aliceID, "set system host-name alice-router"); err != nil {
+		t.Fatalf("EditCandidate(alice) error = %v", err)
+	}
+	bobCandidate, err := srv.GetCandidate(ctx, bobID)
+	if err != nil {
+		t.Fatalf("GetCandidate(bob) error = %v", err)
+	}
+	if strings.Contains(bobCandidate, "alice-router") {
+		t.Fatalf("bob candidate = %q, want alice's edit kept private", bobCandidate)
+	}
+
+	if _, _, err := srv.Commit(ctx, aliceID, "alice", "private commit"); err != nil {
+		t.Fatalf("Commit(alice) error = %v", err)
+	}
+	if err := srv.EditCandidate(ctx, bobID, "set system host-name bob-router"); !errors.Is(err, ErrCandidateConflict) {
+		t.Fatalf("EditCandidate(bob) after alice commit error = %v, want stale candidate conflict", err)
+	}
+	if err := srv.Discard(ctx, bobID); err != nil {
+		t.Fatalf("Discard(bob) error = %v", err)
+	}
+	bobCandidate, err = srv.GetCandidate(ctx, bobID)
+	if err != nil {
+		t.Fatalf("GetCandidate(bob) error = %v", err)
+	}
+	if !strings.Contains(bobCandidate, "alice-router") {
+		t.Fatalf("bob candidate after discard = %q, want running configuration", bobCandidate)
+	}
+}
+
+func TestSharedModeSharesCandidate(t *testing.T) {
+	srv := newCandidateModeTestServer(t)
+	ctx := context.Background()
+	aliceID := enterCandidateMode(t, srv, "alice", CandidateModeShared)
+	bobID := enterCandidateMode(t, srv, "bob", CandidateModeShared)
+	privateID := enterCandidateMode(t, srv, "carol", CandidateModePrivate)
+
+	if err := srv.EditCandidate(ctx, aliceID, "set system host-name shared-router"); err != nil {
+		t.Fatalf("EditCandidate(alice) error = %v", err)
+	}
+	bobCandidate, err := srv.GetCandidate(ctx, bobID)
+	if err != nil {
+		t.Fatalf("GetCandidate(bob) error = %v", err)
+	}
+	if !strings.Contains(bobCandidate, "shared-router") {
+		t.Fatalf("bob candidate = %q, want alice's shared edit", bobCandidate)
+	}
+	privateCandidate, err := srv.GetCandidate(ctx, privateID)
+	if err != nil {
+		t.Fatalf("GetCandidate(carol) error = %v", err)
+	}
+	if strings.Contains(privateCandidate, "shared-router") {
+		t.Fatalf("private candidate = %q, want shared edit kept out", privateCandidate)
+	}
+
+	// Leaving shared mode keeps the shared candidate for the remaining
+	// shared sessions, and the last one to leave drops it.
+	if err := srv.ReleaseLock(ctx, aliceID); err != nil {
+		t.Fatalf("ReleaseLock(alice) error = %v", err)
+	}
+	if bobCandidate, err = srv.GetCandidate(ctx, bobID); err != nil || !strings.Contains(bobCandidate, "shared-router") {
+		t.Fatalf("GetCandidate(bob) after alice left = %q, %v; want shared edit", bobCandidate, err)
+	}
+	if _, _, err := srv.Commit(ctx, bobID, "bob", "shared commit"); err != nil {
+		t.Fatalf("Commit(bob) error = %v", err)
+	}
+	if snap := srv.engine.RunningSnapshot(); snap == nil || snap.Config.System.HostName != "shared-router" || snap.Author != "bob" {
+		t.Fatalf("running snapshot = %#v, want shared-router committed by bob", snap)
+	}
+	if err := srv.EditCandidate(ctx, bobID, "set system host-name pending"); err != nil {
+		t.Fatalf("EditCandidate(bob) error = %v", err)
+	}
+	if err := srv.ReleaseLock(ctx, bobID); err != nil {
+		t.Fatalf("ReleaseLock(bob) error = %v", err)
+	}
+	aliceID2 := enterCandidateMode(t, srv, "alice", CandidateModeShared)
+	if candidate, err := srv.GetCandidate(ctx, aliceID2); err != nil || strings.Contains(candidate, "pending") {
+		t.Fatalf("GetCandidate() after last shared session left = %q, %v; want a fresh candidate", candidate, err)
+	}
+}
@@ -0,0 +1,221 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/netconf"
+)
+
+// Subsystem health states reported by GetSystemHealth.
+const (
+	SubsystemStatusUp         = "up"
+	SubsystemStatusDown       = "down"
+	SubsystemStatusNotRunning = "not running"
+)
+
+const (
+	systemHealthProbeTimeout = 5 * time.Second
+	unknownBuildInfoField    = "unknown"
+	frrVersionCommand        = "show version"
+)
+
+// BuildInfo identifies the running daemon build.
+type BuildInfo struct {
+	Version   string
+	Commit    string
+	BuildDate string
+}
+
+// SubsystemHealth is the health of one dependency of the daemon.
+type SubsystemHealth struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// NETCONFHealthInfo summarizes NETCONF server metrics for the health view.
+type NETCONFHealthInfo struct {
+	Listening            bool     `json:"listening"`
+	ListenAddrs          []string `json:"listen_addrs,omitempty"`
+	ActiveSessions       int      `json:"active_sessions"`
+	ActiveConnections    int32    `json:"active_connections"`
+	TotalConnections     uint64   `json:"total_connections"`
+	SuccessfulHandshakes uint64   `json:"successful_handshakes"`
+	FailedHandshakes     uint64   `json:"failed_handshakes"`
+}
+
+// SystemHealthInfo is a point-in-time health snapshot of the daemon and the
+// subsystems it drives, used by "show system information".
+type SystemHealthInfo struct {
+	Hostname   string             `json:"hostname,omitempty"`
+	Version    string             `json:"version"`
+	Commit     string             `json:"commit"`
+	BuildDate  string             `json:"build_date"`
+	UptimeSecs uint64             `json:"uptime_secs"`
+	Subsystems []SubsystemHealth  `json:"subsystems"`
+	NETCONF    *NETCONFHealthInfo `json:"netconf,omitempty"`
+}
+
+type netconfMetricsSource interface {
+	GetMetrics() netconf.ServerMetrics
+}
+
+// SetBuildInfo records the daemon build reported by GetSystemInfo and
+// GetSystemHealth.
+func (s *Server) SetBuildInfo(info BuildInfo) {
+	s.buildInfo = info
+}
+
+// SetNETCONFMetricsSource installs the NETCONF server whose metrics are
+// included in GetSystemHealth.
+func (s *Server) SetNETCONFMetricsSource(source netconfMetricsSource) {
+	s.netconfMetrics = source
+}
+
+func (s *Server) uptimeSecs() uint64 {
+	if s.startedAt.IsZero() {
+		return 0
+	}
+	return uint64(time.Since(s.startedAt).Seconds())
+}
+
+// GetSystemHealth probes VPP, FRR, the configuration store, and the NETCONF
+// server. A subsystem that cannot be reached is reported as down with the
+// probe error instead of failing the whole call.
+func (s *Server) GetSystemHealth(ctx context.Context) (*SystemHealthInfo, error) {
+	info := &SystemHealthInfo{
+		Version:    buildInfoField(s.buildInfo.Version),
+		Commit:     buildInfoField(s.buildInfo.Commit),
+		BuildDate:  buildInfoField(s.buildInfo.BuildDate),
+		UptimeSecs: s.uptimeSecs(),
+	}
+	if s.engine != nil {
+		if cfg := s.engine.ActiveRunning(); cfg != nil && cfg.System != nil {
+			info.Hostname = cfg.System.HostName
+		}
+	}
+	info.Subsystems = []SubsystemHealth{
+		s.vppHealth(ctx),
+		s.frrHealth(ctx),
+		s.datastoreHealth(ctx),
+		s.netconfHealth(info),
+	}
+	return info, nil
+}
+
+func (s *Server) vppHealth(ctx context.Context) SubsystemHealth {
+	health := SubsystemHealth{Name: "vpp"}
+	probeCtx, cancel := context.WithTimeout(ctx, systemHealthProbeTimeout)
+	defer cancel()
+
+	client := newOperationalVPPClient()
+	if err := client.Connect(probeCtx); err != nil {
+		health.Status = SubsystemStatusDown
+		health.Detail = fmt.Sprintf("connect: %v", err)
+		return health
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			s.log.Debug("failed to close VPP client", slog.Any("error", err))
+		}
+	}()
+	version, err := client.GetVersion(probeCtx)
+	if err != nil {
+		health.Status = SubsystemStatusDown
+		health.Detail = fmt.Sprintf("show version: %v", err)
+		return health
+	}
+	health.Status = SubsystemStatusUp
+	health.Detail = strings.TrimSpace(version)
+	return health
+}
+
+func (s *Server) frrHealth(ctx context.Context) SubsystemHealth {
+	health := SubsystemHealth{Name: "frr"}
+	probeCtx, cancel := context.WithTimeout(ctx, systemHealthProbeTimeout)
+	defer cancel()
+
+	output, err := runOperationalVtyshCommand(probeCtx, frrVersionCommand)
+	if err != nil {
+		health.Status = SubsystemStatusDown
+		health.Detail = err.Error()
+		return health
+	}
+	health.Status = SubsystemStatusUp
+	health.Detail = firstNonEmptyLine(output)
+	return health
+}
+
+func (s *Server) datastoreHealth(ctx context.Context) SubsystemHealth {
+	health := SubsystemHealth{Name: "datastore"}
+	if s.store == nil {
+		health.Status = SubsystemStatusNotRunning
+		return health
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, systemHealthProbeTimeout)
+	defer cancel()
+
+	snap, err := s.store.GetLatestSnapshot(probeCtx)
+	if err != nil {
+		health.Status = SubsystemStatusDown
+		health.Detail = err.Error()
+		return health
+	}
+	health.Status = SubsystemStatusUp
+	health.Detail = "no commits"
+	if snap != nil {
+		health.Detail = fmt.Sprintf("latest commit version %d", snap.Version)
+	}
+	return health
+}
+
+func (s *Server) netconfHealth(info *SystemHealthInfo) SubsystemHealth {
+	health := SubsystemHealth{Name: "netconf"}
+	if s.netconfMetrics == nil {
+		health.Status = SubsystemStatusNotRunning
+		return health
+	}
+	metrics := s.netconfMetrics.GetMetrics()
+	info.NETCONF = &NETCONFHealthInfo{
+		Listening:            metrics.IsListening,
+		ListenAddrs:          append([]string(nil), metrics.ListenAddrs...),
+		ActiveSessions:       metrics.ActiveSessions,
+		ActiveConnections:    metrics.ActiveConnections,
+		TotalConnections:     metrics.TotalConnections,
+		SuccessfulHandshakes: metrics.SuccessfulHandshakes,
+		FailedHandshakes:     metrics.FailedHandshakes,
+	}
+	if metrics.IsDraining {
+		health.Status = SubsystemStatusDown
+		health.Detail = fmt.Sprintf("draining, %d active session(s)", metrics.ActiveSessions)
+		return health
+	}
+	if !metrics.IsListening {
+		health.Status = SubsystemStatusDown
+		health.Detail = "not accepting connections"
+		return health
+	}
+	health.Status = SubsystemStatusUp
+	health.Detail = fmt.Sprintf("%d active session(s) on %s", metrics.ActiveSessions, strings.Join(metrics.ListenAddrs, ", "))
+	return health
+}
+
+func buildInfoField(value string) string {
+	if value == "" {
+		return unknownBuildInfoField
+	}
+	return value
+}
+
+func firstNonEmptyLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
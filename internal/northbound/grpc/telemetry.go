@@ -27,6 +27,7 @@ var (
 		"/system",
 		"/config/running",
 		"/interfaces",
+		"/interfaces/interface/state/counters",
 		"/routes",
 		"/routing/bgp/neighbors",
 		"/routing/ospf/neighbors",
@@ -37,51 +38,66 @@ var (
 		"/bfd",
 		"/lcp",
 		"/ha",
+		"/system/health",
+		"/system/alarms",
+		"/config/last-apply",
 	}
 	telemetryPathDescriptions = map[string]string{
-		"/system":                  "daemon system metadata and uptime",
-		"/config/running":          "running configuration text and version",
-		"/interfaces":              "managed interface operational state, counters, QoS binding, and queue placement",
-		"/routes":                  "routing table snapshot",
-		"/routing/bgp/neighbors":   "BGP neighbor operational state",
-		"/routing/ospf/neighbors":  "OSPFv2 neighbor operational state",
-		"/routing/ospf3/neighbors": "OSPFv3 neighbor operational state",
-		"/routing-instances":       "routing instance operational summary",
-		"/overlays/evpn":           "EVPN/VXLAN VNI overlay intent",
-		"/class-of-service":        "class-of-service intent, enforcement status, and QoS capability diagnostics",
-		"/bfd":                     "BFD peer operational status",
-		"/lcp":                     "VPP LCP reconciliation status",
-		"/ha":                      "control-plane HA convergence status",
+		"/system":                              "daemon system metadata and uptime",
+		"/system/health":                       "daemon build, uptime, and VPP/FRR/datastore/NETCONF health",
+		"/system/alarms":                       "active interface error-rate and link-down alarms",
+		"/config/running":                      "running configuration text and version",
+		"/config/last-apply":                   "outcome of the last configuration apply per southbound plugin",
+		"/interfaces":                          "managed interface operational state, counters, QoS binding, and queue placement",
+		"/interfaces/interface/state/counters": "per-interface packet, octet, and error counters (gNMI-style path)",
+		"/routes":                              "routing table snapshot",
+		"/routing/bgp/neighbors":               "BGP neighbor operational state",
+		"/routing/ospf/neighbors":              "OSPFv2 neighbor operational state",
+		"/routing/ospf3/neighbors":             "OSPFv3 neighbor operational state",
+		"/routing-instances":                   "routing instance operational summary",
+		"/overlays/evpn":                       "EVPN/VXLAN VNI overlay intent",
+		"/class-of-service":                    "class-of-service intent, enforcement status, and QoS capability diagnostics",
+		"/bfd":                                 "BFD peer operational status",
+		"/lcp":                                 "VPP LCP reconciliation status",
+		"/ha":                                  "control-plane HA convergence status",
 	}
 	telemetryPathCardinality = map[string]string{
-		"/system":                  "single",
-		"/config/running":          "single",
-		"/interfaces":              "per-interface",
-		"/routes":                  "per-route",
-		"/routing/bgp/neighbors":   "per-neighbor",
-		"/routing/ospf/neighbors":  "per-neighbor",
-		"/routing/ospf3/neighbors": "per-neighbor",
-		"/routing-instances":       "per-instance",
-		"/overlays/evpn":           "per-vni",
-		"/class-of-service":        "per-intent-object",
-		"/bfd":                     "per-peer",
-		"/lcp":                     "single",
-		"/ha":                      "single",
+		"/system":                              "single",
+		"/system/health":                       "single",
+		"/system/alarms":                       "single",
+		"/config/running":                      "single",
+		"/config/last-apply":                   "single",
+		"/interfaces":                          "per-interface",
+		"/interfaces/interface/state/counters": "per-interface",
+		"/routes":                              "per-route",
+		"/routing/bgp/neighbors":               "per-neighbor",
+		"/routing/ospf/neighbors":              "per-neighbor",
+		"/routing/ospf3/neighbors":             "per-neighbor",
+		"/routing-instances":                   "per-instance",
+		"/overlays/evpn":                       "per-vni",
+		"/class-of-service":                    "per-intent-object",
+		"/bfd":                                 "per-peer",
+		"/lcp":                                 "single",
+		"/ha":                                  "single",
 	}
 	telemetryPathPayloadSchemas = map[string]string{
-		"/system":                  "arca.telemetry.system.v1",
-		"/config/running":          "arca.telemetry.config.running.v1",
-		"/interfaces":              "arca.telemetry.interfaces.v1",
-		"/routes":                  "arca.telemetry.routes.v1",
-		"/routing/bgp/neighbors":   "arca.telemetry.routing.bgp.neighbors.v1",
-		"/routing/ospf/neighbors":  "arca.telemetry.routing.ospf.neighbors.v1",
-		"/routing/ospf3/neighbors": "arca.telemetry.routing.ospf3.neighbors.v1",
-		"/routing-instances":       "arca.telemetry.routing.instances.v1",
-		"/overlays/evpn":           "arca.telemetry.overlays.evpn.v1",
-		"/class-of-service":        "arca.telemetry.class_of_service.v1",
-		"/bfd":                     "arca.telemetry.bfd.v1",
-		"/lcp":                     "arca.telemetry.lcp.v1",
-		"/ha":                      "arca.telemetry.ha.v1",
+		"/system":                              "arca.telemetry.system.v1",
+		"/system/health":                       "arca.telemetry.system.health.v1",
+		"/system/alarms":                       "arca.telemetry.system.alarms.v1",
+		"/config/running":                      "arca.telemetry.config.running.v1",
+		"/config/last-apply":                   "arca.telemetry.config.last_apply.v1",
+		"/interfaces":                          "arca.telemetry.interfaces.v1",
+		"/interfaces/interface/state/counters": "arca.telemetry.interfaces.counters.v1",
+		"/routes":                              "arca.telemetry.routes.v1",
+		"/routing/bgp/neighbors":               "arca.telemetry.routing.bgp.neighbors.v1",
+		"/routing/ospf/neighbors":              "arca.telemetry.routing.ospf.neighbors.v1",
+		"/routing/ospf3/neighbors":             "arca.telemetry.routing.ospf3.neighbors.v1",
+		"/routing-instances":                   "arca.telemetry.routing.instances.v1",
+		"/overlays/evpn":                       "arca.telemetry.overlays.evpn.v1",
+		"/class-of-service":                    "arca.telemetry.class_of_service.v1",
+		"/bfd":                                 "arca.telemetry.bfd.v1",
+		"/lcp":                                 "arca.telemetry.lcp.v1",
+		"/ha":                                  "arca.telemetry.ha.v1",
 	}
 	telemetryPathPayloadFields = map[string][]TelemetryPayloadFieldInfo{
 		"/system": {
@@ -89,14 +105,36 @@ var (
 			{Name: "version", Type: "string", Description: "daemon software version"},
 			{Name: "uptime_secs", Type: "uint64", Description: "daemon uptime in seconds"},
 		},
+		"/system/health": {
+			{Name: "version", Type: "string", Description: "daemon software version"},
+			{Name: "commit", Type: "string", Description: "daemon source commit"},
+			{Name: "build_date", Type: "string", Description: "daemon build date"},
+			{Name: "uptime_secs", Type: "uint64", Description: "daemon uptime in seconds"},
+			{Name: "subsystems", Type: "[]SubsystemHealth", Description: "vpp, frr, datastore, and netconf status with probe detail"},
+			{Name: "netconf", Type: "NETCONFHealthInfo", Description: "NETCONF server metrics when the server is running"},
+		},
 		"/config/running": {
 			{Name: "version", Type: "uint64", Description: "running configuration version"},
 			{Name: "config_text", Type: "string", Description: "running configuration in set-command text format"},
 			{Name: "line_count", Type: "int", Description: "number of running configuration lines"},
 		},
+		"/system/alarms": {
+			{Name: "alarms", Type: "[]AlarmInfo", Description: "active alarms with type, object, description, and RFC 3339 raise time"},
+		},
+		"/config/last-apply": {
+			{Name: "recorded", Type: "bool", Description: "whether an apply has been recorded"},
+			{Name: "time", Type: "string", Description: "RFC 3339 time the apply finished"},
+			{Name: "version", Type: "uint64", Description: "running configuration version after the apply"},
+			{Name: "status", Type: "string", Description: "ok or failed"},
+			{Name: "error", Type: "string", Description: "apply error when status is failed"},
+			{Name: "plugins", Type: "[]PluginApplyInfo", Description: "per-plugin status (applied, failed, rolled-back, rollback-failed, or not-applied) with the operations the plugin reported"},
+		},
 		"/interfaces": {
 			{Name: "interfaces", Type: "[]InterfaceInfo", Description: "managed interface operational state entries"},
 		},
+		"/interfaces/interface/state/counters": {
+			{Name: "interfaces", Type: "[]InterfaceCountersInfo", Description: "per-interface counter samples"},
+		},
 		"/routes": {
 			{Name: "routes", Type: "[]RouteInfo", Description: "routing table entries"},
 		},
@@ -129,12 +167,13 @@ var (
 		},
 	}
 	telemetryPathAliases = map[string][]string{
-		"/config/running":          {"/running", "/config"},
-		"/routing/bgp/neighbors":   {"/bgp", "/bgp/neighbors"},
-		"/routing/ospf/neighbors":  {"/ospf", "/ospf/neighbors"},
-		"/routing/ospf3/neighbors": {"/ospf3", "/ospf3/neighbors"},
-		"/overlays/evpn":           {"/evpn", "/overlay/evpn"},
-		"/class-of-service":        {"/cos"},
+		"/interfaces/interface/state/counters": {"/interfaces/counters"},
+		"/config/running":                      {"/running", "/config"},
+		"/routing/bgp/neighbors":               {"/bgp", "/bgp/neighbors"},
+		"/routing/ospf/neighbors":              {"/ospf", "/ospf/neighbors"},
+		"/routing/ospf3/neighbors":             {"/ospf3", "/ospf3/neighbors"},
+		"/overlays/evpn":                       {"/evpn", "/overlay/evpn"},
+		"/class-of-service":                    {"/cos"},
 	}
 	telemetryPathSet = buildTelemetryPathSet(telemetryPathOrder)
 )
@@ -386,6 +425,21 @@ type telemetryConfigPayload struct {
 	LineCount  int    `json:"line_count"`
 }
 
+// InterfaceCountersInfo is one interface sample on the
+// /interfaces/interface/state/counters path. Field names follow the
+// OpenConfig interface counters model.
+type InterfaceCountersInfo struct {
+	Name       string `json:"name"`
+	IfIndex    uint32 `json:"if_index"`
+	OperStatus string `json:"oper_status"`
+	InPkts     uint64 `json:"in_pkts"`
+	OutPkts    uint64 `json:"out_pkts"`
+	InOctets   uint64 `json:"in_octets"`
+	OutOctets  uint64 `json:"out_octets"`
+	InErrors   uint64 `json:"in_errors"`
+	OutErrors  uint64 `json:"out_errors"`
+}
+
 type telemetryEVPNPayload struct {
 	VNIs []telemetryEVPNVNIPayload `json:"vnis"`
 }
@@ -504,6 +558,12 @@ func (s *Server) telemetryPayload(ctx context.Context, path string) (any, error)
 			Version:    info.Version,
 			UptimeSecs: info.UptimeSecs,
 		}, nil
+	case "/system/health":
+		return s.GetSystemHealth(ctx)
+	case "/system/alarms":
+		return s.GetSystemAlarms(ctx)
+	case "/config/last-apply":
+		return s.GetLastApply(ctx)
 	case "/config/running":
 		text, version, err := s.runningText(true)
 		if err != nil {
@@ -522,6 +582,14 @@ func (s *Server) telemetryPayload(ctx context.Context, path string) (any, error)
 		return struct {
 			Interfaces []InterfaceInfo `json:"interfaces"`
 		}{Interfaces: interfaces}, nil
+	case "/interfaces/interface/state/counters":
+		interfaces, err := s.GetInterfaces(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		return struct {
+			Interfaces []InterfaceCountersInfo `json:"interfaces"`
+		}{Interfaces: interfaceCountersInfos(interfaces)}, nil
 	case "/routes":
 		routes, err := s.GetRoutes(ctx, "", "")
 		if err != nil {
@@ -601,6 +669,24 @@ func (s *Server) telemetryPayload(ctx context.Context, path string) (any, error)
 	}
 }
 
+func interfaceCountersInfos(interfaces []InterfaceInfo) []InterfaceCountersInfo {
+	out := make([]InterfaceCountersInfo, 0, len(interfaces))
+	for _, iface := range interfaces {
+		out = append(out, InterfaceCountersInfo{
+			Name:       iface.Name,
+			IfIndex:    iface.IfIndex,
+			OperStatus: iface.OperStatus,
+			InPkts:     iface.RxPackets,
+			OutPkts:    iface.TxPackets,
+			InOctets:   iface.RxBytes,
+			OutOctets:  iface.TxBytes,
+			InErrors:   iface.RxErrors,
+			OutErrors:  iface.TxErrors,
+		})
+	}
+	return out
+}
+
 func normalizeTelemetryPaths(rawPaths []string) ([]string, error) {
 	if len(rawPaths) == 0 {
 		return append([]string(nil), defaultTelemetryPaths...), nil
@@ -639,6 +725,12 @@ func normalizeTelemetryPath(rawPath string) string {
 	switch path {
 	case "/running", "/config":
 		return "/config/running"
+	case "/last-apply":
+		return "/config/last-apply"
+	case "/alarms":
+		return "/system/alarms"
+	case "/health", "/system/information":
+		return "/system/health"
 	case "/bgp", "/bgp/neighbors":
 		return "/routing/bgp/neighbors"
 	case "/ospf", "/ospf/neighbors":
@@ -649,6 +741,8 @@ func normalizeTelemetryPath(rawPath string) string {
 		return "/overlays/evpn"
 	case "/cos":
 		return "/class-of-service"
+	case "/interfaces/counters":
+		return "/interfaces/interface/state/counters"
 	}
 	return path
 }
@@ -679,7 +773,7 @@ func (s *Server) telemetryEVPNPayload() telemetryEVPNPayload {
 	if s.engine == nil {
 		return payload
 	}
-	cfg := s.engine.Running()
+	cfg := s.engine.ActiveRunning()
 	if cfg == nil || cfg.Protocols == nil || cfg.Protocols.EVPN == nil || len(cfg.Protocols.EVPN.VNIs) == 0 {
 		return payload
 	}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultTelemetrySubscriberBuffer = 64
+
+// TelemetryPublisher samples a fixed set of telemetry paths on an interval
+// and fans each event out to in-process subscribers. It is the internal
+// counterpart of the SubscribeTelemetry RPC: one sampling loop can feed
+// several consumers such as an exporter to an external collector.
+type TelemetryPublisher struct {
+	server   *Server
+	paths    []string
+	interval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[*TelemetrySubscriber]struct{}
+	closed      bool
+}
+
+// TelemetrySubscriber receives events from a TelemetryPublisher. A consumer
+// that falls behind loses events instead of stalling the publisher; the
+// number of lost events is reported by Dropped.
+type TelemetrySubscriber struct {
+	events  chan TelemetryEvent
+	dropped atomic.Uint64
+}
+
+// NewTelemetryPublisher validates the telemetry paths and returns a publisher
+// that samples them every interval once Run is called.
+func NewTelemetryPublisher(server *Server, rawPaths []string, interval time.Duration) (*TelemetryPublisher, error) {
+	if server == nil {
+		return nil, fmt.Errorf("telemetry publisher requires a server")
+	}
+	paths, err := normalizeTelemetryPaths(rawPaths)
+	if err != nil {
+		return nil, err
+	}
+	return &TelemetryPublisher{
+		server:      server,
+		paths:       paths,
+		interval:    normalizeTelemetryInterval(interval),
+		subscribers: make(map[*TelemetrySubscriber]struct{}),
+	}, nil
+}
+
+// Paths returns the canonical telemetry paths sampled by the publisher.
+func (p *TelemetryPublisher) Paths() []string {
+	return append([]string(nil), p.paths...)
+}
+
+// Interval returns the normalized sample interval.
+func (p *TelemetryPublisher) Interval() time.Duration {
+	return p.interval
+}
+
+// Subscribe registers a consumer with a channel of the given capacity.
+// A non-positive buffer uses the default capacity. Subscribing after Run
+// has returned yields an already closed channel.
+func (p *TelemetryPublisher) Subscribe(buffer int) *TelemetrySubscriber {
+	if buffer <= 0 {
+		buffer = defaultTelemetrySubscriberBuffer
+	}
+	sub := &TelemetrySubscriber{events: make(chan TelemetryEvent, buffer)}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		close(sub.events)
+		return sub
+	}
+	p.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe removes a consumer and closes its channel.
+func (p *TelemetryPublisher) Unsubscribe(sub *TelemetrySubscriber) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.subscribers[sub]; !ok {
+		return
+	}
+	delete(p.subscribers, sub)
+	close(sub.events)
+}
+
+// Run samples the publisher paths until ctx is cancelled, then closes every
+// subscriber channel. The first sample is published immediately.
+func (p *TelemetryPublisher) Run(ctx context.Context) error {
+	defer p.closeSubscribers()
+	return p.server.SubscribeTelemetry(ctx, p.paths, p.interval, false, func(event TelemetryEvent) error {
+		p.publish(event)
+		return nil
+	})
+}
+
+func (p *TelemetryPublisher) publish(event TelemetryEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for sub := range p.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+func (p *TelemetryPublisher) closeSubscribers() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	for sub := range p.subscribers {
+		close(sub.events)
+	}
+	p.subscribers = make(map[*TelemetrySubscriber]struct{})
+}
+
+// Events returns the channel on which telemetry events are delivered. It is
+// closed when the subscriber is removed or the publisher stops.
+func (s *TelemetrySubscriber) Events() <-chan TelemetryEvent {
+	return s.events
+}
+
+// Dropped reports how many events were discarded because the channel was full.
+func (s *TelemetrySubscriber) Dropped() uint64 {
+	return s.dropped.Load()
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/netconf"
+)
+
+// userKeyStore is the NETCONF user database surface used to manage SSH keys.
+type userKeyStore interface {
+	ListPublicKeys(username string) ([]netconf.PublicKeyRecord, error)
+	GetPublicKey(fingerprint string) (*netconf.PublicKeyRecord, error)
+	UpdatePublicKeyStatus(fingerprint string, enabled bool) error
+	RemovePublicKey(fingerprint string) error
+}
+
+// SetUserKeyStore installs the NETCONF user database used by the SSH key
+// management RPCs.
+func (s *Server) SetUserKeyStore(store userKeyStore) {
+	s.userKeys = store
+}
+
+// ListUserSSHKeys returns the SSH public keys registered for username.
+func (s *Server) ListUserSSHKeys(ctx context.Context, username string) ([]UserSSHKey, error) {
+	if err := s.userKeyStoreReady(username); err != nil {
+		return nil, err
+	}
+	records, err := s.userKeys.ListPublicKeys(username)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]UserSSHKey, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, UserSSHKey{
+			Algorithm:   record.Algorithm,
+			Fingerprint: record.Fingerprint,
+			Comment:     record.Comment,
+			Enabled:     record.Enabled,
+			CreatedAt:   time.Unix(record.CreatedAt, 0).UTC(),
+		})
+	}
+	return keys, nil
+}
+
+// SetUserSSHKeyStatus enables or disables a key owned by username.
+func (s *Server) SetUserSSHKeyStatus(ctx context.Context, username, fingerprint string, enabled bool) error {
+	if err := s.requireUserSSHKey(username, fingerprint); err != nil {
+		return err
+	}
+	if err := s.userKeys.UpdatePublicKeyStatus(fingerprint, enabled); err != nil {
+		return userKeyLookupError(username, fingerprint, err)
+	}
+	s.log.Info("user SSH key status updated",
+		slog.String("username", username),
+		slog.String("fingerprint", fingerprint),
+		slog.Bool("enabled", enabled))
+	return nil
+}
+
+// RemoveUserSSHKey deletes a key owned by username.
+func (s *Server) RemoveUserSSHKey(ctx context.Context, username, fingerprint string) error {
+	if err := s.requireUserSSHKey(username, fingerprint); err != nil {
+		return err
+	}
+	if err := s.userKeys.RemovePublicKey(fingerprint); err != nil {
+		return userKeyLookupError(username, fingerprint, err)
+	}
+	s.log.Info("user SSH key removed",
+		slog.String("username", username),
+		slog.String("fingerprint", fingerprint))
+	return nil
+}
+
+func (s *Server) userKeyStoreReady(username string) error {
+	if s.userKeys == nil {
+		return classifiedError{kind: ErrUserDatabaseUnavailable, msg: "NETCONF user database is not available"}
+	}
+	if strings.TrimSpace(username) == "" {
+		return newConfigInputErrorf("username is required")
+	}
+	return nil
+}
+
+// requireUserSSHKey checks that fingerprint belongs to username. Fingerprints
+// are unique across the database, so without this check one user's key could
+// be changed through another user's command.
+func (s *Server) requireUserSSHKey(username, fingerprint string) error {
+	if err := s.userKeyStoreReady(username); err != nil {
+		return err
+	}
+	if strings.TrimSpace(fingerprint) == "" {
+		return newConfigInputErrorf("fingerprint is required")
+	}
+	record, err := s.userKeys.GetPublicKey(fingerprint)
+	if err != nil {
+		return userKeyLookupError(username, fingerprint, err)
+	}
+	if record.Username != username {
+		return userKeyLookupError(username, fingerprint, netconf.ErrPublicKeyNotFound)
+	}
+	return nil
+}
+
+func userKeyLookupError(username, fingerprint string, err error) error {
+	if errors.Is(err, netconf.ErrPublicKeyNotFound) {
+		return classifiedError{
+			kind: ErrUserKeyNotFound,
+			msg:  fmt.Sprintf("user %s has no SSH key with fingerprint %s", username, fingerprint),
+		}
+	}
+	return err
+}
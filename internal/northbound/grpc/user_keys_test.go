@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/pkg/auth"
+	"github.com/akam1o/arca-router/pkg/netconf"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestUserSSHKeyManagementAgainstUserDatabase(t *testing.T) {
+	userDB, err := netconf.NewUserDatabase(filepath.Join(t.TempDir(), "users.db"), nil)
+	if err != nil {
+		t.Fatalf("NewUserDatabase() error = %v", err)
+	}
+	t.Cleanup(func() { _ = userDB.Close() })
+	aliceKey, aliceFingerprint := addTestUserSSHKey(t, userDB, "alice")
+	_, bobFingerprint := addTestUserSSHKey(t, userDB, "bob")
+
+	srv := NewServer(engine.NewEngine(nil, testLogger()), nil, testLogger())
+	srv.SetUserKeyStore(userDB)
+	ctx := context.Background()
+
+	keys, err := srv.ListUserSSHKeys(ctx, "alice")
+	if err != nil {
+		t.Fatalf("ListUserSSHKeys() error = %v", err)
+	}
+	if len(keys) != 1 || keys[0].Fingerprint != aliceFingerprint || keys[0].Algorithm != ssh.KeyAlgoED25519 ||
+		keys[0].Comment != "alice@test" || !keys[0].Enabled || keys[0].CreatedAt.IsZero() {
+		t.Fatalf("ListUserSSHKeys() = %+v, want alice's enabled ed25519 key", keys)
+	}
+
+	if err := srv.SetUserSSHKeyStatus(ctx, "alice", aliceFingerprint, false); err != nil {
+		t.Fatalf("SetUserSSHKeyStatus(disable) error = %v", err)
+	}
+	if _, reason, err := userDB.VerifyPublicKeyAuth("alice", aliceKey); err == nil || reason != "key_not_found" {
+		t.Fatalf("VerifyPublicKeyAuth() reason = %q, err = %v; want disabled key rejected", reason, err)
+	}
+	keys, err = srv.ListUserSSHKeys(ctx, "alice")
+	if err != nil || len(keys) != 1 || keys[0].Enabled {
+		t.Fatalf("ListUserSSHKeys() after disable = %+v, %v; want disabled key", keys, err)
+	}
+
+	if err := srv.SetUserSSHKeyStatus(ctx, "alice", bobFingerprint, false); !errors.Is(err, ErrUserKeyNotFound) {
+		t.Fatalf("SetUserSSHKeyStatus(bob's key as alice) error = %v, want ErrUserKeyNotFound", err)
+	}
+	if err := srv.RemoveUserSSHKey(ctx, "alice", bobFingerprint); !errors.Is(err, ErrUserKeyNotFound) {
+		t.Fatalf("RemoveUserSSHKey(bob's key as alice) error = %v, want ErrUserKeyNotFound", err)
+	}
+	if _, err := userDB.GetPublicKey(bobFingerprint); err != nil {
+		t.Fatalf("bob's key was modified: %v", err)
+	}
+
+	if err := srv.RemoveUserSSHKey(ctx, "alice", aliceFingerprint); err != nil {
+		t.Fatalf("RemoveUserSSHKey() error = %v", err)
+	}
+	keys, err = srv.ListUserSSHKeys(ctx, "alice")
+	if err != nil || len(keys) != 0 {
+		t.Fatalf("ListUserSSHKeys() after delete = %+v, %v; want none", keys, err)
+	}
+}
+
+func TestUserSSHKeyManagementRequiresUserDatabase(t *testing.T) {
+	srv := NewServer(engine.NewEngine(nil, testLogger()), nil, testLogger())
+	if _, err := srv.ListUserSSHKeys(context.Background(), "alice"); !errors.Is(err, ErrUserDatabaseUnavailable) {
+		t.Fatalf("ListUserSSHKeys() error = %v, want ErrUserDatabaseUnavailable", err)
+	}
+}
+
+func addTestUserSSHKey(t *testing.T, userDB *netconf.UserDatabase, username string) (string, string) {
+	t.Helper()
+	hash, err := auth.HashPassword(username + "-password-123")
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+	if err := userDB.CreateUser(username, hash, netconf.RoleOperator); err != nil {
+		t.Fatalf("CreateUser(%s) error = %v", username, err)
+	}
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatalf("NewPublicKey() error = %v", err)
+	}
+	keyData := base64.StdEncoding.EncodeToString(key.Marshal())
+	fingerprint := ssh.FingerprintSHA256(key)
+	if err := userDB.AddPublicKey(username, key.Type(), keyData, fingerprint, username+"@test"); err != nil {
+		t.Fatalf("AddPublicKey(%s) error = %v", username, err)
+	}
+	return keyData, fingerprint
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 
 	"github.com/akam1o/arca-router/internal/engine"
@@ -190,6 +191,11 @@ func (p *FRRPlugin) ApplyChanges(ctx context.Context, diff *engine.ConfigDiff) e
 	p.hasRollbackConfig = hasRollbackConfig
 
 	applier, applyMode := p.applierForConfig(frrConfig)
+	if applyMode == pkgfrr.BackendModeTransactional && removesFileBackendProtocol(previousFRRConfig, frrConfig) {
+		// The management datastore cannot delete ripd or ospf6d config, so
+		// the commit that removes them still goes through the file backend.
+		applier, applyMode = p.fileBackendApplier(), pkgfrr.BackendModeFile
+	}
 	applied := false
 	if applyMode == pkgfrr.BackendModeTransactional && previousFRRConfig != nil {
 		if diffApplier, ok := applier.(pkgfrr.DifferentialApplier); ok {
@@ -201,7 +207,12 @@ func (p *FRRPlugin) ApplyChanges(ctx context.Context, diff *engine.ConfigDiff) e
 		}
 	}
 	if !applied {
-		if err := applier.ApplyConfig(ctx, configContent, frrConfig); err != nil {
+		applyContent := configContent
+		if applyMode != pkgfrr.BackendModeTransactional && previousFRRConfig != nil {
+			applyContent = withProtocolRemovals(configContent, previousFRRConfig, frrConfig)
+			applyContent = withBGPShutdownChanges(applyContent, previousFRRConfig.BGP, frrConfig.BGP)
+		}
+		if err := applier.ApplyConfig(ctx, applyContent, frrConfig); err != nil {
 			return fmt.Errorf("apply FRR config: %w", err)
 		}
 	}
@@ -219,6 +230,7 @@ func (p *FRRPlugin) ApplyChanges(ctx context.Context, diff *engine.ConfigDiff) e
 		slog.Bool("bgp_changed", diff.BGPChanged),
 		slog.Bool("ospf_changed", diff.OSPFChanged),
 		slog.Bool("ospf3_changed", diff.OSPF3Changed),
+		slog.Bool("rip_changed", diff.RIPChanged),
 	)
 	p.logVRRPStatus(p.vrrpStatus)
 	p.logBFDStatus(p.bfdStatus)
@@ -253,6 +265,36 @@ func generateFRRArtifacts(cfg *model.RouterConfig) (*pkgfrr.Config, string, erro
 	return frrConfig, configContent, nil
 }
 
+// withBGPShutdownChanges inserts the commands that re-enable previously shut
+// down BGP neighbors before the footer of a generated config file, so that
+// file-based apply methods which only add lines still bring the session up.
+func withBGPShutdownChanges(content string, previous, current *pkgfrr.BGPConfig) string {
+	changes := pkgfrr.GenerateBGPShutdownChanges(previous, current)
+	footer := strings.LastIndex(content, "!\nline vty\n")
+	if changes == "" || footer < 0 {
+		return content
+	}
+	return content[:footer] + changes + content[footer:]
+}
+
+// withProtocolRemovals inserts the commands that remove deleted routing
+// instances and OSPF interface settings right after the header of a generated
+// config file, so that they run before the remaining sections are re-added.
+func withProtocolRemovals(content string, previous, current *pkgfrr.Config) string {
+	removals := pkgfrr.GenerateProtocolRemovals(previous, current)
+	if removals == "" || !strings.HasPrefix(content, pkgfrr.ConfigFileHeader) {
+		return content
+	}
+	return pkgfrr.ConfigFileHeader + removals + content[len(pkgfrr.ConfigFileHeader):]
+}
+
+func removesFileBackendProtocol(previous, current *pkgfrr.Config) bool {
+	if previous == nil || current == nil {
+		return false
+	}
+	return (previous.RIP != nil && current.RIP == nil) || (previous.OSPF3 != nil && current.OSPF3 == nil)
+}
+
 // RollbackChanges reverts to the previous FRR configuration.
 func (p *FRRPlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff) error {
 	p.mu.Lock()
@@ -335,6 +377,7 @@ func requiresFRRFileBackend(cfg *pkgfrr.Config) bool {
 		return false
 	}
 	return cfg.OSPF3 != nil ||
+		cfg.RIP != nil ||
 		frrBGPHasEVPN(cfg.BGP) ||
 		frrVRFsHaveEVPN(cfg.VRFs) ||
 		frrBGPHasBFDProfiles(cfg.BGP) ||
@@ -457,6 +500,11 @@ func (p *FRRPlugin) buildFullConfig(diff *engine.ConfigDiff) *model.RouterConfig
 	} else if diff.OldOSPF3 != nil && !diff.OSPF3Changed {
 		cfg.Protocols.OSPF3 = diff.OldOSPF3
 	}
+	if diff.NewRIP != nil {
+		cfg.Protocols.RIP = diff.NewRIP
+	} else if diff.OldRIP != nil && !diff.RIPChanged {
+		cfg.Protocols.RIP = diff.OldRIP
+	}
 	if diff.NewVRRP != nil {
 		cfg.Protocols.VRRP = diff.NewVRRP
 	} else if diff.OldVRRP != nil && !diff.VRRPChanged {
@@ -501,6 +549,7 @@ func hasFRRRelevantChanges(diff *engine.ConfigDiff) bool {
 		diff.EVPNChanged ||
 		diff.OSPFChanged ||
 		diff.OSPF3Changed ||
+		diff.RIPChanged ||
 		diff.StaticRoutesChanged ||
 		diff.PolicyChanged ||
 		diff.RoutingChanged ||
@@ -515,7 +564,8 @@ func hasFRRRelevantInterfaceChanges(diff *engine.ConfigDiff) bool {
 		return true
 	}
 	for _, change := range diff.InterfacesChanged {
-		if len(change.AddressesAdded) > 0 || len(change.AddressesRemoved) > 0 {
+		// Bandwidth feeds the derived OSPF interface cost.
+		if len(change.AddressesAdded) > 0 || len(change.AddressesRemoved) > 0 || change.BandwidthChanged {
 			return true
 		}
 	}
@@ -502,6 +502,7 @@ func TestApplyChangesPassesRoutingInstancesToApplier(t *testing.T) {
 }
 
 func TestApplyChangesFallsBackToFileBackendForOSPF3(t *testing.T) {
+	metric := 20
 	newCfg := model.NewRouterConfig()
 	newCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
 		Units: map[int]*model.Unit{
@@ -512,7 +513,7 @@ func TestApplyChangesFallsBackToFileBackendForOSPF3(t *testing.T) {
 		OSPF3: &model.OSPFConfig{Areas: map[string]*model.OSPFArea{
 			"0.0.0.0": {
 				Interfaces: map[string]*model.OSPFInterface{
-					"ge-0/0/0": {Metric: 20},
+					"ge-0/0/0": {Metric: &metric},
 				},
 			},
 		}},
@@ -544,6 +545,39 @@ func TestApplyChangesFallsBackToFileBackendForOSPF3(t *testing.T) {
 	}
 }
 
+func TestApplyChangesFallsBackToFileBackendForRIP(t *testing.T) {
+	newCfg := model.NewRouterConfig()
+	newCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+		},
+	}
+	newCfg.Protocols = &model.ProtocolsConfig{
+		RIP: &model.RIPConfig{Groups: map[string]*model.RIPGroup{
+			"EDGE": {Neighbors: map[string]*model.RIPNeighbor{"ge-0/0/0": {}}},
+		}},
+	}
+	diff := engine.ComputeDiff(model.NewRouterConfig(), newCfg)
+	if !diff.RIPChanged {
+		t.Fatal("RIPChanged = false, want true")
+	}
+	transactionalApplier := &recordingApplier{}
+	fileApplier := &recordingApplier{}
+	plugin := NewFRRPlugin(testLogger())
+	plugin.applier = transactionalApplier
+	plugin.fileApplier = fileApplier
+
+	if err := plugin.ApplyChanges(context.Background(), diff); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if transactionalApplier.calls != 0 || fileApplier.calls != 1 {
+		t.Fatalf("ApplyConfig calls transactional=%d file=%d, want 0 and 1", transactionalApplier.calls, fileApplier.calls)
+	}
+	if !strings.Contains(fileApplier.configContent, "router rip\n version 2\n network ge0-0-0\n") {
+		t.Fatalf("file applier config missing RIP:\n%s", fileApplier.configContent)
+	}
+}
+
 func TestApplyChangesFallsBackToFileBackendForEVPN(t *testing.T) {
 	newCfg := model.NewRouterConfig()
 	newCfg.Routing = &model.RoutingConfig{AutonomousSystem: 65000, RouterID: "192.0.2.1"}
@@ -685,6 +719,7 @@ func TestApplyChangesFallsBackToFileBackendForUnsupportedRouteMapMatches(t *test
 }
 
 func TestRollbackUsesFileBackendAfterFileFallback(t *testing.T) {
+	metric := 20
 	newCfg := model.NewRouterConfig()
 	newCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
 		Units: map[int]*model.Unit{
@@ -695,7 +730,7 @@ func TestRollbackUsesFileBackendAfterFileFallback(t *testing.T) {
 		OSPF3: &model.OSPFConfig{Areas: map[string]*model.OSPFArea{
 			"0.0.0.0": {
 				Interfaces: map[string]*model.OSPFInterface{
-					"ge-0/0/0": {Metric: 20},
+					"ge-0/0/0": {Metric: &metric},
 				},
 			},
 		}},
@@ -799,6 +834,154 @@ func TestApplyChangesUsesConfiguredFileBackend(t *testing.T) {
 	}
 }
 
+func TestApplyChangesReenablesShutdownNeighborWithFileBackend(t *testing.T) {
+	bgpConfig := func(shutdown bool) *model.RouterConfig {
+		cfg := model.NewRouterConfig()
+		setTestRoutingOptions(cfg)
+		cfg.Protocols = &model.ProtocolsConfig{
+			BGP: &model.BGPConfig{Groups: map[string]*model.BGPGroup{
+				"EBGP": {
+					Type: "external",
+					Neighbors: map[string]*model.BGPNeighbor{
+						"192.0.2.2": {PeerAS: 65001, Shutdown: shutdown},
+					},
+				},
+			}},
+		}
+		return cfg
+	}
+	applier := &recordingApplier{}
+	plugin := NewFRRPluginWithApplyMode(testLogger(), pkgfrr.BackendModeFile)
+	plugin.applier = applier
+
+	if err := plugin.ApplyChanges(context.Background(), engine.ComputeDiff(model.NewRouterConfig(), bgpConfig(true))); err != nil {
+		t.Fatalf("ApplyChanges(shutdown) error = %v", err)
+	}
+	if !strings.Contains(applier.configContent, " neighbor 192.0.2.2 shutdown\n") {
+		t.Fatalf("applied config missing shutdown line:\n%s", applier.configContent)
+	}
+
+	if err := plugin.ApplyChanges(context.Background(), engine.ComputeDiff(bgpConfig(true), bgpConfig(false))); err != nil {
+		t.Fatalf("ApplyChanges(no shutdown) error = %v", err)
+	}
+	if !strings.Contains(applier.configContent, " no neighbor 192.0.2.2 shutdown\n") {
+		t.Fatalf("applied config missing no shutdown line:\n%s", applier.configContent)
+	}
+	if strings.Contains(plugin.currentConfig, "shutdown") {
+		t.Fatalf("current config kept shutdown commands:\n%s", plugin.currentConfig)
+	}
+}
+
+func TestApplyChangesRemovesDeletedBGPWithFileBackend(t *testing.T) {
+	oldCfg := model.NewRouterConfig()
+	setTestRoutingOptions(oldCfg)
+	oldCfg.Protocols = &model.ProtocolsConfig{
+		BGP: &model.BGPConfig{Groups: map[string]*model.BGPGroup{
+			"EBGP": {
+				Type:      "external",
+				Neighbors: map[string]*model.BGPNeighbor{"192.0.2.2": {PeerAS: 65001}},
+			},
+		}},
+	}
+	newCfg := oldCfg.Clone()
+	newCfg.Protocols.BGP = nil
+	diff := engine.ComputeDiff(oldCfg, newCfg)
+	if !diff.BGPChanged {
+		t.Fatal("BGPChanged = false, want true")
+	}
+	applier := &recordingApplier{}
+	plugin := NewFRRPluginWithApplyMode(testLogger(), pkgfrr.BackendModeFile)
+	plugin.applier = applier
+
+	if err := plugin.ApplyChanges(context.Background(), diff); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if !strings.HasPrefix(applier.configContent, pkgfrr.ConfigFileHeader+"!\nno router bgp 65000\n!\n") {
+		t.Fatalf("applied config does not start by removing BGP:\n%s", applier.configContent)
+	}
+	if strings.Contains(applier.configContent, "neighbor 192.0.2.2") {
+		t.Fatalf("applied config kept the removed neighbor:\n%s", applier.configContent)
+	}
+	if strings.Contains(plugin.currentConfig, "router bgp") {
+		t.Fatalf("current config kept BGP:\n%s", plugin.currentConfig)
+	}
+}
+
+func TestApplyChangesRemovesDeletedBGPWithTransactionalBackend(t *testing.T) {
+	oldCfg := model.NewRouterConfig()
+	setTestRoutingOptions(oldCfg)
+	oldCfg.Protocols = &model.ProtocolsConfig{
+		BGP: &model.BGPConfig{Groups: map[string]*model.BGPGroup{
+			"EBGP": {
+				Type:      "external",
+				Neighbors: map[string]*model.BGPNeighbor{"192.0.2.2": {PeerAS: 65001}},
+			},
+		}},
+	}
+	newCfg := oldCfg.Clone()
+	newCfg.Protocols.BGP = nil
+	applier := &recordingApplier{}
+	plugin := NewFRRPlugin(testLogger())
+	plugin.applier = applier
+
+	if err := plugin.ApplyChanges(context.Background(), engine.ComputeDiff(oldCfg, newCfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if applier.calls != 1 || applier.cfg == nil || applier.cfg.BGP != nil {
+		t.Fatalf("ApplyConfig calls = %d cfg = %#v, want one call without BGP", applier.calls, applier.cfg)
+	}
+	ops, err := pkgfrr.BuildMgmtOperations(applier.cfg)
+	if err != nil {
+		t.Fatalf("BuildMgmtOperations() error = %v", err)
+	}
+	deleted := false
+	for _, op := range ops {
+		if op.Type == pkgfrr.MgmtOperationDelete && strings.Contains(op.XPath, "frr-bgp:bgp") {
+			deleted = true
+		}
+		if op.Type == pkgfrr.MgmtOperationSet && strings.Contains(op.XPath, "frr-bgp:bgp") {
+			t.Fatalf("management operations still set BGP: %s", op.Command())
+		}
+	}
+	if !deleted {
+		t.Fatalf("management operations do not delete BGP: %#v", ops)
+	}
+}
+
+func TestApplyChangesRemovesDeletedRIPWithFileBackend(t *testing.T) {
+	oldCfg := model.NewRouterConfig()
+	oldCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+		},
+	}
+	oldCfg.Protocols = &model.ProtocolsConfig{
+		RIP: &model.RIPConfig{Groups: map[string]*model.RIPGroup{
+			"EDGE": {Neighbors: map[string]*model.RIPNeighbor{"ge-0/0/0": {}}},
+		}},
+	}
+	newCfg := oldCfg.Clone()
+	newCfg.Protocols.RIP = nil
+	transactionalApplier := &recordingApplier{}
+	fileApplier := &recordingApplier{}
+	plugin := NewFRRPlugin(testLogger())
+	plugin.applier = transactionalApplier
+	plugin.fileApplier = fileApplier
+
+	if err := plugin.ApplyChanges(context.Background(), engine.ComputeDiff(oldCfg, newCfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if transactionalApplier.calls != 0 || fileApplier.calls != 1 {
+		t.Fatalf("ApplyConfig calls transactional=%d file=%d, want 0 and 1", transactionalApplier.calls, fileApplier.calls)
+	}
+	if !strings.Contains(fileApplier.configContent, "no router rip\n") {
+		t.Fatalf("file applier config does not remove RIP:\n%s", fileApplier.configContent)
+	}
+	if plugin.currentApplyMode != pkgfrr.BackendModeFile {
+		t.Fatalf("currentApplyMode = %q, want file", plugin.currentApplyMode)
+	}
+}
+
 func TestApplyChangesUsesTransactionalDiffForStaticRouteOnlyChange(t *testing.T) {
 	oldCfg := model.NewRouterConfig()
 	oldCfg.Routing = &model.RoutingConfig{StaticRoutes: []*model.StaticRoute{
@@ -1127,3 +1310,30 @@ func (r fakeBFDStatusReader) ReadBFDStatus(ctx context.Context) (*pkgfrr.BFDStat
 	}
 	return r.status, nil
 }
+
+func TestGenerateFRRArtifactsExcludesDeactivatedBGPGroup(t *testing.T) {
+	cfg := model.NewRouterConfig()
+	setTestRoutingOptions(cfg)
+	cfg.Protocols = &model.ProtocolsConfig{
+		BGP: &model.BGPConfig{Groups: map[string]*model.BGPGroup{
+			"EXTERNAL": {Type: "external", Neighbors: map[string]*model.BGPNeighbor{"203.0.113.1": {PeerAS: 65001}}},
+			"INTERNAL": {Type: "internal", Neighbors: map[string]*model.BGPNeighbor{"192.0.2.2": {PeerAS: 65000}}},
+		}},
+	}
+	cfg.Inactive = []string{"protocols bgp group EXTERNAL"}
+
+	active, err := cfg.ActiveConfig()
+	if err != nil {
+		t.Fatalf("ActiveConfig() error = %v", err)
+	}
+	_, content, err := generateFRRArtifacts(active)
+	if err != nil {
+		t.Fatalf("generateFRRArtifacts() error = %v", err)
+	}
+	if strings.Contains(content, "203.0.113.1") {
+		t.Fatalf("generated FRR config contains deactivated neighbor:\n%s", content)
+	}
+	if !strings.Contains(content, "neighbor 192.0.2.2 remote-as 65000") {
+		t.Fatalf("generated FRR config missing active neighbor:\n%s", content)
+	}
+}
@@ -0,0 +1,243 @@
+package vpp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/model"
+)
+
+const defaultAlarmInterval = 10 * time.Second
+
+// Alarm types raised by the VPP plugin.
+const (
+	AlarmInterfaceErrorRate = "interface-error-rate"
+	AlarmInterfaceLinkDown  = "interface-link-down"
+)
+
+// Alarm is an active health alarm raised from VPP interface state.
+type Alarm struct {
+	Type        string
+	Object      string // interface name
+	Description string
+	RaisedAt    time.Time
+}
+
+// alarmEvaluator raises and clears the system alarms configured under
+// "system alarms" from periodic interface state samples. An alarm stays
+// active, keeping the time it was first raised, until a sample no longer
+// crosses its threshold.
+type alarmEvaluator struct {
+	mu       sync.Mutex
+	now      func() time.Time
+	cfg      model.SystemAlarmsConfig
+	samples  map[string]errorSample
+	active   map[alarmKey]Alarm
+	observer func([]Alarm)
+}
+
+type alarmKey struct {
+	alarmType string
+	object    string
+}
+
+// errorSample is the error counter total of an interface at one evaluation.
+type errorSample struct {
+	errors uint64
+	at     time.Time
+}
+
+func newAlarmEvaluator(now func() time.Time) *alarmEvaluator {
+	return &alarmEvaluator{
+		now:     now,
+		samples: make(map[string]errorSample),
+		active:  make(map[alarmKey]Alarm),
+	}
+}
+
+// enabled reports whether any alarm is configured.
+func (e *alarmEvaluator) enabled() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.cfg.InterfaceErrorRate != 0 || e.cfg.InterfaceLinkDown
+}
+
+// setConfig replaces the alarm thresholds with those of cfg and clears the
+// active alarms whose type is no longer configured.
+func (e *alarmEvaluator) setConfig(cfg *model.RouterConfig) {
+	e.mu.Lock()
+	e.cfg = model.SystemAlarmsConfig{}
+	if cfg != nil && cfg.System != nil && cfg.System.Alarms != nil {
+		e.cfg = *cfg.System.Alarms
+	}
+	if e.cfg.InterfaceErrorRate == 0 {
+		e.samples = make(map[string]errorSample)
+	}
+	next := make(map[alarmKey]Alarm, len(e.active))
+	for key, alarm := range e.active {
+		if e.configuredLocked(key.alarmType) {
+			next[key] = alarm
+		}
+	}
+	e.replaceLocked(next)
+}
+
+// restore installs alarms that were active before a restart.
+func (e *alarmEvaluator) restore(alarms []Alarm) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, alarm := range alarms {
+		e.active[alarmKey{alarmType: alarm.Type, object: alarm.Object}] = alarm
+	}
+}
+
+// evaluate raises and clears alarms from one sample of interface state.
+func (e *alarmEvaluator) evaluate(states map[string]*model.InterfaceState) {
+	e.mu.Lock()
+	now := e.now()
+	next := make(map[alarmKey]Alarm)
+	raise := func(alarmType, object, description string) {
+		key := alarmKey{alarmType: alarmType, object: object}
+		alarm, ok := e.active[key]
+		if !ok {
+			alarm = Alarm{Type: alarmType, Object: object, RaisedAt: now}
+		}
+		alarm.Description = description
+		next[key] = alarm
+	}
+
+	for name, state := range states {
+		if state == nil {
+			continue
+		}
+		if e.cfg.InterfaceLinkDown && state.AdminStatus == "up" && state.OperStatus != "up" {
+			raise(AlarmInterfaceLinkDown, name, "link is down")
+		}
+		if e.cfg.InterfaceErrorRate == 0 || state.Counters == nil {
+			continue
+		}
+		total := state.Counters.RxErrors + state.Counters.TxErrors
+		previous, ok := e.samples[name]
+		e.samples[name] = errorSample{errors: total, at: now}
+		threshold := e.cfg.InterfaceErrorRate
+		description := fmt.Sprintf("receive and transmit errors exceed %d per second", threshold)
+		if !ok || total < previous.errors || !now.After(previous.at) {
+			// No rate without an earlier sample of the same counters; keep
+			// the alarm as it was.
+			if alarm, active := e.active[alarmKey{alarmType: AlarmInterfaceErrorRate, object: name}]; active {
+				next[alarmKey{alarmType: AlarmInterfaceErrorRate, object: name}] = alarm
+			}
+			continue
+		}
+		rate := float64(total-previous.errors) / now.Sub(previous.at).Seconds()
+		if rate > float64(threshold) {
+			raise(AlarmInterfaceErrorRate, name, description)
+		}
+	}
+	for name := range e.samples {
+		if _, ok := states[name]; !ok {
+			delete(e.samples, name)
+		}
+	}
+	e.replaceLocked(next)
+}
+
+// replaceLocked installs next as the active alarms and, when the set of
+// alarms changed, reports it to the observer. It releases e.mu.
+func (e *alarmEvaluator) replaceLocked(next map[alarmKey]Alarm) {
+	changed := len(next) != len(e.active)
+	for key := range next {
+		if _, ok := e.active[key]; !ok {
+			changed = true
+		}
+	}
+	e.active = next
+	observer := e.observer
+	alarms := e.alarmsLocked()
+	e.mu.Unlock()
+	if changed && observer != nil {
+		observer(alarms)
+	}
+}
+
+func (e *alarmEvaluator) configuredLocked(alarmType string) bool {
+	switch alarmType {
+	case AlarmInterfaceErrorRate:
+		return e.cfg.InterfaceErrorRate != 0
+	case AlarmInterfaceLinkDown:
+		return e.cfg.InterfaceLinkDown
+	default:
+		return false
+	}
+}
+
+func (e *alarmEvaluator) alarms() []Alarm {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.alarmsLocked()
+}
+
+// alarmsLocked returns the active alarms ordered by object and type.
+func (e *alarmEvaluator) alarmsLocked() []Alarm {
+	alarms := make([]Alarm, 0, len(e.active))
+	for _, alarm := range e.active {
+		alarms = append(alarms, alarm)
+	}
+	sort.Slice(alarms, func(i, j int) bool {
+		if alarms[i].Object != alarms[j].Object {
+			return alarms[i].Object < alarms[j].Object
+		}
+		return alarms[i].Type < alarms[j].Type
+	})
+	return alarms
+}
+
+func (p *VPPPlugin) runAlarmLoop(ctx context.Context) {
+	ticker := time.NewTicker(defaultAlarmInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.evaluateAlarms(ctx)
+		}
+	}
+}
+
+// evaluateAlarms samples interface state and updates the active alarms.
+// Nothing is sampled while no alarm is configured.
+func (p *VPPPlugin) evaluateAlarms(ctx context.Context) {
+	if !p.alarms.enabled() {
+		return
+	}
+	states, err := p.CollectState(ctx)
+	if err != nil {
+		p.log.Warn("Failed to collect interface state for alarms", slog.Any("error", err))
+		return
+	}
+	p.alarms.evaluate(states)
+}
+
+// Alarms returns the active system alarms ordered by interface and type.
+func (p *VPPPlugin) Alarms() []Alarm {
+	return p.alarms.alarms()
+}
+
+// SetAlarmObserver installs a function called with the active alarms each
+// time an alarm is raised or cleared.
+func (p *VPPPlugin) SetAlarmObserver(observer func([]Alarm)) {
+	p.alarms.mu.Lock()
+	defer p.alarms.mu.Unlock()
+	p.alarms.observer = observer
+}
+
+// RestoreAlarms installs the alarms that were active before a restart. They
+// are cleared by the next evaluation that no longer crosses their threshold.
+func (p *VPPPlugin) RestoreAlarms(alarms []Alarm) {
+	p.alarms.restore(alarms)
+}
@@ -0,0 +1,106 @@
+package vpp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/akam1o/arca-router/internal/model"
+)
+
+// linkDebouncer applies interface hold-time to the link state read from VPP.
+// VPP has no link debounce of its own, so a transition is only reported once
+// the new state has persisted for the configured up or down hold-time; a
+// flap that reverts within the hold-time is never reported.
+//
+// Link state is polled rather than delivered as events, so the hold-time is
+// measured from the poll that first read the new state, not from the link
+// transition itself. A transition is therefore reported between the
+// hold-time and the hold-time plus one poll interval after it happened, and
+// a flap shorter than the poll interval may never be seen at all.
+type linkDebouncer struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	holdTimes map[string]model.HoldTime
+	links     map[string]*debouncedLink
+}
+
+// debouncedLink is the debounce state of one interface.
+type debouncedLink struct {
+	reported bool      // link state last reported
+	observed bool      // link state last read from VPP
+	since    time.Time // when observed last changed
+}
+
+func newLinkDebouncer(now func() time.Time) *linkDebouncer {
+	return &linkDebouncer{
+		now:       now,
+		holdTimes: make(map[string]model.HoldTime),
+		links:     make(map[string]*debouncedLink),
+	}
+}
+
+// setHoldTimes replaces the configured hold-times with those of cfg and
+// forgets the state of interfaces that are no longer configured.
+func (d *linkDebouncer) setHoldTimes(cfg *model.RouterConfig) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.holdTimes = make(map[string]model.HoldTime)
+	if cfg == nil {
+		d.links = make(map[string]*debouncedLink)
+		return
+	}
+	for name, iface := range cfg.Interfaces {
+		if iface != nil && iface.HoldTime != nil {
+			d.holdTimes[name] = *iface.HoldTime
+		}
+	}
+	for name := range d.links {
+		if _, ok := cfg.Interfaces[name]; !ok {
+			delete(d.links, name)
+		}
+	}
+}
+
+// restartHoldTimer restarts the hold-time of a pending transition of the
+// named interface, so that a hold-time changed by a commit is measured in
+// full from the commit rather than from when the transition was first read.
+func (d *linkDebouncer) restartHoldTimer(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if link, ok := d.links[name]; ok && link.observed != link.reported {
+		link.since = d.now()
+	}
+}
+
+// observe records the link state read from VPP and returns the state to
+// report. The first observation of an interface is reported as is.
+func (d *linkDebouncer) observe(name string, up bool) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	link, ok := d.links[name]
+	if !ok {
+		d.links[name] = &debouncedLink{reported: up, observed: up, since: now}
+		return up
+	}
+	if up != link.observed {
+		link.observed = up
+		link.since = now
+	}
+	if link.observed == link.reported {
+		return link.reported
+	}
+
+	holdTime := d.holdTimes[name]
+	hold := time.Duration(holdTime.Down) * time.Millisecond
+	if up {
+		hold = time.Duration(holdTime.Up) * time.Millisecond
+	}
+	if now.Sub(link.since) >= hold {
+		link.reported = up
+	}
+	return link.reported
+}
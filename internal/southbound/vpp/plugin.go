@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/akam1o/arca-router/internal/engine"
 	"github.com/akam1o/arca-router/internal/model"
+	"github.com/akam1o/arca-router/pkg/config"
 	"github.com/akam1o/arca-router/pkg/device"
 	pkgvpp "github.com/akam1o/arca-router/pkg/vpp"
 )
@@ -35,6 +38,9 @@ type VPPPlugin struct {
 	// ifaceIndex maps Junos interface name → VPP sw_if_index
 	ifaceIndex map[string]uint32
 
+	// stableIndex assigns persistent logical indexes that survive VPP restarts
+	stableIndex *pkgvpp.InterfaceIndexTable
+
 	// vxlanIfIndex maps EVPN VNI → VPP VXLAN tunnel sw_if_index
 	vxlanIfIndex map[int]uint32
 
@@ -48,6 +54,13 @@ type VPPPlugin struct {
 	// partial changes before returning an error.
 	applyFailureRolledBack bool
 
+	// links debounces the reported oper status with interface hold-time
+	links *linkDebouncer
+
+	// alarms raises and clears the configured system alarms
+	alarms      *alarmEvaluator
+	alarmCancel context.CancelFunc
+
 	lcpReconciliation LCPReconciliationStatus
 	qosCapabilities   QoSCapabilityStatus
 }
@@ -82,14 +95,23 @@ func NewVPPPlugin(client pkgvpp.Client, hwConfig *device.HardwareConfig, log *sl
 		hwConfig:          hwConfig,
 		log:               log.With("plugin", "vpp"),
 		ifaceIndex:        make(map[string]uint32),
+		stableIndex:       pkgvpp.NewInterfaceIndexTable(),
 		vxlanIfIndex:      make(map[int]uint32),
 		appliedAddrs:      make(map[uint32][]*net.IPNet),
 		removedInterfaces: make(map[string]uint32),
+		links:             newLinkDebouncer(time.Now),
+		alarms:            newAlarmEvaluator(time.Now),
 	}
 }
 
 func (p *VPPPlugin) Name() string { return "vpp" }
 
+// SetInterfaceIndexPath persists stable interface indexes in path. Without
+// it the indexes are kept in memory only. It must be called before Init.
+func (p *VPPPlugin) SetInterfaceIndexPath(path string) {
+	p.stableIndex = pkgvpp.NewInterfaceIndexTableWithPath(path)
+}
+
 func (p *VPPPlugin) Init(ctx context.Context) error {
 	if err := p.client.Connect(ctx); err != nil {
 		return fmt.Errorf("vpp connect: %w", err)
@@ -100,31 +122,54 @@ func (p *VPPPlugin) Init(ctx context.Context) error {
 		p.log.Warn("LCP state sync failed, continuing", slog.Any("error", err))
 	}
 	p.updateQoSCapabilities(ctx)
+	if err := p.stableIndex.Load(); err != nil {
+		p.log.Warn("Failed to load stable interface indexes, reassigning", slog.Any("error", err))
+	}
 
 	// Build interface index from existing VPP interfaces
 	existing, err := p.client.ListInterfaces(ctx)
 	if err != nil {
 		p.log.Warn("Failed to list existing interfaces", slog.Any("error", err))
 	} else {
+		indexChanged := false
 		for _, iface := range existing {
 			if iface.PCIAddress != "" {
 				// Map PCI back to Junos name via hardware config
 				for _, hw := range p.hwConfig.Interfaces {
 					if hw.PCI == iface.PCIAddress {
 						p.ifaceIndex[hw.Name] = iface.SwIfIndex
+						if _, changed := p.stableIndex.Assign(hw.Name, hw.PCI); changed {
+							indexChanged = true
+						}
 						break
 					}
 				}
 			}
 		}
+		if indexChanged {
+			p.saveStableIndex()
+		}
 	}
 
 	p.updateLCPReconciliation(ctx)
 
+	alarmCtx, cancel := context.WithCancel(ctx)
+	p.mu.Lock()
+	p.alarmCancel = cancel
+	p.mu.Unlock()
+	go p.runAlarmLoop(alarmCtx)
+
 	return nil
 }
 
 func (p *VPPPlugin) Close() error {
+	p.mu.Lock()
+	cancel := p.alarmCancel
+	p.alarmCancel = nil
+	p.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
 	return p.client.Close()
 }
 
@@ -141,12 +186,29 @@ func (p *VPPPlugin) ValidateChanges(ctx context.Context, diff *engine.ConfigDiff
 	if err := validateEVPNChanges(diff); err != nil {
 		return err
 	}
-	// Validate added interfaces exist in hardware config
-	for name := range diff.InterfacesAdded {
+	// Validate added interfaces exist in hardware config; tunnel interfaces
+	// are created from their endpoints instead.
+	for name, ifaceCfg := range diff.InterfacesAdded {
+		if _, ok := softwareInterfaceCreateRequest(name, ifaceCfg); ok {
+			continue
+		}
 		if !p.hasHardwareConfig(name) {
 			return fmt.Errorf("interface %s: not found in hardware configuration", name)
 		}
 	}
+	for _, change := range diff.InterfacesChanged {
+		if change.TunnelChanged {
+			return fmt.Errorf("interface %s: tunnel endpoints cannot be changed in place; delete the interface and commit before configuring the new endpoints", change.Name)
+		}
+		// VPP fixes the queue count when it creates the interface, and
+		// physical interfaces are never deleted from VPP.
+		if change.QueuesChanged {
+			return fmt.Errorf("interface %s: rxq/txq cannot be changed on an existing interface; VPP sets the queue count when it creates the interface", change.Name)
+		}
+	}
+	if err := p.validateRxPlacement(ctx, diff); err != nil {
+		return err
+	}
 
 	if diff.RoutingInstancesChanged {
 		if _, err := routingInstancePlanMap(diff.NewRoutingInstances); err != nil {
@@ -181,19 +243,24 @@ func (p *VPPPlugin) ApplyChanges(ctx context.Context, diff *engine.ConfigDiff) e
 	p.removedInterfaces = make(map[string]uint32)
 	p.applyFailureRolledBack = false
 
-	// 1. Create new interfaces
-	for name, ifaceCfg := range diff.InterfacesAdded {
-		if err := p.createInterface(ctx, name, ifaceCfg, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("create interface %s: %w", name, err), rollbackOps)
+	// 1. Create new interfaces, in name order like RenderOperations
+	for _, name := range slices.Sorted(maps.Keys(diff.InterfacesAdded)) {
+		ifaceCfg := diff.InterfacesAdded[name]
+		if err := p.applyStep(ctx, "create interface "+name, &rollbackOps, func() error {
+			return p.createInterface(ctx, name, ifaceCfg, &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
 	tableAddressHandled := make(map[string]bool)
 	if diff.RoutingInstancesChanged {
-		var err error
-		tableAddressHandled, err = p.applyRoutingInstanceChanges(ctx, diff, &rollbackOps, false)
-		if err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("update routing instance tables: %w", err), rollbackOps)
+		if err := p.applyStep(ctx, "update routing instance tables", &rollbackOps, func() error {
+			var err error
+			tableAddressHandled, err = p.applyRoutingInstanceChanges(ctx, diff, &rollbackOps, false)
+			return err
+		}); err != nil {
+			return err
 		}
 	}
 
@@ -205,59 +272,119 @@ func (p *VPPPlugin) ApplyChanges(ctx context.Context, diff *engine.ConfigDiff) e
 		if !ok {
 			return p.rollbackApplyError(ctx, fmt.Errorf("interface %s not found in VPP", name), rollbackOps)
 		}
-		if err := p.applyAddresses(ctx, swIfIndex, ifaceCfg, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("apply interface %s addresses: %w", name, err), rollbackOps)
+		if err := p.applyStep(ctx, "apply interface "+name+" addresses", &rollbackOps, func() error {
+			return p.applyAddresses(ctx, swIfIndex, ifaceCfg, &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
-	// 2. Apply address changes on existing interfaces
+	// 2. Apply settings and address changes on existing interfaces
 	for _, change := range diff.InterfacesChanged {
-		if tableAddressHandled[change.Name] {
-			continue
-		}
-		if err := p.applyInterfaceChanges(ctx, change, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("update interface %s: %w", change.Name, err), rollbackOps)
+		if err := p.applyStep(ctx, "update interface "+change.Name, &rollbackOps, func() error {
+			if err := p.applyInterfaceSettings(ctx, change, &rollbackOps); err != nil {
+				return err
+			}
+			if tableAddressHandled[change.Name] {
+				return nil
+			}
+			return p.applyInterfaceChanges(ctx, change, &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
-	// 3. Apply MPLS forwarding state before interfaces are removed.
+	// 3. Apply static ARP/ND neighbors once interface addresses are in place.
+	if err := p.applyStep(ctx, "update static neighbors", &rollbackOps, func() error {
+		return p.applyNeighborChanges(ctx, diff.OldConfig, diff.NewConfig, &rollbackOps)
+	}); err != nil {
+		return err
+	}
+	if err := p.applyStep(ctx, "enable IPv6", &rollbackOps, func() error {
+		return p.applyIPv6EnableChanges(ctx, diff.OldConfig, diff.NewConfig, true, &rollbackOps)
+	}); err != nil {
+		return err
+	}
+	if err := p.applyStep(ctx, "update router advertisements", &rollbackOps, func() error {
+		return p.applyRouterAdvertisementChanges(ctx, diff.OldConfig, diff.NewConfig, &rollbackOps)
+	}); err != nil {
+		return err
+	}
+	if err := p.applyStep(ctx, "disable IPv6", &rollbackOps, func() error {
+		return p.applyIPv6EnableChanges(ctx, diff.OldConfig, diff.NewConfig, false, &rollbackOps)
+	}); err != nil {
+		return err
+	}
+
+	// 4. Apply MPLS forwarding state before interfaces are removed.
 	if diff.MPLSChanged {
-		if err := p.applyMPLSChanges(ctx, diff.OldMPLS, diff.NewMPLS, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("update MPLS interfaces: %w", err), rollbackOps)
+		if err := p.applyStep(ctx, "update MPLS interfaces", &rollbackOps, func() error {
+			return p.applyMPLSChanges(ctx, diff.OldMPLS, diff.NewMPLS, &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
-	// 4. Apply class-of-service profile bindings before interfaces are removed.
+	// 5. Apply class-of-service profile bindings before interfaces are removed.
 	if diff.ClassOfServiceChanged {
-		if err := p.applyClassOfServiceChanges(ctx, diff.OldClassOfService, diff.NewClassOfService, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("update class-of-service interfaces: %w", err), rollbackOps)
+		if err := p.applyStep(ctx, "update class-of-service interfaces", &rollbackOps, func() error {
+			return p.applyClassOfServiceChanges(ctx, diff.OldClassOfService, diff.NewClassOfService, &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
-	// 5. Apply EVPN/VXLAN overlay state before interfaces are removed.
+	// 6. Apply firewall policers and interface bindings before interfaces are removed.
+	if err := p.applyStep(ctx, "update firewall policers", &rollbackOps, func() error {
+		return p.applyPolicerChanges(ctx, diff.OldConfig, diff.NewConfig, &rollbackOps)
+	}); err != nil {
+		return err
+	}
+
+	// 7. Apply EVPN/VXLAN overlay state before interfaces are removed.
 	if diff.EVPNChanged {
-		if err := p.applyEVPNChanges(ctx, diff, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("update EVPN/VXLAN dataplane: %w", err), rollbackOps)
+		if err := p.applyStep(ctx, "update EVPN/VXLAN dataplane", &rollbackOps, func() error {
+			return p.applyEVPNChanges(ctx, diff, &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
 	if diff.RoutingInstancesChanged {
-		if err := p.deleteStaleRoutingInstanceTables(ctx, diff, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("delete routing instance tables: %w", err), rollbackOps)
+		if err := p.applyStep(ctx, "delete routing instance tables", &rollbackOps, func() error {
+			return p.deleteStaleRoutingInstanceTables(ctx, diff, &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
-	// 6. Remove interfaces (remove addresses, LCP, then disable)
+	// 8. Remove interfaces (remove addresses, LCP, then disable)
 	for _, name := range diff.InterfacesRemoved {
-		if err := p.removeInterface(ctx, name, &rollbackOps); err != nil {
-			return p.rollbackApplyError(ctx, fmt.Errorf("remove interface %s: %w", name, err), rollbackOps)
+		if err := p.applyStep(ctx, "remove interface "+name, &rollbackOps, func() error {
+			return p.removeInterface(ctx, name, configuredInterface(diff.OldConfig, name), &rollbackOps)
+		}); err != nil {
+			return err
 		}
 	}
 
+	p.setHoldTimes(diff, diff.NewConfig)
+	p.alarms.setConfig(diff.NewConfig)
 	p.updateLCPReconciliationLocked(ctx)
 	return nil
 }
 
+// setHoldTimes installs the hold-times of cfg, the new configuration of diff
+// or the old one on rollback, and restarts the pending transitions of the
+// interfaces whose hold-time diff changes.
+func (p *VPPPlugin) setHoldTimes(diff *engine.ConfigDiff, cfg *model.RouterConfig) {
+	p.links.setHoldTimes(cfg)
+	for name, change := range diff.InterfacesChanged {
+		if change != nil && change.HoldTimeChanged {
+			p.links.restartHoldTimer(name)
+		}
+	}
+}
+
 // RollbackChanges undoes previously applied VPP changes.
 func (p *VPPPlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff) error {
 	p.mu.Lock()
@@ -283,23 +410,36 @@ func (p *VPPPlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff
 		if !ok {
 			continue
 		}
-		p.ifaceIndex[name] = swIfIndex
-		if err := p.client.SetInterfaceUp(ctx, swIfIndex); err != nil {
-			rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore interface %s up: %w", name, err))
-		}
-		if linuxName, err := pkgvpp.ConvertJunosToLinuxName(name); err == nil {
-			if err := p.lcpManager.Create(ctx, swIfIndex, linuxName, name); err != nil {
-				rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore LCP interface %s: %w", name, err))
-			}
+		oldCfg := configuredInterface(diff.OldConfig, name)
+		_, tunnel := softwareInterfaceCreateRequest(name, oldCfg)
+		if err := p.restoreRemovedInterface(ctx, name, swIfIndex, oldCfg, tunnel); err != nil {
+			rollbackErr = errors.Join(rollbackErr, err)
 		}
 	}
 
+	if err := p.applyNeighborChanges(ctx, diff.NewConfig, diff.OldConfig, nil); err != nil {
+		rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore static neighbors: %w", err))
+	}
+	if err := p.applyIPv6EnableChanges(ctx, diff.NewConfig, diff.OldConfig, true, nil); err != nil {
+		rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore IPv6 enable: %w", err))
+	}
+	if err := p.applyRouterAdvertisementChanges(ctx, diff.NewConfig, diff.OldConfig, nil); err != nil {
+		rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore router advertisements: %w", err))
+	}
+	if err := p.applyIPv6EnableChanges(ctx, diff.NewConfig, diff.OldConfig, false, nil); err != nil {
+		rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore IPv6 disable: %w", err))
+	}
+
 	if diff.ClassOfServiceChanged {
 		if err := p.applyClassOfServiceChanges(ctx, diff.NewClassOfService, diff.OldClassOfService, nil); err != nil {
 			rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore class-of-service interfaces: %w", err))
 		}
 	}
 
+	if err := p.applyPolicerChanges(ctx, diff.NewConfig, diff.OldConfig, nil); err != nil {
+		rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore firewall policers: %w", err))
+	}
+
 	if diff.EVPNChanged {
 		if err := p.applyEVPNChanges(ctx, reverseEVPNDiff(diff), nil); err != nil {
 			rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore EVPN/VXLAN dataplane: %w", err))
@@ -321,6 +461,11 @@ func (p *VPPPlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff
 		if err := p.client.SetInterfaceDown(ctx, swIfIndex); err != nil {
 			rollbackErr = errors.Join(rollbackErr, fmt.Errorf("set interface %s down: %w", name, err))
 		}
+		if req, ok := softwareInterfaceCreateRequest(name, ifaceCfg); ok {
+			if err := p.client.DeleteTunnelInterface(ctx, req, swIfIndex); err != nil {
+				rollbackErr = errors.Join(rollbackErr, fmt.Errorf("delete tunnel interface %s: %w", name, err))
+			}
+		}
 		delete(p.ifaceIndex, name)
 	}
 
@@ -339,13 +484,33 @@ func (p *VPPPlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff
 	}
 
 	for _, change := range diff.InterfacesChanged {
-		if tableAddressHandled[change.Name] {
-			continue
-		}
 		swIfIndex, ok := p.ifaceIndex[change.Name]
 		if !ok {
 			continue
 		}
+		if change.PromiscuousChanged {
+			if err := p.client.SetInterfacePromiscuous(ctx, swIfIndex, !change.NewPromiscuous); err != nil {
+				rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore promiscuous mode on interface %s: %w", change.Name, err))
+			}
+		}
+		if change.RxModeChanged {
+			if err := p.client.SetInterfaceRxMode(ctx, swIfIndex, vppRxMode(change.OldRxMode)); err != nil {
+				rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore rx-mode on interface %s: %w", change.Name, err))
+			}
+		}
+		if change.MTUChanged {
+			if err := p.programMTU(ctx, swIfIndex, change.OldMTU); err != nil {
+				rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore MTU on interface %s: %w", change.Name, err))
+			}
+		}
+		if change.RxPlacementChanged {
+			if err := p.restoreRxPlacement(ctx, swIfIndex, change.OldRxPlacement, change.NewRxPlacement); err != nil {
+				rollbackErr = errors.Join(rollbackErr, fmt.Errorf("restore rx-placement on interface %s: %w", change.Name, err))
+			}
+		}
+		if tableAddressHandled[change.Name] {
+			continue
+		}
 		// Remove addresses that were added
 		for _, addr := range change.AddressesAdded {
 			ipNet, err := pkgvpp.ParseCIDRAddress(addr.Address)
@@ -376,6 +541,8 @@ func (p *VPPPlugin) RollbackChanges(ctx context.Context, diff *engine.ConfigDiff
 		}
 	}
 
+	p.setHoldTimes(diff, diff.OldConfig)
+	p.alarms.setConfig(diff.OldConfig)
 	p.updateLCPReconciliationLocked(ctx)
 	return rollbackErr
 }
@@ -405,9 +572,15 @@ func (p *VPPPlugin) CollectState(ctx context.Context) (map[string]*model.Interfa
 
 		state := &model.InterfaceState{
 			Name:       junosName,
+			IfIndex:    p.StableInterfaceIndex(junosName),
 			MAC:        iface.MAC.String(),
 			QoSProfile: iface.QoSProfile,
 		}
+		for _, addr := range iface.Addresses {
+			if addr != nil {
+				state.Addresses = append(state.Addresses, addr.String())
+			}
+		}
 		if tableID, err := p.client.GetInterfaceTable(ctx, iface.SwIfIndex, false); err != nil {
 			p.log.Warn("Failed to get VPP interface IPv4 table", slog.String("interface", junosName), slog.Any("error", err))
 		} else {
@@ -423,7 +596,7 @@ func (p *VPPPlugin) CollectState(ctx context.Context) (map[string]*model.Interfa
 		} else {
 			state.AdminStatus = "down"
 		}
-		if iface.LinkUp {
+		if p.links.observe(junosName, iface.LinkUp) {
 			state.OperStatus = "up"
 		} else {
 			state.OperStatus = "down"
@@ -473,6 +646,23 @@ func modelInterfaceQueues(queues pkgvpp.InterfaceQueuePlacements) *model.Interfa
 	return result
 }
 
+// ClearInterfaceCounters resets the VPP counters of a managed interface, or
+// of every interface when name is empty.
+func (p *VPPPlugin) ClearInterfaceCounters(ctx context.Context, name string) error {
+	ifIndex := pkgvpp.AllInterfaces
+	if name != "" {
+		idx, ok := p.GetInterfaceIndex(name)
+		if !ok {
+			return fmt.Errorf("unknown interface %s", name)
+		}
+		ifIndex = idx
+	}
+	if err := p.client.ClearInterfaceStats(ctx, ifIndex); err != nil {
+		return fmt.Errorf("clear interface counters: %w", err)
+	}
+	return nil
+}
+
 // LCPReconciliationStatus returns a copy of the latest LCP reconciliation result.
 func (p *VPPPlugin) LCPReconciliationStatus() LCPReconciliationStatus {
 	p.mu.RLock()
@@ -513,10 +703,44 @@ func (p *VPPPlugin) getHardwareConfig(name string) *device.PhysicalInterface {
 	return nil
 }
 
-func (p *VPPPlugin) createInterface(ctx context.Context, name string, ifaceCfg *model.InterfaceConfig, rollback *[]func(context.Context) error) error {
+// softwareInterfaceCreateRequest returns the request that creates an
+// interface VPP implements in software rather than from hardware
+// configuration: a gr- (GRE) or ip- (IP-in-IP) tunnel from its configured
+// endpoints, or the lo0 loopback. It returns false for physical interfaces.
+func softwareInterfaceCreateRequest(name string, ifaceCfg *model.InterfaceConfig) (*pkgvpp.CreateInterfaceRequest, bool) {
+	if name == config.LoopbackInterface {
+		return &pkgvpp.CreateInterfaceRequest{Type: pkgvpp.InterfaceTypeLoopback, Name: name}, true
+	}
+	var ifaceType pkgvpp.InterfaceType
+	switch config.TunnelInterfaceType(name) {
+	case config.TunnelTypeGRE:
+		ifaceType = pkgvpp.InterfaceTypeGRE
+	case config.TunnelTypeIPIP:
+		ifaceType = pkgvpp.InterfaceTypeIPIP
+	default:
+		return nil, false
+	}
+	req := &pkgvpp.CreateInterfaceRequest{Type: ifaceType, Name: name}
+	if ifaceCfg != nil && ifaceCfg.Tunnel != nil {
+		req.TunnelSource = net.ParseIP(ifaceCfg.Tunnel.Source)
+		req.TunnelDestination = net.ParseIP(ifaceCfg.Tunnel.Destination)
+	}
+	return req, true
+}
+
+func configuredInterface(cfg *model.RouterConfig, name string) *model.InterfaceConfig {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Interfaces[name]
+}
+
+// hardwareCreateRequest returns the request that creates a physical interface
+// from its hardware configuration.
+func (p *VPPPlugin) hardwareCreateRequest(name string, ifaceCfg *model.InterfaceConfig) (*pkgvpp.CreateInterfaceRequest, error) {
 	hw := p.getHardwareConfig(name)
 	if hw == nil {
-		return fmt.Errorf("no hardware config for %s", name)
+		return nil, fmt.Errorf("no hardware config for %s", name)
 	}
 
 	// Determine interface type
@@ -530,27 +754,58 @@ func (p *VPPPlugin) createInterface(ctx context.Context, name string, ifaceCfg *
 		ifaceType = pkgvpp.InterfaceTypeRDMA
 		linuxIfName, err := pkgvpp.GetLinuxIfNameFromPCI(hw.PCI)
 		if err != nil {
-			return fmt.Errorf("PCI resolve for RDMA: %w", err)
+			return nil, fmt.Errorf("PCI resolve for RDMA: %w", err)
 		}
 		deviceInstance = linuxIfName
 	default:
-		return fmt.Errorf("unsupported driver: %s", hw.Driver)
+		return nil, fmt.Errorf("unsupported driver: %s", hw.Driver)
 	}
 
-	// Create VPP interface
-	vppIface, err := p.client.CreateInterface(ctx, &pkgvpp.CreateInterfaceRequest{
+	rxq, txq := interfaceQueueCounts(ifaceCfg)
+	return &pkgvpp.CreateInterfaceRequest{
 		Type:           ifaceType,
 		DeviceInstance: deviceInstance,
 		PCIAddress:     hw.PCI,
 		Name:           name,
-		NumRxQueues:    1,
-		NumTxQueues:    1,
-	})
+		NumRxQueues:    rxq,
+		NumTxQueues:    txq,
+	}, nil
+}
+
+// interfaceQueueCounts returns the RX and TX queue counts a physical
+// interface is created with; unset counts default to one queue.
+func interfaceQueueCounts(ifaceCfg *model.InterfaceConfig) (rxq, txq uint16) {
+	rxq, txq = 1, 1
+	if ifaceCfg != nil && ifaceCfg.GigEtherOptions != nil {
+		if ifaceCfg.GigEtherOptions.RxQueues > 0 {
+			rxq = uint16(ifaceCfg.GigEtherOptions.RxQueues)
+		}
+		if ifaceCfg.GigEtherOptions.TxQueues > 0 {
+			txq = uint16(ifaceCfg.GigEtherOptions.TxQueues)
+		}
+	}
+	return rxq, txq
+}
+
+func (p *VPPPlugin) createInterface(ctx context.Context, name string, ifaceCfg *model.InterfaceConfig, rollback *[]func(context.Context) error) error {
+	req, tunnel := softwareInterfaceCreateRequest(name, ifaceCfg)
+	if !tunnel {
+		var err error
+		if req, err = p.hardwareCreateRequest(name, ifaceCfg); err != nil {
+			return err
+		}
+	}
+
+	// Create VPP interface
+	vppIface, err := p.client.CreateInterface(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	p.ifaceIndex[name] = vppIface.SwIfIndex
+	if _, changed := p.stableIndex.Assign(name, req.PCIAddress); changed {
+		p.saveStableIndex()
+	}
 	*rollback = append(*rollback, func(ctx context.Context) error {
 		var rollbackErr error
 		if err := p.client.DeleteLCPInterface(ctx, vppIface.SwIfIndex); err != nil {
@@ -559,6 +814,11 @@ func (p *VPPPlugin) createInterface(ctx context.Context, name string, ifaceCfg *
 		if err := p.client.SetInterfaceDown(ctx, vppIface.SwIfIndex); err != nil {
 			rollbackErr = errors.Join(rollbackErr, fmt.Errorf("set interface %s down: %w", name, err))
 		}
+		if tunnel {
+			if err := p.client.DeleteTunnelInterface(ctx, req, vppIface.SwIfIndex); err != nil {
+				rollbackErr = errors.Join(rollbackErr, fmt.Errorf("delete tunnel interface %s: %w", name, err))
+			}
+		}
 		delete(p.ifaceIndex, name)
 		return rollbackErr
 	})
@@ -568,6 +828,30 @@ func (p *VPPPlugin) createInterface(ctx context.Context, name string, ifaceCfg *
 		return fmt.Errorf("set up: %w", err)
 	}
 
+	// Promiscuous mode is off and the RX mode and MTU are the dataplane
+	// defaults on a new interface, so only explicitly configured settings
+	// are programmed.
+	if ifaceCfg != nil && ifaceCfg.Promiscuous {
+		if err := p.setPromiscuous(ctx, vppIface.SwIfIndex, true, rollback); err != nil {
+			return err
+		}
+	}
+	if ifaceCfg != nil && ifaceCfg.RxMode != "" {
+		if err := p.setRxMode(ctx, vppIface.SwIfIndex, "", ifaceCfg.RxMode, rollback); err != nil {
+			return err
+		}
+	}
+	if mtu := engine.ConfiguredInterfaceMTU(ifaceCfg); !mtu.IsZero() {
+		if err := p.setMTU(ctx, vppIface.SwIfIndex, engine.InterfaceMTU{}, mtu, rollback); err != nil {
+			return err
+		}
+	}
+	if ifaceCfg != nil && ifaceCfg.GigEtherOptions != nil {
+		if err := p.setRxPlacement(ctx, vppIface.SwIfIndex, nil, ifaceCfg.GigEtherOptions.RxPlacement, rollback); err != nil {
+			return err
+		}
+	}
+
 	// Create LCP pair
 	linuxName, err := pkgvpp.ConvertJunosToLinuxName(name)
 	if err != nil {
@@ -588,39 +872,264 @@ func (p *VPPPlugin) applyInterfaceChanges(ctx context.Context, change *engine.In
 	}
 
 	// Remove old addresses
+	var removeOps []pkgvpp.AddressOp
 	for _, addr := range change.AddressesRemoved {
 		ipNet, err := pkgvpp.ParseCIDRAddress(addr.Address)
 		if err != nil {
 			continue
 		}
-		if err := p.client.DeleteInterfaceAddress(ctx, swIfIndex, ipNet); err != nil {
-			return fmt.Errorf("delete address %s: %w", addr.Address, err)
-		}
-		addrCopy := cloneIPNet(ipNet)
-		*rollback = append(*rollback, func(ctx context.Context) error {
-			return p.client.SetInterfaceAddress(ctx, swIfIndex, addrCopy)
-		})
+		removeOps = append(removeOps, pkgvpp.AddressOp{IfIndex: swIfIndex, Address: ipNet, Delete: true})
+	}
+	if err := p.applyAddressOps(ctx, removeOps, rollback); err != nil {
+		return err
 	}
 
 	// Add new addresses
+	var addOps []pkgvpp.AddressOp
 	for _, addr := range change.AddressesAdded {
 		ipNet, err := pkgvpp.ParseCIDRAddress(addr.Address)
 		if err != nil {
 			return fmt.Errorf("parse CIDR %s: %w", addr.Address, err)
 		}
-		if err := p.client.SetInterfaceAddress(ctx, swIfIndex, ipNet); err != nil {
-			return fmt.Errorf("set address %s: %w", addr.Address, err)
+		addOps = append(addOps, pkgvpp.AddressOp{IfIndex: swIfIndex, Address: ipNet})
+	}
+	return p.applyAddressOps(ctx, addOps, rollback)
+}
+
+// applyAddressOps sends address changes to VPP as one batch. Every operation
+// is attempted; a rollback is registered for each one that succeeded, and the
+// failures are returned together behind a count summary.
+func (p *VPPPlugin) applyAddressOps(ctx context.Context, ops []pkgvpp.AddressOp, rollback *[]func(context.Context) error) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	errs := p.client.ApplyInterfaceAddresses(ctx, ops)
+	var failed []error
+	for i, op := range ops {
+		if err := errs[i]; err != nil {
+			verb := "set"
+			if op.Delete {
+				verb = "delete"
+			}
+			failed = append(failed, fmt.Errorf("%s address %s: %w", verb, op.Address, err))
+			continue
+		}
+		if rollback != nil {
+			undo := pkgvpp.AddressOp{IfIndex: op.IfIndex, Address: cloneIPNet(op.Address), Delete: !op.Delete}
+			*rollback = append(*rollback, func(ctx context.Context) error {
+				if undo.Delete {
+					return p.client.DeleteInterfaceAddress(ctx, undo.IfIndex, undo.Address)
+				}
+				return p.client.SetInterfaceAddress(ctx, undo.IfIndex, undo.Address)
+			})
+		}
+	}
+	p.log.Debug("Applied interface address batch",
+		slog.Int("operations", len(ops)),
+		slog.Int("failed", len(failed)))
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d address operations failed: %w", len(failed), len(ops), errors.Join(failed...))
+}
+
+func (p *VPPPlugin) applyInterfaceSettings(ctx context.Context, change *engine.InterfaceChange, rollback *[]func(context.Context) error) error {
+	if !change.PromiscuousChanged && !change.RxModeChanged && !change.MTUChanged && !change.RxPlacementChanged {
+		return nil
+	}
+	swIfIndex, ok := p.ifaceIndex[change.Name]
+	if !ok {
+		return fmt.Errorf("interface %s not found in VPP", change.Name)
+	}
+	if change.PromiscuousChanged {
+		if err := p.setPromiscuous(ctx, swIfIndex, change.NewPromiscuous, rollback); err != nil {
+			return err
+		}
+	}
+	if change.RxModeChanged {
+		if err := p.setRxMode(ctx, swIfIndex, change.OldRxMode, change.NewRxMode, rollback); err != nil {
+			return err
+		}
+	}
+	if change.MTUChanged {
+		if err := p.setMTU(ctx, swIfIndex, change.OldMTU, change.NewMTU, rollback); err != nil {
+			return err
+		}
+	}
+	if change.RxPlacementChanged {
+		if err := p.setRxPlacement(ctx, swIfIndex, change.OldRxPlacement, change.NewRxPlacement, rollback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *VPPPlugin) setPromiscuous(ctx context.Context, swIfIndex uint32, enabled bool, rollback *[]func(context.Context) error) error {
+	if err := p.client.SetInterfacePromiscuous(ctx, swIfIndex, enabled); err != nil {
+		return fmt.Errorf("set promiscuous %t: %w", enabled, err)
+	}
+	*rollback = append(*rollback, func(ctx context.Context) error {
+		return p.client.SetInterfacePromiscuous(ctx, swIfIndex, !enabled)
+	})
+	return nil
+}
+
+func (p *VPPPlugin) setRxMode(ctx context.Context, swIfIndex uint32, oldMode, newMode string, rollback *[]func(context.Context) error) error {
+	if err := p.client.SetInterfaceRxMode(ctx, swIfIndex, vppRxMode(newMode)); err != nil {
+		return fmt.Errorf("set rx-mode %s: %w", vppRxMode(newMode), err)
+	}
+	*rollback = append(*rollback, func(ctx context.Context) error {
+		return p.client.SetInterfaceRxMode(ctx, swIfIndex, vppRxMode(oldMode))
+	})
+	return nil
+}
+
+// validateRxPlacement checks the RX queue pins of added and changed
+// interfaces against the worker threads VPP runs.
+func (p *VPPPlugin) validateRxPlacement(ctx context.Context, diff *engine.ConfigDiff) error {
+	type placement struct {
+		rxQueues int
+		queues   map[int]int
+	}
+	placements := make(map[string]placement)
+	for name, ifaceCfg := range diff.InterfacesAdded {
+		if ifaceCfg == nil || ifaceCfg.GigEtherOptions == nil {
+			continue
+		}
+		if opts := ifaceCfg.GigEtherOptions; opts.RxQueues > 1 || len(opts.RxPlacement) > 0 {
+			placements[name] = placement{rxQueues: opts.RxQueues, queues: opts.RxPlacement}
+		}
+	}
+	for _, change := range diff.InterfacesChanged {
+		if change.RxPlacementChanged {
+			placements[change.Name] = placement{queues: changedRxPlacement(change.OldRxPlacement, change.NewRxPlacement)}
 		}
-		addrCopy := cloneIPNet(ipNet)
-		*rollback = append(*rollback, func(ctx context.Context) error {
-			return p.client.DeleteInterfaceAddress(ctx, swIfIndex, addrCopy)
-		})
 	}
+	if len(placements) == 0 {
+		return nil
+	}
+
+	workers, err := p.client.GetWorkerCount(ctx)
+	if err != nil {
+		return fmt.Errorf("get worker count: %w", err)
+	}
+	names := make([]string, 0, len(placements))
+	for name := range placements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		check := placements[name]
+		if check.rxQueues > 1 && uint32(check.rxQueues) > workers {
+			p.log.Warn("Interface has more RX queues than VPP worker threads",
+				slog.String("interface", name),
+				slog.Int("rx_queues", check.rxQueues),
+				slog.Int("workers", int(workers)))
+		}
+		for _, queue := range slices.Sorted(maps.Keys(check.queues)) {
+			if worker := check.queues[queue]; uint32(worker) >= workers {
+				return fmt.Errorf("interface %s: rx-queue %d worker %d: VPP has %d worker threads", name, queue, worker, workers)
+			}
+		}
+	}
+	return nil
+}
+
+// changedRxPlacement returns the pins in newPlacement whose worker differs
+// from oldPlacement.
+func changedRxPlacement(oldPlacement, newPlacement map[int]int) map[int]int {
+	changed := make(map[int]int)
+	for queue, worker := range newPlacement {
+		if old, ok := oldPlacement[queue]; !ok || old != worker {
+			changed[queue] = worker
+		}
+	}
+	return changed
+}
+
+// setRxPlacement pins the RX queues whose worker changed. A queue whose pin
+// is removed stays on the worker it is on; rollback restores the old pins.
+// ValidateChanges has already checked the workers against VPP.
+func (p *VPPPlugin) setRxPlacement(ctx context.Context, swIfIndex uint32, oldPlacement, newPlacement map[int]int, rollback *[]func(context.Context) error) error {
+	changed := changedRxPlacement(oldPlacement, newPlacement)
+	for _, queue := range slices.Sorted(maps.Keys(changed)) {
+		worker := newPlacement[queue]
+		if err := p.client.SetInterfaceRxPlacement(ctx, swIfIndex, uint32(queue), uint32(worker)); err != nil {
+			return fmt.Errorf("set rx-queue %d worker %d: %w", queue, worker, err)
+		}
+		if old, ok := oldPlacement[queue]; ok {
+			*rollback = append(*rollback, func(ctx context.Context) error {
+				return p.client.SetInterfaceRxPlacement(ctx, swIfIndex, uint32(queue), uint32(old))
+			})
+		}
+	}
+	return nil
+}
+
+// restoreRxPlacement re-applies the old pins of queues whose worker changed.
+func (p *VPPPlugin) restoreRxPlacement(ctx context.Context, swIfIndex uint32, oldPlacement, newPlacement map[int]int) error {
+	var errs []error
+	for queue, worker := range oldPlacement {
+		if current, ok := newPlacement[queue]; ok && current == worker {
+			continue
+		}
+		if err := p.client.SetInterfaceRxPlacement(ctx, swIfIndex, uint32(queue), uint32(worker)); err != nil {
+			errs = append(errs, fmt.Errorf("rx-queue %d worker %d: %w", queue, worker, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *VPPPlugin) setMTU(ctx context.Context, swIfIndex uint32, oldMTU, newMTU engine.InterfaceMTU, rollback *[]func(context.Context) error) error {
+	if err := p.programMTU(ctx, swIfIndex, newMTU); err != nil {
+		return err
+	}
+	*rollback = append(*rollback, func(ctx context.Context) error {
+		return p.programMTU(ctx, swIfIndex, oldMTU)
+	})
+	return nil
+}
 
+// programMTU sets the link MTU and then the per-family IP MTUs.
+func (p *VPPPlugin) programMTU(ctx context.Context, swIfIndex uint32, mtu engine.InterfaceMTU) error {
+	link, ip4, ip6 := vppInterfaceMTU(mtu)
+	if err := p.client.SetInterfaceMTU(ctx, swIfIndex, link); err != nil {
+		return fmt.Errorf("set mtu %d: %w", link, err)
+	}
+	if err := p.client.SetInterfaceIPMTU(ctx, swIfIndex, ip4, ip6); err != nil {
+		return fmt.Errorf("set ip mtu inet %d inet6 %d: %w", ip4, ip6, err)
+	}
 	return nil
 }
 
-func (p *VPPPlugin) removeInterface(ctx context.Context, name string, rollback *[]func(context.Context) error) error {
+// vppInterfaceMTU returns the link, IPv4, and IPv6 MTUs programmed for mtu.
+// An unset link MTU restores the VPP default, and an unset family MTU
+// follows the link MTU.
+func vppInterfaceMTU(mtu engine.InterfaceMTU) (link, ip4, ip6 uint32) {
+	link = uint32(pkgvpp.DefaultInterfaceMTU)
+	if mtu.Link != 0 {
+		link = uint32(mtu.Link)
+	}
+	ip4, ip6 = link, link
+	if mtu.Inet != 0 {
+		ip4 = uint32(mtu.Inet)
+	}
+	if mtu.Inet6 != 0 {
+		ip6 = uint32(mtu.Inet6)
+	}
+	return link, ip4, ip6
+}
+
+// vppRxMode maps a configured rx-mode to the VPP mode name; an unset mode
+// restores the dataplane default.
+func vppRxMode(mode string) string {
+	if mode == "" {
+		return "default"
+	}
+	return mode
+}
+
+func (p *VPPPlugin) removeInterface(ctx context.Context, name string, ifaceCfg *model.InterfaceConfig, rollback *[]func(context.Context) error) error {
 	swIfIndex, ok := p.ifaceIndex[name]
 	if !ok {
 		return nil // Already gone
@@ -631,17 +1140,9 @@ func (p *VPPPlugin) removeInterface(ctx context.Context, name string, rollback *
 	if err := p.client.SetInterfaceDown(ctx, swIfIndex); err != nil {
 		return fmt.Errorf("set down: %w", err)
 	}
+	tunnelDeleted := false
 	*rollback = append(*rollback, func(ctx context.Context) error {
-		p.ifaceIndex[name] = swIfIndex
-		if err := p.client.SetInterfaceUp(ctx, swIfIndex); err != nil {
-			return fmt.Errorf("restore interface %s up: %w", name, err)
-		}
-		if linuxName, err := pkgvpp.ConvertJunosToLinuxName(name); err == nil {
-			if err := p.lcpManager.Create(ctx, swIfIndex, linuxName, name); err != nil {
-				return fmt.Errorf("restore LCP interface %s: %w", name, err)
-			}
-		}
-		return nil
+		return p.restoreRemovedInterface(ctx, name, swIfIndex, ifaceCfg, tunnelDeleted)
 	})
 
 	// Remove LCP pair if it exists. LCP creation is currently best-effort, so
@@ -649,8 +1150,43 @@ func (p *VPPPlugin) removeInterface(ctx context.Context, name string, rollback *
 	if err := p.deleteLCPIfPresent(ctx, swIfIndex); err != nil {
 		return fmt.Errorf("delete LCP interface: %w", err)
 	}
-
-	delete(p.ifaceIndex, name)
+
+	// Physical interfaces stay in VPP, administratively down. Tunnel
+	// interfaces are deleted so the endpoints can be reused.
+	if req, ok := softwareInterfaceCreateRequest(name, ifaceCfg); ok {
+		if err := p.client.DeleteTunnelInterface(ctx, req, swIfIndex); err != nil {
+			return fmt.Errorf("delete tunnel: %w", err)
+		}
+		tunnelDeleted = true
+	}
+
+	delete(p.ifaceIndex, name)
+	return nil
+}
+
+// restoreRemovedInterface undoes removeInterface. A deleted tunnel interface
+// no longer exists in VPP, so it is re-created with its previous endpoints
+// and addresses.
+func (p *VPPPlugin) restoreRemovedInterface(ctx context.Context, name string, swIfIndex uint32, ifaceCfg *model.InterfaceConfig, tunnelDeleted bool) error {
+	if req, ok := softwareInterfaceCreateRequest(name, ifaceCfg); ok && tunnelDeleted {
+		iface, err := p.client.CreateInterface(ctx, req)
+		if err != nil {
+			return fmt.Errorf("re-create tunnel interface %s: %w", name, err)
+		}
+		swIfIndex = iface.SwIfIndex
+		if err := p.applyAddresses(ctx, swIfIndex, ifaceCfg, nil); err != nil {
+			return fmt.Errorf("restore interface %s addresses: %w", name, err)
+		}
+	}
+	p.ifaceIndex[name] = swIfIndex
+	if err := p.client.SetInterfaceUp(ctx, swIfIndex); err != nil {
+		return fmt.Errorf("restore interface %s up: %w", name, err)
+	}
+	if linuxName, err := pkgvpp.ConvertJunosToLinuxName(name); err == nil {
+		if err := p.lcpManager.Create(ctx, swIfIndex, linuxName, name); err != nil {
+			return fmt.Errorf("restore LCP interface %s: %w", name, err)
+		}
+	}
 	return nil
 }
 
@@ -740,6 +1276,490 @@ func (p *VPPPlugin) applyClassOfServiceChanges(ctx context.Context, oldCoS, newC
 	return nil
 }
 
+// interfacePolicerBinding identifies one direction of an interface policer.
+type interfacePolicerBinding struct {
+	iface  string
+	output bool
+}
+
+// staticNeighbor identifies a configured static ARP/ND entry.
+type staticNeighbor struct {
+	iface string
+	ip    string
+}
+
+// applyNeighborChanges reconciles static ARP/ND entries. Stale or modified
+// entries are deleted before new ones are added.
+func (p *VPPPlugin) applyNeighborChanges(ctx context.Context, oldCfg, newCfg *model.RouterConfig, rollback *[]func(context.Context) error) error {
+	oldNeighbors := staticNeighborMap(oldCfg)
+	newNeighbors := staticNeighborMap(newCfg)
+
+	addRollback := func(op func(context.Context) error) {
+		if rollback != nil {
+			*rollback = append(*rollback, op)
+		}
+	}
+
+	for _, key := range sortedStaticNeighbors(oldNeighbors) {
+		mac := oldNeighbors[key]
+		if newNeighbors[key] == mac {
+			continue
+		}
+		swIfIndex, ok := p.ifaceIndex[key.iface]
+		if !ok {
+			continue
+		}
+		neighbor, err := vppStaticNeighbor(swIfIndex, key.ip, mac)
+		if err != nil {
+			return fmt.Errorf("interface %s: %w", key.iface, err)
+		}
+		if err := p.client.DeleteNeighbor(ctx, neighbor); err != nil {
+			return fmt.Errorf("delete neighbor %s on %s: %w", key.ip, key.iface, err)
+		}
+		addRollback(func(ctx context.Context) error {
+			return p.client.AddNeighbor(ctx, neighbor)
+		})
+	}
+
+	for _, key := range sortedStaticNeighbors(newNeighbors) {
+		mac := newNeighbors[key]
+		if oldNeighbors[key] == mac {
+			continue
+		}
+		swIfIndex, ok := p.ifaceIndex[key.iface]
+		if !ok {
+			return fmt.Errorf("interface %s not found in VPP", key.iface)
+		}
+		neighbor, err := vppStaticNeighbor(swIfIndex, key.ip, mac)
+		if err != nil {
+			return fmt.Errorf("interface %s: %w", key.iface, err)
+		}
+		if err := p.client.AddNeighbor(ctx, neighbor); err != nil {
+			return fmt.Errorf("add neighbor %s on %s: %w", key.ip, key.iface, err)
+		}
+		addRollback(func(ctx context.Context) error {
+			return p.client.DeleteNeighbor(ctx, neighbor)
+		})
+	}
+	return nil
+}
+
+func staticNeighborMap(cfg *model.RouterConfig) map[staticNeighbor]string {
+	neighbors := make(map[staticNeighbor]string)
+	if cfg == nil {
+		return neighbors
+	}
+	for name, iface := range cfg.Interfaces {
+		if iface == nil {
+			continue
+		}
+		for _, unit := range iface.Units {
+			if unit == nil {
+				continue
+			}
+			for _, family := range unit.Family {
+				if family == nil {
+					continue
+				}
+				for ip, mac := range family.Neighbors {
+					neighbors[staticNeighbor{iface: name, ip: ip}] = mac
+				}
+			}
+		}
+	}
+	return neighbors
+}
+
+func sortedStaticNeighbors(neighbors map[staticNeighbor]string) []staticNeighbor {
+	keys := make([]staticNeighbor, 0, len(neighbors))
+	for key := range neighbors {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].iface != keys[j].iface {
+			return keys[i].iface < keys[j].iface
+		}
+		return keys[i].ip < keys[j].ip
+	})
+	return keys
+}
+
+func vppStaticNeighbor(swIfIndex uint32, ipStr, macStr string) (pkgvpp.Neighbor, error) {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return pkgvpp.Neighbor{}, fmt.Errorf("invalid neighbor address %q", ipStr)
+	}
+	mac, err := net.ParseMAC(macStr)
+	if err != nil {
+		return pkgvpp.Neighbor{}, fmt.Errorf("invalid MAC %q for neighbor %s: %w", macStr, ipStr, err)
+	}
+	return pkgvpp.Neighbor{SwIfIndex: swIfIndex, IP: ip, MAC: mac, Static: true}, nil
+}
+
+// applyIPv6EnableChanges explicitly enables IPv6 on interfaces that gain an
+// inet6 family (enabled=true) or disables it on interfaces that lose theirs
+// (enabled=false), so that an inet6 family without addresses still runs IPv6
+// on its link-local address. VPP counts enables, so addresses added on top
+// keep working. Enables run before and disables after the router
+// advertisement changes that depend on them.
+func (p *VPPPlugin) applyIPv6EnableChanges(ctx context.Context, oldCfg, newCfg *model.RouterConfig, enabled bool, rollback *[]func(context.Context) error) error {
+	from, to := inet6Interfaces(oldCfg), inet6Interfaces(newCfg)
+	if !enabled {
+		from, to = to, from
+	}
+	names := make([]string, 0, len(to))
+	for name := range to {
+		if !from[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		swIfIndex, ok := p.ifaceIndex[name]
+		if !ok {
+			if enabled {
+				return fmt.Errorf("interface %s not found in VPP", name)
+			}
+			continue
+		}
+		if err := p.client.SetInterfaceIP6Enabled(ctx, swIfIndex, enabled); err != nil {
+			return fmt.Errorf("set IPv6 enabled=%t on %s: %w", enabled, name, err)
+		}
+		if rollback != nil {
+			*rollback = append(*rollback, func(ctx context.Context) error {
+				return p.client.SetInterfaceIP6Enabled(ctx, swIfIndex, !enabled)
+			})
+		}
+	}
+	return nil
+}
+
+// inet6Interfaces returns the interfaces with an inet6 family on any unit.
+func inet6Interfaces(cfg *model.RouterConfig) map[string]bool {
+	names := make(map[string]bool)
+	if cfg == nil {
+		return names
+	}
+	for name, iface := range cfg.Interfaces {
+		if hasInet6Family(iface) {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+func hasInet6Family(iface *model.InterfaceConfig) bool {
+	if iface == nil {
+		return false
+	}
+	for _, unit := range iface.Units {
+		if unit != nil && unit.Family["inet6"] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// routerAdvertisementState is the desired RA state of one interface.
+type routerAdvertisementState struct {
+	ra       pkgvpp.RouterAdvertisement
+	prefixes map[string]pkgvpp.RouterAdvertisementPrefix
+}
+
+// applyRouterAdvertisementChanges reconciles IPv6 router advertisements. VPP
+// advertises on every IPv6-enabled interface by default, so inet6 interfaces
+// without a router-advertisement stanza are explicitly suppressed.
+func (p *VPPPlugin) applyRouterAdvertisementChanges(ctx context.Context, oldCfg, newCfg *model.RouterConfig, rollback *[]func(context.Context) error) error {
+	oldStates, err := routerAdvertisementStates(oldCfg)
+	if err != nil {
+		return err
+	}
+	newStates, err := routerAdvertisementStates(newCfg)
+	if err != nil {
+		return err
+	}
+
+	addRollback := func(op func(context.Context) error) {
+		if rollback != nil {
+			*rollback = append(*rollback, op)
+		}
+	}
+
+	names := make([]string, 0, len(oldStates)+len(newStates))
+	for name := range oldStates {
+		names = append(names, name)
+	}
+	for name := range newStates {
+		if _, ok := oldStates[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		oldState, hadOld := oldStates[name]
+		newState, hasNew := newStates[name]
+		swIfIndex, ok := p.ifaceIndex[name]
+		if !ok {
+			if hasNew {
+				return fmt.Errorf("interface %s not found in VPP", name)
+			}
+			continue
+		}
+		if !hasNew {
+			// The interface no longer has IPv6; stop advertising on it.
+			newState = routerAdvertisementState{ra: pkgvpp.RouterAdvertisement{Suppress: true}}
+		}
+
+		for _, key := range sortedRouterAdvertisementPrefixes(oldState.prefixes) {
+			prefix := oldState.prefixes[key]
+			if current, ok := newState.prefixes[key]; ok && sameRouterAdvertisementLifetimes(current, prefix) {
+				continue
+			}
+			if err := p.client.DeleteRouterAdvertisementPrefix(ctx, swIfIndex, prefix.Prefix); err != nil {
+				return fmt.Errorf("delete advertised prefix %s on %s: %w", key, name, err)
+			}
+			addRollback(func(ctx context.Context) error {
+				return p.client.AddRouterAdvertisementPrefix(ctx, swIfIndex, prefix)
+			})
+		}
+
+		if !hadOld || oldState.ra != newState.ra {
+			if err := p.client.SetInterfaceRouterAdvertisement(ctx, swIfIndex, newState.ra); err != nil {
+				return fmt.Errorf("configure router advertisements on %s: %w", name, err)
+			}
+			previous := pkgvpp.RouterAdvertisement{Suppress: true}
+			if hadOld {
+				previous = oldState.ra
+			}
+			addRollback(func(ctx context.Context) error {
+				return p.client.SetInterfaceRouterAdvertisement(ctx, swIfIndex, previous)
+			})
+		}
+
+		for _, key := range sortedRouterAdvertisementPrefixes(newState.prefixes) {
+			prefix := newState.prefixes[key]
+			if current, ok := oldState.prefixes[key]; ok && sameRouterAdvertisementLifetimes(current, prefix) {
+				continue
+			}
+			if err := p.client.AddRouterAdvertisementPrefix(ctx, swIfIndex, prefix); err != nil {
+				return fmt.Errorf("advertise prefix %s on %s: %w", key, name, err)
+			}
+			addRollback(func(ctx context.Context) error {
+				return p.client.DeleteRouterAdvertisementPrefix(ctx, swIfIndex, prefix.Prefix)
+			})
+		}
+	}
+	return nil
+}
+
+// routerAdvertisementStates returns the desired RA state of every interface
+// with an inet6 family.
+func routerAdvertisementStates(cfg *model.RouterConfig) (map[string]routerAdvertisementState, error) {
+	states := make(map[string]routerAdvertisementState)
+	if cfg == nil {
+		return states, nil
+	}
+	for name, iface := range cfg.Interfaces {
+		if !hasInet6Family(iface) {
+			continue
+		}
+		ra := engine.ConfiguredRouterAdvertisement(iface)
+		if ra == nil {
+			states[name] = routerAdvertisementState{ra: pkgvpp.RouterAdvertisement{Suppress: true}}
+			continue
+		}
+		state := routerAdvertisementState{
+			ra: pkgvpp.RouterAdvertisement{
+				Managed:     ra.ManagedConfiguration,
+				Other:       ra.OtherStatefulConfiguration,
+				MaxInterval: uint32(ra.MaxAdvertisementInterval),
+				MinInterval: uint32(ra.MinAdvertisementInterval),
+			},
+			prefixes: make(map[string]pkgvpp.RouterAdvertisementPrefix, len(ra.Prefixes)),
+		}
+		for prefixStr, lifetimes := range ra.Prefixes {
+			_, ipNet, err := net.ParseCIDR(prefixStr)
+			if err != nil {
+				return nil, fmt.Errorf("interface %s: invalid advertised prefix %q: %w", name, prefixStr, err)
+			}
+			prefix := pkgvpp.RouterAdvertisementPrefix{Prefix: ipNet}
+			if lifetimes != nil {
+				prefix.ValidLifetime = uint32(lifetimes.ValidLifetime)
+				prefix.PreferredLifetime = uint32(lifetimes.PreferredLifetime)
+			}
+			state.prefixes[ipNet.String()] = prefix
+		}
+		states[name] = state
+	}
+	return states, nil
+}
+
+func sameRouterAdvertisementLifetimes(a, b pkgvpp.RouterAdvertisementPrefix) bool {
+	return a.ValidLifetime == b.ValidLifetime && a.PreferredLifetime == b.PreferredLifetime
+}
+
+func sortedRouterAdvertisementPrefixes(prefixes map[string]pkgvpp.RouterAdvertisementPrefix) []string {
+	keys := make([]string, 0, len(prefixes))
+	for key := range prefixes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// applyPolicerChanges reconciles firewall policers and their interface
+// bindings. Bindings are removed before their policer is deleted or replaced,
+// and re-applied once the new policer exists.
+func (p *VPPPlugin) applyPolicerChanges(ctx context.Context, oldCfg, newCfg *model.RouterConfig, rollback *[]func(context.Context) error) error {
+	oldPolicers := firewallPolicerMap(oldCfg)
+	newPolicers := firewallPolicerMap(newCfg)
+	oldBindings := interfacePolicerBindings(oldCfg)
+	newBindings := interfacePolicerBindings(newCfg)
+
+	unchanged := func(name string) bool {
+		oldPolicer, oldOK := oldPolicers[name]
+		newPolicer, newOK := newPolicers[name]
+		return oldOK && newOK && oldPolicer == newPolicer
+	}
+	addRollback := func(op func(context.Context) error) {
+		if rollback != nil {
+			*rollback = append(*rollback, op)
+		}
+	}
+
+	for _, binding := range sortedPolicerBindings(oldBindings) {
+		name := oldBindings[binding]
+		if newBindings[binding] == name && unchanged(name) {
+			continue
+		}
+		swIfIndex, ok := p.ifaceIndex[binding.iface]
+		if !ok {
+			continue
+		}
+		if err := p.client.SetInterfacePolicer(ctx, swIfIndex, name, binding.output, false); err != nil {
+			return fmt.Errorf("remove %s policer %s from %s: %w", policerDirection(binding.output), name, binding.iface, err)
+		}
+		output := binding.output
+		addRollback(func(ctx context.Context) error {
+			return p.client.SetInterfacePolicer(ctx, swIfIndex, name, output, true)
+		})
+	}
+
+	for _, name := range sortedPolicerNames(oldPolicers) {
+		if unchanged(name) {
+			continue
+		}
+		if err := p.client.DeletePolicer(ctx, name); err != nil {
+			return fmt.Errorf("delete policer %s: %w", name, err)
+		}
+		oldPolicer := oldPolicers[name]
+		addRollback(func(ctx context.Context) error {
+			return p.client.AddPolicer(ctx, oldPolicer)
+		})
+	}
+
+	for _, name := range sortedPolicerNames(newPolicers) {
+		if unchanged(name) {
+			continue
+		}
+		if err := p.client.AddPolicer(ctx, newPolicers[name]); err != nil {
+			return fmt.Errorf("add policer %s: %w", name, err)
+		}
+		policerName := name
+		addRollback(func(ctx context.Context) error {
+			return p.client.DeletePolicer(ctx, policerName)
+		})
+	}
+
+	for _, binding := range sortedPolicerBindings(newBindings) {
+		name := newBindings[binding]
+		if oldBindings[binding] == name && unchanged(name) {
+			continue
+		}
+		swIfIndex, ok := p.ifaceIndex[binding.iface]
+		if !ok {
+			return fmt.Errorf("interface %s not found in VPP", binding.iface)
+		}
+		if err := p.client.SetInterfacePolicer(ctx, swIfIndex, name, binding.output, true); err != nil {
+			return fmt.Errorf("apply %s policer %s to %s: %w", policerDirection(binding.output), name, binding.iface, err)
+		}
+		output := binding.output
+		addRollback(func(ctx context.Context) error {
+			return p.client.SetInterfacePolicer(ctx, swIfIndex, name, output, false)
+		})
+	}
+	return nil
+}
+
+func firewallPolicerMap(cfg *model.RouterConfig) map[string]pkgvpp.Policer {
+	policers := make(map[string]pkgvpp.Policer)
+	if cfg == nil || cfg.Firewall == nil {
+		return policers
+	}
+	for name, policer := range cfg.Firewall.Policers {
+		if policer == nil {
+			continue
+		}
+		policers[name] = pkgvpp.Policer{
+			Name:           name,
+			BandwidthLimit: policer.BandwidthLimit,
+			BurstSizeLimit: policer.BurstSizeLimit,
+		}
+	}
+	return policers
+}
+
+func interfacePolicerBindings(cfg *model.RouterConfig) map[interfacePolicerBinding]string {
+	bindings := make(map[interfacePolicerBinding]string)
+	if cfg == nil {
+		return bindings
+	}
+	for name, iface := range cfg.Interfaces {
+		if iface == nil {
+			continue
+		}
+		if iface.InputPolicer != "" {
+			bindings[interfacePolicerBinding{iface: name}] = iface.InputPolicer
+		}
+		if iface.OutputPolicer != "" {
+			bindings[interfacePolicerBinding{iface: name, output: true}] = iface.OutputPolicer
+		}
+	}
+	return bindings
+}
+
+func sortedPolicerNames(policers map[string]pkgvpp.Policer) []string {
+	names := make([]string, 0, len(policers))
+	for name := range policers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedPolicerBindings(bindings map[interfacePolicerBinding]string) []interfacePolicerBinding {
+	keys := make([]interfacePolicerBinding, 0, len(bindings))
+	for key := range bindings {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].iface != keys[j].iface {
+			return keys[i].iface < keys[j].iface
+		}
+		return !keys[i].output && keys[j].output
+	})
+	return keys
+}
+
+func policerDirection(output bool) string {
+	if output {
+		return "output"
+	}
+	return "input"
+}
+
 func classOfServiceBindingMap(cfg *model.ClassOfServiceConfig) (map[string]pkgvpp.QoSProfile, error) {
 	bindings := make(map[string]pkgvpp.QoSProfile)
 	if cfg == nil {
@@ -1066,18 +2086,11 @@ func (p *VPPPlugin) removeConfiguredAddresses(ctx context.Context, cfg *model.Ro
 	if err != nil {
 		return err
 	}
+	ops := make([]pkgvpp.AddressOp, 0, len(addresses))
 	for _, address := range addresses {
-		if err := p.client.DeleteInterfaceAddress(ctx, swIfIndex, address); err != nil {
-			return err
-		}
-		if rollback != nil {
-			addressCopy := cloneIPNet(address)
-			*rollback = append(*rollback, func(ctx context.Context) error {
-				return p.client.SetInterfaceAddress(ctx, swIfIndex, addressCopy)
-			})
-		}
+		ops = append(ops, pkgvpp.AddressOp{IfIndex: swIfIndex, Address: address, Delete: true})
 	}
-	return nil
+	return p.applyAddressOps(ctx, ops, rollback)
 }
 
 func (p *VPPPlugin) addConfiguredAddresses(ctx context.Context, cfg *model.RouterConfig, name string, rollback *[]func(context.Context) error) error {
@@ -1089,18 +2102,11 @@ func (p *VPPPlugin) addConfiguredAddresses(ctx context.Context, cfg *model.Route
 	if err != nil {
 		return err
 	}
+	ops := make([]pkgvpp.AddressOp, 0, len(addresses))
 	for _, address := range addresses {
-		if err := p.client.SetInterfaceAddress(ctx, swIfIndex, address); err != nil {
-			return err
-		}
-		if rollback != nil {
-			addressCopy := cloneIPNet(address)
-			*rollback = append(*rollback, func(ctx context.Context) error {
-				return p.client.DeleteInterfaceAddress(ctx, swIfIndex, addressCopy)
-			})
-		}
+		ops = append(ops, pkgvpp.AddressOp{IfIndex: swIfIndex, Address: address})
 	}
-	return nil
+	return p.applyAddressOps(ctx, ops, rollback)
 }
 
 func configuredInterfaceAddresses(cfg *model.RouterConfig, name string) ([]*net.IPNet, error) {
@@ -1207,6 +2213,7 @@ func isLCPNotFound(err error) bool {
 }
 
 func (p *VPPPlugin) applyAddresses(ctx context.Context, swIfIndex uint32, ifaceCfg *model.InterfaceConfig, rollback *[]func(context.Context) error) error {
+	var ops []pkgvpp.AddressOp
 	for _, unit := range ifaceCfg.Units {
 		for _, family := range unit.Family {
 			for _, addrStr := range family.Addresses {
@@ -1214,17 +2221,11 @@ func (p *VPPPlugin) applyAddresses(ctx context.Context, swIfIndex uint32, ifaceC
 				if err != nil {
 					return fmt.Errorf("parse CIDR %s: %w", addrStr, err)
 				}
-				if err := p.client.SetInterfaceAddress(ctx, swIfIndex, ipNet); err != nil {
-					return fmt.Errorf("set address %s: %w", addrStr, err)
-				}
-				addrCopy := cloneIPNet(ipNet)
-				*rollback = append(*rollback, func(ctx context.Context) error {
-					return p.client.DeleteInterfaceAddress(ctx, swIfIndex, addrCopy)
-				})
+				ops = append(ops, pkgvpp.AddressOp{IfIndex: swIfIndex, Address: ipNet})
 			}
 		}
 	}
-	return nil
+	return p.applyAddressOps(ctx, ops, rollback)
 }
 
 func (p *VPPPlugin) deleteConfiguredAddresses(ctx context.Context, swIfIndex uint32, ifaceCfg *model.InterfaceConfig) error {
@@ -1288,6 +2289,21 @@ func (p *VPPPlugin) executeRollback(ctx context.Context, ops []func(context.Cont
 	return rollbackErr
 }
 
+// applyStep runs one apply step and reports it to the engine. Steps that
+// changed nothing, and so queued no rollback operation, are not reported. A
+// failed step rolls back everything applied so far.
+func (p *VPPPlugin) applyStep(ctx context.Context, operation string, rollbackOps *[]func(context.Context) error, fn func() error) error {
+	queued := len(*rollbackOps)
+	err := fn()
+	if err != nil || len(*rollbackOps) > queued {
+		engine.RecordOperation(ctx, operation, err)
+	}
+	if err != nil {
+		return p.rollbackApplyError(ctx, fmt.Errorf("%s: %w", operation, err), *rollbackOps)
+	}
+	return nil
+}
+
 func (p *VPPPlugin) rollbackApplyError(ctx context.Context, operationErr error, rollbackOps []func(context.Context) error) error {
 	rollbackErr := p.executeRollback(ctx, rollbackOps)
 	if rollbackErr == nil {
@@ -1371,6 +2387,19 @@ func cloneQoSCapabilityStatus(status QoSCapabilityStatus) QoSCapabilityStatus {
 	return status
 }
 
+// StableInterfaceIndex returns the persistent logical index of an interface,
+// or 0 if none has been assigned.
+func (p *VPPPlugin) StableInterfaceIndex(name string) uint32 {
+	ifIndex, _ := p.stableIndex.Lookup(name)
+	return ifIndex
+}
+
+func (p *VPPPlugin) saveStableIndex() {
+	if err := p.stableIndex.Save(); err != nil {
+		p.log.Warn("Failed to persist stable interface indexes", slog.Any("error", err))
+	}
+}
+
 // GetInterfaceIndex returns the VPP sw_if_index for a Junos interface name.
 func (p *VPPPlugin) GetInterfaceIndex(name string) (uint32, bool) {
 	p.mu.RLock()
@@ -3,11 +3,15 @@ package vpp
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"net"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/akam1o/arca-router/internal/engine"
 	"github.com/akam1o/arca-router/internal/model"
@@ -122,6 +126,48 @@ func TestApplyChangesPreservesInterfaceAddressHostIP(t *testing.T) {
 	}
 }
 
+func TestApplyChangesRemovesOnlyDeletedAddress(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	oldCfg := model.NewRouterConfig()
+	oldCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24", "198.51.100.1/24"}}}},
+		},
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), oldCfg)); err != nil {
+		t.Fatalf("initial ApplyChanges() error = %v", err)
+	}
+	newCfg := model.NewRouterConfig()
+	newCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"198.51.100.1/24"}}}},
+		},
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(oldCfg, newCfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+
+	idx, _ := plugin.GetInterfaceIndex("ge-0/0/0")
+	iface, err := client.GetInterface(ctx, idx)
+	if err != nil {
+		t.Fatalf("GetInterface() error = %v", err)
+	}
+	if len(iface.Addresses) != 1 || !iface.Addresses[0].IP.Equal(net.ParseIP("198.51.100.1")) {
+		t.Fatalf("addresses = %v, want only 198.51.100.1/24 kept", iface.Addresses)
+	}
+}
+
 func TestApplyChangesFailsOnAddressDeleteFailure(t *testing.T) {
 	ctx := context.Background()
 	client := pkgvpp.NewMockClient()
@@ -206,6 +252,49 @@ func TestApplyChangesRollsBackInterfaceIndexOnAddressFailure(t *testing.T) {
 	}
 }
 
+func TestApplyChangesReportsEveryFailedAddress(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	client.SetInterfaceAddressError = errors.New("address failed")
+	diff := engine.ComputeDiff(model.NewRouterConfig(), &model.RouterConfig{
+		Interfaces: map[string]*model.InterfaceConfig{
+			"ge-0/0/0": {
+				Units: map[int]*model.Unit{
+					0: {Family: map[string]*model.AddressFamily{
+						"inet":  {Addresses: []string{"192.0.2.1/24", "198.51.100.1/24"}},
+						"inet6": {Addresses: []string{"2001:db8::1/64"}},
+					}},
+				},
+			},
+		},
+	})
+
+	err := plugin.ApplyChanges(ctx, diff)
+	if err == nil {
+		t.Fatal("ApplyChanges() error = nil, want address failures")
+	}
+	for _, want := range []string{
+		"3 of 3 address operations failed",
+		"set address 192.0.2.1/24",
+		"set address 198.51.100.1/24",
+		"set address 2001:db8::1/64",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("ApplyChanges() error = %v, want substring %q", err, want)
+		}
+	}
+}
+
 func TestApplyChangesReturnsRollbackErrors(t *testing.T) {
 	ctx := context.Background()
 	client := pkgvpp.NewMockClient()
@@ -306,6 +395,126 @@ func TestEngineRollbackSkipsAlreadyRolledBackVPPApplyFailure(t *testing.T) {
 	}
 }
 
+func TestEngineCommitCreatesLo0AsVPPLoopback(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+	eng := engine.NewEngine([]engine.Plugin{plugin}, testLogger())
+
+	cfg := model.NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+		},
+	}
+	cfg.Interfaces["lo0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"10.255.0.1/32"}}}},
+		},
+	}
+	if err := eng.Apply(ctx, cfg, "test", "add lo0"); err != nil {
+		t.Fatalf("Apply() error = %v, want lo0 created without hardware configuration", err)
+	}
+	swIfIndex, ok := plugin.GetInterfaceIndex("lo0")
+	if !ok {
+		t.Fatal("Apply() did not create lo0 in VPP")
+	}
+	if req, _ := client.InterfaceCreateRequest(swIfIndex); req.Type != pkgvpp.InterfaceTypeLoopback {
+		t.Fatalf("lo0 create request type = %q, want %q", req.Type, pkgvpp.InterfaceTypeLoopback)
+	}
+	iface, err := client.GetInterface(ctx, swIfIndex)
+	if err != nil {
+		t.Fatalf("GetInterface(lo0) error = %v", err)
+	}
+	if len(iface.Addresses) != 1 || iface.Addresses[0].String() != "10.255.0.1/32" {
+		t.Fatalf("lo0 addresses = %v, want [10.255.0.1/32]", iface.Addresses)
+	}
+	lcp, err := client.GetLCPInterface(ctx, swIfIndex)
+	if err != nil {
+		t.Fatalf("GetLCPInterface(lo0) error = %v", err)
+	}
+	if lcp.LinuxIfName != "lo0" {
+		t.Fatalf("lo0 LCP host interface = %q, want lo0", lcp.LinuxIfName)
+	}
+
+	withoutLoopback := model.NewRouterConfig()
+	withoutLoopback.Interfaces["ge-0/0/0"] = cfg.Interfaces["ge-0/0/0"]
+	if err := eng.Apply(ctx, withoutLoopback, "test", "remove lo0"); err != nil {
+		t.Fatalf("Apply() removing lo0 error = %v", err)
+	}
+	if _, err := client.GetInterface(ctx, swIfIndex); err == nil {
+		t.Fatal("removing lo0 left the loopback in VPP")
+	}
+}
+
+func TestEngineLastApplyReportsPartialVPPFailure(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	before := &recordingPlugin{name: "cluster"}
+	after := &recordingPlugin{name: "frr"}
+	eng := engine.NewEngine([]engine.Plugin{before, plugin, after}, testLogger())
+	client.SetInterfaceAddressError = errors.New("address rejected")
+
+	cfg := model.NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+		},
+	}
+	if err := eng.Apply(ctx, cfg, "test", "add address"); err == nil {
+		t.Fatal("Apply() error = nil, want address failure")
+	}
+
+	result := eng.LastApply()
+	if result.Err == nil || result.Time.IsZero() {
+		t.Fatalf("LastApply() = %#v, want failed apply", result)
+	}
+	want := map[string]string{
+		"cluster": engine.PluginRolledBack,
+		"vpp":     engine.PluginFailed,
+		"frr":     engine.PluginNotApplied,
+	}
+	if len(result.Plugins) != len(want) {
+		t.Fatalf("LastApply().Plugins = %#v, want %d plugins", result.Plugins, len(want))
+	}
+	for _, got := range result.Plugins {
+		if got.Status != want[got.Plugin] {
+			t.Errorf("plugin %s status = %q, want %q", got.Plugin, got.Status, want[got.Plugin])
+		}
+	}
+	vpp := result.Plugins[1]
+	if !strings.Contains(vpp.Error, "address rejected") {
+		t.Errorf("vpp error = %q, want address rejected", vpp.Error)
+	}
+	if len(vpp.Operations) != 2 ||
+		vpp.Operations[0] != (engine.OperationResult{Operation: "create interface ge-0/0/0"}) ||
+		vpp.Operations[1].Operation != "apply interface ge-0/0/0 addresses" ||
+		!strings.Contains(vpp.Operations[1].Error, "address rejected") {
+		t.Errorf("vpp operations = %#v, want interface created and address failed", vpp.Operations)
+	}
+	if after.applied {
+		t.Error("plugin after VPP was applied, want it skipped")
+	}
+}
+
 func TestRollbackChangesRemovesAddedInterfaceIndex(t *testing.T) {
 	ctx := context.Background()
 	client := pkgvpp.NewMockClient()
@@ -451,6 +660,71 @@ func TestApplyChangesRemovesInterfaceWithoutLCPPair(t *testing.T) {
 	}
 }
 
+func TestApplyChangesCreatesAndDeletesTunnelInterface(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	tunnelCfg := model.NewRouterConfig()
+	tunnelCfg.Interfaces["gr-0/0/0"] = &model.InterfaceConfig{
+		Tunnel: &model.TunnelConfig{Source: "192.0.2.1", Destination: "198.51.100.1"},
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{"inet": {Addresses: []string{"10.255.0.1/30"}}}},
+		},
+	}
+	added := engine.ComputeDiff(model.NewRouterConfig(), tunnelCfg)
+	if err := plugin.ValidateChanges(ctx, added); err != nil {
+		t.Fatalf("ValidateChanges() error = %v, want tunnel accepted without hardware config", err)
+	}
+	if err := plugin.ApplyChanges(ctx, added); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("gr-0/0/0")
+	if !ok {
+		t.Fatal("ApplyChanges() did not add tunnel interface index")
+	}
+	iface, err := client.GetInterface(ctx, idx)
+	if err != nil {
+		t.Fatalf("GetInterface() error = %v", err)
+	}
+	if !strings.HasPrefix(iface.Name, "gre") || !iface.AdminUp || len(iface.Addresses) != 1 {
+		t.Fatalf("tunnel interface = %#v, want admin-up gre interface with one address", iface)
+	}
+
+	moved := tunnelCfg.Clone()
+	moved.Interfaces["gr-0/0/0"].Tunnel.Destination = "198.51.100.2"
+	if err := plugin.ValidateChanges(ctx, engine.ComputeDiff(tunnelCfg, moved)); err == nil || !strings.Contains(err.Error(), "cannot be changed in place") {
+		t.Fatalf("ValidateChanges(changed endpoints) error = %v, want in-place change rejected", err)
+	}
+
+	removed := engine.ComputeDiff(tunnelCfg, model.NewRouterConfig())
+	if err := plugin.ApplyChanges(ctx, removed); err != nil {
+		t.Fatalf("ApplyChanges(remove) error = %v", err)
+	}
+	if _, err := client.GetInterface(ctx, idx); err == nil {
+		t.Fatal("ApplyChanges(remove) left tunnel interface in VPP")
+	}
+
+	if err := plugin.RollbackChanges(ctx, removed); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	restored, ok := plugin.GetInterfaceIndex("gr-0/0/0")
+	if !ok {
+		t.Fatal("RollbackChanges() did not restore tunnel interface index")
+	}
+	iface, err = client.GetInterface(ctx, restored)
+	if err != nil {
+		t.Fatalf("GetInterface(restored) error = %v", err)
+	}
+	if !iface.AdminUp || len(iface.Addresses) != 1 {
+		t.Fatalf("restored tunnel interface = %#v, want admin-up with its address", iface)
+	}
+}
+
 func TestCollectStateIncludesInterfaceCounters(t *testing.T) {
 	ctx := context.Background()
 	client := pkgvpp.NewMockClient()
@@ -493,6 +767,9 @@ func TestCollectStateIncludesInterfaceCounters(t *testing.T) {
 	if err := client.SetQoSProfile(ctx, idx, pkgvpp.QoSProfile{Name: "WAN"}); err != nil {
 		t.Fatalf("SetQoSProfile() error = %v", err)
 	}
+	if err := client.SetInterfaceAddress(ctx, idx, &net.IPNet{IP: net.ParseIP("192.0.2.1").To4(), Mask: net.CIDRMask(24, 32)}); err != nil {
+		t.Fatalf("SetInterfaceAddress() error = %v", err)
+	}
 
 	state, err := plugin.CollectState(ctx)
 	if err != nil {
@@ -507,6 +784,9 @@ func TestCollectStateIncludesInterfaceCounters(t *testing.T) {
 	if got := state["ge-0/0/0"].QoSProfile; got != "WAN" {
 		t.Fatalf("CollectState() QoSProfile = %q, want WAN", got)
 	}
+	if got := state["ge-0/0/0"].Addresses; len(got) != 1 || got[0] != "192.0.2.1/24" {
+		t.Fatalf("CollectState() Addresses = %v, want [192.0.2.1/24]", got)
+	}
 	if state["ge-0/0/0"].Queues == nil {
 		t.Fatal("CollectState() did not include queue placements")
 	}
@@ -518,80 +798,281 @@ func TestCollectStateIncludesInterfaceCounters(t *testing.T) {
 	}
 }
 
-func TestInitRecordsLCPReconciliationStatus(t *testing.T) {
+func TestClearInterfaceCountersResetsOneOrAllInterfaces(t *testing.T) {
 	ctx := context.Background()
 	client := pkgvpp.NewMockClient()
-	if err := client.Connect(ctx); err != nil {
-		t.Fatalf("Connect() error = %v", err)
-	}
-	iface, err := client.CreateInterface(ctx, &pkgvpp.CreateInterfaceRequest{
-		Type:           pkgvpp.InterfaceTypeAVF,
-		DeviceInstance: "0000:03:00.0",
-		PCIAddress:     "0000:03:00.0",
-		Name:           "ge-0/0/0",
-	})
-	if err != nil {
-		t.Fatalf("CreateInterface() error = %v", err)
-	}
-	if err := client.CreateLCPInterface(ctx, iface.SwIfIndex, "ge000"); err != nil {
-		t.Fatalf("CreateLCPInterface() error = %v", err)
-	}
-	if err := client.Close(); err != nil {
-		t.Fatalf("Close() error = %v", err)
-	}
-
-	plugin := NewVPPPlugin(client, &device.HardwareConfig{}, testLogger())
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+			{Name: "ge-0/0/1", PCI: "0000:03:00.1", Driver: "avf"},
+		},
+	}, testLogger())
 	if err := plugin.Init(ctx); err != nil {
 		t.Fatalf("Init() error = %v", err)
 	}
 	t.Cleanup(func() { _ = plugin.Close() })
 
-	status := plugin.LCPReconciliationStatus()
-	if status.LastRun.IsZero() {
-		t.Fatal("LCPReconciliationStatus().LastRun is zero")
+	cfg := model.NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{Units: map[int]*model.Unit{}}
+	cfg.Interfaces["ge-0/0/1"] = &model.InterfaceConfig{Units: map[int]*model.Unit{}}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), cfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
 	}
-	if status.PairCount != 1 {
-		t.Fatalf("LCPReconciliationStatus().PairCount = %d, want 1", status.PairCount)
+	for _, name := range []string{"ge-0/0/0", "ge-0/0/1"} {
+		idx, _ := plugin.GetInterfaceIndex(name)
+		client.SetInterfaceCounters(idx, pkgvpp.InterfaceCounters{RxPackets: 10})
 	}
-	if len(status.Inconsistencies) != 0 {
-		t.Fatalf("LCPReconciliationStatus().Inconsistencies = %#v, want none", status.Inconsistencies)
+
+	if err := plugin.ClearInterfaceCounters(ctx, "ge-0/0/0"); err != nil {
+		t.Fatalf("ClearInterfaceCounters(ge-0/0/0) error = %v", err)
 	}
-	if status.LastError != "" {
-		t.Fatalf("LCPReconciliationStatus().LastError = %q, want empty", status.LastError)
+	counters, _ := client.ListInterfaceCounters(ctx)
+	if len(counters) != 1 {
+		t.Fatalf("counters after clearing ge-0/0/0 = %#v, want only ge-0/0/1", counters)
+	}
+	if err := plugin.ClearInterfaceCounters(ctx, ""); err != nil {
+		t.Fatalf("ClearInterfaceCounters(all) error = %v", err)
+	}
+	if counters, _ := client.ListInterfaceCounters(ctx); len(counters) != 0 {
+		t.Fatalf("counters after clearing all = %#v, want none", counters)
+	}
+	if err := plugin.ClearInterfaceCounters(ctx, "ge-0/0/9"); err == nil || !strings.Contains(err.Error(), "unknown interface") {
+		t.Fatalf("ClearInterfaceCounters(unknown) error = %v, want unknown interface", err)
+	}
+
+	client.ClearInterfaceStatsError = errors.New("VPP API socket closed")
+	if err := plugin.ClearInterfaceCounters(ctx, ""); err == nil || !strings.Contains(err.Error(), "VPP API socket closed") {
+		t.Fatalf("ClearInterfaceCounters() error = %v, want VPP failure", err)
 	}
 }
 
-func TestInitRecordsRedactedLCPReconciliationError(t *testing.T) {
+func TestCollectStateDebouncesLinkWithHoldTime(t *testing.T) {
 	ctx := context.Background()
 	client := pkgvpp.NewMockClient()
-	client.ListLCPInterfacesError = errors.New("list /run/vpp/lcp.sock: permission denied with token=secret")
-	plugin := NewVPPPlugin(client, &device.HardwareConfig{}, testLogger())
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
 	if err := plugin.Init(ctx); err != nil {
 		t.Fatalf("Init() error = %v", err)
 	}
 	t.Cleanup(func() { _ = plugin.Close() })
+	now := time.Unix(1000, 0)
+	plugin.links.now = func() time.Time { return now }
 
-	status := plugin.LCPReconciliationStatus()
-	if status.LastError != vppLCPReconciliationErrorMessage {
-		t.Fatalf("LCPReconciliationStatus().LastError = %q, want redacted reconciliation error", status.LastError)
+	cfg := model.NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		HoldTime: &model.HoldTime{Up: 2000, Down: 500},
+		Units:    map[int]*model.Unit{},
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), cfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("ApplyChanges() did not add interface index")
 	}
-	assertVPPStatusErrorRedacted(t, status.LastError)
-}
 
-func TestValidateChangesAllowsMPLSConfig(t *testing.T) {
-	plugin := NewVPPPlugin(pkgvpp.NewMockClient(), &device.HardwareConfig{}, testLogger())
-	newCfg := model.NewRouterConfig()
-	newCfg.Protocols = &model.ProtocolsConfig{
-		MPLS: &model.MPLSConfig{Interfaces: []string{"ge-0/0/0"}},
+	step := func(elapsed time.Duration, linkUp bool, want string) {
+		t.Helper()
+		now = now.Add(elapsed)
+		if linkUp {
+			_ = client.SetInterfaceUp(ctx, idx)
+		} else {
+			_ = client.SetInterfaceDown(ctx, idx)
+		}
+		state, err := plugin.CollectState(ctx)
+		if err != nil {
+			t.Fatalf("CollectState() error = %v", err)
+		}
+		if got := state["ge-0/0/0"].OperStatus; got != want {
+			t.Fatalf("OperStatus after %v with link up=%t = %q, want %q", elapsed, linkUp, got, want)
+		}
 	}
-	diff := engine.ComputeDiff(model.NewRouterConfig(), newCfg)
 
-	if err := plugin.ValidateChanges(context.Background(), diff); err != nil {
-		t.Fatalf("ValidateChanges() error = %v, want nil", err)
+	step(0, true, "up")                       // first observation is reported as is
+	step(time.Second, false, "up")            // down for 0ms < 500ms
+	step(400*time.Millisecond, true, "up")    // flap reverted within the down hold-time
+	step(100*time.Millisecond, false, "up")   // down again, timer restarts
+	step(499*time.Millisecond, false, "up")   // 499ms < 500ms
+	step(time.Millisecond, false, "down")     // held down for 500ms
+	step(time.Second, true, "down")           // up for 0ms < 2000ms
+	step(1999*time.Millisecond, true, "down") // 1999ms < 2000ms
+	step(time.Millisecond, true, "up")        // held up for 2000ms
+
+	// A commit that changes the hold-time restarts a pending transition.
+	step(time.Second, false, "up") // down for 0ms
+	longer := cfg.Clone()
+	longer.Interfaces["ge-0/0/0"].HoldTime = &model.HoldTime{Up: 2000, Down: 1000}
+	now = now.Add(400 * time.Millisecond)
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(cfg, longer)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
 	}
+	step(999*time.Millisecond, false, "up") // 999ms since the commit < 1000ms
+	step(time.Millisecond, false, "down")   // held down for 1000ms since the commit
+	step(time.Second, true, "down")         // up for 0ms
+	step(2000*time.Millisecond, true, "up") // held up for 2000ms
+	cfg = longer
+
+	// Without a hold-time transitions are reported immediately.
+	next := cfg.Clone()
+	next.Interfaces["ge-0/0/0"].HoldTime = nil
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(cfg, next)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	step(0, false, "down")
+	step(0, true, "up")
 }
 
-func assertVPPStatusErrorRedacted(t *testing.T, msg string) {
+func TestEvaluateAlarmsRaisesAndClearsInterfaceAlarms(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+	now := time.Unix(1000, 0)
+	plugin.alarms.now = func() time.Time { return now }
+	var observed [][]Alarm
+	plugin.SetAlarmObserver(func(alarms []Alarm) { observed = append(observed, alarms) })
+
+	cfg := model.NewRouterConfig()
+	cfg.System = &model.SystemConfig{Alarms: &model.SystemAlarmsConfig{InterfaceErrorRate: 10, InterfaceLinkDown: true}}
+	cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{Units: map[int]*model.Unit{}}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), cfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("ApplyChanges() did not add interface index")
+	}
+	_ = client.SetInterfaceUp(ctx, idx)
+
+	step := func(elapsed time.Duration, errors uint64, linkUp bool, want ...string) {
+		t.Helper()
+		now = now.Add(elapsed)
+		client.SetInterfaceCounters(idx, pkgvpp.InterfaceCounters{RxErrors: errors / 2, TxErrors: errors - errors/2})
+		client.SetInterfaceLinkState(idx, linkUp)
+		plugin.evaluateAlarms(ctx)
+		var got []string
+		for _, alarm := range plugin.Alarms() {
+			if alarm.Object != "ge-0/0/0" {
+				t.Fatalf("alarm object = %q, want ge-0/0/0", alarm.Object)
+			}
+			got = append(got, alarm.Type)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("alarms after %v with %d errors, link up=%t = %v, want %v", elapsed, errors, linkUp, got, want)
+		}
+	}
+
+	step(0, 0, true)                                         // first sample has no rate
+	step(10*time.Second, 100, true)                          // 10 errors/s does not exceed 10
+	step(10*time.Second, 201, true, AlarmInterfaceErrorRate) // 10.1 errors/s
+	raisedAt := plugin.Alarms()[0].RaisedAt
+	step(10*time.Second, 400, false, AlarmInterfaceErrorRate, AlarmInterfaceLinkDown)
+	if got := plugin.Alarms()[0].RaisedAt; !got.Equal(raisedAt) {
+		t.Fatalf("error-rate alarm raised at %v, want unchanged %v", got, raisedAt)
+	}
+	step(10*time.Second, 450, true) // 5 errors/s and link back up
+	if len(observed) != 3 || len(observed[2]) != 0 {
+		t.Fatalf("observer calls = %v, want raise, raise, and clear", observed)
+	}
+
+	// Deleting the alarm configuration clears active alarms.
+	step(10*time.Second, 1000, false, AlarmInterfaceErrorRate, AlarmInterfaceLinkDown)
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(cfg, func() *model.RouterConfig {
+		next := cfg.Clone()
+		next.System.Alarms = nil
+		return next
+	}())); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if alarms := plugin.Alarms(); len(alarms) != 0 {
+		t.Fatalf("Alarms() after deleting system alarms = %#v, want none", alarms)
+	}
+}
+
+func TestInitRecordsLCPReconciliationStatus(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	iface, err := client.CreateInterface(ctx, &pkgvpp.CreateInterfaceRequest{
+		Type:           pkgvpp.InterfaceTypeAVF,
+		DeviceInstance: "0000:03:00.0",
+		PCIAddress:     "0000:03:00.0",
+		Name:           "ge-0/0/0",
+	})
+	if err != nil {
+		t.Fatalf("CreateInterface() error = %v", err)
+	}
+	if err := client.CreateLCPInterface(ctx, iface.SwIfIndex, "ge000"); err != nil {
+		t.Fatalf("CreateLCPInterface() error = %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	status := plugin.LCPReconciliationStatus()
+	if status.LastRun.IsZero() {
+		t.Fatal("LCPReconciliationStatus().LastRun is zero")
+	}
+	if status.PairCount != 1 {
+		t.Fatalf("LCPReconciliationStatus().PairCount = %d, want 1", status.PairCount)
+	}
+	if len(status.Inconsistencies) != 0 {
+		t.Fatalf("LCPReconciliationStatus().Inconsistencies = %#v, want none", status.Inconsistencies)
+	}
+	if status.LastError != "" {
+		t.Fatalf("LCPReconciliationStatus().LastError = %q, want empty", status.LastError)
+	}
+}
+
+func TestInitRecordsRedactedLCPReconciliationError(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	client.ListLCPInterfacesError = errors.New("list /run/vpp/lcp.sock: permission denied with token=secret")
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	status := plugin.LCPReconciliationStatus()
+	if status.LastError != vppLCPReconciliationErrorMessage {
+		t.Fatalf("LCPReconciliationStatus().LastError = %q, want redacted reconciliation error", status.LastError)
+	}
+	assertVPPStatusErrorRedacted(t, status.LastError)
+}
+
+func TestValidateChangesAllowsMPLSConfig(t *testing.T) {
+	plugin := NewVPPPlugin(pkgvpp.NewMockClient(), &device.HardwareConfig{}, testLogger())
+	newCfg := model.NewRouterConfig()
+	newCfg.Protocols = &model.ProtocolsConfig{
+		MPLS: &model.MPLSConfig{Interfaces: []string{"ge-0/0/0"}},
+	}
+	diff := engine.ComputeDiff(model.NewRouterConfig(), newCfg)
+
+	if err := plugin.ValidateChanges(context.Background(), diff); err != nil {
+		t.Fatalf("ValidateChanges() error = %v, want nil", err)
+	}
+}
+
+func assertVPPStatusErrorRedacted(t *testing.T, msg string) {
 	t.Helper()
 	for _, leaked := range []string{"/run/vpp", "api.sock", "lcp.sock", "permission denied", "secret"} {
 		if strings.Contains(msg, leaked) {
@@ -1363,3 +1844,670 @@ func TestRollbackChangesRestoresClassOfServiceProfiles(t *testing.T) {
 		t.Fatalf("QoSProfile() after rollback = %#v, want WAN shaping profile", profile)
 	}
 }
+
+func TestApplyChangesProgramsPromiscuousAndRxMode(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+			{Name: "ge-0/0/1", PCI: "0000:03:00.1", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	// Interfaces without promiscuous or rx-mode config must not touch either
+	// knob, so any call to the client would fail the apply.
+	client.SetPromiscuousError = errors.New("unexpected promiscuous call")
+	client.SetRxModeError = errors.New("unexpected rx-mode call")
+	plain := model.NewRouterConfig()
+	plain.Interfaces["ge-0/0/1"] = &model.InterfaceConfig{Units: map[int]*model.Unit{}}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), plain)); err != nil {
+		t.Fatalf("ApplyChanges() without settings error = %v", err)
+	}
+	client.SetPromiscuousError = nil
+	client.SetRxModeError = nil
+
+	newCfg := plain.Clone()
+	newCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Promiscuous: true,
+		RxMode:      "interrupt",
+		Units:       map[int]*model.Unit{},
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(plain, newCfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("ApplyChanges() did not add interface index")
+	}
+	if !client.InterfacePromiscuous(idx) {
+		t.Fatal("ApplyChanges() did not enable promiscuous mode")
+	}
+	if got := client.InterfaceRxMode(idx); got != "interrupt" {
+		t.Fatalf("InterfaceRxMode() = %q, want interrupt", got)
+	}
+
+	changedCfg := newCfg.Clone()
+	changedCfg.Interfaces["ge-0/0/0"].Promiscuous = false
+	changedCfg.Interfaces["ge-0/0/0"].RxMode = ""
+	diff := engine.ComputeDiff(newCfg, changedCfg)
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() clear settings error = %v", err)
+	}
+	if client.InterfacePromiscuous(idx) {
+		t.Fatal("ApplyChanges() left promiscuous mode enabled")
+	}
+	if got := client.InterfaceRxMode(idx); got != "" {
+		t.Fatalf("InterfaceRxMode() = %q, want dataplane default", got)
+	}
+
+	if err := plugin.RollbackChanges(ctx, diff); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if !client.InterfacePromiscuous(idx) {
+		t.Fatal("RollbackChanges() did not restore promiscuous mode")
+	}
+	if got := client.InterfaceRxMode(idx); got != "interrupt" {
+		t.Fatalf("InterfaceRxMode() after rollback = %q, want interrupt", got)
+	}
+}
+
+func TestApplyChangesProgramsQueuesAndRxPlacement(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	oldCfg := model.NewRouterConfig()
+	newCfg := model.NewRouterConfig()
+	newCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		GigEtherOptions: &model.GigEtherOptions{
+			RxQueues:    2,
+			TxQueues:    2,
+			RxPlacement: map[int]int{0: 0, 1: 1},
+		},
+		Units: map[int]*model.Unit{},
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(oldCfg, newCfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("ApplyChanges() did not add interface index")
+	}
+	req, ok := client.InterfaceCreateRequest(idx)
+	if !ok || req.NumRxQueues != 2 || req.NumTxQueues != 2 {
+		t.Fatalf("CreateInterface request = %+v, want 2 RX and 2 TX queues", req)
+	}
+	if got, want := client.InterfaceRxPlacement(idx), map[uint32]uint32{0: 0, 1: 1}; !maps.Equal(got, want) {
+		t.Fatalf("InterfaceRxPlacement() = %v, want %v", got, want)
+	}
+
+	movedCfg := newCfg.Clone()
+	movedCfg.Interfaces["ge-0/0/0"].GigEtherOptions.RxPlacement[1] = 0
+	diff := engine.ComputeDiff(newCfg, movedCfg)
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() move queue error = %v", err)
+	}
+	if got, want := client.InterfaceRxPlacement(idx), map[uint32]uint32{0: 0, 1: 0}; !maps.Equal(got, want) {
+		t.Fatalf("InterfaceRxPlacement() = %v, want %v", got, want)
+	}
+	if err := plugin.RollbackChanges(ctx, diff); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if got, want := client.InterfaceRxPlacement(idx), map[uint32]uint32{0: 0, 1: 1}; !maps.Equal(got, want) {
+		t.Fatalf("InterfaceRxPlacement() after rollback = %v, want %v", got, want)
+	}
+
+	// Queues can only be placed on workers VPP runs.
+	client.SetWorkerCount(1)
+	badCfg := newCfg.Clone()
+	badCfg.Interfaces["ge-0/0/0"].GigEtherOptions.RxPlacement[0] = 1
+	err := plugin.ValidateChanges(ctx, engine.ComputeDiff(newCfg, badCfg))
+	if err == nil || !strings.Contains(err.Error(), "VPP has 1 worker threads") {
+		t.Fatalf("ValidateChanges() error = %v, want worker count error", err)
+	}
+
+	// VPP fixes the queue count when it creates the interface.
+	client.SetWorkerCount(2)
+	resizedCfg := newCfg.Clone()
+	resizedCfg.Interfaces["ge-0/0/0"].GigEtherOptions.RxQueues = 4
+	err = plugin.ValidateChanges(ctx, engine.ComputeDiff(newCfg, resizedCfg))
+	if err == nil || !strings.Contains(err.Error(), "rxq/txq cannot be changed on an existing interface") {
+		t.Fatalf("ValidateChanges() error = %v, want queue count change rejected", err)
+	}
+}
+
+func TestApplyChangesProgramsInterfaceMTU(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	oldCfg := model.NewRouterConfig()
+	oldCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		MTU: 9000,
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{
+				"inet": {Addresses: []string{"192.0.2.1/24"}, MTU: 1500},
+			}},
+		},
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), oldCfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("ApplyChanges() did not add interface index")
+	}
+	// An unset inet6 MTU follows the link MTU.
+	if link, ip4, ip6 := client.InterfaceMTU(idx); link != 9000 || ip4 != 1500 || ip6 != 9000 {
+		t.Fatalf("InterfaceMTU() = %d/%d/%d, want 9000/1500/9000", link, ip4, ip6)
+	}
+
+	newCfg := oldCfg.Clone()
+	newCfg.Interfaces["ge-0/0/0"].MTU = 0
+	newCfg.Interfaces["ge-0/0/0"].Units[0].Family["inet"].MTU = 0
+	diff := engine.ComputeDiff(oldCfg, newCfg)
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() clear MTU error = %v", err)
+	}
+	want := uint32(pkgvpp.DefaultInterfaceMTU)
+	if link, ip4, ip6 := client.InterfaceMTU(idx); link != want || ip4 != want || ip6 != want {
+		t.Fatalf("InterfaceMTU() = %d/%d/%d, want dataplane default %d", link, ip4, ip6, want)
+	}
+
+	if err := plugin.RollbackChanges(ctx, diff); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if link, ip4, _ := client.InterfaceMTU(idx); link != 9000 || ip4 != 1500 {
+		t.Fatalf("InterfaceMTU() after rollback = %d/%d, want 9000/1500", link, ip4)
+	}
+}
+
+func TestApplyChangesEnablesIPv6WithoutAddresses(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	oldCfg := model.NewRouterConfig()
+	oldCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{
+				"inet": {Addresses: []string{"192.0.2.1/24"}},
+			}},
+		},
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), oldCfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("ApplyChanges() did not add interface index")
+	}
+	if client.InterfaceIP6Enabled(idx) {
+		t.Fatal("InterfaceIP6Enabled() = true without an inet6 family")
+	}
+
+	newCfg := oldCfg.Clone()
+	newCfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"] = &model.AddressFamily{}
+	diff := engine.ComputeDiff(oldCfg, newCfg)
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() enable inet6 error = %v", err)
+	}
+	if !client.InterfaceIP6Enabled(idx) {
+		t.Fatal("InterfaceIP6Enabled() = false after adding an address-less inet6 family")
+	}
+
+	if err := plugin.RollbackChanges(ctx, diff); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if client.InterfaceIP6Enabled(idx) {
+		t.Fatal("InterfaceIP6Enabled() = true after rolling back the inet6 family")
+	}
+
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() re-enable inet6 error = %v", err)
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(newCfg, oldCfg)); err != nil {
+		t.Fatalf("ApplyChanges() remove inet6 error = %v", err)
+	}
+	if client.InterfaceIP6Enabled(idx) {
+		t.Fatal("InterfaceIP6Enabled() = true after removing the inet6 family")
+	}
+}
+
+func TestApplyChangesProgramsStaticNeighbors(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	oldCfg := model.NewRouterConfig()
+	oldCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{Units: map[int]*model.Unit{
+		0: {Family: map[string]*model.AddressFamily{
+			"inet": {
+				Addresses: []string{"192.0.2.1/24"},
+				Neighbors: map[string]string{"192.0.2.10": "00:11:22:33:44:55"},
+			},
+			"inet6": {
+				Addresses: []string{"2001:db8::1/64"},
+				Neighbors: map[string]string{"2001:db8::10": "00:11:22:33:44:66"},
+			},
+		}},
+	}}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), oldCfg)); err != nil {
+		t.Fatalf("initial ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("initial ApplyChanges() did not add interface index")
+	}
+	neighborMACs := func() map[string]string {
+		t.Helper()
+		neighbors, err := client.GetNeighbors(ctx, idx)
+		if err != nil {
+			t.Fatalf("GetNeighbors() error = %v", err)
+		}
+		macs := make(map[string]string)
+		for _, n := range neighbors {
+			if !n.Static {
+				t.Fatalf("neighbor %s is not static", n.IP)
+			}
+			macs[n.IP.String()] = n.MAC.String()
+		}
+		return macs
+	}
+	if got := neighborMACs(); len(got) != 2 || got["192.0.2.10"] != "00:11:22:33:44:55" || got["2001:db8::10"] != "00:11:22:33:44:66" {
+		t.Fatalf("neighbors after initial apply = %v", got)
+	}
+
+	// A neighbor-only change replaces the MAC and removes the IPv6 entry.
+	newCfg := oldCfg.Clone()
+	newCfg.Interfaces["ge-0/0/0"].Units[0].Family["inet"].Neighbors["192.0.2.10"] = "00:11:22:33:44:77"
+	newCfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].Neighbors = nil
+	diff := engine.ComputeDiff(oldCfg, newCfg)
+	if change := diff.InterfacesChanged["ge-0/0/0"]; change == nil || !change.NeighborsChanged {
+		t.Fatalf("ComputeDiff() InterfacesChanged = %+v, want NeighborsChanged", diff.InterfacesChanged)
+	}
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if got := neighborMACs(); len(got) != 1 || got["192.0.2.10"] != "00:11:22:33:44:77" {
+		t.Fatalf("neighbors after change = %v", got)
+	}
+
+	if err := plugin.RollbackChanges(ctx, diff); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if got := neighborMACs(); len(got) != 2 || got["192.0.2.10"] != "00:11:22:33:44:55" || got["2001:db8::10"] != "00:11:22:33:44:66" {
+		t.Fatalf("neighbors after rollback = %v", got)
+	}
+}
+
+func TestApplyChangesProgramsRouterAdvertisement(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	oldCfg := model.NewRouterConfig()
+	oldCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{Units: map[int]*model.Unit{
+		0: {Family: map[string]*model.AddressFamily{
+			"inet6": {Addresses: []string{"2001:db8:1::1/64"}},
+		}},
+	}}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), oldCfg)); err != nil {
+		t.Fatalf("initial ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("initial ApplyChanges() did not add interface index")
+	}
+	if ra, ok := client.InterfaceRouterAdvertisement(idx); !ok || !ra.Suppress {
+		t.Fatalf("RA without configuration = %+v (set %v), want suppressed", ra, ok)
+	}
+
+	newCfg := oldCfg.Clone()
+	newCfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].RouterAdvertisement = &model.RouterAdvertisement{
+		ManagedConfiguration:     true,
+		MaxAdvertisementInterval: 60,
+		Prefixes: map[string]*model.RouterAdvertisementPrefix{
+			"2001:db8:1::/64": {ValidLifetime: 3600, PreferredLifetime: 1800},
+		},
+	}
+	diff := engine.ComputeDiff(oldCfg, newCfg)
+	if change := diff.InterfacesChanged["ge-0/0/0"]; change == nil || !change.RAChanged {
+		t.Fatalf("ComputeDiff() InterfacesChanged = %+v, want RAChanged", diff.InterfacesChanged)
+	}
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	ra, _ := client.InterfaceRouterAdvertisement(idx)
+	if ra.Suppress || !ra.Managed || ra.Other || ra.MaxInterval != 60 {
+		t.Fatalf("RA after enable = %+v", ra)
+	}
+	prefixes := client.RouterAdvertisementPrefixes(idx)
+	if len(prefixes) != 1 || prefixes[0].Prefix.String() != "2001:db8:1::/64" ||
+		prefixes[0].ValidLifetime != 3600 || prefixes[0].PreferredLifetime != 1800 {
+		t.Fatalf("advertised prefixes after enable = %+v", prefixes)
+	}
+
+	if err := plugin.RollbackChanges(ctx, diff); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if ra, _ := client.InterfaceRouterAdvertisement(idx); !ra.Suppress {
+		t.Fatalf("RA after rollback = %+v, want suppressed", ra)
+	}
+	if prefixes := client.RouterAdvertisementPrefixes(idx); len(prefixes) != 0 {
+		t.Fatalf("advertised prefixes after rollback = %+v, want none", prefixes)
+	}
+}
+
+func TestApplyChangesProgramsFirewallPolicers(t *testing.T) {
+	ctx := context.Background()
+	client := pkgvpp.NewMockClient()
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	oldCfg := model.NewRouterConfig()
+	oldCfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		InputPolicer: "LIMIT",
+		Units:        map[int]*model.Unit{},
+	}
+	oldCfg.Firewall = &model.FirewallConfig{Policers: map[string]*model.Policer{
+		"LIMIT": {BandwidthLimit: 100000000, BurstSizeLimit: 15000, Action: "discard"},
+	}}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), oldCfg)); err != nil {
+		t.Fatalf("initial ApplyChanges() error = %v", err)
+	}
+	idx, ok := plugin.GetInterfaceIndex("ge-0/0/0")
+	if !ok {
+		t.Fatal("initial ApplyChanges() did not add interface index")
+	}
+	if policer, ok := client.Policer("LIMIT"); !ok || policer.BandwidthLimit != 100000000 || policer.BurstSizeLimit != 15000 {
+		t.Fatalf("Policer(LIMIT) = %#v, %v, want 100m/15k", policer, ok)
+	}
+	if name, ok := client.InterfacePolicer(idx, false); !ok || name != "LIMIT" {
+		t.Fatalf("InterfacePolicer(input) = %q, %v, want LIMIT", name, ok)
+	}
+
+	// Changing the rate replaces the policer and re-applies the binding; the
+	// output binding is new.
+	newCfg := oldCfg.Clone()
+	newCfg.Firewall.Policers["LIMIT"].BandwidthLimit = 50000000
+	newCfg.Interfaces["ge-0/0/0"].OutputPolicer = "LIMIT"
+	diff := engine.ComputeDiff(oldCfg, newCfg)
+	if err := plugin.ApplyChanges(ctx, diff); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if policer, _ := client.Policer("LIMIT"); policer.BandwidthLimit != 50000000 {
+		t.Fatalf("Policer(LIMIT).BandwidthLimit = %d, want 50000000", policer.BandwidthLimit)
+	}
+	for _, output := range []bool{false, true} {
+		if name, ok := client.InterfacePolicer(idx, output); !ok || name != "LIMIT" {
+			t.Fatalf("InterfacePolicer(output=%v) = %q, %v, want LIMIT", output, name, ok)
+		}
+	}
+
+	if err := plugin.RollbackChanges(ctx, diff); err != nil {
+		t.Fatalf("RollbackChanges() error = %v", err)
+	}
+	if policer, _ := client.Policer("LIMIT"); policer.BandwidthLimit != 100000000 {
+		t.Fatalf("Policer(LIMIT).BandwidthLimit after rollback = %d, want 100000000", policer.BandwidthLimit)
+	}
+	if _, ok := client.InterfacePolicer(idx, true); ok {
+		t.Fatal("RollbackChanges() left output policer applied")
+	}
+	if name, ok := client.InterfacePolicer(idx, false); !ok || name != "LIMIT" {
+		t.Fatalf("InterfacePolicer(input) after rollback = %q, %v, want LIMIT", name, ok)
+	}
+
+	removed := model.NewRouterConfig()
+	removed.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{Units: map[int]*model.Unit{}}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(oldCfg, removed)); err != nil {
+		t.Fatalf("ApplyChanges() remove error = %v", err)
+	}
+	if _, ok := client.Policer("LIMIT"); ok {
+		t.Fatal("ApplyChanges() left policer configured after removing firewall config")
+	}
+	if _, ok := client.InterfacePolicer(idx, false); ok {
+		t.Fatal("ApplyChanges() left input policer applied")
+	}
+}
+
+func TestStableInterfaceIndexSurvivesVPPRestart(t *testing.T) {
+	ctx := context.Background()
+	indexPath := filepath.Join(t.TempDir(), "interface_index.json")
+	hwConfig := &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+			{Name: "ge-0/0/1", PCI: "0000:03:00.1", Driver: "avf"},
+		},
+	}
+
+	first := NewVPPPlugin(pkgvpp.NewMockClient(), hwConfig, testLogger())
+	first.SetInterfaceIndexPath(indexPath)
+	if err := first.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	cfg := model.NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{Units: map[int]*model.Unit{}}
+	cfg.Interfaces["ge-0/0/1"] = &model.InterfaceConfig{Units: map[int]*model.Unit{}}
+	if err := first.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), cfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	want := map[string]uint32{
+		"ge-0/0/0": first.StableInterfaceIndex("ge-0/0/0"),
+		"ge-0/0/1": first.StableInterfaceIndex("ge-0/0/1"),
+	}
+	if want["ge-0/0/0"] == 0 || want["ge-0/0/1"] == 0 || want["ge-0/0/0"] == want["ge-0/0/1"] {
+		t.Fatalf("stable indexes = %v, want distinct non-zero indexes", want)
+	}
+	_ = first.Close()
+
+	// After a VPP restart the NICs are created in the opposite order and get
+	// different sw_if_index values.
+	client := pkgvpp.NewMockClient()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	for _, pci := range []string{"0000:03:00.1", "0000:03:00.0"} {
+		if _, err := client.CreateInterface(ctx, &pkgvpp.CreateInterfaceRequest{
+			Type: pkgvpp.InterfaceTypeAVF, DeviceInstance: pci, PCIAddress: pci,
+		}); err != nil {
+			t.Fatalf("CreateInterface(%s) error = %v", pci, err)
+		}
+	}
+	_ = client.Close()
+	second := NewVPPPlugin(client, hwConfig, testLogger())
+	second.SetInterfaceIndexPath(indexPath)
+	if err := second.Init(ctx); err != nil {
+		t.Fatalf("second Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = second.Close() })
+
+	swIfIndex0, _ := second.GetInterfaceIndex("ge-0/0/0")
+	swIfIndex1, _ := second.GetInterfaceIndex("ge-0/0/1")
+	if swIfIndex1 >= swIfIndex0 {
+		t.Fatalf("sw_if_index ge-0/0/0=%d ge-0/0/1=%d, want reordered allocation", swIfIndex0, swIfIndex1)
+	}
+	states, err := second.CollectState(ctx)
+	if err != nil {
+		t.Fatalf("CollectState() error = %v", err)
+	}
+	for name, ifIndex := range want {
+		state := states[name]
+		if state == nil || state.IfIndex != ifIndex {
+			t.Fatalf("CollectState()[%s] = %#v, want if-index %d", name, state, ifIndex)
+		}
+	}
+}
+
+// recordingPlugin is a no-op engine plugin that records whether it was applied.
+type recordingPlugin struct {
+	name    string
+	applied bool
+}
+
+func (p *recordingPlugin) Name() string { return p.name }
+
+func (p *recordingPlugin) Init(context.Context) error { return nil }
+
+func (p *recordingPlugin) Close() error { return nil }
+
+func (p *recordingPlugin) HealthCheck(context.Context) error { return nil }
+
+func (p *recordingPlugin) ValidateChanges(context.Context, *engine.ConfigDiff) error { return nil }
+
+func (p *recordingPlugin) ApplyChanges(context.Context, *engine.ConfigDiff) error {
+	p.applied = true
+	return nil
+}
+
+func (p *recordingPlugin) RollbackChanges(context.Context, *engine.ConfigDiff) error { return nil }
+
+// recordingClient records the interface setup calls the plugin makes, in the
+// form RenderOperations prints them.
+type recordingClient struct {
+	*pkgvpp.MockClient
+	names map[uint32]string
+	ops   []string
+}
+
+func (c *recordingClient) CreateInterface(ctx context.Context, req *pkgvpp.CreateInterfaceRequest) (*pkgvpp.Interface, error) {
+	iface, err := c.MockClient.CreateInterface(ctx, req)
+	if err == nil {
+		c.names[iface.SwIfIndex] = req.Name
+		c.ops = append(c.ops, fmt.Sprintf("create interface %s from hardware configuration num-rx-queues %d num-tx-queues %d", req.Name, req.NumRxQueues, req.NumTxQueues))
+	}
+	return iface, err
+}
+
+func (c *recordingClient) SetInterfaceUp(ctx context.Context, ifIndex uint32) error {
+	c.ops = append(c.ops, fmt.Sprintf("set interface state %s up", c.names[ifIndex]))
+	return c.MockClient.SetInterfaceUp(ctx, ifIndex)
+}
+
+func (c *recordingClient) SetInterfacePromiscuous(ctx context.Context, ifIndex uint32, enabled bool) error {
+	c.ops = append(c.ops, fmt.Sprintf("set interface promiscuous on %s", c.names[ifIndex]))
+	return c.MockClient.SetInterfacePromiscuous(ctx, ifIndex, enabled)
+}
+
+func (c *recordingClient) SetInterfaceRxMode(ctx context.Context, ifIndex uint32, mode string) error {
+	c.ops = append(c.ops, fmt.Sprintf("set interface rx-mode %s %s", c.names[ifIndex], mode))
+	return c.MockClient.SetInterfaceRxMode(ctx, ifIndex, mode)
+}
+
+func (c *recordingClient) SetInterfaceMTU(ctx context.Context, ifIndex uint32, mtu uint32) error {
+	c.ops = append(c.ops, fmt.Sprintf("set interface mtu %d %s", mtu, c.names[ifIndex]))
+	return c.MockClient.SetInterfaceMTU(ctx, ifIndex, mtu)
+}
+
+func (c *recordingClient) SetInterfaceIPMTU(ctx context.Context, ifIndex uint32, ip4, ip6 uint32) error {
+	c.ops = append(c.ops, fmt.Sprintf("set interface mtu ip4 %d ip6 %d %s", ip4, ip6, c.names[ifIndex]))
+	return c.MockClient.SetInterfaceIPMTU(ctx, ifIndex, ip4, ip6)
+}
+
+func (c *recordingClient) SetInterfaceRxPlacement(ctx context.Context, ifIndex uint32, queueID uint32, workerID uint32) error {
+	c.ops = append(c.ops, fmt.Sprintf("set interface rx-placement %s queue %d worker %d", c.names[ifIndex], queueID, workerID))
+	return c.MockClient.SetInterfaceRxPlacement(ctx, ifIndex, queueID, workerID)
+}
+
+func (c *recordingClient) CreateLCPInterface(ctx context.Context, ifIndex uint32, linuxIfName string) error {
+	c.ops = append(c.ops, fmt.Sprintf("lcp create %s host-if %s", c.names[ifIndex], linuxIfName))
+	return c.MockClient.CreateLCPInterface(ctx, ifIndex, linuxIfName)
+}
+
+func TestRenderOperationsMatchesAppliedOperations(t *testing.T) {
+	ctx := context.Background()
+	client := &recordingClient{MockClient: pkgvpp.NewMockClient(), names: make(map[uint32]string)}
+	plugin := NewVPPPlugin(client, &device.HardwareConfig{
+		Interfaces: []device.PhysicalInterface{
+			{Name: "ge-0/0/0", PCI: "0000:03:00.0", Driver: "avf"},
+			{Name: "ge-0/0/1", PCI: "0000:03:00.1", Driver: "avf"},
+		},
+	}, testLogger())
+	if err := plugin.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = plugin.Close() })
+
+	cfg := model.NewRouterConfig()
+	cfg.Interfaces["ge-0/0/0"] = &model.InterfaceConfig{
+		Promiscuous: true,
+		RxMode:      "polling",
+		MTU:         9000,
+		GigEtherOptions: &model.GigEtherOptions{
+			RxQueues:    2,
+			TxQueues:    2,
+			RxPlacement: map[int]int{1: 0, 0: 1},
+		},
+		Units: map[int]*model.Unit{
+			0: {Family: map[string]*model.AddressFamily{
+				"inet": {MTU: 1500},
+			}},
+		},
+	}
+	cfg.Interfaces["ge-0/0/1"] = &model.InterfaceConfig{
+		GigEtherOptions: &model.GigEtherOptions{TxQueues: 2},
+	}
+
+	want, err := RenderOperations(cfg)
+	if err != nil {
+		t.Fatalf("RenderOperations() error = %v", err)
+	}
+	if err := plugin.ApplyChanges(ctx, engine.ComputeDiff(model.NewRouterConfig(), cfg)); err != nil {
+		t.Fatalf("ApplyChanges() error = %v", err)
+	}
+	if got := strings.Join(client.ops, "\n"); got != strings.Join(want, "\n") {
+		t.Fatalf("applied operations =\n%s\nwant rendered\n%s", got, strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,177 @@
+package vpp
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/akam1o/arca-router/internal/engine"
+	"github.com/akam1o/arca-router/internal/model"
+	pkgvpp "github.com/akam1o/arca-router/pkg/vpp"
+)
+
+// RenderOperations summarizes the VPP operations that programming cfg onto
+// an empty dataplane performs, one line per operation, in the order the
+// plugin applies them. Nothing is sent to VPP. Interfaces are named as
+// configured; the VPP interface a physical interface maps to comes from the
+// hardware configuration of the router. Deactivated statements are skipped.
+// Queue counts and MTUs come from the helpers the plugin programs them with;
+// TestRenderOperationsMatchesAppliedOperations pins the interface operations
+// to the ones ApplyChanges performs.
+func RenderOperations(cfg *model.RouterConfig) ([]string, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("configuration is nil")
+	}
+	active, err := cfg.ActiveConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []string
+	names := make([]string, 0, len(active.Interfaces))
+	for name := range active.Interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ifaceCfg := active.Interfaces[name]
+		if req, ok := softwareInterfaceCreateRequest(name, ifaceCfg); ok {
+			if req.Type == pkgvpp.InterfaceTypeLoopback {
+				ops = append(ops, fmt.Sprintf("create loopback interface %s", name))
+			} else {
+				ops = append(ops, fmt.Sprintf("create %s tunnel %s src %s dst %s", req.Type, name, req.TunnelSource, req.TunnelDestination))
+			}
+		} else {
+			op := fmt.Sprintf("create interface %s from hardware configuration", name)
+			if opts := ifaceGigEtherOptions(ifaceCfg); opts != nil && (opts.RxQueues > 0 || opts.TxQueues > 0) {
+				rxq, txq := interfaceQueueCounts(ifaceCfg)
+				op += fmt.Sprintf(" num-rx-queues %d num-tx-queues %d", rxq, txq)
+			}
+			ops = append(ops, op)
+		}
+		ops = append(ops, fmt.Sprintf("set interface state %s up", name))
+		if ifaceCfg != nil && ifaceCfg.Promiscuous {
+			ops = append(ops, fmt.Sprintf("set interface promiscuous on %s", name))
+		}
+		if ifaceCfg != nil && ifaceCfg.RxMode != "" {
+			ops = append(ops, fmt.Sprintf("set interface rx-mode %s %s", name, vppRxMode(ifaceCfg.RxMode)))
+		}
+		if mtu := engine.ConfiguredInterfaceMTU(ifaceCfg); !mtu.IsZero() {
+			link, ip4, ip6 := vppInterfaceMTU(mtu)
+			ops = append(ops, fmt.Sprintf("set interface mtu %d %s", link, name))
+			ops = append(ops, fmt.Sprintf("set interface mtu ip4 %d ip6 %d %s", ip4, ip6, name))
+		}
+		if opts := ifaceGigEtherOptions(ifaceCfg); opts != nil {
+			for _, queue := range slices.Sorted(maps.Keys(opts.RxPlacement)) {
+				ops = append(ops, fmt.Sprintf("set interface rx-placement %s queue %d worker %d", name, queue, opts.RxPlacement[queue]))
+			}
+		}
+		if linuxName, err := pkgvpp.ConvertJunosToLinuxName(name); err == nil {
+			ops = append(ops, fmt.Sprintf("lcp create %s host-if %s", name, linuxName))
+		}
+	}
+
+	plans, err := routingInstancePlanMap(active.RoutingInstances)
+	if err != nil {
+		return nil, err
+	}
+	planNames := make([]string, 0, len(plans))
+	for name := range plans {
+		planNames = append(planNames, name)
+	}
+	sort.Strings(planNames)
+	for _, name := range planNames {
+		plan := plans[name]
+		ops = append(ops, fmt.Sprintf("ip table add %d (routing-instance %s)", plan.tableID, name))
+		ops = append(ops, fmt.Sprintf("ip6 table add %d (routing-instance %s)", plan.tableID, name))
+		for _, iface := range plan.interfaces {
+			ops = append(ops, fmt.Sprintf("set interface ip table %s %d", iface, plan.tableID))
+			ops = append(ops, fmt.Sprintf("set interface ip6 table %s %d", iface, plan.tableID))
+		}
+	}
+
+	for _, name := range names {
+		if hasInet6Family(active.Interfaces[name]) {
+			ops = append(ops, fmt.Sprintf("enable ip6 interface %s", name))
+		}
+		for _, addr := range renderedAddresses(active.Interfaces[name]) {
+			ops = append(ops, fmt.Sprintf("set interface ip address %s %s", name, addr))
+		}
+	}
+
+	neighbors := staticNeighborMap(active)
+	for _, key := range sortedStaticNeighbors(neighbors) {
+		ops = append(ops, fmt.Sprintf("set ip neighbor %s %s %s static", key.iface, key.ip, neighbors[key]))
+	}
+	if active.Protocols != nil && active.Protocols.MPLS != nil {
+		interfaces := append([]string(nil), active.Protocols.MPLS.Interfaces...)
+		sort.Strings(interfaces)
+		for _, name := range interfaces {
+			ops = append(ops, fmt.Sprintf("set interface mpls %s enable", name))
+		}
+	}
+	if active.Firewall != nil {
+		policers := make([]string, 0, len(active.Firewall.Policers))
+		for name := range active.Firewall.Policers {
+			policers = append(policers, name)
+		}
+		sort.Strings(policers)
+		for _, name := range policers {
+			policer := active.Firewall.Policers[name]
+			ops = append(ops, fmt.Sprintf("policer add %s rate %dbps burst %d bytes", name, policer.BandwidthLimit, policer.BurstSizeLimit))
+		}
+	}
+	for _, name := range names {
+		ifaceCfg := active.Interfaces[name]
+		if ifaceCfg == nil {
+			continue
+		}
+		if ifaceCfg.InputPolicer != "" {
+			ops = append(ops, fmt.Sprintf("policer input %s %s", ifaceCfg.InputPolicer, name))
+		}
+		if ifaceCfg.OutputPolicer != "" {
+			ops = append(ops, fmt.Sprintf("policer output %s %s", ifaceCfg.OutputPolicer, name))
+		}
+	}
+	return ops, nil
+}
+
+// renderedAddresses returns the addresses of an interface ordered by unit
+// and family.
+func renderedAddresses(ifaceCfg *model.InterfaceConfig) []string {
+	if ifaceCfg == nil {
+		return nil
+	}
+	units := make([]int, 0, len(ifaceCfg.Units))
+	for unit := range ifaceCfg.Units {
+		units = append(units, unit)
+	}
+	sort.Ints(units)
+	var addrs []string
+	for _, unitNum := range units {
+		unit := ifaceCfg.Units[unitNum]
+		if unit == nil {
+			continue
+		}
+		families := make([]string, 0, len(unit.Family))
+		for name := range unit.Family {
+			families = append(families, name)
+		}
+		sort.Strings(families)
+		for _, name := range families {
+			if family := unit.Family[name]; family != nil {
+				addrs = append(addrs, family.Addresses...)
+			}
+		}
+	}
+	return addrs
+}
+
+func ifaceGigEtherOptions(ifaceCfg *model.InterfaceConfig) *model.GigEtherOptions {
+	if ifaceCfg == nil {
+		return nil
+	}
+	return ifaceCfg.GigEtherOptions
+}
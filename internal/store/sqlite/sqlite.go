@@ -167,6 +167,8 @@ func (s *Store) PrepareCommit(ctx context.Context, snap *model.ConfigSnapshot) (
 		SessionID:     sessionID,
 		User:          snap.Author,
 		Message:       snap.Message,
+		Source:        snap.Source,
+		SourceIP:      snap.SourceIP,
 		CorrelationID: correlationID,
 	}
 
@@ -234,11 +236,114 @@ func (s *Store) PrepareRollback(ctx context.Context, snap *model.ConfigSnapshot,
 			CommitID:      targetCommitID,
 			User:          snap.Author,
 			Message:       snap.Message,
+			Source:        snap.Source,
+			SourceIP:      snap.SourceIP,
 			CorrelationID: correlationID,
 		},
 	}, nil
 }
 
+func (s *Store) scheduledCommitStore() (datastore.ScheduledCommitStore, error) {
+	scheduler, ok := s.ds.(datastore.ScheduledCommitStore)
+	if !ok {
+		return nil, fmt.Errorf("datastore does not support scheduled commits")
+	}
+	return scheduler, nil
+}
+
+// AppendCommitNote appends note to the message of an archived commit.
+func (s *Store) AppendCommitNote(ctx context.Context, commitID, note string) error {
+	notes, ok := s.ds.(datastore.CommitNoteStore)
+	if !ok {
+		return fmt.Errorf("datastore does not support commit notes")
+	}
+	return notes.AppendCommitNote(ctx, commitID, note)
+}
+
+// CompareCommits diffs two archived commits with the datastore's commit
+// comparison.
+func (s *Store) CompareCommits(ctx context.Context, commitID1, commitID2 string) (string, error) {
+	diff, err := s.ds.CompareCommits(ctx, commitID1, commitID2)
+	if err != nil {
+		return "", err
+	}
+	return diff.DiffText, nil
+}
+
+// CheckIntegrity checks the underlying datastore and, with repair, removes
+// orphaned candidates and expired locks.
+func (s *Store) CheckIntegrity(ctx context.Context, repair bool) ([]store.IntegrityIssue, error) {
+	checker, ok := s.ds.(datastore.IntegrityChecker)
+	if !ok {
+		return nil, fmt.Errorf("datastore does not support integrity checks")
+	}
+	issues, err := checker.CheckIntegrity(ctx, repair)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]store.IntegrityIssue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, store.IntegrityIssue{
+			Check:       issue.Check,
+			Object:      issue.Object,
+			Description: issue.Description,
+			Repaired:    issue.Repaired,
+		})
+	}
+	return result, nil
+}
+
+func (s *Store) GetScheduledCommit(ctx context.Context) (*store.ScheduledCommit, error) {
+	scheduler, err := s.scheduledCommitStore()
+	if err != nil {
+		return nil, err
+	}
+	scheduled, err := scheduler.GetScheduledCommit(ctx)
+	if err != nil {
+		var dsErr *datastore.Error
+		if errors.As(err, &dsErr) && dsErr.Code == datastore.ErrCodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &store.ScheduledCommit{
+		ConfigText:  scheduled.ConfigText,
+		SessionID:   scheduled.SessionID,
+		User:        scheduled.User,
+		Message:     scheduled.Message,
+		CreatedAt:   scheduled.CreatedAt,
+		ScheduledAt: scheduled.ScheduledAt,
+		BaseVersion: scheduled.BaseVersion,
+	}, nil
+}
+
+func (s *Store) SaveScheduledCommit(ctx context.Context, scheduled *store.ScheduledCommit) error {
+	if scheduled == nil {
+		return fmt.Errorf("scheduled commit is nil")
+	}
+	scheduler, err := s.scheduledCommitStore()
+	if err != nil {
+		return err
+	}
+	return scheduler.SaveScheduledCommit(ctx, &datastore.ScheduledCommit{
+		ConfigText:  scheduled.ConfigText,
+		SessionID:   scheduled.SessionID,
+		User:        scheduled.User,
+		Message:     scheduled.Message,
+		CreatedAt:   scheduled.CreatedAt,
+		ScheduledAt: scheduled.ScheduledAt,
+		BaseVersion: scheduled.BaseVersion,
+	})
+}
+
+func (s *Store) ClearScheduledCommit(ctx context.Context) error {
+	scheduler, err := s.scheduledCommitStore()
+	if err != nil {
+		return err
+	}
+	return scheduler.ClearScheduledCommit(ctx)
+}
+
 func (s *Store) GetCommit(ctx context.Context, commitID string) (*store.CommitRecord, error) {
 	entry, err := s.ds.GetCommit(ctx, commitID)
 	if err != nil {
@@ -259,6 +364,7 @@ func (s *Store) GetCommit(ctx context.Context, commitID string) (*store.CommitRe
 		ConfigText: entry.ConfigText,
 		Author:     entry.User,
 		Message:    entry.Message,
+		Source:     entry.Source,
 		Timestamp:  entry.Timestamp,
 		IsRollback: entry.IsRollback,
 	}, nil
@@ -283,6 +389,7 @@ func (s *Store) ListCommits(ctx context.Context, opts *store.ListOptions) ([]*st
 			CommitID:   e.CommitID,
 			Author:     e.User,
 			Message:    e.Message,
+			Source:     e.Source,
 			Timestamp:  e.Timestamp,
 			IsRollback: e.IsRollback,
 		})
@@ -414,6 +521,9 @@ func (s *Store) parseLegacyText(text string) (*model.RouterConfig, error) {
 // Verify interface compliance at compile time.
 var _ store.ConfigStore = (*Store)(nil)
 var _ store.RollbackPreparer = (*Store)(nil)
+var _ store.ScheduledCommitStore = (*Store)(nil)
+var _ store.IntegrityStore = (*Store)(nil)
+var _ store.CommitNoteStore = (*Store)(nil)
 
 // Legacy returns the underlying legacy datastore for components that
 // still need it during the migration period.
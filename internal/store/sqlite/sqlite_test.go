@@ -181,6 +181,62 @@ func TestSaveCommitPropagatesCorrelationIDToAudit(t *testing.T) {
 	}
 }
 
+func TestSaveCommitAuditsUserSourceAndChanges(t *testing.T) {
+	st, err := NewFromPath(filepath.Join(t.TempDir(), "config.db"))
+	if err != nil {
+		t.Fatalf("NewFromPath() error = %v", err)
+	}
+	t.Cleanup(func() { _ = st.Close() })
+
+	ctx := context.Background()
+	first := model.NewSnapshot(&model.RouterConfig{
+		System:     &model.SystemConfig{HostName: "router1"},
+		Interfaces: map[string]*model.InterfaceConfig{},
+	}, 1, "alice", "initial")
+	if _, err := st.SaveCommit(ctx, first); err != nil {
+		t.Fatalf("SaveCommit() error = %v", err)
+	}
+
+	second := model.NewSnapshot(&model.RouterConfig{
+		System: &model.SystemConfig{
+			HostName: "router2",
+			Services: &model.SystemServicesConfig{SNMP: &model.SNMPConfig{Community: "s3cret-community"}},
+		},
+		Interfaces: map[string]*model.InterfaceConfig{},
+	}, 2, "bob", "rename")
+	second.Source = datastore.CommitSourceCLI
+	second.SourceIP = "192.0.2.10"
+	commitID, err := st.SaveCommit(ctx, second)
+	if err != nil {
+		t.Fatalf("SaveCommit() error = %v", err)
+	}
+
+	events, err := st.Legacy().ListAuditEvents(ctx, &datastore.AuditOptions{Action: "commit", User: "bob"})
+	if err != nil {
+		t.Fatalf("ListAuditEvents() error = %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("audit events = %d, want 1", len(events))
+	}
+	event := events[0]
+	if event.SourceIP != "192.0.2.10" {
+		t.Fatalf("audit SourceIP = %q, want 192.0.2.10", event.SourceIP)
+	}
+	for _, want := range []string{
+		"commit_id: " + commitID,
+		"- set system host-name router1",
+		"+ set system host-name router2",
+		"+ set system services snmp community <redacted>",
+	} {
+		if !strings.Contains(event.Details, want) {
+			t.Fatalf("audit details = %q, want %q", event.Details, want)
+		}
+	}
+	if strings.Contains(event.Details, "s3cret-community") {
+		t.Fatalf("audit details = %q, leaked SNMP community", event.Details)
+	}
+}
+
 func TestAuditLogRejectsUnmarshalableDetails(t *testing.T) {
 	st, err := NewFromPath(filepath.Join(t.TempDir(), "config.db"))
 	if err != nil {
@@ -52,6 +52,63 @@ type RollbackPreparer interface {
 	PrepareRollback(ctx context.Context, snap *model.ConfigSnapshot, targetCommitID string) (PreparedCommit, error)
 }
 
+// ScheduledCommitStore persists the commit scheduled with "commit at" so it
+// survives daemon restarts.
+type ScheduledCommitStore interface {
+	// GetScheduledCommit returns the scheduled commit, or nil when no commit
+	// is scheduled.
+	GetScheduledCommit(ctx context.Context) (*ScheduledCommit, error)
+
+	// SaveScheduledCommit records or replaces the scheduled commit.
+	SaveScheduledCommit(ctx context.Context, scheduled *ScheduledCommit) error
+
+	// ClearScheduledCommit removes the scheduled commit (idempotent).
+	ClearScheduledCommit(ctx context.Context) error
+}
+
+// CommitNoteStore appends notes, such as post-commit script output, to the
+// message of an archived commit.
+type CommitNoteStore interface {
+	AppendCommitNote(ctx context.Context, commitID, note string) error
+}
+
+// CommitCompareStore diffs the configuration of two archived commits after
+// migrating them to the current schema.
+type CommitCompareStore interface {
+	// CompareCommits returns the line diff from the first commit's
+	// configuration to the second's, or "" when they are identical.
+	CompareCommits(ctx context.Context, commitID1, commitID2 string) (string, error)
+}
+
+// IntegrityStore checks the persisted configuration state for corruption
+// and orphaned records.
+type IntegrityStore interface {
+	// CheckIntegrity returns the problems found. With repair, orphaned
+	// records are removed and their issues are marked repaired.
+	CheckIntegrity(ctx context.Context, repair bool) ([]IntegrityIssue, error)
+}
+
+// IntegrityIssue is one problem found by an integrity check.
+type IntegrityIssue struct {
+	Check       string `json:"check"`
+	Object      string `json:"object,omitempty"`
+	Description string `json:"description"`
+	Repaired    bool   `json:"repaired,omitempty"`
+}
+
+// ScheduledCommit is a candidate configuration waiting to be committed at
+// ScheduledAt. BaseVersion is the running version the candidate was built
+// on; the commit is abandoned if running has changed by then (0 = unchecked).
+type ScheduledCommit struct {
+	ConfigText  string    `json:"config_text"`
+	SessionID   string    `json:"session_id,omitempty"`
+	User        string    `json:"user"`
+	Message     string    `json:"message,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	BaseVersion uint64    `json:"base_version,omitempty"`
+}
+
 // CommitRecord represents a persisted commit entry.
 type CommitRecord struct {
 	CommitID   string              `json:"commit_id"`
@@ -60,6 +117,7 @@ type CommitRecord struct {
 	ConfigText string              `json:"config_text,omitempty"`
 	Author     string              `json:"author"`
 	Message    string              `json:"message,omitempty"`
+	Source     string              `json:"source,omitempty"`
 	Timestamp  time.Time           `json:"timestamp"`
 	IsRollback bool                `json:"is_rollback,omitempty"`
 }
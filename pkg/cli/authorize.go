@@ -0,0 +1,113 @@
+// Package cli provides role-based command authorization
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	internalauth "github.com/akam1o/arca-router/internal/auth"
+)
+
+// configurationCommands change the candidate or running configuration and
+// require at least the operator role.
+var configurationCommands = map[string]bool{
+	"configure":       true,
+	"set":             true,
+	"delete":          true,
+	"insert":          true,
+	"activate":        true,
+	"deactivate":      true,
+	"edit":            true,
+	"commit":          true,
+	"rollback":        true,
+	"restore":         true,
+	"load":            true,
+	"replace":         true,
+	"discard-changes": true,
+	"clear":           true,
+}
+
+// statementCommands edit individual statements; their path decides whether
+// an operator may run them.
+var statementCommands = map[string]bool{
+	"set":        true,
+	"delete":     true,
+	"insert":     true,
+	"activate":   true,
+	"deactivate": true,
+}
+
+// adminCommands manage user accounts and credentials.
+var adminCommands = map[string]bool{
+	"request": true,
+}
+
+// AuthorizeCommand checks whether role may run command, mirroring NETCONF
+// authorization: read-only users may only run operational commands,
+// operators may configure everything except the security hierarchy, and
+// admins may do everything. path is the full configuration path of a
+// statement command (edit hierarchy followed by the command arguments).
+func AuthorizeCommand(role, command string, path []string) error {
+	switch role {
+	case internalauth.RoleAdmin:
+		return nil
+	case internalauth.RoleOperator:
+		if adminCommands[command] {
+			return fmt.Errorf("permission denied: %s role cannot run '%s'", role, command)
+		}
+		if statementCommands[command] && (len(path) == 0 || path[0] == "security") {
+			return fmt.Errorf("permission denied: %s role cannot change security configuration", role)
+		}
+		return nil
+	case internalauth.RoleReadOnly:
+		if configurationCommands[command] || adminCommands[command] {
+			return fmt.Errorf("permission denied: %s role cannot run '%s'", role, command)
+		}
+		return nil
+	default:
+		return fmt.Errorf("permission denied: unknown role %q", role)
+	}
+}
+
+// AuthorizeConfigReplacement checks whether role may turn configuration
+// oldText into newText in one step, as rollback, restore and replace do. It
+// applies the rule of AuthorizeCommand to the whole change: operators may
+// change everything except the security hierarchy.
+func AuthorizeConfigReplacement(role, oldText, newText string) error {
+	switch role {
+	case internalauth.RoleAdmin:
+		return nil
+	case internalauth.RoleOperator:
+		if !slices.Equal(securityStatements(oldText), securityStatements(newText)) {
+			return fmt.Errorf("permission denied: %s role cannot change security configuration", role)
+		}
+		return nil
+	case internalauth.RoleReadOnly:
+		return fmt.Errorf("permission denied: %s role cannot change configuration", role)
+	default:
+		return fmt.Errorf("permission denied: unknown role %q", role)
+	}
+}
+
+// securityStatements returns the sorted, token-normalized statements of text
+// under the security hierarchy.
+func securityStatements(text string) []string {
+	var statements []string
+	for _, line := range strings.Split(text, "\n") {
+		tokens, err := TokenizeCommand(strings.TrimSpace(line))
+		if err != nil {
+			tokens = strings.Fields(line)
+		}
+		if len(tokens) >= 2 && tokens[1] == "security" {
+			statements = append(statements, strings.Join(tokens, "\x00"))
+		}
+	}
+	slices.Sort(statements)
+	return statements
+}
+
+func (s *Session) authorize(command string, args []string) error {
+	path := append(append([]string{}, s.configPath...), args...)
+	return AuthorizeCommand(s.role, command, path)
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAuthorizeCommandByRole(t *testing.T) {
+	tests := []struct {
+		role    string
+		command string
+		path    []string
+		allowed bool
+	}{
+		{"read-only", "show", []string{"configuration"}, true},
+		{"read-only", "help", nil, true},
+		{"read-only", "configure", nil, false},
+		{"read-only", "set", []string{"system", "host-name", "r1"}, false},
+		{"read-only", "commit", nil, false},
+		{"read-only", "rollback", []string{"1"}, false},
+		{"read-only", "request", []string{"security"}, false},
+
+		{"operator", "configure", nil, true},
+		{"operator", "set", []string{"interfaces", "ge-0/0/0", "description", "WAN"}, true},
+		{"operator", "delete", []string{"protocols", "bgp"}, true},
+		{"operator", "commit", nil, true},
+		{"operator", "set", []string{"security", "users", "user", "bob", "role", "admin"}, false},
+		{"operator", "delete", []string{"security"}, false},
+		{"operator", "delete", nil, false},
+		{"operator", "request", []string{"security"}, false},
+
+		{"admin", "configure", nil, true},
+		{"admin", "set", []string{"security", "users", "user", "bob", "role", "admin"}, true},
+		{"admin", "delete", nil, true},
+		{"admin", "request", []string{"security"}, true},
+
+		{"guest", "show", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+"_"+tt.command+"_"+strings.Join(tt.path, "_"), func(t *testing.T) {
+			err := AuthorizeCommand(tt.role, tt.command, tt.path)
+			if tt.allowed && err != nil {
+				t.Fatalf("AuthorizeCommand() error = %v, want allowed", err)
+			}
+			if !tt.allowed {
+				if err == nil {
+					t.Fatal("AuthorizeCommand() error = nil, want permission denied")
+				}
+				if !strings.Contains(err.Error(), "permission denied") {
+					t.Fatalf("AuthorizeCommand() error = %v, want permission denied", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSessionRoleRestrictsConfiguration(t *testing.T) {
+	ctx := context.Background()
+
+	readOnly := NewSessionWithRole("viewer", "read-only", &mockDatastore{})
+	if err := readOnly.EnterConfigurationMode(ctx); err == nil {
+		t.Fatal("read-only EnterConfigurationMode() error = nil, want permission denied")
+	}
+	if readOnly.Mode() != ModeOperational {
+		t.Fatalf("read-only mode = %s, want operational", readOnly.Mode())
+	}
+
+	ds := &mockDatastore{}
+	operator := NewSessionWithRole("oper", "operator", ds)
+	if err := operator.EnterConfigurationMode(ctx); err != nil {
+		t.Fatalf("operator EnterConfigurationMode() error = %v", err)
+	}
+	operator.EditHierarchy([]string{"security", "users"})
+	if err := operator.SetCommandWithPath(ctx, []string{"user", "bob", "role", "admin"}); err == nil {
+		t.Fatal("operator set under [edit security users] error = nil, want permission denied")
+	}
+	if ds.saveCandidateText != "" && strings.Contains(ds.saveCandidateText, "security") {
+		t.Fatalf("operator saved security change: %q", ds.saveCandidateText)
+	}
+
+	if got := NewSession("root", &mockDatastore{}).Role(); got != "admin" {
+		t.Fatalf("NewSession() role = %q, want admin", got)
+	}
+}
+
+func TestAuthorizeConfigReplacementProtectsSecurity(t *testing.T) {
+	running := "set system host-name r1\nset security users user bob role operator\n"
+	hostChange := "set security users user bob role operator\nset system host-name r2\n"
+	securityChange := "set system host-name r1\nset security users user bob role admin\n"
+	requoted := "set system host-name r1\nset security users user \"bob\" role operator\n"
+
+	tests := []struct {
+		role    string
+		newText string
+		allowed bool
+	}{
+		{"admin", securityChange, true},
+		{"operator", hostChange, true},
+		{"operator", requoted, true},
+		{"operator", securityChange, false},
+		{"operator", "set system host-name r1\n", false},
+		{"read-only", hostChange, false},
+		{"", hostChange, false},
+	}
+	for _, tt := range tests {
+		err := AuthorizeConfigReplacement(tt.role, running, tt.newText)
+		if tt.allowed != (err == nil) {
+			t.Fatalf("AuthorizeConfigReplacement(%q, %q) error = %v, want allowed %t", tt.role, tt.newText, err, tt.allowed)
+		}
+		if err != nil && !strings.Contains(err.Error(), "permission denied") {
+			t.Fatalf("AuthorizeConfigReplacement(%q) error = %v, want permission denied", tt.role, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/akam1o/arca-router/pkg/datastore"
 )
@@ -17,6 +18,9 @@ type CommitOptions struct {
 
 // CommitWithOptions commits candidate to running with options
 func (s *Session) CommitWithOptions(ctx context.Context, opts CommitOptions) error {
+	if err := s.authorize("commit", nil); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -42,17 +46,13 @@ func (s *Session) CommitWithOptions(ctx context.Context, opts CommitOptions) err
 		return fmt.Errorf("cannot commit: %w", err)
 	}
 
-	// Prepare commit message
-	message := opts.Message
-	if message == "" {
-		message = "CLI commit"
-	}
-
-	// Perform commit
+	// Perform commit; the datastore records a default message when no
+	// comment was given.
 	req := &datastore.CommitRequest{
 		SessionID: s.id,
 		User:      s.username,
-		Message:   message,
+		Message:   opts.Message,
+		Source:    datastore.CommitSourceCLI,
 		SourceIP:  "local",
 	}
 	commitID, err := s.ds.Commit(ctx, req)
@@ -73,6 +73,9 @@ func (s *Session) CommitWithOptions(ctx context.Context, opts CommitOptions) err
 // rollbackNum=0: discard changes (sync with running)
 // rollbackNum=N: rollback to N commits ago
 func (s *Session) RollbackWithNumber(ctx context.Context, rollbackNum int) error {
+	if err := s.authorize("rollback", nil); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -116,6 +119,7 @@ func (s *Session) RollbackWithNumber(ctx context.Context, rollbackNum int) error
 		CommitID:  targetCommit.CommitID,
 		User:      s.username,
 		Message:   fmt.Sprintf("CLI rollback %d", rollbackNum),
+		Source:    datastore.CommitSourceCLI,
 		SourceIP:  "local",
 	}
 	newCommitID, err := s.ds.Rollback(ctx, req)
@@ -177,23 +181,27 @@ func (s *Session) ShowCommitHistory(ctx context.Context, limit int) error {
 	}
 
 	fmt.Printf("Commit history (showing last %d commits):\n\n", len(history))
+	fmt.Printf("%2s  %-8s  %-19s  %-16s  %-8s  %s\n", "#", "Commit", "Timestamp", "User", "Source", "Message")
 	for i, commit := range history {
 		shortID := commit.CommitID
 		if len(shortID) >= 8 {
 			shortID = shortID[:8]
 		}
-		fmt.Printf("%2d  %s  %s\n", i, shortID, commit.Timestamp.Format("2006-01-02 15:04:05"))
-		if commit.User != "" {
-			fmt.Printf("    User: %s\n", commit.User)
-		}
-		if commit.Message != "" {
-			fmt.Printf("    Message: %s\n", commit.Message)
-		}
+		message := commit.Message
 		if commit.IsRollback {
-			fmt.Printf("    (Rollback)\n")
+			message += " (rollback)"
 		}
-		fmt.Println()
+		fmt.Printf("%2d  %-8s  %-19s  %-16s  %-8s  %s\n", i, shortID,
+			commit.Timestamp.Format("2006-01-02 15:04:05"),
+			historyColumn(commit.User), historyColumn(commit.Source), strings.TrimSpace(message))
 	}
 
 	return nil
 }
+
+func historyColumn(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -9,6 +9,9 @@ import (
 
 // SetCommandWithPath executes a 'set' command with hierarchy path
 func (s *Session) SetCommandWithPath(ctx context.Context, args []string) error {
+	if err := s.authorize("set", args); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -41,6 +44,9 @@ func (s *Session) SetCommandWithPath(ctx context.Context, args []string) error {
 // DeleteCommandWithPath executes a 'delete' command with hierarchy path
 // Deletes all lines that match the prefix
 func (s *Session) DeleteCommandWithPath(ctx context.Context, args []string) error {
+	if err := s.authorize("delete", args); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -59,8 +65,17 @@ func (s *Session) DeleteCommandWithPath(ctx context.Context, args []string) erro
 		return fmt.Errorf("failed to get candidate: %w", err)
 	}
 
-	// Remove all lines matching the prefix
 	lines := strings.Split(candidate.ConfigText, "\n")
+	if fullPath := append(append([]string(nil), s.configPath...), args...); IsInterfaceAddressPath(fullPath) {
+		// Delete just this address, not every line under the family
+		remaining, err := DeleteInterfaceAddress(lines, fullPath)
+		if err != nil {
+			return err
+		}
+		return s.ds.SaveCandidate(ctx, s.id, strings.Join(remaining, "\n"))
+	}
+
+	// Remove all lines matching the prefix
 	var newLines []string
 	deletedCount := 0
 
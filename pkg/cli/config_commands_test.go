@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strings"
 	"testing"
 )
 
@@ -141,3 +142,34 @@ func TestDeleteCommandWithPath(t *testing.T) {
 		t.Errorf("DeleteCommandWithPath() error = %v", err)
 	}
 }
+
+func TestDeleteCommandWithPathRemovesSingleAddress(t *testing.T) {
+	ctx := context.Background()
+	ds := &mockDatastore{candidateText: strings.Join([]string{
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.168.1.1/24",
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.168.2.1/24",
+		"set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64",
+	}, "\n")}
+	session := NewSession("testuser", ds)
+	if err := session.EnterConfigurationMode(ctx); err != nil {
+		t.Fatalf("Failed to enter configuration mode: %v", err)
+	}
+
+	session.EditHierarchy([]string{"interfaces", "ge-0/0/0", "unit", "0"})
+	if err := session.DeleteCommandWithPath(ctx, []string{"family", "inet", "address", "192.168.1.1/24"}); err != nil {
+		t.Fatalf("DeleteCommandWithPath() error = %v", err)
+	}
+	want := "set interfaces ge-0/0/0 unit 0 family inet address 192.168.2.1/24\n" +
+		"set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64"
+	if ds.saveCandidateText != want {
+		t.Fatalf("saved candidate = %q, want %q", ds.saveCandidateText, want)
+	}
+
+	if err := session.DeleteCommandWithPath(ctx, []string{"family", "inet6", "address", "2001:DB8::1/64"}); err != nil {
+		t.Fatalf("DeleteCommandWithPath(upper-case IPv6) error = %v", err)
+	}
+	err := session.DeleteCommandWithPath(ctx, []string{"family", "inet", "address", "192.168.3.1/24"})
+	if err == nil || !strings.Contains(err.Error(), "address 192.168.3.1/24 is not configured") {
+		t.Fatalf("DeleteCommandWithPath(missing address) error = %v, want not configured", err)
+	}
+}
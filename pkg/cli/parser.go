@@ -3,6 +3,7 @@ package cli
 
 import (
 	"fmt"
+	"net/netip"
 	"strings"
 
 	pkgconfig "github.com/akam1o/arca-router/pkg/config"
@@ -56,8 +57,8 @@ func NormalizeConfigPath(path []string) string {
 	// Join with spaces, preserving quoted strings
 	var result []string
 	for _, token := range path {
-		// If token contains spaces and not already quoted, quote it
-		if strings.Contains(token, " ") && !strings.HasPrefix(token, "\"") {
+		// If token contains spaces or escapes and not already quoted, quote it
+		if strings.ContainsAny(token, " \\") && !strings.HasPrefix(token, "\"") {
 			result = append(result, fmt.Sprintf("\"%s\"", token))
 		} else {
 			result = append(result, token)
@@ -71,6 +72,8 @@ func NormalizeConfigPath(path []string) string {
 // Example: `set description "test interface"` -> ["set", "description", "test interface"], nil
 // Returns error if quotes are unmatched
 // Treats both spaces and tabs as whitespace delimiters
+// Backslash escapes inside quotes, such as \", are kept as written so the
+// configuration lexer can decode them
 func TokenizeCommand(line string) ([]string, error) {
 	var tokens []string
 	var current strings.Builder
@@ -80,6 +83,12 @@ func TokenizeCommand(line string) ([]string, error) {
 		char := line[i]
 
 		switch char {
+		case '\\':
+			current.WriteByte(char)
+			if inQuote && i+1 < len(line) {
+				i++
+				current.WriteByte(line[i])
+			}
 		case '"':
 			inQuote = !inQuote
 		case ' ', '\t': // Treat both space and tab as whitespace
@@ -105,6 +114,77 @@ func TokenizeCommand(line string) ([]string, error) {
 	return tokens, nil
 }
 
+// ExpandValueList tokenizes a command whose value is a bracketed list, such
+// as `set policy-options prefix-list PL [ 10.0.0.0/8 172.16.0.0/12 ]`, into
+// one token slice per value so each element becomes its own statement.
+// Brackets inside quotes are part of the value. A command without a list
+// yields its tokens unchanged.
+func ExpandValueList(line string) ([][]string, error) {
+	start, end := -1, -1
+	inQuote := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			if inQuote {
+				i++
+			}
+		case '"':
+			inQuote = !inQuote
+		case '[':
+			if inQuote {
+				continue
+			}
+			if start >= 0 {
+				return nil, fmt.Errorf("only one value list is allowed in a command")
+			}
+			start = i
+		case ']':
+			if inQuote {
+				continue
+			}
+			if start < 0 || end >= 0 {
+				return nil, fmt.Errorf("unmatched ']' in command")
+			}
+			end = i
+		}
+	}
+	if start < 0 {
+		tokens, err := TokenizeCommand(line)
+		if err != nil {
+			return nil, err
+		}
+		return [][]string{tokens}, nil
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("unmatched '[' in command")
+	}
+
+	head, err := TokenizeCommand(line[:start])
+	if err != nil {
+		return nil, err
+	}
+	values, err := TokenizeCommand(line[start+1 : end])
+	if err != nil {
+		return nil, err
+	}
+	tail, err := TokenizeCommand(line[end+1:])
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("empty value list in command")
+	}
+	expanded := make([][]string, 0, len(values))
+	for _, value := range values {
+		tokens := make([]string, 0, len(head)+1+len(tail))
+		tokens = append(tokens, head...)
+		tokens = append(tokens, value)
+		tokens = append(tokens, tail...)
+		expanded = append(expanded, tokens)
+	}
+	return expanded, nil
+}
+
 // MatchesPrefix checks if a config line matches a delete prefix
 // with token boundary checking to avoid over-deletion
 // Example: line="set interfaces ge-0/0/0 unit 0 family inet"
@@ -139,3 +219,193 @@ func MatchesPrefix(line, prefix string) bool {
 
 	return true
 }
+
+// interfaceAddressPathLen is the length of a configuration path that names
+// one interface family address:
+// interfaces <name> unit <n> family <family> address <address>.
+const interfaceAddressPathLen = 8
+
+// IsInterfaceAddressPath reports whether path names one address of an
+// interface family rather than a whole configuration node.
+func IsInterfaceAddressPath(path []string) bool {
+	return len(path) == interfaceAddressPathLen &&
+		path[0] == "interfaces" && path[2] == "unit" && path[4] == "family" && path[6] == "address"
+}
+
+// DeleteInterfaceAddress removes the set and deactivate statements of the
+// single interface family address named by path, which must satisfy
+// IsInterfaceAddressPath, and leaves the family's other addresses in place.
+// Addresses are compared by value, so 2001:DB8::1/64 deletes
+// 2001:db8::1/64. It returns an error when the address is not configured.
+func DeleteInterfaceAddress(lines []string, path []string) ([]string, error) {
+	if !IsInterfaceAddressPath(path) {
+		return nil, fmt.Errorf("%s: not an interface address", NormalizeConfigPath(path))
+	}
+	parent := path[:interfaceAddressPathLen-1]
+	target := path[interfaceAddressPathLen-1]
+
+	remaining := make([]string, 0, len(lines))
+	deleted := 0
+	for _, line := range lines {
+		tokens, err := TokenizeCommand(line)
+		if err == nil && len(tokens) > interfaceAddressPathLen &&
+			(tokens[0] == "set" || tokens[0] == "deactivate") &&
+			equalTokens(tokens[1:interfaceAddressPathLen], parent) &&
+			sameAddress(tokens[interfaceAddressPathLen], target) {
+			if tokens[0] == "set" {
+				deleted++
+			}
+			continue
+		}
+		remaining = append(remaining, line)
+	}
+	if deleted == 0 {
+		return nil, fmt.Errorf("address %s is not configured on %s unit %s family %s", target, path[1], path[3], path[5])
+	}
+	return remaining, nil
+}
+
+func equalTokens(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameAddress reports whether two CIDR addresses are equal by value,
+// falling back to a text comparison for values that do not parse.
+func sameAddress(a, b string) bool {
+	pa, errA := netip.ParsePrefix(a)
+	pb, errB := netip.ParsePrefix(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return pa == pb
+}
+
+// InsertCommand describes an "insert" command that moves an element of an
+// ordered list before or after another element of the same list.
+type InsertCommand struct {
+	// Element is the set-line prefix of the element being moved
+	Element string
+	// Anchor is the set-line prefix of the reference element, or of the
+	// whole list when List is set
+	Anchor string
+	// After places the element after the anchor instead of before it
+	After bool
+	// List is set for "first" and "last": the element is placed before
+	// the first or after the last other element of the list
+	List bool
+}
+
+// ParseInsertCommand parses an insert command with hierarchy context
+// Supported ordered lists are policy-statement terms and prefix-list entries:
+//
+//	insert policy-options policy-statement P term T3 before term T1
+//	insert policy-options prefix-list PL 10.0.0.0/8 after 192.0.2.0/24
+//	insert policy-options policy-statement P term T3 first
+//	insert policy-options prefix-list PL 10.0.0.0/8 last
+func ParseInsertCommand(args []string, basePath []string) (*InsertCommand, error) {
+	fullPath := make([]string, 0, len(basePath)+len(args))
+	fullPath = append(fullPath, basePath...)
+	fullPath = append(fullPath, args...)
+
+	split := -1
+	for i, token := range fullPath {
+		if token == "before" || token == "after" || token == "first" || token == "last" {
+			split = i
+			break
+		}
+	}
+	edge := split > 0 && (fullPath[split] == "first" || fullPath[split] == "last")
+	if split <= 0 || (edge && split != len(fullPath)-1) || (!edge && split == len(fullPath)-1) {
+		return nil, fmt.Errorf("usage: insert <path> ((before|after) <reference>|first|last)")
+	}
+	element := fullPath[:split]
+	reference := fullPath[split+1:]
+
+	var keyLen int
+	switch {
+	case len(element) == 5 && element[0] == "policy-options" && element[1] == "policy-statement" && element[3] == "term":
+		if !edge && (len(reference) != 2 || reference[0] != "term") {
+			return nil, fmt.Errorf("insert reference must be 'term <name>'")
+		}
+		keyLen = 2
+	case len(element) == 4 && element[0] == "policy-options" && element[1] == "prefix-list":
+		if !edge && len(reference) != 1 {
+			return nil, fmt.Errorf("insert reference must be a prefix")
+		}
+		keyLen = 1
+	default:
+		return nil, fmt.Errorf("insert is only supported for policy-statement terms and prefix-list entries")
+	}
+
+	if edge {
+		// Every element of the list shares the path up to its last token.
+		return &InsertCommand{
+			Element: "set " + NormalizeConfigPath(element),
+			Anchor:  "set " + NormalizeConfigPath(element[:len(element)-1]),
+			After:   fullPath[split] == "last",
+			List:    true,
+		}, nil
+	}
+
+	parent := element[:len(element)-keyLen]
+	anchor := append(append([]string(nil), parent...), reference...)
+	if NormalizeConfigPath(anchor) == NormalizeConfigPath(element) {
+		return nil, fmt.Errorf("cannot insert %s relative to itself", strings.Join(reference, " "))
+	}
+
+	return &InsertCommand{
+		Element: "set " + NormalizeConfigPath(element),
+		Anchor:  "set " + NormalizeConfigPath(anchor),
+		After:   fullPath[split] == "after",
+	}, nil
+}
+
+// ApplyInsert reorders candidate lines so that every line belonging to the
+// element is placed immediately before the first line of the anchor, or
+// immediately after its last line. Both element and anchor must exist,
+// except that "first" and "last" leave the only element of a list in place.
+func ApplyInsert(lines []string, cmd *InsertCommand) ([]string, error) {
+	var moved, rest []string
+	for _, line := range lines {
+		if MatchesPrefix(line, cmd.Element) {
+			moved = append(moved, line)
+		} else {
+			rest = append(rest, line)
+		}
+	}
+	if len(moved) == 0 {
+		return nil, fmt.Errorf("%s: element does not exist", strings.TrimPrefix(cmd.Element, "set "))
+	}
+
+	position := -1
+	for i, line := range rest {
+		if !MatchesPrefix(line, cmd.Anchor) {
+			continue
+		}
+		if !cmd.After {
+			position = i
+			break
+		}
+		position = i + 1
+	}
+	if position < 0 && cmd.List {
+		return lines, nil
+	}
+	if position < 0 {
+		return nil, fmt.Errorf("%s: reference element does not exist", strings.TrimPrefix(cmd.Anchor, "set "))
+	}
+
+	result := make([]string, 0, len(lines))
+	result = append(result, rest[:position]...)
+	result = append(result, moved...)
+	result = append(result, rest[position:]...)
+	return result, nil
+}
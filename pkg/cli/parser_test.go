@@ -2,6 +2,7 @@ package cli
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -176,6 +177,12 @@ func TestTokenizeCommand(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "escaped quotes kept for the lexer",
+			line:    `set description "a \"quoted\" word"`,
+			want:    []string{"set", "description", `a \"quoted\" word`},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -346,6 +353,57 @@ func TestParseDeleteCommandEdgeCases(t *testing.T) {
 	}
 }
 
+func TestExpandValueList(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name: "no list",
+			line: "set policy-options prefix-list PL 10.0.0.0/8",
+			want: [][]string{{"set", "policy-options", "prefix-list", "PL", "10.0.0.0/8"}},
+		},
+		{
+			name: "spaced list",
+			line: "set policy-options prefix-list PL [ 10.0.0.0/8 172.16.0.0/12 ]",
+			want: [][]string{
+				{"set", "policy-options", "prefix-list", "PL", "10.0.0.0/8"},
+				{"set", "policy-options", "prefix-list", "PL", "172.16.0.0/12"},
+			},
+		},
+		{
+			name: "list without inner spaces",
+			line: "delete routing-instances RED vrf-import [A B]",
+			want: [][]string{
+				{"delete", "routing-instances", "RED", "vrf-import", "A"},
+				{"delete", "routing-instances", "RED", "vrf-import", "B"},
+			},
+		},
+		{
+			name: "quoted brackets are a value",
+			line: `set interfaces ge-0/0/0 description "[core] uplink"`,
+			want: [][]string{{"set", "interfaces", "ge-0/0/0", "description", "[core] uplink"}},
+		},
+		{name: "unterminated list", line: "set policy-options prefix-list PL [ 10.0.0.0/8", wantErr: true},
+		{name: "unmatched close", line: "set policy-options prefix-list PL 10.0.0.0/8 ]", wantErr: true},
+		{name: "empty list", line: "set policy-options prefix-list PL [ ]", wantErr: true},
+		{name: "two lists", line: "set a [ b ] [ c ]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandValueList(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExpandValueList(%q) error = %v, wantErr %v", tt.line, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ExpandValueList(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTokenizeCommandEdgeCases(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -606,3 +664,174 @@ func TestMatchesPrefixEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInsertCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		basePath []string
+		want     *InsertCommand
+		wantErr  string
+	}{
+		{
+			name: "policy term before",
+			args: []string{"policy-options", "policy-statement", "EXPORT", "term", "T3", "before", "term", "T1"},
+			want: &InsertCommand{
+				Element: "set policy-options policy-statement EXPORT term T3",
+				Anchor:  "set policy-options policy-statement EXPORT term T1",
+			},
+		},
+		{
+			name:     "prefix-list entry after with base path",
+			args:     []string{"10.0.0.0/8", "after", "192.0.2.0/24"},
+			basePath: []string{"policy-options", "prefix-list", "PL"},
+			want: &InsertCommand{
+				Element: "set policy-options prefix-list PL 10.0.0.0/8",
+				Anchor:  "set policy-options prefix-list PL 192.0.2.0/24",
+				After:   true,
+			},
+		},
+		{
+			name: "policy term first",
+			args: []string{"policy-options", "policy-statement", "EXPORT", "term", "T3", "first"},
+			want: &InsertCommand{
+				Element: "set policy-options policy-statement EXPORT term T3",
+				Anchor:  "set policy-options policy-statement EXPORT term",
+				List:    true,
+			},
+		},
+		{
+			name:     "prefix-list entry last with base path",
+			args:     []string{"10.0.0.0/8", "last"},
+			basePath: []string{"policy-options", "prefix-list", "PL"},
+			want: &InsertCommand{
+				Element: "set policy-options prefix-list PL 10.0.0.0/8",
+				Anchor:  "set policy-options prefix-list PL",
+				After:   true,
+				List:    true,
+			},
+		},
+		{
+			name:    "first with a reference",
+			args:    []string{"policy-options", "policy-statement", "EXPORT", "term", "T3", "first", "term", "T1"},
+			wantErr: "usage",
+		},
+		{
+			name:    "missing reference",
+			args:    []string{"policy-options", "policy-statement", "EXPORT", "term", "T3", "before"},
+			wantErr: "usage",
+		},
+		{
+			name:    "reference is not a term",
+			args:    []string{"policy-options", "policy-statement", "EXPORT", "term", "T3", "after", "T1"},
+			wantErr: "term <name>",
+		},
+		{
+			name:    "unordered hierarchy",
+			args:    []string{"interfaces", "ge-0/0/1", "before", "ge-0/0/0"},
+			wantErr: "only supported",
+		},
+		{
+			name:    "relative to itself",
+			args:    []string{"policy-options", "policy-statement", "EXPORT", "term", "T1", "after", "term", "T1"},
+			wantErr: "itself",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInsertCommand(tt.args, tt.basePath)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseInsertCommand() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseInsertCommand() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseInsertCommand() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyInsert(t *testing.T) {
+	lines := []string{
+		"set system host-name r1",
+		"set policy-options policy-statement P term T1 from protocol bgp",
+		"set policy-options policy-statement P term T1 then accept",
+		"set policy-options policy-statement P term T2 then accept",
+		"set policy-options policy-statement P term T3 from protocol static",
+		"set policy-options policy-statement P term T3 then reject",
+	}
+	terms := func(t *testing.T, got []string) []string {
+		t.Helper()
+		var order []string
+		for _, line := range got {
+			parts := strings.Fields(line)
+			if len(parts) > 5 && parts[1] == "policy-options" {
+				if len(order) == 0 || order[len(order)-1] != parts[5] {
+					order = append(order, parts[5])
+				}
+			}
+		}
+		return order
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "before first", args: []string{"term", "T3", "before", "term", "T1"}, want: []string{"T3", "T1", "T2"}},
+		{name: "after last", args: []string{"term", "T1", "after", "term", "T3"}, want: []string{"T2", "T3", "T1"}},
+		{name: "before middle", args: []string{"term", "T3", "before", "term", "T2"}, want: []string{"T1", "T3", "T2"}},
+		{name: "after first", args: []string{"term", "T3", "after", "term", "T1"}, want: []string{"T1", "T3", "T2"}},
+		{name: "first", args: []string{"term", "T2", "first"}, want: []string{"T2", "T1", "T3"}},
+		{name: "last", args: []string{"term", "T2", "last"}, want: []string{"T1", "T3", "T2"}},
+		{name: "first already first", args: []string{"term", "T1", "first"}, want: []string{"T1", "T2", "T3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := ParseInsertCommand(tt.args, []string{"policy-options", "policy-statement", "P"})
+			if err != nil {
+				t.Fatalf("ParseInsertCommand() error = %v", err)
+			}
+			got, err := ApplyInsert(append([]string(nil), lines...), cmd)
+			if err != nil {
+				t.Fatalf("ApplyInsert() error = %v", err)
+			}
+			if len(got) != len(lines) || got[0] != lines[0] {
+				t.Fatalf("ApplyInsert() = %#v, want all lines kept with unrelated lines in place", got)
+			}
+			if order := terms(t, got); !reflect.DeepEqual(order, tt.want) {
+				t.Fatalf("term order = %v, want %v", order, tt.want)
+			}
+		})
+	}
+
+	only := []string{"set policy-options policy-statement Q term T1 then accept"}
+	cmd, err := ParseInsertCommand([]string{"term", "T1", "last"}, []string{"policy-options", "policy-statement", "Q"})
+	if err != nil {
+		t.Fatalf("ParseInsertCommand(last) error = %v", err)
+	}
+	if got, err := ApplyInsert(only, cmd); err != nil || !reflect.DeepEqual(got, only) {
+		t.Fatalf("ApplyInsert(only term last) = %#v, %v; want unchanged", got, err)
+	}
+
+	for _, args := range [][]string{
+		{"term", "T9", "before", "term", "T1"},
+		{"term", "T1", "before", "term", "T9"},
+		{"term", "T9", "first"},
+	} {
+		cmd, err := ParseInsertCommand(args, []string{"policy-options", "policy-statement", "P"})
+		if err != nil {
+			t.Fatalf("ParseInsertCommand(%v) error = %v", args, err)
+		}
+		if _, err := ApplyInsert(append([]string(nil), lines...), cmd); err == nil || !strings.Contains(err.Error(), "does not exist") {
+			t.Fatalf("ApplyInsert(%v) error = %v, want missing element error", args, err)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PatchLine is one statement of a configuration patch: the set-format diff
+// printed by "show | compare", where "+ " adds a statement to the candidate
+// and "- " removes it.
+type PatchLine struct {
+	// Remove is true for "- " lines
+	Remove bool
+	// Statement is the normalized "set" or "deactivate" statement
+	Statement string
+}
+
+// Path returns the configuration path of the patch statement without its
+// leading keyword.
+func (l PatchLine) Path() []string {
+	tokens, err := TokenizeCommand(l.Statement)
+	if err != nil || len(tokens) == 0 {
+		return nil
+	}
+	return tokens[1:]
+}
+
+func (l PatchLine) String() string {
+	if l.Remove {
+		return "- " + l.Statement
+	}
+	return "+ " + l.Statement
+}
+
+// ParsePatch parses the set-format diff printed by "show | compare". Blank
+// lines and lines starting with "#" are ignored. A removal may also be
+// written as "- delete <path>", which is the same as "- set <path>".
+func ParsePatch(text string) ([]PatchLine, error) {
+	var patch []PatchLine
+	for i, raw := range strings.Split(text, "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var remove bool
+		switch {
+		case strings.HasPrefix(line, "+ "):
+		case strings.HasPrefix(line, "- "):
+			remove = true
+		default:
+			return nil, fmt.Errorf("line %d: expected '+ ' or '- ' prefix: %s", i+1, line)
+		}
+		tokens, err := TokenizeCommand(line[2:])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: statement requires a configuration path: %s", i+1, line)
+		}
+		keyword := tokens[0]
+		switch keyword {
+		case "set", "deactivate":
+		case "delete":
+			if !remove {
+				return nil, fmt.Errorf("line %d: 'delete' is only valid in removed lines: %s", i+1, line)
+			}
+			keyword = "set"
+		default:
+			return nil, fmt.Errorf("line %d: unsupported statement '%s'", i+1, tokens[0])
+		}
+		patch = append(patch, PatchLine{
+			Remove:    remove,
+			Statement: keyword + " " + NormalizeConfigPath(tokens[1:]),
+		})
+	}
+	return patch, nil
+}
+
+// ApplyPatch applies patch to the candidate configuration text and returns
+// the patched text. Removed statements must be present in the candidate;
+// added statements that are already present are left alone. When any
+// removed statement is missing, for example because the candidate changed
+// since the diff was taken, nothing is applied and every conflict is
+// reported.
+func ApplyPatch(candidate string, patch []PatchLine) (string, error) {
+	var lines []string
+	for _, line := range strings.Split(candidate, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	present := make(map[string]int, len(lines))
+	for i, line := range lines {
+		present[normalizePatchStatement(line)] = i
+	}
+
+	removed := make(map[int]bool)
+	var conflicts []string
+	for _, line := range patch {
+		if !line.Remove {
+			continue
+		}
+		i, ok := present[line.Statement]
+		if !ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s: statement does not exist", line.Statement))
+			continue
+		}
+		removed[i] = true
+	}
+	if len(conflicts) > 0 {
+		return "", fmt.Errorf("patch does not apply:\n  %s", strings.Join(conflicts, "\n  "))
+	}
+
+	result := make([]string, 0, len(lines)+len(patch))
+	for i, line := range lines {
+		if !removed[i] {
+			result = append(result, line)
+		}
+	}
+	for _, line := range patch {
+		if line.Remove {
+			continue
+		}
+		if i, ok := present[line.Statement]; ok && !removed[i] {
+			continue
+		}
+		present[line.Statement] = -1
+		result = append(result, line.Statement)
+	}
+	if len(result) == 0 {
+		return "", nil
+	}
+	return strings.Join(result, "\n") + "\n", nil
+}
+
+// normalizePatchStatement normalizes a candidate line the same way ParsePatch
+// normalizes patch statements so the two compare equal.
+func normalizePatchStatement(line string) string {
+	tokens, err := TokenizeCommand(line)
+	if err != nil || len(tokens) == 0 {
+		return line
+	}
+	return tokens[0] + " " + NormalizeConfigPath(tokens[1:])
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParsePatch(t *testing.T) {
+	patch, err := ParsePatch(`# saved from show | compare
++ set system host-name router2
+-   set system host-name router1
+
+- delete interfaces ge-0/0/0 description "uplink port"
++ deactivate protocols ospf
+`)
+	if err != nil {
+		t.Fatalf("ParsePatch() error = %v", err)
+	}
+	want := []string{
+		"+ set system host-name router2",
+		"- set system host-name router1",
+		`- set interfaces ge-0/0/0 description "uplink port"`,
+		"+ deactivate protocols ospf",
+	}
+	if len(patch) != len(want) {
+		t.Fatalf("ParsePatch() = %v, want %v", patch, want)
+	}
+	for i := range want {
+		if patch[i].String() != want[i] {
+			t.Errorf("patch[%d] = %q, want %q", i, patch[i].String(), want[i])
+		}
+	}
+
+	for _, bad := range []string{
+		"set system host-name router2",
+		"+ delete system host-name",
+		"+ edit system",
+		"- set",
+		`+ set system host-name "router2`,
+	} {
+		if _, err := ParsePatch(bad); err == nil {
+			t.Errorf("ParsePatch(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	candidate := "set system host-name router1\nset interfaces ge-0/0/0 description uplink\n"
+	patch, err := ParsePatch("+ set system host-name router2\n- set system host-name router1\n+ set interfaces ge-0/0/0 description uplink\n")
+	if err != nil {
+		t.Fatalf("ParsePatch() error = %v", err)
+	}
+	got, err := ApplyPatch(candidate, patch)
+	if err != nil {
+		t.Fatalf("ApplyPatch() error = %v", err)
+	}
+	if want := "set interfaces ge-0/0/0 description uplink\nset system host-name router2\n"; got != want {
+		t.Fatalf("ApplyPatch() = %q, want %q", got, want)
+	}
+}
+
+func TestApplyPatchReportsConflicts(t *testing.T) {
+	candidate := "set system host-name router3\n"
+	patch, err := ParsePatch("- set system host-name router1\n- set protocols ospf area 0.0.0.0 interface ge-0/0/0\n+ set system host-name router2\n")
+	if err != nil {
+		t.Fatalf("ParsePatch() error = %v", err)
+	}
+	_, err = ApplyPatch(candidate, patch)
+	if err == nil {
+		t.Fatal("ApplyPatch() error = nil, want conflicts")
+	}
+	for _, want := range []string{
+		"set system host-name router1: statement does not exist",
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/0: statement does not exist",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("ApplyPatch() error = %v, want %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
+)
+
+// PatternChange is one candidate statement rewritten by ReplacePattern.
+type PatternChange struct {
+	Old string
+	New string
+}
+
+// ReplacePattern replaces every match of the regular expression pattern
+// with replacement in the candidate values that are safe to rename:
+// descriptions, policy-statement names, and the policy references of BGP
+// group import/export and routing-instance vrf-import/vrf-export. Other
+// values, such as addresses and interface names, are never changed.
+// replacement may refer to submatches as in regexp.Regexp.ReplaceAllString.
+//
+// It returns the rewritten candidate text and the changed statements. The
+// replacement is rejected when the result no longer parses or fails
+// validation checks that the original candidate passed, for example when a
+// policy reference is renamed without the policy-statement it refers to.
+func ReplacePattern(candidate, pattern, replacement string) (string, []PatternChange, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	lines := strings.Split(candidate, "\n")
+	var changes []PatternChange
+	for i, line := range lines {
+		tokens, err := TokenizeCommand(line)
+		if err != nil || len(tokens) < 2 || (tokens[0] != "set" && tokens[0] != "deactivate") {
+			continue
+		}
+		path := tokens[1:]
+		changed := false
+		for _, idx := range replaceableValueIndexes(path) {
+			value := re.ReplaceAllString(path[idx], replacement)
+			if value == path[idx] {
+				continue
+			}
+			if strings.TrimSpace(value) == "" {
+				return "", nil, fmt.Errorf("%s: replacement leaves an empty value", strings.TrimSpace(line))
+			}
+			path[idx] = value
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		statement := tokens[0] + " " + NormalizeConfigPath(path)
+		changes = append(changes, PatternChange{Old: strings.TrimSpace(line), New: statement})
+		lines[i] = statement
+	}
+	if len(changes) == 0 {
+		return candidate, nil, nil
+	}
+
+	result := strings.Join(lines, "\n")
+	if err := checkReplacementValid(candidate, result); err != nil {
+		return "", nil, err
+	}
+	return result, changes, nil
+}
+
+// replaceableValueIndexes returns the indexes of the path tokens that
+// ReplacePattern may rewrite.
+func replaceableValueIndexes(path []string) []int {
+	var indexes []int
+	n := len(path)
+	if n >= 3 && path[0] == "policy-options" && path[1] == "policy-statement" {
+		indexes = append(indexes, 2)
+	}
+	if n >= 2 {
+		switch path[n-2] {
+		case "description", "vrf-import", "vrf-export":
+			indexes = append(indexes, n-1)
+		case "import", "export":
+			if n >= 5 && path[n-4] == "group" && path[n-5] == "bgp" {
+				indexes = append(indexes, n-1)
+			}
+		}
+	}
+	return indexes
+}
+
+// checkReplacementValid rejects a replaced candidate that no longer parses
+// or has validation errors the original candidate did not have.
+func checkReplacementValid(original, replaced string) error {
+	originalCfg, err := pkgconfig.NewParser(strings.NewReader(original)).Parse()
+	if err != nil {
+		// The candidate is already unparseable; nothing to compare against.
+		return nil
+	}
+	replacedCfg, err := pkgconfig.NewParser(strings.NewReader(replaced)).Parse()
+	if err != nil {
+		return fmt.Errorf("replacement produces an invalid configuration: %w", err)
+	}
+
+	existing := make(map[string]bool)
+	for _, issue := range originalCfg.ValidateAll().Errors() {
+		existing[issue.String()] = true
+	}
+	var introduced []string
+	for _, issue := range replacedCfg.ValidateAll().Errors() {
+		if !existing[issue.String()] {
+			introduced = append(introduced, issue.String())
+		}
+	}
+	if len(introduced) > 0 {
+		return fmt.Errorf("replacement produces an invalid configuration:\n  %s", strings.Join(introduced, "\n  "))
+	}
+	return nil
+}
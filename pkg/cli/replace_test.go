@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+const replaceTestCandidate = `set routing-options autonomous-system 65000
+set policy-options policy-statement EXPORT-OLD term ALL then accept
+set protocols bgp group UPSTREAM type external
+set protocols bgp group UPSTREAM export EXPORT-OLD
+set protocols bgp group UPSTREAM neighbor 192.0.2.1 peer-as 65001
+set protocols bgp group PEERS type external
+set protocols bgp group PEERS export EXPORT-OLD
+set protocols bgp group PEERS neighbor 198.51.100.1 peer-as 65002
+set interfaces ge-0/0/0 description "uplink EXPORT-OLD"
+`
+
+func TestReplacePatternRenamesPolicyAcrossBGPGroups(t *testing.T) {
+	got, changes, err := ReplacePattern(replaceTestCandidate, "EXPORT-OLD", "EXPORT-NEW")
+	if err != nil {
+		t.Fatalf("ReplacePattern() error = %v", err)
+	}
+	wantChanges := []PatternChange{
+		{Old: "set policy-options policy-statement EXPORT-OLD term ALL then accept", New: "set policy-options policy-statement EXPORT-NEW term ALL then accept"},
+		{Old: "set protocols bgp group UPSTREAM export EXPORT-OLD", New: "set protocols bgp group UPSTREAM export EXPORT-NEW"},
+		{Old: "set protocols bgp group PEERS export EXPORT-OLD", New: "set protocols bgp group PEERS export EXPORT-NEW"},
+		{Old: `set interfaces ge-0/0/0 description "uplink EXPORT-OLD"`, New: `set interfaces ge-0/0/0 description "uplink EXPORT-NEW"`},
+	}
+	if len(changes) != len(wantChanges) {
+		t.Fatalf("ReplacePattern() changes = %#v, want %#v", changes, wantChanges)
+	}
+	for i := range wantChanges {
+		if changes[i] != wantChanges[i] {
+			t.Errorf("changes[%d] = %#v, want %#v", i, changes[i], wantChanges[i])
+		}
+	}
+	if strings.Contains(got, "EXPORT-OLD") {
+		t.Fatalf("ReplacePattern() left EXPORT-OLD in candidate:\n%s", got)
+	}
+}
+
+func TestReplacePatternOnlyTouchesSafeValues(t *testing.T) {
+	candidate := "set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24\nset interfaces ge-0/0/0 description core\n"
+	got, changes, err := ReplacePattern(candidate, `192\.0\.2`, "198.51.100")
+	if err != nil {
+		t.Fatalf("ReplacePattern() error = %v", err)
+	}
+	if len(changes) != 0 || got != candidate {
+		t.Fatalf("ReplacePattern() = %q, %#v; want address left alone", got, changes)
+	}
+
+	_, changes, err = ReplacePattern(candidate, `^(c)ore$`, "${1}ore-uplink")
+	if err != nil {
+		t.Fatalf("ReplacePattern() error = %v", err)
+	}
+	if len(changes) != 1 || changes[0].New != "set interfaces ge-0/0/0 description core-uplink" {
+		t.Fatalf("ReplacePattern() changes = %#v, want description rewritten with submatch", changes)
+	}
+}
+
+func TestReplacePatternRejectsInvalidResults(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		pattern     string
+		replacement string
+		wantErr     string
+	}{
+		{name: "bad regexp", pattern: "EXPORT-(", replacement: "X", wantErr: "invalid pattern"},
+		{name: "empty value", pattern: ".*", replacement: "", wantErr: "replacement leaves an empty value"},
+		{name: "invalid value", pattern: "^uplink", replacement: strings.Repeat("x", 300), wantErr: "replacement produces an invalid configuration"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ReplacePattern(replaceTestCandidate, tt.pattern, tt.replacement)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ReplacePattern() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	internalauth "github.com/akam1o/arca-router/internal/auth"
 	pkgconfig "github.com/akam1o/arca-router/pkg/config"
 	"github.com/akam1o/arca-router/pkg/datastore"
 	"github.com/google/uuid"
@@ -36,6 +37,7 @@ func (m Mode) String() string {
 type Session struct {
 	id           string
 	username     string
+	role         string
 	mode         Mode
 	ds           datastore.Datastore
 	lockAcquired bool
@@ -44,11 +46,18 @@ type Session struct {
 	configPath   []string
 }
 
-// NewSession creates a new CLI session
+// NewSession creates a new CLI session with the admin role
 func NewSession(username string, ds datastore.Datastore) *Session {
+	return NewSessionWithRole(username, internalauth.RoleAdmin, ds)
+}
+
+// NewSessionWithRole creates a new CLI session whose commands are limited to
+// role (admin, operator, or read-only)
+func NewSessionWithRole(username, role string, ds datastore.Datastore) *Session {
 	return &Session{
 		id:           uuid.New().String(),
 		username:     username,
+		role:         role,
 		mode:         ModeOperational,
 		ds:           ds,
 		lockAcquired: false,
@@ -60,6 +69,7 @@ func NewSession(username string, ds datastore.Datastore) *Session {
 
 func (s *Session) ID() string           { return s.id }
 func (s *Session) Username() string     { return s.username }
+func (s *Session) Role() string         { return s.role }
 func (s *Session) Mode() Mode           { return s.mode }
 func (s *Session) ConfigPath() []string { return s.configPath }
 
@@ -183,6 +193,9 @@ func (s *Session) verifyLock(ctx context.Context) error {
 
 // EnterConfigurationMode enters configuration mode
 func (s *Session) EnterConfigurationMode(ctx context.Context) error {
+	if err := s.authorize("configure", nil); err != nil {
+		return err
+	}
 	if s.mode == ModeConfiguration {
 		return fmt.Errorf("already in configuration mode")
 	}
@@ -234,6 +247,9 @@ func (s *Session) ExitConfigurationMode(ctx context.Context) error {
 // SetCommand executes a 'set' command
 // Deprecated: Use SetCommandWithPath instead for better hierarchy support
 func (s *Session) SetCommand(ctx context.Context, args []string) error {
+	if err := AuthorizeCommand(s.role, "set", args); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -267,6 +283,9 @@ func (s *Session) SetCommand(ctx context.Context, args []string) error {
 // DeleteCommand executes a 'delete' command
 // Deprecated: Use DeleteCommandWithPath instead for proper token-boundary checking
 func (s *Session) DeleteCommand(ctx context.Context, args []string) error {
+	if err := AuthorizeCommand(s.role, "delete", args); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -332,6 +351,9 @@ func (s *Session) CompareCommand(ctx context.Context) (string, error) {
 // CommitCommand commits candidate to running
 // Deprecated: Use CommitWithOptions instead for better control and consistency
 func (s *Session) CommitCommand(ctx context.Context) error {
+	if err := s.authorize("commit", nil); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -344,7 +366,7 @@ func (s *Session) CommitCommand(ctx context.Context) error {
 	req := &datastore.CommitRequest{
 		SessionID: s.id,
 		User:      s.username,
-		Message:   "CLI commit",
+		Source:    datastore.CommitSourceCLI,
 		SourceIP:  "local",
 	}
 	commitID, err := s.ds.Commit(ctx, req)
@@ -373,6 +395,18 @@ func (s *Session) CommitCheckCommand(ctx context.Context) error {
 		return fmt.Errorf("candidate configuration is empty")
 	}
 
+	cfg, err := pkgconfig.NewParser(strings.NewReader(candidate.ConfigText)).Parse()
+	if err != nil {
+		return fmt.Errorf("configuration check failed: %w", err)
+	}
+	result := cfg.ValidateAll()
+	for _, issue := range result.Issues {
+		fmt.Println(issue)
+	}
+	if errs := result.Errors(); len(errs) > 0 {
+		return fmt.Errorf("configuration check failed: %d error(s)", len(errs))
+	}
+
 	fmt.Println("configuration check succeeds")
 	return nil
 }
@@ -380,6 +414,9 @@ func (s *Session) CommitCheckCommand(ctx context.Context) error {
 // RollbackCommand rolls back to a previous commit
 // Deprecated: Use RollbackWithNumber instead for better consistency
 func (s *Session) RollbackCommand(ctx context.Context, rollbackNum int) error {
+	if err := s.authorize("rollback", nil); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
@@ -415,6 +452,7 @@ func (s *Session) RollbackCommand(ctx context.Context, rollbackNum int) error {
 		CommitID:  history[rollbackNum].CommitID,
 		User:      s.username,
 		Message:   fmt.Sprintf("Rollback %d", rollbackNum),
+		Source:    datastore.CommitSourceCLI,
 		SourceIP:  "local",
 	}
 	newCommitID, err := s.ds.Rollback(ctx, req)
@@ -429,6 +467,9 @@ func (s *Session) RollbackCommand(ctx context.Context, rollbackNum int) error {
 
 // DiscardChanges discards candidate changes
 func (s *Session) DiscardChanges(ctx context.Context) error {
+	if err := s.authorize("discard-changes", nil); err != nil {
+		return err
+	}
 	if s.mode != ModeConfiguration {
 		return fmt.Errorf("not in configuration mode")
 	}
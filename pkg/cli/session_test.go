@@ -17,6 +17,7 @@ type mockDatastore struct {
 	acquireLockErr    error
 	releaseLockErr    error
 	getCandidateErr   error
+	candidateText     string
 	saveCandidateText string
 	saveCandidateErr  error
 	history           []*datastore.CommitHistoryEntry
@@ -34,9 +35,13 @@ func (m *mockDatastore) GetCandidate(ctx context.Context, sessionID string) (*da
 	if m.getCandidateErr != nil {
 		return nil, m.getCandidateErr
 	}
+	configText := "set system host-name test-router"
+	if m.candidateText != "" {
+		configText = m.candidateText
+	}
 	return &datastore.CandidateConfig{
 		SessionID:  sessionID,
-		ConfigText: "set system host-name test-router",
+		ConfigText: configText,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 	}, nil
@@ -0,0 +1,71 @@
+package commitscript
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+// NoteAppender records post-commit script output on a stored commit. It is
+// implemented by datastores that keep commit notes.
+type NoteAppender interface {
+	AppendCommitNote(ctx context.Context, commitID, note string) error
+}
+
+// AbortError is returned by RunPreCommit when a pre-commit script fails.
+type AbortError struct {
+	// Record is the formatted output of the scripts that ran.
+	Record string
+	Err    error
+}
+
+func (e *AbortError) Error() string {
+	return "commit aborted: " + e.Record
+}
+
+func (e *AbortError) Unwrap() error {
+	return e.Err
+}
+
+// RunPreCommit runs the pre-commit scripts for the commit described by req
+// and returns the commit message with their output appended. An empty
+// message is replaced with the default message for req.Source and req.User.
+// Without scripts, message is returned unchanged. A failing script aborts
+// the commit with an *AbortError.
+func (r *Runner) RunPreCommit(ctx context.Context, req Request, scripts []string, message string) (string, error) {
+	if len(scripts) == 0 {
+		return message, nil
+	}
+	req.Stage = StagePre
+	results, err := r.Run(ctx, req, scripts)
+	record := Record(StagePre, results)
+	if err != nil {
+		return "", &AbortError{Record: record, Err: err}
+	}
+	if message == "" {
+		message = datastore.DefaultCommitMessage(req.Source, req.User)
+	}
+	return message + "\n" + record, nil
+}
+
+// RunPostCommit runs the post-commit scripts for the commit described by req
+// and appends their output and exit status to the commit record through
+// notes, when notes is non-nil and commitID is set. The commit has already
+// succeeded, so script failures are only reported in the returned record;
+// the error is that of recording it.
+func (r *Runner) RunPostCommit(ctx context.Context, req Request, scripts []string, notes NoteAppender, commitID string) (string, error) {
+	if len(scripts) == 0 {
+		return "", nil
+	}
+	req.Stage = StagePost
+	results, _ := r.Run(ctx, req, scripts)
+	record := Record(StagePost, results)
+	if notes == nil || commitID == "" {
+		return record, nil
+	}
+	if err := notes.AppendCommitNote(ctx, commitID, record); err != nil {
+		return record, fmt.Errorf("record post-commit script output on commit %s: %w", commitID, err)
+	}
+	return record, nil
+}
@@ -0,0 +1,218 @@
+// Package commitscript runs operator-supplied pre- and post-commit scripts
+// configured with "set system scripts commit pre|post <path>".
+//
+// Scripts run with the daemon's privileges, so only executables inside an
+// allowlisted directory are accepted. Each script receives the candidate
+// configuration in set-command format on stdin and the commit context in
+// ARCA_COMMIT_* environment variables.
+package commitscript
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultDir is the directory commit scripts must live in.
+	DefaultDir = "/etc/arca-router/scripts"
+
+	// DefaultTimeout bounds the run time of each script.
+	DefaultTimeout = 30 * time.Second
+
+	// maxOutputBytes caps the combined stdout/stderr kept per script.
+	maxOutputBytes = 4096
+
+	scriptPath = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+)
+
+// Stage identifies when a script runs relative to the commit.
+type Stage string
+
+// Commit script stages.
+const (
+	StagePre  Stage = "pre"
+	StagePost Stage = "post"
+)
+
+// Request describes the commit a script runs for.
+type Request struct {
+	Stage      Stage
+	User       string
+	Source     string
+	ConfigText string
+}
+
+// Result is the outcome of one script.
+type Result struct {
+	Path   string
+	Output string
+	Err    error
+}
+
+// Runner executes commit scripts from an allowlisted directory.
+type Runner struct {
+	// Dir is the allowlisted script directory.
+	Dir string
+	// Timeout bounds each script; zero uses DefaultTimeout.
+	Timeout time.Duration
+}
+
+// NewRunner returns a runner using DefaultDir and DefaultTimeout.
+func NewRunner() *Runner {
+	return &Runner{Dir: DefaultDir, Timeout: DefaultTimeout}
+}
+
+// Run executes scripts in order. In the pre stage the first failing script
+// stops the run and its error is returned, which aborts the commit. In the
+// post stage every script runs and failures are only reported in the results.
+func (r *Runner) Run(ctx context.Context, req Request, scripts []string) ([]Result, error) {
+	results := make([]Result, 0, len(scripts))
+	for _, path := range scripts {
+		result := r.runOne(ctx, req, path)
+		results = append(results, result)
+		if result.Err != nil && req.Stage == StagePre {
+			return results, fmt.Errorf("%s-commit script %s failed: %w", req.Stage, path, result.Err)
+		}
+	}
+	return results, nil
+}
+
+func (r *Runner) runOne(ctx context.Context, req Request, path string) Result {
+	result := Result{Path: path}
+	resolved, err := r.resolve(path)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	timeout := r.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	output := &limitedBuffer{limit: maxOutputBytes}
+	cmd := exec.CommandContext(runCtx, resolved)
+	cmd.Dir = filepath.Dir(resolved)
+	cmd.Env = []string{
+		scriptPath,
+		"ARCA_COMMIT_STAGE=" + string(req.Stage),
+		"ARCA_COMMIT_USER=" + req.User,
+		"ARCA_COMMIT_SOURCE=" + req.Source,
+	}
+	cmd.Stdin = strings.NewReader(req.ConfigText)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	cmd.WaitDelay = time.Second
+
+	err = cmd.Run()
+	result.Output = output.String()
+	switch {
+	case runCtx.Err() == context.DeadlineExceeded:
+		result.Err = fmt.Errorf("timed out after %s", timeout)
+	case err != nil:
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			result.Err = fmt.Errorf("exit status %d", exitErr.ExitCode())
+		} else {
+			result.Err = err
+		}
+	}
+	return result
+}
+
+// resolve checks that path names an executable regular file inside the
+// allowlisted directory after following symlinks, and that the file is not
+// writable by group or others.
+func (r *Runner) resolve(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("script path %q is not absolute", path)
+	}
+	dir := r.Dir
+	if dir == "" {
+		dir = DefaultDir
+	}
+	allowed, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("script directory %s: %w", dir, err)
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", fmt.Errorf("script %s: %w", path, err)
+	}
+	rel, err := filepath.Rel(allowed, resolved)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("script %s is outside the allowed directory %s", path, dir)
+	}
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return "", fmt.Errorf("script %s: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("script %s is not a regular file", path)
+	}
+	if info.Mode().Perm()&0o111 == 0 {
+		return "", fmt.Errorf("script %s is not executable", path)
+	}
+	if info.Mode().Perm()&0o022 != 0 {
+		return "", fmt.Errorf("script %s is writable by group or others", path)
+	}
+	return resolved, nil
+}
+
+// Record formats script results for a commit record, one header line per
+// script followed by its captured output.
+func Record(stage Stage, results []Result) string {
+	var b strings.Builder
+	for _, result := range results {
+		status := "ok"
+		if result.Err != nil {
+			status = result.Err.Error()
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s-commit script %s: %s", stage, result.Path, status)
+		if output := strings.TrimRight(result.Output, "\n"); output != "" {
+			b.WriteString("\n")
+			b.WriteString(output)
+		}
+	}
+	return b.String()
+}
+
+// limitedBuffer keeps the first limit bytes written and notes truncation.
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.buf.Len(); room > 0 {
+		if len(p) > room {
+			b.buf.Write(p[:room])
+			b.truncated = true
+		} else {
+			b.buf.Write(p)
+		}
+	} else if len(p) > 0 {
+		b.truncated = true
+	}
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	if b.truncated {
+		return b.buf.String() + "\n[output truncated]"
+	}
+	return b.buf.String()
+}
@@ -0,0 +1,169 @@
+package commitscript
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+func writeScript(t *testing.T, dir, name, body string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body), 0o755); err != nil {
+		t.Fatalf("WriteFile(%s) error = %v", name, err)
+	}
+	return path
+}
+
+func TestRunPreScriptAbortsOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	ok := writeScript(t, dir, "ok", "echo \"$ARCA_COMMIT_STAGE by $ARCA_COMMIT_USER\"\ngrep -c 'set ' -\n")
+	fail := writeScript(t, dir, "fail", "echo 'hostname not allowed' >&2\nexit 3\n")
+	skipped := writeScript(t, dir, "skipped", "echo should-not-run\n")
+
+	runner := &Runner{Dir: dir, Timeout: 5 * time.Second}
+	results, err := runner.Run(context.Background(), Request{
+		Stage:      StagePre,
+		User:       "alice",
+		ConfigText: "set system host-name r1\nset system scripts commit pre " + ok + "\n",
+	}, []string{ok, fail, skipped})
+	if err == nil || !strings.Contains(err.Error(), "pre-commit script "+fail+" failed: exit status 3") {
+		t.Fatalf("Run() error = %v, want failing pre-commit script", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Run() results = %#v, want run to stop at the failing script", results)
+	}
+	if results[0].Err != nil || results[0].Output != "pre by alice\n2\n" {
+		t.Fatalf("results[0] = %#v, want stage, user and stdin line count", results[0])
+	}
+
+	record := Record(StagePre, results)
+	want := "pre-commit script " + ok + ": ok\npre by alice\n2\n" +
+		"pre-commit script " + fail + ": exit status 3\nhostname not allowed"
+	if record != want {
+		t.Fatalf("Record() = %q, want %q", record, want)
+	}
+}
+
+func TestRunPostScriptsContinueAfterFailure(t *testing.T) {
+	dir := t.TempDir()
+	fail := writeScript(t, dir, "fail", "exit 1\n")
+	notify := writeScript(t, dir, "notify", "echo notified\n")
+
+	runner := &Runner{Dir: dir, Timeout: 5 * time.Second}
+	results, err := runner.Run(context.Background(), Request{Stage: StagePost}, []string{fail, notify})
+	if err != nil {
+		t.Fatalf("Run(post) error = %v, want nil", err)
+	}
+	if len(results) != 2 || results[0].Err == nil || results[1].Output != "notified\n" {
+		t.Fatalf("Run(post) results = %#v, want both scripts run", results)
+	}
+}
+
+type noteRecorder map[string]string
+
+func (n noteRecorder) AppendCommitNote(_ context.Context, commitID, note string) error {
+	n[commitID] = note
+	return nil
+}
+
+func TestRunPreAndPostCommitUseRequestSource(t *testing.T) {
+	dir := t.TempDir()
+	source := writeScript(t, dir, "source", "echo \"$ARCA_COMMIT_STAGE from $ARCA_COMMIT_SOURCE\"\n")
+	runner := &Runner{Dir: dir, Timeout: 5 * time.Second}
+	req := Request{User: "alice", Source: datastore.CommitSourceNETCONF}
+
+	message, err := runner.RunPreCommit(context.Background(), req, []string{source}, "")
+	if err != nil {
+		t.Fatalf("RunPreCommit() error = %v", err)
+	}
+	want := datastore.DefaultCommitMessage(datastore.CommitSourceNETCONF, "alice") +
+		"\npre-commit script " + source + ": ok\npre from netconf"
+	if message != want {
+		t.Fatalf("RunPreCommit() = %q, want %q", message, want)
+	}
+
+	notes := noteRecorder{}
+	record, err := runner.RunPostCommit(context.Background(), req, []string{source}, notes, "c1")
+	if err != nil {
+		t.Fatalf("RunPostCommit() error = %v", err)
+	}
+	if want := "post-commit script " + source + ": ok\npost from netconf"; record != want || notes["c1"] != want {
+		t.Fatalf("RunPostCommit() record = %q, note = %q, want %q", record, notes["c1"], want)
+	}
+}
+
+func TestRunPreCommitAbortsOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	fail := writeScript(t, dir, "fail", "exit 2\n")
+	runner := &Runner{Dir: dir, Timeout: 5 * time.Second}
+
+	_, err := runner.RunPreCommit(context.Background(), Request{Source: datastore.CommitSourceCLI}, []string{fail}, "msg")
+	var abort *AbortError
+	if !errors.As(err, &abort) || !strings.Contains(abort.Record, "exit status 2") {
+		t.Fatalf("RunPreCommit() error = %v, want *AbortError with script record", err)
+	}
+}
+
+func TestRunRejectsScriptsOutsideAllowedDirectory(t *testing.T) {
+	dir := t.TempDir()
+	outside := t.TempDir()
+	escaped := writeScript(t, outside, "escaped", "exit 0\n")
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink(escaped, link); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+	writable := writeScript(t, dir, "writable", "exit 0\n")
+	if err := os.Chmod(writable, 0o777); err != nil {
+		t.Fatalf("Chmod() error = %v", err)
+	}
+	plain := filepath.Join(dir, "plain")
+	if err := os.WriteFile(plain, []byte("#!/bin/sh\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	runner := &Runner{Dir: dir}
+	for path, want := range map[string]string{
+		escaped:    "outside the allowed directory",
+		link:       "outside the allowed directory",
+		writable:   "writable by group or others",
+		plain:      "not executable",
+		"relative": "not absolute",
+		dir:        "outside the allowed directory",
+	} {
+		results, err := runner.Run(context.Background(), Request{Stage: StagePre}, []string{path})
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Run(%s) error = %v, want %q", path, err, want)
+		}
+		if len(results) != 1 || results[0].Output != "" {
+			t.Errorf("Run(%s) results = %#v, want rejected script not to run", path, results)
+		}
+	}
+}
+
+func TestRunTimesOutAndTruncatesOutput(t *testing.T) {
+	dir := t.TempDir()
+	slow := writeScript(t, dir, "slow", "sleep 5\n")
+	noisy := writeScript(t, dir, "noisy", "head -c 10000 /dev/zero | tr '\\0' x\n")
+
+	runner := &Runner{Dir: dir, Timeout: 100 * time.Millisecond}
+	_, err := runner.Run(context.Background(), Request{Stage: StagePre}, []string{slow})
+	if err == nil || !strings.Contains(err.Error(), "timed out after 100ms") {
+		t.Fatalf("Run(slow) error = %v, want timeout", err)
+	}
+
+	runner.Timeout = 5 * time.Second
+	results, err := runner.Run(context.Background(), Request{Stage: StagePre}, []string{noisy})
+	if err != nil {
+		t.Fatalf("Run(noisy) error = %v", err)
+	}
+	if got := results[0].Output; len(got) != maxOutputBytes+len("\n[output truncated]") || !strings.HasSuffix(got, "[output truncated]") {
+		t.Fatalf("Run(noisy) output length = %d, want truncated to %d bytes", len(got), maxOutputBytes)
+	}
+}
@@ -10,6 +10,7 @@ func TestBFDProtocolBindingsParseValidateAndSerialize(t *testing.T) {
 		"set routing-options autonomous-system 65000",
 		"set routing-options router-id 192.0.2.1",
 		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64",
 		"set protocols bfd profile fast receive-interval 150",
 		"set protocols bgp group EBGP type external",
 		"set protocols bgp group EBGP neighbor 192.0.2.2 peer-as 65001",
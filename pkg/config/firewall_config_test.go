@@ -0,0 +1,147 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFirewallPolicerRoundTrip(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/0 policer input LIMIT-100M",
+		"set interfaces ge-0/0/0 policer output LIMIT-100M",
+		"set firewall policer LIMIT-100M if-exceeding bandwidth-limit 100m",
+		"set firewall policer LIMIT-100M if-exceeding burst-size-limit 15k",
+		"set firewall policer LIMIT-100M then discard",
+	)
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	policer := cfg.Firewall.Policers["LIMIT-100M"]
+	if policer.BandwidthLimit != 100000000 || policer.BurstSizeLimit != 15000 || policer.Action != "discard" {
+		t.Fatalf("policer = %+v", policer)
+	}
+	iface := cfg.Interfaces["ge-0/0/0"]
+	if iface.InputPolicer != "LIMIT-100M" || iface.OutputPolicer != "LIMIT-100M" {
+		t.Fatalf("interface policers = %q/%q", iface.InputPolicer, iface.OutputPolicer)
+	}
+	text := ToSetCommands(cfg)
+	for _, want := range []string{
+		"set firewall policer LIMIT-100M if-exceeding bandwidth-limit 100m",
+		"set firewall policer LIMIT-100M if-exceeding burst-size-limit 15k",
+		"set interfaces ge-0/0/0 policer input LIMIT-100M",
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("ToSetCommands() missing %q:\n%s", want, text)
+		}
+	}
+	assertSetCommandRoundTrip(t, cfg)
+}
+
+func TestFirewallPolicerCombinedClauses(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set firewall policer P if-exceeding bandwidth-limit 100m burst-size-limit 15k then discard",
+	)
+
+	policer := cfg.Firewall.Policers["P"]
+	if policer.BandwidthLimit != 100000000 || policer.BurstSizeLimit != 15000 || policer.Action != "discard" {
+		t.Fatalf("policer = %+v, want 100m bandwidth, 15k burst, discard", policer)
+	}
+	assertSetCommandRoundTrip(t, cfg)
+
+	_, err := NewParser(strings.NewReader("set firewall policer P if-exceeding bandwidth-limit 100m bogus")).Parse()
+	if err == nil || !strings.Contains(err.Error(), "unsupported policer parameter: bogus") {
+		t.Fatalf("Parse() error = %v, want unsupported policer parameter", err)
+	}
+}
+
+func TestParseUnitValueSuffixes(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint64
+	}{
+		{"1500", 1500},
+		{"15k", 15000},
+		{"15K", 15000},
+		{"100m", 100000000},
+		{"100M", 100000000},
+		{"10g", 10000000000},
+		{"2G", 2000000000},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnitValue(tt.input)
+		if err != nil {
+			t.Fatalf("ParseUnitValue(%q) error = %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Fatalf("ParseUnitValue(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+		if back, _ := ParseUnitValue(FormatUnitValue(got)); back != got {
+			t.Fatalf("FormatUnitValue(%d) = %q does not round-trip", got, FormatUnitValue(got))
+		}
+	}
+
+	for _, input := range []string{"", "m", "10t", "1.5m", "-1k", "99999999999999999999g"} {
+		if _, err := ParseUnitValue(input); err == nil {
+			t.Fatalf("ParseUnitValue(%q) error = nil, want error", input)
+		}
+	}
+	if got := FormatUnitValue(1500); got != "1500" {
+		t.Fatalf("FormatUnitValue(1500) = %q, want 1500", got)
+	}
+}
+
+func TestFirewallPolicerParseRejectsInvalidLimit(t *testing.T) {
+	_, err := NewParser(strings.NewReader("set firewall policer P if-exceeding bandwidth-limit fast")).Parse()
+	if err == nil || !strings.Contains(err.Error(), "invalid bandwidth-limit") {
+		t.Fatalf("Parse() error = %v, want invalid bandwidth-limit", err)
+	}
+}
+
+func TestFirewallPolicerValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{
+			name:  "missing burst",
+			lines: []string{"set firewall policer P if-exceeding bandwidth-limit 10m"},
+			want:  "has no burst-size-limit",
+		},
+		{
+			name: "bandwidth too low",
+			lines: []string{
+				"set firewall policer P if-exceeding bandwidth-limit 1k",
+				"set firewall policer P if-exceeding burst-size-limit 15k",
+			},
+			want: "invalid bandwidth-limit",
+		},
+		{
+			name: "unsupported action",
+			lines: []string{
+				"set firewall policer P if-exceeding bandwidth-limit 10m",
+				"set firewall policer P if-exceeding burst-size-limit 15k",
+				"set firewall policer P then accept",
+			},
+			want: "unsupported action",
+		},
+		{
+			name: "unknown interface policer",
+			lines: []string{
+				"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+				"set interfaces ge-0/0/0 policer input missing",
+			},
+			want: "unknown input policer missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseSetCommands(t, tt.lines...).Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Validate() error = %v, want substring %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/akam1o/arca-router/pkg/errors"
+)
+
+// parseGroups parses a configuration group statement
+// Format: groups <name> interfaces <name|pattern> <statement>
+//
+// A group holds configuration that only takes effect on the interfaces that
+// name it in apply-groups (see ExpandGroups). "interface" is accepted for
+// "interfaces".
+func (p *Parser) parseGroups(config *Config) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected group name")
+	}
+	name := p.current.Value
+	p.nextToken()
+
+	if p.current.Type != TokenWord || (p.current.Value != "interfaces" && p.current.Value != "interface") {
+		if p.current.Type == TokenWord {
+			return p.error(fmt.Sprintf("unsupported group hierarchy: %s (groups support interfaces)", p.current.Value))
+		}
+		return p.error(fmt.Sprintf("expected 'interfaces' in group %s", name))
+	}
+	p.nextToken()
+
+	if config.Groups == nil {
+		config.Groups = make(map[string]*Config)
+	}
+	group := config.Groups[name]
+	if group == nil {
+		group = NewConfig()
+		config.Groups[name] = group
+	}
+	if err := p.parseInterfaceStatement(group); err != nil {
+		return err
+	}
+	for ifName, iface := range group.Interfaces {
+		if iface != nil && len(iface.ApplyGroups) > 0 {
+			return p.error(fmt.Sprintf("apply-groups is not allowed in group %s interface %s", name, ifName))
+		}
+	}
+	return nil
+}
+
+// parseInterfaceApplyGroups parses "apply-groups <group>". Groups are
+// inherited in the order they are applied.
+func (p *Parser) parseInterfaceApplyGroups(iface *Interface) error {
+	if p.current.Type != TokenWord && p.current.Type != TokenString {
+		return p.error("expected group name")
+	}
+	iface.ApplyGroups = appendUniqueString(iface.ApplyGroups, p.current.Value)
+	p.nextToken()
+	return nil
+}
+
+// isWildcardPattern reports whether a name is a <pattern> matching several
+// configuration objects, for example <*> or <ge-*>.
+func isWildcardPattern(name string) bool {
+	return len(name) >= 2 && strings.HasPrefix(name, "<") && strings.HasSuffix(name, ">")
+}
+
+// MatchGroupPattern reports whether name matches a group statement name: a
+// <pattern> in which * matches any run of characters and ? any single
+// character, or otherwise the literal name.
+func MatchGroupPattern(pattern, name string) bool {
+	if !isWildcardPattern(pattern) {
+		return pattern == name
+	}
+	glob := regexp.QuoteMeta(pattern[1 : len(pattern)-1])
+	glob = strings.ReplaceAll(glob, `\*`, ".*")
+	glob = strings.ReplaceAll(glob, `\?`, ".")
+	return regexp.MustCompile("^" + glob + "$").MatchString(name)
+}
+
+// hasApplyGroups reports whether any interface applies a group.
+func (c *Config) hasApplyGroups() bool {
+	for _, iface := range c.Interfaces {
+		if iface != nil && len(iface.ApplyGroups) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandGroups returns the configuration with the group statements matching
+// each interface merged into the interfaces that apply the group. Statements
+// configured on the interface itself override inherited ones, and when two
+// applied groups set the same statement the group applied first wins. The
+// result has no groups or apply-groups. The receiver is returned as-is when
+// no interface applies a group.
+func (c *Config) ExpandGroups() (*Config, error) {
+	if c == nil || !c.hasApplyGroups() {
+		return c, nil
+	}
+
+	explicit := *c
+	explicit.Groups = nil
+	explicit.Interfaces = make(map[string]*Interface, len(c.Interfaces))
+	for name, iface := range c.Interfaces {
+		if iface == nil {
+			continue
+		}
+		withoutGroups := *iface
+		withoutGroups.ApplyGroups = nil
+		explicit.Interfaces[name] = &withoutGroups
+	}
+	text, err := ToSetCommandsWithError(&explicit)
+	if err != nil {
+		return nil, err
+	}
+
+	// The statements are parsed in order and later statements override
+	// earlier ones, so inherited statements come first, from the last
+	// applied group to the first.
+	var inherited strings.Builder
+	for _, name := range sortedKeys(c.Interfaces) {
+		iface := c.Interfaces[name]
+		if iface == nil {
+			continue
+		}
+		for i := len(iface.ApplyGroups) - 1; i >= 0; i-- {
+			group, ok := c.Groups[iface.ApplyGroups[i]]
+			if !ok || group == nil {
+				return nil, fmt.Errorf("interface %s applies undefined group %s", name, iface.ApplyGroups[i])
+			}
+			for _, pattern := range sortedKeys(group.Interfaces) {
+				if MatchGroupPattern(pattern, name) {
+					writeInterfaces(&inherited, map[string]*Interface{name: group.Interfaces[pattern]})
+				}
+			}
+		}
+	}
+
+	expanded, err := NewParser(strings.NewReader(inherited.String() + text)).Parse()
+	if err != nil {
+		return nil, fmt.Errorf("expand groups: %w", err)
+	}
+	return expanded, nil
+}
+
+// validateGroupReferences adds an error for every apply-groups that names a
+// group that is not defined.
+func (c *Config) validateGroupReferences(result *ValidationResult) {
+	for _, name := range sortedKeys(c.Interfaces) {
+		iface := c.Interfaces[name]
+		if iface == nil {
+			continue
+		}
+		for _, group := range iface.ApplyGroups {
+			if _, ok := c.Groups[group]; ok {
+				continue
+			}
+			result.addErrorAt([]string{"interfaces", name, "apply-groups"}, errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Interface %s applies undefined group %s", name, group),
+				"apply-groups must name a group defined under groups",
+				fmt.Sprintf("Define 'groups %s' or remove the apply-groups reference", group),
+			))
+		}
+	}
+}
+
+func writeGroups(b *strings.Builder, groups map[string]*Config) {
+	for _, name := range sortedKeys(groups) {
+		if groups[name] == nil {
+			continue
+		}
+		var group strings.Builder
+		writeInterfaces(&group, groups[name].Interfaces)
+		for _, line := range strings.Split(strings.TrimSuffix(group.String(), "\n"), "\n") {
+			if statement, ok := strings.CutPrefix(line, "set "); ok {
+				writeLine(b, "set groups %s %s", EscapeValue(name), statement)
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+const groupsConfig = `set groups GLOBAL-IFACE interface <*> mtu 9000
+set groups GLOBAL-IFACE interfaces <ge-*> description "from group"
+set groups JUMBO interfaces <*> mtu 9216
+set interfaces ge-0/0/0 apply-groups GLOBAL-IFACE
+set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/24
+set interfaces ge-0/0/1 apply-groups GLOBAL-IFACE
+set interfaces ge-0/0/1 mtu 1500
+set interfaces xe-0/0/0 apply-groups GLOBAL-IFACE
+set interfaces xe-0/0/1 apply-groups GLOBAL-IFACE
+set interfaces xe-0/0/1 apply-groups JUMBO
+set interfaces xe-0/0/2 mtu 1500
+`
+
+func TestExpandGroupsInheritsWildcardStatements(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader(groupsConfig)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	expanded, err := cfg.ExpandGroups()
+	if err != nil {
+		t.Fatalf("ExpandGroups() error = %v", err)
+	}
+	for _, tt := range []struct {
+		name        string
+		mtu         int
+		description string
+	}{
+		{name: "ge-0/0/0", mtu: 9000, description: "from group"},
+		{name: "ge-0/0/1", mtu: 1500, description: "from group"}, // explicit mtu overrides the group
+		{name: "xe-0/0/0", mtu: 9000},                            // <ge-*> does not match
+		{name: "xe-0/0/1", mtu: 9000},                            // the group applied first wins
+		{name: "xe-0/0/2", mtu: 1500},                            // no apply-groups
+	} {
+		iface := expanded.Interfaces[tt.name]
+		if iface == nil {
+			t.Fatalf("expanded config has no interface %s", tt.name)
+		}
+		if iface.MTU != tt.mtu || iface.Description != tt.description {
+			t.Errorf("%s mtu = %d description = %q, want %d %q", tt.name, iface.MTU, iface.Description, tt.mtu, tt.description)
+		}
+		if len(iface.ApplyGroups) != 0 {
+			t.Errorf("%s kept apply-groups %v after expansion", tt.name, iface.ApplyGroups)
+		}
+	}
+	if got := expanded.Interfaces["ge-0/0/0"].Units[0].Family["inet"].Addresses; len(got) != 1 || got[0] != "10.0.0.1/24" {
+		t.Errorf("ge-0/0/0 addresses = %v, want explicit address kept", got)
+	}
+	if expanded.Groups != nil {
+		t.Errorf("expanded config kept groups %v", expanded.Groups)
+	}
+
+	active, err := cfg.ActiveConfig()
+	if err != nil {
+		t.Fatalf("ActiveConfig() error = %v", err)
+	}
+	if active.Interfaces["ge-0/0/0"].MTU != 9000 {
+		t.Errorf("ActiveConfig() ge-0/0/0 mtu = %d, want inherited 9000", active.Interfaces["ge-0/0/0"].MTU)
+	}
+}
+
+func TestGroupsRoundTrip(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader(groupsConfig)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	text, err := ToSetCommandsWithError(cfg)
+	if err != nil {
+		t.Fatalf("ToSetCommandsWithError() error = %v", err)
+	}
+	for _, want := range []string{
+		"set groups GLOBAL-IFACE interfaces <*> mtu 9000\n",
+		"set groups GLOBAL-IFACE interfaces <ge-*> description \"from group\"\n",
+		"set interfaces xe-0/0/1 apply-groups GLOBAL-IFACE\nset interfaces xe-0/0/1 apply-groups JUMBO\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("serialized config missing %q:\n%s", want, text)
+		}
+	}
+	reparsed, err := NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		t.Fatalf("re-Parse() error = %v", err)
+	}
+	if again := ToSetCommands(reparsed); again != text {
+		t.Errorf("round trip changed the configuration:\n%s\nwant:\n%s", again, text)
+	}
+}
+
+func TestValidateRejectsUndefinedApplyGroups(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader("set interfaces ge-0/0/0 apply-groups MISSING\n")).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	issues := cfg.ValidateAll().Errors()
+	if len(issues) != 1 || !strings.Contains(issues[0].Err.Error(), "undefined group MISSING") {
+		t.Fatalf("ValidateAll() errors = %v, want undefined group MISSING", issues)
+	}
+	if got := strings.Join(issues[0].Path, " "); got != "interfaces ge-0/0/0 apply-groups" {
+		t.Errorf("issue path = %q, want interfaces ge-0/0/0 apply-groups", got)
+	}
+}
+
+func TestParseGroupsRejectsInvalidStatements(t *testing.T) {
+	for _, input := range []string{
+		"set interfaces <*> mtu 9000",
+		"set groups G protocols bgp group X type internal",
+		"set groups G interfaces <ge-*> apply-groups H",
+		"set groups G interfaces <ge-* mtu 9000",
+	} {
+		if _, err := NewParser(strings.NewReader(input)).Parse(); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", input)
+		}
+	}
+}
+
+func TestMatchGroupPattern(t *testing.T) {
+	for _, tt := range []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"<*>", "ge-0/0/0", true},
+		{"<ge-*>", "ge-0/0/0", true},
+		{"<ge-*>", "xe-0/0/0", false},
+		{"<ge-0/0/?>", "ge-0/0/7", true},
+		{"<ge-0/0/?>", "ge-0/0/10", false},
+		{"ge-0/0/0", "ge-0/0/0", true},
+		{"ge-0/0/0", "ge-0/0/1", false},
+	} {
+		if got := MatchGroupPattern(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("MatchGroupPattern(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
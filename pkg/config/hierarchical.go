@@ -0,0 +1,234 @@
+package config
+
+import (
+	"strings"
+)
+
+// hierarchicalIndent is the indentation used per nesting level.
+const hierarchicalIndent = "    "
+
+// namedHierarchyKeywords are statements whose next token names a list entry,
+// rendered together as a single node (for example "unit 0 {").
+var namedHierarchyKeywords = map[string]bool{
+	"address":                 true,
+	"area":                    true,
+	"as-path":                 true,
+	"bridge-domain":           true,
+	"community":               true,
+	"family":                  true,
+	"filter":                  true,
+	"forwarding-class":        true,
+	"group":                   true,
+	"interface":               true,
+	"neighbor":                true,
+	"next-hop":                true,
+	"node":                    true,
+	"policer":                 true,
+	"policy-statement":        true,
+	"prefix-list":             true,
+	"profile":                 true,
+	"route":                   true,
+	"term":                    true,
+	"traffic-control-profile": true,
+	"unit":                    true,
+	"user":                    true,
+	"vrf":                     true,
+}
+
+// qualifiedLeafKeywords are named keywords that form a container of leaf
+// statements, rather than a list entry, when followed by one of these
+// qualifiers. "policer input P" renders as "policer { input P; }".
+var qualifiedLeafKeywords = map[string]map[string]bool{
+	"filter":  {"input": true, "output": true, "input-list": true, "output-list": true},
+	"policer": {"input": true, "output": true},
+}
+
+// actionLeafKeywords are named keywords that form a single leaf statement
+// with their action and value, as in "community add C;".
+var actionLeafKeywords = map[string]map[string]bool{
+	"community": {"add": true, "delete": true, "set": true},
+}
+
+// hierarchyNode is one statement in the curly-brace configuration tree.
+type hierarchyNode struct {
+	label    string
+	inactive bool
+	children []*hierarchyNode
+	index    map[string]*hierarchyNode
+}
+
+func (n *hierarchyNode) child(label string) *hierarchyNode {
+	if n.index == nil {
+		n.index = make(map[string]*hierarchyNode)
+	}
+	if c, ok := n.index[label]; ok {
+		return c
+	}
+	c := &hierarchyNode{label: label}
+	n.index[label] = c
+	n.children = append(n.children, c)
+	return c
+}
+
+// lookup finds the node for a deactivate path. A path may stop at a named
+// entry or a bare keyword, so a joined "keyword name" label is tried first.
+func (n *hierarchyNode) lookup(tokens []string) *hierarchyNode {
+	if len(tokens) == 0 {
+		return n
+	}
+	if len(tokens) > 1 {
+		if c := n.index[tokens[0]+" "+tokens[1]]; c != nil {
+			return c.lookup(tokens[2:])
+		}
+	}
+	if c := n.index[tokens[0]]; c != nil {
+		return c.lookup(tokens[1:])
+	}
+	return nil
+}
+
+// ToHierarchical renders the configuration in Junos curly-brace style.
+// Statements appear in the canonical order of ToSetCommands, so map keys are
+// sorted and ordered lists such as policy terms keep their order.
+func (c *Config) ToHierarchical() string {
+	return SetCommandsToHierarchical(ToSetCommands(c))
+}
+
+// SetCommandsToHierarchical renders set and deactivate commands in Junos
+// curly-brace style. Deactivated statements are prefixed with "inactive:".
+// Lines that are neither set nor deactivate commands are ignored.
+func SetCommandsToHierarchical(text string) string {
+	root := &hierarchyNode{}
+	var inactive [][]string
+
+	for _, line := range strings.Split(text, "\n") {
+		tokens := splitHierarchyTokens(line)
+		if len(tokens) < 2 {
+			continue
+		}
+		switch tokens[0] {
+		case "set":
+			node := root
+			for _, label := range hierarchyLabels(tokens[1:]) {
+				node = node.child(label)
+			}
+		case "deactivate":
+			inactive = append(inactive, tokens[1:])
+		}
+	}
+
+	for _, path := range inactive {
+		if node := root.lookup(path); node != nil {
+			node.inactive = true
+		}
+	}
+	root.foldLeaves()
+
+	var b strings.Builder
+	for _, c := range root.children {
+		writeHierarchyNode(&b, c, 0)
+	}
+	return b.String()
+}
+
+// hierarchyLabels groups the tokens of one statement path into node labels.
+// A named keyword joins its name, and the final keyword joins its value.
+func hierarchyLabels(tokens []string) []string {
+	var labels []string
+	for i := 0; i < len(tokens); {
+		remaining := len(tokens) - i
+		if remaining == 3 && qualifiedLeafKeywords[tokens[i]][tokens[i+1]] {
+			labels = append(labels, tokens[i], tokens[i+1]+" "+tokens[i+2])
+			break
+		}
+		if remaining == 3 && actionLeafKeywords[tokens[i]][tokens[i+1]] {
+			labels = append(labels, strings.Join(tokens[i:], " "))
+			break
+		}
+		if remaining == 2 || (remaining > 2 && namedHierarchyKeywords[tokens[i]]) {
+			labels = append(labels, tokens[i]+" "+tokens[i+1])
+			i += 2
+			continue
+		}
+		labels = append(labels, tokens[i])
+		i++
+	}
+	return labels
+}
+
+// foldLeaves moves each "keyword value" leaf into a sibling container named
+// keyword, so "then accept" and "then community C" render as one then block.
+// The container takes the position of the first statement folded into it.
+func (n *hierarchyNode) foldLeaves() {
+	children := make([]*hierarchyNode, 0, len(n.children))
+	placed := make(map[*hierarchyNode]bool)
+	folded := make(map[*hierarchyNode][]*hierarchyNode)
+	for _, c := range n.children {
+		target := c
+		if keyword, value, ok := strings.Cut(c.label, " "); ok && len(c.children) == 0 {
+			if container := n.index[keyword]; container != nil && len(container.children) > 0 {
+				folded[container] = append(folded[container], &hierarchyNode{label: value, inactive: c.inactive})
+				target = container
+			}
+		}
+		if !placed[target] {
+			placed[target] = true
+			children = append(children, target)
+		}
+	}
+	for container, leaves := range folded {
+		container.children = append(leaves, container.children...)
+	}
+	n.children = children
+	for _, c := range n.children {
+		c.foldLeaves()
+	}
+}
+
+func writeHierarchyNode(b *strings.Builder, n *hierarchyNode, depth int) {
+	b.WriteString(strings.Repeat(hierarchicalIndent, depth))
+	if n.inactive {
+		b.WriteString("inactive: ")
+	}
+	b.WriteString(n.label)
+	if len(n.children) == 0 {
+		b.WriteString(";\n")
+		return
+	}
+	b.WriteString(" {\n")
+	for _, c := range n.children {
+		writeHierarchyNode(b, c, depth+1)
+	}
+	b.WriteString(strings.Repeat(hierarchicalIndent, depth))
+	b.WriteString("}\n")
+}
+
+// splitHierarchyTokens splits a command on whitespace outside double quotes,
+// keeping quotes and escapes so values are rendered exactly as serialized.
+func splitHierarchyTokens(line string) []string {
+	var tokens []string
+	var current strings.Builder
+	inQuote := false
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\' && inQuote:
+			escaped = true
+		case r == '"':
+			inQuote = !inQuote
+		case (r == ' ' || r == '\t') && !inQuote:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func TestToHierarchicalGolden(t *testing.T) {
+	input, err := os.Open(filepath.Join("testdata", "hierarchical.conf"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer func() { _ = input.Close() }()
+	cfg, err := NewParser(input).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	got := cfg.ToHierarchical()
+	goldenPath := filepath.Join("testdata", "hierarchical.golden")
+	if *updateGolden {
+		if err := os.WriteFile(goldenPath, []byte(got), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got != string(want) {
+		t.Fatalf("ToHierarchical() mismatch\n--- got ---\n%s\n--- want ---\n%s", got, want)
+	}
+
+	if again := SetCommandsToHierarchical(ToSetCommands(cfg)); again != got {
+		t.Fatalf("SetCommandsToHierarchical() = %q, want %q", again, got)
+	}
+}
+
+func TestSetCommandsToHierarchicalMergesPresenceStatements(t *testing.T) {
+	text := "set protocols bgp group IBGP neighbor 10.0.0.2\n" +
+		"set protocols bgp group IBGP neighbor 10.0.0.2 peer-as 65001\n"
+	want := "protocols {\n" +
+		"    bgp {\n" +
+		"        group IBGP {\n" +
+		"            neighbor 10.0.0.2 {\n" +
+		"                peer-as 65001;\n" +
+		"            }\n" +
+		"        }\n" +
+		"    }\n" +
+		"}\n"
+	if got := SetCommandsToHierarchical(text); got != want {
+		t.Fatalf("SetCommandsToHierarchical() = %q, want %q", got, want)
+	}
+}
+
+func TestSetCommandsToHierarchicalQualifiedLeaves(t *testing.T) {
+	text := "set interfaces ge-0/0/0 policer input P\n" +
+		"set interfaces ge-0/0/0 unit 0 family inet filter input F\n" +
+		"set interfaces ge-0/0/0 unit 0 family inet filter output F-OUT\n" +
+		"set policy-options policy-statement EXPORT term T1 then community add C\n" +
+		"set policy-options policy-statement EXPORT term T1 then accept\n"
+	want := "interfaces {\n" +
+		"    ge-0/0/0 {\n" +
+		"        policer {\n" +
+		"            input P;\n" +
+		"        }\n" +
+		"        unit 0 {\n" +
+		"            family inet {\n" +
+		"                filter {\n" +
+		"                    input F;\n" +
+		"                    output F-OUT;\n" +
+		"                }\n" +
+		"            }\n" +
+		"        }\n" +
+		"    }\n" +
+		"}\n" +
+		"policy-options {\n" +
+		"    policy-statement EXPORT {\n" +
+		"        term T1 {\n" +
+		"            then {\n" +
+		"                accept;\n" +
+		"                community add C;\n" +
+		"            }\n" +
+		"        }\n" +
+		"    }\n" +
+		"}\n"
+	if got := SetCommandsToHierarchical(text); got != want {
+		t.Fatalf("SetCommandsToHierarchical() =\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// topLevelKeywords lists the hierarchies accepted after "set" and "deactivate"
+var topLevelKeywords = map[string]bool{
+	"system":            true,
+	"chassis":           true,
+	"interfaces":        true,
+	"routing-options":   true,
+	"routing-instances": true,
+	"protocols":         true,
+	"policy-options":    true,
+	"class-of-service":  true,
+	"firewall":          true,
+	"security":          true,
+}
+
+// parseDeactivate parses an inactive marker for a configuration subtree
+// Format: deactivate <path>
+func (p *Parser) parseDeactivate(config *Config) error {
+	var path []string
+	for p.current.Type != TokenEOL && p.current.Type != TokenEOF {
+		if p.current.Type == TokenError {
+			return p.lexerError(p.current.Value)
+		}
+		path = append(path, EscapeValue(p.current.Value))
+		p.nextToken()
+	}
+	if len(path) == 0 {
+		return p.error("expected configuration path after 'deactivate'")
+	}
+	if !topLevelKeywords[path[0]] {
+		return p.error(fmt.Sprintf("unsupported keyword: %s", path[0]))
+	}
+	config.Inactive = appendUniqueString(config.Inactive, strings.Join(path, " "))
+	return nil
+}
+
+func writeInactive(b *strings.Builder, inactive []string) {
+	paths := append([]string(nil), inactive...)
+	sort.Strings(paths)
+	for _, path := range paths {
+		writeLine(b, "deactivate %s", path)
+	}
+}
+
+// ActiveConfig returns the configuration with applied groups expanded (see
+// ExpandGroups) and every deactivated subtree removed. It is the view that is
+// programmed into FRR and VPP. The receiver is returned as-is when nothing is
+// deactivated and no group is applied.
+func (c *Config) ActiveConfig() (*Config, error) {
+	c, err := c.ExpandGroups()
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || len(c.Inactive) == 0 {
+		return c, nil
+	}
+	full := *c
+	full.Inactive = nil
+	text, err := ToSetCommandsWithError(&full)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept []string
+	for _, line := range strings.Split(text, "\n") {
+		if line == "" || IsInactiveStatement(line, c.Inactive) {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	active, err := NewParser(strings.NewReader(strings.Join(kept, "\n"))).Parse()
+	if err != nil {
+		return nil, fmt.Errorf("build active configuration: %w", err)
+	}
+	return active, nil
+}
+
+// IsInactiveStatement reports whether a set statement falls under one of the
+// deactivated paths.
+func IsInactiveStatement(line string, inactive []string) bool {
+	statement, ok := strings.CutPrefix(line, "set ")
+	if !ok {
+		return false
+	}
+	for _, path := range inactive {
+		if statement == path || strings.HasPrefix(statement, path+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// DanglingInactivePaths returns the deactivated paths of c that no longer
+// cover any configuration statement, such as a marker left behind when its
+// subtree was deleted.
+func (c *Config) DanglingInactivePaths() ([]string, error) {
+	if c == nil || len(c.Inactive) == 0 {
+		return nil, nil
+	}
+	expanded, err := c.ExpandGroups()
+	if err != nil {
+		return nil, err
+	}
+	full := *expanded
+	full.Inactive = nil
+	text, err := ToSetCommandsWithError(&full)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(text, "\n")
+	var dangling []string
+	for _, path := range c.Inactive {
+		covered := false
+		for _, line := range lines {
+			if IsInactiveStatement(line, []string{path}) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			dangling = append(dangling, path)
+		}
+	}
+	return dangling, nil
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+const inactiveBGPConfig = `set routing-options autonomous-system 65000
+set protocols bgp group EXTERNAL type external
+set protocols bgp group EXTERNAL neighbor 203.0.113.1 peer-as 65001
+set protocols bgp group INTERNAL type internal
+set protocols bgp group INTERNAL neighbor 192.0.2.2 peer-as 65000
+deactivate protocols bgp group EXTERNAL
+`
+
+func TestParseDeactivateRoundTrip(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader(inactiveBGPConfig)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(cfg.Inactive) != 1 || cfg.Inactive[0] != "protocols bgp group EXTERNAL" {
+		t.Fatalf("Inactive = %#v, want protocols bgp group EXTERNAL", cfg.Inactive)
+	}
+	if cfg.Protocols.BGP.Groups["EXTERNAL"] == nil {
+		t.Fatal("deactivated group was dropped from the parsed configuration")
+	}
+
+	text, err := ToSetCommandsWithError(cfg)
+	if err != nil {
+		t.Fatalf("ToSetCommandsWithError() error = %v", err)
+	}
+	if !strings.Contains(text, "deactivate protocols bgp group EXTERNAL\n") {
+		t.Fatalf("serialized config missing deactivate statement:\n%s", text)
+	}
+	reparsed, err := NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		t.Fatalf("re-Parse() error = %v", err)
+	}
+	if len(reparsed.Inactive) != 1 {
+		t.Fatalf("re-parsed Inactive = %#v, want one path", reparsed.Inactive)
+	}
+}
+
+func TestActiveConfigRemovesDeactivatedSubtree(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader(inactiveBGPConfig)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	active, err := cfg.ActiveConfig()
+	if err != nil {
+		t.Fatalf("ActiveConfig() error = %v", err)
+	}
+	if _, ok := active.Protocols.BGP.Groups["EXTERNAL"]; ok {
+		t.Fatal("ActiveConfig() kept deactivated BGP group")
+	}
+	if _, ok := active.Protocols.BGP.Groups["INTERNAL"]; !ok {
+		t.Fatal("ActiveConfig() dropped active BGP group")
+	}
+	if len(active.Inactive) != 0 {
+		t.Fatalf("ActiveConfig().Inactive = %#v, want empty", active.Inactive)
+	}
+	if _, ok := cfg.Protocols.BGP.Groups["EXTERNAL"]; !ok {
+		t.Fatal("ActiveConfig() mutated the full configuration")
+	}
+}
+
+func TestDanglingInactivePaths(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader(inactiveBGPConfig + "deactivate protocols bgp group GONE\n")).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	dangling, err := cfg.DanglingInactivePaths()
+	if err != nil {
+		t.Fatalf("DanglingInactivePaths() error = %v", err)
+	}
+	if len(dangling) != 1 || dangling[0] != "protocols bgp group GONE" {
+		t.Fatalf("DanglingInactivePaths() = %#v, want only the marker without a subtree", dangling)
+	}
+
+	delete(cfg.Protocols.BGP.Groups, "EXTERNAL")
+	dangling, err = cfg.DanglingInactivePaths()
+	if err != nil {
+		t.Fatalf("DanglingInactivePaths() after delete error = %v", err)
+	}
+	if len(dangling) != 2 || dangling[0] != "protocols bgp group EXTERNAL" {
+		t.Fatalf("DanglingInactivePaths() after delete = %#v, want the deleted group's marker too", dangling)
+	}
+}
+
+func TestParseDeactivateRejectsUnknownHierarchy(t *testing.T) {
+	for _, text := range []string{"deactivate\n", "deactivate bogus thing\n"} {
+		if _, err := NewParser(strings.NewReader(text)).Parse(); err == nil {
+			t.Fatalf("Parse(%q) error = nil", text)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/akam1o/arca-router/pkg/errors"
+)
+
+// DefaultMaxIncludeDepth is the default limit on nested @include directives.
+const DefaultMaxIncludeDepth = 8
+
+// includeFrame records the including file while an @include is being read.
+type includeFrame struct {
+	path  string
+	file  *os.File
+	lexer *Lexer
+	peek  Token
+}
+
+// ParseFile parses the configuration file at path and splices in every
+// `@include "file"` directive. Relative include paths resolve against the
+// directory of the file containing the directive, and every included file
+// must be inside opts.IncludeDirs (the main file's directory when empty).
+// Cycles and nesting beyond opts.MaxIncludeDepth are rejected.
+func ParseFile(path string, opts ParserOptions) (*Config, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(absPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	if len(opts.IncludeDirs) == 0 {
+		opts.IncludeDirs = []string{filepath.Dir(absPath)}
+	}
+	p := NewParserWithOptions(file, opts)
+	p.path = realPath(absPath)
+	return p.Parse()
+}
+
+// parseInclude handles an @include directive and switches input to the
+// included file.
+func (p *Parser) parseInclude() error {
+	p.nextToken()
+	if p.current.Type != TokenString && p.current.Type != TokenWord {
+		return p.error("expected file path after @include")
+	}
+	target := p.current.Value
+	if p.peek.Type != TokenEOL && p.peek.Type != TokenEOF {
+		p.nextToken()
+		return p.error("expected end of line after @include path")
+	}
+
+	if len(p.options.IncludeDirs) == 0 || p.path == "" {
+		return p.includeError(target, "@include is only supported when loading configuration files")
+	}
+	if p.options.MaxIncludeDepth > 0 && len(p.includes) >= p.options.MaxIncludeDepth {
+		return p.includeError(target, fmt.Sprintf("exceeds maximum include depth of %d", p.options.MaxIncludeDepth))
+	}
+
+	resolved, err := p.resolveInclude(target)
+	if err != nil {
+		return p.includeError(target, err.Error())
+	}
+	if resolved == p.path {
+		return p.includeError(target, "include cycle detected")
+	}
+	for _, frame := range p.includes {
+		if frame.path == resolved {
+			return p.includeError(target, "include cycle detected")
+		}
+	}
+
+	file, err := os.Open(resolved)
+	if err != nil {
+		return p.includeError(target, err.Error())
+	}
+
+	// The end of line after the path is kept as the lookahead to resume
+	// with once the included file ends.
+	p.includes = append(p.includes, &includeFrame{
+		path:  resolved,
+		file:  file,
+		lexer: p.lexer,
+		peek:  p.peek,
+	})
+	p.lexer = NewLexer(p.options.limitReader(file))
+	p.current = Token{Type: TokenEOL, Line: p.current.Line, Column: p.current.Column}
+	p.peek = p.lexer.NextToken()
+	return nil
+}
+
+// popInclude closes the innermost included file and resumes the including
+// file. A synthetic end of line terminates the included file's last statement.
+func (p *Parser) popInclude() {
+	frame := p.includes[len(p.includes)-1]
+	p.includes = p.includes[:len(p.includes)-1]
+	_ = frame.file.Close()
+	p.lexer = frame.lexer
+	p.current = Token{Type: TokenEOL, Line: p.current.Line, Column: p.current.Column}
+	p.peek = frame.peek
+}
+
+func (p *Parser) closeIncludes() {
+	for _, frame := range p.includes {
+		_ = frame.file.Close()
+	}
+	p.includes = nil
+}
+
+// resolveInclude returns the real path of target and checks it against the
+// allowed include directories.
+func (p *Parser) resolveInclude(target string) (string, error) {
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(filepath.Dir(p.currentPath()), target)
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(target))
+	if err != nil {
+		return "", err
+	}
+	for _, dir := range p.options.IncludeDirs {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			continue
+		}
+		if pathWithin(realPath(absDir), resolved) {
+			return resolved, nil
+		}
+	}
+	return "", fmt.Errorf("%s is outside the allowed include directories", resolved)
+}
+
+func (p *Parser) currentPath() string {
+	if len(p.includes) > 0 {
+		return p.includes[len(p.includes)-1].path
+	}
+	return p.path
+}
+
+// includeLocation names the included file for error messages.
+func (p *Parser) includeLocation() string {
+	if len(p.includes) == 0 {
+		return ""
+	}
+	return " in " + p.includes[len(p.includes)-1].path
+}
+
+func (p *Parser) includeError(target, msg string) error {
+	return errors.New(
+		errors.ErrCodeConfigParseError,
+		fmt.Sprintf("Parse error%s at line %d: @include %q: %s", p.includeLocation(), p.current.Line, target, msg),
+		"The included configuration file could not be read",
+		"Check the include path, file permissions, and allowed include directories",
+	)
+}
+
+func realPath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return filepath.Clean(path)
+}
+
+func pathWithin(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
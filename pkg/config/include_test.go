@@ -0,0 +1,118 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestParseFileNestedIncludes(t *testing.T) {
+	dir := t.TempDir()
+	main := writeConfigFile(t, dir, "arca-router.conf", `set system host-name router1
+@include "conf.d/interfaces.conf"
+set routing-options autonomous-system 65001
+`)
+	writeConfigFile(t, dir, "conf.d/interfaces.conf", `set interfaces ge-0/0/0 description "uplink"
+@include "addresses.conf"`)
+	writeConfigFile(t, dir, "conf.d/addresses.conf", `set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24`)
+
+	cfg, err := ParseFile(main, ParserOptions{})
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+	if cfg.System == nil || cfg.System.HostName != "router1" {
+		t.Fatalf("host-name = %#v, want router1", cfg.System)
+	}
+	iface := cfg.Interfaces["ge-0/0/0"]
+	if iface == nil || iface.Description != "uplink" {
+		t.Fatalf("interface = %#v, want description from included file", iface)
+	}
+	unit := iface.Units[0]
+	if unit == nil || unit.Family["inet"] == nil || len(unit.Family["inet"].Addresses) != 1 {
+		t.Fatalf("unit 0 = %#v, want address from nested include", unit)
+	}
+	if cfg.RoutingOptions == nil || cfg.RoutingOptions.AutonomousSystem != 65001 {
+		t.Fatalf("routing-options = %#v, want statement after include", cfg.RoutingOptions)
+	}
+}
+
+func TestParseFileRejectsIncludeCycle(t *testing.T) {
+	dir := t.TempDir()
+	main := writeConfigFile(t, dir, "arca-router.conf", "@include \"a.conf\"\n")
+	writeConfigFile(t, dir, "a.conf", "@include \"b.conf\"\n")
+	writeConfigFile(t, dir, "b.conf", "@include \"a.conf\"\n")
+
+	_, err := ParseFile(main, ParserOptions{})
+	if err == nil || !strings.Contains(err.Error(), "include cycle detected") {
+		t.Fatalf("ParseFile() error = %v, want include cycle error", err)
+	}
+	if !strings.Contains(err.Error(), "b.conf") {
+		t.Fatalf("ParseFile() error = %v, want including file in message", err)
+	}
+}
+
+func TestParseFileRejectsIncludeOutsideAllowedDirs(t *testing.T) {
+	dir := t.TempDir()
+	outside := writeConfigFile(t, t.TempDir(), "outside.conf", "set system host-name outside\n")
+	main := writeConfigFile(t, dir, "arca-router.conf", "@include \""+outside+"\"\n")
+
+	_, err := ParseFile(main, ParserOptions{})
+	if err == nil || !strings.Contains(err.Error(), "outside the allowed include directories") {
+		t.Fatalf("ParseFile() error = %v, want allowlist error", err)
+	}
+
+	cfg, err := ParseFile(main, ParserOptions{IncludeDirs: []string{dir, filepath.Dir(outside)}})
+	if err != nil {
+		t.Fatalf("ParseFile() with allowed dir error = %v", err)
+	}
+	if cfg.System == nil || cfg.System.HostName != "outside" {
+		t.Fatalf("host-name = %#v, want outside", cfg.System)
+	}
+}
+
+func TestParseFileEnforcesIncludeDepth(t *testing.T) {
+	dir := t.TempDir()
+	main := writeConfigFile(t, dir, "arca-router.conf", "@include \"1.conf\"\n")
+	writeConfigFile(t, dir, "1.conf", "@include \"2.conf\"\n")
+	writeConfigFile(t, dir, "2.conf", "@include \"3.conf\"\n")
+	writeConfigFile(t, dir, "3.conf", "set system host-name deep\n")
+
+	if _, err := ParseFile(main, ParserOptions{MaxIncludeDepth: 3}); err != nil {
+		t.Fatalf("ParseFile() depth 3 error = %v", err)
+	}
+	_, err := ParseFile(main, ParserOptions{MaxIncludeDepth: 2})
+	if err == nil || !strings.Contains(err.Error(), "exceeds maximum include depth of 2") {
+		t.Fatalf("ParseFile() error = %v, want include depth error", err)
+	}
+}
+
+func TestParserRejectsIncludeWithoutFile(t *testing.T) {
+	_, err := NewParser(strings.NewReader("@include \"/etc/passwd\"\n")).Parse()
+	if err == nil || !strings.Contains(err.Error(), "only supported when loading configuration files") {
+		t.Fatalf("Parse() error = %v, want include rejected for text input", err)
+	}
+}
+
+func TestParseFileReportsIncludedFileInErrors(t *testing.T) {
+	dir := t.TempDir()
+	main := writeConfigFile(t, dir, "arca-router.conf", "@include \"bad.conf\"\n")
+	writeConfigFile(t, dir, "bad.conf", "set system host-name ok\nset bogus\n")
+
+	_, err := ParseFile(main, ParserOptions{})
+	if err == nil || !strings.Contains(err.Error(), "bad.conf at line") {
+		t.Fatalf("ParseFile() error = %v, want error located in bad.conf", err)
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalConfigJSON encodes cfg as indented JSON using the same member names
+// as the configuration hierarchy, for example "routing-options".
+func MarshalConfigJSON(cfg *Config) ([]byte, error) {
+	if cfg == nil {
+		cfg = NewConfig()
+	}
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal config JSON: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalConfigJSON decodes a configuration produced by MarshalConfigJSON.
+// Unknown members are rejected so typos are not silently dropped.
+func UnmarshalConfigJSON(data []byte) (*Config, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	cfg := NewConfig()
+	if err := dec.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("unmarshal config JSON: %w", err)
+	}
+	return cfg, nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"strings"
@@ -17,8 +18,22 @@ type Lexer struct {
 	ch rune
 	// EOF flag
 	eof bool
+	// err is the read error that ended input early, if it was not io.EOF
+	err error
+
+	// lineText holds the part of the current line read so far. recent
+	// holds the most recently completed lines, the first of which is line
+	// recentFirst. Errors quote the source from both.
+	lineText    strings.Builder
+	recent      []string
+	recentFirst int
 }
 
+// maxRecentLines bounds how many completed lines the lexer keeps for error
+// context. A statement or quoted string spanning more lines is reported
+// without a source excerpt.
+const maxRecentLines = 64
+
 // NewLexer creates a new lexer from an io.Reader
 func NewLexer(r io.Reader) *Lexer {
 	l := &Lexer{
@@ -51,6 +66,20 @@ func (l *Lexer) NextToken() Token {
 		return l.NextToken()
 	case l.ch == '"':
 		return l.readString()
+	case l.ch == '@':
+		return l.readDirective()
+	case l.ch == '<':
+		return l.readPattern()
+	case l.ch == '[':
+		token.Type = TokenLBracket
+		token.Value = "["
+		l.readChar()
+		return token
+	case l.ch == ']':
+		token.Type = TokenRBracket
+		token.Value = "]"
+		l.readChar()
+		return token
 	case isWordChar(l.ch):
 		return l.readWord()
 	default:
@@ -65,6 +94,9 @@ func (l *Lexer) NextToken() Token {
 func (l *Lexer) readChar() {
 	ch, _, err := l.reader.ReadRune()
 	if err != nil {
+		if err != io.EOF {
+			l.err = err
+		}
 		l.eof = true
 		l.ch = 0
 		return
@@ -72,13 +104,128 @@ func (l *Lexer) readChar() {
 
 	l.ch = ch
 	if ch == '\n' {
+		l.finishLine()
 		l.line++
 		l.column = 0
 	} else {
+		l.lineText.WriteRune(ch)
 		l.column++
 	}
 }
 
+// finishLine moves the current line into the recent lines.
+func (l *Lexer) finishLine() {
+	if len(l.recent) == 0 {
+		l.recentFirst = l.line
+	}
+	l.recent = append(l.recent, strings.TrimSuffix(l.lineText.String(), "\r"))
+	if len(l.recent) > maxRecentLines {
+		l.recent = l.recent[1:]
+		l.recentFirst++
+	}
+	l.lineText.Reset()
+}
+
+// sourceLine returns the text of line if the lexer still has it. The rest of
+// the current line is peeked without consuming it.
+func (l *Lexer) sourceLine(line int) (string, bool) {
+	if line == l.line {
+		text := l.lineText.String()
+		if !l.eof {
+			text += l.peekRestOfLine()
+		}
+		return strings.TrimSuffix(text, "\r"), true
+	}
+	if i := line - l.recentFirst; len(l.recent) > 0 && i >= 0 && i < len(l.recent) {
+		return l.recent[i], true
+	}
+	return "", false
+}
+
+func (l *Lexer) peekRestOfLine() string {
+	for n := 64; ; n *= 2 {
+		buf, err := l.reader.Peek(n)
+		if i := bytes.IndexByte(buf, '\n'); i >= 0 {
+			return string(buf[:i])
+		}
+		if err != nil || n >= l.reader.Size() {
+			return string(buf)
+		}
+	}
+}
+
+// errorContext returns the source line of an error at line and column with a
+// caret under the column, like compiler diagnostics, or "" if the line is no
+// longer available. Column 0 marks the end of the previous line, where end of
+// line tokens are reported. Secret values in the line are redacted (see
+// RedactSecretCommand), since errors end up in logs and RPC replies.
+func (l *Lexer) errorContext(line, column int) string {
+	if column == 0 && line > 1 {
+		text, ok := l.sourceLine(line - 1)
+		if !ok {
+			return ""
+		}
+		text, _ = redactSourceLine(text, 0)
+		return caretLine(text, len([]rune(text))+1)
+	}
+	text, ok := l.sourceLine(line)
+	if !ok {
+		return ""
+	}
+	return caretLine(redactSourceLine(text, column))
+}
+
+// redactSourceLine replaces the secret value in a quoted source line with
+// the redacted marker, as RedactSecretCommand does, but keeps the text
+// before the value as written so that column still points at the same
+// character. A column inside or after the value moves to the marker.
+func redactSourceLine(text string, column int) (string, int) {
+	keep := secretValueField(strings.Fields(text))
+	if keep == 0 {
+		return text, column
+	}
+	runes := []rune(text)
+	field, start := 0, 0
+	for i := range runes {
+		if unicode.IsSpace(runes[i]) || (i > 0 && !unicode.IsSpace(runes[i-1])) {
+			continue
+		}
+		if field == keep {
+			start = i
+			break
+		}
+		field++
+	}
+	if column > start+1 {
+		column = start + 1
+	}
+	return string(runes[:start]) + redactedSecretValue, column
+}
+
+// caretLine renders text and a caret under the 1-based rune column, keeping
+// tabs so that the caret lines up however they are displayed.
+func caretLine(text string, column int) string {
+	var b strings.Builder
+	b.WriteString("\n\t")
+	b.WriteString(text)
+	b.WriteString("\n\t")
+	for i, r := range []rune(text) {
+		if i >= column-1 {
+			break
+		}
+		if r == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	for i := len([]rune(text)); i < column-1; i++ {
+		b.WriteRune(' ')
+	}
+	b.WriteString("^")
+	return b.String()
+}
+
 // skipWhitespace skips whitespace except newlines
 func (l *Lexer) skipWhitespace() {
 	for !l.eof && unicode.IsSpace(l.ch) && l.ch != '\n' {
@@ -96,6 +243,30 @@ func (l *Lexer) skipLine() {
 	}
 }
 
+// readPattern reads a <pattern> word used to match names in configuration
+// groups, for example <*> or <ge-*>. The brackets are kept in the value.
+func (l *Lexer) readPattern() Token {
+	token := Token{Type: TokenWord, Line: l.line, Column: l.column}
+	var sb strings.Builder
+
+	sb.WriteRune(l.ch)
+	l.readChar()
+	for !l.eof && (isWordChar(l.ch) || l.ch == '*' || l.ch == '?') {
+		sb.WriteRune(l.ch)
+		l.readChar()
+	}
+	if l.eof || l.ch != '>' {
+		token.Type = TokenError
+		token.Value = "unterminated pattern: missing closing '>'"
+		return token
+	}
+	sb.WriteRune(l.ch)
+	l.readChar()
+
+	token.Value = sb.String()
+	return token
+}
+
 // readWord reads a word token
 func (l *Lexer) readWord() Token {
 	token := Token{Line: l.line, Column: l.column}
@@ -119,6 +290,27 @@ func (l *Lexer) readWord() Token {
 	return token
 }
 
+// readDirective reads an "@" directive token. Only @include is recognized.
+func (l *Lexer) readDirective() Token {
+	token := Token{Line: l.line, Column: l.column}
+	var sb strings.Builder
+
+	sb.WriteRune(l.ch)
+	l.readChar()
+	for !l.eof && isWordChar(l.ch) {
+		sb.WriteRune(l.ch)
+		l.readChar()
+	}
+
+	if sb.String() == "@include" {
+		token.Type = TokenInclude
+		return token
+	}
+	token.Type = TokenError
+	token.Value = fmt.Sprintf("unknown directive: %s", sb.String())
+	return token
+}
+
 // isNumber returns true if the string is a pure number
 func isNumber(s string) bool {
 	if len(s) == 0 {
@@ -132,7 +324,10 @@ func isNumber(s string) bool {
 	return true
 }
 
-// readString reads a quoted string token
+// readString reads a quoted string token. A string may span lines, and a
+// backslash escapes the next character: \n and \t stand for newline and tab,
+// and any other escaped character, including a quote or backslash, stands
+// for itself. An unterminated string is reported at its opening quote.
 func (l *Lexer) readString() Token {
 	token := Token{Line: l.line, Column: l.column, Type: TokenString}
 	var sb strings.Builder
@@ -144,11 +339,8 @@ func (l *Lexer) readString() Token {
 		if l.ch == '\\' {
 			l.readChar()
 			if l.eof {
-				token.Type = TokenError
-				token.Value = "unexpected EOF in string"
-				return token
+				break
 			}
-			// Simple escape handling
 			switch l.ch {
 			case 'n':
 				sb.WriteRune('\n')
@@ -169,7 +361,7 @@ func (l *Lexer) readString() Token {
 
 	if l.eof {
 		token.Type = TokenError
-		token.Value = "unterminated string"
+		token.Value = "unterminated string: missing closing quote"
 		return token
 	}
 
@@ -182,5 +374,5 @@ func (l *Lexer) readString() Token {
 
 // isWordChar returns true if the character is valid in a word
 func isWordChar(ch rune) bool {
-	return unicode.IsLetter(ch) || unicode.IsDigit(ch) || ch == '-' || ch == '_' || ch == '/' || ch == '.' || ch == ':'
+	return unicode.IsLetter(ch) || unicode.IsDigit(ch) || ch == '-' || ch == '_' || ch == '/' || ch == '.' || ch == ':' || ch == '%'
 }
@@ -162,8 +162,112 @@ func TestLexer_UnterminatedString(t *testing.T) {
 	if tok.Type != TokenError {
 		t.Errorf("type = %v, want TokenError", tok.Type)
 	}
-	if tok.Value != "unterminated string" {
-		t.Errorf("value = %q, want %q", tok.Value, "unterminated string")
+	if tok.Value != "unterminated string: missing closing quote" {
+		t.Errorf("value = %q, want %q", tok.Value, "unterminated string: missing closing quote")
+	}
+}
+
+func TestLexer_EscapedQuotes(t *testing.T) {
+	input := `description "a \"quoted\" word \\ done" next`
+
+	lexer := NewLexer(strings.NewReader(input))
+	lexer.NextToken()
+	tok := lexer.NextToken()
+	if tok.Type != TokenString {
+		t.Fatalf("type = %v, want TokenString", tok.Type)
+	}
+	if want := `a "quoted" word \ done`; tok.Value != want {
+		t.Errorf("value = %q, want %q", tok.Value, want)
+	}
+	if next := lexer.NextToken(); next.Type != TokenWord || next.Value != "next" {
+		t.Errorf("token after string = %v %q, want word \"next\"", next.Type, next.Value)
+	}
+}
+
+func TestLexer_NewlineInString(t *testing.T) {
+	input := "set description \"line one\nline two\" end\nset"
+
+	lexer := NewLexer(strings.NewReader(input))
+	lexer.NextToken()
+	lexer.NextToken()
+	tok := lexer.NextToken()
+	if tok.Type != TokenString || tok.Value != "line one\nline two" {
+		t.Fatalf("token = %v %q, want string spanning two lines", tok.Type, tok.Value)
+	}
+	if tok.Line != 1 || tok.Column != 17 {
+		t.Errorf("string position = %d:%d, want 1:17", tok.Line, tok.Column)
+	}
+	end := lexer.NextToken()
+	if end.Value != "end" || end.Line != 2 || end.Column != 11 {
+		t.Errorf("token after string = %q at %d:%d, want \"end\" at 2:11", end.Value, end.Line, end.Column)
+	}
+	lexer.NextToken()
+	if set := lexer.NextToken(); set.Type != TokenSet || set.Line != 3 {
+		t.Errorf("next statement = %v at line %d, want set at line 3", set.Type, set.Line)
+	}
+}
+
+func TestLexer_UnterminatedStringPosition(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{name: "spans to EOF", input: "set system host-name r1\nset description \"oops\nset system host-name r2\n", line: 2, column: 17},
+		{name: "trailing backslash", input: `set description "oops \`, line: 1, column: 17},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := NewLexer(strings.NewReader(tt.input))
+			var tok Token
+			for tok = lexer.NextToken(); tok.Type != TokenError && tok.Type != TokenEOF; tok = lexer.NextToken() {
+			}
+			if tok.Type != TokenError || !strings.HasPrefix(tok.Value, "unterminated string") {
+				t.Fatalf("token = %v %q, want unterminated string error", tok.Type, tok.Value)
+			}
+			if tok.Line != tt.line || tok.Column != tt.column {
+				t.Errorf("error position = %d:%d, want %d:%d", tok.Line, tok.Column, tt.line, tt.column)
+			}
+		})
+	}
+}
+
+func TestLexer_Directives(t *testing.T) {
+	lexer := NewLexer(strings.NewReader(`@include "interfaces.conf"`))
+	if tok := lexer.NextToken(); tok.Type != TokenInclude {
+		t.Errorf("type = %v, want TokenInclude", tok.Type)
+	}
+	if tok := lexer.NextToken(); tok.Type != TokenString || tok.Value != "interfaces.conf" {
+		t.Errorf("token = %v %q, want TokenString %q", tok.Type, tok.Value, "interfaces.conf")
+	}
+
+	tok := NewLexer(strings.NewReader("@import x")).NextToken()
+	if tok.Type != TokenError || tok.Value != "unknown directive: @import" {
+		t.Errorf("token = %v %q, want unknown directive error", tok.Type, tok.Value)
+	}
+}
+
+func TestLexer_ValueListBrackets(t *testing.T) {
+	lexer := NewLexer(strings.NewReader("prefix-list PL [ 10.0.0.0/8 bogus ]\n[a]"))
+	want := []Token{
+		{Type: TokenWord, Value: "prefix-list", Line: 1, Column: 1},
+		{Type: TokenWord, Value: "PL", Line: 1, Column: 13},
+		{Type: TokenLBracket, Value: "[", Line: 1, Column: 16},
+		{Type: TokenWord, Value: "10.0.0.0/8", Line: 1, Column: 18},
+		{Type: TokenWord, Value: "bogus", Line: 1, Column: 29},
+		{Type: TokenRBracket, Value: "]", Line: 1, Column: 35},
+		{Type: TokenEOL, Line: 2, Column: 0},
+		{Type: TokenLBracket, Value: "[", Line: 2, Column: 1},
+		{Type: TokenWord, Value: "a", Line: 2, Column: 2},
+		{Type: TokenRBracket, Value: "]", Line: 2, Column: 3},
+		{Type: TokenEOF, Line: 2, Column: 3},
+	}
+	for i, w := range want {
+		got := lexer.NextToken()
+		if got != w {
+			t.Errorf("token[%d] = %+v, want %+v", i, got, w)
+		}
 	}
 }
 
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SchemaVersion is the version of the set-command configuration schema
+// written by this release. Stored configurations record the version they
+// were written with so that Migrate can upgrade them on load.
+//
+// Version history:
+//
+//	1: configurations stored before schema versions were recorded
+//	2: static route BFD uses the canonical "multi-hop" keyword
+const SchemaVersion = 2
+
+// migrationSteps upgrade set-command text from version i+1 to version i+2.
+var migrationSteps = []func(lines []string) []string{
+	migrateStaticRouteMultihop,
+}
+
+// Migrate upgrades set-command configuration text written with schema
+// fromVersion to SchemaVersion. Text that is already current is returned
+// unchanged.
+func Migrate(raw []byte, fromVersion int) ([]byte, error) {
+	if fromVersion < 1 {
+		return nil, fmt.Errorf("invalid config schema version %d", fromVersion)
+	}
+	if fromVersion > SchemaVersion {
+		return nil, fmt.Errorf("config schema version %d is newer than supported version %d", fromVersion, SchemaVersion)
+	}
+	if fromVersion == SchemaVersion {
+		return raw, nil
+	}
+
+	lines := strings.Split(string(raw), "\n")
+	for _, step := range migrationSteps[fromVersion-1:] {
+		lines = step(lines)
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// migrateStaticRouteMultihop rewrites the "multihop" spelling accepted for
+// static route BFD to "multi-hop", the keyword the serializer writes.
+func migrateStaticRouteMultihop(lines []string) []string {
+	for i, line := range lines {
+		tokens := lineTokens(line)
+		start := -1
+		for j := 0; j+2 < len(tokens); j++ {
+			if tokens[j].Value == "routing-options" && tokens[j+1].Value == "static" && tokens[j+2].Value == "route" {
+				start = j + 6 // after "route <prefix> next-hop <address>"
+				break
+			}
+		}
+		if start < 0 {
+			continue
+		}
+
+		runes := []rune(line)
+		shift := 0 // runes added by earlier replacements on this line
+		for j := start; j < len(tokens); j++ {
+			tok := tokens[j]
+			switch {
+			case tok.Type != TokenWord:
+			case tok.Value == "distance" || tok.Value == "profile" || tok.Value == "source":
+				j++ // skip the parameter value
+			case tok.Value == "multihop":
+				col := tok.Column - 1 + shift
+				runes = append(runes[:col], append([]rune("multi-hop"), runes[col+len("multihop"):]...)...)
+				shift++
+			}
+		}
+		if shift > 0 {
+			lines[i] = string(runes)
+		}
+	}
+	return lines
+}
+
+// lineTokens returns the tokens of a single set command, or nil when the
+// line does not lex cleanly.
+func lineTokens(line string) []Token {
+	lexer := NewLexer(strings.NewReader(line))
+	var tokens []Token
+	for {
+		tok := lexer.NextToken()
+		switch tok.Type {
+		case TokenEOF, TokenEOL:
+			return tokens
+		case TokenError:
+			return nil
+		}
+		tokens = append(tokens, tok)
+	}
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMigrateV1ConfigToCurrentSchema(t *testing.T) {
+	v1 := strings.Join([]string{
+		"set protocols bfd profile multihop receive-interval 150",
+		"set protocols bfd peer 192.0.2.9 multihop",
+		"set routing-options static route 203.0.113.0/24 next-hop 192.0.2.2 bfd profile multihop multihop",
+		"set routing-options static route 198.51.100.0/24 next-hop 192.0.2.3 distance 10",
+		"",
+	}, "\n")
+
+	got, err := Migrate([]byte(v1), 1)
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	want := strings.Join([]string{
+		"set protocols bfd profile multihop receive-interval 150",
+		"set protocols bfd peer 192.0.2.9 multihop",
+		"set routing-options static route 203.0.113.0/24 next-hop 192.0.2.2 bfd profile multihop multi-hop",
+		"set routing-options static route 198.51.100.0/24 next-hop 192.0.2.3 distance 10",
+		"",
+	}, "\n")
+	if string(got) != want {
+		t.Fatalf("Migrate() =\n%s\nwant\n%s", got, want)
+	}
+
+	legacy, err := NewParser(strings.NewReader(v1)).Parse()
+	if err != nil {
+		t.Fatalf("Parse(v1) error = %v", err)
+	}
+	migrated, err := NewParser(strings.NewReader(string(got))).Parse()
+	if err != nil {
+		t.Fatalf("Parse(migrated) error = %v", err)
+	}
+	if ToSetCommands(migrated) != ToSetCommands(legacy) {
+		t.Fatal("migrated config differs from the v1 config it was migrated from")
+	}
+
+	current, err := Migrate(got, SchemaVersion)
+	if err != nil || string(current) != string(got) {
+		t.Fatalf("Migrate(current) = %q, %v, want unchanged", current, err)
+	}
+}
+
+func TestMigrateRejectsUnknownSchemaVersions(t *testing.T) {
+	for _, version := range []int{0, SchemaVersion + 1} {
+		if _, err := Migrate([]byte("set system host-name r1\n"), version); err == nil {
+			t.Errorf("Migrate(version %d) error = nil, want error", version)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStaticNeighborRoundTrip(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/0 unit 0 family inet neighbor 192.0.2.10 mac 00:11:22:33:44:55",
+		"set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64",
+		"set interfaces ge-0/0/0 unit 0 family inet6 neighbor 2001:db8::10 mac 00:11:22:33:44:66",
+	)
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	unit := cfg.Interfaces["ge-0/0/0"].Units[0]
+	if got := unit.Family["inet"].Neighbors["192.0.2.10"]; got != "00:11:22:33:44:55" {
+		t.Fatalf("inet neighbor MAC = %q", got)
+	}
+	if got := unit.Family["inet6"].Neighbors["2001:db8::10"]; got != "00:11:22:33:44:66" {
+		t.Fatalf("inet6 neighbor MAC = %q", got)
+	}
+	text := ToSetCommands(cfg)
+	want := "set interfaces ge-0/0/0 unit 0 family inet neighbor 192.0.2.10 mac 00:11:22:33:44:55"
+	if !strings.Contains(text, want) {
+		t.Fatalf("ToSetCommands() missing %q:\n%s", want, text)
+	}
+	assertSetCommandRoundTrip(t, cfg)
+}
+
+func TestValidate_StaticNeighborIPv6LinkLocal(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64",
+		"set interfaces ge-0/0/0 unit 0 family inet6 neighbor fe80::10 mac 00:11:22:33:44:66",
+	)
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want link-local neighbor accepted", err)
+	}
+}
+
+func TestValidate_StaticNeighborErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "family mismatch",
+			line: "set interfaces ge-0/0/0 unit 0 family inet neighbor 2001:db8::10 mac 00:11:22:33:44:55",
+			want: "Invalid neighbor address",
+		},
+		{
+			name: "off subnet",
+			line: "set interfaces ge-0/0/0 unit 0 family inet neighbor 198.51.100.10 mac 00:11:22:33:44:55",
+			want: "is not on a subnet",
+		},
+		{
+			name: "invalid mac",
+			line: "set interfaces ge-0/0/0 unit 0 family inet neighbor 192.0.2.10 mac 00:11:22",
+			want: "Invalid MAC address",
+		},
+		{
+			name: "multicast mac",
+			line: "set interfaces ge-0/0/0 unit 0 family inet neighbor 192.0.2.10 mac 01:00:5e:00:00:01",
+			want: "Invalid MAC address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := parseSetCommands(t,
+				"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+				tt.line,
+			)
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
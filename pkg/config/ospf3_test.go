@@ -32,7 +32,7 @@ func TestParser_OSPF3(t *testing.T) {
 		t.Fatalf("OSPF3 interfaces = %d, want 2", len(area.Interfaces))
 	}
 	iface := area.Interfaces["ge-0/0/1"]
-	if iface == nil || !iface.Passive || iface.Metric != 100 || !iface.PrioritySet || iface.Priority != 0 {
+	if iface == nil || !iface.Passive || iface.Metric == nil || *iface.Metric != 100 || iface.Priority == nil || *iface.Priority != 0 {
 		t.Fatalf("OSPF3 interface ge-0/0/1 = %#v, want passive metric 100 priority 0", iface)
 	}
 }
@@ -46,7 +46,7 @@ func TestToSetCommandsWritesOSPF3(t *testing.T) {
 					"0.0.0.0": {
 						AreaID: "0.0.0.0",
 						Interfaces: map[string]*OSPFInterface{
-							"ge-0/0/0": {Name: "ge-0/0/0", Metric: 20},
+							"ge-0/0/0": {Name: "ge-0/0/0", Metric: intPtr(20)},
 						},
 					},
 				},
@@ -96,3 +96,23 @@ func TestValidate_OSPF3AllowsMissingRouterID(t *testing.T) {
 		t.Fatalf("Validate() error = %v, want nil", err)
 	}
 }
+
+func TestValidate_OSPF3RequiresInet6Address(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader(strings.Join([]string{
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		"set protocols ospf3 router-id 10.0.1.2",
+		"set protocols ospf3 area 0.0.0.0 interface ge-0/0/0",
+	}, "\n"))).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "has no inet6 address") {
+		t.Fatalf("Validate() error = %v, want missing inet6 address error", err)
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
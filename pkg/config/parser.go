@@ -10,25 +10,28 @@ import (
 
 // Parser parses set-style configuration
 type Parser struct {
-	lexer   *Lexer
-	current Token
-	peek    Token
+	lexer      *Lexer
+	current    Token
+	peek       Token
+	options    ParserOptions
+	statements int
+
+	// path is the file being parsed, if any; includes holds the stack of
+	// open @include files.
+	path     string
+	includes []*includeFrame
 }
 
-// NewParser creates a new parser from an io.Reader
+// NewParser creates a new parser from an io.Reader using the default input
+// limits (see DefaultParserOptions).
 func NewParser(r io.Reader) *Parser {
-	p := &Parser{
-		lexer: NewLexer(r),
-	}
-	// Read two tokens to initialize current and peek
-	p.nextToken()
-	p.nextToken()
-	return p
+	return NewParserWithOptions(r, DefaultParserOptions())
 }
 
 // Parse parses the entire configuration and returns a Config
 func (p *Parser) Parse() (*Config, error) {
 	config := NewConfig()
+	defer p.closeIncludes()
 
 	for p.current.Type != TokenEOF {
 		// Skip empty lines
@@ -37,12 +40,30 @@ func (p *Parser) Parse() (*Config, error) {
 			continue
 		}
 
+		if p.current.Type == TokenInclude {
+			if err := p.parseInclude(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		p.statements++
+		if p.options.MaxStatements > 0 && p.statements > p.options.MaxStatements {
+			return nil, p.statementLimitError()
+		}
+
 		if err := p.parseStatement(config); err != nil {
+			if limitErr := p.inputLimitError(); limitErr != nil {
+				return nil, limitErr
+			}
 			return nil, err
 		}
 
 		// Expect EOL or EOF after each statement
 		if p.current.Type != TokenEOL && p.current.Type != TokenEOF {
+			if limitErr := p.inputLimitError(); limitErr != nil {
+				return nil, limitErr
+			}
 			return nil, p.error("expected end of line after statement")
 		}
 
@@ -51,14 +72,21 @@ func (p *Parser) Parse() (*Config, error) {
 			p.nextToken()
 		}
 	}
+	if err := p.inputLimitError(); err != nil {
+		return nil, err
+	}
 
 	return config, nil
 }
 
-// nextToken advances to the next token
+// nextToken advances to the next token, returning to the including file
+// when an included file is exhausted
 func (p *Parser) nextToken() {
 	p.current = p.peek
 	p.peek = p.lexer.NextToken()
+	if p.current.Type == TokenEOF && len(p.includes) > 0 && p.lexer.err == nil {
+		p.popInclude()
+	}
 }
 
 // parseStatement parses a single set statement
@@ -68,6 +96,11 @@ func (p *Parser) parseStatement(config *Config) error {
 		return p.lexerError(p.current.Value)
 	}
 
+	if p.current.Type == TokenWord && p.current.Value == "deactivate" {
+		p.nextToken()
+		return p.parseDeactivate(config)
+	}
+
 	// Expect "set" keyword
 	if p.current.Type != TokenSet {
 		return p.error(fmt.Sprintf("expected 'set', got %s", p.current.Type))
@@ -106,6 +139,10 @@ func (p *Parser) parseStatement(config *Config) error {
 		return p.parseClassOfService(config)
 	case "security":
 		return p.parseSecurity(config)
+	case "firewall":
+		return p.parseFirewall(config)
+	case "groups":
+		return p.parseGroups(config)
 	default:
 		return p.error(fmt.Sprintf("unsupported keyword: %s", keyword))
 	}
@@ -133,11 +170,91 @@ func (p *Parser) parseSystem(config *Config) error {
 		return nil
 	case "services":
 		return p.parseSystemServices(config)
+	case "scripts":
+		return p.parseSystemScripts(config)
+	case "alarms":
+		return p.parseSystemAlarms(config)
 	default:
 		return p.error(fmt.Sprintf("unsupported system parameter: %s", param))
 	}
 }
 
+// parseSystemAlarms parses "alarms interface error-rate <errors-per-second>"
+// and "alarms interface link-down".
+func (p *Parser) parseSystemAlarms(config *Config) error {
+	if p.current.Type != TokenWord || p.current.Value != "interface" {
+		return p.error("expected 'interface' after 'alarms'")
+	}
+	p.nextToken()
+
+	if p.current.Type != TokenWord {
+		return p.error("expected interface alarm type (error-rate or link-down)")
+	}
+	alarm := p.current.Value
+	p.nextToken()
+
+	if config.System == nil {
+		config.System = &SystemConfig{}
+	}
+	if config.System.Alarms == nil {
+		config.System.Alarms = &SystemAlarmsConfig{}
+	}
+	switch alarm {
+	case "error-rate":
+		if p.current.Type != TokenNumber {
+			return p.error("expected error-rate threshold in errors per second")
+		}
+		threshold, err := strconv.ParseUint(p.current.Value, 10, 64)
+		if err != nil || threshold == 0 {
+			return p.error(fmt.Sprintf("invalid error-rate threshold: %s", p.current.Value))
+		}
+		config.System.Alarms.InterfaceErrorRate = threshold
+		p.nextToken()
+	case "link-down":
+		config.System.Alarms.InterfaceLinkDown = true
+	default:
+		return p.error(fmt.Sprintf("unsupported interface alarm: %s", alarm))
+	}
+	return nil
+}
+
+// parseSystemScripts parses "scripts commit pre|post <path>".
+func (p *Parser) parseSystemScripts(config *Config) error {
+	if p.current.Type != TokenWord || p.current.Value != "commit" {
+		return p.error("expected 'commit' after 'scripts'")
+	}
+	p.nextToken()
+
+	if p.current.Type != TokenWord {
+		return p.error("expected 'pre' or 'post' after 'scripts commit'")
+	}
+	stage := p.current.Value
+	if stage != "pre" && stage != "post" {
+		return p.error(fmt.Sprintf("unsupported commit script stage: %s", stage))
+	}
+	p.nextToken()
+
+	if p.current.Type != TokenWord && p.current.Type != TokenString {
+		return p.error("expected commit script path")
+	}
+	path := p.current.Value
+	p.nextToken()
+
+	if config.System == nil {
+		config.System = &SystemConfig{}
+	}
+	if config.System.Scripts == nil {
+		config.System.Scripts = &SystemScriptsConfig{}
+	}
+	scripts := config.System.Scripts
+	if stage == "pre" {
+		scripts.CommitPre = appendUniqueString(scripts.CommitPre, path)
+	} else {
+		scripts.CommitPost = appendUniqueString(scripts.CommitPost, path)
+	}
+	return nil
+}
+
 func (p *Parser) parseSystemServices(config *Config) error {
 	if p.current.Type != TokenWord {
 		return p.error("expected system service name")
@@ -418,6 +535,15 @@ func (p *Parser) parseClusterSync(cluster *ClusterConfig) error {
 
 // parseInterfaces parses interface configuration
 func (p *Parser) parseInterfaces(config *Config) error {
+	if p.current.Type == TokenWord && isWildcardPattern(p.current.Value) {
+		return p.error(fmt.Sprintf("interface pattern %s is only allowed in groups", p.current.Value))
+	}
+	return p.parseInterfaceStatement(config)
+}
+
+// parseInterfaceStatement parses the statement of one interface, whose name
+// may be a <pattern> inside groups
+func (p *Parser) parseInterfaceStatement(config *Config) error {
 	// Expect interface name
 	if p.current.Type != TokenWord {
 		return p.error("expected interface name")
@@ -439,6 +565,25 @@ func (p *Parser) parseInterfaces(config *Config) error {
 	switch param {
 	case "description":
 		return p.parseInterfaceDescription(iface)
+	case "promiscuous":
+		iface.Promiscuous = true
+		return nil
+	case "rx-mode":
+		return p.parseInterfaceRxMode(iface)
+	case "mtu":
+		return p.parseMTU(&iface.MTU)
+	case "bandwidth":
+		return p.parseInterfaceBandwidth(iface)
+	case "policer":
+		return p.parseInterfacePolicer(iface)
+	case "tunnel":
+		return p.parseInterfaceTunnel(iface)
+	case "hold-time":
+		return p.parseInterfaceHoldTime(iface)
+	case "gigether-options":
+		return p.parseInterfaceGigEtherOptions(iface)
+	case "apply-groups":
+		return p.parseInterfaceApplyGroups(iface)
 	case "unit":
 		return p.parseInterfaceUnit(iface)
 	default:
@@ -446,6 +591,126 @@ func (p *Parser) parseInterfaces(config *Config) error {
 	}
 }
 
+// parseInterfaceTunnel parses tunnel source and destination addresses
+func (p *Parser) parseInterfaceTunnel(iface *Interface) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected tunnel parameter (source or destination)")
+	}
+	param := p.current.Value
+	p.nextToken()
+
+	if p.current.Type != TokenWord {
+		return p.error(fmt.Sprintf("expected tunnel %s address", param))
+	}
+	if iface.Tunnel == nil {
+		iface.Tunnel = &Tunnel{}
+	}
+	switch param {
+	case "source":
+		iface.Tunnel.Source = p.current.Value
+	case "destination":
+		iface.Tunnel.Destination = p.current.Value
+	default:
+		return p.error(fmt.Sprintf("unsupported tunnel parameter: %s", param))
+	}
+	p.nextToken()
+	return nil
+}
+
+// parseInterfaceHoldTime parses "hold-time up <ms> down <ms>". Either
+// direction may be given alone; the other keeps its current value.
+func (p *Parser) parseInterfaceHoldTime(iface *Interface) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected hold-time direction (up or down)")
+	}
+	if iface.HoldTime == nil {
+		iface.HoldTime = &HoldTime{}
+	}
+	for p.current.Type == TokenWord {
+		direction := p.current.Value
+		var target *int
+		switch direction {
+		case "up":
+			target = &iface.HoldTime.Up
+		case "down":
+			target = &iface.HoldTime.Down
+		default:
+			return p.error(fmt.Sprintf("unsupported hold-time direction: %s", direction))
+		}
+		p.nextToken()
+		if p.current.Type != TokenNumber {
+			return p.error(fmt.Sprintf("expected hold-time %s value in milliseconds", direction))
+		}
+		value, err := strconv.Atoi(p.current.Value)
+		if err != nil {
+			return p.error(fmt.Sprintf("invalid hold-time %s value: %s", direction, p.current.Value))
+		}
+		*target = value
+		p.nextToken()
+	}
+	return nil
+}
+
+// parseInterfaceGigEtherOptions parses "gigether-options rxq <n>",
+// "gigether-options txq <n>", and "gigether-options rx-queue <id> worker <n>".
+// Several parameters may follow one gigether-options keyword.
+func (p *Parser) parseInterfaceGigEtherOptions(iface *Interface) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected gigether-options parameter (rxq, txq, or rx-queue)")
+	}
+	if iface.GigEtherOptions == nil {
+		iface.GigEtherOptions = &GigEtherOptions{}
+	}
+	opts := iface.GigEtherOptions
+	for p.current.Type == TokenWord {
+		param := p.current.Value
+		p.nextToken()
+
+		switch param {
+		case "rxq":
+			if err := p.parseGigEtherNumber("rxq", &opts.RxQueues); err != nil {
+				return err
+			}
+		case "txq":
+			if err := p.parseGigEtherNumber("txq", &opts.TxQueues); err != nil {
+				return err
+			}
+		case "rx-queue":
+			var queue, worker int
+			if err := p.parseGigEtherNumber("rx-queue", &queue); err != nil {
+				return err
+			}
+			if p.current.Type != TokenWord || p.current.Value != "worker" {
+				return p.error("expected 'worker' keyword")
+			}
+			p.nextToken()
+			if err := p.parseGigEtherNumber("worker", &worker); err != nil {
+				return err
+			}
+			if opts.RxPlacement == nil {
+				opts.RxPlacement = make(map[int]int)
+			}
+			opts.RxPlacement[queue] = worker
+		default:
+			return p.error(fmt.Sprintf("unsupported gigether-options parameter: %s", param))
+		}
+	}
+	return nil
+}
+
+func (p *Parser) parseGigEtherNumber(name string, target *int) error {
+	if p.current.Type != TokenNumber {
+		return p.error(fmt.Sprintf("expected %s value", name))
+	}
+	value, err := strconv.Atoi(p.current.Value)
+	if err != nil {
+		return p.error(fmt.Sprintf("invalid %s value: %s", name, p.current.Value))
+	}
+	*target = value
+	p.nextToken()
+	return nil
+}
+
 // parseInterfaceDescription parses interface description
 func (p *Parser) parseInterfaceDescription(iface *Interface) error {
 	if p.current.Type != TokenString && p.current.Type != TokenWord {
@@ -457,6 +722,51 @@ func (p *Parser) parseInterfaceDescription(iface *Interface) error {
 	return nil
 }
 
+// parseInterfaceRxMode parses interface RX queue mode
+func (p *Parser) parseInterfaceRxMode(iface *Interface) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected rx-mode (polling, interrupt, or adaptive)")
+	}
+
+	iface.RxMode = p.current.Value
+	p.nextToken()
+	return nil
+}
+
+// parseMTU parses an MTU value in bytes
+func (p *Parser) parseMTU(mtu *int) error {
+	if p.current.Type != TokenNumber {
+		return p.error("expected MTU value")
+	}
+	value, err := strconv.Atoi(p.current.Value)
+	if err != nil {
+		return p.error(fmt.Sprintf("invalid MTU value: %s", p.current.Value))
+	}
+	*mtu = value
+	p.nextToken()
+	return nil
+}
+
+// parseInterfaceBandwidth parses a logical bandwidth in bits per second with
+// an optional k, m, or g suffix, for example "10g"
+func (p *Parser) parseInterfaceBandwidth(iface *Interface) error {
+	if p.current.Type != TokenNumber && p.current.Type != TokenWord {
+		return p.error("expected bandwidth value")
+	}
+	value, err := ParseUnitValue(p.current.Value)
+	if err != nil {
+		return p.error(fmt.Sprintf("invalid bandwidth: %s", p.current.Value))
+	}
+	// Zero would mean "not configured" and be dropped on serialization, so
+	// reject it here like any other bandwidth below the minimum.
+	if value == 0 {
+		return p.error(fmt.Sprintf("invalid bandwidth: %s (minimum %s)", p.current.Value, FormatUnitValue(MinInterfaceBandwidth)))
+	}
+	iface.Bandwidth = value
+	p.nextToken()
+	return nil
+}
+
 // parseInterfaceUnit parses interface unit configuration
 func (p *Parser) parseInterfaceUnit(iface *Interface) error {
 	// Expect unit number
@@ -488,9 +798,26 @@ func (p *Parser) parseInterfaceUnit(iface *Interface) error {
 
 	family := unit.GetOrCreateFamily(familyName)
 
+	// A bare family enables it without addresses (inet6 link-local only).
+	if p.current.Type == TokenEOL || p.current.Type == TokenEOF {
+		return nil
+	}
+	if p.current.Type == TokenWord && p.current.Value == "neighbor" {
+		p.nextToken()
+		return p.parseFamilyNeighbor(family)
+	}
+	if p.current.Type == TokenWord && p.current.Value == "mtu" {
+		p.nextToken()
+		return p.parseMTU(&family.MTU)
+	}
+	if p.current.Type == TokenWord && p.current.Value == "router-advertisement" {
+		p.nextToken()
+		return p.parseRouterAdvertisement(family)
+	}
+
 	// Expect "address" keyword
 	if p.current.Type != TokenWord || p.current.Value != "address" {
-		return p.error("expected 'address' keyword")
+		return p.error("expected 'address', 'mtu', 'neighbor', or 'router-advertisement' keyword")
 	}
 	p.nextToken()
 
@@ -506,21 +833,181 @@ func (p *Parser) parseInterfaceUnit(iface *Interface) error {
 	return nil
 }
 
+// parseFamilyNeighbor parses a static neighbor: neighbor <ip> mac <mac>
+func (p *Parser) parseFamilyNeighbor(family *Family) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected neighbor IP address")
+	}
+	ip := p.current.Value
+	p.nextToken()
+
+	if p.current.Type != TokenWord || p.current.Value != "mac" {
+		return p.error("expected 'mac' keyword")
+	}
+	p.nextToken()
+
+	if p.current.Type != TokenWord {
+		return p.error("expected MAC address")
+	}
+	if family.Neighbors == nil {
+		family.Neighbors = make(map[string]string)
+	}
+	family.Neighbors[ip] = p.current.Value
+	p.nextToken()
+
+	return nil
+}
+
+// parseRouterAdvertisement parses router-advertisement [<option>]. The bare
+// statement enables advertisements with default settings.
+func (p *Parser) parseRouterAdvertisement(family *Family) error {
+	if family.RouterAdvertisement == nil {
+		family.RouterAdvertisement = &RouterAdvertisement{}
+	}
+	ra := family.RouterAdvertisement
+	if p.current.Type != TokenWord {
+		return nil
+	}
+
+	param := p.current.Value
+	p.nextToken()
+
+	switch param {
+	case "managed-configuration":
+		ra.ManagedConfiguration = true
+		return nil
+	case "other-stateful-configuration":
+		ra.OtherStatefulConfiguration = true
+		return nil
+	case "max-advertisement-interval":
+		return p.parseRouterAdvertisementNumber("max-advertisement-interval", &ra.MaxAdvertisementInterval)
+	case "min-advertisement-interval":
+		return p.parseRouterAdvertisementNumber("min-advertisement-interval", &ra.MinAdvertisementInterval)
+	case "prefix":
+		return p.parseRouterAdvertisementPrefix(ra)
+	default:
+		return p.error(fmt.Sprintf("unsupported router-advertisement parameter: %s", param))
+	}
+}
+
+// parseRouterAdvertisementPrefix parses prefix <prefix> [valid-lifetime <s>]
+// [preferred-lifetime <s>]
+func (p *Parser) parseRouterAdvertisementPrefix(ra *RouterAdvertisement) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected IPv6 prefix in CIDR format")
+	}
+	if ra.Prefixes == nil {
+		ra.Prefixes = make(map[string]*RouterAdvertisementPrefix)
+	}
+	prefix := ra.Prefixes[p.current.Value]
+	if prefix == nil {
+		prefix = &RouterAdvertisementPrefix{}
+		ra.Prefixes[p.current.Value] = prefix
+	}
+	p.nextToken()
+
+	for p.current.Type == TokenWord {
+		param := p.current.Value
+		p.nextToken()
+		switch param {
+		case "valid-lifetime":
+			if err := p.parseRouterAdvertisementNumber("valid-lifetime", &prefix.ValidLifetime); err != nil {
+				return err
+			}
+		case "preferred-lifetime":
+			if err := p.parseRouterAdvertisementNumber("preferred-lifetime", &prefix.PreferredLifetime); err != nil {
+				return err
+			}
+		default:
+			return p.error(fmt.Sprintf("unsupported router-advertisement prefix parameter: %s", param))
+		}
+	}
+	return nil
+}
+
+func (p *Parser) parseRouterAdvertisementNumber(name string, target *int) error {
+	if p.current.Type != TokenNumber {
+		return p.error(fmt.Sprintf("expected router-advertisement %s value", name))
+	}
+	value, err := strconv.Atoi(p.current.Value)
+	if err != nil {
+		return p.error(fmt.Sprintf("invalid router-advertisement %s: %s", name, p.current.Value))
+	}
+	*target = value
+	p.nextToken()
+	return nil
+}
+
+// parseValueList parses the value of a statement that accepts several
+// values at once: a single value, or a bracketed list such as
+// "[ 10.0.0.0/8 172.16.0.0/12 ]". Each value must have one of the token
+// types in types and is passed to add, which validates and stores it; an
+// error from add is reported at the offending value.
+func (p *Parser) parseValueList(what string, add func(value string) error, types ...TokenType) error {
+	accepted := func() bool {
+		for _, t := range types {
+			if p.current.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+	if p.current.Type != TokenLBracket {
+		if !accepted() {
+			return p.error("expected " + what)
+		}
+		if err := add(p.current.Value); err != nil {
+			return err
+		}
+		p.nextToken()
+		return nil
+	}
+
+	p.nextToken()
+	count := 0
+	for p.current.Type != TokenRBracket {
+		if !accepted() {
+			return p.error(fmt.Sprintf("expected %s or ']'", what))
+		}
+		if err := add(p.current.Value); err != nil {
+			return err
+		}
+		p.nextToken()
+		count++
+	}
+	if count == 0 {
+		return p.error(fmt.Sprintf("expected %s in value list", what))
+	}
+	p.nextToken()
+	return nil
+}
+
 // error creates a parse error
+// A lexer error at the current token takes precedence over msg, since the
+// parser only failed because the lexer could not produce the token.
 func (p *Parser) error(msg string) error {
+	if p.current.Type == TokenError {
+		return p.lexerError(p.current.Value)
+	}
 	return errors.New(
 		errors.ErrCodeConfigParseError,
-		fmt.Sprintf("Parse error at line %d, column %d: %s", p.current.Line, p.current.Column, msg),
+		fmt.Sprintf("Parse error%s at line %d, column %d: %s%s", p.includeLocation(), p.current.Line, p.current.Column, msg, p.errorContext()),
 		"The configuration file contains invalid syntax",
 		"Review the configuration file and fix the syntax error",
 	)
 }
 
+// errorContext quotes the source line of the current token with a caret
+// under its column.
+func (p *Parser) errorContext() string {
+	return p.lexer.errorContext(p.current.Line, p.current.Column)
+}
+
 // lexerError creates an error from a lexer error message
 func (p *Parser) lexerError(msg string) error {
 	return errors.New(
 		errors.ErrCodeConfigParseError,
-		fmt.Sprintf("Lexer error at line %d, column %d: %s", p.current.Line, p.current.Column, msg),
+		fmt.Sprintf("Lexer error%s at line %d, column %d: %s%s", p.includeLocation(), p.current.Line, p.current.Column, msg, p.errorContext()),
 		"The configuration file contains invalid characters or formatting",
 		"Review the configuration file and fix the syntax error",
 	)
@@ -546,6 +1033,8 @@ func (p *Parser) parseRoutingOptions(config *Config) error {
 		return p.parseRouterID(config.RoutingOptions)
 	case "static":
 		return p.parseStaticRoute(config.RoutingOptions)
+	case "administrative-distance":
+		return p.parseAdministrativeDistance(config.RoutingOptions)
 	default:
 		return p.error(fmt.Sprintf("unsupported routing-options parameter: %s", param))
 	}
@@ -582,6 +1071,81 @@ func (p *Parser) parseRouterID(ro *RoutingOptions) error {
 	return nil
 }
 
+// parseAdministrativeDistance parses
+// "administrative-distance protocol <protocol> [<route-type>] <distance>".
+// Without a route type the distance applies to external BGP routes or to
+// all OSPF routes.
+func (p *Parser) parseAdministrativeDistance(ro *RoutingOptions) error {
+	if p.current.Type != TokenWord || p.current.Value != "protocol" {
+		return p.error("expected 'protocol' keyword")
+	}
+	p.nextToken()
+
+	if p.current.Type != TokenWord {
+		return p.error("expected protocol name (bgp, ospf, ospf3)")
+	}
+	protocol := p.current.Value
+	p.nextToken()
+
+	routeType := ""
+	if p.current.Type == TokenWord {
+		routeType = p.current.Value
+		p.nextToken()
+	}
+	if p.current.Type != TokenNumber {
+		return p.error("expected distance value")
+	}
+	distance, err := strconv.Atoi(p.current.Value)
+	if err != nil || distance < 1 || distance > 255 {
+		return p.error(fmt.Sprintf("distance out of range (1-255): %s", p.current.Value))
+	}
+	p.nextToken()
+
+	if ro.AdministrativeDistance == nil {
+		ro.AdministrativeDistance = &AdministrativeDistance{}
+	}
+	ad := ro.AdministrativeDistance
+	switch protocol {
+	case "bgp":
+		if ad.BGP == nil {
+			ad.BGP = &BGPDistance{}
+		}
+		switch routeType {
+		case "", "external":
+			ad.BGP.External = distance
+		case "internal":
+			ad.BGP.Internal = distance
+		case "local":
+			ad.BGP.Local = distance
+		default:
+			return p.error(fmt.Sprintf("unsupported BGP distance type: %s (expected external, internal, or local)", routeType))
+		}
+	case "ospf", "ospf3":
+		target := &ad.OSPF
+		if protocol == "ospf3" {
+			target = &ad.OSPF3
+		}
+		if *target == nil {
+			*target = &OSPFDistance{}
+		}
+		switch routeType {
+		case "":
+			(*target).Default = distance
+		case "intra-area":
+			(*target).IntraArea = distance
+		case "inter-area":
+			(*target).InterArea = distance
+		case "external":
+			(*target).External = distance
+		default:
+			return p.error(fmt.Sprintf("unsupported %s distance type: %s (expected intra-area, inter-area, or external)", protocol, routeType))
+		}
+	default:
+		return p.error(fmt.Sprintf("unsupported administrative-distance protocol: %s", protocol))
+	}
+	return nil
+}
+
 // parseStaticRoute parses static route configuration
 func (p *Parser) parseStaticRoute(ro *RoutingOptions) error {
 	// Expect "route" keyword
@@ -733,26 +1297,20 @@ func (p *Parser) parseRoutingInstances(config *Config) error {
 		p.nextToken()
 		return nil
 	case "vrf-import":
-		if p.current.Type != TokenWord && p.current.Type != TokenString {
-			return p.error("expected vrf-import policy")
-		}
-		instance.VRFImport = appendUniqueString(instance.VRFImport, p.current.Value)
-		p.nextToken()
-		return nil
+		return p.parseValueList("vrf-import policy", func(value string) error {
+			instance.VRFImport = appendUniqueString(instance.VRFImport, value)
+			return nil
+		}, TokenWord, TokenString)
 	case "vrf-export":
-		if p.current.Type != TokenWord && p.current.Type != TokenString {
-			return p.error("expected vrf-export policy")
-		}
-		instance.VRFExport = appendUniqueString(instance.VRFExport, p.current.Value)
-		p.nextToken()
-		return nil
+		return p.parseValueList("vrf-export policy", func(value string) error {
+			instance.VRFExport = appendUniqueString(instance.VRFExport, value)
+			return nil
+		}, TokenWord, TokenString)
 	case "interface":
-		if p.current.Type != TokenWord {
-			return p.error("expected routing-instance interface")
-		}
-		instance.Interfaces = appendUniqueString(instance.Interfaces, p.current.Value)
-		p.nextToken()
-		return nil
+		return p.parseValueList("routing-instance interface", func(value string) error {
+			instance.Interfaces = appendUniqueString(instance.Interfaces, value)
+			return nil
+		}, TokenWord)
 	default:
 		return p.error(fmt.Sprintf("unsupported routing-instance parameter: %s", param))
 	}
@@ -130,6 +130,7 @@ func (p *Parser) parseCoSInterface(cos *ClassOfServiceConfig) error {
 //
 //	set security netconf ssh enabled <true|false>
 //	set security netconf ssh listen-address <address>
+//	set security netconf ssh listen <address>
 //	set security netconf ssh port <port>
 //	set security users user <username> password <password>
 //	set security users user <username> role <role>
@@ -161,6 +162,7 @@ func (p *Parser) parseSecurity(config *Config) error {
 //
 //	set security netconf ssh enabled <true|false>
 //	set security netconf ssh listen-address <address>
+//	set security netconf ssh listen <address>
 //	set security netconf ssh port <port>
 func (p *Parser) parseSecurityNETCONF(config *Config) error {
 	if config.Security == nil {
@@ -202,6 +204,13 @@ func (p *Parser) parseSecurityNETCONF(config *Config) error {
 		ssh.ListenAddress = p.current.Value
 		p.nextToken()
 		return nil
+	case "listen":
+		if p.current.Type != TokenWord && p.current.Type != TokenString {
+			return p.error("expected netconf ssh listen address")
+		}
+		ssh.Listen = appendUniqueString(ssh.Listen, p.current.Value)
+		p.nextToken()
+		return nil
 	case "port":
 		if p.current.Type != TokenWord && p.current.Type != TokenNumber {
 			return p.error("expected port number")
@@ -227,6 +236,7 @@ func (p *Parser) parseSecurityNETCONF(config *Config) error {
 //	set security users user <username> password <password>
 //	set security users user <username> role <role>
 //	set security users user <username> ssh-key "<key>"
+//	set security users user <username> disable
 func (p *Parser) parseSecurityUsers(config *Config) error {
 	if config.Security == nil {
 		config.Security = &SecurityConfig{}
@@ -256,7 +266,7 @@ func (p *Parser) parseSecurityUsers(config *Config) error {
 	user := config.Security.Users[username]
 
 	if p.current.Type != TokenWord {
-		return p.error("expected user parameter (password, role, ssh-key)")
+		return p.error("expected user parameter (password, role, ssh-key, disable)")
 	}
 
 	param := p.current.Value
@@ -292,6 +302,9 @@ func (p *Parser) parseSecurityUsers(config *Config) error {
 		user.SSHKey = p.current.Value
 		p.nextToken()
 
+	case "disable":
+		user.Disabled = true
+
 	default:
 		return p.error(fmt.Sprintf("unsupported user parameter: %s", param))
 	}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseFirewall parses firewall configuration
+// Syntax:
+//
+//	set firewall policer <name> if-exceeding bandwidth-limit <rate>
+//	set firewall policer <name> if-exceeding burst-size-limit <size>
+//	set firewall policer <name> then discard
+//
+// The clauses may be combined on one line, for example
+// "if-exceeding bandwidth-limit 100m burst-size-limit 15k then discard".
+func (p *Parser) parseFirewall(config *Config) error {
+	if p.current.Type != TokenWord || p.current.Value != "policer" {
+		return p.error("expected 'policer' after 'firewall'")
+	}
+	p.nextToken()
+
+	if p.current.Type != TokenWord && p.current.Type != TokenNumber {
+		return p.error("expected policer name")
+	}
+	name := p.current.Value
+	p.nextToken()
+
+	if config.Firewall == nil {
+		config.Firewall = &FirewallConfig{}
+	}
+	if config.Firewall.Policers == nil {
+		config.Firewall.Policers = make(map[string]*Policer)
+	}
+	policer := config.Firewall.Policers[name]
+	if policer == nil {
+		policer = &Policer{Name: name}
+		config.Firewall.Policers[name] = policer
+	}
+
+	if p.current.Type != TokenWord {
+		return p.error("expected policer parameter")
+	}
+	for p.current.Type != TokenEOL && p.current.Type != TokenEOF {
+		if p.current.Type != TokenWord {
+			return p.error("expected policer parameter")
+		}
+		param := p.current.Value
+		p.nextToken()
+
+		switch param {
+		case "if-exceeding":
+			if err := p.parsePolicerLimit(policer); err != nil {
+				return err
+			}
+			for p.current.Type == TokenWord && isPolicerLimit(p.current.Value) {
+				if err := p.parsePolicerLimit(policer); err != nil {
+					return err
+				}
+			}
+		case "then":
+			if p.current.Type != TokenWord {
+				return p.error("expected policer action")
+			}
+			policer.Action = p.current.Value
+			p.nextToken()
+		default:
+			return p.error(fmt.Sprintf("unsupported policer parameter: %s", param))
+		}
+	}
+	return nil
+}
+
+// isPolicerLimit reports whether word names an if-exceeding limit.
+func isPolicerLimit(word string) bool {
+	return word == "bandwidth-limit" || word == "burst-size-limit"
+}
+
+// parsePolicerLimit parses a policer bandwidth or burst limit
+func (p *Parser) parsePolicerLimit(policer *Policer) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected 'bandwidth-limit' or 'burst-size-limit'")
+	}
+	limit := p.current.Value
+	p.nextToken()
+
+	if !isPolicerLimit(limit) {
+		return p.error(fmt.Sprintf("unsupported policer limit: %s", limit))
+	}
+	if p.current.Type != TokenNumber && p.current.Type != TokenWord {
+		return p.error(fmt.Sprintf("expected %s value", limit))
+	}
+	value, err := ParseUnitValue(p.current.Value)
+	if err != nil {
+		return p.error(fmt.Sprintf("invalid %s: %s", limit, p.current.Value))
+	}
+	if limit == "bandwidth-limit" {
+		policer.BandwidthLimit = value
+	} else {
+		policer.BurstSizeLimit = value
+	}
+	p.nextToken()
+	return nil
+}
+
+// parseInterfacePolicer parses an interface policer binding
+// Syntax:
+//
+//	set interfaces <name> policer input <policer>
+//	set interfaces <name> policer output <policer>
+func (p *Parser) parseInterfacePolicer(iface *Interface) error {
+	if p.current.Type != TokenWord || (p.current.Value != "input" && p.current.Value != "output") {
+		return p.error("expected 'input' or 'output' after 'policer'")
+	}
+	direction := p.current.Value
+	p.nextToken()
+
+	if p.current.Type != TokenWord && p.current.Type != TokenNumber {
+		return p.error("expected policer name")
+	}
+	if direction == "input" {
+		iface.InputPolicer = p.current.Value
+	} else {
+		iface.OutputPolicer = p.current.Value
+	}
+	p.nextToken()
+	return nil
+}
+
+// unitMultipliers maps the decimal unit suffixes accepted for rates and sizes
+var unitMultipliers = []struct {
+	suffix     string
+	multiplier uint64
+}{
+	{"g", 1000 * 1000 * 1000},
+	{"m", 1000 * 1000},
+	{"k", 1000},
+}
+
+// ParseUnitValue parses a number with an optional decimal k, m, or g suffix
+// (for example "15k" or "100m").
+func ParseUnitValue(s string) (uint64, error) {
+	lower := strings.ToLower(s)
+	multiplier := uint64(1)
+	for _, unit := range unitMultipliers {
+		if strings.HasSuffix(lower, unit.suffix) {
+			lower = strings.TrimSuffix(lower, unit.suffix)
+			multiplier = unit.multiplier
+			break
+		}
+	}
+	value, err := strconv.ParseUint(lower, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if value > ^uint64(0)/multiplier {
+		return 0, fmt.Errorf("value out of range: %s", s)
+	}
+	return value * multiplier, nil
+}
+
+// FormatUnitValue formats a value using the largest exact unit suffix
+func FormatUnitValue(value uint64) string {
+	if value == 0 {
+		return "0"
+	}
+	for _, unit := range unitMultipliers {
+		if value%unit.multiplier == 0 {
+			return fmt.Sprintf("%d%s", value/unit.multiplier, unit.suffix)
+		}
+	}
+	return strconv.FormatUint(value, 10)
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/akam1o/arca-router/pkg/errors"
+)
+
+// Default parser input limits. They are far above any realistic router
+// configuration and exist to bound memory use on accidental or hostile input.
+const (
+	DefaultMaxConfigBytes      int64 = 32 << 20
+	DefaultMaxConfigStatements       = 500000
+)
+
+// ParserOptions bounds the input accepted by a Parser. Zero values select
+// the defaults; negative values disable the corresponding limit.
+type ParserOptions struct {
+	// MaxInputBytes is the maximum number of bytes read from the input.
+	MaxInputBytes int64
+
+	// MaxStatements is the maximum number of set statements parsed.
+	MaxStatements int
+
+	// IncludeDirs lists the directories @include directives may read from.
+	// @include is rejected when it is empty.
+	IncludeDirs []string
+
+	// MaxIncludeDepth is the maximum nesting of @include directives.
+	MaxIncludeDepth int
+}
+
+// DefaultParserOptions returns the limits applied by NewParser.
+func DefaultParserOptions() ParserOptions {
+	return ParserOptions{
+		MaxInputBytes: DefaultMaxConfigBytes,
+		MaxStatements: DefaultMaxConfigStatements,
+	}
+}
+
+func (o ParserOptions) withDefaults() ParserOptions {
+	if o.MaxInputBytes == 0 {
+		o.MaxInputBytes = DefaultMaxConfigBytes
+	}
+	if o.MaxStatements == 0 {
+		o.MaxStatements = DefaultMaxConfigStatements
+	}
+	if o.MaxIncludeDepth == 0 {
+		o.MaxIncludeDepth = DefaultMaxIncludeDepth
+	}
+	return o
+}
+
+// NewParserWithOptions creates a parser that rejects input exceeding opts.
+func NewParserWithOptions(r io.Reader, opts ParserOptions) *Parser {
+	opts = opts.withDefaults()
+	p := &Parser{
+		lexer:   NewLexer(opts.limitReader(r)),
+		options: opts,
+	}
+	p.nextToken()
+	p.nextToken()
+	return p
+}
+
+// limitReader applies MaxInputBytes to one input file.
+func (o ParserOptions) limitReader(r io.Reader) io.Reader {
+	if o.MaxInputBytes > 0 {
+		return &sizeLimitedReader{r: r, remaining: o.MaxInputBytes, limit: o.MaxInputBytes}
+	}
+	return r
+}
+
+// inputLimitError is reported by the lexer when the input is too large.
+type inputLimitError struct {
+	limit int64
+}
+
+func (e *inputLimitError) Error() string {
+	return fmt.Sprintf("configuration input exceeds maximum size of %d bytes", e.limit)
+}
+
+// sizeLimitedReader behaves like io.LimitReader but fails with an
+// inputLimitError instead of reporting a clean EOF at the limit.
+type sizeLimitedReader struct {
+	r         io.Reader
+	remaining int64
+	limit     int64
+}
+
+func (l *sizeLimitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, &inputLimitError{limit: l.limit}
+	}
+	// Read one byte past the limit so input of exactly limit bytes is accepted.
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		n += int(l.remaining)
+		return n, &inputLimitError{limit: l.limit}
+	}
+	return n, err
+}
+
+// inputLimitError returns a parse error when the lexer stopped reading
+// because the input size limit was exceeded.
+func (p *Parser) inputLimitError() error {
+	limitErr, ok := p.lexer.err.(*inputLimitError)
+	if !ok {
+		return nil
+	}
+	return errors.New(
+		errors.ErrCodeConfigParseError,
+		fmt.Sprintf("Parse error at line %d: %v", p.lexer.line, limitErr),
+		"The configuration input is larger than the parser accepts",
+		"Split the configuration or raise the limit with --config-max-size",
+	)
+}
+
+func (p *Parser) statementLimitError() error {
+	return errors.New(
+		errors.ErrCodeConfigParseError,
+		fmt.Sprintf("Parse error at line %d: configuration exceeds maximum of %d statements", p.current.Line, p.options.MaxStatements),
+		"The configuration contains more statements than the parser accepts",
+		"Split the configuration or raise the limit with --config-max-statements",
+	)
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/akam1o/arca-router/pkg/errors"
+)
+
+func syntheticConfig(statements int) string {
+	var sb strings.Builder
+	for i := 0; i < statements; i++ {
+		fmt.Fprintf(&sb, "set interfaces ge-0/0/%d description \"uplink %d\"\n", i, i)
+	}
+	return sb.String()
+}
+
+func TestParserRejectsOversizedInput(t *testing.T) {
+	input := syntheticConfig(200000)
+	if int64(len(input)) <= 1<<20 {
+		t.Fatalf("synthetic input is only %d bytes", len(input))
+	}
+
+	_, err := NewParserWithOptions(strings.NewReader(input), ParserOptions{MaxInputBytes: 1 << 20}).Parse()
+	if err == nil {
+		t.Fatal("Parse() error = nil, want input size limit error")
+	}
+	var parseErr *errors.Error
+	if !errors.As(err, &parseErr) || parseErr.Code != errors.ErrCodeConfigParseError {
+		t.Fatalf("Parse() error = %v, want %s", err, errors.ErrCodeConfigParseError)
+	}
+	if !strings.Contains(err.Error(), "exceeds maximum size of 1048576 bytes") {
+		t.Fatalf("Parse() error = %v, want size limit message", err)
+	}
+}
+
+func TestParserRejectsTooManyStatements(t *testing.T) {
+	_, err := NewParserWithOptions(strings.NewReader(syntheticConfig(11)), ParserOptions{MaxStatements: 10}).Parse()
+	if err == nil {
+		t.Fatal("Parse() error = nil, want statement limit error")
+	}
+	if !strings.Contains(err.Error(), "maximum of 10 statements") {
+		t.Fatalf("Parse() error = %v, want statement limit message", err)
+	}
+}
+
+func TestParserAcceptsInputAtLimits(t *testing.T) {
+	input := syntheticConfig(10)
+	opts := ParserOptions{MaxInputBytes: int64(len(input)), MaxStatements: 10}
+	cfg, err := NewParserWithOptions(strings.NewReader(input), opts).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(cfg.Interfaces) != 10 {
+		t.Fatalf("parsed %d interfaces, want 10", len(cfg.Interfaces))
+	}
+
+	unlimited := ParserOptions{MaxInputBytes: -1, MaxStatements: -1}
+	if _, err := NewParserWithOptions(strings.NewReader(syntheticConfig(20)), unlimited).Parse(); err != nil {
+		t.Fatalf("Parse() with limits disabled error = %v", err)
+	}
+}
@@ -36,19 +36,19 @@ func (p *Parser) parsePrefixList(config *Config) error {
 	listName := p.current.Value
 	p.nextToken()
 
-	// Expect prefix (CIDR)
-	if p.current.Type != TokenWord {
-		return p.error("expected prefix value")
-	}
-	prefix := p.current.Value
-
-	// Validate CIDR format
-	if err := validateCIDR(prefix); err != nil {
-		return p.error(fmt.Sprintf("invalid prefix %q: %v", prefix, err))
+	// Expect one prefix (CIDR) or a bracketed list of prefixes
+	var prefixes []string
+	err := p.parseValueList("prefix value", func(prefix string) error {
+		if err := validateCIDR(prefix); err != nil {
+			return p.error(fmt.Sprintf("invalid prefix %q: %v", prefix, err))
+		}
+		prefixes = append(prefixes, prefix)
+		return nil
+	}, TokenWord)
+	if err != nil {
+		return err
 	}
 
-	p.nextToken()
-
 	// Initialize policy-options if needed
 	if config.PolicyOptions == nil {
 		config.PolicyOptions = &PolicyOptions{
@@ -65,9 +65,11 @@ func (p *Parser) parsePrefixList(config *Config) error {
 		}
 	}
 
-	// Add prefix to list
+	// Add prefixes to list
 	list := config.PolicyOptions.PrefixLists[listName]
-	list.Prefixes = appendUniqueString(list.Prefixes, prefix)
+	for _, prefix := range prefixes {
+		list.Prefixes = appendUniqueString(list.Prefixes, prefix)
+	}
 
 	return nil
 }
@@ -228,11 +230,69 @@ func (p *Parser) parsePolicyMatchConditions(term *PolicyTerm) error {
 		term.From.ASPath = asPath
 		return nil
 
+	case "route-filter":
+		return p.parseRouteFilter(term)
+
 	default:
 		return p.error(fmt.Sprintf("unsupported match condition: %s", condition))
 	}
 }
 
+// parseRouteFilter parses an inline route-filter match
+// Format: ... from route-filter <prefix> <exact|orlonger|longer|upto /len|prefix-length-range /min-/max>
+func (p *Parser) parseRouteFilter(term *PolicyTerm) error {
+	if p.current.Type != TokenWord {
+		return p.error("expected route-filter prefix")
+	}
+	filter := &RouteFilter{Prefix: p.current.Value}
+	p.nextToken()
+
+	if p.current.Type != TokenWord {
+		return p.error("expected route-filter match type (exact, orlonger, longer, upto, prefix-length-range)")
+	}
+	filter.MatchType = p.current.Value
+	p.nextToken()
+
+	switch filter.MatchType {
+	case RouteFilterUpTo:
+		if p.current.Type != TokenWord && p.current.Type != TokenNumber {
+			return p.error("expected upto prefix length (for example /24)")
+		}
+		maxLength, err := parseRouteFilterLength(p.current.Value)
+		if err != nil {
+			return p.error(err.Error())
+		}
+		filter.MaxLength = maxLength
+		p.nextToken()
+	case RouteFilterPrefixLengthRange:
+		if p.current.Type != TokenWord {
+			return p.error("expected prefix-length-range (for example /16-/24)")
+		}
+		minLength, maxLength, err := parseRouteFilterLengthRange(p.current.Value)
+		if err != nil {
+			return p.error(err.Error())
+		}
+		filter.MinLength = minLength
+		filter.MaxLength = maxLength
+		p.nextToken()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return p.error(fmt.Sprintf("invalid route-filter: %v", err))
+	}
+
+	if term.From == nil {
+		term.From = &PolicyMatchConditions{}
+	}
+	for _, existing := range term.From.RouteFilters {
+		if existing != nil && *existing == *filter {
+			return nil
+		}
+	}
+	term.From.RouteFilters = append(term.From.RouteFilters, filter)
+	return nil
+}
+
 // parsePolicyActions parses actions in a policy term
 // Format: set policy-options policy-statement <name> term <term> then <action> [value]
 func (p *Parser) parsePolicyActions(term *PolicyTerm) error {
@@ -286,7 +346,7 @@ func (p *Parser) parsePolicyActions(term *PolicyTerm) error {
 		community := p.current.Value
 
 		// Validate community
-		if err := validateCommunity(community); err != nil {
+		if err := ValidateCommunity(community); err != nil {
 			return p.error(fmt.Sprintf("invalid community: %v", err))
 		}
 
@@ -338,10 +398,15 @@ func validateIPAddress(ip string) error {
 	return nil
 }
 
-// validateCommunity validates a BGP community string
-func validateCommunity(community string) error {
+// communityFormatHint lists the community forms accepted by ValidateCommunity.
+const communityFormatHint = "expected ASN:value, ASN:value1:value2 (large), target:/origin: extended community, or well-known community (no-export, no-advertise, local-AS, no-peer)"
+
+// ValidateCommunity validates a BGP community string
+func ValidateCommunity(community string) error {
 	// Valid formats:
-	// - "65000:100" (standard community)
+	// - "65000:100" (standard community, 16-bit halves)
+	// - "65000:1:2" (large community, RFC 8092, 32-bit fields)
+	// - "target:65000:100", "origin:192.0.2.1:7" (extended communities)
 	// - "no-export", "no-advertise", "local-AS", "no-peer" (well-known communities)
 	wellKnown := map[string]bool{
 		"no-export":    true,
@@ -354,21 +419,69 @@ func validateCommunity(community string) error {
 		return nil
 	}
 
-	// Check standard format: ASN:value (must be exactly this format)
-	parts := strings.Split(community, ":")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return fmt.Errorf("invalid community format %q, expected ASN:value or well-known community (no-export, no-advertise, local-AS, no-peer)", community)
+	if rest, ok := strings.CutPrefix(community, "target:"); ok {
+		return validateExtendedCommunityValue(community, rest)
 	}
-
-	asn, err := strconv.ParseUint(parts[0], 10, 32)
-	if err != nil || asn > 65535 {
-		return fmt.Errorf("invalid community format %q, expected ASN:value or well-known community (no-export, no-advertise, local-AS, no-peer)", community)
+	if rest, ok := strings.CutPrefix(community, "origin:"); ok {
+		return validateExtendedCommunityValue(community, rest)
 	}
 
-	value, err := strconv.ParseUint(parts[1], 10, 32)
-	if err != nil || value > 65535 {
-		return fmt.Errorf("invalid community format %q, expected ASN:value or well-known community (no-export, no-advertise, local-AS, no-peer)", community)
+	parts := strings.Split(community, ":")
+	switch len(parts) {
+	case 2:
+		// Standard community: both halves are 16-bit.
+		if !communityFieldFits(parts[0], 16) || !communityFieldFits(parts[1], 16) {
+			return fmt.Errorf("invalid community format %q, %s", community, communityFormatHint)
+		}
+	case 3:
+		// Large community: global administrator and both local data parts are 32-bit.
+		for _, part := range parts {
+			if !communityFieldFits(part, 32) {
+				return fmt.Errorf("invalid large community %q, expected ASN:value1:value2 with 32-bit fields", community)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid community format %q, %s", community, communityFormatHint)
 	}
 
 	return nil
 }
+
+// validateExtendedCommunityValue validates the administrator:value part of a
+// route-target or site-of-origin extended community. The 6 value octets allow
+// a 2-byte ASN with a 32-bit value, or a 4-byte ASN or IPv4 address with a
+// 16-bit value.
+func validateExtendedCommunityValue(community, value string) error {
+	admin, assigned, ok := strings.Cut(value, ":")
+	if !ok || strings.Contains(assigned, ":") {
+		return fmt.Errorf("invalid extended community %q, expected target:ADMIN:value or origin:ADMIN:value", community)
+	}
+	if ip := net.ParseIP(admin); ip != nil && ip.To4() != nil && strings.Contains(admin, ".") {
+		if !communityFieldFits(assigned, 16) {
+			return fmt.Errorf("invalid extended community %q, value must be 0-65535 with an IPv4 administrator", community)
+		}
+		return nil
+	}
+	if communityFieldFits(admin, 16) {
+		if !communityFieldFits(assigned, 32) {
+			return fmt.Errorf("invalid extended community %q, value must be 0-4294967295 with a 2-byte ASN", community)
+		}
+		return nil
+	}
+	if communityFieldFits(admin, 32) {
+		if !communityFieldFits(assigned, 16) {
+			return fmt.Errorf("invalid extended community %q, value must be 0-65535 with a 4-byte ASN", community)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid extended community %q, administrator must be an ASN or IPv4 address", community)
+}
+
+// communityFieldFits reports whether s is a decimal number that fits in bits.
+func communityFieldFits(s string, bits int) bool {
+	if s == "" {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 10, bits)
+	return err == nil
+}
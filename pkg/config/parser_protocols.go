@@ -31,6 +31,8 @@ func (p *Parser) parseProtocols(config *Config) error {
 		return p.parseOSPF3(config.Protocols)
 	case "mpls":
 		return p.parseMPLS(config.Protocols)
+	case "rip":
+		return p.parseRIP(config.Protocols)
 	case "vrrp":
 		return p.parseVRRP(config.Protocols)
 	default:
@@ -346,11 +348,91 @@ func (p *Parser) parseMPLS(pc *ProtocolConfig) error {
 		return p.error("expected 'interface' after protocols mpls")
 	}
 	p.nextToken()
+	return p.parseValueList("MPLS interface name", func(value string) error {
+		pc.MPLS.Interfaces = appendUniqueString(pc.MPLS.Interfaces, value)
+		return nil
+	}, TokenWord)
+}
+
+// parseRIP parses RIP protocol configuration
+func (p *Parser) parseRIP(pc *ProtocolConfig) error {
+	if pc.RIP == nil {
+		pc.RIP = &RIPConfig{Groups: make(map[string]*RIPGroup)}
+	}
 	if p.current.Type != TokenWord {
-		return p.error("expected MPLS interface name")
+		return p.error("expected RIP parameter")
 	}
-	pc.MPLS.Interfaces = appendUniqueString(pc.MPLS.Interfaces, p.current.Value)
+	param := p.current.Value
 	p.nextToken()
+
+	switch param {
+	case "update-interval":
+		return p.parseRIPNumber(param, &pc.RIP.UpdateInterval)
+	case "route-timeout":
+		return p.parseRIPNumber(param, &pc.RIP.RouteTimeout)
+	case "holddown":
+		return p.parseRIPNumber(param, &pc.RIP.Holddown)
+	case "default-metric":
+		return p.parseRIPNumber(param, &pc.RIP.DefaultMetric)
+	case "group":
+		return p.parseRIPGroup(pc.RIP)
+	default:
+		return p.error(fmt.Sprintf("unsupported RIP parameter: %s", param))
+	}
+}
+
+func (p *Parser) parseRIPNumber(name string, target *int) error {
+	if p.current.Type != TokenNumber {
+		return p.error(fmt.Sprintf("expected RIP %s value", name))
+	}
+	value, err := strconv.Atoi(p.current.Value)
+	if err != nil {
+		return p.error(fmt.Sprintf("invalid RIP %s: %s", name, p.current.Value))
+	}
+	*target = value
+	p.nextToken()
+	return nil
+}
+
+// parseRIPGroup parses RIP group configuration
+func (p *Parser) parseRIPGroup(rip *RIPConfig) error {
+	if p.current.Type != TokenWord && p.current.Type != TokenNumber {
+		return p.error("expected RIP group name")
+	}
+	groupName := p.current.Value
+	p.nextToken()
+	if rip.Groups == nil {
+		rip.Groups = make(map[string]*RIPGroup)
+	}
+	if rip.Groups[groupName] == nil {
+		rip.Groups[groupName] = &RIPGroup{Name: groupName, Neighbors: make(map[string]*RIPNeighbor)}
+	}
+	group := rip.Groups[groupName]
+
+	if p.current.Type != TokenWord || p.current.Value != "neighbor" {
+		return p.error("expected 'neighbor' after RIP group name")
+	}
+	p.nextToken()
+	if p.current.Type != TokenWord {
+		return p.error("expected RIP neighbor interface name")
+	}
+	ifName := p.current.Value
+	p.nextToken()
+	if group.Neighbors[ifName] == nil {
+		group.Neighbors[ifName] = &RIPNeighbor{Name: ifName}
+	}
+	neighbor := group.Neighbors[ifName]
+
+	for p.current.Type == TokenWord {
+		param := p.current.Value
+		p.nextToken()
+		switch param {
+		case "passive":
+			neighbor.Passive = true
+		default:
+			return p.error(fmt.Sprintf("unsupported RIP neighbor parameter: %s", param))
+		}
+	}
 	return nil
 }
 
@@ -428,6 +510,13 @@ func (p *Parser) parseBGP(pc *ProtocolConfig) error {
 	p.nextToken()
 
 	switch param {
+	case "router-id":
+		if p.current.Type != TokenWord {
+			return p.error("expected router-id value")
+		}
+		pc.BGP.RouterID = p.current.Value
+		p.nextToken()
+		return nil
 	case "group":
 		return p.parseBGPGroup(pc.BGP)
 	default:
@@ -468,6 +557,19 @@ func (p *Parser) parseBGPGroup(bgp *BGPConfig) error {
 		return p.parseBGPGroupImport(group)
 	case "export":
 		return p.parseBGPGroupExport(group)
+	case "cluster":
+		if p.current.Type != TokenWord {
+			return p.error("expected cluster ID")
+		}
+		group.Cluster = p.current.Value
+		p.nextToken()
+		return nil
+	case "next-hop-self":
+		group.NextHopSelf = true
+		return nil
+	case "remove-private-as":
+		group.RemovePrivateAS = true
+		return nil
 	default:
 		return p.error(fmt.Sprintf("unsupported BGP group parameter: %s", param))
 	}
@@ -542,6 +644,13 @@ func (p *Parser) parseBGPNeighbor(group *BGPGroup) error {
 		neighbor.LocalAddress = p.current.Value
 		p.nextToken()
 		return nil
+	case "update-source":
+		if p.current.Type != TokenWord {
+			return p.error("expected update-source interface or address")
+		}
+		neighbor.UpdateSource = p.current.Value
+		p.nextToken()
+		return nil
 	case "bfd":
 		neighbor.BFD = true
 		if p.current.Type == TokenWord && p.current.Value == "profile" {
@@ -553,6 +662,25 @@ func (p *Parser) parseBGPNeighbor(group *BGPGroup) error {
 			p.nextToken()
 		}
 		return nil
+	case "cluster":
+		if p.current.Type != TokenWord {
+			return p.error("expected cluster ID")
+		}
+		neighbor.Cluster = p.current.Value
+		p.nextToken()
+		return nil
+	case "route-reflector-client":
+		neighbor.RouteReflectorClient = true
+		return nil
+	case "shutdown":
+		neighbor.Shutdown = true
+		return nil
+	case "next-hop-self":
+		neighbor.NextHopSelf = true
+		return nil
+	case "remove-private-as":
+		neighbor.RemovePrivateAS = true
+		return nil
 	default:
 		return p.error(fmt.Sprintf("unsupported neighbor parameter: %s", param))
 	}
@@ -613,6 +741,8 @@ func (p *Parser) parseOSPFConfig(ospf *OSPFConfig, protocolName string) error {
 		return p.parseOSPFArea(ospf)
 	case "router-id":
 		return p.parseOSPFRouterID(ospf)
+	case "reference-bandwidth":
+		return p.parseOSPFReferenceBandwidth(ospf)
 	default:
 		return p.error(fmt.Sprintf("unsupported %s parameter: %s", protocolName, param))
 	}
@@ -629,6 +759,21 @@ func (p *Parser) parseOSPFRouterID(ospf *OSPFConfig) error {
 	return nil
 }
 
+// parseOSPFReferenceBandwidth parses the auto-cost reference bandwidth in
+// bits per second with an optional k, m, or g suffix, for example "10g"
+func (p *Parser) parseOSPFReferenceBandwidth(ospf *OSPFConfig) error {
+	if p.current.Type != TokenNumber && p.current.Type != TokenWord {
+		return p.error("expected reference-bandwidth value")
+	}
+	value, err := ParseUnitValue(p.current.Value)
+	if err != nil || value == 0 {
+		return p.error(fmt.Sprintf("invalid reference-bandwidth: %s", p.current.Value))
+	}
+	ospf.ReferenceBandwidth = value
+	p.nextToken()
+	return nil
+}
+
 // parseOSPFArea parses OSPF area configuration
 func (p *Parser) parseOSPFArea(ospf *OSPFConfig) error {
 	// Expect area ID
@@ -682,7 +827,7 @@ func (p *Parser) parseOSPFArea(ospf *OSPFConfig) error {
 			if err != nil {
 				return p.error(fmt.Sprintf("invalid metric value: %s", p.current.Value))
 			}
-			ospfIf.Metric = metric
+			ospfIf.Metric = &metric
 			p.nextToken()
 		case "priority":
 			if p.current.Type != TokenNumber {
@@ -692,8 +837,7 @@ func (p *Parser) parseOSPFArea(ospf *OSPFConfig) error {
 			if err != nil {
 				return p.error(fmt.Sprintf("invalid priority value: %s", p.current.Value))
 			}
-			ospfIf.Priority = priority
-			ospfIf.PrioritySet = true
+			ospfIf.Priority = &priority
 			p.nextToken()
 		case "bfd":
 			ospfIf.BFD = true
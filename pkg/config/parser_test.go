@@ -1,9 +1,13 @@
 package config
 
 import (
+	"maps"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/akam1o/arca-router/pkg/errors"
 )
 
 func TestParser_SystemHostName(t *testing.T) {
@@ -44,6 +48,646 @@ func TestParser_InterfaceDescription(t *testing.T) {
 	}
 }
 
+func TestParser_UnterminatedDescriptionReportsOpeningQuote(t *testing.T) {
+	input := "set system host-name r1\nset interfaces ge-0/0/0 description \"WAN\nset system host-name r2\n"
+
+	_, err := NewParser(strings.NewReader(input)).Parse()
+	if err == nil {
+		t.Fatal("Parse() error = nil, want unterminated string error")
+	}
+	want := "Lexer error at line 2, column 37: unterminated string: missing closing quote"
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("Parse() error = %v, want %q", err, want)
+	}
+}
+
+func TestParser_ErrorQuotesSourceLineWithCaret(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "parse error",
+			input: "set system host-name r1\nset system host-name r1 extra\nset system host-name r2\n",
+			want:  "Parse error at line 2, column 25: expected end of line after statement\n\tset system host-name r1 extra\n\t                        ^",
+		},
+		{
+			name:  "lexer error on a line read past",
+			input: "set system host-name r1\nset interfaces ge-0/0/0 description \"WAN\nset system host-name r2\n",
+			want:  "Lexer error at line 2, column 37: unterminated string: missing closing quote\n\tset interfaces ge-0/0/0 description \"WAN\n\t                                    ^",
+		},
+		{
+			name:  "missing value at end of line",
+			input: "set system host-name\nset system host-name r2\n",
+			want:  "expected hostname value\n\tset system host-name\n\t                    ^",
+		},
+		{
+			name:  "tab indentation",
+			input: "\tset system host-name r1 extra\n",
+			want:  "\n\t\tset system host-name r1 extra\n\t\t                        ^",
+		},
+		{
+			name:  "lexer error",
+			input: "set system host-name r1 $\n",
+			want:  "unexpected character: $\n\tset system host-name r1 $\n\t                        ^",
+		},
+		{
+			name:  "redacted secret keeps spacing",
+			input: "set\tsystem  services snmp community s3cret extra\n",
+			want:  "\n\tset\tsystem  services snmp community <redacted>\n\t   \t                                ^",
+		},
+		{
+			name:  "redacted unterminated secret",
+			input: "set security users user bob   password \"s3cret\n",
+			want:  "\n\tset security users user bob   password <redacted>\n\t                                       ^",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewParser(strings.NewReader(tt.input)).Parse()
+			if err == nil {
+				t.Fatal("Parse() error = nil, want parse error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Parse() error = %q, want %q", err, tt.want)
+			}
+			var cfgErr *errors.Error
+			if !errors.As(err, &cfgErr) || cfgErr.Code != errors.ErrCodeConfigParseError || cfgErr.Action == "" {
+				t.Fatalf("Parse() error = %#v, want structured parse error", err)
+			}
+		})
+	}
+}
+
+func TestParser_ErrorSourceLineRedactsSecrets(t *testing.T) {
+	for _, input := range []string{
+		"set system services snmp community s3cret extra\n",
+		"set security users user bob password \"s3cret\n",
+	} {
+		_, err := NewParser(strings.NewReader(input)).Parse()
+		if err == nil {
+			t.Fatalf("Parse(%q) error = nil, want parse error", input)
+		}
+		if strings.Contains(err.Error(), "s3cret") || !strings.Contains(err.Error(), redactedSecretValue) {
+			t.Fatalf("Parse(%q) error = %q, want the secret redacted from the quoted line", input, err)
+		}
+	}
+}
+
+func TestParser_InterfacePromiscuousAndRxMode(t *testing.T) {
+	input := `set interfaces ge-0/0/0 promiscuous
+set interfaces ge-0/0/0 rx-mode adaptive
+set interfaces ge-0/0/1 unit 0 family inet address 192.0.2.1/24`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	iface := config.Interfaces["ge-0/0/0"]
+	if iface == nil || !iface.Promiscuous || iface.RxMode != "adaptive" {
+		t.Fatalf("ge-0/0/0 = %#v, want promiscuous with adaptive rx-mode", iface)
+	}
+	if other := config.Interfaces["ge-0/0/1"]; other.Promiscuous || other.RxMode != "" {
+		t.Fatalf("ge-0/0/1 = %#v, want defaults left unset", other)
+	}
+
+	text := ToSetCommands(config)
+	for _, line := range []string{
+		"set interfaces ge-0/0/0 promiscuous",
+		"set interfaces ge-0/0/0 rx-mode adaptive",
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Fatalf("serialized config missing %q:\n%s", line, text)
+		}
+	}
+	if strings.Contains(text, "ge-0/0/1 rx-mode") || strings.Contains(text, "ge-0/0/1 promiscuous") {
+		t.Fatalf("serialized config added defaults for ge-0/0/1:\n%s", text)
+	}
+
+	config.Interfaces["ge-0/0/0"].RxMode = "turbo"
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "invalid rx-mode") {
+		t.Fatalf("Validate() error = %v, want invalid rx-mode", err)
+	}
+}
+
+func TestParser_InterfaceMTU(t *testing.T) {
+	input := `set interfaces ge-0/0/0 mtu 9000
+set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set interfaces ge-0/0/0 unit 0 family inet mtu 1500
+set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64
+set interfaces ge-0/0/0 unit 0 family inet6 mtu 9000`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	iface := config.Interfaces["ge-0/0/0"]
+	if iface.MTU != 9000 {
+		t.Fatalf("MTU = %d, want 9000", iface.MTU)
+	}
+	if got := iface.Units[0].Family["inet"].MTU; got != 1500 {
+		t.Fatalf("inet MTU = %d, want 1500", got)
+	}
+	if got := iface.Units[0].Family["inet6"].MTU; got != 9000 {
+		t.Fatalf("inet6 MTU = %d, want 9000", got)
+	}
+
+	text := ToSetCommands(config)
+	for _, line := range []string{
+		"set interfaces ge-0/0/0 mtu 9000",
+		"set interfaces ge-0/0/0 unit 0 family inet mtu 1500",
+		"set interfaces ge-0/0/0 unit 0 family inet6 mtu 9000",
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Fatalf("serialized config missing %q:\n%s", line, text)
+		}
+	}
+
+	for _, bad := range []string{
+		"set interfaces ge-0/0/0 mtu",
+		"set interfaces ge-0/0/0 mtu jumbo",
+		"set interfaces ge-0/0/0 unit 0 family inet mtu -1",
+	} {
+		if _, err := NewParser(strings.NewReader(bad)).Parse(); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestValidate_InterfaceMTU(t *testing.T) {
+	const addrs = `set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64
+set interfaces ge-0/0/0 unit 1 family inet address 198.51.100.1/24
+`
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name: "ip mtu equal to interface mtu",
+			input: `set interfaces ge-0/0/0 mtu 1500
+set interfaces ge-0/0/0 unit 0 family inet mtu 1500`,
+		},
+		{
+			name:  "ip mtu without interface mtu",
+			input: `set interfaces ge-0/0/0 unit 0 family inet6 mtu 9000`,
+		},
+		{
+			name:  "ip mtu at default interface mtu",
+			input: `set interfaces ge-0/0/0 unit 0 family inet mtu 9000`,
+		},
+		{
+			name:    "ip mtu above default interface mtu",
+			input:   `set interfaces ge-0/0/0 unit 0 family inet mtu 9001`,
+			wantErr: "family inet mtu 9001 must be between 68 and the interface MTU 9000",
+		},
+		{
+			name: "ip mtu above interface mtu",
+			input: `set interfaces ge-0/0/0 mtu 1500
+set interfaces ge-0/0/0 unit 0 family inet mtu 9000`,
+			wantErr: "family inet mtu 9000 must be between 68 and the interface MTU 1500",
+		},
+		{
+			name:    "interface mtu out of range",
+			input:   `set interfaces ge-0/0/0 mtu 100`,
+			wantErr: "invalid mtu: 100",
+		},
+		{
+			name:    "inet6 mtu below minimum",
+			input:   `set interfaces ge-0/0/0 unit 0 family inet6 mtu 1000`,
+			wantErr: "family inet6 mtu 1000 must be between 1280",
+		},
+		{
+			name: "family mtu differs between units",
+			input: `set interfaces ge-0/0/0 unit 0 family inet mtu 1500
+set interfaces ge-0/0/0 unit 1 family inet mtu 1400`,
+			wantErr: "family inet mtu differs between units",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewParser(strings.NewReader(addrs + tt.input)).Parse()
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			err = config.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParser_InterfaceTunnel(t *testing.T) {
+	input := `set interfaces gr-0/0/0 tunnel source 192.0.2.1
+set interfaces gr-0/0/0 tunnel destination 198.51.100.1
+set interfaces gr-0/0/0 unit 0 family inet address 10.255.0.1/30
+set interfaces ip-0/0/1 tunnel source 2001:db8::1
+set interfaces ip-0/0/1 tunnel destination 2001:db8::2`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if got := config.Interfaces["gr-0/0/0"].Tunnel; got == nil || got.Source != "192.0.2.1" || got.Destination != "198.51.100.1" {
+		t.Fatalf("gr-0/0/0 tunnel = %#v, want 192.0.2.1 -> 198.51.100.1", got)
+	}
+	if got := TunnelInterfaceType("ip-0/0/1"); got != TunnelTypeIPIP {
+		t.Fatalf("TunnelInterfaceType(ip-0/0/1) = %q, want %q", got, TunnelTypeIPIP)
+	}
+
+	text := ToSetCommands(config)
+	for _, line := range []string{
+		"set interfaces gr-0/0/0 tunnel source 192.0.2.1",
+		"set interfaces gr-0/0/0 tunnel destination 198.51.100.1",
+		"set interfaces ip-0/0/1 tunnel source 2001:db8::1",
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Fatalf("serialized config missing %q:\n%s", line, text)
+		}
+	}
+
+	for _, tt := range []struct {
+		input   string
+		wantErr string
+	}{
+		{"set interfaces gr-0/0/0 tunnel source 192.0.2.1", "tunnel source and destination are both required"},
+		{"set interfaces gr-0/0/0 unit 0 family inet address 10.255.0.1/30", "tunnel source and destination are both required"},
+		{"set interfaces gr-0/0/0 tunnel source 192.0.2.1\nset interfaces gr-0/0/0 tunnel destination 2001:db8::2", "must be the same address family"},
+		{"set interfaces ip-0/0/0 tunnel source router1\nset interfaces ip-0/0/0 tunnel destination 192.0.2.2", "must be a unicast IP address"},
+		{"set interfaces ge-0/0/0 tunnel source 192.0.2.1\nset interfaces ge-0/0/0 tunnel destination 192.0.2.2", "is not a tunnel interface"},
+	} {
+		config, err := NewParser(strings.NewReader(tt.input)).Parse()
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.input, err)
+		}
+		if err := config.Validate(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Validate(%q) error = %v, want %q", tt.input, err, tt.wantErr)
+		}
+	}
+	if _, err := NewParser(strings.NewReader("set interfaces gr-0/0/0 tunnel remote 192.0.2.1")).Parse(); err == nil {
+		t.Error("Parse(tunnel remote) error = nil, want error")
+	}
+}
+
+func TestParser_SystemAlarms(t *testing.T) {
+	input := `set system alarms interface error-rate 50
+set system alarms interface link-down`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := config.System.Alarms; got == nil || *got != (SystemAlarmsConfig{InterfaceErrorRate: 50, InterfaceLinkDown: true}) {
+		t.Fatalf("system alarms = %#v, want error-rate 50 and link-down", got)
+	}
+	if text := ToSetCommands(config); !strings.Contains(text, input+"\n") {
+		t.Fatalf("serialized config = %q, want alarm lines", text)
+	}
+
+	for _, bad := range []string{
+		"set system alarms",
+		"set system alarms interface",
+		"set system alarms interface error-rate",
+		"set system alarms interface error-rate 0",
+		"set system alarms interface flapping",
+	} {
+		if _, err := NewParser(strings.NewReader(bad)).Parse(); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestParser_InterfaceHoldTime(t *testing.T) {
+	input := `set interfaces ge-0/0/0 hold-time up 2000 down 0
+set interfaces ge-0/0/1 hold-time down 500
+set interfaces ge-0/0/1 hold-time up 100`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if got := config.Interfaces["ge-0/0/0"].HoldTime; got == nil || *got != (HoldTime{Up: 2000, Down: 0}) {
+		t.Fatalf("ge-0/0/0 hold-time = %#v, want up 2000 down 0", got)
+	}
+	if got := config.Interfaces["ge-0/0/1"].HoldTime; got == nil || *got != (HoldTime{Up: 100, Down: 500}) {
+		t.Fatalf("ge-0/0/1 hold-time = %#v, want up 100 down 500", got)
+	}
+
+	text := ToSetCommands(config)
+	for _, line := range []string{
+		"set interfaces ge-0/0/0 hold-time up 2000",
+		"set interfaces ge-0/0/0 hold-time down 0",
+		"set interfaces ge-0/0/1 hold-time up 100",
+		"set interfaces ge-0/0/1 hold-time down 500",
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Fatalf("serialized config missing %q:\n%s", line, text)
+		}
+	}
+
+	config, err = NewParser(strings.NewReader("set interfaces ge-0/0/0 hold-time up 4294968")).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "hold-time up must be 0-4294967 ms") {
+		t.Errorf("Validate() error = %v, want hold-time range error", err)
+	}
+	for _, bad := range []string{
+		"set interfaces ge-0/0/0 hold-time",
+		"set interfaces ge-0/0/0 hold-time up",
+		"set interfaces ge-0/0/0 hold-time up fast",
+		"set interfaces ge-0/0/0 hold-time sideways 10",
+	} {
+		if _, err := NewParser(strings.NewReader(bad)).Parse(); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestParser_InterfaceGigEtherOptions(t *testing.T) {
+	input := `set interfaces ge-0/0/0 gigether-options rxq 4 txq 4
+set interfaces ge-0/0/0 gigether-options rx-queue 0 worker 0
+set interfaces ge-0/0/0 gigether-options rx-queue 3 worker 2`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	got := config.Interfaces["ge-0/0/0"].GigEtherOptions
+	if got == nil || got.RxQueues != 4 || got.TxQueues != 4 || !maps.Equal(got.RxPlacement, map[int]int{0: 0, 3: 2}) {
+		t.Fatalf("gigether-options = %#v, want rxq 4 txq 4 with queues 0 and 3 placed", got)
+	}
+
+	text := ToSetCommands(config)
+	for _, line := range []string{
+		"set interfaces ge-0/0/0 gigether-options rxq 4",
+		"set interfaces ge-0/0/0 gigether-options txq 4",
+		"set interfaces ge-0/0/0 gigether-options rx-queue 0 worker 0",
+		"set interfaces ge-0/0/0 gigether-options rx-queue 3 worker 2",
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Fatalf("serialized config missing %q:\n%s", line, text)
+		}
+	}
+
+	for bad, want := range map[string]string{
+		"set interfaces ge-0/0/0 gigether-options rxq 65":                    "must be 1-64",
+		"set interfaces ge-0/0/0 gigether-options rxq 2 rx-queue 2 worker 0": "rx-queue 2 does not exist",
+		"set interfaces ge-0/0/0 gigether-options rx-queue 1 worker 0":       "rx-queue 1 does not exist",
+		"set interfaces gr-0/0/0 tunnel source 192.0.2.1\nset interfaces gr-0/0/0 tunnel destination 192.0.2.2\nset interfaces gr-0/0/0 gigether-options rxq 2": "does not support gigether-options",
+	} {
+		config, err := NewParser(strings.NewReader(bad)).Parse()
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", bad, err)
+		}
+		if err := config.Validate(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate(%q) error = %v, want %q", bad, err, want)
+		}
+	}
+	for _, bad := range []string{
+		"set interfaces ge-0/0/0 gigether-options",
+		"set interfaces ge-0/0/0 gigether-options rxq",
+		"set interfaces ge-0/0/0 gigether-options rxq many",
+		"set interfaces ge-0/0/0 gigether-options rx-queue 0",
+		"set interfaces ge-0/0/0 gigether-options rx-queue 0 core 1",
+		"set interfaces ge-0/0/0 gigether-options flow-control",
+	} {
+		if _, err := NewParser(strings.NewReader(bad)).Parse(); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestParser_InterfaceBandwidth(t *testing.T) {
+	input := `set interfaces ge-0/0/0 bandwidth 10g
+set interfaces ge-0/0/1 bandwidth 1500k
+set interfaces ge-0/0/2 bandwidth 2500000`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	for name, want := range map[string]uint64{
+		"ge-0/0/0": 10 * 1000 * 1000 * 1000,
+		"ge-0/0/1": 1500 * 1000,
+		"ge-0/0/2": 2500 * 1000,
+	} {
+		if got := config.Interfaces[name].Bandwidth; got != want {
+			t.Errorf("%s bandwidth = %d, want %d", name, got, want)
+		}
+	}
+
+	text := ToSetCommands(config)
+	for _, line := range []string{
+		"set interfaces ge-0/0/0 bandwidth 10g",
+		"set interfaces ge-0/0/1 bandwidth 1500k",
+		"set interfaces ge-0/0/2 bandwidth 2500k",
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Fatalf("serialized config missing %q:\n%s", line, text)
+		}
+	}
+
+	for _, tt := range []struct {
+		input   string
+		wantErr string
+	}{
+		{input: "set interfaces ge-0/0/0 bandwidth 999", wantErr: "invalid bandwidth"},
+		{input: "set interfaces ge-0/0/0 bandwidth 1001g", wantErr: "invalid bandwidth"},
+	} {
+		config, err := NewParser(strings.NewReader(tt.input)).Parse()
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.input, err)
+		}
+		if err := config.Validate(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Validate(%q) error = %v, want %q", tt.input, err, tt.wantErr)
+		}
+	}
+	for _, bad := range []string{
+		"set interfaces ge-0/0/0 bandwidth",
+		"set interfaces ge-0/0/0 bandwidth fast",
+		"set interfaces ge-0/0/0 bandwidth 10x",
+		"set interfaces ge-0/0/0 bandwidth 0",
+		"set interfaces ge-0/0/0 bandwidth 0k",
+	} {
+		if _, err := NewParser(strings.NewReader(bad)).Parse(); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestParser_OSPFReferenceBandwidth(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/30",
+		"set routing-options router-id 10.255.0.1",
+		"set protocols ospf reference-bandwidth 100g",
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/0",
+	)
+	if got := cfg.Protocols.OSPF.ReferenceBandwidth; got != 100*1000*1000*1000 {
+		t.Fatalf("ReferenceBandwidth = %d, want 100g", got)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	assertSetCommandRoundTrip(t, cfg)
+
+	for _, value := range []string{"1500k", "5000g"} {
+		bad := parseSetCommands(t,
+			"set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/30",
+			"set routing-options router-id 10.255.0.1",
+			"set protocols ospf reference-bandwidth "+value,
+			"set protocols ospf area 0.0.0.0 interface ge-0/0/0",
+		)
+		if err := bad.Validate(); err == nil || !strings.Contains(err.Error(), "reference-bandwidth") {
+			t.Errorf("Validate(reference-bandwidth %s) error = %v, want reference-bandwidth error", value, err)
+		}
+	}
+	if _, err := NewParser(strings.NewReader("set protocols ospf reference-bandwidth 0\n")).Parse(); err == nil {
+		t.Error("Parse(reference-bandwidth 0) error = nil, want error")
+	}
+}
+
+func TestParser_RouterAdvertisement(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8:1::1/64
+set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement managed-configuration
+set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement max-advertisement-interval 60
+set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement min-advertisement-interval 20
+set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement prefix 2001:db8:1::/64 valid-lifetime 86400 preferred-lifetime 14400
+set interfaces ge-0/0/1 unit 0 family inet6 address 2001:db8:2::1/64
+set interfaces ge-0/0/1 unit 0 family inet6 router-advertisement`
+
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	ra := config.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].RouterAdvertisement
+	if ra == nil || !ra.ManagedConfiguration || ra.OtherStatefulConfiguration ||
+		ra.MaxAdvertisementInterval != 60 || ra.MinAdvertisementInterval != 20 {
+		t.Fatalf("RouterAdvertisement = %#v, want managed with intervals 60/20", ra)
+	}
+	if prefix := ra.Prefixes["2001:db8:1::/64"]; prefix == nil || prefix.ValidLifetime != 86400 || prefix.PreferredLifetime != 14400 {
+		t.Fatalf("RA prefix = %#v, want lifetimes 86400/14400", prefix)
+	}
+	if ra := config.Interfaces["ge-0/0/1"].Units[0].Family["inet6"].RouterAdvertisement; ra == nil {
+		t.Fatal("bare router-advertisement did not enable advertisements")
+	}
+
+	text := ToSetCommands(config)
+	for _, line := range []string{
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement managed-configuration",
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement max-advertisement-interval 60",
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement min-advertisement-interval 20",
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement prefix 2001:db8:1::/64 valid-lifetime 86400",
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement prefix 2001:db8:1::/64 preferred-lifetime 14400",
+		"set interfaces ge-0/0/1 unit 0 family inet6 router-advertisement",
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Fatalf("serialized config missing %q:\n%s", line, text)
+		}
+	}
+	reparsed, err := NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		t.Fatalf("Parse(serialized) error = %v", err)
+	}
+	if got := ToSetCommands(reparsed); got != text {
+		t.Fatalf("round trip changed config:\n%s\nwant:\n%s", got, text)
+	}
+
+	if config.Interfaces["ge-0/0/0"].Units[0].Family["inet"] != nil {
+		t.Fatal("router-advertisement created an inet family")
+	}
+	for _, bad := range []string{
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement max-advertisement-interval",
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement prefix",
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement prefix 2001:db8::/64 lifetime 10",
+		"set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement suppress",
+	} {
+		if _, err := NewParser(strings.NewReader(bad)).Parse(); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestValidate_RouterAdvertisement(t *testing.T) {
+	const addrs = `set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64
+set interfaces ge-0/0/0 unit 1 family inet6 address 2001:db8:1::1/64
+`
+	const ra = "set interfaces ge-0/0/0 unit 0 family inet6 router-advertisement "
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "default intervals", input: ra + "other-stateful-configuration"},
+		{name: "min at three quarters of max", input: ra + "max-advertisement-interval 40\n" + ra + "min-advertisement-interval 30"},
+		{name: "max too small", input: ra + "max-advertisement-interval 3", wantErr: "max-advertisement-interval 3 must be between 4 and 1800"},
+		{name: "max too large", input: ra + "max-advertisement-interval 3600", wantErr: "max-advertisement-interval 3600"},
+		{name: "min above three quarters of max", input: ra + "max-advertisement-interval 40\n" + ra + "min-advertisement-interval 31", wantErr: "min-advertisement-interval 31 must be between 3 and 3/4"},
+		{name: "min above default max", input: ra + "min-advertisement-interval 160", wantErr: "(150)"},
+		{name: "ipv4 prefix", input: ra + "prefix 192.0.2.0/24", wantErr: "must be an IPv6 prefix"},
+		{name: "preferred above valid", input: ra + "prefix 2001:db8::/64 valid-lifetime 3600 preferred-lifetime 7200", wantErr: "preferred-lifetime 7200 exceeds valid-lifetime 3600"},
+		{name: "preferred above default valid", input: ra + "prefix 2001:db8::/64 valid-lifetime 600", wantErr: "preferred-lifetime 604800 exceeds valid-lifetime 600"},
+		{name: "inet family", input: "set interfaces ge-0/0/0 unit 0 family inet router-advertisement", wantErr: "Router advertisement configured for family inet"},
+		{name: "two units", input: ra + "managed-configuration\nset interfaces ge-0/0/0 unit 1 family inet6 router-advertisement", wantErr: "router-advertisement on units 0 and 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewParser(strings.NewReader(addrs + tt.input)).Parse()
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			err = config.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestParser_InterfaceAddress(t *testing.T) {
 	input := `set interfaces ge-0/0/0 unit 0 family inet address 198.51.100.1/30`
 
@@ -327,6 +971,54 @@ set routing-options static route 10.0.0.0/8 next-hop 10.0.0.1 distance 10`
 	}
 }
 
+func TestParser_AdministrativeDistance(t *testing.T) {
+	input := `set routing-options administrative-distance protocol bgp 20
+set routing-options administrative-distance protocol bgp internal 150
+set routing-options administrative-distance protocol bgp local 160
+set routing-options administrative-distance protocol ospf 90
+set routing-options administrative-distance protocol ospf intra-area 80
+set routing-options administrative-distance protocol ospf3 external 170`
+
+	cfg, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	ad := cfg.RoutingOptions.AdministrativeDistance
+	if ad == nil {
+		t.Fatal("AdministrativeDistance is nil")
+	}
+	if got, want := *ad.BGP, (BGPDistance{External: 20, Internal: 150, Local: 160}); got != want {
+		t.Errorf("BGP distance = %+v, want %+v", got, want)
+	}
+	if got, want := *ad.OSPF, (OSPFDistance{Default: 90, IntraArea: 80}); got != want {
+		t.Errorf("OSPF distance = %+v, want %+v", got, want)
+	}
+	if got, want := *ad.OSPF3, (OSPFDistance{External: 170}); got != want {
+		t.Errorf("OSPF3 distance = %+v, want %+v", got, want)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	text := ToSetCommands(cfg)
+	for _, want := range []string{
+		"set routing-options administrative-distance protocol bgp external 20\n",
+		"set routing-options administrative-distance protocol ospf 90\n",
+		"set routing-options administrative-distance protocol ospf3 external 170\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("ToSetCommands() missing %q:\n%s", want, text)
+		}
+	}
+	reparsed, err := NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		t.Fatalf("Parse(ToSetCommands()) error = %v", err)
+	}
+	if !reflect.DeepEqual(reparsed.RoutingOptions.AdministrativeDistance, ad) {
+		t.Errorf("round trip = %+v, want %+v", reparsed.RoutingOptions.AdministrativeDistance, ad)
+	}
+}
+
 // Test BGP parsing
 func TestParser_BGP(t *testing.T) {
 	input := `set routing-options autonomous-system 65001
@@ -454,11 +1146,11 @@ set protocols ospf area 0.0.0.0 interface ge-0/0/1 priority 1`
 	if !if1.Passive {
 		t.Error("Expected ge-0/0/1 to be passive")
 	}
-	if if1.Metric != 100 {
-		t.Errorf("Expected metric 100, got %d", if1.Metric)
+	if if1.Metric == nil || *if1.Metric != 100 {
+		t.Errorf("Expected metric 100, got %v", if1.Metric)
 	}
-	if if1.Priority != 1 {
-		t.Errorf("Expected priority 1, got %d", if1.Priority)
+	if if1.Priority == nil || *if1.Priority != 1 {
+		t.Errorf("Expected priority 1, got %v", if1.Priority)
 	}
 }
 
@@ -549,6 +1241,22 @@ set routing-options static route 0.0.0.0/0 next-hop 10.0.0.2`,
 			name:  "unknown OSPF3 interface option",
 			input: `set protocols ospf3 area 0.0.0.0 interface ge-0/0/0 bogus`,
 		},
+		{
+			name:  "administrative distance zero",
+			input: `set routing-options administrative-distance protocol bgp 0`,
+		},
+		{
+			name:  "administrative distance above 255",
+			input: `set routing-options administrative-distance protocol ospf 256`,
+		},
+		{
+			name:  "administrative distance unknown protocol",
+			input: `set routing-options administrative-distance protocol isis 115`,
+		},
+		{
+			name:  "administrative distance unknown BGP route type",
+			input: `set routing-options administrative-distance protocol bgp intra-area 20`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -643,3 +1351,148 @@ func TestParser_Phase2SampleFile(t *testing.T) {
 		t.Errorf("Validation failed: %v", err)
 	}
 }
+
+func TestParser_BGPRouteReflector(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set routing-options autonomous-system 65000",
+		"set protocols bgp group RR-CLIENTS type internal",
+		"set protocols bgp group RR-CLIENTS cluster 192.0.2.1",
+		"set protocols bgp group RR-CLIENTS neighbor 10.0.0.2 peer-as 65000",
+		"set protocols bgp group IBGP type internal",
+		"set protocols bgp group IBGP neighbor 10.0.0.3 peer-as 65000",
+		"set protocols bgp group IBGP neighbor 10.0.0.3 cluster 192.0.2.1",
+		"set protocols bgp group IBGP neighbor 10.0.0.4 peer-as 65000",
+		"set protocols bgp group IBGP neighbor 10.0.0.4 route-reflector-client",
+		"set protocols bgp group IBGP neighbor 10.0.0.5 peer-as 65000",
+	)
+
+	clients := cfg.Protocols.BGP.Groups["RR-CLIENTS"]
+	if clients.Cluster != "192.0.2.1" {
+		t.Errorf("group cluster = %q, want 192.0.2.1", clients.Cluster)
+	}
+	if !clients.IsRouteReflectorClient(clients.Neighbors["10.0.0.2"]) {
+		t.Error("neighbor 10.0.0.2 should be a client through the group cluster")
+	}
+
+	ibgp := cfg.Protocols.BGP.Groups["IBGP"]
+	if got := ibgp.Neighbors["10.0.0.3"].Cluster; got != "192.0.2.1" {
+		t.Errorf("neighbor 10.0.0.3 cluster = %q, want 192.0.2.1", got)
+	}
+	if !ibgp.Neighbors["10.0.0.4"].RouteReflectorClient {
+		t.Error("neighbor 10.0.0.4 should be a route-reflector client")
+	}
+	if ibgp.IsRouteReflectorClient(ibgp.Neighbors["10.0.0.5"]) {
+		t.Error("neighbor 10.0.0.5 should not be a route-reflector client")
+	}
+
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	assertSetCommandRoundTrip(t, cfg)
+}
+
+func TestParser_BGPNextHopSelfAndRemovePrivateAS(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set routing-options autonomous-system 65000",
+		"set protocols bgp group IBGP type internal",
+		"set protocols bgp group IBGP next-hop-self",
+		"set protocols bgp group IBGP neighbor 10.0.0.2 peer-as 65000",
+		"set protocols bgp group IBGP neighbor 10.0.0.2 local-address 10.0.0.1",
+		"set protocols bgp group EBGP type external",
+		"set protocols bgp group EBGP remove-private-as",
+		"set protocols bgp group EBGP neighbor 192.0.2.2 peer-as 65001",
+		"set protocols bgp group EBGP neighbor 192.0.2.2 next-hop-self",
+		"set protocols bgp group EBGP neighbor 192.0.2.3 peer-as 65001",
+	)
+
+	ibgp := cfg.Protocols.BGP.Groups["IBGP"]
+	if !ibgp.IsNextHopSelf(ibgp.Neighbors["10.0.0.2"]) || ibgp.IsRemovePrivateAS(ibgp.Neighbors["10.0.0.2"]) {
+		t.Errorf("IBGP neighbor = %+v, want next-hop-self through the group only", ibgp.Neighbors["10.0.0.2"])
+	}
+	ebgp := cfg.Protocols.BGP.Groups["EBGP"]
+	if !ebgp.IsRemovePrivateAS(ebgp.Neighbors["192.0.2.3"]) || ebgp.IsNextHopSelf(ebgp.Neighbors["192.0.2.3"]) {
+		t.Errorf("EBGP neighbor = %+v, want remove-private-as through the group only", ebgp.Neighbors["192.0.2.3"])
+	}
+
+	result := cfg.ValidateAll()
+	if result.HasErrors() {
+		t.Fatalf("ValidateAll() errors = %v", result.Errors())
+	}
+	want := "warning: BGP neighbor 192.0.2.2 in group EBGP sets next-hop-self, which has no effect on external peers"
+	if got := result.Warnings(); len(got) != 1 || got[0].String() != want {
+		t.Errorf("warnings = %v, want [%s]", got, want)
+	}
+	assertSetCommandRoundTrip(t, cfg)
+}
+
+func TestParser_BGPRouterID(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set routing-options autonomous-system 65000",
+		"set routing-options router-id 10.0.0.1",
+		"set protocols bgp router-id 192.0.2.1",
+		"set protocols bgp group IBGP type internal",
+		"set protocols bgp group IBGP neighbor 10.0.0.2 peer-as 65000",
+	)
+
+	if got := cfg.Protocols.BGP.RouterID; got != "192.0.2.1" {
+		t.Errorf("BGP router-id = %q, want 192.0.2.1", got)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	assertSetCommandRoundTrip(t, cfg)
+
+	cfg.Protocols.BGP.RouterID = "2001:db8::1"
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want IPv6 BGP router-id error")
+	}
+}
+
+func TestValidate_BGPRouteReflectorErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{
+			name: "invalid cluster ID",
+			lines: []string{
+				"set protocols bgp group IBGP type internal",
+				"set protocols bgp group IBGP cluster 2001:db8::1",
+				"set protocols bgp group IBGP neighbor 10.0.0.2 peer-as 65000",
+			},
+			want: "Invalid cluster ID",
+		},
+		{
+			name: "external group",
+			lines: []string{
+				"set protocols bgp group EBGP type external",
+				"set protocols bgp group EBGP neighbor 10.0.0.2 peer-as 65001",
+				"set protocols bgp group EBGP neighbor 10.0.0.2 route-reflector-client",
+			},
+			want: "group EBGP is external",
+		},
+		{
+			name: "conflicting cluster IDs",
+			lines: []string{
+				"set protocols bgp group A type internal",
+				"set protocols bgp group A cluster 192.0.2.1",
+				"set protocols bgp group A neighbor 10.0.0.2 peer-as 65000",
+				"set protocols bgp group B type internal",
+				"set protocols bgp group B neighbor 10.0.0.3 peer-as 65000",
+				"set protocols bgp group B neighbor 10.0.0.3 cluster 192.0.2.2",
+			},
+			want: "cluster 192.0.2.1 is already configured",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := append([]string{"set routing-options autonomous-system 65000"}, tt.lines...)
+			err := parseSetCommands(t, lines...).Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
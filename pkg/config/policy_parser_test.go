@@ -92,6 +92,37 @@ set policy-options prefix-list LIST2 192.168.0.0/16
 	}
 }
 
+func TestParsePrefixListValueList(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader("set policy-options prefix-list PL [ 10.0.0.0/8 172.16.0.0/12 10.0.0.0/8 ]\nset policy-options prefix-list PL 192.168.0.0/16\n")).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got := cfg.PolicyOptions.PrefixLists["PL"].Prefixes
+	want := []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("prefixes = %v, want %v", got, want)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "invalid element", input: "set policy-options prefix-list PL [ 10.0.0.0/8 10.0.0.300/8 172.16.0.0/12 ]\n", wantErr: `column 48: invalid prefix "10.0.0.300/8"`},
+		{name: "missing close", input: "set policy-options prefix-list PL [ 10.0.0.0/8\n", wantErr: "expected prefix value or ']'"},
+		{name: "empty list", input: "set policy-options prefix-list PL [ ]\n", wantErr: "expected prefix value in value list"},
+		{name: "nested list", input: "set policy-options prefix-list PL [ [ 10.0.0.0/8 ] ]\n", wantErr: "expected prefix value or ']'"},
+		{name: "trailing token", input: "set policy-options prefix-list PL [ 10.0.0.0/8 ] extra\n", wantErr: "expected end of line after statement"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewParser(strings.NewReader(tt.input)).Parse()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 // TestParsePolicyStatementAccept tests policy-statement with accept action
 func TestParsePolicyStatementAccept(t *testing.T) {
 	input := `set policy-options policy-statement MYPOLICY term TERM1 from prefix-list MYLIST
@@ -219,6 +250,67 @@ set policy-options policy-statement MYPOLICY term TERM1 then community 65000:100
 	}
 }
 
+// TestValidateCommunityForms tests standard, large and extended community validation
+func TestValidateCommunityForms(t *testing.T) {
+	tests := []struct {
+		community string
+		wantErr   bool
+	}{
+		{"65000:100", false},
+		{"65535:65535", false},
+		{"no-export", false},
+		{"65536:1", true},
+		{"1:65536", true},
+		{"65000", true},
+		{"65000:", true},
+		{"4200000000:1:2", false},
+		{"65000:4294967295:0", false},
+		{"65000:4294967296:0", true},
+		{"65000:1:2:3", true},
+		{"target:65000:100", false},
+		{"target:65000:4294967295", false},
+		{"target:4200000000:100", false},
+		{"target:4200000000:65536", true},
+		{"origin:192.0.2.1:7", false},
+		{"origin:192.0.2.1:65536", true},
+		{"target:65000", true},
+		{"target:bogus:1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.community, func(t *testing.T) {
+			err := ValidateCommunity(tt.community)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCommunity(%q) error = %v, wantErr %v", tt.community, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestParsePolicyStatementLargeCommunity tests large and extended community actions
+func TestParsePolicyStatementLargeCommunity(t *testing.T) {
+	input := `set policy-options policy-statement MYPOLICY term LARGE then community 65000:1:2
+set policy-options policy-statement MYPOLICY term RT then community target:65000:100
+`
+	config, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	terms := config.PolicyOptions.PolicyStatements["MYPOLICY"].Terms
+	if terms[0].Then.Community != "65000:1:2" {
+		t.Errorf("Expected community 65000:1:2, got %s", terms[0].Then.Community)
+	}
+	if terms[1].Then.Community != "target:65000:100" {
+		t.Errorf("Expected community target:65000:100, got %s", terms[1].Then.Community)
+	}
+
+	_, err = NewParser(strings.NewReader("set policy-options policy-statement MYPOLICY term TERM1 then community 65536:1\n")).Parse()
+	if err == nil || !strings.Contains(err.Error(), "invalid community") {
+		t.Fatalf("Parse() error = %v, want invalid community", err)
+	}
+}
+
 // TestParsePolicyStatementASPath tests as-path match condition
 func TestParsePolicyStatementASPath(t *testing.T) {
 	input := `set policy-options policy-statement MYPOLICY term TERM1 from as-path ".*65001.*"
@@ -422,3 +514,67 @@ set policy-options policy-statement MYPOLICY term TERM1 then accept
 		t.Error("Policy statement MYPOLICY not found")
 	}
 }
+
+// TestParsePolicyStatementRouteFilter tests route-filter match types
+func TestParsePolicyStatementRouteFilter(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    RouteFilter
+		wantMin int
+		wantMax int
+	}{
+		{"10.0.0.0/8 exact", RouteFilter{Prefix: "10.0.0.0/8", MatchType: "exact"}, 8, 8},
+		{"10.0.0.0/8 orlonger", RouteFilter{Prefix: "10.0.0.0/8", MatchType: "orlonger"}, 8, 32},
+		{"10.0.0.0/8 longer", RouteFilter{Prefix: "10.0.0.0/8", MatchType: "longer"}, 9, 32},
+		{"10.0.0.0/8 upto /24", RouteFilter{Prefix: "10.0.0.0/8", MatchType: "upto", MaxLength: 24}, 8, 24},
+		{"10.0.0.0/8 prefix-length-range /16-/24", RouteFilter{Prefix: "10.0.0.0/8", MatchType: "prefix-length-range", MinLength: 16, MaxLength: 24}, 16, 24},
+		{"2001:db8::/32 orlonger", RouteFilter{Prefix: "2001:db8::/32", MatchType: "orlonger"}, 32, 128},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			cfg := parseSetCommands(t,
+				"set policy-options policy-statement FILTER term T1 from route-filter "+tt.line,
+				"set policy-options policy-statement FILTER term T1 from route-filter "+tt.line,
+				"set policy-options policy-statement FILTER term T1 then accept",
+			)
+			filters := cfg.PolicyOptions.PolicyStatements["FILTER"].Terms[0].From.RouteFilters
+			if len(filters) != 1 {
+				t.Fatalf("route-filters = %d, want 1", len(filters))
+			}
+			if *filters[0] != tt.want {
+				t.Fatalf("route-filter = %+v, want %+v", *filters[0], tt.want)
+			}
+			if minLength, maxLength := filters[0].LengthRange(); minLength != tt.wantMin || maxLength != tt.wantMax {
+				t.Fatalf("LengthRange() = %d-%d, want %d-%d", minLength, maxLength, tt.wantMin, tt.wantMax)
+			}
+			if err := cfg.Validate(); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			assertSetCommandRoundTrip(t, cfg)
+		})
+	}
+}
+
+// TestParsePolicyStatementRouteFilterRejectsInvalid tests invalid route-filter combinations
+func TestParsePolicyStatementRouteFilterRejectsInvalid(t *testing.T) {
+	tests := []string{
+		"10.0.0.0/33 exact",
+		"10.0.0.0/8 sometimes",
+		"10.0.0.0/8 upto /4",
+		"10.0.0.0/8 upto /33",
+		"10.0.0.0/8 prefix-length-range /24-/16",
+		"10.0.0.0/8 prefix-length-range /4-/16",
+		"10.0.0.0/8 prefix-length-range /16",
+		"192.0.2.1/32 longer",
+	}
+
+	for _, line := range tests {
+		t.Run(line, func(t *testing.T) {
+			input := "set policy-options policy-statement FILTER term T1 from route-filter " + line + "\n"
+			if _, err := NewParser(strings.NewReader(input)).Parse(); err == nil {
+				t.Fatalf("Parse(%q) succeeded, want error", line)
+			}
+		})
+	}
+}
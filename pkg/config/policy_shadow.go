@@ -0,0 +1,162 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"sort"
+)
+
+// policyPrefixMatch is one prefix a policy term matches: routes inside
+// network whose prefix length is between minLength and maxLength.
+type policyPrefixMatch struct {
+	network   *net.IPNet
+	minLength int
+	maxLength int
+}
+
+// covers reports whether every route matched by other is also matched by m.
+func (m policyPrefixMatch) covers(other policyPrefixMatch) bool {
+	ones, bits := m.network.Mask.Size()
+	otherOnes, otherBits := other.network.Mask.Size()
+	if bits != otherBits || ones > otherOnes || !m.network.Contains(other.network.IP) {
+		return false
+	}
+	return m.minLength <= other.minLength && other.maxLength <= m.maxLength
+}
+
+// policyTermMatch is the set of routes a policy term matches. A nil prefixes
+// slice means the term matches any prefix.
+type policyTermMatch struct {
+	prefixes []policyPrefixMatch
+	protocol string
+	neighbor string
+	asPath   string
+}
+
+func (m *policyTermMatch) covers(other *policyTermMatch) bool {
+	if m.protocol != "" && m.protocol != other.protocol {
+		return false
+	}
+	if m.neighbor != "" && m.neighbor != other.neighbor {
+		return false
+	}
+	if m.asPath != "" && m.asPath != other.asPath {
+		return false
+	}
+	if m.prefixes == nil {
+		return true
+	}
+	if other.prefixes == nil {
+		return false
+	}
+	for _, prefix := range other.prefixes {
+		covered := false
+		for _, candidate := range m.prefixes {
+			if candidate.covers(prefix) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// PolicyTermShadowWarnings reports policy terms that can never match because
+// an earlier term of the same policy-statement matches every route they
+// match. Each term becomes a terminal FRR route-map entry, so the first
+// matching term decides the route and a shadowed term's action is never
+// applied, for example a reject behind an accept of a covering prefix. The
+// analysis is best-effort: it compares prefix-lists (exact matches),
+// route-filters, protocol, neighbor, and as-path conditions literally, and
+// skips terms that reference unknown or empty prefix-lists.
+func (c *Config) PolicyTermShadowWarnings() []string {
+	if c == nil || c.PolicyOptions == nil || len(c.PolicyOptions.PolicyStatements) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(c.PolicyOptions.PolicyStatements))
+	for name := range c.PolicyOptions.PolicyStatements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var warnings []string
+	for _, name := range names {
+		statement := c.PolicyOptions.PolicyStatements[name]
+		if statement == nil {
+			continue
+		}
+		var earlier []*PolicyTerm
+		var earlierMatches []*policyTermMatch
+		for _, term := range statement.Terms {
+			if term == nil {
+				continue
+			}
+			match, ok := c.policyTermMatch(term)
+			if !ok {
+				continue
+			}
+			for i, previous := range earlierMatches {
+				if previous.covers(match) {
+					warnings = append(warnings, fmt.Sprintf(
+						"policy-statement %s term %s is shadowed by earlier term %s, which matches every route it matches; its %s action is never applied. Reorder the terms or narrow term %s",
+						name, term.Name, earlier[i].Name, policyTermAction(term), earlier[i].Name))
+					break
+				}
+			}
+			earlier = append(earlier, term)
+			earlierMatches = append(earlierMatches, match)
+		}
+	}
+	return warnings
+}
+
+// policyTermMatch builds the match set of term. It returns false when the
+// term's prefixes cannot be determined.
+func (c *Config) policyTermMatch(term *PolicyTerm) (*policyTermMatch, bool) {
+	match := &policyTermMatch{}
+	from := term.From
+	if from == nil {
+		return match, true
+	}
+	match.protocol = from.Protocol
+	match.neighbor = from.Neighbor
+	match.asPath = from.ASPath
+	if len(from.PrefixLists) == 0 && len(from.RouteFilters) == 0 {
+		return match, true
+	}
+
+	match.prefixes = []policyPrefixMatch{}
+	for _, listName := range from.PrefixLists {
+		list := c.PolicyOptions.PrefixLists[listName]
+		if list == nil || len(list.Prefixes) == 0 {
+			return nil, false
+		}
+		for _, prefix := range list.Prefixes {
+			_, network, err := net.ParseCIDR(prefix)
+			if err != nil {
+				return nil, false
+			}
+			ones, _ := network.Mask.Size()
+			match.prefixes = append(match.prefixes, policyPrefixMatch{network: network, minLength: ones, maxLength: ones})
+		}
+	}
+	for _, filter := range from.RouteFilters {
+		if filter == nil || filter.Validate() != nil {
+			return nil, false
+		}
+		_, network, _ := net.ParseCIDR(filter.Prefix)
+		minLength, maxLength := filter.LengthRange()
+		match.prefixes = append(match.prefixes, policyPrefixMatch{network: network, minLength: minLength, maxLength: maxLength})
+	}
+	return match, true
+}
+
+func policyTermAction(term *PolicyTerm) string {
+	if term.Then != nil && term.Then.Accept != nil && !*term.Then.Accept {
+		return "reject"
+	}
+	return "accept"
+}
@@ -112,3 +112,56 @@ func TestValidateBGPGroupRejectsUnknownPolicyReferences(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyTermShadowWarnings(t *testing.T) {
+	cfg, err := NewParser(strings.NewReader(`
+set policy-options prefix-list CUSTOMER 192.0.2.0/24
+set policy-options prefix-list CUSTOMER 198.51.100.0/24
+set policy-options policy-statement IMPORT term ALL-10 from route-filter 10.0.0.0/8 orlonger
+set policy-options policy-statement IMPORT term ALL-10 then accept
+set policy-options policy-statement IMPORT term BOGON from route-filter 10.1.0.0/16 upto /24
+set policy-options policy-statement IMPORT term BOGON then reject
+set policy-options policy-statement IMPORT term CUSTOMER from prefix-list CUSTOMER
+set policy-options policy-statement IMPORT term CUSTOMER from protocol bgp
+set policy-options policy-statement IMPORT term CUSTOMER then accept
+set policy-options policy-statement IMPORT term CUSTOMER-OSPF from prefix-list CUSTOMER
+set policy-options policy-statement IMPORT term CUSTOMER-OSPF from protocol ospf
+set policy-options policy-statement IMPORT term CUSTOMER-OSPF then reject
+set policy-options policy-statement IMPORT term CUSTOMER-HOST from route-filter 192.0.2.0/24 exact
+set policy-options policy-statement IMPORT term CUSTOMER-HOST from protocol bgp
+set policy-options policy-statement IMPORT term CUSTOMER-HOST then reject
+set policy-options policy-statement EXPORT term SPECIFIC from route-filter 10.1.0.0/16 exact
+set policy-options policy-statement EXPORT term SPECIFIC then reject
+set policy-options policy-statement EXPORT term WIDE from route-filter 10.0.0.0/8 orlonger
+set policy-options policy-statement EXPORT term WIDE then accept
+set policy-options policy-statement EXPORT term DEFAULT then reject
+set policy-options policy-statement EXPORT term NEVER from protocol static
+set policy-options policy-statement EXPORT term NEVER then accept
+`)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	warnings := cfg.PolicyTermShadowWarnings()
+	want := []string{
+		"policy-statement EXPORT term NEVER is shadowed by earlier term DEFAULT",
+		"policy-statement IMPORT term BOGON is shadowed by earlier term ALL-10",
+		"policy-statement IMPORT term CUSTOMER-HOST is shadowed by earlier term CUSTOMER",
+	}
+	if len(warnings) != len(want) {
+		t.Fatalf("PolicyTermShadowWarnings() = %q, want %d warnings", warnings, len(want))
+	}
+	for i, prefix := range want {
+		if !strings.HasPrefix(warnings[i], prefix) {
+			t.Fatalf("warning %d = %q, want prefix %q", i, warnings[i], prefix)
+		}
+	}
+	if !strings.Contains(warnings[1], "its reject action is never applied") {
+		t.Fatalf("warning = %q, want the shadowed reject action", warnings[1])
+	}
+
+	result := cfg.ValidateAll()
+	if result.HasErrors() || len(result.Warnings()) != len(want) {
+		t.Fatalf("ValidateAll() issues = %v, want %d shadowing warnings only", result.Issues, len(want))
+	}
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RedactSecrets returns a copy of cfg with credential material replaced by the
+// reserved redacted marker. Only the secret-bearing branches are copied; the
+// rest of the tree is shared with cfg, so callers must treat the result as
+// read-only. cfg itself is never modified, so committed values are preserved.
+//
+// Every display path (set text, JSON, NETCONF XML) should serialize the
+// redacted copy rather than redacting individual fields.
+func RedactSecrets(cfg *Config) *Config {
+	if cfg == nil {
+		return nil
+	}
+	redacted := *cfg
+	redacted.System = redactSystemSecrets(cfg.System)
+	redacted.Security = redactSecuritySecrets(cfg.Security)
+	return &redacted
+}
+
+func redactSystemSecrets(system *SystemConfig) *SystemConfig {
+	if system == nil || system.Services == nil || system.Services.SNMP == nil || system.Services.SNMP.Community == "" {
+		return system
+	}
+	snmp := *system.Services.SNMP
+	snmp.Community = redactedSecretValue
+	services := *system.Services
+	services.SNMP = &snmp
+	out := *system
+	out.Services = &services
+	return &out
+}
+
+func redactSecuritySecrets(sec *SecurityConfig) *SecurityConfig {
+	if sec == nil || len(sec.Users) == 0 {
+		return sec
+	}
+	out := *sec
+	out.Users = make(map[string]*UserConfig, len(sec.Users))
+	for name, user := range sec.Users {
+		if user == nil {
+			out.Users[name] = nil
+			continue
+		}
+		redactedUser := *user
+		if redactedUser.Password != "" {
+			redactedUser.Password = redactedSecretValue
+		}
+		if redactedUser.SSHKey != "" {
+			redactedUser.SSHKey = redactedSecretValue
+		}
+		out.Users[name] = &redactedUser
+	}
+	return &out
+}
+
+// RedactSecretCommand returns a set or delete command with the value of a
+// secret-bearing field replaced by the redacted marker, for records such as
+// audit entries that keep individual commands. Other commands are returned
+// unchanged.
+func RedactSecretCommand(line string) string {
+	fields := strings.Fields(line)
+	keep := secretValueField(fields)
+	if keep == 0 {
+		return line
+	}
+	return strings.Join(append(fields[:keep:keep], redactedSecretValue), " ")
+}
+
+// secretValueField returns the index of the first field of the secret value
+// in a set or delete command split into fields, or 0 when the command does
+// not carry a secret.
+func secretValueField(fields []string) int {
+	switch {
+	case len(fields) > 5 &&
+		fields[1] == "system" &&
+		fields[2] == "services" &&
+		fields[3] == "snmp" &&
+		fields[4] == "community":
+		return 5
+	case len(fields) > 6 &&
+		fields[1] == "security" &&
+		fields[2] == "users" &&
+		fields[3] == "user" &&
+		(fields[5] == "password" || fields[5] == "ssh-key"):
+		return 6
+	default:
+		return 0
+	}
+}
+
+// ChangeSummary returns the set commands removed from oldText as "- line"
+// and added in newText as "+ line", sorted, with secret values redacted. At
+// most limit lines are listed; when more changed, a final line counts the
+// rest. A limit of 0 lists every change.
+func ChangeSummary(oldText, newText string, limit int) []string {
+	oldLines := changeSummaryLines(oldText)
+	newLines := changeSummaryLines(newText)
+	var changes []string
+	for line := range oldLines {
+		if _, ok := newLines[line]; !ok {
+			changes = append(changes, "- "+RedactSecretCommand(line))
+		}
+	}
+	for line := range newLines {
+		if _, ok := oldLines[line]; !ok {
+			changes = append(changes, "+ "+RedactSecretCommand(line))
+		}
+	}
+	sort.Strings(changes)
+	if limit > 0 && len(changes) > limit {
+		more := len(changes) - limit
+		changes = append(changes[:limit], fmt.Sprintf("... %d more changes", more))
+	}
+	return changes
+}
+
+func changeSummaryLines(text string) map[string]struct{} {
+	lines := make(map[string]struct{})
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines[line] = struct{}{}
+		}
+	}
+	return lines
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func redactTestConfig() *Config {
+	return &Config{
+		System: &SystemConfig{
+			HostName: "edge01",
+			Services: &SystemServicesConfig{
+				SNMP: &SNMPConfig{
+					Enabled:   true,
+					Community: "private-community",
+				},
+			},
+		},
+		Security: &SecurityConfig{
+			Users: map[string]*UserConfig{
+				"admin": {
+					Username: "admin",
+					Password: "plain-password",
+					Role:     "admin",
+					SSHKey:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIadminkey admin@example",
+				},
+			},
+		},
+	}
+}
+
+func TestRedactSecretsMasksEverySecretField(t *testing.T) {
+	cfg := redactTestConfig()
+	redacted := RedactSecrets(cfg)
+
+	if got := redacted.System.Services.SNMP.Community; got != redactedSecretValue {
+		t.Fatalf("SNMP community = %q, want redacted", got)
+	}
+	user := redacted.Security.Users["admin"]
+	if user.Password != redactedSecretValue {
+		t.Fatalf("user password = %q, want redacted", user.Password)
+	}
+	if user.SSHKey != redactedSecretValue {
+		t.Fatalf("user ssh-key = %q, want redacted", user.SSHKey)
+	}
+	if user.Role != "admin" || redacted.System.HostName != "edge01" {
+		t.Fatalf("redacted config lost non-secret fields: %#v", redacted)
+	}
+
+	original := redactTestConfig()
+	if cfg.System.Services.SNMP.Community != original.System.Services.SNMP.Community ||
+		cfg.Security.Users["admin"].Password != original.Security.Users["admin"].Password ||
+		cfg.Security.Users["admin"].SSHKey != original.Security.Users["admin"].SSHKey {
+		t.Fatalf("RedactSecrets() mutated source config: %#v", cfg.Security.Users["admin"])
+	}
+}
+
+func TestRedactSecretsNilAndSecretFreeConfig(t *testing.T) {
+	if RedactSecrets(nil) != nil {
+		t.Fatal("RedactSecrets(nil) != nil")
+	}
+	cfg := &Config{System: &SystemConfig{HostName: "edge01"}}
+	if got := RedactSecrets(cfg); got.System.HostName != "edge01" || got.Security != nil {
+		t.Fatalf("RedactSecrets() = %#v, want unchanged secret-free config", got)
+	}
+}
+
+func TestRedactSecretsDisplayFormats(t *testing.T) {
+	secrets := []string{"private-community", "plain-password", "AAAAC3NzaC1lZDI1NTE5AAAAIadminkey", "$argon2id$"}
+
+	setText, err := ToSetCommandsRedactedWithError(redactTestConfig())
+	if err != nil {
+		t.Fatalf("ToSetCommandsRedactedWithError() error = %v", err)
+	}
+	var jsonText strings.Builder
+	enc := json.NewEncoder(&jsonText)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(RedactSecrets(redactTestConfig())); err != nil {
+		t.Fatalf("json encode error = %v", err)
+	}
+
+	for name, text := range map[string]string{"set": setText, "json": jsonText.String()} {
+		for _, secret := range secrets {
+			if strings.Contains(text, secret) {
+				t.Fatalf("%s output leaked %q:\n%s", name, secret, text)
+			}
+		}
+		if strings.Count(text, redactedSecretValue) != 3 {
+			t.Fatalf("%s output =\n%s\nwant three redacted markers", name, text)
+		}
+	}
+	for _, line := range strings.Split(strings.TrimSpace(setText), "\n") {
+		if strings.Contains(line, redactedSecretValue) && !IsRedactedSecretLine(line) {
+			t.Fatalf("IsRedactedSecretLine(%q) = false, want true", line)
+		}
+	}
+}
+
+func TestRedactSecretsPreservesCommittedValues(t *testing.T) {
+	cfg := redactTestConfig()
+	if _, err := ToSetCommandsRedactedWithError(cfg); err != nil {
+		t.Fatalf("ToSetCommandsRedactedWithError() error = %v", err)
+	}
+
+	text, err := ToSetCommandsWithError(cfg)
+	if err != nil {
+		t.Fatalf("ToSetCommandsWithError() error = %v", err)
+	}
+	if strings.Contains(text, redactedSecretValue) {
+		t.Fatalf("stored config contains redacted marker:\n%s", text)
+	}
+	for _, want := range []string{"private-community", "AAAAC3NzaC1lZDI1NTE5AAAAIadminkey", "password \"$argon2id$"} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("stored config missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestChangeSummaryRedactsSecretCommands(t *testing.T) {
+	oldText := "set system host-name edge01\nset system services snmp community old-community\n"
+	newText := "set system host-name edge02\n" +
+		"set system services snmp community new-community\n" +
+		`set security users user admin ssh-key "ssh-ed25519 AAAAC3Nza admin@example"` + "\n"
+
+	got := ChangeSummary(oldText, newText, 0)
+	want := []string{
+		"+ set security users user admin ssh-key <redacted>",
+		"+ set system host-name edge02",
+		"+ set system services snmp community <redacted>",
+		"- set system host-name edge01",
+		"- set system services snmp community <redacted>",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("ChangeSummary() = %q, want %q", got, want)
+	}
+
+	got = ChangeSummary(oldText, newText, 2)
+	if len(got) != 3 || got[2] != "... 3 more changes" {
+		t.Fatalf("ChangeSummary(limit 2) = %q, want two changes and a count of the rest", got)
+	}
+}
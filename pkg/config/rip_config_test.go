@@ -0,0 +1,102 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRIPRoundTrip(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/1 unit 0 family inet address 198.51.100.1/24",
+		"set protocols rip update-interval 10",
+		"set protocols rip holddown 60",
+		"set protocols rip default-metric 2",
+		"set protocols rip group EDGE neighbor ge-0/0/0",
+		"set protocols rip group EDGE neighbor ge-0/0/1 passive",
+	)
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	rip := cfg.Protocols.RIP
+	if rip.UpdateInterval != 10 || rip.Holddown != 60 || rip.DefaultMetric != 2 {
+		t.Fatalf("RIP = %+v", rip)
+	}
+	group := rip.Groups["EDGE"]
+	if group == nil || len(group.Neighbors) != 2 || !group.Neighbors["ge-0/0/1"].Passive {
+		t.Fatalf("RIP group EDGE = %+v", group)
+	}
+	text := ToSetCommands(cfg)
+	for _, want := range []string{
+		"set protocols rip update-interval 10",
+		"set protocols rip group EDGE neighbor ge-0/0/0\n",
+		"set protocols rip group EDGE neighbor ge-0/0/1 passive",
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("ToSetCommands() missing %q:\n%s", want, text)
+		}
+	}
+	assertSetCommandRoundTrip(t, cfg)
+}
+
+func TestValidate_RIPErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{
+			name:  "unknown interface",
+			lines: []string{"set protocols rip group EDGE neighbor ge-0/0/9"},
+			want:  "non-existent interface ge-0/0/9",
+		},
+		{
+			name: "no inet address",
+			lines: []string{
+				"set interfaces ge-0/0/1 unit 0 family inet6 address 2001:db8::1/64",
+				"set protocols rip group EDGE neighbor ge-0/0/1",
+			},
+			want: "has no inet address",
+		},
+		{
+			name: "interface in two groups",
+			lines: []string{
+				"set protocols rip group A neighbor ge-0/0/0",
+				"set protocols rip group B neighbor ge-0/0/0",
+			},
+			want: "is in groups A and B",
+		},
+		{
+			name: "timer out of range",
+			lines: []string{
+				"set protocols rip route-timeout 2",
+				"set protocols rip group EDGE neighbor ge-0/0/0",
+			},
+			want: "Invalid RIP route-timeout",
+		},
+		{
+			name: "metric out of range",
+			lines: []string{
+				"set protocols rip default-metric 17",
+				"set protocols rip group EDGE neighbor ge-0/0/0",
+			},
+			want: "Invalid RIP default-metric",
+		},
+		{
+			name:  "no groups",
+			lines: []string{"set protocols rip update-interval 30"},
+			want:  "no groups defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := append([]string{"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24"}, tt.lines...)
+			err := parseSetCommands(t, lines...).Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
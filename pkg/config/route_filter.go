@@ -0,0 +1,117 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Route-filter match types
+const (
+	RouteFilterExact             = "exact"
+	RouteFilterOrLonger          = "orlonger"
+	RouteFilterLonger            = "longer"
+	RouteFilterUpTo              = "upto"
+	RouteFilterPrefixLengthRange = "prefix-length-range"
+)
+
+// LengthRange returns the inclusive range of prefix lengths matched by the
+// filter. The prefix must already be valid.
+func (f *RouteFilter) LengthRange() (minLength, maxLength int) {
+	_, ipNet, err := net.ParseCIDR(f.Prefix)
+	if err != nil {
+		return 0, 0
+	}
+	prefixLength, bits := ipNet.Mask.Size()
+	switch f.MatchType {
+	case RouteFilterOrLonger:
+		return prefixLength, bits
+	case RouteFilterLonger:
+		return prefixLength + 1, bits
+	case RouteFilterUpTo:
+		return prefixLength, f.MaxLength
+	case RouteFilterPrefixLengthRange:
+		return f.MinLength, f.MaxLength
+	default:
+		return prefixLength, prefixLength
+	}
+}
+
+// Validate checks the prefix and match-type combination.
+func (f *RouteFilter) Validate() error {
+	if f == nil {
+		return fmt.Errorf("route-filter is nil")
+	}
+	_, ipNet, err := net.ParseCIDR(f.Prefix)
+	if err != nil {
+		return fmt.Errorf("invalid route-filter prefix %q", f.Prefix)
+	}
+	prefixLength, bits := ipNet.Mask.Size()
+	switch f.MatchType {
+	case RouteFilterExact, RouteFilterOrLonger:
+		if f.MinLength != 0 || f.MaxLength != 0 {
+			return fmt.Errorf("route-filter %s %s does not take a prefix length", f.Prefix, f.MatchType)
+		}
+	case RouteFilterLonger:
+		if f.MinLength != 0 || f.MaxLength != 0 {
+			return fmt.Errorf("route-filter %s %s does not take a prefix length", f.Prefix, f.MatchType)
+		}
+		if prefixLength == bits {
+			return fmt.Errorf("route-filter %s longer cannot match a host prefix", f.Prefix)
+		}
+	case RouteFilterUpTo:
+		if f.MinLength != 0 {
+			return fmt.Errorf("route-filter %s upto takes a single prefix length", f.Prefix)
+		}
+		if f.MaxLength < prefixLength || f.MaxLength > bits {
+			return fmt.Errorf("route-filter %s upto /%d must be between /%d and /%d", f.Prefix, f.MaxLength, prefixLength, bits)
+		}
+	case RouteFilterPrefixLengthRange:
+		if f.MinLength < prefixLength || f.MaxLength > bits || f.MinLength > f.MaxLength {
+			return fmt.Errorf("route-filter %s prefix-length-range /%d-/%d must satisfy /%d <= min <= max <= /%d", f.Prefix, f.MinLength, f.MaxLength, prefixLength, bits)
+		}
+	default:
+		return fmt.Errorf("unsupported route-filter match type %q, valid values: exact, orlonger, longer, upto, prefix-length-range", f.MatchType)
+	}
+	return nil
+}
+
+// String renders the filter in set-command form, for example
+// "10.0.0.0/8 upto /24".
+func (f *RouteFilter) String() string {
+	switch f.MatchType {
+	case RouteFilterUpTo:
+		return fmt.Sprintf("%s %s /%d", f.Prefix, f.MatchType, f.MaxLength)
+	case RouteFilterPrefixLengthRange:
+		return fmt.Sprintf("%s %s /%d-/%d", f.Prefix, f.MatchType, f.MinLength, f.MaxLength)
+	default:
+		return fmt.Sprintf("%s %s", f.Prefix, f.MatchType)
+	}
+}
+
+// parseRouteFilterLength parses a prefix length written as "/24" or "24".
+func parseRouteFilterLength(value string) (int, error) {
+	length, err := strconv.Atoi(strings.TrimPrefix(value, "/"))
+	if err != nil || length < 0 || length > 128 {
+		return 0, fmt.Errorf("invalid prefix length %q", value)
+	}
+	return length, nil
+}
+
+// parseRouteFilterLengthRange parses a prefix length range written as "/16-/24".
+func parseRouteFilterLengthRange(value string) (int, int, error) {
+	low, high, ok := strings.Cut(value, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid prefix length range %q, expected /min-/max", value)
+	}
+	minLength, err := parseRouteFilterLength(low)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxLength, err := parseRouteFilterLength(high)
+	if err != nil {
+		return 0, 0, err
+	}
+	return minLength, maxLength, nil
+}
@@ -46,6 +46,24 @@ func TestRoutingInstanceConfigRoundTrip(t *testing.T) {
 	assertSetCommandRoundTrip(t, cfg)
 }
 
+func TestRoutingInstanceValueLists(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set routing-instances BLUE vrf-import [ BLUE-IN COMMON-IN ]",
+		"set routing-instances BLUE interface [ ge-0/0/0 ge-0/0/1 ]",
+		"set protocols mpls interface [ ge-0/0/2 ge-0/0/3 ]",
+	)
+	instance := cfg.RoutingInstances["BLUE"]
+	if got := strings.Join(instance.VRFImport, " "); got != "BLUE-IN COMMON-IN" {
+		t.Fatalf("VRF import = %q, want BLUE-IN COMMON-IN", got)
+	}
+	if got := strings.Join(instance.Interfaces, " "); got != "ge-0/0/0 ge-0/0/1" {
+		t.Fatalf("interfaces = %q, want ge-0/0/0 ge-0/0/1", got)
+	}
+	if got := strings.Join(cfg.Protocols.MPLS.Interfaces, " "); got != "ge-0/0/2 ge-0/0/3" {
+		t.Fatalf("MPLS interfaces = %q, want ge-0/0/2 ge-0/0/3", got)
+	}
+}
+
 func TestRoutingInstanceValidationRejectsUnknownInterfaceReference(t *testing.T) {
 	cfg := NewConfig()
 	cfg.RoutingInstances = map[string]*RoutingInstance{
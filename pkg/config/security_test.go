@@ -30,6 +30,43 @@ func TestParserHashesSecurityUserPassword(t *testing.T) {
 	}
 }
 
+func TestParserSecurityUserDisableRoundTrip(t *testing.T) {
+	input := strings.Join([]string{
+		`set security users user alice role operator`,
+		`set security users user alice ssh-key "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBfakekey alice@example"`,
+		`set security users user alice disable`,
+		`set security users user bob role read-only`,
+	}, "\n")
+	cfg, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !cfg.Security.Users["alice"].Disabled {
+		t.Fatal("alice Disabled = false, want true")
+	}
+	if cfg.Security.Users["bob"].Disabled {
+		t.Fatal("bob Disabled = true, want false")
+	}
+
+	text, err := ToSetCommandsWithError(cfg)
+	if err != nil {
+		t.Fatalf("ToSetCommandsWithError() error = %v", err)
+	}
+	if !strings.Contains(text, "set security users user alice disable\n") {
+		t.Fatalf("serialized config missing disable line:\n%s", text)
+	}
+	if strings.Contains(text, "user bob disable") {
+		t.Fatalf("serialized config disables bob:\n%s", text)
+	}
+	reparsed, err := NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		t.Fatalf("Parse(serialized) error = %v", err)
+	}
+	if !reparsed.Security.Users["alice"].Disabled {
+		t.Fatal("reparsed alice Disabled = false, want true")
+	}
+}
+
 func TestProtectSecretsInSetCommandHashesPassword(t *testing.T) {
 	const plainPassword = "plain-password-value"
 	line, err := ProtectSecretsInSetCommand(`set security users user admin password "` + plainPassword + `"`)
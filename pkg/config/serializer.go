@@ -40,7 +40,7 @@ func IsRedactedSecretLine(line string) bool {
 		fields[1] == "security" &&
 		fields[2] == "users" &&
 		fields[3] == "user" &&
-		fields[5] == "password" {
+		(fields[5] == "password" || fields[5] == "ssh-key") {
 		return true
 	}
 	return false
@@ -95,7 +95,7 @@ func ToSetCommandsWithError(cfg *Config) (string, error) {
 // ToSetCommandsRedactedWithError serializes Config into deterministic set
 // commands with credential material replaced by a non-round-trippable marker.
 func ToSetCommandsRedactedWithError(cfg *Config) (string, error) {
-	return toSetCommandsWithError(cfg, serializeOptions{RedactSecrets: true})
+	return toSetCommandsWithError(RedactSecrets(cfg), serializeOptions{RedactSecrets: true})
 }
 
 func toSetCommandsWithError(cfg *Config, opts serializeOptions) (string, error) {
@@ -105,10 +105,13 @@ func toSetCommandsWithError(cfg *Config, opts serializeOptions) (string, error)
 
 	var b strings.Builder
 
+	writeGroups(&b, cfg.Groups)
 	if cfg.System != nil && cfg.System.HostName != "" {
 		writeLine(&b, "set system host-name %s", EscapeValue(cfg.System.HostName))
 	}
-	writeSystemServices(&b, cfg.System, opts)
+	writeSystemServices(&b, cfg.System)
+	writeSystemScripts(&b, cfg.System)
+	writeSystemAlarms(&b, cfg.System)
 
 	writeChassis(&b, cfg.Chassis)
 	writeInterfaces(&b, cfg.Interfaces)
@@ -117,14 +120,16 @@ func toSetCommandsWithError(cfg *Config, opts serializeOptions) (string, error)
 	writeProtocols(&b, cfg.Protocols)
 	writePolicyOptions(&b, cfg.PolicyOptions)
 	writeClassOfService(&b, cfg.ClassOfService)
+	writeFirewall(&b, cfg.Firewall)
 	if err := writeSecurity(&b, cfg.Security, opts); err != nil {
 		return "", err
 	}
+	writeInactive(&b, cfg.Inactive)
 
 	return b.String(), nil
 }
 
-func writeSystemServices(b *strings.Builder, system *SystemConfig, opts serializeOptions) {
+func writeSystemServices(b *strings.Builder, system *SystemConfig) {
 	if system == nil || system.Services == nil {
 		return
 	}
@@ -161,15 +166,35 @@ func writeSystemServices(b *strings.Builder, system *SystemConfig, opts serializ
 			writeLine(b, "set system services snmp port %d", snmp.Port)
 		}
 		if snmp.Community != "" {
-			community := snmp.Community
-			if opts.RedactSecrets {
-				community = redactedSecretValue
-			}
-			writeLine(b, "set system services snmp community %s", EscapeValue(community))
+			writeLine(b, "set system services snmp community %s", EscapeValue(snmp.Community))
 		}
 	}
 }
 
+func writeSystemScripts(b *strings.Builder, system *SystemConfig) {
+	if system == nil || system.Scripts == nil {
+		return
+	}
+	for _, path := range system.Scripts.CommitPre {
+		writeLine(b, "set system scripts commit pre %s", EscapeValue(path))
+	}
+	for _, path := range system.Scripts.CommitPost {
+		writeLine(b, "set system scripts commit post %s", EscapeValue(path))
+	}
+}
+
+func writeSystemAlarms(b *strings.Builder, system *SystemConfig) {
+	if system == nil || system.Alarms == nil {
+		return
+	}
+	if system.Alarms.InterfaceErrorRate != 0 {
+		writeLine(b, "set system alarms interface error-rate %d", system.Alarms.InterfaceErrorRate)
+	}
+	if system.Alarms.InterfaceLinkDown {
+		writeLine(b, "set system alarms interface link-down")
+	}
+}
+
 func writeChassis(b *strings.Builder, chassis *ChassisConfig) {
 	if chassis == nil || chassis.Cluster == nil {
 		return
@@ -230,6 +255,50 @@ func writeInterfaces(b *strings.Builder, interfaces map[string]*Interface) {
 		if iface.Description != "" {
 			writeLine(b, "set interfaces %s description %s", name, EscapeValue(iface.Description))
 		}
+		if iface.Promiscuous {
+			writeLine(b, "set interfaces %s promiscuous", name)
+		}
+		if iface.RxMode != "" {
+			writeLine(b, "set interfaces %s rx-mode %s", name, iface.RxMode)
+		}
+		if iface.MTU != 0 {
+			writeLine(b, "set interfaces %s mtu %d", name, iface.MTU)
+		}
+		if iface.Bandwidth != 0 {
+			writeLine(b, "set interfaces %s bandwidth %s", name, FormatUnitValue(iface.Bandwidth))
+		}
+		if iface.InputPolicer != "" {
+			writeLine(b, "set interfaces %s policer input %s", name, iface.InputPolicer)
+		}
+		if iface.OutputPolicer != "" {
+			writeLine(b, "set interfaces %s policer output %s", name, iface.OutputPolicer)
+		}
+		if iface.Tunnel != nil {
+			if iface.Tunnel.Source != "" {
+				writeLine(b, "set interfaces %s tunnel source %s", name, iface.Tunnel.Source)
+			}
+			if iface.Tunnel.Destination != "" {
+				writeLine(b, "set interfaces %s tunnel destination %s", name, iface.Tunnel.Destination)
+			}
+		}
+		if iface.HoldTime != nil {
+			writeLine(b, "set interfaces %s hold-time up %d", name, iface.HoldTime.Up)
+			writeLine(b, "set interfaces %s hold-time down %d", name, iface.HoldTime.Down)
+		}
+		if opts := iface.GigEtherOptions; opts != nil {
+			if opts.RxQueues != 0 {
+				writeLine(b, "set interfaces %s gigether-options rxq %d", name, opts.RxQueues)
+			}
+			if opts.TxQueues != 0 {
+				writeLine(b, "set interfaces %s gigether-options txq %d", name, opts.TxQueues)
+			}
+			for _, queue := range sortedInts(opts.RxPlacement) {
+				writeLine(b, "set interfaces %s gigether-options rx-queue %d worker %d", name, queue, opts.RxPlacement[queue])
+			}
+		}
+		for _, group := range iface.ApplyGroups {
+			writeLine(b, "set interfaces %s apply-groups %s", name, EscapeValue(group))
+		}
 		for _, unitNum := range sortedInts(iface.Units) {
 			unit := iface.Units[unitNum]
 			if unit == nil {
@@ -240,17 +309,67 @@ func writeInterfaces(b *strings.Builder, interfaces map[string]*Interface) {
 				if family == nil {
 					continue
 				}
+				if len(family.Addresses) == 0 && family.MTU == 0 && len(family.Neighbors) == 0 && family.RouterAdvertisement == nil {
+					writeLine(b, "set interfaces %s unit %d family %s", name, unitNum, familyName)
+					continue
+				}
 				addresses := append([]string(nil), family.Addresses...)
 				sort.Strings(addresses)
 				for _, addr := range addresses {
 					writeLine(b, "set interfaces %s unit %d family %s address %s",
 						name, unitNum, familyName, addr)
 				}
+				if family.MTU != 0 {
+					writeLine(b, "set interfaces %s unit %d family %s mtu %d",
+						name, unitNum, familyName, family.MTU)
+				}
+				for _, ip := range sortedKeys(family.Neighbors) {
+					writeLine(b, "set interfaces %s unit %d family %s neighbor %s mac %s",
+						name, unitNum, familyName, ip, family.Neighbors[ip])
+				}
+				writeRouterAdvertisement(b, fmt.Sprintf("set interfaces %s unit %d family %s router-advertisement", name, unitNum, familyName), family.RouterAdvertisement)
 			}
 		}
 	}
 }
 
+// writeRouterAdvertisement writes one line per configured option, or the bare
+// statement when advertisements are enabled with default settings.
+func writeRouterAdvertisement(b *strings.Builder, prefix string, ra *RouterAdvertisement) {
+	if ra == nil {
+		return
+	}
+	start := b.Len()
+	if ra.ManagedConfiguration {
+		writeLine(b, "%s managed-configuration", prefix)
+	}
+	if ra.OtherStatefulConfiguration {
+		writeLine(b, "%s other-stateful-configuration", prefix)
+	}
+	if ra.MaxAdvertisementInterval != 0 {
+		writeLine(b, "%s max-advertisement-interval %d", prefix, ra.MaxAdvertisementInterval)
+	}
+	if ra.MinAdvertisementInterval != 0 {
+		writeLine(b, "%s min-advertisement-interval %d", prefix, ra.MinAdvertisementInterval)
+	}
+	for _, raPrefix := range sortedKeys(ra.Prefixes) {
+		lifetimes := ra.Prefixes[raPrefix]
+		if lifetimes == nil || (lifetimes.ValidLifetime == 0 && lifetimes.PreferredLifetime == 0) {
+			writeLine(b, "%s prefix %s", prefix, raPrefix)
+			continue
+		}
+		if lifetimes.ValidLifetime != 0 {
+			writeLine(b, "%s prefix %s valid-lifetime %d", prefix, raPrefix, lifetimes.ValidLifetime)
+		}
+		if lifetimes.PreferredLifetime != 0 {
+			writeLine(b, "%s prefix %s preferred-lifetime %d", prefix, raPrefix, lifetimes.PreferredLifetime)
+		}
+	}
+	if b.Len() == start {
+		writeLine(b, "%s", prefix)
+	}
+}
+
 func writeRoutingOptions(b *strings.Builder, ro *RoutingOptions) {
 	if ro == nil {
 		return
@@ -261,6 +380,7 @@ func writeRoutingOptions(b *strings.Builder, ro *RoutingOptions) {
 	if ro.AutonomousSystem != 0 {
 		writeLine(b, "set routing-options autonomous-system %d", ro.AutonomousSystem)
 	}
+	writeAdministrativeDistance(b, ro.AdministrativeDistance)
 
 	routes := append([]*StaticRoute(nil), ro.StaticRoutes...)
 	sort.Slice(routes, func(i, j int) bool {
@@ -299,6 +419,42 @@ func writeRoutingOptions(b *strings.Builder, ro *RoutingOptions) {
 	}
 }
 
+func writeAdministrativeDistance(b *strings.Builder, ad *AdministrativeDistance) {
+	if ad == nil {
+		return
+	}
+	const prefix = "set routing-options administrative-distance protocol"
+	if bgp := ad.BGP; bgp != nil {
+		for _, d := range []struct {
+			routeType string
+			distance  int
+		}{{"external", bgp.External}, {"internal", bgp.Internal}, {"local", bgp.Local}} {
+			if d.distance != 0 {
+				writeLine(b, "%s bgp %s %d", prefix, d.routeType, d.distance)
+			}
+		}
+	}
+	for _, p := range []struct {
+		name     string
+		distance *OSPFDistance
+	}{{"ospf", ad.OSPF}, {"ospf3", ad.OSPF3}} {
+		if p.distance == nil {
+			continue
+		}
+		if p.distance.Default != 0 {
+			writeLine(b, "%s %s %d", prefix, p.name, p.distance.Default)
+		}
+		for _, d := range []struct {
+			routeType string
+			distance  int
+		}{{"intra-area", p.distance.IntraArea}, {"inter-area", p.distance.InterArea}, {"external", p.distance.External}} {
+			if d.distance != 0 {
+				writeLine(b, "%s %s %s %d", prefix, p.name, d.routeType, d.distance)
+			}
+		}
+	}
+}
+
 func writeRoutingInstances(b *strings.Builder, instances map[string]*RoutingInstance) {
 	for _, name := range sortedKeys(instances) {
 		instance := instances[name]
@@ -344,9 +500,43 @@ func writeProtocols(b *strings.Builder, pc *ProtocolConfig) {
 	writeOSPF(b, "ospf", pc.OSPF)
 	writeOSPF(b, "ospf3", pc.OSPF3)
 	writeMPLS(b, pc.MPLS)
+	writeRIP(b, pc.RIP)
 	writeVRRP(b, pc.VRRP)
 }
 
+func writeRIP(b *strings.Builder, rip *RIPConfig) {
+	if rip == nil {
+		return
+	}
+	if rip.UpdateInterval != 0 {
+		writeLine(b, "set protocols rip update-interval %d", rip.UpdateInterval)
+	}
+	if rip.RouteTimeout != 0 {
+		writeLine(b, "set protocols rip route-timeout %d", rip.RouteTimeout)
+	}
+	if rip.Holddown != 0 {
+		writeLine(b, "set protocols rip holddown %d", rip.Holddown)
+	}
+	if rip.DefaultMetric != 0 {
+		writeLine(b, "set protocols rip default-metric %d", rip.DefaultMetric)
+	}
+	for _, groupName := range sortedKeys(rip.Groups) {
+		group := rip.Groups[groupName]
+		if group == nil {
+			continue
+		}
+		for _, ifName := range sortedKeys(group.Neighbors) {
+			neighbor := group.Neighbors[ifName]
+			base := fmt.Sprintf("set protocols rip group %s neighbor %s", EscapeValue(groupName), ifName)
+			if neighbor != nil && neighbor.Passive {
+				writeLine(b, "%s passive", base)
+				continue
+			}
+			writeLine(b, "%s", base)
+		}
+	}
+}
+
 func writeBFD(b *strings.Builder, bfd *BFDConfig) {
 	if bfd == nil {
 		return
@@ -508,6 +698,9 @@ func writeBGP(b *strings.Builder, bgp *BGPConfig) {
 	if bgp == nil {
 		return
 	}
+	if bgp.RouterID != "" {
+		writeLine(b, "set protocols bgp router-id %s", bgp.RouterID)
+	}
 	for _, groupName := range sortedKeys(bgp.Groups) {
 		group := bgp.Groups[groupName]
 		if group == nil {
@@ -522,6 +715,15 @@ func writeBGP(b *strings.Builder, bgp *BGPConfig) {
 		if group.Export != "" {
 			writeLine(b, "set protocols bgp group %s export %s", groupName, group.Export)
 		}
+		if group.Cluster != "" {
+			writeLine(b, "set protocols bgp group %s cluster %s", groupName, group.Cluster)
+		}
+		if group.NextHopSelf {
+			writeLine(b, "set protocols bgp group %s next-hop-self", groupName)
+		}
+		if group.RemovePrivateAS {
+			writeLine(b, "set protocols bgp group %s remove-private-as", groupName)
+		}
 		for _, neighborIP := range sortedKeys(group.Neighbors) {
 			neighbor := group.Neighbors[neighborIP]
 			if neighbor == nil {
@@ -539,6 +741,10 @@ func writeBGP(b *strings.Builder, bgp *BGPConfig) {
 				writeLine(b, "set protocols bgp group %s neighbor %s local-address %s",
 					groupName, neighborIP, neighbor.LocalAddress)
 			}
+			if neighbor.UpdateSource != "" {
+				writeLine(b, "set protocols bgp group %s neighbor %s update-source %s",
+					groupName, neighborIP, neighbor.UpdateSource)
+			}
 			if neighbor.BFDProfile != "" {
 				writeLine(b, "set protocols bgp group %s neighbor %s bfd profile %s",
 					groupName, neighborIP, EscapeValue(neighbor.BFDProfile))
@@ -546,6 +752,26 @@ func writeBGP(b *strings.Builder, bgp *BGPConfig) {
 				writeLine(b, "set protocols bgp group %s neighbor %s bfd",
 					groupName, neighborIP)
 			}
+			if neighbor.Cluster != "" {
+				writeLine(b, "set protocols bgp group %s neighbor %s cluster %s",
+					groupName, neighborIP, neighbor.Cluster)
+			}
+			if neighbor.RouteReflectorClient {
+				writeLine(b, "set protocols bgp group %s neighbor %s route-reflector-client",
+					groupName, neighborIP)
+			}
+			if neighbor.Shutdown {
+				writeLine(b, "set protocols bgp group %s neighbor %s shutdown",
+					groupName, neighborIP)
+			}
+			if neighbor.NextHopSelf {
+				writeLine(b, "set protocols bgp group %s neighbor %s next-hop-self",
+					groupName, neighborIP)
+			}
+			if neighbor.RemovePrivateAS {
+				writeLine(b, "set protocols bgp group %s neighbor %s remove-private-as",
+					groupName, neighborIP)
+			}
 		}
 	}
 }
@@ -557,6 +783,9 @@ func writeOSPF(b *strings.Builder, protocol string, ospf *OSPFConfig) {
 	if ospf.RouterID != "" {
 		writeLine(b, "set protocols %s router-id %s", protocol, ospf.RouterID)
 	}
+	if ospf.ReferenceBandwidth != 0 {
+		writeLine(b, "set protocols %s reference-bandwidth %s", protocol, FormatUnitValue(ospf.ReferenceBandwidth))
+	}
 	for _, areaName := range sortedKeys(ospf.Areas) {
 		area := ospf.Areas[areaName]
 		if area == nil {
@@ -573,12 +802,12 @@ func writeOSPF(b *strings.Builder, protocol string, ospf *OSPFConfig) {
 				writeLine(b, "%s passive", base)
 				wrote = true
 			}
-			if ospfIface.Metric > 0 {
-				writeLine(b, "%s metric %d", base, ospfIface.Metric)
+			if ospfIface.Metric != nil {
+				writeLine(b, "%s metric %d", base, *ospfIface.Metric)
 				wrote = true
 			}
-			if ospfIface.PrioritySet || ospfIface.Priority > 0 {
-				writeLine(b, "%s priority %d", base, ospfIface.Priority)
+			if ospfIface.Priority != nil {
+				writeLine(b, "%s priority %d", base, *ospfIface.Priority)
 				wrote = true
 			}
 			if ospfIface.BFDProfile != "" {
@@ -604,9 +833,8 @@ func writePolicyOptions(b *strings.Builder, po *PolicyOptions) {
 		if list == nil {
 			continue
 		}
-		prefixes := append([]string(nil), list.Prefixes...)
-		sort.Strings(prefixes)
-		for _, prefix := range prefixes {
+		// Prefix-list entries are ordered; FRR sequence numbers follow this order.
+		for _, prefix := range list.Prefixes {
 			writeLine(b, "set policy-options prefix-list %s %s", listName, prefix)
 		}
 	}
@@ -641,6 +869,12 @@ func writePolicyTerm(b *strings.Builder, policyName string, term *PolicyTerm) {
 		if term.From.ASPath != "" {
 			writeLine(b, "%s from as-path %s", base, EscapeValue(term.From.ASPath))
 		}
+		for _, filter := range term.From.RouteFilters {
+			if filter == nil {
+				continue
+			}
+			writeLine(b, "%s from route-filter %s", base, filter.String())
+		}
 	}
 	if term.Then != nil {
 		if term.Then.Accept != nil {
@@ -692,6 +926,27 @@ func writeClassOfService(b *strings.Builder, cos *ClassOfServiceConfig) {
 	}
 }
 
+func writeFirewall(b *strings.Builder, fw *FirewallConfig) {
+	if fw == nil {
+		return
+	}
+	for _, name := range sortedKeys(fw.Policers) {
+		policer := fw.Policers[name]
+		if policer == nil {
+			continue
+		}
+		if policer.BandwidthLimit != 0 {
+			writeLine(b, "set firewall policer %s if-exceeding bandwidth-limit %s", name, FormatUnitValue(policer.BandwidthLimit))
+		}
+		if policer.BurstSizeLimit != 0 {
+			writeLine(b, "set firewall policer %s if-exceeding burst-size-limit %s", name, FormatUnitValue(policer.BurstSizeLimit))
+		}
+		if policer.Action != "" {
+			writeLine(b, "set firewall policer %s then %s", name, policer.Action)
+		}
+	}
+}
+
 func writeSecurity(b *strings.Builder, sec *SecurityConfig, opts serializeOptions) error {
 	if sec == nil {
 		return nil
@@ -706,6 +961,9 @@ func writeSecurity(b *strings.Builder, sec *SecurityConfig, opts serializeOption
 		if ssh.ListenAddress != "" {
 			writeLine(b, "set security netconf ssh listen-address %s", EscapeValue(ssh.ListenAddress))
 		}
+		for _, addr := range ssh.Listen {
+			writeLine(b, "set security netconf ssh listen %s", EscapeValue(addr))
+		}
 		if ssh.Port != 0 {
 			writeLine(b, "set security netconf ssh port %d", ssh.Port)
 		}
@@ -716,7 +974,9 @@ func writeSecurity(b *strings.Builder, sec *SecurityConfig, opts serializeOption
 			continue
 		}
 		if user.Password != "" {
-			password := redactedSecretValue
+			// Redacted configs already carry the marker; only real
+			// passwords are normalized to their stored hash.
+			password := user.Password
 			if !opts.RedactSecrets {
 				var err error
 				password, err = NormalizePasswordForStorage(user.Password)
@@ -732,6 +992,9 @@ func writeSecurity(b *strings.Builder, sec *SecurityConfig, opts serializeOption
 		if user.SSHKey != "" {
 			writeLine(b, "set security users user %s ssh-key %s", username, EscapeValue(user.SSHKey))
 		}
+		if user.Disabled {
+			writeLine(b, "set security users user %s disable", username)
+		}
 	}
 	if sec.RateLimit != nil {
 		if sec.RateLimit.PerIP != 0 {
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 )
@@ -56,7 +58,7 @@ func TestToSetCommandsRoundTrip(t *testing.T) {
 							"ge-0/0/0": {
 								Name:    "ge-0/0/0",
 								Passive: true,
-								Metric:  20,
+								Metric:  intPtr(20),
 							},
 						},
 					},
@@ -182,7 +184,7 @@ func TestToSetCommandsWritesOSPFAttributesSeparately(t *testing.T) {
 				Areas: map[string]*OSPFArea{
 					"0.0.0.0": {
 						Interfaces: map[string]*OSPFInterface{
-							"ge-0/0/0": {Name: "ge-0/0/0", Passive: true, Metric: 20, Priority: 10},
+							"ge-0/0/0": {Name: "ge-0/0/0", Passive: true, Metric: intPtr(20), Priority: intPtr(10)},
 						},
 					},
 				},
@@ -210,7 +212,7 @@ func TestToSetCommandsWritesExplicitOSPFPriorityZero(t *testing.T) {
 				Areas: map[string]*OSPFArea{
 					"0.0.0.0": {
 						Interfaces: map[string]*OSPFInterface{
-							"ge-0/0/0": {Name: "ge-0/0/0", Priority: 0, PrioritySet: true},
+							"ge-0/0/0": {Name: "ge-0/0/0", Priority: intPtr(0)},
 						},
 					},
 				},
@@ -229,7 +231,99 @@ func TestToSetCommandsWritesExplicitOSPFPriorityZero(t *testing.T) {
 		t.Fatalf("Parse() error = %v", err)
 	}
 	iface := parsed.Protocols.OSPF.Areas["0.0.0.0"].Interfaces["ge-0/0/0"]
-	if !iface.PrioritySet || iface.Priority != 0 {
+	if iface.Priority == nil || *iface.Priority != 0 {
 		t.Fatalf("parsed OSPF interface = %#v, want explicit priority 0", iface)
 	}
 }
+
+func TestToSetCommandsIsDeterministic(t *testing.T) {
+	lines := []string{
+		"set interfaces ge-0/0/2 unit 0 family inet address 192.0.2.9/24",
+		"set interfaces ge-0/0/1 unit 10 family inet6 address 2001:db8::1/64",
+		"set interfaces ge-0/0/1 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/0 description uplink",
+		"set protocols bgp group Z type external",
+		"set protocols bgp group Z neighbor 203.0.113.2 peer-as 65002",
+		"set protocols bgp group Z neighbor 203.0.113.1 peer-as 65001",
+		"set protocols bgp group A type internal",
+		"set protocols bgp group A neighbor 198.51.100.1 peer-as 65000",
+		"set protocols ospf area 0.0.0.1 interface ge-0/0/2",
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/1",
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/0",
+	}
+	reversed := make([]string, len(lines))
+	for i, line := range lines {
+		reversed[len(lines)-1-i] = line
+	}
+
+	want := ToSetCommands(parseSetCommands(t, lines...))
+	for i := 0; i < 10; i++ {
+		if got := ToSetCommands(parseSetCommands(t, lines...)); got != want {
+			t.Fatalf("ToSetCommands() output changed between runs\nwant:\n%s\ngot:\n%s", want, got)
+		}
+		if got := ToSetCommands(parseSetCommands(t, reversed...)); got != want {
+			t.Fatalf("ToSetCommands() depends on input order\nwant:\n%s\ngot:\n%s", want, got)
+		}
+	}
+
+	wantJSON, err := json.Marshal(parseSetCommands(t, lines...))
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	gotJSON, err := json.Marshal(parseSetCommands(t, reversed...))
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !bytes.Equal(gotJSON, wantJSON) {
+		t.Fatalf("JSON output depends on input order\nwant:\n%s\ngot:\n%s", wantJSON, gotJSON)
+	}
+}
+
+func TestSystemCommitScriptsRoundTrip(t *testing.T) {
+	text := "set system scripts commit pre /etc/arca-router/scripts/check\n" +
+		"set system scripts commit pre /etc/arca-router/scripts/lint\n" +
+		"set system scripts commit post /etc/arca-router/scripts/notify\n"
+	cfg, err := NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	scripts := cfg.System.Scripts
+	if scripts == nil || len(scripts.CommitPre) != 2 || scripts.CommitPre[1] != "/etc/arca-router/scripts/lint" ||
+		len(scripts.CommitPost) != 1 || scripts.CommitPost[0] != "/etc/arca-router/scripts/notify" {
+		t.Fatalf("parsed scripts = %#v", scripts)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if got := ToSetCommands(cfg); !strings.Contains(got, text) {
+		t.Fatalf("ToSetCommands() = %q, want it to contain %q", got, text)
+	}
+
+	scripts.CommitPost = []string{"scripts/notify"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "Invalid commit script path") {
+		t.Fatalf("Validate() error = %v, want invalid commit script path", err)
+	}
+}
+
+func TestNETCONFListenAddressesRoundTrip(t *testing.T) {
+	text := "set security netconf ssh listen 192.0.2.10\n" +
+		"set security netconf ssh listen ::1\n"
+	cfg, err := NewParser(strings.NewReader(text)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := cfg.Security.NETCONF.SSH.Listen; len(got) != 2 || got[0] != "192.0.2.10" || got[1] != "::1" {
+		t.Fatalf("Listen = %v", got)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if got := ToSetCommands(cfg); !strings.Contains(got, text) {
+		t.Fatalf("ToSetCommands() = %q, want it to contain %q", got, text)
+	}
+
+	cfg.Security.NETCONF.SSH.Listen = append(cfg.Security.NETCONF.SSH.Listen, "mgmt0")
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "Invalid netconf ssh listen: mgmt0") {
+		t.Fatalf("Validate() error = %v, want invalid listen address", err)
+	}
+}
@@ -18,6 +18,12 @@ const (
 	TokenNumber
 	// TokenError indicates a lexer error
 	TokenError
+	// TokenInclude is the "@include" directive
+	TokenInclude
+	// TokenLBracket opens a value list: "["
+	TokenLBracket
+	// TokenRBracket closes a value list: "]"
+	TokenRBracket
 )
 
 // Token represents a single token from the lexer
@@ -45,6 +51,12 @@ func (t TokenType) String() string {
 		return "NUMBER"
 	case TokenError:
 		return "ERROR"
+	case TokenInclude:
+		return "INCLUDE"
+	case TokenLBracket:
+		return "LBRACKET"
+	case TokenRBracket:
+		return "RBRACKET"
 	default:
 		return "UNKNOWN"
 	}
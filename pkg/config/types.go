@@ -26,8 +26,21 @@ type Config struct {
 	// ClassOfService holds QoS and traffic-control configuration
 	ClassOfService *ClassOfServiceConfig `json:"class-of-service,omitempty"`
 
+	// Firewall holds policer configuration
+	Firewall *FirewallConfig `json:"firewall,omitempty"`
+
 	// Security holds security configuration (Phase 3)
 	Security *SecurityConfig `json:"security,omitempty"`
+
+	// Inactive holds deactivated configuration paths (without the leading
+	// "set"). Deactivated subtrees are kept in the configuration but are not
+	// programmed into the dataplane or routing daemons.
+	Inactive []string `json:"inactive,omitempty"`
+
+	// Groups holds configuration groups by name. A group's interfaces are
+	// keyed by name or <pattern> and inherited by the interfaces that apply
+	// the group.
+	Groups map[string]*Config `json:"groups,omitempty"`
 }
 
 // SystemConfig represents system-level settings
@@ -38,6 +51,34 @@ type SystemConfig struct {
 
 	// Services holds system service settings
 	Services *SystemServicesConfig `json:"services,omitempty"`
+
+	// Scripts holds operator-supplied commit scripts
+	Scripts *SystemScriptsConfig `json:"scripts,omitempty"`
+
+	// Alarms holds the thresholds of the built-in health alarms
+	Alarms *SystemAlarmsConfig `json:"alarms,omitempty"`
+}
+
+// SystemAlarmsConfig represents the thresholds of the built-in health alarms.
+type SystemAlarmsConfig struct {
+	// InterfaceErrorRate raises an alarm when the combined receive and
+	// transmit errors of an interface exceed this many per second; 0 disables
+	// the alarm.
+	InterfaceErrorRate uint64 `json:"interface-error-rate,omitempty"`
+
+	// InterfaceLinkDown raises an alarm for each enabled interface whose link
+	// is down.
+	InterfaceLinkDown bool `json:"interface-link-down,omitempty"`
+}
+
+// SystemScriptsConfig represents operator-supplied scripts run on commit.
+type SystemScriptsConfig struct {
+	// CommitPre lists scripts run before a commit is applied; a non-zero
+	// exit aborts the commit.
+	CommitPre []string `json:"commit-pre,omitempty"`
+
+	// CommitPost lists scripts run after a commit succeeds.
+	CommitPost []string `json:"commit-post,omitempty"`
 }
 
 // SystemServicesConfig represents system service settings.
@@ -108,10 +149,76 @@ type Interface struct {
 	// Description is a human-readable description
 	Description string `json:"description,omitempty"`
 
+	// Promiscuous enables promiscuous mode on the dataplane interface
+	Promiscuous bool `json:"promiscuous,omitempty"`
+
+	// RxMode is the VPP RX queue mode (polling, interrupt, adaptive).
+	// Empty leaves the dataplane default unchanged.
+	RxMode string `json:"rx-mode,omitempty"`
+
+	// MTU is the link (L2 frame) MTU in bytes; 0 keeps the dataplane default
+	MTU int `json:"mtu,omitempty"`
+
+	// Bandwidth is the logical link bandwidth in bits per second used for
+	// OSPF cost derivation; 0 when not configured
+	Bandwidth uint64 `json:"bandwidth,omitempty"`
+
+	// InputPolicer is the firewall policer applied to received traffic
+	InputPolicer string `json:"input-policer,omitempty"`
+
+	// OutputPolicer is the firewall policer applied to transmitted traffic
+	OutputPolicer string `json:"output-policer,omitempty"`
+
+	// Tunnel holds the endpoints of a gr- (GRE) or ip- (IP-in-IP) interface
+	Tunnel *Tunnel `json:"tunnel,omitempty"`
+
+	// HoldTime delays reporting link transitions to debounce link flaps
+	HoldTime *HoldTime `json:"hold-time,omitempty"`
+
+	// GigEtherOptions holds the dataplane RX/TX queue settings of a
+	// physical interface
+	GigEtherOptions *GigEtherOptions `json:"gigether-options,omitempty"`
+
+	// ApplyGroups names the configuration groups this interface inherits
+	// from, in priority order
+	ApplyGroups []string `json:"apply-groups,omitempty"`
+
 	// Units holds logical unit configurations (sub-interfaces)
 	Units map[int]*Unit `json:"units,omitempty"`
 }
 
+// Tunnel represents the endpoints of a point-to-point tunnel interface
+type Tunnel struct {
+	// Source is the local tunnel endpoint address
+	Source string `json:"source,omitempty"`
+
+	// Destination is the remote tunnel endpoint address
+	Destination string `json:"destination,omitempty"`
+}
+
+// GigEtherOptions represents the queue settings of a physical interface.
+// Queue counts are applied when VPP creates the interface; RX placement is
+// applied to a running interface.
+type GigEtherOptions struct {
+	// RxQueues is the number of RX queues; 0 keeps the default of one
+	RxQueues int `json:"rxq,omitempty"`
+
+	// TxQueues is the number of TX queues; 0 keeps the driver default
+	TxQueues int `json:"txq,omitempty"`
+
+	// RxPlacement pins RX queues to VPP worker threads, keyed by queue ID
+	RxPlacement map[int]int `json:"rx-placement,omitempty"`
+}
+
+// HoldTime represents the link debounce delays of an interface
+type HoldTime struct {
+	// Up is how long, in milliseconds, the link must stay up before it is reported up
+	Up int `json:"up"`
+
+	// Down is how long, in milliseconds, the link must stay down before it is reported down
+	Down int `json:"down"`
+}
+
 // Unit represents a logical unit (sub-interface) configuration
 type Unit struct {
 	// Family holds address family configurations
@@ -122,6 +229,44 @@ type Unit struct {
 type Family struct {
 	// Addresses holds IP addresses in CIDR format
 	Addresses []string `json:"addresses,omitempty"`
+
+	// Neighbors holds static ARP (inet) or ND (inet6) entries, keyed by IP address
+	Neighbors map[string]string `json:"neighbors,omitempty"`
+
+	// MTU is the L3 (IP) MTU of the family in bytes; 0 follows the link MTU
+	MTU int `json:"mtu,omitempty"`
+
+	// RouterAdvertisement enables IPv6 router advertisements (inet6 only);
+	// nil keeps them disabled
+	RouterAdvertisement *RouterAdvertisement `json:"router-advertisement,omitempty"`
+}
+
+// RouterAdvertisement configures the IPv6 router advertisements sent on an
+// interface so downstream hosts can autoconfigure addresses.
+type RouterAdvertisement struct {
+	// ManagedConfiguration sets the M flag (addresses from DHCPv6)
+	ManagedConfiguration bool `json:"managed-configuration,omitempty"`
+
+	// OtherStatefulConfiguration sets the O flag (other settings from DHCPv6)
+	OtherStatefulConfiguration bool `json:"other-stateful-configuration,omitempty"`
+
+	// MaxAdvertisementInterval is the maximum time between unsolicited
+	// advertisements in seconds; 0 uses the dataplane default
+	MaxAdvertisementInterval int `json:"max-advertisement-interval,omitempty"`
+
+	// MinAdvertisementInterval is the minimum time between unsolicited
+	// advertisements in seconds; 0 uses 3/4 of the maximum
+	MinAdvertisementInterval int `json:"min-advertisement-interval,omitempty"`
+
+	// Prefixes holds the advertised prefixes, keyed by IPv6 prefix in CIDR format
+	Prefixes map[string]*RouterAdvertisementPrefix `json:"prefixes,omitempty"`
+}
+
+// RouterAdvertisementPrefix holds the lifetimes of an advertised prefix in
+// seconds; 0 uses the dataplane default.
+type RouterAdvertisementPrefix struct {
+	ValidLifetime     int `json:"valid-lifetime,omitempty"`
+	PreferredLifetime int `json:"preferred-lifetime,omitempty"`
 }
 
 // NewConfig creates a new empty configuration
@@ -180,6 +325,36 @@ type RoutingOptions struct {
 
 	// RouterID is the global router ID
 	RouterID string `json:"router-id,omitempty"`
+
+	// AdministrativeDistance overrides the default route distances of the
+	// routing protocols
+	AdministrativeDistance *AdministrativeDistance `json:"administrative-distance,omitempty"`
+}
+
+// AdministrativeDistance holds the per-protocol route distances configured
+// under routing-options administrative-distance. A zero distance keeps the
+// FRR default.
+type AdministrativeDistance struct {
+	BGP   *BGPDistance  `json:"bgp,omitempty"`
+	OSPF  *OSPFDistance `json:"ospf,omitempty"`
+	OSPF3 *OSPFDistance `json:"ospf3,omitempty"`
+}
+
+// BGPDistance holds the distances of external, internal, and locally
+// originated BGP routes
+type BGPDistance struct {
+	External int `json:"external,omitempty"`
+	Internal int `json:"internal,omitempty"`
+	Local    int `json:"local,omitempty"`
+}
+
+// OSPFDistance holds the distance of all OSPF routes and the per route type
+// distances that override it
+type OSPFDistance struct {
+	Default   int `json:"default,omitempty"`
+	IntraArea int `json:"intra-area,omitempty"`
+	InterArea int `json:"inter-area,omitempty"`
+	External  int `json:"external,omitempty"`
 }
 
 // StaticRoute represents a static route entry
@@ -236,6 +411,9 @@ type ProtocolConfig struct {
 	// OSPF3 holds OSPFv3 protocol configuration
 	OSPF3 *OSPFConfig `json:"ospf3,omitempty"`
 
+	// RIP holds RIPv2 protocol configuration
+	RIP *RIPConfig `json:"rip,omitempty"`
+
 	// MPLS holds MPLS protocol configuration
 	MPLS *MPLSConfig `json:"mpls,omitempty"`
 
@@ -318,6 +496,9 @@ type VRRPGroup struct {
 
 // BGPConfig represents BGP protocol configuration
 type BGPConfig struct {
+	// RouterID is the BGP identifier (overrides routing-options router-id)
+	RouterID string `json:"router-id,omitempty"`
+
 	// Groups holds BGP group configurations
 	Groups map[string]*BGPGroup `json:"groups,omitempty"`
 }
@@ -335,6 +516,18 @@ type BGPGroup struct {
 
 	// Export is the export policy name (Phase 2: string only)
 	Export string `json:"export,omitempty"`
+
+	// Cluster is the route-reflector cluster ID; when set, every neighbor in
+	// this internal group is a route-reflector client
+	Cluster string `json:"cluster,omitempty"`
+
+	// NextHopSelf sets this router as the next-hop of routes advertised to
+	// every neighbor in the group
+	NextHopSelf bool `json:"next-hop-self,omitempty"`
+
+	// RemovePrivateAS strips private AS numbers from the AS path of routes
+	// advertised to every neighbor in the group
+	RemovePrivateAS bool `json:"remove-private-as,omitempty"`
 }
 
 // BGPNeighbor represents a BGP neighbor configuration
@@ -351,11 +544,72 @@ type BGPNeighbor struct {
 	// LocalAddress is the local address to use for peering
 	LocalAddress string `json:"local-address,omitempty"`
 
+	// UpdateSource is the interface or local address the session is sourced
+	// from, passed to FRR as-is instead of being derived from LocalAddress
+	UpdateSource string `json:"update-source,omitempty"`
+
 	// BFD enables BFD failure detection for this neighbor
 	BFD bool `json:"bfd,omitempty"`
 
 	// BFDProfile selects the BFD profile for this neighbor
 	BFDProfile string `json:"bfd-profile,omitempty"`
+
+	// Cluster is the route-reflector cluster ID for this client neighbor
+	Cluster string `json:"cluster,omitempty"`
+
+	// RouteReflectorClient marks this neighbor as a route-reflector client
+	RouteReflectorClient bool `json:"route-reflector-client,omitempty"`
+
+	// Shutdown administratively disables the session while keeping its
+	// configuration
+	Shutdown bool `json:"shutdown,omitempty"`
+
+	// NextHopSelf sets this router as the next-hop of routes advertised to
+	// this neighbor
+	NextHopSelf bool `json:"next-hop-self,omitempty"`
+
+	// RemovePrivateAS strips private AS numbers from the AS path of routes
+	// advertised to this neighbor
+	RemovePrivateAS bool `json:"remove-private-as,omitempty"`
+}
+
+// IsRouteReflectorClient reports whether neighbor is a route-reflector client,
+// either explicitly or through a neighbor or group cluster ID.
+func (g *BGPGroup) IsRouteReflectorClient(neighbor *BGPNeighbor) bool {
+	if neighbor == nil {
+		return false
+	}
+	return neighbor.RouteReflectorClient || neighbor.Cluster != "" || (g != nil && g.Cluster != "")
+}
+
+// ClusterID returns the route-reflector cluster ID for neighbor, preferring
+// the neighbor's own setting over the group's.
+func (g *BGPGroup) ClusterID(neighbor *BGPNeighbor) string {
+	if neighbor != nil && neighbor.Cluster != "" {
+		return neighbor.Cluster
+	}
+	if g != nil {
+		return g.Cluster
+	}
+	return ""
+}
+
+// IsNextHopSelf reports whether routes advertised to neighbor carry this
+// router as next-hop, set on the neighbor or on its group.
+func (g *BGPGroup) IsNextHopSelf(neighbor *BGPNeighbor) bool {
+	if neighbor == nil {
+		return false
+	}
+	return neighbor.NextHopSelf || (g != nil && g.NextHopSelf)
+}
+
+// IsRemovePrivateAS reports whether private AS numbers are removed from
+// routes advertised to neighbor, set on the neighbor or on its group.
+func (g *BGPGroup) IsRemovePrivateAS(neighbor *BGPNeighbor) bool {
+	if neighbor == nil {
+		return false
+	}
+	return neighbor.RemovePrivateAS || (g != nil && g.RemovePrivateAS)
 }
 
 // OSPFConfig represents OSPF protocol configuration
@@ -365,6 +619,10 @@ type OSPFConfig struct {
 
 	// RouterID is the OSPF router ID (overrides routing-options router-id)
 	RouterID string `json:"router-id,omitempty"`
+
+	// ReferenceBandwidth is the auto-cost reference bandwidth in bits per
+	// second (0 = FRR default of 100 Mbit/s)
+	ReferenceBandwidth uint64 `json:"reference-bandwidth,omitempty"`
 }
 
 // OSPFArea represents an OSPF area configuration
@@ -376,6 +634,42 @@ type OSPFArea struct {
 	Interfaces map[string]*OSPFInterface `json:"interfaces,omitempty"`
 }
 
+// RIPConfig represents RIPv2 protocol configuration
+type RIPConfig struct {
+	// UpdateInterval is the periodic update interval in seconds (0 = FRR default)
+	UpdateInterval int `json:"update-interval,omitempty"`
+
+	// RouteTimeout is the route expiry timeout in seconds (0 = FRR default)
+	RouteTimeout int `json:"route-timeout,omitempty"`
+
+	// Holddown is the garbage-collection timer in seconds (0 = FRR default)
+	Holddown int `json:"holddown,omitempty"`
+
+	// DefaultMetric is the metric applied to redistributed routes (0 = FRR default)
+	DefaultMetric int `json:"default-metric,omitempty"`
+
+	// Groups holds RIP neighbor groups
+	Groups map[string]*RIPGroup `json:"groups,omitempty"`
+}
+
+// RIPGroup represents a RIP neighbor group
+type RIPGroup struct {
+	// Name is the group name
+	Name string `json:"name"`
+
+	// Neighbors holds the interfaces RIP runs on, keyed by interface name
+	Neighbors map[string]*RIPNeighbor `json:"neighbors,omitempty"`
+}
+
+// RIPNeighbor represents an interface participating in RIP
+type RIPNeighbor struct {
+	// Name is the interface name
+	Name string `json:"name"`
+
+	// Passive advertises the interface network without sending updates on it
+	Passive bool `json:"passive,omitempty"`
+}
+
 // OSPFInterface represents an OSPF interface configuration
 type OSPFInterface struct {
 	// Name is the interface name
@@ -384,14 +678,12 @@ type OSPFInterface struct {
 	// Passive indicates if this is a passive interface
 	Passive bool `json:"passive,omitempty"`
 
-	// Metric is the OSPF metric for this interface
-	Metric int `json:"metric,omitempty"`
+	// Metric is the OSPF metric for this interface; nil when not configured
+	Metric *int `json:"metric,omitempty"`
 
-	// Priority is the OSPF priority for DR election
-	Priority int `json:"priority,omitempty"`
-
-	// PrioritySet records whether priority was explicitly configured.
-	PrioritySet bool `json:"-"`
+	// Priority is the OSPF priority for DR election; nil when not configured.
+	// Zero is meaningful: the router never becomes DR or BDR.
+	Priority *int `json:"priority,omitempty"`
 
 	// BFD enables BFD failure detection on this OSPF interface
 	BFD bool `json:"bfd,omitempty"`
@@ -409,6 +701,27 @@ type PolicyOptions struct {
 	PolicyStatements map[string]*PolicyStatement `json:"policy-statements,omitempty"`
 }
 
+// FirewallConfig represents firewall configuration
+type FirewallConfig struct {
+	// Policers holds named rate-limit policers
+	Policers map[string]*Policer `json:"policers,omitempty"`
+}
+
+// Policer represents a single-rate two-color policer
+type Policer struct {
+	// Name is the policer name
+	Name string `json:"name"`
+
+	// BandwidthLimit is the committed rate in bits per second
+	BandwidthLimit uint64 `json:"bandwidth-limit,omitempty"`
+
+	// BurstSizeLimit is the committed burst size in bytes
+	BurstSizeLimit uint64 `json:"burst-size-limit,omitempty"`
+
+	// Action is applied to traffic exceeding the limits ("discard")
+	Action string `json:"then,omitempty"`
+}
+
 // PrefixList represents a prefix-list configuration
 type PrefixList struct {
 	// Name is the prefix-list name
@@ -452,6 +765,24 @@ type PolicyMatchConditions struct {
 
 	// ASPath is the AS path regular expression to match
 	ASPath string `json:"as-path,omitempty"`
+
+	// RouteFilters holds inline prefix matches with length match types
+	RouteFilters []*RouteFilter `json:"route-filters,omitempty"`
+}
+
+// RouteFilter represents an inline prefix match in a policy term
+type RouteFilter struct {
+	// Prefix is the CIDR prefix to match
+	Prefix string `json:"prefix"`
+
+	// MatchType is exact, orlonger, longer, upto, or prefix-length-range
+	MatchType string `json:"match-type"`
+
+	// MinLength is the shortest matching prefix length (prefix-length-range only)
+	MinLength int `json:"min-length,omitempty"`
+
+	// MaxLength is the longest matching prefix length (upto and prefix-length-range)
+	MaxLength int `json:"max-length,omitempty"`
 }
 
 // PolicyActions represents actions in a policy term
@@ -496,6 +827,10 @@ type NETCONFSSHConfig struct {
 	// ListenAddress is the address to bind for NETCONF/SSH (default: 127.0.0.1)
 	ListenAddress string `json:"listen-address,omitempty"`
 
+	// Listen lists additional addresses to bind, one listener each. IPv4
+	// and IPv6 literals bind only their own address family.
+	Listen []string `json:"listen,omitempty"`
+
 	// Port is the TCP port for NETCONF/SSH (default: 830)
 	Port int `json:"port,omitempty"`
 }
@@ -513,6 +848,9 @@ type UserConfig struct {
 
 	// SSHKey is the user's SSH public key
 	SSHKey string `json:"ssh-key,omitempty"`
+
+	// Disabled locks the account without deleting it
+	Disabled bool `json:"disabled,omitempty"`
 }
 
 // RateLimitConfig represents rate limiting configuration
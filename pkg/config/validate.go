@@ -3,9 +3,13 @@ package config
 import (
 	"fmt"
 	"net"
+	"net/netip"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/akam1o/arca-router/pkg/errors"
 	"github.com/akam1o/arca-router/pkg/security"
@@ -22,15 +26,179 @@ var (
 	interfaceNamePattern = regexp.MustCompile(`^([a-z]{2}-\d+/\d+/\d+|ae\d+|lo\d+|irb|fxp\d+)$`)
 )
 
-// Validate performs semantic validation on the configuration
+// MaxInterfaceDescriptionLength is the maximum interface description length,
+// counted in characters (runes) rather than bytes so multi-byte text gets the
+// same allowance as ASCII.
+const MaxInterfaceDescriptionLength = 255
+
+// Interface MTU bounds in bytes. The link MTU is limited by the largest frame
+// the VPP ethernet drivers accept; a family MTU must also fit the link MTU and
+// cover the protocol minimum (RFC 791 for IPv4, RFC 8200 for IPv6).
+const (
+	MinInterfaceMTU = 256
+	MaxInterfaceMTU = 9216
+	MinInetMTU      = 68
+	MinInet6MTU     = 1280
+)
+
+// DefaultInterfaceMTU is the link MTU of an interface with no MTU configured.
+// It matches the VPP default for hardware interfaces.
+const DefaultInterfaceMTU = 9000
+
+// Interface bandwidth bounds in bits per second.
+const (
+	MinInterfaceBandwidth = 1000
+	MaxInterfaceBandwidth = 1000 * 1000 * 1000 * 1000
+)
+
+// OSPF auto-cost reference bandwidth bounds in bits per second. FRR takes the
+// reference bandwidth in whole Mbit/s.
+const (
+	MinOSPFReferenceBandwidth = 1000 * 1000
+	MaxOSPFReferenceBandwidth = 4294967 * 1000 * 1000
+)
+
+// MaxInterfaceHoldTime is the longest interface hold-time in milliseconds.
+const MaxInterfaceHoldTime = 4294967
+
+// MaxInterfaceQueues is the largest RX or TX queue count of an interface.
+const MaxInterfaceQueues = 64
+
+// IPv6 router advertisement bounds in seconds (RFC 4861 section 6.2.1).
+// DefaultRAMaxInterval and the lifetime defaults match the dataplane and apply
+// when the corresponding value is not configured.
+const (
+	MinRAMaxInterval           = 4
+	MaxRAMaxInterval           = 1800
+	MinRAMinInterval           = 3
+	DefaultRAMaxInterval       = 200
+	DefaultRAValidLifetime     = 30 * 24 * 60 * 60
+	DefaultRAPreferredLifetime = 7 * 24 * 60 * 60
+	maxRALifetime              = 1<<32 - 1
+)
+
+// MinFamilyMTU returns the smallest MTU allowed for an address family.
+func MinFamilyMTU(family string) int {
+	if family == "inet6" {
+		return MinInet6MTU
+	}
+	return MinInetMTU
+}
+
+// Interface RX queue modes supported by the VPP dataplane.
+const (
+	InterfaceRxModePolling   = "polling"
+	InterfaceRxModeInterrupt = "interrupt"
+	InterfaceRxModeAdaptive  = "adaptive"
+)
+
+// ValidInterfaceRxMode reports whether mode is a supported interface rx-mode.
+func ValidInterfaceRxMode(mode string) bool {
+	switch mode {
+	case InterfaceRxModePolling, InterfaceRxModeInterrupt, InterfaceRxModeAdaptive:
+		return true
+	default:
+		return false
+	}
+}
+
+// Tunnel interface types, selected by the interface name prefix.
+const (
+	TunnelTypeGRE  = "gre"
+	TunnelTypeIPIP = "ipip"
+)
+
+// TunnelInterfaceType returns the tunnel type of a gr- (GRE) or ip-
+// (IP-in-IP) interface name, or "" when name is not a tunnel interface.
+func TunnelInterfaceType(name string) string {
+	switch {
+	case strings.HasPrefix(name, "gr-"):
+		return TunnelTypeGRE
+	case strings.HasPrefix(name, "ip-"):
+		return TunnelTypeIPIP
+	default:
+		return ""
+	}
+}
+
+// CheckTunnelEndpoints reports whether source and destination are distinct
+// unicast IP addresses of the same family.
+func CheckTunnelEndpoints(source, destination string) error {
+	if source == "" || destination == "" {
+		return fmt.Errorf("tunnel source and destination are both required")
+	}
+	src := net.ParseIP(source)
+	if src == nil || src.IsUnspecified() || src.IsMulticast() {
+		return fmt.Errorf("tunnel source %q must be a unicast IP address", source)
+	}
+	dst := net.ParseIP(destination)
+	if dst == nil || dst.IsUnspecified() || dst.IsMulticast() {
+		return fmt.Errorf("tunnel destination %q must be a unicast IP address", destination)
+	}
+	if (src.To4() == nil) != (dst.To4() == nil) {
+		return fmt.Errorf("tunnel source %s and destination %s must be the same address family", source, destination)
+	}
+	if src.Equal(dst) {
+		return fmt.Errorf("tunnel source and destination must differ")
+	}
+	return nil
+}
+
+// CheckGigEtherOptions reports whether the queue counts are within
+// 1-MaxInterfaceQueues (0 keeps the default) and every pinned RX queue exists.
+// Worker IDs are checked against the running VPP when they are applied.
+func CheckGigEtherOptions(rxq, txq int, placement map[int]int) error {
+	if rxq < 0 || rxq > MaxInterfaceQueues {
+		return fmt.Errorf("rxq must be 1-%d, got %d", MaxInterfaceQueues, rxq)
+	}
+	if txq < 0 || txq > MaxInterfaceQueues {
+		return fmt.Errorf("txq must be 1-%d, got %d", MaxInterfaceQueues, txq)
+	}
+	queues := max(rxq, 1)
+	for _, queue := range sortedInts(placement) {
+		if queue < 0 || queue >= queues {
+			return fmt.Errorf("rx-queue %d does not exist: the interface has %d RX queues", queue, queues)
+		}
+		if worker := placement[queue]; worker < 0 {
+			return fmt.Errorf("rx-queue %d worker must not be negative, got %d", queue, worker)
+		}
+	}
+	return nil
+}
+
+// CheckHoldTime reports whether the up and down hold-time delays are within
+// 0-MaxInterfaceHoldTime milliseconds.
+func CheckHoldTime(up, down int) error {
+	if up < 0 || up > MaxInterfaceHoldTime {
+		return fmt.Errorf("hold-time up must be 0-%d ms, got %d", MaxInterfaceHoldTime, up)
+	}
+	if down < 0 || down > MaxInterfaceHoldTime {
+		return fmt.Errorf("hold-time down must be 0-%d ms, got %d", MaxInterfaceHoldTime, down)
+	}
+	return nil
+}
+
+// Validate performs semantic validation on the configuration and returns the
+// first error found. Warnings do not cause Validate to fail; use ValidateAll
+// to see every issue.
 func (c *Config) Validate() error {
+	return c.ValidateAll().Err()
+}
+
+// ValidateAll performs semantic validation on the configuration and collects
+// every issue instead of stopping at the first one. Each configuration object
+// (interface, routing instance, protocol, ...) contributes at most one error,
+// so a single mistake does not cascade into follow-on reports.
+func (c *Config) ValidateAll() *ValidationResult {
+	result := &ValidationResult{}
 	if c == nil {
-		return errors.New(
+		result.addError(errors.New(
 			errors.ErrCodeConfigValidation,
 			"Configuration is nil",
 			"Internal error: configuration object is nil",
 			"Report this issue to the maintainers",
-		)
+		))
+		return result
 	}
 
 	// Validate system configuration
@@ -46,69 +214,102 @@ func (c *Config) Validate() error {
 		c.System.HostName = "arca-router"
 	}
 
-	// Validate system configuration
-	if err := c.System.Validate(); err != nil {
-		return err
+	// Inherited group statements are validated where they apply, on the
+	// expanded configuration.
+	if c.hasApplyGroups() {
+		c.validateGroupReferences(result)
+		if result.HasErrors() {
+			return result
+		}
+		expanded, err := c.ExpandGroups()
+		if err != nil {
+			result.addErrorAt([]string{"groups"}, err)
+			return result
+		}
+		return expanded.ValidateAll()
 	}
 
+	// Validate system configuration
+	result.addErrorAt([]string{"system"}, c.System.Validate())
+
 	if c.Chassis != nil {
-		if err := c.Chassis.Validate(); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"chassis"}, c.Chassis.Validate())
 	}
 
 	// Validate interfaces
-	for name, iface := range c.Interfaces {
+	ifNames := make([]string, 0, len(c.Interfaces))
+	for name := range c.Interfaces {
+		ifNames = append(ifNames, name)
+	}
+	sort.Strings(ifNames)
+	for _, name := range ifNames {
 		if err := validateInterfaceName(name); err != nil {
-			return err
-		}
-		if err := iface.Validate(name); err != nil {
-			return err
+			result.addErrorAt([]string{"interfaces", name}, err)
+			continue
 		}
+		result.addErrorAt([]string{"interfaces", name}, c.Interfaces[name].Validate(name))
+	}
+	result.addError(c.validateInterfaceAddressUniqueness())
+	for _, overlap := range c.InterfaceAddressOverlapWarnings() {
+		result.addWarning("%s", overlap)
+	}
+	for _, warning := range c.InterfaceAddressHostBitWarnings() {
+		result.addWarning("%s", warning)
+	}
+	for _, warning := range c.InterfaceLinkLocalAddressWarnings() {
+		result.addWarning("%s", warning)
 	}
 
 	// Validate routing options
 	if c.RoutingOptions != nil {
-		if err := c.RoutingOptions.validate(c); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"routing-options"}, c.RoutingOptions.validate(c))
+	}
+	for _, warning := range c.StaticRouteNextHopWarnings() {
+		result.addWarning("%s", warning)
 	}
 
-	for name, instance := range c.RoutingInstances {
-		if err := validateRoutingInstance(c, name, instance); err != nil {
-			return err
-		}
+	instanceNames := make([]string, 0, len(c.RoutingInstances))
+	for name := range c.RoutingInstances {
+		instanceNames = append(instanceNames, name)
+	}
+	sort.Strings(instanceNames)
+	for _, name := range instanceNames {
+		result.addErrorAt([]string{"routing-instances", name}, validateRoutingInstance(c, name, c.RoutingInstances[name]))
 	}
 
 	// Validate protocols
 	if c.Protocols != nil {
-		if err := c.Protocols.Validate(c); err != nil {
-			return err
-		}
+		c.Protocols.validateAll(c, result)
 	}
 
 	if c.PolicyOptions != nil {
 		if err := c.PolicyOptions.Validate(); err != nil {
-			return err
+			result.addErrorAt([]string{"policy-options"}, err)
+		} else {
+			for _, warning := range c.PolicyTermShadowWarnings() {
+				result.addWarning("%s", warning)
+			}
 		}
 	}
 
 	if c.ClassOfService != nil {
 		if err := c.ClassOfService.Validate(); err != nil {
-			return err
-		}
-		if err := c.validateClassOfServiceInterfaceReferences(); err != nil {
-			return err
+			result.addErrorAt([]string{"class-of-service"}, err)
+		} else {
+			result.addError(c.validateClassOfServiceInterfaceReferences())
 		}
 	}
 
+	if c.Firewall != nil {
+		result.addErrorAt([]string{"firewall"}, c.Firewall.Validate())
+	}
+	result.addError(c.validateInterfacePolicerReferences())
+
 	if c.Security != nil {
-		if err := validateSecurity(c.Security); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"security"}, validateSecurity(c.Security))
 	}
 
-	return nil
+	return result
 }
 
 // Validate validates policy-options configuration.
@@ -170,6 +371,11 @@ func (po *PolicyOptions) validatePolicyStatement(name string, statement *PolicyS
 					)
 				}
 			}
+			for _, filter := range term.From.RouteFilters {
+				if err := filter.Validate(); err != nil {
+					return errors.New(errors.ErrCodeConfigValidation, fmt.Sprintf("Policy statement %s term %s has an invalid route-filter", name, term.Name), err.Error(), "Use 'route-filter <prefix> exact|orlonger|longer|upto /len|prefix-length-range /min-/max'")
+				}
+			}
 			if term.From.Protocol != "" {
 				if err := validateProtocol(term.From.Protocol); err != nil {
 					return errors.New(errors.ErrCodeConfigValidation, fmt.Sprintf("Policy statement %s term %s has invalid protocol %q", name, term.Name, term.From.Protocol), err.Error(), "Use one of bgp, ospf, ospf3, static, connected, direct, kernel, or rip")
@@ -185,8 +391,8 @@ func (po *PolicyOptions) validatePolicyStatement(name string, statement *PolicyS
 			}
 		}
 		if term.Then != nil && term.Then.Community != "" {
-			if err := validateCommunity(term.Then.Community); err != nil {
-				return errors.New(errors.ErrCodeConfigValidation, fmt.Sprintf("Policy statement %s term %s has invalid community %q", name, term.Name, term.Then.Community), err.Error(), "Use ASN:number or a supported well-known community")
+			if err := ValidateCommunity(term.Then.Community); err != nil {
+				return errors.New(errors.ErrCodeConfigValidation, fmt.Sprintf("Policy statement %s term %s has invalid community %q", name, term.Name, term.Then.Community), err.Error(), "Use ASN:number, ASN:number:number, target:/origin: extended community, or a supported well-known community")
 			}
 		}
 	}
@@ -241,6 +447,11 @@ func (s *SystemConfig) Validate() error {
 			return err
 		}
 	}
+	if s.Scripts != nil {
+		if err := validateSystemScripts(s.Scripts); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -315,6 +526,20 @@ func validateSNMP(snmp *SNMPConfig) error {
 	return nil
 }
 
+func validateSystemScripts(scripts *SystemScriptsConfig) error {
+	for _, path := range append(append([]string{}, scripts.CommitPre...), scripts.CommitPost...) {
+		if !filepath.IsAbs(path) || filepath.Clean(path) != path {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Invalid commit script path: %s", path),
+				"Commit script paths must be clean absolute paths",
+				"Use a path like /etc/arca-router/scripts/check-commit",
+			)
+		}
+	}
+	return nil
+}
+
 func validateSecurity(sec *SecurityConfig) error {
 	if sec.NETCONF == nil || sec.NETCONF.SSH == nil {
 		return nil
@@ -336,6 +561,16 @@ func validateSecurity(sec *SecurityConfig) error {
 			"Use a valid listen address",
 		)
 	}
+	for _, addr := range ssh.Listen {
+		if net.ParseIP(addr) == nil && addr != "localhost" {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Invalid netconf ssh listen: %s", addr),
+				"NETCONF SSH listen addresses must be IP addresses or localhost",
+				"Use a valid listen address",
+			)
+		}
+	}
 	return nil
 }
 
@@ -351,14 +586,56 @@ func (i *Interface) Validate(name string) error {
 	}
 
 	// Description is optional, no validation needed if empty
-	if len(i.Description) > 255 {
+	if !utf8.ValidString(i.Description) {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Interface %s description is not valid UTF-8", name),
+			"Description must be UTF-8 text",
+			"Re-enter the description using UTF-8 encoding",
+		)
+	}
+	if utf8.RuneCountInString(i.Description) > MaxInterfaceDescriptionLength {
 		return errors.New(
 			errors.ErrCodeConfigValidation,
 			fmt.Sprintf("Interface %s description too long", name),
-			"Description must be 255 characters or less",
+			fmt.Sprintf("Description must be %d characters or less", MaxInterfaceDescriptionLength),
 			"Use a shorter description",
 		)
 	}
+	if i.RxMode != "" && !ValidInterfaceRxMode(i.RxMode) {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Interface %s has invalid rx-mode: %s", name, i.RxMode),
+			"RX mode must be polling, interrupt, or adaptive",
+			"Use a supported rx-mode or delete it to keep the dataplane default",
+		)
+	}
+	if i.MTU != 0 && (i.MTU < MinInterfaceMTU || i.MTU > MaxInterfaceMTU) {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Interface %s has invalid mtu: %d", name, i.MTU),
+			fmt.Sprintf("Interface MTU must be between %d and %d", MinInterfaceMTU, MaxInterfaceMTU),
+			"Use a supported MTU or delete it to keep the dataplane default",
+		)
+	}
+	if i.Bandwidth != 0 && (i.Bandwidth < MinInterfaceBandwidth || i.Bandwidth > MaxInterfaceBandwidth) {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Interface %s has invalid bandwidth: %s", name, FormatUnitValue(i.Bandwidth)),
+			fmt.Sprintf("Interface bandwidth must be between %s and %s bits per second",
+				FormatUnitValue(MinInterfaceBandwidth), FormatUnitValue(MaxInterfaceBandwidth)),
+			"Use a supported bandwidth or delete it",
+		)
+	}
+	if err := i.validateTunnel(name); err != nil {
+		return err
+	}
+	if err := i.validateHoldTime(name); err != nil {
+		return err
+	}
+	if err := i.validateGigEtherOptions(name); err != nil {
+		return err
+	}
 
 	// Validate units
 	for unitNum, unit := range i.Units {
@@ -367,6 +644,180 @@ func (i *Interface) Validate(name string) error {
 		}
 	}
 
+	if err := i.validateFamilyMTU(name); err != nil {
+		return err
+	}
+	return i.validateRouterAdvertisementUnits(name)
+}
+
+// validateTunnel checks that gr- and ip- interfaces, and only those, have
+// valid tunnel endpoints.
+func (i *Interface) validateTunnel(name string) error {
+	if TunnelInterfaceType(name) == "" {
+		if i.Tunnel != nil {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Interface %s is not a tunnel interface", name),
+				"Tunnel endpoints are only valid on gr- (GRE) and ip- (IP-in-IP) interfaces",
+				"Configure the tunnel on an interface named like gr-0/0/0 or ip-0/0/0",
+			)
+		}
+		return nil
+	}
+	var source, destination string
+	if i.Tunnel != nil {
+		source, destination = i.Tunnel.Source, i.Tunnel.Destination
+	}
+	if err := CheckTunnelEndpoints(source, destination); err != nil {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid tunnel on interface %s: %v", name, err),
+			"GRE and IP-in-IP interfaces need source and destination addresses of the same family",
+			fmt.Sprintf("Set 'interfaces %s tunnel source <ip>' and 'tunnel destination <ip>' with addresses of the same family", name),
+		)
+	}
+	return nil
+}
+
+// validateHoldTime checks that the hold-time delays are within range.
+func (i *Interface) validateHoldTime(name string) error {
+	if i.HoldTime == nil {
+		return nil
+	}
+	if err := CheckHoldTime(i.HoldTime.Up, i.HoldTime.Down); err != nil {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid hold-time on interface %s: %v", name, err),
+			"Hold-time delays are given in milliseconds",
+			fmt.Sprintf("Set 'interfaces %s hold-time up <0-%d> down <0-%d>'", name, MaxInterfaceHoldTime, MaxInterfaceHoldTime),
+		)
+	}
+	return nil
+}
+
+// validateGigEtherOptions checks the queue settings, which only apply to
+// physical interfaces.
+func (i *Interface) validateGigEtherOptions(name string) error {
+	opts := i.GigEtherOptions
+	if opts == nil {
+		return nil
+	}
+	if TunnelInterfaceType(name) != "" {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Interface %s does not support gigether-options", name),
+			"Queue settings are only valid on physical interfaces",
+			fmt.Sprintf("Delete 'interfaces %s gigether-options'", name),
+		)
+	}
+	if err := CheckGigEtherOptions(opts.RxQueues, opts.TxQueues, opts.RxPlacement); err != nil {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid gigether-options on interface %s: %v", name, err),
+			"RX queues are numbered from 0 and pinned to VPP worker threads numbered from 0",
+			fmt.Sprintf("Set 'interfaces %s gigether-options rxq <1-%d>' before pinning queues with 'rx-queue <id> worker <n>'", name, MaxInterfaceQueues),
+		)
+	}
+	return nil
+}
+
+// validateRouterAdvertisementUnits rejects router advertisements on more
+// than one unit: the dataplane keeps one advertisement state per interface.
+func (i *Interface) validateRouterAdvertisementUnits(name string) error {
+	configured := -1
+	for _, unitNum := range sortedInts(i.Units) {
+		family := i.Units[unitNum].Family["inet6"]
+		if family == nil || family.RouterAdvertisement == nil {
+			continue
+		}
+		if configured >= 0 {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Interface %s configures router-advertisement on units %d and %d", name, configured, unitNum),
+				"The dataplane sends one set of router advertisements per interface",
+				"Configure router-advertisement on a single unit",
+			)
+		}
+		configured = unitNum
+	}
+	return nil
+}
+
+// Check reports the first invalid router advertisement setting: an interval
+// outside the RFC 4861 bounds, a prefix that is not an IPv6 prefix, or a
+// preferred lifetime longer than the valid lifetime.
+func (ra *RouterAdvertisement) Check() error {
+	maxInterval, minInterval := ra.MaxAdvertisementInterval, ra.MinAdvertisementInterval
+	if maxInterval != 0 && (maxInterval < MinRAMaxInterval || maxInterval > MaxRAMaxInterval) {
+		return fmt.Errorf("max-advertisement-interval %d must be between %d and %d", maxInterval, MinRAMaxInterval, MaxRAMaxInterval)
+	}
+	effectiveMax := maxInterval
+	if effectiveMax == 0 {
+		effectiveMax = DefaultRAMaxInterval
+	}
+	if minInterval != 0 && (minInterval < MinRAMinInterval || minInterval > effectiveMax*3/4) {
+		return fmt.Errorf("min-advertisement-interval %d must be between %d and 3/4 of the max-advertisement-interval (%d)", minInterval, MinRAMinInterval, effectiveMax*3/4)
+	}
+	for _, prefix := range sortedKeys(ra.Prefixes) {
+		ip, _, err := net.ParseCIDR(prefix)
+		if err != nil || ip.To4() != nil {
+			return fmt.Errorf("prefix %q must be an IPv6 prefix in CIDR format", prefix)
+		}
+		var valid, preferred int
+		if lifetimes := ra.Prefixes[prefix]; lifetimes != nil {
+			valid, preferred = lifetimes.ValidLifetime, lifetimes.PreferredLifetime
+		}
+		if valid < 0 || valid > maxRALifetime || preferred < 0 || preferred > maxRALifetime {
+			return fmt.Errorf("prefix %s lifetimes must be between 0 and %d seconds", prefix, maxRALifetime)
+		}
+		if valid == 0 {
+			valid = DefaultRAValidLifetime
+		}
+		if preferred == 0 {
+			preferred = DefaultRAPreferredLifetime
+		}
+		if preferred > valid {
+			return fmt.Errorf("prefix %s preferred-lifetime %d exceeds valid-lifetime %d", prefix, preferred, valid)
+		}
+	}
+	return nil
+}
+
+// validateFamilyMTU checks each family MTU against the protocol minimum and
+// the link MTU, which is the VPP default when no interface MTU is set. The
+// dataplane keeps one L3 MTU per protocol and interface, so
+// all units of an interface must agree on it.
+func (i *Interface) validateFamilyMTU(name string) error {
+	linkMTU := i.MTU
+	if linkMTU == 0 {
+		linkMTU = DefaultInterfaceMTU
+	}
+	familyMTU := make(map[string]int)
+	for _, unitNum := range sortedInts(i.Units) {
+		for _, familyName := range sortedKeys(i.Units[unitNum].Family) {
+			mtu := i.Units[unitNum].Family[familyName].MTU
+			if mtu == 0 {
+				continue
+			}
+			if mtu < MinFamilyMTU(familyName) || mtu > linkMTU {
+				return errors.New(
+					errors.ErrCodeConfigValidation,
+					fmt.Sprintf("Interface %s unit %d family %s mtu %d must be between %d and the interface MTU %d", name, unitNum, familyName, mtu, MinFamilyMTU(familyName), linkMTU),
+					"The IP MTU cannot exceed the link MTU or fall below the protocol minimum",
+					fmt.Sprintf("Lower the family MTU or raise 'set interfaces %s mtu'", name),
+				)
+			}
+			if other, ok := familyMTU[familyName]; ok && other != mtu {
+				return errors.New(
+					errors.ErrCodeConfigValidation,
+					fmt.Sprintf("Interface %s family %s mtu differs between units: %d and %d", name, familyName, other, mtu),
+					"The dataplane applies one IP MTU per family to the whole interface",
+					"Use the same family MTU on every unit",
+				)
+			}
+			familyMTU[familyName] = mtu
+		}
+	}
 	return nil
 }
 
@@ -426,12 +877,12 @@ func (f *Family) Validate(ifaceName string, unitNum int, familyName string) erro
 		)
 	}
 
-	// Validate addresses
-	if len(f.Addresses) == 0 {
+	// inet6 may run with only a link-local address; inet needs an address.
+	if len(f.Addresses) == 0 && familyName == "inet" {
 		return errors.New(
 			errors.ErrCodeConfigValidation,
 			fmt.Sprintf("No addresses configured for family %s on interface %s unit %d", familyName, ifaceName, unitNum),
-			"At least one address must be configured",
+			"At least one address must be configured for family inet",
 			"Add an address using 'set interfaces <name> unit <num> family <family> address <cidr>'",
 		)
 	}
@@ -442,6 +893,75 @@ func (f *Family) Validate(ifaceName string, unitNum int, familyName string) erro
 		}
 	}
 
+	for _, ip := range sortedKeys(f.Neighbors) {
+		if err := f.validateNeighbor(ip, f.Neighbors[ip], familyName, ifaceName, unitNum); err != nil {
+			return err
+		}
+	}
+
+	if ra := f.RouterAdvertisement; ra != nil {
+		if familyName != "inet6" {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Router advertisement configured for family %s on interface %s unit %d", familyName, ifaceName, unitNum),
+				"Router advertisements are only sent for IPv6",
+				"Configure router-advertisement under family inet6",
+			)
+		}
+		if err := ra.Check(); err != nil {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Invalid router-advertisement on interface %s unit %d: %v", ifaceName, unitNum, err),
+				"Router advertisement intervals and prefixes must follow RFC 4861",
+				"Fix or delete the router-advertisement setting",
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateNeighbor validates a static ARP/ND entry. The neighbor must be a
+// unicast MAC and an address of the family inside one of the unit's subnets.
+// IPv6 link-local (fe80::/10) neighbors are always on-link and exempt from
+// the subnet check.
+func (f *Family) validateNeighbor(ipStr, macStr, familyName, ifaceName string, unitNum int) error {
+	ip := net.ParseIP(ipStr)
+	if ip == nil || (familyName == "inet") != (ip.To4() != nil) {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid neighbor address %s on interface %s unit %d family %s", ipStr, ifaceName, unitNum, familyName),
+			fmt.Sprintf("Neighbor address must be a valid %s address", familyName),
+			"Use an address of the same family as the unit addresses",
+		)
+	}
+
+	onLink := ip.To4() == nil && ip.IsLinkLocalUnicast()
+	for _, addr := range f.Addresses {
+		if _, ipnet, err := net.ParseCIDR(addr); err == nil && ipnet.Contains(ip) {
+			onLink = true
+			break
+		}
+	}
+	if !onLink {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Neighbor %s is not on a subnet of interface %s unit %d family %s", ipStr, ifaceName, unitNum, familyName),
+			"Static neighbors must be reachable on a directly connected subnet",
+			"Configure an interface address covering the neighbor or fix the neighbor address",
+		)
+	}
+
+	mac, err := net.ParseMAC(macStr)
+	if err != nil || len(mac) != 6 || mac[0]&0x01 != 0 {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid MAC address %s for neighbor %s on interface %s unit %d", macStr, ipStr, ifaceName, unitNum),
+			"Neighbor MAC must be a 48-bit unicast address",
+			"Use a MAC address like '00:11:22:33:44:55'",
+		)
+	}
+
 	return nil
 }
 
@@ -535,10 +1055,343 @@ func validateAddress(addr, familyName, ifaceName string, unitNum int) error {
 		}
 	}
 
-	// ParseCIDR validation is enough here (network-address enforcement is optional).
-	_ = ipnet
+	// The host bits are kept: an interface address names the router's own
+	// address on the subnet and is applied to VPP exactly as written.
+	// Addresses that are unusable as a host address are only warned about;
+	// see InterfaceAddressHostBitWarnings.
+	_ = ipnet
+
+	return nil
+}
+
+// interfaceAddress is one configured interface address together with the
+// location it was configured at.
+type interfaceAddress struct {
+	ifName   string
+	unit     int
+	family   string
+	location string
+	address  string
+	ip       net.IP
+	network  *net.IPNet
+}
+
+// configuredInterfaceAddresses returns every parseable interface address in
+// deterministic interface/unit/family order.
+func (c *Config) configuredInterfaceAddresses() []interfaceAddress {
+	var out []interfaceAddress
+	ifNames := make([]string, 0, len(c.Interfaces))
+	for name := range c.Interfaces {
+		ifNames = append(ifNames, name)
+	}
+	sort.Strings(ifNames)
+	for _, ifName := range ifNames {
+		iface := c.Interfaces[ifName]
+		if iface == nil {
+			continue
+		}
+		unitNums := make([]int, 0, len(iface.Units))
+		for unitNum := range iface.Units {
+			unitNums = append(unitNums, unitNum)
+		}
+		sort.Ints(unitNums)
+		for _, unitNum := range unitNums {
+			unit := iface.Units[unitNum]
+			if unit == nil {
+				continue
+			}
+			familyNames := make([]string, 0, len(unit.Family))
+			for familyName := range unit.Family {
+				familyNames = append(familyNames, familyName)
+			}
+			sort.Strings(familyNames)
+			for _, familyName := range familyNames {
+				family := unit.Family[familyName]
+				if family == nil {
+					continue
+				}
+				location := fmt.Sprintf("interface %s unit %d family %s", ifName, unitNum, familyName)
+				for _, addr := range family.Addresses {
+					ip, network, err := net.ParseCIDR(addr)
+					if err != nil {
+						continue
+					}
+					out = append(out, interfaceAddress{ifName: ifName, unit: unitNum, family: familyName, location: location, address: addr, ip: ip, network: network})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// linkScope returns the link an IPv6 link-local interface address is scoped
+// to, or "" for any other address. A link-local address takes its zone from
+// the interface unit it is configured on, so the same link-local address on
+// different units names different addresses.
+func (a interfaceAddress) linkScope() string {
+	if a.ip.To4() != nil || !a.ip.IsLinkLocalUnicast() {
+		return ""
+	}
+	return fmt.Sprintf("%s.%d", a.ifName, a.unit)
+}
+
+// InterfaceAddress is one address as configured on an interface unit.
+type InterfaceAddress struct {
+	Interface string
+	Unit      int
+	Family    string
+	Address   string // CIDR as configured
+}
+
+// Location returns the "interface <name> unit <n> family <family>" form used
+// in validation messages.
+func (a InterfaceAddress) Location() string {
+	return fmt.Sprintf("interface %s unit %d family %s", a.Interface, a.Unit, a.Family)
+}
+
+// DuplicateInterfaceAddress describes an address assigned to two interface
+// units of the same routing instance.
+type DuplicateInterfaceAddress struct {
+	Address         string // IP address, with the link zone for IPv6 link-local
+	RoutingInstance string // "" for the default instance
+	First, Second   InterfaceAddress
+}
+
+func (d *DuplicateInterfaceAddress) String() string {
+	s := fmt.Sprintf("address %s on %s and %s", d.Address, d.First.Location(), d.Second.Location())
+	if d.RoutingInstance != "" {
+		s += " in routing-instance " + d.RoutingInstance
+	}
+	return s
+}
+
+// FindDuplicateInterfaceAddress returns the first IP address in addrs that is
+// assigned to more than one interface unit of the same routing instance, or
+// nil. VPP refuses such assignments within one FIB table, while each routing
+// instance has its own table and may reuse addresses of another. An interface
+// belongs to the routing instance that lists it, as "name" or "name.unit", in
+// instanceInterfaces. IPv6 link-local addresses only need to be unique on
+// their own link. Unparseable addresses are skipped.
+func FindDuplicateInterfaceAddress(addrs []InterfaceAddress, instanceInterfaces map[string][]string) *DuplicateInterfaceAddress {
+	instanceOf := interfaceInstanceResolver(instanceInterfaces)
+	type seenAddress struct {
+		key      string
+		instance string
+	}
+	seen := make(map[seenAddress]InterfaceAddress)
+	for _, addr := range addrs {
+		ip, _, err := net.ParseCIDR(addr.Address)
+		if err != nil {
+			continue
+		}
+		key := ip.String()
+		if ip.To4() == nil && ip.IsLinkLocalUnicast() {
+			key = fmt.Sprintf("%s%%%s.%d", key, addr.Interface, addr.Unit)
+		}
+		instance := instanceOf(addr.Interface, addr.Unit)
+		id := seenAddress{key: key, instance: instance}
+		if previous, exists := seen[id]; exists {
+			return &DuplicateInterfaceAddress{Address: key, RoutingInstance: instance, First: previous, Second: addr}
+		}
+		seen[id] = addr
+	}
+	return nil
+}
+
+// interfaceInstanceResolver returns a function that maps an interface unit
+// to the routing instance listing it, as "name.unit" or "name", in
+// instanceInterfaces, or to "" for the default instance.
+func interfaceInstanceResolver(instanceInterfaces map[string][]string) func(ifName string, unit int) string {
+	instanceOf := make(map[string]string)
+	for instance, names := range instanceInterfaces {
+		for _, name := range names {
+			instanceOf[name] = instance
+		}
+	}
+	return func(ifName string, unit int) string {
+		if instance, ok := instanceOf[fmt.Sprintf("%s.%d", ifName, unit)]; ok {
+			return instance
+		}
+		return instanceOf[ifName]
+	}
+}
+
+// routingInstanceInterfaces returns the interfaces listed by each routing
+// instance, in the form FindDuplicateInterfaceAddress expects.
+func (c *Config) routingInstanceInterfaces() map[string][]string {
+	instanceInterfaces := make(map[string][]string)
+	for name, instance := range c.RoutingInstances {
+		if instance != nil {
+			instanceInterfaces[name] = instance.Interfaces
+		}
+	}
+	return instanceInterfaces
+}
+
+// validateInterfaceAddressUniqueness rejects the same IP address configured
+// on more than one interface unit of one routing instance.
+func (c *Config) validateInterfaceAddressUniqueness() error {
+	var addrs []InterfaceAddress
+	for _, addr := range c.configuredInterfaceAddresses() {
+		addrs = append(addrs, InterfaceAddress{Interface: addr.ifName, Unit: addr.unit, Family: addr.family, Address: addr.address})
+	}
+	if dup := FindDuplicateInterfaceAddress(addrs, c.routingInstanceInterfaces()); dup != nil {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			"Duplicate "+dup.String(),
+			"An IP address can be assigned to only one interface unit of a routing instance",
+			"Remove the address from one of the interface units",
+		)
+	}
+	return nil
+}
+
+// InterfaceAddressOverlapWarnings reports interface subnets that overlap
+// across different interface units of one routing instance. Overlaps are
+// legal but usually indicate a typo, so they are surfaced as warnings rather
+// than validation errors. Each routing instance has its own table, so
+// subnets in different instances never overlap, and link-local subnets never
+// overlap: each one belongs to its own link.
+func (c *Config) InterfaceAddressOverlapWarnings() []string {
+	if c == nil {
+		return nil
+	}
+	addrs := c.configuredInterfaceAddresses()
+	instanceOf := interfaceInstanceResolver(c.routingInstanceInterfaces())
+	var warnings []string
+	for i := range addrs {
+		for j := i + 1; j < len(addrs); j++ {
+			a, b := addrs[i], addrs[j]
+			if a.location == b.location || a.ip.Equal(b.ip) || a.linkScope() != "" || b.linkScope() != "" {
+				continue
+			}
+			if instanceOf(a.ifName, a.unit) != instanceOf(b.ifName, b.unit) {
+				continue
+			}
+			if !a.network.Contains(b.network.IP) && !b.network.Contains(a.network.IP) {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("address %s on %s overlaps %s on %s",
+				a.address, a.location, b.address, b.location))
+		}
+	}
+	return warnings
+}
+
+// InterfaceAddressHostBitWarnings reports interface addresses that are the
+// network address of their subnet, or for IPv4 the broadcast address, which
+// usually means the host bits were mistyped. Point-to-point /31 and /127
+// subnets and host /32 and /128 addresses have no such reserved addresses,
+// so every address in them is accepted without a warning (RFC 3021, RFC
+// 6164).
+func (c *Config) InterfaceAddressHostBitWarnings() []string {
+	if c == nil {
+		return nil
+	}
+	var warnings []string
+	for _, addr := range c.configuredInterfaceAddresses() {
+		ones, bits := addr.network.Mask.Size()
+		if bits-ones < 2 {
+			continue
+		}
+		ip := addr.ip.To16()
+		if ip4 := addr.ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+		switch {
+		case ip.Equal(addr.network.IP):
+			warnings = append(warnings, fmt.Sprintf("address %s on %s is the network address of its subnet",
+				addr.address, addr.location))
+		case len(ip) == net.IPv4len && ip.Equal(broadcastAddress(addr.network)):
+			warnings = append(warnings, fmt.Sprintf("address %s on %s is the broadcast address of its subnet",
+				addr.address, addr.location))
+		}
+	}
+	return warnings
+}
+
+// InterfaceLinkLocalAddressWarnings reports IPv6 link-local (fe80::/10)
+// interface addresses whose prefix length is not /64. Link-local addresses
+// are scoped to the interface they are configured on and every link uses
+// the fe80::/64 prefix (RFC 4291), so any other length usually means the
+// address was meant to be a global one.
+func (c *Config) InterfaceLinkLocalAddressWarnings() []string {
+	if c == nil {
+		return nil
+	}
+	var warnings []string
+	for _, addr := range c.configuredInterfaceAddresses() {
+		if addr.linkScope() == "" {
+			continue
+		}
+		if ones, _ := addr.network.Mask.Size(); ones != 64 {
+			warnings = append(warnings, fmt.Sprintf("link-local address %s on %s should use a /64 prefix length",
+				addr.address, addr.location))
+		}
+	}
+	return warnings
+}
+
+// broadcastAddress returns the last address of an IPv4 subnet.
+func broadcastAddress(network *net.IPNet) net.IP {
+	base := network.IP.To4()
+	last := make(net.IP, net.IPv4len)
+	for i := range last {
+		last[i] = base[i] | ^network.Mask[len(network.Mask)-net.IPv4len+i]
+	}
+	return last
+}
 
-	return nil
+// StaticRouteNextHopWarnings reports global static routes whose next-hop is
+// not inside any subnet configured on an interface of the default routing
+// instance. FRR resolves such next-hops recursively, so the route stays
+// inactive (and traffic is dropped) unless another route covers the next-hop.
+// IPv6 link-local next-hops are skipped because they are only meaningful
+// together with an outgoing interface.
+func (c *Config) StaticRouteNextHopWarnings() []string {
+	if c == nil || c.RoutingOptions == nil || len(c.RoutingOptions.StaticRoutes) == 0 {
+		return nil
+	}
+	instanceInterfaces := make(map[string]bool)
+	for _, instance := range c.RoutingInstances {
+		if instance == nil {
+			continue
+		}
+		for _, name := range instance.Interfaces {
+			instanceInterfaces[name] = true
+		}
+	}
+	var connected []interfaceAddress
+	for _, addr := range c.configuredInterfaceAddresses() {
+		if instanceInterfaces[addr.ifName] || instanceInterfaces[fmt.Sprintf("%s.%d", addr.ifName, addr.unit)] {
+			continue
+		}
+		connected = append(connected, addr)
+	}
+
+	var warnings []string
+	for _, sr := range c.RoutingOptions.StaticRoutes {
+		if sr == nil {
+			continue
+		}
+		nextHop := net.ParseIP(sr.NextHop)
+		if nextHop == nil || nextHop.IsLinkLocalUnicast() {
+			continue
+		}
+		adjacent := false
+		for _, addr := range connected {
+			if addr.network.Contains(nextHop) {
+				adjacent = true
+				break
+			}
+		}
+		if !adjacent {
+			warnings = append(warnings, fmt.Sprintf(
+				"static route %s next-hop %s is not on any configured interface subnet; FRR resolves it recursively and the route stays inactive unless another route reaches the next-hop. Use a directly connected next-hop or add an interface address covering it",
+				sr.Prefix, sr.NextHop))
+		}
+	}
+	return warnings
 }
 
 // Validate validates chassis configuration.
@@ -576,6 +1429,46 @@ func keys(m map[string]bool) []string {
 	return result
 }
 
+// RouterIDAuto is the routing-options router-id value that derives the
+// router ID from the loopback address.
+const RouterIDAuto = "auto"
+
+// LoopbackInterface is the interface "router-id auto" derives the router ID
+// from.
+const LoopbackInterface = "lo0"
+
+// LoopbackRouterID returns the first IPv4 /32 address in addresses, or "" when
+// there is none. It is used with the inet addresses of lo0 unit 0.
+func LoopbackRouterID(addresses []string) string {
+	for _, addr := range addresses {
+		ip, network, err := net.ParseCIDR(addr)
+		if err != nil || ip.To4() == nil {
+			continue
+		}
+		if ones, bits := network.Mask.Size(); ones == 32 && bits == 32 {
+			return ip.String()
+		}
+	}
+	return ""
+}
+
+// EffectiveRouterID returns the global router ID. With "router-id auto" it is
+// derived from the first /32 inet address of lo0 unit 0; an empty result means
+// no router ID is configured or none can be derived.
+func (c *Config) EffectiveRouterID() string {
+	if c == nil || c.RoutingOptions == nil {
+		return ""
+	}
+	if c.RoutingOptions.RouterID != RouterIDAuto {
+		return c.RoutingOptions.RouterID
+	}
+	iface := c.Interfaces[LoopbackInterface]
+	if iface == nil || iface.Units[0] == nil || iface.Units[0].Family["inet"] == nil {
+		return ""
+	}
+	return LoopbackRouterID(iface.Units[0].Family["inet"].Addresses)
+}
+
 // Validate validates routing options configuration
 func (ro *RoutingOptions) Validate() error {
 	return ro.validate(nil)
@@ -586,8 +1479,18 @@ func (ro *RoutingOptions) validate(cfg *Config) error {
 		return nil
 	}
 
-	// Validate router-id format if specified
-	if ro.RouterID != "" {
+	// Validate router-id format if specified. "router-id auto" needs a /32
+	// inet address on lo0 unit 0 to derive the router ID from.
+	if ro.RouterID == RouterIDAuto {
+		if cfg != nil && cfg.EffectiveRouterID() == "" {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				"router-id auto found no /32 inet address on lo0 unit 0",
+				"router-id auto derives the router ID from the loopback address",
+				"Set 'interfaces lo0 unit 0 family inet address <ip>/32' or configure an explicit router-id",
+			)
+		}
+	} else if ro.RouterID != "" {
 		if net.ParseIP(ro.RouterID) == nil {
 			return errors.New(
 				errors.ErrCodeConfigValidation,
@@ -620,6 +1523,10 @@ func (ro *RoutingOptions) validate(cfg *Config) error {
 		}
 	}
 
+	if err := validateAdministrativeDistance(ro.AdministrativeDistance); err != nil {
+		return err
+	}
+
 	// Validate static routes
 	for _, sr := range ro.StaticRoutes {
 		if err := validateStaticRoute(cfg, sr); err != nil {
@@ -630,6 +1537,48 @@ func (ro *RoutingOptions) validate(cfg *Config) error {
 	return nil
 }
 
+// validateAdministrativeDistance checks that every configured protocol
+// distance is within 1-255.
+func validateAdministrativeDistance(ad *AdministrativeDistance) error {
+	if ad == nil {
+		return nil
+	}
+	type distance struct {
+		name  string
+		value int
+	}
+	var distances []distance
+	if ad.BGP != nil {
+		distances = append(distances,
+			distance{"bgp external", ad.BGP.External},
+			distance{"bgp internal", ad.BGP.Internal},
+			distance{"bgp local", ad.BGP.Local})
+	}
+	for _, p := range []struct {
+		name string
+		d    *OSPFDistance
+	}{{"ospf", ad.OSPF}, {"ospf3", ad.OSPF3}} {
+		if p.d != nil {
+			distances = append(distances,
+				distance{p.name, p.d.Default},
+				distance{p.name + " intra-area", p.d.IntraArea},
+				distance{p.name + " inter-area", p.d.InterArea},
+				distance{p.name + " external", p.d.External})
+		}
+	}
+	for _, d := range distances {
+		if d.value < 0 || d.value > 255 {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Administrative distance out of range for %s: %d", d.name, d.value),
+				"Administrative distance must be between 1 and 255",
+				"Use a distance between 1 and 255",
+			)
+		}
+	}
+	return nil
+}
+
 // validateStaticRoute validates a static route
 func validateStaticRoute(cfg *Config, sr *StaticRoute) error {
 	if sr == nil {
@@ -851,65 +1800,123 @@ func validatePolicyStatementReference(cfg *Config, context, policyName string) e
 
 // Validate validates protocol configuration
 func (pc *ProtocolConfig) Validate(cfg *Config) error {
+	result := &ValidationResult{}
+	pc.validateAll(cfg, result)
+	return result.Err()
+}
+
+// validateAll validates each configured protocol independently, adding one
+// error per failing protocol and advisory warnings to result.
+func (pc *ProtocolConfig) validateAll(cfg *Config, result *ValidationResult) {
 	if pc == nil {
-		return nil
+		return
 	}
 
 	if pc.BFD != nil {
-		if err := pc.BFD.Validate(cfg); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"protocols", "bfd"}, pc.BFD.Validate(cfg))
 	}
 
 	// Validate BGP
 	if pc.BGP != nil {
-		if err := pc.BGP.Validate(cfg); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"protocols", "bgp"}, pc.BGP.Validate(cfg))
+		pc.BGP.addLocalAddressWarnings(result)
+		pc.BGP.addNextHopSelfWarnings(result)
 	}
 
 	if pc.EVPN != nil {
-		if err := pc.EVPN.Validate(cfg); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"protocols", "evpn"}, pc.EVPN.Validate(cfg))
 	}
 
 	// Validate OSPF
 	if pc.OSPF != nil {
-		if err := pc.OSPF.Validate(cfg); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"protocols", "ospf"}, pc.OSPF.Validate(cfg))
+		pc.OSPF.addBackboneWarnings("OSPF", result)
 	}
 
 	// Validate OSPFv3
 	if pc.OSPF3 != nil {
-		if err := pc.OSPF3.ValidateOSPF3(cfg); err != nil {
-			return err
-		}
+		result.addErrorAt([]string{"protocols", "ospf3"}, pc.OSPF3.ValidateOSPF3(cfg))
+		pc.OSPF3.addBackboneWarnings("OSPF3", result)
 	}
 
 	if pc.MPLS != nil {
 		for _, ifName := range pc.MPLS.Interfaces {
 			if err := validateConfiguredInterfaceReference(cfg, "MPLS", ifName); err != nil {
-				return err
+				result.addErrorAt([]string{"protocols", "mpls"}, err)
+				break
 			}
 		}
 	}
 
+	if pc.RIP != nil {
+		result.addErrorAt([]string{"protocols", "rip"}, pc.RIP.Validate(cfg))
+	}
+
 	if pc.VRRP != nil {
-		if err := pc.VRRP.Validate(); err != nil {
-			return err
+		result.addErrorAt([]string{"protocols", "vrrp"}, pc.validateVRRP(cfg))
+	}
+}
+
+func (pc *ProtocolConfig) validateVRRP(cfg *Config) error {
+	if err := pc.VRRP.Validate(); err != nil {
+		return err
+	}
+	for name, group := range pc.VRRP.Groups {
+		if group != nil && group.Interface != "" {
+			if err := validateConfiguredInterfaceReference(cfg, fmt.Sprintf("VRRP group %s", name), group.Interface); err != nil {
+				return err
+			}
 		}
-		for name, group := range pc.VRRP.Groups {
-			if group != nil && group.Interface != "" {
-				if err := validateConfiguredInterfaceReference(cfg, fmt.Sprintf("VRRP group %s", name), group.Interface); err != nil {
-					return err
-				}
+	}
+	return nil
+}
+
+// addLocalAddressWarnings flags internal BGP neighbors without a
+// local-address. Such sessions are sourced from the egress interface
+// address, which usually breaks loopback-to-loopback iBGP peering.
+func (bgp *BGPConfig) addLocalAddressWarnings(result *ValidationResult) {
+	groupNames := make([]string, 0, len(bgp.Groups))
+	for name := range bgp.Groups {
+		groupNames = append(groupNames, name)
+	}
+	sort.Strings(groupNames)
+	for _, groupName := range groupNames {
+		group := bgp.Groups[groupName]
+		if group == nil || group.Type != "internal" {
+			continue
+		}
+		neighborIPs := make([]string, 0, len(group.Neighbors))
+		for ip := range group.Neighbors {
+			neighborIPs = append(neighborIPs, ip)
+		}
+		sort.Strings(neighborIPs)
+		for _, ip := range neighborIPs {
+			if neighbor := group.Neighbors[ip]; neighbor != nil && neighbor.LocalAddress == "" && neighbor.UpdateSource == "" {
+				result.addWarning("BGP neighbor %s in internal group %s has no local-address; the session is sourced from the outgoing interface address", ip, groupName)
 			}
 		}
 	}
+}
 
-	return nil
+// addNextHopSelfWarnings flags next-hop-self on external groups. eBGP
+// already advertises this router as next-hop, so the setting is only
+// meaningful towards internal peers and route-reflector clients.
+func (bgp *BGPConfig) addNextHopSelfWarnings(result *ValidationResult) {
+	for _, groupName := range sortedKeys(bgp.Groups) {
+		group := bgp.Groups[groupName]
+		if group == nil || group.Type == "internal" {
+			continue
+		}
+		if group.NextHopSelf {
+			result.addWarning("BGP group %s sets next-hop-self, which has no effect on external peers", groupName)
+			continue
+		}
+		for _, ip := range sortedKeys(group.Neighbors) {
+			if neighbor := group.Neighbors[ip]; neighbor != nil && neighbor.NextHopSelf {
+				result.addWarning("BGP neighbor %s in group %s sets next-hop-self, which has no effect on external peers", ip, groupName)
+			}
+		}
+	}
 }
 
 // Validate validates EVPN/VXLAN overlay configuration.
@@ -1128,6 +2135,17 @@ func (bgp *BGPConfig) Validate(cfg *Config) error {
 		)
 	}
 
+	if bgp.RouterID != "" {
+		if ip := net.ParseIP(bgp.RouterID); ip == nil || ip.To4() == nil {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Invalid BGP router-id: %s", bgp.RouterID),
+				"BGP router ID must be an IPv4 address",
+				"Use an IPv4 address for 'protocols bgp router-id'",
+			)
+		}
+	}
+
 	// Validate groups
 	if len(bgp.Groups) == 0 {
 		return errors.New(
@@ -1144,6 +2162,52 @@ func (bgp *BGPConfig) Validate(cfg *Config) error {
 		}
 	}
 
+	return validateBGPClusterIDs(bgp)
+}
+
+// validateBGPClusterIDs checks that all route-reflector cluster IDs agree,
+// since FRR supports a single cluster ID per BGP instance.
+func validateBGPClusterIDs(bgp *BGPConfig) error {
+	clusterID := ""
+	for _, groupName := range sortedKeys(bgp.Groups) {
+		group := bgp.Groups[groupName]
+		for _, neighborIP := range sortedKeys(group.Neighbors) {
+			neighbor := group.Neighbors[neighborIP]
+			if !group.IsRouteReflectorClient(neighbor) {
+				continue
+			}
+			id := group.ClusterID(neighbor)
+			if id == "" {
+				continue
+			}
+			if clusterID == "" {
+				clusterID = id
+				continue
+			}
+			if id != clusterID {
+				return errors.New(
+					errors.ErrCodeConfigValidation,
+					fmt.Sprintf("BGP neighbor %s in group %s uses cluster %s, but cluster %s is already configured", neighborIP, groupName, id, clusterID),
+					"All route-reflector clients must share one cluster ID",
+					"Use the same 'cluster <id>' value for every group and neighbor",
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// validateBGPClusterID validates a route-reflector cluster ID
+func validateBGPClusterID(context, clusterID string) error {
+	ip := net.ParseIP(clusterID)
+	if ip == nil || ip.To4() == nil || strings.Contains(clusterID, ":") {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid cluster ID for %s: %s", context, clusterID),
+			"Cluster ID must be a valid IPv4 address",
+			"Use a dotted-quad cluster ID such as 192.0.2.1",
+		)
+	}
 	return nil
 }
 
@@ -1191,6 +2255,19 @@ func validateBGPGroup(cfg *Config, groupName string, group *BGPGroup) error {
 		if err := validateBGPNeighbor(cfg, groupName, neighborIP, neighbor); err != nil {
 			return err
 		}
+		if group.IsRouteReflectorClient(neighbor) && group.Type != "internal" {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("BGP neighbor %s in group %s is a route-reflector client, but group %s is %s", neighborIP, groupName, groupName, group.Type),
+				"Route-reflector clients are only supported in internal groups",
+				"Set 'set protocols bgp group <name> type internal' or remove the cluster/route-reflector-client setting",
+			)
+		}
+	}
+	if group.Cluster != "" {
+		if err := validateBGPClusterID(fmt.Sprintf("BGP group %s", groupName), group.Cluster); err != nil {
+			return err
+		}
 	}
 	if group.Import != "" {
 		if err := validatePolicyStatementReference(cfg, fmt.Sprintf("BGP group %s import", groupName), group.Import); err != nil {
@@ -1249,13 +2326,14 @@ func validateBGPNeighbor(cfg *Config, groupName, neighborIP string, neighbor *BG
 
 	// Validate local address if specified
 	if neighbor.LocalAddress != "" {
-		if net.ParseIP(neighbor.LocalAddress) == nil {
-			return errors.New(
-				errors.ErrCodeConfigValidation,
-				fmt.Sprintf("Invalid local address for neighbor %s in group %s: %s", neighborIP, groupName, neighbor.LocalAddress),
-				"Local address must be a valid IP address",
-				"Use a valid IPv4 or IPv6 address",
-			)
+		if err := validateBGPLocalAddress(cfg, groupName, neighborIP, neighbor.LocalAddress); err != nil {
+			return err
+		}
+	}
+
+	if neighbor.UpdateSource != "" {
+		if err := validateBGPUpdateSource(cfg, groupName, neighborIP, neighbor); err != nil {
+			return err
 		}
 	}
 
@@ -1265,6 +2343,84 @@ func validateBGPNeighbor(cfg *Config, groupName, neighborIP string, neighbor *BG
 		}
 	}
 
+	if neighbor.Cluster != "" {
+		if err := validateBGPClusterID(fmt.Sprintf("BGP neighbor %s in group %s", neighborIP, groupName), neighbor.Cluster); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBGPLocalAddress checks a neighbor's local-address. An IPv6
+// link-local address exists on every link, so it must name the interface it
+// is used on as a zone, for example fe80::1%ge-0/0/0.
+func validateBGPLocalAddress(cfg *Config, groupName, neighborIP, localAddress string) error {
+	addr, err := netip.ParseAddr(localAddress)
+	if err != nil {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid local address for neighbor %s in group %s: %s", neighborIP, groupName, localAddress),
+			"Local address must be a valid IP address",
+			"Use a valid IPv4 or IPv6 address",
+		)
+	}
+	zone := addr.Zone()
+	if !addr.Is6() || !addr.IsLinkLocalUnicast() {
+		if zone != "" {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Local address %s for neighbor %s in group %s has an interface zone", localAddress, neighborIP, groupName),
+				"Only an IPv6 link-local local-address names an interface",
+				fmt.Sprintf("Use 'local-address %s'", addr.WithZone("")),
+			)
+		}
+		return nil
+	}
+	if zone == "" {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Link-local local address %s for neighbor %s in group %s has no interface", localAddress, neighborIP, groupName),
+			"A link-local address is ambiguous without the interface it belongs to",
+			fmt.Sprintf("Append the interface as a zone, for example 'local-address %s%%ge-0/0/0', or use a global address", localAddress),
+		)
+	}
+	return validateConfiguredInterfaceReference(cfg, fmt.Sprintf("BGP neighbor %s in group %s local-address", neighborIP, groupName), zone)
+}
+
+// validateBGPUpdateSource checks that a neighbor's update-source names a
+// configured interface or an address configured on one. FRR accepts only one
+// update-source per neighbor, so it cannot be combined with local-address.
+func validateBGPUpdateSource(cfg *Config, groupName, neighborIP string, neighbor *BGPNeighbor) error {
+	if neighbor.LocalAddress != "" {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("BGP neighbor %s in group %s has both local-address and update-source", neighborIP, groupName),
+			"local-address and update-source both select the session source",
+			"Keep either 'local-address' or 'update-source'",
+		)
+	}
+	if ip := net.ParseIP(neighbor.UpdateSource); ip != nil {
+		for _, addr := range cfg.configuredInterfaceAddresses() {
+			if addr.ip.Equal(ip) {
+				return nil
+			}
+		}
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("BGP neighbor %s in group %s update-source %s is not configured on any interface", neighborIP, groupName, neighbor.UpdateSource),
+			"An update-source address must be assigned to a local interface",
+			"Configure the address on an interface or use the interface name",
+		)
+	}
+	if _, ok := cfg.Interfaces[neighbor.UpdateSource]; !ok {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("BGP neighbor %s in group %s update-source references unknown interface %s", neighborIP, groupName, neighbor.UpdateSource),
+			"An update-source interface must be configured under interfaces",
+			"Configure the interface or use one of its addresses",
+		)
+	}
 	return nil
 }
 
@@ -1283,10 +2439,14 @@ func (ospf *OSPFConfig) validate(cfg *Config, protocolLabel, protocolCommand str
 		return nil
 	}
 
-	// Check for router-id (from OSPF config or routing-options)
+	// Check for router-id (from OSPF config or routing-options; OSPFv3 may
+	// also derive it from the BGP router-id)
 	routerID := ospf.RouterID
-	if routerID == "" && cfg.RoutingOptions != nil {
-		routerID = cfg.RoutingOptions.RouterID
+	if routerID == "" {
+		routerID = cfg.EffectiveRouterID()
+	}
+	if routerID == "" && !requireRouterID && cfg.Protocols != nil && cfg.Protocols.BGP != nil {
+		routerID = cfg.Protocols.BGP.RouterID
 	}
 
 	if routerID == "" && requireRouterID {
@@ -1317,6 +2477,17 @@ func (ospf *OSPFConfig) validate(cfg *Config, protocolLabel, protocolCommand str
 		)
 	}
 
+	if ospf.ReferenceBandwidth != 0 && (ospf.ReferenceBandwidth < MinOSPFReferenceBandwidth ||
+		ospf.ReferenceBandwidth > MaxOSPFReferenceBandwidth || ospf.ReferenceBandwidth%MinOSPFReferenceBandwidth != 0) {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid %s reference-bandwidth: %s", protocolLabel, FormatUnitValue(ospf.ReferenceBandwidth)),
+			fmt.Sprintf("Reference bandwidth must be a whole number of Mbit/s between %s and %s",
+				FormatUnitValue(MinOSPFReferenceBandwidth), FormatUnitValue(MaxOSPFReferenceBandwidth)),
+			"Use a value like '10g' or '100m'",
+		)
+	}
+
 	// Validate areas
 	if len(ospf.Areas) == 0 {
 		return errors.New(
@@ -1333,9 +2504,70 @@ func (ospf *OSPFConfig) validate(cfg *Config, protocolLabel, protocolCommand str
 		}
 	}
 
+	return ospf.validateInterfaceAreas(protocolLabel)
+}
+
+// validateInterfaceAreas checks that each interface is assigned to exactly
+// one area. FRR binds an interface to a single area, so a second assignment
+// silently breaks adjacencies in one of them.
+func (ospf *OSPFConfig) validateInterfaceAreas(protocolLabel string) error {
+	areaIDs := make([]string, 0, len(ospf.Areas))
+	for areaID := range ospf.Areas {
+		areaIDs = append(areaIDs, areaID)
+	}
+	sort.Strings(areaIDs)
+	assigned := make(map[string]string)
+	for _, areaID := range areaIDs {
+		ifNames := make([]string, 0, len(ospf.Areas[areaID].Interfaces))
+		for ifName := range ospf.Areas[areaID].Interfaces {
+			ifNames = append(ifNames, ifName)
+		}
+		sort.Strings(ifNames)
+		for _, ifName := range ifNames {
+			if first, ok := assigned[ifName]; ok {
+				return errors.New(
+					errors.ErrCodeConfigValidation,
+					fmt.Sprintf("%s interface %s is assigned to both area %s and area %s", protocolLabel, ifName, first, areaID),
+					"An OSPF interface can belong to only one area",
+					fmt.Sprintf("Remove interface %s from all but one area", ifName),
+				)
+			}
+			assigned[ifName] = areaID
+		}
+	}
 	return nil
 }
 
+// addBackboneWarnings flags multi-area configurations without the backbone
+// area. Non-backbone areas exchange routes only through area 0.0.0.0, so
+// without it inter-area routes are never learned.
+func (ospf *OSPFConfig) addBackboneWarnings(protocolLabel string, result *ValidationResult) {
+	if len(ospf.Areas) < 2 {
+		return
+	}
+	for areaID := range ospf.Areas {
+		if isOSPFBackboneArea(areaID) {
+			return
+		}
+	}
+	areaIDs := make([]string, 0, len(ospf.Areas))
+	for areaID := range ospf.Areas {
+		areaIDs = append(areaIDs, areaID)
+	}
+	sort.Strings(areaIDs)
+	result.addWarning("%s areas %s are configured without backbone area 0.0.0.0; inter-area routes will not be exchanged", protocolLabel, strings.Join(areaIDs, ", "))
+}
+
+// isOSPFBackboneArea reports whether areaID is area 0 in either dotted or
+// integer form.
+func isOSPFBackboneArea(areaID string) bool {
+	if ip := net.ParseIP(areaID); ip != nil {
+		return ip.Equal(net.IPv4zero)
+	}
+	n, err := strconv.ParseUint(areaID, 10, 32)
+	return err == nil && n == 0
+}
+
 // validateOSPFArea validates an OSPF area
 func validateOSPFArea(protocolLabel, protocolCommand, areaID string, area *OSPFArea, cfg *Config) error {
 	if area == nil {
@@ -1386,11 +2618,36 @@ func validateOSPFArea(protocolLabel, protocolCommand, areaID string, area *OSPFA
 		if err := validateOSPFInterface(protocolLabel, areaID, ifName, ospfIf, cfg); err != nil {
 			return err
 		}
+		if protocolCommand == "ospf3" && !interfaceHasFamilyAddress(cfg.Interfaces[ifName], "inet6") {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("%s interface %s in area %s has no inet6 address", protocolLabel, ifName, areaID),
+				"OSPFv3 runs over IPv6 and requires an inet6 address on the interface",
+				fmt.Sprintf("Add 'set interfaces %s unit <unit> family inet6 address <prefix>'", ifName),
+			)
+		}
 	}
 
 	return nil
 }
 
+// interfaceHasFamilyAddress reports whether any unit of iface has an address
+// in the given family
+func interfaceHasFamilyAddress(iface *Interface, family string) bool {
+	if iface == nil {
+		return false
+	}
+	for _, unit := range iface.Units {
+		if unit == nil {
+			continue
+		}
+		if fam := unit.Family[family]; fam != nil && len(fam.Addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // validateOSPFInterface validates an OSPF interface
 func validateOSPFInterface(protocolLabel, areaID, ifName string, ospfIf *OSPFInterface, cfg *Config) error {
 	if ospfIf == nil {
@@ -1407,20 +2664,20 @@ func validateOSPFInterface(protocolLabel, areaID, ifName string, ospfIf *OSPFInt
 	}
 
 	// Validate metric
-	if ospfIf.Metric < 0 || ospfIf.Metric > 65535 {
+	if ospfIf.Metric != nil && (*ospfIf.Metric < 1 || *ospfIf.Metric > 65535) {
 		return errors.New(
 			errors.ErrCodeConfigValidation,
-			fmt.Sprintf("Invalid %s metric for interface %s in area %s: %d", protocolLabel, ifName, areaID, ospfIf.Metric),
-			fmt.Sprintf("%s metric must be between 0 and 65535", protocolLabel),
+			fmt.Sprintf("Invalid %s metric for interface %s in area %s: %d", protocolLabel, ifName, areaID, *ospfIf.Metric),
+			fmt.Sprintf("%s metric must be between 1 and 65535", protocolLabel),
 			"Use a valid metric value",
 		)
 	}
 
 	// Validate priority
-	if ospfIf.Priority < 0 || ospfIf.Priority > 255 {
+	if ospfIf.Priority != nil && (*ospfIf.Priority < 0 || *ospfIf.Priority > 255) {
 		return errors.New(
 			errors.ErrCodeConfigValidation,
-			fmt.Sprintf("Invalid %s priority for interface %s in area %s: %d", protocolLabel, ifName, areaID, ospfIf.Priority),
+			fmt.Sprintf("Invalid %s priority for interface %s in area %s: %d", protocolLabel, ifName, areaID, *ospfIf.Priority),
 			fmt.Sprintf("%s priority must be between 0 and 255", protocolLabel),
 			"Use a valid priority value",
 		)
@@ -1435,6 +2692,92 @@ func validateOSPFInterface(protocolLabel, areaID, ifName string, ospfIf *OSPFInt
 	return nil
 }
 
+// RIP timer and metric limits
+const (
+	MinRIPTimer         = 5
+	MaxRIPTimer         = 65535
+	MaxRIPDefaultMetric = 16
+)
+
+// Validate validates RIP configuration.
+func (rip *RIPConfig) Validate(cfg *Config) error {
+	if rip == nil {
+		return nil
+	}
+
+	timers := []struct {
+		name  string
+		value int
+	}{
+		{"update-interval", rip.UpdateInterval},
+		{"route-timeout", rip.RouteTimeout},
+		{"holddown", rip.Holddown},
+	}
+	for _, timer := range timers {
+		if timer.value != 0 && (timer.value < MinRIPTimer || timer.value > MaxRIPTimer) {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Invalid RIP %s: %d", timer.name, timer.value),
+				fmt.Sprintf("RIP timers must be between %d and %d seconds", MinRIPTimer, MaxRIPTimer),
+				"Use a valid timer value",
+			)
+		}
+	}
+	if rip.DefaultMetric < 0 || rip.DefaultMetric > MaxRIPDefaultMetric {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			fmt.Sprintf("Invalid RIP default-metric: %d", rip.DefaultMetric),
+			fmt.Sprintf("RIP default-metric must be between 1 and %d", MaxRIPDefaultMetric),
+			"Use a valid metric value",
+		)
+	}
+
+	if len(rip.Groups) == 0 {
+		return errors.New(
+			errors.ErrCodeConfigValidation,
+			"RIP configured but no groups defined",
+			"RIP requires at least one group with a neighbor interface",
+			"Add a group using 'set protocols rip group <name> neighbor <interface>'",
+		)
+	}
+
+	owner := make(map[string]string)
+	for _, groupName := range sortedKeys(rip.Groups) {
+		group := rip.Groups[groupName]
+		if group == nil || len(group.Neighbors) == 0 {
+			return errors.New(
+				errors.ErrCodeConfigValidation,
+				fmt.Sprintf("RIP group %s has no neighbors", groupName),
+				"RIP group must have at least one neighbor interface",
+				fmt.Sprintf("Add 'set protocols rip group %s neighbor <interface>'", groupName),
+			)
+		}
+		for _, ifName := range sortedKeys(group.Neighbors) {
+			if err := validateConfiguredInterfaceReference(cfg, fmt.Sprintf("RIP group %s", groupName), ifName); err != nil {
+				return err
+			}
+			if other, exists := owner[ifName]; exists {
+				return errors.New(
+					errors.ErrCodeConfigValidation,
+					fmt.Sprintf("RIP interface %s is in groups %s and %s", ifName, other, groupName),
+					"An interface can belong to only one RIP group",
+					fmt.Sprintf("Remove %s from one of the groups", ifName),
+				)
+			}
+			owner[ifName] = groupName
+			if !interfaceHasFamilyAddress(cfg.Interfaces[ifName], "inet") {
+				return errors.New(
+					errors.ErrCodeConfigValidation,
+					fmt.Sprintf("RIP interface %s in group %s has no inet address", ifName, groupName),
+					"RIPv2 runs over IPv4 and requires an inet address on the interface",
+					fmt.Sprintf("Add 'set interfaces %s unit <unit> family inet address <prefix>'", ifName),
+				)
+			}
+		}
+	}
+	return nil
+}
+
 func validateBFDProfileReference(cfg *Config, context, profileName string) error {
 	if strings.TrimSpace(profileName) == "" {
 		return errors.New(
@@ -1502,3 +2845,85 @@ func (c *Config) validateClassOfServiceInterfaceReferences() error {
 	}
 	return nil
 }
+
+// Policer limits supported by the VPP dataplane. VPP programs the committed
+// rate in kbps as a 32-bit value.
+const (
+	MaxPolicerNameLength     = 63
+	MinPolicerBandwidthLimit = 8000
+	MaxPolicerBandwidthLimit = uint64(^uint32(0)) * 1000
+	MinPolicerBurstSizeLimit = 1500
+	MaxPolicerBurstSizeLimit = 100 * 1000 * 1000 * 1000
+	PolicerActionDiscard     = "discard"
+)
+
+// Validate validates firewall configuration.
+func (f *FirewallConfig) Validate() error {
+	for name, policer := range f.Policers {
+		if policer == nil {
+			return errors.New(errors.ErrCodeConfigValidation, fmt.Sprintf("Policer %s is nil", name), "Policer is invalid", "Remove or recreate the policer")
+		}
+		if len(name) > MaxPolicerNameLength {
+			return errors.New(errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Policer name %s is too long", name),
+				fmt.Sprintf("Policer names must be at most %d characters", MaxPolicerNameLength),
+				"Use a shorter policer name")
+		}
+		if policer.BandwidthLimit == 0 {
+			return errors.New(errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Policer %s has no bandwidth-limit", name),
+				"Policers require a bandwidth limit",
+				fmt.Sprintf("Add: set firewall policer %s if-exceeding bandwidth-limit <rate>", name))
+		}
+		if policer.BandwidthLimit < MinPolicerBandwidthLimit || policer.BandwidthLimit > MaxPolicerBandwidthLimit {
+			return errors.New(errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Policer %s has invalid bandwidth-limit: %s", name, FormatUnitValue(policer.BandwidthLimit)),
+				fmt.Sprintf("Bandwidth limit must be between %s and %s bits per second",
+					FormatUnitValue(MinPolicerBandwidthLimit), FormatUnitValue(MaxPolicerBandwidthLimit)),
+				"Use a supported bandwidth limit")
+		}
+		if policer.BurstSizeLimit == 0 {
+			return errors.New(errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Policer %s has no burst-size-limit", name),
+				"Policers require a burst size limit",
+				fmt.Sprintf("Add: set firewall policer %s if-exceeding burst-size-limit <size>", name))
+		}
+		if policer.BurstSizeLimit < MinPolicerBurstSizeLimit || policer.BurstSizeLimit > MaxPolicerBurstSizeLimit {
+			return errors.New(errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Policer %s has invalid burst-size-limit: %s", name, FormatUnitValue(policer.BurstSizeLimit)),
+				fmt.Sprintf("Burst size limit must be between %s and %s bytes",
+					FormatUnitValue(MinPolicerBurstSizeLimit), FormatUnitValue(MaxPolicerBurstSizeLimit)),
+				"Use a supported burst size limit")
+		}
+		if policer.Action != "" && policer.Action != PolicerActionDiscard {
+			return errors.New(errors.ErrCodeConfigValidation,
+				fmt.Sprintf("Policer %s has unsupported action: %s", name, policer.Action),
+				"Only 'discard' is supported for traffic exceeding the policer",
+				fmt.Sprintf("Use: set firewall policer %s then discard", name))
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateInterfacePolicerReferences() error {
+	for ifName, iface := range c.Interfaces {
+		if iface == nil {
+			continue
+		}
+		for _, ref := range []struct{ direction, name string }{
+			{"input", iface.InputPolicer},
+			{"output", iface.OutputPolicer},
+		} {
+			if ref.name == "" {
+				continue
+			}
+			if c.Firewall == nil || c.Firewall.Policers[ref.name] == nil {
+				return errors.New(errors.ErrCodeConfigValidation,
+					fmt.Sprintf("Interface %s references unknown %s policer %s", ifName, ref.direction, ref.name),
+					"Referenced firewall policer must exist",
+					"Create the policer before binding it to an interface")
+			}
+		}
+	}
+	return nil
+}
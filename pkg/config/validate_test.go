@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -195,6 +197,33 @@ func TestValidate_NoAddresses(t *testing.T) {
 	}
 }
 
+func TestValidate_AddresslessInet6Family(t *testing.T) {
+	cfg := parseSetCommands(t,
+		"set interfaces ge-0/0/0 unit 0 family inet6",
+		"set interfaces ge-0/0/1 unit 0 family inet",
+	)
+	if family := cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"]; family == nil || len(family.Addresses) != 0 {
+		t.Fatalf("inet6 family = %+v, want enabled without addresses", family)
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() should reject an inet family without addresses")
+	}
+	if !strings.Contains(err.Error(), "family inet on interface ge-0/0/1") {
+		t.Fatalf("Validate() error = %v, want the inet family of ge-0/0/1", err)
+	}
+
+	delete(cfg.Interfaces, "ge-0/0/1")
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() address-less inet6 family error = %v", err)
+	}
+	if got := ToSetCommands(cfg); !strings.Contains(got, "set interfaces ge-0/0/0 unit 0 family inet6\n") {
+		t.Fatalf("ToSetCommands() = %q, want the bare inet6 family", got)
+	}
+	assertSetCommandRoundTrip(t, cfg)
+}
+
 func TestValidate_Description(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -205,6 +234,9 @@ func TestValidate_Description(t *testing.T) {
 		{"normal", "WAN Interface", false},
 		{"long but valid", string(make([]byte, 255)), false},
 		{"too long", string(make([]byte, 256)), true},
+		{"cjk at limit", strings.Repeat("東京", 127) + "局", false},
+		{"cjk over limit", strings.Repeat("東京", 128), true},
+		{"invalid utf-8", "uplink \xff", true},
 	}
 
 	for _, tt := range tests {
@@ -262,6 +294,295 @@ func TestValidate_MultipleInterfaces(t *testing.T) {
 	}
 }
 
+func TestValidate_DuplicateInterfaceAddress(t *testing.T) {
+	cfg := &Config{
+		Interfaces: map[string]*Interface{
+			"ge-0/0/0": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{"192.168.1.1/24"}}}},
+				},
+			},
+			"ge-0/0/1": {
+				Units: map[int]*Unit{
+					10: {Family: map[string]*Family{"inet": {Addresses: []string{"192.168.1.1/24"}}}},
+				},
+			},
+		},
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil, want duplicate address error")
+	}
+	for _, want := range []string{
+		"192.168.1.1",
+		"interface ge-0/0/0 unit 0 family inet",
+		"interface ge-0/0/1 unit 10 family inet",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error = %v, want it to contain %q", err, want)
+		}
+	}
+}
+
+func TestInterfaceAddressOverlapWarnings(t *testing.T) {
+	cfg := &Config{
+		Interfaces: map[string]*Interface{
+			"ge-0/0/0": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{"10.0.0.1/24", "10.0.0.2/24"}}}},
+				},
+			},
+			"ge-0/0/1": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{"10.0.0.129/25"}}}},
+				},
+			},
+			"ge-0/0/2": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{"10.0.1.1/24"}}}},
+				},
+			},
+		},
+	}
+
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want overlaps to be accepted", err)
+	}
+	warnings := cfg.InterfaceAddressOverlapWarnings()
+	want := []string{
+		"address 10.0.0.1/24 on interface ge-0/0/0 unit 0 family inet overlaps 10.0.0.129/25 on interface ge-0/0/1 unit 0 family inet",
+		"address 10.0.0.2/24 on interface ge-0/0/0 unit 0 family inet overlaps 10.0.0.129/25 on interface ge-0/0/1 unit 0 family inet",
+	}
+	if len(warnings) != len(want) {
+		t.Fatalf("InterfaceAddressOverlapWarnings() = %q, want %q", warnings, want)
+	}
+	for i := range want {
+		if warnings[i] != want[i] {
+			t.Errorf("warning[%d] = %q, want %q", i, warnings[i], want[i])
+		}
+	}
+}
+
+func TestInterfaceAddressOverlapWarningsPerRoutingInstance(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/24
+set interfaces ge-0/0/1 unit 0 family inet address 10.0.0.129/25
+set interfaces ge-0/0/2 unit 0 family inet address 10.0.0.130/25
+set routing-instances RED instance-type vrf
+set routing-instances RED interface ge-0/0/1.0
+set routing-instances RED interface ge-0/0/2
+`
+	cfg, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	warnings := cfg.InterfaceAddressOverlapWarnings()
+	want := []string{
+		"address 10.0.0.129/25 on interface ge-0/0/1 unit 0 family inet overlaps 10.0.0.130/25 on interface ge-0/0/2 unit 0 family inet",
+	}
+	if len(warnings) != len(want) || warnings[0] != want[0] {
+		t.Fatalf("InterfaceAddressOverlapWarnings() = %q, want %q", warnings, want)
+	}
+}
+
+func TestInterfaceAddressHostBitWarnings(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.0/31
+set interfaces ge-0/0/1 unit 0 family inet address 192.0.2.3/31
+set interfaces ge-0/0/2 unit 0 family inet address 198.51.100.0/30
+set interfaces ge-0/0/3 unit 0 family inet address 198.51.100.7/30
+set interfaces ge-0/0/4 unit 0 family inet address 198.51.100.9/30
+set interfaces ge-0/0/5 unit 0 family inet6 address 2001:db8:1::/64
+set interfaces ge-0/0/6 unit 0 family inet6 address 2001:db8:2::/127
+set interfaces lo0 unit 0 family inet address 10.255.0.1/32
+`
+	cfg, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want host bit issues to be accepted", err)
+	}
+	if got := cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet"].Addresses[0]; got != "192.0.2.0/31" {
+		t.Fatalf("address = %s, want it kept as configured", got)
+	}
+
+	want := []string{
+		"address 198.51.100.0/30 on interface ge-0/0/2 unit 0 family inet is the network address of its subnet",
+		"address 198.51.100.7/30 on interface ge-0/0/3 unit 0 family inet is the broadcast address of its subnet",
+		"address 2001:db8:1::/64 on interface ge-0/0/5 unit 0 family inet6 is the network address of its subnet",
+	}
+	warnings := cfg.InterfaceAddressHostBitWarnings()
+	if strings.Join(warnings, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("InterfaceAddressHostBitWarnings() = %q, want %q", warnings, want)
+	}
+	if got := len(cfg.ValidateAll().Warnings()); got != len(want) {
+		t.Fatalf("ValidateAll() warnings = %d, want %d", got, len(want))
+	}
+}
+
+func TestInterfaceLinkLocalAddresses(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet6 address fe80::1/64
+set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64
+set interfaces ge-0/0/1 unit 0 family inet6 address fe80::1/64
+set interfaces ge-0/0/2 unit 0 family inet6 address fe80::2/10
+`
+	cfg, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want the same link-local address on different links to be accepted", err)
+	}
+	if warnings := cfg.InterfaceAddressOverlapWarnings(); len(warnings) != 0 {
+		t.Fatalf("InterfaceAddressOverlapWarnings() = %q, want link-local subnets kept apart", warnings)
+	}
+	want := []string{"link-local address fe80::2/10 on interface ge-0/0/2 unit 0 family inet6 should use a /64 prefix length"}
+	if warnings := cfg.InterfaceLinkLocalAddressWarnings(); strings.Join(warnings, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("InterfaceLinkLocalAddressWarnings() = %q, want %q", warnings, want)
+	}
+
+	cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].Addresses = append(cfg.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].Addresses, "fe80::1/64")
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "Duplicate address fe80::1") {
+		t.Fatalf("Validate() error = %v, want duplicate link-local address on one link rejected", err)
+	}
+}
+
+func TestInterfaceAddressUniquenessPerRoutingInstance(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set interfaces ge-0/0/1 unit 0 family inet address 192.0.2.1/24
+set interfaces ge-0/0/2 unit 0 family inet address 192.0.2.1/24
+set routing-instances RED instance-type vrf
+set routing-instances RED interface ge-0/0/1
+`
+	cfg, err := NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	err = cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil, want duplicate address in the default instance")
+	}
+	if want := "Duplicate address 192.0.2.1 on interface ge-0/0/0 unit 0 family inet and interface ge-0/0/2 unit 0 family inet"; !strings.Contains(err.Error(), want) {
+		t.Fatalf("Validate() error = %v, want %q", err, want)
+	}
+
+	delete(cfg.Interfaces, "ge-0/0/2")
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want the address reused in routing-instance RED to be accepted", err)
+	}
+
+	cfg.RoutingInstances["RED"].Interfaces = append(cfg.RoutingInstances["RED"].Interfaces, "ge-0/0/0.0")
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "in routing-instance RED") {
+		t.Fatalf("Validate() error = %v, want duplicate address in routing-instance RED", err)
+	}
+}
+
+func TestStaticRouteNextHopWarnings(t *testing.T) {
+	cfg := &Config{
+		Interfaces: map[string]*Interface{
+			"ge-0/0/0": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{
+						"inet":  {Addresses: []string{"192.0.2.1/24"}},
+						"inet6": {Addresses: []string{"2001:db8::1/64"}},
+					}},
+				},
+			},
+			"ge-0/0/1": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{"198.51.100.1/24"}}}},
+				},
+			},
+		},
+		RoutingInstances: map[string]*RoutingInstance{
+			"BLUE": {Name: "BLUE", InstanceType: "vrf", Interfaces: []string{"ge-0/0/1"}},
+		},
+		RoutingOptions: &RoutingOptions{StaticRoutes: []*StaticRoute{
+			{Prefix: "0.0.0.0/0", NextHop: "192.0.2.254"},
+			{Prefix: "10.0.0.0/8", NextHop: "203.0.113.1"},
+			{Prefix: "172.16.0.0/12", NextHop: "198.51.100.254"},
+			{Prefix: "::/0", NextHop: "2001:db8::ffff"},
+			{Prefix: "2001:db8:1::/48", NextHop: "fe80::1"},
+		}},
+	}
+
+	warnings := cfg.StaticRouteNextHopWarnings()
+	if len(warnings) != 2 {
+		t.Fatalf("StaticRouteNextHopWarnings() = %q, want off-subnet and VRF-only next-hops", warnings)
+	}
+	if !strings.HasPrefix(warnings[0], "static route 10.0.0.0/8 next-hop 203.0.113.1 is not on any configured interface subnet") {
+		t.Errorf("warnings[0] = %q, want off-subnet next-hop warning", warnings[0])
+	}
+	if !strings.HasPrefix(warnings[1], "static route 172.16.0.0/12 next-hop 198.51.100.254 ") {
+		t.Errorf("warnings[1] = %q, want warning for next-hop only reachable in a routing instance", warnings[1])
+	}
+
+	result := cfg.ValidateAll()
+	if result.HasErrors() {
+		t.Fatalf("ValidateAll() errors = %v, want off-subnet next-hops to be accepted", result.Errors())
+	}
+	if got := result.Warnings(); len(got) != 2 || !strings.Contains(got[0].String(), "warning: static route 10.0.0.0/8") {
+		t.Fatalf("ValidateAll() warnings = %v, want static route next-hop warnings", got)
+	}
+}
+
+func TestValidateAllCollectsErrorsAndWarnings(t *testing.T) {
+	cfg := &Config{
+		Interfaces: map[string]*Interface{
+			"bogus0": {},
+			"ge-0/0/0": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{"10.0.0.1/24"}}}},
+				},
+			},
+			"ge-0/0/1": {
+				Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{"10.0.0.129/25"}}}},
+				},
+			},
+		},
+		RoutingOptions: &RoutingOptions{AutonomousSystem: 65000, RouterID: "not-an-ip"},
+		Protocols: &ProtocolConfig{
+			BGP: &BGPConfig{Groups: map[string]*BGPGroup{
+				"ibgp": {Type: "internal", Neighbors: map[string]*BGPNeighbor{
+					"192.0.2.1": {PeerAS: 65000},
+					"192.0.2.2": {PeerAS: 65000, LocalAddress: "192.0.2.254"},
+				}},
+			}},
+		},
+	}
+
+	result := cfg.ValidateAll()
+	errs := result.Errors()
+	if len(errs) != 2 || !strings.Contains(errs[0].Err.Error(), "bogus0") || !strings.Contains(errs[1].Err.Error(), "router-id") {
+		t.Fatalf("Errors() = %v, want interface name and router-id errors", errs)
+	}
+	warnings := result.Warnings()
+	if len(warnings) != 2 {
+		t.Fatalf("Warnings() = %v, want overlap and local-address warnings", warnings)
+	}
+	if !strings.Contains(warnings[0].String(), "warning: address 10.0.0.1/24") {
+		t.Errorf("warnings[0] = %q, want subnet overlap warning", warnings[0].String())
+	}
+	if !strings.Contains(warnings[1].String(), "BGP neighbor 192.0.2.1 in internal group ibgp has no local-address") {
+		t.Errorf("warnings[1] = %q, want missing local-address warning", warnings[1].String())
+	}
+	if err := cfg.Validate(); err == nil || err.Error() != errs[0].Err.Error() {
+		t.Fatalf("Validate() = %v, want first collected error %v", err, errs[0].Err)
+	}
+
+	delete(cfg.Interfaces, "bogus0")
+	cfg.RoutingOptions.RouterID = ""
+	result = cfg.ValidateAll()
+	if result.HasErrors() || len(result.Warnings()) != 2 {
+		t.Fatalf("ValidateAll() issues = %v, want warnings only", result.Issues)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want warnings to be accepted", err)
+	}
+}
+
 func TestValidate_NilConfig(t *testing.T) {
 	var config *Config
 	err := config.Validate()
@@ -354,6 +675,121 @@ func TestValidate_RoutingOptions(t *testing.T) {
 	}
 }
 
+func TestValidate_RouterIDAuto(t *testing.T) {
+	parse := func(t *testing.T, input string) *Config {
+		t.Helper()
+		cfg, err := NewParser(strings.NewReader(input)).Parse()
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		return cfg
+	}
+	const loopback = `set interfaces lo0 unit 0 family inet address 10.255.0.1/32
+set interfaces lo0 unit 0 family inet address 10.255.1.1/24
+`
+
+	cfg := parse(t, loopback+"set routing-options router-id auto\nset protocols ospf area 0.0.0.0 interface lo0\n")
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if got := cfg.EffectiveRouterID(); got != "10.255.0.1" {
+		t.Fatalf("EffectiveRouterID() = %q, want lo0 address 10.255.0.1", got)
+	}
+	if cfg.RoutingOptions.RouterID != RouterIDAuto {
+		t.Fatalf("RouterID = %q, want %q kept for serialization", cfg.RoutingOptions.RouterID, RouterIDAuto)
+	}
+
+	explicit := parse(t, loopback+"set routing-options router-id 192.0.2.1\n")
+	if err := explicit.Validate(); err != nil {
+		t.Fatalf("Validate() with explicit router-id error = %v", err)
+	}
+	if got := explicit.EffectiveRouterID(); got != "192.0.2.1" {
+		t.Fatalf("EffectiveRouterID() with explicit router-id = %q, want 192.0.2.1", got)
+	}
+
+	noLoopback := parse(t, "set interfaces lo0 unit 0 family inet address 10.255.1.1/24\nset routing-options router-id auto\n")
+	if err := noLoopback.Validate(); err == nil || !strings.Contains(err.Error(), "router-id auto") {
+		t.Fatalf("Validate() without lo0 /32 error = %v, want router-id auto error", err)
+	}
+}
+
+func TestValidate_BGPUpdateSource(t *testing.T) {
+	const base = `set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set routing-options autonomous-system 65000
+set routing-options router-id 192.0.2.1
+set protocols bgp group IBGP type internal
+set protocols bgp group IBGP neighbor 192.0.2.2 peer-as 65000
+`
+	tests := []struct {
+		name    string
+		line    string
+		wantErr string
+	}{
+		{name: "interface", line: "update-source ge-0/0/0"},
+		{name: "address", line: "update-source 192.0.2.1"},
+		{name: "unknown interface", line: "update-source ge-0/0/9", wantErr: "unknown interface ge-0/0/9"},
+		{name: "unconfigured address", line: "update-source 192.0.2.9", wantErr: "is not configured on any interface"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := base + "set protocols bgp group IBGP neighbor 192.0.2.2 " + tt.line + "\n"
+			cfg, err := NewParser(strings.NewReader(input)).Parse()
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			err = cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_BGPLinkLocalLocalAddress(t *testing.T) {
+	const base = `set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64
+set routing-options autonomous-system 65000
+set routing-options router-id 192.0.2.1
+set protocols bgp group EBGP type external
+set protocols bgp group EBGP neighbor fe80::2 peer-as 65001
+`
+	tests := []struct {
+		name    string
+		line    string
+		wantErr string
+	}{
+		{name: "global", line: "local-address 2001:db8::1"},
+		{name: "link-local with interface", line: "local-address fe80::1%ge-0/0/0"},
+		{name: "link-local without interface", line: "local-address fe80::1", wantErr: "Link-local local address fe80::1 for neighbor fe80::2 in group EBGP has no interface"},
+		{name: "link-local with unknown interface", line: "local-address fe80::1%ge-0/0/9", wantErr: "references non-existent interface ge-0/0/9"},
+		{name: "global with interface", line: "local-address 2001:db8::1%ge-0/0/0", wantErr: "has an interface zone"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := base + "set protocols bgp group EBGP neighbor fe80::2 " + tt.line + "\n"
+			cfg, err := NewParser(strings.NewReader(input)).Parse()
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			err = cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 // Test BGP validation
 func TestValidate_BGP(t *testing.T) {
 	tests := []struct {
@@ -503,6 +939,68 @@ func TestValidate_BGP(t *testing.T) {
 }
 
 // Test OSPF validation
+func ospfAreaTestConfig(areas map[string][]string) *Config {
+	cfg := &Config{
+		Interfaces:     map[string]*Interface{},
+		RoutingOptions: &RoutingOptions{RouterID: "10.0.1.1"},
+		Protocols:      &ProtocolConfig{OSPF: &OSPFConfig{Areas: map[string]*OSPFArea{}}},
+	}
+	subnet := 0
+	for areaID, ifNames := range areas {
+		area := &OSPFArea{AreaID: areaID, Interfaces: map[string]*OSPFInterface{}}
+		for _, ifName := range ifNames {
+			if cfg.Interfaces[ifName] == nil {
+				subnet++
+				cfg.Interfaces[ifName] = &Interface{Units: map[int]*Unit{
+					0: {Family: map[string]*Family{"inet": {Addresses: []string{fmt.Sprintf("10.0.%d.1/24", subnet)}}}},
+				}}
+			}
+			area.Interfaces[ifName] = &OSPFInterface{Name: ifName}
+		}
+		cfg.Protocols.OSPF.Areas[areaID] = area
+	}
+	return cfg
+}
+
+func TestValidate_OSPFInterfaceInTwoAreas(t *testing.T) {
+	cfg := ospfAreaTestConfig(map[string][]string{
+		"0.0.0.0": {"ge-0/0/0", "ge-0/0/1"},
+		"0.0.0.1": {"ge-0/0/1"},
+	})
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil, want interface in two areas rejected")
+	}
+	if !strings.Contains(err.Error(), "OSPF interface ge-0/0/1 is assigned to both area 0.0.0.0 and area 0.0.0.1") {
+		t.Fatalf("Validate() error = %v, want duplicate area assignment", err)
+	}
+}
+
+func TestValidate_OSPFDisconnectedAreasWarn(t *testing.T) {
+	cfg := ospfAreaTestConfig(map[string][]string{
+		"0.0.0.1": {"ge-0/0/0"},
+		"0.0.0.2": {"ge-0/0/1"},
+	})
+	result := cfg.ValidateAll()
+	if result.HasErrors() {
+		t.Fatalf("ValidateAll() errors = %v, want warning only", result.Errors())
+	}
+	warnings := result.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].String(), "OSPF areas 0.0.0.1, 0.0.0.2 are configured without backbone area 0.0.0.0") {
+		t.Fatalf("Warnings() = %v, want missing backbone warning", warnings)
+	}
+
+	for _, backbone := range []string{"0.0.0.0", "0"} {
+		cfg := ospfAreaTestConfig(map[string][]string{
+			backbone:  {"ge-0/0/0"},
+			"0.0.0.2": {"ge-0/0/1"},
+		})
+		if warnings := cfg.ValidateAll().Warnings(); len(warnings) != 0 {
+			t.Fatalf("Warnings() with backbone %s = %v, want none", backbone, warnings)
+		}
+	}
+}
+
 func TestValidate_OSPF(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -713,7 +1211,37 @@ func TestValidate_OSPF(t *testing.T) {
 							"0.0.0.0": {
 								AreaID: "0.0.0.0",
 								Interfaces: map[string]*OSPFInterface{
-									"ge-0/0/0": {Name: "ge-0/0/0", Metric: 70000},
+									"ge-0/0/0": {Name: "ge-0/0/0", Metric: intPtr(70000)},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OSPF with zero metric",
+			config: &Config{
+				Interfaces: map[string]*Interface{
+					"ge-0/0/0": {
+						Units: map[int]*Unit{
+							0: {
+								Family: map[string]*Family{
+									"inet": {Addresses: []string{"10.0.0.1/24"}},
+								},
+							},
+						},
+					},
+				},
+				RoutingOptions: &RoutingOptions{RouterID: "10.0.1.1"},
+				Protocols: &ProtocolConfig{
+					OSPF: &OSPFConfig{
+						Areas: map[string]*OSPFArea{
+							"0.0.0.0": {
+								AreaID: "0.0.0.0",
+								Interfaces: map[string]*OSPFInterface{
+									"ge-0/0/0": {Name: "ge-0/0/0", Metric: intPtr(0)},
 								},
 							},
 						},
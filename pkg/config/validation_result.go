@@ -0,0 +1,93 @@
+package config
+
+import "fmt"
+
+// ValidationSeverity classifies a validation issue.
+type ValidationSeverity string
+
+// Validation severities. Errors block a commit; warnings are advisory.
+const (
+	SeverityError   ValidationSeverity = "error"
+	SeverityWarning ValidationSeverity = "warning"
+)
+
+// ValidationIssue is one problem found while validating a configuration.
+type ValidationIssue struct {
+	Severity ValidationSeverity
+	Err      error
+	// Path is the set-command path of the configuration object the issue
+	// belongs to, for example ["interfaces", "ge-0/0/0"] or
+	// ["protocols", "bgp"]. It is empty when the issue spans several objects.
+	Path []string
+}
+
+// String renders the issue prefixed with its severity, for example
+// "warning: address 10.0.0.1/24 on ... overlaps ...".
+func (i ValidationIssue) String() string {
+	return fmt.Sprintf("%s: %v", i.Severity, i.Err)
+}
+
+// ValidationResult collects every issue found by Config.ValidateAll, in the
+// order the checks ran.
+type ValidationResult struct {
+	Issues []ValidationIssue
+}
+
+func (r *ValidationResult) addError(err error) {
+	if err != nil {
+		r.Issues = append(r.Issues, ValidationIssue{Severity: SeverityError, Err: err})
+	}
+}
+
+// addErrorAt adds err as an error issue belonging to the object at path.
+func (r *ValidationResult) addErrorAt(path []string, err error) {
+	if err != nil {
+		r.Issues = append(r.Issues, ValidationIssue{Severity: SeverityError, Err: err, Path: path})
+	}
+}
+
+func (r *ValidationResult) addWarning(format string, args ...any) {
+	r.Issues = append(r.Issues, ValidationIssue{Severity: SeverityWarning, Err: fmt.Errorf(format, args...)})
+}
+
+// Errors returns the issues with error severity.
+func (r *ValidationResult) Errors() []ValidationIssue {
+	return r.filter(SeverityError)
+}
+
+// Warnings returns the issues with warning severity.
+func (r *ValidationResult) Warnings() []ValidationIssue {
+	return r.filter(SeverityWarning)
+}
+
+// HasErrors reports whether any issue blocks a commit.
+func (r *ValidationResult) HasErrors() bool {
+	return r.Err() != nil
+}
+
+// Err returns the first error-severity issue, or nil when the configuration
+// is valid. Warnings never produce an error.
+func (r *ValidationResult) Err() error {
+	if r == nil {
+		return nil
+	}
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityError {
+			return issue.Err
+		}
+	}
+	return nil
+}
+
+func (r *ValidationResult) filter(severity ValidationSeverity) []ValidationIssue {
+	if r == nil {
+		return nil
+	}
+	var out []ValidationIssue
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			out = append(out, issue)
+		}
+	}
+	return out
+}
@@ -0,0 +1,20 @@
+package datastore
+
+import (
+	"strings"
+
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
+)
+
+// maxAuditChanges caps the changed commands listed in one audit entry.
+const maxAuditChanges = 50
+
+// AuditChanges summarizes the set commands a configuration change removes
+// and adds, with secret values redacted, for its audit entry.
+func AuditChanges(oldText, newText string) string {
+	changes := pkgconfig.ChangeSummary(oldText, newText, maxAuditChanges)
+	if len(changes) == 0 {
+		return "none"
+	}
+	return strings.Join(changes, "; ")
+}
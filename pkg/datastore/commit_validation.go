@@ -1,5 +1,10 @@
 package datastore
 
+import (
+	"fmt"
+	"sort"
+)
+
 func validateCommitRequest(req *CommitRequest) error {
 	if req == nil {
 		return NewError(ErrCodeValidation, "commit request is nil", nil)
@@ -13,3 +18,86 @@ func validateRollbackRequest(req *RollbackRequest) error {
 	}
 	return nil
 }
+
+func validatePendingConfirm(pending *PendingConfirm) error {
+	if pending == nil {
+		return NewError(ErrCodeValidation, "pending confirmed commit is nil", nil)
+	}
+	if pending.CommitID == "" {
+		return NewError(ErrCodeValidation, "pending confirmed commit requires a commit ID", nil)
+	}
+	if pending.RollbackCommitID == "" {
+		return NewError(ErrCodeValidation, "pending confirmed commit requires a rollback commit ID", nil)
+	}
+	if pending.ExpiresAt.IsZero() {
+		return NewError(ErrCodeValidation, "pending confirmed commit requires an expiry time", nil)
+	}
+	return nil
+}
+
+func validateScheduledCommit(scheduled *ScheduledCommit) error {
+	if scheduled == nil {
+		return NewError(ErrCodeValidation, "scheduled commit is nil", nil)
+	}
+	if scheduled.ConfigText == "" {
+		return NewError(ErrCodeValidation, "scheduled commit requires configuration text", nil)
+	}
+	if scheduled.ScheduledAt.IsZero() {
+		return NewError(ErrCodeValidation, "scheduled commit requires a commit time", nil)
+	}
+	return nil
+}
+
+// staleCandidateError reports a commit whose candidate was created from a
+// running configuration that has since been replaced by another commit.
+func staleCandidateError(baseCommitID, runningCommitID string) *Error {
+	return NewError(ErrCodeStaleCandidate,
+		fmt.Sprintf("cannot commit: candidate is based on running commit %s but running is now %s; "+
+			"discard the candidate and re-apply the changes on top of the current running configuration",
+			displayCommitID(baseCommitID), displayCommitID(runningCommitID)), nil)
+}
+
+func displayCommitID(commitID string) string {
+	if commitID == "" {
+		return "(none)"
+	}
+	return commitID
+}
+
+func validateApplyResult(result *ApplyResult) error {
+	if result == nil {
+		return NewError(ErrCodeValidation, "apply result is nil", nil)
+	}
+	if result.AppliedAt.IsZero() {
+		return NewError(ErrCodeValidation, "apply result requires an apply time", nil)
+	}
+	return nil
+}
+
+func validateAlarms(alarms []Alarm) error {
+	seen := make(map[[2]string]bool, len(alarms))
+	for _, alarm := range alarms {
+		if alarm.Type == "" || alarm.Object == "" {
+			return NewError(ErrCodeValidation, "alarm requires a type and an object", nil)
+		}
+		if alarm.RaisedAt.IsZero() {
+			return NewError(ErrCodeValidation, "alarm requires a raise time", nil)
+		}
+		key := [2]string{alarm.Type, alarm.Object}
+		if seen[key] {
+			return NewError(ErrCodeValidation, fmt.Sprintf("duplicate alarm %s on %s", alarm.Type, alarm.Object), nil)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// sortAlarms orders alarms by object and type.
+func sortAlarms(alarms []Alarm) {
+	sort.Slice(alarms, func(i, j int) bool {
+		if alarms[i].Object != alarms[j].Object {
+			return alarms[i].Object < alarms[j].Object
+		}
+		return alarms[i].Type < alarms[j].Type
+	})
+}
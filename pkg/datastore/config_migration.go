@@ -0,0 +1,15 @@
+package datastore
+
+import (
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
+)
+
+// migrateConfigText upgrades stored set-command text written with config
+// schema version to the current schema.
+func migrateConfigText(text string, version int) (string, error) {
+	migrated, err := pkgconfig.Migrate([]byte(text), version)
+	if err != nil {
+		return "", NewError(ErrCodeInternal, "failed to migrate stored config", err)
+	}
+	return string(migrated), nil
+}
@@ -0,0 +1,51 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+)
+
+func (ds *etcdDatastore) alarmsKey() string {
+	return ds.key("alarms", "active")
+}
+
+// ListAlarms returns the active system alarms ordered by object and type.
+func (ds *etcdDatastore) ListAlarms(ctx context.Context) ([]Alarm, error) {
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := ds.client.Get(ctx, ds.alarmsKey())
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to list alarms", err)
+	}
+	alarms := []Alarm{}
+	if len(resp.Kvs) == 0 {
+		return alarms, nil
+	}
+	if err := json.Unmarshal(resp.Kvs[0].Value, &alarms); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to unmarshal alarms", err)
+	}
+	sortAlarms(alarms)
+	return alarms, nil
+}
+
+// ReplaceAlarms replaces the active system alarms.
+func (ds *etcdDatastore) ReplaceAlarms(ctx context.Context, alarms []Alarm) error {
+	if err := validateAlarms(alarms); err != nil {
+		return err
+	}
+
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	stored := append([]Alarm{}, alarms...)
+	sortAlarms(stored)
+	storedJSON, err := json.Marshal(stored)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal alarms", err)
+	}
+	if _, err := ds.client.Put(ctx, ds.alarmsKey(), string(storedJSON)); err != nil {
+		return NewError(ErrCodeInternal, "failed to save alarms", err)
+	}
+	return nil
+}
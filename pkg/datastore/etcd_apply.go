@@ -0,0 +1,68 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// applyResultData is the JSON document stored under the last-apply key.
+type applyResultData struct {
+	AppliedAt time.Time           `json:"applied_at"`
+	Version   uint64              `json:"version"`
+	Error     string              `json:"error,omitempty"`
+	Plugins   []PluginApplyResult `json:"plugins"`
+}
+
+func (ds *etcdDatastore) lastApplyKey() string {
+	return ds.key("apply-result", "last")
+}
+
+// GetLastApply returns the outcome of the last configuration apply.
+func (ds *etcdDatastore) GetLastApply(ctx context.Context) (*ApplyResult, error) {
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := ds.client.Get(ctx, ds.lastApplyKey())
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to get last apply result", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, NewError(ErrCodeNotFound, "no apply result recorded", nil)
+	}
+
+	var stored applyResultData
+	if err := json.Unmarshal(resp.Kvs[0].Value, &stored); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to unmarshal last apply result", err)
+	}
+	return &ApplyResult{
+		AppliedAt: stored.AppliedAt,
+		Version:   stored.Version,
+		Error:     stored.Error,
+		Plugins:   stored.Plugins,
+	}, nil
+}
+
+// SaveLastApply records the outcome of the last configuration apply.
+func (ds *etcdDatastore) SaveLastApply(ctx context.Context, result *ApplyResult) error {
+	if err := validateApplyResult(result); err != nil {
+		return err
+	}
+
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	storedJSON, err := json.Marshal(applyResultData{
+		AppliedAt: result.AppliedAt,
+		Version:   result.Version,
+		Error:     result.Error,
+		Plugins:   applyPluginResults(result.Plugins),
+	})
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal last apply result", err)
+	}
+	if _, err := ds.client.Put(ctx, ds.lastApplyKey(), string(storedJSON)); err != nil {
+		return NewError(ErrCodeInternal, "failed to save last apply result", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"time"
 
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
 	"github.com/google/uuid"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -16,13 +17,15 @@ import (
 
 // commitEntry represents a commit stored in etcd.
 type commitEntry struct {
-	CommitID   string    `json:"commit_id"`
-	User       string    `json:"user"`
-	Timestamp  time.Time `json:"timestamp"`
-	Message    string    `json:"message"`
-	ConfigText string    `json:"config_text"`
-	IsRollback bool      `json:"is_rollback"`
-	SourceIP   string    `json:"source_ip"`
+	CommitID      string    `json:"commit_id"`
+	User          string    `json:"user"`
+	Timestamp     time.Time `json:"timestamp"`
+	Message       string    `json:"message"`
+	ConfigText    string    `json:"config_text"`
+	IsRollback    bool      `json:"is_rollback"`
+	Source        string    `json:"source,omitempty"`
+	SourceIP      string    `json:"source_ip"`
+	SchemaVersion int       `json:"schema_version,omitempty"`
 }
 
 const commitHistoryIndexPageSize = 1000
@@ -59,23 +62,48 @@ func (ds *etcdDatastore) Commit(ctx context.Context, req *CommitRequest) (string
 	candidateValue := string(getCandidateResp.Kvs[0].Value)
 	candidateModRevision := getCandidateResp.Kvs[0].ModRevision
 
-	// Parse candidate to get config text
-	var candidateData struct {
-		ConfigText string `json:"config_text"`
-	}
+	// Parse candidate to get config text and base commit
+	var candidateData etcdCandidate
 	if err := json.Unmarshal(getCandidateResp.Kvs[0].Value, &candidateData); err != nil {
 		return "", NewError(ErrCodeInternal, "failed to parse candidate config", err)
 	}
+	// The candidate is stored as the current schema version below.
+	candidateData.ConfigText, err = migrateConfigText(candidateData.ConfigText, etcdSchemaVersion(candidateData.SchemaVersion))
+	if err != nil {
+		return "", err
+	}
+
+	// Reject the commit if another commit replaced running after this
+	// candidate was created (optimistic concurrency check). The running
+	// metadata revision is also pinned in the transaction below.
+	runningCommitID, runningModRevision, err := ds.currentRunningCommit(ctx)
+	if err != nil {
+		return "", err
+	}
+	candidate := &CandidateConfig{BaseCommitID: candidateData.BaseCommitID, BaseTracked: candidateData.BaseTracked}
+	if candidate.IsStale(runningCommitID) {
+		return "", staleCandidateError(candidate.BaseCommitID, runningCommitID)
+	}
+	runningText, err := ds.runningConfigText(ctx)
+	if err != nil {
+		return "", err
+	}
 
 	// Prepare commit entry
+	message := req.Message
+	if message == "" {
+		message = DefaultCommitMessage(req.Source, req.User)
+	}
 	entry := commitEntry{
-		CommitID:   commitID,
-		User:       req.User,
-		Timestamp:  now,
-		Message:    req.Message,
-		ConfigText: candidateData.ConfigText,
-		IsRollback: false,
-		SourceIP:   req.SourceIP,
+		CommitID:      commitID,
+		User:          req.User,
+		Timestamp:     now,
+		Message:       message,
+		ConfigText:    candidateData.ConfigText,
+		IsRollback:    false,
+		Source:        req.Source,
+		SourceIP:      req.SourceIP,
+		SchemaVersion: pkgconfig.SchemaVersion,
 	}
 
 	entryJSON, err := json.Marshal(entry)
@@ -85,8 +113,9 @@ func (ds *etcdDatastore) Commit(ctx context.Context, req *CommitRequest) (string
 
 	// Prepare running metadata
 	metadata := runningMetadata{
-		CommitID:  commitID,
-		Timestamp: now,
+		CommitID:      commitID,
+		Timestamp:     now,
+		SchemaVersion: pkgconfig.SchemaVersion,
 	}
 
 	metadataJSON, err := json.Marshal(metadata)
@@ -105,7 +134,8 @@ func (ds *etcdDatastore) Commit(ctx context.Context, req *CommitRequest) (string
 		CorrelationID: req.CorrelationID,
 		Action:        "commit",
 		Result:        "success",
-		Details:       fmt.Sprintf("commit_id=%s message=%q", commitID, req.Message),
+		Details: fmt.Sprintf("commit_id=%s message=%q changes=%q",
+			commitID, req.Message, AuditChanges(runningText, candidateData.ConfigText)),
 	}
 
 	auditJSON, err := json.Marshal(auditEvent)
@@ -169,8 +199,9 @@ func (ds *etcdDatastore) Commit(ctx context.Context, req *CommitRequest) (string
 	txnResp, err := ds.client.Txn(ctx).
 		If(
 			clientv3.Compare(clientv3.Value(lockKey), "=", lockValue),
-			clientv3.Compare(clientv3.Value(candidateKey), "=", candidateValue),             // Candidate unchanged
-			clientv3.Compare(clientv3.ModRevision(candidateKey), "=", candidateModRevision), // No concurrent modification
+			clientv3.Compare(clientv3.Value(candidateKey), "=", candidateValue),                 // Candidate unchanged
+			clientv3.Compare(clientv3.ModRevision(candidateKey), "=", candidateModRevision),     // No concurrent modification
+			clientv3.Compare(clientv3.ModRevision(runningMetadataKey), "=", runningModRevision), // Running unchanged since check
 		).
 		Then(
 			clientv3.OpPut(runningMetadataKey, string(metadataJSON)),
@@ -188,7 +219,7 @@ func (ds *etcdDatastore) Commit(ctx context.Context, req *CommitRequest) (string
 	}
 
 	if !txnResp.Succeeded {
-		return "", NewError(ErrCodeConflict, "commit failed: lock was lost or candidate was deleted", nil)
+		return "", NewError(ErrCodeConflict, "commit failed: lock was lost, candidate was deleted, or running changed concurrently", nil)
 	}
 
 	// Revoke lease
@@ -222,19 +253,26 @@ func (ds *etcdDatastore) Rollback(ctx context.Context, req *RollbackRequest) (st
 		return "", rollbackTargetCommitLookupError(err)
 	}
 
+	runningText, err := ds.runningConfigText(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	// Generate new commit ID for the rollback
 	newCommitID := uuid.New().String()
 	now := time.Now()
 
 	// Prepare rollback commit entry
 	entry := commitEntry{
-		CommitID:   newCommitID,
-		User:       req.User,
-		Timestamp:  now,
-		Message:    fmt.Sprintf("Rollback to commit %s: %s", req.CommitID, req.Message),
-		ConfigText: targetCommit.ConfigText,
-		IsRollback: true,
-		SourceIP:   req.SourceIP,
+		CommitID:      newCommitID,
+		User:          req.User,
+		Timestamp:     now,
+		Message:       fmt.Sprintf("Rollback to commit %s: %s", req.CommitID, req.Message),
+		ConfigText:    targetCommit.ConfigText,
+		IsRollback:    true,
+		Source:        req.Source,
+		SourceIP:      req.SourceIP,
+		SchemaVersion: pkgconfig.SchemaVersion,
 	}
 
 	entryJSON, err := json.Marshal(entry)
@@ -244,8 +282,9 @@ func (ds *etcdDatastore) Rollback(ctx context.Context, req *RollbackRequest) (st
 
 	// Prepare running metadata
 	metadata := runningMetadata{
-		CommitID:  newCommitID,
-		Timestamp: now,
+		CommitID:      newCommitID,
+		Timestamp:     now,
+		SchemaVersion: pkgconfig.SchemaVersion,
 	}
 
 	metadataJSON, err := json.Marshal(metadata)
@@ -264,7 +303,8 @@ func (ds *etcdDatastore) Rollback(ctx context.Context, req *RollbackRequest) (st
 		CorrelationID: req.CorrelationID,
 		Action:        "rollback",
 		Result:        "success",
-		Details:       fmt.Sprintf("rollback_commit_id=%s target_commit_id=%s message=%q", newCommitID, req.CommitID, req.Message),
+		Details: fmt.Sprintf("rollback_commit_id=%s target_commit_id=%s message=%q changes=%q",
+			newCommitID, req.CommitID, req.Message, AuditChanges(runningText, targetCommit.ConfigText)),
 	}
 
 	auditJSON, err := json.Marshal(auditEvent)
@@ -471,14 +511,19 @@ func commitHistoryEntryFromEtcdKV(kv *mvccpb.KeyValue, opts *HistoryOptions) (*C
 	if !opts.EndTime.IsZero() && entry.Timestamp.After(opts.EndTime) {
 		return nil, false
 	}
+	configText, err := migrateConfigText(entry.ConfigText, etcdSchemaVersion(entry.SchemaVersion))
+	if err != nil {
+		return nil, false
+	}
 
 	return &CommitHistoryEntry{
 		CommitID:   entry.CommitID,
 		User:       entry.User,
 		Timestamp:  entry.Timestamp,
 		Message:    entry.Message,
-		ConfigText: entry.ConfigText,
+		ConfigText: configText,
 		IsRollback: entry.IsRollback,
+		Source:     entry.Source,
 		SourceIP:   entry.SourceIP,
 	}, true
 }
@@ -569,14 +614,33 @@ func (ds *etcdDatastore) GetCommit(ctx context.Context, commitID string) (*Commi
 	if err := json.Unmarshal(resp.Kvs[0].Value, &entry); err != nil {
 		return nil, NewError(ErrCodeInternal, "failed to unmarshal commit entry", err)
 	}
+	configText, err := migrateConfigText(entry.ConfigText, etcdSchemaVersion(entry.SchemaVersion))
+	if err != nil {
+		return nil, err
+	}
 
 	return &CommitHistoryEntry{
 		CommitID:   entry.CommitID,
 		User:       entry.User,
 		Timestamp:  entry.Timestamp,
 		Message:    entry.Message,
-		ConfigText: entry.ConfigText,
+		ConfigText: configText,
 		IsRollback: entry.IsRollback,
+		Source:     entry.Source,
 		SourceIP:   entry.SourceIP,
 	}, nil
 }
+
+// runningConfigText returns the current running config text, or "" before
+// the first commit.
+func (ds *etcdDatastore) runningConfigText(ctx context.Context) (string, error) {
+	running, err := ds.GetRunning(ctx)
+	if err != nil {
+		var dsErr *Error
+		if errors.As(err, &dsErr) && dsErr.Code == ErrCodeNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return running.ConfigText, nil
+}
@@ -11,8 +11,18 @@ import (
 
 // runningMetadata stores metadata about the current running configuration.
 type runningMetadata struct {
-	CommitID  string    `json:"commit_id"`
-	Timestamp time.Time `json:"timestamp"`
+	CommitID      string    `json:"commit_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	SchemaVersion int       `json:"schema_version,omitempty"`
+}
+
+// etcdSchemaVersion returns the config schema version recorded in an etcd
+// entry. Entries written before schema versions were recorded omit it.
+func etcdSchemaVersion(version int) int {
+	if version == 0 {
+		return 1
+	}
+	return version
 }
 
 // GetRunning retrieves the current running configuration.
@@ -51,6 +61,10 @@ func (ds *etcdDatastore) GetRunning(ctx context.Context) (*RunningConfig, error)
 	if err != nil {
 		return nil, err
 	}
+	configText, err = migrateConfigText(configText, etcdSchemaVersion(metadata.SchemaVersion))
+	if err != nil {
+		return nil, err
+	}
 
 	return &RunningConfig{
 		CommitID:   metadata.CommitID,
@@ -91,25 +105,56 @@ func (ds *etcdDatastore) GetCandidate(ctx context.Context, sessionID string) (*C
 	kv := resp.Kvs[0]
 
 	// Parse the stored JSON
-	var stored struct {
-		SessionID  string    `json:"session_id"`
-		ConfigText string    `json:"config_text"`
-		CreatedAt  time.Time `json:"created_at"`
-		UpdatedAt  time.Time `json:"updated_at"`
-	}
-
+	var stored etcdCandidate
 	if err := json.Unmarshal(kv.Value, &stored); err != nil {
 		return nil, NewError(ErrCodeInternal, "failed to unmarshal candidate config", err)
 	}
 
+	configText, err := migrateConfigText(stored.ConfigText, etcdSchemaVersion(stored.SchemaVersion))
+	if err != nil {
+		return nil, err
+	}
+
 	return &CandidateConfig{
-		SessionID:  stored.SessionID,
-		ConfigText: stored.ConfigText,
-		CreatedAt:  stored.CreatedAt,
-		UpdatedAt:  stored.UpdatedAt,
+		SessionID:    stored.SessionID,
+		ConfigText:   configText,
+		CreatedAt:    stored.CreatedAt,
+		UpdatedAt:    stored.UpdatedAt,
+		BaseCommitID: stored.BaseCommitID,
+		BaseTracked:  stored.BaseTracked,
 	}, nil
 }
 
+// etcdCandidate is the stored form of a candidate configuration. Candidates
+// written by older releases decode with BaseTracked and SchemaVersion unset.
+type etcdCandidate struct {
+	SessionID     string    `json:"session_id"`
+	ConfigText    string    `json:"config_text"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	BaseCommitID  string    `json:"base_commit_id,omitempty"`
+	BaseTracked   bool      `json:"base_tracked,omitempty"`
+	SchemaVersion int       `json:"schema_version,omitempty"`
+}
+
+// currentRunningCommit returns the current running commit ID ("" when no
+// running configuration exists) and the metadata key's mod revision (0 when
+// the key does not exist).
+func (ds *etcdDatastore) currentRunningCommit(ctx context.Context) (string, int64, error) {
+	resp, err := ds.client.Get(ctx, ds.key("running", "current"))
+	if err != nil {
+		return "", 0, NewError(ErrCodeInternal, "failed to get running metadata", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return "", 0, nil
+	}
+	var metadata runningMetadata
+	if err := json.Unmarshal(resp.Kvs[0].Value, &metadata); err != nil {
+		return "", 0, NewError(ErrCodeInternal, "failed to unmarshal running metadata", err)
+	}
+	return metadata.CommitID, resp.Kvs[0].ModRevision, nil
+}
+
 // SaveCandidate saves or updates a session's candidate configuration.
 func (ds *etcdDatastore) SaveCandidate(ctx context.Context, sessionID string, configText string) error {
 	protectedText, err := pkgconfig.ProtectSecretsInSetCommands(configText)
@@ -130,35 +175,30 @@ func (ds *etcdDatastore) SaveCandidate(ctx context.Context, sessionID string, co
 	}
 
 	now := time.Now()
-	var createdAt time.Time
+	candidate := etcdCandidate{
+		SessionID:     sessionID,
+		ConfigText:    configText,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		SchemaVersion: pkgconfig.SchemaVersion,
+	}
 
 	if len(existing.Kvs) > 0 {
-		// Update existing candidate - preserve creation time
-		var stored struct {
-			CreatedAt time.Time `json:"created_at"`
-		}
-		if err := json.Unmarshal(existing.Kvs[0].Value, &stored); err != nil {
-			// If unmarshal fails, use current time as fallback
-			createdAt = now
-		} else {
-			createdAt = stored.CreatedAt
+		// Update existing candidate - preserve creation time and base commit
+		var stored etcdCandidate
+		if err := json.Unmarshal(existing.Kvs[0].Value, &stored); err == nil {
+			candidate.CreatedAt = stored.CreatedAt
+			candidate.BaseCommitID = stored.BaseCommitID
+			candidate.BaseTracked = stored.BaseTracked
 		}
 	} else {
-		// New candidate
-		createdAt = now
-	}
-
-	// Prepare candidate data
-	candidate := struct {
-		SessionID  string    `json:"session_id"`
-		ConfigText string    `json:"config_text"`
-		CreatedAt  time.Time `json:"created_at"`
-		UpdatedAt  time.Time `json:"updated_at"`
-	}{
-		SessionID:  sessionID,
-		ConfigText: configText,
-		CreatedAt:  createdAt,
-		UpdatedAt:  now,
+		// New candidate - record the running commit it is based on
+		baseCommitID, _, err := ds.currentRunningCommit(ctx)
+		if err != nil {
+			return err
+		}
+		candidate.BaseCommitID = baseCommitID
+		candidate.BaseTracked = true
 	}
 
 	data, err := json.Marshal(candidate)
@@ -0,0 +1,119 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// pendingConfirmData is the JSON document stored under the pending-confirm key.
+type pendingConfirmData struct {
+	CommitID         string    `json:"commit_id"`
+	RollbackCommitID string    `json:"rollback_commit_id"`
+	SessionID        string    `json:"session_id"`
+	User             string    `json:"user"`
+	PersistID        string    `json:"persist_id,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+func (ds *etcdDatastore) pendingConfirmKey() string {
+	return ds.key("confirmed-commit", "pending")
+}
+
+// GetPendingConfirm returns the confirmed commit awaiting confirmation.
+func (ds *etcdDatastore) GetPendingConfirm(ctx context.Context) (*PendingConfirm, error) {
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := ds.client.Get(ctx, ds.pendingConfirmKey())
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to get pending confirmed commit", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, NewError(ErrCodeNotFound, "no confirmed commit pending", nil)
+	}
+
+	var stored pendingConfirmData
+	if err := json.Unmarshal(resp.Kvs[0].Value, &stored); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to unmarshal pending confirmed commit", err)
+	}
+	return &PendingConfirm{
+		CommitID:         stored.CommitID,
+		RollbackCommitID: stored.RollbackCommitID,
+		SessionID:        stored.SessionID,
+		User:             stored.User,
+		PersistID:        stored.PersistID,
+		CreatedAt:        stored.CreatedAt,
+		ExpiresAt:        stored.ExpiresAt,
+	}, nil
+}
+
+// SavePendingConfirm records or replaces the pending confirmed commit.
+func (ds *etcdDatastore) SavePendingConfirm(ctx context.Context, pending *PendingConfirm) error {
+	if err := validatePendingConfirm(pending); err != nil {
+		return err
+	}
+
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	now := time.Now()
+	createdAt := pending.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+	stored := pendingConfirmData{
+		CommitID:         pending.CommitID,
+		RollbackCommitID: pending.RollbackCommitID,
+		SessionID:        pending.SessionID,
+		User:             pending.User,
+		PersistID:        pending.PersistID,
+		CreatedAt:        createdAt,
+		ExpiresAt:        pending.ExpiresAt,
+	}
+	storedJSON, err := json.Marshal(stored)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal pending confirmed commit", err)
+	}
+
+	auditULID := generateULID()
+	auditJSON, err := json.Marshal(&AuditEvent{
+		Key:       auditULID,
+		Timestamp: now,
+		User:      pending.User,
+		SessionID: pending.SessionID,
+		Action:    "confirmed_commit",
+		Result:    "success",
+		Details: fmt.Sprintf("commit_id=%s rollback_commit_id=%s expires_at=%s persist=%t",
+			pending.CommitID, pending.RollbackCommitID, pending.ExpiresAt.UTC().Format(time.RFC3339), pending.PersistID != ""),
+	})
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal audit event", err)
+	}
+
+	_, err = ds.client.Txn(ctx).
+		Then(
+			clientv3.OpPut(ds.pendingConfirmKey(), string(storedJSON)),
+			clientv3.OpPut(ds.key("audit", auditULID), string(auditJSON)),
+		).
+		Commit()
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to save pending confirmed commit", err)
+	}
+	return nil
+}
+
+// ClearPendingConfirm removes the pending confirmed commit.
+func (ds *etcdDatastore) ClearPendingConfirm(ctx context.Context) error {
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := ds.client.Delete(ctx, ds.pendingConfirmKey()); err != nil {
+		return NewError(ErrCodeInternal, "failed to clear pending confirmed commit", err)
+	}
+	return nil
+}
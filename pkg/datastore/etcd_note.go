@@ -0,0 +1,51 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// AppendCommitNote appends note to the message of an archived commit. The
+// update is rejected with ErrCodeConflict when the entry changes between the
+// read and the write.
+func (ds *etcdDatastore) AppendCommitNote(ctx context.Context, commitID, note string) error {
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	commitKey := ds.key("commits", commitID)
+	resp, err := ds.client.Get(ctx, commitKey)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to get commit", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return NewError(ErrCodeNotFound, "commit not found", nil)
+	}
+
+	var entry commitEntry
+	if err := json.Unmarshal(resp.Kvs[0].Value, &entry); err != nil {
+		return NewError(ErrCodeInternal, "failed to unmarshal commit entry", err)
+	}
+	if entry.Message == "" {
+		entry.Message = note
+	} else {
+		entry.Message += "\n" + note
+	}
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal commit entry", err)
+	}
+
+	txnResp, err := ds.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(commitKey), "=", resp.Kvs[0].ModRevision)).
+		Then(clientv3.OpPut(commitKey, string(entryJSON))).
+		Commit()
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to append commit note", err)
+	}
+	if !txnResp.Succeeded {
+		return NewError(ErrCodeConflict, "commit entry changed while appending note", nil)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// scheduledCommitData is the JSON document stored under the scheduled-commit key.
+type scheduledCommitData struct {
+	ConfigText  string    `json:"config_text"`
+	SessionID   string    `json:"session_id"`
+	User        string    `json:"user"`
+	Message     string    `json:"message,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	BaseVersion uint64    `json:"base_version,omitempty"`
+}
+
+func (ds *etcdDatastore) scheduledCommitKey() string {
+	return ds.key("scheduled-commit", "pending")
+}
+
+// GetScheduledCommit returns the commit scheduled with "commit at".
+func (ds *etcdDatastore) GetScheduledCommit(ctx context.Context) (*ScheduledCommit, error) {
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := ds.client.Get(ctx, ds.scheduledCommitKey())
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to get scheduled commit", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, NewError(ErrCodeNotFound, "no commit scheduled", nil)
+	}
+
+	var stored scheduledCommitData
+	if err := json.Unmarshal(resp.Kvs[0].Value, &stored); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to unmarshal scheduled commit", err)
+	}
+	return &ScheduledCommit{
+		ConfigText:  stored.ConfigText,
+		SessionID:   stored.SessionID,
+		User:        stored.User,
+		Message:     stored.Message,
+		CreatedAt:   stored.CreatedAt,
+		ScheduledAt: stored.ScheduledAt,
+		BaseVersion: stored.BaseVersion,
+	}, nil
+}
+
+// SaveScheduledCommit records or replaces the scheduled commit.
+func (ds *etcdDatastore) SaveScheduledCommit(ctx context.Context, scheduled *ScheduledCommit) error {
+	if err := validateScheduledCommit(scheduled); err != nil {
+		return err
+	}
+
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	now := time.Now()
+	createdAt := scheduled.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+	stored := scheduledCommitData{
+		ConfigText:  scheduled.ConfigText,
+		SessionID:   scheduled.SessionID,
+		User:        scheduled.User,
+		Message:     scheduled.Message,
+		CreatedAt:   createdAt,
+		ScheduledAt: scheduled.ScheduledAt,
+		BaseVersion: scheduled.BaseVersion,
+	}
+	storedJSON, err := json.Marshal(stored)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal scheduled commit", err)
+	}
+
+	auditULID := generateULID()
+	auditJSON, err := json.Marshal(&AuditEvent{
+		Key:       auditULID,
+		Timestamp: now,
+		User:      scheduled.User,
+		SessionID: scheduled.SessionID,
+		Action:    "schedule_commit",
+		Result:    "success",
+		Details:   fmt.Sprintf("scheduled_at=%s", scheduled.ScheduledAt.UTC().Format(time.RFC3339)),
+	})
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal audit event", err)
+	}
+
+	_, err = ds.client.Txn(ctx).
+		Then(
+			clientv3.OpPut(ds.scheduledCommitKey(), string(storedJSON)),
+			clientv3.OpPut(ds.key("audit", auditULID), string(auditJSON)),
+		).
+		Commit()
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to save scheduled commit", err)
+	}
+	return nil
+}
+
+// ClearScheduledCommit removes the scheduled commit.
+func (ds *etcdDatastore) ClearScheduledCommit(ctx context.Context) error {
+	ctx, cancel := ds.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := ds.client.Delete(ctx, ds.scheduledCommitKey()); err != nil {
+		return NewError(ErrCodeInternal, "failed to clear scheduled commit", err)
+	}
+	return nil
+}
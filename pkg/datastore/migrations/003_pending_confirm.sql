@@ -0,0 +1,18 @@
+-- Migration 003: Persist pending confirmed-commit state
+-- Stores the RFC 6241 confirmed commit awaiting confirmation so that a
+-- <persist-id> confirming commit can arrive from another session or after a
+-- daemon restart. At most one confirmed commit may be pending at a time.
+
+CREATE TABLE IF NOT EXISTS pending_confirm (
+    slot INTEGER PRIMARY KEY CHECK (slot = 1),
+    commit_id TEXT NOT NULL,
+    rollback_commit_id TEXT NOT NULL,
+    session_id TEXT NOT NULL,
+    user TEXT NOT NULL,
+    persist_id TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    expires_at INTEGER NOT NULL
+);
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (3);
@@ -0,0 +1,20 @@
+-- Migration 004: Record the commit source in commit history
+-- Stores which northbound interface (cli or netconf) issued each commit so
+-- that history listings can show it. Existing rows keep an empty source.
+
+-- Partially initialized databases may predate commit_history; create it with
+-- the migration 001 definition before extending it.
+CREATE TABLE IF NOT EXISTS commit_history (
+    commit_id TEXT PRIMARY KEY,
+    user TEXT NOT NULL,
+    timestamp DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    message TEXT,
+    config_text TEXT NOT NULL,
+    is_rollback BOOLEAN NOT NULL DEFAULT 0,
+    source_ip TEXT
+);
+
+ALTER TABLE commit_history ADD COLUMN source TEXT NOT NULL DEFAULT '';
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (4);
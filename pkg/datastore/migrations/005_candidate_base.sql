@@ -0,0 +1,18 @@
+-- Migration 005: Record the running commit each candidate was created from
+-- Commit compares it with the current running commit so a candidate edited
+-- concurrently with another session's commit cannot silently overwrite that
+-- commit. Existing candidates keep a NULL base and are not checked.
+
+-- Partially initialized databases may predate candidate_configs; create it
+-- with the migration 001 definition before extending it.
+CREATE TABLE IF NOT EXISTS candidate_configs (
+    session_id TEXT PRIMARY KEY,
+    config_text TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE candidate_configs ADD COLUMN base_commit_id TEXT;
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (5);
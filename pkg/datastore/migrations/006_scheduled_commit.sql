@@ -0,0 +1,17 @@
+-- Migration 006: Persist commits scheduled with "commit at"
+-- Stores the candidate configuration and commit time so the daemon can
+-- perform the commit at the scheduled time, including after a restart.
+-- At most one commit may be scheduled at a time.
+
+CREATE TABLE IF NOT EXISTS scheduled_commit (
+    slot INTEGER PRIMARY KEY CHECK (slot = 1),
+    config_text TEXT NOT NULL,
+    session_id TEXT NOT NULL,
+    user TEXT NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    scheduled_at INTEGER NOT NULL
+);
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (6);
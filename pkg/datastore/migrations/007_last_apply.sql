@@ -0,0 +1,15 @@
+-- Migration 007: Persist the outcome of the last configuration apply
+-- Records when the daemon last programmed the data plane and the result of
+-- each southbound plugin, so a commit that was stored but only partially
+-- applied remains visible after a restart. Only the latest result is kept.
+
+CREATE TABLE IF NOT EXISTS last_apply (
+    slot INTEGER PRIMARY KEY CHECK (slot = 1),
+    applied_at INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    plugins TEXT NOT NULL DEFAULT '[]'
+);
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (7);
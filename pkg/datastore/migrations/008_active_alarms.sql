@@ -0,0 +1,15 @@
+-- Migration 008: Persist the active system alarms
+-- Keeps the alarms raised by the daemon's health evaluator, one row per
+-- alarmed object and alarm type, so an alarm raised before a restart stays
+-- visible with its original raise time until it clears.
+
+CREATE TABLE IF NOT EXISTS active_alarms (
+    alarm_type TEXT NOT NULL,
+    object TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    raised_at INTEGER NOT NULL,
+    PRIMARY KEY (alarm_type, object)
+);
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (8);
@@ -0,0 +1,22 @@
+-- Migration 009: Record the config schema version of stored configurations
+-- The running config and commit history keep the set-command schema version
+-- each configuration was written with, so configurations stored by an older
+-- release are upgraded with config.Migrate when they are loaded. Existing
+-- rows predate schema versions and are recorded as version 1.
+
+-- Partially initialized databases may predate running_config; create it with
+-- the migration 001 definition before extending it. commit_history is
+-- guaranteed by migration 004.
+CREATE TABLE IF NOT EXISTS running_config (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    commit_id TEXT NOT NULL UNIQUE,
+    config_text TEXT NOT NULL,
+    timestamp DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    is_current BOOLEAN NOT NULL DEFAULT 0
+);
+
+ALTER TABLE running_config ADD COLUMN schema_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE commit_history ADD COLUMN schema_version INTEGER NOT NULL DEFAULT 1;
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (9);
@@ -0,0 +1,19 @@
+-- Migration 010: Record the config schema version of candidate configurations
+-- Candidates carry the set-command schema version they were written with, like
+-- the running config and commit history, so a candidate saved by an older
+-- release is upgraded with config.Migrate when it is loaded or committed.
+-- Existing rows predate schema versions and are recorded as version 1.
+
+-- Partially initialized databases may predate candidate_configs; create it
+-- with the migration 001 definition before extending it.
+CREATE TABLE IF NOT EXISTS candidate_configs (
+    session_id TEXT PRIMARY KEY,
+    config_text TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE candidate_configs ADD COLUMN schema_version INTEGER NOT NULL DEFAULT 1;
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (10);
@@ -0,0 +1,22 @@
+-- Migration 011: Record the running version a scheduled commit was built on
+-- The scheduler compares it with the running version when the commit time
+-- arrives, so changes committed in the meantime (for example over NETCONF)
+-- are not silently reverted by the scheduled snapshot. Existing rows keep
+-- base version 0 and are not checked.
+
+-- Partially initialized databases may predate scheduled_commit; create it
+-- with the migration 006 definition before extending it.
+CREATE TABLE IF NOT EXISTS scheduled_commit (
+    slot INTEGER PRIMARY KEY CHECK (slot = 1),
+    config_text TEXT NOT NULL,
+    session_id TEXT NOT NULL,
+    user TEXT NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    scheduled_at INTEGER NOT NULL
+);
+
+ALTER TABLE scheduled_commit ADD COLUMN base_version INTEGER NOT NULL DEFAULT 0;
+
+-- Record this migration
+INSERT OR IGNORE INTO schema_version (version) VALUES (11);
@@ -67,23 +67,59 @@ type CandidateConfig struct {
 	ConfigText string    // Configuration in set-command format
 	CreatedAt  time.Time // When the candidate was created
 	UpdatedAt  time.Time // Last modification time
+
+	// BaseCommitID is the running commit the candidate was created from
+	// ("" when no running configuration existed). It is only meaningful when
+	// BaseTracked is set; candidates saved by older releases have no base.
+	BaseCommitID string
+	BaseTracked  bool
+}
+
+// IsStale reports whether the candidate was created from a running commit
+// other than runningCommitID. Untracked candidates are never stale.
+func (c *CandidateConfig) IsStale(runningCommitID string) bool {
+	return c != nil && c.BaseTracked && c.BaseCommitID != runningCommitID
 }
 
 // CommitRequest contains parameters for a commit operation.
 type CommitRequest struct {
 	SessionID     string // Session holding the candidate config
 	User          string // Username performing the commit
-	Message       string // Optional commit message
+	Message       string // Optional commit message (defaults to source and user)
+	Source        string // Northbound interface that issued the commit (CommitSource*)
 	SourceIP      string // Source IP address of the user (for audit)
 	CorrelationID string // Correlation ID for tracing related audit/log records
 }
 
+// Commit sources recorded in commit history.
+const (
+	CommitSourceCLI     = "cli"
+	CommitSourceNETCONF = "netconf"
+)
+
+// DefaultCommitMessage returns the history message recorded for a commit
+// issued without a comment.
+func DefaultCommitMessage(source, user string) string {
+	label := "commit"
+	switch source {
+	case CommitSourceCLI:
+		label = "CLI commit"
+	case CommitSourceNETCONF:
+		label = "NETCONF commit"
+	}
+	if user == "" {
+		return label
+	}
+	return label + " by " + user
+}
+
 // RollbackRequest contains parameters for a rollback operation.
 type RollbackRequest struct {
 	SessionID     string // Session holding the candidate config lock
 	CommitID      string // Target commit ID to rollback to
 	User          string // Username performing the rollback
 	Message       string // Optional rollback reason
+	Source        string // Northbound interface that issued the rollback (CommitSource*)
 	SourceIP      string // Source IP address of the user (for audit)
 	CorrelationID string // Correlation ID for tracing related audit/log records
 }
@@ -147,6 +183,7 @@ type CommitHistoryEntry struct {
 	Message    string    // Commit message (may be empty)
 	ConfigText string    // Configuration at this commit
 	IsRollback bool      // Whether this commit was a rollback operation
+	Source     string    // Northbound interface that issued the commit (empty for older entries)
 	SourceIP   string    // Source IP address of the user (for audit tracking)
 }
 
@@ -194,6 +231,152 @@ type EtcdStatus struct {
 	RunningTimestamp time.Time
 }
 
+// ConfirmedCommitStore persists the state of an RFC 6241 confirmed commit that
+// is waiting for its confirming commit. It is implemented by the SQLite and
+// etcd backends so a pending confirmation survives the originating session
+// and daemon restarts without extending the main Datastore interface.
+type ConfirmedCommitStore interface {
+	// GetPendingConfirm returns the pending confirmed commit, or an
+	// ErrCodeNotFound error when no confirmed commit is pending.
+	GetPendingConfirm(ctx context.Context) (*PendingConfirm, error)
+
+	// SavePendingConfirm records or replaces the pending confirmed commit.
+	SavePendingConfirm(ctx context.Context, pending *PendingConfirm) error
+
+	// ClearPendingConfirm removes the pending confirmed commit (idempotent).
+	ClearPendingConfirm(ctx context.Context) error
+}
+
+// PendingConfirm describes a confirmed commit awaiting confirmation.
+type PendingConfirm struct {
+	CommitID         string    // Commit applied by the confirmed commit
+	RollbackCommitID string    // Running commit restored if the commit is not confirmed
+	SessionID        string    // Session that issued the confirmed commit
+	User             string    // Username that issued the confirmed commit
+	PersistID        string    // RFC 6241 persist token (empty = bound to SessionID)
+	CreatedAt        time.Time // When the first confirmed commit was issued
+	ExpiresAt        time.Time // When the commit is rolled back unless confirmed
+}
+
+// ScheduledCommitStore persists a commit scheduled for a future time with
+// "commit at". It is implemented by the SQLite and etcd backends so the
+// scheduled configuration survives the originating session and daemon
+// restarts. At most one commit may be scheduled at a time.
+type ScheduledCommitStore interface {
+	// GetScheduledCommit returns the scheduled commit, or an ErrCodeNotFound
+	// error when no commit is scheduled.
+	GetScheduledCommit(ctx context.Context) (*ScheduledCommit, error)
+
+	// SaveScheduledCommit records or replaces the scheduled commit.
+	SaveScheduledCommit(ctx context.Context, scheduled *ScheduledCommit) error
+
+	// ClearScheduledCommit removes the scheduled commit (idempotent).
+	ClearScheduledCommit(ctx context.Context) error
+}
+
+// CommitNoteStore appends text to the message of an archived commit, so
+// results that are only known after the commit, such as the output of
+// post-commit scripts, stay on the commit record. It is implemented by the
+// SQLite and etcd backends without extending the main Datastore interface.
+type CommitNoteStore interface {
+	// AppendCommitNote appends note to the commit's message on a new line.
+	// It returns an ErrCodeNotFound error when the commit does not exist.
+	AppendCommitNote(ctx context.Context, commitID, note string) error
+}
+
+// ScheduledCommit describes a candidate configuration waiting to be committed
+// at ScheduledAt.
+type ScheduledCommit struct {
+	ConfigText  string    // Candidate configuration to commit (set-command format)
+	SessionID   string    // Session that scheduled the commit
+	User        string    // Username that scheduled the commit
+	Message     string    // Commit message
+	CreatedAt   time.Time // When the commit was scheduled
+	ScheduledAt time.Time // When the commit is performed
+	BaseVersion uint64    // Running version the candidate was built on (0 = unchecked)
+}
+
+// ApplyResultStore persists the outcome of the most recent configuration
+// apply to the data plane. It is implemented by the SQLite and etcd backends
+// so a commit that was stored but failed to program the data plane stays
+// visible after a daemon restart.
+type ApplyResultStore interface {
+	// GetLastApply returns the last apply result, or an ErrCodeNotFound
+	// error when none has been recorded.
+	GetLastApply(ctx context.Context) (*ApplyResult, error)
+
+	// SaveLastApply records result, replacing the previous one.
+	SaveLastApply(ctx context.Context, result *ApplyResult) error
+}
+
+// ApplyResult describes the outcome of a configuration apply.
+type ApplyResult struct {
+	AppliedAt time.Time           // When the apply finished
+	Version   uint64              // Running configuration version after the apply
+	Error     string              // Apply error (empty = success)
+	Plugins   []PluginApplyResult // Per-plugin outcome, in apply order
+}
+
+// PluginApplyResult is the outcome of an apply for one southbound plugin.
+type PluginApplyResult struct {
+	Plugin     string                 `json:"plugin"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error,omitempty"`
+	Operations []ApplyOperationResult `json:"operations,omitempty"` // Steps the plugin reported, in order
+}
+
+// ApplyOperationResult is the outcome of one step of a plugin apply.
+type ApplyOperationResult struct {
+	Operation string `json:"operation"`
+	Error     string `json:"error,omitempty"` // Empty when the step succeeded
+}
+
+// AlarmStore persists the active system alarms, so an alarm raised before a
+// daemon restart stays visible, with the time it was raised, until it
+// clears. It is implemented by the SQLite and etcd backends.
+type AlarmStore interface {
+	// ListAlarms returns the active alarms ordered by object and type.
+	ListAlarms(ctx context.Context) ([]Alarm, error)
+
+	// ReplaceAlarms replaces the active alarms with alarms.
+	ReplaceAlarms(ctx context.Context, alarms []Alarm) error
+}
+
+// Alarm is an active system alarm.
+type Alarm struct {
+	Type        string    `json:"type"`        // Alarm type (e.g. interface-link-down)
+	Object      string    `json:"object"`      // Alarmed object, such as an interface name
+	Description string    `json:"description"` // Human-readable alarm condition
+	RaisedAt    time.Time `json:"raised_at"`   // When the alarm was first raised
+}
+
+// IntegrityChecker checks a datastore for corruption and for records left
+// inconsistent by interrupted writes. It is implemented by the SQLite
+// backend.
+type IntegrityChecker interface {
+	// CheckIntegrity returns the problems found. With repair, orphaned
+	// candidates and expired locks are removed and reported as repaired;
+	// other problems are only reported.
+	CheckIntegrity(ctx context.Context, repair bool) ([]IntegrityIssue, error)
+}
+
+// Integrity checks reported in IntegrityIssue.Check.
+const (
+	IntegrityCheckStorage   = "storage"   // SQLite PRAGMA integrity_check
+	IntegrityCheckRunning   = "running"   // Running configuration and commit history
+	IntegrityCheckCandidate = "candidate" // Candidate base commits
+	IntegrityCheckLock      = "lock"      // Configuration locks
+	IntegrityCheckConfirm   = "confirm"   // Pending confirmed commit
+)
+
+// IntegrityIssue is one problem found by CheckIntegrity.
+type IntegrityIssue struct {
+	Check       string `json:"check"`              // Integrity check that found the issue
+	Object      string `json:"object,omitempty"`   // Affected record, such as a session or commit ID
+	Description string `json:"description"`        // Human-readable problem
+	Repaired    bool   `json:"repaired,omitempty"` // Whether repair removed the record
+}
+
 // MigrationManager handles database schema migrations.
 type MigrationManager interface {
 	// GetCurrentVersion returns the current schema version.
@@ -223,7 +406,8 @@ type Config struct {
 	Backend BackendType
 
 	// SQLite-specific configuration
-	SQLitePath string // Path to SQLite database file (default: /var/lib/arca-router/config.db)
+	SQLitePath    string        // Path to SQLite database file (default: /var/lib/arca-router/config.db)
+	SQLiteOptions SQLiteOptions // Busy timeout, synchronous mode (default: FULL), and WAL checkpoint policy
 
 	// etcd-specific configuration
 	EtcdEndpoints []string      // etcd cluster endpoints (e.g., ["localhost:2379"])
@@ -262,6 +446,10 @@ const (
 
 	// ErrCodeUnauthorized indicates insufficient permissions.
 	ErrCodeUnauthorized ErrorCode = "UNAUTHORIZED"
+
+	// ErrCodeStaleCandidate indicates a commit was rejected because another
+	// commit replaced the running configuration the candidate was based on.
+	ErrCodeStaleCandidate ErrorCode = "STALE_CANDIDATE"
 )
 
 // Error represents a datastore error with structured information.
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,6 +27,7 @@ type sqliteDatastore struct {
 	dbPath          string
 	cleanupStopChan chan struct{}
 	cleanupDoneChan chan struct{}
+	checkpointer    *SQLiteWALCheckpointer
 	closeOnce       sync.Once
 }
 
@@ -52,6 +54,10 @@ func NewSQLiteDatastore(cfg *Config) (Datastore, error) {
 	if err := validateSQLitePath(dbPath); err != nil {
 		return nil, err
 	}
+	sqliteOpts, err := cfg.SQLiteOptions.WithDefaults(DefaultSQLiteSynchronous)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sqlite options: %w", err)
+	}
 
 	// Create directory if it doesn't exist
 	if dbPath != ":memory:" {
@@ -64,7 +70,13 @@ func NewSQLiteDatastore(cfg *Config) (Datastore, error) {
 	// This ensures write transactions acquire RESERVED lock immediately, preventing lock upgrade races
 	// Read-only transactions (with ReadOnly: true in TxOptions) are unaffected and remain DEFERRED
 	// Note: With WAL mode, read/write locks are independent, so this has minimal impact on read concurrency
-	dsn := dbPath + "?_txlock=immediate"
+	// WAL, synchronous (FULL by default, preferring config durability over
+	// commit latency), foreign keys, and busy timeout are set in the DSN so
+	// every pooled connection gets them.
+	dsn, err := SQLiteDSN(dbPath, sqliteOpts, DefaultSQLiteSynchronous, url.Values{"_txlock": {"immediate"}})
+	if err != nil {
+		return nil, fmt.Errorf("invalid sqlite options: %w", err)
+	}
 	db, err := sql.Open("sqlite3", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
@@ -72,10 +84,6 @@ func NewSQLiteDatastore(cfg *Config) (Datastore, error) {
 
 	// Configure SQLite for production use
 	pragmas := []string{
-		"PRAGMA journal_mode=WAL",    // Write-Ahead Logging for better concurrency
-		"PRAGMA synchronous=FULL",    // Prefer config durability over commit latency
-		"PRAGMA foreign_keys=ON",     // Enable foreign key constraints
-		"PRAGMA busy_timeout=5000",   // Wait up to 5 seconds on lock contention
 		"PRAGMA cache_size=-64000",   // Use 64MB cache
 		"PRAGMA temp_store=MEMORY",   // Store temp tables in memory
 		"PRAGMA mmap_size=268435456", // Memory-map I/O (256MB)
@@ -120,6 +128,9 @@ func NewSQLiteDatastore(cfg *Config) (Datastore, error) {
 
 	// Start background cleanup goroutine for expired locks
 	go ds.cleanupExpiredLocks()
+	if dbPath != ":memory:" {
+		ds.checkpointer = StartSQLiteWALCheckpointer(db, sqliteOpts.WALCheckpointInterval)
+	}
 
 	return ds, nil
 }
@@ -361,6 +372,7 @@ func (ds *sqliteDatastore) Close() error {
 		case <-time.After(5 * time.Second):
 			// Timeout waiting for cleanup goroutine
 		}
+		ds.checkpointer.Stop()
 
 		if ds.db != nil {
 			closeErr = ds.db.Close()
@@ -431,16 +443,7 @@ func (ds *sqliteDatastore) performLockCleanup(ctx context.Context) error {
 		// Delete expired locks for all targets (candidate, running)
 		result, err := tx.ExecContext(ctx, `
 				DELETE FROM config_locks
-				WHERE COALESCE(
-					CASE
-						WHEN typeof(expires_at) IN ('integer', 'real') THEN CAST(expires_at AS INTEGER)
-					END,
-					CASE
-						WHEN typeof(expires_at) = 'text' AND expires_at NOT GLOB '*[^0-9]*' THEN CAST(expires_at AS INTEGER)
-					END,
-					CAST(strftime('%s', expires_at) AS INTEGER),
-					0
-				) < ?
+				WHERE `+sqliteLockExpiresAt+` < ?
 			`, now)
 
 		if err != nil {
@@ -0,0 +1,60 @@
+package datastore
+
+import "context"
+
+// ListAlarms returns the active system alarms ordered by object and type.
+func (ds *sqliteDatastore) ListAlarms(ctx context.Context) ([]Alarm, error) {
+	rows, err := ds.db.QueryContext(ctx, `
+		SELECT alarm_type, object, description, raised_at
+		FROM active_alarms
+		ORDER BY object, alarm_type
+	`)
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to list alarms", err)
+	}
+	defer rows.Close()
+
+	alarms := []Alarm{}
+	for rows.Next() {
+		var alarm Alarm
+		var raisedAt sqliteUnixTime
+		if err := rows.Scan(&alarm.Type, &alarm.Object, &alarm.Description, &raisedAt); err != nil {
+			return nil, NewError(ErrCodeInternal, "failed to scan alarm", err)
+		}
+		alarm.RaisedAt = raisedAt.Time()
+		alarms = append(alarms, alarm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to list alarms", err)
+	}
+	return alarms, nil
+}
+
+// ReplaceAlarms replaces the active system alarms.
+func (ds *sqliteDatastore) ReplaceAlarms(ctx context.Context, alarms []Alarm) error {
+	if err := validateAlarms(alarms); err != nil {
+		return err
+	}
+
+	tx, err := ds.db.BeginTx(ctx, nil)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to begin transaction", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM active_alarms`); err != nil {
+		return NewError(ErrCodeInternal, "failed to clear alarms", err)
+	}
+	for _, alarm := range alarms {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO active_alarms (alarm_type, object, description, raised_at)
+			VALUES (?, ?, ?, ?)
+		`, alarm.Type, alarm.Object, alarm.Description, alarm.RaisedAt.Unix()); err != nil {
+			return NewError(ErrCodeInternal, "failed to save alarm", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return NewError(ErrCodeInternal, "failed to commit alarms", err)
+	}
+	return nil
+}
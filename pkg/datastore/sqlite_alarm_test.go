@@ -0,0 +1,54 @@
+package datastore
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteAlarmsReplaceAndList(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	if alarms, err := ds.ListAlarms(ctx); err != nil || len(alarms) != 0 {
+		t.Fatalf("ListAlarms() = %#v, %v; want none", alarms, err)
+	}
+
+	raisedAt := time.Now().Truncate(time.Second)
+	active := []Alarm{
+		{Type: "interface-link-down", Object: "ge-0/0/1", Description: "link is down", RaisedAt: raisedAt},
+		{Type: "interface-error-rate", Object: "ge-0/0/0", Description: "errors exceed 10 per second", RaisedAt: raisedAt.Add(-time.Minute)},
+	}
+	if err := ds.ReplaceAlarms(ctx, active); err != nil {
+		t.Fatalf("ReplaceAlarms() error = %v", err)
+	}
+	got, err := ds.ListAlarms(ctx)
+	if err != nil {
+		t.Fatalf("ListAlarms() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Object != "ge-0/0/0" || !got[0].RaisedAt.Equal(raisedAt.Add(-time.Minute)) || !sameAlarm(got[1], active[0]) {
+		t.Fatalf("ListAlarms() = %#v, want both alarms ordered by object", got)
+	}
+
+	if err := ds.ReplaceAlarms(ctx, active[:1]); err != nil {
+		t.Fatalf("ReplaceAlarms() error = %v", err)
+	}
+	if got, err := ds.ListAlarms(ctx); err != nil || len(got) != 1 || !sameAlarm(got[0], active[0]) {
+		t.Fatalf("ListAlarms() after clearing = %#v, %v; want only the link-down alarm", got, err)
+	}
+
+	for _, bad := range [][]Alarm{
+		{{Object: "ge-0/0/0", RaisedAt: raisedAt}},
+		{{Type: "interface-link-down", Object: "ge-0/0/0"}},
+		{active[0], active[0]},
+	} {
+		if err := ds.ReplaceAlarms(ctx, bad); err == nil {
+			t.Errorf("ReplaceAlarms(%#v) error = nil, want validation error", bad)
+		}
+	}
+}
+
+func sameAlarm(a, b Alarm) bool {
+	return a.Type == b.Type && a.Object == b.Object && a.Description == b.Description && a.RaisedAt.Equal(b.RaisedAt)
+}
@@ -0,0 +1,61 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+)
+
+// GetLastApply returns the outcome of the last configuration apply.
+func (ds *sqliteDatastore) GetLastApply(ctx context.Context) (*ApplyResult, error) {
+	var result ApplyResult
+	var appliedAt sqliteUnixTime
+	var version int64
+	var plugins string
+	err := ds.db.QueryRowContext(ctx, `
+		SELECT applied_at, version, error, plugins
+		FROM last_apply
+		WHERE slot = 1
+	`).Scan(&appliedAt, &version, &result.Error, &plugins)
+	if err == sql.ErrNoRows {
+		return nil, NewError(ErrCodeNotFound, "no apply result recorded", nil)
+	}
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to get last apply result", err)
+	}
+	if err := json.Unmarshal([]byte(plugins), &result.Plugins); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to unmarshal apply plugin results", err)
+	}
+	result.AppliedAt = appliedAt.Time()
+	result.Version = uint64(version)
+	return &result, nil
+}
+
+// SaveLastApply records the outcome of the last configuration apply.
+func (ds *sqliteDatastore) SaveLastApply(ctx context.Context, result *ApplyResult) error {
+	if err := validateApplyResult(result); err != nil {
+		return err
+	}
+	plugins, err := json.Marshal(applyPluginResults(result.Plugins))
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to marshal apply plugin results", err)
+	}
+
+	_, err = ds.db.ExecContext(ctx, `
+		INSERT OR REPLACE INTO last_apply (slot, applied_at, version, error, plugins)
+		VALUES (1, ?, ?, ?, ?)
+	`, result.AppliedAt.Unix(), int64(result.Version), result.Error, string(plugins))
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to save last apply result", err)
+	}
+	return nil
+}
+
+// applyPluginResults returns plugins, or an empty slice so a result without
+// plugins is stored as an empty JSON array.
+func applyPluginResults(plugins []PluginApplyResult) []PluginApplyResult {
+	if plugins == nil {
+		return []PluginApplyResult{}
+	}
+	return plugins
+}
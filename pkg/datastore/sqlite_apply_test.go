@@ -0,0 +1,68 @@
+package datastore
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSQLiteLastApplyRoundTrip(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	if _, err := ds.GetLastApply(ctx); !isNotFoundError(err) {
+		t.Fatalf("GetLastApply() error = %v, want not found", err)
+	}
+	if err := ds.SaveLastApply(ctx, &ApplyResult{}); err == nil {
+		t.Fatal("SaveLastApply() without apply time error = nil, want validation error")
+	}
+
+	appliedAt := time.Now().Truncate(time.Second)
+	if err := ds.SaveLastApply(ctx, &ApplyResult{
+		AppliedAt: appliedAt,
+		Version:   3,
+		Error:     "plugin vpp apply failed (rollback succeeded): address rejected",
+		Plugins: []PluginApplyResult{
+			{Plugin: "vpp", Status: "failed", Error: "address rejected"},
+			{Plugin: "frr", Status: "not-applied"},
+		},
+	}); err != nil {
+		t.Fatalf("SaveLastApply() error = %v", err)
+	}
+	if err := ds.SaveLastApply(ctx, &ApplyResult{AppliedAt: appliedAt.Add(time.Second), Version: 4}); err != nil {
+		t.Fatalf("second SaveLastApply() error = %v", err)
+	}
+
+	got, err := ds.GetLastApply(ctx)
+	if err != nil {
+		t.Fatalf("GetLastApply() error = %v", err)
+	}
+	if !got.AppliedAt.Equal(appliedAt.Add(time.Second)) || got.Version != 4 || got.Error != "" || len(got.Plugins) != 0 {
+		t.Fatalf("GetLastApply() = %#v, want only the latest result", got)
+	}
+
+	if err := ds.SaveLastApply(ctx, &ApplyResult{
+		AppliedAt: appliedAt,
+		Version:   5,
+		Error:     "apply boom",
+		Plugins: []PluginApplyResult{{Plugin: "vpp", Status: "failed", Error: "apply boom", Operations: []ApplyOperationResult{
+			{Operation: "create interface ge-0/0/0"},
+			{Operation: "apply interface ge-0/0/0 addresses", Error: "apply boom"},
+		}}},
+	}); err != nil {
+		t.Fatalf("SaveLastApply() error = %v", err)
+	}
+	got, err = ds.GetLastApply(ctx)
+	if err != nil {
+		t.Fatalf("GetLastApply() error = %v", err)
+	}
+	want := PluginApplyResult{Plugin: "vpp", Status: "failed", Error: "apply boom", Operations: []ApplyOperationResult{
+		{Operation: "create interface ge-0/0/0"},
+		{Operation: "apply interface ge-0/0/0 addresses", Error: "apply boom"},
+	}}
+	if got.Version != 5 || got.Error != "apply boom" || len(got.Plugins) != 1 || !reflect.DeepEqual(got.Plugins[0], want) {
+		t.Fatalf("GetLastApply() = %#v, want failed vpp result", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
 	"github.com/google/uuid"
 )
 
@@ -50,18 +51,43 @@ func (ds *sqliteDatastore) Commit(ctx context.Context, req *CommitRequest) (stri
 		}
 
 		var candidate CandidateConfig
+		var baseCommitID sql.NullString
+		var schemaVersion int
 		err = tx.QueryRowContext(ctx, `
-				SELECT config_text, created_at, updated_at
+				SELECT config_text, created_at, updated_at, base_commit_id, schema_version
 				FROM candidate_configs
 				WHERE session_id = ?
-			`, req.SessionID).Scan(&candidate.ConfigText, &candidate.CreatedAt, &candidate.UpdatedAt)
+			`, req.SessionID).Scan(&candidate.ConfigText, &candidate.CreatedAt, &candidate.UpdatedAt, &baseCommitID, &schemaVersion)
 		if err == sql.ErrNoRows {
 			return NewError(ErrCodeNotFound, "no candidate configuration found for session", nil)
 		}
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to get candidate config", err)
 		}
+		// The candidate is stored as the current schema version below.
+		if candidate.ConfigText, err = migrateConfigText(candidate.ConfigText, schemaVersion); err != nil {
+			return err
+		}
 		candidate.SessionID = req.SessionID
+		candidate.BaseCommitID = baseCommitID.String
+		candidate.BaseTracked = baseCommitID.Valid
+
+		// Reject the commit if another commit replaced running after this
+		// candidate was created (optimistic concurrency check).
+		var runningCommitID string
+		err = tx.QueryRowContext(ctx, `
+				SELECT COALESCE((SELECT commit_id FROM running_config WHERE is_current = 1), '')
+			`).Scan(&runningCommitID)
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to get running commit", err)
+		}
+		if candidate.IsStale(runningCommitID) {
+			return staleCandidateError(candidate.BaseCommitID, runningCommitID)
+		}
+		runningText, err := sqliteRunningConfigText(ctx, tx)
+		if err != nil {
+			return err
+		}
 
 		// 1. Update all running_config rows to is_current = 0
 		_, err = tx.ExecContext(ctx, `
@@ -73,18 +99,22 @@ func (ds *sqliteDatastore) Commit(ctx context.Context, req *CommitRequest) (stri
 
 		// 2. Insert new running config with is_current = 1
 		_, err = tx.ExecContext(ctx, `
-			INSERT INTO running_config (commit_id, config_text, timestamp, is_current)
-			VALUES (?, ?, ?, 1)
-		`, commitID, candidate.ConfigText, now)
+			INSERT INTO running_config (commit_id, config_text, timestamp, is_current, schema_version)
+			VALUES (?, ?, ?, 1, ?)
+		`, commitID, candidate.ConfigText, now, pkgconfig.SchemaVersion)
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to insert new running config", err)
 		}
 
 		// 3. Insert commit history
+		message := req.Message
+		if message == "" {
+			message = DefaultCommitMessage(req.Source, req.User)
+		}
 		_, err = tx.ExecContext(ctx, `
-			INSERT INTO commit_history (commit_id, user, timestamp, message, config_text, is_rollback, source_ip)
-			VALUES (?, ?, ?, ?, ?, 0, ?)
-		`, commitID, req.User, now, req.Message, candidate.ConfigText, req.SourceIP)
+			INSERT INTO commit_history (commit_id, user, timestamp, message, config_text, is_rollback, source, source_ip, schema_version)
+			VALUES (?, ?, ?, ?, ?, 0, ?, ?, ?)
+		`, commitID, req.User, now, message, candidate.ConfigText, req.Source, req.SourceIP, pkgconfig.SchemaVersion)
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to insert commit history", err)
 		}
@@ -110,7 +140,8 @@ func (ds *sqliteDatastore) Commit(ctx context.Context, req *CommitRequest) (stri
 		_, err = tx.ExecContext(ctx, `
 			INSERT INTO audit_log (user, session_id, source_ip, correlation_id, action, result, details)
 			VALUES (?, ?, ?, ?, 'commit', 'success', ?)
-		`, req.User, req.SessionID, req.SourceIP, req.CorrelationID, fmt.Sprintf("commit_id: %s", commitID))
+		`, req.User, req.SessionID, req.SourceIP, req.CorrelationID,
+			fmt.Sprintf("commit_id: %s, changes: %s", commitID, AuditChanges(runningText, candidate.ConfigText)))
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to log audit event", err)
 		}
@@ -171,6 +202,11 @@ func (ds *sqliteDatastore) Rollback(ctx context.Context, req *RollbackRequest) (
 				fmt.Sprintf("cannot rollback: config lock is held by another session (%s)", lockSessionID), nil)
 		}
 
+		runningText, err := sqliteRunningConfigText(ctx, tx)
+		if err != nil {
+			return err
+		}
+
 		// 1. Update all running_config rows to is_current = 0
 		_, err = tx.ExecContext(ctx, `
 			UPDATE running_config SET is_current = 0 WHERE is_current = 1
@@ -181,9 +217,9 @@ func (ds *sqliteDatastore) Rollback(ctx context.Context, req *RollbackRequest) (
 
 		// 2. Insert new running config with target commit's config_text
 		_, err = tx.ExecContext(ctx, `
-			INSERT INTO running_config (commit_id, config_text, timestamp, is_current)
-			VALUES (?, ?, ?, 1)
-		`, newCommitID, targetCommit.ConfigText, now)
+			INSERT INTO running_config (commit_id, config_text, timestamp, is_current, schema_version)
+			VALUES (?, ?, ?, 1, ?)
+		`, newCommitID, targetCommit.ConfigText, now, pkgconfig.SchemaVersion)
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to insert rollback running config", err)
 		}
@@ -195,9 +231,9 @@ func (ds *sqliteDatastore) Rollback(ctx context.Context, req *RollbackRequest) (
 		}
 
 		_, err = tx.ExecContext(ctx, `
-			INSERT INTO commit_history (commit_id, user, timestamp, message, config_text, is_rollback, source_ip)
-			VALUES (?, ?, ?, ?, ?, 1, ?)
-		`, newCommitID, req.User, now, message, targetCommit.ConfigText, req.SourceIP)
+			INSERT INTO commit_history (commit_id, user, timestamp, message, config_text, is_rollback, source, source_ip, schema_version)
+			VALUES (?, ?, ?, ?, ?, 1, ?, ?, ?)
+		`, newCommitID, req.User, now, message, targetCommit.ConfigText, req.Source, req.SourceIP, pkgconfig.SchemaVersion)
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to insert rollback history", err)
 		}
@@ -214,7 +250,8 @@ func (ds *sqliteDatastore) Rollback(ctx context.Context, req *RollbackRequest) (
 		_, err = tx.ExecContext(ctx, `
 			INSERT INTO audit_log (user, session_id, source_ip, correlation_id, action, result, details)
 			VALUES (?, ?, ?, ?, 'rollback', 'success', ?)
-		`, req.User, req.SessionID, req.SourceIP, req.CorrelationID, fmt.Sprintf("new_commit_id: %s, target_commit_id: %s", newCommitID, req.CommitID))
+		`, req.User, req.SessionID, req.SourceIP, req.CorrelationID, fmt.Sprintf("new_commit_id: %s, target_commit_id: %s, changes: %s",
+			newCommitID, req.CommitID, AuditChanges(runningText, targetCommit.ConfigText)))
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to log audit event", err)
 		}
@@ -246,7 +283,7 @@ func (ds *sqliteDatastore) ListCommitHistory(ctx context.Context, opts *HistoryO
 
 	// Build query with filters
 	query := `
-		SELECT commit_id, user, timestamp, message, config_text, is_rollback, source_ip
+		SELECT commit_id, user, timestamp, message, config_text, is_rollback, source, source_ip, schema_version
 		FROM commit_history
 		WHERE 1=1
 	`
@@ -300,6 +337,7 @@ func (ds *sqliteDatastore) ListCommitHistory(ctx context.Context, opts *HistoryO
 		var entry CommitHistoryEntry
 		var message sql.NullString
 		var sourceIP sql.NullString
+		var schemaVersion int
 
 		err := rows.Scan(
 			&entry.CommitID,
@@ -308,11 +346,17 @@ func (ds *sqliteDatastore) ListCommitHistory(ctx context.Context, opts *HistoryO
 			&message,
 			&entry.ConfigText,
 			&entry.IsRollback,
+			&entry.Source,
 			&sourceIP,
+			&schemaVersion,
 		)
 		if err != nil {
 			return nil, NewError(ErrCodeInternal, "failed to scan commit history row", err)
 		}
+		entry.ConfigText, err = migrateConfigText(entry.ConfigText, schemaVersion)
+		if err != nil {
+			return nil, err
+		}
 
 		if message.Valid {
 			entry.Message = message.String
@@ -336,9 +380,10 @@ func (ds *sqliteDatastore) GetCommit(ctx context.Context, commitID string) (*Com
 	var entry CommitHistoryEntry
 	var message sql.NullString
 	var sourceIP sql.NullString
+	var schemaVersion int
 
 	err := ds.db.QueryRowContext(ctx, `
-		SELECT commit_id, user, timestamp, message, config_text, is_rollback, source_ip
+		SELECT commit_id, user, timestamp, message, config_text, is_rollback, source, source_ip, schema_version
 		FROM commit_history
 		WHERE commit_id = ?
 	`, commitID).Scan(
@@ -348,7 +393,9 @@ func (ds *sqliteDatastore) GetCommit(ctx context.Context, commitID string) (*Com
 		&message,
 		&entry.ConfigText,
 		&entry.IsRollback,
+		&entry.Source,
 		&sourceIP,
+		&schemaVersion,
 	)
 
 	if err == sql.ErrNoRows {
@@ -357,6 +404,10 @@ func (ds *sqliteDatastore) GetCommit(ctx context.Context, commitID string) (*Com
 	if err != nil {
 		return nil, NewError(ErrCodeInternal, "failed to get commit", err)
 	}
+	entry.ConfigText, err = migrateConfigText(entry.ConfigText, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	if message.Valid {
 		entry.Message = message.String
@@ -367,3 +418,20 @@ func (ds *sqliteDatastore) GetCommit(ctx context.Context, commitID string) (*Com
 
 	return &entry, nil
 }
+
+// sqliteRunningConfigText returns the current running config text within tx,
+// or "" before the first commit.
+func sqliteRunningConfigText(ctx context.Context, tx *sql.Tx) (string, error) {
+	var configText string
+	var schemaVersion int
+	err := tx.QueryRowContext(ctx, `
+		SELECT config_text, schema_version FROM running_config WHERE is_current = 1
+	`).Scan(&configText, &schemaVersion)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", NewError(ErrCodeInternal, "failed to get running config", err)
+	}
+	return migrateConfigText(configText, schemaVersion)
+}
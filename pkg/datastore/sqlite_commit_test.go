@@ -2,11 +2,14 @@ package datastore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
+
+	pkgconfig "github.com/akam1o/arca-router/pkg/config"
 )
 
 func TestListCommitHistoryAllowsOffsetWithoutLimit(t *testing.T) {
@@ -69,6 +72,37 @@ func TestListCommitHistoryCapsOversizedLimit(t *testing.T) {
 	}
 }
 
+func TestListCommitHistoryPagesWithLimitAndOffset(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	insertCommitHistoryRows(t, ds, 45)
+	ctx := context.Background()
+
+	var pages [][]string
+	for offset := 0; ; offset += 20 {
+		history, err := ds.ListCommitHistory(ctx, &HistoryOptions{Limit: 20, Offset: offset})
+		if err != nil {
+			t.Fatalf("ListCommitHistory(offset %d) error = %v", offset, err)
+		}
+		if len(history) == 0 {
+			break
+		}
+		var ids []string
+		for _, entry := range history {
+			ids = append(ids, entry.CommitID)
+		}
+		pages = append(pages, ids)
+	}
+
+	if len(pages) != 3 || len(pages[0]) != 20 || len(pages[1]) != 20 || len(pages[2]) != 5 {
+		t.Fatalf("page sizes = %v, want 20, 20, 5", pages)
+	}
+	if pages[0][0] != "commit-0044" || pages[0][19] != "commit-0025" ||
+		pages[1][0] != "commit-0024" || pages[1][19] != "commit-0005" ||
+		pages[2][0] != "commit-0004" || pages[2][4] != "commit-0000" {
+		t.Fatalf("pages = %v, want consecutive newest-first pages", pages)
+	}
+}
+
 func TestSQLiteCountCommitHistory(t *testing.T) {
 	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
 	insertCommitHistoryRows(t, ds, 3)
@@ -82,6 +116,188 @@ func TestSQLiteCountCommitHistory(t *testing.T) {
 	}
 }
 
+func TestSQLiteCommitRecordsMessageAndSource(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	commit := func(sessionID, configText string, req CommitRequest) string {
+		t.Helper()
+		if err := ds.AcquireLock(ctx, &LockRequest{
+			Target:    LockTargetCandidate,
+			SessionID: sessionID,
+			User:      req.User,
+		}); err != nil {
+			t.Fatalf("AcquireLock() error = %v", err)
+		}
+		if err := ds.SaveCandidate(ctx, sessionID, configText); err != nil {
+			t.Fatalf("SaveCandidate() error = %v", err)
+		}
+		req.SessionID = sessionID
+		commitID, err := ds.Commit(ctx, &req)
+		if err != nil {
+			t.Fatalf("Commit() error = %v", err)
+		}
+		return commitID
+	}
+
+	commentedID := commit("session-1", "set system host-name router1\n", CommitRequest{
+		User:    "alice",
+		Message: "change host name",
+		Source:  CommitSourceCLI,
+	})
+	defaultID := commit("session-2", "set system host-name router2\n", CommitRequest{
+		User:   "bob",
+		Source: CommitSourceNETCONF,
+	})
+
+	entry, err := ds.GetCommit(ctx, commentedID)
+	if err != nil {
+		t.Fatalf("GetCommit() error = %v", err)
+	}
+	if entry.Message != "change host name" || entry.Source != CommitSourceCLI || entry.User != "alice" {
+		t.Fatalf("commented commit = %+v, want message/source/user to round-trip", entry)
+	}
+
+	history, err := ds.ListCommitHistory(ctx, &HistoryOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListCommitHistory() error = %v", err)
+	}
+	got := map[string]*CommitHistoryEntry{}
+	for _, h := range history {
+		got[h.CommitID] = h
+	}
+	if h := got[commentedID]; h == nil || h.Message != "change host name" || h.Source != CommitSourceCLI {
+		t.Fatalf("history entry %s = %+v, want commented CLI commit", commentedID, h)
+	}
+	if h := got[defaultID]; h == nil || h.Message != "NETCONF commit by bob" || h.Source != CommitSourceNETCONF {
+		t.Fatalf("history entry %s = %+v, want default NETCONF message", defaultID, h)
+	}
+}
+
+func TestSQLiteAppendCommitNote(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	if err := ds.AcquireLock(ctx, &LockRequest{Target: LockTargetCandidate, SessionID: "s1", User: "alice"}); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := ds.SaveCandidate(ctx, "s1", "set system host-name router1\n"); err != nil {
+		t.Fatalf("SaveCandidate() error = %v", err)
+	}
+	commitID, err := ds.Commit(ctx, &CommitRequest{SessionID: "s1", User: "alice", Message: "change host name"})
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	note := "post-commit script /etc/arca-router/scripts/notify: exit status 1\nunreachable"
+	if err := ds.AppendCommitNote(ctx, commitID, note); err != nil {
+		t.Fatalf("AppendCommitNote() error = %v", err)
+	}
+	entry, err := ds.GetCommit(ctx, commitID)
+	if err != nil {
+		t.Fatalf("GetCommit() error = %v", err)
+	}
+	if want := "change host name\n" + note; entry.Message != want {
+		t.Fatalf("Message = %q, want %q", entry.Message, want)
+	}
+
+	if err := ds.AppendCommitNote(ctx, "missing", note); !isNotFoundError(err) {
+		t.Fatalf("AppendCommitNote(missing) error = %v, want not found", err)
+	}
+}
+
+func TestSQLiteCommitRejectsCandidateWithStaleBase(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	lockAndCommit := func(sessionID, user string) (string, error) {
+		t.Helper()
+		if err := ds.AcquireLock(ctx, &LockRequest{Target: LockTargetCandidate, SessionID: sessionID, User: user}); err != nil {
+			t.Fatalf("AcquireLock(%s) error = %v", sessionID, err)
+		}
+		return ds.Commit(ctx, &CommitRequest{SessionID: sessionID, User: user})
+	}
+
+	// Both sessions start editing from an empty running configuration.
+	if err := ds.SaveCandidate(ctx, "session-1", "set system host-name router1\n"); err != nil {
+		t.Fatalf("SaveCandidate(session-1) error = %v", err)
+	}
+	if err := ds.SaveCandidate(ctx, "session-2", "set system host-name router2\n"); err != nil {
+		t.Fatalf("SaveCandidate(session-2) error = %v", err)
+	}
+	candidate, err := ds.GetCandidate(ctx, "session-2")
+	if err != nil {
+		t.Fatalf("GetCandidate(session-2) error = %v", err)
+	}
+	if !candidate.BaseTracked || candidate.BaseCommitID != "" {
+		t.Fatalf("candidate base = %q (tracked %v), want tracked empty base", candidate.BaseCommitID, candidate.BaseTracked)
+	}
+
+	firstID, err := lockAndCommit("session-1", "alice")
+	if err != nil {
+		t.Fatalf("first Commit() error = %v", err)
+	}
+
+	// The second session's candidate predates the first commit.
+	if _, err := lockAndCommit("session-2", "bob"); err == nil {
+		t.Fatal("second Commit() error = nil, want stale candidate conflict")
+	} else {
+		var dsErr *Error
+		if !errors.As(err, &dsErr) || dsErr.Code != ErrCodeStaleCandidate {
+			t.Fatalf("second Commit() error = %v, want %s", err, ErrCodeStaleCandidate)
+		}
+		if !strings.Contains(err.Error(), firstID) {
+			t.Fatalf("second Commit() error = %v, want it to name running commit %s", err, firstID)
+		}
+	}
+	running, err := ds.GetRunning(ctx)
+	if err != nil {
+		t.Fatalf("GetRunning() error = %v", err)
+	}
+	if running.CommitID != firstID || !strings.Contains(running.ConfigText, "router1") {
+		t.Fatalf("running = %s %q, want first commit preserved", running.CommitID, running.ConfigText)
+	}
+
+	// Rebasing (discarding and re-creating the candidate) allows the commit.
+	if err := ds.DeleteCandidate(ctx, "session-2"); err != nil {
+		t.Fatalf("DeleteCandidate(session-2) error = %v", err)
+	}
+	if err := ds.SaveCandidate(ctx, "session-2", "set system host-name router2\n"); err != nil {
+		t.Fatalf("SaveCandidate(session-2) after rebase error = %v", err)
+	}
+	candidate, err = ds.GetCandidate(ctx, "session-2")
+	if err != nil {
+		t.Fatalf("GetCandidate(session-2) error = %v", err)
+	}
+	if candidate.BaseCommitID != firstID {
+		t.Fatalf("rebased candidate base = %q, want %q", candidate.BaseCommitID, firstID)
+	}
+	if _, err := ds.Commit(ctx, &CommitRequest{SessionID: "session-2", User: "bob"}); err != nil {
+		t.Fatalf("Commit() after rebase error = %v", err)
+	}
+}
+
+func TestSQLiteCommitSkipsBaseCheckForUntrackedCandidate(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	mustExec(t, ds.db, `
+		INSERT INTO running_config (commit_id, config_text, timestamp, is_current)
+		VALUES ('commit-1', 'set system host-name router1', CURRENT_TIMESTAMP, 1)
+	`)
+	// Candidates saved before base tracking have a NULL base.
+	mustExec(t, ds.db, `
+		INSERT INTO candidate_configs (session_id, config_text, created_at, updated_at)
+		VALUES ('legacy', 'set system host-name legacy', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`)
+	if err := ds.AcquireLock(ctx, &LockRequest{Target: LockTargetCandidate, SessionID: "legacy", User: "alice"}); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if _, err := ds.Commit(ctx, &CommitRequest{SessionID: "legacy", User: "alice"}); err != nil {
+		t.Fatalf("Commit() error = %v, want untracked candidate to commit", err)
+	}
+}
+
 func TestSQLiteCommitReadsCandidateInsideTransaction(t *testing.T) {
 	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
 	ctx := context.Background()
@@ -169,3 +385,101 @@ func insertCommitHistoryRows(t *testing.T, ds *sqliteDatastore, count int) {
 		)
 	}
 }
+
+func TestSQLiteMigratesStoredConfigSchemaOnLoad(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	// Rows written before schema versions were recorded default to version 1.
+	legacy := "set routing-options static route 203.0.113.0/24 next-hop 192.0.2.2 bfd multihop"
+	mustExec(t, ds.db, `
+		INSERT INTO running_config (commit_id, config_text, timestamp, is_current)
+		VALUES ('commit-1', '`+legacy+`', CURRENT_TIMESTAMP, 1)
+	`)
+	mustExec(t, ds.db, `
+		INSERT INTO commit_history (commit_id, user, timestamp, message, config_text, is_rollback, source_ip)
+		VALUES ('commit-1', 'alice', CURRENT_TIMESTAMP, 'legacy', '`+legacy+`', 0, '')
+	`)
+
+	want := "set routing-options static route 203.0.113.0/24 next-hop 192.0.2.2 bfd multi-hop"
+	running, err := ds.GetRunning(ctx)
+	if err != nil {
+		t.Fatalf("GetRunning() error = %v", err)
+	}
+	if running.ConfigText != want {
+		t.Fatalf("running config = %q, want %q", running.ConfigText, want)
+	}
+	commit, err := ds.GetCommit(ctx, "commit-1")
+	if err != nil {
+		t.Fatalf("GetCommit() error = %v", err)
+	}
+	if commit.ConfigText != want {
+		t.Fatalf("commit config = %q, want %q", commit.ConfigText, want)
+	}
+
+	if err := ds.AcquireLock(ctx, &LockRequest{Target: LockTargetCandidate, SessionID: "s1", User: "alice"}); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := ds.SaveCandidate(ctx, "s1", want); err != nil {
+		t.Fatalf("SaveCandidate() error = %v", err)
+	}
+	commitID, err := ds.Commit(ctx, &CommitRequest{SessionID: "s1", User: "alice"})
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	var version int
+	if err := ds.db.QueryRow(`SELECT schema_version FROM commit_history WHERE commit_id = ?`, commitID).Scan(&version); err != nil {
+		t.Fatalf("schema_version query failed: %v", err)
+	}
+	if version != pkgconfig.SchemaVersion {
+		t.Fatalf("stored schema_version = %d, want %d", version, pkgconfig.SchemaVersion)
+	}
+}
+
+func TestSQLiteMigratesCandidateSchemaOnLoadAndCommit(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	// A candidate saved before schema versions were recorded defaults to
+	// version 1.
+	legacy := "set routing-options static route 203.0.113.0/24 next-hop 192.0.2.2 bfd multihop"
+	mustExec(t, ds.db, `
+		INSERT INTO candidate_configs (session_id, config_text, created_at, updated_at, base_commit_id)
+		VALUES ('s1', '`+legacy+`', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, '')
+	`)
+
+	want := "set routing-options static route 203.0.113.0/24 next-hop 192.0.2.2 bfd multi-hop"
+	candidate, err := ds.GetCandidate(ctx, "s1")
+	if err != nil {
+		t.Fatalf("GetCandidate() error = %v", err)
+	}
+	if candidate.ConfigText != want {
+		t.Fatalf("candidate config = %q, want %q", candidate.ConfigText, want)
+	}
+
+	if err := ds.AcquireLock(ctx, &LockRequest{Target: LockTargetCandidate, SessionID: "s1", User: "alice"}); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	commitID, err := ds.Commit(ctx, &CommitRequest{SessionID: "s1", User: "alice"})
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	commit, err := ds.GetCommit(ctx, commitID)
+	if err != nil {
+		t.Fatalf("GetCommit() error = %v", err)
+	}
+	if commit.ConfigText != want {
+		t.Fatalf("committed config = %q, want the migrated candidate %q", commit.ConfigText, want)
+	}
+
+	if err := ds.SaveCandidate(ctx, "s2", want); err != nil {
+		t.Fatalf("SaveCandidate() error = %v", err)
+	}
+	var version int
+	if err := ds.db.QueryRow(`SELECT schema_version FROM candidate_configs WHERE session_id = 's2'`).Scan(&version); err != nil {
+		t.Fatalf("schema_version query failed: %v", err)
+	}
+	if version != pkgconfig.SchemaVersion {
+		t.Fatalf("stored candidate schema_version = %d, want %d", version, pkgconfig.SchemaVersion)
+	}
+}
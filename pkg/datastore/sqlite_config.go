@@ -12,12 +12,13 @@ import (
 func (ds *sqliteDatastore) GetRunning(ctx context.Context) (*RunningConfig, error) {
 	var commitID, configText string
 	var timestamp time.Time
+	var schemaVersion int
 
 	err := ds.db.QueryRowContext(ctx, `
-		SELECT commit_id, config_text, timestamp
+		SELECT commit_id, config_text, timestamp, schema_version
 		FROM running_config
 		WHERE is_current = 1
-	`).Scan(&commitID, &configText, &timestamp)
+	`).Scan(&commitID, &configText, &timestamp, &schemaVersion)
 
 	if err == sql.ErrNoRows {
 		// No running config exists (first startup)
@@ -26,6 +27,10 @@ func (ds *sqliteDatastore) GetRunning(ctx context.Context) (*RunningConfig, erro
 	if err != nil {
 		return nil, NewError(ErrCodeInternal, "failed to get running config", err)
 	}
+	configText, err = migrateConfigText(configText, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	return &RunningConfig{
 		CommitID:   commitID,
@@ -38,12 +43,14 @@ func (ds *sqliteDatastore) GetRunning(ctx context.Context) (*RunningConfig, erro
 func (ds *sqliteDatastore) GetCandidate(ctx context.Context, sessionID string) (*CandidateConfig, error) {
 	var configText string
 	var createdAt, updatedAt time.Time
+	var baseCommitID sql.NullString
+	var schemaVersion int
 
 	err := ds.db.QueryRowContext(ctx, `
-		SELECT config_text, created_at, updated_at
+		SELECT config_text, created_at, updated_at, base_commit_id, schema_version
 		FROM candidate_configs
 		WHERE session_id = ?
-	`, sessionID).Scan(&configText, &createdAt, &updatedAt)
+	`, sessionID).Scan(&configText, &createdAt, &updatedAt, &baseCommitID, &schemaVersion)
 
 	if err == sql.ErrNoRows {
 		// No candidate exists for this session
@@ -52,12 +59,18 @@ func (ds *sqliteDatastore) GetCandidate(ctx context.Context, sessionID string) (
 	if err != nil {
 		return nil, NewError(ErrCodeInternal, "failed to get candidate config", err)
 	}
+	configText, err = migrateConfigText(configText, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	return &CandidateConfig{
-		SessionID:  sessionID,
-		ConfigText: configText,
-		CreatedAt:  createdAt,
-		UpdatedAt:  updatedAt,
+		SessionID:    sessionID,
+		ConfigText:   configText,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+		BaseCommitID: baseCommitID.String,
+		BaseTracked:  baseCommitID.Valid,
 	}, nil
 }
 
@@ -72,14 +85,16 @@ func (ds *sqliteDatastore) SaveCandidate(ctx context.Context, sessionID string,
 	return ds.withTx(ctx, false, func(tx *sql.Tx) error {
 		now := time.Now()
 
-		// Use INSERT OR REPLACE to handle both create and update
+		// Upsert the candidate. A new candidate records the current running
+		// commit as its base; updates keep the original base.
 		_, err := tx.ExecContext(ctx, `
-			INSERT INTO candidate_configs (session_id, config_text, created_at, updated_at)
-			VALUES (?, ?, ?, ?)
+			INSERT INTO candidate_configs (session_id, config_text, created_at, updated_at, base_commit_id, schema_version)
+			VALUES (?, ?, ?, ?, COALESCE((SELECT commit_id FROM running_config WHERE is_current = 1), ''), ?)
 			ON CONFLICT(session_id) DO UPDATE SET
 				config_text = excluded.config_text,
-				updated_at = excluded.updated_at
-		`, sessionID, configText, now, now)
+				updated_at = excluded.updated_at,
+				schema_version = excluded.schema_version
+		`, sessionID, configText, now, now, pkgconfig.SchemaVersion)
 
 		if err != nil {
 			return NewError(ErrCodeInternal, "failed to save candidate config", err)
@@ -0,0 +1,79 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GetPendingConfirm returns the confirmed commit awaiting confirmation.
+func (ds *sqliteDatastore) GetPendingConfirm(ctx context.Context) (*PendingConfirm, error) {
+	var pending PendingConfirm
+	var createdAt, expiresAt sqliteUnixTime
+	err := ds.db.QueryRowContext(ctx, `
+		SELECT commit_id, rollback_commit_id, session_id, user, persist_id, created_at, expires_at
+		FROM pending_confirm
+		WHERE slot = 1
+	`).Scan(
+		&pending.CommitID,
+		&pending.RollbackCommitID,
+		&pending.SessionID,
+		&pending.User,
+		&pending.PersistID,
+		&createdAt,
+		&expiresAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, NewError(ErrCodeNotFound, "no confirmed commit pending", nil)
+	}
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to get pending confirmed commit", err)
+	}
+	pending.CreatedAt = createdAt.Time()
+	pending.ExpiresAt = expiresAt.Time()
+	return &pending, nil
+}
+
+// SavePendingConfirm records or replaces the pending confirmed commit.
+func (ds *sqliteDatastore) SavePendingConfirm(ctx context.Context, pending *PendingConfirm) error {
+	if err := validatePendingConfirm(pending); err != nil {
+		return err
+	}
+
+	createdAt := pending.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	return ds.withTx(ctx, false, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT OR REPLACE INTO pending_confirm
+				(slot, commit_id, rollback_commit_id, session_id, user, persist_id, created_at, expires_at)
+			VALUES (1, ?, ?, ?, ?, ?, ?, ?)
+		`, pending.CommitID, pending.RollbackCommitID, pending.SessionID, pending.User, pending.PersistID,
+			createdAt.Unix(), pending.ExpiresAt.Unix())
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to save pending confirmed commit", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO audit_log (user, session_id, action, result, details)
+			VALUES (?, ?, 'confirmed_commit', 'success', ?)
+		`, pending.User, pending.SessionID, fmt.Sprintf("commit_id: %s, rollback_commit_id: %s, expires_at: %s, persist: %t",
+			pending.CommitID, pending.RollbackCommitID, pending.ExpiresAt.UTC().Format(time.RFC3339), pending.PersistID != ""))
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to log audit event", err)
+		}
+		return nil
+	})
+}
+
+// ClearPendingConfirm removes the pending confirmed commit.
+func (ds *sqliteDatastore) ClearPendingConfirm(ctx context.Context) error {
+	_, err := ds.db.ExecContext(ctx, `DELETE FROM pending_confirm WHERE slot = 1`)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to clear pending confirmed commit", err)
+	}
+	return nil
+}
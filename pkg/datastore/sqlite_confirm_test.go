@@ -0,0 +1,82 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLitePendingConfirmRoundTrip(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	if _, err := ds.GetPendingConfirm(ctx); !isNotFoundError(err) {
+		t.Fatalf("GetPendingConfirm() error = %v, want not found", err)
+	}
+
+	expiresAt := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	pending := &PendingConfirm{
+		CommitID:         "commit-2",
+		RollbackCommitID: "commit-1",
+		SessionID:        "session-1",
+		User:             "alice",
+		PersistID:        "token",
+		ExpiresAt:        expiresAt,
+	}
+	if err := ds.SavePendingConfirm(ctx, pending); err != nil {
+		t.Fatalf("SavePendingConfirm() error = %v", err)
+	}
+
+	got, err := ds.GetPendingConfirm(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingConfirm() error = %v", err)
+	}
+	if got.CommitID != "commit-2" || got.RollbackCommitID != "commit-1" || got.SessionID != "session-1" ||
+		got.User != "alice" || got.PersistID != "token" {
+		t.Fatalf("GetPendingConfirm() = %#v, want saved pending confirm", got)
+	}
+	if !got.ExpiresAt.Equal(expiresAt) {
+		t.Fatalf("ExpiresAt = %v, want %v", got.ExpiresAt, expiresAt)
+	}
+	if got.CreatedAt.IsZero() {
+		t.Fatal("CreatedAt is zero, want default creation time")
+	}
+
+	events, err := ds.ListAuditEvents(ctx, &AuditOptions{Action: "confirmed_commit"})
+	if err != nil {
+		t.Fatalf("ListAuditEvents() error = %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("confirmed_commit audit events = %d, want 1", len(events))
+	}
+
+	if err := ds.ClearPendingConfirm(ctx); err != nil {
+		t.Fatalf("ClearPendingConfirm() error = %v", err)
+	}
+	if err := ds.ClearPendingConfirm(ctx); err != nil {
+		t.Fatalf("second ClearPendingConfirm() error = %v", err)
+	}
+	if _, err := ds.GetPendingConfirm(ctx); !isNotFoundError(err) {
+		t.Fatalf("GetPendingConfirm() after clear error = %v, want not found", err)
+	}
+}
+
+func TestSQLiteSavePendingConfirmRequiresRollbackTarget(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+
+	err := ds.SavePendingConfirm(context.Background(), &PendingConfirm{
+		CommitID:  "commit-2",
+		ExpiresAt: time.Now().Add(time.Minute),
+	})
+	var dsErr *Error
+	if !errors.As(err, &dsErr) || dsErr.Code != ErrCodeValidation {
+		t.Fatalf("SavePendingConfirm() error = %v, want validation error", err)
+	}
+}
+
+func isNotFoundError(err error) bool {
+	var dsErr *Error
+	return errors.As(err, &dsErr) && dsErr.Code == ErrCodeNotFound
+}
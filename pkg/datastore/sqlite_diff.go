@@ -61,19 +61,14 @@ func compareConfigs(oldText, newText string) *DiffResult {
 		}
 	}
 
-	// Split into lines for line-based comparison
-	oldLines := strings.Split(oldText, "\n")
-	newLines := strings.Split(newText, "\n")
-
 	// Use diffmatchpatch for line-level diff
 	dmp := diffmatchpatch.New()
 
-	// Convert lines to unique strings for diff algorithm
-	oldLineText := strings.Join(oldLines, "\n")
-	newLineText := strings.Join(newLines, "\n")
+	// Convert lines to unique characters so the diff never splits a line
+	oldLineText, newLineText, lines := dmp.DiffLinesToChars(oldText, newText)
 
 	diffs := dmp.DiffMain(oldLineText, newLineText, false)
-	diffs = dmp.DiffCleanupSemantic(diffs)
+	diffs = dmp.DiffCharsToLines(diffs, lines)
 
 	// Generate simplified diff output with +/- prefixes
 	diffText := generateSimplifiedDiff(diffs)
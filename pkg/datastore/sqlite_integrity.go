@@ -0,0 +1,231 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// sqliteLockExpiresAt converts config_locks.expires_at to Unix seconds,
+// accepting the integer, numeric text, and datetime text forms written by
+// earlier schema versions.
+const sqliteLockExpiresAt = `COALESCE(
+	CASE
+		WHEN typeof(expires_at) IN ('integer', 'real') THEN CAST(expires_at AS INTEGER)
+	END,
+	CASE
+		WHEN typeof(expires_at) = 'text' AND expires_at NOT GLOB '*[^0-9]*' THEN CAST(expires_at AS INTEGER)
+	END,
+	CAST(strftime('%s', expires_at) AS INTEGER),
+	0
+)`
+
+// CheckIntegrity runs SQLite's integrity check and verifies that the
+// running configuration, candidates, locks, and pending confirmed commit
+// refer to commits that exist. With repair, candidates based on a missing
+// commit and expired locks are deleted in one transaction and the repair is
+// recorded in the audit log.
+func (ds *sqliteDatastore) CheckIntegrity(ctx context.Context, repair bool) ([]IntegrityIssue, error) {
+	issues := []IntegrityIssue{}
+	err := ds.withTx(ctx, !repair, func(tx *sql.Tx) error {
+		storage, err := sqliteStorageIssues(ctx, tx)
+		if err != nil {
+			return err
+		}
+		issues = append(issues, storage...)
+		if len(storage) > 0 {
+			// The table contents cannot be trusted; do not repair from them.
+			repair = false
+		}
+
+		for _, check := range []func(context.Context, *sql.Tx) ([]IntegrityIssue, error){
+			sqliteRunningIssues,
+			sqliteCandidateIssues,
+			sqliteLockIssues,
+			sqliteConfirmIssues,
+		} {
+			found, err := check(ctx, tx)
+			if err != nil {
+				return err
+			}
+			issues = append(issues, found...)
+		}
+		if !repair {
+			return nil
+		}
+		return repairSQLiteIssues(ctx, tx, issues)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+func sqliteStorageIssues(ctx context.Context, tx *sql.Tx) ([]IntegrityIssue, error) {
+	rows, err := tx.QueryContext(ctx, `PRAGMA integrity_check`)
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to run integrity check", err)
+	}
+	defer rows.Close()
+
+	var issues []IntegrityIssue
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return nil, NewError(ErrCodeInternal, "failed to scan integrity check result", err)
+		}
+		if result != "ok" {
+			issues = append(issues, IntegrityIssue{Check: IntegrityCheckStorage, Description: result})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to run integrity check", err)
+	}
+	return issues, nil
+}
+
+func sqliteRunningIssues(ctx context.Context, tx *sql.Tx) ([]IntegrityIssue, error) {
+	var total, current int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*), COALESCE(SUM(is_current = 1), 0) FROM running_config
+	`).Scan(&total, &current); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to check running config", err)
+	}
+	if total > 0 && current == 0 {
+		return []IntegrityIssue{{Check: IntegrityCheckRunning, Description: "no running configuration is marked current"}}, nil
+	}
+
+	var issues []IntegrityIssue
+	err := queryStrings(ctx, tx, `
+		SELECT commit_id FROM running_config
+		WHERE is_current = 1 AND commit_id NOT IN (SELECT commit_id FROM commit_history)
+	`, func(commitID string) {
+		issues = append(issues, IntegrityIssue{
+			Check:       IntegrityCheckRunning,
+			Object:      commitID,
+			Description: "current running configuration has no commit history entry",
+		})
+	})
+	return issues, err
+}
+
+func sqliteCandidateIssues(ctx context.Context, tx *sql.Tx) ([]IntegrityIssue, error) {
+	var issues []IntegrityIssue
+	err := queryStrings(ctx, tx, `
+		SELECT session_id FROM candidate_configs
+		WHERE COALESCE(base_commit_id, '') != ''
+			AND base_commit_id NOT IN (SELECT commit_id FROM running_config)
+			AND base_commit_id NOT IN (SELECT commit_id FROM commit_history)
+		ORDER BY session_id
+	`, func(sessionID string) {
+		issues = append(issues, IntegrityIssue{
+			Check:       IntegrityCheckCandidate,
+			Object:      sessionID,
+			Description: "candidate is based on a commit that does not exist",
+		})
+	})
+	return issues, err
+}
+
+func sqliteLockIssues(ctx context.Context, tx *sql.Tx) ([]IntegrityIssue, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT target, session_id FROM config_locks
+		WHERE `+sqliteLockExpiresAt+` < ?
+		ORDER BY target
+	`, time.Now().Unix())
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to check locks", err)
+	}
+	defer rows.Close()
+
+	var issues []IntegrityIssue
+	for rows.Next() {
+		var target, sessionID string
+		if err := rows.Scan(&target, &sessionID); err != nil {
+			return nil, NewError(ErrCodeInternal, "failed to scan lock", err)
+		}
+		issues = append(issues, IntegrityIssue{
+			Check:       IntegrityCheckLock,
+			Object:      target,
+			Description: fmt.Sprintf("%s lock held by session %s has expired", target, sessionID),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to check locks", err)
+	}
+	return issues, nil
+}
+
+func sqliteConfirmIssues(ctx context.Context, tx *sql.Tx) ([]IntegrityIssue, error) {
+	var issues []IntegrityIssue
+	err := queryStrings(ctx, tx, `
+		SELECT commit_id FROM pending_confirm
+		WHERE commit_id NOT IN (SELECT commit_id FROM commit_history)
+		UNION
+		SELECT rollback_commit_id FROM pending_confirm
+		WHERE rollback_commit_id != '' AND rollback_commit_id NOT IN (SELECT commit_id FROM commit_history)
+	`, func(commitID string) {
+		issues = append(issues, IntegrityIssue{
+			Check:       IntegrityCheckConfirm,
+			Object:      commitID,
+			Description: "pending confirmed commit refers to a commit that does not exist",
+		})
+	})
+	return issues, err
+}
+
+// repairSQLiteIssues deletes the orphaned candidates and expired locks among
+// issues and marks them repaired.
+func repairSQLiteIssues(ctx context.Context, tx *sql.Tx, issues []IntegrityIssue) error {
+	repaired := 0
+	for i := range issues {
+		issue := &issues[i]
+		var err error
+		switch issue.Check {
+		case IntegrityCheckCandidate:
+			_, err = tx.ExecContext(ctx, `DELETE FROM candidate_configs WHERE session_id = ?`, issue.Object)
+		case IntegrityCheckLock:
+			_, err = tx.ExecContext(ctx, `
+				DELETE FROM config_locks WHERE target = ? AND `+sqliteLockExpiresAt+` < ?
+			`, issue.Object, time.Now().Unix())
+		default:
+			continue
+		}
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to repair datastore", err)
+		}
+		issue.Repaired = true
+		repaired++
+	}
+	if repaired == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO audit_log (user, session_id, action, result, details)
+		VALUES ('system', '', 'datastore_repair', 'success', ?)
+	`, fmt.Sprintf("removed %d orphaned record(s)", repaired)); err != nil {
+		return NewError(ErrCodeInternal, "failed to log datastore repair", err)
+	}
+	return nil
+}
+
+// queryStrings runs a single-column query and calls fn for each value.
+func queryStrings(ctx context.Context, tx *sql.Tx, query string, fn func(string)) error {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to check datastore", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return NewError(ErrCodeInternal, "failed to check datastore", err)
+		}
+		fn(value)
+	}
+	if err := rows.Err(); err != nil {
+		return NewError(ErrCodeInternal, "failed to check datastore", err)
+	}
+	return nil
+}
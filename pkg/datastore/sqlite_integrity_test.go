@@ -0,0 +1,108 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteCheckIntegrityRepairsOrphanedCandidateAndLock(t *testing.T) {
+	ctx := context.Background()
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+
+	issues, err := ds.CheckIntegrity(ctx, false)
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+	if len(issues) != 0 {
+		t.Fatalf("CheckIntegrity() on a new datastore = %#v, want no issues", issues)
+	}
+
+	mustExec(t, ds.db, `
+		INSERT INTO candidate_configs (session_id, config_text, base_commit_id)
+		VALUES ('orphan', 'set system host-name orphan', 'missing-commit')
+	`)
+	expired := time.Now().Add(-time.Hour).Unix()
+	mustExec(t, ds.db, `
+		INSERT INTO config_locks (target, session_id, user, acquired_at, expires_at, last_activity)
+		VALUES ('candidate', 'orphan', 'alice', ?, ?, ?)
+	`, expired, expired, expired)
+
+	issues, err = ds.CheckIntegrity(ctx, false)
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+	if len(issues) != 2 ||
+		issues[0].Check != IntegrityCheckCandidate || issues[0].Object != "orphan" ||
+		issues[1].Check != IntegrityCheckLock || issues[1].Object != LockTargetCandidate {
+		t.Fatalf("CheckIntegrity() = %#v, want orphaned candidate and expired lock", issues)
+	}
+	if issues[0].Repaired || issues[1].Repaired {
+		t.Fatalf("CheckIntegrity() without repair reported repaired issues: %#v", issues)
+	}
+	if count := countRows(t, ds, "candidate_configs"); count != 1 {
+		t.Fatalf("candidate_configs rows after check = %d, want 1", count)
+	}
+
+	issues, err = ds.CheckIntegrity(ctx, true)
+	if err != nil {
+		t.Fatalf("CheckIntegrity(repair) error = %v", err)
+	}
+	if len(issues) != 2 || !issues[0].Repaired || !issues[1].Repaired {
+		t.Fatalf("CheckIntegrity(repair) = %#v, want both issues repaired", issues)
+	}
+	if count := countRows(t, ds, "candidate_configs"); count != 0 {
+		t.Fatalf("candidate_configs rows after repair = %d, want 0", count)
+	}
+	if count := countRows(t, ds, "config_locks"); count != 0 {
+		t.Fatalf("config_locks rows after repair = %d, want 0", count)
+	}
+
+	issues, err = ds.CheckIntegrity(ctx, false)
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+	if len(issues) != 0 {
+		t.Fatalf("CheckIntegrity() after repair = %#v, want no issues", issues)
+	}
+}
+
+func TestSQLiteCheckIntegrityKeepsCandidateOfExistingCommit(t *testing.T) {
+	ctx := context.Background()
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+
+	if err := ds.SaveCandidate(ctx, "s1", "set system host-name r1"); err != nil {
+		t.Fatalf("SaveCandidate() error = %v", err)
+	}
+	if err := ds.AcquireLock(ctx, &LockRequest{Target: LockTargetCandidate, SessionID: "s1", User: "alice"}); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if _, err := ds.Commit(ctx, &CommitRequest{SessionID: "s1", User: "alice", Message: "initial"}); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if err := ds.SaveCandidate(ctx, "s2", "set system host-name r2"); err != nil {
+		t.Fatalf("SaveCandidate() error = %v", err)
+	}
+
+	issues, err := ds.CheckIntegrity(ctx, true)
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+	if len(issues) != 0 {
+		t.Fatalf("CheckIntegrity() = %#v, want no issues", issues)
+	}
+	if count := countRows(t, ds, "candidate_configs"); count != 1 {
+		t.Fatalf("candidate_configs rows = %d, want 1", count)
+	}
+}
+
+func countRows(t *testing.T, ds *sqliteDatastore, table string) int {
+	t.Helper()
+	var count int
+	if err := ds.db.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&count); err != nil && err != sql.ErrNoRows {
+		t.Fatalf("count %s: %v", table, err)
+	}
+	return count
+}
@@ -38,8 +38,8 @@ func TestSQLiteMigrationConvertsLegacyLockTimestamps(t *testing.T) {
 	if err := ds.db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_version`).Scan(&version); err != nil {
 		t.Fatalf("schema version query failed: %v", err)
 	}
-	if version != 2 {
-		t.Fatalf("schema version = %d, want 2", version)
+	if version != 11 {
+		t.Fatalf("schema version = %d, want 11", version)
 	}
 
 	var storageType string
@@ -92,8 +92,8 @@ func TestSQLiteMigrationRepairsUnrecordedTargetLockMigration(t *testing.T) {
 	if err := ds.db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_version`).Scan(&version); err != nil {
 		t.Fatalf("schema version query failed: %v", err)
 	}
-	if version != 2 {
-		t.Fatalf("schema version = %d, want repaired version 2", version)
+	if version != 11 {
+		t.Fatalf("schema version = %d, want 11 after repairing version 2", version)
 	}
 
 	info, err := ds.GetLockInfo(context.Background(), LockTargetCandidate)
@@ -0,0 +1,28 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+)
+
+// AppendCommitNote appends note to the message of an archived commit.
+func (ds *sqliteDatastore) AppendCommitNote(ctx context.Context, commitID, note string) error {
+	return ds.withTx(ctx, false, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE commit_history
+			SET message = CASE WHEN COALESCE(message, '') = '' THEN ? ELSE message || char(10) || ? END
+			WHERE commit_id = ?
+		`, note, note, commitID)
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to append commit note", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to check commit note result", err)
+		}
+		if rowsAffected == 0 {
+			return NewError(ErrCodeNotFound, "commit not found", nil)
+		}
+		return nil
+	})
+}
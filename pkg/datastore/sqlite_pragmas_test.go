@@ -1,8 +1,11 @@
 package datastore
 
 import (
+	"context"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSQLiteDatastoreUsesFullSynchronousMode(t *testing.T) {
@@ -16,3 +19,56 @@ func TestSQLiteDatastoreUsesFullSynchronousMode(t *testing.T) {
 		t.Fatalf("PRAGMA synchronous = %q, want 2 (FULL)", synchronous)
 	}
 }
+
+func TestSQLiteDatastoreAppliesBusyTimeoutToEveryConnection(t *testing.T) {
+	ds, err := NewSQLiteDatastore(&Config{
+		Backend:    BackendSQLite,
+		SQLitePath: filepath.Join(t.TempDir(), "config.db"),
+		SQLiteOptions: SQLiteOptions{
+			BusyTimeout: 15 * time.Second,
+			Synchronous: "normal",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewSQLiteDatastore() error = %v", err)
+	}
+	t.Cleanup(func() { _ = ds.Close() })
+	db := ds.(*sqliteDatastore).db
+
+	// Hold several connections at once so the pool cannot reuse the one
+	// that ran setup.
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			t.Fatalf("Conn() error = %v", err)
+		}
+		defer conn.Close()
+
+		var busyTimeout int
+		if err := conn.QueryRowContext(ctx, "PRAGMA busy_timeout").Scan(&busyTimeout); err != nil {
+			t.Fatalf("query busy_timeout pragma: %v", err)
+		}
+		if busyTimeout != 15000 {
+			t.Fatalf("connection %d PRAGMA busy_timeout = %d, want 15000", i, busyTimeout)
+		}
+		var synchronous string
+		if err := conn.QueryRowContext(ctx, "PRAGMA synchronous").Scan(&synchronous); err != nil {
+			t.Fatalf("query synchronous pragma: %v", err)
+		}
+		if synchronous != "1" {
+			t.Fatalf("connection %d PRAGMA synchronous = %q, want 1 (NORMAL)", i, synchronous)
+		}
+	}
+}
+
+func TestSQLiteDatastoreRejectsInvalidSynchronousMode(t *testing.T) {
+	_, err := NewSQLiteDatastore(&Config{
+		Backend:       BackendSQLite,
+		SQLitePath:    filepath.Join(t.TempDir(), "config.db"),
+		SQLiteOptions: SQLiteOptions{Synchronous: "sometimes"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "synchronous") {
+		t.Fatalf("NewSQLiteDatastore() error = %v, want synchronous mode error", err)
+	}
+}
@@ -0,0 +1,79 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GetScheduledCommit returns the commit scheduled with "commit at".
+func (ds *sqliteDatastore) GetScheduledCommit(ctx context.Context) (*ScheduledCommit, error) {
+	var scheduled ScheduledCommit
+	var createdAt, scheduledAt sqliteUnixTime
+	err := ds.db.QueryRowContext(ctx, `
+		SELECT config_text, session_id, user, message, created_at, scheduled_at, base_version
+		FROM scheduled_commit
+		WHERE slot = 1
+	`).Scan(
+		&scheduled.ConfigText,
+		&scheduled.SessionID,
+		&scheduled.User,
+		&scheduled.Message,
+		&createdAt,
+		&scheduledAt,
+		&scheduled.BaseVersion,
+	)
+	if err == sql.ErrNoRows {
+		return nil, NewError(ErrCodeNotFound, "no commit scheduled", nil)
+	}
+	if err != nil {
+		return nil, NewError(ErrCodeInternal, "failed to get scheduled commit", err)
+	}
+	scheduled.CreatedAt = createdAt.Time()
+	scheduled.ScheduledAt = scheduledAt.Time()
+	return &scheduled, nil
+}
+
+// SaveScheduledCommit records or replaces the scheduled commit.
+func (ds *sqliteDatastore) SaveScheduledCommit(ctx context.Context, scheduled *ScheduledCommit) error {
+	if err := validateScheduledCommit(scheduled); err != nil {
+		return err
+	}
+
+	createdAt := scheduled.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	return ds.withTx(ctx, false, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT OR REPLACE INTO scheduled_commit
+				(slot, config_text, session_id, user, message, created_at, scheduled_at, base_version)
+			VALUES (1, ?, ?, ?, ?, ?, ?, ?)
+		`, scheduled.ConfigText, scheduled.SessionID, scheduled.User, scheduled.Message,
+			createdAt.Unix(), scheduled.ScheduledAt.Unix(), scheduled.BaseVersion)
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to save scheduled commit", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO audit_log (user, session_id, action, result, details)
+			VALUES (?, ?, 'schedule_commit', 'success', ?)
+		`, scheduled.User, scheduled.SessionID, fmt.Sprintf("scheduled_at: %s",
+			scheduled.ScheduledAt.UTC().Format(time.RFC3339)))
+		if err != nil {
+			return NewError(ErrCodeInternal, "failed to log audit event", err)
+		}
+		return nil
+	})
+}
+
+// ClearScheduledCommit removes the scheduled commit.
+func (ds *sqliteDatastore) ClearScheduledCommit(ctx context.Context) error {
+	_, err := ds.db.ExecContext(ctx, `DELETE FROM scheduled_commit WHERE slot = 1`)
+	if err != nil {
+		return NewError(ErrCodeInternal, "failed to clear scheduled commit", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package datastore
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteScheduledCommitRoundTrip(t *testing.T) {
+	ds := openSQLiteDatastoreForTest(t, filepath.Join(t.TempDir(), "config.db"))
+	ctx := context.Background()
+
+	if _, err := ds.GetScheduledCommit(ctx); !isNotFoundError(err) {
+		t.Fatalf("GetScheduledCommit() error = %v, want not found", err)
+	}
+
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := ds.SaveScheduledCommit(ctx, &ScheduledCommit{
+		ConfigText:  "set system host-name router2\n",
+		SessionID:   "session-1",
+		User:        "alice",
+		Message:     "maintenance window",
+		ScheduledAt: scheduledAt,
+		BaseVersion: 7,
+	}); err != nil {
+		t.Fatalf("SaveScheduledCommit() error = %v", err)
+	}
+
+	got, err := ds.GetScheduledCommit(ctx)
+	if err != nil {
+		t.Fatalf("GetScheduledCommit() error = %v", err)
+	}
+	if got.ConfigText != "set system host-name router2\n" || got.SessionID != "session-1" ||
+		got.User != "alice" || got.Message != "maintenance window" || got.BaseVersion != 7 {
+		t.Fatalf("GetScheduledCommit() = %#v, want saved scheduled commit", got)
+	}
+	if !got.ScheduledAt.Equal(scheduledAt) || got.CreatedAt.IsZero() {
+		t.Fatalf("ScheduledAt = %v CreatedAt = %v, want %v and default creation time", got.ScheduledAt, got.CreatedAt, scheduledAt)
+	}
+
+	events, err := ds.ListAuditEvents(ctx, &AuditOptions{Action: "schedule_commit"})
+	if err != nil {
+		t.Fatalf("ListAuditEvents() error = %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("schedule_commit audit events = %d, want 1", len(events))
+	}
+
+	if err := ds.ClearScheduledCommit(ctx); err != nil {
+		t.Fatalf("ClearScheduledCommit() error = %v", err)
+	}
+	if err := ds.ClearScheduledCommit(ctx); err != nil {
+		t.Fatalf("second ClearScheduledCommit() error = %v", err)
+	}
+	if _, err := ds.GetScheduledCommit(ctx); !isNotFoundError(err) {
+		t.Fatalf("GetScheduledCommit() after clear error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,131 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default SQLite connection tuning. The config datastore prefers durability
+// (synchronous=FULL); callers with cheaper-to-rebuild data may pick NORMAL.
+const (
+	DefaultSQLiteBusyTimeout           = 5 * time.Second
+	DefaultSQLiteSynchronous           = "FULL"
+	DefaultSQLiteWALCheckpointInterval = 5 * time.Minute
+)
+
+// SQLiteOptions tunes a SQLite connection pool. Zero values select defaults.
+type SQLiteOptions struct {
+	// BusyTimeout is how long a connection waits on a locked database before
+	// failing with "database is locked" (default: 5s).
+	BusyTimeout time.Duration
+
+	// Synchronous is the PRAGMA synchronous mode: OFF, NORMAL, FULL, or EXTRA.
+	Synchronous string
+
+	// WALCheckpointInterval is how often the WAL is checkpointed and truncated
+	// to bound its size (default: 5m). A negative value disables periodic
+	// checkpoints and leaves them to SQLite's automatic checkpointing.
+	WALCheckpointInterval time.Duration
+}
+
+// WithDefaults validates o and fills unset fields, using synchronous as the
+// default mode.
+func (o SQLiteOptions) WithDefaults(synchronous string) (SQLiteOptions, error) {
+	if o.BusyTimeout < 0 {
+		return o, fmt.Errorf("sqlite busy timeout must not be negative: %s", o.BusyTimeout)
+	}
+	if o.BusyTimeout == 0 {
+		o.BusyTimeout = DefaultSQLiteBusyTimeout
+	}
+	if o.WALCheckpointInterval == 0 {
+		o.WALCheckpointInterval = DefaultSQLiteWALCheckpointInterval
+	}
+	mode := strings.ToUpper(strings.TrimSpace(o.Synchronous))
+	if mode == "" {
+		mode = synchronous
+	}
+	switch mode {
+	case "OFF", "NORMAL", "FULL", "EXTRA":
+	default:
+		return o, fmt.Errorf("invalid sqlite synchronous mode %q (expected OFF, NORMAL, FULL, or EXTRA)", o.Synchronous)
+	}
+	o.Synchronous = mode
+	return o, nil
+}
+
+// SQLiteDSN returns a go-sqlite3 DSN for path with WAL journaling, foreign
+// keys, and the tuned busy timeout and synchronous mode. These are
+// per-connection settings, so they belong in the DSN where the driver applies
+// them to every pooled connection; a one-off PRAGMA only reaches whichever
+// connection happened to run it. defaultSynchronous is used when
+// opts.Synchronous is unset. extra carries additional driver parameters.
+func SQLiteDSN(path string, opts SQLiteOptions, defaultSynchronous string, extra url.Values) (string, error) {
+	opts, err := opts.WithDefaults(defaultSynchronous)
+	if err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	for key, values := range extra {
+		params[key] = append([]string(nil), values...)
+	}
+	params.Set("_journal_mode", "WAL")
+	params.Set("_synchronous", opts.Synchronous)
+	params.Set("_foreign_keys", "1")
+	params.Set("_busy_timeout", strconv.FormatInt(opts.BusyTimeout.Milliseconds(), 10))
+	return path + "?" + params.Encode(), nil
+}
+
+// SQLiteWALCheckpointer periodically checkpoints a WAL-mode database.
+type SQLiteWALCheckpointer struct {
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// StartSQLiteWALCheckpointer runs "PRAGMA wal_checkpoint(TRUNCATE)" on db
+// every interval until Stop is called. It returns nil when interval is not
+// positive. Checkpoint failures are retried on the next tick; a busy reader
+// only delays truncation.
+func StartSQLiteWALCheckpointer(db *sql.DB, interval time.Duration) *SQLiteWALCheckpointer {
+	if db == nil || interval <= 0 {
+		return nil
+	}
+	c := &SQLiteWALCheckpointer{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(c.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				_, _ = db.ExecContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)")
+				cancel()
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+	return c
+}
+
+// Stop ends periodic checkpointing and waits for an in-flight checkpoint.
+// It is safe to call on a nil checkpointer and more than once.
+func (c *SQLiteWALCheckpointer) Stop() {
+	if c == nil {
+		return
+	}
+	c.stopOnce.Do(func() {
+		close(c.stop)
+		<-c.done
+	})
+}
@@ -0,0 +1,45 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"canceled", context.Canceled, false},
+		{"deadline", context.DeadlineExceeded, true},
+		{"timeout code", NewError(ErrCodeTimeout, "timed out", nil), true},
+		{"validation", NewError(ErrCodeValidation, "invalid commit", nil), false},
+		{"not found", NewError(ErrCodeNotFound, "no such commit", nil), false},
+		{"conflict", NewError(ErrCodeConflict, "lock held", syscall.EIO), false},
+		{"internal parse", NewError(ErrCodeInternal, "failed to parse lock data", errors.New("unexpected end of JSON input")), false},
+		{"internal without cause", NewError(ErrCodeInternal, "failed", nil), false},
+		{"sqlite busy", NewError(ErrCodeInternal, "failed", sqlite3.Error{Code: sqlite3.ErrBusy}), true},
+		{"sqlite io", NewError(ErrCodeInternal, "failed", sqlite3.Error{Code: sqlite3.ErrIoErr}), true},
+		{"sqlite constraint", NewError(ErrCodeInternal, "failed", sqlite3.Error{Code: sqlite3.ErrConstraint}), false},
+		{"errno", NewError(ErrCodeInternal, "failed", fmt.Errorf("write: %w", syscall.EIO)), true},
+		{"connection refused", fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{"grpc unavailable", NewError(ErrCodeInternal, "failed", status.Error(codes.Unavailable, "no leader")), true},
+		{"grpc invalid argument", NewError(ErrCodeInternal, "failed", status.Error(codes.InvalidArgument, "bad key")), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransientError(tt.err); got != tt.want {
+				t.Fatalf("IsTransientError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
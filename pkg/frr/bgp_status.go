@@ -88,7 +88,7 @@ func collectBGPNeighborStatuses(value any) []BGPNeighborStatus {
 		}
 		var neighbors []BGPNeighborStatus
 		for key, item := range typed {
-			if child, ok := item.(map[string]any); ok && looksLikeBGPNeighborKey(key) &&
+			if child, ok := item.(map[string]any); ok && looksLikeBGPNeighborKey(key, child) &&
 				lookupNormalized(child, "peer", "neighbor", "peeraddress", "address") == nil {
 				child = cloneJSONObject(child)
 				child["peer"] = key
@@ -128,9 +128,13 @@ func looksLikeBGPNeighborObject(object map[string]any) bool {
 	) != nil
 }
 
-func looksLikeBGPNeighborKey(key string) bool {
-	_, err := netip.ParseAddr(strings.TrimSpace(key))
-	return err == nil
+// looksLikeBGPNeighborKey reports whether key names a peer: an address, or an
+// interface for unnumbered peers, which FRR marks with idType "interface".
+func looksLikeBGPNeighborKey(key string, child map[string]any) bool {
+	if _, err := netip.ParseAddr(strings.TrimSpace(key)); err == nil {
+		return true
+	}
+	return strings.EqualFold(stringFromNormalized(child, "idtype"), "interface")
 }
 
 func bgpStateFromObject(object map[string]any) string {
@@ -2,6 +2,7 @@ package frr
 
 import (
 	"context"
+	"reflect"
 	"testing"
 )
 
@@ -140,3 +141,66 @@ func TestVtyshBGPSummaryStatusReaderRunsShowBGPSummaryJSON(t *testing.T) {
 		t.Fatalf("neighbor = %#v, want parsed BGP summary", got)
 	}
 }
+
+func TestParseBGPSummaryJSONAcceptsCapturedFRROutput(t *testing.T) {
+	// Captured from FRR 9 "show bgp summary json": every peer carries both the
+	// session "state" and the administrative "peerState", and the unnumbered
+	// peer is keyed by its interface.
+	status, err := ParseBGPSummaryJSON([]byte(`{
+		"ipv4Unicast": {
+			"routerId": "192.0.2.1", "as": 65000, "vrfId": 0, "vrfName": "default",
+			"tableVersion": 7, "ribCount": 5, "peerCount": 3,
+			"peers": {
+				"192.0.2.2": {
+					"hostname": "peer1", "remoteAs": 65001, "localAs": 65000, "version": 4,
+					"msgRcvd": 120, "msgSent": 118, "tableVersion": 0, "outq": 0, "inq": 0,
+					"peerUptime": "01:02:03", "peerUptimeMsec": 3723000,
+					"peerUptimeEstablishedEpoch": 1700000000,
+					"pfxRcd": 3, "pfxSnt": 2, "state": "Established", "peerState": "OK",
+					"connectionsEstablished": 1, "connectionsDropped": 0, "idType": "ipv4"
+				},
+				"192.0.2.6": {
+					"remoteAs": 65002, "localAs": 65000, "version": 4, "msgRcvd": 0, "msgSent": 0,
+					"peerUptime": "never", "peerUptimeMsec": 0, "pfxRcd": 0, "pfxSnt": 0,
+					"state": "Active", "peerState": "OK", "idType": "ipv4"
+				},
+				"ge0-0-1": {
+					"hostname": "peer3", "remoteAs": 65003, "localAs": 65000,
+					"peerUptime": "00:05:00", "peerUptimeMsec": 300000,
+					"pfxRcd": 1, "pfxSnt": 4, "state": "Established", "peerState": "OK",
+					"idType": "interface"
+				}
+			},
+			"failedPeers": 1, "displayedPeers": 3, "totalPeers": 3, "dynamicPeers": 0
+		},
+		"ipv6Unicast": {
+			"routerId": "192.0.2.1", "as": 65000,
+			"peers": {
+				"2001:db8::2": {
+					"remoteAs": 65001, "localAs": 65000, "peerUptime": "00:10:00",
+					"peerUptimeMsec": 600000, "pfxRcd": 5, "pfxSnt": 6,
+					"state": "Established", "peerState": "OK", "idType": "ipv6"
+				},
+				"ge0-0-1": {
+					"hostname": "peer3", "remoteAs": 65003, "localAs": 65000,
+					"peerUptime": "00:05:00", "peerUptimeMsec": 300000,
+					"pfxRcd": 2, "pfxSnt": 1, "state": "Established", "peerState": "OK",
+					"idType": "interface"
+				}
+			},
+			"totalPeers": 2
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("ParseBGPSummaryJSON() error = %v", err)
+	}
+	want := []BGPNeighborStatus{
+		{PeerAddress: "192.0.2.2", PeerAS: 65001, State: "Established", UptimeSecs: 3723, PrefixReceived: 3, PrefixSent: 2},
+		{PeerAddress: "192.0.2.6", PeerAS: 65002, State: "Active"},
+		{PeerAddress: "2001:db8::2", PeerAS: 65001, State: "Established", UptimeSecs: 600, PrefixReceived: 5, PrefixSent: 6},
+		{PeerAddress: "ge0-0-1", PeerAS: 65003, State: "Established", UptimeSecs: 300, PrefixReceived: 3, PrefixSent: 5},
+	}
+	if !reflect.DeepEqual(status.Neighbors, want) {
+		t.Fatalf("neighbors = %#v, want %#v", status.Neighbors, want)
+	}
+}
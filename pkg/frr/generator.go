@@ -29,6 +29,10 @@ func GenerateFRRConfig(cfg *config.Config) (*Config, error) {
 		LogTimestamp:     true,
 		InterfaceMapping: make(map[string]string),
 	}
+	if cfg.RoutingOptions != nil {
+		frrConfig.RouterID = cfg.EffectiveRouterID()
+		frrConfig.ASN = cfg.RoutingOptions.AutonomousSystem
+	}
 
 	// Build interface mapping (Junos name → Linux name)
 	if err := buildInterfaceMapping(cfg, frrConfig); err != nil {
@@ -50,6 +54,7 @@ func GenerateFRRConfig(cfg *config.Config) (*Config, error) {
 		if err != nil {
 			return nil, NewGenerateError("failed to convert BGP configuration", err)
 		}
+		bgpConfig.Distance = convertBGPDistance(cfg.RoutingOptions)
 		frrConfig.BGP = bgpConfig
 	}
 
@@ -64,6 +69,9 @@ func GenerateFRRConfig(cfg *config.Config) (*Config, error) {
 		if err != nil {
 			return nil, NewGenerateError("failed to convert OSPF configuration", err)
 		}
+		if cfg.RoutingOptions != nil && cfg.RoutingOptions.AdministrativeDistance != nil {
+			ospfConfig.Distance = convertOSPFDistance(cfg.RoutingOptions.AdministrativeDistance.OSPF)
+		}
 		frrConfig.OSPF = ospfConfig
 	}
 
@@ -73,9 +81,21 @@ func GenerateFRRConfig(cfg *config.Config) (*Config, error) {
 		if err != nil {
 			return nil, NewGenerateError("failed to convert OSPFv3 configuration", err)
 		}
+		if cfg.RoutingOptions != nil && cfg.RoutingOptions.AdministrativeDistance != nil {
+			ospf3Config.Distance = convertOSPFDistance(cfg.RoutingOptions.AdministrativeDistance.OSPF3)
+		}
 		frrConfig.OSPF3 = ospf3Config
 	}
 
+	// Convert RIP configuration
+	if cfg.Protocols != nil && cfg.Protocols.RIP != nil {
+		ripConfig, err := convertRIPConfig(cfg.Protocols.RIP, frrConfig.InterfaceMapping)
+		if err != nil {
+			return nil, NewGenerateError("failed to convert RIP configuration", err)
+		}
+		frrConfig.RIP = ripConfig
+	}
+
 	// Convert VRRP configuration
 	if cfg.Protocols != nil && cfg.Protocols.VRRP != nil {
 		vrrpConfig, err := convertVRRPConfig(cfg.Protocols.VRRP, frrConfig.InterfaceMapping)
@@ -132,6 +152,9 @@ func GenerateFRRConfig(cfg *config.Config) (*Config, error) {
 	return frrConfig, nil
 }
 
+// ConfigFileHeader starts every generated FRR configuration file.
+const ConfigFileHeader = "!\n! FRR configuration generated by arca-router\n!\n"
+
 // GenerateFRRConfigFile generates the complete FRR configuration file content.
 func GenerateFRRConfigFile(frrConfig *Config) (string, error) {
 	if frrConfig == nil {
@@ -143,10 +166,7 @@ func GenerateFRRConfigFile(frrConfig *Config) (string, error) {
 
 	var b strings.Builder
 
-	// Header
-	b.WriteString("!\n")
-	b.WriteString("! FRR configuration generated by arca-router\n")
-	b.WriteString("!\n")
+	b.WriteString(ConfigFileHeader)
 
 	// Hostname
 	if frrConfig.Hostname != "" {
@@ -161,6 +181,11 @@ func GenerateFRRConfigFile(frrConfig *Config) (string, error) {
 		b.WriteString("log timestamp precision 3\n")
 	}
 
+	// Global router ID shared by zebra and every protocol without its own
+	if frrConfig.RouterID != "" {
+		fmt.Fprintf(&b, "router-id %s\n", frrConfig.RouterID)
+	}
+
 	b.WriteString("!\n")
 
 	// Static routes
@@ -235,6 +260,15 @@ func GenerateFRRConfigFile(frrConfig *Config) (string, error) {
 		b.WriteString(ospf3Config)
 	}
 
+	// RIP configuration
+	if frrConfig.RIP != nil {
+		ripConfig, err := GenerateRIPConfig(frrConfig.RIP)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(ripConfig)
+	}
+
 	// VRRP configuration
 	if frrConfig.VRRP != nil {
 		vrrpConfig, err := GenerateVRRPConfig(frrConfig.VRRP)
@@ -262,6 +296,9 @@ func GenerateFRRConfigFile(frrConfig *Config) (string, error) {
 }
 
 func validateFRRConfigReferences(frrConfig *Config) error {
+	if err := validateGlobalIdentity(frrConfig); err != nil {
+		return err
+	}
 	if err := validatePolicyObjects(frrConfig.PrefixLists, frrConfig.RouteMaps); err != nil {
 		return err
 	}
@@ -274,6 +311,29 @@ func validateFRRConfigReferences(frrConfig *Config) error {
 	return validateRouteMapASPathReferences(frrConfig.RouteMaps, frrConfig.ASPathAccessLists)
 }
 
+// validateGlobalIdentity checks that the global router ID is usable and that
+// every BGP instance uses the shared local AS.
+func validateGlobalIdentity(frrConfig *Config) error {
+	if frrConfig.RouterID != "" {
+		ip := net.ParseIP(frrConfig.RouterID)
+		if ip == nil || ip.To4() == nil {
+			return NewInvalidConfigError(fmt.Sprintf("invalid global router-id: %s (must be IPv4 format)", frrConfig.RouterID))
+		}
+	}
+	if frrConfig.ASN == 0 {
+		return nil
+	}
+	if frrConfig.BGP != nil && frrConfig.BGP.ASN != frrConfig.ASN {
+		return NewInvalidConfigError(fmt.Sprintf("BGP ASN %d does not match routing-options autonomous-system %d", frrConfig.BGP.ASN, frrConfig.ASN))
+	}
+	for _, vrf := range frrConfig.VRFs {
+		if vrf.ASN != 0 && vrf.ASN != frrConfig.ASN {
+			return NewInvalidConfigError(fmt.Sprintf("VRF %s BGP ASN %d does not match routing-options autonomous-system %d", vrf.Name, vrf.ASN, frrConfig.ASN))
+		}
+	}
+	return nil
+}
+
 func validateRouteMapASPathReferences(routeMaps []RouteMap, asPathLists []ASPathAccessList) error {
 	asPathListNames := make(map[string]struct{}, len(asPathLists))
 	for _, list := range asPathLists {
@@ -322,27 +382,79 @@ func convertBGPConfig(cfg *config.Config, ifaceMapping map[string]string) (*BGPC
 		return nil, fmt.Errorf("BGP requires autonomous-system to be configured in routing-options")
 	}
 
+	// Determine router-id priority: BGP router-id > routing-options router-id
+	routerID := arcaBGP.RouterID
+	if routerID == "" {
+		routerID = cfg.EffectiveRouterID()
+	}
+
 	frrBGP := &BGPConfig{
 		ASN:         asn,
-		RouterID:    cfg.RoutingOptions.RouterID,
+		RouterID:    routerID,
 		Neighbors:   make([]BGPNeighbor, 0),
 		IPv4Unicast: false,
 		IPv6Unicast: false,
 	}
 
-	// Convert BGP groups and neighbors
-	for _, group := range arcaBGP.Groups {
+	// Convert BGP groups and neighbors. Each group with several neighbors
+	// becomes a peer-group carrying the settings its neighbors share.
+	for groupName, group := range arcaBGP.Groups {
+		peerGroupName := ""
+		if len(group.Neighbors) > 1 {
+			peerGroupName = groupName
+		}
+		peerGroup := BGPPeerGroup{
+			Name:                 groupName,
+			RouteMapIn:           group.Import,
+			RouteMapOut:          group.Export,
+			RouteReflectorClient: true,
+			NextHopSelf:          true,
+			RemovePrivateAS:      true,
+		}
+		first := true
+		for _, neighbor := range group.Neighbors {
+			if first {
+				peerGroup.RemoteAS = neighbor.PeerAS
+				first = false
+			} else if peerGroup.RemoteAS != neighbor.PeerAS {
+				peerGroup.RemoteAS = 0
+			}
+			if !group.IsRouteReflectorClient(neighbor) {
+				peerGroup.RouteReflectorClient = false
+			}
+			if !group.IsNextHopSelf(neighbor) {
+				peerGroup.NextHopSelf = false
+			}
+			if !group.IsRemovePrivateAS(neighbor) {
+				peerGroup.RemovePrivateAS = false
+			}
+		}
+		if peerGroupName != "" {
+			frrBGP.PeerGroups = append(frrBGP.PeerGroups, peerGroup)
+		}
+
 		for _, neighbor := range group.Neighbors {
 			frrNeighbor := BGPNeighbor{
-				IP:         neighbor.IP,
-				RemoteAS:   neighbor.PeerAS,
-				BFD:        neighbor.BFD,
-				BFDProfile: neighbor.BFDProfile,
+				IP:                   neighbor.IP,
+				RemoteAS:             neighbor.PeerAS,
+				BFD:                  neighbor.BFD,
+				BFDProfile:           neighbor.BFDProfile,
+				RouteReflectorClient: group.IsRouteReflectorClient(neighbor),
+				Shutdown:             neighbor.Shutdown,
+				NextHopSelf:          group.IsNextHopSelf(neighbor),
+				RemovePrivateAS:      group.IsRemovePrivateAS(neighbor),
+				PeerGroup:            peerGroupName,
+			}
+			if clusterID := group.ClusterID(neighbor); frrNeighbor.RouteReflectorClient && clusterID != "" {
+				if frrBGP.ClusterID != "" && frrBGP.ClusterID != clusterID {
+					return nil, fmt.Errorf("BGP neighbor %s uses cluster %s, but cluster %s is already configured", neighbor.IP, clusterID, frrBGP.ClusterID)
+				}
+				frrBGP.ClusterID = clusterID
 			}
 
 			// Add description (include group name)
-			if neighbor.Description != "" {
-				frrNeighbor.Description = neighbor.Description
+			if desc := escapeDescription(neighbor.Description); desc != "" {
+				frrNeighbor.Description = desc
 			} else {
 				frrNeighbor.Description = fmt.Sprintf("BGP peer in group %s", group.Type)
 			}
@@ -356,9 +468,23 @@ func convertBGPConfig(cfg *config.Config, ifaceMapping map[string]string) (*BGPC
 			}
 
 			// Convert update-source (local-address)
+			// A link-local LocalAddress names its interface as a zone, which
+			// becomes the update-source interface
 			// If LocalAddress is an IP, try to find the interface that has this IP
 			// If not found, use the IP directly as update-source
-			if neighbor.LocalAddress != "" {
+			// An explicit update-source takes precedence and is used as-is,
+			// with interface names mapped to their Linux names
+			if neighbor.UpdateSource != "" {
+				frrNeighbor.UpdateSource = neighbor.UpdateSource
+				if linuxName, ok := ifaceMapping[neighbor.UpdateSource]; ok {
+					frrNeighbor.UpdateSource = linuxName
+				}
+			} else if ip, zone, ok := strings.Cut(neighbor.LocalAddress, "%"); ok {
+				frrNeighbor.UpdateSource = ip
+				if linuxName, ok := ifaceMapping[zone]; ok {
+					frrNeighbor.UpdateSource = linuxName
+				}
+			} else if neighbor.LocalAddress != "" {
 				updateSource := neighbor.LocalAddress
 
 				// Try to find interface with this local address
@@ -460,6 +586,47 @@ func convertBFDConfig(arcaBFD *config.BFDConfig, ifaceMapping map[string]string)
 	return frrBFD, nil
 }
 
+// FRR default BGP distances, used for the route types left unset in
+// routing-options administrative-distance.
+const (
+	defaultBGPExternalDistance = 20
+	defaultBGPInternalDistance = 200
+	defaultBGPLocalDistance    = 200
+)
+
+// convertBGPDistance returns the "distance bgp" of routing-options
+// administrative-distance protocol bgp, or nil when none is configured.
+func convertBGPDistance(ro *config.RoutingOptions) *BGPDistance {
+	if ro == nil || ro.AdministrativeDistance == nil || ro.AdministrativeDistance.BGP == nil {
+		return nil
+	}
+	bgp := ro.AdministrativeDistance.BGP
+	d := &BGPDistance{
+		External: defaultBGPExternalDistance,
+		Internal: defaultBGPInternalDistance,
+		Local:    defaultBGPLocalDistance,
+	}
+	if bgp.External != 0 {
+		d.External = bgp.External
+	}
+	if bgp.Internal != 0 {
+		d.Internal = bgp.Internal
+	}
+	if bgp.Local != 0 {
+		d.Local = bgp.Local
+	}
+	return d
+}
+
+// convertOSPFDistance returns the FRR form of an OSPF or OSPFv3
+// administrative distance, or nil when none is configured.
+func convertOSPFDistance(d *config.OSPFDistance) *OSPFDistance {
+	if d == nil || *d == (config.OSPFDistance{}) {
+		return nil
+	}
+	return &OSPFDistance{Default: d.Default, IntraArea: d.IntraArea, InterArea: d.InterArea, External: d.External}
+}
+
 // convertOSPFConfig converts arca-router OSPF config to FRR OSPF config.
 func convertOSPFConfig(cfg *config.Config, arcaOSPF *config.OSPFConfig, ifaceMapping map[string]string, isOSPFv3 bool) (*OSPFConfig, error) {
 	if arcaOSPF == nil {
@@ -474,8 +641,12 @@ func convertOSPFConfig(cfg *config.Config, arcaOSPF *config.OSPFConfig, ifaceMap
 
 	// Determine router-id priority: protocol router-id > routing-options router-id
 	routerID := arcaOSPF.RouterID
-	if routerID == "" && cfg.RoutingOptions != nil {
-		routerID = cfg.RoutingOptions.RouterID
+	if routerID == "" {
+		routerID = cfg.EffectiveRouterID()
+	}
+	// OSPFv3 derives its router-id from BGP when no other is configured.
+	if routerID == "" && isOSPFv3 && cfg.Protocols != nil && cfg.Protocols.BGP != nil {
+		routerID = cfg.Protocols.BGP.RouterID
 	}
 
 	if routerID == "" && !isOSPFv3 {
@@ -483,10 +654,11 @@ func convertOSPFConfig(cfg *config.Config, arcaOSPF *config.OSPFConfig, ifaceMap
 	}
 
 	frrOSPF := &OSPFConfig{
-		RouterID:   routerID,
-		Networks:   make([]OSPFNetwork, 0),
-		Interfaces: make([]OSPFInterface, 0),
-		IsOSPFv3:   isOSPFv3,
+		RouterID:           routerID,
+		Networks:           make([]OSPFNetwork, 0),
+		Interfaces:         make([]OSPFInterface, 0),
+		IsOSPFv3:           isOSPFv3,
+		ReferenceBandwidth: arcaOSPF.ReferenceBandwidth,
 	}
 
 	// Convert OSPF areas and interfaces
@@ -531,14 +703,18 @@ func convertOSPFConfig(cfg *config.Config, arcaOSPF *config.OSPFConfig, ifaceMap
 				Name:       linuxName,
 				AreaID:     area.AreaID,
 				Passive:    iface.Passive,
-				Metric:     iface.Metric,
 				BFD:        iface.BFD,
 				BFDProfile: iface.BFDProfile,
 			}
+			if iface.Metric != nil {
+				frrIface.Metric = *iface.Metric
+			} else if arcaIface.Bandwidth != 0 {
+				frrIface.Metric = ospfBandwidthCost(arcaOSPF.ReferenceBandwidth, arcaIface.Bandwidth)
+			}
 
 			// Set priority only if explicitly configured.
-			if iface.PrioritySet || iface.Priority != 0 {
-				priority := iface.Priority
+			if iface.Priority != nil {
+				priority := *iface.Priority
 				frrIface.Priority = &priority
 			}
 
@@ -549,6 +725,30 @@ func convertOSPFConfig(cfg *config.Config, arcaOSPF *config.OSPFConfig, ifaceMap
 	return frrOSPF, nil
 }
 
+// defaultOSPFReferenceBandwidth is FRR's default auto-cost reference
+// bandwidth (100 Mbit/s) in bits per second. With it every link of 100 Mbit/s
+// or more gets cost 1, so faster links can only be told apart by raising the
+// reference bandwidth with "protocols ospf reference-bandwidth".
+const defaultOSPFReferenceBandwidth = 100 * 1000 * 1000
+
+// ospfBandwidthCost derives an OSPF interface cost from the configured
+// interface bandwidth the way FRR auto-cost does from the link speed:
+// reference bandwidth divided by interface bandwidth, at least 1 and at most
+// 65535. A zero reference selects FRR's default.
+func ospfBandwidthCost(reference, bandwidth uint64) int {
+	if reference == 0 {
+		reference = defaultOSPFReferenceBandwidth
+	}
+	cost := reference / bandwidth
+	if cost < 1 {
+		return 1
+	}
+	if cost > 65535 {
+		return 65535
+	}
+	return int(cost)
+}
+
 // convertVRRPConfig converts arca-router VRRP config to FRR VRRP config.
 func convertVRRPConfig(arcaVRRP *config.VRRPConfig, ifaceMapping map[string]string) (*VRRPConfig, error) {
 	if arcaVRRP == nil || len(arcaVRRP.Groups) == 0 {
@@ -581,6 +781,38 @@ func convertVRRPConfig(arcaVRRP *config.VRRPConfig, ifaceMapping map[string]stri
 	return frrVRRP, nil
 }
 
+// convertRIPConfig converts arca-router RIP configuration to FRR format.
+func convertRIPConfig(arcaRIP *config.RIPConfig, ifaceMapping map[string]string) (*RIPConfig, error) {
+	if arcaRIP == nil || len(arcaRIP.Groups) == 0 {
+		return nil, nil
+	}
+	frrRIP := &RIPConfig{
+		UpdateInterval: arcaRIP.UpdateInterval,
+		Timeout:        arcaRIP.RouteTimeout,
+		GarbageCollect: arcaRIP.Holddown,
+		DefaultMetric:  arcaRIP.DefaultMetric,
+	}
+	for groupName, group := range arcaRIP.Groups {
+		if group == nil {
+			continue
+		}
+		for junosName, neighbor := range group.Neighbors {
+			linuxName, ok := ifaceMapping[junosName]
+			if !ok {
+				return nil, fmt.Errorf("RIP group %s interface %s not found in interface mapping", groupName, junosName)
+			}
+			frrRIP.Interfaces = append(frrRIP.Interfaces, RIPInterface{
+				Name:    linuxName,
+				Passive: neighbor != nil && neighbor.Passive,
+			})
+		}
+	}
+	if len(frrRIP.Interfaces) == 0 {
+		return nil, nil
+	}
+	return frrRIP, nil
+}
+
 // convertStaticRoutes converts arca-router static routes to FRR format.
 func convertStaticRoutes(arcaRoutes []*config.StaticRoute) ([]StaticRoute, error) {
 	frrRoutes := make([]StaticRoute, 0, len(arcaRoutes))
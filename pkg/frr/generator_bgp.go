@@ -5,6 +5,7 @@ import (
 	"net"
 	"sort"
 	"strings"
+	"unicode"
 )
 
 // GenerateBGPConfig generates FRR BGP configuration from BGPConfig.
@@ -28,6 +29,10 @@ func GenerateBGPConfig(cfg *BGPConfig) (string, error) {
 		fmt.Fprintf(&b, " bgp router-id %s\n", cfg.RouterID)
 	}
 
+	if cfg.ClusterID != "" {
+		fmt.Fprintf(&b, " bgp cluster-id %s\n", cfg.ClusterID)
+	}
+
 	// Sort neighbors for deterministic output (test stability)
 	neighbors := make([]BGPNeighbor, len(cfg.Neighbors))
 	copy(neighbors, cfg.Neighbors)
@@ -35,12 +40,36 @@ func GenerateBGPConfig(cfg *BGPConfig) (string, error) {
 		return neighbors[i].IP < neighbors[j].IP
 	})
 
+	peerGroups := make([]BGPPeerGroup, len(cfg.PeerGroups))
+	copy(peerGroups, cfg.PeerGroups)
+	sort.Slice(peerGroups, func(i, j int) bool {
+		return peerGroups[i].Name < peerGroups[j].Name
+	})
+	groupsByName := make(map[string]*BGPPeerGroup, len(peerGroups))
+	for i := range peerGroups {
+		groupsByName[peerGroups[i].Name] = &peerGroups[i]
+	}
+
+	// BGP peer-groups
+	for _, g := range peerGroups {
+		fmt.Fprintf(&b, " neighbor %s peer-group\n", g.Name)
+		if g.RemoteAS != 0 {
+			fmt.Fprintf(&b, " neighbor %s remote-as %d\n", g.Name, g.RemoteAS)
+		}
+	}
+
 	// BGP neighbors
 	for _, n := range neighbors {
-		fmt.Fprintf(&b, " neighbor %s remote-as %d\n", n.IP, n.RemoteAS)
+		group := groupsByName[n.PeerGroup]
+		if group == nil || group.RemoteAS != n.RemoteAS {
+			fmt.Fprintf(&b, " neighbor %s remote-as %d\n", n.IP, n.RemoteAS)
+		}
+		if group != nil {
+			fmt.Fprintf(&b, " neighbor %s peer-group %s\n", n.IP, group.Name)
+		}
 
-		if n.Description != "" {
-			fmt.Fprintf(&b, " neighbor %s description %s\n", n.IP, escapeDescription(n.Description))
+		if desc := escapeDescription(n.Description); desc != "" {
+			fmt.Fprintf(&b, " neighbor %s description %s\n", n.IP, desc)
 		}
 
 		if n.UpdateSource != "" {
@@ -52,48 +81,26 @@ func GenerateBGPConfig(cfg *BGPConfig) (string, error) {
 		} else if n.BFD {
 			fmt.Fprintf(&b, " neighbor %s bfd\n", n.IP)
 		}
+
+		if n.Shutdown {
+			fmt.Fprintf(&b, " neighbor %s shutdown\n", n.IP)
+		}
 	}
 
 	// Address families
 	if cfg.IPv4Unicast {
 		b.WriteString(" !\n")
 		b.WriteString(" address-family ipv4 unicast\n")
-
-		for _, n := range neighbors {
-			if !n.IsIPv6 {
-				fmt.Fprintf(&b, "  neighbor %s activate\n", n.IP)
-
-				// Apply route-maps (import/export policies)
-				if n.RouteMapIn != "" {
-					fmt.Fprintf(&b, "  neighbor %s route-map %s in\n", n.IP, n.RouteMapIn)
-				}
-				if n.RouteMapOut != "" {
-					fmt.Fprintf(&b, "  neighbor %s route-map %s out\n", n.IP, n.RouteMapOut)
-				}
-			}
-		}
-
+		writeBGPDistance(&b, cfg.Distance)
+		writeBGPAddressFamilyNeighbors(&b, peerGroups, neighbors, false)
 		b.WriteString(" exit-address-family\n")
 	}
 
 	if cfg.IPv6Unicast {
 		b.WriteString(" !\n")
 		b.WriteString(" address-family ipv6 unicast\n")
-
-		for _, n := range neighbors {
-			if n.IsIPv6 {
-				fmt.Fprintf(&b, "  neighbor %s activate\n", n.IP)
-
-				// Apply route-maps (import/export policies)
-				if n.RouteMapIn != "" {
-					fmt.Fprintf(&b, "  neighbor %s route-map %s in\n", n.IP, n.RouteMapIn)
-				}
-				if n.RouteMapOut != "" {
-					fmt.Fprintf(&b, "  neighbor %s route-map %s out\n", n.IP, n.RouteMapOut)
-				}
-			}
-		}
-
+		writeBGPDistance(&b, cfg.Distance)
+		writeBGPAddressFamilyNeighbors(&b, peerGroups, neighbors, true)
 		b.WriteString(" exit-address-family\n")
 	}
 
@@ -110,6 +117,145 @@ func GenerateBGPConfig(cfg *BGPConfig) (string, error) {
 	return b.String(), nil
 }
 
+// GenerateBGPShutdownChanges generates the commands that re-enable neighbors
+// shut down in previous but no longer in current. A whole-file config only
+// omits the shutdown line, which additive apply methods such as vtysh -f
+// never undo, so the explicit "no neighbor <ip> shutdown" is returned for
+// them. It returns an empty string when no neighbor is re-enabled.
+func GenerateBGPShutdownChanges(previous, current *BGPConfig) string {
+	if previous == nil || current == nil {
+		return ""
+	}
+	wasShutdown := make(map[string]bool, len(previous.Neighbors))
+	for _, n := range previous.Neighbors {
+		wasShutdown[n.IP] = n.Shutdown
+	}
+	var enabled []string
+	for _, n := range current.Neighbors {
+		if wasShutdown[n.IP] && !n.Shutdown {
+			enabled = append(enabled, n.IP)
+		}
+	}
+	if len(enabled) == 0 {
+		return ""
+	}
+	sort.Strings(enabled)
+
+	var b strings.Builder
+	b.WriteString("!\n")
+	fmt.Fprintf(&b, "router bgp %d\n", current.ASN)
+	for _, ip := range enabled {
+		fmt.Fprintf(&b, " no neighbor %s shutdown\n", ip)
+	}
+	b.WriteString("!\n")
+	return b.String()
+}
+
+// writeBGPDistance writes the "distance bgp" of a unicast address family.
+func writeBGPDistance(b *strings.Builder, d *BGPDistance) {
+	if d == nil {
+		return
+	}
+	fmt.Fprintf(b, "  distance bgp %d %d %d\n", d.External, d.Internal, d.Local)
+}
+
+// writeBGPAddressFamilyNeighbors writes the unicast address-family settings
+// of the neighbors of one family. A peer-group whose members all belong to
+// the family is activated and configured once; its members only repeat the
+// settings that differ from the group. Members of a peer-group that mixes
+// families are configured individually so they are not activated in the
+// other family.
+func writeBGPAddressFamilyNeighbors(b *strings.Builder, peerGroups []BGPPeerGroup, neighbors []BGPNeighbor, ipv6 bool) {
+	familyGroups := make(map[string]*BGPPeerGroup, len(peerGroups))
+	for i := range peerGroups {
+		g := &peerGroups[i]
+		members, sameFamily := 0, 0
+		for _, n := range neighbors {
+			if n.PeerGroup != g.Name {
+				continue
+			}
+			members++
+			if n.IsIPv6 == ipv6 {
+				sameFamily++
+			}
+		}
+		if members == 0 || sameFamily != members {
+			continue
+		}
+		familyGroups[g.Name] = g
+		writeBGPAddressFamilyPeer(b, g.Name, true, bgpAddressFamilySettings{
+			routeReflectorClient: g.RouteReflectorClient,
+			nextHopSelf:          g.NextHopSelf,
+			removePrivateAS:      g.RemovePrivateAS,
+			routeMapIn:           g.RouteMapIn,
+			routeMapOut:          g.RouteMapOut,
+		})
+	}
+
+	for _, n := range neighbors {
+		if n.IsIPv6 != ipv6 {
+			continue
+		}
+		settings := bgpAddressFamilySettings{
+			routeReflectorClient: n.RouteReflectorClient,
+			nextHopSelf:          n.NextHopSelf,
+			removePrivateAS:      n.RemovePrivateAS,
+			routeMapIn:           n.RouteMapIn,
+			routeMapOut:          n.RouteMapOut,
+		}
+		group := familyGroups[n.PeerGroup]
+		if group == nil {
+			writeBGPAddressFamilyPeer(b, n.IP, true, settings)
+			continue
+		}
+		settings.routeReflectorClient = n.RouteReflectorClient && !group.RouteReflectorClient
+		settings.nextHopSelf = n.NextHopSelf && !group.NextHopSelf
+		settings.removePrivateAS = n.RemovePrivateAS && !group.RemovePrivateAS
+		if settings.routeMapIn == group.RouteMapIn {
+			settings.routeMapIn = ""
+		}
+		if settings.routeMapOut == group.RouteMapOut {
+			settings.routeMapOut = ""
+		}
+		writeBGPAddressFamilyPeer(b, n.IP, false, settings)
+	}
+}
+
+// bgpAddressFamilySettings are the unicast address-family settings written
+// for a neighbor or peer-group.
+type bgpAddressFamilySettings struct {
+	routeReflectorClient bool
+	nextHopSelf          bool
+	removePrivateAS      bool
+	routeMapIn           string
+	routeMapOut          string
+}
+
+// writeBGPAddressFamilyPeer writes the address-family settings of a neighbor
+// or peer-group.
+func writeBGPAddressFamilyPeer(b *strings.Builder, peer string, activate bool, s bgpAddressFamilySettings) {
+	if activate {
+		fmt.Fprintf(b, "  neighbor %s activate\n", peer)
+	}
+	if s.routeReflectorClient {
+		fmt.Fprintf(b, "  neighbor %s route-reflector-client\n", peer)
+	}
+	if s.nextHopSelf {
+		fmt.Fprintf(b, "  neighbor %s next-hop-self\n", peer)
+	}
+	if s.removePrivateAS {
+		fmt.Fprintf(b, "  neighbor %s remove-private-AS\n", peer)
+	}
+
+	// Apply route-maps (import/export policies)
+	if s.routeMapIn != "" {
+		fmt.Fprintf(b, "  neighbor %s route-map %s in\n", peer, s.routeMapIn)
+	}
+	if s.routeMapOut != "" {
+		fmt.Fprintf(b, "  neighbor %s route-map %s out\n", peer, s.routeMapOut)
+	}
+}
+
 func validateBGPConfig(cfg *BGPConfig) error {
 	if cfg.ASN == 0 {
 		return NewInvalidConfigError("BGP ASN is required")
@@ -120,8 +266,34 @@ func validateBGPConfig(cfg *BGPConfig) error {
 			return NewInvalidConfigError(fmt.Sprintf("invalid BGP router-id: %s", cfg.RouterID))
 		}
 	}
+	if d := cfg.Distance; d != nil {
+		for _, distance := range []int{d.External, d.Internal, d.Local} {
+			if distance < 1 || distance > 255 {
+				return NewInvalidConfigError(fmt.Sprintf("invalid BGP distance: %d %d %d (must be 1-255)", d.External, d.Internal, d.Local))
+			}
+		}
+	}
+	if cfg.ClusterID != "" {
+		clusterID := net.ParseIP(cfg.ClusterID)
+		if clusterID == nil || clusterID.To4() == nil {
+			return NewInvalidConfigError(fmt.Sprintf("invalid BGP cluster-id: %s", cfg.ClusterID))
+		}
+	}
+	peerGroups := make(map[string]struct{}, len(cfg.PeerGroups))
+	for _, group := range cfg.PeerGroups {
+		if group.Name == "" || strings.ContainsFunc(group.Name, unicode.IsSpace) || net.ParseIP(group.Name) != nil {
+			return NewInvalidConfigError(fmt.Sprintf("invalid BGP peer-group name: %q", group.Name))
+		}
+		if _, ok := peerGroups[group.Name]; ok {
+			return NewInvalidConfigError(fmt.Sprintf("BGP peer-group %s is duplicated", group.Name))
+		}
+		peerGroups[group.Name] = struct{}{}
+	}
 	neighbors := make(map[string]struct{}, len(cfg.Neighbors))
 	for _, neighbor := range cfg.Neighbors {
+		if _, ok := peerGroups[neighbor.PeerGroup]; neighbor.PeerGroup != "" && !ok {
+			return NewInvalidConfigError(fmt.Sprintf("BGP neighbor %s references unknown peer-group %s", neighbor.IP, neighbor.PeerGroup))
+		}
 		if err := validateBGPNeighbor(&neighbor); err != nil {
 			return err
 		}
@@ -163,14 +335,15 @@ func validateBGPNeighbor(n *BGPNeighbor) error {
 	return nil
 }
 
-// escapeDescription escapes special characters in description strings.
-// FRR descriptions should be quoted if they contain spaces.
+// escapeDescription renders a description as the trailing LINE argument of
+// "neighbor <ip> description". vtysh takes the rest of the line verbatim and
+// does not strip quotes, so the text is not quoted; instead control
+// characters and whitespace runs collapse to single spaces, which keeps the
+// description on one line and prevents it from injecting further commands.
 func escapeDescription(desc string) string {
-	if strings.Contains(desc, " ") || strings.Contains(desc, "\t") {
-		// Quote the description if it contains whitespace
-		return fmt.Sprintf("\"%s\"", strings.ReplaceAll(desc, "\"", "\\\""))
-	}
-	return desc
+	return strings.Join(strings.FieldsFunc(desc, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}), " ")
 }
 
 // isIPv6 checks if an IP address is IPv6.
@@ -50,7 +50,7 @@ func TestGenerateBGPConfig(t *testing.T) {
 			},
 			want: []string{
 				"neighbor 10.0.2.2 remote-as 65002",
-				"neighbor 10.0.2.2 description \"External BGP Peer - ISP\"",
+				"neighbor 10.0.2.2 description External BGP Peer - ISP",
 				"neighbor 10.0.2.2 update-source ge0-0-2",
 			},
 			wantErr: false,
@@ -187,6 +187,54 @@ func TestGenerateBGPConfig(t *testing.T) {
 	}
 }
 
+func TestGenerateBGPConfigNeighborShutdown(t *testing.T) {
+	shut := &BGPConfig{
+		ASN:         65001,
+		IPv4Unicast: true,
+		Neighbors: []BGPNeighbor{
+			{IP: "10.0.1.2", RemoteAS: 65002, Shutdown: true},
+			{IP: "10.0.1.3", RemoteAS: 65003},
+		},
+	}
+	got, err := GenerateBGPConfig(shut)
+	if err != nil {
+		t.Fatalf("GenerateBGPConfig() error = %v", err)
+	}
+	if !strings.Contains(got, " neighbor 10.0.1.2 shutdown\n") {
+		t.Errorf("GenerateBGPConfig() missing shutdown line:\n%s", got)
+	}
+	if strings.Contains(got, "neighbor 10.0.1.3 shutdown") {
+		t.Errorf("GenerateBGPConfig() shut down an enabled neighbor:\n%s", got)
+	}
+
+	enabled := &BGPConfig{
+		ASN:         65001,
+		IPv4Unicast: true,
+		Neighbors: []BGPNeighbor{
+			{IP: "10.0.1.2", RemoteAS: 65002},
+			{IP: "10.0.1.3", RemoteAS: 65003},
+		},
+	}
+	got, err = GenerateBGPConfig(enabled)
+	if err != nil {
+		t.Fatalf("GenerateBGPConfig() error = %v", err)
+	}
+	if strings.Contains(got, "shutdown") {
+		t.Errorf("GenerateBGPConfig() kept shutdown after removal:\n%s", got)
+	}
+
+	want := "!\nrouter bgp 65001\n no neighbor 10.0.1.2 shutdown\n!\n"
+	if got := GenerateBGPShutdownChanges(shut, enabled); got != want {
+		t.Errorf("GenerateBGPShutdownChanges() = %q, want %q", got, want)
+	}
+	if got := GenerateBGPShutdownChanges(enabled, shut); got != "" {
+		t.Errorf("GenerateBGPShutdownChanges() on shutdown = %q, want empty", got)
+	}
+	if got := GenerateBGPShutdownChanges(shut, &BGPConfig{ASN: 65001}); got != "" {
+		t.Errorf("GenerateBGPShutdownChanges() for a removed neighbor = %q, want empty", got)
+	}
+}
+
 func TestGenerateBGPConfigRejectsInvalidConfig(t *testing.T) {
 	tests := []struct {
 		name string
@@ -228,6 +276,22 @@ func TestGenerateBGPConfigRejectsInvalidConfig(t *testing.T) {
 			},
 			want: "address family does not match configured address family",
 		},
+		{
+			name: "unknown peer-group",
+			cfg: &BGPConfig{
+				ASN:       65001,
+				Neighbors: []BGPNeighbor{{IP: "192.0.2.2", RemoteAS: 65002, PeerGroup: "MISSING"}},
+			},
+			want: "BGP neighbor 192.0.2.2 references unknown peer-group MISSING",
+		},
+		{
+			name: "peer-group named like an address",
+			cfg: &BGPConfig{
+				ASN:        65001,
+				PeerGroups: []BGPPeerGroup{{Name: "192.0.2.9"}},
+			},
+			want: "invalid BGP peer-group name",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -319,17 +383,27 @@ func TestEscapeDescription(t *testing.T) {
 		{
 			name:  "with spaces",
 			input: "External BGP Peer",
-			want:  "\"External BGP Peer\"",
+			want:  "External BGP Peer",
 		},
 		{
 			name:  "with quotes",
 			input: "Peer \"Main\"",
-			want:  "\"Peer \\\"Main\\\"\"",
+			want:  "Peer \"Main\"",
 		},
 		{
 			name:  "with tabs",
 			input: "Peer\tMain",
-			want:  "\"Peer\tMain\"",
+			want:  "Peer Main",
+		},
+		{
+			name:  "with newline",
+			input: "Peer\n neighbor 192.0.2.9 shutdown\r\n",
+			want:  "Peer neighbor 192.0.2.9 shutdown",
+		},
+		{
+			name:  "only whitespace",
+			input: " \t\n",
+			want:  "",
 		},
 	}
 
@@ -128,7 +128,7 @@ func newEVPNBGPConfig(cfg *config.Config) (*BGPConfig, error) {
 	}
 	return &BGPConfig{
 		ASN:      cfg.RoutingOptions.AutonomousSystem,
-		RouterID: cfg.RoutingOptions.RouterID,
+		RouterID: cfg.EffectiveRouterID(),
 	}, nil
 }
 
@@ -33,6 +33,11 @@ func GenerateOSPFConfig(cfg *OSPFConfig) (string, error) {
 		fmt.Fprintf(&b, " ospf router-id %s\n", cfg.RouterID)
 	}
 
+	// FRR takes the auto-cost reference bandwidth in Mbit/s
+	if cfg.ReferenceBandwidth != 0 {
+		fmt.Fprintf(&b, " auto-cost reference-bandwidth %d\n", cfg.ReferenceBandwidth/(1000*1000))
+	}
+
 	// Sort networks for deterministic output
 	networks := make([]OSPFNetwork, len(cfg.Networks))
 	copy(networks, cfg.Networks)
@@ -45,6 +50,8 @@ func GenerateOSPFConfig(cfg *OSPFConfig) (string, error) {
 		fmt.Fprintf(&b, " network %s area %s\n", n.Prefix, n.AreaID)
 	}
 
+	writeOSPFDistance(&b, cfg.Distance, cfg.IsOSPFv3)
+
 	b.WriteString("!\n")
 
 	// Interface-specific configurations
@@ -108,6 +115,35 @@ func GenerateOSPFConfig(cfg *OSPFConfig) (string, error) {
 	return b.String(), nil
 }
 
+// writeOSPFDistance writes the distance of all OSPF routes and the per route
+// type "distance ospf" (or "distance ospf6") overrides.
+func writeOSPFDistance(b *strings.Builder, d *OSPFDistance, isOSPFv3 bool) {
+	if d == nil {
+		return
+	}
+	if d.Default != 0 {
+		fmt.Fprintf(b, " distance %d\n", d.Default)
+	}
+	var types []string
+	if d.IntraArea != 0 {
+		types = append(types, fmt.Sprintf("intra-area %d", d.IntraArea))
+	}
+	if d.InterArea != 0 {
+		types = append(types, fmt.Sprintf("inter-area %d", d.InterArea))
+	}
+	if d.External != 0 {
+		types = append(types, fmt.Sprintf("external %d", d.External))
+	}
+	if len(types) == 0 {
+		return
+	}
+	keyword := "ospf"
+	if isOSPFv3 {
+		keyword = "ospf6"
+	}
+	fmt.Fprintf(b, " distance %s %s\n", keyword, strings.Join(types, " "))
+}
+
 func validateOSPFConfig(cfg *OSPFConfig) error {
 	if cfg == nil {
 		return nil
@@ -120,6 +156,19 @@ func validateOSPFConfig(cfg *OSPFConfig) error {
 		return NewInvalidConfigError("OSPF router-id is required for OSPFv2")
 	}
 
+	if cfg.ReferenceBandwidth != 0 && (cfg.ReferenceBandwidth%(1000*1000) != 0 ||
+		cfg.ReferenceBandwidth/(1000*1000) > 4294967) {
+		return NewInvalidConfigError(fmt.Sprintf("invalid OSPF reference bandwidth: %d (must be 1-4294967 Mbit/s)", cfg.ReferenceBandwidth))
+	}
+
+	if d := cfg.Distance; d != nil {
+		for _, distance := range []int{d.Default, d.IntraArea, d.InterArea, d.External} {
+			if distance < 0 || distance > 255 {
+				return NewInvalidConfigError(fmt.Sprintf("invalid OSPF distance: %d (must be 1-255)", distance))
+			}
+		}
+	}
+
 	seenNetworks := make(map[string]struct{}, len(cfg.Networks))
 	for _, network := range cfg.Networks {
 		if err := validateOSPFNetwork(&network); err != nil {
@@ -240,6 +240,27 @@ func TestGenerateOSPFConfig(t *testing.T) {
 	}
 }
 
+func TestGenerateOSPFConfigDistance(t *testing.T) {
+	got, err := GenerateOSPFConfig(&OSPFConfig{
+		RouterID: "10.0.1.1",
+		IsOSPFv3: true,
+		Distance: &OSPFDistance{IntraArea: 90, InterArea: 95},
+	})
+	if err != nil {
+		t.Fatalf("GenerateOSPFConfig() error = %v", err)
+	}
+	if !strings.Contains(got, "router ospf6\n ospf router-id 10.0.1.1\n distance ospf6 intra-area 90 inter-area 95\n") {
+		t.Errorf("GenerateOSPFConfig() missing OSPFv3 distance:\n%s", got)
+	}
+	if strings.Contains(got, " distance 0") {
+		t.Errorf("GenerateOSPFConfig() wrote unset default distance:\n%s", got)
+	}
+
+	if _, err := GenerateOSPFConfig(&OSPFConfig{RouterID: "10.0.1.1", Distance: &OSPFDistance{Default: 256}}); err == nil {
+		t.Error("GenerateOSPFConfig() accepted distance 256")
+	}
+}
+
 func TestGenerateOSPFConfigRejectsInvalidConfig(t *testing.T) {
 	tests := []struct {
 		name string
@@ -28,6 +28,11 @@ func convertPolicyOptions(cfg *config.Config) ([]PrefixList, []RouteMap, []ASPat
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	routeFilterLists, err := convertRouteFilterPrefixLists(cfg.PolicyOptions.PolicyStatements, prefixLists)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	prefixLists = append(prefixLists, routeFilterLists...)
 	prefixLists, routeMaps, err = aggregateRouteMapPrefixListMatches(prefixLists, routeMaps)
 	if err != nil {
 		return nil, nil, nil, err
@@ -193,6 +198,13 @@ func convertPolicyStatementsWithMapping(policyStatementsMap map[string]*config.P
 					}
 					entry.MatchPrefixLists = expandedLists
 				}
+				hasIPv4, hasIPv6 := routeFilterFamilies(term.From.RouteFilters)
+				if hasIPv4 {
+					entry.MatchPrefixLists = append(entry.MatchPrefixLists, routeFilterPrefixListName(name, entry.Seq, false))
+				}
+				if hasIPv6 {
+					entry.MatchPrefixLists = append(entry.MatchPrefixLists, routeFilterPrefixListName(name, entry.Seq, true))
+				}
 				if term.From.Protocol != "" {
 					entry.MatchProtocol = frrSourceProtocol(term.From.Protocol)
 				}
@@ -227,7 +239,7 @@ func convertPolicyStatementsWithMapping(policyStatementsMap map[string]*config.P
 					entry.SetLocalPreference = term.Then.LocalPreference
 				}
 				if term.Then.Community != "" {
-					entry.SetCommunity = term.Then.Community
+					setRouteMapCommunity(&entry, term.Then.Community)
 				}
 			}
 
@@ -322,7 +334,7 @@ func aggregatePrefixLists(name string, ipv6 bool, sourceNames []string, prefixLi
 		entries := append([]PrefixListEntry(nil), source.Entries...)
 		sort.Slice(entries, func(i, j int) bool { return entries[i].Seq < entries[j].Seq })
 		for _, entry := range entries {
-			key := entry.Action + "\x00" + entry.Prefix
+			key := fmt.Sprintf("%s\x00%s\x00%d\x00%d", entry.Action, entry.Prefix, entry.GE, entry.LE)
 			if seen[key] {
 				continue
 			}
@@ -331,6 +343,8 @@ func aggregatePrefixLists(name string, ipv6 bool, sourceNames []string, prefixLi
 				Seq:    (len(list.Entries) + 1) * 10,
 				Action: entry.Action,
 				Prefix: entry.Prefix,
+				GE:     entry.GE,
+				LE:     entry.LE,
 			})
 		}
 	}
@@ -350,10 +364,25 @@ func uniqueAggregatePrefixListName(base string, prefixListByName map[string]Pref
 }
 
 func aggregateRouteMapPrefixListName(routeMapName string, seq int, ipv6 bool) string {
-	family := "V4"
+	return fmt.Sprintf("%s-%d-%s", generatedPolicyObjectBase(routeMapName), seq, prefixListFamilySuffix(ipv6))
+}
+
+// routeFilterPrefixListName names the inline prefix-list generated for the
+// route-filters of one policy term.
+func routeFilterPrefixListName(routeMapName string, seq int, ipv6 bool) string {
+	return fmt.Sprintf("%s-%d-RF-%s", generatedPolicyObjectBase(routeMapName), seq, prefixListFamilySuffix(ipv6))
+}
+
+func prefixListFamilySuffix(ipv6 bool) string {
 	if ipv6 {
-		family = "V6"
+		return "V6"
 	}
+	return "V4"
+}
+
+// generatedPolicyObjectBase returns "ARCA-" followed by the upper-cased
+// alphanumeric form of routeMapName.
+func generatedPolicyObjectBase(routeMapName string) string {
 	var b strings.Builder
 	b.WriteString("ARCA-")
 	wrote := false
@@ -370,7 +399,102 @@ func aggregateRouteMapPrefixListName(routeMapName string, seq int, ipv6 bool) st
 	if !wrote {
 		b.WriteString("ROUTE-MAP")
 	}
-	return fmt.Sprintf("%s-%d-%s", strings.TrimRight(b.String(), "-"), seq, family)
+	return strings.TrimRight(b.String(), "-")
+}
+
+// routeFilterFamilies reports which address families a term's route-filters use.
+func routeFilterFamilies(filters []*config.RouteFilter) (hasIPv4, hasIPv6 bool) {
+	for _, filter := range filters {
+		if filter == nil {
+			continue
+		}
+		if isIPv6Prefix(filter.Prefix) {
+			hasIPv6 = true
+		} else {
+			hasIPv4 = true
+		}
+	}
+	return hasIPv4, hasIPv6
+}
+
+// convertRouteFilterPrefixLists generates one inline prefix-list per address
+// family for every policy term with route-filters. Each filter's match type
+// becomes the entry's ge/le bounds.
+func convertRouteFilterPrefixLists(policyStatementsMap map[string]*config.PolicyStatement, existing []PrefixList) ([]PrefixList, error) {
+	existingNames := make(map[string]struct{}, len(existing))
+	for _, list := range existing {
+		existingNames[list.Name] = struct{}{}
+	}
+
+	names := make([]string, 0, len(policyStatementsMap))
+	for name := range policyStatementsMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var lists []PrefixList
+	for _, name := range names {
+		ps := policyStatementsMap[name]
+		if ps == nil {
+			continue
+		}
+		for i, term := range ps.Terms {
+			if term == nil || term.From == nil || len(term.From.RouteFilters) == 0 {
+				continue
+			}
+			seq := (i + 1) * 10
+			ipv4List := PrefixList{Name: routeFilterPrefixListName(name, seq, false)}
+			ipv6List := PrefixList{Name: routeFilterPrefixListName(name, seq, true), IsIPv6: true}
+			for _, filter := range term.From.RouteFilters {
+				if filter == nil {
+					continue
+				}
+				entry, err := routeFilterPrefixListEntry(filter)
+				if err != nil {
+					return nil, fmt.Errorf("policy-statement %s term %s: %w", name, term.Name, err)
+				}
+				list := &ipv4List
+				if isIPv6Prefix(filter.Prefix) {
+					list = &ipv6List
+				}
+				entry.Seq = (len(list.Entries) + 1) * 10
+				list.Entries = append(list.Entries, entry)
+			}
+			for _, list := range []PrefixList{ipv4List, ipv6List} {
+				if len(list.Entries) == 0 {
+					continue
+				}
+				if _, exists := existingNames[list.Name]; exists {
+					return nil, fmt.Errorf("policy-statement %s term %s: generated route-filter prefix-list %s conflicts with a configured prefix-list", name, term.Name, list.Name)
+				}
+				existingNames[list.Name] = struct{}{}
+				lists = append(lists, list)
+			}
+		}
+	}
+	return lists, nil
+}
+
+// routeFilterPrefixListEntry converts a route-filter to a permit entry. FRR
+// requires len < ge <= le, so bounds equal to the prefix length are omitted.
+func routeFilterPrefixListEntry(filter *config.RouteFilter) (PrefixListEntry, error) {
+	if err := filter.Validate(); err != nil {
+		return PrefixListEntry{}, err
+	}
+	_, prefixNet, err := net.ParseCIDR(filter.Prefix)
+	if err != nil {
+		return PrefixListEntry{}, fmt.Errorf("invalid route-filter prefix %q", filter.Prefix)
+	}
+	prefixLength, _ := prefixNet.Mask.Size()
+	entry := PrefixListEntry{Action: "permit", Prefix: prefixNet.String()}
+	minLength, maxLength := filter.LengthRange()
+	if minLength > prefixLength {
+		entry.GE = minLength
+	}
+	if maxLength > prefixLength {
+		entry.LE = maxLength
+	}
+	return entry, nil
 }
 
 func frrSourceProtocol(protocol string) string {
@@ -402,8 +526,15 @@ func GeneratePrefixListConfig(prefixLists []PrefixList) (string, error) {
 		}
 
 		for _, entry := range pl.Entries {
-			fmt.Fprintf(&b, "%s prefix-list %s seq %d %s %s\n",
+			fmt.Fprintf(&b, "%s prefix-list %s seq %d %s %s",
 				prefix, pl.Name, entry.Seq, entry.Action, entry.Prefix)
+			if entry.GE > 0 {
+				fmt.Fprintf(&b, " ge %d", entry.GE)
+			}
+			if entry.LE > 0 {
+				fmt.Fprintf(&b, " le %d", entry.LE)
+			}
+			b.WriteString("\n")
 		}
 		b.WriteString("!\n")
 	}
@@ -466,6 +597,18 @@ func GenerateRouteMapConfig(routeMaps []RouteMap, prefixLists []PrefixList) (str
 				fmt.Fprintf(&b, " set community %s\n", entry.SetCommunity)
 			}
 
+			if entry.SetLargeCommunity != "" {
+				fmt.Fprintf(&b, " set large-community %s\n", entry.SetLargeCommunity)
+			}
+
+			if entry.SetExtCommunityRT != "" {
+				fmt.Fprintf(&b, " set extcommunity rt %s\n", entry.SetExtCommunityRT)
+			}
+
+			if entry.SetExtCommunitySoO != "" {
+				fmt.Fprintf(&b, " set extcommunity soo %s\n", entry.SetExtCommunitySoO)
+			}
+
 			b.WriteString("!\n")
 		}
 	}
@@ -507,6 +650,13 @@ func validatePolicyObjects(prefixLists []PrefixList, routeMaps []RouteMap) error
 			if prefixIPv6 != list.IsIPv6 {
 				return NewInvalidConfigError(fmt.Sprintf("prefix-list %s entry %d address family does not match configured address family", list.Name, entry.Seq))
 			}
+			prefixLength, bits := prefixNet.Mask.Size()
+			if entry.GE != 0 && (entry.GE <= prefixLength || entry.GE > bits) {
+				return NewInvalidConfigError(fmt.Sprintf("prefix-list %s entry %d ge %d must be between %d and %d", list.Name, entry.Seq, entry.GE, prefixLength+1, bits))
+			}
+			if entry.LE != 0 && (entry.LE <= prefixLength || entry.LE > bits || entry.LE < entry.GE) {
+				return NewInvalidConfigError(fmt.Sprintf("prefix-list %s entry %d le %d must be between %d and %d and not below ge", list.Name, entry.Seq, entry.LE, prefixLength+1, bits))
+			}
 		}
 	}
 
@@ -614,3 +764,23 @@ func isIPv6Prefix(prefix string) bool {
 	}
 	return ip.To4() == nil
 }
+
+// setRouteMapCommunity stores a validated policy community on the FRR
+// route-map field that matches its form: target:/origin: extended
+// communities, three-field large communities, or standard and well-known
+// communities.
+func setRouteMapCommunity(entry *RouteMapEntry, community string) {
+	if value, ok := strings.CutPrefix(community, "target:"); ok {
+		entry.SetExtCommunityRT = value
+		return
+	}
+	if value, ok := strings.CutPrefix(community, "origin:"); ok {
+		entry.SetExtCommunitySoO = value
+		return
+	}
+	if strings.Count(community, ":") == 2 {
+		entry.SetLargeCommunity = community
+		return
+	}
+	entry.SetCommunity = community
+}
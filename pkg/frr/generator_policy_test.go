@@ -1122,6 +1122,58 @@ func TestCommunityFormats(t *testing.T) {
 	}
 }
 
+// TestLargeAndExtendedCommunities tests that non-standard communities use the matching FRR set clause
+func TestLargeAndExtendedCommunities(t *testing.T) {
+	acceptTrue := true
+
+	tests := []struct {
+		community string
+		wantLine  string
+	}{
+		{"65000:1:2", "set large-community 65000:1:2"},
+		{"target:65000:100", "set extcommunity rt 65000:100"},
+		{"origin:192.0.2.1:7", "set extcommunity soo 192.0.2.1:7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.community, func(t *testing.T) {
+			input := map[string]*config.PolicyStatement{
+				"COMM": {
+					Name: "COMM",
+					Terms: []*config.PolicyTerm{
+						{
+							Name: "TERM1",
+							Then: &config.PolicyActions{
+								Accept:    &acceptTrue,
+								Community: tt.community,
+							},
+						},
+					},
+				},
+			}
+
+			routeMaps, _, err := convertPolicyStatements(input)
+			if err != nil {
+				t.Fatalf("convertPolicyStatements() error = %v", err)
+			}
+			if routeMaps[0].Entries[0].SetCommunity != "" {
+				t.Errorf("SetCommunity = %q, want empty", routeMaps[0].Entries[0].SetCommunity)
+			}
+
+			result, err := GenerateRouteMapConfig(routeMaps, nil)
+			if err != nil {
+				t.Fatalf("GenerateRouteMapConfig() error = %v", err)
+			}
+			if !strings.Contains(result, tt.wantLine) {
+				t.Errorf("Expected %q in output:\n%s", tt.wantLine, result)
+			}
+			if strings.Contains(result, "set community") {
+				t.Errorf("Unexpected standard community clause in output:\n%s", result)
+			}
+		})
+	}
+}
+
 // Additional tests for comprehensive coverage
 
 // TestPrefixListConfigEmpty tests empty prefix-list config generation
@@ -1402,3 +1454,132 @@ func TestHighLocalPreferenceValue(t *testing.T) {
 		t.Error("Expected local-preference 4294967295")
 	}
 }
+
+// TestRouteFilterPrefixLists tests that route-filter match types become inline prefix-lists with ge/le
+func TestRouteFilterPrefixLists(t *testing.T) {
+	acceptTrue := true
+	cfg := &config.Config{
+		PolicyOptions: &config.PolicyOptions{
+			PolicyStatements: map[string]*config.PolicyStatement{
+				"IMPORT": {
+					Name: "IMPORT",
+					Terms: []*config.PolicyTerm{
+						{
+							Name: "FILTERS",
+							From: &config.PolicyMatchConditions{
+								RouteFilters: []*config.RouteFilter{
+									{Prefix: "10.0.0.0/8", MatchType: "exact"},
+									{Prefix: "172.16.0.0/12", MatchType: "orlonger"},
+									{Prefix: "192.168.0.0/16", MatchType: "longer"},
+									{Prefix: "100.64.0.0/10", MatchType: "upto", MaxLength: 24},
+									{Prefix: "198.18.0.0/15", MatchType: "prefix-length-range", MinLength: 16, MaxLength: 24},
+									{Prefix: "203.0.113.0/24", MatchType: "prefix-length-range", MinLength: 24, MaxLength: 28},
+									{Prefix: "2001:db8::/32", MatchType: "upto", MaxLength: 48},
+								},
+							},
+							Then: &config.PolicyActions{Accept: &acceptTrue},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	prefixLists, routeMaps, _, err := convertPolicyOptions(cfg)
+	if err != nil {
+		t.Fatalf("convertPolicyOptions() error = %v", err)
+	}
+
+	gotMatches := routeMaps[0].Entries[0].MatchPrefixLists
+	wantMatches := []string{"ARCA-IMPORT-10-RF-V4", "ARCA-IMPORT-10-RF-V6"}
+	if strings.Join(gotMatches, ",") != strings.Join(wantMatches, ",") {
+		t.Fatalf("MatchPrefixLists = %v, want %v", gotMatches, wantMatches)
+	}
+
+	out, err := GeneratePrefixListConfig(prefixLists)
+	if err != nil {
+		t.Fatalf("GeneratePrefixListConfig() error = %v", err)
+	}
+	for _, want := range []string{
+		"ip prefix-list ARCA-IMPORT-10-RF-V4 seq 10 permit 10.0.0.0/8\n",
+		"ip prefix-list ARCA-IMPORT-10-RF-V4 seq 20 permit 172.16.0.0/12 le 32\n",
+		"ip prefix-list ARCA-IMPORT-10-RF-V4 seq 30 permit 192.168.0.0/16 ge 17 le 32\n",
+		"ip prefix-list ARCA-IMPORT-10-RF-V4 seq 40 permit 100.64.0.0/10 le 24\n",
+		"ip prefix-list ARCA-IMPORT-10-RF-V4 seq 50 permit 198.18.0.0/15 ge 16 le 24\n",
+		"ip prefix-list ARCA-IMPORT-10-RF-V4 seq 60 permit 203.0.113.0/24 le 28\n",
+		"ipv6 prefix-list ARCA-IMPORT-10-RF-V6 seq 10 permit 2001:db8::/32 le 48\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("prefix-list output missing %q:\n%s", want, out)
+		}
+	}
+
+	routeMapOut, err := GenerateRouteMapConfig(routeMaps, prefixLists)
+	if err != nil {
+		t.Fatalf("GenerateRouteMapConfig() error = %v", err)
+	}
+	for _, want := range []string{
+		" match ip address prefix-list ARCA-IMPORT-10-RF-V4\n",
+		" match ipv6 address prefix-list ARCA-IMPORT-10-RF-V6\n",
+	} {
+		if !strings.Contains(routeMapOut, want) {
+			t.Errorf("route-map output missing %q:\n%s", want, routeMapOut)
+		}
+	}
+
+	commands := commandsFromOps(buildPrefixListOps(prefixLists))
+	for _, want := range []string{
+		"[name='ARCA-IMPORT-10-RF-V4']/entry[sequence='30']/ipv4-prefix-length-greater-or-equal 17",
+		"[name='ARCA-IMPORT-10-RF-V4']/entry[sequence='30']/ipv4-prefix-length-lesser-or-equal 32",
+		"[name='ARCA-IMPORT-10-RF-V6']/entry[sequence='10']/ipv6-prefix-length-lesser-or-equal 48",
+	} {
+		if !strings.Contains(commands, want) {
+			t.Errorf("mgmt commands missing %q:\n%s", want, commands)
+		}
+	}
+}
+
+// TestRouteFilterPrefixListsAggregateWithNamedPrefixList tests route-filters combined with a named prefix-list
+func TestRouteFilterPrefixListsAggregateWithNamedPrefixList(t *testing.T) {
+	cfg := &config.Config{
+		PolicyOptions: &config.PolicyOptions{
+			PrefixLists: map[string]*config.PrefixList{
+				"CUSTOMERS": {Name: "CUSTOMERS", Prefixes: []string{"192.0.2.0/24"}},
+			},
+			PolicyStatements: map[string]*config.PolicyStatement{
+				"IMPORT": {
+					Name: "IMPORT",
+					Terms: []*config.PolicyTerm{
+						{
+							Name: "MIXED",
+							From: &config.PolicyMatchConditions{
+								PrefixLists:  []string{"CUSTOMERS"},
+								RouteFilters: []*config.RouteFilter{{Prefix: "10.0.0.0/8", MatchType: "orlonger"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	prefixLists, routeMaps, _, err := convertPolicyOptions(cfg)
+	if err != nil {
+		t.Fatalf("convertPolicyOptions() error = %v", err)
+	}
+	if got := routeMaps[0].Entries[0].MatchPrefixLists; len(got) != 1 || got[0] != "ARCA-IMPORT-10-V4" {
+		t.Fatalf("MatchPrefixLists = %v, want aggregated ARCA-IMPORT-10-V4", got)
+	}
+	out, err := GeneratePrefixListConfig(prefixLists)
+	if err != nil {
+		t.Fatalf("GeneratePrefixListConfig() error = %v", err)
+	}
+	for _, want := range []string{
+		"ip prefix-list ARCA-IMPORT-10-V4 seq 10 permit 192.0.2.0/24\n",
+		"ip prefix-list ARCA-IMPORT-10-V4 seq 20 permit 10.0.0.0/8 le 32\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("prefix-list output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package frr
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GenerateProtocolRemovals generates the commands that tear down the routing
+// instances and interface bindings of previous that current no longer has.
+// File-based apply methods which only add lines (vtysh -f) otherwise keep a
+// deleted protocol and its sessions running.
+func GenerateProtocolRemovals(previous, current *Config) string {
+	if previous == nil {
+		return ""
+	}
+	if current == nil {
+		current = &Config{}
+	}
+
+	var routers []string
+	for _, instance := range removedBGPInstances(previous, current) {
+		routers = append(routers, "no "+instance)
+	}
+	if previous.OSPF != nil && current.OSPF == nil {
+		routers = append(routers, "no router ospf")
+	}
+	if previous.OSPF3 != nil && current.OSPF3 == nil {
+		routers = append(routers, "no router ospf6")
+	}
+	if previous.RIP != nil && current.RIP == nil {
+		routers = append(routers, "no router rip")
+	}
+
+	var b strings.Builder
+	if len(routers) > 0 {
+		b.WriteString("!\n")
+		for _, router := range routers {
+			b.WriteString(router + "\n")
+		}
+		b.WriteString("!\n")
+	}
+	writeOSPFInterfaceRemovals(&b, previous.OSPF, current.OSPF, false)
+	writeOSPFInterfaceRemovals(&b, previous.OSPF3, current.OSPF3, true)
+	return b.String()
+}
+
+// removedBGPInstances returns the "router bgp" instances of previous missing
+// from current. VRF instances come first because FRR refuses to delete the
+// default instance while VRF instances still exist.
+func removedBGPInstances(previous, current *Config) []string {
+	currentInstances := make(map[string]bool)
+	for _, instance := range bgpInstances(current) {
+		currentInstances[instance] = true
+	}
+	var vrfs []string
+	var defaults []string
+	for _, instance := range bgpInstances(previous) {
+		if currentInstances[instance] {
+			continue
+		}
+		if strings.Contains(instance, " vrf ") {
+			vrfs = append(vrfs, instance)
+		} else {
+			defaults = append(defaults, instance)
+		}
+	}
+	sort.Strings(vrfs)
+	return append(vrfs, defaults...)
+}
+
+func bgpInstances(cfg *Config) []string {
+	var instances []string
+	if cfg.BGP != nil {
+		instances = append(instances, fmt.Sprintf("router bgp %d", cfg.BGP.ASN))
+	}
+	for _, vrf := range cfg.VRFs {
+		if vrfHasBGPConfig(vrf) {
+			instances = append(instances, fmt.Sprintf("router bgp %d vrf %s", vrf.ASN, vrf.Name))
+		}
+	}
+	return instances
+}
+
+// writeOSPFInterfaceRemovals detaches the OSPF interface settings that
+// current no longer carries. They live in interface sections, which
+// "no router ospf" leaves behind.
+func writeOSPFInterfaceRemovals(b *strings.Builder, previous, current *OSPFConfig, isOSPFv3 bool) {
+	if previous == nil {
+		return
+	}
+
+	currentInterfaces := make(map[string]OSPFInterface)
+	if current != nil {
+		for _, iface := range current.Interfaces {
+			currentInterfaces[iface.Name] = iface
+		}
+	}
+	interfaces := make([]OSPFInterface, len(previous.Interfaces))
+	copy(interfaces, previous.Interfaces)
+	sort.Slice(interfaces, func(i, j int) bool {
+		return interfaces[i].Name < interfaces[j].Name
+	})
+
+	prefix := " ip ospf"
+	if isOSPFv3 {
+		prefix = " ipv6 ospf6"
+	}
+	for _, old := range interfaces {
+		cur := currentInterfaces[old.Name]
+		var lines []string
+		if isOSPFv3 && old.AreaID != "" && old.AreaID != cur.AreaID {
+			lines = append(lines, " no"+prefix+" area "+old.AreaID)
+		}
+		if old.Passive && !cur.Passive {
+			lines = append(lines, " no"+prefix+" passive")
+		}
+		if old.Metric > 0 && cur.Metric == 0 {
+			lines = append(lines, " no"+prefix+" cost")
+		}
+		if old.Priority != nil && cur.Priority == nil {
+			lines = append(lines, " no"+prefix+" priority")
+		}
+		if (old.BFD || old.BFDProfile != "") && !cur.BFD && cur.BFDProfile == "" {
+			lines = append(lines, " no"+prefix+" bfd")
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		fmt.Fprintf(b, "interface %s\n", old.Name)
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("!\n")
+	}
+}
@@ -0,0 +1,76 @@
+package frr
+
+import "testing"
+
+func TestGenerateProtocolRemovals(t *testing.T) {
+	priority := 10
+	previous := &Config{
+		BGP: &BGPConfig{ASN: 65001},
+		VRFs: []VRFConfig{
+			{Name: "CUST-A", ASN: 65001, RouteDistinguisher: "65001:1", ExportTargets: []string{"65001:1"}},
+		},
+		OSPF: &OSPFConfig{
+			RouterID: "192.0.2.1",
+			Interfaces: []OSPFInterface{
+				{Name: "ge0-0-1", AreaID: "0.0.0.0", Metric: 20},
+				{Name: "ge0-0-0", AreaID: "0.0.0.0", Passive: true, Priority: &priority, BFD: true},
+			},
+		},
+		OSPF3: &OSPFConfig{
+			IsOSPFv3:   true,
+			Interfaces: []OSPFInterface{{Name: "ge0-0-0", AreaID: "0.0.0.1"}},
+		},
+		RIP: &RIPConfig{Interfaces: []RIPInterface{{Name: "ge0-0-0"}}},
+	}
+
+	tests := []struct {
+		name    string
+		current *Config
+		want    string
+	}{
+		{
+			name:    "unchanged",
+			current: previous,
+			want:    "",
+		},
+		{
+			name:    "all protocols deleted",
+			current: &Config{},
+			want: "!\n" +
+				"no router bgp 65001 vrf CUST-A\n" +
+				"no router bgp 65001\n" +
+				"no router ospf\n" +
+				"no router ospf6\n" +
+				"no router rip\n" +
+				"!\n" +
+				"interface ge0-0-0\n no ip ospf passive\n no ip ospf priority\n no ip ospf bfd\n!\n" +
+				"interface ge0-0-1\n no ip ospf cost\n!\n" +
+				"interface ge0-0-0\n no ipv6 ospf6 area 0.0.0.1\n!\n",
+		},
+		{
+			name: "BGP ASN changed and OSPF interface settings cleared",
+			current: &Config{
+				BGP:   &BGPConfig{ASN: 65002},
+				OSPF:  &OSPFConfig{Interfaces: []OSPFInterface{{Name: "ge0-0-0", AreaID: "0.0.0.0", Passive: true, Priority: &priority, BFD: true}}},
+				OSPF3: &OSPFConfig{IsOSPFv3: true, Interfaces: []OSPFInterface{{Name: "ge0-0-0", AreaID: "0.0.0.2"}}},
+				RIP:   previous.RIP,
+			},
+			want: "!\n" +
+				"no router bgp 65001 vrf CUST-A\n" +
+				"no router bgp 65001\n" +
+				"!\n" +
+				"interface ge0-0-1\n no ip ospf cost\n!\n" +
+				"interface ge0-0-0\n no ipv6 ospf6 area 0.0.0.1\n!\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GenerateProtocolRemovals(previous, tt.current); got != tt.want {
+				t.Errorf("GenerateProtocolRemovals() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if got := GenerateProtocolRemovals(nil, previous); got != "" {
+		t.Errorf("GenerateProtocolRemovals(nil) = %q, want empty", got)
+	}
+}
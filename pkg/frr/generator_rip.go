@@ -0,0 +1,92 @@
+package frr
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FRR ripd defaults used when only some of the basic timers are configured.
+const (
+	defaultRIPUpdateInterval = 30
+	defaultRIPTimeout        = 180
+	defaultRIPGarbageCollect = 120
+)
+
+// GenerateRIPConfig generates FRR RIPv2 configuration from RIPConfig.
+func GenerateRIPConfig(cfg *RIPConfig) (string, error) {
+	if cfg == nil {
+		return "", nil
+	}
+	if err := validateRIPConfig(cfg); err != nil {
+		return "", err
+	}
+
+	interfaces := make([]RIPInterface, len(cfg.Interfaces))
+	copy(interfaces, cfg.Interfaces)
+	sort.Slice(interfaces, func(i, j int) bool {
+		return interfaces[i].Name < interfaces[j].Name
+	})
+
+	var b strings.Builder
+	b.WriteString("!\n")
+	b.WriteString("router rip\n")
+	b.WriteString(" version 2\n")
+
+	if cfg.UpdateInterval != 0 || cfg.Timeout != 0 || cfg.GarbageCollect != 0 {
+		fmt.Fprintf(&b, " timers basic %d %d %d\n",
+			ripTimerOrDefault(cfg.UpdateInterval, defaultRIPUpdateInterval),
+			ripTimerOrDefault(cfg.Timeout, defaultRIPTimeout),
+			ripTimerOrDefault(cfg.GarbageCollect, defaultRIPGarbageCollect))
+	}
+	if cfg.DefaultMetric != 0 {
+		fmt.Fprintf(&b, " default-metric %d\n", cfg.DefaultMetric)
+	}
+	for _, iface := range interfaces {
+		fmt.Fprintf(&b, " network %s\n", iface.Name)
+	}
+	for _, iface := range interfaces {
+		if iface.Passive {
+			fmt.Fprintf(&b, " passive-interface %s\n", iface.Name)
+		}
+	}
+	b.WriteString("!\n")
+
+	return b.String(), nil
+}
+
+func ripTimerOrDefault(value, fallback int) int {
+	if value == 0 {
+		return fallback
+	}
+	return value
+}
+
+func validateRIPConfig(cfg *RIPConfig) error {
+	if len(cfg.Interfaces) == 0 {
+		return NewInvalidConfigError("RIP requires at least one interface")
+	}
+	for name, value := range map[string]int{
+		"update-interval": cfg.UpdateInterval,
+		"route-timeout":   cfg.Timeout,
+		"holddown":        cfg.GarbageCollect,
+	} {
+		if value != 0 && (value < 5 || value > 65535) {
+			return NewInvalidConfigError(fmt.Sprintf("RIP %s %d must be 5-65535", name, value))
+		}
+	}
+	if cfg.DefaultMetric < 0 || cfg.DefaultMetric > 16 {
+		return NewInvalidConfigError(fmt.Sprintf("RIP default-metric %d must be 1-16", cfg.DefaultMetric))
+	}
+	seen := make(map[string]struct{}, len(cfg.Interfaces))
+	for _, iface := range cfg.Interfaces {
+		if iface.Name == "" {
+			return NewInvalidConfigError("RIP interface name is required")
+		}
+		if _, ok := seen[iface.Name]; ok {
+			return NewInvalidConfigError(fmt.Sprintf("RIP interface %s is duplicated", iface.Name))
+		}
+		seen[iface.Name] = struct{}{}
+	}
+	return nil
+}
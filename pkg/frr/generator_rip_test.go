@@ -0,0 +1,125 @@
+package frr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/akam1o/arca-router/pkg/config"
+)
+
+func TestGenerateRIPConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *RIPConfig
+		want    []string
+		notWant []string
+		wantErr bool
+	}{
+		{
+			name: "basic RIP with sorted networks",
+			cfg: &RIPConfig{
+				Interfaces: []RIPInterface{
+					{Name: "ge0-0-1"},
+					{Name: "ge0-0-0"},
+				},
+			},
+			want: []string{
+				"router rip\n version 2\n network ge0-0-0\n network ge0-0-1\n",
+			},
+			notWant: []string{"timers basic", "default-metric", "passive-interface"},
+		},
+		{
+			name: "RIP with passive interface",
+			cfg: &RIPConfig{
+				Interfaces: []RIPInterface{
+					{Name: "ge0-0-0"},
+					{Name: "lo0", Passive: true},
+				},
+			},
+			want: []string{
+				" network lo0\n passive-interface lo0\n",
+			},
+			notWant: []string{"passive-interface ge0-0-0"},
+		},
+		{
+			name: "RIP timers fill FRR defaults",
+			cfg: &RIPConfig{
+				UpdateInterval: 10,
+				DefaultMetric:  3,
+				Interfaces:     []RIPInterface{{Name: "ge0-0-0"}},
+			},
+			want: []string{
+				" timers basic 10 180 120\n",
+				" default-metric 3\n",
+			},
+		},
+		{
+			name:    "no interfaces",
+			cfg:     &RIPConfig{},
+			wantErr: true,
+		},
+		{
+			name: "timer out of range",
+			cfg: &RIPConfig{
+				GarbageCollect: 1,
+				Interfaces:     []RIPInterface{{Name: "ge0-0-0"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate interface",
+			cfg: &RIPConfig{
+				Interfaces: []RIPInterface{{Name: "ge0-0-0"}, {Name: "ge0-0-0", Passive: true}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateRIPConfig(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GenerateRIPConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("GenerateRIPConfig() missing %q in:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("GenerateRIPConfig() unexpectedly contains %q in:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertRIPConfigMapsInterfaces(t *testing.T) {
+	rip := &config.RIPConfig{
+		Holddown: 60,
+		Groups: map[string]*config.RIPGroup{
+			"EDGE": {Name: "EDGE", Neighbors: map[string]*config.RIPNeighbor{
+				"ge-0/0/0": {Name: "ge-0/0/0", Passive: true},
+			}},
+		},
+	}
+
+	frrRIP, err := convertRIPConfig(rip, map[string]string{"ge-0/0/0": "ge0-0-0"})
+	if err != nil {
+		t.Fatalf("convertRIPConfig() error = %v", err)
+	}
+	if frrRIP.GarbageCollect != 60 {
+		t.Fatalf("GarbageCollect = %d, want 60", frrRIP.GarbageCollect)
+	}
+	if len(frrRIP.Interfaces) != 1 || frrRIP.Interfaces[0] != (RIPInterface{Name: "ge0-0-0", Passive: true}) {
+		t.Fatalf("Interfaces = %#v, want passive ge0-0-0", frrRIP.Interfaces)
+	}
+
+	if _, err := convertRIPConfig(rip, map[string]string{}); err == nil {
+		t.Fatal("convertRIPConfig() error = nil, want missing interface mapping error")
+	}
+}
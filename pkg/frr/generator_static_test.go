@@ -41,6 +41,20 @@ func TestGenerateStaticRouteConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "IPv4 floating static route",
+			routes: []StaticRoute{
+				{
+					Prefix:   "0.0.0.0/0",
+					NextHop:  "192.0.2.1",
+					Distance: 200,
+				},
+			},
+			want: []string{
+				"ip route 0.0.0.0/0 192.0.2.1 200",
+			},
+			wantErr: false,
+		},
 		{
 			name: "IPv6 static route",
 			routes: []StaticRoute{
@@ -212,6 +226,32 @@ func TestGenerateStaticRouteConfig(t *testing.T) {
 	}
 }
 
+func TestGenerateFRRConfigFileWritesStaticRouteDistance(t *testing.T) {
+	input := `set routing-options static route 0.0.0.0/0 next-hop 192.0.2.1 distance 200
+set routing-options static route 198.51.100.0/24 next-hop 192.0.2.1
+`
+	cfg, err := config.NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	frrCfg, err := GenerateFRRConfig(cfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	got, err := GenerateFRRConfigFile(frrCfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	for _, want := range []string{
+		"ip route 0.0.0.0/0 192.0.2.1 200\n",
+		"ip route 198.51.100.0/24 192.0.2.1\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("config missing %q:\n%s", want, got)
+		}
+	}
+}
+
 func TestGenerateFRRConfigConvertsBFDStaticRoute(t *testing.T) {
 	cfg := config.NewConfig()
 	cfg.RoutingOptions = &config.RoutingOptions{
@@ -161,7 +161,7 @@ func TestGenerateFRRConfig(t *testing.T) {
 							"0.0.0.0": {
 								AreaID: "0.0.0.0",
 								Interfaces: map[string]*config.OSPFInterface{
-									"ge-0/0/0": {Name: "ge-0/0/0", Metric: 20},
+									"ge-0/0/0": {Name: "ge-0/0/0", Metric: intPtr(20)},
 								},
 							},
 						},
@@ -182,6 +182,9 @@ func TestGenerateFRRConfig(t *testing.T) {
 				if len(frrCfg.OSPF3.Interfaces) != 1 || frrCfg.OSPF3.Interfaces[0].Name != "ge0-0-0" {
 					t.Fatalf("OSPF3 interfaces = %#v, want ge0-0-0", frrCfg.OSPF3.Interfaces)
 				}
+				if iface := frrCfg.OSPF3.Interfaces[0]; iface.Metric != 20 || iface.Priority != nil {
+					t.Fatalf("OSPF3 interface = %#v, want metric 20 and unset priority", iface)
+				}
 			},
 		},
 		{
@@ -426,6 +429,108 @@ func TestGenerateFRRConfigFileAllowsPolicyReferences(t *testing.T) {
 	}
 }
 
+func TestGenerateFRRConfigFileWritesGlobalRouterIDAndAS(t *testing.T) {
+	frrConfig, err := GenerateFRRConfig(&config.Config{
+		RoutingOptions: &config.RoutingOptions{AutonomousSystem: 65000, RouterID: "192.0.2.1"},
+		Protocols: &config.ProtocolConfig{
+			BGP: &config.BGPConfig{Groups: map[string]*config.BGPGroup{
+				"EBGP": {Neighbors: map[string]*config.BGPNeighbor{
+					"198.51.100.2": {IP: "198.51.100.2", PeerAS: 65001},
+				}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	if frrConfig.RouterID != "192.0.2.1" || frrConfig.ASN != 65000 {
+		t.Fatalf("global identity = %q/%d, want 192.0.2.1/65000", frrConfig.RouterID, frrConfig.ASN)
+	}
+
+	text, err := GenerateFRRConfigFile(frrConfig)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	for _, want := range []string{
+		"\nrouter-id 192.0.2.1\n",
+		"router bgp 65000\n bgp router-id 192.0.2.1\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("FRR config missing %q:\n%s", want, text)
+		}
+	}
+
+	frrConfig.BGP.ASN = 65010
+	if _, err := GenerateFRRConfigFile(frrConfig); err == nil || !strings.Contains(err.Error(), "does not match routing-options autonomous-system") {
+		t.Fatalf("GenerateFRRConfigFile() mismatched ASN error = %v", err)
+	}
+	frrConfig.BGP.ASN = 65000
+	frrConfig.RouterID = "2001:db8::1"
+	if _, err := GenerateFRRConfigFile(frrConfig); err == nil || !strings.Contains(err.Error(), "invalid global router-id") {
+		t.Fatalf("GenerateFRRConfigFile() IPv6 router-id error = %v", err)
+	}
+}
+
+func TestGenerateFRRConfigFileWritesSystemHostName(t *testing.T) {
+	frrConfig, err := GenerateFRRConfig(&config.Config{System: &config.SystemConfig{HostName: "edge-1"}})
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	text, err := GenerateFRRConfigFile(frrConfig)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	if !strings.HasPrefix(text, ConfigFileHeader+"hostname edge-1\n") {
+		t.Fatalf("FRR config does not start with the hostname:\n%s", text)
+	}
+
+	frrConfig, err = GenerateFRRConfig(&config.Config{})
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	text, err = GenerateFRRConfigFile(frrConfig)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	if strings.Contains(text, "hostname") {
+		t.Fatalf("FRR config without system host-name has a hostname:\n%s", text)
+	}
+}
+
+func TestGenerateFRRConfigFileWritesNeighborDescriptions(t *testing.T) {
+	frrConfig, err := GenerateFRRConfig(&config.Config{
+		RoutingOptions: &config.RoutingOptions{AutonomousSystem: 65000, RouterID: "192.0.2.1"},
+		Protocols: &config.ProtocolConfig{
+			BGP: &config.BGPConfig{Groups: map[string]*config.BGPGroup{
+				"EBGP": {Type: "external", Neighbors: map[string]*config.BGPNeighbor{
+					"198.51.100.2": {IP: "198.51.100.2", PeerAS: 65001, Description: "Transit \"A\" - circuit 42"},
+					"198.51.100.6": {IP: "198.51.100.6", PeerAS: 65002, Description: "IX peer\n neighbor 198.51.100.6 shutdown"},
+					"198.51.100.9": {IP: "198.51.100.9", PeerAS: 65003},
+				}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	text, err := GenerateFRRConfigFile(frrConfig)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	for _, want := range []string{
+		" neighbor 198.51.100.2 description Transit \"A\" - circuit 42\n",
+		" neighbor 198.51.100.6 description IX peer neighbor 198.51.100.6 shutdown\n",
+		" neighbor 198.51.100.9 description BGP peer in group external\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("FRR config missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "\n neighbor 198.51.100.6 shutdown") {
+		t.Fatalf("description injected a separate command:\n%s", text)
+	}
+}
+
 func TestBuildInterfaceMapping(t *testing.T) {
 	cfg := &config.Config{
 		Interfaces: map[string]*config.Interface{
@@ -629,3 +734,492 @@ func TestConvertBGPConfigPolicyValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertBGPConfigPeerGroup(t *testing.T) {
+	cfg := &config.Config{
+		RoutingOptions: &config.RoutingOptions{AutonomousSystem: 65000, RouterID: "10.0.0.1"},
+		Protocols: &config.ProtocolConfig{
+			BGP: &config.BGPConfig{
+				Groups: map[string]*config.BGPGroup{
+					"UPSTREAM": {
+						Type:   "external",
+						Import: "IMPORT",
+						Export: "EXPORT",
+						Neighbors: map[string]*config.BGPNeighbor{
+							"192.0.2.2": {IP: "192.0.2.2", PeerAS: 65001},
+							"192.0.2.3": {IP: "192.0.2.3", PeerAS: 65001},
+							"192.0.2.4": {IP: "192.0.2.4", PeerAS: 65001},
+						},
+					},
+				},
+			},
+		},
+		PolicyOptions: &config.PolicyOptions{
+			PolicyStatements: map[string]*config.PolicyStatement{
+				"IMPORT": {Name: "IMPORT"},
+				"EXPORT": {Name: "EXPORT"},
+			},
+		},
+	}
+
+	bgp, err := convertBGPConfig(cfg, nil)
+	if err != nil {
+		t.Fatalf("convertBGPConfig() error = %v", err)
+	}
+	out, err := GenerateBGPConfig(bgp)
+	if err != nil {
+		t.Fatalf("GenerateBGPConfig() error = %v", err)
+	}
+
+	want := "!\n" +
+		"router bgp 65000\n" +
+		" bgp router-id 10.0.0.1\n" +
+		" neighbor UPSTREAM peer-group\n" +
+		" neighbor UPSTREAM remote-as 65001\n" +
+		" neighbor 192.0.2.2 peer-group UPSTREAM\n" +
+		" neighbor 192.0.2.2 description BGP peer in group external\n" +
+		" neighbor 192.0.2.3 peer-group UPSTREAM\n" +
+		" neighbor 192.0.2.3 description BGP peer in group external\n" +
+		" neighbor 192.0.2.4 peer-group UPSTREAM\n" +
+		" neighbor 192.0.2.4 description BGP peer in group external\n" +
+		" !\n" +
+		" address-family ipv4 unicast\n" +
+		"  neighbor UPSTREAM activate\n" +
+		"  neighbor UPSTREAM route-map IMPORT in\n" +
+		"  neighbor UPSTREAM route-map EXPORT out\n" +
+		" exit-address-family\n" +
+		"!\n"
+	if out != want {
+		t.Errorf("GenerateBGPConfig() =\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestConvertBGPConfigRouteReflectorClients(t *testing.T) {
+	cfg := &config.Config{
+		RoutingOptions: &config.RoutingOptions{AutonomousSystem: 65000, RouterID: "10.0.0.1"},
+		Protocols: &config.ProtocolConfig{
+			BGP: &config.BGPConfig{
+				Groups: map[string]*config.BGPGroup{
+					"RR-CLIENTS": {
+						Type:    "internal",
+						Cluster: "192.0.2.1",
+						Neighbors: map[string]*config.BGPNeighbor{
+							"10.0.0.2": {IP: "10.0.0.2", PeerAS: 65000},
+						},
+					},
+					"IBGP": {
+						Type: "internal",
+						Neighbors: map[string]*config.BGPNeighbor{
+							"10.0.0.3": {IP: "10.0.0.3", PeerAS: 65000, RouteReflectorClient: true},
+							"10.0.0.4": {IP: "10.0.0.4", PeerAS: 65000},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bgp, err := convertBGPConfig(cfg, nil)
+	if err != nil {
+		t.Fatalf("convertBGPConfig() error = %v", err)
+	}
+	if bgp.ClusterID != "192.0.2.1" {
+		t.Errorf("ClusterID = %q, want 192.0.2.1", bgp.ClusterID)
+	}
+
+	out, err := GenerateBGPConfig(bgp)
+	if err != nil {
+		t.Fatalf("GenerateBGPConfig() error = %v", err)
+	}
+	for _, want := range []string{
+		" bgp cluster-id 192.0.2.1\n",
+		"  neighbor 10.0.0.2 route-reflector-client\n",
+		"  neighbor 10.0.0.3 route-reflector-client\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "neighbor 10.0.0.4 route-reflector-client") || strings.Contains(out, "neighbor IBGP route-reflector-client") {
+		t.Errorf("non-client neighbor marked as route-reflector client:\n%s", out)
+	}
+
+	commands := commandsFromOps(buildBGPOps(bgp))
+	for _, want := range []string{
+		"/frr-bgp:bgp/global/route-reflector/route-reflector-cluster-id 192.0.2.1",
+		"neighbor[remote-address='10.0.0.2']/afi-safis/afi-safi[afi-safi-name='frr-routing:ipv4-unicast']/ipv4-unicast/route-reflector/route-reflector-client true",
+	} {
+		if !strings.Contains(commands, want) {
+			t.Errorf("mgmt commands missing %q:\n%s", want, commands)
+		}
+	}
+}
+
+func TestConvertBGPConfigNextHopSelfAndRemovePrivateAS(t *testing.T) {
+	cfg := &config.Config{
+		RoutingOptions: &config.RoutingOptions{AutonomousSystem: 65000, RouterID: "10.0.0.1"},
+		Protocols: &config.ProtocolConfig{
+			BGP: &config.BGPConfig{
+				Groups: map[string]*config.BGPGroup{
+					"IBGP": {
+						Type:        "internal",
+						NextHopSelf: true,
+						Neighbors: map[string]*config.BGPNeighbor{
+							"10.0.0.2": {IP: "10.0.0.2", PeerAS: 65000},
+							"10.0.0.3": {IP: "10.0.0.3", PeerAS: 65000, RemovePrivateAS: true},
+						},
+					},
+					"EBGP": {
+						Type: "external",
+						Neighbors: map[string]*config.BGPNeighbor{
+							"192.0.2.2": {IP: "192.0.2.2", PeerAS: 65001, RemovePrivateAS: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bgp, err := convertBGPConfig(cfg, nil)
+	if err != nil {
+		t.Fatalf("convertBGPConfig() error = %v", err)
+	}
+	out, err := GenerateBGPConfig(bgp)
+	if err != nil {
+		t.Fatalf("GenerateBGPConfig() error = %v", err)
+	}
+	for _, want := range []string{
+		"  neighbor IBGP next-hop-self\n",
+		"  neighbor 10.0.0.3 remove-private-AS\n",
+		"  neighbor 192.0.2.2 remove-private-AS\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{
+		"neighbor 10.0.0.2 next-hop-self",
+		"neighbor 10.0.0.3 next-hop-self",
+		"neighbor IBGP remove-private-AS",
+		"neighbor 10.0.0.2 remove-private-AS",
+		"neighbor 192.0.2.2 next-hop-self",
+	} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("output contains %q:\n%s", unwanted, out)
+		}
+	}
+
+	commands := commandsFromOps(buildBGPOps(bgp))
+	for _, want := range []string{
+		"neighbor[remote-address='10.0.0.2']/afi-safis/afi-safi[afi-safi-name='frr-routing:ipv4-unicast']/ipv4-unicast/nexthop-self/next-hop-self true",
+		"neighbor[remote-address='192.0.2.2']/afi-safis/afi-safi[afi-safi-name='frr-routing:ipv4-unicast']/ipv4-unicast/private-as/remove-private-as true",
+	} {
+		if !strings.Contains(commands, want) {
+			t.Errorf("mgmt commands missing %q:\n%s", want, commands)
+		}
+	}
+}
+
+func TestConvertBGPConfigRouterIDPrecedence(t *testing.T) {
+	cfg := &config.Config{
+		RoutingOptions: &config.RoutingOptions{AutonomousSystem: 65000, RouterID: "10.0.0.1"},
+		Protocols: &config.ProtocolConfig{
+			BGP: &config.BGPConfig{
+				RouterID: "192.0.2.1",
+				Groups: map[string]*config.BGPGroup{
+					"IBGP": {
+						Type: "internal",
+						Neighbors: map[string]*config.BGPNeighbor{
+							"10.0.0.2": {IP: "10.0.0.2", PeerAS: 65000},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bgp, err := convertBGPConfig(cfg, nil)
+	if err != nil {
+		t.Fatalf("convertBGPConfig() error = %v", err)
+	}
+	if bgp.RouterID != "192.0.2.1" {
+		t.Fatalf("RouterID = %q, want BGP router-id 192.0.2.1", bgp.RouterID)
+	}
+	out, err := GenerateBGPConfig(bgp)
+	if err != nil {
+		t.Fatalf("GenerateBGPConfig() error = %v", err)
+	}
+	if !strings.Contains(out, " bgp router-id 192.0.2.1\n") {
+		t.Fatalf("output missing BGP router-id:\n%s", out)
+	}
+
+	cfg.Protocols.BGP.RouterID = ""
+	bgp, err = convertBGPConfig(cfg, nil)
+	if err != nil {
+		t.Fatalf("convertBGPConfig() fallback error = %v", err)
+	}
+	if bgp.RouterID != "10.0.0.1" {
+		t.Fatalf("RouterID fallback = %q, want routing-options router-id 10.0.0.1", bgp.RouterID)
+	}
+}
+
+func TestConvertOSPF3ConfigDerivesRouterIDFromBGP(t *testing.T) {
+	ospf3 := &config.OSPFConfig{Areas: map[string]*config.OSPFArea{
+		"0.0.0.0": {
+			AreaID: "0.0.0.0",
+			Interfaces: map[string]*config.OSPFInterface{
+				"ge-0/0/0": {Name: "ge-0/0/0"},
+			},
+		},
+	}}
+	cfg := &config.Config{
+		Interfaces:     map[string]*config.Interface{"ge-0/0/0": {}},
+		RoutingOptions: &config.RoutingOptions{AutonomousSystem: 65000},
+		Protocols: &config.ProtocolConfig{
+			BGP:   &config.BGPConfig{RouterID: "192.0.2.1"},
+			OSPF3: ospf3,
+		},
+	}
+	mapping := map[string]string{"ge-0/0/0": "ge0-0-0"}
+
+	frrOSPF, err := convertOSPFConfig(cfg, ospf3, mapping, true)
+	if err != nil {
+		t.Fatalf("convertOSPFConfig() error = %v", err)
+	}
+	if frrOSPF.RouterID != "192.0.2.1" {
+		t.Fatalf("OSPFv3 RouterID = %q, want derived BGP router-id 192.0.2.1", frrOSPF.RouterID)
+	}
+
+	cfg.RoutingOptions.RouterID = "10.0.0.1"
+	frrOSPF, err = convertOSPFConfig(cfg, ospf3, mapping, true)
+	if err != nil {
+		t.Fatalf("convertOSPFConfig() error = %v", err)
+	}
+	if frrOSPF.RouterID != "10.0.0.1" {
+		t.Fatalf("OSPFv3 RouterID = %q, want routing-options router-id 10.0.0.1", frrOSPF.RouterID)
+	}
+}
+
+func TestGenerateFRRConfigDerivesRouterIDFromLoopback(t *testing.T) {
+	input := `set interfaces lo0 unit 0 family inet address 10.255.0.1/32
+set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/30
+set routing-options autonomous-system 65000
+set routing-options router-id auto
+set protocols bgp group IBGP type internal
+set protocols bgp group IBGP neighbor 10.0.0.2 peer-as 65000
+set protocols ospf area 0.0.0.0 interface ge-0/0/0
+set protocols ospf area 0.0.0.0 interface lo0
+`
+	cfg, err := config.NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	frrCfg, err := GenerateFRRConfig(cfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	out, err := GenerateFRRConfigFile(frrCfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	for _, want := range []string{
+		"router-id 10.255.0.1\n",
+		" bgp router-id 10.255.0.1\n",
+		" ospf router-id 10.255.0.1\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "router-id auto") {
+		t.Errorf("output contains literal router-id auto:\n%s", out)
+	}
+}
+
+func TestGenerateFRRConfigAdministrativeDistance(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/30
+set routing-options autonomous-system 65000
+set routing-options router-id 10.255.0.1
+set routing-options administrative-distance protocol bgp 30
+set routing-options administrative-distance protocol bgp internal 190
+set routing-options administrative-distance protocol ospf 100
+set routing-options administrative-distance protocol ospf external 150
+set protocols bgp group EBGP type external
+set protocols bgp group EBGP neighbor 10.0.0.2 peer-as 65001
+set protocols ospf area 0.0.0.0 interface ge-0/0/0
+`
+	cfg, err := config.NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	frrCfg, err := GenerateFRRConfig(cfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	out, err := GenerateFRRConfigFile(frrCfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	for _, want := range []string{
+		" address-family ipv4 unicast\n  distance bgp 30 190 200\n",
+		"router ospf\n",
+		" distance 100\n",
+		" distance ospf external 150\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	cfg.RoutingOptions.AdministrativeDistance = nil
+	frrCfg, err = GenerateFRRConfig(cfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	out, err = GenerateFRRConfigFile(frrCfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	if strings.Contains(out, "distance") {
+		t.Errorf("output has distance without administrative-distance:\n%s", out)
+	}
+}
+
+func TestGenerateFRRConfigBGPUpdateSource(t *testing.T) {
+	input := `set interfaces lo0 unit 0 family inet address 10.255.0.1/32
+set interfaces ge-0/0/0 unit 0 family inet6 address 2001:db8::1/64
+set routing-options autonomous-system 65000
+set routing-options router-id 10.255.0.1
+set protocols bgp group IBGP type internal
+set protocols bgp group IBGP neighbor 10.255.0.2 peer-as 65000
+set protocols bgp group IBGP neighbor 10.255.0.2 update-source lo0
+set protocols bgp group IBGP neighbor 2001:db8::2 peer-as 65000
+set protocols bgp group IBGP neighbor 2001:db8::2 update-source 2001:db8::1
+set protocols bgp group IBGP neighbor fe80::2 peer-as 65000
+set protocols bgp group IBGP neighbor fe80::2 local-address fe80::1%ge-0/0/0
+`
+	cfg, err := config.NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	frrCfg, err := GenerateFRRConfig(cfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	out, err := GenerateBGPConfig(frrCfg.BGP)
+	if err != nil {
+		t.Fatalf("GenerateBGPConfig() error = %v", err)
+	}
+	for _, want := range []string{
+		" neighbor 10.255.0.2 update-source lo0\n",
+		" neighbor 2001:db8::2 update-source 2001:db8::1\n",
+		" neighbor fe80::2 update-source ge0-0-0\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateFRRConfigDerivesOSPFCostFromBandwidth(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/30
+set interfaces ge-0/0/1 unit 0 family inet address 10.0.1.1/30
+set interfaces ge-0/0/1 bandwidth 10m
+set interfaces ge-0/0/2 unit 0 family inet address 10.0.2.1/30
+set interfaces ge-0/0/2 bandwidth 10g
+set interfaces ge-0/0/3 unit 0 family inet address 10.0.3.1/30
+set interfaces ge-0/0/3 bandwidth 1500k
+set routing-options router-id 10.255.0.1
+set protocols ospf area 0.0.0.0 interface ge-0/0/0
+set protocols ospf area 0.0.0.0 interface ge-0/0/1
+set protocols ospf area 0.0.0.0 interface ge-0/0/2
+set protocols ospf area 0.0.0.0 interface ge-0/0/3 metric 5
+`
+	cfg, err := config.NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	frrCfg, err := GenerateFRRConfig(cfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	costs := make(map[string]int)
+	for _, iface := range frrCfg.OSPF.Interfaces {
+		costs[iface.Name] = iface.Metric
+	}
+	want := map[string]int{
+		"ge0-0-0": 0,  // no bandwidth: FRR auto-cost from the link speed
+		"ge0-0-1": 10, // 100m reference / 10m
+		"ge0-0-2": 1,  // faster than the reference bandwidth
+		"ge0-0-3": 5,  // an explicit metric wins over bandwidth
+	}
+	for name, cost := range want {
+		if costs[name] != cost {
+			t.Errorf("OSPF cost of %s = %d, want %d", name, costs[name], cost)
+		}
+	}
+
+	out, err := GenerateFRRConfigFile(frrCfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	if want := "interface ge0-0-1\n ip ospf cost 10\n"; !strings.Contains(out, want) {
+		t.Errorf("output missing %q:\n%s", want, out)
+	}
+}
+
+func TestGenerateFRRConfigUsesOSPFReferenceBandwidth(t *testing.T) {
+	input := `set interfaces ge-0/0/0 unit 0 family inet address 10.0.0.1/30
+set interfaces ge-0/0/0 bandwidth 10g
+set interfaces ge-0/0/1 unit 0 family inet address 10.0.1.1/30
+set interfaces ge-0/0/1 bandwidth 1g
+set routing-options router-id 10.255.0.1
+set protocols ospf reference-bandwidth 100g
+set protocols ospf area 0.0.0.0 interface ge-0/0/0
+set protocols ospf area 0.0.0.0 interface ge-0/0/1
+`
+	cfg, err := config.NewParser(strings.NewReader(input)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	frrCfg, err := GenerateFRRConfig(cfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfig() error = %v", err)
+	}
+	costs := make(map[string]int)
+	for _, iface := range frrCfg.OSPF.Interfaces {
+		costs[iface.Name] = iface.Metric
+	}
+	// Both links are faster than FRR's default 100 Mbit/s reference.
+	if costs["ge0-0-0"] != 10 || costs["ge0-0-1"] != 100 {
+		t.Fatalf("OSPF costs = %v, want 10 for 10g and 100 for 1g", costs)
+	}
+
+	out, err := GenerateFRRConfigFile(frrCfg)
+	if err != nil {
+		t.Fatalf("GenerateFRRConfigFile() error = %v", err)
+	}
+	if want := "router ospf\n ospf router-id 10.255.0.1\n auto-cost reference-bandwidth 100000\n"; !strings.Contains(out, want) {
+		t.Errorf("output missing %q:\n%s", want, out)
+	}
+}
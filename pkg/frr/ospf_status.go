@@ -119,11 +119,11 @@ func parseOSPFNeighborObject(fallbackRouterID string, object map[string]any) (OS
 	return OSPFNeighbor{
 		RouterID:     routerID,
 		Address:      stringFromNormalized(object, "address", "neighboraddress", "neighborip", "ifaceaddress", "interfaceaddress", "linklocaladdress"),
-		Interface:    stringFromNormalized(object, "interface", "ifname", "ifacename", "interfacename"),
+		Interface:    ospfInterfaceName(stringFromNormalized(object, "interfacename", "interface", "ifname", "ifacename")),
 		State:        state,
 		Role:         role,
 		Priority:     uint32FromNormalized(object, "priority", "nbrpriority", "neighborpriority"),
-		DeadTimeSecs: ospfSecondsFromNormalized(object, []string{"deadtimemsec", "deadtimeinmsec", "deadmilliseconds"}, []string{"deadtime", "dead", "timer"}),
+		DeadTimeSecs: ospfSecondsFromNormalized(object, []string{"deadtimemsecs", "deadtimemsec", "deadtimeinmsec", "routerdeadintervaltimerduemsec", "deadmilliseconds"}, []string{"deadtime", "dead", "timer"}),
 		UptimeSecs:   ospfSecondsFromNormalized(object, []string{"uptimemsec", "uptimeinmsec", "durationmsec"}, []string{"uptime", "duration", "updown"}),
 	}, true
 }
@@ -143,9 +143,20 @@ func looksLikeOSPFRouterID(value string) bool {
 	return err == nil && addr.Is4()
 }
 
+// ospfInterfaceName drops the local address FRR appends to OSPFv2 interface
+// names ("ge0-0-0:192.0.2.1").
+func ospfInterfaceName(name string) string {
+	if i := strings.LastIndex(name, ":"); i > 0 {
+		if addr, err := netip.ParseAddr(name[i+1:]); err == nil && addr.Is4() {
+			return name[:i]
+		}
+	}
+	return name
+}
+
 func ospfStateAndRole(object map[string]any) (string, string) {
 	state := stateFromNormalized(object, "state", "nbrstate", "neighborstate", "ospfstate")
-	role := stateFromNormalized(object, "role", "neighborrole")
+	role := stateFromNormalized(object, "role", "neighborrole", "ifstate")
 	if before, after, ok := strings.Cut(state, "/"); ok {
 		state = strings.TrimSpace(before)
 		if role == "" {
@@ -2,6 +2,7 @@ package frr
 
 import (
 	"context"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -79,3 +80,61 @@ func TestVtyshOSPFNeighborStatusReaderUsesFamilyCommands(t *testing.T) {
 		t.Fatalf("commands = %q, want %q", strings.Join(commands, "\n"), want)
 	}
 }
+
+func TestParseOSPFNeighborJSONAcceptsCapturedFRROutput(t *testing.T) {
+	// Captured from FRR 9 "show ip ospf neighbor json", which suffixes the
+	// interface name with the local address and reports the dead timer in
+	// milliseconds.
+	status, err := ParseOSPFNeighborJSON([]byte(`{
+		"neighbors": {
+			"10.0.0.2": [
+				{
+					"nbrPriority": 1, "nbrState": "Full/DR", "converged": "Full", "role": "DR",
+					"upTimeInMsec": 3723000, "routerDeadIntervalTimerDueMsec": 33979,
+					"upTime": "1h02m03s", "deadTime": "33.979s",
+					"ifaceAddress": "192.0.2.2", "ifaceName": "ge0-0-0:192.0.2.1",
+					"linkStateRetransmissionListCounter": 0, "linkStateRequestListCounter": 0,
+					"databaseSummaryListCounter": 0
+				}
+			],
+			"10.0.0.3": [
+				{
+					"nbrPriority": 0, "nbrState": "2-Way/DROther", "converged": "2-Way",
+					"upTimeInMsec": 1000, "deadTimeMsecs": 39000,
+					"ifaceAddress": "192.0.2.3", "ifaceName": "ge0-0-0:192.0.2.1"
+				}
+			]
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("ParseOSPFNeighborJSON() error = %v", err)
+	}
+	want := []OSPFNeighbor{
+		{RouterID: "10.0.0.2", Address: "192.0.2.2", Interface: "ge0-0-0", State: "Full", Role: "DR", Priority: 1, DeadTimeSecs: 33, UptimeSecs: 3723},
+		{RouterID: "10.0.0.3", Address: "192.0.2.3", Interface: "ge0-0-0", State: "2-Way", Role: "DROther", DeadTimeSecs: 39, UptimeSecs: 1},
+	}
+	if !reflect.DeepEqual(status.Neighbors, want) {
+		t.Fatalf("neighbors = %#v, want %#v", status.Neighbors, want)
+	}
+
+	// Captured from FRR 9 "show ipv6 ospf6 neighbor json"; ifState is the
+	// neighbor's DR role on the link.
+	status, err = ParseOSPFNeighborJSON([]byte(`{
+		"neighbors": [
+			{
+				"neighborId": "10.0.0.2", "priority": 1, "deadTime": "00:00:35",
+				"state": "Full", "ifState": "DR", "duration": "01:02:03",
+				"interfaceName": "ge0-0-0", "interfaceState": "BDR"
+			}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseOSPFNeighborJSON(ospf6) error = %v", err)
+	}
+	want = []OSPFNeighbor{
+		{RouterID: "10.0.0.2", Interface: "ge0-0-0", State: "Full", Role: "DR", Priority: 1, DeadTimeSecs: 35, UptimeSecs: 3723},
+	}
+	if !reflect.DeepEqual(status.Neighbors, want) {
+		t.Fatalf("ospf6 neighbors = %#v, want %#v", status.Neighbors, want)
+	}
+}
@@ -0,0 +1,122 @@
+package frr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RouteSummaryReader reads FRR's per-protocol route counts.
+type RouteSummaryReader interface {
+	ReadRouteSummary(ctx context.Context) (*RouteSummary, error)
+}
+
+// RouteSummary is the parsed output of FRR's route summary JSON commands.
+type RouteSummary struct {
+	Families []RouteFamilySummary
+}
+
+// RouteFamilySummary holds the route counts of one address family.
+type RouteFamilySummary struct {
+	// Family is "inet" or "inet6".
+	Family    string
+	Protocols []RouteProtocolCount
+	Total     uint32
+}
+
+// RouteProtocolCount is the number of RIB and installed FIB routes of one
+// protocol.
+type RouteProtocolCount struct {
+	Protocol  string
+	Routes    uint32
+	FIBRoutes uint32
+}
+
+// VtyshRouteSummaryReader reads IPv4 and IPv6 route counts through vtysh.
+type VtyshRouteSummaryReader struct {
+	run VtyshRunner
+}
+
+// NewVtyshRouteSummaryReader creates a vtysh-backed route summary reader.
+func NewVtyshRouteSummaryReader() *VtyshRouteSummaryReader {
+	return &VtyshRouteSummaryReader{run: runVtyshMgmtCommand}
+}
+
+// NewVtyshRouteSummaryReaderWithRunner creates a reader with a test runner.
+func NewVtyshRouteSummaryReaderWithRunner(run VtyshRunner) *VtyshRouteSummaryReader {
+	return &VtyshRouteSummaryReader{run: run}
+}
+
+// ReadRouteSummary executes FRR's JSON route summary commands and parses the
+// result. Unlike ReadRouteStatus it never transfers the routing table itself.
+func (r *VtyshRouteSummaryReader) ReadRouteSummary(ctx context.Context) (*RouteSummary, error) {
+	if r.run == nil {
+		r.run = runVtyshMgmtCommand
+	}
+	summary := &RouteSummary{}
+	for _, family := range []struct{ name, command string }{
+		{name: "inet", command: "show ip route summary json"},
+		{name: "inet6", command: "show ipv6 route summary json"},
+	} {
+		output, err := r.run(ctx, family.command)
+		if err != nil {
+			return nil, NewApplyError("read FRR route summary", err)
+		}
+		parsed, err := ParseRouteSummaryJSON(output)
+		if err != nil {
+			return nil, NewApplyError("parse FRR route summary", err)
+		}
+		parsed.Family = family.name
+		summary.Families = append(summary.Families, *parsed)
+	}
+	return summary, nil
+}
+
+// ParseRouteSummaryJSON parses FRR's show ip/ipv6 route summary json output.
+// FRR reports eBGP and iBGP routes separately; both count as bgp.
+func ParseRouteSummaryJSON(data []byte) (*RouteFamilySummary, error) {
+	var root struct {
+		Routes []struct {
+			Type string `json:"type"`
+			RIB  uint32 `json:"rib"`
+			FIB  uint32 `json:"fib"`
+		} `json:"routes"`
+	}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("decode JSON: %w", err)
+	}
+
+	counts := make(map[string]*RouteProtocolCount)
+	summary := &RouteFamilySummary{}
+	for _, route := range root.Routes {
+		protocol := routeSummaryProtocol(route.Type)
+		count := counts[protocol]
+		if count == nil {
+			count = &RouteProtocolCount{Protocol: protocol}
+			counts[protocol] = count
+		}
+		count.Routes += route.RIB
+		count.FIBRoutes += route.FIB
+		summary.Total += route.RIB
+	}
+	for _, count := range counts {
+		summary.Protocols = append(summary.Protocols, *count)
+	}
+	sort.Slice(summary.Protocols, func(i, j int) bool {
+		return summary.Protocols[i].Protocol < summary.Protocols[j].Protocol
+	})
+	return summary, nil
+}
+
+func routeSummaryProtocol(routeType string) string {
+	protocol := strings.ToLower(strings.TrimSpace(routeType))
+	switch protocol {
+	case "":
+		return "unknown"
+	case "ebgp", "ibgp":
+		return "bgp"
+	}
+	return protocol
+}
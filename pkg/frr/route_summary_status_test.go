@@ -0,0 +1,62 @@
+package frr
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseRouteSummaryJSONFoldsBGPRouteTypes(t *testing.T) {
+	summary, err := ParseRouteSummaryJSON([]byte(`{
+		"routes": [
+			{"fib": 2, "rib": 2, "fibOffLoaded": 0, "fibTrapped": 0, "type": "connected"},
+			{"fib": 1, "rib": 1, "fibOffLoaded": 0, "fibTrapped": 0, "type": "static"},
+			{"fib": 3, "rib": 4, "fibOffLoaded": 0, "fibTrapped": 0, "type": "ebgp"},
+			{"fib": 1, "rib": 1, "fibOffLoaded": 0, "fibTrapped": 0, "type": "ibgp"},
+			{"fib": 0, "rib": 1, "fibOffLoaded": 0, "fibTrapped": 0, "type": "ospf"}
+		],
+		"routesTotal": 9,
+		"routesTotalFib": 7
+	}`))
+	if err != nil {
+		t.Fatalf("ParseRouteSummaryJSON() error = %v", err)
+	}
+	want := []RouteProtocolCount{
+		{Protocol: "bgp", Routes: 5, FIBRoutes: 4},
+		{Protocol: "connected", Routes: 2, FIBRoutes: 2},
+		{Protocol: "ospf", Routes: 1},
+		{Protocol: "static", Routes: 1, FIBRoutes: 1},
+	}
+	if !reflect.DeepEqual(summary.Protocols, want) || summary.Total != 9 {
+		t.Fatalf("summary = %#v, want %#v total 9", summary, want)
+	}
+
+	if _, err := ParseRouteSummaryJSON([]byte(`not json`)); err == nil {
+		t.Fatal("ParseRouteSummaryJSON(invalid) error = nil, want decode error")
+	}
+}
+
+func TestVtyshRouteSummaryReaderReadsBothFamilies(t *testing.T) {
+	var commands []string
+	reader := NewVtyshRouteSummaryReaderWithRunner(func(ctx context.Context, command string) ([]byte, error) {
+		commands = append(commands, command)
+		if command == "show ipv6 route summary json" {
+			return []byte(`{"routes":[{"fib":1,"rib":1,"type":"ospf6"}],"routesTotal":1}`), nil
+		}
+		return []byte(`{"routes":[{"fib":1,"rib":1,"type":"connected"}],"routesTotal":1}`), nil
+	})
+
+	summary, err := reader.ReadRouteSummary(context.Background())
+	if err != nil {
+		t.Fatalf("ReadRouteSummary() error = %v", err)
+	}
+	if want := []string{"show ip route summary json", "show ipv6 route summary json"}; !reflect.DeepEqual(commands, want) {
+		t.Fatalf("commands = %v, want %v", commands, want)
+	}
+	if len(summary.Families) != 2 || summary.Families[0].Family != "inet" || summary.Families[1].Family != "inet6" {
+		t.Fatalf("families = %#v, want inet and inet6", summary.Families)
+	}
+	if got := summary.Families[1].Protocols; len(got) != 1 || got[0].Protocol != "ospf6" || got[0].Routes != 1 {
+		t.Fatalf("inet6 protocols = %#v, want one ospf6 route", got)
+	}
+}
@@ -49,6 +49,12 @@ type DifferentialApplier interface {
 	ApplyConfigDiff(ctx context.Context, oldCfg, newCfg *Config) (bool, error)
 }
 
+// HostnameSetter sets the FRR hostname, which the management datastore does
+// not carry.
+type HostnameSetter interface {
+	SetHostname(ctx context.Context, hostname string) error
+}
+
 // VtyshRunner executes one vtysh command.
 type VtyshRunner func(ctx context.Context, command string) ([]byte, error)
 
@@ -95,6 +101,18 @@ func (c *VtyshMgmtClient) Apply(ctx context.Context, ops []MgmtOperation) error
 	return nil
 }
 
+// SetHostname sets the hostname of every FRR daemon through vtysh. The next
+// Apply persists it with "write memory".
+func (c *VtyshMgmtClient) SetHostname(ctx context.Context, hostname string) error {
+	if c.run == nil {
+		c.run = runVtyshMgmtCommand
+	}
+	if _, err := c.run(ctx, "configure terminal\nhostname "+hostname); err != nil {
+		return wrapMgmtApplyError("set FRR hostname", err)
+	}
+	return nil
+}
+
 func runVtyshMgmtCommand(ctx context.Context, command string) ([]byte, error) {
 	vtyshPath, err := lookupVtyshPath()
 	if err != nil {
@@ -157,6 +175,11 @@ func (a *TransactionalApplier) ApplyConfig(ctx context.Context, _ string, cfg *C
 	if err := prepareVRRPSystem(ctx, a.vrrpPreparer, cfg); err != nil {
 		return err
 	}
+	if setter, ok := a.client.(HostnameSetter); ok && cfg.Hostname != "" {
+		if err := setter.SetHostname(ctx, cfg.Hostname); err != nil {
+			return err
+		}
+	}
 	return a.client.Apply(ctx, ops)
 }
 
@@ -186,6 +209,9 @@ func BuildMgmtOperations(cfg *Config) ([]MgmtOperation, error) {
 	if cfg.OSPF3 != nil {
 		return nil, NewInvalidConfigError("OSPFv3 is not supported by the transactional FRR backend because FRR does not expose core ospf6d YANG paths")
 	}
+	if cfg.RIP != nil {
+		return nil, NewInvalidConfigError("RIP is not supported by the transactional FRR backend until ripd management operations are implemented")
+	}
 	if err := validateTransactionalBGP(cfg); err != nil {
 		return nil, err
 	}
@@ -837,6 +863,9 @@ func buildBGPOps(cfg *BGPConfig) []MgmtOperation {
 	if cfg.RouterID != "" {
 		ops = append(ops, setOp(bgpProtocolBase()+"/frr-bgp:bgp/global/router-id", cfg.RouterID))
 	}
+	if cfg.ClusterID != "" {
+		ops = append(ops, setOp(bgpProtocolBase()+"/frr-bgp:bgp/global/route-reflector/route-reflector-cluster-id", cfg.ClusterID))
+	}
 	neighbors := append([]BGPNeighbor(nil), cfg.Neighbors...)
 	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].IP < neighbors[j].IP })
 	for _, neighbor := range neighbors {
@@ -859,6 +888,9 @@ func buildBGPOps(cfg *BGPConfig) []MgmtOperation {
 		if neighbor.BFD {
 			ops = append(ops, setOp(base+"/bfd-options/enable", "true"))
 		}
+		if neighbor.Shutdown {
+			ops = append(ops, setOp(base+"/admin-shutdown/enable", "true"))
+		}
 		afi := "frr-routing:ipv4-unicast"
 		afiContainer := "ipv4-unicast"
 		if neighbor.IsIPv6 {
@@ -870,6 +902,15 @@ func buildBGPOps(cfg *BGPConfig) []MgmtOperation {
 			setOp(afiBase+"/afi-safi-name", afi),
 			setOp(afiBase+"/enabled", "true"),
 		)
+		if neighbor.RouteReflectorClient {
+			ops = append(ops, setOp(afiBase+"/"+afiContainer+"/route-reflector/route-reflector-client", "true"))
+		}
+		if neighbor.NextHopSelf {
+			ops = append(ops, setOp(afiBase+"/"+afiContainer+"/nexthop-self/next-hop-self", "true"))
+		}
+		if neighbor.RemovePrivateAS {
+			ops = append(ops, setOp(afiBase+"/"+afiContainer+"/private-as/remove-private-as", "true"))
+		}
 		if neighbor.RouteMapIn != "" {
 			ops = append(ops, setOp(afiBase+"/"+afiContainer+"/filter-config/rmap-import", neighbor.RouteMapIn))
 		}
@@ -877,6 +918,39 @@ func buildBGPOps(cfg *BGPConfig) []MgmtOperation {
 			ops = append(ops, setOp(afiBase+"/"+afiContainer+"/filter-config/rmap-export", neighbor.RouteMapOut))
 		}
 	}
+	ops = append(ops, buildBGPDistanceOps(cfg)...)
+	return ops
+}
+
+// buildBGPDistanceOps sets the "distance bgp" of each enabled unicast address
+// family, matching the address families the text generator writes it into.
+func buildBGPDistanceOps(cfg *BGPConfig) []MgmtOperation {
+	d := cfg.Distance
+	if d == nil {
+		return nil
+	}
+	families := []struct {
+		enabled   bool
+		afi       string
+		container string
+	}{
+		{cfg.IPv4Unicast, "frr-routing:ipv4-unicast", "ipv4-unicast"},
+		{cfg.IPv6Unicast, "frr-routing:ipv6-unicast", "ipv6-unicast"},
+	}
+	var ops []MgmtOperation
+	for _, family := range families {
+		if !family.enabled {
+			continue
+		}
+		base := bgpProtocolBase() + "/frr-bgp:bgp/global/afi-safis/afi-safi" + keyPred("afi-safi-name", family.afi)
+		distanceBase := base + "/" + family.container + "/admin-distance"
+		ops = append(ops,
+			setOp(base+"/afi-safi-name", family.afi),
+			setOp(distanceBase+"/external", strconv.Itoa(d.External)),
+			setOp(distanceBase+"/internal", strconv.Itoa(d.Internal)),
+			setOp(distanceBase+"/local", strconv.Itoa(d.Local)),
+		)
+	}
 	return ops
 }
 
@@ -905,6 +979,30 @@ func buildOSPFOps(cfg *OSPFConfig) []MgmtOperation {
 			ops = append(ops, buildOSPFInterfaceOps(iface)...)
 		}
 	}
+	ops = append(ops, buildOSPFDistanceOps(cfg.Distance)...)
+	return ops
+}
+
+// buildOSPFDistanceOps sets the distance of all OSPF routes and the per route
+// type overrides; zero values keep the FRR default.
+func buildOSPFDistanceOps(d *OSPFDistance) []MgmtOperation {
+	if d == nil {
+		return nil
+	}
+	base := ospfProtocolBase() + "/frr-ospfd:ospf/distance"
+	var ops []MgmtOperation
+	if d.Default != 0 {
+		ops = append(ops, setOp(base+"/admin-value", strconv.Itoa(d.Default)))
+	}
+	if d.IntraArea != 0 {
+		ops = append(ops, setOp(base+"/ospf/intra-area", strconv.Itoa(d.IntraArea)))
+	}
+	if d.InterArea != 0 {
+		ops = append(ops, setOp(base+"/ospf/inter-area", strconv.Itoa(d.InterArea)))
+	}
+	if d.External != 0 {
+		ops = append(ops, setOp(base+"/ospf/external", strconv.Itoa(d.External)))
+	}
 	return ops
 }
 
@@ -960,6 +1058,12 @@ func buildPrefixListOps(prefixLists []PrefixList) []MgmtOperation {
 				setOp(entryBase+"/action", entry.Action),
 				setOp(entryBase+"/"+prefixLeaf, entry.Prefix),
 			)
+			if entry.GE > 0 {
+				ops = append(ops, setOp(entryBase+"/"+prefixLeaf+"-length-greater-or-equal", strconv.Itoa(entry.GE)))
+			}
+			if entry.LE > 0 {
+				ops = append(ops, setOp(entryBase+"/"+prefixLeaf+"-length-lesser-or-equal", strconv.Itoa(entry.LE)))
+			}
 		}
 	}
 	return ops
@@ -1009,6 +1113,27 @@ func buildRouteMapOps(routeMaps []RouteMap, prefixLists []PrefixList) []MgmtOper
 					setOp(setBase+"/rmap-set-action/community-string", entry.SetCommunity),
 				)
 			}
+			if entry.SetLargeCommunity != "" {
+				setBase := entryBase + "/set-action" + keyPred("action", "frr-bgp-route-map:set-large-community")
+				ops = append(ops,
+					setOp(setBase+"/action", "frr-bgp-route-map:set-large-community"),
+					setOp(setBase+"/rmap-set-action/large-community-string", entry.SetLargeCommunity),
+				)
+			}
+			if entry.SetExtCommunityRT != "" {
+				setBase := entryBase + "/set-action" + keyPred("action", "frr-bgp-route-map:set-extcommunity-rt")
+				ops = append(ops,
+					setOp(setBase+"/action", "frr-bgp-route-map:set-extcommunity-rt"),
+					setOp(setBase+"/rmap-set-action/extcommunity-rt", entry.SetExtCommunityRT),
+				)
+			}
+			if entry.SetExtCommunitySoO != "" {
+				setBase := entryBase + "/set-action" + keyPred("action", "frr-bgp-route-map:set-extcommunity-soo")
+				ops = append(ops,
+					setOp(setBase+"/action", "frr-bgp-route-map:set-extcommunity-soo"),
+					setOp(setBase+"/rmap-set-action/extcommunity-soo", entry.SetExtCommunitySoO),
+				)
+			}
 		}
 	}
 	return ops
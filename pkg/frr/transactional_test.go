@@ -777,6 +777,39 @@ func TestBuildMgmtOperationsOSPFInterfaceAttributes(t *testing.T) {
 	}
 }
 
+func TestBuildMgmtOperationsAdministrativeDistance(t *testing.T) {
+	ops, err := BuildMgmtOperations(&Config{
+		BGP: &BGPConfig{
+			ASN:         65000,
+			IPv4Unicast: true,
+			IPv6Unicast: true,
+			Distance:    &BGPDistance{External: 25, Internal: 210, Local: 200},
+		},
+		OSPF: &OSPFConfig{
+			RouterID: "192.0.2.1",
+			Distance: &OSPFDistance{Default: 115, External: 150},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BuildMgmtOperations() error = %v", err)
+	}
+	commands := commandsFromOps(ops)
+	for _, want := range []string{
+		"mgmt set-config /frr-routing:routing/control-plane-protocols/control-plane-protocol[type='frr-bgp:bgp'][name='bgp'][vrf='default']/frr-bgp:bgp/global/afi-safis/afi-safi[afi-safi-name='frr-routing:ipv4-unicast']/ipv4-unicast/admin-distance/external 25",
+		"mgmt set-config /frr-routing:routing/control-plane-protocols/control-plane-protocol[type='frr-bgp:bgp'][name='bgp'][vrf='default']/frr-bgp:bgp/global/afi-safis/afi-safi[afi-safi-name='frr-routing:ipv4-unicast']/ipv4-unicast/admin-distance/internal 210",
+		"mgmt set-config /frr-routing:routing/control-plane-protocols/control-plane-protocol[type='frr-bgp:bgp'][name='bgp'][vrf='default']/frr-bgp:bgp/global/afi-safis/afi-safi[afi-safi-name='frr-routing:ipv6-unicast']/ipv6-unicast/admin-distance/local 200",
+		"mgmt set-config /frr-routing:routing/control-plane-protocols/control-plane-protocol[type='frr-ospfd:ospf'][name='ospf'][vrf='default']/frr-ospfd:ospf/distance/admin-value 115",
+		"mgmt set-config /frr-routing:routing/control-plane-protocols/control-plane-protocol[type='frr-ospfd:ospf'][name='ospf'][vrf='default']/frr-ospfd:ospf/distance/ospf/external 150",
+	} {
+		if !strings.Contains(commands, want) {
+			t.Fatalf("commands missing %q:\n%s", want, commands)
+		}
+	}
+	if strings.Contains(commands, "distance/ospf/intra-area") {
+		t.Fatalf("commands set an unconfigured intra-area distance:\n%s", commands)
+	}
+}
+
 func TestBuildMgmtOperationsRejectsInvalidOSPF(t *testing.T) {
 	priorityTooHigh := 256
 	tests := []struct {
@@ -1110,6 +1143,32 @@ func TestVtyshMgmtClientApplySequence(t *testing.T) {
 	}
 }
 
+func TestTransactionalApplierSetsHostnameBeforeCommit(t *testing.T) {
+	var got []string
+	client := NewVtyshMgmtClientWithRunner(func(ctx context.Context, command string) ([]byte, error) {
+		got = append(got, command)
+		return nil, nil
+	})
+	applier := NewTransactionalApplierWithPreparer(client, nil)
+
+	if err := applier.ApplyConfig(context.Background(), "", &Config{Hostname: "edge-1"}); err != nil {
+		t.Fatalf("ApplyConfig() error = %v", err)
+	}
+	if len(got) < 2 || got[0] != "configure terminal\nhostname edge-1" || got[len(got)-1] != "write memory" {
+		t.Fatalf("commands = %#v, want hostname first and write memory last", got)
+	}
+
+	got = nil
+	if err := applier.ApplyConfig(context.Background(), "", &Config{}); err != nil {
+		t.Fatalf("ApplyConfig() error = %v", err)
+	}
+	for _, command := range got {
+		if strings.Contains(command, "hostname") {
+			t.Fatalf("commands = %#v, want no hostname without system host-name", got)
+		}
+	}
+}
+
 func TestVtyshMgmtClientApplyPreservesPermissionDenied(t *testing.T) {
 	client := NewVtyshMgmtClientWithRunner(func(ctx context.Context, command string) ([]byte, error) {
 		return nil, NewPermissionDeniedError("run vtysh management command", nil)
@@ -12,6 +12,14 @@ type Config struct {
 	// LogTimestamp enables timestamp in logs
 	LogTimestamp bool
 
+	// RouterID is the global zebra router ID shared by all protocols
+	// (routing-options router-id)
+	RouterID string
+
+	// ASN is the local AS number shared by all BGP instances
+	// (routing-options autonomous-system)
+	ASN uint32
+
 	// BGP holds BGP configuration
 	BGP *BGPConfig
 
@@ -24,6 +32,9 @@ type Config struct {
 	// OSPF3 holds OSPFv3 configuration
 	OSPF3 *OSPFConfig
 
+	// RIP holds RIPv2 configuration
+	RIP *RIPConfig
+
 	// VRRP holds VRRP configuration
 	VRRP *VRRPConfig
 
@@ -55,9 +66,15 @@ type BGPConfig struct {
 	// RouterID is the BGP router ID
 	RouterID string
 
+	// ClusterID is the route-reflector cluster ID (empty = router ID)
+	ClusterID string
+
 	// Neighbors holds BGP neighbor configurations
 	Neighbors []BGPNeighbor
 
+	// PeerGroups holds settings shared by the neighbors of a BGP group
+	PeerGroups []BGPPeerGroup
+
 	// IPv4Unicast enables IPv4 unicast address family
 	IPv4Unicast bool
 
@@ -66,6 +83,17 @@ type BGPConfig struct {
 
 	// EVPN holds EVPN/VXLAN BGP address-family configuration
 	EVPN *EVPNConfig
+
+	// Distance overrides the default BGP distances (nil = FRR defaults)
+	Distance *BGPDistance
+}
+
+// BGPDistance represents the FRR "distance bgp" external, internal, and
+// local route distances.
+type BGPDistance struct {
+	External int
+	Internal int
+	Local    int
 }
 
 // EVPNConfig represents FRR EVPN/VXLAN BGP configuration.
@@ -149,6 +177,48 @@ type BGPNeighbor struct {
 
 	// RouteMapOut is the route-map applied to outgoing routes (export policy)
 	RouteMapOut string
+
+	// RouteReflectorClient marks this neighbor as a route-reflector client
+	RouteReflectorClient bool
+
+	// Shutdown administratively disables the session
+	Shutdown bool
+
+	// NextHopSelf advertises routes to this neighbor with this router as
+	// next-hop
+	NextHopSelf bool
+
+	// RemovePrivateAS strips private AS numbers from routes advertised to
+	// this neighbor
+	RemovePrivateAS bool
+
+	// PeerGroup is the peer-group this neighbor belongs to (empty = none).
+	// Settings equal to the peer-group's are inherited rather than repeated.
+	PeerGroup string
+}
+
+// BGPPeerGroup represents a BGP peer-group in FRR format.
+type BGPPeerGroup struct {
+	// Name is the peer-group name
+	Name string
+
+	// RemoteAS is the peer AS number shared by all members (0 = per neighbor)
+	RemoteAS uint32
+
+	// RouteMapIn is the route-map applied to incoming routes (import policy)
+	RouteMapIn string
+
+	// RouteMapOut is the route-map applied to outgoing routes (export policy)
+	RouteMapOut string
+
+	// RouteReflectorClient marks all members as route-reflector clients
+	RouteReflectorClient bool
+
+	// NextHopSelf applies next-hop-self to all members
+	NextHopSelf bool
+
+	// RemovePrivateAS applies remove-private-AS to all members
+	RemovePrivateAS bool
 }
 
 // OSPFConfig represents FRR OSPF configuration.
@@ -164,6 +234,22 @@ type OSPFConfig struct {
 
 	// IsOSPFv3 indicates if this is OSPFv3 (IPv6)
 	IsOSPFv3 bool
+
+	// Distance overrides the default OSPF distances (nil = FRR defaults)
+	Distance *OSPFDistance
+
+	// ReferenceBandwidth is the auto-cost reference bandwidth in bits per
+	// second (0 = FRR default)
+	ReferenceBandwidth uint64
+}
+
+// OSPFDistance represents the FRR OSPF distance of all routes and its per
+// route type overrides. A zero distance is not configured.
+type OSPFDistance struct {
+	Default   int
+	IntraArea int
+	InterArea int
+	External  int
 }
 
 // OSPFNetwork represents an OSPF network statement.
@@ -199,6 +285,30 @@ type OSPFInterface struct {
 	BFDProfile string
 }
 
+// RIPConfig represents FRR RIPv2 configuration.
+type RIPConfig struct {
+	// UpdateInterval, Timeout and GarbageCollect are the "timers basic"
+	// values in seconds (all zero = FRR defaults)
+	UpdateInterval int
+	Timeout        int
+	GarbageCollect int
+
+	// DefaultMetric is the metric for redistributed routes (0 = not set)
+	DefaultMetric int
+
+	// Interfaces holds the RIP-enabled Linux interfaces
+	Interfaces []RIPInterface
+}
+
+// RIPInterface represents one RIP-enabled interface.
+type RIPInterface struct {
+	// Name is the Linux interface name
+	Name string
+
+	// Passive suppresses updates on the interface
+	Passive bool
+}
+
 // VRRPConfig represents FRR VRRP configuration.
 type VRRPConfig struct {
 	Groups []VRRPGroup
@@ -283,6 +393,12 @@ type PrefixListEntry struct {
 
 	// Prefix is the network prefix in CIDR format
 	Prefix string
+
+	// GE is the minimum matched prefix length (0 = not set)
+	GE int
+
+	// LE is the maximum matched prefix length (0 = not set)
+	LE int
 }
 
 // RouteMap represents an FRR route-map configuration.
@@ -340,4 +456,13 @@ type RouteMapEntry struct {
 
 	// SetCommunity is the BGP community to set
 	SetCommunity string
+
+	// SetLargeCommunity is the BGP large community (ASN:value1:value2) to set
+	SetLargeCommunity string
+
+	// SetExtCommunityRT is the route-target extended community to set
+	SetExtCommunityRT string
+
+	// SetExtCommunitySoO is the site-of-origin extended community to set
+	SetExtCommunitySoO string
 }
@@ -170,13 +170,16 @@ func intFromNormalized(object map[string]any, names ...string) int {
 	}
 }
 
+// lookupNormalized returns the value of the first of names present in object.
+// Names are tried in order, so FRR output carrying several aliases (such as a
+// BGP peer's "state" and "peerState") resolves to the preferred one.
 func lookupNormalized(object map[string]any, names ...string) any {
-	wanted := make(map[string]struct{}, len(names))
-	for _, name := range names {
-		wanted[normalizeJSONKey(name)] = struct{}{}
-	}
+	normalized := make(map[string]any, len(object))
 	for key, value := range object {
-		if _, ok := wanted[normalizeJSONKey(key)]; ok {
+		normalized[normalizeJSONKey(key)] = value
+	}
+	for _, name := range names {
+		if value, ok := normalized[normalizeJSONKey(name)]; ok {
 			return value
 		}
 	}
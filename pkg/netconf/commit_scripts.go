@@ -0,0 +1,79 @@
+package netconf
+
+import (
+	"context"
+	"log"
+
+	"github.com/akam1o/arca-router/pkg/commitscript"
+	"github.com/akam1o/arca-router/pkg/config"
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+// SetCommitScriptRunner overrides the runner used for configured commit
+// scripts. Without one, scripts run from commitscript.DefaultDir.
+func (s *Server) SetCommitScriptRunner(r *commitscript.Runner) {
+	if s == nil {
+		return
+	}
+	s.commitScripts = r
+}
+
+func (s *Server) commitScriptRunner() *commitscript.Runner {
+	if s.commitScripts != nil {
+		return s.commitScripts
+	}
+	return commitscript.NewRunner()
+}
+
+// runPreCommitScripts runs the candidate's pre-commit scripts and returns the
+// commit message carrying their output. A failing script aborts the commit.
+func (s *Server) runPreCommitScripts(ctx context.Context, sess *Session, cfg *config.Config, configText string) (string, *RPCError) {
+	if cfg.System == nil || cfg.System.Scripts == nil {
+		return "", nil
+	}
+	message, err := s.commitScriptRunner().RunPreCommit(ctx, commitscript.Request{
+		User:       sess.Username,
+		Source:     datastore.CommitSourceNETCONF,
+		ConfigText: configText,
+	}, cfg.System.Scripts.CommitPre, "")
+	if err != nil {
+		log.Printf("[NETCONF] Commit aborted for session %s: %v", sess.ID, err)
+		return "", ErrOperationFailed(err.Error())
+	}
+	return message, nil
+}
+
+// runPostCommitScripts runs the committed configuration's post-commit
+// scripts and appends their output and exit status to the commit record.
+// The commit has already succeeded, so failures are only logged.
+func (s *Server) runPostCommitScripts(ctx context.Context, sess *Session, cfg *config.Config, configText, commitID string) {
+	if cfg.System == nil || cfg.System.Scripts == nil {
+		return
+	}
+	var notes commitscript.NoteAppender
+	if noteStore := s.commitNoteStore(); noteStore != nil {
+		notes = noteStore
+	}
+	record, err := s.commitScriptRunner().RunPostCommit(ctx, commitscript.Request{
+		User:       sess.Username,
+		Source:     datastore.CommitSourceNETCONF,
+		ConfigText: configText,
+	}, cfg.System.Scripts.CommitPost, notes, commitID)
+	if record != "" {
+		log.Printf("[NETCONF] Post-commit scripts for session %s:\n%s", sess.ID, record)
+	}
+	if err != nil {
+		log.Printf("[NETCONF] Post-commit scripts for session %s failed: %v", sess.ID, err)
+	}
+}
+
+// commitNoteStore returns the datastore's commit note support, or nil when
+// the backend has none.
+func (s *Server) commitNoteStore() datastore.CommitNoteStore {
+	ds := s.datastore
+	if resilient, ok := ds.(*resilientDatastore); ok {
+		ds = resilient.inner
+	}
+	notes, _ := ds.(datastore.CommitNoteStore)
+	return notes
+}
@@ -1,6 +1,7 @@
 package netconf
 
 import (
+	"net"
 	"time"
 
 	"github.com/akam1o/arca-router/pkg/datastore"
@@ -8,19 +9,31 @@ import (
 
 // SSHConfig holds SSH server configuration
 type SSHConfig struct {
-	ListenAddr                  string // Default: ":830"
-	HostKeyPath                 string // Default: "/var/lib/arca-router/ssh_host_ed25519_key"
-	UserDBPath                  string // Default: "/var/lib/arca-router/users.db"
-	DatastorePath               string // Default: "/var/lib/arca-router/config.db"
+	ListenAddr                  string                  // Default: ":830"
+	ListenAddrs                 []string                // When set, bound instead of ListenAddr (one listener each)
+	HostKeyPath                 string                  // Default: "/var/lib/arca-router/ssh_host_ed25519_key"
+	UserDBPath                  string                  // Default: "/var/lib/arca-router/users.db"
+	UserDBSQLite                datastore.SQLiteOptions // Zero values use defaults; synchronous defaults to NORMAL
+	DatastorePath               string                  // Default: "/var/lib/arca-router/config.db"
 	DatastoreConfig             *datastore.Config
 	SkipDatastoreStartupCleanup bool // For embedded servers whose parent owns datastore startup
+	// DatastoreRetry controls retries and the circuit breaker for datastore
+	// operations issued by RPCs. Zero fields use DefaultDatastoreRetryPolicy.
+	DatastoreRetry DatastoreRetryPolicy
+	// MessageSizeLimits bounds get-config replies, config inputs, and
+	// filters separately. Zero fields use DefaultMessageSizeLimits.
+	MessageSizeLimits MessageSizeLimits
 	// AdvertiseStandardXPath controls standard :xpath capability advertisement.
 	// It defaults to true for v0.10; set DisableStandardXPath to suppress it.
 	AdvertiseStandardXPath bool
 	DisableStandardXPath   bool
-	IdleTimeout            time.Duration // Default: 30m (idle timeout)
-	AbsoluteTimeout        time.Duration // Default: 24h (max session lifetime)
-	MaxSessions            int           // Default: 100
+	// DisableJSONEncoding rejects <encoding>json</encoding> in get-config and
+	// stops advertising the JSON encoding capability.
+	DisableJSONEncoding bool
+	IdleTimeout         time.Duration // Default: 30m (idle timeout)
+	AbsoluteTimeout     time.Duration // Default: 24h (max session lifetime)
+	ReplyWriteTimeout   time.Duration // Default: 60s (a stalled reply write closes the session)
+	MaxSessions         int           // Default: 100
 
 	// Lockout configuration
 	IPFailureLimit    int           // Default: 3 (IP-based lockout threshold)
@@ -43,6 +56,7 @@ func DefaultSSHConfig() *SSHConfig {
 		DatastorePath:          "/var/lib/arca-router/config.db",
 		IdleTimeout:            30 * time.Minute,
 		AbsoluteTimeout:        24 * time.Hour,
+		ReplyWriteTimeout:      DefaultReplyWriteTimeout,
 		MaxSessions:            100,
 		IPFailureLimit:         3,
 		IPLockoutWindow:        5 * time.Minute,
@@ -79,6 +93,7 @@ func sshConfigWithDefaults(config *SSHConfig) *SSHConfig {
 	if merged.ListenAddr == "" {
 		merged.ListenAddr = defaults.ListenAddr
 	}
+	merged.ListenAddrs = append([]string(nil), merged.ListenAddrs...)
 	if merged.HostKeyPath == "" {
 		merged.HostKeyPath = defaults.HostKeyPath
 	}
@@ -103,6 +118,9 @@ func sshConfigWithDefaults(config *SSHConfig) *SSHConfig {
 	if merged.AbsoluteTimeout <= 0 {
 		merged.AbsoluteTimeout = defaults.AbsoluteTimeout
 	}
+	if merged.ReplyWriteTimeout <= 0 {
+		merged.ReplyWriteTimeout = defaults.ReplyWriteTimeout
+	}
 	if merged.MaxSessions <= 0 {
 		merged.MaxSessions = defaults.MaxSessions
 	}
@@ -138,3 +156,30 @@ func sshConfigWithDefaults(config *SSHConfig) *SSHConfig {
 	}
 	return &merged
 }
+
+// listenAddrs returns every address the server binds, in order.
+func (c *SSHConfig) listenAddrs() []string {
+	if len(c.ListenAddrs) > 0 {
+		return c.ListenAddrs
+	}
+	return []string{c.ListenAddr}
+}
+
+// listenNetwork picks the socket family for addr. Literal IPv4 and IPv6
+// hosts bind only their own family, so "0.0.0.0" and "::" can be listed
+// side by side; host names and empty hosts use the dual-stack default.
+func listenNetwork(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "tcp"
+	}
+	ip := net.ParseIP(host)
+	switch {
+	case ip == nil:
+		return "tcp"
+	case ip.To4() != nil:
+		return "tcp4"
+	default:
+		return "tcp6"
+	}
+}
@@ -1,9 +1,12 @@
 package netconf
 
 import (
+	stderrors "errors"
 	"fmt"
+	"strings"
 
 	"github.com/akam1o/arca-router/pkg/config"
+	"github.com/akam1o/arca-router/pkg/errors"
 )
 
 func validateConfigSemantics(rpcName string, cfg *config.Config) *RPCError {
@@ -13,12 +16,132 @@ func validateConfigSemantics(rpcName string, cfg *config.Config) *RPCError {
 		}
 		return ErrConfigValidationFailed(rpcName, fmt.Sprintf("validation error: %v", err))
 	}
-	if err := cfg.Validate(); err != nil {
+	// Deactivated subtrees are not programmed, so validate the view that is.
+	active, err := cfg.ActiveConfig()
+	if err != nil {
 		return ErrConfigValidationFailed(rpcName, fmt.Sprintf("validation error: %v", err))
 	}
+	for _, issue := range active.ValidateAll().Errors() {
+		return validationIssueRPCError(rpcName, issue)
+	}
 	return nil
 }
 
+// validationIssueRPCError maps a configuration validation issue to an
+// rpc-error. A pkg/errors code selects the error-tag and error-app-tag, its
+// suggested action becomes the error-info hint, and the path of the object
+// the issue belongs to becomes the error-path.
+func validationIssueRPCError(rpcName string, issue config.ValidationIssue) *RPCError {
+	rpcErr := ErrConfigValidationFailed(rpcName, fmt.Sprintf("validation error: %v", issue.Err))
+	var cfgErr *errors.Error
+	if stderrors.As(issue.Err, &cfgErr) {
+		message := cfgErr.Message
+		if cfgErr.Underlying != nil {
+			message = fmt.Sprintf("%s: %v", message, cfgErr.Underlying)
+		}
+		rpcErr.ErrorMessage = "validation error: " + message
+		rpcErr.ErrorTag, rpcErr.ErrorAppTag = configErrorTags(cfgErr.Code)
+		if cfgErr.Action != "" {
+			rpcErr.WithHint(cfgErr.Action)
+		}
+	}
+	if path, namespaces := configDataPath(issue.Path); path != "" {
+		rpcErr.WithPath(path)
+		rpcErr.ErrorPathNamespaces = namespaces
+	}
+	return rpcErr
+}
+
+// configErrorTags returns the error-tag and error-app-tag for a pkg/errors
+// configuration error code.
+func configErrorTags(code string) (ErrorTag, string) {
+	switch code {
+	case errors.ErrCodeConfigParseError:
+		return ErrorTagInvalidValue, "parse-failed"
+	case errors.ErrCodeConfigPermission, errors.ErrCodePermissionDenied:
+		return ErrorTagAccessDenied, "permission-denied"
+	default:
+		return ErrorTagInvalidValue, "validation-failed"
+	}
+}
+
+// configDataPath renders a set-command object path as a namespace-qualified
+// XPath on the configuration data tree, keying the interface and
+// routing-instance lists by name, for example
+// /if:interfaces/if:interface[if:name='ge-0/0/0']. It also returns the
+// namespaces of the prefixes the path uses.
+func configDataPath(path []string) (string, map[string]string) {
+	if len(path) == 0 {
+		return "", nil
+	}
+	prefix, root := configDataRoot(path[0])
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		name := path[i]
+		if i == 0 {
+			name = root
+		}
+		fmt.Fprintf(&b, "/%s:%s", prefix, name)
+		entry := ""
+		switch path[i] {
+		case "interfaces":
+			entry = "interface"
+		case "routing-instances":
+			entry = "instance"
+		}
+		if entry != "" && i+1 < len(path) {
+			i++
+			fmt.Fprintf(&b, "/%[1]s:%[2]s[%[1]s:name=%[3]s]", prefix, entry, xpathLiteral(path[i]))
+		}
+	}
+	return b.String(), map[string]string{prefix: configDataNamespaces[prefix]}
+}
+
+// configDataNamespaces maps the prefixes used by configDataPath, which match
+// the arca-router YANG module's own prefix and imports, to their namespaces.
+var configDataNamespaces = map[string]string{
+	"arca": ArcaConfigNS,
+	"if":   IETFInterfacesNS,
+	"rt":   IETFRoutingNS,
+}
+
+// configDataRoot returns the namespace prefix and element name of the data
+// tree node that holds a top-level set-command statement. Interfaces and
+// routing options are written in their IETF modules; everything else is in
+// the arca-router namespace.
+func configDataRoot(statement string) (prefix, element string) {
+	switch statement {
+	case "interfaces":
+		return "if", "interfaces"
+	case "routing-options":
+		return "rt", "routing"
+	default:
+		return "arca", statement
+	}
+}
+
+// xpathLiteral quotes s as an XPath string literal. XPath 1.0 literals
+// cannot escape quotes, so a value containing both kinds is built with
+// concat().
+func xpathLiteral(s string) string {
+	if !strings.Contains(s, "'") {
+		return "'" + s + "'"
+	}
+	if !strings.Contains(s, `"`) {
+		return `"` + s + `"`
+	}
+	var parts []string
+	for i, part := range strings.Split(s, "'") {
+		if i > 0 {
+			parts = append(parts, `"'"`)
+		}
+		if part != "" {
+			parts = append(parts, "'"+part+"'")
+		}
+	}
+	return "concat(" + strings.Join(parts, ", ") + ")"
+}
+
 func configValidationErrorPath(rpcName string) string {
 	switch rpcName {
 	case "edit-config":
@@ -1,15 +1,21 @@
 package netconf
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/akam1o/arca-router/pkg/config"
 )
 
 func TestValidateConfigSemanticsErrorPath(t *testing.T) {
-	cfg := &config.Config{
-		System:     &config.SystemConfig{HostName: "bad_name"},
-		Interfaces: map[string]*config.Interface{},
+	// A duplicate address spans two interfaces, so the error has no single
+	// object path and falls back to the RPC's config element.
+	cfg, err := config.NewParser(strings.NewReader(`set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set interfaces ge-0/0/1 unit 0 family inet address 192.0.2.1/24
+`)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
 	}
 
 	tests := []struct {
@@ -34,3 +40,100 @@ func TestValidateConfigSemanticsErrorPath(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfigSemanticsObjectPath(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want string
+	}{
+		{
+			name: "system",
+			cfg:  &config.Config{System: &config.SystemConfig{HostName: "bad_name"}},
+			want: "/arca:system",
+		},
+		{
+			name: "interface",
+			cfg: &config.Config{Interfaces: map[string]*config.Interface{
+				"ge-0/0/0": {Units: map[int]*config.Unit{0: {Family: map[string]*config.Family{"inet": {Addresses: []string{"192.0.2.1"}}}}}},
+			}},
+			want: "/if:interfaces/if:interface[if:name='ge-0/0/0']",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConfigSemantics("edit-config", tt.cfg)
+			if err == nil {
+				t.Fatal("validateConfigSemantics() error = nil, want validation error")
+			}
+			if err.ErrorPath != tt.want || err.ErrorAppTag != "validation-failed" {
+				t.Fatalf("validateConfigSemantics() path, app-tag = %q, %q; want %q, validation-failed", err.ErrorPath, err.ErrorAppTag, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateConfigSemanticsIgnoresInactiveSubtrees(t *testing.T) {
+	// The address without a prefix length is invalid, but the interface is
+	// deactivated and is never programmed.
+	cfg, err := config.NewParser(strings.NewReader(`set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1
+deactivate interfaces ge-0/0/0
+`)).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := validateConfigSemantics("edit-config", cfg); err != nil {
+		t.Fatalf("validateConfigSemantics() error = %v, want nil for inactive subtree", err)
+	}
+
+	cfg.Inactive = nil
+	if err := validateConfigSemantics("edit-config", cfg); err == nil {
+		t.Fatal("validateConfigSemantics() error = nil, want error once the subtree is active")
+	}
+}
+
+func TestConfigDataPathQualifiesNamespaces(t *testing.T) {
+	tests := []struct {
+		path       []string
+		want       string
+		namespaces map[string]string
+	}{
+		{
+			path:       []string{"interfaces", "ge-0/0/0", "apply-groups"},
+			want:       "/if:interfaces/if:interface[if:name='ge-0/0/0']/if:apply-groups",
+			namespaces: map[string]string{"if": IETFInterfacesNS},
+		},
+		{
+			path:       []string{"routing-options"},
+			want:       "/rt:routing",
+			namespaces: map[string]string{"rt": IETFRoutingNS},
+		},
+		{
+			path:       []string{"routing-instances", "CUST-A"},
+			want:       "/arca:routing-instances/arca:instance[arca:name='CUST-A']",
+			namespaces: map[string]string{"arca": ArcaConfigNS},
+		},
+	}
+	for _, tt := range tests {
+		got, namespaces := configDataPath(tt.path)
+		if got != tt.want || !reflect.DeepEqual(namespaces, tt.namespaces) {
+			t.Errorf("configDataPath(%q) = %q, %v; want %q, %v", tt.path, got, namespaces, tt.want, tt.namespaces)
+		}
+	}
+}
+
+func TestXPathLiteralQuotesMixedQuotes(t *testing.T) {
+	tests := map[string]string{
+		"ge-0/0/0": `'ge-0/0/0'`,
+		"it's":     `"it's"`,
+		`say "hi"`: `'say "hi"'`,
+		`a'b"c'`:   `concat('a', "'", 'b"c', "'")`,
+		`'"`:       `concat("'", '"')`,
+	}
+	for value, want := range tests {
+		if got := xpathLiteral(value); got != want {
+			t.Errorf("xpathLiteral(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package netconf
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+const (
+	// defaultConfirmTimeout is the RFC 6241 default <confirm-timeout>.
+	defaultConfirmTimeout = 600 * time.Second
+
+	// confirmedCommitRollbackTimeout bounds one automatic rollback attempt.
+	confirmedCommitRollbackTimeout = 2 * time.Minute
+
+	// confirmedCommitRetryInterval is the delay before retrying a failed
+	// automatic rollback (for example while another session holds the lock).
+	confirmedCommitRetryInterval = 10 * time.Second
+
+	// confirmedCommitLockTimeout is the candidate lock lease used while the
+	// server rolls back an unconfirmed commit.
+	confirmedCommitLockTimeout = time.Minute
+)
+
+// CancelCommitRequest represents <cancel-commit> RPC
+type CancelCommitRequest struct {
+	XMLName   xml.Name `xml:"cancel-commit"`
+	PersistID *string  `xml:"persist-id"`
+}
+
+// confirmedCommitStore returns the datastore's confirmed-commit persistence,
+// or nil when the backend does not support confirmed commits.
+func (s *Server) confirmedCommitStore() datastore.ConfirmedCommitStore {
+	if s == nil || s.datastore == nil {
+		return nil
+	}
+	if ds, ok := s.datastore.(*resilientDatastore); ok {
+		return ds.confirmedCommitStore()
+	}
+	store, ok := s.datastore.(datastore.ConfirmedCommitStore)
+	if !ok {
+		return nil
+	}
+	return store
+}
+
+// supportsConfirmedCommit reports whether :confirmed-commit:1.1 is advertised.
+func (s *Server) supportsConfirmedCommit() bool {
+	return s.confirmedCommitStore() != nil
+}
+
+// handleConfirmedCommit implements <commit> with RFC 6241 confirmed-commit
+// options. A pending confirmed commit is confirmed by a plain <commit> from
+// the issuing session, or from any session presenting the matching
+// <persist-id> when the confirmed commit was issued with <persist>.
+func (s *Server) handleConfirmedCommit(ctx context.Context, sess *Session, rpc *RPC, req *CommitRequest) *RPCReply {
+	timeout, rpcErr := parseConfirmTimeout(req)
+	if rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+	if req.Persist != nil && strings.TrimSpace(*req.Persist) == "" {
+		return NewErrorReply(rpc.MessageID, ErrInvalidConfirmedCommitOption("commit", "persist", "persist value must not be empty"))
+	}
+
+	store := s.confirmedCommitStore()
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+
+	pending, rpcErr := s.loadPendingConfirm(ctx, store)
+	if rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+	if rpcErr := authorizePendingConfirm("commit", sess, pending, req.PersistID); rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+
+	confirmed := req.Confirmed != nil
+	rollbackCommitID := ""
+	if confirmed && pending == nil {
+		running, err := s.datastore.GetRunning(ctx)
+		if err != nil || running == nil || running.CommitID == "" {
+			log.Printf("[NETCONF] Confirmed commit has no running config to roll back to (session %s): %v", sess.ID, err)
+			return NewErrorReply(rpc.MessageID, ErrOperationFailed("confirmed commit requires an existing running configuration"))
+		}
+		rollbackCommitID = running.CommitID
+	}
+
+	commitID, rpcErr := s.commitCandidate(ctx, sess, pending != nil)
+	if rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+
+	if !confirmed {
+		if pending != nil {
+			s.stopConfirmTimerLocked()
+			if err := store.ClearPendingConfirm(ctx); err != nil {
+				log.Printf("[NETCONF] Failed to clear confirmed commit %s: %v", pending.CommitID, err)
+				return NewErrorReply(rpc.MessageID, ErrDatastoreError("failed to clear pending confirmed commit"))
+			}
+			log.Printf("[NETCONF] Confirmed commit %s confirmed (session: %s, user: %s)", pending.CommitID, sess.ID, sess.Username)
+		}
+		if commitID != "" {
+			log.Printf("[NETCONF] Commit successful: %s (session: %s, user: %s)", commitID, sess.ID, sess.Username)
+		}
+		return NewOKReply(rpc.MessageID)
+	}
+
+	now := time.Now()
+	next := &datastore.PendingConfirm{
+		CommitID:         commitID,
+		RollbackCommitID: rollbackCommitID,
+		SessionID:        sess.ID,
+		User:             sess.Username,
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(timeout),
+	}
+	if req.Persist != nil {
+		next.PersistID = strings.TrimSpace(*req.Persist)
+	}
+	if pending != nil {
+		// A follow-up confirmed commit keeps the original rollback target.
+		next.RollbackCommitID = pending.RollbackCommitID
+		next.CreatedAt = pending.CreatedAt
+		if next.CommitID == "" {
+			next.CommitID = pending.CommitID
+		}
+	}
+	if err := store.SavePendingConfirm(ctx, next); err != nil {
+		log.Printf("[NETCONF] Failed to record confirmed commit %s: %v", next.CommitID, err)
+		return NewErrorReply(rpc.MessageID, ErrDatastoreError("failed to record pending confirmed commit"))
+	}
+	s.scheduleConfirmTimerLocked(next)
+
+	log.Printf("[NETCONF] Confirmed commit %s pending until %s (session: %s, user: %s, persist: %t)",
+		next.CommitID, next.ExpiresAt.UTC().Format(time.RFC3339), sess.ID, sess.Username, next.PersistID != "")
+
+	return NewOKReply(rpc.MessageID)
+}
+
+// handleCancelCommit handles <cancel-commit> RPC - rolls back a pending
+// confirmed commit immediately.
+func (s *Server) handleCancelCommit(ctx context.Context, sess *Session, rpc *RPC) *RPCReply {
+	store := s.confirmedCommitStore()
+	if store == nil {
+		return NewErrorReply(rpc.MessageID, ErrUnknownRPC("cancel-commit"))
+	}
+
+	var req CancelCommitRequest
+	if err := rpc.UnmarshalOperation(&req); err != nil {
+		return NewErrorReply(rpc.MessageID, rpcErrorFromError(err))
+	}
+
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+
+	pending, rpcErr := s.loadPendingConfirm(ctx, store)
+	if rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+	if pending == nil {
+		return NewErrorReply(rpc.MessageID, ErrOperationFailed("no confirmed commit pending"))
+	}
+	if rpcErr := authorizePendingConfirm("cancel-commit", sess, pending, req.PersistID); rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+
+	s.stopConfirmTimerLocked()
+	if err := s.rollbackPendingConfirmLocked(ctx, store, pending, "cancelled"); err != nil {
+		log.Printf("[NETCONF] Cancel of confirmed commit %s failed: %v", pending.CommitID, err)
+		s.scheduleConfirmTimerLocked(pending)
+		return NewErrorReply(rpc.MessageID, commitFailureError(err))
+	}
+
+	log.Printf("[NETCONF] Confirmed commit %s cancelled (session: %s, user: %s)", pending.CommitID, sess.ID, sess.Username)
+
+	return NewOKReply(rpc.MessageID)
+}
+
+func parseConfirmTimeout(req *CommitRequest) (time.Duration, *RPCError) {
+	if req.Confirmed == nil {
+		switch {
+		case req.ConfirmTimeout != nil:
+			return 0, ErrInvalidConfirmedCommitOption("commit", "confirm-timeout", "confirm-timeout requires confirmed")
+		case req.Persist != nil:
+			return 0, ErrInvalidConfirmedCommitOption("commit", "persist", "persist requires confirmed")
+		}
+		return 0, nil
+	}
+	if req.ConfirmTimeout == nil {
+		return defaultConfirmTimeout, nil
+	}
+	seconds, err := strconv.ParseUint(strings.TrimSpace(*req.ConfirmTimeout), 10, 32)
+	if err != nil || seconds == 0 {
+		return 0, ErrInvalidConfirmedCommitOption("commit", "confirm-timeout", "confirm-timeout must be a positive number of seconds")
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// authorizePendingConfirm checks that sess may confirm, extend, or cancel the
+// pending confirmed commit.
+func authorizePendingConfirm(rpcName string, sess *Session, pending *datastore.PendingConfirm, persistID *string) *RPCError {
+	if pending == nil {
+		if persistID != nil {
+			return ErrInvalidConfirmedCommitOption(rpcName, "persist-id", "no confirmed commit pending for persist-id")
+		}
+		return nil
+	}
+	if pending.PersistID != "" {
+		if persistID == nil {
+			return ErrConfirmedCommitInUse("a persistent confirmed commit is pending; persist-id is required")
+		}
+		if strings.TrimSpace(*persistID) != pending.PersistID {
+			return ErrInvalidConfirmedCommitOption(rpcName, "persist-id", "persist-id does not match the pending confirmed commit")
+		}
+		return nil
+	}
+	if persistID != nil {
+		return ErrInvalidConfirmedCommitOption(rpcName, "persist-id", "pending confirmed commit was not issued with persist")
+	}
+	if pending.SessionID != sess.ID {
+		return ErrConfirmedCommitInUse("a confirmed commit from another session is pending")
+	}
+	return nil
+}
+
+func (s *Server) loadPendingConfirm(ctx context.Context, store datastore.ConfirmedCommitStore) (*datastore.PendingConfirm, *RPCError) {
+	pending, err := store.GetPendingConfirm(ctx)
+	if err != nil {
+		if isDatastoreNotFound(err) {
+			return nil, nil
+		}
+		log.Printf("[NETCONF] Failed to read pending confirmed commit: %v", err)
+		return nil, ErrDatastoreError("failed to read pending confirmed commit")
+	}
+	return pending, nil
+}
+
+// rollbackPendingConfirmLocked restores the configuration that was running
+// before the pending confirmed commit and clears the pending state. The
+// caller must hold confirmMu.
+func (s *Server) rollbackPendingConfirmLocked(ctx context.Context, store datastore.ConfirmedCommitStore, pending *datastore.PendingConfirm, reason string) error {
+	target, err := s.datastore.GetCommit(ctx, pending.RollbackCommitID)
+	if err != nil {
+		return fmt.Errorf("load rollback commit %s: %w", pending.RollbackCommitID, err)
+	}
+	running, err := s.datastore.GetRunning(ctx)
+	if err != nil && !isDatastoreNotFound(err) {
+		return fmt.Errorf("read running config: %w", err)
+	}
+	if running != nil && running.ConfigText == target.ConfigText {
+		return store.ClearPendingConfirm(ctx)
+	}
+
+	lockSessionID := "confirmed-commit:" + pending.CommitID
+	if err := s.datastore.AcquireLock(ctx, &datastore.LockRequest{
+		Target:    datastore.LockTargetCandidate,
+		SessionID: lockSessionID,
+		User:      pending.User,
+		Timeout:   confirmedCommitLockTimeout,
+	}); err != nil {
+		return fmt.Errorf("acquire candidate lock for rollback: %w", err)
+	}
+
+	rollbackReq := &datastore.RollbackRequest{
+		SessionID: lockSessionID,
+		CommitID:  pending.RollbackCommitID,
+		User:      pending.User,
+		Message:   fmt.Sprintf("confirmed commit %s %s", pending.CommitID, reason),
+		Source:    datastore.CommitSourceNETCONF,
+	}
+	persist := func(ctx context.Context) (string, error) {
+		return s.datastore.Rollback(ctx, rollbackReq)
+	}
+
+	var commitID string
+	if s.commitHook != nil {
+		commitID, err = s.commitHook(ctx, &CommitHookRequest{
+			SessionID:  lockSessionID,
+			User:       pending.User,
+			Message:    rollbackReq.Message,
+			ConfigText: target.ConfigText,
+		}, persist)
+	} else {
+		commitID, err = persist(ctx)
+	}
+	if err != nil {
+		if releaseErr := s.datastore.ReleaseLock(context.Background(), datastore.LockTargetCandidate, lockSessionID); releaseErr != nil {
+			log.Printf("[NETCONF] Failed to release confirmed commit rollback lock: %v", releaseErr)
+		}
+		return err
+	}
+	if err := store.ClearPendingConfirm(ctx); err != nil {
+		return fmt.Errorf("clear pending confirmed commit: %w", err)
+	}
+
+	log.Printf("[NETCONF] Confirmed commit %s rolled back to %s as %s (%s)", pending.CommitID, pending.RollbackCommitID, commitID, reason)
+	return nil
+}
+
+// scheduleConfirmTimerLocked arms the rollback timer for pending. The caller
+// must hold confirmMu.
+func (s *Server) scheduleConfirmTimerLocked(pending *datastore.PendingConfirm) {
+	s.scheduleConfirmTimerAfterLocked(pending.CommitID, time.Until(pending.ExpiresAt), "timed out")
+}
+
+func (s *Server) scheduleConfirmTimerAfterLocked(commitID string, delay time.Duration, reason string) {
+	s.stopConfirmTimerLocked()
+	if s.confirmStopped {
+		return
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	s.confirmTimer = time.AfterFunc(delay, func() {
+		s.rollbackPendingConfirm(func(pending *datastore.PendingConfirm) bool {
+			return pending.CommitID == commitID
+		}, reason)
+	})
+}
+
+func (s *Server) stopConfirmTimerLocked() {
+	if s.confirmTimer != nil {
+		s.confirmTimer.Stop()
+		s.confirmTimer = nil
+	}
+}
+
+// sessionTerminated rolls back a pending confirmed commit that was issued
+// without <persist> by a session that has now ended (RFC 6241 Section 8.4.1).
+func (s *Server) sessionTerminated(sessionID string) {
+	if s.confirmedCommitStore() == nil {
+		return
+	}
+	s.rollbackPendingConfirm(func(pending *datastore.PendingConfirm) bool {
+		return pending.PersistID == "" && pending.SessionID == sessionID
+	}, "session terminated")
+}
+
+func (s *Server) rollbackPendingConfirm(match func(*datastore.PendingConfirm) bool, reason string) {
+	store := s.confirmedCommitStore()
+	if store == nil {
+		return
+	}
+
+	s.confirmMu.Lock()
+	defer s.confirmMu.Unlock()
+	if s.confirmStopped {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), confirmedCommitRollbackTimeout)
+	defer cancel()
+
+	pending, rpcErr := s.loadPendingConfirm(ctx, store)
+	if rpcErr != nil {
+		s.scheduleConfirmRetryLocked("")
+		return
+	}
+	if pending == nil || !match(pending) {
+		return
+	}
+	s.stopConfirmTimerLocked()
+	if err := s.rollbackPendingConfirmLocked(ctx, store, pending, reason); err != nil {
+		log.Printf("[NETCONF] Rollback of confirmed commit %s failed, retrying in %s: %v", pending.CommitID, confirmedCommitRetryInterval, err)
+		s.scheduleConfirmRetryLocked(pending.CommitID)
+	}
+}
+
+func (s *Server) scheduleConfirmRetryLocked(commitID string) {
+	if commitID == "" {
+		// The pending state could not be read; retry whatever is pending.
+		s.stopConfirmTimerLocked()
+		if s.confirmStopped {
+			return
+		}
+		s.confirmTimer = time.AfterFunc(confirmedCommitRetryInterval, func() {
+			s.rollbackPendingConfirm(func(pending *datastore.PendingConfirm) bool {
+				return !time.Now().Before(pending.ExpiresAt)
+			}, "timed out")
+		})
+		return
+	}
+	s.scheduleConfirmTimerAfterLocked(commitID, confirmedCommitRetryInterval, "timed out")
+}
+
+// resumeConfirmedCommit re-arms the rollback timer for a confirmed commit that
+// was pending when the server last stopped. A commit issued without <persist>
+// is bound to a session that no longer exists and is rolled back at once.
+func (s *Server) resumeConfirmedCommit(ctx context.Context) {
+	store := s.confirmedCommitStore()
+	if store == nil {
+		return
+	}
+
+	s.confirmMu.Lock()
+	pending, rpcErr := s.loadPendingConfirm(ctx, store)
+	if rpcErr != nil || pending == nil {
+		s.confirmMu.Unlock()
+		return
+	}
+	if pending.PersistID != "" {
+		log.Printf("[NETCONF] Resuming confirmed commit %s pending until %s", pending.CommitID, pending.ExpiresAt.UTC().Format(time.RFC3339))
+		s.scheduleConfirmTimerLocked(pending)
+		s.confirmMu.Unlock()
+		return
+	}
+	s.scheduleConfirmTimerAfterLocked(pending.CommitID, 0, "session terminated")
+	s.confirmMu.Unlock()
+}
+
+// stopConfirmedCommitTimer disarms the rollback timer so a shutdown does not
+// roll back a pending confirmed commit; it is resumed on the next start.
+func (s *Server) stopConfirmedCommitTimer() {
+	if s == nil {
+		return
+	}
+	s.confirmMu.Lock()
+	s.confirmStopped = true
+	s.stopConfirmTimerLocked()
+	s.confirmMu.Unlock()
+}
@@ -0,0 +1,275 @@
+package netconf
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+const (
+	confirmedCommitBaseConfig  = "set system host-name router1\n"
+	confirmedCommitTrialConfig = "set system host-name router2\n"
+)
+
+func TestConfirmedCommitWithPersistConfirmedFromAnotherSession(t *testing.T) {
+	ds, srv := newConfirmedCommitTestServer(t)
+	ctx := context.Background()
+	issuer := newConfirmedCommitTestSession("session-1", 1)
+
+	stageConfirmedCommitCandidate(t, ds, issuer, confirmedCommitTrialConfig)
+	reply := handleSessionCommitRPC(t, srv, issuer, "<confirmed/><confirm-timeout>60</confirm-timeout><persist>token-1</persist>")
+	assertOKReply(t, reply)
+
+	pending, err := ds.(datastore.ConfirmedCommitStore).GetPendingConfirm(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingConfirm() error = %v", err)
+	}
+	if pending.PersistID != "token-1" || pending.SessionID != issuer.ID {
+		t.Fatalf("pending confirm = %#v, want persist token from session-1", pending)
+	}
+
+	other := newConfirmedCommitTestSession("session-2", 2)
+	reply = handleSessionCommitRPC(t, srv, other, "")
+	assertRPCErrorTag(t, reply, ErrorTagInUse)
+
+	reply = handleSessionCommitRPC(t, srv, other, "<persist-id>wrong</persist-id>")
+	assertRPCErrorTag(t, reply, ErrorTagInvalidValue)
+
+	reply = handleSessionCommitRPC(t, srv, other, "<persist-id>token-1</persist-id>")
+	assertOKReply(t, reply)
+
+	if _, err := ds.(datastore.ConfirmedCommitStore).GetPendingConfirm(ctx); !isDatastoreNotFound(err) {
+		t.Fatalf("GetPendingConfirm() after confirm error = %v, want not found", err)
+	}
+	assertRunningConfig(t, ds, confirmedCommitTrialConfig)
+}
+
+func TestConfirmedCommitRollsBackOnTimeout(t *testing.T) {
+	ds, srv := newConfirmedCommitTestServer(t)
+	issuer := newConfirmedCommitTestSession("session-1", 1)
+
+	stageConfirmedCommitCandidate(t, ds, issuer, confirmedCommitTrialConfig)
+	reply := handleSessionCommitRPC(t, srv, issuer, "<confirmed/><confirm-timeout>1</confirm-timeout><persist>token-1</persist>")
+	assertOKReply(t, reply)
+	assertRunningConfig(t, ds, confirmedCommitTrialConfig)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		running, err := ds.GetRunning(context.Background())
+		if err == nil && running.ConfigText == confirmedCommitBaseConfig {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("running config was not rolled back after confirm-timeout")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if _, err := ds.(datastore.ConfirmedCommitStore).GetPendingConfirm(context.Background()); !isDatastoreNotFound(err) {
+		t.Fatalf("GetPendingConfirm() after timeout error = %v, want not found", err)
+	}
+}
+
+func TestConfirmedCommitConfirmedByFollowUpCommit(t *testing.T) {
+	ds, srv := newConfirmedCommitTestServer(t)
+	ctx := context.Background()
+	issuer := newConfirmedCommitTestSession("session-1", 1)
+
+	stageConfirmedCommitCandidate(t, ds, issuer, confirmedCommitTrialConfig)
+	assertOKReply(t, handleSessionCommitRPC(t, srv, issuer, "<confirmed/>"))
+
+	pending, err := ds.(datastore.ConfirmedCommitStore).GetPendingConfirm(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingConfirm() error = %v", err)
+	}
+	if got := pending.ExpiresAt.Sub(pending.CreatedAt); got != defaultConfirmTimeout {
+		t.Fatalf("pending confirm timeout = %v, want %v", got, defaultConfirmTimeout)
+	}
+
+	assertOKReply(t, handleSessionCommitRPC(t, srv, issuer, ""))
+	if _, err := ds.(datastore.ConfirmedCommitStore).GetPendingConfirm(ctx); !isDatastoreNotFound(err) {
+		t.Fatalf("GetPendingConfirm() after confirm error = %v, want not found", err)
+	}
+
+	srv.sessionTerminated(issuer.ID)
+	assertRunningConfig(t, ds, confirmedCommitTrialConfig)
+}
+
+func TestCancelCommitWithPersistIDRestoresRunning(t *testing.T) {
+	ds, srv := newConfirmedCommitTestServer(t)
+	issuer := newConfirmedCommitTestSession("session-1", 1)
+
+	stageConfirmedCommitCandidate(t, ds, issuer, confirmedCommitTrialConfig)
+	assertOKReply(t, handleSessionCommitRPC(t, srv, issuer, "<confirmed/><persist>token-1</persist>"))
+
+	other := newConfirmedCommitTestSession("session-2", 2)
+	reply := handleSessionRPC(t, srv, other, `<rpc message-id="102" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<cancel-commit><persist-id>token-1</persist-id></cancel-commit>
+	</rpc>`)
+	assertOKReply(t, reply)
+
+	assertRunningConfig(t, ds, confirmedCommitBaseConfig)
+}
+
+func TestConfirmedCommitWithoutPersistRollsBackWhenSessionEnds(t *testing.T) {
+	ds, srv := newConfirmedCommitTestServer(t)
+	issuer := newConfirmedCommitTestSession("session-1", 1)
+
+	stageConfirmedCommitCandidate(t, ds, issuer, confirmedCommitTrialConfig)
+	assertOKReply(t, handleSessionCommitRPC(t, srv, issuer, "<confirmed/>"))
+
+	other := newConfirmedCommitTestSession("session-2", 2)
+	reply := handleSessionCommitRPC(t, srv, other, "<persist-id>token-1</persist-id>")
+	assertRPCErrorTag(t, reply, ErrorTagInvalidValue)
+
+	srv.sessionTerminated(other.ID)
+	assertRunningConfig(t, ds, confirmedCommitTrialConfig)
+
+	srv.sessionTerminated(issuer.ID)
+	assertRunningConfig(t, ds, confirmedCommitBaseConfig)
+}
+
+func TestConfirmedCommitWithPersistSurvivesSessionEnd(t *testing.T) {
+	ds, srv := newConfirmedCommitTestServer(t)
+	issuer := newConfirmedCommitTestSession("session-1", 1)
+
+	stageConfirmedCommitCandidate(t, ds, issuer, confirmedCommitTrialConfig)
+	assertOKReply(t, handleSessionCommitRPC(t, srv, issuer, "<confirmed/><persist>token-1</persist>"))
+
+	srv.sessionTerminated(issuer.ID)
+	assertRunningConfig(t, ds, confirmedCommitTrialConfig)
+	if _, err := ds.(datastore.ConfirmedCommitStore).GetPendingConfirm(context.Background()); err != nil {
+		t.Fatalf("GetPendingConfirm() after session end error = %v, want pending persist commit", err)
+	}
+}
+
+func TestConfirmedCommitOptionValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		path    string
+	}{
+		{name: "timeout without confirmed", content: "<confirm-timeout>60</confirm-timeout>", path: "/rpc/commit/confirm-timeout"},
+		{name: "persist without confirmed", content: "<persist>token</persist>", path: "/rpc/commit/persist"},
+		{name: "zero timeout", content: "<confirmed/><confirm-timeout>0</confirm-timeout>", path: "/rpc/commit/confirm-timeout"},
+		{name: "empty persist", content: "<confirmed/><persist></persist>", path: "/rpc/commit/persist"},
+		{name: "persist-id without pending", content: "<persist-id>token</persist-id>", path: "/rpc/commit/persist-id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, srv := newConfirmedCommitTestServer(t)
+			reply := handleSessionCommitRPC(t, srv, newConfirmedCommitTestSession("session-1", 1), tt.content)
+
+			assertRPCErrorTag(t, reply, ErrorTagInvalidValue)
+			if reply.Errors[0].ErrorPath != tt.path {
+				t.Fatalf("error path = %q, want %q", reply.Errors[0].ErrorPath, tt.path)
+			}
+		})
+	}
+}
+
+func newConfirmedCommitTestServer(t *testing.T) (datastore.Datastore, *Server) {
+	t.Helper()
+
+	ds, err := datastore.NewSQLiteDatastore(&datastore.Config{
+		Backend:    datastore.BackendSQLite,
+		SQLitePath: filepath.Join(t.TempDir(), "config.db"),
+	})
+	if err != nil {
+		t.Fatalf("NewSQLiteDatastore() error = %v", err)
+	}
+	srv := NewServer(ds, nil)
+	t.Cleanup(func() {
+		srv.stopConfirmedCommitTimer()
+		_ = ds.Close()
+	})
+
+	seed := newConfirmedCommitTestSession("seed", 99)
+	stageConfirmedCommitCandidate(t, ds, seed, confirmedCommitBaseConfig)
+	if _, err := ds.Commit(context.Background(), &datastore.CommitRequest{SessionID: seed.ID, User: seed.Username}); err != nil {
+		t.Fatalf("seed Commit() error = %v", err)
+	}
+	return ds, srv
+}
+
+func newConfirmedCommitTestSession(id string, numericID uint32) *Session {
+	return &Session{
+		ID:             id,
+		NumericID:      numericID,
+		Username:       "alice",
+		Role:           RoleOperator,
+		LastUsed:       time.Now(),
+		datastoreLocks: map[string]struct{}{},
+	}
+}
+
+func stageConfirmedCommitCandidate(t *testing.T, ds datastore.Datastore, sess *Session, configText string) {
+	t.Helper()
+
+	ctx := context.Background()
+	if err := ds.AcquireLock(ctx, &datastore.LockRequest{
+		Target:    datastore.LockTargetCandidate,
+		SessionID: sess.ID,
+		User:      sess.Username,
+		Timeout:   time.Minute,
+	}); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if err := ds.SaveCandidate(ctx, sess.ID, configText); err != nil {
+		t.Fatalf("SaveCandidate() error = %v", err)
+	}
+}
+
+func handleSessionCommitRPC(t *testing.T, srv *Server, sess *Session, content string) *RPCReply {
+	t.Helper()
+
+	return handleSessionRPC(t, srv, sess, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<commit>
+			`+content+`
+		</commit>
+	</rpc>`)
+}
+
+func handleSessionRPC(t *testing.T, srv *Server, sess *Session, rpcXML string) *RPCReply {
+	t.Helper()
+
+	rpc, err := ParseRPC([]byte(rpcXML))
+	if err != nil {
+		t.Fatalf("ParseRPC() error = %v", err)
+	}
+	return srv.HandleRPC(context.Background(), sess, rpc)
+}
+
+func assertOKReply(t *testing.T, reply *RPCReply) {
+	t.Helper()
+
+	if len(reply.Errors) != 0 {
+		t.Fatalf("reply errors = %#v, want ok", reply.Errors[0])
+	}
+}
+
+func assertRPCErrorTag(t *testing.T, reply *RPCReply, tag ErrorTag) {
+	t.Helper()
+
+	if len(reply.Errors) != 1 {
+		t.Fatalf("reply errors = %d, want 1", len(reply.Errors))
+	}
+	if reply.Errors[0].ErrorTag != tag {
+		t.Fatalf("error tag = %s, want %s (%s)", reply.Errors[0].ErrorTag, tag, reply.Errors[0].ErrorMessage)
+	}
+}
+
+func assertRunningConfig(t *testing.T, ds datastore.Datastore, want string) {
+	t.Helper()
+
+	running, err := ds.GetRunning(context.Background())
+	if err != nil {
+		t.Fatalf("GetRunning() error = %v", err)
+	}
+	if running.ConfigText != want {
+		t.Fatalf("running config = %q, want %q", running.ConfigText, want)
+	}
+}
@@ -0,0 +1,387 @@
+package netconf
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+// DatastoreRetryPolicy controls how the NETCONF server rides out transient
+// datastore failures such as a briefly unavailable SQLite file. Operations
+// that fail with an I/O or connection error are retried with exponential
+// backoff, except commits, rollbacks, lock acquisition and audit inserts,
+// which are not idempotent and run once; after FailureThreshold consecutive
+// failed operations the circuit
+// breaker opens and RPCs are rejected with resource-denied until OpenDuration
+// has passed and a trial operation succeeds.
+type DatastoreRetryPolicy struct {
+	Attempts         int           // Attempts per operation, including the first. Default: 3
+	InitialBackoff   time.Duration // Delay before the first retry, doubled per retry. Default: 50ms
+	MaxBackoff       time.Duration // Upper bound for the retry delay. Default: 1s
+	FailureThreshold int           // Consecutive failed operations that open the breaker. Default: 5
+	OpenDuration     time.Duration // How long the open breaker rejects RPCs. Default: 30s
+}
+
+// DefaultDatastoreRetryPolicy returns the default datastore retry policy.
+func DefaultDatastoreRetryPolicy() DatastoreRetryPolicy {
+	return DatastoreRetryPolicy{
+		Attempts:         3,
+		InitialBackoff:   50 * time.Millisecond,
+		MaxBackoff:       time.Second,
+		FailureThreshold: 5,
+		OpenDuration:     30 * time.Second,
+	}
+}
+
+func (p DatastoreRetryPolicy) withDefaults() DatastoreRetryPolicy {
+	defaults := DefaultDatastoreRetryPolicy()
+	if p.Attempts <= 0 {
+		p.Attempts = defaults.Attempts
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = defaults.InitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaults.MaxBackoff
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+	if p.FailureThreshold <= 0 {
+		p.FailureThreshold = defaults.FailureThreshold
+	}
+	if p.OpenDuration <= 0 {
+		p.OpenDuration = defaults.OpenDuration
+	}
+	return p
+}
+
+// errDatastoreUnavailable is returned without touching the datastore while
+// the circuit breaker is open.
+var errDatastoreUnavailable = errors.New("datastore unavailable after repeated failures")
+
+// resilientDatastore wraps a datastore with retry-with-backoff and a circuit
+// breaker. Reads and writes that converge on the same state when repeated go
+// through do; writes that would take effect twice if an attempt failed only
+// after committing, such as Commit or AcquireLock, go through doOnce.
+type resilientDatastore struct {
+	inner  datastore.Datastore
+	policy DatastoreRetryPolicy
+	now    func() time.Time
+	sleep  func(context.Context, time.Duration) error
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func newResilientDatastore(inner datastore.Datastore, policy DatastoreRetryPolicy) *resilientDatastore {
+	return &resilientDatastore{
+		inner:  inner,
+		policy: policy.withDefaults(),
+		now:    time.Now,
+		sleep:  sleepContext,
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// available reports whether operations may reach the datastore. Once the
+// open period has passed, operations are let through again as trials; the
+// first success closes the breaker and a failure reopens it.
+func (d *resilientDatastore) available() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.failures < d.policy.FailureThreshold || !d.now().Before(d.openUntil)
+}
+
+func (d *resilientDatastore) recordSuccess() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.failures >= d.policy.FailureThreshold {
+		log.Printf("[NETCONF] Datastore recovered after %d failed operations", d.failures)
+	}
+	d.failures = 0
+	d.openUntil = time.Time{}
+}
+
+func (d *resilientDatastore) recordFailure(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.failures++
+	if d.failures >= d.policy.FailureThreshold {
+		d.openUntil = d.now().Add(d.policy.OpenDuration)
+		log.Printf("[NETCONF] Datastore circuit breaker open for %s after %d failed operations: %v",
+			d.policy.OpenDuration, d.failures, err)
+	}
+}
+
+// do runs an idempotent op, retrying transient failures with exponential
+// backoff.
+func (d *resilientDatastore) do(ctx context.Context, op func(context.Context) error) error {
+	d.mu.Lock()
+	attempts := d.policy.Attempts
+	d.mu.Unlock()
+	return d.run(ctx, attempts, op)
+}
+
+// doOnce runs a non-idempotent op without retrying it. A transient failure
+// still counts toward the circuit breaker.
+func (d *resilientDatastore) doOnce(ctx context.Context, op func(context.Context) error) error {
+	return d.run(ctx, 1, op)
+}
+
+func (d *resilientDatastore) run(ctx context.Context, attempts int, op func(context.Context) error) error {
+	if !d.available() {
+		return errDatastoreUnavailable
+	}
+	d.mu.Lock()
+	policy := d.policy
+	d.mu.Unlock()
+
+	backoff := policy.InitialBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = op(ctx)
+		if !isTransientDatastoreError(ctx, err) {
+			d.recordSuccess()
+			return err
+		}
+		if attempt >= attempts {
+			break
+		}
+		if sleepErr := d.sleep(ctx, backoff); sleepErr != nil {
+			break
+		}
+		backoff = min(backoff*2, policy.MaxBackoff)
+	}
+	d.recordFailure(err)
+	return err
+}
+
+// isTransientDatastoreError reports whether err points at an unhealthy
+// datastore. Not-found, conflict, validation and parse errors are answers from
+// a healthy datastore, and a canceled request says nothing about its health.
+func isTransientDatastoreError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	return datastore.IsTransientError(err)
+}
+
+func (d *resilientDatastore) GetRunning(ctx context.Context) (*datastore.RunningConfig, error) {
+	var running *datastore.RunningConfig
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		running, err = d.inner.GetRunning(ctx)
+		return err
+	})
+	return running, err
+}
+
+func (d *resilientDatastore) GetCandidate(ctx context.Context, sessionID string) (*datastore.CandidateConfig, error) {
+	var candidate *datastore.CandidateConfig
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		candidate, err = d.inner.GetCandidate(ctx, sessionID)
+		return err
+	})
+	return candidate, err
+}
+
+func (d *resilientDatastore) SaveCandidate(ctx context.Context, sessionID string, configText string) error {
+	return d.do(ctx, func(ctx context.Context) error {
+		return d.inner.SaveCandidate(ctx, sessionID, configText)
+	})
+}
+
+func (d *resilientDatastore) DeleteCandidate(ctx context.Context, sessionID string) error {
+	return d.do(ctx, func(ctx context.Context) error {
+		return d.inner.DeleteCandidate(ctx, sessionID)
+	})
+}
+
+func (d *resilientDatastore) Commit(ctx context.Context, req *datastore.CommitRequest) (string, error) {
+	var commitID string
+	err := d.doOnce(ctx, func(ctx context.Context) (err error) {
+		commitID, err = d.inner.Commit(ctx, req)
+		return err
+	})
+	return commitID, err
+}
+
+func (d *resilientDatastore) Rollback(ctx context.Context, req *datastore.RollbackRequest) (string, error) {
+	var commitID string
+	err := d.doOnce(ctx, func(ctx context.Context) (err error) {
+		commitID, err = d.inner.Rollback(ctx, req)
+		return err
+	})
+	return commitID, err
+}
+
+func (d *resilientDatastore) CompareCandidateRunning(ctx context.Context, sessionID string) (*datastore.DiffResult, error) {
+	var diff *datastore.DiffResult
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		diff, err = d.inner.CompareCandidateRunning(ctx, sessionID)
+		return err
+	})
+	return diff, err
+}
+
+func (d *resilientDatastore) CompareCommits(ctx context.Context, commitID1, commitID2 string) (*datastore.DiffResult, error) {
+	var diff *datastore.DiffResult
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		diff, err = d.inner.CompareCommits(ctx, commitID1, commitID2)
+		return err
+	})
+	return diff, err
+}
+
+func (d *resilientDatastore) AcquireLock(ctx context.Context, req *datastore.LockRequest) error {
+	return d.doOnce(ctx, func(ctx context.Context) error {
+		return d.inner.AcquireLock(ctx, req)
+	})
+}
+
+func (d *resilientDatastore) ReleaseLock(ctx context.Context, target string, sessionID string) error {
+	return d.do(ctx, func(ctx context.Context) error {
+		return d.inner.ReleaseLock(ctx, target, sessionID)
+	})
+}
+
+func (d *resilientDatastore) ExtendLock(ctx context.Context, target string, sessionID string, duration time.Duration) error {
+	return d.do(ctx, func(ctx context.Context) error {
+		return d.inner.ExtendLock(ctx, target, sessionID, duration)
+	})
+}
+
+func (d *resilientDatastore) StealLock(ctx context.Context, req *datastore.StealLockRequest) error {
+	return d.doOnce(ctx, func(ctx context.Context) error {
+		return d.inner.StealLock(ctx, req)
+	})
+}
+
+func (d *resilientDatastore) GetLockInfo(ctx context.Context, target string) (*datastore.LockInfo, error) {
+	var info *datastore.LockInfo
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		info, err = d.inner.GetLockInfo(ctx, target)
+		return err
+	})
+	return info, err
+}
+
+func (d *resilientDatastore) ListCommitHistory(ctx context.Context, opts *datastore.HistoryOptions) ([]*datastore.CommitHistoryEntry, error) {
+	var entries []*datastore.CommitHistoryEntry
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		entries, err = d.inner.ListCommitHistory(ctx, opts)
+		return err
+	})
+	return entries, err
+}
+
+func (d *resilientDatastore) GetCommit(ctx context.Context, commitID string) (*datastore.CommitHistoryEntry, error) {
+	var entry *datastore.CommitHistoryEntry
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		entry, err = d.inner.GetCommit(ctx, commitID)
+		return err
+	})
+	return entry, err
+}
+
+func (d *resilientDatastore) LogAuditEvent(ctx context.Context, event *datastore.AuditEvent) error {
+	return d.doOnce(ctx, func(ctx context.Context) error {
+		return d.inner.LogAuditEvent(ctx, event)
+	})
+}
+
+func (d *resilientDatastore) ListAuditEvents(ctx context.Context, opts *datastore.AuditOptions) ([]*datastore.AuditEvent, error) {
+	var events []*datastore.AuditEvent
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		events, err = d.inner.ListAuditEvents(ctx, opts)
+		return err
+	})
+	return events, err
+}
+
+func (d *resilientDatastore) CleanupAuditLog(ctx context.Context, cutoff time.Time) (int64, error) {
+	var removed int64
+	err := d.do(ctx, func(ctx context.Context) (err error) {
+		removed, err = d.inner.CleanupAuditLog(ctx, cutoff)
+		return err
+	})
+	return removed, err
+}
+
+func (d *resilientDatastore) Close() error {
+	return d.inner.Close()
+}
+
+// confirmedCommitStore returns the wrapped backend's confirmed-commit store
+// behind the same retry policy and breaker, or nil when unsupported.
+func (d *resilientDatastore) confirmedCommitStore() datastore.ConfirmedCommitStore {
+	store, ok := d.inner.(datastore.ConfirmedCommitStore)
+	if !ok {
+		return nil
+	}
+	return &resilientConfirmedCommitStore{ds: d, inner: store}
+}
+
+type resilientConfirmedCommitStore struct {
+	ds    *resilientDatastore
+	inner datastore.ConfirmedCommitStore
+}
+
+func (s *resilientConfirmedCommitStore) GetPendingConfirm(ctx context.Context) (*datastore.PendingConfirm, error) {
+	var pending *datastore.PendingConfirm
+	err := s.ds.do(ctx, func(ctx context.Context) (err error) {
+		pending, err = s.inner.GetPendingConfirm(ctx)
+		return err
+	})
+	return pending, err
+}
+
+func (s *resilientConfirmedCommitStore) SavePendingConfirm(ctx context.Context, pending *datastore.PendingConfirm) error {
+	return s.ds.do(ctx, func(ctx context.Context) error {
+		return s.inner.SavePendingConfirm(ctx, pending)
+	})
+}
+
+func (s *resilientConfirmedCommitStore) ClearPendingConfirm(ctx context.Context) error {
+	return s.ds.do(ctx, func(ctx context.Context) error {
+		return s.inner.ClearPendingConfirm(ctx)
+	})
+}
+
+// datastoreAvailable reports whether RPCs may use the datastore, returning the
+// resource-denied error to send while the circuit breaker is open.
+func (s *Server) datastoreAvailable() *RPCError {
+	ds, ok := s.datastore.(*resilientDatastore)
+	if !ok || ds.available() {
+		return nil
+	}
+	return ErrDatastoreUnavailable()
+}
+
+// SetDatastoreRetryPolicy replaces the retry and circuit-breaker policy used
+// for datastore operations.
+func (s *Server) SetDatastoreRetryPolicy(policy DatastoreRetryPolicy) {
+	if s == nil {
+		return
+	}
+	if ds, ok := s.datastore.(*resilientDatastore); ok {
+		ds.mu.Lock()
+		ds.policy = policy.withDefaults()
+		ds.mu.Unlock()
+	}
+}
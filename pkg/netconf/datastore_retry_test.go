@@ -0,0 +1,158 @@
+package netconf
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+	"github.com/mattn/go-sqlite3"
+)
+
+// flakyDatastore fails GetRunning with an I/O error while down, or for the
+// next failNext calls.
+type flakyDatastore struct {
+	datastore.Datastore
+	down     bool
+	failNext int
+	calls    int
+}
+
+func (d *flakyDatastore) GetRunning(context.Context) (*datastore.RunningConfig, error) {
+	d.calls++
+	if d.down || d.failNext > 0 {
+		if d.failNext > 0 {
+			d.failNext--
+		}
+		return nil, datastore.NewError(datastore.ErrCodeInternal, "failed to get running config", sqlite3.Error{Code: sqlite3.ErrIoErr})
+	}
+	return &datastore.RunningConfig{ConfigText: "set system host-name router1\n"}, nil
+}
+
+func TestDatastoreRetryAndCircuitBreaker(t *testing.T) {
+	ds := &flakyDatastore{}
+	srv := NewServer(ds, nil)
+	srv.SetDatastoreRetryPolicy(DatastoreRetryPolicy{Attempts: 3, FailureThreshold: 2, OpenDuration: time.Minute})
+	resilient := srv.datastore.(*resilientDatastore)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resilient.now = func() time.Time { return now }
+	var backoffs []time.Duration
+	resilient.sleep = func(_ context.Context, d time.Duration) error {
+		backoffs = append(backoffs, d)
+		return nil
+	}
+
+	getConfig := func() *RPCReply {
+		t.Helper()
+		return handleParsedRPC(t, srv, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+			<get-config><source><running/></source></get-config>
+		</rpc>`)
+	}
+
+	// Transient failures are retried with exponential backoff.
+	ds.failNext = 2
+	if reply := getConfig(); len(reply.Errors) != 0 {
+		t.Fatalf("get-config after transient failures errors = %#v, want none", reply.Errors)
+	}
+	if ds.calls != 3 || len(backoffs) != 2 || backoffs[1] != 2*backoffs[0] {
+		t.Fatalf("calls = %d backoffs = %v, want 3 calls with doubling backoff", ds.calls, backoffs)
+	}
+
+	// Operations that exhaust their retries open the breaker.
+	ds.down = true
+	for i := 0; i < 2; i++ {
+		reply := getConfig()
+		if len(reply.Errors) != 1 || reply.Errors[0].ErrorTag != ErrorTagOperationFailed {
+			t.Fatalf("get-config with datastore down errors = %#v, want operation-failed", reply.Errors)
+		}
+	}
+	calls := ds.calls
+	reply := getConfig()
+	if len(reply.Errors) != 1 || reply.Errors[0].ErrorTag != ErrorTagResourceDenied {
+		t.Fatalf("get-config with breaker open errors = %#v, want resource-denied", reply.Errors)
+	}
+	if ds.calls != calls {
+		t.Fatalf("datastore called %d times with breaker open, want 0", ds.calls-calls)
+	}
+
+	// After the open period a trial operation reaches the datastore and its
+	// success closes the breaker.
+	ds.down = false
+	now = now.Add(time.Minute)
+	if reply := getConfig(); len(reply.Errors) != 0 {
+		t.Fatalf("get-config after recovery errors = %#v, want none", reply.Errors)
+	}
+	if !resilient.available() || resilient.failures != 0 {
+		t.Fatalf("breaker after recovery: available = %v failures = %d, want closed", resilient.available(), resilient.failures)
+	}
+}
+
+func TestDatastoreRetrySkipsNonTransientErrors(t *testing.T) {
+	ctx := context.Background()
+	resilient := newResilientDatastore(&validateDatastore{
+		runningErr: datastore.NewError(datastore.ErrCodeNotFound, "no running configuration", nil),
+	}, DatastoreRetryPolicy{FailureThreshold: 1})
+	resilient.sleep = func(context.Context, time.Duration) error {
+		t.Fatal("non-transient error was retried")
+		return nil
+	}
+
+	if _, err := resilient.GetRunning(ctx); err == nil {
+		t.Fatal("GetRunning() error = nil, want not-found")
+	}
+	if !resilient.available() {
+		t.Fatal("not-found error opened the circuit breaker")
+	}
+}
+
+func TestDatastoreRetryDoesNotRetryCommit(t *testing.T) {
+	ctx := context.Background()
+	ds := &validateDatastore{
+		commitErr: datastore.NewError(datastore.ErrCodeInternal, "failed to commit", sqlite3.Error{Code: sqlite3.ErrIoErr}),
+	}
+	calls := 0
+	resilient := newResilientDatastore(&countingCommitDatastore{validateDatastore: ds, calls: &calls}, DatastoreRetryPolicy{FailureThreshold: 1})
+	resilient.sleep = func(context.Context, time.Duration) error {
+		t.Fatal("commit was retried")
+		return nil
+	}
+
+	if _, err := resilient.Commit(ctx, &datastore.CommitRequest{SessionID: "s1"}); err == nil {
+		t.Fatal("Commit() error = nil, want I/O error")
+	}
+	if calls != 1 {
+		t.Fatalf("Commit() reached the datastore %d times, want 1", calls)
+	}
+	if resilient.available() {
+		t.Fatal("transient commit failure did not count toward the circuit breaker")
+	}
+}
+
+type countingCommitDatastore struct {
+	*validateDatastore
+	calls *int
+}
+
+func (d *countingCommitDatastore) Commit(ctx context.Context, req *datastore.CommitRequest) (string, error) {
+	*d.calls++
+	return d.validateDatastore.Commit(ctx, req)
+}
+
+func TestDatastoreRetrySkipsParseErrors(t *testing.T) {
+	ctx := context.Background()
+	resilient := newResilientDatastore(&validateDatastore{
+		runningErr: datastore.NewError(datastore.ErrCodeInternal, "failed to parse candidate config", errors.New("invalid character 'x' looking for beginning of value")),
+	}, DatastoreRetryPolicy{FailureThreshold: 1})
+	resilient.sleep = func(context.Context, time.Duration) error {
+		t.Fatal("parse error was retried")
+		return nil
+	}
+
+	if _, err := resilient.GetRunning(ctx); err == nil {
+		t.Fatal("GetRunning() error = nil, want parse error")
+	}
+	if !resilient.available() {
+		t.Fatal("parse error opened the circuit breaker")
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 )
 
 // ErrorType represents NETCONF error-type values per RFC 6241
@@ -26,12 +28,16 @@ const (
 	ErrorTagAccessDenied          ErrorTag = "access-denied"
 	ErrorTagLockDenied            ErrorTag = "lock-denied"
 	ErrorTagInUse                 ErrorTag = "in-use"
+	ErrorTagResourceDenied        ErrorTag = "resource-denied"
 	ErrorTagOperationFailed       ErrorTag = "operation-failed"
 	ErrorTagMissingElement        ErrorTag = "missing-element"
 	ErrorTagMissingAttribute      ErrorTag = "missing-attribute"
+	ErrorTagBadAttribute          ErrorTag = "bad-attribute"
 	ErrorTagUnknownElement        ErrorTag = "unknown-element"
 	ErrorTagUnknownAttribute      ErrorTag = "unknown-attribute"
 	ErrorTagUnknownNamespace      ErrorTag = "unknown-namespace"
+	ErrorTagDataExists            ErrorTag = "data-exists"
+	ErrorTagDataMissing           ErrorTag = "data-missing"
 )
 
 // ErrorSeverity represents NETCONF error-severity values per RFC 6241
@@ -52,6 +58,51 @@ type RPCError struct {
 	ErrorPath     string        `xml:"error-path,omitempty"`
 	ErrorMessage  string        `xml:"error-message,omitempty"`
 	ErrorInfo     *ErrorInfo    `xml:"error-info,omitempty"`
+
+	// ErrorPathNamespaces declares the namespace prefixes used in
+	// ErrorPath, keyed by prefix. RFC 6241 requires them to be in scope of
+	// the error-path element.
+	ErrorPathNamespaces map[string]string `xml:"-"`
+}
+
+// errorPathElement is the encoded error-path element with its namespace
+// declarations.
+type errorPathElement struct {
+	Attrs []xml.Attr `xml:",any,attr"`
+	Path  string     `xml:",chardata"`
+}
+
+// MarshalXML encodes the rpc-error, declaring ErrorPathNamespaces on the
+// error-path element.
+func (e *RPCError) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	var path *errorPathElement
+	if e.ErrorPath != "" {
+		path = &errorPathElement{Path: e.ErrorPath}
+		for _, prefix := range slices.Sorted(maps.Keys(e.ErrorPathNamespaces)) {
+			path.Attrs = append(path.Attrs, xml.Attr{
+				Name:  xml.Name{Local: "xmlns:" + prefix},
+				Value: e.ErrorPathNamespaces[prefix],
+			})
+		}
+	}
+	start.Name = xml.Name{Space: NetconfBaseNS, Local: "rpc-error"}
+	return enc.EncodeElement(struct {
+		ErrorType     ErrorType         `xml:"error-type"`
+		ErrorTag      ErrorTag          `xml:"error-tag"`
+		ErrorSeverity ErrorSeverity     `xml:"error-severity"`
+		ErrorAppTag   string            `xml:"error-app-tag,omitempty"`
+		ErrorPath     *errorPathElement `xml:"error-path,omitempty"`
+		ErrorMessage  string            `xml:"error-message,omitempty"`
+		ErrorInfo     *ErrorInfo        `xml:"error-info,omitempty"`
+	}{
+		ErrorType:     e.ErrorType,
+		ErrorTag:      e.ErrorTag,
+		ErrorSeverity: e.ErrorSeverity,
+		ErrorAppTag:   e.ErrorAppTag,
+		ErrorPath:     path,
+		ErrorMessage:  e.ErrorMessage,
+		ErrorInfo:     e.ErrorInfo,
+	}, start)
 }
 
 // ErrorInfo contains structured error details per RFC 6241
@@ -60,6 +111,8 @@ type ErrorInfo struct {
 	BadAttribute     string `xml:"bad-attribute,omitempty"`
 	BadNamespace     string `xml:"bad-namespace,omitempty"`
 	LockOwnerSession string `xml:"lock-owner-session,omitempty"`
+	// Hint is the corrective action suggested by configuration validation.
+	Hint string `xml:"urn:arca:router:config:1.0 hint,omitempty"`
 }
 
 // NewRPCError creates a new RPCError with required fields
@@ -129,6 +182,18 @@ func (e *RPCError) WithLockOwner(sessionID string) *RPCError {
 	return e
 }
 
+// WithHint adds the arca hint element to error-info
+func (e *RPCError) WithHint(hint string) *RPCError {
+	if e == nil {
+		return nil
+	}
+	if e.ErrorInfo == nil {
+		e.ErrorInfo = &ErrorInfo{}
+	}
+	e.ErrorInfo.Hint = hint
+	return e
+}
+
 // WithAppTag adds error-app-tag as direct child of rpc-error (RFC 6241)
 func (e *RPCError) WithAppTag(tag string) *RPCError {
 	if e == nil {
@@ -211,6 +276,28 @@ func ErrConfirmedCommitNotSupported(element string) *RPCError {
 		WithBadElement(element)
 }
 
+// ErrInvalidConfirmedCommitOption returns an error for a confirmed-commit
+// option that is malformed or does not match the pending confirmed commit.
+func ErrInvalidConfirmedCommitOption(rpcName, element, message string) *RPCError {
+	return NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue, message).
+		WithPath(fmt.Sprintf("/rpc/%s/%s", rpcName, element)).
+		WithBadElement(element)
+}
+
+// ErrConfirmedCommitInUse returns an error when a pending confirmed commit
+// belongs to another session or requires a persist-id.
+func ErrConfirmedCommitInUse(message string) *RPCError {
+	return NewRPCError(ErrorTypeProtocol, ErrorTagInUse, message)
+}
+
+// ErrStaleCandidate returns an error when a commit is rejected because the
+// running configuration changed after the candidate was created.
+func ErrStaleCandidate(message string) *RPCError {
+	return NewRPCError(ErrorTypeApplication, ErrorTagInUse, message).
+		WithPath("/rpc/commit").
+		WithAppTag("stale-candidate")
+}
+
 // ErrUnsupportedFilterType returns error for unsupported filter type
 func ErrUnsupportedFilterType(rpcName, filterType string) *RPCError {
 	return NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue, fmt.Sprintf("unsupported filter type: %s", filterType)).
@@ -241,6 +328,15 @@ func ErrMissingAttribute(element, attribute string) *RPCError {
 		WithBadAttribute(attribute)
 }
 
+// ErrBadAttribute returns error for an attribute with an invalid value or
+// an attribute that appears more than once
+func ErrBadAttribute(element, attribute, reason string) *RPCError {
+	return NewRPCError(ErrorTypeRPC, ErrorTagBadAttribute, fmt.Sprintf("bad attribute %s: %s", attribute, reason)).
+		WithPath(rpcErrorPath(element)).
+		WithBadElement(element).
+		WithBadAttribute(attribute)
+}
+
 // ErrMissingElement returns error for missing required element
 func ErrMissingElement(rpcName, element string) *RPCError {
 	return NewRPCError(ErrorTypeProtocol, ErrorTagMissingElement, fmt.Sprintf("missing required element: %s", element)).
@@ -363,6 +459,14 @@ func ErrDatastoreError(message string) *RPCError {
 		WithAppTag("datastore-error")
 }
 
+// ErrDatastoreUnavailable returns error while the datastore circuit breaker is
+// open after repeated datastore failures
+func ErrDatastoreUnavailable() *RPCError {
+	return NewRPCError(ErrorTypeApplication, ErrorTagResourceDenied,
+		"configuration datastore is temporarily unavailable; retry later").
+		WithAppTag("datastore-unavailable")
+}
+
 // ErrTimeout returns error for operation timeout
 func ErrTimeout(message string) *RPCError {
 	return NewRPCError(ErrorTypeApplication, ErrorTagOperationFailed, message).
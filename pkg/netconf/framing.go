@@ -268,16 +268,8 @@ func (fw *FramingWriter) writeChunkedMessage(data []byte) error {
 			chunkSize = MaxChunkSize
 		}
 
-		// Write chunk header: \n#<len>\n
-		header := fmt.Sprintf("\n#%d\n", chunkSize)
-		if _, err := fw.writer.Write([]byte(header)); err != nil {
-			return fmt.Errorf("write chunk header: %w", err)
-		}
-
-		// Write chunk data
-		chunk := data[offset : offset+chunkSize]
-		if _, err := fw.writer.Write(chunk); err != nil {
-			return fmt.Errorf("write chunk data: %w", err)
+		if err := writeChunk(fw.writer, data[offset:offset+chunkSize]); err != nil {
+			return err
 		}
 
 		offset += chunkSize
@@ -292,6 +284,18 @@ func (fw *FramingWriter) writeChunkedMessage(data []byte) error {
 	return nil
 }
 
+// writeChunk writes one base:1.1 chunk: \n#<len>\n<chunk>
+func writeChunk(w io.Writer, chunk []byte) error {
+	header := fmt.Sprintf("\n#%d\n", len(chunk))
+	if _, err := w.Write([]byte(header)); err != nil {
+		return fmt.Errorf("write chunk header: %w", err)
+	}
+	if _, err := w.Write(chunk); err != nil {
+		return fmt.Errorf("write chunk data: %w", err)
+	}
+	return nil
+}
+
 // writeEOMMessage writes a base:1.0 EOM-delimited message
 // Format: <message>]]>]]>
 func (fw *FramingWriter) writeEOMMessage(data []byte) error {
@@ -312,3 +316,117 @@ func (fw *FramingWriter) writeEOMMessage(data []byte) error {
 
 	return nil
 }
+
+// WriteMessageStream writes one NETCONF message whose body is produced
+// incrementally by fn. In base:1.1 the output is framed into chunks of at most
+// MaxChunkSize as it is produced, so large replies are never held in memory
+// as a whole. If fn fails, part of the message may already have been sent and
+// the caller must close the session.
+func (fw *FramingWriter) WriteMessageStream(fn func(w io.Writer) error) error {
+	if fw == nil || fw.writer == nil {
+		return fmt.Errorf("framing writer is not initialized")
+	}
+	if fw.baseVersion == "1.1" {
+		cw := &chunkWriter{w: fw.writer}
+		if err := fn(cw); err != nil {
+			return err
+		}
+		return cw.close()
+	}
+	ew := &eomWriter{w: fw.writer}
+	if err := fn(ew); err != nil {
+		return err
+	}
+	return ew.close()
+}
+
+// chunkWriter frames streamed output as base:1.1 chunks, emitting a chunk
+// each time MaxChunkSize bytes have accumulated.
+type chunkWriter struct {
+	w      io.Writer
+	buf    [MaxChunkSize]byte
+	n      int
+	chunks int
+	err    error
+}
+
+func (cw *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if cw.err != nil {
+			return written, cw.err
+		}
+		copied := copy(cw.buf[cw.n:], p)
+		cw.n += copied
+		written += copied
+		p = p[copied:]
+		if cw.n == len(cw.buf) {
+			cw.flush()
+		}
+	}
+	return written, cw.err
+}
+
+func (cw *chunkWriter) flush() {
+	if cw.err != nil || cw.n == 0 {
+		return
+	}
+	cw.err = writeChunk(cw.w, cw.buf[:cw.n])
+	cw.n = 0
+	cw.chunks++
+}
+
+func (cw *chunkWriter) close() error {
+	cw.flush()
+	if cw.err != nil {
+		return cw.err
+	}
+	if cw.chunks == 0 {
+		return fmt.Errorf("chunked message must contain at least one chunk")
+	}
+	if _, err := cw.w.Write([]byte(ChunkEnd)); err != nil {
+		return fmt.Errorf("write chunk end: %w", err)
+	}
+	return nil
+}
+
+// eomWriter passes streamed output through for base:1.0 framing and rejects
+// an embedded EOM marker, including one split across writes.
+type eomWriter struct {
+	w    io.Writer
+	tail []byte
+}
+
+func (ew *eomWriter) Write(p []byte) (int, error) {
+	overlap := p
+	if len(overlap) > len(EOMMarker)-1 {
+		overlap = overlap[:len(EOMMarker)-1]
+	}
+	var edgeBuf [2 * (len(EOMMarker) - 1)]byte
+	edge := append(append(edgeBuf[:0], ew.tail...), overlap...)
+	if bytes.Contains(edge, []byte(EOMMarker)) || bytes.Contains(p, []byte(EOMMarker)) {
+		return 0, fmt.Errorf("message contains EOM marker %q which would cause truncation in base:1.0", EOMMarker)
+	}
+
+	if len(p) >= len(EOMMarker)-1 {
+		ew.tail = append(ew.tail[:0], p[len(p)-(len(EOMMarker)-1):]...)
+	} else {
+		if len(edge) > len(EOMMarker)-1 {
+			edge = edge[len(edge)-(len(EOMMarker)-1):]
+		}
+		ew.tail = append(ew.tail[:0], edge...)
+	}
+
+	n, err := ew.w.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("write message: %w", err)
+	}
+	return n, nil
+}
+
+func (ew *eomWriter) close() error {
+	if _, err := ew.w.Write([]byte(EOMMarker)); err != nil {
+		return fmt.Errorf("write EOM marker: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package netconf
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 )
@@ -64,6 +65,59 @@ func TestChunkedFramingWritesRFC6242Markers(t *testing.T) {
 	}
 }
 
+func TestWriteMessageStreamMatchesWriteMessage(t *testing.T) {
+	message := strings.Repeat("0123456789", MaxChunkSize/4)
+
+	for _, version := range []string{"1.0", "1.1"} {
+		var want bytes.Buffer
+		if err := NewFramingWriter(&want, version).WriteMessage([]byte(message)); err != nil {
+			t.Fatalf("base:%s WriteMessage failed: %v", version, err)
+		}
+
+		var got bytes.Buffer
+		err := NewFramingWriter(&got, version).WriteMessageStream(func(w io.Writer) error {
+			// Uneven writes exercise chunk boundaries falling inside a write.
+			for rest := message; len(rest) > 0; {
+				n := min(len(rest), 7)
+				if _, err := io.WriteString(w, rest[:n]); err != nil {
+					return err
+				}
+				rest = rest[n:]
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("base:%s WriteMessageStream failed: %v", version, err)
+		}
+		if got.String() != want.String() {
+			t.Fatalf("base:%s WriteMessageStream() output differs from WriteMessage()", version)
+		}
+
+		reader := NewFramingReader(&got, version)
+		read, err := reader.ReadMessage()
+		if err != nil {
+			t.Fatalf("base:%s ReadMessage failed: %v", version, err)
+		}
+		if string(read) != message {
+			t.Fatalf("base:%s ReadMessage() did not round-trip streamed message", version)
+		}
+	}
+}
+
+func TestWriteMessageStreamRejectsSplitEOMMarker(t *testing.T) {
+	var buf bytes.Buffer
+	err := NewFramingWriter(&buf, "1.0").WriteMessageStream(func(w io.Writer) error {
+		if _, err := io.WriteString(w, "<data>]]>"); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, "]]></data>")
+		return err
+	})
+	if err == nil || !strings.Contains(err.Error(), "EOM marker") {
+		t.Fatalf("WriteMessageStream() error = %v, want EOM marker error", err)
+	}
+}
+
 func TestFramingReaderNilReceiver(t *testing.T) {
 	var reader *FramingReader
 	reader.SetBaseVersion("1.1")
@@ -18,6 +18,12 @@ const (
 	CapabilityArcaRouter = "urn:arca:router:config:1.0?module=arca-router&revision=2025-12-27"
 	// Arca-specific capability for the safe absolute XPath subset accepted by filters.
 	CapabilityArcaXPathFilterSubset = "urn:arca:router:netconf:capability:xpath-filter-subset:1.0"
+	// Arca-specific capability for <encoding>json</encoding> in <get-config>.
+	CapabilityArcaJSONEncoding = "urn:arca:router:netconf:capability:json-encoding:1.0"
+
+	// RFC 6241 confirmed-commit, advertised when the datastore can persist
+	// pending confirmed-commit state.
+	CapabilityConfirmedCommit = "urn:ietf:params:netconf:capability:confirmed-commit:1.1"
 
 	// NETCONF namespace
 	NetconfNamespace = "urn:ietf:params:xml:ns:netconf:base:1.0"
@@ -34,36 +40,84 @@ type Hello struct {
 
 // HelloOptions controls optional NETCONF capability advertisement.
 type HelloOptions struct {
-	AdvertiseStandardXPath bool
-	DisableStandardXPath   bool
+	AdvertiseStandardXPath   bool
+	DisableStandardXPath     bool
+	AdvertiseConfirmedCommit bool
 }
 
-// ServerHello creates a server <hello> message with the given session ID
-func ServerHello(sessionID uint32) *Hello {
-	return ServerHelloWithOptions(sessionID, HelloOptions{})
+// ServerCapabilities is the set of optional NETCONF features a server has
+// enabled. The base protocol versions, :candidate, and the arca-router module
+// capabilities are always advertised; every other capability is advertised
+// only when its feature is enabled, so clients never see a capability for an
+// operation the server would reject. The :startup and :notification
+// capabilities are not implemented and are never advertised.
+type ServerCapabilities struct {
+	Validate        bool
+	RollbackOnError bool
+	ConfirmedCommit bool
+	StandardXPath   bool
+	JSONEncoding    bool
 }
 
-// ServerHelloWithOptions creates a server <hello> with optional capabilities.
-func ServerHelloWithOptions(sessionID uint32, options HelloOptions) *Hello {
-	hello := &Hello{
-		SessionID: sessionID,
+// DefaultServerCapabilities returns the capabilities of a server with no
+// optional backends configured.
+func DefaultServerCapabilities() ServerCapabilities {
+	return ServerCapabilities{
+		Validate:        true,
+		RollbackOnError: true,
+		StandardXPath:   true,
+		JSONEncoding:    true,
 	}
-	hello.Capabilities.Capability = []string{
+}
+
+// URIs returns the capability URIs to advertise, in a stable order.
+func (c ServerCapabilities) URIs() []string {
+	uris := []string{
 		CapabilityBase10,
 		CapabilityBase11,
 		CapabilityCandidate,
-		CapabilityValidate,
-		CapabilityRollback,
+	}
+	if c.Validate {
+		uris = append(uris, CapabilityValidate)
+	}
+	if c.RollbackOnError {
+		uris = append(uris, CapabilityRollback)
+	}
+	if c.ConfirmedCommit {
+		uris = append(uris, CapabilityConfirmedCommit)
+	}
+	uris = append(uris,
 		CapabilityArcaRouter,
 		CapabilityArcaXPathFilterSubset,
+	)
+	if c.JSONEncoding {
+		uris = append(uris, CapabilityArcaJSONEncoding)
 	}
-	advertiseStandardXPath := !options.DisableStandardXPath
-	if options.AdvertiseStandardXPath {
-		advertiseStandardXPath = true
+	if c.StandardXPath {
+		uris = append(uris, CapabilityXPath)
 	}
-	if advertiseStandardXPath {
-		hello.Capabilities.Capability = append(hello.Capabilities.Capability, CapabilityXPath)
+	return uris
+}
+
+// ServerHello creates a server <hello> message with the given session ID
+func ServerHello(sessionID uint32) *Hello {
+	return ServerHelloWithCapabilities(sessionID, DefaultServerCapabilities())
+}
+
+// ServerHelloWithOptions creates a server <hello> with optional capabilities.
+func ServerHelloWithOptions(sessionID uint32, options HelloOptions) *Hello {
+	caps := DefaultServerCapabilities()
+	caps.ConfirmedCommit = options.AdvertiseConfirmedCommit
+	caps.StandardXPath = !options.DisableStandardXPath || options.AdvertiseStandardXPath
+	return ServerHelloWithCapabilities(sessionID, caps)
+}
+
+// ServerHelloWithCapabilities creates a server <hello> advertising caps.
+func ServerHelloWithCapabilities(sessionID uint32, caps ServerCapabilities) *Hello {
+	hello := &Hello{
+		SessionID: sessionID,
 	}
+	hello.Capabilities.Capability = caps.URIs()
 	return hello
 }
 
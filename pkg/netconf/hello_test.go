@@ -65,6 +65,90 @@ func TestServerHelloCanSuppressStandardXPath(t *testing.T) {
 	}
 }
 
+func TestServerHelloAdvertisesConfirmedCommitWhenEnabled(t *testing.T) {
+	if ServerHello(12345).HasCapability(CapabilityConfirmedCommit) {
+		t.Fatalf("ServerHello() advertised %q by default", CapabilityConfirmedCommit)
+	}
+
+	hello := ServerHelloWithOptions(12345, HelloOptions{AdvertiseConfirmedCommit: true})
+	if !hello.HasCapability(CapabilityConfirmedCommit) {
+		t.Fatalf("ServerHelloWithOptions() did not advertise %q", CapabilityConfirmedCommit)
+	}
+}
+
+func TestServerHelloAdvertisesEnabledCapabilities(t *testing.T) {
+	always := []string{CapabilityBase10, CapabilityBase11, CapabilityCandidate}
+	module := []string{CapabilityArcaRouter, CapabilityArcaXPathFilterSubset}
+	join := func(parts ...[]string) []string {
+		var out []string
+		for _, part := range parts {
+			out = append(out, part...)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name string
+		caps ServerCapabilities
+		want []string
+	}{
+		{
+			name: "minimal",
+			caps: ServerCapabilities{},
+			want: join(always, module),
+		},
+		{
+			name: "default",
+			caps: DefaultServerCapabilities(),
+			want: join(always, []string{CapabilityValidate, CapabilityRollback}, module, []string{CapabilityArcaJSONEncoding, CapabilityXPath}),
+		},
+		{
+			name: "all features",
+			caps: ServerCapabilities{
+				Validate:        true,
+				RollbackOnError: true,
+				ConfirmedCommit: true,
+				StandardXPath:   true,
+				JSONEncoding:    true,
+			},
+			want: join(always,
+				[]string{CapabilityValidate, CapabilityRollback, CapabilityConfirmedCommit},
+				module,
+				[]string{CapabilityArcaJSONEncoding, CapabilityXPath}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ServerHelloWithCapabilities(7, tt.caps).Capabilities.Capability
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Fatalf("advertised capabilities:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestSSHServerCapabilitiesReflectConfiguration(t *testing.T) {
+	server := &SSHServer{config: &SSHConfig{AdvertiseStandardXPath: false}}
+	caps := server.serverCapabilities()
+	if caps.StandardXPath {
+		t.Fatal("serverCapabilities() enabled :xpath with AdvertiseStandardXPath=false")
+	}
+	if caps.ConfirmedCommit {
+		t.Fatal("serverCapabilities() enabled :confirmed-commit without a confirmed-commit store")
+	}
+	for _, uri := range caps.URIs() {
+		if strings.Contains(uri, ":startup:") || strings.Contains(uri, ":notification:") {
+			t.Fatalf("serverCapabilities() advertises %s, which is not implemented", uri)
+		}
+	}
+
+	server.config.AdvertiseStandardXPath = true
+	if !server.serverCapabilities().StandardXPath {
+		t.Fatal("serverCapabilities() did not enable :xpath with AdvertiseStandardXPath=true")
+	}
+}
+
 func TestMarshalHello(t *testing.T) {
 	hello := ServerHello(12345)
 	data, err := MarshalHello(hello)
@@ -0,0 +1,52 @@
+package netconf
+
+// MessageSizeLimits bounds NETCONF message sizes per operation class, so a
+// deployment can allow large get-config replies while keeping edit-config
+// input tightly bounded. Input limits cannot exceed MaxXMLSize because every
+// RPC is already capped at that size; get-config replies above MaxXMLSize are
+// streamed rather than buffered.
+type MessageSizeLimits struct {
+	ConfigReply int // get-config <data> content in bytes. Default: MaxXMLSize
+	ConfigInput int // <config> content of edit-config, copy-config, and validate. Default and maximum: MaxXMLSize
+	Filter      int // <filter> content and xpath select of get and get-config. Default and maximum: MaxXMLSize
+}
+
+// DefaultMessageSizeLimits returns the default per-operation size limits.
+func DefaultMessageSizeLimits() MessageSizeLimits {
+	return MessageSizeLimits{
+		ConfigReply: MaxXMLSize,
+		ConfigInput: MaxXMLSize,
+		Filter:      MaxXMLSize,
+	}
+}
+
+func (l MessageSizeLimits) withDefaults() MessageSizeLimits {
+	defaults := DefaultMessageSizeLimits()
+	if l.ConfigReply <= 0 {
+		l.ConfigReply = defaults.ConfigReply
+	}
+	if l.ConfigInput <= 0 || l.ConfigInput > MaxXMLSize {
+		l.ConfigInput = defaults.ConfigInput
+	}
+	if l.Filter <= 0 || l.Filter > MaxXMLSize {
+		l.Filter = defaults.Filter
+	}
+	return l
+}
+
+// SetMessageSizeLimits replaces the per-operation message size limits.
+func (s *Server) SetMessageSizeLimits(limits MessageSizeLimits) {
+	if s == nil {
+		return
+	}
+	s.sizeLimits = limits.withDefaults()
+}
+
+// messageSizeLimits returns the effective limits; a server that was never
+// configured uses the defaults.
+func (s *Server) messageSizeLimits() MessageSizeLimits {
+	if s == nil {
+		return DefaultMessageSizeLimits()
+	}
+	return s.sizeLimits.withDefaults()
+}
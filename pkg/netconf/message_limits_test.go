@@ -0,0 +1,77 @@
+package netconf
+
+import (
+	"testing"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+func TestMessageSizeLimitsAreEnforcedPerOperation(t *testing.T) {
+	getConfig := `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config><source><running/></source></get-config>
+	</rpc>`
+	filteredGetConfig := `<rpc message-id="102" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config><source><running/></source>
+			<filter type="subtree"><system xmlns="` + ArcaConfigNS + `"/></filter>
+		</get-config>
+	</rpc>`
+	jsonGetConfig := `<rpc message-id="104" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config><source><running/></source><encoding>json</encoding></get-config>
+	</rpc>`
+	validate := `<rpc message-id="103" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<validate>
+			<source><config><system xmlns="` + ArcaConfigNS + `"><host-name>router1</host-name></system></config></source>
+		</validate>
+	</rpc>`
+
+	tests := []struct {
+		name   string
+		limits MessageSizeLimits
+		failed string
+	}{
+		{name: "defaults"},
+		{name: "config reply", limits: MessageSizeLimits{ConfigReply: 16}, failed: "get-config"},
+		{name: "config input", limits: MessageSizeLimits{ConfigInput: 16}, failed: "validate"},
+		{name: "filter", limits: MessageSizeLimits{Filter: 16}, failed: "filter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := NewServer(&validateDatastore{
+				running: &datastore.RunningConfig{ConfigText: "set system host-name router1\n"},
+			}, nil)
+			srv.SetMessageSizeLimits(tt.limits)
+
+			for _, rpc := range []struct {
+				name string
+				xml  string
+			}{
+				{name: "get-config", xml: getConfig},
+				{name: "filter", xml: filteredGetConfig},
+				{name: "json", xml: jsonGetConfig},
+				{name: "validate", xml: validate},
+			} {
+				reply := handleParsedRPC(t, srv, rpc.xml)
+				wantErr := rpc.name == tt.failed || (tt.failed == "get-config" && (rpc.name == "filter" || rpc.name == "json"))
+				if !wantErr {
+					if len(reply.Errors) != 0 {
+						t.Errorf("%s errors = %#v, want none", rpc.name, reply.Errors)
+					}
+					continue
+				}
+				if len(reply.Errors) != 1 || reply.Errors[0].ErrorAppTag != "size-limit" {
+					t.Errorf("%s errors = %#v, want size-limit error", rpc.name, reply.Errors)
+				}
+			}
+		})
+	}
+}
+
+func TestMessageSizeLimitsCapInputsAtMaxXMLSize(t *testing.T) {
+	limits := MessageSizeLimits{ConfigReply: 2 * MaxXMLSize, ConfigInput: 2 * MaxXMLSize, Filter: -1}.withDefaults()
+	if limits.ConfigReply != 2*MaxXMLSize {
+		t.Errorf("ConfigReply = %d, want %d", limits.ConfigReply, 2*MaxXMLSize)
+	}
+	if limits.ConfigInput != MaxXMLSize || limits.Filter != MaxXMLSize {
+		t.Errorf("ConfigInput, Filter = %d, %d; want both capped at %d", limits.ConfigInput, limits.Filter, MaxXMLSize)
+	}
+}
@@ -19,13 +19,46 @@ type OperationalStateProvider interface {
 	BFDStatus(ctx context.Context) (*BFDOperationalState, error)
 }
 
+// LastApplyProvider is implemented by operational state providers that report
+// the outcome of the last configuration apply to the data plane.
+type LastApplyProvider interface {
+	// LastApply returns the last apply result, or nil when none is recorded.
+	LastApply(ctx context.Context) (*LastApplyOperationalState, error)
+}
+
+// LastApplyOperationalState describes the last configuration apply. A commit
+// can be stored in running while its apply failed to program the data plane.
+type LastApplyOperationalState struct {
+	Time    time.Time
+	Version uint64
+	Error   string // empty when the apply succeeded
+	Plugins []PluginApplyOperationalState
+}
+
+// PluginApplyOperationalState is the last apply outcome of one plugin.
+type PluginApplyOperationalState struct {
+	Name       string
+	Status     string
+	Error      string
+	Operations []ApplyOperationOperationalState
+}
+
+// ApplyOperationOperationalState is the outcome of one step of a plugin
+// apply.
+type ApplyOperationOperationalState struct {
+	Operation string
+	Error     string // empty when the step succeeded
+}
+
 // InterfaceOperationalState is a transport-neutral interface state snapshot.
 type InterfaceOperationalState struct {
 	Name        string
+	IfIndex     uint32
 	AdminStatus string
 	OperStatus  string
 	MAC         string
 	QoSProfile  string
+	Addresses   []string
 	IPv4TableID uint32
 	IPv6TableID uint32
 	Counters    *InterfaceOperationalCounters
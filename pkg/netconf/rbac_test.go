@@ -1,8 +1,12 @@
 package netconf
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
 )
 
 // TestRBACMatrix tests the complete RBAC authorization matrix
@@ -322,3 +326,84 @@ func TestRBACConsistency(t *testing.T) {
 		t.Errorf("Operator should allow at least as many operations as read-only")
 	}
 }
+
+func roleEditConfigRPC(t *testing.T, ds datastore.Datastore, role, defaultOperation, configXML string) *RPCReply {
+	t.Helper()
+
+	srv := NewServer(ds, nil)
+	sess := &Session{
+		ID:             "session-1",
+		NumericID:      1,
+		Username:       "alice",
+		Role:           role,
+		LastUsed:       time.Now(),
+		datastoreLocks: map[string]struct{}{},
+	}
+	rpc, err := ParseRPC([]byte(`<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<edit-config>
+			<target><candidate/></target>
+			<default-operation>` + defaultOperation + `</default-operation>
+			` + configXML + `
+		</edit-config>
+	</rpc>`))
+	if err != nil {
+		t.Fatalf("ParseRPC() error = %v", err)
+	}
+	return srv.HandleRPC(context.Background(), sess, rpc)
+}
+
+func TestHandleRPCReadOnlyEditConfigAccessDenied(t *testing.T) {
+	ds := &copyConfigDatastore{
+		candidate: &datastore.CandidateConfig{ConfigText: "set system host-name old-router\n"},
+		lockInfo:  &datastore.LockInfo{IsLocked: true, SessionID: "session-1"},
+	}
+
+	reply := roleEditConfigRPC(t, ds, RoleReadOnly, "merge", "<config><system><host-name>router1</host-name></system></config>")
+	if len(reply.Errors) != 1 {
+		t.Fatalf("read-only edit-config errors = %#v, want access-denied", reply.Errors)
+	}
+	if err := reply.Errors[0]; err.ErrorTag != ErrorTagAccessDenied || err.ErrorPath != "/rpc/edit-config" {
+		t.Fatalf("read-only edit-config error = %#v, want access-denied at /rpc/edit-config", err)
+	}
+	if ds.saveCalled {
+		t.Fatal("read-only edit-config saved the candidate")
+	}
+}
+
+func TestHandleRPCUserManagementRequiresAdmin(t *testing.T) {
+	candidate := strings.Join([]string{
+		"set security netconf ssh port 830",
+		"set security users user bob role operator",
+		"",
+	}, "\n")
+	// A replace of <security> cannot carry user accounts, so it deletes them.
+	edit := "<config><security><netconf><ssh><port>1830</port></ssh></netconf></security></config>"
+
+	ds := &copyConfigDatastore{
+		candidate: &datastore.CandidateConfig{ConfigText: candidate},
+		lockInfo:  &datastore.LockInfo{IsLocked: true, SessionID: "session-1"},
+	}
+	reply := roleEditConfigRPC(t, ds, RoleOperator, "replace", edit)
+	if len(reply.Errors) != 1 || reply.Errors[0].ErrorTag != ErrorTagAccessDenied {
+		t.Fatalf("operator user removal errors = %#v, want access-denied", reply.Errors)
+	}
+	if !strings.Contains(reply.Errors[0].ErrorMessage, "user management requires admin role") {
+		t.Fatalf("operator user removal message = %q", reply.Errors[0].ErrorMessage)
+	}
+	if ds.saveCalled {
+		t.Fatal("operator user removal saved the candidate")
+	}
+
+	// Operators may still edit non-user security settings.
+	ds.saveCalled = false
+	reply = roleEditConfigRPC(t, ds, RoleOperator, "merge", edit)
+	if len(reply.Errors) != 0 || !ds.saveCalled {
+		t.Fatalf("operator security merge errors = %#v, saved = %v, want ok", reply.Errors, ds.saveCalled)
+	}
+
+	ds.saveCalled = false
+	reply = roleEditConfigRPC(t, ds, RoleAdmin, "replace", edit)
+	if len(reply.Errors) != 0 || !ds.saveCalled {
+		t.Fatalf("admin user removal errors = %#v, saved = %v, want ok", reply.Errors, ds.saveCalled)
+	}
+}
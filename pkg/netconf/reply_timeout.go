@@ -0,0 +1,99 @@
+package netconf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultReplyWriteTimeout bounds each write of a reply to the SSH channel.
+const DefaultReplyWriteTimeout = 60 * time.Second
+
+// replyWriteChunkSize is the largest piece of a reply written under one
+// timer, so the timeout bounds a stall rather than the whole reply. It
+// matches the SSH maximum packet payload.
+const replyWriteChunkSize = 32 * 1024
+
+// errReplyWriteTimeout reports a reply write the client did not drain in time.
+var errReplyWriteTimeout = errors.New("reply write timed out")
+
+// replyTimeoutWriter bounds every write to a session's SSH channel, which has
+// no write deadline of its own. A client that stops reading eventually fills
+// the SSH window and blocks the write; when a write makes no progress within
+// timeout, onTimeout tears the session's connection down, which fails the
+// blocked write so the session goroutine and its slot are released. Large
+// writes are split into chunks that each get the full timeout, so a slow
+// client that keeps reading is not cut off part way through a large reply.
+type replyTimeoutWriter struct {
+	w         io.Writer
+	timeout   time.Duration
+	onTimeout func()
+	timedOut  atomic.Bool
+}
+
+func newReplyTimeoutWriter(w io.Writer, timeout time.Duration, onTimeout func()) *replyTimeoutWriter {
+	return &replyTimeoutWriter{w: w, timeout: timeout, onTimeout: onTimeout}
+}
+
+func (w *replyTimeoutWriter) Write(p []byte) (int, error) {
+	if w.timedOut.Load() {
+		return 0, errReplyWriteTimeout
+	}
+	if w.timeout <= 0 {
+		return w.w.Write(p)
+	}
+	written := 0
+	for written < len(p) {
+		chunk := p[written:min(written+replyWriteChunkSize, len(p))]
+		n, err := w.writeChunk(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		if n < len(chunk) {
+			return written, io.ErrShortWrite
+		}
+	}
+	return written, nil
+}
+
+// writeChunk writes p under a fresh timer.
+func (w *replyTimeoutWriter) writeChunk(p []byte) (int, error) {
+	timer := time.AfterFunc(w.timeout, func() {
+		if w.timedOut.CompareAndSwap(false, true) && w.onTimeout != nil {
+			w.onTimeout()
+		}
+	})
+	n, err := w.w.Write(p)
+	timer.Stop()
+	if w.timedOut.Load() {
+		return n, fmt.Errorf("%w after %s", errReplyWriteTimeout, w.timeout)
+	}
+	return n, err
+}
+
+// replyWriter wraps a session channel so a stuck reply write drops the
+// session and is counted in the server metrics.
+func (s *SSHServer) replyWriter(sess *Session, w io.Writer) io.Writer {
+	var timeout time.Duration
+	if s.config != nil {
+		timeout = s.config.ReplyWriteTimeout
+	}
+	return newReplyTimeoutWriter(w, timeout, func() {
+		atomic.AddUint64(&s.replyWriteTimeouts, 1)
+		if s.log != nil {
+			s.log.Warn("NETCONF client stopped reading replies, closing session",
+				"session", sess.ID, "user", sess.Username, "timeout", timeout)
+		}
+		// Closing the connection wakes a write blocked on the SSH window;
+		// closing only the channel does not.
+		if sess.conn != nil {
+			_ = sess.conn.Close()
+		}
+		if sess.channel != nil {
+			_ = sess.channel.Close()
+		}
+	})
+}
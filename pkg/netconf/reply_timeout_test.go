@@ -0,0 +1,121 @@
+package netconf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/logger"
+	"golang.org/x/crypto/ssh"
+)
+
+// stalledChannel is an ssh.Channel whose client never reads: writes and
+// reads block until the channel is closed.
+type stalledChannel struct {
+	ssh.Channel
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newStalledChannel() *stalledChannel {
+	return &stalledChannel{closed: make(chan struct{})}
+}
+
+func (c *stalledChannel) Write([]byte) (int, error) {
+	<-c.closed
+	return 0, io.EOF
+}
+
+func (c *stalledChannel) Read([]byte) (int, error) {
+	<-c.closed
+	return 0, io.EOF
+}
+
+func (c *stalledChannel) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+func TestHandleNETCONFClosesSessionWhenClientStopsReading(t *testing.T) {
+	cfg := DefaultSSHConfig()
+	cfg.ReplyWriteTimeout = 50 * time.Millisecond
+	log := logger.New("test", logger.DefaultConfig())
+	sessionMgr := NewSessionManager(cfg, nil, log)
+	server := &SSHServer{
+		config:        cfg,
+		sessionMgr:    sessionMgr,
+		netconfServer: NewServer(nil, sessionMgr),
+		log:           log,
+	}
+	channel := newStalledChannel()
+	sess := sessionMgr.Create("alice", RoleAdmin, nil, channel)
+
+	done := make(chan struct{})
+	go func() {
+		server.handleNETCONF(context.Background(), sess, channel)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handleNETCONF() still blocked on a client that stopped reading")
+	}
+
+	metrics := server.GetMetrics()
+	if metrics.ReplyWriteTimeouts != 1 {
+		t.Fatalf("ReplyWriteTimeouts = %d, want 1", metrics.ReplyWriteTimeouts)
+	}
+	if metrics.ActiveSessions != 0 {
+		t.Fatalf("ActiveSessions = %d, want the stalled session closed", metrics.ActiveSessions)
+	}
+}
+
+func TestReplyTimeoutWriterPassesThroughTimelyWrites(t *testing.T) {
+	var buf bytes.Buffer
+	timeouts := 0
+	w := newReplyTimeoutWriter(&buf, time.Second, func() { timeouts++ })
+	if n, err := w.Write([]byte("<hello/>")); err != nil || n != 8 {
+		t.Fatalf("Write() = %d, %v, want 8, nil", n, err)
+	}
+	if buf.String() != "<hello/>" || timeouts != 0 {
+		t.Fatalf("Write() wrote %q with %d timeouts", buf.String(), timeouts)
+	}
+
+	stalled := newStalledChannel()
+	w = newReplyTimeoutWriter(stalled, 10*time.Millisecond, func() { _ = stalled.Close() })
+	if _, err := w.Write([]byte("<rpc-reply/>")); !errors.Is(err, errReplyWriteTimeout) {
+		t.Fatalf("Write() on a stalled channel error = %v, want timeout", err)
+	}
+	if _, err := w.Write([]byte("<rpc-reply/>")); !errors.Is(err, errReplyWriteTimeout) {
+		t.Fatalf("Write() after timeout error = %v, want timeout", err)
+	}
+}
+
+// slowWriter accepts every write after a delay, like a client that reads
+// slowly but steadily.
+type slowWriter struct {
+	delay time.Duration
+	buf   bytes.Buffer
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(w.delay)
+	return w.buf.Write(p)
+}
+
+func TestReplyTimeoutWriterTimesEachChunk(t *testing.T) {
+	slow := &slowWriter{delay: 20 * time.Millisecond}
+	timeouts := 0
+	w := newReplyTimeoutWriter(slow, 50*time.Millisecond, func() { timeouts++ })
+	reply := bytes.Repeat([]byte("x"), 5*replyWriteChunkSize)
+	if n, err := w.Write(reply); err != nil || n != len(reply) {
+		t.Fatalf("Write() = %d, %v, want %d, nil", n, err, len(reply))
+	}
+	if timeouts != 0 || !bytes.Equal(slow.buf.Bytes(), reply) {
+		t.Fatalf("Write() wrote %d bytes with %d timeouts, want the whole reply and none", slow.buf.Len(), timeouts)
+	}
+}
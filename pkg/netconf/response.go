@@ -27,6 +27,9 @@ type RPCReply struct {
 type DataReply struct {
 	XMLName xml.Name `xml:"urn:ietf:params:xml:ns:netconf:base:1.0 data"`
 	Content []byte   `xml:",innerxml"`
+
+	// stream, when set, produces the content at write time instead of Content.
+	stream func(w io.Writer) error
 }
 
 // NewOKReply creates a successful <rpc-reply> with <ok/>
@@ -47,6 +50,23 @@ func NewDataReply(messageID string, data []byte) *RPCReply {
 	}
 }
 
+// NewStreamingDataReply creates a successful <rpc-reply> whose <data> content
+// is written by stream when the reply is sent. The content is trusted server
+// output and is not re-parsed, so stream must produce well-formed XML.
+func NewStreamingDataReply(messageID string, stream func(w io.Writer) error) *RPCReply {
+	return &RPCReply{
+		MessageID: messageID,
+		Data: &DataReply{
+			stream: stream,
+		},
+	}
+}
+
+// Streaming reports whether the reply's <data> content is produced on demand.
+func (r *RPCReply) Streaming() bool {
+	return r != nil && r.Data != nil && r.Data.stream != nil
+}
+
 // NewErrorReply creates an error <rpc-reply> with one <rpc-error>
 func NewErrorReply(messageID string, err *RPCError) *RPCReply {
 	return &RPCReply{
@@ -102,7 +122,8 @@ func isEmptyErrorInfo(info *ErrorInfo) bool {
 		info.BadElement == "" &&
 		info.BadAttribute == "" &&
 		info.BadNamespace == "" &&
-		info.LockOwnerSession == ""
+		info.LockOwnerSession == "" &&
+		info.Hint == ""
 }
 
 func validateRPCErrorFields(err *RPCError) error {
@@ -184,22 +205,22 @@ func MarshalReply(reply *RPCReply) ([]byte, error) {
 	}
 
 	var buf bytes.Buffer
-	buf.WriteString("<rpc-reply")
-	writeXMLAttribute(&buf, "xmlns", netconfNamespace)
-	if reply.MessageID != "" {
-		writeXMLAttribute(&buf, "message-id", reply.MessageID)
-	}
-	if err := writeReplyAttributes(&buf, reply.Attrs); err != nil {
+	if err := writeReplyStart(&buf, reply); err != nil {
 		return nil, err
 	}
-	buf.WriteByte('>')
 
 	if reply.OK != nil {
 		buf.WriteString("<ok/>")
 	}
 	if reply.Data != nil {
 		buf.WriteString("<data>")
-		buf.Write(reply.Data.Content)
+		if reply.Data.stream != nil {
+			if err := reply.Data.stream(&buf); err != nil {
+				return nil, err
+			}
+		} else {
+			buf.Write(reply.Data.Content)
+		}
 		buf.WriteString("</data>")
 	}
 	for _, rpcErr := range reply.Errors {
@@ -221,6 +242,51 @@ func MarshalReply(reply *RPCReply) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// WriteReply serializes reply to w. Streaming <data> content is written
+// straight through to w rather than assembled in memory first; other replies
+// are marshaled with MarshalReply.
+func WriteReply(w io.Writer, reply *RPCReply) error {
+	if !reply.Streaming() {
+		data, err := MarshalReply(reply)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	if err := validateReplyPayload(reply); err != nil {
+		return err
+	}
+
+	var head bytes.Buffer
+	if err := writeReplyStart(&head, reply); err != nil {
+		return err
+	}
+	head.WriteString("<data>")
+	if _, err := w.Write(head.Bytes()); err != nil {
+		return err
+	}
+	if err := reply.Data.stream(w); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "</data></rpc-reply>")
+	return err
+}
+
+// writeReplyStart writes the <rpc-reply> start tag with its attributes.
+func writeReplyStart(buf *bytes.Buffer, reply *RPCReply) error {
+	buf.WriteString("<rpc-reply")
+	writeXMLAttribute(buf, "xmlns", netconfNamespace)
+	if reply.MessageID != "" {
+		writeXMLAttribute(buf, "message-id", reply.MessageID)
+	}
+	if err := writeReplyAttributes(buf, reply.Attrs); err != nil {
+		return err
+	}
+	buf.WriteByte('>')
+	return nil
+}
+
 func validateReplyPayload(reply *RPCReply) error {
 	payloads := 0
 	if reply.OK != nil {
@@ -238,7 +304,7 @@ func validateReplyPayload(reply *RPCReply) error {
 	if payloads > 1 {
 		return fmt.Errorf("RPC reply has multiple payloads")
 	}
-	if reply.Data != nil {
+	if reply.Data != nil && reply.Data.stream == nil {
 		if err := validateDataReplyContent(reply.Data.Content); err != nil {
 			return err
 		}
@@ -69,7 +69,10 @@ func ParseRPC(data []byte) (*RPC, error) {
 		return nil, err
 	}
 
-	// Validate message-id presence
+	// Validate message-id presence; the reply must echo exactly one value.
+	if err := validateRPCMessageID(data); err != nil {
+		return nil, err
+	}
 	if envelope.MessageID == "" {
 		return nil, ErrMissingAttribute("rpc", "message-id")
 	}
@@ -216,6 +219,21 @@ func validateRPCRootAttributes(_ []xml.Attr) error {
 }
 
 func extractRPCReplyContext(data []byte) (string, []xml.Attr) {
+	root, ok := rpcRootElement(data)
+	if !ok {
+		return "", nil
+	}
+	messageIDs := rpcMessageIDs(root.Attr)
+	if len(messageIDs) != 1 {
+		// Without exactly one message-id there is nothing to echo safely.
+		return "", rpcReplyAttrsFromRootAttrs(root.Attr)
+	}
+	return messageIDs[0], rpcReplyAttrsFromRootAttrs(root.Attr)
+}
+
+// rpcRootElement returns the <rpc> start element of data, if data begins
+// with one.
+func rpcRootElement(data []byte) (xml.StartElement, bool) {
 	decoder := xml.NewDecoder(bytes.NewReader(data))
 	decoder.Strict = true
 	decoder.Entity = nil
@@ -223,32 +241,52 @@ func extractRPCReplyContext(data []byte) (string, []xml.Attr) {
 	for {
 		token, err := decoder.Token()
 		if err != nil {
-			return "", nil
+			return xml.StartElement{}, false
 		}
 
 		switch t := token.(type) {
 		case xml.StartElement:
 			if t.Name.Local != "rpc" {
-				return "", nil
+				return xml.StartElement{}, false
 			}
-
-			messageID := ""
-			for _, attr := range t.Attr {
-				if isMessageIDAttribute(attr) {
-					messageID = attr.Value
-					break
-				}
-			}
-			return messageID, rpcReplyAttrsFromRootAttrs(t.Attr)
+			return t, true
 		case xml.CharData:
 			if len(bytes.TrimSpace(t)) == 0 {
 				continue
 			}
-			return "", nil
+			return xml.StartElement{}, false
 		}
 	}
 }
 
+// validateRPCMessageID rejects an <rpc> carrying more than one message-id
+// attribute or an empty one. encoding/xml silently keeps the last duplicate,
+// which would make the reply's message-id ambiguous.
+func validateRPCMessageID(data []byte) *RPCError {
+	root, ok := rpcRootElement(data)
+	if !ok {
+		return nil
+	}
+	messageIDs := rpcMessageIDs(root.Attr)
+	switch {
+	case len(messageIDs) > 1:
+		return ErrBadAttribute("rpc", "message-id", "attribute appears more than once")
+	case len(messageIDs) == 1 && messageIDs[0] == "":
+		return ErrBadAttribute("rpc", "message-id", "value must not be empty")
+	}
+	return nil
+}
+
+func rpcMessageIDs(attrs []xml.Attr) []string {
+	var ids []string
+	for _, attr := range attrs {
+		if isMessageIDAttribute(attr) {
+			ids = append(ids, attr.Value)
+		}
+	}
+	return ids
+}
+
 func rpcReplyAttrsFromRootAttrs(attrs []xml.Attr) []xml.Attr {
 	if len(attrs) == 0 {
 		return nil
@@ -523,6 +561,7 @@ var rpcOperationElementPaths = map[string]map[string]struct{}{
 		"get-config/source/candidate": {},
 		"get-config/source/startup":   {},
 		"get-config/filter":           {},
+		"get-config/encoding":         {},
 	},
 	"edit-config": {
 		"edit-config":                   {},
@@ -575,6 +614,10 @@ var rpcOperationElementPaths = map[string]map[string]struct{}{
 		"commit/persist":         {},
 		"commit/persist-id":      {},
 	},
+	"cancel-commit": {
+		"cancel-commit":            {},
+		"cancel-commit/persist-id": {},
+	},
 	"discard-changes": {
 		"discard-changes": {},
 	},
@@ -603,6 +646,7 @@ var rpcOperationCardinalityRules = map[string][]rpcCardinalityRule{
 	"get-config": {
 		{path: "get-config/source", min: 1, max: 1},
 		{path: "get-config/filter", min: 0, max: 1},
+		{path: "get-config/encoding", min: 0, max: 1},
 	},
 	"edit-config": {
 		{path: "edit-config/target", min: 1, max: 1},
@@ -639,6 +683,9 @@ var rpcOperationCardinalityRules = map[string][]rpcCardinalityRule{
 		{path: "commit/persist", min: 0, max: 1},
 		{path: "commit/persist-id", min: 0, max: 1},
 	},
+	"cancel-commit": {
+		{path: "cancel-commit/persist-id", min: 0, max: 1},
+	},
 }
 
 var rpcDatastoreChoicePaths = map[string][]string{
@@ -688,12 +735,14 @@ func isRPCTextContentPath(path []string) bool {
 }
 
 var rpcTextContentPaths = map[string]struct{}{
+	"get-config/encoding":           {},
 	"edit-config/default-operation": {},
 	"edit-config/test-option":       {},
 	"edit-config/error-option":      {},
 	"commit/confirm-timeout":        {},
 	"commit/persist":                {},
 	"commit/persist-id":             {},
+	"cancel-commit/persist-id":      {},
 	"kill-session/session-id":       {},
 }
 
@@ -895,6 +944,12 @@ func (f *Filter) validateXPathFilter(rpcName string) error {
 		return nil
 	}
 	if xpathFilter != nil {
+		if len(xpathFilter.Segments) > MaxXMLDepth {
+			return NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue,
+				fmt.Sprintf("xpath filter exceeds maximum depth limit (%d)", MaxXMLDepth)).
+				WithPath(fmt.Sprintf("/rpc/%s/filter", rpcName)).
+				WithAppTag("depth-limit")
+		}
 		if err := validateXPathFilterNamespaces(xpathFilter); err != nil {
 			return ErrInvalidFilter(rpcName, fmt.Sprintf("invalid xpath filter namespace: %v", err))
 		}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/akam1o/arca-router/pkg/config"
 	dsstore "github.com/akam1o/arca-router/pkg/datastore"
@@ -19,8 +20,20 @@ type GetConfigRequest struct {
 	XMLName xml.Name `xml:"get-config"`
 	Source  Source   `xml:"source"`
 	Filter  *Filter  `xml:"filter"`
+	// Encoding selects the reply encoding: "xml" (the default) or "json".
+	Encoding string `xml:"encoding"`
 }
 
+// Reply encodings accepted in the get-config <encoding> element.
+const (
+	EncodingXML  = "xml"
+	EncodingJSON = "json"
+)
+
+// ArcaJSONNamespace is the namespace of the <config-json> element that
+// carries a JSON-encoded configuration inside <data>.
+const ArcaJSONNamespace = "urn:arca:router:netconf:json:1.0"
+
 func (r *GetConfigRequest) SetInheritedNamespaceAttrs(attrs []xml.Attr) {
 	if r == nil {
 		return
@@ -90,10 +103,16 @@ func (s *Server) handleGetConfig(ctx context.Context, sess *Session, rpc *RPC) *
 	}
 
 	// Validate filter depth and size limits
-	if err := ValidateFilterDepthAndSize("get-config", req.Filter); err != nil {
+	limits := s.messageSizeLimits()
+	if err := ValidateFilterDepthAndSizeWithLimit("get-config", req.Filter, limits.Filter); err != nil {
 		return NewErrorReply(rpc.MessageID, rpcErrorFromError(err))
 	}
 
+	encoding, rpcErr := s.getConfigEncoding(req.Encoding)
+	if rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+
 	// Get configuration text from datastore
 	var textCfg string
 	switch source {
@@ -131,14 +150,35 @@ func (s *Server) handleGetConfig(ctx context.Context, sess *Session, rpc *RPC) *
 
 	// Convert config to XML. Experimental XPath filters are evaluated after
 	// building the full response tree so XPath functions can inspect siblings.
+	// Credential material is never returned in cleartext.
 	outputFilter := req.Filter
 	if usesExperimentalXPathEngine(req.Filter) {
 		outputFilter = nil
 	}
-	xmlData, err := ConfigToXML(cfg, outputFilter)
+	redacted := config.RedactSecrets(cfg)
+
+	if encoding == EncodingJSON && req.Filter == nil {
+		return configJSONReply(rpc.MessageID, redacted, limits.ConfigReply)
+	}
+
+	// Without content filtering the reply is the serializer output verbatim,
+	// so large documents are measured (without buffering) and then streamed
+	// to the session instead of being held in memory.
+	if encoding == EncodingXML && !usesExperimentalXPathEngine(req.Filter) && !usesSubtreeContentFilter(req.Filter) {
+		size, err := MeasureConfigXMLWithLimit(redacted, outputFilter, limits.ConfigReply)
+		if err != nil {
+			return configSerializationErrorReply(rpc.MessageID, err)
+		}
+		if size > getConfigStreamThreshold {
+			return NewStreamingDataReply(rpc.MessageID, func(w io.Writer) error {
+				return WriteConfigXMLWithLimit(w, redacted, outputFilter, limits.ConfigReply)
+			})
+		}
+	}
+
+	xmlData, err := ConfigToXMLWithLimit(redacted, outputFilter, limits.ConfigReply)
 	if err != nil {
-		log.Printf("[NETCONF] Config to XML conversion error: %v", err)
-		return NewErrorReply(rpc.MessageID, ErrOperationFailed(fmt.Sprintf("config serialization failed: %v", err)))
+		return configSerializationErrorReply(rpc.MessageID, err)
 	}
 	if usesExperimentalXPathEngine(req.Filter) {
 		xmlData, err = applyExperimentalXPathFilter("get-config", xmlData, req.Filter)
@@ -150,10 +190,97 @@ func (s *Server) handleGetConfig(ctx context.Context, sess *Session, rpc *RPC) *
 			return NewErrorReply(rpc.MessageID, ErrOperationFailed(fmt.Sprintf("xpath filter failed: %v", err)))
 		}
 	}
+	if usesSubtreeContentFilter(req.Filter) {
+		xmlData, err = applySubtreeContentFilter("get-config", xmlData, req.Filter)
+		if err != nil {
+			log.Printf("[NETCONF] Subtree filter error: %v", err)
+			if rpcErr, ok := err.(*RPCError); ok {
+				return NewErrorReply(rpc.MessageID, rpcErr)
+			}
+			return NewErrorReply(rpc.MessageID, ErrOperationFailed(fmt.Sprintf("subtree filter failed: %v", err)))
+		}
+	}
 
+	if encoding == EncodingJSON {
+		// Filters select XML elements, so the filtered document is read back
+		// into a configuration before it is encoded as JSON.
+		filtered, err := XMLToConfigWithLimit(xmlData, DefaultOpMerge, limits.ConfigReply)
+		if err != nil {
+			return configSerializationErrorReply(rpc.MessageID, err)
+		}
+		return configJSONReply(rpc.MessageID, filtered, limits.ConfigReply)
+	}
+
+	// Filtered replies are built in memory. Ones too close to MaxXMLSize to
+	// be marshaled as a buffered reply are streamed, so a ConfigReply limit
+	// above MaxXMLSize also applies to them.
+	if len(xmlData) > MaxXMLSize-getConfigStreamThreshold {
+		return NewStreamingDataReply(rpc.MessageID, func(w io.Writer) error {
+			_, err := w.Write(xmlData)
+			return err
+		})
+	}
 	return NewDataReply(rpc.MessageID, xmlData)
 }
 
+// getConfigEncoding resolves the requested get-config reply encoding.
+func (s *Server) getConfigEncoding(value string) (string, *RPCError) {
+	switch encoding := strings.TrimSpace(value); encoding {
+	case "", EncodingXML:
+		return EncodingXML, nil
+	case EncodingJSON:
+		if s.jsonEncodingDisabled {
+			return "", NewRPCError(ErrorTypeApplication, ErrorTagOperationNotSupported, "JSON encoding is disabled on this server").
+				WithPath("/rpc/get-config/encoding")
+		}
+		return EncodingJSON, nil
+	default:
+		return "", NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue, fmt.Sprintf("unsupported encoding %q: expected xml or json", encoding)).
+			WithPath("/rpc/get-config/encoding")
+	}
+}
+
+// configJSONReply wraps the JSON encoding of cfg in a <config-json> element.
+// The reply is subject to the same ConfigReply limit as XML replies, and ones
+// too close to MaxXMLSize to be marshaled as a buffered reply are streamed.
+func configJSONReply(messageID string, cfg *config.Config, limit int) *RPCReply {
+	jsonData, err := config.MarshalConfigJSON(cfg)
+	if err != nil {
+		return configSerializationErrorReply(messageID, err)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`<config-json xmlns="` + ArcaJSONNamespace + `">`)
+	if err := xml.EscapeText(&buf, jsonData); err != nil {
+		return configSerializationErrorReply(messageID, err)
+	}
+	buf.WriteString(`</config-json>`)
+	if buf.Len() > limit {
+		return NewErrorReply(messageID, NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue,
+			fmt.Sprintf("generated JSON configuration exceeds size limit (%d bytes)", limit)).
+			WithPath("/rpc/get-config").
+			WithAppTag("size-limit"))
+	}
+	if buf.Len() > MaxXMLSize-getConfigStreamThreshold {
+		return NewStreamingDataReply(messageID, func(w io.Writer) error {
+			_, err := buf.WriteTo(w)
+			return err
+		})
+	}
+	return NewDataReply(messageID, buf.Bytes())
+}
+
+// getConfigStreamThreshold is the <data> size above which unfiltered
+// get-config replies are streamed rather than buffered and re-validated.
+const getConfigStreamThreshold = 256 * 1024
+
+func configSerializationErrorReply(messageID string, err error) *RPCReply {
+	log.Printf("[NETCONF] Config to XML conversion error: %v", err)
+	if rpcErr, ok := err.(*RPCError); ok {
+		return NewErrorReply(messageID, rpcErr)
+	}
+	return NewErrorReply(messageID, ErrOperationFailed(fmt.Sprintf("config serialization failed: %v", err)))
+}
+
 // EditConfigRequest represents <edit-config> RPC
 type EditConfigRequest struct {
 	XMLName          xml.Name          `xml:"edit-config"`
@@ -421,7 +548,7 @@ func (s *Server) handleEditConfig(ctx context.Context, sess *Session, rpc *RPC)
 	if err != nil {
 		return NewErrorReply(rpc.MessageID, rpcErrorFromError(err))
 	}
-	newCfg, err := XMLToConfig(configXML, defaultOp)
+	newCfg, inactiveOps, err := xmlToConfigEdit(configXML, defaultOp, s.messageSizeLimits().ConfigInput)
 	if err != nil {
 		log.Printf("[NETCONF] XML to config conversion error: %v", err)
 		if rpcErr, ok := err.(*RPCError); ok {
@@ -451,6 +578,9 @@ func (s *Server) handleEditConfig(ctx context.Context, sess *Session, rpc *RPC)
 
 	// Apply edit based on default-operation
 	mergedCfg, err := ApplyConfigEdit(existingCfg, newCfg, defaultOp)
+	if err == nil {
+		err = applyInactiveEdit(existingCfg, mergedCfg, inactiveOps)
+	}
 	if err != nil {
 		log.Printf("[NETCONF] Config merge error: %v", err)
 		if rpcErr, ok := err.(*RPCError); ok {
@@ -459,6 +589,9 @@ func (s *Server) handleEditConfig(ctx context.Context, sess *Session, rpc *RPC)
 		return NewErrorReply(rpc.MessageID, ErrOperationFailed(fmt.Sprintf("config merge failed: %v", err)))
 	}
 
+	if rpcErr := checkUserManagement(sess, "edit-config", existingCfg, mergedCfg); rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
 	if rpcErr := validateConfigSemantics("edit-config", mergedCfg); rpcErr != nil {
 		log.Printf("[NETCONF] Config validation error: %v", rpcErr)
 		return NewErrorReply(rpc.MessageID, rpcErr)
@@ -479,10 +612,31 @@ func (s *Server) handleEditConfig(ctx context.Context, sess *Session, rpc *RPC)
 		log.Printf("[NETCONF] Failed to save candidate: %v", err)
 		return NewErrorReply(rpc.MessageID, ErrDatastoreError("failed to save candidate"))
 	}
+	s.auditConfigEdit(ctx, sess, string(defaultOp), existingTextCfg, mergedTextCfg)
 
 	return NewOKReply(rpc.MessageID)
 }
 
+// auditConfigEdit records a candidate edit in the audit log. Audit failures
+// are logged and do not fail the edit.
+func (s *Server) auditConfigEdit(ctx context.Context, sess *Session, operation, oldText, newText string) {
+	if oldText == newText {
+		return
+	}
+	event := &dsstore.AuditEvent{
+		Timestamp: time.Now(),
+		User:      sess.Username,
+		SessionID: sess.ID,
+		SourceIP:  sess.RemoteAddr(),
+		Action:    "edit_config",
+		Result:    "success",
+		Details:   fmt.Sprintf("target=candidate operation=%s changes=%q", operation, dsstore.AuditChanges(oldText, newText)),
+	}
+	if err := s.datastore.LogAuditEvent(ctx, event); err != nil {
+		log.Printf("[NETCONF] Failed to record edit-config audit event for session %s: %v", sess.ID, err)
+	}
+}
+
 // CopyConfigRequest represents <copy-config> RPC
 type CopyConfigRequest struct {
 	XMLName xml.Name `xml:"copy-config"`
@@ -536,7 +690,7 @@ func (s *Server) handleCopyConfig(ctx context.Context, sess *Session, rpc *RPC)
 		if err != nil {
 			return NewErrorReply(rpc.MessageID, rpcErrorFromError(err))
 		}
-		srcCfg, err = XMLToConfig(configXML, DefaultOpMerge)
+		srcCfg, err = XMLToConfigWithLimit(configXML, DefaultOpMerge, s.messageSizeLimits().ConfigInput)
 		if err != nil {
 			log.Printf("[NETCONF] CopyConfig inline source parse error: %v", err)
 			if rpcErr, ok := err.(*RPCError); ok {
@@ -590,6 +744,26 @@ func (s *Server) handleCopyConfig(ctx context.Context, sess *Session, rpc *RPC)
 		log.Printf("[NETCONF] CopyConfig source validation error: %v", rpcErr)
 		return NewErrorReply(rpc.MessageID, rpcErr)
 	}
+	if sess.Role != RoleAdmin {
+		targetText, rpcErr := s.readCandidateOrRunningConfigText(
+			ctx,
+			sess.ID,
+			"failed to read target candidate",
+			"failed to read running config for candidate target fallback",
+		)
+		if rpcErr != nil {
+			log.Printf("[NETCONF] CopyConfig target read error: %v", rpcErr)
+			return NewErrorReply(rpc.MessageID, rpcErr)
+		}
+		targetCfg, err := TextToConfig(targetText)
+		if err != nil {
+			log.Printf("[NETCONF] CopyConfig target parse error: %v", err)
+			return NewErrorReply(rpc.MessageID, ErrDatastoreError("failed to parse target candidate"))
+		}
+		if rpcErr := checkUserManagement(sess, "copy-config", targetCfg, srcCfg); rpcErr != nil {
+			return NewErrorReply(rpc.MessageID, rpcErr)
+		}
+	}
 
 	// Save to candidate
 	if err := s.datastore.SaveCandidate(ctx, sess.ID, srcTextCfg); err != nil {
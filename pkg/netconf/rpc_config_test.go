@@ -1,11 +1,15 @@
 package netconf
 
 import (
+	"bytes"
 	"context"
+	"encoding/xml"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/akam1o/arca-router/pkg/config"
 	"github.com/akam1o/arca-router/pkg/datastore"
 )
 
@@ -17,6 +21,12 @@ type copyConfigDatastore struct {
 	saveCalled bool
 	savedText  string
 	savedID    string
+	audit      []*datastore.AuditEvent
+}
+
+func (d *copyConfigDatastore) LogAuditEvent(_ context.Context, event *datastore.AuditEvent) error {
+	d.audit = append(d.audit, event)
+	return nil
 }
 
 func (d *copyConfigDatastore) GetRunning(context.Context) (*datastore.RunningConfig, error) {
@@ -76,8 +86,8 @@ func TestEditConfigTestOnlyReturnsSemanticError(t *testing.T) {
 	if err.ErrorTag != ErrorTagInvalidValue {
 		t.Fatalf("edit-config test-only error tag = %s, want %s", err.ErrorTag, ErrorTagInvalidValue)
 	}
-	if err.ErrorPath != "/rpc/edit-config/config" {
-		t.Fatalf("edit-config test-only error path = %q, want /rpc/edit-config/config", err.ErrorPath)
+	if err.ErrorPath != "/arca:system" {
+		t.Fatalf("edit-config test-only error path = %q, want /arca:system", err.ErrorPath)
 	}
 	if ds.saveCalled {
 		t.Fatal("edit-config test-only saved invalid candidate")
@@ -106,6 +116,16 @@ func TestEditConfigTestThenSetSavesCandidate(t *testing.T) {
 	if ds.savedText != "set system host-name router1\n" {
 		t.Fatalf("saved candidate = %q, want test-then-set edit", ds.savedText)
 	}
+	if len(ds.audit) != 1 {
+		t.Fatalf("audit events = %d, want 1", len(ds.audit))
+	}
+	event := ds.audit[0]
+	if event.Action != "edit_config" || event.User != "alice" || event.SessionID != "session-1" {
+		t.Fatalf("audit event = %+v, want alice's edit-config", event)
+	}
+	if want := "+ set system host-name router1; - set system host-name old-router"; !strings.Contains(event.Details, want) {
+		t.Fatalf("audit details = %q, want %q", event.Details, want)
+	}
 }
 
 func TestEditConfigTrimsOperationOptions(t *testing.T) {
@@ -246,8 +266,8 @@ func TestEditConfigRollbackOnErrorDoesNotSaveInvalidCandidate(t *testing.T) {
 	if err.ErrorTag != ErrorTagInvalidValue {
 		t.Fatalf("edit-config rollback-on-error error tag = %s, want %s", err.ErrorTag, ErrorTagInvalidValue)
 	}
-	if err.ErrorPath != "/rpc/edit-config/config" {
-		t.Fatalf("edit-config rollback-on-error error path = %q, want /rpc/edit-config/config", err.ErrorPath)
+	if err.ErrorPath != "/arca:system" {
+		t.Fatalf("edit-config rollback-on-error error path = %q, want /arca:system", err.ErrorPath)
 	}
 	if ds.saveCalled {
 		t.Fatal("edit-config rollback-on-error saved invalid candidate")
@@ -342,6 +362,342 @@ func TestGetConfigCandidateFallsBackToRunningWhenMissing(t *testing.T) {
 	}
 }
 
+func TestGetConfigStreamsLargeReply(t *testing.T) {
+	var text strings.Builder
+	for i := 0; i < 4000; i++ {
+		fmt.Fprintf(&text, "set interfaces ge-0/%d/%d description streamed-interface-%d\n", i/1000, i%1000, i)
+	}
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: text.String()},
+	}
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+		</get-config>
+	</rpc>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get-config errors = %#v, want none", reply.Errors)
+	}
+	if !reply.Streaming() {
+		t.Fatal("get-config large reply is buffered, want streaming reply")
+	}
+	if len(reply.Data.Content) != 0 {
+		t.Fatalf("get-config streaming reply buffered %d bytes of content", len(reply.Data.Content))
+	}
+
+	var streamed bytes.Buffer
+	if err := WriteReply(&streamed, reply); err != nil {
+		t.Fatalf("WriteReply() error = %v", err)
+	}
+	marshaled, err := MarshalReply(reply)
+	if err != nil {
+		t.Fatalf("MarshalReply() error = %v", err)
+	}
+	if !bytes.Equal(streamed.Bytes(), marshaled) {
+		t.Fatal("WriteReply() output differs from MarshalReply()")
+	}
+	if !strings.HasPrefix(streamed.String(), `<rpc-reply xmlns="urn:ietf:params:xml:ns:netconf:base:1.0" message-id="101"><data>`) ||
+		!strings.HasSuffix(streamed.String(), "</data></rpc-reply>") ||
+		!strings.Contains(streamed.String(), "<description>streamed-interface-3999</description>") {
+		t.Fatalf("WriteReply() produced unexpected envelope or content")
+	}
+}
+
+func TestGetConfigRedactsSNMPCommunity(t *testing.T) {
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: "set system services snmp enabled true\nset system services snmp community private-community\n"},
+	}
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+		</get-config>
+	</rpc>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get-config errors = %#v, want none", reply.Errors)
+	}
+	content := string(reply.Data.Content)
+	if strings.Contains(content, "private-community") {
+		t.Fatalf("get-config leaked SNMP community:\n%s", content)
+	}
+	if !strings.Contains(content, "<community>&lt;redacted&gt;</community>") {
+		t.Fatalf("get-config data = %s, want redacted community", content)
+	}
+}
+
+func TestGetConfigJSONEncodingRoundTrip(t *testing.T) {
+	text := strings.Join([]string{
+		"set system host-name router1",
+		`set interfaces ge-0/0/0 description "uplink"`,
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		`set interfaces xe-0/0/0 description "peer"`,
+		"set routing-options router-id 192.0.2.1",
+		"",
+	}, "\n")
+	ds := &copyConfigDatastore{running: &datastore.RunningConfig{ConfigText: text}}
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+			<encoding>json</encoding>
+		</get-config>
+	</rpc>`)
+	got := decodeConfigJSONReply(t, reply)
+	want, err := TextToConfig(text)
+	if err != nil {
+		t.Fatalf("TextToConfig() error = %v", err)
+	}
+	if config.ToSetCommands(got) != config.ToSetCommands(want) {
+		t.Fatalf("JSON round trip:\n%s\nwant:\n%s", config.ToSetCommands(got), config.ToSetCommands(want))
+	}
+
+	filtered := copyConfigParsedRPC(t, ds, `<rpc message-id="102" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+			<filter type="xpath" xmlns:if="urn:ietf:params:xml:ns:yang:ietf-interfaces" select="/if:interfaces/if:interface[if:name='ge-0/0/0']"/>
+			<encoding>json</encoding>
+		</get-config>
+	</rpc>`)
+	gotFiltered := decodeConfigJSONReply(t, filtered)
+	if len(gotFiltered.Interfaces) != 1 || gotFiltered.Interfaces["ge-0/0/0"] == nil || gotFiltered.System != nil {
+		t.Fatalf("filtered JSON config = %#v, want only ge-0/0/0", gotFiltered)
+	}
+	if addrs := gotFiltered.Interfaces["ge-0/0/0"].Units[0].Family["inet"].Addresses; len(addrs) != 1 || addrs[0] != "192.0.2.1/24" {
+		t.Fatalf("filtered ge-0/0/0 addresses = %v, want 192.0.2.1/24", addrs)
+	}
+}
+
+func TestGetConfigRejectsUnsupportedEncoding(t *testing.T) {
+	ds := &copyConfigDatastore{running: &datastore.RunningConfig{ConfigText: "set system host-name router1\n"}}
+	request := `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+			<encoding>%s</encoding>
+		</get-config>
+	</rpc>`
+
+	reply := copyConfigParsedRPC(t, ds, fmt.Sprintf(request, "yaml"))
+	if len(reply.Errors) != 1 || reply.Errors[0].ErrorTag != ErrorTagInvalidValue {
+		t.Fatalf("get-config yaml errors = %#v, want invalid-value", reply.Errors)
+	}
+
+	srv := NewServer(ds, nil)
+	srv.jsonEncodingDisabled = true
+	rpc, err := ParseRPC([]byte(fmt.Sprintf(request, "json")))
+	if err != nil {
+		t.Fatalf("ParseRPC() error = %v", err)
+	}
+	reply = srv.HandleRPC(context.Background(), &Session{ID: "session-1", Role: RoleOperator, datastoreLocks: map[string]struct{}{}}, rpc)
+	if len(reply.Errors) != 1 || reply.Errors[0].ErrorTag != ErrorTagOperationNotSupported {
+		t.Fatalf("get-config json with encoding disabled errors = %#v, want operation-not-supported", reply.Errors)
+	}
+}
+
+func decodeConfigJSONReply(t *testing.T, reply *RPCReply) *config.Config {
+	t.Helper()
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get-config json errors = %#v, want none", reply.Errors)
+	}
+	var wrapper struct {
+		XMLName xml.Name
+		JSON    string `xml:",chardata"`
+	}
+	if err := xml.Unmarshal(reply.Data.Content, &wrapper); err != nil {
+		t.Fatalf("xml.Unmarshal(%s) error = %v", reply.Data.Content, err)
+	}
+	if wrapper.XMLName.Space != ArcaJSONNamespace || wrapper.XMLName.Local != "config-json" {
+		t.Fatalf("get-config json element = %v, want config-json in %s", wrapper.XMLName, ArcaJSONNamespace)
+	}
+	cfg, err := config.UnmarshalConfigJSON([]byte(wrapper.JSON))
+	if err != nil {
+		t.Fatalf("UnmarshalConfigJSON() error = %v\n%s", err, wrapper.JSON)
+	}
+	return cfg
+}
+
+func TestGetConfigXPathPredicateSelectsSingleInterface(t *testing.T) {
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: strings.Join([]string{
+			`set interfaces ge-0/0/0 description "uplink"`,
+			`set interfaces xe-0/0/0 description "peer"`,
+			"set protocols bgp group EXT neighbor 192.0.2.2 peer-as 65001",
+			"",
+		}, "\n")},
+	}
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+			<filter type="xpath" xmlns:if="urn:ietf:params:xml:ns:yang:ietf-interfaces" select="/if:interfaces/if:interface[if:name='ge-0/0/0']"/>
+		</get-config>
+	</rpc>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get-config xpath errors = %#v, want none", reply.Errors)
+	}
+	gotXML := string(reply.Data.Content)
+	if !strings.Contains(gotXML, "<name>ge-0/0/0</name>") || !strings.Contains(gotXML, "uplink") {
+		t.Fatalf("get-config xpath missing selected interface:\n%s", gotXML)
+	}
+	for _, unexpected := range []string{"xe-0/0/0", "peer", "<bgp>"} {
+		if strings.Contains(gotXML, unexpected) {
+			t.Fatalf("get-config xpath included %q:\n%s", unexpected, gotXML)
+		}
+	}
+}
+
+func TestGetConfigXPathPredicateSelectsSingleBGPGroup(t *testing.T) {
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: strings.Join([]string{
+			`set interfaces ge-0/0/0 description "uplink"`,
+			"set protocols bgp group EXT type external",
+			"set protocols bgp group EXT neighbor 192.0.2.2 peer-as 65001",
+			"set protocols bgp group INT type internal",
+			"set protocols bgp group INT neighbor 198.51.100.2 peer-as 65000",
+			"set protocols ospf area 0.0.0.0 interface ge-0/0/0",
+			"",
+		}, "\n")},
+	}
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+			<filter type="xpath" xmlns:arca="`+ArcaConfigNS+`" select="/arca:protocols/arca:bgp/arca:group[arca:name='EXT']"/>
+		</get-config>
+	</rpc>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get-config xpath errors = %#v, want none", reply.Errors)
+	}
+	gotXML := string(reply.Data.Content)
+	for _, want := range []string{"<name>EXT</name>", "192.0.2.2"} {
+		if !strings.Contains(gotXML, want) {
+			t.Fatalf("get-config xpath missing %q:\n%s", want, gotXML)
+		}
+	}
+	for _, unexpected := range []string{"INT", "198.51.100.2", "<ospf>", "ge-0/0/0"} {
+		if strings.Contains(gotXML, unexpected) {
+			t.Fatalf("get-config xpath included %q:\n%s", unexpected, gotXML)
+		}
+	}
+}
+
+func TestGetConfigSubtreeFilterMixesContentMatchAndSelection(t *testing.T) {
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: strings.Join([]string{
+			`set interfaces ge-0/0/0 description "uplink"`,
+			"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+			`set interfaces xe-0/0/0 description "peer"`,
+			"set protocols bgp group EXT neighbor 192.0.2.2 peer-as 65001",
+			"",
+		}, "\n")},
+	}
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+			<filter type="subtree">
+				<interfaces xmlns="urn:ietf:params:xml:ns:yang:ietf-interfaces">
+					<interface>
+						<name>ge-0/0/0</name>
+						<description/>
+					</interface>
+				</interfaces>
+			</filter>
+		</get-config>
+	</rpc>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get-config subtree errors = %#v, want none", reply.Errors)
+	}
+	gotXML := string(reply.Data.Content)
+	if !strings.Contains(gotXML, "<name>ge-0/0/0</name>") || !strings.Contains(gotXML, "<description>uplink</description>") {
+		t.Fatalf("get-config subtree missing selected nodes:\n%s", gotXML)
+	}
+	for _, unexpected := range []string{"xe-0/0/0", "peer", "<unit>", "192.0.2.1/24", "<bgp>"} {
+		if strings.Contains(gotXML, unexpected) {
+			t.Fatalf("get-config subtree included %q:\n%s", unexpected, gotXML)
+		}
+	}
+}
+
+func TestGetConfigSubtreeFilterContentMatchSelectsWholeEntry(t *testing.T) {
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: strings.Join([]string{
+			`set interfaces ge-0/0/0 description "uplink"`,
+			"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+			`set interfaces xe-0/0/0 description "peer"`,
+			"",
+		}, "\n")},
+	}
+
+	tests := []struct {
+		name       string
+		filterName string
+		want       []string
+		unexpected []string
+	}{
+		{
+			name:       "matching entry",
+			filterName: "ge-0/0/0",
+			want:       []string{"<name>ge-0/0/0</name>", "uplink", "192.0.2.1/24"},
+			unexpected: []string{"xe-0/0/0", "peer"},
+		},
+		{
+			name:       "no matching entry",
+			filterName: "ge-9/9/9",
+			unexpected: []string{"<interfaces", "ge-0/0/0", "xe-0/0/0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+				<get-config>
+					<source><running/></source>
+					<filter type="subtree">
+						<if:interfaces xmlns:if="urn:ietf:params:xml:ns:yang:ietf-interfaces">
+							<if:interface><if:name>`+tt.filterName+`</if:name></if:interface>
+						</if:interfaces>
+					</filter>
+				</get-config>
+			</rpc>`)
+			if len(reply.Errors) != 0 {
+				t.Fatalf("get-config subtree errors = %#v, want none", reply.Errors)
+			}
+			gotXML := string(reply.Data.Content)
+			for _, want := range tt.want {
+				if !strings.Contains(gotXML, want) {
+					t.Fatalf("get-config subtree missing %q:\n%s", want, gotXML)
+				}
+			}
+			for _, unexpected := range tt.unexpected {
+				if strings.Contains(gotXML, unexpected) {
+					t.Fatalf("get-config subtree included %q:\n%s", unexpected, gotXML)
+				}
+			}
+		})
+	}
+}
+
+func TestGetConfigXPathFilterRejectsExcessiveDepth(t *testing.T) {
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: "set system host-name router1\n"},
+	}
+	selectPath := strings.Repeat("/arca:system", MaxXMLDepth+1)
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+			<filter type="xpath" xmlns:arca="`+ArcaConfigNS+`" select="`+selectPath+`"/>
+		</get-config>
+	</rpc>`)
+	if len(reply.Errors) != 1 {
+		t.Fatalf("get-config deep xpath errors = %d, want 1", len(reply.Errors))
+	}
+	if !strings.Contains(reply.Errors[0].ErrorMessage, "depth") {
+		t.Fatalf("get-config deep xpath error = %q, want depth limit", reply.Errors[0].ErrorMessage)
+	}
+}
+
 func TestGetConfigExperimentalXPathFilterSupportsFunctions(t *testing.T) {
 	ds := &copyConfigDatastore{
 		running: &datastore.RunningConfig{ConfigText: strings.Join([]string{
@@ -555,8 +911,8 @@ func TestCopyConfigValidatesRunningSourceBeforeSavingCandidate(t *testing.T) {
 	if err.ErrorTag != ErrorTagInvalidValue {
 		t.Fatalf("copy-config error tag = %s, want %s", err.ErrorTag, ErrorTagInvalidValue)
 	}
-	if err.ErrorPath != "/rpc/copy-config/source" {
-		t.Fatalf("copy-config error path = %q, want /rpc/copy-config/source", err.ErrorPath)
+	if err.ErrorPath != "/arca:system" {
+		t.Fatalf("copy-config error path = %q, want /arca:system", err.ErrorPath)
 	}
 	if ds.saveCalled {
 		t.Fatal("copy-config saved invalid source config")
@@ -639,6 +995,240 @@ func TestCopyConfigSavesInlineConfigSource(t *testing.T) {
 	}
 }
 
+func TestGetConfigShowsInactiveSubtrees(t *testing.T) {
+	ds := &copyConfigDatastore{
+		running: &datastore.RunningConfig{ConfigText: strings.Join([]string{
+			"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+			"deactivate interfaces ge-0/0/0",
+			"",
+		}, "\n")},
+	}
+
+	reply := copyConfigParsedRPC(t, ds, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get-config>
+			<source><running/></source>
+		</get-config>
+	</rpc>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get-config errors = %#v, want none", reply.Errors)
+	}
+	if reply.Data == nil || !strings.Contains(string(reply.Data.Content), "<path>interfaces ge-0/0/0</path>") {
+		t.Fatalf("get-config data = %v, want inactive interface path", reply.Data)
+	}
+}
+
+func TestEditConfigDefaultOperationReplaceKeepsInactiveSubtrees(t *testing.T) {
+	ds := &copyConfigDatastore{
+		candidate: &datastore.CandidateConfig{ConfigText: strings.Join([]string{
+			"set system host-name old-router",
+			"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+			"set routing-options autonomous-system 65000",
+			"set protocols bgp group EXT neighbor 192.0.2.2 peer-as 65001",
+			"deactivate interfaces ge-0/0/0",
+			"deactivate protocols bgp group EXT",
+			"",
+		}, "\n")},
+		lockInfo: &datastore.LockInfo{
+			IsLocked:  true,
+			SessionID: "session-1",
+		},
+	}
+
+	reply := editConfigRPCWithDefaultOperation(t, ds, "replace", `<config><system><host-name>router1</host-name></system><inactive xmlns="urn:arca:router:config:1.0"><path>system</path></inactive></config>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("edit-config replace errors = %#v, want none", reply.Errors)
+	}
+	for _, want := range []string{
+		"set system host-name router1",
+		"deactivate protocols bgp group EXT",
+		"deactivate system",
+	} {
+		if !strings.Contains(ds.savedText, want) {
+			t.Fatalf("saved candidate missing %q:\n%s", want, ds.savedText)
+		}
+	}
+	// The replace deleted the interfaces subtree, so its marker must go too.
+	if strings.Contains(ds.savedText, "deactivate interfaces ge-0/0/0") {
+		t.Fatalf("saved candidate kept a marker for a deleted subtree:\n%s", ds.savedText)
+	}
+}
+
+func TestEditConfigInactiveOperations(t *testing.T) {
+	base := strings.Join([]string{
+		"set system host-name router1",
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/1 unit 0 family inet address 198.51.100.1/24",
+		"deactivate interfaces ge-0/0/0",
+		"deactivate interfaces ge-0/0/1",
+		"",
+	}, "\n")
+	inactive := func(attrs, body string) string {
+		return `<config><inactive xmlns="urn:arca:router:config:1.0"` + attrs + `>` + body + `</inactive></config>`
+	}
+
+	tests := []struct {
+		name      string
+		defaultOp string
+		config    string
+		wantTag   ErrorTag
+		want      []string
+		notWant   []string
+	}{
+		{
+			name:    "path delete reactivates subtree",
+			config:  inactive("", `<path xmlns:nc="urn:ietf:params:xml:ns:netconf:base:1.0" nc:operation="delete">interfaces ge-0/0/0</path>`),
+			want:    []string{"deactivate interfaces ge-0/0/1"},
+			notWant: []string{"deactivate interfaces ge-0/0/0"},
+		},
+		{
+			name:    "path delete of missing marker",
+			config:  inactive("", `<path operation="delete">system</path>`),
+			wantTag: ErrorTagDataMissing,
+		},
+		{
+			name:    "path remove of missing marker",
+			config:  inactive("", `<path operation="remove">system</path>`),
+			want:    []string{"deactivate interfaces ge-0/0/0", "deactivate interfaces ge-0/0/1"},
+			notWant: []string{"deactivate system"},
+		},
+		{
+			name:    "path create of existing marker",
+			config:  inactive("", `<path operation="create">interfaces ge-0/0/1</path>`),
+			wantTag: ErrorTagDataExists,
+		},
+		{
+			name:      "path operation applies with default-operation none",
+			defaultOp: "none",
+			config:    inactive("", `<path operation="remove">interfaces ge-0/0/1</path><path>system</path>`),
+			want:      []string{"deactivate interfaces ge-0/0/0"},
+			notWant:   []string{"deactivate interfaces ge-0/0/1", "deactivate system"},
+		},
+		{
+			name:    "container replace",
+			config:  inactive(` operation="replace"`, `<path>system</path>`),
+			want:    []string{"deactivate system"},
+			notWant: []string{"deactivate interfaces"},
+		},
+		{
+			name:    "container delete clears all markers",
+			config:  inactive(` operation="delete"`, ""),
+			notWant: []string{"deactivate"},
+		},
+		{
+			name:    "container create with existing markers",
+			config:  inactive(` operation="create"`, `<path>system</path>`),
+			wantTag: ErrorTagDataExists,
+		},
+		{
+			name:    "nested operation under container operation",
+			config:  inactive(` operation="replace"`, `<path operation="delete">system</path>`),
+			wantTag: ErrorTagBadAttribute,
+		},
+		{
+			name:    "unsupported operation value",
+			config:  inactive("", `<path operation="purge">system</path>`),
+			wantTag: ErrorTagBadAttribute,
+		},
+		{
+			name:    "operation outside inactive",
+			config:  `<config><system operation="delete"/></config>`,
+			wantTag: ErrorTagOperationNotSupported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &copyConfigDatastore{
+				candidate: &datastore.CandidateConfig{ConfigText: base},
+				lockInfo:  &datastore.LockInfo{IsLocked: true, SessionID: "session-1"},
+			}
+			reply := editConfigRPCWithDefaultOperation(t, ds, tt.defaultOp, tt.config)
+			if tt.wantTag != "" {
+				if len(reply.Errors) != 1 || reply.Errors[0].ErrorTag != tt.wantTag {
+					t.Fatalf("edit-config errors = %#v, want %s", reply.Errors, tt.wantTag)
+				}
+				return
+			}
+			if len(reply.Errors) != 0 {
+				t.Fatalf("edit-config errors = %#v, want none", reply.Errors)
+			}
+			if !strings.Contains(ds.savedText, "set interfaces ge-0/0/0") {
+				t.Fatalf("saved candidate lost the configuration:\n%s", ds.savedText)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(ds.savedText, want) {
+					t.Fatalf("saved candidate missing %q:\n%s", want, ds.savedText)
+				}
+			}
+			for _, unwanted := range tt.notWant {
+				if strings.Contains(ds.savedText, unwanted) {
+					t.Fatalf("saved candidate contains %q:\n%s", unwanted, ds.savedText)
+				}
+			}
+		})
+	}
+}
+
+func TestCopyConfigInlineSourceKeepsInactiveSubtrees(t *testing.T) {
+	ds := &copyConfigDatastore{
+		lockInfo: &datastore.LockInfo{
+			IsLocked:  true,
+			SessionID: "session-1",
+		},
+	}
+
+	reply := copyConfigRPC(t, ds, `<source><config><system><host-name>router1</host-name></system><inactive xmlns="urn:arca:router:config:1.0"><path>system</path></inactive></config></source>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("copy-config inline source errors = %#v, want none", reply.Errors)
+	}
+	if want := "set system host-name router1\ndeactivate system\n"; ds.savedText != want {
+		t.Fatalf("saved candidate = %q, want %q", ds.savedText, want)
+	}
+}
+
+func TestEditConfigKeepsGroupsInCandidate(t *testing.T) {
+	ds := &copyConfigDatastore{
+		candidate: &datastore.CandidateConfig{ConfigText: strings.Join([]string{
+			"set groups JUMBO interfaces <ge-*> mtu 9000",
+			"set interfaces ge-0/0/0 apply-groups JUMBO",
+			"",
+		}, "\n")},
+		lockInfo: &datastore.LockInfo{IsLocked: true, SessionID: "session-1"},
+	}
+
+	reply := editConfigRPCWithDefaultOperation(t, ds, "merge", "<config><system><host-name>router1</host-name></system></config>")
+	if len(reply.Errors) != 0 {
+		t.Fatalf("edit-config errors = %#v, want none", reply.Errors)
+	}
+	for _, want := range []string{
+		"set groups JUMBO interfaces <ge-*> mtu 9000",
+		"set interfaces ge-0/0/0 apply-groups JUMBO",
+		"set system host-name router1",
+	} {
+		if !strings.Contains(ds.savedText, want) {
+			t.Fatalf("saved candidate = %q, want it to contain %q", ds.savedText, want)
+		}
+	}
+}
+
+func TestCopyConfigInlineSourceWithGroups(t *testing.T) {
+	ds := &copyConfigDatastore{
+		lockInfo: &datastore.LockInfo{IsLocked: true, SessionID: "session-1"},
+	}
+
+	reply := copyConfigRPC(t, ds, `<source><config><groups xmlns="urn:arca:router:config:1.0"><group><name>JUMBO</name><interfaces><interface><name>&lt;ge-*&gt;</name><mtu>9000</mtu></interface></interfaces></group></groups><interfaces><interface><name>ge-0/0/0</name><apply-groups>JUMBO</apply-groups></interface></interfaces></config></source>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("copy-config errors = %#v, want none", reply.Errors)
+	}
+	for _, want := range []string{
+		"set groups JUMBO interfaces <ge-*> mtu 9000",
+		"set interfaces ge-0/0/0 apply-groups JUMBO",
+	} {
+		if !strings.Contains(ds.savedText, want) {
+			t.Fatalf("saved candidate = %q, want it to contain %q", ds.savedText, want)
+		}
+	}
+}
+
 func TestCopyConfigInlineSourcePreservesAncestorNamespaceDeclarations(t *testing.T) {
 	ds := &copyConfigDatastore{
 		lockInfo: &datastore.LockInfo{
@@ -679,8 +1269,8 @@ func TestCopyConfigValidatesInlineConfigSourceBeforeSavingCandidate(t *testing.T
 	if err.ErrorTag != ErrorTagInvalidValue {
 		t.Fatalf("copy-config inline source error tag = %s, want %s", err.ErrorTag, ErrorTagInvalidValue)
 	}
-	if err.ErrorPath != "/rpc/copy-config/source" {
-		t.Fatalf("copy-config inline source error path = %q, want /rpc/copy-config/source", err.ErrorPath)
+	if err.ErrorPath != "/arca:system" {
+		t.Fatalf("copy-config inline source error path = %q, want /arca:system", err.ErrorPath)
 	}
 	if ds.saveCalled {
 		t.Fatal("copy-config saved invalid inline source config")
@@ -0,0 +1,120 @@
+package netconf
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/datastore"
+)
+
+func TestRPCRepliesEchoMessageID(t *testing.T) {
+	ds, err := datastore.NewSQLiteDatastore(&datastore.Config{
+		Backend:    datastore.BackendSQLite,
+		SQLitePath: filepath.Join(t.TempDir(), "config.db"),
+	})
+	if err != nil {
+		t.Fatalf("NewSQLiteDatastore() error = %v", err)
+	}
+	t.Cleanup(func() { _ = ds.Close() })
+
+	srv := NewServer(ds, nil)
+	tests := []struct {
+		name      string
+		role      string
+		messageID string
+		operation string
+	}{
+		{name: "get-config", role: RoleAdmin, messageID: "101", operation: `<get-config><source><running/></source></get-config>`},
+		{name: "discard-changes", role: RoleAdmin, messageID: "urn:uuid:6f1c", operation: `<discard-changes/>`},
+		{name: "unknown operation", role: RoleAdmin, messageID: "  spaced id  ", operation: `<frobnicate/>`},
+		{name: "access denied", role: RoleReadOnly, messageID: "x&y", operation: `<discard-changes/>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rpcXML bytes.Buffer
+			rpcXML.WriteString(`<rpc xmlns="urn:ietf:params:xml:ns:netconf:base:1.0" message-id="`)
+			if err := xml.EscapeText(&rpcXML, []byte(tt.messageID)); err != nil {
+				t.Fatalf("EscapeText() error = %v", err)
+			}
+			rpcXML.WriteString(`">` + tt.operation + `</rpc>`)
+
+			rpc, err := ParseRPC(rpcXML.Bytes())
+			if err != nil {
+				t.Fatalf("ParseRPC() error = %v", err)
+			}
+			sess := &Session{
+				ID:             "session-1",
+				NumericID:      1,
+				Username:       "alice",
+				Role:           tt.role,
+				LastUsed:       time.Now(),
+				datastoreLocks: map[string]struct{}{},
+			}
+			replyXML, err := MarshalReply(srv.HandleRPC(context.Background(), sess, rpc))
+			if err != nil {
+				t.Fatalf("MarshalReply() error = %v", err)
+			}
+
+			var reply struct {
+				MessageID string `xml:"message-id,attr"`
+			}
+			if err := xml.Unmarshal(replyXML, &reply); err != nil {
+				t.Fatalf("unmarshal reply: %v\n%s", err, replyXML)
+			}
+			if reply.MessageID != tt.messageID {
+				t.Fatalf("reply message-id = %q, want %q\n%s", reply.MessageID, tt.messageID, replyXML)
+			}
+		})
+	}
+}
+
+func TestParseRPCRejectsMissingOrAmbiguousMessageID(t *testing.T) {
+	tests := []struct {
+		name    string
+		xml     string
+		wantTag ErrorTag
+	}{
+		{
+			name:    "missing",
+			xml:     `<rpc xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><get/></rpc>`,
+			wantTag: ErrorTagMissingAttribute,
+		},
+		{
+			name:    "empty",
+			xml:     `<rpc message-id="" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><get/></rpc>`,
+			wantTag: ErrorTagBadAttribute,
+		},
+		{
+			name:    "duplicate",
+			xml:     `<rpc message-id="101" message-id="102" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><get/></rpc>`,
+			wantTag: ErrorTagBadAttribute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRPC([]byte(tt.xml))
+			rpcErr, ok := err.(*RPCError)
+			if !ok {
+				t.Fatalf("ParseRPC() error = %v (%T), want *RPCError", err, err)
+			}
+			if rpcErr.ErrorTag != tt.wantTag {
+				t.Fatalf("ParseRPC() error tag = %s, want %s", rpcErr.ErrorTag, tt.wantTag)
+			}
+			if rpcErr.ErrorInfo == nil || rpcErr.ErrorInfo.BadAttribute != "message-id" {
+				t.Fatalf("ParseRPC() error-info = %+v, want bad-attribute message-id", rpcErr.ErrorInfo)
+			}
+
+			// An ambiguous or missing message-id must not be echoed.
+			messageID, _ := extractRPCReplyContext([]byte(tt.xml))
+			if messageID != "" {
+				t.Fatalf("extractRPCReplyContext() message-id = %q, want empty", messageID)
+			}
+		})
+	}
+}
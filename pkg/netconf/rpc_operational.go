@@ -46,7 +46,7 @@ func (s *Server) handleGet(ctx context.Context, sess *Session, rpc *RPC) *RPCRep
 	}
 
 	// Validate filter depth and size limits
-	if err := ValidateFilterDepthAndSize("get", req.Filter); err != nil {
+	if err := ValidateFilterDepthAndSizeWithLimit("get", req.Filter, s.messageSizeLimits().Filter); err != nil {
 		return NewErrorReply(rpc.MessageID, rpcErrorFromError(err))
 	}
 
@@ -89,13 +89,17 @@ func (s *Server) getOperationalData(ctx context.Context, filter *Filter) ([]byte
 	ospfNeighbors := s.collectOSPFOperationalState(ctx, collectionFilter, false)
 	ospf3Neighbors := s.collectOSPFOperationalState(ctx, collectionFilter, true)
 	bfdStatus := s.collectBFDOperationalState(ctx, collectionFilter)
-	data, err := buildOperationalData(cfg, collectionFilter, time.Now().UTC(), interfaceStates, routes, bgpNeighbors, ospfNeighbors, ospf3Neighbors, bfdStatus)
+	lastApply := s.collectLastApplyOperationalState(ctx, collectionFilter)
+	data, err := buildOperationalData(cfg, collectionFilter, time.Now().UTC(), interfaceStates, routes, bgpNeighbors, ospfNeighbors, ospf3Neighbors, bfdStatus, lastApply)
 	if err != nil {
 		return nil, err
 	}
 	if usesExperimentalXPathEngine(filter) {
 		return applyExperimentalXPathFilter("get", data, filter)
 	}
+	if usesSubtreeContentFilter(filter) {
+		return applySubtreeContentFilter("get", data, filter)
+	}
 	return data, nil
 }
 
@@ -107,19 +111,22 @@ func GetOperationalData(ctx context.Context, filter *Filter) ([]byte, error) {
 	if usesExperimentalXPathEngine(filter) {
 		outputFilter = nil
 	}
-	data, err := buildOperationalData(config.NewConfig(), outputFilter, time.Now().UTC(), nil, nil, nil, nil, nil, nil)
+	data, err := buildOperationalData(config.NewConfig(), outputFilter, time.Now().UTC(), nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 	if usesExperimentalXPathEngine(filter) {
 		return applyExperimentalXPathFilter("get", data, filter)
 	}
+	if usesSubtreeContentFilter(filter) {
+		return applySubtreeContentFilter("get", data, filter)
+	}
 	return data, nil
 }
 
 // buildAllOperationalData builds operational data XML for the inside of <data>.
 func buildAllOperationalData() string {
-	data, err := buildOperationalData(config.NewConfig(), nil, time.Now().UTC(), nil, nil, nil, nil, nil, nil)
+	data, err := buildOperationalData(config.NewConfig(), nil, time.Now().UTC(), nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		return ""
 	}
@@ -180,6 +187,22 @@ func (s *Server) collectOSPFOperationalState(ctx context.Context, filter *Filter
 	return neighbors
 }
 
+func (s *Server) collectLastApplyOperationalState(ctx context.Context, filter *Filter) *LastApplyOperationalState {
+	if s == nil || !includeOperationalSection(filter, "state", "last-apply") {
+		return nil
+	}
+	provider, ok := s.operationalProvider.(LastApplyProvider)
+	if !ok {
+		return nil
+	}
+	lastApply, err := provider.LastApply(ctx)
+	if err != nil {
+		log.Printf("[NETCONF] Failed to collect last apply state: %v", err)
+		return nil
+	}
+	return lastApply
+}
+
 func (s *Server) collectBFDOperationalState(ctx context.Context, filter *Filter) *BFDOperationalState {
 	if s == nil || s.operationalProvider == nil || !includeOperationalSection(filter, "state", "protocols", "bfd") {
 		return nil
@@ -195,7 +218,7 @@ func (s *Server) collectBFDOperationalState(ctx context.Context, filter *Filter)
 	return status
 }
 
-func buildOperationalData(cfg *config.Config, filter *Filter, now time.Time, interfaceStates map[string]*InterfaceOperationalState, routes []RouteOperationalState, bgpNeighbors []BGPNeighborOperationalState, ospfNeighbors []OSPFNeighborOperationalState, ospf3Neighbors []OSPFNeighborOperationalState, bfdStatus *BFDOperationalState) ([]byte, error) {
+func buildOperationalData(cfg *config.Config, filter *Filter, now time.Time, interfaceStates map[string]*InterfaceOperationalState, routes []RouteOperationalState, bgpNeighbors []BGPNeighborOperationalState, ospfNeighbors []OSPFNeighborOperationalState, ospf3Neighbors []OSPFNeighborOperationalState, bfdStatus *BFDOperationalState, lastApply *LastApplyOperationalState) ([]byte, error) {
 	if cfg == nil {
 		cfg = config.NewConfig()
 	}
@@ -237,6 +260,9 @@ func buildOperationalData(cfg *config.Config, filter *Filter, now time.Time, int
 	if !includeOperationalSection(filter, "state", "protocols", "bfd") {
 		bfdStatus = nil
 	}
+	if !includeOperationalSection(filter, "state", "last-apply") {
+		lastApply = nil
+	}
 	routes = filterRouteOperationalStates(routes, xpathFilter)
 	bgpNeighbors = filterBGPOperationalNeighbors(bgpNeighbors, xpathFilter)
 	ospfNeighbors = filterOSPFOperationalNeighbors(ospfNeighbors, xpathFilter, "ospf")
@@ -247,8 +273,8 @@ func buildOperationalData(cfg *config.Config, filter *Filter, now time.Time, int
 		return nil, err
 	}
 	routingInstances = filterRoutingInstanceOperationalStates(routingInstances, xpathFilter)
-	if hasArcaOperationalState(routes, routingInstances, bgpNeighbors, ospfNeighbors, ospf3Neighbors, bfdStatus) {
-		if err := writeArcaStateXML(&buf, routes, routingInstances, bgpNeighbors, ospfNeighbors, ospf3Neighbors, bfdStatus); err != nil {
+	if hasArcaOperationalState(routes, routingInstances, bgpNeighbors, ospfNeighbors, ospf3Neighbors, bfdStatus, lastApply) {
+		if err := writeArcaStateXML(&buf, routes, routingInstances, bgpNeighbors, ospfNeighbors, ospf3Neighbors, bfdStatus, lastApply); err != nil {
 			return nil, err
 		}
 	}
@@ -340,8 +366,9 @@ func modelRoutingInstanceExportTargets(instance *model.RoutingInstance) []string
 	return targets
 }
 
-func hasArcaOperationalState(routes []RouteOperationalState, routingInstances []RoutingInstanceOperationalState, bgpNeighbors []BGPNeighborOperationalState, ospfNeighbors []OSPFNeighborOperationalState, ospf3Neighbors []OSPFNeighborOperationalState, bfdStatus *BFDOperationalState) bool {
-	return len(routes) > 0 ||
+func hasArcaOperationalState(routes []RouteOperationalState, routingInstances []RoutingInstanceOperationalState, bgpNeighbors []BGPNeighborOperationalState, ospfNeighbors []OSPFNeighborOperationalState, ospf3Neighbors []OSPFNeighborOperationalState, bfdStatus *BFDOperationalState, lastApply *LastApplyOperationalState) bool {
+	return lastApply != nil ||
+		len(routes) > 0 ||
 		len(routingInstances) > 0 ||
 		len(bgpNeighbors) > 0 ||
 		len(ospfNeighbors) > 0 ||
@@ -633,6 +660,9 @@ func writeInterfaceStateXML(buf *bytes.Buffer, interfaces map[string]*config.Int
 		if err := writeEscapedElement(buf, "      ", "name", name); err != nil {
 			return err
 		}
+		if state != nil && state.IfIndex != 0 {
+			fmt.Fprintf(buf, "      <if-index>%d</if-index>\n", state.IfIndex)
+		}
 		if err := writeEscapedElement(buf, "      ", "admin-status", interfaceAdminStatus(state)); err != nil {
 			return err
 		}
@@ -690,12 +720,32 @@ func writeInterfaceStateXML(buf *bytes.Buffer, interfaces map[string]*config.Int
 			}
 			buf.WriteString("      </addresses>\n")
 		}
+		if state != nil && len(state.Addresses) > 0 {
+			if err := writeInterfaceLiveAddressesXML(buf, state.Addresses); err != nil {
+				return err
+			}
+		}
 		buf.WriteString("    </interface>\n")
 	}
 	buf.WriteString("  </interfaces>\n")
 	return nil
 }
 
+// writeInterfaceLiveAddressesXML writes the addresses programmed in the
+// dataplane as an Arca state augmentation of the IETF interface entry.
+func writeInterfaceLiveAddressesXML(buf *bytes.Buffer, addresses []string) error {
+	sorted := append([]string(nil), addresses...)
+	sort.Strings(sorted)
+	buf.WriteString(`      <live-addresses xmlns="` + ArcaStateNS + `">` + "\n")
+	for _, addr := range sorted {
+		if err := writeEscapedElement(buf, "        ", "ip", addr); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("      </live-addresses>\n")
+	return nil
+}
+
 func sortedInterfaceStateNames(interfaces map[string]*config.Interface, states map[string]*InterfaceOperationalState) []string {
 	seen := make(map[string]struct{}, len(interfaces)+len(states))
 	names := make([]string, 0, len(interfaces)+len(states))
@@ -902,8 +952,13 @@ func routingProtocolMatchesXPathPredicates(xpathFilter *XPathFilter, protocolTyp
 	return true
 }
 
-func writeArcaStateXML(buf *bytes.Buffer, routes []RouteOperationalState, routingInstances []RoutingInstanceOperationalState, bgpNeighbors []BGPNeighborOperationalState, ospfNeighbors []OSPFNeighborOperationalState, ospf3Neighbors []OSPFNeighborOperationalState, bfdStatus *BFDOperationalState) error {
+func writeArcaStateXML(buf *bytes.Buffer, routes []RouteOperationalState, routingInstances []RoutingInstanceOperationalState, bgpNeighbors []BGPNeighborOperationalState, ospfNeighbors []OSPFNeighborOperationalState, ospf3Neighbors []OSPFNeighborOperationalState, bfdStatus *BFDOperationalState, lastApply *LastApplyOperationalState) error {
 	buf.WriteString(`  <state xmlns="` + ArcaConfigNS + `">` + "\n")
+	if lastApply != nil {
+		if err := writeLastApplyOperationalStateXML(buf, lastApply); err != nil {
+			return err
+		}
+	}
 	if len(routes) > 0 {
 		if err := writeRouteOperationalStateXML(buf, routes); err != nil {
 			return err
@@ -942,6 +997,62 @@ func writeArcaStateXML(buf *bytes.Buffer, routes []RouteOperationalState, routin
 	return nil
 }
 
+func writeLastApplyOperationalStateXML(buf *bytes.Buffer, lastApply *LastApplyOperationalState) error {
+	buf.WriteString("    <last-apply>\n")
+	if err := writeEscapedElement(buf, "      ", "time", lastApply.Time.UTC().Format(time.RFC3339Nano)); err != nil {
+		return err
+	}
+	fmt.Fprintf(buf, "      <version>%d</version>\n", lastApply.Version)
+	status := "ok"
+	if lastApply.Error != "" {
+		status = "failed"
+	}
+	if err := writeEscapedElement(buf, "      ", "status", status); err != nil {
+		return err
+	}
+	if lastApply.Error != "" {
+		if err := writeEscapedElement(buf, "      ", "error", lastApply.Error); err != nil {
+			return err
+		}
+	}
+	for _, plugin := range lastApply.Plugins {
+		buf.WriteString("      <plugin>\n")
+		if err := writeEscapedElement(buf, "        ", "name", plugin.Name); err != nil {
+			return err
+		}
+		if err := writeEscapedElement(buf, "        ", "status", plugin.Status); err != nil {
+			return err
+		}
+		if plugin.Error != "" {
+			if err := writeEscapedElement(buf, "        ", "error", plugin.Error); err != nil {
+				return err
+			}
+		}
+		for _, operation := range plugin.Operations {
+			buf.WriteString("        <operation>\n")
+			if err := writeEscapedElement(buf, "          ", "name", operation.Operation); err != nil {
+				return err
+			}
+			status := "ok"
+			if operation.Error != "" {
+				status = "failed"
+			}
+			if err := writeEscapedElement(buf, "          ", "status", status); err != nil {
+				return err
+			}
+			if operation.Error != "" {
+				if err := writeEscapedElement(buf, "          ", "error", operation.Error); err != nil {
+					return err
+				}
+			}
+			buf.WriteString("        </operation>\n")
+		}
+		buf.WriteString("      </plugin>\n")
+	}
+	buf.WriteString("    </last-apply>\n")
+	return nil
+}
+
 func writeRouteOperationalStateXML(buf *bytes.Buffer, routes []RouteOperationalState) error {
 	buf.WriteString("    <routes>\n")
 	for _, route := range routes {
@@ -13,11 +13,12 @@ import (
 )
 
 type testOperationalStateProvider struct {
-	routes []RouteOperationalState
+	interfaces map[string]*InterfaceOperationalState
+	routes     []RouteOperationalState
 }
 
 func (p *testOperationalStateProvider) InterfaceStates(context.Context) (map[string]*InterfaceOperationalState, error) {
-	return nil, nil
+	return p.interfaces, nil
 }
 
 func (p *testOperationalStateProvider) Routes(context.Context) ([]RouteOperationalState, error) {
@@ -67,7 +68,7 @@ func TestBuildOperationalDataUsesRunningConfig(t *testing.T) {
 	}
 	cfg.Protocols = &config.ProtocolConfig{BGP: &config.BGPConfig{}}
 
-	data, err := buildOperationalData(cfg, nil, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil)
+	data, err := buildOperationalData(cfg, nil, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -204,7 +205,7 @@ func TestBuildOperationalDataFiltersIETFRoutingStateRouteXPathPredicates(t *test
 	}
 	filter := &Filter{Type: "xpath", Select: "/routing/routing-state/routes/route[destination-prefix='0.0.0.0/0'][metric='5']"}
 
-	data, err := buildOperationalData(cfg, filter, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil)
+	data, err := buildOperationalData(cfg, filter, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -237,7 +238,7 @@ func TestBuildOperationalDataFiltersIETFRoutingStateProtocolXPathPredicates(t *t
 	}
 	filter := &Filter{Type: "xpath", Select: "/routing/routing-state/routing-protocols/routing-protocol[type='bgp']"}
 
-	data, err := buildOperationalData(cfg, filter, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil)
+	data, err := buildOperationalData(cfg, filter, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -265,7 +266,7 @@ func TestBuildOperationalDataRejectsUnsupportedFilterType(t *testing.T) {
 	cfg.System = &config.SystemConfig{HostName: "router1"}
 	filter := &Filter{Type: "unsupported"}
 
-	data, err := buildOperationalData(cfg, filter, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil)
+	data, err := buildOperationalData(cfg, filter, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -283,10 +284,12 @@ func TestBuildOperationalDataUsesLiveInterfaceState(t *testing.T) {
 	data, err := buildOperationalData(cfg, nil, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), map[string]*InterfaceOperationalState{
 		"ge-0/0/0": {
 			Name:        "ge-0/0/0",
+			IfIndex:     3,
 			AdminStatus: "up",
 			OperStatus:  "down",
 			MAC:         "02:00:00:00:00:01",
 			QoSProfile:  "WAN",
+			Addresses:   []string{"192.0.2.1/24", "2001:db8::1/64"},
 			IPv4TableID: 100,
 			IPv6TableID: 100,
 			Counters: &InterfaceOperationalCounters{
@@ -307,13 +310,14 @@ func TestBuildOperationalDataUsesLiveInterfaceState(t *testing.T) {
 				},
 			},
 		},
-	}, nil, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
 
 	for _, want := range []string{
 		"<name>ge-0/0/0</name>",
+		"<if-index>3</if-index>",
 		"<admin-status>up</admin-status>",
 		"<oper-status>down</oper-status>",
 		"<phys-address>02:00:00:00:00:01</phys-address>",
@@ -335,6 +339,8 @@ func TestBuildOperationalDataUsesLiveInterfaceState(t *testing.T) {
 		"<shared>true</shared>",
 		"<thread>2</thread>",
 		"<ip>192.0.2.1/24</ip>",
+		`<live-addresses xmlns="urn:arca:router:state:1.0">`,
+		"<ip>2001:db8::1/64</ip>",
 	} {
 		if !bytes.Contains(data, []byte(want)) {
 			t.Fatalf("operational data missing %q:\n%s", want, data)
@@ -342,6 +348,40 @@ func TestBuildOperationalDataUsesLiveInterfaceState(t *testing.T) {
 	}
 }
 
+func TestGetInterfaceStateHonorsSubtreeFilter(t *testing.T) {
+	srv := NewServer(nil, nil)
+	srv.SetOperationalStateProvider(&testOperationalStateProvider{
+		interfaces: map[string]*InterfaceOperationalState{
+			"ge-0/0/0": {
+				Name:        "ge-0/0/0",
+				AdminStatus: "up",
+				OperStatus:  "up",
+				Addresses:   []string{"192.0.2.1/24"},
+				Counters:    &InterfaceOperationalCounters{RxPackets: 7},
+			},
+		},
+		routes: []RouteOperationalState{{Prefix: "198.51.100.0/24", Protocol: "static"}},
+	})
+
+	reply := getParsedRPC(t, srv, `<rpc message-id="101" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<get>
+			<filter type="subtree"><interfaces xmlns="urn:ietf:params:xml:ns:yang:ietf-interfaces"/></filter>
+		</get>
+	</rpc>`)
+	if len(reply.Errors) != 0 {
+		t.Fatalf("get interface state errors = %#v, want none", reply.Errors)
+	}
+	data := reply.Data.Content
+	for _, want := range []string{"<oper-status>up</oper-status>", "<rx-packets>7</rx-packets>", "<ip>192.0.2.1/24</ip>"} {
+		if !bytes.Contains(data, []byte(want)) {
+			t.Fatalf("get interface state missing %q:\n%s", want, data)
+		}
+	}
+	if bytes.Contains(data, []byte("198.51.100.0/24")) {
+		t.Fatalf("get interface state included filtered route state:\n%s", data)
+	}
+}
+
 func TestBuildOperationalDataFiltersInterfaceStateXPathPredicates(t *testing.T) {
 	cfg := config.NewConfig()
 	filter := &Filter{Type: "xpath", Select: "/interfaces/interface[admin-status='up']"}
@@ -356,7 +396,7 @@ func TestBuildOperationalDataFiltersInterfaceStateXPathPredicates(t *testing.T)
 			AdminStatus: "down",
 			OperStatus:  "down",
 		},
-	}, nil, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -407,7 +447,7 @@ func TestBuildOperationalDataWritesBFDState(t *testing.T) {
 				Up:        true,
 			},
 		},
-	})
+	}, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -444,6 +484,54 @@ func TestBuildOperationalDataWritesBFDState(t *testing.T) {
 	}
 }
 
+func TestBuildOperationalDataWritesLastApplyState(t *testing.T) {
+	cfg := config.NewConfig()
+	lastApply := &LastApplyOperationalState{
+		Time:    time.Date(2026, 5, 14, 6, 0, 0, 0, time.UTC),
+		Version: 7,
+		Error:   "plugin vpp apply failed (rollback succeeded): address rejected",
+		Plugins: []PluginApplyOperationalState{
+			{Name: "cluster", Status: "rolled-back"},
+			{Name: "vpp", Status: "failed", Error: "address rejected", Operations: []ApplyOperationOperationalState{
+				{Operation: "create interface ge-0/0/0"},
+				{Operation: "apply interface ge-0/0/0 addresses", Error: "address rejected"},
+			}},
+			{Name: "frr", Status: "not-applied"},
+		},
+	}
+	data, err := buildOperationalData(cfg, nil, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil, lastApply)
+	if err != nil {
+		t.Fatalf("buildOperationalData() error = %v", err)
+	}
+	for _, want := range []string{
+		`<state xmlns="urn:arca:router:config:1.0">`,
+		"<last-apply>",
+		"<time>2026-05-14T06:00:00Z</time>",
+		"<version>7</version>",
+		"<status>failed</status>",
+		"<error>plugin vpp apply failed (rollback succeeded): address rejected</error>",
+		"<name>vpp</name>",
+		"<error>address rejected</error>",
+		"<status>rolled-back</status>",
+		"<status>not-applied</status>",
+		"<operation>\n          <name>create interface ge-0/0/0</name>\n          <status>ok</status>\n        </operation>",
+		"<name>apply interface ge-0/0/0 addresses</name>\n          <status>failed</status>\n          <error>address rejected</error>",
+	} {
+		if !bytes.Contains(data, []byte(want)) {
+			t.Fatalf("operational data missing %q:\n%s", want, data)
+		}
+	}
+
+	filter := &Filter{Type: "xpath", Select: "/state/routes"}
+	data, err = buildOperationalData(cfg, filter, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil, lastApply)
+	if err != nil {
+		t.Fatalf("buildOperationalData() error = %v", err)
+	}
+	if bytes.Contains(data, []byte("<last-apply>")) {
+		t.Fatalf("operational data included last-apply outside the filter:\n%s", data)
+	}
+}
+
 func TestBuildOperationalDataFiltersBFDPeerXPathPredicates(t *testing.T) {
 	cfg := config.NewConfig()
 	filter := &Filter{Type: "xpath", Select: "/state/protocols/bfd/peer[address='192.0.2.3'][status='down']"}
@@ -466,7 +554,7 @@ func TestBuildOperationalDataFiltersBFDPeerXPathPredicates(t *testing.T) {
 				Up:        false,
 			},
 		},
-	})
+	}, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -497,7 +585,7 @@ func TestBuildOperationalDataWritesRoutingInstanceState(t *testing.T) {
 		Interfaces:         []string{"ge-0/0/1", "ge-0/0/0", "ge-0/0/1"},
 	}
 
-	data, err := buildOperationalData(cfg, nil, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil)
+	data, err := buildOperationalData(cfg, nil, time.Date(2026, 5, 12, 4, 0, 0, 0, time.UTC), nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -543,7 +631,7 @@ func TestBuildOperationalDataWritesRouteState(t *testing.T) {
 			Interface: "ge-0/0/0",
 			Active:    true,
 		},
-	}, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -585,7 +673,7 @@ func TestBuildOperationalDataFiltersRouteXPathPredicates(t *testing.T) {
 			Interface: "ge-0/0/1",
 			Active:    true,
 		},
-	}, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -613,7 +701,7 @@ func TestBuildOperationalDataWritesBGPNeighborState(t *testing.T) {
 			PrefixReceived: 10,
 			PrefixSent:     20,
 		},
-	}, nil, nil, nil)
+	}, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -650,7 +738,7 @@ func TestBuildOperationalDataFiltersBGPNeighborXPathPredicates(t *testing.T) {
 			PeerAS:      65002,
 			State:       "Idle",
 		},
-	}, nil, nil, nil)
+	}, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -699,7 +787,7 @@ func TestBuildOperationalDataWritesOSPFNeighborState(t *testing.T) {
 			DeadTimeSecs: 32,
 			UptimeSecs:   95,
 		},
-	}, nil)
+	}, nil, nil)
 	if err != nil {
 		t.Fatalf("buildOperationalData() error = %v", err)
 	}
@@ -26,13 +26,49 @@ func (s *Server) handleCommit(ctx context.Context, sess *Session, rpc *RPC) *RPC
 	if err := rpc.UnmarshalOperation(&req); err != nil {
 		return NewErrorReply(rpc.MessageID, rpcErrorFromError(err))
 	}
+	if s.confirmedCommitStore() != nil {
+		return s.handleConfirmedCommit(ctx, sess, rpc, &req)
+	}
 	if rpcErr := unsupportedCommitOption(&req); rpcErr != nil {
 		return NewErrorReply(rpc.MessageID, rpcErr)
 	}
 
+	commitID, rpcErr := s.commitCandidate(ctx, sess, false)
+	if rpcErr != nil {
+		return NewErrorReply(rpc.MessageID, rpcErr)
+	}
+
+	log.Printf("[NETCONF] Commit successful: %s (session: %s, user: %s)", commitID, sess.ID, sess.Username)
+
+	return NewOKReply(rpc.MessageID)
+}
+
+// commitCandidate validates and commits the session candidate. When
+// skipUnchanged is set, a missing candidate or one identical to running is
+// not an error and returns an empty commit ID, so a confirming commit can
+// succeed without new changes.
+func (s *Server) commitCandidate(ctx context.Context, sess *Session, skipUnchanged bool) (string, *RPCError) {
+	if skipUnchanged {
+		candidate, err := s.datastore.GetCandidate(ctx, sess.ID)
+		if err != nil && !isDatastoreNotFound(err) {
+			log.Printf("[NETCONF] Failed to read candidate config for commit session %s: %v", sess.ID, err)
+			return "", ErrDatastoreError("failed to read candidate config")
+		}
+		if candidate == nil {
+			return "", nil
+		}
+		runningText, rpcErr := s.readRunningConfigText(ctx, true, "", "failed to retrieve running config for commit")
+		if rpcErr != nil {
+			return "", rpcErr
+		}
+		if candidate.ConfigText == runningText {
+			return "", nil
+		}
+	}
+
 	// Check if candidate lock is held by this session
 	if lockErr := s.checkLockOwnership(ctx, sess, DatastoreCandidate, "commit"); lockErr != nil {
-		return NewErrorReply(rpc.MessageID, lockErr)
+		return "", lockErr
 	}
 
 	// Check if candidate exists
@@ -40,32 +76,42 @@ func (s *Server) handleCommit(ctx context.Context, sess *Session, rpc *RPC) *RPC
 	if err != nil {
 		if isDatastoreNotFound(err) {
 			log.Printf("[NETCONF] No candidate config to commit for session %s: %v", sess.ID, err)
-			return NewErrorReply(rpc.MessageID, ErrOperationFailed("no candidate configuration to commit"))
+			return "", ErrOperationFailed("no candidate configuration to commit")
 		}
 		log.Printf("[NETCONF] Failed to read candidate config for commit session %s: %v", sess.ID, err)
-		return NewErrorReply(rpc.MessageID, ErrDatastoreError("failed to read candidate config"))
+		return "", ErrDatastoreError("failed to read candidate config")
 	}
 	if candidate == nil {
 		log.Printf("[NETCONF] No candidate config to commit for session %s", sess.ID)
-		return NewErrorReply(rpc.MessageID, ErrOperationFailed("no candidate configuration to commit"))
+		return "", ErrOperationFailed("no candidate configuration to commit")
+	}
+
+	if rpcErr := s.checkCandidateBase(ctx, candidate); rpcErr != nil {
+		log.Printf("[NETCONF] Commit rejected for session %s: %s", sess.ID, rpcErr.ErrorMessage)
+		return "", rpcErr
 	}
 
 	cfg, err := TextToConfig(candidate.ConfigText)
 	if err != nil {
 		log.Printf("[NETCONF] Failed to parse candidate config before commit: %v", err)
-		return NewErrorReply(rpc.MessageID, ErrValidationFailed(fmt.Sprintf("config parsing failed: %v", err)))
+		return "", ErrValidationFailed(fmt.Sprintf("config parsing failed: %v", err))
 	}
 	if rpcErr := validateConfigSemantics("commit", cfg); rpcErr != nil {
 		log.Printf("[NETCONF] Commit validation failed for session %s: %v", sess.ID, rpcErr)
-		return NewErrorReply(rpc.MessageID, rpcErr)
+		return "", rpcErr
+	}
+	message, rpcErr := s.runPreCommitScripts(ctx, sess, cfg, candidate.ConfigText)
+	if rpcErr != nil {
+		return "", rpcErr
 	}
 
 	// Perform commit
 	commitReq := &datastore.CommitRequest{
 		SessionID: sess.ID,
 		User:      sess.Username,
+		Message:   message,
+		Source:    datastore.CommitSourceNETCONF,
 		SourceIP:  sess.RemoteAddr(),
-		Message:   fmt.Sprintf("NETCONF commit by %s", sess.Username),
 	}
 
 	persist := func(ctx context.Context) (string, error) {
@@ -86,18 +132,45 @@ func (s *Server) handleCommit(ctx context.Context, sess *Session, rpc *RPC) *RPC
 	}
 	if err != nil {
 		log.Printf("[NETCONF] Commit failed for session %s: %v", sess.ID, err)
-		return NewErrorReply(rpc.MessageID, commitFailureError(err))
+		return "", commitFailureError(err)
 	}
 	sess.RemoveLock(DatastoreCandidate)
+	s.runPostCommitScripts(ctx, sess, cfg, candidate.ConfigText, commitID)
+	return commitID, nil
+}
 
-	log.Printf("[NETCONF] Commit successful: %s (session: %s, user: %s)", commitID, sess.ID, sess.Username)
+// checkCandidateBase rejects a candidate created from a running commit that
+// has since been replaced, before the candidate is applied. The datastore
+// repeats the check atomically when the commit is persisted.
+func (s *Server) checkCandidateBase(ctx context.Context, candidate *datastore.CandidateConfig) *RPCError {
+	if !candidate.BaseTracked {
+		return nil
+	}
+	runningCommitID := ""
+	running, err := s.datastore.GetRunning(ctx)
+	if err != nil && !isDatastoreNotFound(err) {
+		return ErrDatastoreError("failed to retrieve running config for commit")
+	}
+	if running != nil {
+		runningCommitID = running.CommitID
+	}
+	if candidate.IsStale(runningCommitID) {
+		return staleCandidateRPCError()
+	}
+	return nil
+}
 
-	return NewOKReply(rpc.MessageID)
+func staleCandidateRPCError() *RPCError {
+	return ErrStaleCandidate("running configuration was changed by another commit after this candidate was created; " +
+		"discard-changes and re-apply the edits to the current running configuration")
 }
 
 func commitFailureError(err error) *RPCError {
 	var dsErr *datastore.Error
 	if errors.As(err, &dsErr) {
+		if dsErr.Code == datastore.ErrCodeStaleCandidate {
+			return staleCandidateRPCError()
+		}
 		return ErrDatastoreError("commit failed")
 	}
 	return ErrBackendValidationFailed("commit failed")
@@ -189,7 +262,7 @@ func (s *Server) validateSourceConfig(ctx context.Context, sess *Session, source
 		if err != nil {
 			return nil, rpcErrorFromError(err)
 		}
-		cfg, err := XMLToConfig(configXML, DefaultOpMerge)
+		cfg, err := XMLToConfigWithLimit(configXML, DefaultOpMerge, s.messageSizeLimits().ConfigInput)
 		if err != nil {
 			log.Printf("[NETCONF] Failed to parse inline validate source: %v", err)
 			if rpcErr, ok := err.(*RPCError); ok {
@@ -3,10 +3,13 @@ package netconf
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/akam1o/arca-router/pkg/commitscript"
 	"github.com/akam1o/arca-router/pkg/datastore"
 )
 
@@ -18,6 +21,7 @@ type validateDatastore struct {
 	runningErr   error
 	candidateErr error
 	commitErr    error
+	commitReq    *datastore.CommitRequest
 }
 
 func (d *validateDatastore) GetRunning(context.Context) (*datastore.RunningConfig, error) {
@@ -38,7 +42,8 @@ func (d *validateDatastore) GetLockInfo(context.Context, string) (*datastore.Loc
 	return d.lockInfo, nil
 }
 
-func (d *validateDatastore) Commit(context.Context, *datastore.CommitRequest) (string, error) {
+func (d *validateDatastore) Commit(_ context.Context, req *datastore.CommitRequest) (string, error) {
+	d.commitReq = req
 	if d.commitErr != nil {
 		return "", d.commitErr
 	}
@@ -70,8 +75,8 @@ func TestValidateRunningDatastoreSemanticError(t *testing.T) {
 	if err.ErrorTag != ErrorTagInvalidValue {
 		t.Fatalf("validate running error tag = %s, want %s", err.ErrorTag, ErrorTagInvalidValue)
 	}
-	if err.ErrorPath != "/rpc/validate/source" {
-		t.Fatalf("validate running error path = %q, want /rpc/validate/source", err.ErrorPath)
+	if err.ErrorPath != "/arca:system" {
+		t.Fatalf("validate running error path = %q, want /arca:system", err.ErrorPath)
 	}
 }
 
@@ -122,8 +127,8 @@ func TestValidateInlineConfigSourceSemanticError(t *testing.T) {
 	if err.ErrorTag != ErrorTagInvalidValue {
 		t.Fatalf("validate inline source error tag = %s, want %s", err.ErrorTag, ErrorTagInvalidValue)
 	}
-	if err.ErrorPath != "/rpc/validate/source" {
-		t.Fatalf("validate inline source error path = %q, want /rpc/validate/source", err.ErrorPath)
+	if err.ErrorPath != "/arca:system" {
+		t.Fatalf("validate inline source error path = %q, want /arca:system", err.ErrorPath)
 	}
 }
 
@@ -298,6 +303,44 @@ func TestCommitDatastoreFailureReturnsDatastoreError(t *testing.T) {
 	}
 }
 
+func TestCommitValidationFailureReturnsStructuredError(t *testing.T) {
+	ds := &validateDatastore{
+		candidate: &datastore.CandidateConfig{ConfigText: "set protocols bgp group EXT type external\nset protocols bgp group EXT neighbor 192.0.2.1 peer-as 65001\n"},
+		lockInfo: &datastore.LockInfo{
+			IsLocked:  true,
+			SessionID: "session-1",
+		},
+	}
+	reply := commitRPC(t, ds, "")
+
+	if len(reply.Errors) != 1 {
+		t.Fatalf("commit validation errors = %#v, want 1", reply.Errors)
+	}
+	err := reply.Errors[0]
+	if err.ErrorTag != ErrorTagInvalidValue || err.ErrorAppTag != "validation-failed" {
+		t.Fatalf("commit validation tag, app-tag = %s, %q; want %s, validation-failed", err.ErrorTag, err.ErrorAppTag, ErrorTagInvalidValue)
+	}
+	if err.ErrorPath != "/arca:protocols/arca:bgp" {
+		t.Fatalf("commit validation path = %q, want /arca:protocols/arca:bgp", err.ErrorPath)
+	}
+	data, marshalErr := MarshalReply(reply)
+	if marshalErr != nil {
+		t.Fatalf("MarshalReply() error = %v", marshalErr)
+	}
+	if want := `<error-path xmlns:arca="` + ArcaConfigNS + `">/arca:protocols/arca:bgp</error-path>`; !strings.Contains(string(data), want) {
+		t.Fatalf("commit validation reply = %s, want %s", data, want)
+	}
+	if err.ErrorInfo == nil || err.ErrorInfo.Hint == "" {
+		t.Fatalf("commit validation error-info = %#v, want hint", err.ErrorInfo)
+	}
+	if strings.Contains(err.ErrorMessage, "CONFIG_VALIDATION_ERROR") {
+		t.Fatalf("commit validation message = %q, want code carried by app-tag only", err.ErrorMessage)
+	}
+	if ds.commitReq != nil {
+		t.Fatal("commit reached the datastore despite a validation error")
+	}
+}
+
 func TestCommitBackendFailureRedactsDetails(t *testing.T) {
 	ds := &validateDatastore{
 		candidate: &datastore.CandidateConfig{ConfigText: "set system host-name router1\n"},
@@ -346,6 +389,44 @@ func validateParsedRPC(t *testing.T, ds datastore.Datastore, rpcXML string) *RPC
 	return handleParsedRPC(t, NewServer(ds, nil), rpcXML)
 }
 
+func TestCommitPreScriptAbortsCommit(t *testing.T) {
+	dir := t.TempDir()
+	check := filepath.Join(dir, "check")
+	if err := os.WriteFile(check, []byte("#!/bin/sh\ngrep -q 'host-name forbidden' - && { echo 'forbidden hostname'; exit 1; }\necho checked\n"), 0o755); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	newDatastore := func(hostname string) *validateDatastore {
+		return &validateDatastore{
+			candidate: &datastore.CandidateConfig{ConfigText: "set system host-name " + hostname + "\nset system scripts commit pre " + check + "\n"},
+			lockInfo:  &datastore.LockInfo{IsLocked: true, SessionID: "session-1"},
+		}
+	}
+
+	ds := newDatastore("forbidden")
+	srv := NewServer(ds, nil)
+	srv.SetCommitScriptRunner(&commitscript.Runner{Dir: dir, Timeout: 5 * time.Second})
+	reply := handleCommitRPC(t, srv, "")
+	if len(reply.Errors) != 1 || reply.Errors[0].ErrorTag != ErrorTagOperationFailed ||
+		!strings.Contains(reply.Errors[0].ErrorMessage, "commit aborted: pre-commit script "+check+": exit status 1\nforbidden hostname") {
+		t.Fatalf("commit errors = %#v, want aborted pre-commit script", reply.Errors)
+	}
+	if ds.commitReq != nil {
+		t.Fatalf("datastore commit = %#v, want no commit after aborting script", ds.commitReq)
+	}
+
+	ds = newDatastore("router1")
+	srv = NewServer(ds, nil)
+	srv.SetCommitScriptRunner(&commitscript.Runner{Dir: dir, Timeout: 5 * time.Second})
+	reply = handleCommitRPC(t, srv, "")
+	if len(reply.Errors) != 0 {
+		t.Fatalf("commit errors = %#v, want none", reply.Errors)
+	}
+	want := "NETCONF commit by alice\npre-commit script " + check + ": ok\nchecked"
+	if ds.commitReq == nil || ds.commitReq.Message != want {
+		t.Fatalf("commit request = %#v, want message %q", ds.commitReq, want)
+	}
+}
+
 func commitRPC(t *testing.T, ds datastore.Datastore, content string) *RPCReply {
 	t.Helper()
 
@@ -4,7 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"sync"
+	"time"
 
+	"github.com/akam1o/arca-router/pkg/commitscript"
+	"github.com/akam1o/arca-router/pkg/config"
 	"github.com/akam1o/arca-router/pkg/datastore"
 )
 
@@ -13,7 +18,21 @@ type Server struct {
 	datastore           datastore.Datastore
 	sessions            *SessionManager
 	commitHook          CommitHook
+	commitScripts       *commitscript.Runner
 	operationalProvider OperationalStateProvider
+
+	// jsonEncodingDisabled rejects get-config requests for JSON output.
+	jsonEncodingDisabled bool
+
+	// sizeLimits bounds messages per operation class; zero fields use
+	// DefaultMessageSizeLimits.
+	sizeLimits MessageSizeLimits
+
+	// Confirmed-commit state. confirmMu serializes commits against the
+	// rollback timer so a confirming commit cannot race an expiry.
+	confirmMu      sync.Mutex
+	confirmTimer   *time.Timer
+	confirmStopped bool
 }
 
 // CommitHookRequest contains the data needed to apply a NETCONF candidate
@@ -32,6 +51,9 @@ type CommitHook func(ctx context.Context, req *CommitHookRequest, persist func(c
 
 // NewServer creates a new NETCONF server
 func NewServer(ds datastore.Datastore, sm *SessionManager) *Server {
+	if ds != nil {
+		ds = newResilientDatastore(ds, DefaultDatastoreRetryPolicy())
+	}
 	return &Server{
 		datastore: ds,
 		sessions:  sm,
@@ -92,6 +114,8 @@ func (s *Server) HandleRPC(ctx context.Context, sess *Session, rpc *RPC) *RPCRep
 		handler = s.handleUnlock
 	case "commit":
 		handler = s.handleCommit
+	case "cancel-commit":
+		handler = s.handleCancelCommit
 	case "discard-changes":
 		handler = s.handleDiscardChanges
 	case "validate":
@@ -115,67 +139,88 @@ func (s *Server) HandleRPC(ctx context.Context, sess *Session, rpc *RPC) *RPCRep
 		return NewErrorReply(rpc.MessageID, err).WithAttributes(rpc.ReplyAttrs)
 	}
 
+	// Fail fast while the datastore circuit breaker is open. Session
+	// teardown does not need the datastore.
+	if opName != "close-session" && opName != "kill-session" {
+		if err := s.datastoreAvailable(); err != nil {
+			return NewErrorReply(rpc.MessageID, err).WithAttributes(rpc.ReplyAttrs)
+		}
+	}
+
 	// Execute handler
 	return handler(ctx, sess, rpc).WithAttributes(rpc.ReplyAttrs)
 }
 
+// roleRank orders roles so each role includes the permissions of the roles
+// below it.
+var roleRank = map[string]int{
+	RoleReadOnly: 1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// operationRoles maps each NETCONF operation to the lowest role allowed to
+// perform it: read-only sessions may only read configuration and state,
+// operators may edit and commit, and session administration is admin-only.
+var operationRoles = map[string]string{
+	"get-config":      RoleReadOnly,
+	"get":             RoleReadOnly,
+	"lock":            RoleOperator,
+	"unlock":          RoleOperator,
+	"edit-config":     RoleOperator,
+	"validate":        RoleOperator,
+	"commit":          RoleOperator,
+	"cancel-commit":   RoleOperator,
+	"discard-changes": RoleOperator,
+	"copy-config":     RoleOperator,
+	"delete-config":   RoleOperator,
+	"close-session":   RoleOperator,
+	"kill-session":    RoleAdmin,
+}
+
 // checkRBAC enforces role-based access control per design document Section 4
 func (s *Server) checkRBAC(role, operation string) *RPCError {
-	// Define RBAC matrix per design document
-	readOnlyOps := map[string]bool{
-		"get-config": true,
-		"get":        true,
-	}
-
-	operatorOps := map[string]bool{
-		"get-config":      true,
-		"get":             true,
-		"lock":            true,
-		"unlock":          true,
-		"edit-config":     true,
-		"validate":        true,
-		"commit":          true,
-		"discard-changes": true,
-		"copy-config":     true,
-		"delete-config":   true,
-		"close-session":   true,
-	}
-
-	adminOps := map[string]bool{
-		"get-config":      true,
-		"get":             true,
-		"lock":            true,
-		"unlock":          true,
-		"edit-config":     true,
-		"validate":        true,
-		"commit":          true,
-		"discard-changes": true,
-		"copy-config":     true,
-		"delete-config":   true,
-		"close-session":   true,
-		"kill-session":    true,
-	}
-
-	switch role {
-	case RoleReadOnly:
-		if !readOnlyOps[operation] {
-			return ErrAccessDenied(operation, "read-only role cannot perform this operation")
-		}
-	case RoleOperator:
-		if !operatorOps[operation] {
-			return ErrAccessDenied(operation, "operator role cannot perform this operation")
-		}
-	case RoleAdmin:
-		if !adminOps[operation] {
-			return ErrAccessDenied(operation, "unknown operation")
-		}
-	default:
+	rank, ok := roleRank[role]
+	if !ok {
 		return ErrAccessDenied(operation, "unknown role")
 	}
 
+	required, known := operationRoles[operation]
+	if !known && role == RoleAdmin {
+		return ErrAccessDenied(operation, "unknown operation")
+	}
+	if !known || rank < roleRank[required] {
+		return ErrAccessDenied(operation, fmt.Sprintf("%s role cannot perform this operation", role))
+	}
+
 	return nil
 }
 
+// checkUserManagement rejects configuration writes by non-admin sessions
+// that would change the security users section. Operators may edit the rest
+// of the configuration but not add, remove, or modify login accounts.
+func checkUserManagement(sess *Session, operation string, before, after *config.Config) *RPCError {
+	if sess != nil && sess.Role == RoleAdmin {
+		return nil
+	}
+	if reflect.DeepEqual(securityUsers(before), securityUsers(after)) {
+		return nil
+	}
+	username := ""
+	if sess != nil {
+		username = sess.Username
+	}
+	log.Printf("[RBAC] Access denied: user=%s operation=%s reason=user-management", username, operation)
+	return ErrAccessDenied(operation, "user management requires admin role")
+}
+
+func securityUsers(cfg *config.Config) map[string]*config.UserConfig {
+	if cfg == nil || cfg.Security == nil || len(cfg.Security.Users) == 0 {
+		return nil
+	}
+	return cfg.Security.Users
+}
+
 // handleCloseSession handles <close-session> RPC
 func (s *Server) handleCloseSession(ctx context.Context, sess *Session, rpc *RPC) *RPCReply {
 	// Session cleanup will be handled by SSH server after reply is sent
@@ -18,6 +18,21 @@ import (
 // RFC 6241 specifies session-id as an integer (uint32)
 var sessionIDCounter uint32
 
+// sessionReapInterval is how often the reaper probes session channels.
+const sessionReapInterval = 15 * time.Second
+
+// sessionProbeRequest is the channel request sent to probe a session. It is
+// sent with want-reply: clients answer unknown requests with a failure
+// message, so any reply shows that the peer is still reading.
+const sessionProbeRequest = "keepalive@openssh.com"
+
+// sessionProbeTimeout bounds how long one probe waits for the reply.
+const sessionProbeTimeout = 10 * time.Second
+
+// sessionProbeFailureLimit is the number of consecutive failed probes after
+// which a session is reaped, so one slow reply does not end a session.
+const sessionProbeFailureLimit = 3
+
 // NETCONFSession represents a NETCONF session
 type NETCONFSession struct {
 	ID              string // UUID v4 (internal identifier)
@@ -35,6 +50,7 @@ type NETCONFSession struct {
 	cancel          context.CancelFunc
 	datastoreLocks  map[string]struct{} // Set of locked datastores ("candidate", "running")
 	mu              sync.RWMutex        // Protects datastoreLocks and LastUsed
+	probeFailures   int                 // Consecutive failed probes; owned by the reaper
 }
 
 // SessionManager manages NETCONF sessions
@@ -48,6 +64,9 @@ type SessionManager struct {
 	cleanup        *time.Ticker
 	cleanupDone    chan struct{}
 	cleanupStopped sync.Once
+	reaped         uint64 // Sessions closed by the reaper (atomic)
+	reaping        atomic.Bool
+	probeTimeout   time.Duration // Zero means sessionProbeTimeout
 	log            *logger.Logger
 }
 
@@ -106,7 +125,7 @@ func (sm *SessionManager) Create(username, role string, conn ssh.Conn, channel s
 
 	session := &NETCONFSession{
 		ID:              uuid.New().String(),
-		NumericID:       atomic.AddUint32(&sessionIDCounter, 1),
+		NumericID:       sm.allocateNumericIDLocked(),
 		Username:        username,
 		Role:            role,
 		CreatedAt:       time.Now(),
@@ -128,6 +147,25 @@ func (sm *SessionManager) Create(username, role string, conn ssh.Conn, channel s
 	return session
 }
 
+// allocateNumericIDLocked returns the session-id for a new session.
+// Session-ids increase for the lifetime of the process and are not reused
+// when a session closes, so a kill-session or notification still naming a
+// closed session can never reach a newer one. 0 is skipped as RFC 6241
+// requires, as is any id still held by an open session should the counter
+// ever wrap.
+func (sm *SessionManager) allocateNumericIDLocked() uint32 {
+	for {
+		id := atomic.AddUint32(&sessionIDCounter, 1)
+		if id == 0 {
+			continue
+		}
+		if _, inUse := sm.numericIDIndex[id]; inUse {
+			continue
+		}
+		return id
+	}
+}
+
 func (sm *SessionManager) ensureRuntimeStateLocked() {
 	if sm.sessions == nil {
 		sm.sessions = make(map[string]*NETCONFSession)
@@ -226,6 +264,8 @@ func (sm *SessionManager) StartCleanup(ctx context.Context, wg *sync.WaitGroup)
 	sm.cleanup = ticker
 	sm.cleanupMu.Unlock()
 	defer ticker.Stop()
+	reapTicker := time.NewTicker(sessionReapInterval)
+	defer reapTicker.Stop()
 
 	sm.mu.Lock()
 	if sm.cleanupDone == nil {
@@ -242,10 +282,86 @@ func (sm *SessionManager) StartCleanup(ctx context.Context, wg *sync.WaitGroup)
 			return
 		case <-ticker.C:
 			sm.cleanupExpiredSessions(ctx)
+		case <-reapTicker.C:
+			// Probes wait for replies, so they run off this loop; a round
+			// still in progress makes the tick a no-op.
+			if sm.reaping.CompareAndSwap(false, true) {
+				go func() {
+					defer sm.reaping.Store(false)
+					sm.reapDeadSessions()
+				}()
+			}
+		}
+	}
+}
+
+// reapDeadSessions probes every session's SSH channel and closes the
+// sessions that failed sessionProbeFailureLimit probes in a row, releasing
+// their datastore locks. A dead connection otherwise keeps its session, and
+// any lock it holds, until the idle timeout expires.
+func (sm *SessionManager) reapDeadSessions() {
+	if sm == nil {
+		return
+	}
+
+	sm.mu.RLock()
+	sessions := make([]*NETCONFSession, 0, len(sm.sessions))
+	for _, session := range sm.sessions {
+		sessions = append(sessions, session)
+	}
+	sm.mu.RUnlock()
+
+	// Probe outside the lock and in parallel, so one unresponsive client
+	// delays the round by at most one probe timeout.
+	timeout := sm.probeTimeout
+	if timeout <= 0 {
+		timeout = sessionProbeTimeout
+	}
+	alive := make([]bool, len(sessions))
+	var wg sync.WaitGroup
+	for i, session := range sessions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			alive[i] = session.channelAlive(timeout)
+		}()
+	}
+	wg.Wait()
+
+	for i, session := range sessions {
+		if alive[i] {
+			session.probeFailures = 0
+			continue
+		}
+		session.probeFailures++
+		if session.probeFailures < sessionProbeFailureLimit {
+			continue
+		}
+		sm.mu.Lock()
+		if sm.sessions[session.ID] != session {
+			sm.mu.Unlock()
+			continue
 		}
+		delete(sm.sessions, session.ID)
+		delete(sm.numericIDIndex, session.NumericID)
+		sm.mu.Unlock()
+
+		atomic.AddUint64(&sm.reaped, 1)
+		if sm.log != nil {
+			sm.log.Info("Session reaped (channel closed)", "id", session.ID, "user", session.Username)
+		}
+		sm.closeSession(session, "channel closed")
 	}
 }
 
+// ReapedCount returns the number of sessions closed by the reaper.
+func (sm *SessionManager) ReapedCount() uint64 {
+	if sm == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&sm.reaped)
+}
+
 // cleanupExpiredSessions removes expired sessions
 func (sm *SessionManager) cleanupExpiredSessions(ctx context.Context) {
 	if sm == nil {
@@ -458,6 +574,29 @@ func (s *NETCONFSession) UpdateLastUsed() {
 	s.LastUsed = time.Now()
 }
 
+// channelAlive reports whether the session's SSH channel answered a probe
+// within timeout. Sessions without a channel are treated as alive.
+func (s *NETCONFSession) channelAlive(timeout time.Duration) bool {
+	if s == nil || s.channel == nil {
+		return true
+	}
+	// SendRequest blocks until the reply arrives or the channel closes, so
+	// a probe that times out returns once the session is closed.
+	result := make(chan error, 1)
+	go func() {
+		_, err := s.channel.SendRequest(sessionProbeRequest, true, nil)
+		result <- err
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-result:
+		return err == nil
+	case <-timer.C:
+		return false
+	}
+}
+
 // RemoteAddr returns the remote address (for logging)
 func (s *NETCONFSession) RemoteAddr() string {
 	if s == nil {
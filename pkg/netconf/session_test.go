@@ -3,11 +3,15 @@ package netconf
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"golang.org/x/crypto/ssh"
+
 	"github.com/akam1o/arca-router/pkg/logger"
 )
 
@@ -72,6 +76,53 @@ func TestSessionManagerCountsScaledSessions(t *testing.T) {
 	}
 }
 
+func TestSessionManagerNeverReusesNumericIDs(t *testing.T) {
+	sm := newTestSessionManager(nil)
+
+	const sessionCount = 1000
+	seen := make(map[uint32]struct{}, sessionCount)
+	var previous uint32
+	for i := 0; i < sessionCount; i++ {
+		session := sm.Create("alice", RoleOperator, nil, nil)
+		if session.NumericID == 0 {
+			t.Fatalf("session %d got numeric session ID 0", i)
+		}
+		if _, ok := seen[session.NumericID]; ok {
+			t.Fatalf("session %d reused numeric session ID %d", i, session.NumericID)
+		}
+		if session.NumericID <= previous {
+			t.Fatalf("session %d numeric ID %d not above previous %d", i, session.NumericID, previous)
+		}
+		seen[session.NumericID] = struct{}{}
+		previous = session.NumericID
+
+		if err := sm.CloseSessionByNumericID(session.NumericID); err != nil {
+			t.Fatalf("CloseSessionByNumericID(%d) error = %v", session.NumericID, err)
+		}
+		// A kill-session that arrives after the close must not find a
+		// session, even once new sessions have been created.
+		if err := sm.CloseSessionByNumericID(session.NumericID); err == nil {
+			t.Fatalf("CloseSessionByNumericID(%d) on closed session error = nil", session.NumericID)
+		}
+	}
+}
+
+func TestSessionManagerNumericIDWrapSkipsZeroAndOpenSessions(t *testing.T) {
+	saved := atomic.LoadUint32(&sessionIDCounter)
+	defer atomic.StoreUint32(&sessionIDCounter, saved)
+
+	sm := newTestSessionManager(nil)
+	// An open session holds id 1 and the counter is about to wrap, so the
+	// next ids would be 0 and then the open session's id.
+	sm.numericIDIndex[1] = &NETCONFSession{NumericID: 1}
+	atomic.StoreUint32(&sessionIDCounter, ^uint32(0))
+
+	session := sm.Create("bob", RoleOperator, nil, nil)
+	if session.NumericID != 2 {
+		t.Fatalf("NumericID after wrap = %d, want 2 (skipping 0 and the open session)", session.NumericID)
+	}
+}
+
 func TestSessionManagerCloseAllClearsScaledSessionsAndLocks(t *testing.T) {
 	store := &recordingLockReleaser{}
 	sm := newTestSessionManager(store)
@@ -275,6 +326,79 @@ func TestNETCONFSessionNilReceiverMethods(t *testing.T) {
 	}
 }
 
+func TestSessionManagerReapsSessionsWithDeadChannels(t *testing.T) {
+	store := &recordingLockReleaser{}
+	sm := newTestSessionManager(store)
+
+	dead := sm.Create("alice", RoleAdmin, nil, &probeChannel{err: io.EOF})
+	dead.AddLock("candidate")
+	alive := &probeChannel{}
+	healthy := sm.Create("bob", RoleAdmin, nil, alive)
+
+	for range sessionProbeFailureLimit - 1 {
+		sm.reapDeadSessions()
+	}
+	if _, ok := sm.Get(dead.ID); !ok {
+		t.Fatalf("session reaped before %d failed probes", sessionProbeFailureLimit)
+	}
+	sm.reapDeadSessions()
+
+	if _, ok := sm.Get(dead.ID); ok {
+		t.Fatal("session with a dead channel was not reaped")
+	}
+	if got := store.releaseCount(); got != 1 {
+		t.Fatalf("released locks = %d, want 1", got)
+	}
+	if locks := dead.GetLocks(); len(locks) != 0 {
+		t.Fatalf("reaped session locks = %#v, want none", locks)
+	}
+	select {
+	case <-dead.ctx.Done():
+	default:
+		t.Fatal("reaped session context is still active")
+	}
+	if _, ok := sm.Get(healthy.ID); !ok {
+		t.Fatal("session with a live channel was reaped")
+	}
+	if got := alive.probeCount(); got != sessionProbeFailureLimit {
+		t.Fatalf("live channel probes = %d, want %d", got, sessionProbeFailureLimit)
+	}
+	if !alive.lastWantReply {
+		t.Fatal("probe sent without want-reply")
+	}
+	if got := sm.ReapedCount(); got != 1 {
+		t.Fatalf("ReapedCount() = %d, want 1", got)
+	}
+}
+
+func TestSessionManagerReapsSessionsWhoseProbesTimeOut(t *testing.T) {
+	sm := newTestSessionManager(&recordingLockReleaser{})
+	sm.probeTimeout = 10 * time.Millisecond
+
+	stuck := &probeChannel{release: make(chan struct{})}
+	defer close(stuck.release)
+	session := sm.Create("alice", RoleAdmin, nil, stuck)
+	flaky := &probeChannel{err: io.EOF}
+	recovering := sm.Create("bob", RoleAdmin, nil, flaky)
+
+	for i := range sessionProbeFailureLimit {
+		if i == sessionProbeFailureLimit-1 {
+			// A successful probe resets the failure count.
+			flaky.mu.Lock()
+			flaky.err = nil
+			flaky.mu.Unlock()
+		}
+		sm.reapDeadSessions()
+	}
+
+	if _, ok := sm.Get(session.ID); ok {
+		t.Fatal("session whose probes time out was not reaped")
+	}
+	if _, ok := sm.Get(recovering.ID); !ok {
+		t.Fatal("session that answered a probe was reaped")
+	}
+}
+
 func newTestSessionManager(store DatastoreLockReleaser) *SessionManager {
 	cfg := DefaultSSHConfig()
 	cfg.IdleTimeout = time.Hour
@@ -300,3 +424,35 @@ func (r *recordingLockReleaser) releaseCount() int {
 	defer r.mu.Unlock()
 	return len(r.releases)
 }
+
+// probeChannel is an ssh.Channel whose requests fail with err, or block
+// until release is closed when it is set.
+type probeChannel struct {
+	ssh.Channel
+	err           error
+	release       chan struct{}
+	mu            sync.Mutex
+	probes        int
+	lastWantReply bool
+}
+
+func (c *probeChannel) SendRequest(name string, wantReply bool, payload []byte) (bool, error) {
+	c.mu.Lock()
+	c.probes++
+	c.lastWantReply = wantReply
+	c.mu.Unlock()
+	if c.release != nil {
+		<-c.release
+	}
+	return false, c.err
+}
+
+func (c *probeChannel) probeCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.probes
+}
+
+func (c *probeChannel) Close() error {
+	return nil
+}
@@ -33,7 +33,7 @@ const sshHandshakeTimeout = 30 * time.Second
 // Create a new instance if restart is needed.
 type SSHServer struct {
 	config        *SSHConfig
-	listener      net.Listener
+	listeners     []net.Listener
 	sessionMgr    *SessionManager
 	userDB        *UserDatabase
 	datastore     datastore.Datastore
@@ -55,6 +55,8 @@ type SSHServer struct {
 	failedHandshakes     uint64 // Failed SSH handshakes (use atomic)
 	activeConnections    int32  // Currently active SSH connections (use atomic)
 	isListening          int32  // Whether server is actively accepting (use atomic: 0=no, 1=yes)
+	isDraining           int32  // Whether Drain is waiting for sessions to close (use atomic: 0=no, 1=yes)
+	replyWriteTimeouts   uint64 // Sessions closed because a reply write timed out (use atomic)
 }
 
 // NewSSHServer creates a new SSH server instance
@@ -80,7 +82,7 @@ func NewSSHServer(config *SSHConfig) (*SSHServer, error) {
 	}
 
 	// Create user database
-	userDB, err := NewUserDatabase(config.UserDBPath, log)
+	userDB, err := NewUserDatabaseWithOptions(config.UserDBPath, log, config.UserDBSQLite)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user database: %w", err)
 	}
@@ -128,6 +130,9 @@ func NewSSHServer(config *SSHConfig) (*SSHServer, error) {
 
 	// Create NETCONF server
 	netconfServer := NewServer(ds, sessionMgr)
+	netconfServer.jsonEncodingDisabled = config.DisableJSONEncoding
+	netconfServer.SetDatastoreRetryPolicy(config.DatastoreRetry)
+	netconfServer.SetMessageSizeLimits(config.MessageSizeLimits)
 
 	// Create rate limiter for brute force protection
 	rateLimiter := NewRateLimiter(config)
@@ -242,6 +247,14 @@ func (s *SSHServer) SetCommitHook(h CommitHook) {
 	}
 }
 
+// UserDatabase returns the NETCONF user database used for authentication.
+func (s *SSHServer) UserDatabase() *UserDatabase {
+	if s == nil {
+		return nil
+	}
+	return s.userDB
+}
+
 // SetOperationalStateProvider installs a live-state source for <get> replies.
 func (s *SSHServer) SetOperationalStateProvider(provider OperationalStateProvider) {
 	if s != nil && s.netconfServer != nil {
@@ -260,28 +273,44 @@ func (s *SSHServer) Start(ctx context.Context) error {
 		s.mu.Unlock()
 		return fmt.Errorf("server stopped")
 	}
-	if s.listener != nil {
+	if len(s.listeners) > 0 {
 		s.mu.Unlock()
 		return fmt.Errorf("server already started")
 	}
 
-	listener, err := net.Listen("tcp", s.config.ListenAddr)
-	if err != nil {
-		s.mu.Unlock()
-		return fmt.Errorf("failed to listen on %s: %w", s.config.ListenAddr, err)
+	addrs := s.config.listenAddrs()
+	listeners := make([]net.Listener, 0, len(addrs))
+	for _, addr := range addrs {
+		listener, err := net.Listen(listenNetwork(addr), addr)
+		if err != nil {
+			for _, opened := range listeners {
+				_ = opened.Close()
+			}
+			s.mu.Unlock()
+			return fmt.Errorf("failed to listen on %s: %w", addr, err)
+		}
+		listeners = append(listeners, listener)
 	}
-	s.listener = listener
+	s.listeners = listeners
 	atomic.StoreInt32(&s.isListening, 1)
 
 	// Start goroutines while holding the lifecycle lock so Stop cannot wait
 	// before all startup workers have been registered.
 	s.wg.Add(1)
 	go s.sessionMgr.StartCleanup(ctx, &s.wg)
-	s.wg.Add(1)
-	go s.acceptConnections(ctx)
+	for _, listener := range listeners {
+		s.wg.Add(1)
+		go s.acceptConnections(ctx, listener)
+	}
 	s.mu.Unlock()
 
-	s.log.Info("SSH server started", "addr", s.config.ListenAddr)
+	if s.netconfServer != nil {
+		s.netconfServer.resumeConfirmedCommit(ctx)
+	}
+
+	for _, listener := range listeners {
+		s.log.Info("SSH server started", "addr", listener.Addr().String())
+	}
 
 	return nil
 }
@@ -296,10 +325,14 @@ func (s *SSHServer) Stop() error {
 		// Mark as not listening
 		atomic.StoreInt32(&s.isListening, 0)
 
+		// Keep a pending confirmed commit across the restart instead of
+		// rolling it back while sessions are torn down.
+		s.netconfServer.stopConfirmedCommitTimer()
+
 		s.mu.Lock()
 		s.stopped = true
-		listener := s.listener
-		s.listener = nil
+		listeners := s.listeners
+		s.listeners = nil
 		activeConns := make([]net.Conn, 0, len(s.activeConns))
 		for conn := range s.activeConns {
 			activeConns = append(activeConns, conn)
@@ -311,10 +344,10 @@ func (s *SSHServer) Stop() error {
 			close(s.done)
 		}
 
-		if listener != nil {
+		for _, listener := range listeners {
 			if err := listener.Close(); err != nil {
 				if s.log != nil {
-					s.log.Error("Failed to close listener", "error", err)
+					s.log.Error("Failed to close listener", "addr", listener.Addr().String(), "error", err)
 				}
 			}
 		}
@@ -361,6 +394,51 @@ func (s *SSHServer) Stop() error {
 	return nil
 }
 
+// DefaultDrainTimeout bounds how long Drain waits for existing connections
+// to close before it stops the server.
+const DefaultDrainTimeout = 30 * time.Second
+
+// Drain stops accepting new connections and lets the existing connections
+// finish. It closes the listeners, waits until every connection has closed
+// or timeout passes, and then stops the server as Stop does.
+func (s *SSHServer) Drain(timeout time.Duration) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	atomic.StoreInt32(&s.isDraining, 1)
+	atomic.StoreInt32(&s.isListening, 0)
+	listeners := s.listeners
+	s.listeners = nil
+	s.mu.Unlock()
+	defer atomic.StoreInt32(&s.isDraining, 0)
+
+	for _, listener := range listeners {
+		if err := listener.Close(); err != nil && s.log != nil {
+			s.log.Error("Failed to close listener", "addr", listener.Addr().String(), "error", err)
+		}
+	}
+	if s.log != nil {
+		s.log.Info("SSH server draining", "active_connections", atomic.LoadInt32(&s.activeConnections), "timeout", timeout)
+	}
+
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for atomic.LoadInt32(&s.activeConnections) > 0 && time.Now().Before(deadline) {
+		<-ticker.C
+	}
+	if remaining := atomic.LoadInt32(&s.activeConnections); remaining > 0 && s.log != nil {
+		s.log.Warn("Drain timeout passed, closing remaining connections", "active_connections", remaining)
+	}
+	return s.Stop()
+}
+
 func (s *SSHServer) startConnectionHandler(ctx context.Context, conn net.Conn) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -408,19 +486,10 @@ func (s *SSHServer) startNETCONFHandler(ctx context.Context, username, role stri
 	return true
 }
 
-// acceptConnections accepts incoming SSH connections
-func (s *SSHServer) acceptConnections(ctx context.Context) {
+// acceptConnections accepts incoming SSH connections on one listener
+func (s *SSHServer) acceptConnections(ctx context.Context, listener net.Listener) {
 	defer s.wg.Done()
 
-	// Capture listener locally to avoid nil reference during shutdown
-	s.mu.Lock()
-	listener := s.listener
-	s.mu.Unlock()
-
-	if listener == nil {
-		return
-	}
-
 	for {
 		select {
 		case <-s.done:
@@ -440,11 +509,14 @@ func (s *SSHServer) acceptConnections(ctx context.Context) {
 			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
 				continue
 			}
+			if errors.Is(err, net.ErrClosed) && atomic.LoadInt32(&s.isDraining) == 1 {
+				return
+			}
 			select {
 			case <-s.done:
 				return
 			default:
-				s.log.Error("Failed to accept connection", "error", err)
+				s.log.Error("Failed to accept connection", "addr", listener.Addr().String(), "error", err)
 				continue
 			}
 		}
@@ -794,13 +866,17 @@ func writeHostKeyFile(path string, data []byte) error {
 
 // ServerMetrics contains server health and performance metrics
 type ServerMetrics struct {
-	TotalConnections     uint64 // Total TCP connections accepted since server start
-	SuccessfulHandshakes uint64 // Successful SSH protocol handshakes (not authentication - NoClientAuth mode)
-	FailedHandshakes     uint64 // Failed SSH handshakes (protocol errors, not authentication)
-	ActiveConnections    int32  // Currently active SSH connections
-	ActiveSessions       int    // Currently active NETCONF sessions
-	ListenAddr           string // Server listen address
-	IsListening          bool   // Whether server is currently accepting connections (Start/Stop state)
+	TotalConnections     uint64   // Total TCP connections accepted since server start
+	SuccessfulHandshakes uint64   // Successful SSH protocol handshakes (not authentication - NoClientAuth mode)
+	FailedHandshakes     uint64   // Failed SSH handshakes (protocol errors, not authentication)
+	ActiveConnections    int32    // Currently active SSH connections
+	ActiveSessions       int      // Currently active NETCONF sessions
+	ReapedSessions       uint64   // Sessions closed because their SSH channel was dead
+	ReplyWriteTimeouts   uint64   // Sessions closed because the client stopped reading replies
+	ListenAddr           string   // First configured listen address
+	ListenAddrs          []string // Bound address of every listener, in configuration order
+	IsListening          bool     // Whether server is currently accepting connections (Start/Stop state)
+	IsDraining           bool     // Whether Drain is waiting for existing connections to close
 }
 
 // GetMetrics returns current server metrics
@@ -816,19 +892,27 @@ func (s *SSHServer) GetMetrics() ServerMetrics {
 		FailedHandshakes:     atomic.LoadUint64(&s.failedHandshakes),
 		ActiveConnections:    atomic.LoadInt32(&s.activeConnections),
 		IsListening:          atomic.LoadInt32(&s.isListening) == 1,
+		IsDraining:           atomic.LoadInt32(&s.isDraining) == 1,
+		ReplyWriteTimeouts:   atomic.LoadUint64(&s.replyWriteTimeouts),
 	}
 	if s.sessionMgr != nil {
 		metrics.ActiveSessions = s.sessionMgr.Count()
+		metrics.ReapedSessions = s.sessionMgr.ReapedCount()
 	}
 	if s.config != nil {
-		metrics.ListenAddr = s.config.ListenAddr
+		metrics.ListenAddr = s.config.listenAddrs()[0]
+	}
+	s.mu.Lock()
+	for _, listener := range s.listeners {
+		metrics.ListenAddrs = append(metrics.ListenAddrs, listener.Addr().String())
 	}
+	s.mu.Unlock()
 	return metrics
 }
 
 // HealthCheck verifies the server is healthy and operational
 // This method checks:
-// 1. Server is actively accepting connections (not stopped or failed)
+// 1. Server is actively accepting connections (not draining, stopped, or failed)
 // 2. User database is accessible and healthy
 // 3. Session count is within configured limits
 func (s *SSHServer) HealthCheck() error {
@@ -837,14 +921,17 @@ func (s *SSHServer) HealthCheck() error {
 	}
 
 	// Check if server is actively accepting connections
-	// Uses atomic flag set by Start/Stop to avoid race conditions
+	// Uses atomic flags set by Start/Drain/Stop to avoid race conditions
+	if atomic.LoadInt32(&s.isDraining) == 1 {
+		return fmt.Errorf("server is draining")
+	}
 	if atomic.LoadInt32(&s.isListening) != 1 {
 		return fmt.Errorf("server is not accepting connections")
 	}
 
 	// Verify listener is still valid
 	s.mu.Lock()
-	if s.listener == nil {
+	if len(s.listeners) == 0 {
 		s.mu.Unlock()
 		return fmt.Errorf("server listener is nil (stopped or failed)")
 	}
@@ -871,6 +958,16 @@ func (s *SSHServer) HealthCheck() error {
 	return nil
 }
 
+// serverCapabilities computes the capabilities to advertise from the SSH
+// configuration and the backends wired into the NETCONF server.
+func (s *SSHServer) serverCapabilities() ServerCapabilities {
+	caps := DefaultServerCapabilities()
+	caps.StandardXPath = s.config.AdvertiseStandardXPath
+	caps.ConfirmedCommit = s.netconfServer.supportsConfirmedCommit()
+	caps.JSONEncoding = !s.config.DisableJSONEncoding
+	return caps
+}
+
 // handleNETCONF handles NETCONF protocol over SSH channel
 func (s *SSHServer) handleNETCONF(ctx context.Context, sess *Session, channel ssh.Channel) {
 	defer func() {
@@ -878,14 +975,12 @@ func (s *SSHServer) handleNETCONF(ctx context.Context, sess *Session, channel ss
 		if err := s.sessionMgr.CloseSession(sess.ID); err != nil {
 			s.log.Error("Failed to close session", "error", err)
 		}
+		s.netconfServer.sessionTerminated(sess.ID)
 		s.log.Info("NETCONF session closed", "session", sess.ID, "user", sess.Username)
 	}()
 
 	// Phase 1: Send server hello
-	serverHello := ServerHelloWithOptions(sess.NumericID, HelloOptions{
-		AdvertiseStandardXPath: s.config.AdvertiseStandardXPath,
-		DisableStandardXPath:   !s.config.AdvertiseStandardXPath,
-	})
+	serverHello := ServerHelloWithCapabilities(sess.NumericID, s.serverCapabilities())
 	serverHelloXML, err := MarshalHello(serverHello)
 	if err != nil {
 		s.log.Error("Failed to generate server hello", "error", err)
@@ -896,7 +991,7 @@ func (s *SSHServer) handleNETCONF(ctx context.Context, sess *Session, channel ss
 	// Base version is negotiated after Hello exchange completes
 	// Create reader/writer ONCE to preserve buffered data for pipelined RPCs
 	reader := NewFramingReader(channel, "1.0")
-	writer := NewFramingWriter(channel, "1.0")
+	writer := NewFramingWriter(s.replyWriter(sess, channel), "1.0")
 
 	// Send server hello
 	if err := writer.WriteMessage(serverHelloXML); err != nil {
@@ -1001,6 +1096,19 @@ func (s *SSHServer) handleNETCONF(ctx context.Context, sess *Session, channel ss
 		// Dispatch RPC to server
 		reply := s.netconfServer.HandleRPC(ctx, sess, rpc)
 
+		// Large replies are framed as they are serialized. A failure part way
+		// through leaves a truncated message on the wire, so the session ends.
+		if reply.Streaming() {
+			if err := writer.WriteMessageStream(func(w io.Writer) error {
+				return WriteReply(w, reply)
+			}); err != nil {
+				s.log.Error("Failed to stream reply", "error", err)
+				return
+			}
+			s.log.Debug("RPC reply sent", "session", sess.ID, "message_id", rpc.MessageID)
+			continue
+		}
+
 		// Serialize and send reply
 		replyXML, err := MarshalReply(reply)
 		if err != nil {
@@ -10,6 +10,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"slices"
 	"strings"
 	"sync/atomic"
@@ -60,6 +61,55 @@ func TestSSHServerStopAfterStartFailureReleasesProcessLock(t *testing.T) {
 	assertCanAcquireSQLiteProcessLock(t, dbPath)
 }
 
+func TestSSHServerListensOnEveryAddress(t *testing.T) {
+	cfg, _ := testSSHServerConfig(t, "")
+	cfg.ListenAddrs = []string{"127.0.0.1:0", "localhost:0"}
+	server, err := NewSSHServer(cfg)
+	if err != nil {
+		t.Fatalf("NewSSHServer() error = %v", err)
+	}
+	t.Cleanup(func() { _ = server.Stop() })
+
+	if err := server.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	metrics := server.GetMetrics()
+	if len(metrics.ListenAddrs) != 2 || metrics.ListenAddrs[0] == metrics.ListenAddrs[1] {
+		t.Fatalf("ListenAddrs = %v, want two distinct listeners", metrics.ListenAddrs)
+	}
+	if metrics.ListenAddr != "127.0.0.1:0" {
+		t.Fatalf("ListenAddr = %q, want first configured address", metrics.ListenAddr)
+	}
+	for _, addr := range metrics.ListenAddrs {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatalf("Dial(%s) error = %v", addr, err)
+		}
+		defer func() { _ = conn.Close() }()
+	}
+	waitForCondition(t, time.Second, func() bool {
+		return server.GetMetrics().TotalConnections == 2
+	})
+	if err := server.HealthCheck(); err != nil {
+		t.Fatalf("HealthCheck() error = %v", err)
+	}
+}
+
+func TestListenNetworkBindsLiteralFamily(t *testing.T) {
+	for addr, want := range map[string]string{
+		"0.0.0.0:830":     "tcp4",
+		"192.0.2.1:830":   "tcp4",
+		"[::]:830":        "tcp6",
+		"[2001:db8::1]:0": "tcp6",
+		"localhost:830":   "tcp",
+		":830":            "tcp",
+	} {
+		if got := listenNetwork(addr); got != want {
+			t.Errorf("listenNetwork(%q) = %q, want %q", addr, got, want)
+		}
+	}
+}
+
 func TestSSHServerStopClosesIdlePreAuthConnection(t *testing.T) {
 	cfg, _ := testSSHServerConfig(t, "127.0.0.1:0")
 	server, err := NewSSHServer(cfg)
@@ -179,7 +229,7 @@ func TestSSHServerLifecycleMethodsNilReceiver(t *testing.T) {
 	if err := server.Start(context.Background()); err == nil {
 		t.Fatal("Start() error = nil, want uninitialized server error")
 	}
-	if metrics := server.GetMetrics(); metrics != (ServerMetrics{}) {
+	if metrics := server.GetMetrics(); !reflect.DeepEqual(metrics, ServerMetrics{}) {
 		t.Fatalf("GetMetrics() = %+v, want zero metrics", metrics)
 	}
 	if err := server.HealthCheck(); err == nil {
@@ -196,7 +246,7 @@ func TestSSHServerLifecycleMethodsZeroValue(t *testing.T) {
 	if err := server.Stop(); err != nil {
 		t.Fatalf("Stop() error = %v", err)
 	}
-	if metrics := server.GetMetrics(); metrics != (ServerMetrics{}) {
+	if metrics := server.GetMetrics(); !reflect.DeepEqual(metrics, ServerMetrics{}) {
 		t.Fatalf("GetMetrics() = %+v, want zero metrics", metrics)
 	}
 	if err := server.HealthCheck(); err == nil {
@@ -615,10 +665,10 @@ func testSSHServerListenAddr(t *testing.T, server *SSHServer) string {
 
 	server.mu.Lock()
 	defer server.mu.Unlock()
-	if server.listener == nil {
+	if len(server.listeners) == 0 {
 		t.Fatal("server listener is nil")
 	}
-	return server.listener.Addr().String()
+	return server.listeners[0].Addr().String()
 }
 
 func waitForCondition(t *testing.T, timeout time.Duration, condition func() bool) {
@@ -645,3 +695,113 @@ func assertCanAcquireSQLiteProcessLock(t *testing.T, dbPath string) {
 		t.Fatalf("ProcessLock Close() error = %v", err)
 	}
 }
+
+func TestSSHServerDrainRefusesNewConnectionsWhileSessionFinishes(t *testing.T) {
+	cfg, _ := testSSHServerConfig(t, "127.0.0.1:0")
+	server, err := NewSSHServer(cfg)
+	if err != nil {
+		t.Fatalf("NewSSHServer() error = %v", err)
+	}
+	t.Cleanup(func() { _ = server.Stop() })
+	passwordHash, err := HashPassword("drain-test-Passw0rd!")
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+	if err := server.UserDatabase().CreateUser("alice", passwordHash, RoleAdmin); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if err := server.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	addr := testSSHServerListenAddr(t, server)
+
+	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            "alice",
+		Auth:            []ssh.AuthMethod{ssh.Password("drain-test-Passw0rd!")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("ssh.Dial() error = %v", err)
+	}
+	defer func() { _ = client.Close() }()
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		t.Fatalf("StdinPipe() error = %v", err)
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		t.Fatalf("StdoutPipe() error = %v", err)
+	}
+	if err := session.RequestSubsystem("netconf"); err != nil {
+		t.Fatalf("RequestSubsystem() error = %v", err)
+	}
+	reader := NewFramingReader(stdout, "1.0")
+	if _, err := reader.ReadMessage(); err != nil {
+		t.Fatalf("read server hello error = %v", err)
+	}
+	writeEOM := func(msg string) {
+		t.Helper()
+		if _, err := io.WriteString(stdin, msg+"]]>]]>"); err != nil {
+			t.Fatalf("write %q error = %v", msg, err)
+		}
+	}
+	writeEOM(`<hello xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><capabilities><capability>urn:ietf:params:netconf:base:1.0</capability></capabilities></hello>`)
+	waitForCondition(t, time.Second, func() bool {
+		return server.GetMetrics().ActiveSessions == 1
+	})
+
+	drained := make(chan error, 1)
+	go func() {
+		drained <- server.Drain(10 * time.Second)
+	}()
+	waitForCondition(t, time.Second, func() bool {
+		return server.GetMetrics().IsDraining
+	})
+	if err := server.HealthCheck(); err == nil || !strings.Contains(err.Error(), "draining") {
+		t.Fatalf("HealthCheck() during drain error = %v, want draining", err)
+	}
+	if metrics := server.GetMetrics(); metrics.IsListening {
+		t.Fatalf("GetMetrics().IsListening = true during drain")
+	}
+	if conn, err := net.DialTimeout("tcp", addr, time.Second); err == nil {
+		_ = conn.Close()
+		t.Fatal("Dial() during drain succeeded, want connection refused")
+	}
+
+	writeEOM(`<rpc message-id="1" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><lock><target><candidate/></target></lock></rpc>`)
+	reply, err := reader.ReadMessage()
+	if err != nil {
+		t.Fatalf("read lock reply during drain error = %v", err)
+	}
+	if !strings.Contains(string(reply), "<ok/>") {
+		t.Fatalf("lock reply during drain = %s, want ok", reply)
+	}
+	select {
+	case err := <-drained:
+		t.Fatalf("Drain() returned with a session still open: %v", err)
+	default:
+	}
+
+	writeEOM(`<rpc message-id="2" xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><close-session/></rpc>`)
+	if _, err := reader.ReadMessage(); err != nil {
+		t.Fatalf("read close-session reply error = %v", err)
+	}
+	_ = session.Close()
+	_ = client.Close()
+	select {
+	case err := <-drained:
+		if err != nil {
+			t.Fatalf("Drain() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Drain() did not return after the last session closed")
+	}
+	if metrics := server.GetMetrics(); metrics.IsDraining || metrics.IsListening {
+		t.Fatalf("GetMetrics() after drain = %+v, want stopped", metrics)
+	}
+}
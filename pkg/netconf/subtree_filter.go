@@ -0,0 +1,224 @@
+package netconf
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/antchfx/xmlquery"
+)
+
+// subtreeFilterMatch accumulates the data nodes selected by a subtree filter.
+// include marks nodes that are copied with their selected children only;
+// fullSubtree marks nodes that are copied with all descendants.
+type subtreeFilterMatch struct {
+	include     map[*xmlquery.Node]struct{}
+	fullSubtree map[*xmlquery.Node]struct{}
+}
+
+func newSubtreeFilterMatch() *subtreeFilterMatch {
+	return &subtreeFilterMatch{
+		include:     map[*xmlquery.Node]struct{}{},
+		fullSubtree: map[*xmlquery.Node]struct{}{},
+	}
+}
+
+func (m *subtreeFilterMatch) merge(other *subtreeFilterMatch) {
+	for node := range other.include {
+		m.include[node] = struct{}{}
+	}
+	for node := range other.fullSubtree {
+		m.fullSubtree[node] = struct{}{}
+	}
+}
+
+func (m *subtreeFilterMatch) selectSubtree(node *xmlquery.Node) {
+	m.include[node] = struct{}{}
+	m.fullSubtree[node] = struct{}{}
+}
+
+func usesSubtreeContentFilter(filter *Filter) bool {
+	if filter == nil {
+		return false
+	}
+	switch normalizedFilterType(filter) {
+	case "", "subtree":
+	default:
+		return false
+	}
+	return len(bytes.TrimSpace(filter.Content)) > 0
+}
+
+// applySubtreeContentFilter shapes a <data> payload with RFC 6241 section 6
+// subtree filtering semantics. Containment nodes recurse, selection nodes
+// (empty leaves) select matching data subtrees, and content-match nodes (leaves
+// with text) restrict their parent to data instances whose child has an equal
+// value. Section writers only pre-select top-level elements, so this pass is
+// what narrows list entries and leaves.
+func applySubtreeContentFilter(rpcName string, xmlData []byte, filter *Filter) ([]byte, error) {
+	if !usesSubtreeContentFilter(filter) {
+		return append([]byte(nil), xmlData...), nil
+	}
+	filterRoot, err := parseSubtreeFilterTree(filter)
+	if err != nil {
+		return nil, ErrInvalidFilter(rpcName, fmt.Sprintf("invalid subtree filter XML: %v", err))
+	}
+	doc, err := parseSubtreeFilterData(xmlData)
+	if err != nil {
+		return nil, ErrOperationFailed(fmt.Sprintf("subtree filter input is not valid XML: %v", err))
+	}
+	dataRoot := experimentalXPathDataRoot(doc)
+	if dataRoot == nil {
+		return nil, ErrOperationFailed("subtree filter failed to locate NETCONF data root")
+	}
+
+	match := newSubtreeFilterMatch()
+	match.include[dataRoot] = struct{}{}
+	for _, filterNode := range subtreeFilterChildElements(filterRoot) {
+		for _, dataNode := range subtreeFilterChildElements(dataRoot) {
+			if !subtreeFilterNodeNameMatches(filterNode, dataNode) {
+				continue
+			}
+			candidate := newSubtreeFilterMatch()
+			if matchSubtreeFilterNode(filterNode, dataNode, candidate) {
+				match.merge(candidate)
+			}
+		}
+	}
+
+	dataClone := cloneExperimentalXPathNode(dataRoot, match.include, match.fullSubtree, false)
+	if dataClone == nil {
+		return []byte{}, nil
+	}
+	var buf bytes.Buffer
+	for child := dataClone.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != xmlquery.ElementNode {
+			continue
+		}
+		if err := child.WriteWithOptions(&buf, xmlquery.WithOutputSelf(), xmlquery.WithoutComments()); err != nil {
+			return nil, ErrOperationFailed(fmt.Sprintf("subtree filter rendering failed: %v", err))
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// matchSubtreeFilterNode evaluates filterNode against dataNode, whose names
+// already match, and records the selected output in match.
+func matchSubtreeFilterNode(filterNode, dataNode *xmlquery.Node, match *subtreeFilterMatch) bool {
+	filterChildren := subtreeFilterChildElements(filterNode)
+	if len(filterChildren) == 0 {
+		match.selectSubtree(dataNode)
+		return true
+	}
+
+	var contentMatches, selections, containments []*xmlquery.Node
+	for _, child := range filterChildren {
+		switch {
+		case len(subtreeFilterChildElements(child)) > 0:
+			containments = append(containments, child)
+		case strings.TrimSpace(child.InnerText()) != "":
+			contentMatches = append(contentMatches, child)
+		default:
+			selections = append(selections, child)
+		}
+	}
+
+	dataChildren := subtreeFilterChildElements(dataNode)
+	var matchedContent []*xmlquery.Node
+	for _, contentMatch := range contentMatches {
+		want := strings.TrimSpace(contentMatch.InnerText())
+		found := false
+		for _, dataChild := range dataChildren {
+			if subtreeFilterNodeNameMatches(contentMatch, dataChild) && strings.TrimSpace(dataChild.InnerText()) == want {
+				matchedContent = append(matchedContent, dataChild)
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	// A filter made only of content-match nodes selects the whole instance.
+	if len(selections) == 0 && len(containments) == 0 {
+		match.selectSubtree(dataNode)
+		return true
+	}
+
+	local := newSubtreeFilterMatch()
+	local.include[dataNode] = struct{}{}
+	for _, dataChild := range matchedContent {
+		local.selectSubtree(dataChild)
+	}
+	selected := false
+	for _, dataChild := range dataChildren {
+		for _, selection := range selections {
+			if subtreeFilterNodeNameMatches(selection, dataChild) {
+				local.selectSubtree(dataChild)
+				selected = true
+			}
+		}
+		for _, containment := range containments {
+			if !subtreeFilterNodeNameMatches(containment, dataChild) {
+				continue
+			}
+			candidate := newSubtreeFilterMatch()
+			if matchSubtreeFilterNode(containment, dataChild, candidate) {
+				local.merge(candidate)
+				selected = true
+			}
+		}
+	}
+	if !selected && len(matchedContent) == 0 {
+		return false
+	}
+	match.merge(local)
+	return true
+}
+
+func subtreeFilterNodeNameMatches(filterNode, dataNode *xmlquery.Node) bool {
+	element := subtreeFilterElement{LocalName: filterNode.Data, Namespace: filterNode.NamespaceURI}
+	return element.matches(xml.Name{Space: dataNode.NamespaceURI, Local: dataNode.Data})
+}
+
+func subtreeFilterChildElements(node *xmlquery.Node) []*xmlquery.Node {
+	var children []*xmlquery.Node
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == xmlquery.ElementNode {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func parseSubtreeFilterTree(filter *Filter) (*xmlquery.Node, error) {
+	var wrapped bytes.Buffer
+	wrapped.WriteString("<filter")
+	writeNamespaceDeclarationAttrs(&wrapped, collectNamespaceAttrs(filter.InheritedAttrs, filter.Attrs), map[string]string{})
+	wrapped.WriteByte('>')
+	wrapped.Write(filter.Content)
+	wrapped.WriteString("</filter>")
+
+	doc, err := xmlquery.Parse(bytes.NewReader(wrapped.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	for node := doc.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type == xmlquery.ElementNode && node.Data == "filter" {
+			return node, nil
+		}
+	}
+	return nil, fmt.Errorf("missing filter root")
+}
+
+func parseSubtreeFilterData(xmlData []byte) (*xmlquery.Node, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`<data xmlns="`)
+	buf.WriteString(NetconfBaseNS)
+	buf.WriteString(`">`)
+	buf.Write(xmlData)
+	buf.WriteString(`</data>`)
+	return xmlquery.Parse(bytes.NewReader(buf.Bytes()))
+}
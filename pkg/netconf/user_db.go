@@ -3,6 +3,7 @@ package netconf
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/akam1o/arca-router/pkg/audit"
 	"github.com/akam1o/arca-router/pkg/auth"
+	"github.com/akam1o/arca-router/pkg/datastore"
 	"github.com/akam1o/arca-router/pkg/logger"
 )
 
@@ -28,6 +30,12 @@ const dummyPasswordHash = "$argon2id$v=19$m=65536,t=3,p=4$AAAAAAAAAAAAAAAAAAAAAA
 
 var verifyPasswordHash = auth.VerifyPassword
 
+// Lookup errors returned by UserDatabase.
+var (
+	ErrUserNotFound      = errors.New("user not found")
+	ErrPublicKeyNotFound = errors.New("public key not found")
+)
+
 // Role constants for user authorization
 const (
 	RoleAdmin    = "admin"
@@ -37,10 +45,11 @@ const (
 
 // UserDatabase manages user authentication data
 type UserDatabase struct {
-	db          *sql.DB
-	path        string
-	log         *logger.Logger
-	auditLogger *audit.Logger // Optional: for audit trail to datastore
+	db           *sql.DB
+	path         string
+	log          *logger.Logger
+	auditLogger  *audit.Logger // Optional: for audit trail to datastore
+	checkpointer *datastore.SQLiteWALCheckpointer
 }
 
 // User represents a user account
@@ -53,8 +62,19 @@ type User struct {
 	UpdatedAt    int64
 }
 
+// userDBDefaultSynchronous trades a little durability for faster
+// authentication writes; the user database is small and easy to rebuild.
+const userDBDefaultSynchronous = "NORMAL"
+
 // NewUserDatabase creates a new user database connection
 func NewUserDatabase(path string, log *logger.Logger) (*UserDatabase, error) {
+	return NewUserDatabaseWithOptions(path, log, datastore.SQLiteOptions{})
+}
+
+// NewUserDatabaseWithOptions creates a user database connection with the
+// given SQLite tuning. Unset options use the datastore defaults, except that
+// synchronous defaults to NORMAL.
+func NewUserDatabaseWithOptions(path string, log *logger.Logger, opts datastore.SQLiteOptions) (*UserDatabase, error) {
 	if log == nil {
 		log = logger.New("netconf-userdb", logger.DefaultConfig())
 	}
@@ -62,12 +82,21 @@ func NewUserDatabase(path string, log *logger.Logger) (*UserDatabase, error) {
 	if err := validateUserDatabasePath(path); err != nil {
 		return nil, err
 	}
+	opts, err := opts.WithDefaults(userDBDefaultSynchronous)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user database options: %w", err)
+	}
+	dsn, err := datastore.SQLiteDSN(path, opts, userDBDefaultSynchronous, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user database options: %w", err)
+	}
 	if err := prepareSecureUserDatabaseFile(path); err != nil {
 		return nil, err
 	}
 
-	// Open database
-	db, err := sql.Open("sqlite3", path)
+	// Open database. WAL, synchronous, foreign keys, and busy timeout are
+	// applied per connection through the DSN.
+	db, err := sql.Open("sqlite3", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -77,22 +106,6 @@ func NewUserDatabase(path string, log *logger.Logger) (*UserDatabase, error) {
 	db.SetMaxIdleConns(5)            // Maximum number of idle connections
 	db.SetConnMaxLifetime(time.Hour) // Maximum connection lifetime
 
-	// Set SQLite pragmas
-	pragmas := []string{
-		"PRAGMA journal_mode=WAL",
-		"PRAGMA synchronous=NORMAL",
-		"PRAGMA foreign_keys=ON",
-		"PRAGMA busy_timeout=5000",
-	}
-	for _, pragma := range pragmas {
-		if _, err := db.Exec(pragma); err != nil {
-			if closeErr := db.Close(); closeErr != nil {
-				_ = closeErr
-			}
-			return nil, fmt.Errorf("failed to set pragma: %w", err)
-		}
-	}
-
 	udb := &UserDatabase{
 		db:   db,
 		path: path,
@@ -113,6 +126,7 @@ func NewUserDatabase(path string, log *logger.Logger) (*UserDatabase, error) {
 		return nil, err
 	}
 
+	udb.checkpointer = datastore.StartSQLiteWALCheckpointer(db, opts.WALCheckpointInterval)
 	return udb, nil
 }
 
@@ -374,7 +388,7 @@ func (udb *UserDatabase) GetUser(username string) (*User, error) {
 		&enabled,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("user not found: %s", username)
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, username)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
@@ -429,7 +443,7 @@ func (udb *UserDatabase) UpdateUser(username, passwordHash, role string, enabled
 		return fmt.Errorf("failed to check rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("user not found: %s", username)
+		return fmt.Errorf("%w: %s", ErrUserNotFound, username)
 	}
 
 	udb.safeLog().Info("User updated", "username", username)
@@ -454,7 +468,7 @@ func (udb *UserDatabase) DeleteUser(username string) error {
 		return fmt.Errorf("failed to check rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("user not found: %s", username)
+		return fmt.Errorf("%w: %s", ErrUserNotFound, username)
 	}
 
 	udb.safeLog().Info("User deleted", "username", username)
@@ -723,6 +737,7 @@ func (udb *UserDatabase) Close() error {
 	if udb == nil {
 		return nil
 	}
+	udb.checkpointer.Stop()
 	if udb.db != nil {
 		return udb.db.Close()
 	}
@@ -749,7 +764,7 @@ func (udb *UserDatabase) AddPublicKey(username, algorithm, keyData, fingerprint,
 
 	// Verify user exists
 	if _, err := udb.GetUser(username); err != nil {
-		return fmt.Errorf("user not found: %s", username)
+		return fmt.Errorf("%w: %s", ErrUserNotFound, username)
 	}
 
 	now := time.Now().Unix()
@@ -788,7 +803,7 @@ func (udb *UserDatabase) GetPublicKey(fingerprint string) (*PublicKeyRecord, err
 		&record.CreatedAt,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("public key not found: %s", fingerprint)
+		return nil, fmt.Errorf("%w: %s", ErrPublicKeyNotFound, fingerprint)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get public key: %w", err)
@@ -855,7 +870,7 @@ func (udb *UserDatabase) RemovePublicKey(fingerprint string) error {
 		return fmt.Errorf("failed to check rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("public key not found: %s", fingerprint)
+		return fmt.Errorf("%w: %s", ErrPublicKeyNotFound, fingerprint)
 	}
 
 	udb.safeLog().Info("Public key removed", "fingerprint", fingerprint)
@@ -880,7 +895,7 @@ func (udb *UserDatabase) UpdatePublicKeyStatus(fingerprint string, enabled bool)
 		return fmt.Errorf("failed to check rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("public key not found: %s", fingerprint)
+		return fmt.Errorf("%w: %s", ErrPublicKeyNotFound, fingerprint)
 	}
 
 	udb.safeLog().Info("Public key status updated", "fingerprint", fingerprint, "enabled", enabled)
@@ -4,8 +4,10 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/akam1o/arca-router/pkg/auth"
+	"github.com/akam1o/arca-router/pkg/datastore"
 	"github.com/akam1o/arca-router/pkg/logger"
 )
 
@@ -101,6 +103,31 @@ func TestNewUserDatabaseDefaultsNilLogger(t *testing.T) {
 	}
 }
 
+func TestNewUserDatabaseWithOptionsSetsBusyTimeout(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "users.db")
+
+	userDB, err := NewUserDatabaseWithOptions(dbPath, nil, datastore.SQLiteOptions{BusyTimeout: 20 * time.Second})
+	if err != nil {
+		t.Fatalf("NewUserDatabaseWithOptions() error = %v", err)
+	}
+	t.Cleanup(func() { _ = userDB.Close() })
+
+	var busyTimeout int
+	if err := userDB.db.QueryRow("PRAGMA busy_timeout").Scan(&busyTimeout); err != nil {
+		t.Fatalf("query busy_timeout pragma: %v", err)
+	}
+	if busyTimeout != 20000 {
+		t.Fatalf("PRAGMA busy_timeout = %d, want 20000", busyTimeout)
+	}
+	var synchronous string
+	if err := userDB.db.QueryRow("PRAGMA synchronous").Scan(&synchronous); err != nil {
+		t.Fatalf("query synchronous pragma: %v", err)
+	}
+	if synchronous != "1" {
+		t.Fatalf("PRAGMA synchronous = %q, want 1 (NORMAL)", synchronous)
+	}
+}
+
 func TestUserDatabaseLifecycleMethodsNilReceiver(t *testing.T) {
 	var userDB *UserDatabase
 
@@ -34,90 +34,146 @@ const (
 // ConfigToXML converts internal config to NETCONF <data> content with optional filtering
 // This implements Phase 2 Step 3: XML↔Config Conversion
 func ConfigToXML(cfg *config.Config, filter *Filter) ([]byte, error) {
+	return ConfigToXMLWithLimit(cfg, filter, MaxXMLSize)
+}
+
+// ConfigToXMLWithLimit is ConfigToXML with a caller-chosen size limit for the
+// generated document.
+func ConfigToXMLWithLimit(cfg *config.Config, filter *Filter, maxSize int) ([]byte, error) {
 	if cfg == nil {
 		return []byte{}, nil
 	}
 
 	var buf bytes.Buffer
+	if err := WriteConfigXMLWithLimit(&buf, cfg, filter, maxSize); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteConfigXML streams NETCONF <data> content for cfg to w. Output is
+// counted as it is produced and serialization stops as soon as MaxXMLSize is
+// exceeded, returning the size-limit rpc-error; w may then hold a truncated
+// prefix of the document.
+func WriteConfigXML(w io.Writer, cfg *config.Config, filter *Filter) error {
+	return WriteConfigXMLWithLimit(w, cfg, filter, MaxXMLSize)
+}
+
+// WriteConfigXMLWithLimit is WriteConfigXML with a caller-chosen size limit
+// in place of MaxXMLSize.
+func WriteConfigXMLWithLimit(w io.Writer, cfg *config.Config, filter *Filter, maxSize int) error {
+	if cfg == nil {
+		return nil
+	}
+
+	buf := newXMLStreamWriter(w, maxSize)
+
+	// Configuration groups
+	if len(cfg.Groups) > 0 && (filter == nil || filterMatches(filter, "groups")) {
+		if err := buf.check("groups", writeGroupsXML(buf, cfg.Groups)); err != nil {
+			return err
+		}
+	}
 
 	// System configuration
 	if cfg.System != nil && (filter == nil || filterMatches(filter, "system")) {
-		if err := writeSystemXML(&buf, cfg.System); err != nil {
-			return nil, fmt.Errorf("failed to serialize system config: %w", err)
+		if err := buf.check("system config", writeSystemXML(buf, cfg.System)); err != nil {
+			return err
 		}
 	}
 
 	// Chassis clustering configuration
 	if cfg.Chassis != nil && (filter == nil || filterMatches(filter, "chassis")) {
-		if err := writeChassisXML(&buf, cfg.Chassis); err != nil {
-			return nil, fmt.Errorf("failed to serialize chassis config: %w", err)
+		if err := buf.check("chassis config", writeChassisXML(buf, cfg.Chassis)); err != nil {
+			return err
 		}
 	}
 
 	// Interfaces configuration - use IETF interfaces namespace
 	if len(cfg.Interfaces) > 0 && (filter == nil || filterMatches(filter, "interfaces")) {
-		if err := writeInterfacesXML(&buf, cfg.Interfaces, filter); err != nil {
-			return nil, fmt.Errorf("failed to serialize interfaces: %w", err)
+		if err := buf.check("interfaces", writeInterfacesXML(buf, cfg.Interfaces, filter)); err != nil {
+			return err
 		}
 	}
 
 	// Routing options - use IETF routing namespace
 	// Note: XML element is "routing" but internal name is "routing-options"
 	if cfg.RoutingOptions != nil && (filter == nil || filterMatches(filter, "routing") || filterMatches(filter, "routing-options")) {
-		if err := writeRoutingOptionsXML(&buf, cfg.RoutingOptions, filter); err != nil {
-			return nil, fmt.Errorf("failed to serialize routing options: %w", err)
+		if err := buf.check("routing options", writeRoutingOptionsXML(buf, cfg.RoutingOptions, filter)); err != nil {
+			return err
 		}
 	}
 
 	// Routing instances
 	if len(cfg.RoutingInstances) > 0 && (filter == nil || filterMatches(filter, "routing-instances")) {
-		if err := writeRoutingInstancesXML(&buf, cfg.RoutingInstances); err != nil {
-			return nil, fmt.Errorf("failed to serialize routing instances: %w", err)
+		if err := buf.check("routing instances", writeRoutingInstancesXML(buf, cfg.RoutingInstances)); err != nil {
+			return err
 		}
 	}
 
 	// Protocols (BGP, OSPF)
 	if cfg.Protocols != nil && (filter == nil || filterMatches(filter, "protocols")) {
-		if err := writeProtocolsXML(&buf, cfg.Protocols); err != nil {
-			return nil, fmt.Errorf("failed to serialize protocols: %w", err)
+		if err := buf.check("protocols", writeProtocolsXML(buf, cfg.Protocols, filter)); err != nil {
+			return err
 		}
 	}
 
 	// Class of service
 	if cfg.ClassOfService != nil && (filter == nil || filterMatches(filter, "class-of-service")) {
-		if err := writeClassOfServiceXML(&buf, cfg.ClassOfService); err != nil {
-			return nil, fmt.Errorf("failed to serialize class of service: %w", err)
+		if err := buf.check("class of service", writeClassOfServiceXML(buf, cfg.ClassOfService)); err != nil {
+			return err
+		}
+	}
+
+	// Firewall policers
+	if cfg.Firewall != nil && (filter == nil || filterMatches(filter, "firewall")) {
+		if err := buf.check("firewall", writeFirewallXML(buf, cfg.Firewall)); err != nil {
+			return err
 		}
 	}
 
 	// Security configuration; user secrets are intentionally omitted.
 	if cfg.Security != nil && (filter == nil || filterMatches(filter, "security")) {
-		if err := writeSecurityXML(&buf, cfg.Security); err != nil {
-			return nil, fmt.Errorf("failed to serialize security config: %w", err)
+		if err := buf.check("security config", writeSecurityXML(buf, cfg.Security)); err != nil {
+			return err
 		}
 	}
 
-	result := buf.Bytes()
-
-	// Validate size
-	if len(result) > MaxXMLSize {
-		return nil, NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue,
-			fmt.Sprintf("generated XML exceeds size limit (%d bytes)", MaxXMLSize)).
-			WithPath("/rpc/get-config").
-			WithAppTag("size-limit")
+	// Deactivated subtrees, so that get-config does not present them as active.
+	if len(cfg.Inactive) > 0 && (filter == nil || filterMatches(filter, "inactive")) {
+		if err := buf.check("inactive paths", writeInactiveXML(buf, cfg.Inactive)); err != nil {
+			return err
+		}
 	}
 
-	return result, nil
+	return buf.check("config", buf.flush())
+}
+
+// MeasureConfigXML returns the size of the <data> content WriteConfigXML
+// would produce without retaining it. It fails with the size-limit rpc-error
+// as soon as the document grows past MaxXMLSize.
+func MeasureConfigXML(cfg *config.Config, filter *Filter) (int, error) {
+	return MeasureConfigXMLWithLimit(cfg, filter, MaxXMLSize)
+}
+
+// MeasureConfigXMLWithLimit is MeasureConfigXML with a caller-chosen size
+// limit in place of MaxXMLSize.
+func MeasureConfigXMLWithLimit(cfg *config.Config, filter *Filter, maxSize int) (int, error) {
+	counter := &xmlByteCounter{}
+	if err := WriteConfigXMLWithLimit(counter, cfg, filter, maxSize); err != nil {
+		return 0, err
+	}
+	return counter.n, nil
 }
 
 // writeSystemXML writes system configuration to XML
-func writeSystemXML(buf *bytes.Buffer, sys *config.SystemConfig) error {
+func writeSystemXML(buf xmlWriter, sys *config.SystemConfig) error {
 	buf.WriteString(`  <system xmlns="` + ArcaConfigNS + `">`)
 	buf.WriteString("\n")
 
 	if sys.HostName != "" {
 		buf.WriteString(`    <host-name>`)
-		if err := xml.EscapeText(buf, []byte(sys.HostName)); err != nil {
+		if err := writeEscapedText(buf, sys.HostName); err != nil {
 			return err
 		}
 		buf.WriteString(`</host-name>`)
@@ -134,12 +190,40 @@ func writeSystemXML(buf *bytes.Buffer, sys *config.SystemConfig) error {
 		buf.WriteString("\n")
 	}
 
+	if sys.Scripts != nil && (len(sys.Scripts.CommitPre) > 0 || len(sys.Scripts.CommitPost) > 0) {
+		buf.WriteString("    <scripts>\n      <commit>\n")
+		for _, entry := range []struct {
+			element string
+			paths   []string
+		}{{"pre", sys.Scripts.CommitPre}, {"post", sys.Scripts.CommitPost}} {
+			for _, path := range entry.paths {
+				fmt.Fprintf(buf, "        <%s>", entry.element)
+				if err := writeEscapedText(buf, path); err != nil {
+					return err
+				}
+				fmt.Fprintf(buf, "</%s>\n", entry.element)
+			}
+		}
+		buf.WriteString("      </commit>\n    </scripts>\n")
+	}
+
+	if sys.Alarms != nil {
+		buf.WriteString("    <alarms>\n      <interface>\n")
+		if sys.Alarms.InterfaceErrorRate != 0 {
+			fmt.Fprintf(buf, "        <error-rate>%d</error-rate>\n", sys.Alarms.InterfaceErrorRate)
+		}
+		if sys.Alarms.InterfaceLinkDown {
+			buf.WriteString("        <link-down>true</link-down>\n")
+		}
+		buf.WriteString("      </interface>\n    </alarms>\n")
+	}
+
 	buf.WriteString(`  </system>`)
 	buf.WriteString("\n")
 	return nil
 }
 
-func writeSystemServicesXML(buf *bytes.Buffer, services *config.SystemServicesConfig) error {
+func writeSystemServicesXML(buf xmlWriter, services *config.SystemServicesConfig) error {
 	if services.WebUI != nil {
 		if err := writeServiceXML(buf, "web-ui", services.WebUI.Enabled, services.WebUI.ListenAddress, services.WebUI.Port, ""); err != nil {
 			return err
@@ -158,7 +242,7 @@ func writeSystemServicesXML(buf *bytes.Buffer, services *config.SystemServicesCo
 	return nil
 }
 
-func writeServiceXML(buf *bytes.Buffer, name string, enabled bool, listenAddress string, port int, community string) error {
+func writeServiceXML(buf xmlWriter, name string, enabled bool, listenAddress string, port int, community string) error {
 	if !enabled && listenAddress == "" && port == 0 && community == "" {
 		return nil
 	}
@@ -168,7 +252,7 @@ func writeServiceXML(buf *bytes.Buffer, name string, enabled bool, listenAddress
 	}
 	if listenAddress != "" {
 		buf.WriteString(`        <listen-address>`)
-		if err := xml.EscapeText(buf, []byte(listenAddress)); err != nil {
+		if err := writeEscapedText(buf, listenAddress); err != nil {
 			return err
 		}
 		buf.WriteString(`</listen-address>`)
@@ -179,7 +263,7 @@ func writeServiceXML(buf *bytes.Buffer, name string, enabled bool, listenAddress
 	}
 	if community != "" {
 		buf.WriteString(`        <community>`)
-		if err := xml.EscapeText(buf, []byte(community)); err != nil {
+		if err := writeEscapedText(buf, community); err != nil {
 			return err
 		}
 		buf.WriteString(`</community>`)
@@ -189,7 +273,7 @@ func writeServiceXML(buf *bytes.Buffer, name string, enabled bool, listenAddress
 	return nil
 }
 
-func writeChassisXML(buf *bytes.Buffer, chassis *config.ChassisConfig) error {
+func writeChassisXML(buf xmlWriter, chassis *config.ChassisConfig) error {
 	if chassis.Cluster == nil {
 		return nil
 	}
@@ -211,14 +295,14 @@ func writeChassisXML(buf *bytes.Buffer, chassis *config.ChassisConfig) error {
 		buf.WriteString(`      <node>`)
 		buf.WriteString("\n")
 		buf.WriteString(`        <name>`)
-		if err := xml.EscapeText(buf, []byte(name)); err != nil {
+		if err := writeEscapedText(buf, name); err != nil {
 			return err
 		}
 		buf.WriteString(`</name>`)
 		buf.WriteString("\n")
 		if node.Address != "" {
 			buf.WriteString(`        <address>`)
-			if err := xml.EscapeText(buf, []byte(node.Address)); err != nil {
+			if err := writeEscapedText(buf, node.Address); err != nil {
 				return err
 			}
 			buf.WriteString(`</address>`)
@@ -237,7 +321,7 @@ func writeChassisXML(buf *bytes.Buffer, chassis *config.ChassisConfig) error {
 		buf.WriteString("\n")
 		for _, endpoint := range cluster.Sync.Etcd.Endpoints {
 			buf.WriteString(`          <endpoint>`)
-			if err := xml.EscapeText(buf, []byte(endpoint)); err != nil {
+			if err := writeEscapedText(buf, endpoint); err != nil {
 				return err
 			}
 			buf.WriteString(`</endpoint>`)
@@ -256,11 +340,20 @@ func writeChassisXML(buf *bytes.Buffer, chassis *config.ChassisConfig) error {
 }
 
 // writeInterfacesXML writes interfaces configuration to XML with IETF namespace.
-func writeInterfacesXML(buf *bytes.Buffer, interfaces map[string]*config.Interface, filter *Filter) error {
-	xpathFilter := outputXPathFilter(filter)
+func writeInterfacesXML(buf xmlWriter, interfaces map[string]*config.Interface, filter *Filter) error {
 	buf.WriteString(`  <interfaces xmlns="` + IETFInterfacesNS + `">`)
 	buf.WriteString("\n")
+	if err := writeInterfaceEntriesXML(buf, interfaces, outputXPathFilter(filter)); err != nil {
+		return err
+	}
+	buf.WriteString(`  </interfaces>`)
+	buf.WriteString("\n")
+	return nil
+}
 
+// writeInterfaceEntriesXML writes one <interface> element per interface, as
+// children of the top-level <interfaces> container or of a group's.
+func writeInterfaceEntriesXML(buf xmlWriter, interfaces map[string]*config.Interface, xpathFilter *XPathFilter) error {
 	for _, name := range sortedStringKeys(interfaces) {
 		iface := interfaces[name]
 		if !interfaceMatchesXPathPredicates(xpathFilter, name, iface) {
@@ -271,20 +364,100 @@ func writeInterfacesXML(buf *bytes.Buffer, interfaces map[string]*config.Interfa
 		buf.WriteString("\n")
 
 		buf.WriteString(`      <name>`)
-		if err := xml.EscapeText(buf, []byte(name)); err != nil {
+		if err := writeEscapedText(buf, name); err != nil {
 			return err
 		}
 		buf.WriteString(`</name>`)
 		buf.WriteString("\n")
 
+		for _, group := range iface.ApplyGroups {
+			buf.WriteString(`      <apply-groups>`)
+			if err := writeEscapedText(buf, group); err != nil {
+				return err
+			}
+			buf.WriteString(`</apply-groups>`)
+			buf.WriteString("\n")
+		}
 		if iface.Description != "" {
 			buf.WriteString(`      <description>`)
-			if err := xml.EscapeText(buf, []byte(iface.Description)); err != nil {
+			if err := writeEscapedText(buf, iface.Description); err != nil {
 				return err
 			}
 			buf.WriteString(`</description>`)
 			buf.WriteString("\n")
 		}
+		if iface.Promiscuous {
+			buf.WriteString(`      <promiscuous>true</promiscuous>`)
+			buf.WriteString("\n")
+		}
+		if iface.RxMode != "" {
+			buf.WriteString(`      <rx-mode>`)
+			if err := writeEscapedText(buf, iface.RxMode); err != nil {
+				return err
+			}
+			buf.WriteString(`</rx-mode>`)
+			buf.WriteString("\n")
+		}
+		if iface.MTU != 0 {
+			fmt.Fprintf(buf, "      <mtu>%d</mtu>\n", iface.MTU)
+		}
+		if iface.Bandwidth != 0 {
+			fmt.Fprintf(buf, "      <bandwidth>%d</bandwidth>\n", iface.Bandwidth)
+		}
+		if iface.InputPolicer != "" {
+			buf.WriteString(`      <input-policer>`)
+			if err := writeEscapedText(buf, iface.InputPolicer); err != nil {
+				return err
+			}
+			buf.WriteString(`</input-policer>`)
+			buf.WriteString("\n")
+		}
+		if iface.OutputPolicer != "" {
+			buf.WriteString(`      <output-policer>`)
+			if err := writeEscapedText(buf, iface.OutputPolicer); err != nil {
+				return err
+			}
+			buf.WriteString(`</output-policer>`)
+			buf.WriteString("\n")
+		}
+		if iface.Tunnel != nil {
+			buf.WriteString(`      <tunnel>`)
+			buf.WriteString("\n")
+			if iface.Tunnel.Source != "" {
+				buf.WriteString(`        <source>`)
+				if err := writeEscapedText(buf, iface.Tunnel.Source); err != nil {
+					return err
+				}
+				buf.WriteString(`</source>`)
+				buf.WriteString("\n")
+			}
+			if iface.Tunnel.Destination != "" {
+				buf.WriteString(`        <destination>`)
+				if err := writeEscapedText(buf, iface.Tunnel.Destination); err != nil {
+					return err
+				}
+				buf.WriteString(`</destination>`)
+				buf.WriteString("\n")
+			}
+			buf.WriteString(`      </tunnel>`)
+			buf.WriteString("\n")
+		}
+		if iface.HoldTime != nil {
+			fmt.Fprintf(buf, "      <hold-time>\n        <up>%d</up>\n        <down>%d</down>\n      </hold-time>\n", iface.HoldTime.Up, iface.HoldTime.Down)
+		}
+		if opts := iface.GigEtherOptions; opts != nil {
+			buf.WriteString("      <gigether-options>\n")
+			if opts.RxQueues != 0 {
+				fmt.Fprintf(buf, "        <rxq>%d</rxq>\n", opts.RxQueues)
+			}
+			if opts.TxQueues != 0 {
+				fmt.Fprintf(buf, "        <txq>%d</txq>\n", opts.TxQueues)
+			}
+			for _, queue := range sortedIntKeys(opts.RxPlacement) {
+				fmt.Fprintf(buf, "        <rx-queue>\n          <name>%d</name>\n          <worker>%d</worker>\n        </rx-queue>\n", queue, opts.RxPlacement[queue])
+			}
+			buf.WriteString("      </gigether-options>\n")
+		}
 
 		// Units (sub-interfaces)
 		if len(iface.Units) > 0 {
@@ -303,7 +476,7 @@ func writeInterfacesXML(buf *bytes.Buffer, interfaces map[string]*config.Interfa
 						buf.WriteString("\n")
 
 						buf.WriteString(`          <name>`)
-						if err := xml.EscapeText(buf, []byte(familyName)); err != nil {
+						if err := writeEscapedText(buf, familyName); err != nil {
 							return err
 						}
 						buf.WriteString(`</name>`)
@@ -313,7 +486,7 @@ func writeInterfacesXML(buf *bytes.Buffer, interfaces map[string]*config.Interfa
 						if len(family.Addresses) > 0 {
 							for _, addr := range family.Addresses {
 								buf.WriteString(`          <address>`)
-								if err := xml.EscapeText(buf, []byte(addr)); err != nil {
+								if err := writeEscapedText(buf, addr); err != nil {
 									return err
 								}
 								buf.WriteString(`</address>`)
@@ -321,6 +494,36 @@ func writeInterfacesXML(buf *bytes.Buffer, interfaces map[string]*config.Interfa
 							}
 						}
 
+						if family.MTU != 0 {
+							fmt.Fprintf(buf, "          <mtu>%d</mtu>\n", family.MTU)
+						}
+
+						// Static neighbors
+						for _, ip := range sortedStringKeys(family.Neighbors) {
+							buf.WriteString(`          <neighbor>`)
+							buf.WriteString("\n")
+							buf.WriteString(`            <address>`)
+							if err := writeEscapedText(buf, ip); err != nil {
+								return err
+							}
+							buf.WriteString(`</address>`)
+							buf.WriteString("\n")
+							buf.WriteString(`            <mac>`)
+							if err := writeEscapedText(buf, family.Neighbors[ip]); err != nil {
+								return err
+							}
+							buf.WriteString(`</mac>`)
+							buf.WriteString("\n")
+							buf.WriteString(`          </neighbor>`)
+							buf.WriteString("\n")
+						}
+
+						if family.RouterAdvertisement != nil {
+							if err := writeRouterAdvertisementXML(buf, family.RouterAdvertisement); err != nil {
+								return err
+							}
+						}
+
 						buf.WriteString(`        </family>`)
 						buf.WriteString("\n")
 					}
@@ -334,21 +537,97 @@ func writeInterfacesXML(buf *bytes.Buffer, interfaces map[string]*config.Interfa
 		buf.WriteString(`    </interface>`)
 		buf.WriteString("\n")
 	}
+	return nil
+}
 
-	buf.WriteString(`  </interfaces>`)
+// writeGroupsXML writes configuration groups. A group's interface statements
+// use the same elements as top-level interfaces, one level deeper.
+func writeGroupsXML(buf xmlWriter, groups map[string]*config.Config) error {
+	buf.WriteString(`  <groups xmlns="` + ArcaConfigNS + `">`)
+	buf.WriteString("\n")
+	for _, name := range sortedStringKeys(groups) {
+		group := groups[name]
+		if group == nil {
+			continue
+		}
+		buf.WriteString(`    <group>`)
+		buf.WriteString("\n")
+		buf.WriteString(`      <name>`)
+		if err := writeEscapedText(buf, name); err != nil {
+			return err
+		}
+		buf.WriteString(`</name>`)
+		buf.WriteString("\n")
+		if len(group.Interfaces) > 0 {
+			buf.WriteString(`      <interfaces>`)
+			buf.WriteString("\n")
+			if err := writeInterfaceEntriesXML(newXMLIndentWriter(buf, "    "), group.Interfaces, nil); err != nil {
+				return err
+			}
+			buf.WriteString(`      </interfaces>`)
+			buf.WriteString("\n")
+		}
+		buf.WriteString(`    </group>`)
+		buf.WriteString("\n")
+	}
+	buf.WriteString(`  </groups>`)
+	buf.WriteString("\n")
+	return nil
+}
+
+// writeRouterAdvertisementXML writes the IPv6 router advertisement settings of
+// an address family.
+func writeRouterAdvertisementXML(buf xmlWriter, ra *config.RouterAdvertisement) error {
+	buf.WriteString(`          <router-advertisement>`)
+	buf.WriteString("\n")
+	if ra.ManagedConfiguration {
+		buf.WriteString(`            <managed-configuration>true</managed-configuration>`)
+		buf.WriteString("\n")
+	}
+	if ra.OtherStatefulConfiguration {
+		buf.WriteString(`            <other-stateful-configuration>true</other-stateful-configuration>`)
+		buf.WriteString("\n")
+	}
+	if ra.MaxAdvertisementInterval != 0 {
+		fmt.Fprintf(buf, "            <max-advertisement-interval>%d</max-advertisement-interval>\n", ra.MaxAdvertisementInterval)
+	}
+	if ra.MinAdvertisementInterval != 0 {
+		fmt.Fprintf(buf, "            <min-advertisement-interval>%d</min-advertisement-interval>\n", ra.MinAdvertisementInterval)
+	}
+	for _, prefix := range sortedStringKeys(ra.Prefixes) {
+		buf.WriteString(`            <prefix>`)
+		buf.WriteString("\n")
+		buf.WriteString(`              <name>`)
+		if err := writeEscapedText(buf, prefix); err != nil {
+			return err
+		}
+		buf.WriteString(`</name>`)
+		buf.WriteString("\n")
+		if lifetimes := ra.Prefixes[prefix]; lifetimes != nil {
+			if lifetimes.ValidLifetime != 0 {
+				fmt.Fprintf(buf, "              <valid-lifetime>%d</valid-lifetime>\n", lifetimes.ValidLifetime)
+			}
+			if lifetimes.PreferredLifetime != 0 {
+				fmt.Fprintf(buf, "              <preferred-lifetime>%d</preferred-lifetime>\n", lifetimes.PreferredLifetime)
+			}
+		}
+		buf.WriteString(`            </prefix>`)
+		buf.WriteString("\n")
+	}
+	buf.WriteString(`          </router-advertisement>`)
 	buf.WriteString("\n")
 	return nil
 }
 
 // writeRoutingOptionsXML writes routing options to XML with IETF routing namespace.
-func writeRoutingOptionsXML(buf *bytes.Buffer, ro *config.RoutingOptions, filter *Filter) error {
+func writeRoutingOptionsXML(buf xmlWriter, ro *config.RoutingOptions, filter *Filter) error {
 	xpathFilter := outputXPathFilter(filter)
 	buf.WriteString(`  <routing xmlns="` + IETFRoutingNS + `">`)
 	buf.WriteString("\n")
 
 	if ro.RouterID != "" {
 		buf.WriteString(`    <router-id>`)
-		if err := xml.EscapeText(buf, []byte(ro.RouterID)); err != nil {
+		if err := writeEscapedText(buf, ro.RouterID); err != nil {
 			return err
 		}
 		buf.WriteString(`</router-id>`)
@@ -373,14 +652,14 @@ func writeRoutingOptionsXML(buf *bytes.Buffer, ro *config.RoutingOptions, filter
 			buf.WriteString("\n")
 
 			buf.WriteString(`        <prefix>`)
-			if err := xml.EscapeText(buf, []byte(route.Prefix)); err != nil {
+			if err := writeEscapedText(buf, route.Prefix); err != nil {
 				return err
 			}
 			buf.WriteString(`</prefix>`)
 			buf.WriteString("\n")
 
 			buf.WriteString(`        <next-hop>`)
-			if err := xml.EscapeText(buf, []byte(route.NextHop)); err != nil {
+			if err := writeEscapedText(buf, route.NextHop); err != nil {
 				return err
 			}
 			buf.WriteString(`</next-hop>`)
@@ -397,7 +676,7 @@ func writeRoutingOptionsXML(buf *bytes.Buffer, ro *config.RoutingOptions, filter
 
 			if route.BFDProfile != "" {
 				buf.WriteString(`        <bfd-profile>`)
-				if err := xml.EscapeText(buf, []byte(route.BFDProfile)); err != nil {
+				if err := writeEscapedText(buf, route.BFDProfile); err != nil {
 					return err
 				}
 				buf.WriteString(`</bfd-profile>`)
@@ -406,7 +685,7 @@ func writeRoutingOptionsXML(buf *bytes.Buffer, ro *config.RoutingOptions, filter
 
 			if route.BFDSource != "" {
 				buf.WriteString(`        <bfd-source>`)
-				if err := xml.EscapeText(buf, []byte(route.BFDSource)); err != nil {
+				if err := writeEscapedText(buf, route.BFDSource); err != nil {
 					return err
 				}
 				buf.WriteString(`</bfd-source>`)
@@ -461,6 +740,13 @@ func interfacePredicatesMatch(xpathFilter *XPathFilter, name string, iface *conf
 			if iface != nil {
 				got = iface.Description
 			}
+		case "if-index":
+			if !includeState {
+				return false
+			}
+			if state != nil {
+				got = strconv.FormatUint(uint64(state.IfIndex), 10)
+			}
 		case "admin-status":
 			if !includeState {
 				return false
@@ -553,6 +839,32 @@ func staticRouteMatchesXPathPredicates(xpathFilter *XPathFilter, route *config.S
 	return true
 }
 
+func bgpGroupMatchesXPathPredicates(xpathFilter *XPathFilter, name string, group *config.BGPGroup) bool {
+	segmentIndex, ok := xpathListSegmentIndex(xpathFilter, []string{"protocols", "bgp", "group"})
+	if !ok {
+		return true
+	}
+	for key, want := range xpathFilter.Predicates[segmentIndex] {
+		var got string
+		switch key {
+		case "name":
+			got = name
+		case "type":
+			got = group.Type
+		case "import":
+			got = group.Import
+		case "export":
+			got = group.Export
+		default:
+			return false
+		}
+		if got != want {
+			return false
+		}
+	}
+	return true
+}
+
 func xpathStaticRouteSegmentIndex(xpathFilter *XPathFilter) (int, bool) {
 	if index, ok := xpathListSegmentIndex(xpathFilter, []string{"routing", "static-routes", "route"}); ok {
 		return index, true
@@ -576,7 +888,7 @@ func xpathListSegmentIndex(xpathFilter *XPathFilter, path []string) (int, bool)
 	return index, true
 }
 
-func writeRoutingInstancesXML(buf *bytes.Buffer, instances map[string]*config.RoutingInstance) error {
+func writeRoutingInstancesXML(buf xmlWriter, instances map[string]*config.RoutingInstance) error {
 	buf.WriteString(`  <routing-instances xmlns="` + ArcaConfigNS + `">`)
 	buf.WriteString("\n")
 
@@ -588,14 +900,14 @@ func writeRoutingInstancesXML(buf *bytes.Buffer, instances map[string]*config.Ro
 		buf.WriteString(`    <instance>`)
 		buf.WriteString("\n")
 		buf.WriteString(`      <name>`)
-		if err := xml.EscapeText(buf, []byte(name)); err != nil {
+		if err := writeEscapedText(buf, name); err != nil {
 			return err
 		}
 		buf.WriteString(`</name>`)
 		buf.WriteString("\n")
 		if instance.InstanceType != "" {
 			buf.WriteString(`      <instance-type>`)
-			if err := xml.EscapeText(buf, []byte(instance.InstanceType)); err != nil {
+			if err := writeEscapedText(buf, instance.InstanceType); err != nil {
 				return err
 			}
 			buf.WriteString(`</instance-type>`)
@@ -603,7 +915,7 @@ func writeRoutingInstancesXML(buf *bytes.Buffer, instances map[string]*config.Ro
 		}
 		if instance.RouteDistinguisher != "" {
 			buf.WriteString(`      <route-distinguisher>`)
-			if err := xml.EscapeText(buf, []byte(instance.RouteDistinguisher)); err != nil {
+			if err := writeEscapedText(buf, instance.RouteDistinguisher); err != nil {
 				return err
 			}
 			buf.WriteString(`</route-distinguisher>`)
@@ -611,7 +923,7 @@ func writeRoutingInstancesXML(buf *bytes.Buffer, instances map[string]*config.Ro
 		}
 		if instance.VRFTarget != "" {
 			buf.WriteString(`      <vrf-target>`)
-			if err := xml.EscapeText(buf, []byte(instance.VRFTarget)); err != nil {
+			if err := writeEscapedText(buf, instance.VRFTarget); err != nil {
 				return err
 			}
 			buf.WriteString(`</vrf-target>`)
@@ -641,11 +953,11 @@ func writeRoutingInstancesXML(buf *bytes.Buffer, instances map[string]*config.Ro
 	return nil
 }
 
-func writeStringListXML(buf *bytes.Buffer, element string, values []string, indent string) error {
+func writeStringListXML(buf xmlWriter, element string, values []string, indent string) error {
 	for _, value := range values {
 		buf.WriteString(indent)
 		fmt.Fprintf(buf, "<%s>", element)
-		if err := xml.EscapeText(buf, []byte(value)); err != nil {
+		if err := writeEscapedText(buf, value); err != nil {
 			return err
 		}
 		fmt.Fprintf(buf, "</%s>\n", element)
@@ -678,48 +990,55 @@ func sortedIntKeys[V any](m map[int]V) []int {
 }
 
 // writeProtocolsXML writes protocol configuration to XML
-func writeProtocolsXML(buf *bytes.Buffer, protocols *config.ProtocolConfig) error {
+func writeProtocolsXML(buf xmlWriter, protocols *config.ProtocolConfig, filter *Filter) error {
+	xpathFilter := outputXPathFilter(filter)
 	buf.WriteString(`  <protocols xmlns="` + ArcaConfigNS + `">`)
 	buf.WriteString("\n")
 
-	if protocols.BFD != nil {
+	if protocols.BFD != nil && xpathFilter.MatchesSection([]string{"protocols", "bfd"}) {
 		if err := writeBFDXML(buf, protocols.BFD); err != nil {
 			return err
 		}
 	}
 
 	// BGP
-	if protocols.BGP != nil {
-		if err := writeBGPXML(buf, protocols.BGP); err != nil {
+	if protocols.BGP != nil && xpathFilter.MatchesSection([]string{"protocols", "bgp"}) {
+		if err := writeBGPXML(buf, protocols.BGP, xpathFilter); err != nil {
 			return err
 		}
 	}
 
-	if protocols.EVPN != nil {
+	if protocols.EVPN != nil && xpathFilter.MatchesSection([]string{"protocols", "evpn"}) {
 		if err := writeEVPNXML(buf, protocols.EVPN); err != nil {
 			return err
 		}
 	}
 
 	// OSPF
-	if protocols.OSPF != nil {
+	if protocols.OSPF != nil && xpathFilter.MatchesSection([]string{"protocols", "ospf"}) {
 		if err := writeOSPFXML(buf, "ospf", protocols.OSPF); err != nil {
 			return err
 		}
 	}
-	if protocols.OSPF3 != nil {
+	if protocols.OSPF3 != nil && xpathFilter.MatchesSection([]string{"protocols", "ospf3"}) {
 		if err := writeOSPFXML(buf, "ospf3", protocols.OSPF3); err != nil {
 			return err
 		}
 	}
 
-	if protocols.MPLS != nil {
+	if protocols.MPLS != nil && xpathFilter.MatchesSection([]string{"protocols", "mpls"}) {
 		if err := writeMPLSXML(buf, protocols.MPLS); err != nil {
 			return err
 		}
 	}
 
-	if protocols.VRRP != nil {
+	if protocols.RIP != nil && xpathFilter.MatchesSection([]string{"protocols", "rip"}) {
+		if err := writeRIPXML(buf, protocols.RIP); err != nil {
+			return err
+		}
+	}
+
+	if protocols.VRRP != nil && xpathFilter.MatchesSection([]string{"protocols", "vrrp"}) {
 		if err := writeVRRPXML(buf, protocols.VRRP); err != nil {
 			return err
 		}
@@ -730,7 +1049,7 @@ func writeProtocolsXML(buf *bytes.Buffer, protocols *config.ProtocolConfig) erro
 	return nil
 }
 
-func writeBFDXML(buf *bytes.Buffer, bfd *config.BFDConfig) error {
+func writeBFDXML(buf xmlWriter, bfd *config.BFDConfig) error {
 	if len(bfd.Profiles) == 0 && len(bfd.Peers) == 0 {
 		return nil
 	}
@@ -744,7 +1063,7 @@ func writeBFDXML(buf *bytes.Buffer, bfd *config.BFDConfig) error {
 		buf.WriteString(`      <profile>`)
 		buf.WriteString("\n")
 		buf.WriteString(`        <name>`)
-		if err := xml.EscapeText(buf, []byte(name)); err != nil {
+		if err := writeEscapedText(buf, name); err != nil {
 			return err
 		}
 		buf.WriteString(`</name>`)
@@ -767,14 +1086,14 @@ func writeBFDXML(buf *bytes.Buffer, bfd *config.BFDConfig) error {
 		buf.WriteString(`      <peer>`)
 		buf.WriteString("\n")
 		buf.WriteString(`        <address>`)
-		if err := xml.EscapeText(buf, []byte(peerAddress)); err != nil {
+		if err := writeEscapedText(buf, peerAddress); err != nil {
 			return err
 		}
 		buf.WriteString(`</address>`)
 		buf.WriteString("\n")
 		if peer.LocalAddress != "" {
 			buf.WriteString(`        <local-address>`)
-			if err := xml.EscapeText(buf, []byte(peer.LocalAddress)); err != nil {
+			if err := writeEscapedText(buf, peer.LocalAddress); err != nil {
 				return err
 			}
 			buf.WriteString(`</local-address>`)
@@ -782,7 +1101,7 @@ func writeBFDXML(buf *bytes.Buffer, bfd *config.BFDConfig) error {
 		}
 		if peer.Interface != "" {
 			buf.WriteString(`        <interface>`)
-			if err := xml.EscapeText(buf, []byte(peer.Interface)); err != nil {
+			if err := writeEscapedText(buf, peer.Interface); err != nil {
 				return err
 			}
 			buf.WriteString(`</interface>`)
@@ -790,7 +1109,7 @@ func writeBFDXML(buf *bytes.Buffer, bfd *config.BFDConfig) error {
 		}
 		if peer.VRF != "" {
 			buf.WriteString(`        <vrf>`)
-			if err := xml.EscapeText(buf, []byte(peer.VRF)); err != nil {
+			if err := writeEscapedText(buf, peer.VRF); err != nil {
 				return err
 			}
 			buf.WriteString(`</vrf>`)
@@ -802,7 +1121,7 @@ func writeBFDXML(buf *bytes.Buffer, bfd *config.BFDConfig) error {
 		}
 		if peer.Profile != "" {
 			buf.WriteString(`        <profile>`)
-			if err := xml.EscapeText(buf, []byte(peer.Profile)); err != nil {
+			if err := writeEscapedText(buf, peer.Profile); err != nil {
 				return err
 			}
 			buf.WriteString(`</profile>`)
@@ -823,7 +1142,7 @@ func writeBFDXML(buf *bytes.Buffer, bfd *config.BFDConfig) error {
 	return nil
 }
 
-func writeBFDSessionXML(buf *bytes.Buffer, detectMultiplier, receiveInterval, transmitInterval int, echoMode, passiveMode bool, indent string) error {
+func writeBFDSessionXML(buf xmlWriter, detectMultiplier, receiveInterval, transmitInterval int, echoMode, passiveMode bool, indent string) error {
 	if detectMultiplier != 0 {
 		fmt.Fprintf(buf, "%s<detect-multiplier>%d</detect-multiplier>\n", indent, detectMultiplier)
 	}
@@ -843,21 +1162,30 @@ func writeBFDSessionXML(buf *bytes.Buffer, detectMultiplier, receiveInterval, tr
 }
 
 // writeBGPXML writes BGP configuration to XML
-func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
+func writeBGPXML(buf xmlWriter, bgp *config.BGPConfig, xpathFilter *XPathFilter) error {
 	buf.WriteString(`    <bgp>`)
 	buf.WriteString("\n")
 
+	if bgp.RouterID != "" {
+		buf.WriteString(`      <router-id>`)
+		if err := writeEscapedText(buf, bgp.RouterID); err != nil {
+			return err
+		}
+		buf.WriteString(`</router-id>`)
+		buf.WriteString("\n")
+	}
+
 	if len(bgp.Groups) > 0 {
 		for _, groupName := range sortedStringKeys(bgp.Groups) {
 			group := bgp.Groups[groupName]
-			if group == nil {
+			if group == nil || !bgpGroupMatchesXPathPredicates(xpathFilter, groupName, group) {
 				continue
 			}
 			buf.WriteString(`      <group>`)
 			buf.WriteString("\n")
 
 			buf.WriteString(`        <name>`)
-			if err := xml.EscapeText(buf, []byte(groupName)); err != nil {
+			if err := writeEscapedText(buf, groupName); err != nil {
 				return err
 			}
 			buf.WriteString(`</name>`)
@@ -865,7 +1193,7 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 
 			if group.Type != "" {
 				buf.WriteString(`        <type>`)
-				if err := xml.EscapeText(buf, []byte(group.Type)); err != nil {
+				if err := writeEscapedText(buf, group.Type); err != nil {
 					return err
 				}
 				buf.WriteString(`</type>`)
@@ -874,7 +1202,7 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 
 			if group.Import != "" {
 				buf.WriteString(`        <import>`)
-				if err := xml.EscapeText(buf, []byte(group.Import)); err != nil {
+				if err := writeEscapedText(buf, group.Import); err != nil {
 					return err
 				}
 				buf.WriteString(`</import>`)
@@ -883,13 +1211,32 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 
 			if group.Export != "" {
 				buf.WriteString(`        <export>`)
-				if err := xml.EscapeText(buf, []byte(group.Export)); err != nil {
+				if err := writeEscapedText(buf, group.Export); err != nil {
 					return err
 				}
 				buf.WriteString(`</export>`)
 				buf.WriteString("\n")
 			}
 
+			if group.Cluster != "" {
+				buf.WriteString(`        <cluster>`)
+				if err := writeEscapedText(buf, group.Cluster); err != nil {
+					return err
+				}
+				buf.WriteString(`</cluster>`)
+				buf.WriteString("\n")
+			}
+
+			if group.NextHopSelf {
+				buf.WriteString(`        <next-hop-self>true</next-hop-self>`)
+				buf.WriteString("\n")
+			}
+
+			if group.RemovePrivateAS {
+				buf.WriteString(`        <remove-private-as>true</remove-private-as>`)
+				buf.WriteString("\n")
+			}
+
 			// Neighbors
 			if len(group.Neighbors) > 0 {
 				for _, neighborIP := range sortedStringKeys(group.Neighbors) {
@@ -901,7 +1248,7 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 					buf.WriteString("\n")
 
 					buf.WriteString(`          <ip>`)
-					if err := xml.EscapeText(buf, []byte(neighbor.IP)); err != nil {
+					if err := writeEscapedText(buf, neighbor.IP); err != nil {
 						return err
 					}
 					buf.WriteString(`</ip>`)
@@ -911,7 +1258,7 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 
 					if neighbor.Description != "" {
 						buf.WriteString(`          <description>`)
-						if err := xml.EscapeText(buf, []byte(neighbor.Description)); err != nil {
+						if err := writeEscapedText(buf, neighbor.Description); err != nil {
 							return err
 						}
 						buf.WriteString(`</description>`)
@@ -920,13 +1267,22 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 
 					if neighbor.LocalAddress != "" {
 						buf.WriteString(`          <local-address>`)
-						if err := xml.EscapeText(buf, []byte(neighbor.LocalAddress)); err != nil {
+						if err := writeEscapedText(buf, neighbor.LocalAddress); err != nil {
 							return err
 						}
 						buf.WriteString(`</local-address>`)
 						buf.WriteString("\n")
 					}
 
+					if neighbor.UpdateSource != "" {
+						buf.WriteString(`          <update-source>`)
+						if err := writeEscapedText(buf, neighbor.UpdateSource); err != nil {
+							return err
+						}
+						buf.WriteString(`</update-source>`)
+						buf.WriteString("\n")
+					}
+
 					if neighbor.BFD || neighbor.BFDProfile != "" {
 						buf.WriteString(`          <bfd>true</bfd>`)
 						buf.WriteString("\n")
@@ -934,13 +1290,42 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 
 					if neighbor.BFDProfile != "" {
 						buf.WriteString(`          <bfd-profile>`)
-						if err := xml.EscapeText(buf, []byte(neighbor.BFDProfile)); err != nil {
+						if err := writeEscapedText(buf, neighbor.BFDProfile); err != nil {
 							return err
 						}
 						buf.WriteString(`</bfd-profile>`)
 						buf.WriteString("\n")
 					}
 
+					if neighbor.Cluster != "" {
+						buf.WriteString(`          <cluster>`)
+						if err := writeEscapedText(buf, neighbor.Cluster); err != nil {
+							return err
+						}
+						buf.WriteString(`</cluster>`)
+						buf.WriteString("\n")
+					}
+
+					if neighbor.RouteReflectorClient {
+						buf.WriteString(`          <route-reflector-client>true</route-reflector-client>`)
+						buf.WriteString("\n")
+					}
+
+					if neighbor.Shutdown {
+						buf.WriteString(`          <shutdown>true</shutdown>`)
+						buf.WriteString("\n")
+					}
+
+					if neighbor.NextHopSelf {
+						buf.WriteString(`          <next-hop-self>true</next-hop-self>`)
+						buf.WriteString("\n")
+					}
+
+					if neighbor.RemovePrivateAS {
+						buf.WriteString(`          <remove-private-as>true</remove-private-as>`)
+						buf.WriteString("\n")
+					}
+
 					buf.WriteString(`        </neighbor>`)
 					buf.WriteString("\n")
 				}
@@ -956,7 +1341,7 @@ func writeBGPXML(buf *bytes.Buffer, bgp *config.BGPConfig) error {
 	return nil
 }
 
-func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
+func writeEVPNXML(buf xmlWriter, evpn *config.EVPNConfig) error {
 	if len(evpn.VNIs) == 0 {
 		return nil
 	}
@@ -972,7 +1357,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		fmt.Fprintf(buf, "        <id>%d</id>\n", vni)
 		if entry.Type != "" {
 			buf.WriteString(`        <type>`)
-			if err := xml.EscapeText(buf, []byte(entry.Type)); err != nil {
+			if err := writeEscapedText(buf, entry.Type); err != nil {
 				return err
 			}
 			buf.WriteString(`</type>`)
@@ -980,7 +1365,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.BridgeDomain != "" {
 			buf.WriteString(`        <bridge-domain>`)
-			if err := xml.EscapeText(buf, []byte(entry.BridgeDomain)); err != nil {
+			if err := writeEscapedText(buf, entry.BridgeDomain); err != nil {
 				return err
 			}
 			buf.WriteString(`</bridge-domain>`)
@@ -991,7 +1376,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.RoutingInstance != "" {
 			buf.WriteString(`        <routing-instance>`)
-			if err := xml.EscapeText(buf, []byte(entry.RoutingInstance)); err != nil {
+			if err := writeEscapedText(buf, entry.RoutingInstance); err != nil {
 				return err
 			}
 			buf.WriteString(`</routing-instance>`)
@@ -999,7 +1384,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.RouteDistinguisher != "" {
 			buf.WriteString(`        <route-distinguisher>`)
-			if err := xml.EscapeText(buf, []byte(entry.RouteDistinguisher)); err != nil {
+			if err := writeEscapedText(buf, entry.RouteDistinguisher); err != nil {
 				return err
 			}
 			buf.WriteString(`</route-distinguisher>`)
@@ -1007,7 +1392,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.VRFTarget != "" {
 			buf.WriteString(`        <vrf-target>`)
-			if err := xml.EscapeText(buf, []byte(entry.VRFTarget)); err != nil {
+			if err := writeEscapedText(buf, entry.VRFTarget); err != nil {
 				return err
 			}
 			buf.WriteString(`</vrf-target>`)
@@ -1015,7 +1400,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		for _, target := range sortedStrings(entry.VRFTargetImport) {
 			buf.WriteString(`        <vrf-target-import>`)
-			if err := xml.EscapeText(buf, []byte(target)); err != nil {
+			if err := writeEscapedText(buf, target); err != nil {
 				return err
 			}
 			buf.WriteString(`</vrf-target-import>`)
@@ -1023,7 +1408,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		for _, target := range sortedStrings(entry.VRFTargetExport) {
 			buf.WriteString(`        <vrf-target-export>`)
-			if err := xml.EscapeText(buf, []byte(target)); err != nil {
+			if err := writeEscapedText(buf, target); err != nil {
 				return err
 			}
 			buf.WriteString(`</vrf-target-export>`)
@@ -1031,7 +1416,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.SourceInterface != "" {
 			buf.WriteString(`        <source-interface>`)
-			if err := xml.EscapeText(buf, []byte(entry.SourceInterface)); err != nil {
+			if err := writeEscapedText(buf, entry.SourceInterface); err != nil {
 				return err
 			}
 			buf.WriteString(`</source-interface>`)
@@ -1039,7 +1424,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.SourceAddress != "" {
 			buf.WriteString(`        <source-address>`)
-			if err := xml.EscapeText(buf, []byte(entry.SourceAddress)); err != nil {
+			if err := writeEscapedText(buf, entry.SourceAddress); err != nil {
 				return err
 			}
 			buf.WriteString(`</source-address>`)
@@ -1047,7 +1432,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.MulticastGroup != "" {
 			buf.WriteString(`        <multicast-group>`)
-			if err := xml.EscapeText(buf, []byte(entry.MulticastGroup)); err != nil {
+			if err := writeEscapedText(buf, entry.MulticastGroup); err != nil {
 				return err
 			}
 			buf.WriteString(`</multicast-group>`)
@@ -1055,7 +1440,7 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 		}
 		if entry.RemoteVTEP != "" {
 			buf.WriteString(`        <remote-vtep>`)
-			if err := xml.EscapeText(buf, []byte(entry.RemoteVTEP)); err != nil {
+			if err := writeEscapedText(buf, entry.RemoteVTEP); err != nil {
 				return err
 			}
 			buf.WriteString(`</remote-vtep>`)
@@ -1070,18 +1455,21 @@ func writeEVPNXML(buf *bytes.Buffer, evpn *config.EVPNConfig) error {
 }
 
 // writeOSPFXML writes OSPF configuration to XML
-func writeOSPFXML(buf *bytes.Buffer, element string, ospf *config.OSPFConfig) error {
+func writeOSPFXML(buf xmlWriter, element string, ospf *config.OSPFConfig) error {
 	fmt.Fprintf(buf, "    <%s>", element)
 	buf.WriteString("\n")
 
 	if ospf.RouterID != "" {
 		buf.WriteString(`      <router-id>`)
-		if err := xml.EscapeText(buf, []byte(ospf.RouterID)); err != nil {
+		if err := writeEscapedText(buf, ospf.RouterID); err != nil {
 			return err
 		}
 		buf.WriteString(`</router-id>`)
 		buf.WriteString("\n")
 	}
+	if ospf.ReferenceBandwidth != 0 {
+		fmt.Fprintf(buf, "      <reference-bandwidth>%d</reference-bandwidth>\n", ospf.ReferenceBandwidth)
+	}
 
 	if len(ospf.Areas) > 0 {
 		for _, areaName := range sortedStringKeys(ospf.Areas) {
@@ -1093,14 +1481,14 @@ func writeOSPFXML(buf *bytes.Buffer, element string, ospf *config.OSPFConfig) er
 			buf.WriteString("\n")
 
 			buf.WriteString(`        <name>`)
-			if err := xml.EscapeText(buf, []byte(areaName)); err != nil {
+			if err := writeEscapedText(buf, areaName); err != nil {
 				return err
 			}
 			buf.WriteString(`</name>`)
 			buf.WriteString("\n")
 
 			buf.WriteString(`        <area-id>`)
-			if err := xml.EscapeText(buf, []byte(area.AreaID)); err != nil {
+			if err := writeEscapedText(buf, area.AreaID); err != nil {
 				return err
 			}
 			buf.WriteString(`</area-id>`)
@@ -1117,7 +1505,7 @@ func writeOSPFXML(buf *bytes.Buffer, element string, ospf *config.OSPFConfig) er
 					buf.WriteString("\n")
 
 					buf.WriteString(`          <name>`)
-					if err := xml.EscapeText(buf, []byte(ospfIface.Name)); err != nil {
+					if err := writeEscapedText(buf, ospfIface.Name); err != nil {
 						return err
 					}
 					buf.WriteString(`</name>`)
@@ -1128,12 +1516,12 @@ func writeOSPFXML(buf *bytes.Buffer, element string, ospf *config.OSPFConfig) er
 						buf.WriteString("\n")
 					}
 
-					if ospfIface.Metric > 0 {
-						fmt.Fprintf(buf, "          <metric>%d</metric>\n", ospfIface.Metric)
+					if ospfIface.Metric != nil {
+						fmt.Fprintf(buf, "          <metric>%d</metric>\n", *ospfIface.Metric)
 					}
 
-					if ospfIface.PrioritySet || ospfIface.Priority > 0 {
-						fmt.Fprintf(buf, "          <priority>%d</priority>\n", ospfIface.Priority)
+					if ospfIface.Priority != nil {
+						fmt.Fprintf(buf, "          <priority>%d</priority>\n", *ospfIface.Priority)
 					}
 
 					if ospfIface.BFD || ospfIface.BFDProfile != "" {
@@ -1143,7 +1531,7 @@ func writeOSPFXML(buf *bytes.Buffer, element string, ospf *config.OSPFConfig) er
 
 					if ospfIface.BFDProfile != "" {
 						buf.WriteString(`          <bfd-profile>`)
-						if err := xml.EscapeText(buf, []byte(ospfIface.BFDProfile)); err != nil {
+						if err := writeEscapedText(buf, ospfIface.BFDProfile); err != nil {
 							return err
 						}
 						buf.WriteString(`</bfd-profile>`)
@@ -1165,7 +1553,7 @@ func writeOSPFXML(buf *bytes.Buffer, element string, ospf *config.OSPFConfig) er
 	return nil
 }
 
-func writeMPLSXML(buf *bytes.Buffer, mpls *config.MPLSConfig) error {
+func writeMPLSXML(buf xmlWriter, mpls *config.MPLSConfig) error {
 	if len(mpls.Interfaces) == 0 {
 		return nil
 	}
@@ -1179,7 +1567,63 @@ func writeMPLSXML(buf *bytes.Buffer, mpls *config.MPLSConfig) error {
 	return nil
 }
 
-func writeVRRPXML(buf *bytes.Buffer, vrrp *config.VRRPConfig) error {
+func writeRIPXML(buf xmlWriter, rip *config.RIPConfig) error {
+	buf.WriteString(`    <rip>`)
+	buf.WriteString("\n")
+	if rip.UpdateInterval != 0 {
+		fmt.Fprintf(buf, "      <update-interval>%d</update-interval>\n", rip.UpdateInterval)
+	}
+	if rip.RouteTimeout != 0 {
+		fmt.Fprintf(buf, "      <route-timeout>%d</route-timeout>\n", rip.RouteTimeout)
+	}
+	if rip.Holddown != 0 {
+		fmt.Fprintf(buf, "      <holddown>%d</holddown>\n", rip.Holddown)
+	}
+	if rip.DefaultMetric != 0 {
+		fmt.Fprintf(buf, "      <default-metric>%d</default-metric>\n", rip.DefaultMetric)
+	}
+	for _, name := range sortedStringKeys(rip.Groups) {
+		group := rip.Groups[name]
+		if group == nil {
+			continue
+		}
+		buf.WriteString(`      <group>`)
+		buf.WriteString("\n")
+		buf.WriteString(`        <name>`)
+		if err := writeEscapedText(buf, name); err != nil {
+			return err
+		}
+		buf.WriteString(`</name>`)
+		buf.WriteString("\n")
+		for _, ifName := range sortedStringKeys(group.Neighbors) {
+			neighbor := group.Neighbors[ifName]
+			if neighbor == nil {
+				continue
+			}
+			buf.WriteString(`        <neighbor>`)
+			buf.WriteString("\n")
+			buf.WriteString(`          <name>`)
+			if err := writeEscapedText(buf, ifName); err != nil {
+				return err
+			}
+			buf.WriteString(`</name>`)
+			buf.WriteString("\n")
+			if neighbor.Passive {
+				buf.WriteString(`          <passive>true</passive>`)
+				buf.WriteString("\n")
+			}
+			buf.WriteString(`        </neighbor>`)
+			buf.WriteString("\n")
+		}
+		buf.WriteString(`      </group>`)
+		buf.WriteString("\n")
+	}
+	buf.WriteString(`    </rip>`)
+	buf.WriteString("\n")
+	return nil
+}
+
+func writeVRRPXML(buf xmlWriter, vrrp *config.VRRPConfig) error {
 	if len(vrrp.Groups) == 0 {
 		return nil
 	}
@@ -1193,14 +1637,14 @@ func writeVRRPXML(buf *bytes.Buffer, vrrp *config.VRRPConfig) error {
 		buf.WriteString(`      <group>`)
 		buf.WriteString("\n")
 		buf.WriteString(`        <name>`)
-		if err := xml.EscapeText(buf, []byte(name)); err != nil {
+		if err := writeEscapedText(buf, name); err != nil {
 			return err
 		}
 		buf.WriteString(`</name>`)
 		buf.WriteString("\n")
 		if group.Interface != "" {
 			buf.WriteString(`        <interface>`)
-			if err := xml.EscapeText(buf, []byte(group.Interface)); err != nil {
+			if err := writeEscapedText(buf, group.Interface); err != nil {
 				return err
 			}
 			buf.WriteString(`</interface>`)
@@ -1208,7 +1652,7 @@ func writeVRRPXML(buf *bytes.Buffer, vrrp *config.VRRPConfig) error {
 		}
 		if group.VirtualAddress != "" {
 			buf.WriteString(`        <virtual-address>`)
-			if err := xml.EscapeText(buf, []byte(group.VirtualAddress)); err != nil {
+			if err := writeEscapedText(buf, group.VirtualAddress); err != nil {
 				return err
 			}
 			buf.WriteString(`</virtual-address>`)
@@ -1229,7 +1673,7 @@ func writeVRRPXML(buf *bytes.Buffer, vrrp *config.VRRPConfig) error {
 	return nil
 }
 
-func writeClassOfServiceXML(buf *bytes.Buffer, cos *config.ClassOfServiceConfig) error {
+func writeClassOfServiceXML(buf xmlWriter, cos *config.ClassOfServiceConfig) error {
 	buf.WriteString(`  <class-of-service xmlns="` + ArcaConfigNS + `">`)
 	buf.WriteString("\n")
 
@@ -1244,7 +1688,7 @@ func writeClassOfServiceXML(buf *bytes.Buffer, cos *config.ClassOfServiceConfig)
 			buf.WriteString(`      <forwarding-class>`)
 			buf.WriteString("\n")
 			buf.WriteString(`        <name>`)
-			if err := xml.EscapeText(buf, []byte(name)); err != nil {
+			if err := writeEscapedText(buf, name); err != nil {
 				return err
 			}
 			buf.WriteString(`</name>`)
@@ -1268,7 +1712,7 @@ func writeClassOfServiceXML(buf *bytes.Buffer, cos *config.ClassOfServiceConfig)
 			buf.WriteString(`      <traffic-control-profile>`)
 			buf.WriteString("\n")
 			buf.WriteString(`        <name>`)
-			if err := xml.EscapeText(buf, []byte(name)); err != nil {
+			if err := writeEscapedText(buf, name); err != nil {
 				return err
 			}
 			buf.WriteString(`</name>`)
@@ -1278,7 +1722,7 @@ func writeClassOfServiceXML(buf *bytes.Buffer, cos *config.ClassOfServiceConfig)
 			}
 			if profile.SchedulerMap != "" {
 				buf.WriteString(`        <scheduler-map>`)
-				if err := xml.EscapeText(buf, []byte(profile.SchedulerMap)); err != nil {
+				if err := writeEscapedText(buf, profile.SchedulerMap); err != nil {
 					return err
 				}
 				buf.WriteString(`</scheduler-map>`)
@@ -1302,14 +1746,14 @@ func writeClassOfServiceXML(buf *bytes.Buffer, cos *config.ClassOfServiceConfig)
 			buf.WriteString(`      <interface>`)
 			buf.WriteString("\n")
 			buf.WriteString(`        <name>`)
-			if err := xml.EscapeText(buf, []byte(name)); err != nil {
+			if err := writeEscapedText(buf, name); err != nil {
 				return err
 			}
 			buf.WriteString(`</name>`)
 			buf.WriteString("\n")
 			if iface.OutputTrafficControlProfile != "" {
 				buf.WriteString(`        <output-traffic-control-profile>`)
-				if err := xml.EscapeText(buf, []byte(iface.OutputTrafficControlProfile)); err != nil {
+				if err := writeEscapedText(buf, iface.OutputTrafficControlProfile); err != nil {
 					return err
 				}
 				buf.WriteString(`</output-traffic-control-profile>`)
@@ -1327,20 +1771,66 @@ func writeClassOfServiceXML(buf *bytes.Buffer, cos *config.ClassOfServiceConfig)
 	return nil
 }
 
-func writeSecurityXML(buf *bytes.Buffer, security *config.SecurityConfig) error {
-	if (security.NETCONF == nil || security.NETCONF.SSH == nil || security.NETCONF.SSH.Port == 0) && security.RateLimit == nil {
-		return nil
-	}
-
-	buf.WriteString(`  <security xmlns="` + ArcaConfigNS + `">`)
+func writeFirewallXML(buf xmlWriter, fw *config.FirewallConfig) error {
+	buf.WriteString(`  <firewall xmlns="` + ArcaConfigNS + `">`)
 	buf.WriteString("\n")
-	if security.NETCONF != nil && security.NETCONF.SSH != nil && security.NETCONF.SSH.Port != 0 {
-		buf.WriteString(`    <netconf>`)
-		buf.WriteString("\n")
-		buf.WriteString(`      <ssh>`)
-		buf.WriteString("\n")
-		fmt.Fprintf(buf, "        <port>%d</port>\n", security.NETCONF.SSH.Port)
-		buf.WriteString(`      </ssh>`)
+
+	if len(fw.Policers) > 0 {
+		buf.WriteString(`    <policers>`)
+		buf.WriteString("\n")
+		for _, name := range sortedStringKeys(fw.Policers) {
+			policer := fw.Policers[name]
+			if policer == nil {
+				continue
+			}
+			buf.WriteString(`      <policer>`)
+			buf.WriteString("\n")
+			buf.WriteString(`        <name>`)
+			if err := writeEscapedText(buf, name); err != nil {
+				return err
+			}
+			buf.WriteString(`</name>`)
+			buf.WriteString("\n")
+			if policer.BandwidthLimit != 0 {
+				fmt.Fprintf(buf, "        <bandwidth-limit>%d</bandwidth-limit>\n", policer.BandwidthLimit)
+			}
+			if policer.BurstSizeLimit != 0 {
+				fmt.Fprintf(buf, "        <burst-size-limit>%d</burst-size-limit>\n", policer.BurstSizeLimit)
+			}
+			if policer.Action != "" {
+				buf.WriteString(`        <then>`)
+				if err := writeEscapedText(buf, policer.Action); err != nil {
+					return err
+				}
+				buf.WriteString(`</then>`)
+				buf.WriteString("\n")
+			}
+			buf.WriteString(`      </policer>`)
+			buf.WriteString("\n")
+		}
+		buf.WriteString(`    </policers>`)
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString(`  </firewall>`)
+	buf.WriteString("\n")
+	return nil
+}
+
+func writeSecurityXML(buf xmlWriter, security *config.SecurityConfig) error {
+	if (security.NETCONF == nil || security.NETCONF.SSH == nil || security.NETCONF.SSH.Port == 0) && security.RateLimit == nil {
+		return nil
+	}
+
+	buf.WriteString(`  <security xmlns="` + ArcaConfigNS + `">`)
+	buf.WriteString("\n")
+	if security.NETCONF != nil && security.NETCONF.SSH != nil && security.NETCONF.SSH.Port != 0 {
+		buf.WriteString(`    <netconf>`)
+		buf.WriteString("\n")
+		buf.WriteString(`      <ssh>`)
+		buf.WriteString("\n")
+		fmt.Fprintf(buf, "        <port>%d</port>\n", security.NETCONF.SSH.Port)
+		buf.WriteString(`      </ssh>`)
 		buf.WriteString("\n")
 		buf.WriteString(`    </netconf>`)
 		buf.WriteString("\n")
@@ -1362,18 +1852,39 @@ func writeSecurityXML(buf *bytes.Buffer, security *config.SecurityConfig) error
 	return nil
 }
 
+// writeInactiveXML writes the deactivated configuration paths in the form
+// used by "deactivate" statements, e.g. "interfaces ge-0/0/0".
+func writeInactiveXML(buf xmlWriter, inactive []string) error {
+	buf.WriteString(`  <inactive xmlns="` + ArcaConfigNS + `">`)
+	buf.WriteString("\n")
+	paths := append([]string(nil), inactive...)
+	sort.Strings(paths)
+	for _, path := range paths {
+		buf.WriteString(`    <path>`)
+		if err := writeEscapedText(buf, path); err != nil {
+			return err
+		}
+		buf.WriteString(`</path>`)
+		buf.WriteString("\n")
+	}
+	buf.WriteString(`  </inactive>`)
+	buf.WriteString("\n")
+	return nil
+}
+
 // filterMatches is now implemented in xpath_filter.go
 // This placeholder is kept for reference only
 
 type xmlOSPFProtocol struct {
-	RouterID string `xml:"router-id"`
-	Areas    []struct {
+	RouterID           string `xml:"router-id"`
+	ReferenceBandwidth uint64 `xml:"reference-bandwidth"`
+	Areas              []struct {
 		Name       string `xml:"name"`
 		AreaID     string `xml:"area-id"`
 		Interfaces []struct {
 			Name       string `xml:"name"`
 			Passive    bool   `xml:"passive"`
-			Metric     int    `xml:"metric"`
+			Metric     *int   `xml:"metric"`
 			Priority   *int   `xml:"priority"`
 			BFD        bool   `xml:"bfd"`
 			BFDProfile string `xml:"bfd-profile"`
@@ -1406,6 +1917,61 @@ type xmlBFDProtocol struct {
 	} `xml:"peer"`
 }
 
+// xmlInterface is the edit-config form of an interface, used both for
+// top-level interfaces and for the interface statements of a group.
+type xmlInterface struct {
+	Name          string   `xml:"name"`
+	ApplyGroups   []string `xml:"apply-groups"`
+	Description   string   `xml:"description"`
+	Promiscuous   bool     `xml:"promiscuous"`
+	RxMode        string   `xml:"rx-mode"`
+	MTU           int      `xml:"mtu"`
+	Bandwidth     uint64   `xml:"bandwidth"`
+	InputPolicer  string   `xml:"input-policer"`
+	OutputPolicer string   `xml:"output-policer"`
+	Tunnel        *struct {
+		Source      string `xml:"source"`
+		Destination string `xml:"destination"`
+	} `xml:"tunnel"`
+	HoldTime *struct {
+		Up   int `xml:"up"`
+		Down int `xml:"down"`
+	} `xml:"hold-time"`
+	GigEtherOptions *struct {
+		RxQueues int `xml:"rxq"`
+		TxQueues int `xml:"txq"`
+		RxQueue  []struct {
+			Name   int `xml:"name"`
+			Worker int `xml:"worker"`
+		} `xml:"rx-queue"`
+	} `xml:"gigether-options"`
+	Units []struct {
+		Name   int `xml:"name"`
+		Family []struct {
+			Name      string   `xml:"name"`
+			Addresses []string `xml:"address"`
+			MTU       int      `xml:"mtu"`
+			Neighbors []struct {
+				Address string `xml:"address"`
+				MAC     string `xml:"mac"`
+			} `xml:"neighbor"`
+			RouterAdvertisement *xmlRouterAdvertisement `xml:"router-advertisement"`
+		} `xml:"family"`
+	} `xml:"unit"`
+}
+
+type xmlRouterAdvertisement struct {
+	ManagedConfiguration       bool `xml:"managed-configuration"`
+	OtherStatefulConfiguration bool `xml:"other-stateful-configuration"`
+	MaxAdvertisementInterval   int  `xml:"max-advertisement-interval"`
+	MinAdvertisementInterval   int  `xml:"min-advertisement-interval"`
+	Prefixes                   []struct {
+		Name              string `xml:"name"`
+		ValidLifetime     int    `xml:"valid-lifetime"`
+		PreferredLifetime int    `xml:"preferred-lifetime"`
+	} `xml:"prefix"`
+}
+
 type xmlEVPNProtocol struct {
 	VNIs []struct {
 		ID                 int      `xml:"id"`
@@ -1486,13 +2052,33 @@ func evpnConfigFromXML(evpn *xmlEVPNProtocol) *config.EVPNConfig {
 	return cfgEVPN
 }
 
+func routerAdvertisementFromXML(ra *xmlRouterAdvertisement) *config.RouterAdvertisement {
+	cfgRA := &config.RouterAdvertisement{
+		ManagedConfiguration:       ra.ManagedConfiguration,
+		OtherStatefulConfiguration: ra.OtherStatefulConfiguration,
+		MaxAdvertisementInterval:   ra.MaxAdvertisementInterval,
+		MinAdvertisementInterval:   ra.MinAdvertisementInterval,
+	}
+	for _, prefix := range ra.Prefixes {
+		if cfgRA.Prefixes == nil {
+			cfgRA.Prefixes = make(map[string]*config.RouterAdvertisementPrefix)
+		}
+		cfgRA.Prefixes[prefix.Name] = &config.RouterAdvertisementPrefix{
+			ValidLifetime:     prefix.ValidLifetime,
+			PreferredLifetime: prefix.PreferredLifetime,
+		}
+	}
+	return cfgRA
+}
+
 func ospfConfigFromXML(ospf *xmlOSPFProtocol) *config.OSPFConfig {
 	if ospf == nil {
 		return nil
 	}
 	cfgOSPF := &config.OSPFConfig{
-		RouterID: ospf.RouterID,
-		Areas:    make(map[string]*config.OSPFArea),
+		RouterID:           ospf.RouterID,
+		ReferenceBandwidth: ospf.ReferenceBandwidth,
+		Areas:              make(map[string]*config.OSPFArea),
 	}
 	for _, area := range ospf.Areas {
 		cfgArea := &config.OSPFArea{
@@ -1500,20 +2086,13 @@ func ospfConfigFromXML(ospf *xmlOSPFProtocol) *config.OSPFConfig {
 			Interfaces: make(map[string]*config.OSPFInterface),
 		}
 		for _, ospfIface := range area.Interfaces {
-			priority := 0
-			prioritySet := false
-			if ospfIface.Priority != nil {
-				priority = *ospfIface.Priority
-				prioritySet = true
-			}
 			cfgArea.Interfaces[ospfIface.Name] = &config.OSPFInterface{
-				Name:        ospfIface.Name,
-				Passive:     ospfIface.Passive,
-				Metric:      ospfIface.Metric,
-				Priority:    priority,
-				PrioritySet: prioritySet,
-				BFD:         ospfIface.BFD || ospfIface.BFDProfile != "",
-				BFDProfile:  ospfIface.BFDProfile,
+				Name:       ospfIface.Name,
+				Passive:    ospfIface.Passive,
+				Metric:     ospfIface.Metric,
+				Priority:   ospfIface.Priority,
+				BFD:        ospfIface.BFD || ospfIface.BFDProfile != "",
+				BFDProfile: ospfIface.BFDProfile,
 			}
 		}
 		cfgOSPF.Areas[area.Name] = cfgArea
@@ -1523,10 +2102,34 @@ func ospfConfigFromXML(ospf *xmlOSPFProtocol) *config.OSPFConfig {
 
 // XMLToConfig converts NETCONF XML to internal config structure.
 func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, error) {
+	return XMLToConfigWithLimit(xmlData, defaultOp, MaxXMLSize)
+}
+
+// XMLToConfigWithLimit is XMLToConfig with a caller-chosen size limit for
+// xmlData in place of MaxXMLSize.
+func XMLToConfigWithLimit(xmlData []byte, defaultOp DefaultOperation, maxSize int) (*config.Config, error) {
+	return xmlToConfig(xmlData, defaultOp, maxSize, nil)
+}
+
+// xmlToConfigEdit parses an edit-config payload. Unlike other configuration
+// payloads it may carry operation attributes on <inactive> and its <path>
+// entries; those are returned separately for applyInactiveEdit.
+func xmlToConfigEdit(xmlData []byte, defaultOp DefaultOperation, maxSize int) (*config.Config, *inactiveEdit, error) {
+	ops := &inactiveEdit{}
+	cfg, err := xmlToConfig(xmlData, defaultOp, maxSize, ops)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, ops, nil
+}
+
+// xmlToConfig implements XMLToConfigWithLimit. Operation attributes on
+// <inactive> are accepted and recorded in ops only when ops is non-nil.
+func xmlToConfig(xmlData []byte, defaultOp DefaultOperation, maxSize int, ops *inactiveEdit) (*config.Config, error) {
 	// Security: Validate size
-	if len(xmlData) > MaxXMLSize {
+	if len(xmlData) > maxSize {
 		return nil, NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue,
-			fmt.Sprintf("XML size exceeds maximum (%d bytes)", MaxXMLSize)).
+			fmt.Sprintf("XML size exceeds maximum (%d bytes)", maxSize)).
 			WithPath("/rpc/edit-config/config").
 			WithAppTag("size-limit")
 	}
@@ -1540,7 +2143,7 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 	if err != nil {
 		return nil, err
 	}
-	if err := validateConfigXMLAllowlist(normalizedXML); err != nil {
+	if err := validateConfigXMLAllowlist(normalizedXML, ops != nil); err != nil {
 		return nil, err
 	}
 
@@ -1567,6 +2170,18 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 					Community     string `xml:"community"`
 				} `xml:"snmp"`
 			} `xml:"services"`
+			Scripts *struct {
+				Commit *struct {
+					Pre  []string `xml:"pre"`
+					Post []string `xml:"post"`
+				} `xml:"commit"`
+			} `xml:"scripts"`
+			Alarms *struct {
+				Interface *struct {
+					ErrorRate uint64 `xml:"error-rate"`
+					LinkDown  bool   `xml:"link-down"`
+				} `xml:"interface"`
+			} `xml:"alarms"`
 		} `xml:"system"`
 		Chassis *struct {
 			Cluster *struct {
@@ -1583,18 +2198,12 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 				} `xml:"sync"`
 			} `xml:"cluster"`
 		} `xml:"chassis"`
-		Interfaces []struct {
-			Name        string `xml:"name"`
-			Description string `xml:"description"`
-			Units       []struct {
-				Name   int `xml:"name"`
-				Family []struct {
-					Name      string   `xml:"name"`
-					Addresses []string `xml:"address"`
-				} `xml:"family"`
-			} `xml:"unit"`
-		} `xml:"interfaces>interface"`
-		Routing *struct {
+		Groups []struct {
+			Name       string         `xml:"name"`
+			Interfaces []xmlInterface `xml:"interfaces>interface"`
+		} `xml:"groups>group"`
+		Interfaces []xmlInterface `xml:"interfaces>interface"`
+		Routing    *struct {
 			RouterID         string `xml:"router-id"`
 			AutonomousSystem uint32 `xml:"autonomous-system"`
 			StaticRoutes     []struct {
@@ -1621,18 +2230,28 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 		Protocols *struct {
 			BFD *xmlBFDProtocol `xml:"bfd"`
 			BGP *struct {
-				Groups []struct {
-					Name      string `xml:"name"`
-					Type      string `xml:"type"`
-					Import    string `xml:"import"`
-					Export    string `xml:"export"`
-					Neighbors []struct {
-						IP           string `xml:"ip"`
-						PeerAS       uint32 `xml:"peer-as"`
-						Description  string `xml:"description"`
-						LocalAddress string `xml:"local-address"`
-						BFD          bool   `xml:"bfd"`
-						BFDProfile   string `xml:"bfd-profile"`
+				RouterID string `xml:"router-id"`
+				Groups   []struct {
+					Name            string `xml:"name"`
+					Type            string `xml:"type"`
+					Import          string `xml:"import"`
+					Export          string `xml:"export"`
+					Cluster         string `xml:"cluster"`
+					NextHopSelf     bool   `xml:"next-hop-self"`
+					RemovePrivateAS bool   `xml:"remove-private-as"`
+					Neighbors       []struct {
+						IP                   string `xml:"ip"`
+						PeerAS               uint32 `xml:"peer-as"`
+						Description          string `xml:"description"`
+						LocalAddress         string `xml:"local-address"`
+						UpdateSource         string `xml:"update-source"`
+						BFD                  bool   `xml:"bfd"`
+						BFDProfile           string `xml:"bfd-profile"`
+						Cluster              string `xml:"cluster"`
+						RouteReflectorClient bool   `xml:"route-reflector-client"`
+						Shutdown             bool   `xml:"shutdown"`
+						NextHopSelf          bool   `xml:"next-hop-self"`
+						RemovePrivateAS      bool   `xml:"remove-private-as"`
 					} `xml:"neighbor"`
 				} `xml:"group"`
 			} `xml:"bgp"`
@@ -1642,6 +2261,19 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 			MPLS  *struct {
 				Interfaces []string `xml:"interface"`
 			} `xml:"mpls"`
+			RIP *struct {
+				UpdateInterval int `xml:"update-interval"`
+				RouteTimeout   int `xml:"route-timeout"`
+				Holddown       int `xml:"holddown"`
+				DefaultMetric  int `xml:"default-metric"`
+				Groups         []struct {
+					Name      string `xml:"name"`
+					Neighbors []struct {
+						Name    string `xml:"name"`
+						Passive bool   `xml:"passive"`
+					} `xml:"neighbor"`
+				} `xml:"group"`
+			} `xml:"rip"`
 			VRRP *struct {
 				Groups []struct {
 					Name           string `xml:"name"`
@@ -1667,6 +2299,14 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 				OutputTrafficControlProfile string `xml:"output-traffic-control-profile"`
 			} `xml:"interfaces>interface"`
 		} `xml:"class-of-service"`
+		Firewall *struct {
+			Policers []struct {
+				Name           string `xml:"name"`
+				BandwidthLimit uint64 `xml:"bandwidth-limit"`
+				BurstSizeLimit uint64 `xml:"burst-size-limit"`
+				Then           string `xml:"then"`
+			} `xml:"policers>policer"`
+		} `xml:"firewall"`
 		Security *struct {
 			NETCONF *struct {
 				SSH *struct {
@@ -1678,6 +2318,13 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 				PerUser int `xml:"per-user"`
 			} `xml:"rate-limit"`
 		} `xml:"security"`
+		Inactive *struct {
+			Operation string `xml:"operation,attr"`
+			Paths     []struct {
+				Operation string `xml:"operation,attr"`
+				Value     string `xml:",chardata"`
+			} `xml:"path"`
+		} `xml:"inactive"`
 	}
 
 	// Parse with strict settings
@@ -1724,6 +2371,18 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 				}
 			}
 		}
+		if root.System.Scripts != nil && root.System.Scripts.Commit != nil {
+			cfg.System.Scripts = &config.SystemScriptsConfig{
+				CommitPre:  append([]string(nil), root.System.Scripts.Commit.Pre...),
+				CommitPost: append([]string(nil), root.System.Scripts.Commit.Post...),
+			}
+		}
+		if root.System.Alarms != nil && root.System.Alarms.Interface != nil {
+			cfg.System.Alarms = &config.SystemAlarmsConfig{
+				InterfaceErrorRate: root.System.Alarms.Interface.ErrorRate,
+				InterfaceLinkDown:  root.System.Alarms.Interface.LinkDown,
+			}
+		}
 	}
 
 	// Chassis
@@ -1750,21 +2409,20 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 		}
 	}
 
-	// Interfaces
-	for _, iface := range root.Interfaces {
-		cfgIface := cfg.GetOrCreateInterface(iface.Name)
-		cfgIface.Description = iface.Description
-
-		for _, unit := range iface.Units {
-			cfgUnit := cfgIface.GetOrCreateUnit(unit.Name)
-
-			for _, family := range unit.Family {
-				cfgFamily := cfgUnit.GetOrCreateFamily(family.Name)
-				cfgFamily.Addresses = append(cfgFamily.Addresses, family.Addresses...)
-			}
+	// Configuration groups
+	for _, group := range root.Groups {
+		if cfg.Groups == nil {
+			cfg.Groups = make(map[string]*config.Config)
+		}
+		if cfg.Groups[group.Name] == nil {
+			cfg.Groups[group.Name] = config.NewConfig()
 		}
+		interfacesFromXML(cfg.Groups[group.Name], group.Interfaces)
 	}
 
+	// Interfaces
+	interfacesFromXML(cfg, root.Interfaces)
+
 	// Routing options
 	if root.Routing != nil {
 		cfg.RoutingOptions = &config.RoutingOptions{
@@ -1815,25 +2473,35 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 		// BGP
 		if root.Protocols.BGP != nil {
 			cfg.Protocols.BGP = &config.BGPConfig{
-				Groups: make(map[string]*config.BGPGroup),
+				RouterID: root.Protocols.BGP.RouterID,
+				Groups:   make(map[string]*config.BGPGroup),
 			}
 
 			for _, group := range root.Protocols.BGP.Groups {
 				cfgGroup := &config.BGPGroup{
-					Type:      group.Type,
-					Import:    group.Import,
-					Export:    group.Export,
-					Neighbors: make(map[string]*config.BGPNeighbor),
+					Type:            group.Type,
+					Import:          group.Import,
+					Export:          group.Export,
+					Cluster:         group.Cluster,
+					NextHopSelf:     group.NextHopSelf,
+					RemovePrivateAS: group.RemovePrivateAS,
+					Neighbors:       make(map[string]*config.BGPNeighbor),
 				}
 
 				for _, neighbor := range group.Neighbors {
 					cfgGroup.Neighbors[neighbor.IP] = &config.BGPNeighbor{
-						IP:           neighbor.IP,
-						PeerAS:       neighbor.PeerAS,
-						Description:  neighbor.Description,
-						LocalAddress: neighbor.LocalAddress,
-						BFD:          neighbor.BFD || neighbor.BFDProfile != "",
-						BFDProfile:   neighbor.BFDProfile,
+						IP:                   neighbor.IP,
+						PeerAS:               neighbor.PeerAS,
+						Description:          neighbor.Description,
+						LocalAddress:         neighbor.LocalAddress,
+						UpdateSource:         neighbor.UpdateSource,
+						BFD:                  neighbor.BFD || neighbor.BFDProfile != "",
+						BFDProfile:           neighbor.BFDProfile,
+						Cluster:              neighbor.Cluster,
+						RouteReflectorClient: neighbor.RouteReflectorClient,
+						Shutdown:             neighbor.Shutdown,
+						NextHopSelf:          neighbor.NextHopSelf,
+						RemovePrivateAS:      neighbor.RemovePrivateAS,
 					}
 				}
 
@@ -1860,6 +2528,30 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 			}
 		}
 
+		// RIP
+		if root.Protocols.RIP != nil {
+			cfg.Protocols.RIP = &config.RIPConfig{
+				UpdateInterval: root.Protocols.RIP.UpdateInterval,
+				RouteTimeout:   root.Protocols.RIP.RouteTimeout,
+				Holddown:       root.Protocols.RIP.Holddown,
+				DefaultMetric:  root.Protocols.RIP.DefaultMetric,
+				Groups:         make(map[string]*config.RIPGroup),
+			}
+			for _, group := range root.Protocols.RIP.Groups {
+				ripGroup := &config.RIPGroup{
+					Name:      group.Name,
+					Neighbors: make(map[string]*config.RIPNeighbor),
+				}
+				for _, neighbor := range group.Neighbors {
+					ripGroup.Neighbors[neighbor.Name] = &config.RIPNeighbor{
+						Name:    neighbor.Name,
+						Passive: neighbor.Passive,
+					}
+				}
+				cfg.Protocols.RIP.Groups[group.Name] = ripGroup
+			}
+		}
+
 		// VRRP
 		if root.Protocols.VRRP != nil {
 			cfg.Protocols.VRRP = &config.VRRPConfig{
@@ -1905,6 +2597,19 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 		}
 	}
 
+	// Firewall
+	if root.Firewall != nil {
+		cfg.Firewall = &config.FirewallConfig{Policers: make(map[string]*config.Policer)}
+		for _, policer := range root.Firewall.Policers {
+			cfg.Firewall.Policers[policer.Name] = &config.Policer{
+				Name:           policer.Name,
+				BandwidthLimit: policer.BandwidthLimit,
+				BurstSizeLimit: policer.BurstSizeLimit,
+				Action:         policer.Then,
+			}
+		}
+	}
+
 	// Security
 	if root.Security != nil {
 		cfg.Security = &config.SecurityConfig{}
@@ -1923,6 +2628,34 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 		}
 	}
 
+	// Deactivated subtrees
+	if root.Inactive != nil {
+		containerOp := root.Inactive.Operation
+		if containerOp == "merge" {
+			containerOp = ""
+		}
+		if ops != nil {
+			ops.operation = containerOp
+		}
+		for _, entry := range root.Inactive.Paths {
+			path := strings.TrimSpace(entry.Value)
+			if err := validateInactivePath(path); err != nil {
+				return nil, err
+			}
+			if containerOp != "" && entry.Operation != "" {
+				return nil, ErrBadAttribute("edit-config/config/inactive/path", "operation",
+					fmt.Sprintf("not allowed inside <inactive operation=%q>", containerOp))
+			}
+			if containerOp != "" || entry.Operation != "" {
+				ops.paths = append(ops.paths, inactivePathEdit{path: path, operation: entry.Operation})
+				continue
+			}
+			if !contains(cfg.Inactive, path) {
+				cfg.Inactive = append(cfg.Inactive, path)
+			}
+		}
+	}
+
 	// Validate depth and element count
 	if err := ValidateConfig(cfg); err != nil {
 		return nil, err
@@ -1931,7 +2664,62 @@ func XMLToConfig(xmlData []byte, defaultOp DefaultOperation) (*config.Config, er
 	return cfg, nil
 }
 
-var allowedConfigElementPaths = map[string]struct{}{
+// interfacesFromXML adds the interfaces of an edit-config payload to cfg.
+func interfacesFromXML(cfg *config.Config, interfaces []xmlInterface) {
+	for _, iface := range interfaces {
+		cfgIface := cfg.GetOrCreateInterface(iface.Name)
+		for _, group := range iface.ApplyGroups {
+			if !contains(cfgIface.ApplyGroups, group) {
+				cfgIface.ApplyGroups = append(cfgIface.ApplyGroups, group)
+			}
+		}
+		cfgIface.Description = iface.Description
+		cfgIface.Promiscuous = iface.Promiscuous
+		cfgIface.RxMode = iface.RxMode
+		cfgIface.MTU = iface.MTU
+		cfgIface.Bandwidth = iface.Bandwidth
+		cfgIface.InputPolicer = iface.InputPolicer
+		cfgIface.OutputPolicer = iface.OutputPolicer
+		if iface.Tunnel != nil {
+			cfgIface.Tunnel = &config.Tunnel{Source: iface.Tunnel.Source, Destination: iface.Tunnel.Destination}
+		}
+		if iface.HoldTime != nil {
+			cfgIface.HoldTime = &config.HoldTime{Up: iface.HoldTime.Up, Down: iface.HoldTime.Down}
+		}
+		if opts := iface.GigEtherOptions; opts != nil {
+			cfgIface.GigEtherOptions = &config.GigEtherOptions{RxQueues: opts.RxQueues, TxQueues: opts.TxQueues}
+			for _, queue := range opts.RxQueue {
+				if cfgIface.GigEtherOptions.RxPlacement == nil {
+					cfgIface.GigEtherOptions.RxPlacement = make(map[int]int)
+				}
+				cfgIface.GigEtherOptions.RxPlacement[queue.Name] = queue.Worker
+			}
+		}
+
+		for _, unit := range iface.Units {
+			cfgUnit := cfgIface.GetOrCreateUnit(unit.Name)
+
+			for _, family := range unit.Family {
+				cfgFamily := cfgUnit.GetOrCreateFamily(family.Name)
+				cfgFamily.Addresses = append(cfgFamily.Addresses, family.Addresses...)
+				if family.MTU != 0 {
+					cfgFamily.MTU = family.MTU
+				}
+				for _, neighbor := range family.Neighbors {
+					if cfgFamily.Neighbors == nil {
+						cfgFamily.Neighbors = make(map[string]string)
+					}
+					cfgFamily.Neighbors[neighbor.Address] = neighbor.MAC
+				}
+				if family.RouterAdvertisement != nil {
+					cfgFamily.RouterAdvertisement = routerAdvertisementFromXML(family.RouterAdvertisement)
+				}
+			}
+		}
+	}
+}
+
+var allowedConfigElementPaths = withGroupInterfacePaths(map[string]struct{}{
 	"config": {},
 
 	"config/system":                                    {},
@@ -1950,6 +2738,10 @@ var allowedConfigElementPaths = map[string]struct{}{
 	"config/system/services/snmp/listen-address":       {},
 	"config/system/services/snmp/port":                 {},
 	"config/system/services/snmp/community":            {},
+	"config/system/alarms":                             {},
+	"config/system/alarms/interface":                   {},
+	"config/system/alarms/interface/error-rate":        {},
+	"config/system/alarms/interface/link-down":         {},
 	"config/chassis":                                   {},
 	"config/chassis/cluster":                           {},
 	"config/chassis/cluster/enabled":                   {},
@@ -1961,15 +2753,46 @@ var allowedConfigElementPaths = map[string]struct{}{
 	"config/chassis/cluster/sync/etcd":                 {},
 	"config/chassis/cluster/sync/etcd/endpoint":        {},
 
-	"config/interfaces":                               {},
-	"config/interfaces/interface":                     {},
-	"config/interfaces/interface/name":                {},
-	"config/interfaces/interface/description":         {},
-	"config/interfaces/interface/unit":                {},
-	"config/interfaces/interface/unit/name":           {},
-	"config/interfaces/interface/unit/family":         {},
-	"config/interfaces/interface/unit/family/name":    {},
-	"config/interfaces/interface/unit/family/address": {},
+	"config/interfaces":                                                                         {},
+	"config/interfaces/interface":                                                               {},
+	"config/interfaces/interface/name":                                                          {},
+	"config/interfaces/interface/description":                                                   {},
+	"config/interfaces/interface/promiscuous":                                                   {},
+	"config/interfaces/interface/rx-mode":                                                       {},
+	"config/interfaces/interface/mtu":                                                           {},
+	"config/interfaces/interface/bandwidth":                                                     {},
+	"config/interfaces/interface/input-policer":                                                 {},
+	"config/interfaces/interface/output-policer":                                                {},
+	"config/interfaces/interface/tunnel":                                                        {},
+	"config/interfaces/interface/tunnel/source":                                                 {},
+	"config/interfaces/interface/tunnel/destination":                                            {},
+	"config/interfaces/interface/hold-time":                                                     {},
+	"config/interfaces/interface/hold-time/up":                                                  {},
+	"config/interfaces/interface/hold-time/down":                                                {},
+	"config/interfaces/interface/gigether-options":                                              {},
+	"config/interfaces/interface/gigether-options/rxq":                                          {},
+	"config/interfaces/interface/gigether-options/txq":                                          {},
+	"config/interfaces/interface/gigether-options/rx-queue":                                     {},
+	"config/interfaces/interface/gigether-options/rx-queue/name":                                {},
+	"config/interfaces/interface/gigether-options/rx-queue/worker":                              {},
+	"config/interfaces/interface/unit":                                                          {},
+	"config/interfaces/interface/unit/name":                                                     {},
+	"config/interfaces/interface/unit/family":                                                   {},
+	"config/interfaces/interface/unit/family/name":                                              {},
+	"config/interfaces/interface/unit/family/address":                                           {},
+	"config/interfaces/interface/unit/family/mtu":                                               {},
+	"config/interfaces/interface/unit/family/neighbor":                                          {},
+	"config/interfaces/interface/unit/family/neighbor/address":                                  {},
+	"config/interfaces/interface/unit/family/neighbor/mac":                                      {},
+	"config/interfaces/interface/unit/family/router-advertisement":                              {},
+	"config/interfaces/interface/unit/family/router-advertisement/managed-configuration":        {},
+	"config/interfaces/interface/unit/family/router-advertisement/other-stateful-configuration": {},
+	"config/interfaces/interface/unit/family/router-advertisement/max-advertisement-interval":   {},
+	"config/interfaces/interface/unit/family/router-advertisement/min-advertisement-interval":   {},
+	"config/interfaces/interface/unit/family/router-advertisement/prefix":                       {},
+	"config/interfaces/interface/unit/family/router-advertisement/prefix/name":                  {},
+	"config/interfaces/interface/unit/family/router-advertisement/prefix/valid-lifetime":        {},
+	"config/interfaces/interface/unit/family/router-advertisement/prefix/preferred-lifetime":    {},
 
 	"config/routing":                                  {},
 	"config/routing/router-id":                        {},
@@ -1996,89 +2819,111 @@ var allowedConfigElementPaths = map[string]struct{}{
 	"config/routing-instances/instance/vrf-export":          {},
 	"config/routing-instances/instance/interface":           {},
 
-	"config/protocols":                                  {},
-	"config/protocols/bfd":                              {},
-	"config/protocols/bfd/profile":                      {},
-	"config/protocols/bfd/profile/name":                 {},
-	"config/protocols/bfd/profile/detect-multiplier":    {},
-	"config/protocols/bfd/profile/receive-interval":     {},
-	"config/protocols/bfd/profile/transmit-interval":    {},
-	"config/protocols/bfd/profile/echo-mode":            {},
-	"config/protocols/bfd/profile/passive-mode":         {},
-	"config/protocols/bfd/peer":                         {},
-	"config/protocols/bfd/peer/address":                 {},
-	"config/protocols/bfd/peer/local-address":           {},
-	"config/protocols/bfd/peer/interface":               {},
-	"config/protocols/bfd/peer/vrf":                     {},
-	"config/protocols/bfd/peer/multihop":                {},
-	"config/protocols/bfd/peer/profile":                 {},
-	"config/protocols/bfd/peer/detect-multiplier":       {},
-	"config/protocols/bfd/peer/receive-interval":        {},
-	"config/protocols/bfd/peer/transmit-interval":       {},
-	"config/protocols/bfd/peer/echo-mode":               {},
-	"config/protocols/bfd/peer/passive-mode":            {},
-	"config/protocols/bfd/peer/shutdown":                {},
-	"config/protocols/bgp":                              {},
-	"config/protocols/bgp/group":                        {},
-	"config/protocols/bgp/group/name":                   {},
-	"config/protocols/bgp/group/type":                   {},
-	"config/protocols/bgp/group/import":                 {},
-	"config/protocols/bgp/group/export":                 {},
-	"config/protocols/bgp/group/neighbor":               {},
-	"config/protocols/bgp/group/neighbor/ip":            {},
-	"config/protocols/bgp/group/neighbor/peer-as":       {},
-	"config/protocols/bgp/group/neighbor/description":   {},
-	"config/protocols/bgp/group/neighbor/local-address": {},
-	"config/protocols/bgp/group/neighbor/bfd":           {},
-	"config/protocols/bgp/group/neighbor/bfd-profile":   {},
-	"config/protocols/evpn":                             {},
-	"config/protocols/evpn/vni":                         {},
-	"config/protocols/evpn/vni/id":                      {},
-	"config/protocols/evpn/vni/type":                    {},
-	"config/protocols/evpn/vni/bridge-domain":           {},
-	"config/protocols/evpn/vni/vlan-id":                 {},
-	"config/protocols/evpn/vni/routing-instance":        {},
-	"config/protocols/evpn/vni/route-distinguisher":     {},
-	"config/protocols/evpn/vni/vrf-target":              {},
-	"config/protocols/evpn/vni/vrf-target-import":       {},
-	"config/protocols/evpn/vni/vrf-target-export":       {},
-	"config/protocols/evpn/vni/source-interface":        {},
-	"config/protocols/evpn/vni/source-address":          {},
-	"config/protocols/evpn/vni/multicast-group":         {},
-	"config/protocols/evpn/vni/remote-vtep":             {},
-	"config/protocols/ospf":                             {},
-	"config/protocols/ospf/router-id":                   {},
-	"config/protocols/ospf/area":                        {},
-	"config/protocols/ospf/area/name":                   {},
-	"config/protocols/ospf/area/area-id":                {},
-	"config/protocols/ospf/area/interface":              {},
-	"config/protocols/ospf/area/interface/name":         {},
-	"config/protocols/ospf/area/interface/passive":      {},
-	"config/protocols/ospf/area/interface/metric":       {},
-	"config/protocols/ospf/area/interface/priority":     {},
-	"config/protocols/ospf/area/interface/bfd":          {},
-	"config/protocols/ospf/area/interface/bfd-profile":  {},
-	"config/protocols/ospf3":                            {},
-	"config/protocols/ospf3/router-id":                  {},
-	"config/protocols/ospf3/area":                       {},
-	"config/protocols/ospf3/area/name":                  {},
-	"config/protocols/ospf3/area/area-id":               {},
-	"config/protocols/ospf3/area/interface":             {},
-	"config/protocols/ospf3/area/interface/name":        {},
-	"config/protocols/ospf3/area/interface/passive":     {},
-	"config/protocols/ospf3/area/interface/metric":      {},
-	"config/protocols/ospf3/area/interface/priority":    {},
-	"config/protocols/ospf3/area/interface/bfd":         {},
-	"config/protocols/ospf3/area/interface/bfd-profile": {},
-	"config/protocols/mpls":                             {},
-	"config/protocols/mpls/interface":                   {},
-	"config/protocols/vrrp":                             {},
-	"config/protocols/vrrp/group":                       {},
-	"config/protocols/vrrp/group/name":                  {},
-	"config/protocols/vrrp/group/interface":             {},
-	"config/protocols/vrrp/group/virtual-address":       {},
-	"config/protocols/vrrp/group/priority":              {},
-	"config/protocols/vrrp/group/preempt":               {},
+	"config/protocols":                                           {},
+	"config/protocols/bfd":                                       {},
+	"config/protocols/bfd/profile":                               {},
+	"config/protocols/bfd/profile/name":                          {},
+	"config/protocols/bfd/profile/detect-multiplier":             {},
+	"config/protocols/bfd/profile/receive-interval":              {},
+	"config/protocols/bfd/profile/transmit-interval":             {},
+	"config/protocols/bfd/profile/echo-mode":                     {},
+	"config/protocols/bfd/profile/passive-mode":                  {},
+	"config/protocols/bfd/peer":                                  {},
+	"config/protocols/bfd/peer/address":                          {},
+	"config/protocols/bfd/peer/local-address":                    {},
+	"config/protocols/bfd/peer/interface":                        {},
+	"config/protocols/bfd/peer/vrf":                              {},
+	"config/protocols/bfd/peer/multihop":                         {},
+	"config/protocols/bfd/peer/profile":                          {},
+	"config/protocols/bfd/peer/detect-multiplier":                {},
+	"config/protocols/bfd/peer/receive-interval":                 {},
+	"config/protocols/bfd/peer/transmit-interval":                {},
+	"config/protocols/bfd/peer/echo-mode":                        {},
+	"config/protocols/bfd/peer/passive-mode":                     {},
+	"config/protocols/bfd/peer/shutdown":                         {},
+	"config/protocols/bgp":                                       {},
+	"config/protocols/bgp/router-id":                             {},
+	"config/protocols/bgp/group":                                 {},
+	"config/protocols/bgp/group/name":                            {},
+	"config/protocols/bgp/group/type":                            {},
+	"config/protocols/bgp/group/import":                          {},
+	"config/protocols/bgp/group/export":                          {},
+	"config/protocols/bgp/group/cluster":                         {},
+	"config/protocols/bgp/group/next-hop-self":                   {},
+	"config/protocols/bgp/group/remove-private-as":               {},
+	"config/protocols/bgp/group/neighbor":                        {},
+	"config/protocols/bgp/group/neighbor/ip":                     {},
+	"config/protocols/bgp/group/neighbor/peer-as":                {},
+	"config/protocols/bgp/group/neighbor/description":            {},
+	"config/protocols/bgp/group/neighbor/local-address":          {},
+	"config/protocols/bgp/group/neighbor/update-source":          {},
+	"config/protocols/bgp/group/neighbor/bfd":                    {},
+	"config/protocols/bgp/group/neighbor/bfd-profile":            {},
+	"config/protocols/bgp/group/neighbor/cluster":                {},
+	"config/protocols/bgp/group/neighbor/route-reflector-client": {},
+	"config/protocols/bgp/group/neighbor/shutdown":               {},
+	"config/protocols/bgp/group/neighbor/next-hop-self":          {},
+	"config/protocols/bgp/group/neighbor/remove-private-as":      {},
+	"config/protocols/evpn":                                      {},
+	"config/protocols/evpn/vni":                                  {},
+	"config/protocols/evpn/vni/id":                               {},
+	"config/protocols/evpn/vni/type":                             {},
+	"config/protocols/evpn/vni/bridge-domain":                    {},
+	"config/protocols/evpn/vni/vlan-id":                          {},
+	"config/protocols/evpn/vni/routing-instance":                 {},
+	"config/protocols/evpn/vni/route-distinguisher":              {},
+	"config/protocols/evpn/vni/vrf-target":                       {},
+	"config/protocols/evpn/vni/vrf-target-import":                {},
+	"config/protocols/evpn/vni/vrf-target-export":                {},
+	"config/protocols/evpn/vni/source-interface":                 {},
+	"config/protocols/evpn/vni/source-address":                   {},
+	"config/protocols/evpn/vni/multicast-group":                  {},
+	"config/protocols/evpn/vni/remote-vtep":                      {},
+	"config/protocols/ospf":                                      {},
+	"config/protocols/ospf/router-id":                            {},
+	"config/protocols/ospf/reference-bandwidth":                  {},
+	"config/protocols/ospf/area":                                 {},
+	"config/protocols/ospf/area/name":                            {},
+	"config/protocols/ospf/area/area-id":                         {},
+	"config/protocols/ospf/area/interface":                       {},
+	"config/protocols/ospf/area/interface/name":                  {},
+	"config/protocols/ospf/area/interface/passive":               {},
+	"config/protocols/ospf/area/interface/metric":                {},
+	"config/protocols/ospf/area/interface/priority":              {},
+	"config/protocols/ospf/area/interface/bfd":                   {},
+	"config/protocols/ospf/area/interface/bfd-profile":           {},
+	"config/protocols/ospf3":                                     {},
+	"config/protocols/ospf3/router-id":                           {},
+	"config/protocols/ospf3/reference-bandwidth":                 {},
+	"config/protocols/ospf3/area":                                {},
+	"config/protocols/ospf3/area/name":                           {},
+	"config/protocols/ospf3/area/area-id":                        {},
+	"config/protocols/ospf3/area/interface":                      {},
+	"config/protocols/ospf3/area/interface/name":                 {},
+	"config/protocols/ospf3/area/interface/passive":              {},
+	"config/protocols/ospf3/area/interface/metric":               {},
+	"config/protocols/ospf3/area/interface/priority":             {},
+	"config/protocols/ospf3/area/interface/bfd":                  {},
+	"config/protocols/ospf3/area/interface/bfd-profile":          {},
+	"config/protocols/mpls":                                      {},
+	"config/protocols/mpls/interface":                            {},
+	"config/protocols/rip":                                       {},
+	"config/protocols/rip/update-interval":                       {},
+	"config/protocols/rip/route-timeout":                         {},
+	"config/protocols/rip/holddown":                              {},
+	"config/protocols/rip/default-metric":                        {},
+	"config/protocols/rip/group":                                 {},
+	"config/protocols/rip/group/name":                            {},
+	"config/protocols/rip/group/neighbor":                        {},
+	"config/protocols/rip/group/neighbor/name":                   {},
+	"config/protocols/rip/group/neighbor/passive":                {},
+	"config/protocols/vrrp":                                      {},
+	"config/protocols/vrrp/group":                                {},
+	"config/protocols/vrrp/group/name":                           {},
+	"config/protocols/vrrp/group/interface":                      {},
+	"config/protocols/vrrp/group/virtual-address":                {},
+	"config/protocols/vrrp/group/priority":                       {},
+	"config/protocols/vrrp/group/preempt":                        {},
 
 	"config/class-of-service":                                                                {},
 	"config/class-of-service/forwarding-classes":                                             {},
@@ -2095,6 +2940,14 @@ var allowedConfigElementPaths = map[string]struct{}{
 	"config/class-of-service/interfaces/interface/name":                                      {},
 	"config/class-of-service/interfaces/interface/output-traffic-control-profile":            {},
 
+	"config/firewall":                                   {},
+	"config/firewall/policers":                          {},
+	"config/firewall/policers/policer":                  {},
+	"config/firewall/policers/policer/name":             {},
+	"config/firewall/policers/policer/bandwidth-limit":  {},
+	"config/firewall/policers/policer/burst-size-limit": {},
+	"config/firewall/policers/policer/then":             {},
+
 	"config/security":                     {},
 	"config/security/netconf":             {},
 	"config/security/netconf/ssh":         {},
@@ -2102,9 +2955,17 @@ var allowedConfigElementPaths = map[string]struct{}{
 	"config/security/rate-limit":          {},
 	"config/security/rate-limit/per-ip":   {},
 	"config/security/rate-limit/per-user": {},
-}
 
-var configTextContentPaths = map[string]struct{}{
+	"config/inactive":      {},
+	"config/inactive/path": {},
+
+	"config/groups":                  {},
+	"config/groups/group":            {},
+	"config/groups/group/name":       {},
+	"config/groups/group/interfaces": {},
+}, "config/interfaces/interface/apply-groups")
+
+var configTextContentPaths = withGroupInterfacePaths(map[string]struct{}{
 	"config/system/host-name":                          {},
 	"config/system/services/web-ui/enabled":            {},
 	"config/system/services/web-ui/listen-address":     {},
@@ -2116,17 +2977,43 @@ var configTextContentPaths = map[string]struct{}{
 	"config/system/services/snmp/listen-address":       {},
 	"config/system/services/snmp/port":                 {},
 	"config/system/services/snmp/community":            {},
+	"config/system/alarms/interface/error-rate":        {},
+	"config/system/alarms/interface/link-down":         {},
 	"config/chassis/cluster/enabled":                   {},
 	"config/chassis/cluster/node/name":                 {},
 	"config/chassis/cluster/node/address":              {},
 	"config/chassis/cluster/node/priority":             {},
 	"config/chassis/cluster/sync/etcd/endpoint":        {},
 
-	"config/interfaces/interface/name":                {},
-	"config/interfaces/interface/description":         {},
-	"config/interfaces/interface/unit/name":           {},
-	"config/interfaces/interface/unit/family/name":    {},
-	"config/interfaces/interface/unit/family/address": {},
+	"config/interfaces/interface/name":                                                          {},
+	"config/interfaces/interface/description":                                                   {},
+	"config/interfaces/interface/promiscuous":                                                   {},
+	"config/interfaces/interface/rx-mode":                                                       {},
+	"config/interfaces/interface/mtu":                                                           {},
+	"config/interfaces/interface/bandwidth":                                                     {},
+	"config/interfaces/interface/input-policer":                                                 {},
+	"config/interfaces/interface/output-policer":                                                {},
+	"config/interfaces/interface/tunnel/source":                                                 {},
+	"config/interfaces/interface/tunnel/destination":                                            {},
+	"config/interfaces/interface/hold-time/up":                                                  {},
+	"config/interfaces/interface/hold-time/down":                                                {},
+	"config/interfaces/interface/gigether-options/rxq":                                          {},
+	"config/interfaces/interface/gigether-options/txq":                                          {},
+	"config/interfaces/interface/gigether-options/rx-queue/name":                                {},
+	"config/interfaces/interface/gigether-options/rx-queue/worker":                              {},
+	"config/interfaces/interface/unit/name":                                                     {},
+	"config/interfaces/interface/unit/family/name":                                              {},
+	"config/interfaces/interface/unit/family/address":                                           {},
+	"config/interfaces/interface/unit/family/mtu":                                               {},
+	"config/interfaces/interface/unit/family/neighbor/address":                                  {},
+	"config/interfaces/interface/unit/family/neighbor/mac":                                      {},
+	"config/interfaces/interface/unit/family/router-advertisement/managed-configuration":        {},
+	"config/interfaces/interface/unit/family/router-advertisement/other-stateful-configuration": {},
+	"config/interfaces/interface/unit/family/router-advertisement/max-advertisement-interval":   {},
+	"config/interfaces/interface/unit/family/router-advertisement/min-advertisement-interval":   {},
+	"config/interfaces/interface/unit/family/router-advertisement/prefix/name":                  {},
+	"config/interfaces/interface/unit/family/router-advertisement/prefix/valid-lifetime":        {},
+	"config/interfaces/interface/unit/family/router-advertisement/prefix/preferred-lifetime":    {},
 
 	"config/routing/router-id":                        {},
 	"config/routing/autonomous-system":                {},
@@ -2167,16 +3054,26 @@ var configTextContentPaths = map[string]struct{}{
 	"config/protocols/bfd/peer/passive-mode":         {},
 	"config/protocols/bfd/peer/shutdown":             {},
 
-	"config/protocols/bgp/group/name":                   {},
-	"config/protocols/bgp/group/type":                   {},
-	"config/protocols/bgp/group/import":                 {},
-	"config/protocols/bgp/group/export":                 {},
-	"config/protocols/bgp/group/neighbor/ip":            {},
-	"config/protocols/bgp/group/neighbor/peer-as":       {},
-	"config/protocols/bgp/group/neighbor/description":   {},
-	"config/protocols/bgp/group/neighbor/local-address": {},
-	"config/protocols/bgp/group/neighbor/bfd":           {},
-	"config/protocols/bgp/group/neighbor/bfd-profile":   {},
+	"config/protocols/bgp/router-id":                             {},
+	"config/protocols/bgp/group/name":                            {},
+	"config/protocols/bgp/group/type":                            {},
+	"config/protocols/bgp/group/import":                          {},
+	"config/protocols/bgp/group/export":                          {},
+	"config/protocols/bgp/group/cluster":                         {},
+	"config/protocols/bgp/group/next-hop-self":                   {},
+	"config/protocols/bgp/group/remove-private-as":               {},
+	"config/protocols/bgp/group/neighbor/ip":                     {},
+	"config/protocols/bgp/group/neighbor/peer-as":                {},
+	"config/protocols/bgp/group/neighbor/description":            {},
+	"config/protocols/bgp/group/neighbor/local-address":          {},
+	"config/protocols/bgp/group/neighbor/update-source":          {},
+	"config/protocols/bgp/group/neighbor/bfd":                    {},
+	"config/protocols/bgp/group/neighbor/bfd-profile":            {},
+	"config/protocols/bgp/group/neighbor/cluster":                {},
+	"config/protocols/bgp/group/neighbor/route-reflector-client": {},
+	"config/protocols/bgp/group/neighbor/shutdown":               {},
+	"config/protocols/bgp/group/neighbor/next-hop-self":          {},
+	"config/protocols/bgp/group/neighbor/remove-private-as":      {},
 
 	"config/protocols/evpn/vni/id":                  {},
 	"config/protocols/evpn/vni/type":                {},
@@ -2193,6 +3090,7 @@ var configTextContentPaths = map[string]struct{}{
 	"config/protocols/evpn/vni/remote-vtep":         {},
 
 	"config/protocols/ospf/router-id":                   {},
+	"config/protocols/ospf/reference-bandwidth":         {},
 	"config/protocols/ospf/area/name":                   {},
 	"config/protocols/ospf/area/area-id":                {},
 	"config/protocols/ospf/area/interface/name":         {},
@@ -2202,6 +3100,7 @@ var configTextContentPaths = map[string]struct{}{
 	"config/protocols/ospf/area/interface/bfd":          {},
 	"config/protocols/ospf/area/interface/bfd-profile":  {},
 	"config/protocols/ospf3/router-id":                  {},
+	"config/protocols/ospf3/reference-bandwidth":        {},
 	"config/protocols/ospf3/area/name":                  {},
 	"config/protocols/ospf3/area/area-id":               {},
 	"config/protocols/ospf3/area/interface/name":        {},
@@ -2211,6 +3110,13 @@ var configTextContentPaths = map[string]struct{}{
 	"config/protocols/ospf3/area/interface/bfd":         {},
 	"config/protocols/ospf3/area/interface/bfd-profile": {},
 	"config/protocols/mpls/interface":                   {},
+	"config/protocols/rip/update-interval":              {},
+	"config/protocols/rip/route-timeout":                {},
+	"config/protocols/rip/holddown":                     {},
+	"config/protocols/rip/default-metric":               {},
+	"config/protocols/rip/group/name":                   {},
+	"config/protocols/rip/group/neighbor/name":          {},
+	"config/protocols/rip/group/neighbor/passive":       {},
 	"config/protocols/vrrp/group/name":                  {},
 	"config/protocols/vrrp/group/interface":             {},
 	"config/protocols/vrrp/group/virtual-address":       {},
@@ -2225,9 +3131,34 @@ var configTextContentPaths = map[string]struct{}{
 	"config/class-of-service/interfaces/interface/name":                                      {},
 	"config/class-of-service/interfaces/interface/output-traffic-control-profile":            {},
 
+	"config/firewall/policers/policer/name":             {},
+	"config/firewall/policers/policer/bandwidth-limit":  {},
+	"config/firewall/policers/policer/burst-size-limit": {},
+	"config/firewall/policers/policer/then":             {},
+
 	"config/security/netconf/ssh/port":    {},
 	"config/security/rate-limit/per-ip":   {},
 	"config/security/rate-limit/per-user": {},
+	"config/inactive/path":                {},
+
+	"config/groups/group/name": {},
+}, "config/interfaces/interface/apply-groups")
+
+// withGroupInterfacePaths adds the group counterpart of every interface path
+// to paths, since a group's interface statements use the same elements as
+// top-level interfaces. The interface-only paths, such as apply-groups, are
+// added afterwards so they are not allowed inside a group.
+func withGroupInterfacePaths(paths map[string]struct{}, interfaceOnly ...string) map[string]struct{} {
+	const interfacePrefix = "config/interfaces/interface"
+	for path := range paths {
+		if rest, ok := strings.CutPrefix(path, interfacePrefix); ok {
+			paths["config/groups/group/interfaces/interface"+rest] = struct{}{}
+		}
+	}
+	for _, path := range interfaceOnly {
+		paths[path] = struct{}{}
+	}
+	return paths
 }
 
 func isConfigTextContentPath(path []string) bool {
@@ -2281,7 +3212,7 @@ func normalizeConfigXML(xmlData []byte) ([]byte, error) {
 	}
 }
 
-func validateConfigXMLAllowlist(xmlData []byte) error {
+func validateConfigXMLAllowlist(xmlData []byte, allowInactiveOperations bool) error {
 	decoder := xml.NewDecoder(bytes.NewReader(xmlData))
 	decoder.Strict = true
 	decoder.Entity = nil
@@ -2321,7 +3252,7 @@ func validateConfigXMLAllowlist(xmlData []byte) error {
 			if err := validateConfigElement(t.Name, path); err != nil {
 				return err
 			}
-			if err := validateConfigAttributes(t, path); err != nil {
+			if err := validateConfigAttributes(t, path, allowInactiveOperations); err != nil {
 				return err
 			}
 			stack = append(stack, t.Name.Local)
@@ -2351,7 +3282,7 @@ func validateConfigTextContent(path []string, text xml.CharData) error {
 		WithPath(configElementRPCPath(path))
 }
 
-func validateConfigAttributes(start xml.StartElement, path []string) error {
+func validateConfigAttributes(start xml.StartElement, path []string, allowInactiveOperations bool) error {
 	if len(start.Attr) > MaxXMLAttributes {
 		return NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue,
 			fmt.Sprintf("config element %s exceeds maximum attribute limit (%d)", start.Name.Local, MaxXMLAttributes)).
@@ -2369,6 +3300,13 @@ func validateConfigAttributes(start xml.StartElement, path []string) error {
 			continue
 		}
 		if attr.Name.Local == "operation" && (attr.Name.Space == "" || attr.Name.Space == NetconfBaseNS) {
+			if allowInactiveOperations && isInactiveOperationPath(path) {
+				if !isEditOperation(attr.Value) {
+					return ErrBadAttribute("edit-config/config/"+strings.Join(path[1:], "/"), "operation",
+						fmt.Sprintf("unsupported operation %q", attr.Value))
+				}
+				continue
+			}
 			return NewRPCError(ErrorTypeProtocol, ErrorTagOperationNotSupported,
 				"per-element operation attributes are not supported").
 				WithPath(configElementRPCPath(path)).
@@ -2383,6 +3321,21 @@ func validateConfigAttributes(start xml.StartElement, path []string) error {
 	return nil
 }
 
+// isInactiveOperationPath reports whether path is <inactive> or one of its
+// <path> entries, the only config elements that accept an operation attribute.
+func isInactiveOperationPath(path []string) bool {
+	key := strings.Join(path, "/")
+	return key == "config/inactive" || key == "config/inactive/path"
+}
+
+func isEditOperation(operation string) bool {
+	switch operation {
+	case "merge", "replace", "create", "delete", "remove":
+		return true
+	}
+	return false
+}
+
 func isNamespaceDeclarationAttribute(attr xml.Attr) bool {
 	_, ok := namespaceDeclarationAttrName(attr)
 	return ok
@@ -2418,7 +3371,7 @@ func isAllowedConfigNamespace(path []string, namespace string) bool {
 		return namespace == ArcaConfigNS || namespace == IETFInterfacesNS || namespace == IETFRoutingNS
 	}
 	switch path[1] {
-	case "system", "chassis", "protocols", "routing-instances", "class-of-service", "security":
+	case "system", "chassis", "protocols", "routing-instances", "class-of-service", "firewall", "security", "inactive", "groups":
 		return namespace == ArcaConfigNS
 	case "interfaces":
 		return namespace == IETFInterfacesNS
@@ -2463,8 +3416,9 @@ func ApplyConfigEdit(existing, edit *config.Config, defaultOp DefaultOperation)
 		return replaceConfigs(&merged, edit)
 
 	case DefaultOpNone:
-		// None: only explicit per-element operations apply. They are rejected
-		// during XML parsing, so implicit edit payloads leave the config unchanged.
+		// None: only explicit per-element operations apply. XML parsing rejects
+		// them everywhere except on <inactive>, whose operations are applied by
+		// applyInactiveEdit, so the rest of the payload leaves the config unchanged.
 		return &merged, nil
 
 	default:
@@ -2488,6 +3442,17 @@ func mergeConfigs(existing, edit *config.Config) (*config.Config, error) {
 		if edit.System.Services != nil {
 			mergeSystemServices(existing.System, edit.System.Services)
 		}
+		if edit.System.Alarms != nil {
+			if existing.System.Alarms == nil {
+				existing.System.Alarms = &config.SystemAlarmsConfig{}
+			}
+			if edit.System.Alarms.InterfaceErrorRate != 0 {
+				existing.System.Alarms.InterfaceErrorRate = edit.System.Alarms.InterfaceErrorRate
+			}
+			if edit.System.Alarms.InterfaceLinkDown {
+				existing.System.Alarms.InterfaceLinkDown = true
+			}
+		}
 	}
 
 	// Merge chassis
@@ -2497,57 +3462,19 @@ func mergeConfigs(existing, edit *config.Config) (*config.Config, error) {
 
 	// Merge interfaces
 	if edit.Interfaces != nil {
-		if existing.Interfaces == nil {
-			existing.Interfaces = make(map[string]*config.Interface)
-		}
-		for name, editIface := range edit.Interfaces {
-			if existing.Interfaces[name] == nil {
-				existing.Interfaces[name] = &config.Interface{
-					Units: make(map[int]*config.Unit),
-				}
-			}
-			existingIface := existing.Interfaces[name]
-
-			if editIface.Description != "" {
-				existingIface.Description = editIface.Description
-			}
-
-			// Merge units
-			if editIface.Units != nil {
-				if existingIface.Units == nil {
-					existingIface.Units = make(map[int]*config.Unit)
-				}
-				for unitNum, editUnit := range editIface.Units {
-					if existingIface.Units[unitNum] == nil {
-						existingIface.Units[unitNum] = &config.Unit{
-							Family: make(map[string]*config.Family),
-						}
-					}
-					existingUnit := existingIface.Units[unitNum]
-
-					// Merge families
-					if editUnit.Family != nil {
-						if existingUnit.Family == nil {
-							existingUnit.Family = make(map[string]*config.Family)
-						}
-						for familyName, editFamily := range editUnit.Family {
-							if existingUnit.Family[familyName] == nil {
-								existingUnit.Family[familyName] = &config.Family{
-									Addresses: make([]string, 0),
-								}
-							}
-							existingFamily := existingUnit.Family[familyName]
+		existing.Interfaces = mergeInterfaces(existing.Interfaces, edit.Interfaces)
+	}
 
-							// Merge addresses (append unique)
-							for _, addr := range editFamily.Addresses {
-								if !contains(existingFamily.Addresses, addr) {
-									existingFamily.Addresses = append(existingFamily.Addresses, addr)
-								}
-							}
-						}
-					}
-				}
-			}
+	// Merge groups
+	for name, editGroup := range edit.Groups {
+		if existing.Groups == nil {
+			existing.Groups = make(map[string]*config.Config)
+		}
+		if existing.Groups[name] == nil {
+			existing.Groups[name] = config.NewConfig()
+		}
+		if editGroup != nil {
+			existing.Groups[name].Interfaces = mergeInterfaces(existing.Groups[name].Interfaces, editGroup.Interfaces)
 		}
 	}
 
@@ -2617,6 +3544,9 @@ func mergeConfigs(existing, edit *config.Config) (*config.Config, error) {
 			if existing.Protocols.BGP.Groups == nil {
 				existing.Protocols.BGP.Groups = make(map[string]*config.BGPGroup)
 			}
+			if edit.Protocols.BGP.RouterID != "" {
+				existing.Protocols.BGP.RouterID = edit.Protocols.BGP.RouterID
+			}
 			for groupName, editGroup := range edit.Protocols.BGP.Groups {
 				existing.Protocols.BGP.Groups[groupName] = editGroup
 			}
@@ -2655,6 +3585,10 @@ func mergeConfigs(existing, edit *config.Config) (*config.Config, error) {
 			}
 		}
 
+		if edit.Protocols.RIP != nil {
+			mergeRIPConfig(&existing.Protocols.RIP, edit.Protocols.RIP)
+		}
+
 		if edit.Protocols.VRRP != nil {
 			if existing.Protocols.VRRP == nil {
 				existing.Protocols.VRRP = &config.VRRPConfig{
@@ -2701,6 +3635,21 @@ func mergeConfigs(existing, edit *config.Config) (*config.Config, error) {
 		}
 	}
 
+	// Merge firewall policers
+	if edit.Firewall != nil {
+		if existing.Firewall == nil {
+			existing.Firewall = &config.FirewallConfig{}
+		}
+		if len(edit.Firewall.Policers) > 0 {
+			if existing.Firewall.Policers == nil {
+				existing.Firewall.Policers = make(map[string]*config.Policer)
+			}
+			for name, policer := range edit.Firewall.Policers {
+				existing.Firewall.Policers[name] = policer
+			}
+		}
+	}
+
 	// Merge security
 	if edit.Security != nil {
 		if existing.Security == nil {
@@ -2714,9 +3663,142 @@ func mergeConfigs(existing, edit *config.Config) (*config.Config, error) {
 		}
 	}
 
+	// Merge deactivated subtrees
+	existing.Inactive = mergeInactivePaths(existing.Inactive, edit.Inactive)
+
 	return existing, nil
 }
 
+// mergeInterfaces merges the edit interfaces into existing and returns the
+// result, which is existing unless that was nil.
+func mergeInterfaces(existing, edit map[string]*config.Interface) map[string]*config.Interface {
+	if existing == nil {
+		existing = make(map[string]*config.Interface)
+	}
+	for name, editIface := range edit {
+		if existing[name] == nil {
+			existing[name] = &config.Interface{
+				Units: make(map[int]*config.Unit),
+			}
+		}
+		existingIface := existing[name]
+
+		for _, group := range editIface.ApplyGroups {
+			if !contains(existingIface.ApplyGroups, group) {
+				existingIface.ApplyGroups = append(existingIface.ApplyGroups, group)
+			}
+		}
+		if editIface.Description != "" {
+			existingIface.Description = editIface.Description
+		}
+		if editIface.Promiscuous {
+			existingIface.Promiscuous = true
+		}
+		if editIface.RxMode != "" {
+			existingIface.RxMode = editIface.RxMode
+		}
+		if editIface.MTU != 0 {
+			existingIface.MTU = editIface.MTU
+		}
+		if editIface.Bandwidth != 0 {
+			existingIface.Bandwidth = editIface.Bandwidth
+		}
+		if editIface.InputPolicer != "" {
+			existingIface.InputPolicer = editIface.InputPolicer
+		}
+		if editIface.OutputPolicer != "" {
+			existingIface.OutputPolicer = editIface.OutputPolicer
+		}
+		if editIface.Tunnel != nil {
+			if existingIface.Tunnel == nil {
+				existingIface.Tunnel = &config.Tunnel{}
+			}
+			if editIface.Tunnel.Source != "" {
+				existingIface.Tunnel.Source = editIface.Tunnel.Source
+			}
+			if editIface.Tunnel.Destination != "" {
+				existingIface.Tunnel.Destination = editIface.Tunnel.Destination
+			}
+		}
+		if editIface.HoldTime != nil {
+			holdTime := *editIface.HoldTime
+			existingIface.HoldTime = &holdTime
+		}
+		if editOpts := editIface.GigEtherOptions; editOpts != nil {
+			if existingIface.GigEtherOptions == nil {
+				existingIface.GigEtherOptions = &config.GigEtherOptions{}
+			}
+			opts := existingIface.GigEtherOptions
+			if editOpts.RxQueues != 0 {
+				opts.RxQueues = editOpts.RxQueues
+			}
+			if editOpts.TxQueues != 0 {
+				opts.TxQueues = editOpts.TxQueues
+			}
+			for queue, worker := range editOpts.RxPlacement {
+				if opts.RxPlacement == nil {
+					opts.RxPlacement = make(map[int]int)
+				}
+				opts.RxPlacement[queue] = worker
+			}
+		}
+
+		// Merge units
+		if editIface.Units != nil {
+			if existingIface.Units == nil {
+				existingIface.Units = make(map[int]*config.Unit)
+			}
+			for unitNum, editUnit := range editIface.Units {
+				if existingIface.Units[unitNum] == nil {
+					existingIface.Units[unitNum] = &config.Unit{
+						Family: make(map[string]*config.Family),
+					}
+				}
+				existingUnit := existingIface.Units[unitNum]
+
+				// Merge families
+				if editUnit.Family != nil {
+					if existingUnit.Family == nil {
+						existingUnit.Family = make(map[string]*config.Family)
+					}
+					for familyName, editFamily := range editUnit.Family {
+						if existingUnit.Family[familyName] == nil {
+							existingUnit.Family[familyName] = &config.Family{
+								Addresses: make([]string, 0),
+							}
+						}
+						existingFamily := existingUnit.Family[familyName]
+
+						// Merge addresses (append unique)
+						for _, addr := range editFamily.Addresses {
+							if !contains(existingFamily.Addresses, addr) {
+								existingFamily.Addresses = append(existingFamily.Addresses, addr)
+							}
+						}
+
+						if editFamily.MTU != 0 {
+							existingFamily.MTU = editFamily.MTU
+						}
+
+						// Merge static neighbors (edit wins per address)
+						for ip, mac := range editFamily.Neighbors {
+							if existingFamily.Neighbors == nil {
+								existingFamily.Neighbors = make(map[string]string)
+							}
+							existingFamily.Neighbors[ip] = mac
+						}
+
+						if editFamily.RouterAdvertisement != nil {
+							mergeRouterAdvertisement(&existingFamily.RouterAdvertisement, editFamily.RouterAdvertisement)
+						}
+					}
+				}
+			}
+		}
+	}
+	return existing
+}
+
 func mergeSystemServices(system *config.SystemConfig, editServices *config.SystemServicesConfig) {
 	if system.Services == nil {
 		system.Services = &config.SystemServicesConfig{}
@@ -2744,6 +3826,9 @@ func mergeOSPFConfig(existing **config.OSPFConfig, edit *config.OSPFConfig) {
 	if edit.RouterID != "" {
 		(*existing).RouterID = edit.RouterID
 	}
+	if edit.ReferenceBandwidth != 0 {
+		(*existing).ReferenceBandwidth = edit.ReferenceBandwidth
+	}
 	if (*existing).Areas == nil {
 		(*existing).Areas = make(map[string]*config.OSPFArea)
 	}
@@ -2752,6 +3837,62 @@ func mergeOSPFConfig(existing **config.OSPFConfig, edit *config.OSPFConfig) {
 	}
 }
 
+func mergeRouterAdvertisement(existing **config.RouterAdvertisement, edit *config.RouterAdvertisement) {
+	if edit == nil {
+		return
+	}
+	if *existing == nil {
+		*existing = &config.RouterAdvertisement{}
+	}
+	if edit.ManagedConfiguration {
+		(*existing).ManagedConfiguration = true
+	}
+	if edit.OtherStatefulConfiguration {
+		(*existing).OtherStatefulConfiguration = true
+	}
+	if edit.MaxAdvertisementInterval != 0 {
+		(*existing).MaxAdvertisementInterval = edit.MaxAdvertisementInterval
+	}
+	if edit.MinAdvertisementInterval != 0 {
+		(*existing).MinAdvertisementInterval = edit.MinAdvertisementInterval
+	}
+	for prefix, lifetimes := range edit.Prefixes {
+		if (*existing).Prefixes == nil {
+			(*existing).Prefixes = make(map[string]*config.RouterAdvertisementPrefix)
+		}
+		(*existing).Prefixes[prefix] = lifetimes
+	}
+}
+
+func mergeRIPConfig(existing **config.RIPConfig, edit *config.RIPConfig) {
+	if edit == nil {
+		return
+	}
+	if *existing == nil {
+		*existing = &config.RIPConfig{
+			Groups: make(map[string]*config.RIPGroup),
+		}
+	}
+	if edit.UpdateInterval != 0 {
+		(*existing).UpdateInterval = edit.UpdateInterval
+	}
+	if edit.RouteTimeout != 0 {
+		(*existing).RouteTimeout = edit.RouteTimeout
+	}
+	if edit.Holddown != 0 {
+		(*existing).Holddown = edit.Holddown
+	}
+	if edit.DefaultMetric != 0 {
+		(*existing).DefaultMetric = edit.DefaultMetric
+	}
+	if (*existing).Groups == nil {
+		(*existing).Groups = make(map[string]*config.RIPGroup)
+	}
+	for name, editGroup := range edit.Groups {
+		(*existing).Groups[name] = editGroup
+	}
+}
+
 // replaceConfigs replaces existing config subtrees with edit
 func replaceConfigs(existing, edit *config.Config) (*config.Config, error) {
 	// Replace entire subtrees
@@ -2761,6 +3902,9 @@ func replaceConfigs(existing, edit *config.Config) (*config.Config, error) {
 	if edit.Interfaces != nil {
 		existing.Interfaces = edit.Interfaces
 	}
+	if edit.Groups != nil {
+		existing.Groups = edit.Groups
+	}
 	if edit.Chassis != nil {
 		existing.Chassis = edit.Chassis
 	}
@@ -2776,12 +3920,172 @@ func replaceConfigs(existing, edit *config.Config) (*config.Config, error) {
 	if edit.ClassOfService != nil {
 		existing.ClassOfService = edit.ClassOfService
 	}
+	if edit.Firewall != nil {
+		existing.Firewall = edit.Firewall
+	}
 	if edit.Security != nil {
 		existing.Security = edit.Security
 	}
+	existing.Inactive = mergeInactivePaths(existing.Inactive, edit.Inactive)
 	return existing, nil
 }
 
+// mergeInactivePaths adds the deactivated paths of an edit to the existing
+// ones. Both merge and replace keep existing markers: an edit that does not
+// mention <inactive> must not silently reactivate a subtree. Explicit delete,
+// remove, create and replace operations are applied by applyInactiveEdit.
+func mergeInactivePaths(existing, edit []string) []string {
+	merged := append([]string(nil), existing...)
+	for _, path := range edit {
+		if !contains(merged, path) {
+			merged = append(merged, path)
+		}
+	}
+	return merged
+}
+
+// inactiveEdit holds the explicit operation attributes of an edit-config
+// <inactive> element. A merge operation is the same as none and is not
+// recorded; paths without an explicit operation go to config.Inactive and are
+// merged by ApplyConfigEdit instead.
+type inactiveEdit struct {
+	operation string
+	paths     []inactivePathEdit
+}
+
+// inactivePathEdit is one <path> entry whose edit needs more than a merge.
+type inactivePathEdit struct {
+	path      string
+	operation string
+}
+
+// applyInactiveEdit applies the explicit <inactive> operations of an edit to
+// merged, the result of ApplyConfigEdit on existing. Deleting or removing a
+// path reactivates its subtree. Afterwards markers whose subtree the edit
+// deleted are dropped, so that recreating the subtree later does not bring it
+// back deactivated.
+func applyInactiveEdit(existing, merged *config.Config, edit *inactiveEdit) error {
+	if edit != nil {
+		paths, err := applyInactiveOperations(merged.Inactive, edit)
+		if err != nil {
+			return err
+		}
+		merged.Inactive = paths
+	}
+
+	dangling, err := merged.DanglingInactivePaths()
+	if err != nil || len(dangling) == 0 {
+		return err
+	}
+	alreadyDangling, err := existing.DanglingInactivePaths()
+	if err != nil {
+		return err
+	}
+	var kept []string
+	for _, path := range merged.Inactive {
+		if contains(dangling, path) && contains(existing.Inactive, path) && !contains(alreadyDangling, path) {
+			continue
+		}
+		kept = append(kept, path)
+	}
+	merged.Inactive = kept
+	return nil
+}
+
+func applyInactiveOperations(current []string, edit *inactiveEdit) ([]string, error) {
+	containerErr := func(tag ErrorTag, message string) error {
+		return NewRPCError(ErrorTypeApplication, tag, message).
+			WithPath("/rpc/edit-config/config/inactive")
+	}
+	listed := func() []string {
+		var paths []string
+		for _, entry := range edit.paths {
+			if !contains(paths, entry.path) {
+				paths = append(paths, entry.path)
+			}
+		}
+		return paths
+	}
+	switch edit.operation {
+	case "replace":
+		return listed(), nil
+	case "create":
+		if len(current) > 0 {
+			return nil, containerErr(ErrorTagDataExists, "inactive paths already exist")
+		}
+		return listed(), nil
+	case "delete":
+		if len(current) == 0 {
+			return nil, containerErr(ErrorTagDataMissing, "no inactive paths to delete")
+		}
+		return nil, nil
+	case "remove":
+		return nil, nil
+	}
+
+	paths := append([]string(nil), current...)
+	for _, entry := range edit.paths {
+		pathErr := func(tag ErrorTag, message string) error {
+			return NewRPCError(ErrorTypeApplication, tag, fmt.Sprintf("inactive path %q %s", entry.path, message)).
+				WithPath("/rpc/edit-config/config/inactive/path")
+		}
+		switch entry.operation {
+		case "create":
+			if contains(paths, entry.path) {
+				return nil, pathErr(ErrorTagDataExists, "already exists")
+			}
+			paths = append(paths, entry.path)
+		case "delete":
+			if !contains(paths, entry.path) {
+				return nil, pathErr(ErrorTagDataMissing, "does not exist")
+			}
+			paths = removeString(paths, entry.path)
+		case "remove":
+			paths = removeString(paths, entry.path)
+		default:
+			if !contains(paths, entry.path) {
+				paths = append(paths, entry.path)
+			}
+		}
+	}
+	return paths, nil
+}
+
+func removeString(slice []string, item string) []string {
+	kept := slice[:0]
+	for _, s := range slice {
+		if s != item {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
+
+// validateInactivePath checks that an <inactive><path> value is a single
+// canonical "deactivate" path, so that it serializes back to exactly one
+// statement in the datastore text.
+func validateInactivePath(path string) error {
+	invalid := func(reason string) error {
+		return NewRPCError(ErrorTypeApplication, ErrorTagInvalidValue,
+			fmt.Sprintf("invalid inactive path %q: %s", path, reason)).
+			WithPath("/rpc/edit-config/config/inactive/path")
+	}
+	if path == "" {
+		return invalid("path is empty")
+	}
+	if strings.ContainsAny(path, "\r\n") {
+		return invalid("path must be a single line")
+	}
+	parsed, err := config.NewParser(strings.NewReader("deactivate " + path)).Parse()
+	if err != nil {
+		return invalid(err.Error())
+	}
+	if len(parsed.Inactive) != 1 || parsed.Inactive[0] != path {
+		return invalid("path is not in canonical form")
+	}
+	return nil
+}
+
 // contains checks if slice contains string
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
@@ -2827,7 +4131,7 @@ func calculateConfigDepth(cfg *config.Config) int {
 	// System: depth 2 (config > system > hostname)
 	if cfg.System != nil {
 		maxDepth = max(maxDepth, 2)
-		if cfg.System.Services != nil {
+		if cfg.System.Services != nil || cfg.System.Alarms != nil {
 			maxDepth = max(maxDepth, 4)
 		}
 	}
@@ -2837,16 +4141,41 @@ func calculateConfigDepth(cfg *config.Config) int {
 		maxDepth = max(maxDepth, 5)
 	}
 
-	// Interfaces: depth 5 (config > interfaces > interface > unit > family > address)
+	// Interfaces: depth 5 (config > interfaces > interface > unit > family > address),
+	// or 6 with static neighbors (... > family > neighbor > address)
 	if cfg.Interfaces != nil {
 		for _, iface := range cfg.Interfaces {
+			if iface.Tunnel != nil || iface.HoldTime != nil || iface.GigEtherOptions != nil {
+				maxDepth = max(maxDepth, 4)
+			}
+			if iface.GigEtherOptions != nil && len(iface.GigEtherOptions.RxPlacement) > 0 {
+				maxDepth = max(maxDepth, 5)
+			}
 			if iface.Units != nil {
 				maxDepth = max(maxDepth, 5)
-				break
+			}
+			for _, unit := range iface.Units {
+				for _, family := range unit.Family {
+					if len(family.Neighbors) > 0 || family.RouterAdvertisement != nil {
+						maxDepth = max(maxDepth, 6)
+					}
+					if family.RouterAdvertisement != nil && len(family.RouterAdvertisement.Prefixes) > 0 {
+						maxDepth = max(maxDepth, 7)
+					}
+				}
 			}
 		}
 	}
 
+	// Groups: depth 3 (config > groups > group > name), or two levels below
+	// the depth of the group's interface statements
+	for _, group := range cfg.Groups {
+		maxDepth = max(maxDepth, 3)
+		if group != nil && len(group.Interfaces) > 0 {
+			maxDepth = max(maxDepth, calculateConfigDepth(&config.Config{Interfaces: group.Interfaces})+2)
+		}
+	}
+
 	// Routing options: depth 4 (config > routing > static-routes > route)
 	if cfg.RoutingOptions != nil && len(cfg.RoutingOptions.StaticRoutes) > 0 {
 		maxDepth = max(maxDepth, 4)
@@ -2876,6 +4205,12 @@ func calculateConfigDepth(cfg *config.Config) int {
 		if cfg.Protocols.MPLS != nil && len(cfg.Protocols.MPLS.Interfaces) > 0 {
 			maxDepth = max(maxDepth, 3)
 		}
+		if cfg.Protocols.RIP != nil {
+			maxDepth = max(maxDepth, 3)
+			if len(cfg.Protocols.RIP.Groups) > 0 {
+				maxDepth = max(maxDepth, 5)
+			}
+		}
 		if cfg.Protocols.VRRP != nil && len(cfg.Protocols.VRRP.Groups) > 0 {
 			maxDepth = max(maxDepth, 4)
 		}
@@ -2885,10 +4220,19 @@ func calculateConfigDepth(cfg *config.Config) int {
 		maxDepth = max(maxDepth, 4)
 	}
 
+	if cfg.Firewall != nil {
+		maxDepth = max(maxDepth, 4)
+	}
+
 	if cfg.Security != nil {
 		maxDepth = max(maxDepth, 4)
 	}
 
+	// Inactive: depth 2 (config > inactive > path)
+	if len(cfg.Inactive) > 0 {
+		maxDepth = max(maxDepth, 2)
+	}
+
 	return maxDepth
 }
 
@@ -2910,6 +4254,9 @@ func countConfigElements(cfg *config.Config) int {
 				count += serviceElementCount(service.Enabled, service.ListenAddress, service.Port, service.Community)
 			}
 		}
+		if cfg.System.Alarms != nil {
+			count += 4 // <alarms> + <interface> + <error-rate> + <link-down>
+		}
 	}
 
 	if cfg.Chassis != nil && cfg.Chassis.Cluster != nil {
@@ -2938,17 +4285,54 @@ func countConfigElements(cfg *config.Config) int {
 	if cfg.Interfaces != nil {
 		count++ // <interfaces>
 		for _, iface := range cfg.Interfaces {
-			count += 2 // <interface> + <name>
+			count += 2                      // <interface> + <name>
+			count += len(iface.ApplyGroups) // <apply-groups> elements
 			if iface.Description != "" {
 				count++ // <description>
 			}
+			if iface.Promiscuous {
+				count++ // <promiscuous>
+			}
+			if iface.RxMode != "" {
+				count++ // <rx-mode>
+			}
+			if iface.MTU != 0 {
+				count++ // <mtu>
+			}
+			if iface.InputPolicer != "" {
+				count++ // <input-policer>
+			}
+			if iface.OutputPolicer != "" {
+				count++ // <output-policer>
+			}
+			if iface.Tunnel != nil {
+				count += 3 // <tunnel> + <source> + <destination>
+			}
+			if iface.HoldTime != nil {
+				count += 3 // <hold-time> + <up> + <down>
+			}
+			if opts := iface.GigEtherOptions; opts != nil {
+				count++ // <gigether-options>
+				if opts.RxQueues != 0 {
+					count++ // <rxq>
+				}
+				if opts.TxQueues != 0 {
+					count++ // <txq>
+				}
+				count += 3 * len(opts.RxPlacement) // <rx-queue> + <name> + <worker>
+			}
 			if iface.Units != nil {
 				for _, unit := range iface.Units {
 					count += 2 // <unit> + <name>
 					if unit.Family != nil {
 						for _, family := range unit.Family {
-							count += 2                     // <family> + <name>
-							count += len(family.Addresses) // <address> elements
+							count += 2                         // <family> + <name>
+							count += len(family.Addresses)     // <address> elements
+							count += 3 * len(family.Neighbors) // <neighbor> + <address> + <mac>
+							if family.MTU != 0 {
+								count++ // <mtu>
+							}
+							count += countRouterAdvertisementElements(family.RouterAdvertisement)
 						}
 					}
 				}
@@ -2956,6 +4340,17 @@ func countConfigElements(cfg *config.Config) int {
 		}
 	}
 
+	if len(cfg.Groups) > 0 {
+		count++ // <groups>
+		for _, group := range cfg.Groups {
+			count += 2 // <group> + <name>
+			if group != nil && len(group.Interfaces) > 0 {
+				// The group's <interfaces> subtree, without the nested root.
+				count += countConfigElements(&config.Config{Interfaces: group.Interfaces}) - 1
+			}
+		}
+	}
+
 	if cfg.RoutingOptions != nil {
 		count++ // <routing>
 		if cfg.RoutingOptions.RouterID != "" {
@@ -3078,6 +4473,9 @@ func countConfigElements(cfg *config.Config) int {
 		}
 		if cfg.Protocols.BGP != nil {
 			count++ // <bgp>
+			if cfg.Protocols.BGP.RouterID != "" {
+				count++ // <router-id>
+			}
 			for _, group := range cfg.Protocols.BGP.Groups {
 				count += 2 // <group> + <name>
 				if group.Type != "" {
@@ -3089,6 +4487,15 @@ func countConfigElements(cfg *config.Config) int {
 				if group.Export != "" {
 					count++
 				}
+				if group.Cluster != "" {
+					count++
+				}
+				if group.NextHopSelf {
+					count++
+				}
+				if group.RemovePrivateAS {
+					count++
+				}
 				for _, neighbor := range group.Neighbors {
 					count += 3 // <neighbor> + <ip> + <peer-as>
 					if neighbor.Description != "" {
@@ -3097,12 +4504,30 @@ func countConfigElements(cfg *config.Config) int {
 					if neighbor.LocalAddress != "" {
 						count++
 					}
+					if neighbor.UpdateSource != "" {
+						count++
+					}
 					if neighbor.BFD || neighbor.BFDProfile != "" {
 						count++
 					}
 					if neighbor.BFDProfile != "" {
 						count++
 					}
+					if neighbor.Cluster != "" {
+						count++
+					}
+					if neighbor.RouteReflectorClient {
+						count++
+					}
+					if neighbor.Shutdown {
+						count++
+					}
+					if neighbor.NextHopSelf {
+						count++
+					}
+					if neighbor.RemovePrivateAS {
+						count++
+					}
 				}
 			}
 		}
@@ -3152,6 +4577,9 @@ func countConfigElements(cfg *config.Config) int {
 			if cfg.Protocols.OSPF.RouterID != "" {
 				count++
 			}
+			if cfg.Protocols.OSPF.ReferenceBandwidth != 0 {
+				count++
+			}
 			for _, area := range cfg.Protocols.OSPF.Areas {
 				count += 3 // <area> + <name> + <area-id>
 				for _, ospfIface := range area.Interfaces {
@@ -3159,10 +4587,10 @@ func countConfigElements(cfg *config.Config) int {
 					if ospfIface.Passive {
 						count++
 					}
-					if ospfIface.Metric > 0 {
+					if ospfIface.Metric != nil {
 						count++
 					}
-					if ospfIface.PrioritySet || ospfIface.Priority > 0 {
+					if ospfIface.Priority != nil {
 						count++
 					}
 					if ospfIface.BFD || ospfIface.BFDProfile != "" {
@@ -3179,6 +4607,9 @@ func countConfigElements(cfg *config.Config) int {
 			if cfg.Protocols.OSPF3.RouterID != "" {
 				count++
 			}
+			if cfg.Protocols.OSPF3.ReferenceBandwidth != 0 {
+				count++
+			}
 			for _, area := range cfg.Protocols.OSPF3.Areas {
 				count += 3 // <area> + <name> + <area-id>
 				for _, ospfIface := range area.Interfaces {
@@ -3186,10 +4617,10 @@ func countConfigElements(cfg *config.Config) int {
 					if ospfIface.Passive {
 						count++
 					}
-					if ospfIface.Metric > 0 {
+					if ospfIface.Metric != nil {
 						count++
 					}
-					if ospfIface.PrioritySet || ospfIface.Priority > 0 {
+					if ospfIface.Priority != nil {
 						count++
 					}
 					if ospfIface.BFD || ospfIface.BFDProfile != "" {
@@ -3205,6 +4636,29 @@ func countConfigElements(cfg *config.Config) int {
 			count++ // <mpls>
 			count += len(cfg.Protocols.MPLS.Interfaces)
 		}
+		if rip := cfg.Protocols.RIP; rip != nil {
+			count++ // <rip>
+			for _, value := range []int{rip.UpdateInterval, rip.RouteTimeout, rip.Holddown, rip.DefaultMetric} {
+				if value != 0 {
+					count++
+				}
+			}
+			for _, group := range rip.Groups {
+				if group == nil {
+					continue
+				}
+				count += 2 // <group> + <name>
+				for _, neighbor := range group.Neighbors {
+					if neighbor == nil {
+						continue
+					}
+					count += 2 // <neighbor> + <name>
+					if neighbor.Passive {
+						count++
+					}
+				}
+			}
+		}
 		if cfg.Protocols.VRRP != nil && len(cfg.Protocols.VRRP.Groups) > 0 {
 			count++ // <vrrp>
 			for _, group := range cfg.Protocols.VRRP.Groups {
@@ -3267,6 +4721,28 @@ func countConfigElements(cfg *config.Config) int {
 		}
 	}
 
+	if cfg.Firewall != nil {
+		count++ // <firewall>
+		if len(cfg.Firewall.Policers) > 0 {
+			count++ // <policers>
+			for _, policer := range cfg.Firewall.Policers {
+				if policer == nil {
+					continue
+				}
+				count += 2 // <policer> + <name>
+				if policer.BandwidthLimit != 0 {
+					count++
+				}
+				if policer.BurstSizeLimit != 0 {
+					count++
+				}
+				if policer.Action != "" {
+					count++
+				}
+			}
+		}
+	}
+
 	if cfg.Security != nil {
 		if (cfg.Security.NETCONF != nil && cfg.Security.NETCONF.SSH != nil && cfg.Security.NETCONF.SSH.Port != 0) || cfg.Security.RateLimit != nil {
 			count++ // <security>
@@ -3285,6 +4761,41 @@ func countConfigElements(cfg *config.Config) int {
 		}
 	}
 
+	if len(cfg.Inactive) > 0 {
+		count += 1 + len(cfg.Inactive) // <inactive> + <path> entries
+	}
+
+	return count
+}
+
+// countRouterAdvertisementElements counts the XML elements written for a
+// family's router advertisement settings.
+func countRouterAdvertisementElements(ra *config.RouterAdvertisement) int {
+	if ra == nil {
+		return 0
+	}
+	count := 1 // <router-advertisement>
+	if ra.ManagedConfiguration {
+		count++
+	}
+	if ra.OtherStatefulConfiguration {
+		count++
+	}
+	if ra.MaxAdvertisementInterval != 0 {
+		count++
+	}
+	if ra.MinAdvertisementInterval != 0 {
+		count++
+	}
+	for _, lifetimes := range ra.Prefixes {
+		count += 2 // <prefix> + <name>
+		if lifetimes != nil && lifetimes.ValidLifetime != 0 {
+			count++
+		}
+		if lifetimes != nil && lifetimes.PreferredLifetime != 0 {
+			count++
+		}
+	}
 	return count
 }
 
@@ -3349,9 +4860,21 @@ func ValidateXMLSecurity(data []byte) error {
 
 // ValidateFilterDepthAndSize validates filter depth and size per Phase 2 Step 3
 func ValidateFilterDepthAndSize(rpcName string, filter *Filter) error {
+	return ValidateFilterDepthAndSizeWithLimit(rpcName, filter, MaxXMLSize)
+}
+
+// ValidateFilterDepthAndSizeWithLimit is ValidateFilterDepthAndSize with a
+// caller-chosen limit for the filter content and xpath select size.
+func ValidateFilterDepthAndSizeWithLimit(rpcName string, filter *Filter, maxSize int) error {
 	if filter == nil {
 		return nil
 	}
+	if len(filter.Content)+len(filter.Select) > maxSize {
+		return NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue,
+			fmt.Sprintf("filter exceeds maximum size limit (%d bytes)", maxSize)).
+			WithPath(fmt.Sprintf("/rpc/%s/filter", rpcName)).
+			WithAppTag("size-limit")
+	}
 	filterType := normalizedFilterType(filter)
 	switch filterType {
 	case "xpath":
@@ -3,6 +3,10 @@ package netconf
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
 	"strings"
 	"testing"
 
@@ -18,7 +22,7 @@ func TestConfigToXMLWritesExplicitOSPFPriorityZero(t *testing.T) {
 					"0.0.0.0": {
 						AreaID: "0.0.0.0",
 						Interfaces: map[string]*config.OSPFInterface{
-							"ge-0/0/0": {Name: "ge-0/0/0", Priority: 0, PrioritySet: true},
+							"ge-0/0/0": {Name: "ge-0/0/0", Priority: intPtr(0)},
 						},
 					},
 				},
@@ -35,6 +39,82 @@ func TestConfigToXMLWritesExplicitOSPFPriorityZero(t *testing.T) {
 	}
 }
 
+func TestConfigXMLRoundTripsGroups(t *testing.T) {
+	cfg, err := config.NewParser(strings.NewReader(strings.Join([]string{
+		"set groups JUMBO interfaces <ge-*> mtu 9000",
+		"set groups JUMBO interfaces <ge-*> unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/0 apply-groups JUMBO",
+		"set interfaces ge-0/0/0 description uplink",
+	}, "\n"))).Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{"<groups xmlns=", "<name>&lt;ge-*&gt;</name>", "<apply-groups>JUMBO</apply-groups>"} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %q:\n%s", want, xmlData)
+		}
+	}
+
+	got, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v\n%s", err, xmlData)
+	}
+	if !reflect.DeepEqual(got.Groups, cfg.Groups) {
+		t.Fatalf("round-tripped groups = %#v, want %#v", got.Groups, cfg.Groups)
+	}
+	if got := got.Interfaces["ge-0/0/0"].ApplyGroups; !reflect.DeepEqual(got, []string{"JUMBO"}) {
+		t.Fatalf("round-tripped apply-groups = %v, want [JUMBO]", got)
+	}
+}
+
+func TestConfigToXMLIsDeterministic(t *testing.T) {
+	lines := []string{
+		"set interfaces ge-0/0/2 unit 0 family inet address 192.0.2.9/24",
+		"set interfaces ge-0/0/1 unit 10 family inet6 address 2001:db8::1/64",
+		"set interfaces ge-0/0/1 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/0 description uplink",
+		"set protocols bgp group Z type external",
+		"set protocols bgp group Z neighbor 203.0.113.2 peer-as 65002",
+		"set protocols bgp group Z neighbor 203.0.113.1 peer-as 65001",
+		"set protocols bgp group A type internal",
+		"set protocols bgp group A neighbor 198.51.100.1 peer-as 65000",
+		"set protocols ospf area 0.0.0.1 interface ge-0/0/2",
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/1",
+		"set protocols ospf area 0.0.0.0 interface ge-0/0/0",
+	}
+	reversed := make([]string, len(lines))
+	for i, line := range lines {
+		reversed[len(lines)-1-i] = line
+	}
+	toXML := func(lines []string) []byte {
+		t.Helper()
+		cfg, err := TextToConfig(strings.Join(lines, "\n") + "\n")
+		if err != nil {
+			t.Fatalf("TextToConfig() error = %v", err)
+		}
+		xmlData, err := ConfigToXML(cfg, nil)
+		if err != nil {
+			t.Fatalf("ConfigToXML() error = %v", err)
+		}
+		return xmlData
+	}
+
+	want := toXML(lines)
+	for i := 0; i < 10; i++ {
+		if got := toXML(lines); !bytes.Equal(got, want) {
+			t.Fatalf("ConfigToXML() output changed between runs\nwant:\n%s\ngot:\n%s", want, got)
+		}
+		if got := toXML(reversed); !bytes.Equal(got, want) {
+			t.Fatalf("ConfigToXML() depends on input order\nwant:\n%s\ngot:\n%s", want, got)
+		}
+	}
+}
+
 func TestConfigToXMLWritesOSPF3(t *testing.T) {
 	cfg := &config.Config{
 		Protocols: &config.ProtocolConfig{
@@ -43,7 +123,7 @@ func TestConfigToXMLWritesOSPF3(t *testing.T) {
 					"0.0.0.0": {
 						AreaID: "0.0.0.0",
 						Interfaces: map[string]*config.OSPFInterface{
-							"ge-0/0/0": {Name: "ge-0/0/0", Metric: 20},
+							"ge-0/0/0": {Name: "ge-0/0/0", Metric: intPtr(20)},
 						},
 					},
 				},
@@ -292,6 +372,374 @@ func TestXMLToConfigParsesBFD(t *testing.T) {
 	}
 }
 
+func TestXMLInterfaceDescriptionUnicodeRoundTrip(t *testing.T) {
+	description := strings.Repeat("東京", 126) + "<&>"
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {
+				Description: description,
+				Units: map[int]*config.Unit{
+					0: {Family: map[string]*config.Family{"inet": {Addresses: []string{"192.0.2.1/24"}}}},
+				},
+			},
+		},
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	if !strings.Contains(string(xmlData), strings.Repeat("東京", 126)+"&lt;&amp;&gt;</description>") {
+		t.Fatalf("ConfigToXML() did not write the escaped description:\n%s", xmlData)
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	if got := roundTrip.Interfaces["ge-0/0/0"].Description; got != description {
+		t.Fatalf("round-trip description = %q, want %q", got, description)
+	}
+}
+
+func TestXMLInterfacePromiscuousAndRxModeRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {Promiscuous: true, RxMode: "polling"},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{"<promiscuous>true</promiscuous>", "<rx-mode>polling</rx-mode>"} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	if iface := roundTrip.Interfaces["ge-0/0/0"]; !iface.Promiscuous || iface.RxMode != "polling" {
+		t.Fatalf("round-trip interface = %#v, want promiscuous polling", iface)
+	}
+}
+
+func TestXMLInterfaceTunnelRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"gr-0/0/0": {Tunnel: &config.Tunnel{Source: "192.0.2.1", Destination: "198.51.100.1"}},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{"<source>192.0.2.1</source>", "<destination>198.51.100.1</destination>"} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	if tunnel := roundTrip.Interfaces["gr-0/0/0"].Tunnel; tunnel == nil || *tunnel != *cfg.Interfaces["gr-0/0/0"].Tunnel {
+		t.Fatalf("round-trip tunnel = %#v, want %#v", tunnel, cfg.Interfaces["gr-0/0/0"].Tunnel)
+	}
+}
+
+func TestXMLInterfaceMTURoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {MTU: 9000, Units: map[int]*config.Unit{
+				0: {Family: map[string]*config.Family{
+					"inet": {Addresses: []string{"192.0.2.1/24"}, MTU: 1500},
+				}},
+			}},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{"<mtu>9000</mtu>", "<mtu>1500</mtu>"} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	iface := roundTrip.Interfaces["ge-0/0/0"]
+	if iface.MTU != 9000 || iface.Units[0].Family["inet"].MTU != 1500 {
+		t.Fatalf("round-trip interface = %#v, want mtu 9000 and inet mtu 1500", iface)
+	}
+}
+
+func TestXMLInterfaceBandwidthRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {Bandwidth: 10 * 1000 * 1000 * 1000},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	if want := "<bandwidth>10000000000</bandwidth>"; !strings.Contains(string(xmlData), want) {
+		t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	if got := roundTrip.Interfaces["ge-0/0/0"].Bandwidth; got != 10*1000*1000*1000 {
+		t.Fatalf("round-trip bandwidth = %d, want 10g", got)
+	}
+}
+
+func TestXMLInterfaceGigEtherOptionsRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {GigEtherOptions: &config.GigEtherOptions{
+				RxQueues:    4,
+				TxQueues:    2,
+				RxPlacement: map[int]int{0: 1, 3: 0},
+			}},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{"<rxq>4</rxq>", "<txq>2</txq>", "<name>3</name>", "<worker>1</worker>"} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	if got := roundTrip.Interfaces["ge-0/0/0"].GigEtherOptions; !reflect.DeepEqual(got, cfg.Interfaces["ge-0/0/0"].GigEtherOptions) {
+		t.Fatalf("round-trip gigether-options = %#v, want %#v", got, cfg.Interfaces["ge-0/0/0"].GigEtherOptions)
+	}
+}
+
+func TestXMLInactivePathsRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		System:   &config.SystemConfig{HostName: "router1"},
+		Inactive: []string{"system", `interfaces ge-0/0/0 description "uplink port"`},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	if want := `<inactive xmlns="` + ArcaConfigNS + `">`; !strings.Contains(string(xmlData), want) {
+		t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	got := append([]string(nil), roundTrip.Inactive...)
+	sort.Strings(got)
+	want := []string{`interfaces ge-0/0/0 description "uplink port"`, "system"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("round-trip inactive = %q, want %q", got, want)
+	}
+}
+
+func TestXMLInactivePathRejectsInvalidPaths(t *testing.T) {
+	for _, path := range []string{
+		"",
+		"bogus ge-0/0/0",
+		"system&#10;set system host-name injected",
+	} {
+		xmlData := []byte(`<config><inactive xmlns="` + ArcaConfigNS + `"><path>` + path + `</path></inactive></config>`)
+		if _, err := XMLToConfig(xmlData, DefaultOpMerge); err == nil {
+			t.Fatalf("XMLToConfig(%q) error = nil, want invalid inactive path", path)
+		}
+	}
+}
+
+func TestXMLStaticNeighborRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {Units: map[int]*config.Unit{
+				0: {Family: map[string]*config.Family{
+					"inet": {
+						Addresses: []string{"192.0.2.1/24"},
+						Neighbors: map[string]string{"192.0.2.10": "00:11:22:33:44:55"},
+					},
+				}},
+			}},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{"<address>192.0.2.10</address>", "<mac>00:11:22:33:44:55</mac>"} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	family := roundTrip.Interfaces["ge-0/0/0"].Units[0].Family["inet"]
+	if len(family.Addresses) != 1 || family.Neighbors["192.0.2.10"] != "00:11:22:33:44:55" {
+		t.Fatalf("round-trip family = %#v, want address and static neighbor", family)
+	}
+}
+
+func TestXMLRouterAdvertisementRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {Units: map[int]*config.Unit{
+				0: {Family: map[string]*config.Family{
+					"inet6": {
+						Addresses: []string{"2001:db8:1::1/64"},
+						RouterAdvertisement: &config.RouterAdvertisement{
+							OtherStatefulConfiguration: true,
+							MaxAdvertisementInterval:   60,
+							Prefixes: map[string]*config.RouterAdvertisementPrefix{
+								"2001:db8:1::/64": {ValidLifetime: 3600, PreferredLifetime: 1800},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{
+		"<other-stateful-configuration>true</other-stateful-configuration>",
+		"<max-advertisement-interval>60</max-advertisement-interval>",
+		"<name>2001:db8:1::/64</name>",
+		"<preferred-lifetime>1800</preferred-lifetime>",
+	} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	ra := roundTrip.Interfaces["ge-0/0/0"].Units[0].Family["inet6"].RouterAdvertisement
+	if ra == nil || ra.ManagedConfiguration || !ra.OtherStatefulConfiguration || ra.MaxAdvertisementInterval != 60 {
+		t.Fatalf("round-trip router advertisement = %#v", ra)
+	}
+	if prefix := ra.Prefixes["2001:db8:1::/64"]; prefix == nil || prefix.ValidLifetime != 3600 || prefix.PreferredLifetime != 1800 {
+		t.Fatalf("round-trip prefixes = %#v", ra.Prefixes)
+	}
+}
+
+func TestXMLFirewallPolicerRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{
+			"ge-0/0/0": {InputPolicer: "LIMIT", OutputPolicer: "LIMIT"},
+		},
+		Firewall: &config.FirewallConfig{Policers: map[string]*config.Policer{
+			"LIMIT": {Name: "LIMIT", BandwidthLimit: 100000000, BurstSizeLimit: 15000, Action: "discard"},
+		}},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{
+		"<input-policer>LIMIT</input-policer>",
+		"<bandwidth-limit>100000000</bandwidth-limit>",
+		"<burst-size-limit>15000</burst-size-limit>",
+		"<then>discard</then>",
+	} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	if iface := roundTrip.Interfaces["ge-0/0/0"]; iface.InputPolicer != "LIMIT" || iface.OutputPolicer != "LIMIT" {
+		t.Fatalf("round-trip interface = %#v, want LIMIT policers", iface)
+	}
+	policer := roundTrip.Firewall.Policers["LIMIT"]
+	if policer == nil || *policer != *cfg.Firewall.Policers["LIMIT"] {
+		t.Fatalf("round-trip policer = %#v, want %#v", policer, cfg.Firewall.Policers["LIMIT"])
+	}
+}
+
+func TestXMLRIPRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Protocols: &config.ProtocolConfig{
+			RIP: &config.RIPConfig{
+				UpdateInterval: 10,
+				DefaultMetric:  2,
+				Groups: map[string]*config.RIPGroup{
+					"EDGE": {Name: "EDGE", Neighbors: map[string]*config.RIPNeighbor{
+						"ge-0/0/0": {Name: "ge-0/0/0"},
+						"ge-0/0/1": {Name: "ge-0/0/1", Passive: true},
+					}},
+				},
+			},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	for _, want := range []string{
+		"<update-interval>10</update-interval>",
+		"<default-metric>2</default-metric>",
+		"<passive>true</passive>",
+	} {
+		if !strings.Contains(string(xmlData), want) {
+			t.Fatalf("ConfigToXML() missing %s:\n%s", want, xmlData)
+		}
+	}
+
+	roundTrip, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	if !reflect.DeepEqual(roundTrip.Protocols.RIP, cfg.Protocols.RIP) {
+		t.Fatalf("round-trip RIP = %#v, want %#v", roundTrip.Protocols.RIP, cfg.Protocols.RIP)
+	}
+}
+
 func TestXMLBFDProtocolBindingsRoundTrip(t *testing.T) {
 	cfg := &config.Config{
 		Protocols: &config.ProtocolConfig{
@@ -758,6 +1206,44 @@ func assertXMLOrder(t *testing.T, xmlStr, first, second string) {
 	}
 }
 
+func TestOSPFInterfaceUnsetMetricAndPriorityRoundTrip(t *testing.T) {
+	cfg := &config.Config{
+		Interfaces: map[string]*config.Interface{},
+		Protocols: &config.ProtocolConfig{
+			OSPF: &config.OSPFConfig{
+				Areas: map[string]*config.OSPFArea{
+					"0.0.0.0": {
+						AreaID: "0.0.0.0",
+						Interfaces: map[string]*config.OSPFInterface{
+							"ge-0/0/0": {Name: "ge-0/0/0"},
+							"ge-0/0/1": {Name: "ge-0/0/1", Metric: intPtr(10), Priority: intPtr(1)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	xmlData, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	if got := strings.Count(string(xmlData), "<metric>"); got != 1 {
+		t.Fatalf("ConfigToXML() wrote %d metric elements, want 1:\n%s", got, xmlData)
+	}
+	parsed, err := XMLToConfig(xmlData, DefaultOpMerge)
+	if err != nil {
+		t.Fatalf("XMLToConfig() error = %v", err)
+	}
+	ifaces := parsed.Protocols.OSPF.Areas["0.0.0.0"].Interfaces
+	if unset := ifaces["ge-0/0/0"]; unset.Metric != nil || unset.Priority != nil {
+		t.Fatalf("unset OSPF interface = %#v, want nil metric and priority", unset)
+	}
+	if set := ifaces["ge-0/0/1"]; set.Metric == nil || *set.Metric != 10 || set.Priority == nil || *set.Priority != 1 {
+		t.Fatalf("configured OSPF interface = %#v, want metric 10 priority 1", set)
+	}
+}
+
 func TestXMLToConfigPreservesExplicitOSPFPriorityZero(t *testing.T) {
 	xmlData := []byte(`
 <config>
@@ -781,7 +1267,7 @@ func TestXMLToConfigPreservesExplicitOSPFPriorityZero(t *testing.T) {
 	}
 
 	ospfIface := cfg.Protocols.OSPF.Areas["0.0.0.0"].Interfaces["ge-0/0/0"]
-	if !ospfIface.PrioritySet || ospfIface.Priority != 0 {
+	if ospfIface.Priority == nil || *ospfIface.Priority != 0 {
 		t.Fatalf("XMLToConfig() OSPF interface = %#v, want explicit priority 0", ospfIface)
 	}
 
@@ -1050,7 +1536,7 @@ func TestCountConfigElementsIncludesExplicitOSPFPriorityZero(t *testing.T) {
 					"0.0.0.0": {
 						AreaID: "0.0.0.0",
 						Interfaces: map[string]*config.OSPFInterface{
-							"ge-0/0/0": {Name: "ge-0/0/0", Priority: 0, PrioritySet: true},
+							"ge-0/0/0": {Name: "ge-0/0/0", Priority: intPtr(0)},
 						},
 					},
 				},
@@ -1080,3 +1566,110 @@ func TestCountConfigElementsIncludesExplicitOSPFPriorityZero(t *testing.T) {
 		t.Fatalf("countConfigElements() = %d, want %d", got, want)
 	}
 }
+
+func largeInterfaceConfig(count, descriptionLen int) *config.Config {
+	cfg := config.NewConfig()
+	description := strings.Repeat("d", descriptionLen)
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("ge-0/%d/%d", i/1000, i%1000)
+		cfg.Interfaces[name] = &config.Interface{Description: description}
+	}
+	return cfg
+}
+
+// countingWriter records how much output reached the transport.
+type countingWriter struct {
+	n int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	return len(p), nil
+}
+
+func TestWriteConfigXMLNearSizeLimit(t *testing.T) {
+	cfg := largeInterfaceConfig(9000, 1000)
+	size, err := MeasureConfigXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("MeasureConfigXML() error = %v", err)
+	}
+	if size <= MaxXMLSize*3/4 || size > MaxXMLSize {
+		t.Fatalf("MeasureConfigXML() = %d, want just under %d", size, MaxXMLSize)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteConfigXML(&buf, cfg, nil); err != nil {
+		t.Fatalf("WriteConfigXML() error = %v", err)
+	}
+	if buf.Len() != size {
+		t.Fatalf("WriteConfigXML() wrote %d bytes, MeasureConfigXML() = %d", buf.Len(), size)
+	}
+	buffered, err := ConfigToXML(cfg, nil)
+	if err != nil {
+		t.Fatalf("ConfigToXML() error = %v", err)
+	}
+	if !bytes.Equal(buffered, buf.Bytes()) {
+		t.Fatal("ConfigToXML() output differs from WriteConfigXML()")
+	}
+}
+
+func TestWriteConfigXMLStopsAtSizeLimit(t *testing.T) {
+	cfg := largeInterfaceConfig(20000, 1000)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	out := &countingWriter{}
+	err := WriteConfigXML(out, cfg, nil)
+	runtime.ReadMemStats(&after)
+
+	rpcErr, ok := err.(*RPCError)
+	if !ok {
+		t.Fatalf("WriteConfigXML() error = %v (%T), want *RPCError", err, err)
+	}
+	if rpcErr.ErrorTag != ErrorTagInvalidValue || rpcErr.ErrorAppTag != "size-limit" {
+		t.Fatalf("WriteConfigXML() error = %#v, want invalid-value size-limit", rpcErr)
+	}
+	// Output stops at the limit instead of serializing all ~20MB; the tail
+	// still held in the stream buffer is discarded.
+	if out.n > MaxXMLSize || out.n < MaxXMLSize-8192 {
+		t.Fatalf("WriteConfigXML() wrote %d bytes, want just under %d", out.n, MaxXMLSize)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > MaxXMLSize/8 {
+		t.Fatalf("WriteConfigXML() allocated %d bytes, want streaming without buffering the document", allocated)
+	}
+
+	if _, err := MeasureConfigXML(cfg, nil); err == nil {
+		t.Fatal("MeasureConfigXML() error = nil, want size-limit error")
+	}
+	if _, err := ConfigToXML(cfg, nil); err == nil {
+		t.Fatal("ConfigToXML() error = nil, want size-limit error")
+	}
+}
+
+func TestWriteEscapedTextMatchesEscapeText(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"plain",
+		`<a href="x">'b' & c</a>`,
+		"tab\tnew\nline\rend",
+		"bad\x00ctl\xffbyte",
+		"unicode \u00e9\U0001F600",
+	} {
+		var want bytes.Buffer
+		if err := xml.EscapeText(&want, []byte(value)); err != nil {
+			t.Fatalf("xml.EscapeText(%q) error = %v", value, err)
+		}
+		var got bytes.Buffer
+		if err := writeEscapedText(&got, value); err != nil {
+			t.Fatalf("writeEscapedText(%q) error = %v", value, err)
+		}
+		if got.String() != want.String() {
+			t.Fatalf("writeEscapedText(%q) = %q, want %q", value, got.String(), want.String())
+		}
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
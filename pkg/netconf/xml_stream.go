@@ -0,0 +1,256 @@
+package netconf
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// xmlWriter is the sink used by the config XML serializers. *bytes.Buffer
+// satisfies it, as does xmlStreamWriter for size-limited streaming output.
+type xmlWriter interface {
+	io.Writer
+	io.ByteWriter
+	io.StringWriter
+}
+
+// errXMLSizeLimit marks output that was abandoned because it grew past the
+// configured size limit.
+var errXMLSizeLimit = errors.New("xml output size limit exceeded")
+
+// xmlStreamWriter forwards serializer output to an underlying writer while
+// counting bytes. The first error is sticky: once the limit is exceeded or
+// the underlying writer fails, every later write fails without output, so
+// serializers that check write errors stop early.
+type xmlStreamWriter struct {
+	w        io.Writer
+	buffered *bufio.Writer
+	limit    int
+	n        int
+	err      error
+}
+
+// newXMLStreamWriter wraps w with a byte limit. Writers without WriteString
+// (such as framing writers) get a small buffer so string output is copied
+// rather than converted to a new byte slice on every write.
+func newXMLStreamWriter(w io.Writer, limit int) *xmlStreamWriter {
+	s := &xmlStreamWriter{w: w, limit: limit}
+	if _, ok := w.(io.StringWriter); !ok {
+		s.buffered = bufio.NewWriter(w)
+		s.w = s.buffered
+	}
+	return s
+}
+
+// flush pushes any buffered output to the underlying writer.
+func (s *xmlStreamWriter) flush() error {
+	if s.err != nil || s.buffered == nil {
+		return s.err
+	}
+	if err := s.buffered.Flush(); err != nil {
+		s.err = err
+	}
+	return s.err
+}
+
+func (s *xmlStreamWriter) reserve(size int) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.n+size > s.limit {
+		s.err = errXMLSizeLimit
+		return s.err
+	}
+	s.n += size
+	return nil
+}
+
+func (s *xmlStreamWriter) Write(p []byte) (int, error) {
+	if err := s.reserve(len(p)); err != nil {
+		return 0, err
+	}
+	n, err := s.w.Write(p)
+	if err != nil {
+		s.err = err
+	}
+	return n, err
+}
+
+func (s *xmlStreamWriter) WriteString(str string) (int, error) {
+	if err := s.reserve(len(str)); err != nil {
+		return 0, err
+	}
+	var n int
+	var err error
+	if sw, ok := s.w.(io.StringWriter); ok {
+		n, err = sw.WriteString(str)
+	} else {
+		n, err = s.w.Write([]byte(str))
+	}
+	if err != nil {
+		s.err = err
+	}
+	return n, err
+}
+
+func (s *xmlStreamWriter) WriteByte(c byte) error {
+	if err := s.reserve(1); err != nil {
+		return err
+	}
+	var err error
+	if bw, ok := s.w.(io.ByteWriter); ok {
+		err = bw.WriteByte(c)
+	} else {
+		_, err = s.w.Write([]byte{c})
+	}
+	if err != nil {
+		s.err = err
+	}
+	return err
+}
+
+// check converts the result of serializing one config section. Hitting the
+// size limit is reported as the size-limit rpc-error regardless of how the
+// section surfaced it; other failures are wrapped with the section name.
+func (s *xmlStreamWriter) check(section string, err error) error {
+	if errors.Is(s.err, errXMLSizeLimit) {
+		return newXMLSizeLimitError(s.limit)
+	}
+	if err == nil {
+		err = s.err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to serialize %s: %w", section, err)
+	}
+	return nil
+}
+
+// newXMLSizeLimitError reports a get-config reply that would exceed limit.
+func newXMLSizeLimitError(limit int) *RPCError {
+	return NewRPCError(ErrorTypeProtocol, ErrorTagInvalidValue,
+		fmt.Sprintf("generated XML exceeds size limit (%d bytes)", limit)).
+		WithPath("/rpc/get-config").
+		WithAppTag("size-limit")
+}
+
+// xmlByteCounter discards output and counts its length.
+type xmlByteCounter struct {
+	n int
+}
+
+func (c *xmlByteCounter) Write(p []byte) (int, error) {
+	c.n += len(p)
+	return len(p), nil
+}
+
+func (c *xmlByteCounter) WriteString(s string) (int, error) {
+	c.n += len(s)
+	return len(s), nil
+}
+
+// writeEscapedText writes s as XML character data, escaping it exactly like
+// xml.EscapeText but without converting s to a byte slice first.
+func writeEscapedText(w xmlWriter, s string) error {
+	last := 0
+	for i := 0; i < len(s); {
+		r, width := utf8.DecodeRuneInString(s[i:])
+		var esc string
+		switch r {
+		case '"':
+			esc = "&#34;"
+		case '\'':
+			esc = "&#39;"
+		case '&':
+			esc = "&amp;"
+		case '<':
+			esc = "&lt;"
+		case '>':
+			esc = "&gt;"
+		case '\t':
+			esc = "&#x9;"
+		case '\n':
+			esc = "&#xA;"
+		case '\r':
+			esc = "&#xD;"
+		default:
+			if !isXMLTextChar(r) || (r == utf8.RuneError && width == 1) {
+				esc = "\uFFFD"
+				break
+			}
+			i += width
+			continue
+		}
+		if _, err := w.WriteString(s[last:i]); err != nil {
+			return err
+		}
+		if _, err := w.WriteString(esc); err != nil {
+			return err
+		}
+		i += width
+		last = i
+	}
+	_, err := w.WriteString(s[last:])
+	return err
+}
+
+// isXMLTextChar reports whether r is allowed in XML character data.
+func isXMLTextChar(r rune) bool {
+	return r == 0x09 || r == 0x0A || r == 0x0D ||
+		r >= 0x20 && r <= 0xD7FF ||
+		r >= 0xE000 && r <= 0xFFFD ||
+		r >= 0x10000 && r <= 0x10FFFF
+}
+
+// xmlIndentWriter prefixes every output line with a fixed indent, so an
+// element serializer can be reused one level deeper in the document, such as
+// the interface statements of a configuration group. Text content is written
+// with newlines escaped, so only the serializer's own line breaks are
+// indented.
+type xmlIndentWriter struct {
+	w           xmlWriter
+	indent      string
+	atLineStart bool
+}
+
+func newXMLIndentWriter(w xmlWriter, indent string) *xmlIndentWriter {
+	return &xmlIndentWriter{w: w, indent: indent, atLineStart: true}
+}
+
+func (iw *xmlIndentWriter) Write(p []byte) (int, error) {
+	if _, err := iw.WriteString(string(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (iw *xmlIndentWriter) WriteString(s string) (int, error) {
+	n := 0
+	for len(s) > 0 {
+		if iw.atLineStart {
+			if _, err := iw.w.WriteString(iw.indent); err != nil {
+				return n, err
+			}
+			iw.atLineStart = false
+		}
+		line := s
+		if i := strings.IndexByte(s, '\n'); i >= 0 {
+			line = s[:i+1]
+			iw.atLineStart = true
+		}
+		written, err := iw.w.WriteString(line)
+		n += written
+		if err != nil {
+			return n, err
+		}
+		s = s[len(line):]
+	}
+	return n, nil
+}
+
+func (iw *xmlIndentWriter) WriteByte(c byte) error {
+	_, err := iw.WriteString(string(c))
+	return err
+}
@@ -34,6 +34,9 @@ module ietf-interfaces {
       leaf enabled {
         type boolean;
       }
+      leaf if-index {
+        type uint32;
+      }
       leaf admin-status {
         type string;
       }
@@ -287,12 +290,20 @@ func NewYANGValidator() (*YANGValidator, error) {
 		return nil, fmt.Errorf("failed to build YANG path schema: %w", err)
 	}
 	schemaPaths = append(schemaPaths, netconfXMLCompatibilityYANGPaths...)
+	for _, path := range netconfXMLCompatibilityYANGPaths {
+		if rest, ok := strings.CutPrefix(path, "interfaces/interface/"); ok {
+			schemaPaths = append(schemaPaths, groupInterfaceYANGPrefix+rest)
+		}
+	}
 	schemaLeafTypes, err := yangModuleLeafTypes(ms, "arca-router", "ietf-interfaces", "ietf-routing", "ietf-system")
 	if err != nil {
 		return nil, fmt.Errorf("failed to build YANG leaf type schema: %w", err)
 	}
 	for path, leafType := range netconfXMLCompatibilityYANGLeafTypes {
 		schemaLeafTypes[path] = leafType
+		if rest, ok := strings.CutPrefix(path, "interfaces/interface/"); ok {
+			schemaLeafTypes[groupInterfaceYANGPrefix+rest] = leafType
+		}
 	}
 
 	return &YANGValidator{
@@ -409,20 +420,48 @@ func (v *YANGValidator) validateXPathFilterPath(xpathFilter *XPathFilter) error
 
 var implementedYANGPathSchema = newYANGPathSchema(implementedYANGElementPaths())
 
+// groupInterfaceYANGPrefix is the path of the interface statements of a
+// configuration group. They use the same XML elements as top-level interfaces,
+// so the compatibility paths below apply to them too.
+const groupInterfaceYANGPrefix = "groups/group/interfaces/interface/"
+
 var netconfXMLCompatibilityYANGPaths = []string{
 	"interfaces/interface/unit/name",
 	"interfaces/interface/unit/family/name",
 	"interfaces/interface/unit/family/address",
+	"interfaces/interface/unit/family/mtu",
+	"interfaces/interface/unit/family/neighbor",
+	"interfaces/interface/unit/family/neighbor/address",
+	"interfaces/interface/unit/family/neighbor/mac",
+	"interfaces/interface/unit/family/router-advertisement",
+	"interfaces/interface/unit/family/router-advertisement/managed-configuration",
+	"interfaces/interface/unit/family/router-advertisement/other-stateful-configuration",
+	"interfaces/interface/unit/family/router-advertisement/max-advertisement-interval",
+	"interfaces/interface/unit/family/router-advertisement/min-advertisement-interval",
+	"interfaces/interface/unit/family/router-advertisement/prefix",
+	"interfaces/interface/unit/family/router-advertisement/prefix/name",
+	"interfaces/interface/unit/family/router-advertisement/prefix/valid-lifetime",
+	"interfaces/interface/unit/family/router-advertisement/prefix/preferred-lifetime",
 	"protocols/ospf/area/name",
 	"protocols/ospf3/area/name",
 }
 
 var netconfXMLCompatibilityYANGLeafTypes = map[string]string{
-	"interfaces/interface/unit/name":           "uint32",
-	"interfaces/interface/unit/family/name":    "string",
-	"interfaces/interface/unit/family/address": "string",
-	"protocols/ospf/area/name":                 "string",
-	"protocols/ospf3/area/name":                "string",
+	"interfaces/interface/unit/name":                                                     "uint32",
+	"interfaces/interface/unit/family/name":                                              "string",
+	"interfaces/interface/unit/family/address":                                           "string",
+	"interfaces/interface/unit/family/mtu":                                               "uint16",
+	"interfaces/interface/unit/family/neighbor/address":                                  "string",
+	"interfaces/interface/unit/family/neighbor/mac":                                      "string",
+	"interfaces/interface/unit/family/router-advertisement/managed-configuration":        "boolean",
+	"interfaces/interface/unit/family/router-advertisement/other-stateful-configuration": "boolean",
+	"interfaces/interface/unit/family/router-advertisement/max-advertisement-interval":   "uint16",
+	"interfaces/interface/unit/family/router-advertisement/min-advertisement-interval":   "uint16",
+	"interfaces/interface/unit/family/router-advertisement/prefix/name":                  "string",
+	"interfaces/interface/unit/family/router-advertisement/prefix/valid-lifetime":        "uint32",
+	"interfaces/interface/unit/family/router-advertisement/prefix/preferred-lifetime":    "uint32",
+	"protocols/ospf/area/name":                                                           "string",
+	"protocols/ospf3/area/name":                                                          "string",
 }
 
 func yangModuleElementPaths(ms *yang.Modules, moduleNames ...string) ([]string, error) {
@@ -561,6 +600,7 @@ var operationalStateYANGPaths = []string{
 	"system/system-state/platform/machine",
 	"system/system-state/clock",
 	"system/system-state/clock/current-datetime",
+	"interfaces/interface/if-index",
 	"interfaces/interface/admin-status",
 	"interfaces/interface/oper-status",
 	"interfaces/interface/phys-address",
@@ -605,6 +645,15 @@ var operationalStateYANGPaths = []string{
 	"routing/routing-state/routing-protocols/routing-protocol/name",
 	"routing/routing-state/routing-protocols/routing-protocol/admin-status",
 	"state",
+	"state/last-apply",
+	"state/last-apply/time",
+	"state/last-apply/version",
+	"state/last-apply/status",
+	"state/last-apply/error",
+	"state/last-apply/plugin",
+	"state/last-apply/plugin/name",
+	"state/last-apply/plugin/status",
+	"state/last-apply/plugin/error",
 	"state/interfaces",
 	"state/routes",
 	"state/routes/route",
@@ -3,6 +3,9 @@ package vpp
 import (
 	"context"
 	"net"
+	"time"
+
+	"github.com/akam1o/arca-router/pkg/config"
 )
 
 // LCPInterface represents a Linux Control Plane interface pair
@@ -47,6 +50,57 @@ type Client interface {
 	// DeleteInterfaceAddress removes an IP address from an interface
 	DeleteInterfaceAddress(ctx context.Context, ifIndex uint32, addr *net.IPNet) error
 
+	// ApplyInterfaceAddresses adds or removes many interface addresses in one
+	// batch. Every operation is attempted; the returned slice holds one error
+	// slot per operation, nil when that operation succeeded.
+	ApplyInterfaceAddresses(ctx context.Context, ops []AddressOp) []error
+
+	// GetNeighbors lists IPv4 ARP and IPv6 neighbor entries on an interface,
+	// or on every interface when swIfIndex is AllInterfaces.
+	GetNeighbors(ctx context.Context, swIfIndex uint32) ([]Neighbor, error)
+
+	// AddNeighbor adds a static IPv4 ARP or IPv6 neighbor entry.
+	AddNeighbor(ctx context.Context, neighbor Neighbor) error
+
+	// DeleteNeighbor removes an IPv4 ARP or IPv6 neighbor entry.
+	DeleteNeighbor(ctx context.Context, neighbor Neighbor) error
+
+	// SetInterfacePromiscuous enables or disables promiscuous mode on an interface
+	SetInterfacePromiscuous(ctx context.Context, ifIndex uint32, enabled bool) error
+
+	// SetInterfaceRxMode sets the RX mode (polling, interrupt, adaptive, or
+	// default) for all RX queues of an interface
+	SetInterfaceRxMode(ctx context.Context, ifIndex uint32, mode string) error
+
+	// SetInterfaceRxPlacement places an RX queue of an interface on a VPP
+	// worker thread; workers are numbered from 0
+	SetInterfaceRxPlacement(ctx context.Context, ifIndex uint32, queueID uint32, workerID uint32) error
+
+	// GetWorkerCount returns the number of VPP worker threads, 0 when VPP
+	// forwards on its main thread only
+	GetWorkerCount(ctx context.Context) (uint32, error)
+
+	// SetInterfaceMTU sets the link (hardware) MTU of an interface
+	SetInterfaceMTU(ctx context.Context, ifIndex uint32, mtu uint32) error
+
+	// SetInterfaceIPMTU sets the IPv4 and IPv6 L3 MTUs of an interface
+	SetInterfaceIPMTU(ctx context.Context, ifIndex uint32, ip4, ip6 uint32) error
+
+	// SetInterfaceIP6Enabled enables or disables IPv6 on an interface; enabling
+	// creates its link-local address, so IPv6 runs without a configured address
+	SetInterfaceIP6Enabled(ctx context.Context, ifIndex uint32, enabled bool) error
+
+	// SetInterfaceRouterAdvertisement configures or suppresses IPv6 router
+	// advertisements on an interface
+	SetInterfaceRouterAdvertisement(ctx context.Context, ifIndex uint32, ra RouterAdvertisement) error
+
+	// AddRouterAdvertisementPrefix advertises a prefix in IPv6 router
+	// advertisements on an interface
+	AddRouterAdvertisementPrefix(ctx context.Context, ifIndex uint32, prefix RouterAdvertisementPrefix) error
+
+	// DeleteRouterAdvertisementPrefix stops advertising a prefix on an interface
+	DeleteRouterAdvertisementPrefix(ctx context.Context, ifIndex uint32, prefix *net.IPNet) error
+
 	// SetMPLSInterface enables or disables MPLS forwarding on an interface
 	SetMPLSInterface(ctx context.Context, ifIndex uint32, enabled bool) error
 
@@ -71,6 +125,16 @@ type Client interface {
 	// ClearQoSProfile removes output QoS policy intent from an interface.
 	ClearQoSProfile(ctx context.Context, ifIndex uint32) error
 
+	// AddPolicer creates a named single-rate two-color policer.
+	AddPolicer(ctx context.Context, policer Policer) error
+
+	// DeletePolicer deletes a named policer.
+	DeletePolicer(ctx context.Context, name string) error
+
+	// SetInterfacePolicer applies or removes a named policer on the input or
+	// output path of an interface.
+	SetInterfacePolicer(ctx context.Context, ifIndex uint32, name string, output, apply bool) error
+
 	// AddBridgeDomain creates a VPP L2 bridge domain.
 	AddBridgeDomain(ctx context.Context, bridge BridgeDomain) error
 
@@ -83,12 +147,20 @@ type Client interface {
 	// DeleteVXLAN deletes a VXLAN tunnel interface.
 	DeleteVXLAN(ctx context.Context, req VXLANRequest) error
 
+	// DeleteTunnelInterface deletes a GRE or IPIP tunnel interface or a
+	// loopback interface created with CreateInterface.
+	DeleteTunnelInterface(ctx context.Context, req *CreateInterfaceRequest, ifIndex uint32) error
+
 	// SetInterfaceL2Bridge attaches or detaches an interface to a bridge domain.
 	SetInterfaceL2Bridge(ctx context.Context, ifIndex uint32, bridgeID uint32, enable bool) error
 
 	// ListInterfaceCounters returns packet and byte counters by VPP interface index.
 	ListInterfaceCounters(ctx context.Context) (map[uint32]InterfaceCounters, error)
 
+	// ClearInterfaceStats resets the counters of an interface, or of every
+	// interface when ifIndex is AllInterfaces.
+	ClearInterfaceStats(ctx context.Context, ifIndex uint32) error
+
 	// ListInterfaceQueuePlacements returns RX/TX queue placement by VPP interface index.
 	ListInterfaceQueuePlacements(ctx context.Context) (map[uint32]InterfaceQueuePlacements, error)
 
@@ -115,6 +187,13 @@ type Client interface {
 	GetVersion(ctx context.Context) (string, error)
 }
 
+// AddressOp is one interface address change in an ApplyInterfaceAddresses batch.
+type AddressOp struct {
+	IfIndex uint32
+	Address *net.IPNet
+	Delete  bool
+}
+
 // CreateInterfaceRequest represents a request to create a VPP interface
 type CreateInterfaceRequest struct {
 	// Type of interface
@@ -144,6 +223,23 @@ type CreateInterfaceRequest struct {
 
 	// TxqSize is the TX queue size
 	TxqSize uint16
+
+	// TunnelSource is the local tunnel endpoint (GRE/IPIP)
+	TunnelSource net.IP
+
+	// TunnelDestination is the remote tunnel endpoint (GRE/IPIP)
+	TunnelDestination net.IP
+
+	// HostIfName is the Linux name of the host side of a tap interface
+	HostIfName string
+
+	// HostMTU is the MTU of the host side of a tap interface (0 keeps the
+	// kernel default)
+	HostMTU uint32
+
+	// HostNamespace is the network namespace of the host side of a tap
+	// interface (optional, default: the VPP process namespace)
+	HostNamespace string
 }
 
 // Interface represents a VPP interface
@@ -174,6 +270,54 @@ type Interface struct {
 	QoSProfile string
 }
 
+// AllInterfaces selects every interface in VPP dump and clear requests.
+const AllInterfaces = ^uint32(0)
+
+// DefaultInterfaceMTU is the link MTU restored on an interface when no MTU
+// is configured. The validator checks family MTUs against the same value.
+const DefaultInterfaceMTU = config.DefaultInterfaceMTU
+
+// Router advertisement defaults in seconds. They match the VPP defaults and
+// are used when an interval or prefix lifetime is not configured.
+const (
+	DefaultRAMaxInterval       = 200
+	DefaultRAValidLifetime     = 30 * 24 * 60 * 60
+	DefaultRAPreferredLifetime = 7 * 24 * 60 * 60
+)
+
+// RouterAdvertisement is the IPv6 router advertisement state of an
+// interface. Suppress stops unsolicited advertisements; the other fields are
+// only meaningful when advertisements are sent.
+type RouterAdvertisement struct {
+	Suppress    bool
+	Managed     bool
+	Other       bool
+	MaxInterval uint32
+	MinInterval uint32
+}
+
+// RouterAdvertisementPrefix is a prefix advertised for on-link determination
+// and stateless address autoconfiguration. A zero lifetime keeps the VPP
+// default.
+type RouterAdvertisementPrefix struct {
+	Prefix            *net.IPNet
+	ValidLifetime     uint32
+	PreferredLifetime uint32
+}
+
+// Neighbor represents an IPv4 ARP or IPv6 neighbor discovery entry.
+type Neighbor struct {
+	SwIfIndex uint32
+	IP        net.IP
+	MAC       net.HardwareAddr
+
+	// Static marks entries configured by the operator rather than learned.
+	Static bool
+
+	// Age is the time since the entry was last confirmed (dump only).
+	Age time.Duration
+}
+
 // IPTable represents a VPP IPv4 or IPv6 FIB table.
 type IPTable struct {
 	ID     uint32
@@ -195,6 +339,14 @@ type QoSQueue struct {
 	Queue           uint8
 }
 
+// Policer represents a VPP single-rate two-color policer. Traffic within the
+// committed rate is transmitted and traffic exceeding it is dropped.
+type Policer struct {
+	Name           string
+	BandwidthLimit uint64 // bits per second
+	BurstSizeLimit uint64 // bytes
+}
+
 // QoSCapabilities describes class-of-service dataplane support exposed by VPP.
 type QoSCapabilities struct {
 	MetadataBinding     bool
@@ -265,6 +417,15 @@ const (
 	// InterfaceTypeRDMA is the RDMA (Mellanox) interface type
 	InterfaceTypeRDMA InterfaceType = "rdma"
 
-	// InterfaceTypeTap is the TAP interface type (for LCP)
+	// InterfaceTypeTap is the host-facing TAP interface type
 	InterfaceTypeTap InterfaceType = "tap"
+
+	// InterfaceTypeGRE is the point-to-point L3 GRE tunnel interface type
+	InterfaceTypeGRE InterfaceType = "gre"
+
+	// InterfaceTypeIPIP is the point-to-point IP-in-IP tunnel interface type
+	InterfaceTypeIPIP InterfaceType = "ipip"
+
+	// InterfaceTypeLoopback is the software loopback interface type
+	InterfaceTypeLoopback InterfaceType = "loopback"
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"os"
 	"path/filepath"
@@ -13,20 +14,30 @@ import (
 	"time"
 
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/avf"
+	"github.com/akam1o/arca-router/pkg/vpp/binapi/ethernet_types"
 	vppif "github.com/akam1o/arca-router/pkg/vpp/binapi/interface"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/interface_types"
 	vppip "github.com/akam1o/arca-router/pkg/vpp/binapi/ip"
+	"github.com/akam1o/arca-router/pkg/vpp/binapi/ip_neighbor"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/ip_types"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/lcp"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/mpls"
+	"github.com/akam1o/arca-router/pkg/vpp/binapi/policer"
+	"github.com/akam1o/arca-router/pkg/vpp/binapi/policer_types"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/rdma"
+	"github.com/akam1o/arca-router/pkg/vpp/binapi/tapv2"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/vpe"
 	"go.fd.io/govpp/adapter/socketclient"
 	"go.fd.io/govpp/adapter/statsclient"
 	"go.fd.io/govpp/api"
+	govppgre "go.fd.io/govpp/binapi/gre"
 	govppiftypes "go.fd.io/govpp/binapi/interface_types"
+	govppip6nd "go.fd.io/govpp/binapi/ip6_nd"
 	govppiptypes "go.fd.io/govpp/binapi/ip_types"
+	govppipip "go.fd.io/govpp/binapi/ipip"
 	govppl2 "go.fd.io/govpp/binapi/l2"
+	govpptunneltypes "go.fd.io/govpp/binapi/tunnel_types"
+	govppvlib "go.fd.io/govpp/binapi/vlib"
 	govppvxlan "go.fd.io/govpp/binapi/vxlan"
 	"go.fd.io/govpp/core"
 )
@@ -358,11 +369,151 @@ func (c *govppClient) CreateInterface(ctx context.Context, req *CreateInterfaceR
 		return c.createAVFInterface(ctx, req)
 	case InterfaceTypeRDMA:
 		return c.createRDMAInterface(ctx, req)
+	case InterfaceTypeTap:
+		return c.createTapInterface(ctx, req)
+	case InterfaceTypeGRE:
+		return c.createGRETunnel(ctx, req)
+	case InterfaceTypeIPIP:
+		return c.createIPIPTunnel(ctx, req)
+	case InterfaceTypeLoopback:
+		return c.createLoopbackInterface(ctx)
 	default:
 		return nil, fmt.Errorf("unsupported interface type: %s", req.Type)
 	}
 }
 
+// createLoopbackInterface creates a loopback interface with a VPP-assigned MAC
+// address.
+func (c *govppClient) createLoopbackInterface(ctx context.Context) (*Interface, error) {
+	reply := &vppif.CreateLoopbackReply{}
+	if err := c.ch.SendRequest(&vppif.CreateLoopback{}).ReceiveReply(reply); err != nil {
+		return nil, fmt.Errorf("loopback create failed: %w", err)
+	}
+	if reply.Retval != 0 {
+		return nil, fmt.Errorf("loopback create returned error code: %d", reply.Retval)
+	}
+	return c.GetInterface(ctx, uint32(reply.SwIfIndex))
+}
+
+// createGRETunnel creates a point-to-point L3 GRE tunnel interface
+func (c *govppClient) createGRETunnel(ctx context.Context, req *CreateInterfaceRequest) (*Interface, error) {
+	if err := validateTunnelRequest(req); err != nil {
+		return nil, err
+	}
+
+	reply := &govppgre.GreTunnelAddDelReply{}
+	if err := c.ch.SendRequest(greTunnelAddDel(req, true)).ReceiveReply(reply); err != nil {
+		return nil, fmt.Errorf("GRE tunnel create failed: %w", err)
+	}
+	if reply.Retval != 0 {
+		return nil, fmt.Errorf("GRE tunnel create returned error code: %d", reply.Retval)
+	}
+	return c.GetInterface(ctx, uint32(reply.SwIfIndex))
+}
+
+// createIPIPTunnel creates a point-to-point IP-in-IP tunnel interface
+func (c *govppClient) createIPIPTunnel(ctx context.Context, req *CreateInterfaceRequest) (*Interface, error) {
+	if err := validateTunnelRequest(req); err != nil {
+		return nil, err
+	}
+
+	createReq := &govppipip.IpipAddTunnel{
+		Tunnel: govppipip.IpipTunnel{
+			Instance: ^uint32(0),
+			Src:      govppiptypes.NewAddress(req.TunnelSource),
+			Dst:      govppiptypes.NewAddress(req.TunnelDestination),
+			Mode:     govpptunneltypes.TUNNEL_API_MODE_P2P,
+		},
+	}
+	reply := &govppipip.IpipAddTunnelReply{}
+	if err := c.ch.SendRequest(createReq).ReceiveReply(reply); err != nil {
+		return nil, fmt.Errorf("IPIP tunnel create failed: %w", err)
+	}
+	if reply.Retval != 0 {
+		return nil, fmt.Errorf("IPIP tunnel create returned error code: %d", reply.Retval)
+	}
+	return c.GetInterface(ctx, uint32(reply.SwIfIndex))
+}
+
+// DeleteTunnelInterface deletes a GRE or IPIP tunnel interface. GRE tunnels
+// are looked up by their endpoints; IPIP tunnels by interface index.
+func (c *govppClient) DeleteTunnelInterface(ctx context.Context, req *CreateInterfaceRequest, ifIndex uint32) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+	if req == nil {
+		return fmt.Errorf("request cannot be nil")
+	}
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	switch req.Type {
+	case InterfaceTypeGRE:
+		if err := validateTunnelRequest(req); err != nil {
+			return err
+		}
+		reply := &govppgre.GreTunnelAddDelReply{}
+		if err := c.ch.SendRequest(greTunnelAddDel(req, false)).ReceiveReply(reply); err != nil {
+			return fmt.Errorf("GRE tunnel delete failed: %w", err)
+		}
+		if reply.Retval != 0 {
+			return fmt.Errorf("GRE tunnel delete returned error code: %d", reply.Retval)
+		}
+	case InterfaceTypeIPIP:
+		reply := &govppipip.IpipDelTunnelReply{}
+		if err := c.ch.SendRequest(&govppipip.IpipDelTunnel{
+			SwIfIndex: govppiftypes.InterfaceIndex(ifIndex),
+		}).ReceiveReply(reply); err != nil {
+			return fmt.Errorf("IPIP tunnel delete failed: %w", err)
+		}
+		if reply.Retval != 0 {
+			return fmt.Errorf("IPIP tunnel delete returned error code: %d", reply.Retval)
+		}
+	case InterfaceTypeLoopback:
+		reply := &vppif.DeleteLoopbackReply{}
+		if err := c.ch.SendRequest(&vppif.DeleteLoopback{
+			SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+		}).ReceiveReply(reply); err != nil {
+			return fmt.Errorf("loopback delete failed: %w", err)
+		}
+		if reply.Retval != 0 {
+			return fmt.Errorf("loopback delete returned error code: %d", reply.Retval)
+		}
+	default:
+		return fmt.Errorf("interface type %s is not a tunnel or loopback", req.Type)
+	}
+	return nil
+}
+
+func greTunnelAddDel(req *CreateInterfaceRequest, isAdd bool) *govppgre.GreTunnelAddDel {
+	return &govppgre.GreTunnelAddDel{
+		IsAdd: isAdd,
+		Tunnel: govppgre.GreTunnel{
+			Type:     govppgre.GRE_API_TUNNEL_TYPE_L3,
+			Mode:     govpptunneltypes.TUNNEL_API_MODE_P2P,
+			Instance: ^uint32(0),
+			Src:      govppiptypes.NewAddress(req.TunnelSource),
+			Dst:      govppiptypes.NewAddress(req.TunnelDestination),
+		},
+	}
+}
+
+func validateTunnelRequest(req *CreateInterfaceRequest) error {
+	if req.TunnelSource == nil || req.TunnelSource.To16() == nil {
+		return fmt.Errorf("%s tunnel source address is required", req.Type)
+	}
+	if req.TunnelDestination == nil || req.TunnelDestination.To16() == nil {
+		return fmt.Errorf("%s tunnel destination address is required", req.Type)
+	}
+	if (req.TunnelSource.To4() == nil) != (req.TunnelDestination.To4() == nil) {
+		return fmt.Errorf("%s tunnel source and destination address families must match", req.Type)
+	}
+	return nil
+}
+
 // createAVFInterface creates an AVF interface
 func (c *govppClient) createAVFInterface(ctx context.Context, req *CreateInterfaceRequest) (*Interface, error) {
 	// Parse PCI address to u32 format
@@ -472,6 +623,67 @@ func (c *govppClient) createRDMAInterface(ctx context.Context, req *CreateInterf
 	return iface, nil
 }
 
+// maxTapHostNamespaceLen is the longest host namespace tap_create_v3 accepts
+// (string[64] including the terminating NUL).
+const maxTapHostNamespaceLen = 63
+
+// tapDefaultRingSize is the tap_create_v3 default ring size.
+const tapDefaultRingSize = 256
+
+// createTapInterface creates a host-facing tap interface with tap_create_v3
+func (c *govppClient) createTapInterface(ctx context.Context, req *CreateInterfaceRequest) (*Interface, error) {
+	createReq, err := tapCreateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &tapv2.TapCreateV3Reply{}
+	if err := c.ch.SendRequest(createReq).ReceiveReply(reply); err != nil {
+		return nil, fmt.Errorf("tap create failed: %w", err)
+	}
+	if reply.Retval != 0 {
+		return nil, fmt.Errorf("tap create returned error code: %d", reply.Retval)
+	}
+	return c.GetInterface(ctx, uint32(reply.SwIfIndex))
+}
+
+// tapCreateRequest builds the tap_create_v3 request for req, applying the
+// VPP defaults for queue and ring sizes that are not set.
+func tapCreateRequest(req *CreateInterfaceRequest) (*tapv2.TapCreateV3, error) {
+	if err := ValidateLinuxIfName(req.HostIfName); err != nil {
+		return nil, fmt.Errorf("invalid tap host interface name: %w", err)
+	}
+	if len(req.HostNamespace) > maxTapHostNamespaceLen {
+		return nil, fmt.Errorf("tap host namespace too long: %s (%d chars, max %d)", req.HostNamespace, len(req.HostNamespace), maxTapHostNamespaceLen)
+	}
+
+	createReq := &tapv2.TapCreateV3{
+		ID:            ^uint32(0),
+		UseRandomMac:  true,
+		NumRxQueues:   max(req.NumRxQueues, 1),
+		NumTxQueues:   max(req.NumTxQueues, 1),
+		RxRingSz:      tapDefaultRingSize,
+		TxRingSz:      tapDefaultRingSize,
+		HostIfNameSet: true,
+		HostIfName:    req.HostIfName,
+	}
+	if req.RxqSize != 0 {
+		createReq.RxRingSz = req.RxqSize
+	}
+	if req.TxqSize != 0 {
+		createReq.TxRingSz = req.TxqSize
+	}
+	if req.HostMTU != 0 {
+		createReq.HostMtuSet = true
+		createReq.HostMtuSize = req.HostMTU
+	}
+	if req.HostNamespace != "" {
+		createReq.HostNamespaceSet = true
+		createReq.HostNamespace = req.HostNamespace
+	}
+	return createReq, nil
+}
+
 // getPCIAddressFromSysfs retrieves PCI address from Linux sysfs for a network interface
 func getPCIAddressFromSysfs(ifName string) (string, error) {
 	// Read symlink /sys/class/net/<ifname>/device -> ../../../<pci_address>
@@ -609,8 +821,36 @@ func (c *govppClient) SetInterfaceAddress(ctx context.Context, ifIndex uint32, a
 		return fmt.Errorf("not connected to VPP")
 	}
 
-	if addr == nil {
-		return fmt.Errorf("address cannot be nil")
+	// Check for context cancellation
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	req, err := newAddDelAddressRequest(ifIndex, addr, true)
+	if err != nil {
+		return err
+	}
+
+	reply := &vppif.SwInterfaceAddDelAddressReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to add interface address: %w", err)
+	}
+
+	switch addressRetval(reply.Retval, true) {
+	case addressRetvalOK:
+		return nil
+	case addressRetvalDuplicate:
+		return c.checkAddressPresent(ctx, ifIndex, addr, reply.Retval)
+	}
+	return fmt.Errorf("add interface address returned error code: %d", reply.Retval)
+}
+
+// DeleteInterfaceAddress removes an IP address from an interface
+func (c *govppClient) DeleteInterfaceAddress(ctx context.Context, ifIndex uint32, addr *net.IPNet) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
 	}
 
 	// Check for context cancellation
@@ -620,6 +860,145 @@ func (c *govppClient) SetInterfaceAddress(ctx context.Context, ifIndex uint32, a
 	default:
 	}
 
+	req, err := newAddDelAddressRequest(ifIndex, addr, false)
+	if err != nil {
+		return err
+	}
+
+	reply := &vppif.SwInterfaceAddDelAddressReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to delete interface address: %w", err)
+	}
+
+	if addressRetval(reply.Retval, false) != addressRetvalOK {
+		return fmt.Errorf("delete interface address returned error code: %d", reply.Retval)
+	}
+
+	return nil
+}
+
+// addressRetvalKind classifies the retval of an address add/delete reply.
+type addressRetvalKind int
+
+const (
+	addressRetvalOK addressRetvalKind = iota
+	// addressRetvalDuplicate is an add whose prefix VPP already knows,
+	// which is idempotent only if it is on the requested interface.
+	addressRetvalDuplicate
+	addressRetvalError
+)
+
+// addressRetval makes address programming idempotent so reconciliation can
+// run repeatedly: re-adding an address that is already present, or deleting
+// one that is already gone, leaves the interface in the requested state.
+func addressRetval(retval int32, isAdd bool) addressRetvalKind {
+	switch {
+	case retval == 0:
+		return addressRetvalOK
+	case isAdd && retval == int32(api.VALUE_EXIST):
+		return addressRetvalOK
+	case isAdd && retval == int32(api.DUPLICATE_IF_ADDRESS):
+		return addressRetvalDuplicate
+	case !isAdd && retval == int32(api.ADDRESS_NOT_FOUND_FOR_INTERFACE):
+		return addressRetvalOK
+	}
+	return addressRetvalError
+}
+
+// checkAddressPresent resolves a DUPLICATE_IF_ADDRESS reply, which VPP
+// returns both for a re-add on the same interface and for a prefix owned by
+// another interface; only the former is success.
+func (c *govppClient) checkAddressPresent(ctx context.Context, ifIndex uint32, addr *net.IPNet, retval int32) error {
+	addresses, err := c.getInterfaceAddresses(ctx, ifIndex)
+	if err != nil {
+		return fmt.Errorf("add interface address returned error code: %d (verify existing address: %w)", retval, err)
+	}
+	wantOnes, _ := addr.Mask.Size()
+	for _, existing := range addresses {
+		ones, _ := existing.Mask.Size()
+		if existing.IP.Equal(addr.IP) && ones == wantOnes {
+			return nil
+		}
+	}
+	return fmt.Errorf("add interface address returned error code: %d", retval)
+}
+
+// addressBatchWindow bounds the requests in flight during
+// ApplyInterfaceAddresses so they fit the buffered API channel.
+const addressBatchWindow = 128
+
+// ApplyInterfaceAddresses pipelines address add/delete requests: each window
+// of requests is sent before any reply is read, so the batch costs roughly one
+// API round trip per window instead of one per address.
+func (c *govppClient) ApplyInterfaceAddresses(ctx context.Context, ops []AddressOp) []error {
+	errs := make([]error, len(ops))
+	if c.ch == nil {
+		for i := range errs {
+			errs[i] = fmt.Errorf("not connected to VPP")
+		}
+		return errs
+	}
+
+	type pending struct {
+		index int
+		isAdd bool
+		reply api.RequestCtx
+	}
+	inFlight := make([]pending, 0, addressBatchWindow)
+	// Duplicates are verified once every reply has been read, because the
+	// address dump must not interleave with the pipelined replies.
+	var duplicates []int
+	drain := func() {
+		for _, p := range inFlight {
+			reply := &vppif.SwInterfaceAddDelAddressReply{}
+			verb := "add"
+			if !p.isAdd {
+				verb = "delete"
+			}
+			if err := p.reply.ReceiveReply(reply); err != nil {
+				errs[p.index] = fmt.Errorf("failed to %s interface address: %w", verb, err)
+				continue
+			}
+			switch addressRetval(reply.Retval, p.isAdd) {
+			case addressRetvalOK:
+			case addressRetvalDuplicate:
+				duplicates = append(duplicates, p.index)
+			default:
+				errs[p.index] = fmt.Errorf("%s interface address returned error code: %d", verb, reply.Retval)
+			}
+		}
+		inFlight = inFlight[:0]
+	}
+
+	for i, op := range ops {
+		if err := ctx.Err(); err != nil {
+			errs[i] = fmt.Errorf("operation cancelled: %w", err)
+			continue
+		}
+		req, err := newAddDelAddressRequest(op.IfIndex, op.Address, !op.Delete)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		inFlight = append(inFlight, pending{index: i, isAdd: req.IsAdd, reply: c.ch.SendRequest(req)})
+		if len(inFlight) == addressBatchWindow {
+			drain()
+		}
+	}
+	drain()
+	for _, i := range duplicates {
+		errs[i] = c.checkAddressPresent(ctx, ops[i].IfIndex, ops[i].Address, int32(api.DUPLICATE_IF_ADDRESS))
+	}
+	return errs
+}
+
+// newAddDelAddressRequest builds an address add/delete request with the IP in
+// its canonical 4- or 16-byte form.
+func newAddDelAddressRequest(ifIndex uint32, addr *net.IPNet, isAdd bool) (*vppif.SwInterfaceAddDelAddress, error) {
+	if addr == nil {
+		return nil, fmt.Errorf("address cannot be nil")
+	}
+
 	// Normalize IP address: ensure IPv4 is in 4-byte form, IPv6 is in 16-byte form
 	normalizedAddr := *addr
 	if ip4 := addr.IP.To4(); ip4 != nil {
@@ -627,77 +1006,436 @@ func (c *govppClient) SetInterfaceAddress(ctx context.Context, ifIndex uint32, a
 	} else if ip6 := addr.IP.To16(); ip6 != nil {
 		normalizedAddr.IP = ip6
 	} else {
-		return fmt.Errorf("invalid IP address")
+		return nil, fmt.Errorf("invalid IP address")
 	}
 
-	// Convert net.IPNet to AddressWithPrefix
-	prefix := ip_types.NewAddressWithPrefix(normalizedAddr)
-
-	req := &vppif.SwInterfaceAddDelAddress{
+	return &vppif.SwInterfaceAddDelAddress{
 		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
-		IsAdd:     true,
+		IsAdd:     isAdd,
 		DelAll:    false,
-		Prefix:    prefix,
+		Prefix:    ip_types.NewAddressWithPrefix(normalizedAddr),
+	}, nil
+}
+
+// GetNeighbors lists IPv4 ARP and IPv6 neighbor entries.
+func (c *govppClient) GetNeighbors(ctx context.Context, swIfIndex uint32) ([]Neighbor, error) {
+	if c.ch == nil {
+		return nil, fmt.Errorf("not connected to VPP")
 	}
 
-	reply := &vppif.SwInterfaceAddDelAddressReply{}
+	var neighbors []Neighbor
+	for _, af := range []ip_types.AddressFamily{ip_types.ADDRESS_IP4, ip_types.ADDRESS_IP6} {
+		req := &ip_neighbor.IPNeighborDump{
+			SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+			Af:        af,
+		}
+		reqCtx := c.ch.SendMultiRequest(req)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("operation cancelled: %w", ctx.Err())
+			default:
+			}
+
+			msg := &ip_neighbor.IPNeighborDetails{}
+			stop, err := reqCtx.ReceiveReply(msg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to receive %s neighbor details: %w", af, err)
+			}
+			if stop {
+				break
+			}
+			neighbors = append(neighbors, convertNeighborDetails(msg))
+		}
+	}
+
+	return neighbors, nil
+}
+
+// AddNeighbor adds a static IPv4 ARP or IPv6 neighbor entry.
+func (c *govppClient) AddNeighbor(ctx context.Context, neighbor Neighbor) error {
+	return c.neighborAddDel(ctx, neighbor, true)
+}
+
+// DeleteNeighbor removes an IPv4 ARP or IPv6 neighbor entry.
+func (c *govppClient) DeleteNeighbor(ctx context.Context, neighbor Neighbor) error {
+	return c.neighborAddDel(ctx, neighbor, false)
+}
+
+func (c *govppClient) neighborAddDel(ctx context.Context, neighbor Neighbor, isAdd bool) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+	if neighbor.IP == nil {
+		return fmt.Errorf("neighbor address cannot be nil")
+	}
+	if isAdd && len(neighbor.MAC) != 6 {
+		return fmt.Errorf("neighbor %s: invalid MAC address %q", neighbor.IP, neighbor.MAC.String())
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	flags := ip_neighbor.IP_API_NEIGHBOR_FLAG_NONE
+	if neighbor.Static {
+		flags = ip_neighbor.IP_API_NEIGHBOR_FLAG_STATIC
+	}
+	req := &ip_neighbor.IPNeighborAddDel{
+		IsAdd: isAdd,
+		Neighbor: ip_neighbor.IPNeighbor{
+			SwIfIndex: interface_types.InterfaceIndex(neighbor.SwIfIndex),
+			Flags:     flags,
+			IPAddress: ip_types.NewAddress(neighbor.IP),
+		},
+	}
+	if len(neighbor.MAC) == 6 {
+		req.Neighbor.MacAddress = ethernet_types.NewMacAddress(neighbor.MAC)
+	}
+
+	action := "add"
+	if !isAdd {
+		action = "delete"
+	}
+	reply := &ip_neighbor.IPNeighborAddDelReply{}
 	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
-		return fmt.Errorf("failed to add interface address: %w", err)
+		return fmt.Errorf("failed to %s neighbor %s: %w", action, neighbor.IP, err)
 	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("%s neighbor %s returned error code: %d", action, neighbor.IP, reply.Retval)
+	}
+	return nil
+}
 
+// convertNeighborDetails converts VPP IPNeighborDetails to Neighbor
+func convertNeighborDetails(msg *ip_neighbor.IPNeighborDetails) Neighbor {
+	return Neighbor{
+		SwIfIndex: uint32(msg.Neighbor.SwIfIndex),
+		IP:        msg.Neighbor.IPAddress.ToIP(),
+		MAC:       msg.Neighbor.MacAddress.ToMAC(),
+		Static:    msg.Neighbor.Flags&ip_neighbor.IP_API_NEIGHBOR_FLAG_STATIC != 0,
+		Age:       time.Duration(msg.Age * float64(time.Second)),
+	}
+}
+
+// SetInterfacePromiscuous enables or disables promiscuous mode on an interface.
+func (c *govppClient) SetInterfacePromiscuous(ctx context.Context, ifIndex uint32, enabled bool) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	req := &vppif.SwInterfaceSetPromisc{
+		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+		PromiscOn: enabled,
+	}
+	reply := &vppif.SwInterfaceSetPromiscReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set interface promiscuous mode: %w", err)
+	}
 	if reply.Retval != 0 {
-		return fmt.Errorf("add interface address returned error code: %d", reply.Retval)
+		return fmt.Errorf("set interface promiscuous mode returned error code: %d", reply.Retval)
+	}
+	return nil
+}
+
+// SetInterfaceRxMode sets the RX mode for all RX queues of an interface.
+func (c *govppClient) SetInterfaceRxMode(ctx context.Context, ifIndex uint32, mode string) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
 	}
 
+	rxMode, err := rxModeFromName(mode)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	// QueueIDValid=false applies the mode to every RX queue on the interface.
+	req := &vppif.SwInterfaceSetRxMode{
+		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+		Mode:      rxMode,
+	}
+	reply := &vppif.SwInterfaceSetRxModeReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set interface rx-mode: %w", err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("set interface rx-mode returned error code: %d", reply.Retval)
+	}
 	return nil
 }
 
-// DeleteInterfaceAddress removes an IP address from an interface
-func (c *govppClient) DeleteInterfaceAddress(ctx context.Context, ifIndex uint32, addr *net.IPNet) error {
+// SetInterfaceRxPlacement places an RX queue of an interface on a worker
+// thread.
+func (c *govppClient) SetInterfaceRxPlacement(ctx context.Context, ifIndex uint32, queueID uint32, workerID uint32) error {
 	if c.ch == nil {
 		return fmt.Errorf("not connected to VPP")
 	}
 
-	if addr == nil {
-		return fmt.Errorf("address cannot be nil")
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	// WorkerID is an index into the worker threads; IsMain would place the
+	// queue on the main thread instead.
+	req := &vppif.SwInterfaceSetRxPlacement{
+		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+		QueueID:   queueID,
+		WorkerID:  workerID,
+	}
+	reply := &vppif.SwInterfaceSetRxPlacementReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set interface rx-placement: %w", err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("set interface rx-placement returned error code: %d", reply.Retval)
+	}
+	return nil
+}
+
+// GetWorkerCount returns the number of VPP worker threads.
+func (c *govppClient) GetWorkerCount(ctx context.Context) (uint32, error) {
+	if c.ch == nil {
+		return 0, fmt.Errorf("not connected to VPP")
+	}
+
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	reply := &govppvlib.ShowThreadsReply{}
+	if err := c.ch.SendRequest(&govppvlib.ShowThreads{}).ReceiveReply(reply); err != nil {
+		return 0, fmt.Errorf("failed to show threads: %w", err)
+	}
+	if reply.Retval != 0 {
+		return 0, fmt.Errorf("show threads returned error code: %d", reply.Retval)
+	}
+	// The first thread is the main thread.
+	if len(reply.ThreadData) <= 1 {
+		return 0, nil
+	}
+	return uint32(len(reply.ThreadData) - 1), nil
+}
+
+// SetInterfaceMTU sets the hardware MTU of an interface.
+func (c *govppClient) SetInterfaceMTU(ctx context.Context, ifIndex uint32, mtu uint32) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+	if mtu > math.MaxUint16 {
+		return fmt.Errorf("interface MTU %d out of range", mtu)
 	}
 
-	// Check for context cancellation
 	select {
 	case <-ctx.Done():
 		return fmt.Errorf("operation cancelled: %w", ctx.Err())
 	default:
 	}
 
-	// Normalize IP address: ensure IPv4 is in 4-byte form, IPv6 is in 16-byte form
-	normalizedAddr := *addr
-	if ip4 := addr.IP.To4(); ip4 != nil {
-		normalizedAddr.IP = ip4
-	} else if ip6 := addr.IP.To16(); ip6 != nil {
-		normalizedAddr.IP = ip6
-	} else {
-		return fmt.Errorf("invalid IP address")
+	req := &vppif.HwInterfaceSetMtu{
+		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+		Mtu:       uint16(mtu),
+	}
+	reply := &vppif.HwInterfaceSetMtuReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set interface MTU: %w", err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("set interface MTU returned error code: %d", reply.Retval)
 	}
+	return nil
+}
 
-	// Convert net.IPNet to AddressWithPrefix
-	prefix := ip_types.NewAddressWithPrefix(normalizedAddr)
+// SetInterfaceIPMTU sets the IPv4 and IPv6 L3 MTUs of an interface.
+func (c *govppClient) SetInterfaceIPMTU(ctx context.Context, ifIndex uint32, ip4, ip6 uint32) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
 
-	req := &vppif.SwInterfaceAddDelAddress{
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	// The MTU array is indexed by VNET_MTU_L3, IP4, IP6, MPLS; zero leaves
+	// that protocol unchanged.
+	req := &vppif.SwInterfaceSetMtu{
 		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
-		IsAdd:     false,
-		DelAll:    false,
-		Prefix:    prefix,
+		Mtu:       []uint32{0, ip4, ip6, 0},
+	}
+	reply := &vppif.SwInterfaceSetMtuReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set interface IP MTU: %w", err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("set interface IP MTU returned error code: %d", reply.Retval)
 	}
+	return nil
+}
 
-	reply := &vppif.SwInterfaceAddDelAddressReply{}
+// SetInterfaceIP6Enabled enables or disables IPv6 processing on an interface.
+// VPP counts enables and answers VALUE_EXIST when an address already enabled
+// IPv6, which still takes a reference and is not an error.
+func (c *govppClient) SetInterfaceIP6Enabled(ctx context.Context, ifIndex uint32, enabled bool) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	req := &vppip.SwInterfaceIP6EnableDisable{
+		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+		Enable:    enabled,
+	}
+	reply := &vppip.SwInterfaceIP6EnableDisableReply{}
 	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
-		return fmt.Errorf("failed to delete interface address: %w", err)
+		return fmt.Errorf("failed to set interface ip6 enable: %w", err)
 	}
+	if reply.Retval != 0 && !(enabled && reply.Retval == int32(api.VALUE_EXIST)) {
+		return fmt.Errorf("set interface ip6 enable returned error code: %d", reply.Retval)
+	}
+	return nil
+}
 
+// SetInterfaceRouterAdvertisement programs the IPv6 router advertisement
+// state of an interface. VPP only changes the flags whose request field is
+// non-zero, and is_no clears them instead of setting them, so enabling
+// advertisements takes two requests: one clearing suppression and the
+// unwanted flags, and one setting the wanted flags and intervals. Unset
+// intervals are sent as the VPP defaults so a previous value does not linger.
+func (c *govppClient) SetInterfaceRouterAdvertisement(ctx context.Context, ifIndex uint32, ra RouterAdvertisement) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	swIfIndex := govppiftypes.InterfaceIndex(ifIndex)
+	if ra.Suppress {
+		return c.sendRAConfig(&govppip6nd.SwInterfaceIP6ndRaConfig{SwIfIndex: swIfIndex, Suppress: 1})
+	}
+	if err := c.sendRAConfig(&govppip6nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex: swIfIndex,
+		IsNo:      true,
+		Suppress:  1,
+		Managed:   raFlag(!ra.Managed),
+		Other:     raFlag(!ra.Other),
+	}); err != nil {
+		return err
+	}
+	maxInterval, minInterval := ra.MaxInterval, ra.MinInterval
+	if maxInterval == 0 {
+		maxInterval = DefaultRAMaxInterval
+	}
+	if minInterval == 0 {
+		minInterval = maxInterval * 3 / 4
+	}
+	return c.sendRAConfig(&govppip6nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:   swIfIndex,
+		Managed:     raFlag(ra.Managed),
+		Other:       raFlag(ra.Other),
+		MaxInterval: maxInterval,
+		MinInterval: minInterval,
+	})
+}
+
+func (c *govppClient) sendRAConfig(req *govppip6nd.SwInterfaceIP6ndRaConfig) error {
+	reply := &govppip6nd.SwInterfaceIP6ndRaConfigReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to configure router advertisements: %w", err)
+	}
 	if reply.Retval != 0 {
-		return fmt.Errorf("delete interface address returned error code: %d", reply.Retval)
+		return fmt.Errorf("configure router advertisements returned error code: %d", reply.Retval)
+	}
+	return nil
+}
+
+func raFlag(set bool) uint8 {
+	if set {
+		return 1
+	}
+	return 0
+}
+
+// AddRouterAdvertisementPrefix advertises an on-link, autonomous prefix.
+func (c *govppClient) AddRouterAdvertisementPrefix(ctx context.Context, ifIndex uint32, prefix RouterAdvertisementPrefix) error {
+	if prefix.Prefix == nil {
+		return fmt.Errorf("router advertisement prefix is nil")
+	}
+	// A zero lifetime would advertise the prefix as expired, so unset
+	// lifetimes are sent as the VPP defaults.
+	valid, preferred := prefix.ValidLifetime, prefix.PreferredLifetime
+	if valid == 0 {
+		valid = DefaultRAValidLifetime
+	}
+	if preferred == 0 {
+		preferred = DefaultRAPreferredLifetime
+	}
+	return c.sendRAPrefix(ctx, &govppip6nd.SwInterfaceIP6ndRaPrefix{
+		SwIfIndex:    govppiftypes.InterfaceIndex(ifIndex),
+		Prefix:       govppiptypes.NewPrefix(*prefix.Prefix),
+		ValLifetime:  valid,
+		PrefLifetime: preferred,
+	})
+}
+
+// DeleteRouterAdvertisementPrefix stops advertising a prefix.
+func (c *govppClient) DeleteRouterAdvertisementPrefix(ctx context.Context, ifIndex uint32, prefix *net.IPNet) error {
+	if prefix == nil {
+		return fmt.Errorf("router advertisement prefix is nil")
+	}
+	return c.sendRAPrefix(ctx, &govppip6nd.SwInterfaceIP6ndRaPrefix{
+		SwIfIndex: govppiftypes.InterfaceIndex(ifIndex),
+		Prefix:    govppiptypes.NewPrefix(*prefix),
+		IsNo:      true,
+	})
+}
+
+func (c *govppClient) sendRAPrefix(ctx context.Context, req *govppip6nd.SwInterfaceIP6ndRaPrefix) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
 	}
 
+	reply := &govppip6nd.SwInterfaceIP6ndRaPrefixReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set router advertisement prefix: %w", err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("set router advertisement prefix returned error code: %d", reply.Retval)
+	}
 	return nil
 }
 
@@ -835,7 +1573,7 @@ func (c *govppClient) GetQoSCapabilities(ctx context.Context) (QoSCapabilities,
 		Policer:             false,
 		OperationalCounters: false,
 		Diagnostics: []string{
-			"VPP 24.10 binapi set does not expose scheduler or policer services; arca stores output QoS intent in interface metadata",
+			"VPP 24.10 binapi set does not expose scheduler services and class-of-service profiles are not mapped to policers; arca stores output QoS intent in interface metadata",
 		},
 	}, nil
 }
@@ -885,6 +1623,112 @@ func (c *govppClient) ClearQoSProfile(ctx context.Context, ifIndex uint32) error
 	return nil
 }
 
+// AddPolicer creates a named 1R2C policer. The committed rate is programmed
+// in kbps, rounded up so the configured rate is never undercut.
+func (c *govppClient) AddPolicer(ctx context.Context, p Policer) error {
+	if c.conn == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+	if p.Name == "" {
+		return fmt.Errorf("policer name is required")
+	}
+	cir := (p.BandwidthLimit + 999) / 1000
+	if cir == 0 || cir > uint64(^uint32(0)) {
+		return fmt.Errorf("policer %s: bandwidth-limit %d bps is out of range", p.Name, p.BandwidthLimit)
+	}
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	reply, err := policer.NewServiceClient(c.conn).PolicerAddDel(ctx, &policer.PolicerAddDel{
+		IsAdd:         true,
+		Name:          p.Name,
+		Cir:           uint32(cir),
+		Cb:            p.BurstSizeLimit,
+		RateType:      policer_types.SSE2_QOS_RATE_API_KBPS,
+		RoundType:     policer_types.SSE2_QOS_ROUND_API_TO_CLOSEST,
+		Type:          policer_types.SSE2_QOS_POLICER_TYPE_API_1R2C,
+		ConformAction: policer_types.Sse2QosAction{Type: policer_types.SSE2_QOS_ACTION_API_TRANSMIT},
+		ExceedAction:  policer_types.Sse2QosAction{Type: policer_types.SSE2_QOS_ACTION_API_DROP},
+		ViolateAction: policer_types.Sse2QosAction{Type: policer_types.SSE2_QOS_ACTION_API_DROP},
+	})
+	if err != nil {
+		return fmt.Errorf("add policer %s: %w", p.Name, err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("add policer %s returned error code: %d", p.Name, reply.Retval)
+	}
+	return nil
+}
+
+// DeletePolicer deletes a named policer.
+func (c *govppClient) DeletePolicer(ctx context.Context, name string) error {
+	if c.conn == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	reply, err := policer.NewServiceClient(c.conn).PolicerAddDel(ctx, &policer.PolicerAddDel{
+		IsAdd: false,
+		Name:  name,
+	})
+	if err != nil {
+		return fmt.Errorf("delete policer %s: %w", name, err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("delete policer %s returned error code: %d", name, reply.Retval)
+	}
+	return nil
+}
+
+// SetInterfacePolicer applies or removes a named policer on an interface.
+func (c *govppClient) SetInterfacePolicer(ctx context.Context, ifIndex uint32, name string, output, apply bool) error {
+	if c.conn == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	direction := "input"
+	var retval int32
+	client := policer.NewServiceClient(c.conn)
+	if output {
+		direction = "output"
+		reply, err := client.PolicerOutput(ctx, &policer.PolicerOutput{
+			Name:      name,
+			SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+			Apply:     apply,
+		})
+		if err != nil {
+			return fmt.Errorf("set %s policer %s: %w", direction, name, err)
+		}
+		retval = reply.Retval
+	} else {
+		reply, err := client.PolicerInput(ctx, &policer.PolicerInput{
+			Name:      name,
+			SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+			Apply:     apply,
+		})
+		if err != nil {
+			return fmt.Errorf("set %s policer %s: %w", direction, name, err)
+		}
+		retval = reply.Retval
+	}
+	if retval != 0 {
+		return fmt.Errorf("set %s policer %s returned error code: %d", direction, name, retval)
+	}
+	return nil
+}
+
 // AddBridgeDomain creates a VPP bridge domain.
 func (c *govppClient) AddBridgeDomain(ctx context.Context, bridge BridgeDomain) error {
 	if c.conn == nil {
@@ -1090,6 +1934,32 @@ func (c *govppClient) ListInterfaceCounters(ctx context.Context) (map[uint32]Int
 	return counters, nil
 }
 
+// ClearInterfaceStats resets the counters of an interface, or of every
+// interface when ifIndex is AllInterfaces.
+func (c *govppClient) ClearInterfaceStats(ctx context.Context, ifIndex uint32) error {
+	if c.ch == nil {
+		return fmt.Errorf("not connected to VPP")
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("operation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	req := &vppif.SwInterfaceClearStats{
+		SwIfIndex: interface_types.InterfaceIndex(ifIndex),
+	}
+	reply := &vppif.SwInterfaceClearStatsReply{}
+	if err := c.ch.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to clear interface counters: %w", err)
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("clear interface counters returned error code: %d", reply.Retval)
+	}
+	return nil
+}
+
 // ListInterfaceQueuePlacements returns RX/TX queue placement by VPP interface index.
 func (c *govppClient) ListInterfaceQueuePlacements(ctx context.Context) (map[uint32]InterfaceQueuePlacements, error) {
 	if c.conn == nil {
@@ -1204,6 +2074,21 @@ func rxModeName(mode interface_types.RxMode) string {
 	}
 }
 
+func rxModeFromName(mode string) (interface_types.RxMode, error) {
+	switch mode {
+	case "polling":
+		return interface_types.RX_MODE_API_POLLING, nil
+	case "interrupt":
+		return interface_types.RX_MODE_API_INTERRUPT, nil
+	case "adaptive":
+		return interface_types.RX_MODE_API_ADAPTIVE, nil
+	case "default":
+		return interface_types.RX_MODE_API_DEFAULT, nil
+	default:
+		return interface_types.RX_MODE_API_UNKNOWN, fmt.Errorf("unsupported rx-mode %q", mode)
+	}
+}
+
 func (c *govppClient) ensureStatsConnection(ctx context.Context) (*core.StatsConnection, error) {
 	if c.statsConn != nil {
 		return c.statsConn, nil
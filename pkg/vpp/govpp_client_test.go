@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"syscall"
 	"testing"
@@ -16,10 +17,19 @@ import (
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/ethernet_types"
 	vppif "github.com/akam1o/arca-router/pkg/vpp/binapi/interface"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/interface_types"
+	vppip "github.com/akam1o/arca-router/pkg/vpp/binapi/ip"
+	"github.com/akam1o/arca-router/pkg/vpp/binapi/ip_neighbor"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/ip_types"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/rdma"
+	"github.com/akam1o/arca-router/pkg/vpp/binapi/tapv2"
 	"github.com/akam1o/arca-router/pkg/vpp/binapi/vpe"
 	"go.fd.io/govpp/api"
+	govppgre "go.fd.io/govpp/binapi/gre"
+	govppip6nd "go.fd.io/govpp/binapi/ip6_nd"
+	govppiptypes "go.fd.io/govpp/binapi/ip_types"
+	govppipip "go.fd.io/govpp/binapi/ipip"
+	govpptunneltypes "go.fd.io/govpp/binapi/tunnel_types"
+	govppvlib "go.fd.io/govpp/binapi/vlib"
 )
 
 // TestParsePCIAddress tests PCI address parsing
@@ -379,16 +389,91 @@ func (f *fakeRequestCtx) ReceiveReply(msg api.Message) error {
 			return fmt.Errorf("unexpected message type: expected *rdma.RdmaCreateV4Reply, got %T", msg)
 		}
 		*msg.(*rdma.RdmaCreateV4Reply) = *r
+	case *tapv2.TapCreateV3Reply:
+		if _, ok := msg.(*tapv2.TapCreateV3Reply); !ok {
+			return fmt.Errorf("unexpected message type: expected *tapv2.TapCreateV3Reply, got %T", msg)
+		}
+		*msg.(*tapv2.TapCreateV3Reply) = *r
 	case *vppif.SwInterfaceSetFlagsReply:
 		if _, ok := msg.(*vppif.SwInterfaceSetFlagsReply); !ok {
 			return fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceSetFlagsReply, got %T", msg)
 		}
 		*msg.(*vppif.SwInterfaceSetFlagsReply) = *r
+	case *vppif.SwInterfaceSetPromiscReply:
+		if _, ok := msg.(*vppif.SwInterfaceSetPromiscReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceSetPromiscReply, got %T", msg)
+		}
+		*msg.(*vppif.SwInterfaceSetPromiscReply) = *r
+	case *vppif.SwInterfaceClearStatsReply:
+		if _, ok := msg.(*vppif.SwInterfaceClearStatsReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceClearStatsReply, got %T", msg)
+		}
+		*msg.(*vppif.SwInterfaceClearStatsReply) = *r
+	case *vppif.SwInterfaceSetRxModeReply:
+		if _, ok := msg.(*vppif.SwInterfaceSetRxModeReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceSetRxModeReply, got %T", msg)
+		}
+		*msg.(*vppif.SwInterfaceSetRxModeReply) = *r
+	case *vppif.SwInterfaceSetRxPlacementReply:
+		if _, ok := msg.(*vppif.SwInterfaceSetRxPlacementReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceSetRxPlacementReply, got %T", msg)
+		}
+		*msg.(*vppif.SwInterfaceSetRxPlacementReply) = *r
+	case *vppip.SwInterfaceIP6EnableDisableReply:
+		if _, ok := msg.(*vppip.SwInterfaceIP6EnableDisableReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *vppip.SwInterfaceIP6EnableDisableReply, got %T", msg)
+		}
+		*msg.(*vppip.SwInterfaceIP6EnableDisableReply) = *r
+	case *govppvlib.ShowThreadsReply:
+		if _, ok := msg.(*govppvlib.ShowThreadsReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *govppvlib.ShowThreadsReply, got %T", msg)
+		}
+		*msg.(*govppvlib.ShowThreadsReply) = *r
+	case *vppif.HwInterfaceSetMtuReply:
+		if _, ok := msg.(*vppif.HwInterfaceSetMtuReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *vppif.HwInterfaceSetMtuReply, got %T", msg)
+		}
+		*msg.(*vppif.HwInterfaceSetMtuReply) = *r
+	case *vppif.SwInterfaceSetMtuReply:
+		if _, ok := msg.(*vppif.SwInterfaceSetMtuReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceSetMtuReply, got %T", msg)
+		}
+		*msg.(*vppif.SwInterfaceSetMtuReply) = *r
 	case *vppif.SwInterfaceAddDelAddressReply:
 		if _, ok := msg.(*vppif.SwInterfaceAddDelAddressReply); !ok {
 			return fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceAddDelAddressReply, got %T", msg)
 		}
 		*msg.(*vppif.SwInterfaceAddDelAddressReply) = *r
+	case *ip_neighbor.IPNeighborAddDelReply:
+		if _, ok := msg.(*ip_neighbor.IPNeighborAddDelReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *ip_neighbor.IPNeighborAddDelReply, got %T", msg)
+		}
+		*msg.(*ip_neighbor.IPNeighborAddDelReply) = *r
+	case *govppip6nd.SwInterfaceIP6ndRaConfigReply:
+		if _, ok := msg.(*govppip6nd.SwInterfaceIP6ndRaConfigReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *ip6_nd.SwInterfaceIP6ndRaConfigReply, got %T", msg)
+		}
+		*msg.(*govppip6nd.SwInterfaceIP6ndRaConfigReply) = *r
+	case *govppip6nd.SwInterfaceIP6ndRaPrefixReply:
+		if _, ok := msg.(*govppip6nd.SwInterfaceIP6ndRaPrefixReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *ip6_nd.SwInterfaceIP6ndRaPrefixReply, got %T", msg)
+		}
+		*msg.(*govppip6nd.SwInterfaceIP6ndRaPrefixReply) = *r
+	case *govppgre.GreTunnelAddDelReply:
+		if _, ok := msg.(*govppgre.GreTunnelAddDelReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *gre.GreTunnelAddDelReply, got %T", msg)
+		}
+		*msg.(*govppgre.GreTunnelAddDelReply) = *r
+	case *govppipip.IpipAddTunnelReply:
+		if _, ok := msg.(*govppipip.IpipAddTunnelReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *ipip.IpipAddTunnelReply, got %T", msg)
+		}
+		*msg.(*govppipip.IpipAddTunnelReply) = *r
+	case *govppipip.IpipDelTunnelReply:
+		if _, ok := msg.(*govppipip.IpipDelTunnelReply); !ok {
+			return fmt.Errorf("unexpected message type: expected *ipip.IpipDelTunnelReply, got %T", msg)
+		}
+		*msg.(*govppipip.IpipDelTunnelReply) = *r
 	case *vpe.ShowVersionReply:
 		if _, ok := msg.(*vpe.ShowVersionReply); !ok {
 			return fmt.Errorf("unexpected message type: expected *vpe.ShowVersionReply, got %T", msg)
@@ -423,6 +508,18 @@ func (f *fakeMultiRequestCtx) ReceiveReply(msg api.Message) (bool, error) {
 			return true, fmt.Errorf("unexpected message type: expected *vppif.SwInterfaceDetails, got %T", msg)
 		}
 		*details = *r
+	case *ip_neighbor.IPNeighborDetails:
+		details, ok := msg.(*ip_neighbor.IPNeighborDetails)
+		if !ok {
+			return true, fmt.Errorf("unexpected message type: expected *ip_neighbor.IPNeighborDetails, got %T", msg)
+		}
+		*details = *r
+	case *vppip.IPAddressDetails:
+		details, ok := msg.(*vppip.IPAddressDetails)
+		if !ok {
+			return true, fmt.Errorf("unexpected message type: expected *vppip.IPAddressDetails, got %T", msg)
+		}
+		*details = *r
 	default:
 		return true, fmt.Errorf("unsupported reply type in fake: %T", r)
 	}
@@ -646,6 +743,224 @@ func TestGovppClient_CreateInterface_RDMA(t *testing.T) {
 	}
 }
 
+// TestGovppClient_CreateInterface_Tap tests host-facing tap interface creation
+func TestGovppClient_CreateInterface_Tap(t *testing.T) {
+	expectedSwIfIndex := interface_types.InterfaceIndex(3)
+	var createReq *tapv2.TapCreateV3
+
+	fakeChannel := &fakeChannel{
+		sendRequestFunc: func(msg api.Message) api.RequestCtx {
+			switch req := msg.(type) {
+			case *tapv2.TapCreateV3:
+				createReq = req
+				return &fakeRequestCtx{
+					reply: &tapv2.TapCreateV3Reply{
+						SwIfIndex: expectedSwIfIndex,
+						Retval:    0,
+					},
+				}
+			}
+			return &fakeRequestCtx{err: fmt.Errorf("unexpected message type")}
+		},
+		sendMultiRequestFunc: func(msg api.Message) api.MultiRequestCtx {
+			return &fakeMultiRequestCtx{
+				replies: []api.Message{
+					&vppif.SwInterfaceDetails{
+						SwIfIndex:     expectedSwIfIndex,
+						InterfaceName: "tap0",
+						L2Address:     ethernet_types.MacAddress{0x02, 0xFE, 0x00, 0x00, 0x00, 0x01},
+					},
+				},
+			}
+		},
+	}
+
+	client := &govppClient{
+		ch: fakeChannel,
+	}
+
+	ctx := context.Background()
+	iface, err := client.CreateInterface(ctx, &CreateInterfaceRequest{
+		Type:          InterfaceTypeTap,
+		HostIfName:    "vpp-host0",
+		HostMTU:       9000,
+		HostNamespace: "mgmt",
+		TxqSize:       1024,
+	})
+	if err != nil {
+		t.Fatalf("CreateInterface() error = %v, want nil", err)
+	}
+	if iface == nil || iface.SwIfIndex != uint32(expectedSwIfIndex) {
+		t.Fatalf("CreateInterface() = %+v, want SwIfIndex %d", iface, expectedSwIfIndex)
+	}
+
+	if createReq == nil {
+		t.Fatal("tap_create_v3 was not sent")
+	}
+	want := tapv2.TapCreateV3{
+		ID:               ^uint32(0),
+		UseRandomMac:     true,
+		NumRxQueues:      1,
+		NumTxQueues:      1,
+		RxRingSz:         256,
+		TxRingSz:         1024,
+		HostMtuSet:       true,
+		HostMtuSize:      9000,
+		HostNamespaceSet: true,
+		HostNamespace:    "mgmt",
+		HostIfNameSet:    true,
+		HostIfName:       "vpp-host0",
+	}
+	if *createReq != want {
+		t.Errorf("tap_create_v3 = %+v, want %+v", *createReq, want)
+	}
+}
+
+func TestGovppClient_CreateInterface_TapRejectsInvalidHostIfName(t *testing.T) {
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				t.Fatalf("unexpected request %T", msg)
+				return nil
+			},
+		},
+	}
+
+	for _, name := range []string{"", "host if", "a-very-long-host-if-name"} {
+		_, err := client.CreateInterface(context.Background(), &CreateInterfaceRequest{
+			Type:       InterfaceTypeTap,
+			HostIfName: name,
+		})
+		if err == nil || !strings.Contains(err.Error(), "invalid tap host interface name") {
+			t.Errorf("CreateInterface(host-if-name %q) error = %v, want invalid host interface name", name, err)
+		}
+	}
+}
+
+func TestGovppClient_CreateInterface_GRE(t *testing.T) {
+	var sent []api.Message
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				sent = append(sent, msg)
+				switch msg.(type) {
+				case *govppgre.GreTunnelAddDel:
+					return &fakeRequestCtx{reply: &govppgre.GreTunnelAddDelReply{SwIfIndex: 4}}
+				}
+				return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+			},
+			sendMultiRequestFunc: func(msg api.Message) api.MultiRequestCtx {
+				return &fakeMultiRequestCtx{
+					replies: []api.Message{&vppif.SwInterfaceDetails{SwIfIndex: 4, InterfaceName: "gre0"}},
+				}
+			},
+		},
+	}
+	ctx := context.Background()
+	req := &CreateInterfaceRequest{
+		Type:              InterfaceTypeGRE,
+		Name:              "gr-0/0/0",
+		TunnelSource:      net.ParseIP("192.0.2.1"),
+		TunnelDestination: net.ParseIP("198.51.100.1"),
+	}
+
+	iface, err := client.CreateInterface(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateInterface(gre) error = %v", err)
+	}
+	if iface.SwIfIndex != 4 {
+		t.Fatalf("SwIfIndex = %d, want 4", iface.SwIfIndex)
+	}
+	if err := client.DeleteTunnelInterface(ctx, req, iface.SwIfIndex); err != nil {
+		t.Fatalf("DeleteTunnelInterface(gre) error = %v", err)
+	}
+
+	tunnel := govppgre.GreTunnel{
+		Type:     govppgre.GRE_API_TUNNEL_TYPE_L3,
+		Mode:     govpptunneltypes.TUNNEL_API_MODE_P2P,
+		Instance: ^uint32(0),
+		Src:      govppiptypes.NewAddress(net.ParseIP("192.0.2.1")),
+		Dst:      govppiptypes.NewAddress(net.ParseIP("198.51.100.1")),
+	}
+	want := []api.Message{
+		&govppgre.GreTunnelAddDel{IsAdd: true, Tunnel: tunnel},
+		&govppgre.GreTunnelAddDel{IsAdd: false, Tunnel: tunnel},
+	}
+	if !reflect.DeepEqual(sent, want) {
+		t.Fatalf("sent requests:\n%#v\nwant:\n%#v", sent, want)
+	}
+}
+
+func TestGovppClient_CreateInterface_IPIP(t *testing.T) {
+	var sent []api.Message
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				sent = append(sent, msg)
+				switch msg.(type) {
+				case *govppipip.IpipAddTunnel:
+					return &fakeRequestCtx{reply: &govppipip.IpipAddTunnelReply{SwIfIndex: 5}}
+				case *govppipip.IpipDelTunnel:
+					return &fakeRequestCtx{reply: &govppipip.IpipDelTunnelReply{}}
+				}
+				return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+			},
+			sendMultiRequestFunc: func(msg api.Message) api.MultiRequestCtx {
+				return &fakeMultiRequestCtx{
+					replies: []api.Message{&vppif.SwInterfaceDetails{SwIfIndex: 5, InterfaceName: "ipip0"}},
+				}
+			},
+		},
+	}
+	ctx := context.Background()
+	req := &CreateInterfaceRequest{
+		Type:              InterfaceTypeIPIP,
+		Name:              "ip-0/0/0",
+		TunnelSource:      net.ParseIP("2001:db8::1"),
+		TunnelDestination: net.ParseIP("2001:db8::2"),
+	}
+
+	iface, err := client.CreateInterface(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateInterface(ipip) error = %v", err)
+	}
+	if err := client.DeleteTunnelInterface(ctx, req, iface.SwIfIndex); err != nil {
+		t.Fatalf("DeleteTunnelInterface(ipip) error = %v", err)
+	}
+
+	want := []api.Message{
+		&govppipip.IpipAddTunnel{Tunnel: govppipip.IpipTunnel{
+			Instance: ^uint32(0),
+			Src:      govppiptypes.NewAddress(net.ParseIP("2001:db8::1")),
+			Dst:      govppiptypes.NewAddress(net.ParseIP("2001:db8::2")),
+			Mode:     govpptunneltypes.TUNNEL_API_MODE_P2P,
+		}},
+		&govppipip.IpipDelTunnel{SwIfIndex: 5},
+	}
+	if !reflect.DeepEqual(sent, want) {
+		t.Fatalf("sent requests:\n%#v\nwant:\n%#v", sent, want)
+	}
+}
+
+func TestGovppClient_CreateInterface_TunnelRejectsMixedFamilies(t *testing.T) {
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				t.Fatalf("unexpected request %T for invalid tunnel", msg)
+				return nil
+			},
+		},
+	}
+	_, err := client.CreateInterface(context.Background(), &CreateInterfaceRequest{
+		Type:              InterfaceTypeGRE,
+		TunnelSource:      net.ParseIP("192.0.2.1"),
+		TunnelDestination: net.ParseIP("2001:db8::2"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "address families must match") {
+		t.Fatalf("CreateInterface(mixed families) error = %v, want family mismatch", err)
+	}
+}
+
 // TestGovppClient_SetInterfaceUp tests setting interface up
 func TestGovppClient_SetInterfaceUp(t *testing.T) {
 	fakeChannel := &fakeChannel{
@@ -692,6 +1007,360 @@ func TestGovppClient_SetInterfaceDown(t *testing.T) {
 	}
 }
 
+// TestGovppClient_SetInterfacePromiscuous tests the promiscuous mode request
+func TestGovppClient_SetInterfacePromiscuous(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		var got *vppif.SwInterfaceSetPromisc
+		client := &govppClient{
+			ch: &fakeChannel{
+				sendRequestFunc: func(msg api.Message) api.RequestCtx {
+					req, ok := msg.(*vppif.SwInterfaceSetPromisc)
+					if !ok {
+						return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+					}
+					got = req
+					return &fakeRequestCtx{reply: &vppif.SwInterfaceSetPromiscReply{}}
+				},
+			},
+		}
+
+		if err := client.SetInterfacePromiscuous(context.Background(), 7, enabled); err != nil {
+			t.Fatalf("SetInterfacePromiscuous(%t) error = %v", enabled, err)
+		}
+		if got == nil || got.SwIfIndex != 7 || got.PromiscOn != enabled {
+			t.Fatalf("SetInterfacePromiscuous(%t) sent %#v", enabled, got)
+		}
+	}
+}
+
+// TestGovppClient_ClearInterfaceStats tests clearing one or all interfaces
+func TestGovppClient_ClearInterfaceStats(t *testing.T) {
+	for _, ifIndex := range []uint32{7, AllInterfaces} {
+		var got *vppif.SwInterfaceClearStats
+		client := &govppClient{
+			ch: &fakeChannel{
+				sendRequestFunc: func(msg api.Message) api.RequestCtx {
+					req, ok := msg.(*vppif.SwInterfaceClearStats)
+					if !ok {
+						return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+					}
+					got = req
+					return &fakeRequestCtx{reply: &vppif.SwInterfaceClearStatsReply{}}
+				},
+			},
+		}
+
+		if err := client.ClearInterfaceStats(context.Background(), ifIndex); err != nil {
+			t.Fatalf("ClearInterfaceStats(%d) error = %v", ifIndex, err)
+		}
+		if got == nil || got.SwIfIndex != interface_types.InterfaceIndex(ifIndex) {
+			t.Fatalf("ClearInterfaceStats(%d) sent %#v", ifIndex, got)
+		}
+	}
+}
+
+// TestGovppClient_ClearInterfaceStatsReportsFailure tests that an
+// unreachable VPP or a failed reply is reported instead of ignored
+func TestGovppClient_ClearInterfaceStatsReportsFailure(t *testing.T) {
+	if err := (&govppClient{}).ClearInterfaceStats(context.Background(), AllInterfaces); err == nil || !strings.Contains(err.Error(), "not connected to VPP") {
+		t.Fatalf("ClearInterfaceStats() without connection error = %v, want not connected", err)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     *fakeRequestCtx
+		wantErr string
+	}{
+		{name: "unreachable", ctx: &fakeRequestCtx{err: fmt.Errorf("VPP API socket closed")}, wantErr: "VPP API socket closed"},
+		{name: "retval", ctx: &fakeRequestCtx{reply: &vppif.SwInterfaceClearStatsReply{Retval: -2}}, wantErr: "error code: -2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &govppClient{
+				ch: &fakeChannel{
+					sendRequestFunc: func(msg api.Message) api.RequestCtx { return tt.ctx },
+				},
+			}
+			err := client.ClearInterfaceStats(context.Background(), 7)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ClearInterfaceStats() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestGovppClient_SetInterfaceRxMode tests the RX mode request for each mode
+func TestGovppClient_SetInterfaceRxMode(t *testing.T) {
+	tests := []struct {
+		mode string
+		want interface_types.RxMode
+	}{
+		{mode: "polling", want: interface_types.RX_MODE_API_POLLING},
+		{mode: "interrupt", want: interface_types.RX_MODE_API_INTERRUPT},
+		{mode: "adaptive", want: interface_types.RX_MODE_API_ADAPTIVE},
+		{mode: "default", want: interface_types.RX_MODE_API_DEFAULT},
+	}
+	for _, tt := range tests {
+		var got *vppif.SwInterfaceSetRxMode
+		client := &govppClient{
+			ch: &fakeChannel{
+				sendRequestFunc: func(msg api.Message) api.RequestCtx {
+					req, ok := msg.(*vppif.SwInterfaceSetRxMode)
+					if !ok {
+						return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+					}
+					got = req
+					return &fakeRequestCtx{reply: &vppif.SwInterfaceSetRxModeReply{}}
+				},
+			},
+		}
+
+		if err := client.SetInterfaceRxMode(context.Background(), 3, tt.mode); err != nil {
+			t.Fatalf("SetInterfaceRxMode(%s) error = %v", tt.mode, err)
+		}
+		if got == nil || got.SwIfIndex != 3 || got.Mode != tt.want || got.QueueIDValid {
+			t.Fatalf("SetInterfaceRxMode(%s) sent %#v, want mode %s on all queues", tt.mode, got, tt.want)
+		}
+	}
+}
+
+// TestGovppClient_SetInterfaceRxMode_Errors tests invalid modes and VPP errors
+func TestGovppClient_SetInterfaceRxMode_Errors(t *testing.T) {
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				return &fakeRequestCtx{reply: &vppif.SwInterfaceSetRxModeReply{Retval: -1}}
+			},
+		},
+	}
+
+	if err := client.SetInterfaceRxMode(context.Background(), 1, "turbo"); err == nil {
+		t.Fatal("SetInterfaceRxMode(turbo) error = nil, want unsupported mode error")
+	}
+	if err := client.SetInterfaceRxMode(context.Background(), 1, "polling"); err == nil || !strings.Contains(err.Error(), "error code: -1") {
+		t.Fatalf("SetInterfaceRxMode() error = %v, want VPP error code", err)
+	}
+}
+
+// TestGovppClient_SetInterfaceRxPlacement tests the rx-placement request
+func TestGovppClient_SetInterfaceRxPlacement(t *testing.T) {
+	var got *vppif.SwInterfaceSetRxPlacement
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				req, ok := msg.(*vppif.SwInterfaceSetRxPlacement)
+				if !ok {
+					return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+				}
+				got = req
+				return &fakeRequestCtx{reply: &vppif.SwInterfaceSetRxPlacementReply{}}
+			},
+		},
+	}
+
+	if err := client.SetInterfaceRxPlacement(context.Background(), 3, 1, 2); err != nil {
+		t.Fatalf("SetInterfaceRxPlacement() error = %v", err)
+	}
+	if got == nil || got.SwIfIndex != 3 || got.QueueID != 1 || got.WorkerID != 2 || got.IsMain {
+		t.Fatalf("SetInterfaceRxPlacement() sent %#v, want queue 1 on worker 2", got)
+	}
+}
+
+// TestGovppClient_SetInterfaceIP6Enabled tests the ip6 enable request and
+// VPP errors
+func TestGovppClient_SetInterfaceIP6Enabled(t *testing.T) {
+	var got *vppip.SwInterfaceIP6EnableDisable
+	retval := int32(0)
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				req, ok := msg.(*vppip.SwInterfaceIP6EnableDisable)
+				if !ok {
+					return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+				}
+				got = req
+				return &fakeRequestCtx{reply: &vppip.SwInterfaceIP6EnableDisableReply{Retval: retval}}
+			},
+		},
+	}
+
+	if err := client.SetInterfaceIP6Enabled(context.Background(), 4, true); err != nil {
+		t.Fatalf("SetInterfaceIP6Enabled() error = %v", err)
+	}
+	if got == nil || got.SwIfIndex != 4 || !got.Enable {
+		t.Fatalf("SetInterfaceIP6Enabled() sent %#v, want enable on 4", got)
+	}
+	if err := client.SetInterfaceIP6Enabled(context.Background(), 4, false); err != nil || got.Enable {
+		t.Fatalf("SetInterfaceIP6Enabled(false) sent %#v, error = %v", got, err)
+	}
+	retval = int32(api.VALUE_EXIST)
+	if err := client.SetInterfaceIP6Enabled(context.Background(), 4, true); err != nil {
+		t.Fatalf("SetInterfaceIP6Enabled() on an IPv6 interface error = %v", err)
+	}
+	if err := client.SetInterfaceIP6Enabled(context.Background(), 4, false); err == nil {
+		t.Fatal("SetInterfaceIP6Enabled(false) error = nil, want VPP error code")
+	}
+	retval = -1
+	if err := client.SetInterfaceIP6Enabled(context.Background(), 4, true); err == nil || !strings.Contains(err.Error(), "error code: -1") {
+		t.Fatalf("SetInterfaceIP6Enabled() error = %v, want VPP error code", err)
+	}
+}
+
+// TestGovppClient_SetInterfaceRxPlacement_Errors tests VPP and transport errors
+func TestGovppClient_SetInterfaceRxPlacement_Errors(t *testing.T) {
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				return &fakeRequestCtx{reply: &vppif.SwInterfaceSetRxPlacementReply{Retval: -1}}
+			},
+		},
+	}
+	if err := client.SetInterfaceRxPlacement(context.Background(), 1, 0, 0); err == nil || !strings.Contains(err.Error(), "error code: -1") {
+		t.Fatalf("SetInterfaceRxPlacement() error = %v, want VPP error code", err)
+	}
+
+	client.ch = &fakeChannel{
+		sendRequestFunc: func(msg api.Message) api.RequestCtx {
+			return &fakeRequestCtx{err: errors.New("channel closed")}
+		},
+	}
+	if err := client.SetInterfaceRxPlacement(context.Background(), 1, 0, 0); err == nil || !strings.Contains(err.Error(), "channel closed") {
+		t.Fatalf("SetInterfaceRxPlacement() error = %v, want transport error", err)
+	}
+}
+
+// TestGovppClient_GetWorkerCount tests that the main thread is not counted
+func TestGovppClient_GetWorkerCount(t *testing.T) {
+	tests := []struct {
+		threads int
+		want    uint32
+	}{
+		{threads: 0, want: 0},
+		{threads: 1, want: 0},
+		{threads: 5, want: 4},
+	}
+	for _, tt := range tests {
+		client := &govppClient{
+			ch: &fakeChannel{
+				sendRequestFunc: func(msg api.Message) api.RequestCtx {
+					if _, ok := msg.(*govppvlib.ShowThreads); !ok {
+						return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+					}
+					return &fakeRequestCtx{reply: &govppvlib.ShowThreadsReply{
+						Count:      uint32(tt.threads),
+						ThreadData: make([]govppvlib.ThreadData, tt.threads),
+					}}
+				},
+			},
+		}
+		got, err := client.GetWorkerCount(context.Background())
+		if err != nil {
+			t.Fatalf("GetWorkerCount() error = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("GetWorkerCount() with %d threads = %d, want %d", tt.threads, got, tt.want)
+		}
+	}
+}
+
+// TestGovppClient_SetInterfaceMTU tests the link and per-family MTU requests
+func TestGovppClient_SetInterfaceMTU(t *testing.T) {
+	var sent []api.Message
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				sent = append(sent, msg)
+				switch msg.(type) {
+				case *vppif.HwInterfaceSetMtu:
+					return &fakeRequestCtx{reply: &vppif.HwInterfaceSetMtuReply{}}
+				case *vppif.SwInterfaceSetMtu:
+					return &fakeRequestCtx{reply: &vppif.SwInterfaceSetMtuReply{Retval: -1}}
+				}
+				return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+			},
+		},
+	}
+
+	if err := client.SetInterfaceMTU(context.Background(), 2, 9000); err != nil {
+		t.Fatalf("SetInterfaceMTU() error = %v", err)
+	}
+	if err := client.SetInterfaceMTU(context.Background(), 2, 70000); err == nil {
+		t.Fatal("SetInterfaceMTU(70000) error = nil, want out of range error")
+	}
+	if err := client.SetInterfaceIPMTU(context.Background(), 2, 1500, 9000); err == nil || !strings.Contains(err.Error(), "error code: -1") {
+		t.Fatalf("SetInterfaceIPMTU() error = %v, want VPP error code", err)
+	}
+
+	if len(sent) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(sent))
+	}
+	if hw, ok := sent[0].(*vppif.HwInterfaceSetMtu); !ok || hw.SwIfIndex != 2 || hw.Mtu != 9000 {
+		t.Fatalf("first request = %#v, want hw_interface_set_mtu 9000 on index 2", sent[0])
+	}
+	sw, ok := sent[1].(*vppif.SwInterfaceSetMtu)
+	if !ok || sw.SwIfIndex != 2 || !reflect.DeepEqual(sw.Mtu, []uint32{0, 1500, 9000, 0}) {
+		t.Fatalf("second request = %#v, want sw_interface_set_mtu [0 1500 9000 0] on index 2", sent[1])
+	}
+}
+
+func TestGovppClient_SetInterfaceRouterAdvertisement(t *testing.T) {
+	var sent []api.Message
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				sent = append(sent, msg)
+				switch msg.(type) {
+				case *govppip6nd.SwInterfaceIP6ndRaConfig:
+					return &fakeRequestCtx{reply: &govppip6nd.SwInterfaceIP6ndRaConfigReply{}}
+				case *govppip6nd.SwInterfaceIP6ndRaPrefix:
+					return &fakeRequestCtx{reply: &govppip6nd.SwInterfaceIP6ndRaPrefixReply{}}
+				}
+				return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+			},
+		},
+	}
+	ctx := context.Background()
+
+	if err := client.SetInterfaceRouterAdvertisement(ctx, 3, RouterAdvertisement{Managed: true, MaxInterval: 60}); err != nil {
+		t.Fatalf("SetInterfaceRouterAdvertisement() error = %v", err)
+	}
+	if err := client.SetInterfaceRouterAdvertisement(ctx, 3, RouterAdvertisement{Suppress: true}); err != nil {
+		t.Fatalf("SetInterfaceRouterAdvertisement(suppress) error = %v", err)
+	}
+	_, prefix, _ := net.ParseCIDR("2001:db8:1::/64")
+	if err := client.AddRouterAdvertisementPrefix(ctx, 3, RouterAdvertisementPrefix{Prefix: prefix, ValidLifetime: 86400}); err != nil {
+		t.Fatalf("AddRouterAdvertisementPrefix() error = %v", err)
+	}
+	if err := client.DeleteRouterAdvertisementPrefix(ctx, 3, prefix); err != nil {
+		t.Fatalf("DeleteRouterAdvertisementPrefix() error = %v", err)
+	}
+
+	want := []api.Message{
+		&govppip6nd.SwInterfaceIP6ndRaConfig{SwIfIndex: 3, IsNo: true, Suppress: 1, Other: 1},
+		&govppip6nd.SwInterfaceIP6ndRaConfig{SwIfIndex: 3, Managed: 1, MaxInterval: 60, MinInterval: 45},
+		&govppip6nd.SwInterfaceIP6ndRaConfig{SwIfIndex: 3, Suppress: 1},
+		&govppip6nd.SwInterfaceIP6ndRaPrefix{
+			SwIfIndex:    3,
+			Prefix:       govppiptypes.NewPrefix(*prefix),
+			ValLifetime:  86400,
+			PrefLifetime: DefaultRAPreferredLifetime,
+		},
+		&govppip6nd.SwInterfaceIP6ndRaPrefix{SwIfIndex: 3, Prefix: govppiptypes.NewPrefix(*prefix), IsNo: true},
+	}
+	if !reflect.DeepEqual(sent, want) {
+		t.Fatalf("sent requests:\n%#v\nwant:\n%#v", sent, want)
+	}
+
+	client.ch = &fakeChannel{
+		sendRequestFunc: func(msg api.Message) api.RequestCtx {
+			return &fakeRequestCtx{reply: &govppip6nd.SwInterfaceIP6ndRaConfigReply{Retval: -2}}
+		},
+	}
+	if err := client.SetInterfaceRouterAdvertisement(ctx, 3, RouterAdvertisement{}); err == nil || !strings.Contains(err.Error(), "error code: -2") {
+		t.Fatalf("SetInterfaceRouterAdvertisement() error = %v, want VPP error code", err)
+	}
+}
+
 // TestGovppClient_SetInterfaceAddress_IPv4 tests setting IPv4 address
 func TestGovppClient_SetInterfaceAddress_IPv4(t *testing.T) {
 	fakeChannel := &fakeChannel{
@@ -726,6 +1395,39 @@ func TestGovppClient_SetInterfaceAddress_IPv4(t *testing.T) {
 	}
 }
 
+// TestGovppClient_SetInterfaceAddress_PointToPoint checks that /31 and /32
+// addresses, including the lower /31 address that equals the network
+// address, are sent to VPP exactly as configured.
+func TestGovppClient_SetInterfaceAddress_PointToPoint(t *testing.T) {
+	for _, cidr := range []string{"192.0.2.0/31", "192.0.2.1/31", "10.255.0.1/32", "2001:db8::/127"} {
+		t.Run(cidr, func(t *testing.T) {
+			var got string
+			fakeChannel := &fakeChannel{
+				sendRequestFunc: func(msg api.Message) api.RequestCtx {
+					req, ok := msg.(*vppif.SwInterfaceAddDelAddress)
+					if !ok {
+						return &fakeRequestCtx{err: fmt.Errorf("unexpected message type")}
+					}
+					got = req.Prefix.String()
+					return &fakeRequestCtx{reply: &vppif.SwInterfaceAddDelAddressReply{}}
+				},
+			}
+			client := &govppClient{ch: fakeChannel}
+
+			ipnet, err := ParseCIDRAddress(cidr)
+			if err != nil {
+				t.Fatalf("ParseCIDRAddress() error = %v", err)
+			}
+			if err := client.SetInterfaceAddress(context.Background(), 1, ipnet); err != nil {
+				t.Fatalf("SetInterfaceAddress() error = %v", err)
+			}
+			if got != cidr {
+				t.Fatalf("VPP address = %s, want %s", got, cidr)
+			}
+		})
+	}
+}
+
 // TestGovppClient_SetInterfaceAddress_IPv6 tests setting IPv6 address
 func TestGovppClient_SetInterfaceAddress_IPv6(t *testing.T) {
 	fakeChannel := &fakeChannel{
@@ -763,6 +1465,112 @@ func TestGovppClient_SetInterfaceAddress_NilAddress(t *testing.T) {
 	}
 }
 
+// TestGovppClient_ApplyInterfaceAddresses tests that every operation in a
+// batch is attempted and failures are reported per operation
+func TestGovppClient_ApplyInterfaceAddresses(t *testing.T) {
+	var sent []*vppif.SwInterfaceAddDelAddress
+	fakeChannel := &fakeChannel{
+		sendRequestFunc: func(msg api.Message) api.RequestCtx {
+			req := msg.(*vppif.SwInterfaceAddDelAddress)
+			sent = append(sent, req)
+			retval := int32(0)
+			if req.SwIfIndex == 2 {
+				retval = -1
+			}
+			return &fakeRequestCtx{reply: &vppif.SwInterfaceAddDelAddressReply{Retval: retval}}
+		},
+	}
+	client := &govppClient{ch: fakeChannel}
+
+	_, first, _ := net.ParseCIDR("192.0.2.1/24")
+	_, second, _ := net.ParseCIDR("2001:db8::1/64")
+	ops := []AddressOp{
+		{IfIndex: 1, Address: first},
+		{IfIndex: 2, Address: second},
+		{IfIndex: 1, Address: nil},
+		{IfIndex: 1, Address: second, Delete: true},
+	}
+	errs := client.ApplyInterfaceAddresses(context.Background(), ops)
+	if len(errs) != len(ops) {
+		t.Fatalf("ApplyInterfaceAddresses() returned %d errors, want %d", len(errs), len(ops))
+	}
+	if errs[0] != nil || errs[3] != nil {
+		t.Fatalf("successful operations returned errors: %v, %v", errs[0], errs[3])
+	}
+	if errs[1] == nil || !strings.Contains(errs[1].Error(), "error code: -1") {
+		t.Fatalf("errs[1] = %v, want VPP error code", errs[1])
+	}
+	if errs[2] == nil || !strings.Contains(errs[2].Error(), "address cannot be nil") {
+		t.Fatalf("errs[2] = %v, want nil address error", errs[2])
+	}
+	if len(sent) != 3 {
+		t.Fatalf("sent %d requests, want 3", len(sent))
+	}
+	if !sent[0].IsAdd || sent[2].IsAdd {
+		t.Fatalf("IsAdd flags = %v, %v, want add then delete", sent[0].IsAdd, sent[2].IsAdd)
+	}
+}
+
+// delayedRequestCtx simulates a VPP reply that arrives a fixed time after the
+// request was sent, independent of when the caller starts waiting for it.
+type delayedRequestCtx struct {
+	ready <-chan time.Time
+}
+
+func (d *delayedRequestCtx) ReceiveReply(msg api.Message) error {
+	<-d.ready
+	return nil
+}
+
+func benchmarkAddressOps(n int) []AddressOp {
+	ops := make([]AddressOp, n)
+	for i := range ops {
+		ops[i] = AddressOp{
+			IfIndex: uint32(i%4 + 1),
+			Address: &net.IPNet{IP: net.IPv4(10, 0, byte(i/256), byte(i%256)).To4(), Mask: net.CIDRMask(32, 32)},
+		}
+	}
+	return ops
+}
+
+func newLatencyClient(latency time.Duration) *govppClient {
+	return &govppClient{ch: &fakeChannel{
+		sendRequestFunc: func(msg api.Message) api.RequestCtx {
+			return &delayedRequestCtx{ready: time.After(latency)}
+		},
+	}}
+}
+
+// BenchmarkApplyInterfaceAddresses compares one synchronous request per
+// address with the pipelined batch for 100 addresses over a simulated
+// 50µs API round trip.
+func BenchmarkApplyInterfaceAddresses(b *testing.B) {
+	const latency = 50 * time.Microsecond
+	ops := benchmarkAddressOps(100)
+	ctx := context.Background()
+
+	b.Run("sequential", func(b *testing.B) {
+		client := newLatencyClient(latency)
+		for i := 0; i < b.N; i++ {
+			for _, op := range ops {
+				if err := client.SetInterfaceAddress(ctx, op.IfIndex, op.Address); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("batched", func(b *testing.B) {
+		client := newLatencyClient(latency)
+		for i := 0; i < b.N; i++ {
+			for _, err := range client.ApplyInterfaceAddresses(ctx, ops) {
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
 // TestGovppClient_DeleteInterfaceAddress tests deleting interface address
 func TestGovppClient_DeleteInterfaceAddress(t *testing.T) {
 	fakeChannel := &fakeChannel{
@@ -797,6 +1605,239 @@ func TestGovppClient_DeleteInterfaceAddress(t *testing.T) {
 	}
 }
 
+// TestGovppClient_InterfaceAddressRetvalsAreIdempotent tests that re-adding a
+// present address and deleting an absent one succeed
+func TestGovppClient_InterfaceAddressRetvalsAreIdempotent(t *testing.T) {
+	present, err := ip_types.ParseAddressWithPrefix("192.0.2.1/24")
+	if err != nil {
+		t.Fatalf("ParseAddressWithPrefix() error = %v", err)
+	}
+	retval := int32(0)
+	dumps := 0
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				if _, ok := msg.(*vppif.SwInterfaceAddDelAddress); !ok {
+					return &fakeRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+				}
+				return &fakeRequestCtx{reply: &vppif.SwInterfaceAddDelAddressReply{Retval: retval}}
+			},
+			sendMultiRequestFunc: func(msg api.Message) api.MultiRequestCtx {
+				req, ok := msg.(*vppip.IPAddressDump)
+				if !ok {
+					return &fakeMultiRequestCtx{err: fmt.Errorf("unexpected request type: %T", msg)}
+				}
+				dumps++
+				if req.IsIPv6 || req.SwIfIndex != 1 {
+					return &fakeMultiRequestCtx{}
+				}
+				return &fakeMultiRequestCtx{replies: []api.Message{&vppip.IPAddressDetails{SwIfIndex: 1, Prefix: present}}}
+			},
+		},
+	}
+	ctx := context.Background()
+	addr := &net.IPNet{IP: net.ParseIP("192.0.2.1"), Mask: net.CIDRMask(24, 32)}
+
+	retval = int32(api.VALUE_EXIST)
+	if err := client.SetInterfaceAddress(ctx, 1, addr); err != nil {
+		t.Fatalf("SetInterfaceAddress() already exists error = %v", err)
+	}
+	retval = int32(api.DUPLICATE_IF_ADDRESS)
+	if err := client.SetInterfaceAddress(ctx, 1, addr); err != nil {
+		t.Fatalf("SetInterfaceAddress() duplicate on the same interface error = %v", err)
+	}
+	if err := client.SetInterfaceAddress(ctx, 2, addr); err == nil || !strings.Contains(err.Error(), "error code: -127") {
+		t.Fatalf("SetInterfaceAddress() duplicate on another interface error = %v, want VPP error code", err)
+	}
+	retval = int32(api.ADDRESS_NOT_FOUND_FOR_INTERFACE)
+	if err := client.DeleteInterfaceAddress(ctx, 1, addr); err != nil {
+		t.Fatalf("DeleteInterfaceAddress() not found error = %v", err)
+	}
+	if err := client.SetInterfaceAddress(ctx, 1, addr); err == nil {
+		t.Fatal("SetInterfaceAddress() error = nil, want not found to fail an add")
+	}
+
+	retval = int32(api.DUPLICATE_IF_ADDRESS)
+	dumps = 0
+	errs := client.ApplyInterfaceAddresses(ctx, []AddressOp{
+		{IfIndex: 1, Address: addr},
+		{IfIndex: 2, Address: addr},
+	})
+	if errs[0] != nil || errs[1] == nil {
+		t.Fatalf("ApplyInterfaceAddresses() duplicate errors = %v, want only interface 2 to fail", errs)
+	}
+	if dumps != 4 {
+		t.Fatalf("ApplyInterfaceAddresses() address dumps = %d, want one IPv4 and IPv6 dump per duplicate", dumps)
+	}
+	retval = int32(api.ADDRESS_NOT_FOUND_FOR_INTERFACE)
+	errs = client.ApplyInterfaceAddresses(ctx, []AddressOp{{IfIndex: 1, Address: addr, Delete: true}})
+	if errs[0] != nil {
+		t.Fatalf("ApplyInterfaceAddresses() not found delete error = %v", errs[0])
+	}
+}
+
+// TestGovppClient_AddNeighbor tests adding static IPv4 and IPv6 neighbors
+func TestGovppClient_AddNeighbor(t *testing.T) {
+	tests := []struct {
+		name   string
+		ip     string
+		wantAf ip_types.AddressFamily
+	}{
+		{name: "ipv4", ip: "192.0.2.10", wantAf: ip_types.ADDRESS_IP4},
+		{name: "ipv6", ip: "2001:db8::10", wantAf: ip_types.ADDRESS_IP6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mac, _ := net.ParseMAC("00:11:22:33:44:55")
+			var got *ip_neighbor.IPNeighborAddDel
+			client := &govppClient{
+				ch: &fakeChannel{
+					sendRequestFunc: func(msg api.Message) api.RequestCtx {
+						req, ok := msg.(*ip_neighbor.IPNeighborAddDel)
+						if !ok {
+							return &fakeRequestCtx{err: fmt.Errorf("unexpected message type: %T", msg)}
+						}
+						got = req
+						return &fakeRequestCtx{reply: &ip_neighbor.IPNeighborAddDelReply{}}
+					},
+				},
+			}
+
+			err := client.AddNeighbor(context.Background(), Neighbor{
+				SwIfIndex: 3,
+				IP:        net.ParseIP(tt.ip),
+				MAC:       mac,
+				Static:    true,
+			})
+			if err != nil {
+				t.Fatalf("AddNeighbor() error = %v, want nil", err)
+			}
+			if got == nil || !got.IsAdd {
+				t.Fatalf("request = %+v, want IsAdd=true", got)
+			}
+			if got.Neighbor.SwIfIndex != 3 {
+				t.Errorf("SwIfIndex = %d, want 3", got.Neighbor.SwIfIndex)
+			}
+			if got.Neighbor.Flags != ip_neighbor.IP_API_NEIGHBOR_FLAG_STATIC {
+				t.Errorf("Flags = %v, want STATIC", got.Neighbor.Flags)
+			}
+			if got.Neighbor.IPAddress.Af != tt.wantAf {
+				t.Errorf("Af = %v, want %v", got.Neighbor.IPAddress.Af, tt.wantAf)
+			}
+			if !got.Neighbor.IPAddress.ToIP().Equal(net.ParseIP(tt.ip)) {
+				t.Errorf("IPAddress = %s, want %s", got.Neighbor.IPAddress.ToIP(), tt.ip)
+			}
+			if got.Neighbor.MacAddress.String() != mac.String() {
+				t.Errorf("MacAddress = %s, want %s", got.Neighbor.MacAddress, mac)
+			}
+		})
+	}
+}
+
+// TestGovppClient_AddNeighbor_InvalidMAC tests that add requires a MAC address
+func TestGovppClient_AddNeighbor_InvalidMAC(t *testing.T) {
+	client := &govppClient{ch: &fakeChannel{}}
+	err := client.AddNeighbor(context.Background(), Neighbor{SwIfIndex: 1, IP: net.ParseIP("192.0.2.1")})
+	if err == nil {
+		t.Fatal("AddNeighbor() error = nil, want invalid MAC error")
+	}
+}
+
+// TestGovppClient_DeleteNeighbor tests deleting a neighbor and retval handling
+func TestGovppClient_DeleteNeighbor(t *testing.T) {
+	retval := int32(0)
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendRequestFunc: func(msg api.Message) api.RequestCtx {
+				req, ok := msg.(*ip_neighbor.IPNeighborAddDel)
+				if !ok {
+					return &fakeRequestCtx{err: fmt.Errorf("unexpected message type: %T", msg)}
+				}
+				if req.IsAdd {
+					return &fakeRequestCtx{err: fmt.Errorf("expected IsAdd=false")}
+				}
+				return &fakeRequestCtx{reply: &ip_neighbor.IPNeighborAddDelReply{Retval: retval}}
+			},
+		},
+	}
+
+	neighbor := Neighbor{SwIfIndex: 1, IP: net.ParseIP("2001:db8::1")}
+	if err := client.DeleteNeighbor(context.Background(), neighbor); err != nil {
+		t.Fatalf("DeleteNeighbor() error = %v, want nil", err)
+	}
+
+	retval = -6
+	if err := client.DeleteNeighbor(context.Background(), neighbor); err == nil {
+		t.Fatal("DeleteNeighbor() error = nil, want retval error")
+	}
+}
+
+// TestGovppClient_GetNeighbors tests dumping IPv4 and IPv6 neighbor tables
+func TestGovppClient_GetNeighbors(t *testing.T) {
+	mac := ethernet_types.MacAddress{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	var families []ip_types.AddressFamily
+	client := &govppClient{
+		ch: &fakeChannel{
+			sendMultiRequestFunc: func(msg api.Message) api.MultiRequestCtx {
+				req, ok := msg.(*ip_neighbor.IPNeighborDump)
+				if !ok {
+					return &fakeMultiRequestCtx{err: fmt.Errorf("unexpected message type: %T", msg)}
+				}
+				if req.SwIfIndex != interface_types.InterfaceIndex(AllInterfaces) {
+					return &fakeMultiRequestCtx{err: fmt.Errorf("unexpected sw_if_index %d", req.SwIfIndex)}
+				}
+				families = append(families, req.Af)
+
+				ip := "192.0.2.10"
+				flags := ip_neighbor.IP_API_NEIGHBOR_FLAG_STATIC
+				if req.Af == ip_types.ADDRESS_IP6 {
+					ip = "2001:db8::10"
+					flags = ip_neighbor.IP_API_NEIGHBOR_FLAG_NONE
+				}
+				return &fakeMultiRequestCtx{
+					replies: []api.Message{
+						&ip_neighbor.IPNeighborDetails{
+							Age: 12.5,
+							Neighbor: ip_neighbor.IPNeighbor{
+								SwIfIndex:  2,
+								Flags:      flags,
+								MacAddress: mac,
+								IPAddress:  ip_types.NewAddress(net.ParseIP(ip)),
+							},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	neighbors, err := client.GetNeighbors(context.Background(), AllInterfaces)
+	if err != nil {
+		t.Fatalf("GetNeighbors() error = %v, want nil", err)
+	}
+	if len(families) != 2 || families[0] != ip_types.ADDRESS_IP4 || families[1] != ip_types.ADDRESS_IP6 {
+		t.Fatalf("dumped families = %v, want [IP4 IP6]", families)
+	}
+	if len(neighbors) != 2 {
+		t.Fatalf("len(neighbors) = %d, want 2", len(neighbors))
+	}
+
+	v4, v6 := neighbors[0], neighbors[1]
+	if !v4.IP.Equal(net.ParseIP("192.0.2.10")) || !v4.Static || v4.SwIfIndex != 2 {
+		t.Errorf("IPv4 neighbor = %+v", v4)
+	}
+	if v4.MAC.String() != "00:11:22:33:44:55" {
+		t.Errorf("IPv4 MAC = %s, want 00:11:22:33:44:55", v4.MAC)
+	}
+	if v4.Age != 12500*time.Millisecond {
+		t.Errorf("IPv4 Age = %v, want 12.5s", v4.Age)
+	}
+	if !v6.IP.Equal(net.ParseIP("2001:db8::10")) || v6.Static {
+		t.Errorf("IPv6 neighbor = %+v", v6)
+	}
+}
+
 // TestGovppClient_GetInterface tests getting interface details
 func TestGovppClient_GetInterface(t *testing.T) {
 	expectedIfIndex := interface_types.InterfaceIndex(1)
@@ -0,0 +1,198 @@
+package vpp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/akam1o/arca-router/pkg/errors"
+)
+
+// DefaultInterfaceIndexPath is the daemon's default path for stable interface
+// index persistence.
+const DefaultInterfaceIndexPath = "/var/lib/arca-router/interface_index.json"
+
+// InterfaceIndexMapping is a persisted stable interface index assignment
+type InterfaceIndexMapping struct {
+	Name       string `json:"name"`
+	IfIndex    uint32 `json:"if_index"`
+	PCIAddress string `json:"pci_address,omitempty"`
+}
+
+// InterfaceIndexTable assigns stable logical interface indexes (SNMP ifIndex
+// style) to Junos interface names. VPP sw_if_index values are allocated
+// dynamically and may change across VPP restarts; the logical index is
+// persisted and survives both daemon and VPP restarts.
+//
+// Indexes start at 1 and are never reassigned to a different interface.
+type InterfaceIndexTable struct {
+	mu     sync.RWMutex
+	path   string
+	byName map[string]*InterfaceIndexMapping
+	next   uint32
+}
+
+// NewInterfaceIndexTable creates an in-memory stable interface index table.
+// Load and Save are no-ops, so indexes only last for the life of the process.
+func NewInterfaceIndexTable() *InterfaceIndexTable {
+	return NewInterfaceIndexTableWithPath("")
+}
+
+// NewInterfaceIndexTableWithPath creates a stable interface index table
+// persisted at path. An empty path makes the table in-memory only.
+func NewInterfaceIndexTableWithPath(path string) *InterfaceIndexTable {
+	return &InterfaceIndexTable{
+		path:   path,
+		byName: make(map[string]*InterfaceIndexMapping),
+		next:   1,
+	}
+}
+
+// Load reads persisted index assignments from disk, replacing the in-memory table.
+// A missing file is not an error. Invalid content leaves the table empty and
+// returns an error so the caller can log it.
+func (t *InterfaceIndexTable) Load() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.byName = make(map[string]*InterfaceIndexMapping)
+	t.next = 1
+	if t.path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(t.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeSystemError,
+			"Failed to read interface index file",
+			fmt.Sprintf("Could not read from: %s", t.path),
+			"Ensure the process has read permission")
+	}
+
+	var mappings []*InterfaceIndexMapping
+	if err := json.Unmarshal(data, &mappings); err != nil {
+		return errors.Wrap(err, errors.ErrCodeConfigParseError,
+			"Failed to parse interface index JSON",
+			fmt.Sprintf("Invalid JSON in: %s", t.path),
+			"The interface index file may be corrupted - restore from backup or delete to start fresh")
+	}
+	if problems := validateInterfaceIndexMappings(mappings); len(problems) > 0 {
+		return fmt.Errorf("persisted interface indexes validation failed: %v", problems)
+	}
+
+	for _, mapping := range mappings {
+		copied := *mapping
+		t.byName[mapping.Name] = &copied
+		if mapping.IfIndex >= t.next {
+			t.next = mapping.IfIndex + 1
+		}
+	}
+	return nil
+}
+
+// Assign returns the stable index for an interface, allocating one if needed.
+// Interfaces are matched by name first, then by PCI address so that a renamed
+// port keeps its index. changed reports whether the table must be saved.
+func (t *InterfaceIndexTable) Assign(name, pciAddress string) (ifIndex uint32, changed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if mapping, ok := t.byName[name]; ok {
+		if pciAddress != "" && mapping.PCIAddress != pciAddress {
+			mapping.PCIAddress = pciAddress
+			changed = true
+		}
+		return mapping.IfIndex, changed
+	}
+
+	if pciAddress != "" {
+		for oldName, mapping := range t.byName {
+			if mapping.PCIAddress != pciAddress {
+				continue
+			}
+			delete(t.byName, oldName)
+			mapping.Name = name
+			t.byName[name] = mapping
+			return mapping.IfIndex, true
+		}
+	}
+
+	mapping := &InterfaceIndexMapping{Name: name, IfIndex: t.next, PCIAddress: pciAddress}
+	t.byName[name] = mapping
+	t.next++
+	return mapping.IfIndex, true
+}
+
+// Lookup returns the stable index assigned to an interface name
+func (t *InterfaceIndexTable) Lookup(name string) (uint32, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	mapping, ok := t.byName[name]
+	if !ok {
+		return 0, false
+	}
+	return mapping.IfIndex, true
+}
+
+// Mappings returns a copy of all assignments ordered by index
+func (t *InterfaceIndexTable) Mappings() []InterfaceIndexMapping {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	mappings := make([]InterfaceIndexMapping, 0, len(t.byName))
+	for _, mapping := range t.byName {
+		mappings = append(mappings, *mapping)
+	}
+	sort.Slice(mappings, func(i, j int) bool { return mappings[i].IfIndex < mappings[j].IfIndex })
+	return mappings
+}
+
+// Save persists the index assignments to disk atomically
+func (t *InterfaceIndexTable) Save() error {
+	if t.path == "" {
+		return nil
+	}
+	mappings := t.Mappings()
+	data, err := json.MarshalIndent(mappings, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeSystemError,
+			"Failed to marshal interface indexes to JSON",
+			"Could not serialize interface index data",
+			"This is an internal error - contact support")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return writeStateFileAtomic(t.path, ".interface_index.*.tmp", data)
+}
+
+func validateInterfaceIndexMappings(mappings []*InterfaceIndexMapping) []string {
+	var problems []string
+	seenName := make(map[string]bool)
+	seenIndex := make(map[uint32]bool)
+	for i, mapping := range mappings {
+		if mapping == nil {
+			problems = append(problems, fmt.Sprintf("mapping[%d]: empty entry", i))
+			continue
+		}
+		if mapping.Name == "" {
+			problems = append(problems, fmt.Sprintf("mapping[%d]: missing name", i))
+		}
+		if mapping.IfIndex == 0 {
+			problems = append(problems, fmt.Sprintf("mapping[%d]: if_index must be positive", i))
+		}
+		if seenName[mapping.Name] {
+			problems = append(problems, fmt.Sprintf("mapping[%d]: duplicate name: %s", i, mapping.Name))
+		}
+		seenName[mapping.Name] = true
+		if seenIndex[mapping.IfIndex] {
+			problems = append(problems, fmt.Sprintf("mapping[%d]: duplicate if_index: %d", i, mapping.IfIndex))
+		}
+		seenIndex[mapping.IfIndex] = true
+	}
+	return problems
+}
@@ -0,0 +1,85 @@
+package vpp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInterfaceIndexTableSurvivesReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interface_index.json")
+	table := NewInterfaceIndexTableWithPath(path)
+	if err := table.Load(); err != nil {
+		t.Fatalf("Load() missing file error = %v", err)
+	}
+
+	first, changed := table.Assign("ge-0/0/0", "0000:03:00.0")
+	if first != 1 || !changed {
+		t.Fatalf("Assign(ge-0/0/0) = %d, %v, want 1, true", first, changed)
+	}
+	second, _ := table.Assign("ge-0/0/1", "0000:03:00.1")
+	if second != 2 {
+		t.Fatalf("Assign(ge-0/0/1) = %d, want 2", second)
+	}
+	if again, changed := table.Assign("ge-0/0/0", "0000:03:00.0"); again != first || changed {
+		t.Fatalf("Assign(ge-0/0/0) again = %d, %v, want %d, false", again, changed, first)
+	}
+	if err := table.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// A new process discovers the interfaces in a different order.
+	reloaded := NewInterfaceIndexTableWithPath(path)
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got, _ := reloaded.Assign("ge-0/0/1", "0000:03:00.1"); got != second {
+		t.Fatalf("reloaded Assign(ge-0/0/1) = %d, want %d", got, second)
+	}
+	if got, ok := reloaded.Lookup("ge-0/0/0"); !ok || got != first {
+		t.Fatalf("reloaded Lookup(ge-0/0/0) = %d, %v, want %d", got, ok, first)
+	}
+
+	// A renamed port keeps its index; new ports never reuse one.
+	if got, changed := reloaded.Assign("xe-0/0/0", "0000:03:00.0"); got != first || !changed {
+		t.Fatalf("Assign(renamed port) = %d, %v, want %d, true", got, changed, first)
+	}
+	if _, ok := reloaded.Lookup("ge-0/0/0"); ok {
+		t.Fatal("Lookup(ge-0/0/0) still present after rename")
+	}
+	if got, _ := reloaded.Assign("ge-0/0/2", "0000:03:00.2"); got != 3 {
+		t.Fatalf("Assign(ge-0/0/2) = %d, want 3", got)
+	}
+}
+
+func TestInterfaceIndexTableRejectsInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interface_index.json")
+	data := `[{"name":"ge-0/0/0","if_index":1},{"name":"ge-0/0/1","if_index":1}]`
+	if err := os.WriteFile(path, []byte(data), 0o640); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	table := NewInterfaceIndexTableWithPath(path)
+	if err := table.Load(); err == nil {
+		t.Fatal("Load() error = nil, want duplicate if_index error")
+	}
+	if mappings := table.Mappings(); len(mappings) != 0 {
+		t.Fatalf("Mappings() = %#v, want empty table after invalid load", mappings)
+	}
+}
+
+func TestInterfaceIndexTableWithoutPathIsInMemory(t *testing.T) {
+	table := NewInterfaceIndexTable()
+	if err := table.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got, _ := table.Assign("ge-0/0/0", ""); got != 1 {
+		t.Fatalf("Assign(ge-0/0/0) = %d, want 1", got)
+	}
+	if err := table.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if got, ok := table.Lookup("ge-0/0/0"); !ok || got != 1 {
+		t.Fatalf("Lookup(ge-0/0/0) = %d, %v, want 1", got, ok)
+	}
+}
@@ -19,6 +19,9 @@ const (
 var (
 	// junosIfNamePattern matches Junos interface names like ge-0/0/0, xe-1/2/3, et-4/5/6
 	junosIfNamePattern = regexp.MustCompile(`^([a-z]+)-(\d+)/(\d+)/(\d+)(?:\.(\d+))?$`)
+
+	// loopbackIfNamePattern matches Junos loopback interface names like lo0
+	loopbackIfNamePattern = regexp.MustCompile(`^lo\d+$`)
 )
 
 // ConvertJunosToLinuxName converts a Junos interface name to Linux format.
@@ -29,6 +32,7 @@ var (
 //	et-0/1/2     → et0-1-2
 //	ge-0/0/0.10  → ge0-0-0v10
 //	ge-0/0/10    → ge0-0-10
+//	lo0          → lo0
 //
 // For names that would exceed 15 characters or have potential collisions,
 // a deterministic hash suffix is appended.
@@ -37,6 +41,12 @@ func ConvertJunosToLinuxName(junosName string) (string, error) {
 		return "", fmt.Errorf("empty Junos interface name")
 	}
 
+	// Loopback names are valid Linux names and never clash with the host
+	// "lo", so they are kept as-is.
+	if loopbackIfNamePattern.MatchString(junosName) {
+		return junosName, nil
+	}
+
 	// Parse Junos interface name
 	matches := junosIfNamePattern.FindStringSubmatch(junosName)
 	if matches == nil {
@@ -35,6 +35,12 @@ func TestConvertJunosToLinuxName(t *testing.T) {
 			want:      "ge0-0-10",
 			wantErr:   false,
 		},
+		{
+			name:      "loopback",
+			junosName: "lo0",
+			want:      "lo0",
+			wantErr:   false,
+		},
 		{
 			name:      "all two digits",
 			junosName: "xe-10/20/30",
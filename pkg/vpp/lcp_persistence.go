@@ -54,15 +54,6 @@ func (p *LCPPersistence) SaveMapping(mappings []*LCPMapping) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	// Ensure parent directory exists with correct permissions
-	dir := filepath.Dir(p.path)
-	if err := os.MkdirAll(dir, lcpMappingDirMode); err != nil {
-		return errors.Wrap(err, errors.ErrCodeSystemError,
-			"Failed to create LCP mapping directory",
-			fmt.Sprintf("Could not create directory: %s", dir),
-			"Ensure the process has permission to create directories")
-	}
-
 	// Marshal mappings to JSON
 	data, err := json.MarshalIndent(mappings, "", "  ")
 	if err != nil {
@@ -72,12 +63,27 @@ func (p *LCPPersistence) SaveMapping(mappings []*LCPMapping) error {
 			"This is an internal error - contact support")
 	}
 
+	return writeStateFileAtomic(p.path, ".lcp_mapping.*.tmp", data)
+}
+
+// writeStateFileAtomic writes a state file under /var/lib/arca-router
+// atomically - writes to a temp file and renames to avoid partial writes
+func writeStateFileAtomic(path, tempPattern string, data []byte) error {
+	// Ensure parent directory exists with correct permissions
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, lcpMappingDirMode); err != nil {
+		return errors.Wrap(err, errors.ErrCodeSystemError,
+			"Failed to create state directory",
+			fmt.Sprintf("Could not create directory: %s", dir),
+			"Ensure the process has permission to create directories")
+	}
+
 	// Write to temporary file first (atomic write pattern with security)
 	// Use CreateTemp with O_EXCL to avoid symlink attacks
-	tempFile, err := os.CreateTemp(dir, ".lcp_mapping.*.tmp")
+	tempFile, err := os.CreateTemp(dir, tempPattern)
 	if err != nil {
 		return errors.Wrap(err, errors.ErrCodeSystemError,
-			"Failed to create LCP mapping temporary file",
+			"Failed to create state temporary file",
 			fmt.Sprintf("Could not create temp file in: %s", dir),
 			"Ensure the process has permission to write to /var/lib/arca-router/")
 	}
@@ -96,7 +102,7 @@ func (p *LCPPersistence) SaveMapping(mappings []*LCPMapping) error {
 	if err := tempFile.Chmod(lcpMappingFileMode); err != nil {
 		cleanupTemp()
 		return errors.Wrap(err, errors.ErrCodeSystemError,
-			"Failed to set LCP mapping file permissions",
+			"Failed to set state file permissions",
 			fmt.Sprintf("Could not chmod %s", tempPath),
 			"Ensure the process has permission to set file permissions")
 	}
@@ -105,7 +111,7 @@ func (p *LCPPersistence) SaveMapping(mappings []*LCPMapping) error {
 	if _, err := tempFile.Write(data); err != nil {
 		cleanupTemp()
 		return errors.Wrap(err, errors.ErrCodeSystemError,
-			"Failed to write LCP mapping temporary file",
+			"Failed to write state temporary file",
 			fmt.Sprintf("Could not write to: %s", tempPath),
 			"Ensure the process has permission to write to /var/lib/arca-router/")
 	}
@@ -114,7 +120,7 @@ func (p *LCPPersistence) SaveMapping(mappings []*LCPMapping) error {
 	if err := tempFile.Sync(); err != nil {
 		cleanupTemp()
 		return errors.Wrap(err, errors.ErrCodeSystemError,
-			"Failed to sync LCP mapping file to disk",
+			"Failed to sync state file to disk",
 			fmt.Sprintf("Could not fsync %s", tempPath),
 			"Check disk health and filesystem")
 	}
@@ -125,20 +131,20 @@ func (p *LCPPersistence) SaveMapping(mappings []*LCPMapping) error {
 			_ = err
 		}
 		return errors.Wrap(err, errors.ErrCodeSystemError,
-			"Failed to close LCP mapping temporary file",
+			"Failed to close state temporary file",
 			fmt.Sprintf("Could not close %s", tempPath),
 			"Ensure the process has permission to write to /var/lib/arca-router/")
 	}
 
 	// Atomic rename from temp to target path
-	if err := os.Rename(tempPath, p.path); err != nil {
+	if err := os.Rename(tempPath, path); err != nil {
 		// Clean up temp file on failure
 		if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
 			_ = err
 		}
 		return errors.Wrap(err, errors.ErrCodeSystemError,
-			"Failed to rename LCP mapping file",
-			fmt.Sprintf("Could not rename %s to %s", tempPath, p.path),
+			"Failed to rename state file",
+			fmt.Sprintf("Could not rename %s to %s", tempPath, path),
 			"Ensure the process has permission to write to /var/lib/arca-router/")
 	}
 
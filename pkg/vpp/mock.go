@@ -3,7 +3,9 @@ package vpp
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
+	"sort"
 	"sync"
 
 	"github.com/akam1o/arca-router/pkg/errors"
@@ -16,14 +18,27 @@ type MockClient struct {
 	interfaces      map[uint32]*Interface
 	lcpInterfaces   map[uint32]*LCPInterface
 	mplsInterfaces  map[uint32]bool
+	promiscuous     map[uint32]bool
+	rxModes         map[uint32]string
+	linkMTUs        map[uint32]uint32
+	ipMTUs          map[uint32][2]uint32
+	ip6Enabled      map[uint32]bool
+	routerAdverts   map[uint32]RouterAdvertisement
+	raPrefixes      map[uint32]map[string]RouterAdvertisementPrefix
 	ipTables        map[ipTableKey]IPTable
 	interfaceTable  map[interfaceTableKey]uint32
 	qosProfiles     map[uint32]QoSProfile
+	neighbors       map[neighborKey]Neighbor
+	policers        map[string]Policer
+	policerBindings map[policerBindingKey]string
 	bridgeDomains   map[uint32]BridgeDomain
 	vxlanTunnels    map[vxlanTunnelKey]*Interface
 	l2Bridge        map[uint32]uint32
 	counters        map[uint32]InterfaceCounters
 	queuePlacement  map[uint32]InterfaceQueuePlacements
+	rxPlacement     map[uint32]map[uint32]uint32
+	createRequests  map[uint32]CreateInterfaceRequest
+	workerCount     uint32
 	qosCapabilities QoSCapabilities
 	nextIfIdx       uint32
 
@@ -34,6 +49,15 @@ type MockClient struct {
 	SetInterfaceDownError       error
 	SetInterfaceAddressError    error
 	DeleteInterfaceAddressError error
+	GetNeighborsError           error
+	AddNeighborError            error
+	DeleteNeighborError         error
+	SetPromiscuousError         error
+	SetRxModeError              error
+	SetRxPlacementError         error
+	SetMTUError                 error
+	SetIP6EnableError           error
+	SetRouterAdvertisementError error
 	SetMPLSInterfaceError       error
 	AddIPTableError             error
 	DeleteIPTableError          error
@@ -42,12 +66,17 @@ type MockClient struct {
 	GetQoSCapabilitiesError     error
 	SetQoSProfileError          error
 	ClearQoSProfileError        error
+	AddPolicerError             error
+	DeletePolicerError          error
+	SetInterfacePolicerError    error
 	AddBridgeDomainError        error
 	DeleteBridgeDomainError     error
 	CreateVXLANError            error
 	DeleteVXLANError            error
+	DeleteTunnelInterfaceError  error
 	SetInterfaceL2BridgeError   error
 	ListInterfaceCountersError  error
+	ClearInterfaceStatsError    error
 	ListInterfaceQueuesError    error
 	GetInterfaceError           error
 	ListInterfacesError         error
@@ -60,17 +89,30 @@ type MockClient struct {
 // NewMockClient creates a new mock VPP client
 func NewMockClient() *MockClient {
 	return &MockClient{
-		interfaces:     make(map[uint32]*Interface),
-		lcpInterfaces:  make(map[uint32]*LCPInterface),
-		mplsInterfaces: make(map[uint32]bool),
-		ipTables:       make(map[ipTableKey]IPTable),
-		interfaceTable: make(map[interfaceTableKey]uint32),
-		qosProfiles:    make(map[uint32]QoSProfile),
-		bridgeDomains:  make(map[uint32]BridgeDomain),
-		vxlanTunnels:   make(map[vxlanTunnelKey]*Interface),
-		l2Bridge:       make(map[uint32]uint32),
-		counters:       make(map[uint32]InterfaceCounters),
-		queuePlacement: make(map[uint32]InterfaceQueuePlacements),
+		interfaces:      make(map[uint32]*Interface),
+		lcpInterfaces:   make(map[uint32]*LCPInterface),
+		mplsInterfaces:  make(map[uint32]bool),
+		promiscuous:     make(map[uint32]bool),
+		rxModes:         make(map[uint32]string),
+		linkMTUs:        make(map[uint32]uint32),
+		ipMTUs:          make(map[uint32][2]uint32),
+		ip6Enabled:      make(map[uint32]bool),
+		routerAdverts:   make(map[uint32]RouterAdvertisement),
+		raPrefixes:      make(map[uint32]map[string]RouterAdvertisementPrefix),
+		ipTables:        make(map[ipTableKey]IPTable),
+		interfaceTable:  make(map[interfaceTableKey]uint32),
+		qosProfiles:     make(map[uint32]QoSProfile),
+		neighbors:       make(map[neighborKey]Neighbor),
+		policers:        make(map[string]Policer),
+		policerBindings: make(map[policerBindingKey]string),
+		bridgeDomains:   make(map[uint32]BridgeDomain),
+		vxlanTunnels:    make(map[vxlanTunnelKey]*Interface),
+		l2Bridge:        make(map[uint32]uint32),
+		counters:        make(map[uint32]InterfaceCounters),
+		queuePlacement:  make(map[uint32]InterfaceQueuePlacements),
+		rxPlacement:     make(map[uint32]map[uint32]uint32),
+		createRequests:  make(map[uint32]CreateInterfaceRequest),
+		workerCount:     defaultMockWorkerCount,
 		qosCapabilities: QoSCapabilities{
 			MetadataBinding: true,
 		},
@@ -88,6 +130,16 @@ type interfaceTableKey struct {
 	isIPv6  bool
 }
 
+type neighborKey struct {
+	ifIndex uint32
+	ip      string
+}
+
+type policerBindingKey struct {
+	ifIndex uint32
+	output  bool
+}
+
 type vxlanTunnelKey struct {
 	vni                uint32
 	source             string
@@ -262,37 +314,52 @@ func (m *MockClient) CreateInterface(ctx context.Context, req *CreateInterfaceRe
 			errors.ErrCodeVPPOperation,
 			"Interface type is required",
 			"Interface type must be specified",
-			"Specify a valid interface type (avf, rdma, tap)",
+			"Specify a valid interface type (avf, rdma, tap, gre, ipip, loopback)",
 		)
 	}
 
 	// Validate interface type
 	validTypes := map[InterfaceType]bool{
-		InterfaceTypeAVF:  true,
-		InterfaceTypeRDMA: true,
-		InterfaceTypeTap:  true,
+		InterfaceTypeAVF:      true,
+		InterfaceTypeRDMA:     true,
+		InterfaceTypeTap:      true,
+		InterfaceTypeGRE:      true,
+		InterfaceTypeIPIP:     true,
+		InterfaceTypeLoopback: true,
 	}
 	if !validTypes[req.Type] {
 		return nil, errors.New(
 			errors.ErrCodeVPPOperation,
 			fmt.Sprintf("Invalid interface type: %s", req.Type),
-			"Interface type must be one of: avf, rdma, tap",
+			"Interface type must be one of: avf, rdma, tap, gre, ipip, loopback",
 			"Use a valid interface type",
 		)
 	}
+	if req.Type == InterfaceTypeGRE || req.Type == InterfaceTypeIPIP {
+		if err := validateTunnelRequest(req); err != nil {
+			return nil, errors.New(
+				errors.ErrCodeVPPOperation,
+				fmt.Sprintf("Invalid %s tunnel endpoints", req.Type),
+				err.Error(),
+				"Specify tunnel source and destination addresses of the same family",
+			)
+		}
+	}
 
 	// Create interface
 	iface := &Interface{
-		SwIfIndex: m.nextIfIdx,
-		Name:      fmt.Sprintf("%s%d", req.Type, m.nextIfIdx),
-		AdminUp:   false,
-		LinkUp:    false,
-		MAC:       net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, byte(m.nextIfIdx)},
-		Addresses: []*net.IPNet{},
+		SwIfIndex:  m.nextIfIdx,
+		Name:       fmt.Sprintf("%s%d", req.Type, m.nextIfIdx),
+		AdminUp:    false,
+		LinkUp:     false,
+		MAC:        net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, byte(m.nextIfIdx)},
+		Addresses:  []*net.IPNet{},
+		PCIAddress: req.PCIAddress,
 	}
 
 	// Store a copy to prevent external mutation
 	m.interfaces[m.nextIfIdx] = deepCopyInterface(iface)
+	m.createRequests[m.nextIfIdx] = *req
 	m.nextIfIdx++
 
 	// Return a copy to prevent external mutation
@@ -419,6 +486,20 @@ func (m *MockClient) SetInterfaceAddress(ctx context.Context, ifIndex uint32, ad
 	return nil
 }
 
+// ApplyInterfaceAddresses applies each operation in order through
+// SetInterfaceAddress or DeleteInterfaceAddress.
+func (m *MockClient) ApplyInterfaceAddresses(ctx context.Context, ops []AddressOp) []error {
+	errs := make([]error, len(ops))
+	for i, op := range ops {
+		if op.Delete {
+			errs[i] = m.DeleteInterfaceAddress(ctx, op.IfIndex, op.Address)
+		} else {
+			errs[i] = m.SetInterfaceAddress(ctx, op.IfIndex, op.Address)
+		}
+	}
+	return errs
+}
+
 // DeleteInterfaceAddress removes an IP address from a mock interface
 func (m *MockClient) DeleteInterfaceAddress(ctx context.Context, ifIndex uint32, addr *net.IPNet) error {
 	if m.DeleteInterfaceAddressError != nil {
@@ -472,6 +553,437 @@ func (m *MockClient) DeleteInterfaceAddress(ctx context.Context, ifIndex uint32,
 	)
 }
 
+// GetNeighbors lists mock neighbor entries ordered by interface and address.
+func (m *MockClient) GetNeighbors(ctx context.Context, swIfIndex uint32) ([]Neighbor, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if m.GetNeighborsError != nil {
+		return nil, m.GetNeighborsError
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if !m.connected {
+		return nil, errors.New(
+			errors.ErrCodeVPPConnection,
+			"Not connected to VPP",
+			"VPP connection not established",
+			"Connect to VPP before listing neighbors",
+		)
+	}
+
+	var neighbors []Neighbor
+	for key, neighbor := range m.neighbors {
+		if swIfIndex != AllInterfaces && key.ifIndex != swIfIndex {
+			continue
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].SwIfIndex != neighbors[j].SwIfIndex {
+			return neighbors[i].SwIfIndex < neighbors[j].SwIfIndex
+		}
+		return neighbors[i].IP.String() < neighbors[j].IP.String()
+	})
+	return neighbors, nil
+}
+
+// AddNeighbor adds or replaces a mock neighbor entry.
+func (m *MockClient) AddNeighbor(ctx context.Context, neighbor Neighbor) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.AddNeighborError != nil {
+		return m.AddNeighborError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(neighbor.SwIfIndex, "adding neighbors"); err != nil {
+		return err
+	}
+	if neighbor.IP == nil || len(neighbor.MAC) != 6 {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Invalid neighbor %s", neighbor.IP),
+			"Neighbor requires an IP address and a 6-byte MAC address",
+			"Provide a valid IP and MAC address",
+		)
+	}
+	m.neighbors[neighborKey{ifIndex: neighbor.SwIfIndex, ip: neighbor.IP.String()}] = neighbor
+	return nil
+}
+
+// DeleteNeighbor removes a mock neighbor entry.
+func (m *MockClient) DeleteNeighbor(ctx context.Context, neighbor Neighbor) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.DeleteNeighborError != nil {
+		return m.DeleteNeighborError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(neighbor.SwIfIndex, "deleting neighbors"); err != nil {
+		return err
+	}
+	key := neighborKey{ifIndex: neighbor.SwIfIndex, ip: neighbor.IP.String()}
+	if _, ok := m.neighbors[key]; !ok {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Neighbor %s not found on interface %d", neighbor.IP, neighbor.SwIfIndex),
+			"Neighbor not configured",
+			"Neighbor must be configured before it can be deleted",
+		)
+	}
+	delete(m.neighbors, key)
+	return nil
+}
+
+// SetInterfacePromiscuous enables or disables promiscuous mode on a mock interface.
+func (m *MockClient) SetInterfacePromiscuous(ctx context.Context, ifIndex uint32, enabled bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetPromiscuousError != nil {
+		return m.SetPromiscuousError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "setting promiscuous mode"); err != nil {
+		return err
+	}
+	if enabled {
+		m.promiscuous[ifIndex] = true
+		return nil
+	}
+	delete(m.promiscuous, ifIndex)
+	return nil
+}
+
+// InterfacePromiscuous reports whether promiscuous mode is enabled on a mock interface.
+func (m *MockClient) InterfacePromiscuous(ifIndex uint32) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.promiscuous[ifIndex]
+}
+
+// SetInterfaceRxMode records the RX mode of a mock interface.
+func (m *MockClient) SetInterfaceRxMode(ctx context.Context, ifIndex uint32, mode string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetRxModeError != nil {
+		return m.SetRxModeError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "setting rx-mode"); err != nil {
+		return err
+	}
+	switch mode {
+	case "polling", "interrupt", "adaptive":
+		m.rxModes[ifIndex] = mode
+	case "default":
+		delete(m.rxModes, ifIndex)
+	default:
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Unsupported rx-mode %q", mode),
+			"RX mode must be polling, interrupt, adaptive, or default",
+			"Use a supported rx-mode",
+		)
+	}
+	return nil
+}
+
+// InterfaceRxMode returns the RX mode set on a mock interface, or "" when
+// the interface uses the dataplane default.
+func (m *MockClient) InterfaceRxMode(ifIndex uint32) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.rxModes[ifIndex]
+}
+
+// defaultMockWorkerCount is the number of worker threads a new mock client
+// reports.
+const defaultMockWorkerCount = 2
+
+// SetInterfaceRxPlacement records the worker an RX queue of a mock
+// interface is placed on.
+func (m *MockClient) SetInterfaceRxPlacement(ctx context.Context, ifIndex uint32, queueID uint32, workerID uint32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetRxPlacementError != nil {
+		return m.SetRxPlacementError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "setting rx-placement"); err != nil {
+		return err
+	}
+	if workerID >= m.workerCount {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Worker %d does not exist", workerID),
+			fmt.Sprintf("VPP has %d worker threads", m.workerCount),
+			"Place the queue on an existing worker",
+		)
+	}
+	if m.rxPlacement[ifIndex] == nil {
+		m.rxPlacement[ifIndex] = make(map[uint32]uint32)
+	}
+	m.rxPlacement[ifIndex][queueID] = workerID
+	return nil
+}
+
+// InterfaceRxPlacement returns the workers the RX queues of a mock interface
+// were placed on, keyed by queue ID.
+func (m *MockClient) InterfaceRxPlacement(ifIndex uint32) map[uint32]uint32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return maps.Clone(m.rxPlacement[ifIndex])
+}
+
+// GetWorkerCount returns the number of mock VPP worker threads.
+func (m *MockClient) GetWorkerCount(ctx context.Context) (uint32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.workerCount, nil
+}
+
+// SetWorkerCount sets the number of mock VPP worker threads.
+func (m *MockClient) SetWorkerCount(count uint32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.workerCount = count
+}
+
+// InterfaceCreateRequest returns the request a mock interface was created
+// with.
+func (m *MockClient) InterfaceCreateRequest(ifIndex uint32) (CreateInterfaceRequest, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	req, ok := m.createRequests[ifIndex]
+	return req, ok
+}
+
+// SetInterfaceMTU records the link MTU of a mock interface.
+func (m *MockClient) SetInterfaceMTU(ctx context.Context, ifIndex uint32, mtu uint32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetMTUError != nil {
+		return m.SetMTUError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "setting MTU"); err != nil {
+		return err
+	}
+	m.linkMTUs[ifIndex] = mtu
+	return nil
+}
+
+// SetInterfaceIPMTU records the IPv4 and IPv6 MTUs of a mock interface.
+func (m *MockClient) SetInterfaceIPMTU(ctx context.Context, ifIndex uint32, ip4, ip6 uint32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetMTUError != nil {
+		return m.SetMTUError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "setting IP MTU"); err != nil {
+		return err
+	}
+	m.ipMTUs[ifIndex] = [2]uint32{ip4, ip6}
+	return nil
+}
+
+// InterfaceMTU returns the link MTU and the IPv4 and IPv6 MTUs set on a mock
+// interface; zero means the value was never set.
+func (m *MockClient) InterfaceMTU(ifIndex uint32) (link, ip4, ip6 uint32) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ip := m.ipMTUs[ifIndex]
+	return m.linkMTUs[ifIndex], ip[0], ip[1]
+}
+
+// SetInterfaceIP6Enabled records whether IPv6 is explicitly enabled on a mock
+// interface.
+func (m *MockClient) SetInterfaceIP6Enabled(ctx context.Context, ifIndex uint32, enabled bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetIP6EnableError != nil {
+		return m.SetIP6EnableError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "enabling IPv6"); err != nil {
+		return err
+	}
+	if enabled {
+		m.ip6Enabled[ifIndex] = true
+	} else {
+		delete(m.ip6Enabled, ifIndex)
+	}
+	return nil
+}
+
+// InterfaceIP6Enabled reports whether IPv6 was explicitly enabled on a mock
+// interface.
+func (m *MockClient) InterfaceIP6Enabled(ifIndex uint32) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ip6Enabled[ifIndex]
+}
+
+// SetInterfaceRouterAdvertisement records the router advertisement state of
+// a mock interface.
+func (m *MockClient) SetInterfaceRouterAdvertisement(ctx context.Context, ifIndex uint32, ra RouterAdvertisement) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetRouterAdvertisementError != nil {
+		return m.SetRouterAdvertisementError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "configuring router advertisements"); err != nil {
+		return err
+	}
+	m.routerAdverts[ifIndex] = ra
+	return nil
+}
+
+// AddRouterAdvertisementPrefix records an advertised prefix on a mock
+// interface.
+func (m *MockClient) AddRouterAdvertisementPrefix(ctx context.Context, ifIndex uint32, prefix RouterAdvertisementPrefix) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetRouterAdvertisementError != nil {
+		return m.SetRouterAdvertisementError
+	}
+	if prefix.Prefix == nil {
+		return fmt.Errorf("router advertisement prefix is nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "adding router advertisement prefix"); err != nil {
+		return err
+	}
+	if m.raPrefixes[ifIndex] == nil {
+		m.raPrefixes[ifIndex] = make(map[string]RouterAdvertisementPrefix)
+	}
+	m.raPrefixes[ifIndex][prefix.Prefix.String()] = prefix
+	return nil
+}
+
+// DeleteRouterAdvertisementPrefix removes an advertised prefix from a mock
+// interface.
+func (m *MockClient) DeleteRouterAdvertisementPrefix(ctx context.Context, ifIndex uint32, prefix *net.IPNet) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetRouterAdvertisementError != nil {
+		return m.SetRouterAdvertisementError
+	}
+	if prefix == nil {
+		return fmt.Errorf("router advertisement prefix is nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "deleting router advertisement prefix"); err != nil {
+		return err
+	}
+	delete(m.raPrefixes[ifIndex], prefix.String())
+	return nil
+}
+
+// InterfaceRouterAdvertisement returns the router advertisement state set on
+// a mock interface and whether it was ever set.
+func (m *MockClient) InterfaceRouterAdvertisement(ifIndex uint32) (RouterAdvertisement, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ra, ok := m.routerAdverts[ifIndex]
+	return ra, ok
+}
+
+// RouterAdvertisementPrefixes returns the prefixes advertised on a mock
+// interface, sorted by prefix.
+func (m *MockClient) RouterAdvertisementPrefixes(ifIndex uint32) []RouterAdvertisementPrefix {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]string, 0, len(m.raPrefixes[ifIndex]))
+	for key := range m.raPrefixes[ifIndex] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	prefixes := make([]RouterAdvertisementPrefix, 0, len(keys))
+	for _, key := range keys {
+		prefixes = append(prefixes, m.raPrefixes[ifIndex][key])
+	}
+	return prefixes
+}
+
+func (m *MockClient) checkConnectedLocked(operation string) error {
+	if !m.connected {
+		return errors.New(
+			errors.ErrCodeVPPConnection,
+			"Not connected to VPP",
+			"VPP connection not established",
+			"Connect to VPP before "+operation,
+		)
+	}
+	return nil
+}
+
+func (m *MockClient) checkInterfaceLocked(ifIndex uint32, operation string) error {
+	if err := m.checkConnectedLocked(operation); err != nil {
+		return err
+	}
+	if _, ok := m.interfaces[ifIndex]; !ok {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Interface with index %d not found", ifIndex),
+			"Interface does not exist",
+			"Create the interface before "+operation,
+		)
+	}
+	return nil
+}
+
 // SetMPLSInterface enables or disables MPLS forwarding on a mock interface.
 func (m *MockClient) SetMPLSInterface(ctx context.Context, ifIndex uint32, enabled bool) error {
 	if err := ctx.Err(); err != nil {
@@ -773,6 +1285,118 @@ func cloneQoSCapabilities(capabilities QoSCapabilities) QoSCapabilities {
 	return capabilities
 }
 
+// AddPolicer creates a mock policer.
+func (m *MockClient) AddPolicer(ctx context.Context, policer Policer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.AddPolicerError != nil {
+		return m.AddPolicerError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.connected {
+		return errors.New(
+			errors.ErrCodeVPPConnection,
+			"Not connected to VPP",
+			"VPP connection not established",
+			"Connect to VPP before adding policers",
+		)
+	}
+	if _, exists := m.policers[policer.Name]; exists {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Policer %s already exists", policer.Name),
+			"Policer names must be unique",
+			"Delete the existing policer before adding it again",
+		)
+	}
+	m.policers[policer.Name] = policer
+	return nil
+}
+
+// DeletePolicer deletes a mock policer.
+func (m *MockClient) DeletePolicer(ctx context.Context, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.DeletePolicerError != nil {
+		return m.DeletePolicerError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.connected {
+		return errors.New(
+			errors.ErrCodeVPPConnection,
+			"Not connected to VPP",
+			"VPP connection not established",
+			"Connect to VPP before deleting policers",
+		)
+	}
+	if _, exists := m.policers[name]; !exists {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Policer %s not found", name),
+			"Policer does not exist",
+			"Check the policer name",
+		)
+	}
+	delete(m.policers, name)
+	return nil
+}
+
+// SetInterfacePolicer applies or removes a mock policer on an interface.
+func (m *MockClient) SetInterfacePolicer(ctx context.Context, ifIndex uint32, name string, output, apply bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.SetInterfacePolicerError != nil {
+		return m.SetInterfacePolicerError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkInterfaceLocked(ifIndex, "setting interface policers"); err != nil {
+		return err
+	}
+	if _, exists := m.policers[name]; !exists {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			fmt.Sprintf("Policer %s not found", name),
+			"Policer does not exist",
+			"Add the policer before applying it to an interface",
+		)
+	}
+	key := policerBindingKey{ifIndex: ifIndex, output: output}
+	if apply {
+		m.policerBindings[key] = name
+	} else {
+		delete(m.policerBindings, key)
+	}
+	return nil
+}
+
+// Policer returns a mock policer by name.
+func (m *MockClient) Policer(name string) (Policer, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	policer, ok := m.policers[name]
+	return policer, ok
+}
+
+// InterfacePolicer returns the mock policer applied to an interface direction.
+func (m *MockClient) InterfacePolicer(ifIndex uint32, output bool) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	name, ok := m.policerBindings[policerBindingKey{ifIndex: ifIndex, output: output}]
+	return name, ok
+}
+
 // AddBridgeDomain creates a mock bridge domain.
 func (m *MockClient) AddBridgeDomain(ctx context.Context, bridge BridgeDomain) error {
 	if err := ctx.Err(); err != nil {
@@ -921,6 +1545,42 @@ func (m *MockClient) DeleteVXLAN(ctx context.Context, req VXLANRequest) error {
 	return nil
 }
 
+// DeleteTunnelInterface deletes a mock GRE or IPIP tunnel interface or
+// loopback interface.
+func (m *MockClient) DeleteTunnelInterface(ctx context.Context, req *CreateInterfaceRequest, ifIndex uint32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.DeleteTunnelInterfaceError != nil {
+		return m.DeleteTunnelInterfaceError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.connected {
+		return errors.New(
+			errors.ErrCodeVPPConnection,
+			"Not connected to VPP",
+			"VPP connection not established",
+			"Connect to VPP before deleting tunnel interfaces",
+		)
+	}
+	if req == nil || (req.Type != InterfaceTypeGRE && req.Type != InterfaceTypeIPIP && req.Type != InterfaceTypeLoopback) {
+		return errors.New(
+			errors.ErrCodeVPPOperation,
+			"Invalid tunnel interface type",
+			"Only gre, ipip and loopback interfaces can be deleted this way",
+			"Use a valid tunnel interface type",
+		)
+	}
+	delete(m.interfaceTable, interfaceTableKey{ifIndex: ifIndex, isIPv6: false})
+	delete(m.interfaceTable, interfaceTableKey{ifIndex: ifIndex, isIPv6: true})
+	delete(m.lcpInterfaces, ifIndex)
+	delete(m.interfaces, ifIndex)
+	return nil
+}
+
 // SetInterfaceL2Bridge attaches or detaches an interface to a mock bridge domain.
 func (m *MockClient) SetInterfaceL2Bridge(ctx context.Context, ifIndex uint32, bridgeID uint32, enable bool) error {
 	if err := ctx.Err(); err != nil {
@@ -1056,6 +1716,43 @@ func (m *MockClient) ListInterfaceCounters(ctx context.Context) (map[uint32]Inte
 	return counters, nil
 }
 
+// ClearInterfaceStats resets the mock counters of an interface, or of every
+// interface when ifIndex is AllInterfaces.
+func (m *MockClient) ClearInterfaceStats(ctx context.Context, ifIndex uint32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.ClearInterfaceStatsError != nil {
+		return m.ClearInterfaceStatsError
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ifIndex == AllInterfaces {
+		if err := m.checkConnectedLocked("clearing interface counters"); err != nil {
+			return err
+		}
+		m.counters = make(map[uint32]InterfaceCounters)
+		return nil
+	}
+	if err := m.checkInterfaceLocked(ifIndex, "clearing interface counters"); err != nil {
+		return err
+	}
+	delete(m.counters, ifIndex)
+	return nil
+}
+
+// SetInterfaceLinkState sets the mock link state of a VPP interface without
+// changing its admin state, as when a cable is pulled.
+func (m *MockClient) SetInterfaceLinkState(ifIndex uint32, up bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if iface, ok := m.interfaces[ifIndex]; ok {
+		iface.LinkUp = up
+	}
+}
+
 // SetInterfaceCounters sets mock counters for a VPP interface.
 func (m *MockClient) SetInterfaceCounters(ifIndex uint32, counters InterfaceCounters) {
 	m.mu.Lock()
@@ -1175,14 +1872,25 @@ func (m *MockClient) Reset() {
 	m.interfaces = make(map[uint32]*Interface)
 	m.lcpInterfaces = make(map[uint32]*LCPInterface)
 	m.mplsInterfaces = make(map[uint32]bool)
+	m.promiscuous = make(map[uint32]bool)
+	m.rxModes = make(map[uint32]string)
+	m.linkMTUs = make(map[uint32]uint32)
+	m.ipMTUs = make(map[uint32][2]uint32)
+	m.routerAdverts = make(map[uint32]RouterAdvertisement)
+	m.raPrefixes = make(map[uint32]map[string]RouterAdvertisementPrefix)
 	m.ipTables = make(map[ipTableKey]IPTable)
 	m.interfaceTable = make(map[interfaceTableKey]uint32)
 	m.qosProfiles = make(map[uint32]QoSProfile)
+	m.policers = make(map[string]Policer)
+	m.policerBindings = make(map[policerBindingKey]string)
 	m.bridgeDomains = make(map[uint32]BridgeDomain)
 	m.vxlanTunnels = make(map[vxlanTunnelKey]*Interface)
 	m.l2Bridge = make(map[uint32]uint32)
 	m.counters = make(map[uint32]InterfaceCounters)
 	m.queuePlacement = make(map[uint32]InterfaceQueuePlacements)
+	m.rxPlacement = make(map[uint32]map[uint32]uint32)
+	m.createRequests = make(map[uint32]CreateInterfaceRequest)
+	m.workerCount = defaultMockWorkerCount
 	m.qosCapabilities = QoSCapabilities{MetadataBinding: true}
 	m.nextIfIdx = 1
 
@@ -1192,6 +1900,11 @@ func (m *MockClient) Reset() {
 	m.SetInterfaceDownError = nil
 	m.SetInterfaceAddressError = nil
 	m.DeleteInterfaceAddressError = nil
+	m.SetPromiscuousError = nil
+	m.SetRxModeError = nil
+	m.SetRxPlacementError = nil
+	m.SetMTUError = nil
+	m.SetRouterAdvertisementError = nil
 	m.SetMPLSInterfaceError = nil
 	m.AddIPTableError = nil
 	m.DeleteIPTableError = nil
@@ -1200,12 +1913,16 @@ func (m *MockClient) Reset() {
 	m.GetQoSCapabilitiesError = nil
 	m.SetQoSProfileError = nil
 	m.ClearQoSProfileError = nil
+	m.AddPolicerError = nil
+	m.DeletePolicerError = nil
+	m.SetInterfacePolicerError = nil
 	m.AddBridgeDomainError = nil
 	m.DeleteBridgeDomainError = nil
 	m.CreateVXLANError = nil
 	m.DeleteVXLANError = nil
 	m.SetInterfaceL2BridgeError = nil
 	m.ListInterfaceCountersError = nil
+	m.ClearInterfaceStatsError = nil
 	m.ListInterfaceQueuesError = nil
 	m.GetInterfaceError = nil
 	m.ListInterfacesError = nil